@@ -20,6 +20,21 @@ func main() {
 	migrate := cmd.NewMigrateCommand()
 	root.AddCommand(migrate)
 	
+	bundle := cmd.NewBundleCommand()
+	root.AddCommand(bundle)
+	
+	relationships := cmd.NewRelationshipsCommand()
+	root.AddCommand(relationships)
+	
+	encryption := cmd.NewEncryptionCommand()
+	root.AddCommand(encryption)
+	
+	tenants := cmd.NewTenantsCommand()
+	root.AddCommand(tenants)
+	
+	doctor := cmd.NewDoctorCommand()
+	root.AddCommand(doctor)
+	
 	if err := root.Execute(); err != nil {
 		os.Exit(1)
 	}
@@ -0,0 +1,315 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/adminium/permify/pkg/client"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// Document - Document of a folder, its permissions are kept in permify and only its content in the application
+type Document struct {
+	ID     string `json:"id"`
+	Folder string `json:"folder"`
+	Title  string `json:"title"`
+	Body   string `json:"body"`
+}
+
+// App - Serves the documents of the users that are allowed to see them:
+//
+//	GET    /documents              documents the user can view
+//	POST   /documents              creates a document in a folder the user can edit
+//	GET    /documents/{id}         reads a document the user can view
+//	PUT    /documents/{id}         updates a document the user can edit
+//	DELETE /documents/{id}         deletes a document the user owns
+//	POST   /documents/{id}/viewers shares a document the user can edit with another user
+type App struct {
+	client     *client.Client
+	schema     *client.Schema
+	authorizer *Authorizer
+
+	mu        sync.RWMutex
+	documents map[string]*Document
+	// snap token of the last write, the checks are answered at it or later
+	snap string
+}
+
+// New - Creates new application authorized through the client against the schema written by Setup
+func New(c *client.Client, sch *client.Schema) *App {
+	a := &App{
+		client:    c,
+		schema:    sch,
+		documents: map[string]*Document{},
+	}
+	a.authorizer = NewAuthorizer(c, sch, a.snapToken)
+	return a
+}
+
+// Seed - Writes the sample data: alice administers the acme organization, bob owns its engineering folder and its
+// design document, and the members of acme, carol among them, can view the folder
+func (a *App) Seed(ctx context.Context) error {
+	request, err := a.schema.Tuples().
+		Add("organization", "acme", "admin", "user", "alice").
+		Add("organization", "acme", "member", "user", "carol").
+		Add("folder", "engineering", "org", "organization", "acme").
+		Add("folder", "engineering", "owner", "user", "bob").
+		Add("folder", "engineering", "viewer", "organization", "acme", "member").
+		Add("document", "design", "parent", "folder", "engineering").
+		Add("document", "design", "owner", "user", "bob").
+		WriteRequest()
+	if err != nil {
+		return err
+	}
+	response, err := a.client.Relationship.Write(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.documents["design"] = &Document{ID: "design", Folder: "engineering", Title: "Design", Body: "How it works."}
+	a.snap = response.GetSnapToken()
+	return nil
+}
+
+// Handler - Routes of the application, every one of them requires a user
+func (a *App) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/documents", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			a.list(w, r)
+		case http.MethodPost:
+			a.create(w, r)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	document := func(r *http.Request) (string, string) {
+		id, _ := documentPath(r.URL.Path)
+		return "document", id
+	}
+	read := a.authorizer.Require("view", document, http.HandlerFunc(a.read))
+	update := a.authorizer.Require("edit", document, http.HandlerFunc(a.update))
+	remove := a.authorizer.Require("delete", document, http.HandlerFunc(a.delete))
+	share := a.authorizer.Require("edit", document, http.HandlerFunc(a.share))
+	mux.HandleFunc("/documents/", func(w http.ResponseWriter, r *http.Request) {
+		id, sub := documentPath(r.URL.Path)
+		switch {
+		case id == "":
+			http.NotFound(w, r)
+		case sub == "" && r.Method == http.MethodGet:
+			read.ServeHTTP(w, r)
+		case sub == "" && r.Method == http.MethodPut:
+			update.ServeHTTP(w, r)
+		case sub == "" && r.Method == http.MethodDelete:
+			remove.ServeHTTP(w, r)
+		case sub == "viewers" && r.Method == http.MethodPost:
+			share.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	return Authenticate(mux)
+}
+
+// list - Looks up the documents the user can view instead of checking every document
+func (a *App) list(w http.ResponseWriter, r *http.Request) {
+	response, err := a.client.Permission.LookupEntity(r.Context(), &base.PermissionLookupEntityRequest{
+		TenantId:   a.schema.TenantID,
+		EntityType: "document",
+		Permission: "view",
+		Subject:    &base.Subject{Type: "user", Id: UserFromContext(r.Context())},
+		Metadata: &base.PermissionLookupEntityRequestMetadata{
+			SchemaVersion: a.schema.Version,
+			SnapToken:     a.snapToken(),
+			Depth:         20,
+		},
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	a.mu.RLock()
+	documents := make([]*Document, 0, len(response.GetEntityIds()))
+	for _, id := range response.GetEntityIds() {
+		if document, ok := a.documents[id]; ok {
+			documents = append(documents, document)
+		}
+	}
+	a.mu.RUnlock()
+
+	sort.Slice(documents, func(i, j int) bool { return documents[i].ID < documents[j].ID })
+	writeJSON(w, http.StatusOK, documents)
+}
+
+// create - The document is created in the folder once the user is allowed to edit the folder, its creator owns it
+func (a *App) create(w http.ResponseWriter, r *http.Request) {
+	var document Document
+	if err := json.NewDecoder(r.Body).Decode(&document); err != nil || document.ID == "" || document.Folder == "" {
+		http.Error(w, "id and folder are required", http.StatusBadRequest)
+		return
+	}
+
+	user := UserFromContext(r.Context())
+	allowed, err := a.authorizer.Can(r.Context(), user, "folder", document.Folder, "edit")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	if !allowed {
+		http.Error(w, "forbidden", http.StatusForbidden)
+		return
+	}
+
+	a.mu.RLock()
+	_, exists := a.documents[document.ID]
+	a.mu.RUnlock()
+	if exists {
+		http.Error(w, "document exists", http.StatusConflict)
+		return
+	}
+
+	if err = a.write(r.Context(), a.schema.Tuples().
+		Add("document", document.ID, "parent", "folder", document.Folder).
+		Add("document", document.ID, "owner", "user", user)); err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	a.mu.Lock()
+	a.documents[document.ID] = &document
+	a.mu.Unlock()
+	writeJSON(w, http.StatusCreated, document)
+}
+
+// read -
+func (a *App) read(w http.ResponseWriter, r *http.Request) {
+	document, ok := a.document(r)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	writeJSON(w, http.StatusOK, document)
+}
+
+// update - Replaces the title and the body of the document, it stays in its folder
+func (a *App) update(w http.ResponseWriter, r *http.Request) {
+	var changes Document
+	if err := json.NewDecoder(r.Body).Decode(&changes); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	id, _ := documentPath(r.URL.Path)
+	a.mu.Lock()
+	document, ok := a.documents[id]
+	if ok {
+		updated := *document
+		updated.Title, updated.Body = changes.Title, changes.Body
+		a.documents[id] = &updated
+		document = &updated
+	}
+	a.mu.Unlock()
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	writeJSON(w, http.StatusOK, document)
+}
+
+// delete - Deletes the relationships of the document along with it, so that a document created later with the same
+// id does not inherit its viewers
+func (a *App) delete(w http.ResponseWriter, r *http.Request) {
+	id, _ := documentPath(r.URL.Path)
+	response, err := a.client.Relationship.Delete(r.Context(), &base.RelationshipDeleteRequest{
+		TenantId: a.schema.TenantID,
+		Filter: &base.TupleFilter{
+			Entity: &base.EntityFilter{Type: "document", Ids: []string{id}},
+		},
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	a.mu.Lock()
+	delete(a.documents, id)
+	a.snap = response.GetSnapToken()
+	a.mu.Unlock()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// share - Makes the user of the body a viewer of the document
+func (a *App) share(w http.ResponseWriter, r *http.Request) {
+	var viewer struct {
+		User string `json:"user"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&viewer); err != nil || viewer.User == "" {
+		http.Error(w, "user is required", http.StatusBadRequest)
+		return
+	}
+
+	id, _ := documentPath(r.URL.Path)
+	if err := a.write(r.Context(), a.schema.Tuples().Add("document", id, "viewer", "user", viewer.User)); err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// write - Writes the tuples and keeps the snap token of the write
+func (a *App) write(ctx context.Context, tuples *client.TupleBuilder) error {
+	request, err := tuples.WriteRequest()
+	if err != nil {
+		return err
+	}
+	response, err := a.client.Relationship.Write(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	a.snap = response.GetSnapToken()
+	a.mu.Unlock()
+	return nil
+}
+
+// snapToken - Snap token of the last write
+func (a *App) snapToken() string {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.snap
+}
+
+// document - Document of the path of the request
+func (a *App) document(r *http.Request) (*Document, bool) {
+	id, _ := documentPath(r.URL.Path)
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	document, ok := a.documents[id]
+	return document, ok
+}
+
+// documentPath - Id of the document and the rest of the path, /documents/{id}/{sub}
+func documentPath(path string) (id, sub string) {
+	parts := strings.SplitN(strings.TrimPrefix(path, "/documents/"), "/", 2)
+	if len(parts) == 2 {
+		sub = parts[1]
+	}
+	return parts[0], sub
+}
+
+// writeJSON -
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
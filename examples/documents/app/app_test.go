@@ -0,0 +1,131 @@
+package app
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/adminium/permify/internal/servers"
+	"github.com/adminium/permify/pkg/client"
+	"github.com/adminium/permify/pkg/development"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// TestApp -
+func TestApp(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "documents-suite")
+}
+
+var _ = Describe("documents", func() {
+	var server *grpc.Server
+	var c *client.Client
+	var handler http.Handler
+
+	// the application talks to a permify server over a real grpc connection, the server keeps its data in memory
+	BeforeEach(func() {
+		container := development.NewContainer()
+		l := logger.New("error")
+
+		listener := bufconn.Listen(1 << 20)
+		server = grpc.NewServer()
+		base.RegisterPermissionServer(server, servers.NewPermissionServer(container.P, l))
+		base.RegisterSchemaServer(server, servers.NewSchemaServer(container.S, l))
+		base.RegisterRelationshipServer(server, servers.NewRelationshipServer(container.R, l))
+		go func() {
+			_ = server.Serve(listener)
+		}()
+
+		var err error
+		c, err = client.New(context.Background(), "bufnet",
+			client.WithDialOptions(
+				grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+					return listener.DialContext(ctx)
+				}),
+				grpc.WithTransportCredentials(insecure.NewCredentials()),
+			),
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		var sch *client.Schema
+		sch, err = Setup(context.Background(), c, "t1")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(sch.Version).ShouldNot(BeEmpty())
+
+		documents := New(c, sch)
+		Expect(documents.Seed(context.Background())).Should(Succeed())
+		handler = documents.Handler()
+	})
+
+	AfterEach(func() {
+		Expect(c.Close()).Should(Succeed())
+		server.Stop()
+	})
+
+	do := func(user, method, path, body string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(method, path, strings.NewReader(body))
+		if user != "" {
+			request.Header.Set(UserHeader, user)
+		}
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	Context("Read", func() {
+		It("Case 1: The owner, the admin of the organization and its members can read the document", func() {
+			Expect(do("bob", http.MethodGet, "/documents/design", "").Code).Should(Equal(http.StatusOK))
+			Expect(do("alice", http.MethodGet, "/documents/design", "").Code).Should(Equal(http.StatusOK))
+			Expect(do("carol", http.MethodGet, "/documents/design", "").Code).Should(Equal(http.StatusOK))
+			Expect(do("mallory", http.MethodGet, "/documents/design", "").Code).Should(Equal(http.StatusForbidden))
+			Expect(do("", http.MethodGet, "/documents/design", "").Code).Should(Equal(http.StatusUnauthorized))
+		})
+
+		It("Case 2: The documents are listed for the users that can view them", func() {
+			response := do("carol", http.MethodGet, "/documents", "")
+			Expect(response.Code).Should(Equal(http.StatusOK))
+			Expect(response.Body.String()).Should(ContainSubstring(`"id":"design"`))
+
+			response = do("mallory", http.MethodGet, "/documents", "")
+			Expect(response.Code).Should(Equal(http.StatusOK))
+			Expect(strings.TrimSpace(response.Body.String())).Should(Equal("[]"))
+		})
+	})
+
+	Context("Write", func() {
+		It("Case 1: Only the editors of the document can update it", func() {
+			Expect(do("carol", http.MethodPut, "/documents/design", `{"title":"Design","body":"changed"}`).Code).Should(Equal(http.StatusForbidden))
+			Expect(do("alice", http.MethodPut, "/documents/design", `{"title":"Design","body":"changed"}`).Code).Should(Equal(http.StatusOK))
+			Expect(do("carol", http.MethodGet, "/documents/design", "").Body.String()).Should(ContainSubstring(`"body":"changed"`))
+		})
+
+		It("Case 2: The creator of a document owns it and can share it", func() {
+			Expect(do("carol", http.MethodPost, "/documents", `{"id":"notes","folder":"engineering"}`).Code).Should(Equal(http.StatusForbidden))
+			Expect(do("bob", http.MethodPost, "/documents", `{"id":"notes","folder":"engineering","title":"Notes"}`).Code).Should(Equal(http.StatusCreated))
+
+			// the checks are answered at the snap token of the last write, the new tuples are seen right away
+			Expect(do("bob", http.MethodGet, "/documents/notes", "").Code).Should(Equal(http.StatusOK))
+			Expect(do("mallory", http.MethodGet, "/documents/notes", "").Code).Should(Equal(http.StatusForbidden))
+
+			Expect(do("bob", http.MethodPost, "/documents/notes/viewers", `{"user":"mallory"}`).Code).Should(Equal(http.StatusNoContent))
+			Expect(do("mallory", http.MethodGet, "/documents/notes", "").Code).Should(Equal(http.StatusOK))
+			Expect(do("mallory", http.MethodPut, "/documents/notes", `{"title":"Notes"}`).Code).Should(Equal(http.StatusForbidden))
+		})
+
+		It("Case 3: Only the owner can delete the document", func() {
+			Expect(do("alice", http.MethodDelete, "/documents/design", "").Code).Should(Equal(http.StatusForbidden))
+			Expect(do("bob", http.MethodDelete, "/documents/design", "").Code).Should(Equal(http.StatusNoContent))
+			Expect(do("bob", http.MethodGet, "/documents/design", "").Code).Should(Equal(http.StatusForbidden))
+		})
+	})
+})
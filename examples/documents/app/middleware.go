@@ -0,0 +1,87 @@
+package app
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/adminium/permify/pkg/client"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// UserHeader - Header the user of a request is read from. A real application takes the user from its session or its
+// tokens, the header keeps the example short.
+const UserHeader = "X-User"
+
+type userKey struct{}
+
+// Authenticate - Rejects the requests without a user and passes the user to the handlers in the context
+func Authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user := r.Header.Get(UserHeader)
+		if user == "" {
+			http.Error(w, "unauthenticated", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), userKey{}, user)))
+	})
+}
+
+// UserFromContext - User of the request, empty when the request was not authenticated
+func UserFromContext(ctx context.Context) string {
+	user, _ := ctx.Value(userKey{}).(string)
+	return user
+}
+
+// Resource - Entity a request acts on, the id is taken from the request
+type Resource func(r *http.Request) (entityType, entityID string)
+
+// Authorizer - Guards handlers with permission checks of the user of the request
+type Authorizer struct {
+	client *client.Client
+	schema *client.Schema
+	// snap returns the snap token the checks are answered at, so that the users see their own writes
+	snap func() string
+}
+
+// NewAuthorizer - Creates new authorizer checking with the client against the schema
+func NewAuthorizer(c *client.Client, sch *client.Schema, snap func() string) *Authorizer {
+	return &Authorizer{
+		client: c,
+		schema: sch,
+		snap:   snap,
+	}
+}
+
+// Require - Serves the request only when its user has the permission on the resource of the request, the request is
+// answered with 403 when the user does not have it and with 502 when permify can not be asked
+func (a *Authorizer) Require(permission string, resource Resource, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		entityType, entityID := resource(r)
+		allowed, err := a.Can(r.Context(), UserFromContext(r.Context()), entityType, entityID, permission)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		if !allowed {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Can - Checks the permission of the user on the entity
+func (a *Authorizer) Can(ctx context.Context, user, entityType, entityID, permission string) (bool, error) {
+	request, err := a.schema.Check(entityType, entityID, permission).
+		Subject("user", user).
+		SnapToken(a.snap()).
+		Build()
+	if err != nil {
+		return false, err
+	}
+	response, err := a.client.Permission.Check(ctx, request)
+	if err != nil {
+		return false, err
+	}
+	return response.GetCan() == base.PermissionCheckResponse_RESULT_ALLOWED, nil
+}
@@ -0,0 +1,29 @@
+// Package app is a small document sharing application whose authorization is delegated to permify through the go
+// client: organizations have folders, folders have documents, and every handler is guarded by a permission check.
+package app
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/adminium/permify/pkg/client"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// Definition - Schema of the application, it is written to permify when the application starts
+//
+//go:embed schema.perm
+var Definition string
+
+// Setup - Writes the schema of the application to the tenant and returns the local copy of it that the requests are
+// built and validated with, pinned to the written version
+func Setup(ctx context.Context, c *client.Client, tenantID string) (*client.Schema, error) {
+	response, err := c.Schema.Write(ctx, &base.SchemaWriteRequest{
+		TenantId: tenantID,
+		Schema:   Definition,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return client.NewSchema(tenantID, response.GetSchemaVersion(), Definition)
+}
@@ -0,0 +1,62 @@
+// Command documents runs the document sharing example against a permify server:
+//
+//	permify serve
+//	go run ./examples/documents -permify localhost:3478
+//	curl -H 'X-User: carol' localhost:8080/documents
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/adminium/permify/examples/documents/app"
+	"github.com/adminium/permify/pkg/client"
+)
+
+func main() {
+	target := flag.String("permify", "localhost:3478", "grpc address of the permify server")
+	tenantID := flag.String("tenant", "t1", "tenant the schema and the relationships are written to")
+	token := flag.String("token", "", "preshared key of the permify server, when it requires one")
+	addr := flag.String("addr", ":8080", "address the application listens on")
+	flag.Parse()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	options := []client.Option{
+		client.WithDialOptions(grpc.WithTransportCredentials(insecure.NewCredentials())),
+		client.WithTimeout(5 * time.Second),
+	}
+	if *token != "" {
+		options = append(options, client.WithToken(client.StaticToken(*token)))
+	}
+	c, err := client.New(ctx, *target, options...)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer c.Close()
+
+	sch, err := app.Setup(ctx, c, *tenantID)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	documents := app.New(c, sch)
+	if err = documents.Seed(ctx); err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("documents: listening on %s with schema version %s", *addr, sch.Version)
+	server := &http.Server{
+		Addr:              *addr,
+		Handler:           documents.Handler(),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	log.Fatal(server.ListenAndServe())
+}
@@ -0,0 +1,328 @@
+package archive
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+	
+	"gopkg.in/yaml.v3"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/internal/schema"
+	"github.com/adminium/permify/pkg/archive"
+	"github.com/adminium/permify/pkg/bundle"
+	"github.com/adminium/permify/pkg/database"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/token"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+const (
+	_defaultInterval = time.Minute
+	_defaultPageSize = 100
+	
+	// prefixes of the keys, the cursors are never expired
+	_changesPrefix = "changes/"
+	_exportsPrefix = "exports/"
+	_cursorsPrefix = "cursors/"
+)
+
+// Segment - The relation tuples created and deleted in a tenant between the snapshots of two tokens
+type Segment struct {
+	TenantID   string    `json:"tenant_id"`
+	From       string    `json:"from"`
+	To         string    `json:"to"`
+	ArchivedAt time.Time `json:"archived_at"`
+	Created    []string  `json:"created"`
+	Deleted    []string  `json:"deleted"`
+}
+
+// Archiver - Ships the change log of the tenants and exports of their snapshots to an object storage. Every interval
+// the changes since the last archived snapshot are written as a segment, every export interval the schema and the
+// tuples of the head snapshot are written as a bundle, and the objects older than the retention are deleted. The
+// snapshot the next segment starts at is kept in the store, so a restarted archiver continues where it stopped.
+// Only a single instance should archive to a bucket.
+type Archiver struct {
+	store archive.Store
+	// repositories
+	tenantReader       repositories.TenantReader
+	relationshipReader repositories.RelationshipReader
+	schemaReader       repositories.SchemaReader
+	// options
+	interval       time.Duration
+	exportInterval time.Duration
+	retention      time.Duration
+	lastExport     time.Time
+	logger         logger.Interface
+}
+
+// NewArchiver - Creates a new archiver. A zero export interval disables the exports, a zero retention keeps the
+// objects forever.
+func NewArchiver(store archive.Store, tr repositories.TenantReader, rr repositories.RelationshipReader, sr repositories.SchemaReader, interval, exportInterval, retention time.Duration, l logger.Interface) *Archiver {
+	if interval <= 0 {
+		interval = _defaultInterval
+	}
+	return &Archiver{
+		store:              store,
+		tenantReader:       tr,
+		relationshipReader: rr,
+		schemaReader:       sr,
+		interval:           interval,
+		exportInterval:     exportInterval,
+		retention:          retention,
+		logger:             l,
+	}
+}
+
+// Run - Archives every interval until the context is done, failed archives are logged and retried on the next one
+func (a *Archiver) Run(ctx context.Context) error {
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+	
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case now := <-ticker.C:
+			if err := a.Archive(ctx, now); err != nil {
+				a.logger.Error(fmt.Sprintf("archive failed: %s", err.Error()))
+			}
+		}
+	}
+}
+
+// Archive - Archives the changes of every tenant, exports them when the export interval passed and expires the old
+// objects. The tenants are archived independently, the first error is returned once all of them were tried.
+func (a *Archiver) Archive(ctx context.Context, now time.Time) error {
+	tenants, err := a.tenants(ctx)
+	if err != nil {
+		return err
+	}
+	
+	export := a.exportInterval > 0 && now.Sub(a.lastExport) >= a.exportInterval
+	
+	var errs []error
+	for _, tenantID := range tenants {
+		if err = a.archiveChanges(ctx, tenantID, now); err != nil {
+			errs = append(errs, fmt.Errorf("changes of %s: %w", tenantID, err))
+		}
+		if export {
+			if err = a.export(ctx, tenantID, now); err != nil {
+				errs = append(errs, fmt.Errorf("export of %s: %w", tenantID, err))
+			}
+		}
+	}
+	if export {
+		a.lastExport = now
+	}
+	
+	if err = a.expire(ctx, now); err != nil {
+		errs = append(errs, err)
+	}
+	
+	if len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// archiveChanges - Writes the changes between the archived and the head snapshot of the tenant. The first archive
+// of a tenant only records the head snapshot, its earlier state is covered by the exports.
+func (a *Archiver) archiveChanges(ctx context.Context, tenantID string, now time.Time) error {
+	head, err := a.relationshipReader.HeadSnapshot(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	to := head.Encode().String()
+	
+	var from []byte
+	from, err = a.store.Get(ctx, _cursorsPrefix+tenantID)
+	if err != nil && !errors.Is(err, archive.ErrNotFound) {
+		return err
+	}
+	if string(from) == to {
+		return nil
+	}
+	
+	if len(from) > 0 {
+		var created, deleted *database.TupleCollection
+		created, deleted, err = a.relationshipReader.ReadRelationshipChanges(ctx, tenantID, &base.TupleFilter{}, string(from), to)
+		if err != nil {
+			return err
+		}
+		
+		if len(created.GetTuples()) > 0 || len(deleted.GetTuples()) > 0 {
+			segment := Segment{
+				TenantID:   tenantID,
+				From:       string(from),
+				To:         to,
+				ArchivedAt: now.UTC(),
+				Created:    tuples(created),
+				Deleted:    tuples(deleted),
+			}
+			
+			var data []byte
+			data, err = json.Marshal(segment)
+			if err != nil {
+				return err
+			}
+			if err = a.put(ctx, key(_changesPrefix, tenantID, now, ".json.gz"), data); err != nil {
+				return err
+			}
+		}
+	}
+	
+	// the segment is written before the cursor moves, a failure in between archives the changes twice instead of
+	// losing them
+	return a.store.Put(ctx, _cursorsPrefix+tenantID, []byte(to))
+}
+
+// export - Writes the schema and the tuples of the head snapshot of the tenant as a bundle
+func (a *Archiver) export(ctx context.Context, tenantID string, now time.Time) error {
+	version, err := a.schemaReader.HeadVersion(ctx, tenantID)
+	if err != nil {
+		// a tenant without a schema has nothing to export
+		if err.Error() == base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String() {
+			return nil
+		}
+		return err
+	}
+	
+	var sch *base.SchemaDefinition
+	sch, err = a.schemaReader.ReadSchema(ctx, tenantID, version)
+	if err != nil {
+		return err
+	}
+	
+	var head token.SnapToken
+	head, err = a.relationshipReader.HeadSnapshot(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	snap := head.Encode().String()
+	
+	b := &bundle.Bundle{
+		Version:       bundle.Version,
+		SchemaVersion: version,
+		Schema:        schema.ToString(sch),
+		Tuples:        []string{},
+	}
+	
+	// every tuple belongs to an entity of the schema, so reading each entity type reads them all
+	entityTypes := make([]string, 0, len(sch.GetEntityDefinitions()))
+	for entityType := range sch.GetEntityDefinitions() {
+		entityTypes = append(entityTypes, entityType)
+	}
+	sort.Strings(entityTypes)
+	
+	for _, entityType := range entityTypes {
+		ct := ""
+		for {
+			var collection *database.TupleCollection
+			var next database.EncodedContinuousToken
+			collection, next, err = a.relationshipReader.ReadRelationships(ctx, tenantID, &base.TupleFilter{
+				Entity: &base.EntityFilter{Type: entityType},
+			}, snap, database.NewPagination(database.Size(_defaultPageSize), database.Token(ct)))
+			if err != nil {
+				return err
+			}
+			b.Tuples = append(b.Tuples, tuples(collection)...)
+			if next == nil || next.String() == "" {
+				break
+			}
+			ct = next.String()
+		}
+	}
+	
+	var data []byte
+	data, err = yaml.Marshal(b)
+	if err != nil {
+		return err
+	}
+	return a.put(ctx, key(_exportsPrefix, tenantID, now, ".yaml.gz"), data)
+}
+
+// expire - Deletes the segments and the exports older than the retention
+func (a *Archiver) expire(ctx context.Context, now time.Time) error {
+	if a.retention <= 0 {
+		return nil
+	}
+	for _, prefix := range []string{_changesPrefix, _exportsPrefix} {
+		objects, err := a.store.List(ctx, prefix)
+		if err != nil {
+			return err
+		}
+		for _, object := range objects {
+			if now.Sub(object.LastModified) < a.retention {
+				continue
+			}
+			if err = a.store.Delete(ctx, object.Key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// tenants - Ids of every tenant
+func (a *Archiver) tenants(ctx context.Context) ([]string, error) {
+	var ids []string
+	ct := ""
+	for {
+		tenants, next, err := a.tenantReader.ListTenants(ctx, database.NewPagination(database.Size(_defaultPageSize), database.Token(ct)))
+		if err != nil {
+			return nil, err
+		}
+		for _, tenant := range tenants {
+			ids = append(ids, tenant.GetId())
+		}
+		if next == nil || next.String() == "" {
+			return ids, nil
+		}
+		ct = next.String()
+	}
+}
+
+// put - Compresses and writes the object
+func (a *Archiver) put(ctx context.Context, key string, data []byte) error {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return a.store.Put(ctx, key, buf.Bytes())
+}
+
+// Decompress - Reads an archived segment or export
+func Decompress(data []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+// key - Key of an archived object, the keys of a tenant sort by the time they were archived at
+func key(prefix, tenantID string, now time.Time, extension string) string {
+	return prefix + tenantID + "/" + fmt.Sprintf("%020d", now.UnixNano()) + extension
+}
+
+// tuples - String forms of the tuples of the collection
+func tuples(collection *database.TupleCollection) []string {
+	s := make([]string, 0, len(collection.GetTuples()))
+	for _, t := range collection.GetTuples() {
+		s = append(s, tuple.ToString(t))
+	}
+	return s
+}
@@ -0,0 +1,206 @@
+package archive
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v3"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/internal/repositories/memory"
+	"github.com/adminium/permify/internal/repositories/memory/migrations"
+	"github.com/adminium/permify/pkg/archive"
+	"github.com/adminium/permify/pkg/bundle"
+	"github.com/adminium/permify/pkg/database"
+	db "github.com/adminium/permify/pkg/database/memory"
+	"github.com/adminium/permify/pkg/logger"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+// TestArchive -
+func TestArchive(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "archive-suite")
+}
+
+// store - Object storage in memory, the objects are modified at the time of the clock
+type store struct {
+	mu      sync.Mutex
+	now     time.Time
+	objects map[string][]byte
+	times   map[string]time.Time
+}
+
+func newStore() *store {
+	return &store{objects: map[string][]byte{}, times: map[string]time.Time{}}
+}
+
+func (s *store) Put(_ context.Context, key string, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.objects[key] = data
+	s.times[key] = s.now
+	return nil
+}
+
+func (s *store) Get(_ context.Context, key string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, ok := s.objects[key]
+	if !ok {
+		return nil, archive.ErrNotFound
+	}
+	return data, nil
+}
+
+func (s *store) List(_ context.Context, prefix string) ([]archive.Object, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var objects []archive.Object
+	for key := range s.objects {
+		if strings.HasPrefix(key, prefix) {
+			objects = append(objects, archive.Object{Key: key, LastModified: s.times[key]})
+		}
+	}
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Key < objects[j].Key })
+	return objects, nil
+}
+
+func (s *store) Delete(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.objects, key)
+	delete(s.times, key)
+	return nil
+}
+
+var _ = Describe("archive", func() {
+	var objects *store
+	var archiver *Archiver
+	var write func(tuples ...string)
+	
+	BeforeEach(func() {
+		mem, err := db.New(migrations.Schema)
+		Expect(err).ShouldNot(HaveOccurred())
+		l := logger.New("error")
+		
+		_, err = memory.NewTenantWriter(mem, l).CreateTenant(context.Background(), "t1", "t1")
+		Expect(err).ShouldNot(HaveOccurred())
+		
+		err = memory.NewSchemaWriter(mem, l).WriteSchema(context.Background(), []repositories.SchemaDefinition{
+			{TenantID: "t1", EntityType: "user", SerializedDefinition: []byte("entity user {}"), Version: "v1"},
+			{TenantID: "t1", EntityType: "doc", SerializedDefinition: []byte("entity doc {\n\trelation owner @user\n}"), Version: "v1"},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		
+		writer := memory.NewRelationshipWriter(mem, l)
+		write = func(tuples ...string) {
+			collection := database.NewTupleCollection()
+			for _, t := range tuples {
+				tup, err := tuple.Tuple(t)
+				Expect(err).ShouldNot(HaveOccurred())
+				collection.Add(tup)
+			}
+			_, err := writer.WriteRelationships(context.Background(), "t1", collection)
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+		
+		objects = newStore()
+		archiver = NewArchiver(objects, memory.NewTenantReader(mem, l), memory.NewRelationshipReader(mem, l), memory.NewSchemaReader(mem, l), time.Minute, time.Hour, 2*time.Hour, l)
+	})
+	
+	Context("Archive", func() {
+		It("Case 1: Changes since the last archive are written as segments", func() {
+			write("doc:1#owner@user:1")
+			
+			now := time.Now()
+			objects.now = now
+			Expect(archiver.Archive(context.Background(), now)).Should(Succeed())
+			
+			// the first archive only records where the change log starts
+			segments, err := objects.List(context.Background(), "changes/t1/")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(segments).Should(BeEmpty())
+			
+			write("doc:2#owner@user:2")
+			time.Sleep(time.Millisecond)
+			
+			objects.now = now.Add(time.Minute)
+			Expect(archiver.Archive(context.Background(), now.Add(time.Minute))).Should(Succeed())
+			
+			segments, err = objects.List(context.Background(), "changes/t1/")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(segments).Should(HaveLen(1))
+			
+			data, err := objects.Get(context.Background(), segments[0].Key)
+			Expect(err).ShouldNot(HaveOccurred())
+			data, err = Decompress(data)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			segment := Segment{}
+			Expect(json.Unmarshal(data, &segment)).Should(Succeed())
+			Expect(segment.TenantID).Should(Equal("t1"))
+			Expect(segment.Created).Should(Equal([]string{"doc:2#owner@user:2"}))
+			
+			// nothing changed since
+			Expect(archiver.Archive(context.Background(), now.Add(2*time.Minute))).Should(Succeed())
+			segments, err = objects.List(context.Background(), "changes/t1/")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(segments).Should(HaveLen(1))
+		})
+		
+		It("Case 2: Tenants are exported as bundles every export interval", func() {
+			write("doc:1#owner@user:1", "doc:2#owner@user:2")
+			
+			now := time.Now()
+			objects.now = now
+			Expect(archiver.Archive(context.Background(), now)).Should(Succeed())
+			Expect(archiver.Archive(context.Background(), now.Add(time.Minute))).Should(Succeed())
+			
+			exports, err := objects.List(context.Background(), "exports/t1/")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(exports).Should(HaveLen(1))
+			
+			data, err := objects.Get(context.Background(), exports[0].Key)
+			Expect(err).ShouldNot(HaveOccurred())
+			data, err = Decompress(data)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			b := &bundle.Bundle{}
+			Expect(yaml.Unmarshal(data, b)).Should(Succeed())
+			Expect(b.SchemaVersion).Should(Equal("v1"))
+			Expect(b.Tuples).Should(ConsistOf("doc:1#owner@user:1", "doc:2#owner@user:2"))
+			Expect(b.Validate()).Should(Succeed())
+			
+			Expect(archiver.Archive(context.Background(), now.Add(time.Hour))).Should(Succeed())
+			exports, err = objects.List(context.Background(), "exports/t1/")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(exports).Should(HaveLen(2))
+		})
+		
+		It("Case 3: Objects older than the retention are deleted", func() {
+			now := time.Now()
+			objects.now = now
+			Expect(archiver.Archive(context.Background(), now)).Should(Succeed())
+			
+			objects.now = now.Add(3 * time.Hour)
+			Expect(archiver.Archive(context.Background(), now.Add(3*time.Hour))).Should(Succeed())
+			
+			exports, err := objects.List(context.Background(), "exports/t1/")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(exports).Should(HaveLen(1))
+			Expect(exports[0].LastModified).Should(Equal(now.Add(3 * time.Hour)))
+			
+			// the cursors are never expired
+			_, err = objects.Get(context.Background(), "cursors/t1")
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,21 @@
+package authn
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	
+	"github.com/adminium/permify/internal/repositories"
+)
+
+// HashKey - Hex encoded sha256 of the secret of an api key, the form the keys are stored and looked up in
+func HashKey(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// WithAPIKey - Context of a request that was authenticated with the stored api key, the key is restricted to its
+// tenants and scope
+func WithAPIKey(ctx context.Context, key repositories.APIKey) context.Context {
+	return WithPrincipal(ctx, Principal{Kind: "api key", ID: key.ID, Tenants: key.Tenants, Scope: key.Scope})
+}
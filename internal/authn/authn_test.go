@@ -0,0 +1,13 @@
+package authn
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestAuthn(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "authn-suite")
+}
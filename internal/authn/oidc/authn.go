@@ -2,60 +2,147 @@ package oidc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 	
 	grpcAuth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	"github.com/zitadel/oidc/pkg/client"
 	"github.com/zitadel/oidc/pkg/client/rp"
 	"github.com/zitadel/oidc/pkg/oidc"
+	"golang.org/x/exp/slices"
 	
 	"github.com/adminium/permify/internal/authn"
 	"github.com/adminium/permify/internal/config"
+	"github.com/adminium/permify/pkg/storage"
 )
 
 // OidcAuthenticator - Interface for oidc authenticator
 type OidcAuthenticator interface {
-	Authenticate(ctx context.Context) error
+	// Authenticate returns the context of the request, which carries the tenants and the scope of the token when
+	// its claims restrict it.
+	Authenticate(ctx context.Context) (context.Context, error)
 }
 
 // OidcAuthn - Oidc verifier structure
 type OidcAuthn struct {
 	verifier rp.IDTokenVerifier
+	// claims and scopes that restrict the tokens
+	tenantsClaim string
+	scopeClaim   string
+	scopes       config.OidcScopes
 }
 
-// NewOidcAuthn - Create new Oidc verifier
+// NewOidcAuthn - Create new Oidc verifier, the keys are discovered from the issuer unless the jwks endpoint is
+// configured, in which case the tokens are expected to be signed with RS256
 func NewOidcAuthn(ctx context.Context, cfg config.Oidc) (*OidcAuthn, error) {
-	dis, err := client.Discover(cfg.Issuer, http.DefaultClient)
-	if err != nil {
-		return nil, err
+	var verifier rp.IDTokenVerifier
+	if cfg.JwksURI != "" {
+		verifier = rp.NewIDTokenVerifier(cfg.Issuer, cfg.ClientId, rp.NewRemoteKeySet(http.DefaultClient, cfg.JwksURI))
+	} else {
+		dis, err := client.Discover(cfg.Issuer, http.DefaultClient)
+		if err != nil {
+			return nil, err
+		}
+		remoteKeySet := rp.NewRemoteKeySet(http.DefaultClient, dis.JwksURI)
+		verifier = rp.NewIDTokenVerifier(dis.Issuer, cfg.ClientId, remoteKeySet,
+			rp.WithSupportedSigningAlgorithms(dis.IDTokenSigningAlgValuesSupported...))
 	}
-	remoteKeySet := rp.NewRemoteKeySet(http.DefaultClient, dis.JwksURI)
-	verifier := rp.NewIDTokenVerifier(dis.Issuer, cfg.ClientId, remoteKeySet,
-		rp.WithSupportedSigningAlgorithms(dis.IDTokenSigningAlgValuesSupported...))
 	
-	return &OidcAuthn{verifier: verifier}, nil
+	return &OidcAuthn{
+		verifier:     verifier,
+		tenantsClaim: cfg.TenantsClaim,
+		scopeClaim:   cfg.ScopeClaim,
+		scopes:       cfg.Scopes,
+	}, nil
 }
 
-// Authenticate - Checking whether JWT token is signed by the provider and is valid
-func (t *OidcAuthn) Authenticate(ctx context.Context) error {
+// Authenticate - Checking whether JWT token is signed by the provider and is valid, the tokens restricted by their
+// claims are added to the context so that their tenants and scope can be enforced
+func (t *OidcAuthn) Authenticate(ctx context.Context) (context.Context, error) {
 	rawToken, err := grpcAuth.AuthFromMD(ctx, "Bearer")
 	if err != nil {
-		return authn.MissingBearerTokenError
+		return nil, authn.MissingBearerTokenError
 	}
 	
 	claims, err := rp.VerifyIDToken(ctx, rawToken, t.verifier)
 	if err != nil {
-		return authn.Unauthenticated
+		return nil, authn.Unauthenticated
 	}
 	
 	if err := t.validateOtherClaims(claims); err != nil {
-		return authn.Unauthenticated
+		return nil, authn.Unauthenticated
 	}
-	return nil
+	
+	principal, restricted, err := t.principal(claims)
+	if err != nil {
+		return nil, authn.Unauthenticated
+	}
+	if !restricted {
+		return authn.WithCaller(ctx, "token:"+claims.GetSubject()), nil
+	}
+	return authn.WithPrincipal(ctx, principal), nil
 }
 
+// principal - Maps the claims of the token to the tenants and the scope it is restricted to. A token lacking a
+// configured claim, or one without any of the scopes, is rejected. restricted is false when no claim is configured
+// or the token has the admin scope.
+func (t *OidcAuthn) principal(claims oidc.IDTokenClaims) (principal authn.Principal, restricted bool, err error) {
+	if t.tenantsClaim == "" && t.scopeClaim == "" {
+		return principal, false, nil
+	}
+	principal = authn.Principal{Kind: "token", ID: claims.GetSubject(), Scope: storage.APIKeyScopeReadWrite}
+	
+	if t.scopeClaim != "" {
+		scopes, ok := claimValues(claims.GetClaim(t.scopeClaim))
+		if !ok {
+			return principal, false, fmt.Errorf("token has no %s claim", t.scopeClaim)
+		}
+		switch {
+		case t.scopes.Admin != "" && slices.Contains(scopes, t.scopes.Admin):
+			return principal, false, nil
+		case slices.Contains(scopes, t.scopes.Write):
+			principal.Scope = storage.APIKeyScopeReadWrite
+		case slices.Contains(scopes, t.scopes.Read):
+			principal.Scope = storage.APIKeyScopeRead
+		default:
+			return principal, false, errors.New("token has none of the scopes")
+		}
+	}
+	
+	if t.tenantsClaim != "" {
+		tenants, ok := claimValues(claims.GetClaim(t.tenantsClaim))
+		if !ok || len(tenants) == 0 {
+			return principal, false, fmt.Errorf("token has no %s claim", t.tenantsClaim)
+		}
+		principal.Tenants = tenants
+	}
+	return principal, true, nil
+}
+
+// claimValues - Values of a claim that is a space separated string or a list of strings
+func claimValues(claim interface{}) ([]string, bool) {
+	switch value := claim.(type) {
+	case string:
+		return strings.Fields(value), true
+	case []interface{}:
+		values := make([]string, 0, len(value))
+		for _, v := range value {
+			s, ok := v.(string)
+			if !ok {
+				return nil, false
+			}
+			values = append(values, s)
+		}
+		return values, true
+	default:
+		return nil, false
+	}
+}
+
+
 // validateOtherClaims - Validate claims that are not validated by the oidc client library
 func (t *OidcAuthn) validateOtherClaims(claims oidc.IDTokenClaims) error {
 	if err := checkNotBefore(claims, t.verifier.Offset()); err != nil {
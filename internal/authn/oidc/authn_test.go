@@ -10,6 +10,7 @@ import (
 	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
 	. "github.com/onsi/gomega"
 	
+	"github.com/adminium/permify/internal/authn"
 	"github.com/adminium/permify/internal/config"
 )
 
@@ -83,7 +84,7 @@ func Test_AuthenticateWithSigningMethods(t *testing.T) {
 			// authenticate
 			niceMd := make(metautils.NiceMD)
 			niceMd.Set("authorization", "Bearer "+idToken)
-			err = auth.Authenticate(niceMd.ToIncoming(ctx))
+			_, err = auth.Authenticate(niceMd.ToIncoming(ctx))
 			Expect(err != nil).To(Equal(tt.wantErr), fmt.Sprintf("Wanted error: %t, got %v", tt.wantErr, err))
 		})
 	}
@@ -179,7 +180,7 @@ func Test_AuthenticateClaims(t *testing.T) {
 			// authenticate token
 			niceMd := make(metautils.NiceMD)
 			niceMd.Set("authorization", "Bearer "+idToken)
-			err = auth.Authenticate(niceMd.ToIncoming(ctx))
+			_, err = auth.Authenticate(niceMd.ToIncoming(ctx))
 			Expect(err != nil).To(Equal(tt.wantErr), fmt.Sprintf("Wanted error: %t, got %v", tt.wantErr, err))
 		})
 	}
@@ -268,12 +269,114 @@ func Test_AuthenticateKeyIds(t *testing.T) {
 			// authenticate
 			niceMd := make(metautils.NiceMD)
 			niceMd.Set("authorization", "Bearer "+idToken)
-			err = auth.Authenticate(niceMd.ToIncoming(ctx))
+			_, err = auth.Authenticate(niceMd.ToIncoming(ctx))
 			Expect(err != nil).To(Equal(tt.wantErr), fmt.Sprintf("Wanted error: %t, got %v", tt.wantErr, err))
 		})
 	}
 }
 
+func Test_AuthenticateClaimMapping(t *testing.T) {
+	RegisterFailHandler(fail(t))
+	
+	clientId := "test-client"
+	listenAddress := "localhost:9999"
+	issuerURL := "http://" + listenAddress
+	
+	// Start oidc provider server
+	fakeOidcProvider, err := newfakeOidcProvider(issuerURL)
+	Expect(err).To(BeNil())
+	server, err := fakeHttpServer(listenAddress, fakeOidcProvider.ServeHTTP)
+	Expect(err).To(BeNil())
+	defer server.Close()
+	
+	tests := []struct {
+		name      string
+		claims    jwt.MapClaims
+		wantErr   bool
+		principal *authn.Principal
+	}{
+		{
+			"Read scope and listed tenants restrict the token",
+			jwt.MapClaims{"scope": "openid permify:read", "tenants": []interface{}{"t1", "t2"}},
+			false,
+			&authn.Principal{Kind: "token", ID: "user", Tenants: []string{"t1", "t2"}, Scope: "read"},
+		},
+		{
+			"Write scope and a single tenant restrict the token",
+			jwt.MapClaims{"scope": []interface{}{"permify:write"}, "tenants": "t1"},
+			false,
+			&authn.Principal{Kind: "token", ID: "user", Tenants: []string{"t1"}, Scope: "read_write"},
+		},
+		{
+			"Admin scope does not restrict the token",
+			jwt.MapClaims{"scope": "permify:admin"},
+			false,
+			nil,
+		},
+		{
+			"Token without any of the scopes, it should fail",
+			jwt.MapClaims{"scope": "openid", "tenants": "t1"},
+			true,
+			nil,
+		},
+		{
+			"Token without the tenants claim, it should fail",
+			jwt.MapClaims{"scope": "permify:read"},
+			true,
+			nil,
+		},
+	}
+	
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Now()
+			claims := jwt.MapClaims{
+				"iss": issuerURL,
+				"sub": "user",
+				"aud": []string{clientId},
+				"exp": now.AddDate(1, 0, 0).Unix(),
+				"iat": now.Unix(),
+			}
+			for key, value := range tt.claims {
+				claims[key] = value
+			}
+			
+			// create signed token from oidc provider
+			unsignedToken := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+			unsignedToken.Header["kid"] = fakeOidcProvider.keyIds[jwt.SigningMethodRS256]
+			idToken, err := fakeOidcProvider.SignIDToken(unsignedToken)
+			Expect(err).To(BeNil())
+			
+			// create oidc authenticator with the keys of the jwks endpoint, without discovery
+			ctx := context.Background()
+			auth, err := NewOidcAuthn(ctx, config.Oidc{
+				ClientId:     clientId,
+				Issuer:       issuerURL,
+				JwksURI:      issuerURL + fakeOidcProvider.JWKSPath,
+				TenantsClaim: "tenants",
+				ScopeClaim:   "scope",
+				Scopes:       config.OidcScopes{Read: "permify:read", Write: "permify:write", Admin: "permify:admin"},
+			})
+			Expect(err).To(BeNil())
+			
+			// authenticate
+			niceMd := make(metautils.NiceMD)
+			niceMd.Set("authorization", "Bearer "+idToken)
+			authenticated, err := auth.Authenticate(niceMd.ToIncoming(ctx))
+			Expect(err != nil).To(Equal(tt.wantErr), fmt.Sprintf("Wanted error: %t, got %v", tt.wantErr, err))
+			if err != nil {
+				return
+			}
+			Expect(authn.CallerFromContext(authenticated)).To(Equal("token:user"))
+			principal, restricted := authn.PrincipalFromContext(authenticated)
+			Expect(restricted).To(Equal(tt.principal != nil))
+			if tt.principal != nil {
+				Expect(principal).To(Equal(*tt.principal))
+			}
+		})
+	}
+}
+
 func claimOverride(current, overrider *jwt.RegisteredClaims) {
 	if overrider.Audience != nil {
 		current.Audience = overrider.Audience
@@ -0,0 +1,46 @@
+package authn
+
+import (
+	"context"
+	"crypto/subtle"
+	
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+)
+
+// PeerKeyHeader - Header the nodes of a raft cluster and the peers of a dispatch ring send their configured key in
+const PeerKeyHeader = "permify-peer-key"
+
+// peerCredentials -
+type peerCredentials string
+
+// PeerCredentials - Credentials of the calls of a node to the peer apis of the others, they are sent alongside the
+// credentials of the client the call is made for
+func PeerCredentials(key string) credentials.PerRPCCredentials {
+	return peerCredentials(key)
+}
+
+// GetRequestMetadata -
+func (c peerCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{PeerKeyHeader: string(c)}, nil
+}
+
+// RequireTransportSecurity - The peers reach each other over the internal network, often without tls
+func (c peerCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// IsPeer - Whether the request was made by a peer that knows the key. The peers copy the headers of the client they
+// forward a request for, so any of the values may be the key of the peer.
+func IsPeer(ctx context.Context, key string) bool {
+	if key == "" {
+		return false
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get(PeerKeyHeader) {
+		if subtle.ConstantTimeCompare([]byte(value), []byte(key)) == 1 {
+			return true
+		}
+	}
+	return false
+}
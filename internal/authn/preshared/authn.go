@@ -5,23 +5,44 @@ import (
 	
 	grpcAuth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	
 	"github.com/adminium/permify/internal/authn"
 	"github.com/adminium/permify/internal/config"
+	"github.com/adminium/permify/internal/repositories"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
 )
 
+// _callerHashLength - Length of the prefix of the hash of a configured key that names its caller
+const _callerHashLength = 8
+
 // KeyAuthenticator - Interface for key authenticator
 type KeyAuthenticator interface {
-	Authenticate(ctx context.Context) error
+	// Authenticate returns the context of the request, which carries the stored api key it was authenticated with.
+	Authenticate(ctx context.Context) (context.Context, error)
 }
 
 // KeyAuthn - Authentication Keys Structure
 type KeyAuthn struct {
 	keys map[string]struct{}
+	// stored - reader of the api keys managed through the admin service, nil when only the configured keys are
+	// accepted
+	stored repositories.APIKeyReader
+}
+
+// KeyAuthnOption - Option of the key authenticator
+type KeyAuthnOption func(*KeyAuthn)
+
+// StoredKeys - Accepts the api keys of the repository besides the configured ones
+func StoredKeys(reader repositories.APIKeyReader) KeyAuthnOption {
+	return func(a *KeyAuthn) {
+		a.stored = reader
+	}
 }
 
 // NewKeyAuthn - Create New Authenticated Keys
-func NewKeyAuthn(ctx context.Context, cfg config.Preshared) (*KeyAuthn, error) {
+func NewKeyAuthn(ctx context.Context, cfg config.Preshared, opts ...KeyAuthnOption) (*KeyAuthn, error) {
 	if len(cfg.Keys) < 1 {
 		return nil, errors.New("pre shared key authn must have at least one key")
 	}
@@ -29,19 +50,35 @@ func NewKeyAuthn(ctx context.Context, cfg config.Preshared) (*KeyAuthn, error) {
 	for _, k := range cfg.Keys {
 		mapKeys[k] = struct{}{}
 	}
-	return &KeyAuthn{
+	a := &KeyAuthn{
 		keys: mapKeys,
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a, nil
 }
 
-// Authenticate - Checking whether any API request contain keys
-func (a *KeyAuthn) Authenticate(ctx context.Context) error {
+// Authenticate - Checking whether any API request contain keys. The configured keys are not restricted, the stored
+// ones are added to the context so that their tenants and scope can be enforced.
+func (a *KeyAuthn) Authenticate(ctx context.Context) (context.Context, error) {
 	key, err := grpcAuth.AuthFromMD(ctx, "Bearer")
 	if err != nil {
-		return authn.MissingBearerTokenError
+		return nil, authn.MissingBearerTokenError
 	}
 	if _, found := a.keys[key]; found {
-		return nil
+		// the configured keys have no names, they are told apart by a prefix of their hash
+		return authn.WithCaller(ctx, "preshared key:"+authn.HashKey(key)[:_callerHashLength]), nil
+	}
+	if a.stored == nil {
+		return nil, authn.Unauthenticated
+	}
+	stored, err := a.stored.ReadAPIKey(ctx, authn.HashKey(key))
+	if err != nil {
+		if err.Error() == base.ErrorCode_ERROR_CODE_RECORD_NOT_FOUND.String() {
+			return nil, authn.Unauthenticated
+		}
+		return nil, status.Error(codes.Unavailable, err.Error())
 	}
-	return authn.Unauthenticated
+	return authn.WithAPIKey(ctx, stored), nil
 }
@@ -0,0 +1,89 @@
+package authn
+
+import (
+	"context"
+)
+
+// principalContextKey -
+type principalContextKey struct{}
+
+// callerContextKey -
+type callerContextKey struct{}
+
+// Principal - Restricted caller a request was authenticated as, e.g. a stored api key or a token whose claims name
+// its tenants and scope
+type Principal struct {
+	// Kind - what the caller authenticated with, e.g. api key or token
+	Kind string
+	ID   string
+	// Tenants - tenants the caller may call, empty for all of them
+	Tenants []string
+	// Scope - read or read_write
+	Scope string
+}
+
+// Caller - Kind and id of the principal, e.g. api key:ci
+func (p Principal) Caller() string {
+	return p.Kind + ":" + p.ID
+}
+
+// WithPrincipal - Context of a request that was authenticated as the restricted caller
+func WithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(WithCaller(ctx, principal.Caller()), principalContextKey{}, principal)
+}
+
+// PrincipalFromContext - Restricted caller the request was authenticated as, false for the requests of the callers
+// that are not restricted, e.g. the ones authenticated with a configured key
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalContextKey{}).(Principal)
+	return principal, ok
+}
+
+// WithCaller - Context of a request that was authenticated as the caller, restricted or not, e.g. token:alice. The
+// tuples the request writes are attributed to it.
+func WithCaller(ctx context.Context, caller string) context.Context {
+	return context.WithValue(ctx, callerContextKey{}, caller)
+}
+
+// CallerFromContext - Caller the request was authenticated as, empty when the request was not authenticated
+func CallerFromContext(ctx context.Context) string {
+	caller, _ := ctx.Value(callerContextKey{}).(string)
+	return caller
+}
+
+// Authenticator - Authenticates the requests, the returned context carries the principal of the restricted callers
+type Authenticator interface {
+	Authenticate(ctx context.Context) (context.Context, error)
+}
+
+// anyAuthenticator -
+type anyAuthenticator []Authenticator
+
+// Any - Authenticates the requests with the first of the authenticators that accepts them, so e.g. preshared keys
+// and tokens of an oidc provider can be used side by side
+func Any(authenticators ...Authenticator) Authenticator {
+	if len(authenticators) == 1 {
+		return authenticators[0]
+	}
+	return anyAuthenticator(authenticators)
+}
+
+// Authenticate - A failure other than a rejected credential, e.g. an unavailable key store, is returned over the
+// rejections, so the caller retries instead of giving up
+func (a anyAuthenticator) Authenticate(ctx context.Context) (context.Context, error) {
+	err := Unauthenticated
+	for _, authenticator := range a {
+		authenticated, e := authenticator.Authenticate(ctx)
+		if e == nil {
+			return authenticated, nil
+		}
+		if e == MissingBearerTokenError {
+			return nil, e
+		}
+		if err == Unauthenticated {
+			err = e
+		}
+	}
+	return nil, err
+}
+
@@ -0,0 +1,75 @@
+package authn
+
+import (
+	"context"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// authenticatorFunc -
+type authenticatorFunc func(ctx context.Context) (context.Context, error)
+
+// Authenticate -
+func (f authenticatorFunc) Authenticate(ctx context.Context) (context.Context, error) {
+	return f(ctx)
+}
+
+var _ = Describe("principal", func() {
+	reject := authenticatorFunc(func(ctx context.Context) (context.Context, error) { return nil, Unauthenticated })
+	unavailable := authenticatorFunc(func(ctx context.Context) (context.Context, error) {
+		return nil, status.Error(codes.Unavailable, "unavailable")
+	})
+	restrict := authenticatorFunc(func(ctx context.Context) (context.Context, error) {
+		return WithPrincipal(ctx, Principal{Kind: "token", ID: "user", Scope: "read"}), nil
+	})
+	missing := authenticatorFunc(func(ctx context.Context) (context.Context, error) { return nil, MissingBearerTokenError })
+	
+	Context("Any", func() {
+		It("Case 1: The first authenticator that accepts a request authenticates it", func() {
+			ctx, err := Any(reject, restrict).Authenticate(context.Background())
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			principal, ok := PrincipalFromContext(ctx)
+			Expect(ok).Should(BeTrue())
+			Expect(principal.ID).Should(Equal("user"))
+		})
+		
+		It("Case 2: A failure other than a rejection is returned over the rejections", func() {
+			_, err := Any(unavailable, reject).Authenticate(context.Background())
+			Expect(status.Code(err)).Should(Equal(codes.Unavailable))
+		})
+		
+		It("Case 3: A request every authenticator rejects is unauthenticated", func() {
+			_, err := Any(reject, reject).Authenticate(context.Background())
+			Expect(err).Should(Equal(Unauthenticated))
+		})
+		
+		It("Case 4: A request without a token fails with the missing token error", func() {
+			_, err := Any(missing, restrict).Authenticate(context.Background())
+			Expect(err).Should(Equal(MissingBearerTokenError))
+		})
+	})
+	
+	Context("IsPeer", func() {
+		It("Case 1: A request is made by a peer when any of the values of its peer key header is the key", func() {
+			md, err := PeerCredentials("secret").GetRequestMetadata(context.Background())
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			peer := metadata.NewIncomingContext(context.Background(), metadata.Pairs(PeerKeyHeader, "forged", PeerKeyHeader, md[PeerKeyHeader]))
+			Expect(IsPeer(peer, "secret")).Should(BeTrue())
+		})
+		
+		It("Case 2: A request with a forged key is rejected", func() {
+			client := metadata.NewIncomingContext(context.Background(), metadata.Pairs(PeerKeyHeader, "forged"))
+			Expect(IsPeer(client, "secret")).Should(BeFalse())
+		})
+		
+		It("Case 3: No request is a peer without a configured key", func() {
+			Expect(IsPeer(context.Background(), "")).Should(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,240 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// TestCluster -
+func TestCluster(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cluster-suite")
+}
+
+// failing - Discoverer that always fails
+type failing struct{}
+
+func (failing) Discover(_ context.Context) ([]string, error) {
+	return nil, errors.New("unavailable")
+}
+
+// peer - Serves the dispatch api and allows every check it receives, the owner hint is sent when it is not empty
+func peer(hint string) (string, func()) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).ShouldNot(HaveOccurred())
+	
+	server := grpc.NewServer()
+	server.RegisterService(&grpc.ServiceDesc{
+		ServiceName: "permify.dispatch.v1.Dispatch",
+		HandlerType: (*interface{})(nil),
+		Methods: []grpc.MethodDesc{
+			{
+				MethodName: "Check",
+				Handler: func(_ interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+					in := new(base.PermissionCheckRequest)
+					if err := dec(in); err != nil {
+						return nil, err
+					}
+					if hint != "" {
+						_ = grpc.SetHeader(ctx, metadata.Pairs(OwnerHeader, hint))
+					}
+					return &base.PermissionCheckResponse{Can: base.PermissionCheckResponse_RESULT_ALLOWED}, nil
+				},
+			},
+		},
+	}, struct{}{})
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	return listener.Addr().String(), server.Stop
+}
+
+var _ = Describe("cluster", func() {
+	Context("Ring", func() {
+		It("Case 1: Only the keys of a member that joins move", func() {
+			ring := NewRing(0)
+			added, removed := ring.Set([]string{"10.0.0.1:3478", "10.0.0.2:3478", "10.0.0.3:3478"})
+			Expect(added).Should(Equal([]string{"10.0.0.1:3478", "10.0.0.2:3478", "10.0.0.3:3478"}))
+			Expect(removed).Should(BeEmpty())
+			
+			before := map[string]string{}
+			for i := 0; i < 1000; i++ {
+				key := fmt.Sprintf("doc:%d", i)
+				before[key], _ = ring.Get(key)
+			}
+			
+			added, _ = ring.Set([]string{"10.0.0.1:3478", "10.0.0.2:3478", "10.0.0.3:3478", "10.0.0.4:3478"})
+			Expect(added).Should(Equal([]string{"10.0.0.4:3478"}))
+			
+			moved := 0
+			for key, owner := range before {
+				current, ok := ring.Get(key)
+				Expect(ok).Should(BeTrue())
+				if current != owner {
+					Expect(current).Should(Equal("10.0.0.4:3478"))
+					moved++
+				}
+			}
+			Expect(moved).Should(BeNumerically(">", 0))
+			Expect(moved).Should(BeNumerically("<", 500))
+		})
+		
+		It("Case 2: Empty ring", func() {
+			_, ok := NewRing(0).Get("doc:1")
+			Expect(ok).Should(BeFalse())
+			Expect(NewRing(0).Shares()).Should(BeEmpty())
+		})
+		
+		It("Case 3: Shares of the members add up to one", func() {
+			ring := NewRing(0)
+			ring.Set([]string{"10.0.0.1:3478", "10.0.0.2:3478"})
+			
+			shares := ring.Shares()
+			Expect(shares).Should(HaveLen(2))
+			Expect(shares["10.0.0.1:3478"] + shares["10.0.0.2:3478"]).Should(BeNumerically("~", 1, 1e-9))
+			Expect(shares["10.0.0.1:3478"]).Should(BeNumerically(">", 0.3))
+			Expect(shares["10.0.0.2:3478"]).Should(BeNumerically(">", 0.3))
+		})
+	})
+	
+	Context("Kubernetes", func() {
+		It("Case 1: Ready addresses of the endpoints with the grpc port", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Path).Should(Equal("/api/v1/namespaces/permify/endpoints/permify-headless"))
+				Expect(r.Header.Get("Authorization")).Should(Equal("Bearer token"))
+				_, _ = w.Write([]byte(`{"subsets":[{"addresses":[{"ip":"10.0.0.2"},{"ip":"10.0.0.1"}],"notReadyAddresses":[{"ip":"10.0.0.3"}],"ports":[{"name":"http","port":3476},{"name":"grpc","port":3478}]}]}`))
+			}))
+			defer server.Close()
+			
+			peers, err := (&Kubernetes{URL: server.URL, Token: "token", Namespace: "permify", Service: "permify-headless", PortName: "grpc"}).Discover(context.Background())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(peers).Should(Equal([]string{"10.0.0.1:3478", "10.0.0.2:3478"}))
+		})
+		
+		It("Case 2: Falls back when the endpoints cannot be read", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusForbidden)
+			}))
+			defer server.Close()
+			
+			peers, err := Fallback{
+				&Kubernetes{URL: server.URL, Namespace: "permify", Service: "permify-headless"},
+				Static{"10.0.0.1:3478"},
+			}.Discover(context.Background())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(peers).Should(Equal([]string{"10.0.0.1:3478"}))
+		})
+	})
+	
+	Context("Membership", func() {
+		It("Case 1: Peers leave the ring after the removal delay", func() {
+			ring := NewRing(0)
+			discovered := Static{"10.0.0.1:3478", "10.0.0.2:3478"}
+			membership := NewMembership(ring, &discovered, "10.0.0.1:3478", time.Second, time.Minute, logger.New("error"))
+			
+			now := time.Now()
+			Expect(membership.Refresh(context.Background(), now)).Should(Succeed())
+			Expect(ring.Members()).Should(Equal([]string{"10.0.0.1:3478", "10.0.0.2:3478"}))
+			
+			discovered = Static{}
+			Expect(membership.Refresh(context.Background(), now.Add(time.Second))).Should(Succeed())
+			Expect(ring.Members()).Should(Equal([]string{"10.0.0.1:3478", "10.0.0.2:3478"}))
+			
+			Expect(membership.Refresh(context.Background(), now.Add(2*time.Minute))).Should(Succeed())
+			Expect(ring.Members()).Should(Equal([]string{"10.0.0.1:3478"}))
+		})
+		
+		It("Case 2: Failed discoveries keep the ring", func() {
+			ring := NewRing(0)
+			ring.Set([]string{"10.0.0.1:3478", "10.0.0.2:3478"})
+			
+			membership := NewMembership(ring, failing{}, "", time.Second, 0, logger.New("error"))
+			Expect(membership.Refresh(context.Background(), time.Now())).Should(HaveOccurred())
+			Expect(ring.Members()).Should(Equal([]string{"10.0.0.1:3478", "10.0.0.2:3478"}))
+		})
+	})
+	
+	Context("Dispatcher", func() {
+		It("Case 1: Subproblems are checked by the owner of their entity", func() {
+			address, stop := peer("")
+			defer stop()
+			
+			request := &base.PermissionCheckRequest{TenantId: "t1", Entity: &base.Entity{Type: "doc", Id: "1"}, Permission: "view"}
+			
+			ring := NewRing(0)
+			ring.Set([]string{address})
+			dispatcher := NewDispatcher(ring, "127.0.0.1:1", time.Second, logger.New("error"))
+			defer dispatcher.Close()
+			
+			Expect(dispatcher.Owner(request)).Should(Equal(address))
+			response, ok := dispatcher.Dispatch(context.Background(), request)
+			Expect(ok).Should(BeTrue())
+			Expect(response.GetCan()).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+			
+			// the subproblems this instance owns are checked locally
+			local := NewDispatcher(ring, address, time.Second, logger.New("error"))
+			defer local.Close()
+			_, ok = local.Dispatch(context.Background(), request)
+			Expect(ok).Should(BeFalse())
+		})
+		
+		It("Case 2: Subproblems of unreachable peers are checked locally", func() {
+			address, stop := peer("")
+			stop()
+			
+			ring := NewRing(0)
+			ring.Set([]string{address})
+			dispatcher := NewDispatcher(ring, "127.0.0.1:1", 100*time.Millisecond, logger.New("error"))
+			defer dispatcher.Close()
+			
+			_, ok := dispatcher.Dispatch(context.Background(), &base.PermissionCheckRequest{TenantId: "t1", Entity: &base.Entity{Type: "doc", Id: "1"}})
+			Expect(ok).Should(BeFalse())
+		})
+		
+		It("Case 3: Stats count the subproblems by their peer", func() {
+			address, stop := peer("127.0.0.1:2")
+			defer stop()
+			
+			request := &base.PermissionCheckRequest{TenantId: "t1", Entity: &base.Entity{Type: "doc", Id: "1"}, Permission: "view"}
+			
+			ring := NewRing(0)
+			ring.Set([]string{address})
+			dispatcher := NewDispatcher(ring, "127.0.0.1:1", time.Second, logger.New("error"))
+			defer dispatcher.Close()
+			
+			_, ok := dispatcher.Dispatch(context.Background(), request)
+			Expect(ok).Should(BeTrue())
+			
+			// the peer answered with another owner, its ring disagrees
+			stats := dispatcher.Stats()
+			Expect(stats.Self).Should(Equal("127.0.0.1:1"))
+			Expect(stats.Local).Should(Equal(uint64(0)))
+			Expect(stats.Peers).Should(Equal([]PeerStats{{Address: address, Share: 1, Dispatched: 1, Misrouted: 1}}))
+			
+			ring.Set([]string{"127.0.0.1:1"})
+			_, ok = dispatcher.Dispatch(context.Background(), request)
+			Expect(ok).Should(BeFalse())
+			
+			stats = dispatcher.Stats()
+			Expect(stats.Local).Should(Equal(uint64(1)))
+			Expect(stats.Peers).Should(Equal([]PeerStats{
+				{Address: "127.0.0.1:1", Share: 1},
+				{Address: address, Dispatched: 1, Misrouted: 1},
+			}))
+		})
+	})
+})
@@ -0,0 +1,213 @@
+package cluster
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	_serviceAccountPath = "/var/run/secrets/kubernetes.io/serviceaccount"
+)
+
+// Discoverer - Finds the addresses of the peers, host:port of their grpc servers
+type Discoverer interface {
+	Discover(ctx context.Context) ([]string, error)
+}
+
+// Static - Fixed list of peers
+type Static []string
+
+// Discover - Returns the configured peers
+func (s Static) Discover(_ context.Context) ([]string, error) {
+	return sortedPeers(s), nil
+}
+
+// DNS - Resolves a name to the addresses of the peers, e.g. the headless service of the pods
+type DNS struct {
+	Name     string
+	Port     string
+	Resolver *net.Resolver
+}
+
+// Discover - Returns an address for every record of the name
+func (d DNS) Discover(ctx context.Context) ([]string, error) {
+	resolver := d.Resolver
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+	
+	hosts, err := resolver.LookupHost(ctx, d.Name)
+	if err != nil {
+		return nil, err
+	}
+	
+	peers := make([]string, 0, len(hosts))
+	for _, host := range hosts {
+		peers = append(peers, net.JoinHostPort(host, d.Port))
+	}
+	return sortedPeers(peers), nil
+}
+
+// Kubernetes - Reads the peers from the endpoints of a service through the api of the cluster. Only the ready
+// addresses are peers, so pods join the ring once they pass their readiness probe and leave it as soon as they
+// start terminating.
+type Kubernetes struct {
+	// URL - address of the api server
+	URL       string
+	Token     string
+	Namespace string
+	Service   string
+	// PortName - name of the grpc port of the service, the first port is used when it is empty
+	PortName string
+	Client   *http.Client
+}
+
+// NewInClusterKubernetes - Creates the discoverer of a pod from its service account, the namespace of the pod is
+// used when namespace is empty
+func NewInClusterKubernetes(namespace, service, portName string) (*Kubernetes, error) {
+	host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+	if host == "" || port == "" {
+		return nil, errors.New("not running in a kubernetes cluster")
+	}
+	
+	token, err := os.ReadFile(_serviceAccountPath + "/token")
+	if err != nil {
+		return nil, err
+	}
+	
+	ca, err := os.ReadFile(_serviceAccountPath + "/ca.crt")
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, errors.New("invalid certificate authority of the service account")
+	}
+	
+	if namespace == "" {
+		var ns []byte
+		ns, err = os.ReadFile(_serviceAccountPath + "/namespace")
+		if err != nil {
+			return nil, err
+		}
+		namespace = strings.TrimSpace(string(ns))
+	}
+	
+	return &Kubernetes{
+		URL:       "https://" + net.JoinHostPort(host, port),
+		Token:     strings.TrimSpace(string(token)),
+		Namespace: namespace,
+		Service:   service,
+		PortName:  portName,
+		Client: &http.Client{
+			Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}},
+		},
+	}, nil
+}
+
+// endpoints - The parts of the endpoints object of a service that the discovery reads
+type endpoints struct {
+	Subsets []struct {
+		Addresses []struct {
+			IP string `json:"ip"`
+		} `json:"addresses"`
+		Ports []struct {
+			Name string `json:"name"`
+			Port int    `json:"port"`
+		} `json:"ports"`
+	} `json:"subsets"`
+}
+
+// Discover - Returns the ready addresses of the service with its grpc port
+func (k *Kubernetes) Discover(ctx context.Context) ([]string, error) {
+	url := fmt.Sprintf("%s/api/v1/namespaces/%s/endpoints/%s", strings.TrimSuffix(k.URL, "/"), k.Namespace, k.Service)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if k.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+k.Token)
+	}
+	
+	client := k.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("kubernetes endpoints of %s/%s: %s", k.Namespace, k.Service, resp.Status)
+	}
+	
+	var e endpoints
+	if err = json.NewDecoder(resp.Body).Decode(&e); err != nil {
+		return nil, err
+	}
+	
+	var peers []string
+	for _, subset := range e.Subsets {
+		port := 0
+		for _, p := range subset.Ports {
+			if k.PortName == "" || p.Name == k.PortName {
+				port = p.Port
+				break
+			}
+		}
+		if port == 0 {
+			continue
+		}
+		for _, address := range subset.Addresses {
+			peers = append(peers, net.JoinHostPort(address.IP, strconv.Itoa(port)))
+		}
+	}
+	return sortedPeers(peers), nil
+}
+
+// Fallback - Asks the discoverers in order and returns the peers of the first one that succeeds, e.g. dns when the
+// service account cannot read the endpoints
+type Fallback []Discoverer
+
+// Discover - Returns the peers of the first discoverer that succeeds, or the error of the last one
+func (f Fallback) Discover(ctx context.Context) (peers []string, err error) {
+	for _, discoverer := range f {
+		peers, err = discoverer.Discover(ctx)
+		if err == nil {
+			return peers, nil
+		}
+	}
+	if err == nil {
+		err = errors.New("no discoverer")
+	}
+	return nil, err
+}
+
+// sortedPeers - Distinct peers in ascending order
+func sortedPeers(peers []string) []string {
+	seen := make(map[string]struct{}, len(peers))
+	sorted := make([]string, 0, len(peers))
+	for _, peer := range peers {
+		if _, ok := seen[peer]; ok || peer == "" {
+			continue
+		}
+		seen[peer] = struct{}{}
+		sorted = append(sorted, peer)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
@@ -0,0 +1,202 @@
+package cluster
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+	
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// CheckMethod - Method of the dispatch api the peers check the subproblems they own through
+const CheckMethod = "/permify.dispatch.v1.Dispatch/Check"
+
+// OwnerHeader - Response header of the dispatched checks carrying the peer that owns their entity on the ring of the
+// peer that answered. It differs from the peer the check was sent to while the rings of the peers disagree.
+const OwnerHeader = "permify-owner"
+
+// _defaultDispatchTimeout - How long a peer has to answer a subproblem before it is checked locally
+const _defaultDispatchTimeout = time.Second
+
+// Dispatcher - Sends the subproblems of the checks to the peer that owns their entity on the ring. Every entity is
+// checked by the same peer, so the results of its subproblems are cached once across the replicas instead of once
+// per replica.
+type Dispatcher struct {
+	ring    *Ring
+	self    string
+	timeout time.Duration
+	// connections to the peers, they are reused across the dispatched checks
+	mu          sync.Mutex
+	conns       map[string]*grpc.ClientConn
+	dialOptions []grpc.DialOption
+	// subproblems of the checks by the peer they were routed to
+	statsMu sync.Mutex
+	local   uint64
+	peers   map[string]*PeerStats
+	logger  logger.Interface
+}
+
+// PeerStats - Subproblems of the checks routed to a peer, misrouted ones were answered by a peer that does not own
+// their entity on its own ring
+type PeerStats struct {
+	Address    string
+	Share      float64
+	Dispatched uint64
+	Failed     uint64
+	Misrouted  uint64
+}
+
+// RingStats - Distribution of the keys over the ring and of the subproblems over the peers, local ones are owned by
+// this instance or were checked while the ring was empty
+type RingStats struct {
+	Self  string
+	Local uint64
+	Peers []PeerStats
+}
+
+// NewDispatcher - Creates a new dispatcher, self is the address the peers reach this instance at
+func NewDispatcher(ring *Ring, self string, timeout time.Duration, l logger.Interface, dialOptions ...grpc.DialOption) *Dispatcher {
+	if timeout <= 0 {
+		timeout = _defaultDispatchTimeout
+	}
+	if len(dialOptions) == 0 {
+		dialOptions = []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	}
+	return &Dispatcher{
+		ring:        ring,
+		self:        self,
+		timeout:     timeout,
+		conns:       map[string]*grpc.ClientConn{},
+		dialOptions: dialOptions,
+		peers:       map[string]*PeerStats{},
+		logger:      l,
+	}
+}
+
+// Owner - Peer that owns the entity of the request, it is empty when the ring is empty
+func (d *Dispatcher) Owner(request *base.PermissionCheckRequest) string {
+	owner, _ := d.ring.Get(Key(request.GetTenantId(), request.GetEntity()))
+	return owner
+}
+
+// Dispatch - Checks the request on the peer that owns its entity. It reports false when this instance owns the
+// entity or the peer could not answer, the caller then checks the request itself.
+func (d *Dispatcher) Dispatch(ctx context.Context, request *base.PermissionCheckRequest) (*base.PermissionCheckResponse, bool) {
+	owner := d.Owner(request)
+	if owner == "" || owner == d.self {
+		d.record(owner, func(*PeerStats) {})
+		return nil, false
+	}
+	
+	conn, err := d.conn(owner)
+	if err != nil {
+		d.logger.Warn("dispatch to %s failed: %s", owner, err.Error())
+		d.record(owner, func(s *PeerStats) { s.Failed++ })
+		return nil, false
+	}
+	
+	// the credentials of the client authenticate the dispatched check on the peer
+	md, _ := metadata.FromIncomingContext(ctx)
+	
+	ctx, cancel := context.WithTimeout(metadata.NewOutgoingContext(ctx, md), d.timeout)
+	defer cancel()
+	
+	response := &base.PermissionCheckResponse{}
+	var header metadata.MD
+	if err = conn.Invoke(ctx, CheckMethod, request, response, grpc.Header(&header)); err != nil {
+		d.logger.Warn("dispatch to %s failed: %s", owner, err.Error())
+		d.record(owner, func(s *PeerStats) { s.Failed++ })
+		return nil, false
+	}
+	
+	// the answer is still right, only the results the peers cache are split while their rings disagree
+	hint := header.Get(OwnerHeader)
+	d.record(owner, func(s *PeerStats) {
+		s.Dispatched++
+		if len(hint) > 0 && hint[0] != owner {
+			s.Misrouted++
+		}
+	})
+	return response, true
+}
+
+// Stats - Shares of the peers on the ring and the subproblems routed to them since the dispatcher was created, the
+// peers that left the ring keep their counts
+func (d *Dispatcher) Stats() RingStats {
+	shares := d.ring.Shares()
+	
+	d.statsMu.Lock()
+	defer d.statsMu.Unlock()
+	
+	stats := RingStats{Self: d.self, Local: d.local}
+	seen := make(map[string]bool, len(shares))
+	for address, s := range d.peers {
+		peer := *s
+		peer.Share = shares[address]
+		stats.Peers = append(stats.Peers, peer)
+		seen[address] = true
+	}
+	for address, share := range shares {
+		if !seen[address] {
+			stats.Peers = append(stats.Peers, PeerStats{Address: address, Share: share})
+		}
+	}
+	sort.Slice(stats.Peers, func(i, j int) bool { return stats.Peers[i].Address < stats.Peers[j].Address })
+	return stats
+}
+
+// record - Counts a subproblem of the peer, the ones of this instance are counted as local
+func (d *Dispatcher) record(owner string, fn func(*PeerStats)) {
+	d.statsMu.Lock()
+	defer d.statsMu.Unlock()
+	
+	if owner == "" || owner == d.self {
+		d.local++
+		return
+	}
+	s, ok := d.peers[owner]
+	if !ok {
+		s = &PeerStats{Address: owner}
+		d.peers[owner] = s
+	}
+	fn(s)
+}
+
+// Close - Closes the connections to the peers
+func (d *Dispatcher) Close() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	
+	for address, conn := range d.conns {
+		_ = conn.Close()
+		delete(d.conns, address)
+	}
+}
+
+// conn - Connection to a peer
+func (d *Dispatcher) conn(address string) (*grpc.ClientConn, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	
+	if conn, ok := d.conns[address]; ok {
+		return conn, nil
+	}
+	
+	conn, err := grpc.Dial(address, d.dialOptions...)
+	if err != nil {
+		return nil, err
+	}
+	d.conns[address] = conn
+	return conn, nil
+}
+
+// Key - Key of an entity on the ring, the entities of the tenants are spread independently
+func Key(tenantID string, entity *base.Entity) string {
+	return tenantID + "/" + entity.GetType() + ":" + entity.GetId()
+}
@@ -0,0 +1,106 @@
+package cluster
+
+import (
+	"context"
+	"strings"
+	"time"
+	
+	"github.com/adminium/permify/pkg/logger"
+)
+
+const (
+	_defaultRefreshInterval = 10 * time.Second
+)
+
+// Membership - Keeps the members of the ring in sync with the discovered peers. Peers join the ring as soon as they
+// are discovered, peers that disappear leave it only after the removal delay, so a pod that restarts or misses a
+// single refresh does not move its keys away and back. Failed discoveries leave the ring as it is.
+type Membership struct {
+	ring         *Ring
+	discoverer   Discoverer
+	self         string
+	interval     time.Duration
+	removalDelay time.Duration
+	// missing - peers of the ring that were not discovered, with the time they went missing
+	missing map[string]time.Time
+	logger  logger.Interface
+}
+
+// NewMembership - Creates a new membership, self is always a member of the ring when it is not empty
+func NewMembership(ring *Ring, discoverer Discoverer, self string, interval, removalDelay time.Duration, l logger.Interface) *Membership {
+	if interval <= 0 {
+		interval = _defaultRefreshInterval
+	}
+	return &Membership{
+		ring:         ring,
+		discoverer:   discoverer,
+		self:         self,
+		interval:     interval,
+		removalDelay: removalDelay,
+		missing:      map[string]time.Time{},
+		logger:       l,
+	}
+}
+
+// Refresh - Discovers the peers once and rebalances the ring
+func (m *Membership) Refresh(ctx context.Context, now time.Time) error {
+	peers, err := m.discoverer.Discover(ctx)
+	if err != nil {
+		return err
+	}
+	
+	members := make([]string, 0, len(peers)+1)
+	members = append(members, peers...)
+	if m.self != "" {
+		members = append(members, m.self)
+	}
+	
+	discovered := make(map[string]struct{}, len(members))
+	for _, member := range members {
+		discovered[member] = struct{}{}
+		delete(m.missing, member)
+	}
+	
+	for _, member := range m.ring.Members() {
+		if _, ok := discovered[member]; ok {
+			continue
+		}
+		since, ok := m.missing[member]
+		if !ok {
+			since = now
+			m.missing[member] = now
+		}
+		if now.Sub(since) < m.removalDelay {
+			members = append(members, member)
+		} else {
+			delete(m.missing, member)
+		}
+	}
+	
+	added, removed := m.ring.Set(members)
+	if len(added) > 0 || len(removed) > 0 {
+		m.logger.Info("🔁 dispatch ring rebalanced, joined: [%s] left: [%s] members: %d", strings.Join(added, ", "), strings.Join(removed, ", "), len(m.ring.Members()))
+	}
+	return nil
+}
+
+// Run - Refreshes the ring at every interval until the context is done
+func (m *Membership) Run(ctx context.Context) error {
+	if err := m.Refresh(ctx, time.Now()); err != nil {
+		m.logger.Error("failed to discover the peers of the dispatch ring", err)
+	}
+	
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case now := <-ticker.C:
+			if err := m.Refresh(ctx, now); err != nil {
+				m.logger.Error("failed to discover the peers of the dispatch ring", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package cluster
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+	
+	"github.com/cespare/xxhash"
+)
+
+// _defaultReplicas - Points every member has on the ring, more points spread the keys more evenly
+const _defaultReplicas = 100
+
+// Ring - Consistent hash ring of the peers. Changing the members only moves the keys of the members that joined or
+// left, the other members keep the keys they own and so their caches.
+type Ring struct {
+	mu       sync.RWMutex
+	replicas int
+	points   []uint64
+	owners   map[uint64]string
+	members  map[string]struct{}
+}
+
+// NewRing - Creates a new ring, replicas is the number of points of every member
+func NewRing(replicas int) *Ring {
+	if replicas <= 0 {
+		replicas = _defaultReplicas
+	}
+	return &Ring{
+		replicas: replicas,
+		owners:   map[uint64]string{},
+		members:  map[string]struct{}{},
+	}
+}
+
+// Set - Replaces the members of the ring, it returns the members that joined and the ones that left
+func (r *Ring) Set(members []string) (added, removed []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	
+	desired := make(map[string]struct{}, len(members))
+	for _, member := range members {
+		if member != "" {
+			desired[member] = struct{}{}
+		}
+	}
+	
+	for member := range desired {
+		if _, ok := r.members[member]; !ok {
+			added = append(added, member)
+		}
+	}
+	for member := range r.members {
+		if _, ok := desired[member]; !ok {
+			removed = append(removed, member)
+		}
+	}
+	if len(added) == 0 && len(removed) == 0 {
+		return nil, nil
+	}
+	
+	r.members = desired
+	r.owners = make(map[uint64]string, len(desired)*r.replicas)
+	r.points = r.points[:0]
+	for member := range desired {
+		for i := 0; i < r.replicas; i++ {
+			point := xxhash.Sum64String(member + "#" + strconv.Itoa(i))
+			// two members on the same point would make the owner depend on the order of the map
+			if owner, ok := r.owners[point]; ok && owner < member {
+				continue
+			}
+			if _, ok := r.owners[point]; !ok {
+				r.points = append(r.points, point)
+			}
+			r.owners[point] = member
+		}
+	}
+	sort.Slice(r.points, func(i, j int) bool { return r.points[i] < r.points[j] })
+	
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
+
+// Get - Member that owns the key, false when the ring has no members
+func (r *Ring) Get(key string) (string, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	
+	if len(r.points) == 0 {
+		return "", false
+	}
+	
+	hash := xxhash.Sum64String(key)
+	i := sort.Search(len(r.points), func(i int) bool { return r.points[i] >= hash })
+	if i == len(r.points) {
+		i = 0
+	}
+	return r.owners[r.points[i]], true
+}
+
+// Shares - Fraction of the keys every member owns, they add up to one. Uneven shares point at members whose points
+// landed close together.
+func (r *Ring) Shares() map[string]float64 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	
+	shares := make(map[string]float64, len(r.members))
+	if len(r.points) == 0 {
+		return shares
+	}
+	// a point owns the keys between the point before it and itself, the first one the keys after the last point too
+	var total float64
+	previous := r.points[len(r.points)-1]
+	for _, point := range r.points {
+		arc := float64(point - previous)
+		shares[r.owners[point]] += arc
+		total += arc
+		previous = point
+	}
+	// a single point owns the whole ring
+	if total == 0 {
+		shares[r.owners[r.points[0]]] = 1
+		return shares
+	}
+	for member := range shares {
+		shares[member] /= total
+	}
+	return shares
+}
+
+// Members - Members of the ring in ascending order
+func (r *Ring) Members() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	
+	members := make([]string, 0, len(r.members))
+	for member := range r.members {
+		members = append(members, member)
+	}
+	sort.Strings(members)
+	return members
+}
@@ -0,0 +1,120 @@
+package commands
+
+import (
+	"context"
+	
+	"go.opentelemetry.io/otel/attribute"
+	otelCodes "go.opentelemetry.io/otel/codes"
+	"google.golang.org/protobuf/types/known/structpb"
+	
+	"github.com/adminium/permify/internal/schema"
+	"github.com/adminium/permify/pkg/database"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+const (
+	_defaultCaveatsCacheSize = 1000
+)
+
+// contextWithCheckContext - The sub-checks of a check are evaluated with the context of its request
+func contextWithCheckContext(ctx context.Context, data *structpb.Struct) context.Context {
+	return context.WithValue(ctx, checkContextKey{}, data)
+}
+
+// checkContextFromContext -
+func checkContextFromContext(ctx context.Context) *structpb.Struct {
+	data, _ := ctx.Value(checkContextKey{}).(*structpb.Struct)
+	return data
+}
+
+type checkContextKey struct{}
+
+// readCaveats - Caveats of the schema version of the request
+func (command *CheckCommand) readCaveats(ctx context.Context, request *base.PermissionCheckRequest) (*schema.Caveats, error) {
+	return command.caveats.get(request.GetTenantId()+"|"+request.GetMetadata().GetSchemaVersion(), func() (*schema.Caveats, error) {
+		definitions, err := command.schemaReader.ReadSchemaString(ctx, request.GetTenantId(), request.GetMetadata().GetSchemaVersion())
+		if err != nil {
+			return nil, err
+		}
+		return schema.NewCaveatsFromStringDefinitions(definitions...)
+	})
+}
+
+// checkCaveat - Checks the relation with the tuples whose payload satisfies the caveat with the context of the check,
+// the other tuples of the relation are left out
+func (command *CheckCommand) checkCaveat(ctx context.Context, request *base.PermissionCheckRequest, relation, caveat string) CheckFunction {
+	return func(ctx context.Context) (*base.PermissionCheckResponse, error) {
+		ctx, span := tracer.Start(ctx, "permissions.check.caveat")
+		defer span.End()
+		span.SetAttributes(attribute.String("caveat", caveat))
+		
+		c, err := command.readCaveats(ctx, request)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return denied(&base.PermissionCheckResponseMetadata{}), err
+		}
+		
+		var it *database.TupleIterator
+		it, err = command.relationshipReader.QueryRelationships(ctx, request.GetTenantId(), &base.TupleFilter{
+			Entity: &base.EntityFilter{
+				Type: request.GetEntity().GetType(),
+				Ids:  []string{request.GetEntity().GetId()},
+			},
+			Relation: relation,
+		}, request.GetMetadata().GetSnapToken())
+		if err != nil {
+			return denied(&base.PermissionCheckResponseMetadata{}), err
+		}
+		
+		var wildcards bool
+		wildcards, err = command.wildcards(ctx, request.GetTenantId())
+		if err != nil {
+			return denied(&base.PermissionCheckResponseMetadata{}), err
+		}
+		
+		data := checkContextFromContext(ctx)
+		trace := checkTraceFromContext(ctx)
+		var checkFunctions []CheckFunction
+		for it.HasNext() {
+			t := it.GetNext()
+			trace.touch(t)
+			var satisfied bool
+			satisfied, err = c.Evaluate(caveat, data, t.GetPayload())
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(otelCodes.Error, err.Error())
+				return denied(&base.PermissionCheckResponseMetadata{}), err
+			}
+			if !satisfied {
+				continue
+			}
+			subject := t.GetSubject()
+			if tuple.AreSubjectsEqual(subject, request.GetSubject()) || (wildcards && isWildcardOf(subject, request.GetSubject())) {
+				return allowed(&base.PermissionCheckResponseMetadata{}), nil
+			}
+			if !tuple.IsSubjectUser(subject) && subject.GetRelation() != tuple.ELLIPSIS {
+				checkFunctions = append(checkFunctions, command.execute(ctx, &base.PermissionCheckRequest{
+					TenantId: request.GetTenantId(),
+					Entity: &base.Entity{
+						Type: subject.GetType(),
+						Id:   subject.GetId(),
+					},
+					Permission: subject.GetRelation(),
+					Subject:    request.GetSubject(),
+					Metadata: &base.PermissionCheckRequestMetadata{
+						SchemaVersion: request.GetMetadata().GetSchemaVersion(),
+						SnapToken:     request.GetMetadata().GetSnapToken(),
+						Depth:         request.GetMetadata().GetDepth() - 1,
+					},
+				}))
+			}
+		}
+		
+		if len(checkFunctions) > 0 {
+			return checkUnion(ctx, checkFunctions, command.concurrencyLimit)
+		}
+		return denied(&base.PermissionCheckResponseMetadata{}), nil
+	}
+}
@@ -3,17 +3,20 @@ package commands
 import (
 	"context"
 	"errors"
-	"sync"
+	"time"
 	
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/instrument"
 	
 	otelCodes "go.opentelemetry.io/otel/codes"
 	
+	"github.com/adminium/permify/internal/deadline"
 	"github.com/adminium/permify/internal/keys"
 	"github.com/adminium/permify/internal/repositories"
 	"github.com/adminium/permify/internal/schema"
+	"github.com/adminium/permify/pkg/cache"
 	"github.com/adminium/permify/pkg/database"
+	"github.com/adminium/permify/pkg/dsl/utils"
 	base "github.com/adminium/permify/pkg/pb/base/v1"
 	"github.com/adminium/permify/pkg/token"
 	"github.com/adminium/permify/pkg/tuple"
@@ -29,8 +32,34 @@ type CheckCommand struct {
 	// counters
 	executionCounter       instrument.Int64Counter
 	cachedExecutionCounter instrument.Int64Counter
+	durationHistogram      instrument.Float64Histogram
 	// options
 	concurrencyLimit int
+	// external resolvers by entity_type#relation
+	externals     map[string]External
+	externalCache cache.Cache
+	// attributes and parsed rules of the rules called by actions
+	attributeReader repositories.AttributeReader
+	rules           *versioned[*schema.Rules]
+	// compiled caveats of the relations of actions and conditions of actions, the conditions are expressions of the
+	// context, the entity and the subject only, so they are compiled in a single environment for every version
+	caveats    *versioned[*schema.Caveats]
+	conditions *schema.Conditions
+	// settings of the tenants, nil when every tenant has the default settings
+	tenantSettingsReader repositories.TenantSettingsReader
+	// evaluator and parsed annotations of the policies of actions, nil when policies are not evaluated
+	policyEvaluator PolicyEvaluator
+	policies        *versioned[schema.Policies]
+	// dispatcher of the subproblems to the peers, nil when every subproblem is checked locally
+	dispatcher CheckDispatcher
+	// shares of the deadline of the check, the dispatched subproblems take at most theirs
+	budget deadline.Budget
+	// subject types of the permissions, the checks of other subject types are denied without reading tuples
+	restrictSubjectTypes bool
+	subjectTypes         *versioned[subjectTypeRestriction]
+	// head snapshots of the tenants reused by the checks that minimize latency for at most the staleness
+	staleness time.Duration
+	snapshots snapshots
 }
 
 // NewCheckCommand -
@@ -40,6 +69,10 @@ func NewCheckCommand(km keys.CommandKeyManager, sr repositories.SchemaReader, rr
 		commandKeyManager:  km,
 		relationshipReader: rr,
 		concurrencyLimit:   _defaultConcurrencyLimit,
+		rules:              newVersioned[*schema.Rules](_defaultRulesCacheSize),
+		caveats:            newVersioned[*schema.Caveats](_defaultCaveatsCacheSize),
+		policies:           newVersioned[schema.Policies](_defaultPoliciesCacheSize),
+		subjectTypes:       newVersioned[subjectTypeRestriction](_defaultSubjectTypesCacheSize),
 	}
 	
 	// options
@@ -47,6 +80,12 @@ func NewCheckCommand(km keys.CommandKeyManager, sr repositories.SchemaReader, rr
 		opt(command)
 	}
 	
+	conditions, err := schema.NewConditions()
+	if err != nil {
+		return nil, err
+	}
+	command.conditions = conditions
+	
 	checkExecutionCounter, err := m.Int64Counter("check_execution_count", instrument.WithDescription("check execution count"))
 	if err != nil {
 		return nil, err
@@ -57,8 +96,14 @@ func NewCheckCommand(km keys.CommandKeyManager, sr repositories.SchemaReader, rr
 		return nil, err
 	}
 	
+	checkDurationHistogram, err := m.Float64Histogram("check_duration", instrument.WithDescription("check duration in milliseconds"), instrument.WithUnit("ms"))
+	if err != nil {
+		return nil, err
+	}
+	
 	command.executionCounter = checkExecutionCounter
 	command.cachedExecutionCounter = cachedCheckExecutionCounter
+	command.durationHistogram = checkDurationHistogram
 	return command, nil
 }
 
@@ -72,10 +117,57 @@ func (command *CheckCommand) Execute(ctx context.Context, request *base.Permissi
 	
 	command.executionCounter.Add(ctx, 1)
 	
+	start := time.Now()
+	defer func() {
+		command.durationHistogram.Record(ctx, float64(time.Since(start).Microseconds())/1000)
+	}()
+	
+	trace := checkTraceFromContext(ctx)
+	if trace != nil {
+		defer func() {
+			trace.record(request, response, err)
+		}()
+	}
+	
+	// the check that is not a sub-check of another one reports the lowest depth its sub-checks reached
+	tracker := depthTrackerFromContext(ctx)
+	if tracker == nil {
+		tracker = newDepthTracker(request.GetMetadata().GetDepth())
+		ctx = contextWithDepthTracker(ctx, tracker)
+		defer func() {
+			if response != nil && response.Metadata != nil {
+				response.Metadata.RemainingDepth = tracker.remaining()
+			}
+		}()
+	}
+	tracker.record(request.GetMetadata().GetDepth())
+	
+	// the results of the checks that an external resolver answered through the sub-checks are not cached
+	if len(command.externals) > 0 {
+		ctx = contextWithExternalTracker(ctx)
+	}
+	
+	// the caveats of the sub-checks are evaluated with the context of the request that started them
+	if request.GetContext() != nil && checkContextFromContext(ctx) == nil {
+		ctx = contextWithCheckContext(ctx, request.GetContext())
+	}
+	
 	emptyResp := denied(&base.PermissionCheckResponseMetadata{
 		CheckCount: 0,
 	})
 	
+	// the consistency mode selects the snapshot of the check, its subproblems are checked at that snapshot
+	cacheable := true
+	if consistency := request.GetMetadata().GetConsistency(); consistency != base.PermissionCheckRequestMetadata_CONSISTENCY_UNSPECIFIED {
+		request.Metadata.Consistency = base.PermissionCheckRequestMetadata_CONSISTENCY_UNSPECIFIED
+		cacheable, err = command.selectSnapshot(ctx, request, consistency)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return emptyResp, err
+		}
+	}
+	
 	if request.GetMetadata().GetSnapToken() == "" {
 		var st token.SnapToken
 		st, err = command.relationshipReader.HeadSnapshot(ctx, request.GetTenantId())
@@ -94,9 +186,9 @@ func (command *CheckCommand) Execute(ctx context.Context, request *base.Permissi
 		}
 	}
 	
-	err = checkDepth(request)
-	if err != nil {
-		return emptyResp, err
+	// a sub-check that runs out of depth does not fail the check, it is decided by the other sub-checks when they can
+	if request.GetMetadata().GetDepth() <= 0 {
+		return depthExceeded(&base.PermissionCheckResponseMetadata{}), nil
 	}
 	
 	var en *base.EntityDefinition
@@ -115,7 +207,24 @@ func (command *CheckCommand) Execute(ctx context.Context, request *base.Permissi
 		return emptyResp, err
 	}
 	
-	if tor != base.EntityDefinition_RELATIONAL_REFERENCE_ACTION {
+	// subjects of types the permission can not hold for are denied before any tuple is read
+	if command.restrictSubjectTypes && !request.GetMetadata().GetExclusion() {
+		var ok bool
+		ok, err = command.reachable(ctx, request)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return emptyResp, err
+		}
+		if !ok {
+			return emptyResp, nil
+		}
+	}
+	
+	// relations with an external resolver are not bound to the snapshot, so they are not cached by it
+	_, isExternal := command.external(request.GetEntity().GetType(), request.GetPermission())
+	
+	if tor != base.EntityDefinition_RELATIONAL_REFERENCE_ACTION && !isExternal && trace == nil && cacheable {
 		res, found := command.commandKeyManager.GetCheckKey(request)
 		if found {
 			command.cachedExecutionCounter.Add(ctx, 1)
@@ -140,13 +249,19 @@ func (command *CheckCommand) Execute(ctx context.Context, request *base.Permissi
 	
 	if tor != base.EntityDefinition_RELATIONAL_REFERENCE_ACTION {
 		res.Metadata = increaseCheckCount(res.Metadata)
-		command.commandKeyManager.SetCheckKey(request, &base.PermissionCheckResponse{
-			Can:      res.GetCan(),
-			Metadata: &base.PermissionCheckResponseMetadata{},
-		})
+		// the results that ran out of depth depend on the depth of the request, which the cache keys do not have
+		if command.cacheable(ctx, request) && res.GetCan() != base.PermissionCheckResponse_RESULT_DEPTH_EXCEEDED {
+			command.commandKeyManager.SetCheckKey(request, &base.PermissionCheckResponse{
+				Can:      res.GetCan(),
+				Metadata: &base.PermissionCheckResponseMetadata{},
+			})
+		}
 		if request.GetMetadata().GetExclusion() {
-			if res.GetCan() == base.PermissionCheckResponse_RESULT_ALLOWED {
+			switch res.GetCan() {
+			case base.PermissionCheckResponse_RESULT_ALLOWED:
 				return denied(res.Metadata), nil
+			case base.PermissionCheckResponse_RESULT_DEPTH_EXCEEDED:
+				return res, nil
 			}
 			return allowed(res.Metadata), nil
 		}
@@ -167,6 +282,14 @@ type CheckCombiner func(ctx context.Context, functions []CheckFunction, limit in
 // execute -
 func (command *CheckCommand) execute(ctx context.Context, request *base.PermissionCheckRequest) CheckFunction {
 	return func(ctx context.Context) (*base.PermissionCheckResponse, error) {
+		// the peers evaluate the caveats of the dispatched sub-checks with the same context
+		if request.GetContext() == nil {
+			request.Context = checkContextFromContext(ctx)
+		}
+		if response, ok := command.dispatch(ctx, request); ok {
+			depthTrackerFromContext(ctx).record(response.GetMetadata().GetRemainingDepth())
+			return response, nil
+		}
 		return command.Execute(ctx, request)
 	}
 }
@@ -188,6 +311,14 @@ func (command *CheckCommand) check(ctx context.Context, request *base.Permission
 		} else {
 			fn = command.checkLeaf(ctx, request, child.GetLeaf())
 		}
+		if fn != nil && command.policyEvaluator != nil {
+			fn = command.checkPolicy(ctx, request, fn)
+		}
+	} else if external, ok := command.external(request.GetEntity().GetType(), request.GetPermission()); ok {
+		direct, resolved := command.checkDirect(ctx, request), command.checkExternal(ctx, request, external)
+		fn = func(ctx context.Context) (*base.PermissionCheckResponse, error) {
+			return checkUnion(ctx, []CheckFunction{direct, resolved}, command.concurrencyLimit)
+		}
 	} else {
 		fn = command.checkDirect(ctx, request)
 	}
@@ -213,16 +344,34 @@ func (command *CheckCommand) checkRewrite(ctx context.Context, request *base.Per
 	}
 }
 
-// checkLeaf -
+// checkLeaf - an excluded leaf is combined explicitly as everything except the leaf
 func (command *CheckCommand) checkLeaf(ctx context.Context, request *base.PermissionCheckRequest, leaf *base.Leaf) CheckFunction {
+	var fn CheckFunction
 	switch op := leaf.GetType().(type) {
 	case *base.Leaf_TupleToUserSet:
-		return command.checkTupleToUserSet(ctx, request, op.TupleToUserSet, leaf.GetExclusion())
+		fn = command.checkTupleToUserSet(ctx, request, op.TupleToUserSet)
 	case *base.Leaf_ComputedUserSet:
-		return command.checkComputedUserSet(ctx, request, op.ComputedUserSet, leaf.GetExclusion())
+		if rule, arguments, ok := utils.ParseRuleReference(op.ComputedUserSet.GetRelation()); ok {
+			fn = command.checkRule(ctx, request, rule, arguments)
+			break
+		}
+		if relation, caveat, ok := utils.ParseCaveatReference(op.ComputedUserSet.GetRelation()); ok {
+			fn = command.checkCaveat(ctx, request, relation, caveat)
+			break
+		}
+		fn = command.checkComputedUserSet(ctx, request, op.ComputedUserSet)
+	case *base.Leaf_Condition:
+		fn = command.checkCondition(ctx, request, op.Condition)
 	default:
 		return checkFail(errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_CHILD_TYPE.String()))
 	}
+	
+	if leaf.GetExclusion() {
+		return func(ctx context.Context) (*base.PermissionCheckResponse, error) {
+			return checkExclusion(ctx, []CheckFunction{checkAllowed, fn}, command.concurrencyLimit)
+		}
+	}
+	return fn
 }
 
 // setChild -
@@ -259,12 +408,23 @@ func (command *CheckCommand) checkDirect(ctx context.Context, request *base.Perm
 			return denied(&base.PermissionCheckResponseMetadata{}), err
 		}
 		
+		var wildcards bool
+		wildcards, err = command.wildcards(ctx, request.GetTenantId())
+		if err != nil {
+			return denied(&base.PermissionCheckResponseMetadata{}), err
+		}
+		
+		trace := checkTraceFromContext(ctx)
 		var checkFunctions []CheckFunction
 		for it.HasNext() {
-			subject := it.GetNext().GetSubject()
-			if tuple.AreSubjectsEqual(subject, request.GetSubject()) {
+			t := it.GetNext()
+			trace.touch(t)
+			subject := t.GetSubject()
+			if tuple.AreSubjectsEqual(subject, request.GetSubject()) || (wildcards && isWildcardOf(subject, request.GetSubject())) {
 				result = allowed(&base.PermissionCheckResponseMetadata{})
-				command.commandKeyManager.SetCheckKey(request, result)
+				if command.cacheable(ctx, request) {
+					command.commandKeyManager.SetCheckKey(request, result)
+				}
 				return result, nil
 			}
 			if !tuple.IsSubjectUser(subject) && subject.GetRelation() != tuple.ELLIPSIS {
@@ -278,7 +438,6 @@ func (command *CheckCommand) checkDirect(ctx context.Context, request *base.Perm
 					Subject:    request.GetSubject(),
 					Metadata: &base.PermissionCheckRequestMetadata{
 						SchemaVersion: request.Metadata.GetSchemaVersion(),
-						Exclusion:     request.Metadata.GetExclusion(),
 						SnapToken:     request.Metadata.GetSnapToken(),
 						Depth:         request.Metadata.Depth - 1,
 					},
@@ -291,13 +450,48 @@ func (command *CheckCommand) checkDirect(ctx context.Context, request *base.Perm
 		}
 		
 		result = denied(&base.PermissionCheckResponseMetadata{})
-		command.commandKeyManager.SetCheckKey(request, result)
+		if command.cacheable(ctx, request) {
+			command.commandKeyManager.SetCheckKey(request, result)
+		}
 		return
 	}
 }
 
+// wildcards - Whether the tenant relates every subject of a type with the tuples of the subject id *
+func (command *CheckCommand) wildcards(ctx context.Context, tenantID string) (bool, error) {
+	if command.tenantSettingsReader == nil {
+		return false, nil
+	}
+	settings, err := command.tenantSettingsReader.ReadTenantSettings(ctx, tenantID)
+	if err != nil {
+		return false, err
+	}
+	return settings.Wildcards, nil
+}
+
+// resolvesFromTuples - Whether the checks of the tenant resolve from the tuples alone, without externals, policies
+// or wildcards, so that their results can be computed by walking the tuples of the subject backwards
+func (command *CheckCommand) resolvesFromTuples(ctx context.Context, tenantID string) (bool, error) {
+	if len(command.externals) > 0 || command.policyEvaluator != nil {
+		return false, nil
+	}
+	wildcards, err := command.wildcards(ctx, tenantID)
+	if err != nil {
+		return false, err
+	}
+	return !wildcards, nil
+}
+
+// isWildcardOf - The subject of the tuple is the wildcard of the type and relation of the subject
+func isWildcardOf(wildcard, subject *base.Subject) bool {
+	return wildcard.GetId() == tuple.WILDCARD && wildcard.GetType() == subject.GetType() && wildcard.GetRelation() == subject.GetRelation()
+}
+
 // checkTupleToUserSet -
-func (command *CheckCommand) checkTupleToUserSet(ctx context.Context, request *base.PermissionCheckRequest, ttu *base.TupleToUserSet, exclusion bool) CheckFunction {
+func (command *CheckCommand) checkTupleToUserSet(ctx context.Context, request *base.PermissionCheckRequest, ttu *base.TupleToUserSet) CheckFunction {
+	if relation, ok := utils.ParseTransitiveReference(ttu.GetTupleSet().GetRelation()); ok {
+		return command.checkTransitiveTupleToUserSet(ctx, request, relation, ttu.GetComputed())
+	}
 	return func(ctx context.Context) (*base.PermissionCheckResponse, error) {
 		var err error
 		var it *database.TupleIterator
@@ -312,9 +506,12 @@ func (command *CheckCommand) checkTupleToUserSet(ctx context.Context, request *b
 			return denied(&base.PermissionCheckResponseMetadata{}), err
 		}
 		
+		trace := checkTraceFromContext(ctx)
 		var checkFunctions []CheckFunction
 		for it.HasNext() {
-			subject := it.GetNext().GetSubject()
+			t := it.GetNext()
+			trace.touch(t)
+			subject := t.GetSubject()
 			checkFunctions = append(checkFunctions, command.checkComputedUserSet(ctx, &base.PermissionCheckRequest{
 				TenantId: request.GetTenantId(),
 				Entity: &base.Entity{
@@ -324,15 +521,65 @@ func (command *CheckCommand) checkTupleToUserSet(ctx context.Context, request *b
 				Permission: subject.GetRelation(),
 				Subject:    request.GetSubject(),
 				Metadata:   request.GetMetadata(),
-			}, ttu.GetComputed(), exclusion))
+			}, ttu.GetComputed()))
 		}
 		
 		return checkUnion(ctx, checkFunctions, command.concurrencyLimit)
 	}
 }
 
+// checkTransitiveTupleToUserSet - Checks the computed relation on the entities the relation reaches from the entity,
+// e.g. viewer on the parents, the parents of the parents and so on. The levels are checked one after the other, so the
+// check ends at the closest level that allows it instead of resolving the whole hierarchy; every level takes one of
+// the depth of the request.
+func (command *CheckCommand) checkTransitiveTupleToUserSet(ctx context.Context, request *base.PermissionCheckRequest, relation string, cu *base.ComputedUserSet) CheckFunction {
+	return func(ctx context.Context) (*base.PermissionCheckResponse, error) {
+		result := denied(&base.PermissionCheckResponseMetadata{})
+		exceeded := false
+		err := walkTransitive(ctx, command.relationshipReader, request.GetTenantId(), request.GetEntity(), relation, request.GetMetadata().GetSnapToken(), request.GetMetadata().GetDepth(), func(level int32, entities []*base.Entity) (bool, error) {
+			functions := make([]CheckFunction, 0, len(entities))
+			for _, entity := range entities {
+				functions = append(functions, command.checkComputedUserSet(ctx, &base.PermissionCheckRequest{
+					TenantId: request.GetTenantId(),
+					Entity:   entity,
+					Subject:  request.GetSubject(),
+					Metadata: &base.PermissionCheckRequestMetadata{
+						SchemaVersion: request.GetMetadata().GetSchemaVersion(),
+						SnapToken:     request.GetMetadata().GetSnapToken(),
+						Depth:         request.GetMetadata().GetDepth() - level + 1,
+					},
+				}, cu))
+			}
+			response, err := checkUnion(ctx, functions, command.concurrencyLimit)
+			if err != nil {
+				return true, err
+			}
+			switch response.GetCan() {
+			case base.PermissionCheckResponse_RESULT_ALLOWED:
+				result = response
+				return true, nil
+			case base.PermissionCheckResponse_RESULT_DEPTH_EXCEEDED:
+				exceeded = true
+			}
+			return false, nil
+		})
+		// the levels past the depth are not walked, the closer ones did not allow the check
+		if err != nil && err.Error() == base.ErrorCode_ERROR_CODE_DEPTH_NOT_ENOUGH.String() {
+			depthTrackerFromContext(ctx).record(0)
+			return depthExceeded(&base.PermissionCheckResponseMetadata{}), nil
+		}
+		if err != nil {
+			return denied(&base.PermissionCheckResponseMetadata{}), err
+		}
+		if exceeded && result.GetCan() != base.PermissionCheckResponse_RESULT_ALLOWED {
+			return depthExceeded(result.GetMetadata()), nil
+		}
+		return result, nil
+	}
+}
+
 // checkComputedUserSet -
-func (command *CheckCommand) checkComputedUserSet(ctx context.Context, request *base.PermissionCheckRequest, cu *base.ComputedUserSet, exclusion bool) CheckFunction {
+func (command *CheckCommand) checkComputedUserSet(ctx context.Context, request *base.PermissionCheckRequest, cu *base.ComputedUserSet) CheckFunction {
 	return command.execute(ctx, &base.PermissionCheckRequest{
 		TenantId: request.GetTenantId(),
 		Entity: &base.Entity{
@@ -343,127 +590,12 @@ func (command *CheckCommand) checkComputedUserSet(ctx context.Context, request *
 		Subject:    request.GetSubject(),
 		Metadata: &base.PermissionCheckRequestMetadata{
 			SchemaVersion: request.Metadata.GetSchemaVersion(),
-			Exclusion:     exclusion,
 			SnapToken:     request.Metadata.GetSnapToken(),
 			Depth:         request.Metadata.Depth - 1,
 		},
 	})
 }
 
-// checkUnion -
-func checkUnion(ctx context.Context, functions []CheckFunction, limit int) (*base.PermissionCheckResponse, error) {
-	responseMetadata := &base.PermissionCheckResponseMetadata{}
-	
-	if len(functions) == 0 {
-		return &base.PermissionCheckResponse{
-			Can:      base.PermissionCheckResponse_RESULT_DENIED,
-			Metadata: responseMetadata,
-		}, nil
-	}
-	
-	decisionChan := make(chan CheckResponse, len(functions))
-	cancelCtx, cancel := context.WithCancel(ctx)
-	
-	clean := run(cancelCtx, functions, decisionChan, limit)
-	
-	defer func() {
-		cancel()
-		clean()
-		close(decisionChan)
-	}()
-	
-	for i := 0; i < len(functions); i++ {
-		select {
-		case d := <-decisionChan:
-			responseMetadata = joinResponseMetas(responseMetadata, d.resp.Metadata)
-			if d.err != nil {
-				return denied(responseMetadata), d.err
-			}
-			if d.resp.GetCan() == base.PermissionCheckResponse_RESULT_ALLOWED {
-				return allowed(responseMetadata), nil
-			}
-		case <-ctx.Done():
-			return denied(responseMetadata), errors.New(base.ErrorCode_ERROR_CODE_CANCELLED.String())
-		}
-	}
-	
-	return denied(responseMetadata), nil
-}
-
-// checkIntersection -
-func checkIntersection(ctx context.Context, functions []CheckFunction, limit int) (*base.PermissionCheckResponse, error) {
-	responseMetadata := &base.PermissionCheckResponseMetadata{}
-	
-	if len(functions) == 0 {
-		return denied(responseMetadata), nil
-	}
-	
-	decisionChan := make(chan CheckResponse, len(functions))
-	cancelCtx, cancel := context.WithCancel(ctx)
-	
-	clean := run(cancelCtx, functions, decisionChan, limit)
-	
-	defer func() {
-		cancel()
-		clean()
-		close(decisionChan)
-	}()
-	
-	for i := 0; i < len(functions); i++ {
-		select {
-		case d := <-decisionChan:
-			responseMetadata = joinResponseMetas(responseMetadata, d.resp.Metadata)
-			if d.err != nil {
-				return denied(responseMetadata), d.err
-			}
-			if d.resp.GetCan() == base.PermissionCheckResponse_RESULT_DENIED {
-				return denied(responseMetadata), nil
-			}
-		case <-ctx.Done():
-			return denied(responseMetadata), errors.New(base.ErrorCode_ERROR_CODE_CANCELLED.String())
-		}
-	}
-	
-	return allowed(responseMetadata), nil
-}
-
-// run -
-func run(ctx context.Context, functions []CheckFunction, decisionChan chan<- CheckResponse, limit int) func() {
-	cl := make(chan struct{}, limit)
-	var wg sync.WaitGroup
-	
-	check := func(child CheckFunction) {
-		result, err := child(ctx)
-		decisionChan <- CheckResponse{
-			resp: result,
-			err:  err,
-		}
-		<-cl
-		wg.Done()
-	}
-	
-	wg.Add(1)
-	go func() {
-	run:
-		for _, fun := range functions {
-			child := fun
-			select {
-			case cl <- struct{}{}:
-				wg.Add(1)
-				go check(child)
-			case <-ctx.Done():
-				break run
-			}
-		}
-		wg.Done()
-	}()
-	
-	return func() {
-		wg.Wait()
-		close(cl)
-	}
-}
-
 // checkFail -
 func checkFail(err error) CheckFunction {
 	return func(ctx context.Context) (*base.PermissionCheckResponse, error) {
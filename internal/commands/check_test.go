@@ -2,15 +2,28 @@ package commands
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"time"
 	
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/structpb"
 	
 	"github.com/adminium/permify/internal/keys"
+	"github.com/adminium/permify/internal/repositories"
 	"github.com/adminium/permify/internal/repositories/mocks"
 	"github.com/adminium/permify/internal/schema"
+	"github.com/adminium/permify/pkg/cache"
+	"github.com/adminium/permify/pkg/cache/ristretto"
 	"github.com/adminium/permify/pkg/database"
 	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/storage"
 	"github.com/adminium/permify/pkg/telemetry"
 	"github.com/adminium/permify/pkg/token"
 	"github.com/adminium/permify/pkg/tuple"
@@ -1035,4 +1048,1190 @@ entity doc {
 			Expect(base.PermissionCheckResponse_RESULT_ALLOWED).Should(Equal(response.GetCan()))
 		})
 	})
+	
+	Context("Check Trace", func() {
+		It("Github Sample: Trace", func() {
+			var err error
+			
+			// SCHEMA
+			
+			schemaReader := new(mocks.SchemaReader)
+			
+			var sch *base.SchemaDefinition
+			sch, err = schema.NewSchemaFromStringDefinitions(true, githubSchema)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			var repository *base.EntityDefinition
+			repository, err = schema.GetEntityByName(sch, "repository")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			schemaReader.On("ReadSchemaDefinition", "t1", "repository", "noop").Return(repository, "noop", nil).Times(2)
+			
+			// RELATIONSHIPS
+			
+			relationshipReader := new(mocks.RelationshipReader)
+			
+			relationshipReader.On("QueryRelationships", "t1", &base.TupleFilter{
+				Entity: &base.EntityFilter{
+					Type: "repository",
+					Ids:  []string{"1"},
+				},
+				Relation: "owner",
+			}, token.NewNoopToken().Encode().String()).Return(database.NewTupleIterator([]*base.Tuple{
+				{
+					Entity: &base.Entity{
+						Type: "repository",
+						Id:   "1",
+					},
+					Relation: "owner",
+					Subject: &base.Subject{
+						Type:     tuple.USER,
+						Id:       "1",
+						Relation: "",
+					},
+				},
+			}...), nil).Times(1)
+			
+			checkCommand, _ = NewCheckCommand(keys.NewNoopCheckCommandKeys(), schemaReader, relationshipReader, telemetry.NewNoopMeter())
+			
+			req := &base.PermissionCheckRequest{
+				TenantId:   "t1",
+				Entity:     &base.Entity{Type: "repository", Id: "1"},
+				Subject:    &base.Subject{Type: tuple.USER, Id: "1"},
+				Permission: "push",
+				Metadata: &base.PermissionCheckRequestMetadata{
+					SnapToken:     token.NewNoopToken().Encode().String(),
+					SchemaVersion: "noop",
+					Exclusion:     false,
+					Depth:         20,
+				},
+			}
+			
+			trace := NewCheckTrace()
+			
+			var response *base.PermissionCheckResponse
+			response, err = checkCommand.Execute(ContextWithCheckTrace(context.Background(), trace), req)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(base.PermissionCheckResponse_RESULT_ALLOWED).Should(Equal(response.GetCan()))
+			
+			steps := trace.Steps()
+			Expect(steps).Should(HaveLen(2))
+			Expect(steps[0].Permission).Should(Equal("owner"))
+			Expect(steps[0].Result).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+			Expect(steps[1].Permission).Should(Equal("push"))
+			Expect(steps[1].Result).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+		})
+	})
+	
+	Context("External Sample: Check", func() {
+		It("External Sample: Case 1", func() {
+			sch, err := schema.NewSchemaFromStringDefinitions(true, `
+entity user {}
+
+entity organization {
+	relation admin @user
+	relation employee @user
+	
+	action view = admin or employee
+}
+`)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			var organization *base.EntityDefinition
+			organization, err = schema.GetEntityByName(sch, "organization")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			schemaReader := new(mocks.SchemaReader)
+			schemaReader.On("ReadSchemaDefinition", "t1", "organization", "noop").Return(organization, "noop", nil)
+			
+			relationshipReader := new(mocks.RelationshipReader)
+			for _, relation := range []string{"admin", "employee"} {
+				relationshipReader.On("QueryRelationships", "t1", &base.TupleFilter{
+					Entity: &base.EntityFilter{
+						Type: "organization",
+						Ids:  []string{"1"},
+					},
+					Relation: relation,
+				}, token.NewNoopToken().Encode().String()).Return(database.NewTupleIterator(), nil)
+			}
+			
+			// the hr system knows user 2 as an employee of every organization
+			var calls int32
+			hr := ExternalResolverFunc(func(ctx context.Context, request *base.PermissionCheckRequest) (bool, error) {
+				atomic.AddInt32(&calls, 1)
+				return request.GetSubject().GetId() == "2", nil
+			})
+
+			c, err := ristretto.New()
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			checkCommand, _ = NewCheckCommand(keys.NewNoopCheckCommandKeys(), schemaReader, relationshipReader, telemetry.NewNoopMeter(), Externals(c, External{
+				EntityType: "organization",
+				Relation:   "employee",
+				Resolver:   hr,
+				Timeout:    time.Second,
+				TTL:        time.Minute,
+			}))
+			
+			check := func(subject string) base.PermissionCheckResponse_Result {
+				response, err := checkCommand.Execute(context.Background(), &base.PermissionCheckRequest{
+					TenantId:   "t1",
+					Entity:     &base.Entity{Type: "organization", Id: "1"},
+					Subject:    &base.Subject{Type: tuple.USER, Id: subject},
+					Permission: "view",
+					Metadata: &base.PermissionCheckRequestMetadata{
+						SnapToken:     token.NewNoopToken().Encode().String(),
+						SchemaVersion: "noop",
+						Depth:         20,
+					},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				return response.GetCan()
+			}
+			
+			Expect(check("2")).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+			Expect(check("3")).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+			Expect(atomic.LoadInt32(&calls)).Should(Equal(int32(2)))
+			
+			c.Wait()
+			Expect(check("2")).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+			Expect(atomic.LoadInt32(&calls)).Should(Equal(int32(2)))
+		})
+		
+		It("External Sample: Case 2", func() {
+			sch, err := schema.NewSchemaFromStringDefinitions(true, `
+entity user {}
+
+entity organization {
+	relation employee @user
+}
+`)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			var organization *base.EntityDefinition
+			organization, err = schema.GetEntityByName(sch, "organization")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			schemaReader := new(mocks.SchemaReader)
+			schemaReader.On("ReadSchemaDefinition", "t1", "organization", "noop").Return(organization, "noop", nil)
+			
+			relationshipReader := new(mocks.RelationshipReader)
+			relationshipReader.On("QueryRelationships", "t1", &base.TupleFilter{
+				Entity: &base.EntityFilter{
+					Type: "organization",
+					Ids:  []string{"1"},
+				},
+				Relation: "employee",
+			}, token.NewNoopToken().Encode().String()).Return(database.NewTupleIterator(), nil)
+			
+			// a hook slower than its timeout fails the check
+			slow := ExternalResolverFunc(func(ctx context.Context, request *base.PermissionCheckRequest) (bool, error) {
+				<-ctx.Done()
+				return false, ctx.Err()
+			})
+			
+			checkCommand, _ = NewCheckCommand(keys.NewNoopCheckCommandKeys(), schemaReader, relationshipReader, telemetry.NewNoopMeter(), Externals(cache.NewNoopCache(), External{
+				EntityType: "organization",
+				Relation:   "employee",
+				Resolver:   slow,
+				Timeout:    10 * time.Millisecond,
+			}))
+			
+			_, err = checkCommand.Execute(context.Background(), &base.PermissionCheckRequest{
+				TenantId:   "t1",
+				Entity:     &base.Entity{Type: "organization", Id: "1"},
+				Subject:    &base.Subject{Type: tuple.USER, Id: "2"},
+				Permission: "employee",
+				Metadata: &base.PermissionCheckRequestMetadata{
+					SnapToken:     token.NewNoopToken().Encode().String(),
+					SchemaVersion: "noop",
+					Depth:         20,
+				},
+			})
+			Expect(err).Should(Equal(errors.New(base.ErrorCode_ERROR_CODE_CANCELLED.String())))
+		})
+		
+		It("External Sample: Case 3", func() {
+			sch, err := schema.NewSchemaFromStringDefinitions(true, `
+entity user {}
+
+entity group {
+	relation member @user
+}
+
+entity document {
+	relation viewer @group#member
+}
+`)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			var group, document *base.EntityDefinition
+			group, err = schema.GetEntityByName(sch, "group")
+			Expect(err).ShouldNot(HaveOccurred())
+			document, err = schema.GetEntityByName(sch, "document")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			schemaReader := new(mocks.SchemaReader)
+			schemaReader.On("ReadSchemaDefinition", "t1", "group", "noop").Return(group, "noop", nil)
+			schemaReader.On("ReadSchemaDefinition", "t1", "document", "noop").Return(document, "noop", nil)
+			
+			relationshipReader := new(mocks.RelationshipReader)
+			for i := 0; i < 2; i++ {
+				relationshipReader.On("QueryRelationships", "t1", &base.TupleFilter{
+					Entity: &base.EntityFilter{
+						Type: "document",
+						Ids:  []string{"1"},
+					},
+					Relation: "viewer",
+				}, token.NewNoopToken().Encode().String()).Return(database.NewTupleIterator([]*base.Tuple{
+					{
+						Entity:   &base.Entity{Type: "document", Id: "1"},
+						Relation: "viewer",
+						Subject:  &base.Subject{Type: "group", Id: "1", Relation: "member"},
+					},
+				}...), nil).Once()
+				relationshipReader.On("QueryRelationships", "t1", &base.TupleFilter{
+					Entity: &base.EntityFilter{
+						Type: "group",
+						Ids:  []string{"1"},
+					},
+					Relation: "member",
+				}, token.NewNoopToken().Encode().String()).Return(database.NewTupleIterator(), nil).Once()
+			}
+			
+			// the directory removes user 2 from the group after the first check
+			var member atomic.Bool
+			member.Store(true)
+			directory := ExternalResolverFunc(func(ctx context.Context, request *base.PermissionCheckRequest) (bool, error) {
+				return member.Load() && request.GetSubject().GetId() == "2", nil
+			})
+			
+			c, err := ristretto.New()
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			checkCommand, _ = NewCheckCommand(keys.NewCheckCommandKeys(c), schemaReader, relationshipReader, telemetry.NewNoopMeter(), Externals(cache.NewNoopCache(), External{
+				EntityType: "group",
+				Relation:   "member",
+				Resolver:   directory,
+			}))
+			
+			check := func() base.PermissionCheckResponse_Result {
+				response, err := checkCommand.Execute(context.Background(), &base.PermissionCheckRequest{
+					TenantId:   "t1",
+					Entity:     &base.Entity{Type: "document", Id: "1"},
+					Subject:    &base.Subject{Type: tuple.USER, Id: "2"},
+					Permission: "viewer",
+					Metadata: &base.PermissionCheckRequestMetadata{
+						SnapToken:     token.NewNoopToken().Encode().String(),
+						SchemaVersion: "noop",
+						Depth:         20,
+					},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				return response.GetCan()
+			}
+			
+			Expect(check()).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+			
+			// the viewer check is answered through the external member relation, so it is not cached by the snapshot
+			c.Wait()
+			member.Store(false)
+			Expect(check()).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+		})
+	})
+	
+	Context("Rule Sample: Check", func() {
+		It("Rule Sample: Case 1", func() {
+			definitions := []string{
+				"entity user {}",
+				"entity repository {\n\tattribute visibility string\n\tattribute archived boolean\n\trelation owner @user\n\taction read = owner or is_public(visibility)\n\taction edit = owner and not is_archived(archived)\n}",
+				"rule is_public(visibility string) {\n\tvisibility == \"public\"\n}",
+				"rule is_archived(archived boolean) {\n\tarchived\n}",
+			}
+			sch, err := schema.NewSchemaFromStringDefinitions(true, definitions...)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			var repository *base.EntityDefinition
+			repository, err = schema.GetEntityByName(sch, "repository")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			schemaReader := new(mocks.SchemaReader)
+			schemaReader.On("ReadSchemaDefinition", "t1", "repository", "noop").Return(repository, "noop", nil)
+			schemaReader.On("ReadSchemaString", "t1", "noop").Return(definitions, nil)
+			
+			relationshipReader := new(mocks.RelationshipReader)
+			relationshipReader.On("QueryRelationships", "t1", &base.TupleFilter{
+				Entity: &base.EntityFilter{
+					Type: "repository",
+					Ids:  []string{"1"},
+				},
+				Relation: "owner",
+			}, token.NewNoopToken().Encode().String()).Return(func(context.Context, string, *base.TupleFilter, string) *database.TupleIterator {
+				return database.NewTupleIterator([]*base.Tuple{
+					{
+						Entity:   &base.Entity{Type: "repository", Id: "1"},
+						Relation: "owner",
+						Subject:  &base.Subject{Type: tuple.USER, Id: "1"},
+					},
+				}...)
+			}, nil)
+			
+			// repository 1 is public and archived, repository 2 has no attributes
+			attributeReader := new(mocks.AttributeReader)
+			attributeReader.On("ReadAttributes", "t1", &base.Entity{Type: "repository", Id: "1"}).Return(map[string]*structpb.Value{
+				"visibility": structpb.NewStringValue("public"),
+				"archived":   structpb.NewBoolValue(true),
+			}, nil)
+			
+			checkCommand, _ = NewCheckCommand(keys.NewNoopCheckCommandKeys(), schemaReader, relationshipReader, telemetry.NewNoopMeter(), Attributes(attributeReader))
+			
+			check := func(permission, subject string) base.PermissionCheckResponse_Result {
+				response, err := checkCommand.Execute(context.Background(), &base.PermissionCheckRequest{
+					TenantId:   "t1",
+					Entity:     &base.Entity{Type: "repository", Id: "1"},
+					Subject:    &base.Subject{Type: tuple.USER, Id: subject},
+					Permission: permission,
+					Metadata: &base.PermissionCheckRequestMetadata{
+						SnapToken:     token.NewNoopToken().Encode().String(),
+						SchemaVersion: "noop",
+						Depth:         20,
+					},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				return response.GetCan()
+			}
+			
+			Expect(check("read", "2")).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+			Expect(check("edit", "1")).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+			
+			attributeReader.ExpectedCalls = nil
+			attributeReader.On("ReadAttributes", "t1", &base.Entity{Type: "repository", Id: "1"}).Return(map[string]*structpb.Value{}, nil)
+			
+			// an attribute without a value does not satisfy the rule
+			Expect(check("read", "2")).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+			Expect(check("edit", "1")).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+		})
+	})
+	
+	Context("Caveat Sample: Check", func() {
+		It("Caveat Sample: Case 1", func() {
+			definitions := []string{
+				"entity user {}",
+				"entity team {\n\trelation member @user\n}",
+				"entity doc {\n\trelation owner @user\n\trelation viewer @user @team#member\n\taction view = owner or viewer with ip_allowed\n}",
+				"caveat ip_allowed \"context.ip == payload.ip\"",
+			}
+			sch, err := schema.NewSchemaFromStringDefinitions(true, definitions...)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			var doc, team *base.EntityDefinition
+			doc, err = schema.GetEntityByName(sch, "doc")
+			Expect(err).ShouldNot(HaveOccurred())
+			team, err = schema.GetEntityByName(sch, "team")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			schemaReader := new(mocks.SchemaReader)
+			schemaReader.On("ReadSchemaDefinition", "t1", "doc", "noop").Return(doc, "noop", nil)
+			schemaReader.On("ReadSchemaDefinition", "t1", "team", "noop").Return(team, "noop", nil)
+			schemaReader.On("ReadSchemaString", "t1", "noop").Return(definitions, nil)
+			
+			payload := func(ip string) *structpb.Struct {
+				p, err := structpb.NewStruct(map[string]interface{}{"ip": ip})
+				Expect(err).ShouldNot(HaveOccurred())
+				return p
+			}
+			
+			// user 2 views from 10.0.0.1, the members of team 1 from 10.0.0.2 and user 3 from anywhere without a payload
+			tuples := map[string][]*base.Tuple{
+				"doc#owner": {},
+				"doc#viewer": {
+					{
+						Entity:   &base.Entity{Type: "doc", Id: "1"},
+						Relation: "viewer",
+						Subject:  &base.Subject{Type: tuple.USER, Id: "2"},
+						Payload:  payload("10.0.0.1"),
+					},
+					{
+						Entity:   &base.Entity{Type: "doc", Id: "1"},
+						Relation: "viewer",
+						Subject:  &base.Subject{Type: "team", Id: "1", Relation: "member"},
+						Payload:  payload("10.0.0.2"),
+					},
+					{
+						Entity:   &base.Entity{Type: "doc", Id: "1"},
+						Relation: "viewer",
+						Subject:  &base.Subject{Type: tuple.USER, Id: "3"},
+					},
+				},
+				"team#member": {
+					{
+						Entity:   &base.Entity{Type: "team", Id: "1"},
+						Relation: "member",
+						Subject:  &base.Subject{Type: tuple.USER, Id: "4"},
+					},
+				},
+			}
+			
+			relationshipReader := new(mocks.RelationshipReader)
+			for key := range tuples {
+				key := key
+				entityType, relation, _ := strings.Cut(key, "#")
+				relationshipReader.On("QueryRelationships", "t1", &base.TupleFilter{
+					Entity: &base.EntityFilter{
+						Type: entityType,
+						Ids:  []string{"1"},
+					},
+					Relation: relation,
+				}, token.NewNoopToken().Encode().String()).Return(func(context.Context, string, *base.TupleFilter, string) *database.TupleIterator {
+					return database.NewTupleIterator(tuples[key]...)
+				}, nil)
+			}
+			
+			checkCommand, _ = NewCheckCommand(keys.NewNoopCheckCommandKeys(), schemaReader, relationshipReader, telemetry.NewNoopMeter())
+			
+			check := func(subject, ip string) base.PermissionCheckResponse_Result {
+				request := &base.PermissionCheckRequest{
+					TenantId:   "t1",
+					Entity:     &base.Entity{Type: "doc", Id: "1"},
+					Subject:    &base.Subject{Type: tuple.USER, Id: subject},
+					Permission: "view",
+					Metadata: &base.PermissionCheckRequestMetadata{
+						SnapToken:     token.NewNoopToken().Encode().String(),
+						SchemaVersion: "noop",
+						Depth:         20,
+					},
+				}
+				if ip != "" {
+					request.Context = payload(ip)
+				}
+				response, err := checkCommand.Execute(context.Background(), request)
+				Expect(err).ShouldNot(HaveOccurred())
+				return response.GetCan()
+			}
+			
+			Expect(check("2", "10.0.0.1")).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+			Expect(check("2", "10.0.0.2")).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+			Expect(check("4", "10.0.0.2")).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+			Expect(check("4", "10.0.0.1")).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+			
+			// a caveat that reads a key the context or the payload does not have is not satisfied
+			Expect(check("2", "")).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+			Expect(check("3", "10.0.0.1")).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+		})
+	})
+	
+	Context("Condition Sample: Check", func() {
+		It("Condition Sample: Case 1", func() {
+			sch, err := schema.NewSchemaFromStringDefinitions(true, `
+entity user {}
+
+entity doc {
+	relation owner @user
+
+	action view = owner when "context.hour >= 9 && context.hour < 18"
+	action edit = owner when "entity.id != 'archived'"
+}
+`)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			var doc *base.EntityDefinition
+			doc, err = schema.GetEntityByName(sch, "doc")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			schemaReader := new(mocks.SchemaReader)
+			schemaReader.On("ReadSchemaDefinition", "t1", "doc", "noop").Return(doc, "noop", nil)
+			
+			relationshipReader := new(mocks.RelationshipReader)
+			for _, id := range []string{"1", "archived"} {
+				id := id
+				relationshipReader.On("QueryRelationships", "t1", &base.TupleFilter{
+					Entity: &base.EntityFilter{
+						Type: "doc",
+						Ids:  []string{id},
+					},
+					Relation: "owner",
+				}, token.NewNoopToken().Encode().String()).Return(func(context.Context, string, *base.TupleFilter, string) *database.TupleIterator {
+					return database.NewTupleIterator([]*base.Tuple{
+						{
+							Entity:   &base.Entity{Type: "doc", Id: id},
+							Relation: "owner",
+							Subject:  &base.Subject{Type: tuple.USER, Id: "1"},
+						},
+					}...)
+				}, nil)
+			}
+			
+			checkCommand, _ = NewCheckCommand(keys.NewNoopCheckCommandKeys(), schemaReader, relationshipReader, telemetry.NewNoopMeter())
+			
+			check := func(entity, permission string, data map[string]interface{}) base.PermissionCheckResponse_Result {
+				request := &base.PermissionCheckRequest{
+					TenantId:   "t1",
+					Entity:     &base.Entity{Type: "doc", Id: entity},
+					Subject:    &base.Subject{Type: tuple.USER, Id: "1"},
+					Permission: permission,
+					Metadata: &base.PermissionCheckRequestMetadata{
+						SnapToken:     token.NewNoopToken().Encode().String(),
+						SchemaVersion: "noop",
+						Depth:         20,
+					},
+				}
+				if data != nil {
+					request.Context, err = structpb.NewStruct(data)
+					Expect(err).ShouldNot(HaveOccurred())
+				}
+				response, err := checkCommand.Execute(context.Background(), request)
+				Expect(err).ShouldNot(HaveOccurred())
+				return response.GetCan()
+			}
+			
+			Expect(check("1", "view", map[string]interface{}{"hour": 10})).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+			Expect(check("1", "view", map[string]interface{}{"hour": 20})).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+			// a condition that reads a key the context does not have is not satisfied
+			Expect(check("1", "view", nil)).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+			
+			Expect(check("1", "edit", nil)).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+			Expect(check("archived", "edit", nil)).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+		})
+	})
+	
+	Context("Wildcard Sample: Check", func() {
+		It("Wildcard Sample: Case 1", func() {
+			sch, err := schema.NewSchemaFromStringDefinitions(true, `
+entity user {}
+
+entity doc {
+	relation viewer @user
+	
+	action read = viewer
+}
+`)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			var doc *base.EntityDefinition
+			doc, err = schema.GetEntityByName(sch, "doc")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			schemaReader := new(mocks.SchemaReader)
+			schemaReader.On("ReadSchemaDefinition", "t1", "doc", "noop").Return(doc, "noop", nil)
+			schemaReader.On("ReadSchemaDefinition", "t2", "doc", "noop").Return(doc, "noop", nil)
+			
+			// every user can read the public doc of both tenants
+			relationshipReader := new(mocks.RelationshipReader)
+			for _, tenantID := range []string{"t1", "t2"} {
+				relationshipReader.On("QueryRelationships", tenantID, &base.TupleFilter{
+					Entity: &base.EntityFilter{
+						Type: "doc",
+						Ids:  []string{"1"},
+					},
+					Relation: "viewer",
+				}, token.NewNoopToken().Encode().String()).Return(func(context.Context, string, *base.TupleFilter, string) *database.TupleIterator {
+					return database.NewTupleIterator(&base.Tuple{
+						Entity:   &base.Entity{Type: "doc", Id: "1"},
+						Relation: "viewer",
+						Subject:  &base.Subject{Type: tuple.USER, Id: tuple.WILDCARD},
+					})
+				}, nil)
+			}
+			
+			// only the first tenant has turned the wildcards on
+			settingsReader := new(mocks.TenantSettingsReader)
+			settingsReader.On("ReadTenantSettings", "t1").Return(repositories.TenantSettings{StrictValidation: true, Wildcards: true}, nil)
+			settingsReader.On("ReadTenantSettings", "t2").Return(storage.DefaultTenantSettings(), nil)
+			
+			checkCommand, _ = NewCheckCommand(keys.NewNoopCheckCommandKeys(), schemaReader, relationshipReader, telemetry.NewNoopMeter(), TenantSettings(settingsReader))
+			
+			check := func(tenantID string) base.PermissionCheckResponse_Result {
+				response, err := checkCommand.Execute(context.Background(), &base.PermissionCheckRequest{
+					TenantId:   tenantID,
+					Entity:     &base.Entity{Type: "doc", Id: "1"},
+					Subject:    &base.Subject{Type: tuple.USER, Id: "2"},
+					Permission: "read",
+					Metadata: &base.PermissionCheckRequestMetadata{
+						SnapToken:     token.NewNoopToken().Encode().String(),
+						SchemaVersion: "noop",
+						Depth:         20,
+					},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				return response.GetCan()
+			}
+			
+			Expect(check("t1")).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+			Expect(check("t2")).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+		})
+	})
+	
+	Context("Action References: Check", func() {
+		It("Action References: Case 1", func() {
+			sch, err := schema.NewSchemaFromStringDefinitions(true, `
+entity user {}
+
+entity doc {
+	relation owner @user
+	relation admin @user
+	
+	action edit = owner
+	action delete = edit or admin
+	action purge = delete and admin
+}
+`)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			var doc *base.EntityDefinition
+			doc, err = schema.GetEntityByName(sch, "doc")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			schemaReader := new(mocks.SchemaReader)
+			schemaReader.On("ReadSchemaDefinition", "t1", "doc", "noop").Return(doc, "noop", nil)
+			
+			// user 1 owns the doc, user 2 administrates it
+			relationshipReader := new(mocks.RelationshipReader)
+			for relation, id := range map[string]string{"owner": "1", "admin": "2"} {
+				relation, id := relation, id
+				relationshipReader.On("QueryRelationships", "t1", &base.TupleFilter{
+					Entity: &base.EntityFilter{
+						Type: "doc",
+						Ids:  []string{"1"},
+					},
+					Relation: relation,
+				}, token.NewNoopToken().Encode().String()).Return(func(context.Context, string, *base.TupleFilter, string) *database.TupleIterator {
+					return database.NewTupleIterator(&base.Tuple{
+						Entity:   &base.Entity{Type: "doc", Id: "1"},
+						Relation: relation,
+						Subject:  &base.Subject{Type: tuple.USER, Id: id},
+					})
+				}, nil)
+			}
+			
+			checkCommand, _ = NewCheckCommand(keys.NewNoopCheckCommandKeys(), schemaReader, relationshipReader, telemetry.NewNoopMeter())
+			
+			check := func(permission, subject string) base.PermissionCheckResponse_Result {
+				response, err := checkCommand.Execute(context.Background(), &base.PermissionCheckRequest{
+					TenantId:   "t1",
+					Entity:     &base.Entity{Type: "doc", Id: "1"},
+					Subject:    &base.Subject{Type: tuple.USER, Id: subject},
+					Permission: permission,
+					Metadata: &base.PermissionCheckRequestMetadata{
+						SnapToken:     token.NewNoopToken().Encode().String(),
+						SchemaVersion: "noop",
+						Depth:         20,
+					},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				return response.GetCan()
+			}
+			
+			Expect(check("delete", "1")).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+			Expect(check("delete", "2")).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+			Expect(check("delete", "3")).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+			Expect(check("purge", "1")).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+			Expect(check("purge", "2")).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+		})
+	})
+	
+	Context("Policy Sample: Check", func() {
+		It("Policy Sample: Case 1", func() {
+			definitions := []string{
+				"entity user {}",
+				"entity doc {\n\trelation owner @user\n\trelation admin @user\n\topa \"authz/doc/edit\" action edit = owner\n\topa \"authz/doc/share\" or action share = owner\n\topa \"authz/doc/purge\" override action purge = admin\n}",
+			}
+			sch, err := schema.NewSchemaFromStringDefinitions(true, definitions...)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			var doc *base.EntityDefinition
+			doc, err = schema.GetEntityByName(sch, "doc")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			schemaReader := new(mocks.SchemaReader)
+			schemaReader.On("ReadSchemaDefinition", "t1", "doc", "noop").Return(doc, "noop", nil)
+			schemaReader.On("ReadSchemaString", "t1", "noop").Return(definitions, nil)
+			
+			// user 1 owns the doc, user 2 administrates it
+			relationshipReader := new(mocks.RelationshipReader)
+			for relation, id := range map[string]string{"owner": "1", "admin": "2"} {
+				relation, id := relation, id
+				relationshipReader.On("QueryRelationships", "t1", &base.TupleFilter{
+					Entity: &base.EntityFilter{
+						Type: "doc",
+						Ids:  []string{"1"},
+					},
+					Relation: relation,
+				}, token.NewNoopToken().Encode().String()).Return(func(context.Context, string, *base.TupleFilter, string) *database.TupleIterator {
+					return database.NewTupleIterator(&base.Tuple{
+						Entity:   &base.Entity{Type: "doc", Id: "1"},
+						Relation: relation,
+						Subject:  &base.Subject{Type: tuple.USER, Id: id},
+					})
+				}, nil)
+			}
+			
+			// edits are frozen, user 3 may share and purging inverts the action
+			var evaluations int32
+			evaluator := PolicyEvaluatorFunc(func(_ context.Context, path string, input map[string]interface{}) (bool, error) {
+				atomic.AddInt32(&evaluations, 1)
+				Expect(input["trace"]).ShouldNot(BeEmpty())
+				switch path {
+				case "authz/doc/edit":
+					return false, nil
+				case "authz/doc/share":
+					return input["subject"].(map[string]interface{})["id"] == "3", nil
+				case "authz/doc/purge":
+					return !input["allowed"].(bool), nil
+				}
+				return false, errors.New("unknown policy")
+			})
+
+			checkCommand, _ = NewCheckCommand(keys.NewNoopCheckCommandKeys(), schemaReader, relationshipReader, telemetry.NewNoopMeter(), Policies(evaluator))
+			
+			check := func(permission, subject string) base.PermissionCheckResponse_Result {
+				response, err := checkCommand.Execute(context.Background(), &base.PermissionCheckRequest{
+					TenantId:   "t1",
+					Entity:     &base.Entity{Type: "doc", Id: "1"},
+					Subject:    &base.Subject{Type: tuple.USER, Id: subject},
+					Permission: permission,
+					Metadata: &base.PermissionCheckRequestMetadata{
+						SnapToken:     token.NewNoopToken().Encode().String(),
+						SchemaVersion: "noop",
+						Depth:         20,
+					},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				return response.GetCan()
+			}
+			
+			Expect(check("edit", "1")).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+			Expect(atomic.LoadInt32(&evaluations)).Should(Equal(int32(1)))
+			// a denied action is not evaluated in and mode
+			Expect(check("edit", "2")).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+			Expect(atomic.LoadInt32(&evaluations)).Should(Equal(int32(1)))
+			
+			// an allowed action is not evaluated in or mode
+			Expect(check("share", "1")).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+			Expect(atomic.LoadInt32(&evaluations)).Should(Equal(int32(1)))
+			Expect(check("share", "3")).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+			Expect(check("share", "2")).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+			
+			Expect(check("purge", "1")).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+			Expect(check("purge", "2")).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+		})
+		
+		It("Policy Sample: Case 2", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var body struct {
+					Input map[string]interface{} `json:"input"`
+				}
+				Expect(json.NewDecoder(r.Body).Decode(&body)).Should(Succeed())
+				switch r.URL.Path {
+				case "/v1/data/authz/doc/edit":
+					_, _ = w.Write([]byte(`{"result": ` + fmt.Sprint(body.Input["allowed"]) + `}`))
+				case "/v1/data/authz/doc/undefined":
+					_, _ = w.Write([]byte(`{}`))
+				default:
+					_, _ = w.Write([]byte(`{"result": "yes"}`))
+				}
+			}))
+			defer server.Close()
+			
+			evaluator := NewOPAPolicyEvaluator(server.URL+"/", time.Second)
+			
+			can, err := evaluator.Evaluate(context.Background(), "authz/doc/edit", map[string]interface{}{"allowed": true})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(can).Should(BeTrue())
+			
+			can, err = evaluator.Evaluate(context.Background(), "authz/doc/edit", map[string]interface{}{"allowed": false})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(can).Should(BeFalse())
+			
+			can, err = evaluator.Evaluate(context.Background(), "authz/doc/undefined", nil)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(can).Should(BeFalse())
+			
+			_, err = evaluator.Evaluate(context.Background(), "authz/doc/other", nil)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+	
+	Context("Relation Chains: Check", func() {
+		It("Relation Chains: Case 1", func() {
+			sch, err := schema.NewSchemaFromStringDefinitions(true, `
+entity user {}
+
+entity holding {
+	relation admin @user
+}
+
+entity organization {
+	relation parent @holding
+}
+
+entity repository {
+	relation parent @organization
+	
+	action update = parent.parent.admin
+}
+`)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			schemaReader := new(mocks.SchemaReader)
+			for _, name := range []string{"holding", "organization", "repository"} {
+				var en *base.EntityDefinition
+				en, err = schema.GetEntityByName(sch, name)
+				Expect(err).ShouldNot(HaveOccurred())
+				schemaReader.On("ReadSchemaDefinition", "t1", name, "noop").Return(en, "noop", nil)
+			}
+			
+			// repository 1 belongs to organization 1 of holding 1, user 1 administrates the holding
+			relationshipReader := new(mocks.RelationshipReader)
+			for _, tup := range []string{"repository:1#parent@organization:1", "organization:1#parent@holding:1", "holding:1#admin@user:1"} {
+				t, err := tuple.Tuple(tup)
+				Expect(err).ShouldNot(HaveOccurred())
+				relationshipReader.On("QueryRelationships", "t1", &base.TupleFilter{
+					Entity: &base.EntityFilter{
+						Type: t.GetEntity().GetType(),
+						Ids:  []string{t.GetEntity().GetId()},
+					},
+					Relation: t.GetRelation(),
+				}, token.NewNoopToken().Encode().String()).Return(func(context.Context, string, *base.TupleFilter, string) *database.TupleIterator {
+					return database.NewTupleIterator(t)
+				}, nil)
+			}
+			
+			checkCommand, _ = NewCheckCommand(keys.NewNoopCheckCommandKeys(), schemaReader, relationshipReader, telemetry.NewNoopMeter())
+			
+			check := func(subject string) base.PermissionCheckResponse_Result {
+				response, err := checkCommand.Execute(context.Background(), &base.PermissionCheckRequest{
+					TenantId:   "t1",
+					Entity:     &base.Entity{Type: "repository", Id: "1"},
+					Subject:    &base.Subject{Type: tuple.USER, Id: subject},
+					Permission: "update",
+					Metadata: &base.PermissionCheckRequestMetadata{
+						SnapToken:     token.NewNoopToken().Encode().String(),
+						SchemaVersion: "noop",
+						Depth:         20,
+					},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				return response.GetCan()
+			}
+			
+			Expect(check("1")).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+			Expect(check("2")).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+		})
+	})
+	
+	Context("Depth: Check", func() {
+		It("Depth: Case 1", func() {
+			sch, err := schema.NewSchemaFromStringDefinitions(true, `
+entity user {}
+
+entity team {
+	relation member @user @team#member
+}
+
+entity doc {
+	relation owner @user
+	relation viewer @team#member
+	relation banned @team#member
+	
+	action view = owner or viewer
+	action edit = viewer and owner
+	action read = viewer and not banned
+}
+`)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			schemaReader := new(mocks.SchemaReader)
+			for _, name := range []string{"team", "doc"} {
+				var en *base.EntityDefinition
+				en, err = schema.GetEntityByName(sch, name)
+				Expect(err).ShouldNot(HaveOccurred())
+				schemaReader.On("ReadSchemaDefinition", "t1", name, "noop").Return(en, "noop", nil)
+			}
+			
+			// user 1 is a member of team 3, a member of team 2, a member of team 1, the viewers of doc 1
+			tuples := map[string][]string{
+				"doc:1#owner":   {"doc:1#owner@user:2"},
+				"doc:1#viewer":  {"doc:1#viewer@team:1#member"},
+				"doc:1#banned":  {"doc:1#banned@team:3#member"},
+				"team:1#member": {"team:1#member@team:2#member"},
+				"team:2#member": {"team:2#member@team:3#member"},
+				"team:3#member": {"team:3#member@user:1"},
+			}
+			relationshipReader := new(mocks.RelationshipReader)
+			for key, tups := range tuples {
+				ear, err := tuple.EAR(key)
+				Expect(err).ShouldNot(HaveOccurred())
+				var collection []*base.Tuple
+				for _, tup := range tups {
+					t, err := tuple.Tuple(tup)
+					Expect(err).ShouldNot(HaveOccurred())
+					collection = append(collection, t)
+				}
+				relationshipReader.On("QueryRelationships", "t1", &base.TupleFilter{
+					Entity: &base.EntityFilter{
+						Type: ear.GetEntity().GetType(),
+						Ids:  []string{ear.GetEntity().GetId()},
+					},
+					Relation: ear.GetRelation(),
+				}, token.NewNoopToken().Encode().String()).Return(func(context.Context, string, *base.TupleFilter, string) *database.TupleIterator {
+					return database.NewTupleIterator(collection...)
+				}, nil)
+			}
+			
+			checkCommand, _ = NewCheckCommand(keys.NewNoopCheckCommandKeys(), schemaReader, relationshipReader, telemetry.NewNoopMeter())
+			
+			check := func(permission, subject string, depth int32) *base.PermissionCheckResponse {
+				response, err := checkCommand.Execute(context.Background(), &base.PermissionCheckRequest{
+					TenantId:   "t1",
+					Entity:     &base.Entity{Type: "doc", Id: "1"},
+					Subject:    &base.Subject{Type: tuple.USER, Id: subject},
+					Permission: permission,
+					Metadata: &base.PermissionCheckRequestMetadata{
+						SnapToken:     token.NewNoopToken().Encode().String(),
+						SchemaVersion: "noop",
+						Depth:         depth,
+					},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				return response
+			}
+			
+			// doc, its viewers and the three teams take five levels
+			response := check("view", "1", 5)
+			Expect(response.GetCan()).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+			Expect(response.GetMetadata().GetRemainingDepth()).Should(Equal(int32(1)))
+			
+			response = check("view", "1", 4)
+			Expect(response.GetCan()).Should(Equal(base.PermissionCheckResponse_RESULT_DEPTH_EXCEEDED))
+			Expect(response.GetMetadata().GetRemainingDepth()).Should(Equal(int32(0)))
+			
+			// the branch that is decided within the depth decides the check
+			Expect(check("view", "2", 4).GetCan()).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+			Expect(check("edit", "1", 4).GetCan()).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+			Expect(check("read", "1", 4).GetCan()).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+			
+			// user 3 is not banned, whether it views the doc is past the depth
+			Expect(check("read", "3", 4).GetCan()).Should(Equal(base.PermissionCheckResponse_RESULT_DEPTH_EXCEEDED))
+			Expect(check("read", "3", 5).GetCan()).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+		})
+	})
+	
+	Context("Transitive Relations: Check", func() {
+		It("Transitive Relations: Case 1", func() {
+			sch, err := schema.NewSchemaFromStringDefinitions(true, `
+entity user {}
+
+entity folder {
+	relation parent @folder
+	relation viewer @user
+	
+	action view = viewer or parent*.viewer
+}
+`)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			folder, err := schema.GetEntityByName(sch, "folder")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			schemaReader := new(mocks.SchemaReader)
+			schemaReader.On("ReadSchemaDefinition", "t1", "folder", "noop").Return(folder, "noop", nil)
+			
+			// folder 3 is in folder 2, which is in folder 1, which is in folder 3 again; user 1 views folder 1
+			tuples := map[string][]string{
+				"folder:3#parent": {"folder:3#parent@folder:2"},
+				"folder:2#parent": {"folder:2#parent@folder:1"},
+				"folder:1#parent": {"folder:1#parent@folder:3"},
+				"folder:1#viewer": {"folder:1#viewer@user:1"},
+			}
+			relationshipReader := new(mocks.RelationshipReader)
+			for _, id := range []string{"1", "2", "3"} {
+				for _, relation := range []string{"parent", "viewer"} {
+					var collection []*base.Tuple
+					for _, tup := range tuples[fmt.Sprintf("folder:%s#%s", id, relation)] {
+						t, err := tuple.Tuple(tup)
+						Expect(err).ShouldNot(HaveOccurred())
+						collection = append(collection, t)
+					}
+					relationshipReader.On("QueryRelationships", "t1", &base.TupleFilter{
+						Entity: &base.EntityFilter{
+							Type: "folder",
+							Ids:  []string{id},
+						},
+						Relation: relation,
+					}, token.NewNoopToken().Encode().String()).Return(func(context.Context, string, *base.TupleFilter, string) *database.TupleIterator {
+						return database.NewTupleIterator(collection...)
+					}, nil)
+				}
+			}
+			
+			checkCommand, _ = NewCheckCommand(keys.NewNoopCheckCommandKeys(), schemaReader, relationshipReader, telemetry.NewNoopMeter())
+			
+			check := func(subject string, depth int32) (base.PermissionCheckResponse_Result, error) {
+				response, err := checkCommand.Execute(context.Background(), &base.PermissionCheckRequest{
+					TenantId:   "t1",
+					Entity:     &base.Entity{Type: "folder", Id: "3"},
+					Subject:    &base.Subject{Type: tuple.USER, Id: subject},
+					Permission: "view",
+					Metadata: &base.PermissionCheckRequestMetadata{
+						SnapToken:     token.NewNoopToken().Encode().String(),
+						SchemaVersion: "noop",
+						Depth:         depth,
+					},
+				})
+				return response.GetCan(), err
+			}
+			
+			can, err := check("1", 20)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(can).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+			
+			// the cycle ends the walk
+			can, err = check("2", 20)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(can).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+			
+			// the hierarchy is deeper than the depth, the check is neither allowed nor denied
+			can, err = check("1", 2)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(can).Should(Equal(base.PermissionCheckResponse_RESULT_DEPTH_EXCEEDED))
+		})
+	})
+	
+	Context("Subject Type Restrictions: Check", func() {
+		It("Subject Type Restrictions: Case 1", func() {
+			sch, err := schema.NewSchemaFromStringDefinitions(true, `
+entity user {}
+
+entity team {
+	relation member @user
+}
+
+entity organization {
+	relation member @user
+}
+
+entity repository {
+	relation parent @organization
+	relation owner @user @team#member
+	
+	action edit = owner or parent.member
+}
+`)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			repository, err := schema.GetEntityByName(sch, "repository")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			schemaReader := new(mocks.SchemaReader)
+			schemaReader.On("ReadSchema", "t1", "noop").Return(sch, nil)
+			schemaReader.On("ReadSchemaDefinition", "t1", "repository", "noop").Return(repository, "noop", nil)
+			
+			// the relationship reader has no expectations, the denied checks must not read any tuple
+			relationshipReader := new(mocks.RelationshipReader)
+			
+			checkCommand, _ = NewCheckCommand(keys.NewNoopCheckCommandKeys(), schemaReader, relationshipReader, telemetry.NewNoopMeter(), SubjectTypeRestrictions())
+			
+			check := func(subject *base.Subject) base.PermissionCheckResponse_Result {
+				response, err := checkCommand.Execute(context.Background(), &base.PermissionCheckRequest{
+					TenantId:   "t1",
+					Entity:     &base.Entity{Type: "repository", Id: "1"},
+					Subject:    subject,
+					Permission: "edit",
+					Metadata: &base.PermissionCheckRequestMetadata{
+						SnapToken:     token.NewNoopToken().Encode().String(),
+						SchemaVersion: "noop",
+						Depth:         20,
+					},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				return response.GetCan()
+			}
+			
+			// organizations are only walked by parent, teams are compared as team#member only
+			Expect(check(&base.Subject{Type: "organization", Id: "1"})).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+			Expect(check(&base.Subject{Type: "team", Id: "1"})).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+			Expect(check(&base.Subject{Type: "team", Id: "1", Relation: "admin"})).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+			relationshipReader.AssertNotCalled(GinkgoT(), "QueryRelationships")
+			
+			types, unrestricted := schema.SubjectTypes(sch, "repository", "edit")
+			Expect(unrestricted).Should(BeFalse())
+			Expect(types).Should(Equal([]string{"team#member", "user"}))
+		})
+	})
+	
+	Context("Consistency: Check", func() {
+		It("Consistency: Case 1", func() {
+			sch, err := schema.NewSchemaFromStringDefinitions(true, `
+entity user {}
+
+entity doc {
+	relation owner @user
+}
+`)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			doc, err := schema.GetEntityByName(sch, "doc")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			schemaReader := new(mocks.SchemaReader)
+			schemaReader.On("ReadSchemaDefinition", "t1", "doc", "noop").Return(doc, "noop", nil)
+			
+			t, err := tuple.Tuple("doc:1#owner@user:1")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			relationshipReader := new(mocks.RelationshipReader)
+			relationshipReader.On("HeadSnapshot", "t1").Return(token.NewNoopToken(), nil)
+			relationshipReader.On("QueryRelationships", "t1", &base.TupleFilter{
+				Entity:   &base.EntityFilter{Type: "doc", Ids: []string{"1"}},
+				Relation: "owner",
+			}, token.NewNoopToken().Encode().String()).Return(func(context.Context, string, *base.TupleFilter, string) *database.TupleIterator {
+				return database.NewTupleIterator(t)
+			}, nil)
+			
+			checkCommand, _ = NewCheckCommand(keys.NewNoopCheckCommandKeys(), schemaReader, relationshipReader, telemetry.NewNoopMeter(), MinimizeLatencyStaleness(time.Hour))
+			
+			check := func(consistency base.PermissionCheckRequestMetadata_Consistency, snap string) (base.PermissionCheckResponse_Result, error) {
+				response, err := checkCommand.Execute(context.Background(), &base.PermissionCheckRequest{
+					TenantId:   "t1",
+					Entity:     &base.Entity{Type: "doc", Id: "1"},
+					Subject:    &base.Subject{Type: tuple.USER, Id: "1"},
+					Permission: "owner",
+					Metadata: &base.PermissionCheckRequestMetadata{
+						SnapToken:     snap,
+						SchemaVersion: "noop",
+						Depth:         20,
+						Consistency:   consistency,
+					},
+				})
+				return response.GetCan(), err
+			}
+			
+			// the head snapshot is read once and reused, the snap token is ignored
+			for i := 0; i < 3; i++ {
+				can, err := check(base.PermissionCheckRequestMetadata_CONSISTENCY_MINIMIZE_LATENCY, "stale")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(can).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+			}
+			relationshipReader.AssertNumberOfCalls(GinkgoT(), "HeadSnapshot", 1)
+			
+			// every fully consistent check reads the head snapshot
+			for i := 0; i < 2; i++ {
+				can, err := check(base.PermissionCheckRequestMetadata_CONSISTENCY_FULLY_CONSISTENT, "stale")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(can).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+			}
+			relationshipReader.AssertNumberOfCalls(GinkgoT(), "HeadSnapshot", 3)
+			
+			can, err := check(base.PermissionCheckRequestMetadata_CONSISTENCY_AT_LEAST_AS_FRESH, token.NewNoopToken().Encode().String())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(can).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+			relationshipReader.AssertNumberOfCalls(GinkgoT(), "HeadSnapshot", 3)
+			
+			_, err = check(base.PermissionCheckRequestMetadata_CONSISTENCY_AT_LEAST_AS_FRESH, "")
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_VALIDATION.String()))
+			
+			_, err = check(base.PermissionCheckRequestMetadata_Consistency(9), "")
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_VALIDATION.String()))
+		})
+	})
 })
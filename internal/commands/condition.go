@@ -0,0 +1,30 @@
+package commands
+
+import (
+	"context"
+	
+	"go.opentelemetry.io/otel/attribute"
+	otelCodes "go.opentelemetry.io/otel/codes"
+	
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// checkCondition - Evaluates the condition of the action with the context, the entity and the subject of the check
+func (command *CheckCommand) checkCondition(ctx context.Context, request *base.PermissionCheckRequest, condition *base.Condition) CheckFunction {
+	return func(ctx context.Context) (*base.PermissionCheckResponse, error) {
+		ctx, span := tracer.Start(ctx, "permissions.check.condition")
+		defer span.End()
+		span.SetAttributes(attribute.String("condition", condition.GetExpression()))
+		
+		can, err := command.conditions.Evaluate(condition.GetExpression(), checkContextFromContext(ctx), request.GetEntity(), request.GetSubject())
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return denied(&base.PermissionCheckResponseMetadata{}), err
+		}
+		if can {
+			return allowed(&base.PermissionCheckResponseMetadata{}), nil
+		}
+		return denied(&base.PermissionCheckResponseMetadata{}), nil
+	}
+}
@@ -0,0 +1,91 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+	
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/token"
+)
+
+const (
+	_defaultMinimizeLatencyStaleness = 5 * time.Second
+)
+
+// MinimizeLatencyStaleness - Defines how long a head snapshot is reused by the checks that minimize latency
+func MinimizeLatencyStaleness(staleness time.Duration) CheckOption {
+	return func(command *CheckCommand) {
+		command.staleness = staleness
+	}
+}
+
+// snapshots - Recently read head snapshots of the tenants
+type snapshots struct {
+	mu      sync.Mutex
+	tenants map[string]recentSnapshot
+}
+
+// recentSnapshot -
+type recentSnapshot struct {
+	token  token.EncodedSnapToken
+	readAt time.Time
+}
+
+// selectSnapshot - Sets the snap token of the request by its consistency mode, after the zookies of zanzibar. It
+// reports whether the cached result of the request may be read.
+func (command *CheckCommand) selectSnapshot(ctx context.Context, request *base.PermissionCheckRequest, consistency base.PermissionCheckRequestMetadata_Consistency) (bool, error) {
+	switch consistency {
+	case base.PermissionCheckRequestMetadata_CONSISTENCY_MINIMIZE_LATENCY:
+		st, err := command.recentSnapshot(ctx, request.GetTenantId())
+		if err != nil {
+			return false, err
+		}
+		request.Metadata.SnapToken = st.String()
+		return true, nil
+	case base.PermissionCheckRequestMetadata_CONSISTENCY_AT_LEAST_AS_FRESH:
+		if request.GetMetadata().GetSnapToken() == "" {
+			return false, errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+		}
+		// the token itself is as fresh as it requires and keeps the results of its checks cacheable
+		return true, nil
+	case base.PermissionCheckRequestMetadata_CONSISTENCY_FULLY_CONSISTENT:
+		st, err := command.relationshipReader.HeadSnapshot(ctx, request.GetTenantId())
+		if err != nil {
+			return false, err
+		}
+		request.Metadata.SnapToken = st.Encode().String()
+		return false, nil
+	default:
+		return false, errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+	}
+}
+
+// recentSnapshot - Head snapshot of the tenant, read again when the last one is older than the staleness
+func (command *CheckCommand) recentSnapshot(ctx context.Context, tenantID string) (token.EncodedSnapToken, error) {
+	staleness := command.staleness
+	if staleness == 0 {
+		staleness = _defaultMinimizeLatencyStaleness
+	}
+	
+	command.snapshots.mu.Lock()
+	recent, ok := command.snapshots.tenants[tenantID]
+	command.snapshots.mu.Unlock()
+	if ok && time.Since(recent.readAt) < staleness {
+		return recent.token, nil
+	}
+	
+	st, err := command.relationshipReader.HeadSnapshot(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	
+	command.snapshots.mu.Lock()
+	if command.snapshots.tenants == nil {
+		command.snapshots.tenants = map[string]recentSnapshot{}
+	}
+	command.snapshots.tenants[tenantID] = recentSnapshot{token: st.Encode(), readAt: time.Now()}
+	command.snapshots.mu.Unlock()
+	return st.Encode(), nil
+}
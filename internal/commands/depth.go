@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"context"
+	"sync/atomic"
+
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// depthTracker - Lowest depth the sub-checks of a check were executed at, it is the remaining depth of the check.
+// A sub-check answered from the cache or by a peer counts with the depth it was asked at or answered with.
+type depthTracker struct {
+	lowest atomic.Int32
+}
+
+// newDepthTracker - Creates a tracker starting at the depth of the check
+func newDepthTracker(depth int32) *depthTracker {
+	t := &depthTracker{}
+	t.lowest.Store(depth)
+	return t
+}
+
+// record - Lowers the remaining depth to the depth of a sub-check
+func (t *depthTracker) record(depth int32) {
+	if t == nil {
+		return
+	}
+	for {
+		lowest := t.lowest.Load()
+		if depth >= lowest || t.lowest.CompareAndSwap(lowest, depth) {
+			return
+		}
+	}
+}
+
+// remaining -
+func (t *depthTracker) remaining() int32 {
+	return t.lowest.Load()
+}
+
+type depthTrackerKey struct{}
+
+// contextWithDepthTracker - The sub-checks executed with the returned context record their depths to the tracker
+func contextWithDepthTracker(ctx context.Context, tracker *depthTracker) context.Context {
+	return context.WithValue(ctx, depthTrackerKey{}, tracker)
+}
+
+// depthTrackerFromContext -
+func depthTrackerFromContext(ctx context.Context) *depthTracker {
+	tracker, _ := ctx.Value(depthTrackerKey{}).(*depthTracker)
+	return tracker
+}
+
+// depthExceeded - The depth of the request ran out before the check was decided
+func depthExceeded(meta *base.PermissionCheckResponseMetadata) *base.PermissionCheckResponse {
+	return &base.PermissionCheckResponse{
+		Can:      base.PermissionCheckResponse_RESULT_DEPTH_EXCEEDED,
+		Metadata: meta,
+	}
+}
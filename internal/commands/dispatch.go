@@ -0,0 +1,41 @@
+package commands
+
+import (
+	"context"
+	
+	"github.com/adminium/permify/internal/deadline"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// CheckDispatcher - Sends the subproblems of a check to the peer that owns their entity
+type CheckDispatcher interface {
+	// Dispatch - reports false when the subproblem has to be checked locally, because this instance owns its
+	// entity or the peer could not answer it
+	Dispatch(ctx context.Context, request *base.PermissionCheckRequest) (*base.PermissionCheckResponse, bool)
+}
+
+// Dispatcher - Routes the subproblems of the checks through the dispatcher. The requests of the clients are always
+// checked by the instance they reached, only the subproblems they are broken into move to their owners.
+func Dispatcher(dispatcher CheckDispatcher) CheckOption {
+	return func(c *CheckCommand) {
+		c.dispatcher = dispatcher
+	}
+}
+
+// DeadlineBudget - Gives every dispatched subproblem the check share of the time left before the deadline of the
+// check, so that a peer that does not answer in time leaves the rest to check the subproblem locally
+func DeadlineBudget(budget deadline.Budget) CheckOption {
+	return func(c *CheckCommand) {
+		c.budget = budget
+	}
+}
+
+// dispatch - Checks the subproblem on its owner, traced checks stay local so that every step is recorded
+func (command *CheckCommand) dispatch(ctx context.Context, request *base.PermissionCheckRequest) (*base.PermissionCheckResponse, bool) {
+	if command.dispatcher == nil || checkTraceFromContext(ctx) != nil {
+		return nil, false
+	}
+	ctx, cancel := command.budget.Context(ctx, command.budget.Check)
+	defer cancel()
+	return command.dispatcher.Dispatch(ctx, request)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/adminium/permify/internal/repositories"
 	"github.com/adminium/permify/internal/schema"
 	"github.com/adminium/permify/pkg/database"
+	"github.com/adminium/permify/pkg/dsl/utils"
 	base "github.com/adminium/permify/pkg/pb/base/v1"
 	"github.com/adminium/permify/pkg/token"
 	"github.com/adminium/permify/pkg/tuple"
@@ -19,14 +20,24 @@ type ExpandCommand struct {
 	// repositories
 	schemaReader       repositories.SchemaReader
 	relationshipReader repositories.RelationshipReader
+	// options
+	subjectLimit int
+	memory       *MemoryTracker
 }
 
 // NewExpandCommand -
-func NewExpandCommand(sr repositories.SchemaReader, rr repositories.RelationshipReader) *ExpandCommand {
-	return &ExpandCommand{
+func NewExpandCommand(sr repositories.SchemaReader, rr repositories.RelationshipReader, opts ...ExpandOption) *ExpandCommand {
+	command := &ExpandCommand{
 		schemaReader:       sr,
 		relationshipReader: rr,
 	}
+	
+	// options
+	for _, opt := range opts {
+		opt(command)
+	}
+	
+	return command
 }
 
 // Execute -
@@ -34,6 +45,9 @@ func (command *ExpandCommand) Execute(ctx context.Context, request *base.Permiss
 	ctx, span := tracer.Start(ctx, "permissions.expand.execute")
 	defer span.End()
 	
+	ctx, release := command.memory.begin(ctx)
+	defer release()
+	
 	if request.GetMetadata().GetSnapToken() == "" {
 		var st token.SnapToken
 		st, err = command.relationshipReader.HeadSnapshot(ctx, request.GetTenantId())
@@ -50,7 +64,7 @@ func (command *ExpandCommand) Execute(ctx context.Context, request *base.Permiss
 		}
 	}
 	
-	resp := command.expand(ctx, request, false)
+	resp := command.expand(command.withSubjectBudget(ctx), request, false)
 	if resp.Err != nil {
 		span.RecordError(resp.Err)
 		span.SetStatus(otelCodes.Error, resp.Err.Error())
@@ -58,6 +72,65 @@ func (command *ExpandCommand) Execute(ctx context.Context, request *base.Permiss
 	return resp.Response, resp.Err
 }
 
+// Continue - Expands the next subjects of a leaf truncated by the subject limit, the tree of the response is that
+// single leaf. The leaf is recorded as truncated again when its remaining subjects do not fit either.
+func (command *ExpandCommand) Continue(ctx context.Context, tenantID, continuation string) (response *base.PermissionExpandResponse, err error) {
+	ctx, span := tracer.Start(ctx, "permissions.expand.continue")
+	defer span.End()
+	
+	ctx, release := command.memory.begin(ctx)
+	defer release()
+	
+	var c expandContinuation
+	c, err = decodeExpandContinuation(continuation)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, err
+	}
+	
+	request := &base.PermissionExpandRequest{
+		TenantId:   tenantID,
+		Entity:     &base.Entity{Type: c.EntityType, Id: c.EntityID},
+		Permission: c.Relation,
+		Metadata: &base.PermissionExpandRequestMetadata{
+			SnapToken:     c.SnapToken,
+			SchemaVersion: c.SchemaVersion,
+		},
+	}
+	
+	var subjects []*base.Subject
+	subjects, _, err = command.readDirect(ctx, request)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, err
+	}
+	
+	return &base.PermissionExpandResponse{
+		Tree: &base.Expand{
+			Node: &base.Expand_Leaf{
+				Leaf: &base.Result{
+					Target: &base.EntityAndRelation{
+						Entity:   request.GetEntity(),
+						Relation: request.GetPermission(),
+					},
+					Exclusion: c.Exclusion,
+					Subjects:  limitSubjects(command.withSubjectBudget(ctx), request, c.Exclusion, subjects, c.After),
+				},
+			},
+		},
+	}, nil
+}
+
+// withSubjectBudget - Limits the subjects of the expansions executed with the returned context
+func (command *ExpandCommand) withSubjectBudget(ctx context.Context) context.Context {
+	if command.subjectLimit <= 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, subjectBudgetKey{}, &subjectBudget{remaining: int64(command.subjectLimit)})
+}
+
 // ExpandResponse -
 type ExpandResponse struct {
 	Response *base.PermissionExpandResponse
@@ -126,7 +199,16 @@ func (command *ExpandCommand) expandLeaf(ctx context.Context, request *base.Perm
 	case *base.Leaf_TupleToUserSet:
 		return command.expandTupleToUserSet(ctx, request, op.TupleToUserSet, leaf.GetExclusion())
 	case *base.Leaf_ComputedUserSet:
+		if _, _, ok := utils.ParseRuleReference(op.ComputedUserSet.GetRelation()); ok {
+			return expandRule(request, op.ComputedUserSet, leaf.GetExclusion())
+		}
+		// the subjects of a relation with a caveat depend on the context of a check
+		if _, _, ok := utils.ParseCaveatReference(op.ComputedUserSet.GetRelation()); ok {
+			return expandRule(request, op.ComputedUserSet, leaf.GetExclusion())
+		}
 		return command.expandComputedUserSet(ctx, request, op.ComputedUserSet, leaf.GetExclusion())
+	case *base.Leaf_Condition:
+		return expandRule(request, &base.ComputedUserSet{Relation: utils.ConditionReference(op.Condition.GetExpression())}, leaf.GetExclusion())
 	default:
 		return expandFail(errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_CHILD_TYPE.String()))
 	}
@@ -151,45 +233,64 @@ func (command *ExpandCommand) setChild(ctx context.Context, request *base.Permis
 	}
 }
 
+// readDirect - Reads the tuples of the relation of the request, split into the subjects of the leaf and the
+// subject sets that are expanded further
+func (command *ExpandCommand) readDirect(ctx context.Context, request *base.PermissionExpandRequest) (subjects, sets []*base.Subject, err error) {
+	var it *database.TupleIterator
+	it, err = command.relationshipReader.QueryRelationships(ctx, request.GetTenantId(), &base.TupleFilter{
+		Entity: &base.EntityFilter{
+			Type: request.GetEntity().GetType(),
+			Ids:  []string{request.GetEntity().GetId()},
+		},
+		Relation: request.GetPermission(),
+	}, request.GetMetadata().GetSnapToken())
+	if err != nil {
+		return nil, nil, err
+	}
+		
+	directUserCollection := database.NewSubjectCollection()
+	budget := memoryBudgetFromContext(ctx)
+		
+	for it.HasNext() {
+		t := it.GetNext()
+		if err = budget.charge(tupleBytes(t)); err != nil {
+			return nil, nil, err
+		}
+		subject := t.GetSubject()
+		if !tuple.IsSubjectUser(subject) && subject.GetRelation() != tuple.ELLIPSIS {
+			sets = append(sets, subject)
+		} else {
+			directUserCollection.Add(subject)
+		}
+	}
+	
+	return directUserCollection.GetSubjects(), sets, nil
+}
+
 // expandDirect -
 func (command *ExpandCommand) expandDirect(ctx context.Context, request *base.PermissionExpandRequest, exclusion bool) ExpandFunction {
 	return func(ctx context.Context, expandChan chan<- ExpandResponse) {
-		var err error
-		var it *database.TupleIterator
-		it, err = command.relationshipReader.QueryRelationships(ctx, request.GetTenantId(), &base.TupleFilter{
-			Entity: &base.EntityFilter{
-				Type: request.GetEntity().GetType(),
-				Ids:  []string{request.GetEntity().GetId()},
-			},
-			Relation: request.GetPermission(),
-		}, request.GetMetadata().GetSnapToken())
+		subjects, sets, err := command.readDirect(ctx, request)
 		if err != nil {
 			expandChan <- expandFailResponse(err)
 			return
 		}
 		
 		var expandFunctions []ExpandFunction
-		
-		directUserCollection := database.NewSubjectCollection()
-		
-		for it.HasNext() {
-			subject := it.GetNext().GetSubject()
-			if !tuple.IsSubjectUser(subject) && subject.GetRelation() != tuple.ELLIPSIS {
-				expandFunctions = append(expandFunctions, func(ctx context.Context, resultChan chan<- ExpandResponse) {
-					result := command.expand(ctx, &base.PermissionExpandRequest{
-						TenantId: request.GetTenantId(),
-						Entity: &base.Entity{
-							Type: subject.GetType(),
-							Id:   subject.GetId(),
-						},
-						Permission: subject.GetRelation(),
-						Metadata:   request.GetMetadata(),
-					}, exclusion)
-					resultChan <- result
-				})
-			} else {
-				directUserCollection.Add(subject)
-			}
+		for _, subject := range sets {
+			subject := subject
+			expandFunctions = append(expandFunctions, func(ctx context.Context, resultChan chan<- ExpandResponse) {
+				result := command.expand(ctx, &base.PermissionExpandRequest{
+					TenantId: request.GetTenantId(),
+					Entity: &base.Entity{
+						Type: subject.GetType(),
+						Id:   subject.GetId(),
+					},
+					Permission: subject.GetRelation(),
+					Metadata:   request.GetMetadata(),
+				}, exclusion)
+				resultChan <- result
+			})
 		}
 		
 		target := &base.EntityAndRelation{
@@ -197,18 +298,20 @@ func (command *ExpandCommand) expandDirect(ctx context.Context, request *base.Pe
 			Relation: request.GetPermission(),
 		}
 		
+		leaf := &base.Expand{
+			Node: &base.Expand_Leaf{
+				Leaf: &base.Result{
+					Target:    target,
+					Exclusion: exclusion,
+					Subjects:  limitSubjects(ctx, request, exclusion, subjects, ""),
+				},
+			},
+		}
+		
 		if len(expandFunctions) == 0 {
 			expandChan <- ExpandResponse{
 				Response: &base.PermissionExpandResponse{
-					Tree: &base.Expand{
-						Node: &base.Expand_Leaf{
-							Leaf: &base.Result{
-								Target:    target,
-								Exclusion: exclusion,
-								Subjects:  directUserCollection.GetSubjects(),
-							},
-						},
-					},
+					Tree: leaf,
 				},
 			}
 			return
@@ -220,24 +323,18 @@ func (command *ExpandCommand) expandDirect(ctx context.Context, request *base.Pe
 			return
 		}
 		
-		var ex []*base.Expand
-		ex = append(ex, &base.Expand{
-			Node: &base.Expand_Leaf{
-				Leaf: &base.Result{
-					Target:    target,
-					Exclusion: exclusion,
-					Subjects:  directUserCollection.GetSubjects(),
-				},
-			},
-		})
-		
-		result.Response.Tree.GetExpand().Children = ex
+		// the direct subjects come first, followed by the expansions of the subject sets
+		node := result.Response.Tree.GetExpand()
+		node.Children = append([]*base.Expand{leaf}, node.Children...)
 		expandChan <- result
 	}
 }
 
 // expandTupleToUserSet -
 func (command *ExpandCommand) expandTupleToUserSet(ctx context.Context, request *base.PermissionExpandRequest, ttu *base.TupleToUserSet, exclusion bool) ExpandFunction {
+	if relation, ok := utils.ParseTransitiveReference(ttu.GetTupleSet().GetRelation()); ok {
+		return command.expandTransitiveTupleToUserSet(ctx, request, relation, ttu.GetComputed(), exclusion)
+	}
 	return func(ctx context.Context, expandChan chan<- ExpandResponse) {
 		var err error
 		
@@ -251,11 +348,18 @@ func (command *ExpandCommand) expandTupleToUserSet(ctx context.Context, request
 		}, request.GetMetadata().GetSnapToken())
 		if err != nil {
 			expandChan <- expandFailResponse(err)
+			return
 		}
 		
+		budget := memoryBudgetFromContext(ctx)
 		var expandFunctions []ExpandFunction
 		for it.HasNext() {
-			subject := it.GetNext().GetSubject()
+			t := it.GetNext()
+			if err = budget.charge(tupleBytes(t)); err != nil {
+				expandChan <- expandFailResponse(err)
+				return
+			}
+			subject := t.GetSubject()
 			if subject.GetRelation() == tuple.ELLIPSIS {
 				expandFunctions = append(expandFunctions, command.expandComputedUserSet(ctx, &base.PermissionExpandRequest{
 					TenantId: request.GetTenantId(),
@@ -273,6 +377,30 @@ func (command *ExpandCommand) expandTupleToUserSet(ctx context.Context, request
 	}
 }
 
+// expandTransitiveTupleToUserSet - Expands the computed relation on every entity the relation reaches from the entity,
+// the union of the hierarchy is flat, the levels are not nested in each other
+func (command *ExpandCommand) expandTransitiveTupleToUserSet(ctx context.Context, request *base.PermissionExpandRequest, relation string, cu *base.ComputedUserSet, exclusion bool) ExpandFunction {
+	return func(ctx context.Context, expandChan chan<- ExpandResponse) {
+		var expandFunctions []ExpandFunction
+		err := walkTransitive(ctx, command.relationshipReader, request.GetTenantId(), request.GetEntity(), relation, request.GetMetadata().GetSnapToken(), _defaultTransitiveDepth, func(_ int32, entities []*base.Entity) (bool, error) {
+			for _, entity := range entities {
+				expandFunctions = append(expandFunctions, command.expandComputedUserSet(ctx, &base.PermissionExpandRequest{
+					TenantId: request.GetTenantId(),
+					Entity:   entity,
+					Metadata: request.GetMetadata(),
+				}, cu, exclusion))
+			}
+			return false, nil
+		})
+		if err != nil {
+			expandChan <- expandFailResponse(err)
+			return
+		}
+		
+		expandChan <- expandUnion(ctx, expandFunctions)
+	}
+}
+
 // expandComputedUserSet -
 func (command *ExpandCommand) expandComputedUserSet(ctx context.Context, request *base.PermissionExpandRequest, cu *base.ComputedUserSet, exclusion bool) ExpandFunction {
 	return func(ctx context.Context, resultChan chan<- ExpandResponse) {
@@ -289,6 +417,30 @@ func (command *ExpandCommand) expandComputedUserSet(ctx context.Context, request
 	}
 }
 
+// expandRule - Rules are decided by the attributes of the entity, caveats and conditions by the context of a check,
+// not by subjects alone, so the call, the relation with the caveat or the condition is a leaf without subjects that
+// has it as its target
+func expandRule(request *base.PermissionExpandRequest, cu *base.ComputedUserSet, exclusion bool) ExpandFunction {
+	return func(ctx context.Context, resultChan chan<- ExpandResponse) {
+		resultChan <- ExpandResponse{
+			Response: &base.PermissionExpandResponse{
+				Tree: &base.Expand{
+					Node: &base.Expand_Leaf{
+						Leaf: &base.Result{
+							Target: &base.EntityAndRelation{
+								Entity:   request.GetEntity(),
+								Relation: cu.GetRelation(),
+							},
+							Exclusion: exclusion,
+							Subjects:  []*base.Subject{},
+						},
+					},
+				},
+			},
+		}
+	}
+}
+
 // expandOperation -
 func expandOperation(
 	ctx context.Context,
@@ -336,6 +488,10 @@ func expandOperation(
 		}
 	}
 	
+	if err := memoryBudgetFromContext(ctx).charge(_expandNodeOverhead + int64(len(children))*_expandChildOverhead); err != nil {
+		return expandFailResponse(err)
+	}
+	
 	return ExpandResponse{
 		Response: &base.PermissionExpandResponse{
 			Tree: &base.Expand{
@@ -0,0 +1,178 @@
+package commands
+
+import (
+	"errors"
+	"sort"
+	
+	"github.com/adminium/permify/pkg/dsl/utils"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+// subjectSet - Subjects of a flattened expand tree, a complement stands for every subject except its subjects
+type subjectSet struct {
+	subjects   map[string]*base.Subject
+	complement bool
+}
+
+// FlattenExpand - Evaluates the unions, intersections and exclusions of an expand tree into the single leaf of the
+// target. The leaves expanded from an excluded leaf all carry the exclusion, so a subtree whose leaves are all
+// excluded is evaluated as it is and then excluded as a whole. A flattened leaf with the exclusion stands for every
+// subject except its subjects. Rules are decided by the attributes of the entities instead of subjects, so the trees
+// with rules cannot be flattened.
+func FlattenExpand(target *base.EntityAndRelation, tree *base.Expand) (*base.Expand, error) {
+	set, err := flatten(tree, false)
+	if err != nil {
+		return nil, err
+	}
+	
+	subjects := make([]*base.Subject, 0, len(set.subjects))
+	for _, subject := range set.subjects {
+		subjects = append(subjects, subject)
+	}
+	sort.Slice(subjects, func(i, j int) bool {
+		return tuple.SubjectToString(subjects[i]) < tuple.SubjectToString(subjects[j])
+	})
+	
+	return &base.Expand{
+		Node: &base.Expand_Leaf{
+			Leaf: &base.Result{
+				Target:    target,
+				Exclusion: set.complement,
+				Subjects:  subjects,
+			},
+		},
+	}, nil
+}
+
+// flatten - Subjects of the tree, the exclusions of the leaves are ignored in a subtree that is excluded as a whole
+func flatten(tree *base.Expand, excluded bool) (subjectSet, error) {
+	switch node := tree.GetNode().(type) {
+	case *base.Expand_Leaf:
+		if _, _, ok := utils.ParseRuleReference(node.Leaf.GetTarget().GetRelation()); ok {
+			return subjectSet{}, errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+		}
+		if _, _, ok := utils.ParseCaveatReference(node.Leaf.GetTarget().GetRelation()); ok {
+			return subjectSet{}, errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+		}
+		if _, ok := utils.ParseConditionReference(node.Leaf.GetTarget().GetRelation()); ok {
+			return subjectSet{}, errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+		}
+		set := subjectSet{subjects: map[string]*base.Subject{}, complement: node.Leaf.GetExclusion() && !excluded}
+		for _, subject := range node.Leaf.GetSubjects() {
+			set.subjects[tuple.SubjectToString(subject)] = subject
+		}
+		return set, nil
+	case *base.Expand_Expand:
+		if !excluded && len(node.Expand.GetChildren()) > 0 && allExcluded(tree) {
+			set, err := flatten(tree, true)
+			if err != nil {
+				return subjectSet{}, err
+			}
+			set.complement = !set.complement
+			return set, nil
+		}
+		
+		var result subjectSet
+		for i, child := range node.Expand.GetChildren() {
+			set, err := flatten(child, excluded)
+			if err != nil {
+				return subjectSet{}, err
+			}
+			if i == 0 {
+				result = set
+				continue
+			}
+			if node.Expand.GetOperation() == base.ExpandTreeNode_OPERATION_INTERSECTION {
+				result = intersectSubjects(result, set)
+			} else {
+				result = unionSubjects(result, set)
+			}
+		}
+		if result.subjects == nil {
+			result.subjects = map[string]*base.Subject{}
+		}
+		return result, nil
+	default:
+		return subjectSet{}, errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_CHILD_KIND.String())
+	}
+}
+
+// allExcluded - Whether every leaf of the tree is excluded, a tree without leaves is not
+func allExcluded(tree *base.Expand) bool {
+	if leaf := tree.GetLeaf(); leaf != nil {
+		return leaf.GetExclusion()
+	}
+	children := tree.GetExpand().GetChildren()
+	if len(children) == 0 {
+		return false
+	}
+	for _, child := range children {
+		if !allExcluded(child) {
+			return false
+		}
+	}
+	return true
+}
+
+// unionSubjects - Subjects of either set
+func unionSubjects(a, b subjectSet) subjectSet {
+	switch {
+	case !a.complement && !b.complement:
+		return subjectSet{subjects: merge(a.subjects, b.subjects)}
+	case a.complement && b.complement:
+		return subjectSet{subjects: common(a.subjects, b.subjects), complement: true}
+	case a.complement:
+		return subjectSet{subjects: without(a.subjects, b.subjects), complement: true}
+	default:
+		return subjectSet{subjects: without(b.subjects, a.subjects), complement: true}
+	}
+}
+
+// intersectSubjects - Subjects of both sets
+func intersectSubjects(a, b subjectSet) subjectSet {
+	switch {
+	case !a.complement && !b.complement:
+		return subjectSet{subjects: common(a.subjects, b.subjects)}
+	case a.complement && b.complement:
+		return subjectSet{subjects: merge(a.subjects, b.subjects), complement: true}
+	case a.complement:
+		return subjectSet{subjects: without(b.subjects, a.subjects)}
+	default:
+		return subjectSet{subjects: without(a.subjects, b.subjects)}
+	}
+}
+
+// merge - Subjects of a or b
+func merge(a, b map[string]*base.Subject) map[string]*base.Subject {
+	result := make(map[string]*base.Subject, len(a)+len(b))
+	for k, v := range a {
+		result[k] = v
+	}
+	for k, v := range b {
+		result[k] = v
+	}
+	return result
+}
+
+// common - Subjects of a that are also in b
+func common(a, b map[string]*base.Subject) map[string]*base.Subject {
+	result := map[string]*base.Subject{}
+	for k, v := range a {
+		if _, ok := b[k]; ok {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// without - Subjects of a that are not in b
+func without(a, b map[string]*base.Subject) map[string]*base.Subject {
+	result := map[string]*base.Subject{}
+	for k, v := range a {
+		if _, ok := b[k]; !ok {
+			result[k] = v
+		}
+	}
+	return result
+}
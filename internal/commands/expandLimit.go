@@ -0,0 +1,170 @@
+package commands
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"sort"
+	"sync"
+	"sync/atomic"
+	
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+// ExpandTruncation - Direct leaf of an expand tree that has more subjects than the subject limit allowed to return.
+// The token continues the expansion of the leaf after its returned subjects.
+type ExpandTruncation struct {
+	Target *base.EntityAndRelation
+	Token  string
+}
+
+// ExpandTruncations - Collects the truncated leaves of an expansion. Leaves are appended in completion order.
+type ExpandTruncations struct {
+	mu     sync.Mutex
+	leaves []ExpandTruncation
+}
+
+// NewExpandTruncations - Creates new expand truncations
+func NewExpandTruncations() *ExpandTruncations {
+	return &ExpandTruncations{}
+}
+
+// Leaves - Get truncated leaves
+func (t *ExpandTruncations) Leaves() []ExpandTruncation {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	leaves := make([]ExpandTruncation, len(t.leaves))
+	copy(leaves, t.leaves)
+	return leaves
+}
+
+// record -
+func (t *ExpandTruncations) record(target *base.EntityAndRelation, token string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.leaves = append(t.leaves, ExpandTruncation{
+		Target: target,
+		Token:  token,
+	})
+}
+
+type expandTruncationsKey struct{}
+
+// ContextWithExpandTruncations - Expansions executed with the returned context record their truncated leaves
+func ContextWithExpandTruncations(ctx context.Context, truncations *ExpandTruncations) context.Context {
+	return context.WithValue(ctx, expandTruncationsKey{}, truncations)
+}
+
+// expandTruncationsFromContext -
+func expandTruncationsFromContext(ctx context.Context) *ExpandTruncations {
+	truncations, _ := ctx.Value(expandTruncationsKey{}).(*ExpandTruncations)
+	return truncations
+}
+
+// subjectBudget - Number of subjects an expansion can still return, shared by all of its leaves
+type subjectBudget struct {
+	remaining int64
+}
+
+// take - Takes up to n subjects from the budget and returns how many were taken
+func (b *subjectBudget) take(n int) int {
+	for {
+		remaining := atomic.LoadInt64(&b.remaining)
+		taken := int64(n)
+		if taken > remaining {
+			taken = remaining
+		}
+		if atomic.CompareAndSwapInt64(&b.remaining, remaining, remaining-taken) {
+			return int(taken)
+		}
+	}
+}
+
+type subjectBudgetKey struct{}
+
+// subjectBudgetFromContext -
+func subjectBudgetFromContext(ctx context.Context) *subjectBudget {
+	budget, _ := ctx.Value(subjectBudgetKey{}).(*subjectBudget)
+	return budget
+}
+
+// expandContinuation - Position in the subjects of a truncated leaf. The snap token and schema version pin the
+// continuation to the tree the leaf was cut from.
+type expandContinuation struct {
+	EntityType    string `json:"t"`
+	EntityID      string `json:"i"`
+	Relation      string `json:"r"`
+	Exclusion     bool   `json:"x,omitempty"`
+	SnapToken     string `json:"s"`
+	SchemaVersion string `json:"v"`
+	// After - the last returned subject, subjects of a limited leaf are returned in the order of their string form
+	After string `json:"a,omitempty"`
+}
+
+// encode -
+func (c expandContinuation) encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeExpandContinuation -
+func decodeExpandContinuation(token string) (c expandContinuation, err error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return c, errors.New(base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN.String())
+	}
+	if err = json.Unmarshal(b, &c); err != nil || c.EntityType == "" || c.EntityID == "" || c.Relation == "" {
+		return c, errors.New(base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN.String())
+	}
+	return c, nil
+}
+
+// limitSubjects - Keeps the direct subjects of a leaf that come after the cursor and fit in the subject budget of
+// the expansion. Without a budget the subjects are returned as they are. A leaf that does not fit is recorded as
+// truncated with a continuation after its last returned subject.
+func limitSubjects(ctx context.Context, request *base.PermissionExpandRequest, exclusion bool, subjects []*base.Subject, after string) []*base.Subject {
+	budget := subjectBudgetFromContext(ctx)
+	if budget == nil && after == "" {
+		return subjects
+	}
+	
+	sort.Slice(subjects, func(i, j int) bool {
+		return tuple.SubjectToString(subjects[i]) < tuple.SubjectToString(subjects[j])
+	})
+	if after != "" {
+		i := sort.Search(len(subjects), func(i int) bool {
+			return tuple.SubjectToString(subjects[i]) > after
+		})
+		subjects = subjects[i:]
+	}
+	if budget == nil {
+		return subjects
+	}
+	
+	taken := budget.take(len(subjects))
+	if taken == len(subjects) {
+		return subjects
+	}
+	
+	if truncations := expandTruncationsFromContext(ctx); truncations != nil {
+		last := after
+		if taken > 0 {
+			last = tuple.SubjectToString(subjects[taken-1])
+		}
+		truncations.record(&base.EntityAndRelation{
+			Entity:   request.GetEntity(),
+			Relation: request.GetPermission(),
+		}, expandContinuation{
+			EntityType:    request.GetEntity().GetType(),
+			EntityID:      request.GetEntity().GetId(),
+			Relation:      request.GetPermission(),
+			Exclusion:     exclusion,
+			SnapToken:     request.GetMetadata().GetSnapToken(),
+			SchemaVersion: request.GetMetadata().GetSchemaVersion(),
+			After:         last,
+		}.encode())
+	}
+	return subjects[:taken]
+}
@@ -2,6 +2,7 @@ package commands
 
 import (
 	"context"
+	"errors"
 	
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -10,6 +11,7 @@ import (
 	"github.com/adminium/permify/internal/schema"
 	"github.com/adminium/permify/pkg/database"
 	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/telemetry"
 	"github.com/adminium/permify/pkg/token"
 	"github.com/adminium/permify/pkg/tuple"
 )
@@ -312,4 +314,216 @@ var _ = Describe("expand-command", func() {
 			}).Should(Equal(response.Tree))
 		})
 	})
+	
+	Context("Subject Limit: Expand", func() {
+		It("Subject Limit: Case 1", func() {
+			var err error
+			
+			schemaReader := new(mocks.SchemaReader)
+			
+			var sch *base.SchemaDefinition
+			sch, err = schema.NewSchemaFromStringDefinitions(true, driveSchema)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			var organization *base.EntityDefinition
+			organization, err = schema.GetEntityByName(sch, "organization")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			schemaReader.On("ReadSchemaDefinition", "t1", "organization", "noop").Return(organization, "noop", nil).Times(1)
+			
+			relationshipReader := new(mocks.RelationshipReader)
+			
+			var tuples []*base.Tuple
+			for _, id := range []string{"3", "1", "2"} {
+				tuples = append(tuples, &base.Tuple{
+					Entity:   &base.Entity{Type: "organization", Id: "1"},
+					Relation: "admin",
+					Subject:  &base.Subject{Type: tuple.USER, Id: id},
+				})
+			}
+			
+			filter := &base.TupleFilter{
+				Entity: &base.EntityFilter{
+					Type: "organization",
+					Ids:  []string{"1"},
+				},
+				Relation: "admin",
+			}
+			relationshipReader.On("QueryRelationships", "t1", filter, token.NewNoopToken().Encode().String()).Return(database.NewTupleIterator(tuples...), nil).Times(1)
+			relationshipReader.On("QueryRelationships", "t1", filter, token.NewNoopToken().Encode().String()).Return(database.NewTupleIterator(tuples...), nil).Times(1)
+			
+			expandCommand = NewExpandCommand(schemaReader, relationshipReader, ExpandSubjectLimit(2))
+			
+			truncations := NewExpandTruncations()
+			
+			var response *base.PermissionExpandResponse
+			response, err = expandCommand.Execute(ContextWithExpandTruncations(context.Background(), truncations), &base.PermissionExpandRequest{
+				TenantId:   "t1",
+				Entity:     &base.Entity{Type: "organization", Id: "1"},
+				Permission: "admin",
+				Metadata: &base.PermissionExpandRequestMetadata{
+					SnapToken:     token.NewNoopToken().Encode().String(),
+					SchemaVersion: "noop",
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			Expect(response.GetTree().GetLeaf().GetSubjects()).Should(Equal([]*base.Subject{
+				{Type: tuple.USER, Id: "1"},
+				{Type: tuple.USER, Id: "2"},
+			}))
+			
+			leaves := truncations.Leaves()
+			Expect(leaves).Should(HaveLen(1))
+			Expect(tuple.EntityAndRelationToString(leaves[0].Target)).Should(Equal("organization:1#admin"))
+			
+			truncations = NewExpandTruncations()
+			
+			response, err = expandCommand.Continue(ContextWithExpandTruncations(context.Background(), truncations), "t1", leaves[0].Token)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			Expect(response.GetTree().GetLeaf().GetSubjects()).Should(Equal([]*base.Subject{
+				{Type: tuple.USER, Id: "3"},
+			}))
+			Expect(truncations.Leaves()).Should(BeEmpty())
+			
+			_, err = expandCommand.Continue(context.Background(), "t1", "not a token")
+			Expect(err).Should(Equal(errors.New(base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN.String())))
+		})
+	})
+	
+	Context("Memory Limit: Expand", func() {
+		It("Memory Limit: Case 1", func() {
+			var err error
+			
+			schemaReader := new(mocks.SchemaReader)
+			
+			var sch *base.SchemaDefinition
+			sch, err = schema.NewSchemaFromStringDefinitions(true, driveSchema)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			var organization *base.EntityDefinition
+			organization, err = schema.GetEntityByName(sch, "organization")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			schemaReader.On("ReadSchemaDefinition", "t1", "organization", "noop").Return(organization, "noop", nil)
+			
+			relationshipReader := new(mocks.RelationshipReader)
+			
+			var tuples []*base.Tuple
+			for _, id := range []string{"1", "2", "3"} {
+				tuples = append(tuples, &base.Tuple{
+					Entity:   &base.Entity{Type: "organization", Id: "1"},
+					Relation: "admin",
+					Subject:  &base.Subject{Type: tuple.USER, Id: id},
+				})
+			}
+			
+			filter := &base.TupleFilter{
+				Entity: &base.EntityFilter{
+					Type: "organization",
+					Ids:  []string{"1"},
+				},
+				Relation: "admin",
+			}
+			relationshipReader.On("QueryRelationships", "t1", filter, token.NewNoopToken().Encode().String()).Return(database.NewTupleIterator(tuples...), nil).Times(1)
+			relationshipReader.On("QueryRelationships", "t1", filter, token.NewNoopToken().Encode().String()).Return(database.NewTupleIterator(tuples...), nil).Times(1)
+			
+			request := &base.PermissionExpandRequest{
+				TenantId:   "t1",
+				Entity:     &base.Entity{Type: "organization", Id: "1"},
+				Permission: "admin",
+				Metadata: &base.PermissionExpandRequestMetadata{
+					SnapToken:     token.NewNoopToken().Encode().String(),
+					SchemaVersion: "noop",
+				},
+			}
+			
+			tracker := NewMemoryTracker(2*_tupleOverhead, telemetry.NewNoopMeter())
+			expandCommand = NewExpandCommand(schemaReader, relationshipReader, ExpandMemoryLimit(tracker))
+			
+			_, err = expandCommand.Execute(context.Background(), request)
+			Expect(err).Should(HaveOccurred())
+			Expect(isMemoryLimitExceeded(err)).Should(BeTrue())
+			Expect(err.Error()).Should(Equal(base.ErrorCode_ERROR_CODE_MEMORY_LIMIT_EXCEEDED.String()))
+			Expect(tracker.InFlight()).Should(Equal(int64(0)))
+			
+			tracker = NewMemoryTracker(1<<20, telemetry.NewNoopMeter())
+			expandCommand = NewExpandCommand(schemaReader, relationshipReader, ExpandMemoryLimit(tracker))
+			
+			var response *base.PermissionExpandResponse
+			response, err = expandCommand.Execute(context.Background(), request)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(response.GetTree().GetLeaf().GetSubjects()).Should(HaveLen(3))
+			Expect(tracker.InFlight()).Should(Equal(int64(0)))
+		})
+	})
+	
+	Context("Flatten: Expand", func() {
+		leaf := func(relation string, exclusion bool, ids ...string) *base.Expand {
+			var subjects []*base.Subject
+			for _, id := range ids {
+				subjects = append(subjects, &base.Subject{Type: tuple.USER, Id: id})
+			}
+			return &base.Expand{Node: &base.Expand_Leaf{Leaf: &base.Result{
+				Target:    &base.EntityAndRelation{Entity: &base.Entity{Type: "doc", Id: "1"}, Relation: relation},
+				Exclusion: exclusion,
+				Subjects:  subjects,
+			}}}
+		}
+		node := func(op base.ExpandTreeNode_Operation, children ...*base.Expand) *base.Expand {
+			return &base.Expand{Node: &base.Expand_Expand{Expand: &base.ExpandTreeNode{Operation: op, Children: children}}}
+		}
+		ids := func(tree *base.Expand) []string {
+			var s []string
+			for _, subject := range tree.GetLeaf().GetSubjects() {
+				s = append(s, subject.GetId())
+			}
+			return s
+		}
+		target := &base.EntityAndRelation{Entity: &base.Entity{Type: "doc", Id: "1"}, Relation: "view"}
+		
+		It("Flatten: Case 1", func() {
+			// (owner or viewer) and not banned
+			tree := node(base.ExpandTreeNode_OPERATION_INTERSECTION,
+				node(base.ExpandTreeNode_OPERATION_UNION, leaf("owner", false, "1"), leaf("viewer", false, "2", "3")),
+				leaf("banned", true, "3", "4"),
+			)
+			
+			flattened, err := FlattenExpand(target, tree)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(flattened.GetLeaf().GetTarget()).Should(Equal(target))
+			Expect(flattened.GetLeaf().GetExclusion()).Should(BeFalse())
+			Expect(ids(flattened)).Should(Equal([]string{"1", "2"}))
+		})
+
+		It("Flatten: Case 2", func() {
+			// member and not (banned or suspended), the excluded relation is expanded into excluded leaves
+			tree := node(base.ExpandTreeNode_OPERATION_INTERSECTION,
+				leaf("member", false, "1", "2", "3"),
+				node(base.ExpandTreeNode_OPERATION_UNION, leaf("banned", true, "1"), leaf("suspended", true, "2")),
+			)
+			
+			flattened, err := FlattenExpand(target, tree)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ids(flattened)).Should(Equal([]string{"3"}))
+		})
+		
+		It("Flatten: Case 3", func() {
+			// owner or not banned is every subject except the banned ones that are not owners
+			tree := node(base.ExpandTreeNode_OPERATION_UNION, leaf("owner", false, "1"), leaf("banned", true, "1", "2"))
+			
+			flattened, err := FlattenExpand(target, tree)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(flattened.GetLeaf().GetExclusion()).Should(BeTrue())
+			Expect(ids(flattened)).Should(Equal([]string{"2"}))
+		})
+		
+		It("Flatten: Case 4", func() {
+			tree := node(base.ExpandTreeNode_OPERATION_INTERSECTION, leaf("owner", false, "1"), leaf("is_public()", false))
+			
+			_, err := FlattenExpand(target, tree)
+			Expect(err).Should(Equal(errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())))
+		})
+	})
 })
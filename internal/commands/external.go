@@ -0,0 +1,185 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+	
+	"go.opentelemetry.io/otel/attribute"
+	otelCodes "go.opentelemetry.io/otel/codes"
+	"google.golang.org/grpc"
+	
+	"github.com/adminium/permify/pkg/cache"
+	"github.com/adminium/permify/pkg/dsl/utils"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+// ExternalResolver - Answers whether the subject of the request has the relation to the entity,
+// using data that lives outside of permify (an HR system, a legacy ACL, ...)
+type ExternalResolver interface {
+	Resolve(ctx context.Context, request *base.PermissionCheckRequest) (bool, error)
+}
+
+// ExternalResolverFunc - Adapter to use a go callback as an external resolver
+type ExternalResolverFunc func(ctx context.Context, request *base.PermissionCheckRequest) (bool, error)
+
+// Resolve -
+func (f ExternalResolverFunc) Resolve(ctx context.Context, request *base.PermissionCheckRequest) (bool, error) {
+	return f(ctx, request)
+}
+
+// GRPCExternalResolver - Forwards the check to a grpc hook. The hook implements the given method
+// with the permify check request and response messages, so it needs no generated code of its own.
+type GRPCExternalResolver struct {
+	conn   grpc.ClientConnInterface
+	method string
+}
+
+// NewGRPCExternalResolver - Creates new grpc external resolver, method is the full method name, e.g. /hr.v1.Directory/Check
+func NewGRPCExternalResolver(conn grpc.ClientConnInterface, method string) *GRPCExternalResolver {
+	return &GRPCExternalResolver{
+		conn:   conn,
+		method: method,
+	}
+}
+
+// Resolve -
+func (r *GRPCExternalResolver) Resolve(ctx context.Context, request *base.PermissionCheckRequest) (bool, error) {
+	response := &base.PermissionCheckResponse{}
+	if err := r.conn.Invoke(ctx, r.method, request, response); err != nil {
+		return false, err
+	}
+	return response.GetCan() == base.PermissionCheckResponse_RESULT_ALLOWED, nil
+}
+
+// External - Binds an external resolver to a relation of the schema. The relation is satisfied by its
+// tuples or by the resolver. Results of the resolver are cached for TTL, they are not tied to snapshots.
+type External struct {
+	EntityType string
+	Relation   string
+	Resolver   ExternalResolver
+	// Timeout - limit of a single resolve call, zero for no limit
+	Timeout time.Duration
+	// TTL - lifetime of cached results, zero disables caching
+	TTL time.Duration
+}
+
+// externalResult - cached answer of an external resolver
+type externalResult struct {
+	can     bool
+	expires time.Time
+}
+
+// Externals - Registers external resolvers, their results are cached in the given cache
+func Externals(c cache.Cache, externals ...External) CheckOption {
+	return func(command *CheckCommand) {
+		command.externalCache = c
+		if command.externals == nil {
+			command.externals = map[string]External{}
+		}
+		for _, external := range externals {
+			command.externals[utils.Key(external.EntityType, external.Relation)] = external
+		}
+	}
+}
+
+// external - Resolver bound to the relation, if any
+func (command *CheckCommand) external(entityType, relation string) (External, bool) {
+	external, ok := command.externals[utils.Key(entityType, relation)]
+	return external, ok
+}
+
+// externalTracker - Whether an external resolver answered a check, itself or through its sub-checks. The answers of
+// the resolvers are not bound to the snapshot, so the checks that used them are not cached by it.
+type externalTracker struct {
+	parent  *externalTracker
+	touched atomic.Bool
+}
+
+// touch - Marks the check and the checks it is a sub-check of
+func (t *externalTracker) touch() {
+	for ; t != nil; t = t.parent {
+		t.touched.Store(true)
+	}
+}
+
+// resolved -
+func (t *externalTracker) resolved() bool {
+	return t != nil && t.touched.Load()
+}
+
+type externalTrackerKey struct{}
+
+// contextWithExternalTracker - The tracker of the check is the child of the one of the check it is a sub-check of
+func contextWithExternalTracker(ctx context.Context) context.Context {
+	return context.WithValue(ctx, externalTrackerKey{}, &externalTracker{parent: externalTrackerFromContext(ctx)})
+}
+
+// externalTrackerFromContext -
+func externalTrackerFromContext(ctx context.Context) *externalTracker {
+	tracker, _ := ctx.Value(externalTrackerKey{}).(*externalTracker)
+	return tracker
+}
+
+// cacheable - Whether the result of the check can be cached by the snapshot, it can not when the relation has an
+// external resolver or one answered the check through its sub-checks
+func (command *CheckCommand) cacheable(ctx context.Context, request *base.PermissionCheckRequest) bool {
+	if _, ok := command.external(request.GetEntity().GetType(), request.GetPermission()); ok {
+		return false
+	}
+	return !externalTrackerFromContext(ctx).resolved()
+}
+
+// checkExternal -
+func (command *CheckCommand) checkExternal(ctx context.Context, request *base.PermissionCheckRequest, external External) CheckFunction {
+	return func(ctx context.Context) (*base.PermissionCheckResponse, error) {
+		ctx, span := tracer.Start(ctx, "permissions.check.external")
+		defer span.End()
+		
+		key := request.GetTenantId() + "|" + tuple.EntityToString(request.GetEntity()) + "#" + request.GetPermission() + "@" + tuple.SubjectToString(request.GetSubject())
+		span.SetAttributes(attribute.String("key", key))
+		
+		if external.TTL > 0 && command.externalCache != nil {
+			if v, found := command.externalCache.Get(key); found {
+				if r, ok := v.(externalResult); ok && time.Now().Before(r.expires) {
+					span.SetAttributes(attribute.Bool("cached", true))
+					return externalResponse(r.can), nil
+				}
+			}
+		}
+		
+		if external.Timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, external.Timeout)
+			defer cancel()
+		}
+		
+		externalTrackerFromContext(ctx).touch()
+		
+		can, err := external.Resolver.Resolve(ctx, request)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) || errors.Is(ctx.Err(), context.Canceled) {
+				return denied(&base.PermissionCheckResponseMetadata{}), errors.New(base.ErrorCode_ERROR_CODE_CANCELLED.String())
+			}
+			return denied(&base.PermissionCheckResponseMetadata{}), errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+		
+		if external.TTL > 0 && command.externalCache != nil {
+			command.externalCache.Set(key, externalResult{can: can, expires: time.Now().Add(external.TTL)}, 1)
+		}
+		
+		return externalResponse(can), nil
+	}
+}
+
+// externalResponse -
+func externalResponse(can bool) *base.PermissionCheckResponse {
+	if can {
+		return allowed(&base.PermissionCheckResponseMetadata{})
+	}
+	return denied(&base.PermissionCheckResponseMetadata{})
+}
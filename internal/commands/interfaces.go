@@ -3,6 +3,7 @@ package commands
 import (
 	"context"
 	
+	"github.com/adminium/permify/internal/repositories"
 	base "github.com/adminium/permify/pkg/pb/base/v1"
 )
 
@@ -14,6 +15,7 @@ type ICheckCommand interface {
 // IExpandCommand -
 type IExpandCommand interface {
 	Execute(ctx context.Context, request *base.PermissionExpandRequest) (response *base.PermissionExpandResponse, err error)
+	Continue(ctx context.Context, tenantID, continuation string) (response *base.PermissionExpandResponse, err error)
 }
 
 // ILookupSchemaCommand -
@@ -21,8 +23,18 @@ type ILookupSchemaCommand interface {
 	Execute(ctx context.Context, request *base.PermissionLookupSchemaRequest) (response *base.PermissionLookupSchemaResponse, err error)
 }
 
+// ILookupSubjectCommand -
+type ILookupSubjectCommand interface {
+	Execute(ctx context.Context, request *LookupSubjectRequest) (response *LookupSubjectResponse, err error)
+}
+
 // ILookupEntityCommand -
 type ILookupEntityCommand interface {
 	Execute(ctx context.Context, request *base.PermissionLookupEntityRequest) (response *base.PermissionLookupEntityResponse, err error)
 	Stream(ctx context.Context, request *base.PermissionLookupEntityRequest, server base.Permission_LookupEntityStreamServer) (err error)
 }
+
+// IWatchCommand -
+type IWatchCommand interface {
+	Execute(ctx context.Context, tenantID, snap string, send func(changes *repositories.RelationshipChanges) error) (err error)
+}
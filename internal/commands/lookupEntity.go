@@ -2,12 +2,18 @@ package commands
 
 import (
 	"context"
+	"sort"
+	"strings"
+	"sync"
 	
 	"golang.org/x/sync/errgroup"
 	
 	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/internal/schema"
+	"github.com/adminium/permify/pkg/database"
 	base "github.com/adminium/permify/pkg/pb/base/v1"
 	"github.com/adminium/permify/pkg/token"
+	"github.com/adminium/permify/pkg/tuple"
 )
 
 // LookupEntityCommand -
@@ -17,15 +23,21 @@ type LookupEntityCommand struct {
 	// repositories
 	schemaReader       repositories.SchemaReader
 	relationshipReader repositories.RelationshipReader
+	// options
+	memory *MemoryTracker
 }
 
 // NewLookupEntityCommand -
-func NewLookupEntityCommand(ck ICheckCommand, sr repositories.SchemaReader, rr repositories.RelationshipReader) *LookupEntityCommand {
-	return &LookupEntityCommand{
+func NewLookupEntityCommand(ck ICheckCommand, sr repositories.SchemaReader, rr repositories.RelationshipReader, opts ...LookupEntityOption) *LookupEntityCommand {
+	command := &LookupEntityCommand{
 		checkCommand:       ck,
 		schemaReader:       sr,
 		relationshipReader: rr,
 	}
+	for _, opt := range opts {
+		opt(command)
+	}
+	return command
 }
 
 // Execute -
@@ -33,6 +45,17 @@ func (command *LookupEntityCommand) Execute(ctx context.Context, request *base.P
 	ctx, span := tracer.Start(ctx, "permissions.lookup-entity.execute")
 	defer span.End()
 	
+	ctx, release := command.memory.begin(ctx)
+	defer release()
+	
+	page := newLookupEntityPage(request)
+	var after string
+	if page != nil {
+		if after, err = page.pin(request); err != nil {
+			return response, err
+		}
+	}
+	
 	if request.GetMetadata().GetSnapToken() == "" {
 		var st token.SnapToken
 		st, err = command.relationshipReader.HeadSnapshot(ctx, request.GetTenantId())
@@ -49,18 +72,39 @@ func (command *LookupEntityCommand) Execute(ctx context.Context, request *base.P
 		}
 	}
 	
+	if page != nil {
+		var ids, exceeded []string
+		ids, exceeded, err = command.lookupPage(ctx, request, page, after)
+		if err != nil {
+			return response, err
+		}
+		return &base.PermissionLookupEntityResponse{
+			EntityIds:              ids,
+			DepthExceededEntityIds: exceeded,
+			ContinuousToken:        page.next,
+		}, nil
+	}
+	
 	resultsChan := make(chan string, 100)
-	errChan := make(chan error)
+	errChan := make(chan error, 1)
+	exceeded := &depthExceededEntities{}
 	
-	go command.parallelChecker(ctx, request, resultsChan, errChan)
+	go command.parallelChecker(ctx, request, resultsChan, errChan, exceeded)
 	
+	// the error of the checker is sent before the results are closed
 	entityIDs := make([]string, 0, len(resultsChan))
 	for entityID := range resultsChan {
 		entityIDs = append(entityIDs, entityID)
 	}
+	select {
+	case err = <-errChan:
+		return response, err
+	default:
+	}
 	
 	return &base.PermissionLookupEntityResponse{
-		EntityIds: entityIDs,
+		EntityIds:              entityIDs,
+		DepthExceededEntityIds: exceeded.get(),
 	}, nil
 }
 
@@ -69,6 +113,31 @@ func (command *LookupEntityCommand) Stream(ctx context.Context, request *base.Pe
 	ctx, span := tracer.Start(ctx, "permissions.lookup-entity.stream")
 	defer span.End()
 	
+	ctx, release := command.memory.begin(ctx)
+	defer release()
+	
+	// the ids of a page are found before the first one is sent, the token of the next page is sent with the last one
+	if newLookupEntityPage(request) != nil {
+		var response *base.PermissionLookupEntityResponse
+		response, err = command.Execute(ctx, request)
+		if err != nil {
+			return err
+		}
+		ids := response.GetEntityIds()
+		for i, id := range ids {
+			message := &base.PermissionLookupEntityStreamResponse{
+				EntityId: id,
+			}
+			if i == len(ids)-1 {
+				message.ContinuousToken = response.GetContinuousToken()
+			}
+			if err = server.Send(message); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	
 	if request.GetMetadata().GetSnapToken() == "" {
 		var st token.SnapToken
 		st, err = command.relationshipReader.HeadSnapshot(ctx, request.GetTenantId())
@@ -86,9 +155,10 @@ func (command *LookupEntityCommand) Stream(ctx context.Context, request *base.Pe
 	}
 	
 	resultChan := make(chan string, 100)
-	errChan := make(chan error)
+	errChan := make(chan error, 1)
 	
-	go command.parallelChecker(ctx, request, resultChan, errChan)
+	// a stream has no response to report the entities that ran out of depth in, they are left out
+	go command.parallelChecker(ctx, request, resultChan, errChan, nil)
 	
 	for {
 		select {
@@ -110,7 +180,7 @@ func (command *LookupEntityCommand) Stream(ctx context.Context, request *base.Pe
 }
 
 // parallelChecker -
-func (command *LookupEntityCommand) parallelChecker(ctx context.Context, request *base.PermissionLookupEntityRequest, resultChan chan<- string, errChan chan<- error) {
+func (command *LookupEntityCommand) parallelChecker(ctx context.Context, request *base.PermissionLookupEntityRequest, resultChan chan<- string, errChan chan<- error, exceeded *depthExceededEntities) {
 	//var err error
 	//var en *base.EntityDefinition
 	//en, _, err = command.schemaReader.ReadSchemaDefinition(ctx, request.GetTenantId(), request.GetEntityType(), request.GetMetadata().GetSchemaVersion())
@@ -126,9 +196,18 @@ func (command *LookupEntityCommand) parallelChecker(ctx context.Context, request
 	//
 	//helper.Pre(tor)
 	
-	ids, err := command.relationshipReader.GetUniqueEntityIDsByEntityType(ctx, request.GetTenantId(), request.GetEntityType(), request.GetMetadata().GetSnapToken())
+	ids, allowed, err := command.lookupCandidates(ctx, request)
 	if err != nil {
 		errChan <- err
+		close(resultChan)
+		return
+	}
+	if allowed {
+		for _, id := range ids {
+			resultChan <- id
+		}
+		close(resultChan)
+		return
 	}
 	
 	g := new(errgroup.Group)
@@ -140,7 +219,7 @@ func (command *LookupEntityCommand) parallelChecker(ctx context.Context, request
 			return command.internalCheck(ctx, &base.Entity{
 				Type: request.GetEntityType(),
 				Id:   id,
-			}, request, resultChan)
+			}, request, resultChan, exceeded)
 		})
 	}
 	
@@ -152,9 +231,328 @@ func (command *LookupEntityCommand) parallelChecker(ctx context.Context, request
 	close(resultChan)
 }
 
+// lookupCandidates - Entity ids the lookup checks, in no particular order. allowed is set when the ids were found by
+// the reverse expansion, they hold the permission then and need no checks.
+func (command *LookupEntityCommand) lookupCandidates(ctx context.Context, request *base.PermissionLookupEntityRequest) (ids []string, allowed bool, err error) {
+	scope := newLookupEntityScope(request)
+	
+	// the reverse expansion finds the entities without checking them, from the tuples of the subject only
+	if resolver, ok := command.checkCommand.(tupleResolver); ok {
+		if resolves, err := resolver.resolvesFromTuples(ctx, request.GetTenantId()); err == nil && resolves {
+			ids, ok, err := command.reverseExpand(ctx, request)
+			if isMemoryLimitExceeded(err) {
+				return nil, false, err
+			}
+			if err == nil && ok {
+				if scope != nil {
+					ids = scope.filter(ids)
+				}
+				return ids, true, nil
+			}
+		}
+	}
+	
+	// the ids of a scope are checked as they are, the other candidates are filtered by its prefix
+	var ok bool
+	if scope != nil {
+		ids, ok = scope.candidates()
+	}
+	if !ok {
+		// pre-filtering is an optimization, if it is not possible every entity of the type is checked
+		ids, ok, err = command.candidates(ctx, request)
+		if isMemoryLimitExceeded(err) {
+			return nil, false, err
+		}
+		if err != nil || !ok {
+			ids, err = command.relationshipReader.GetUniqueEntityIDsByEntityType(ctx, request.GetTenantId(), request.GetEntityType(), request.GetMetadata().GetSnapToken())
+			if err != nil {
+				return nil, false, err
+			}
+		}
+		if scope != nil {
+			ids = scope.filter(ids)
+		}
+	}
+	return ids, false, nil
+}
+
+// lookupPage - Checks the sorted candidates that follow the position of the page, as many at once as the page still
+// takes, and stops once the page is full. The depth exceeded ids are the ones of the checked candidates.
+func (command *LookupEntityCommand) lookupPage(ctx context.Context, request *base.PermissionLookupEntityRequest, page *lookupEntityPage, after string) (ids, exceeded []string, err error) {
+	ctx, span := tracer.Start(ctx, "permissions.lookup-entity.page")
+	defer span.End()
+	
+	candidates, allowed, err := command.lookupCandidates(ctx, request)
+	if err != nil {
+		return nil, nil, err
+	}
+	candidates = page.seek(candidates, after)
+	
+	ids = []string{}
+	for len(candidates) > 0 && page.remaining(len(ids)) != 0 {
+		batch := candidates
+		if n := page.remaining(len(ids)); n > 0 && n < len(batch) {
+			batch = batch[:n]
+		}
+		candidates = candidates[len(batch):]
+		
+		if allowed {
+			ids = append(ids, batch...)
+			continue
+		}
+		
+		results := make([]base.PermissionCheckResponse_Result, len(batch))
+		g := new(errgroup.Group)
+		g.SetLimit(100)
+		for i, id := range batch {
+			i, id := i, id
+			g.Go(func() (err error) {
+				results[i], err = command.checkEntity(ctx, &base.Entity{Type: request.GetEntityType(), Id: id}, request)
+				return err
+			})
+		}
+		if err = g.Wait(); err != nil {
+			return nil, nil, err
+		}
+		for i, id := range batch {
+			switch results[i] {
+			case base.PermissionCheckResponse_RESULT_ALLOWED:
+				ids = append(ids, id)
+			case base.PermissionCheckResponse_RESULT_DEPTH_EXCEEDED:
+				exceeded = append(exceeded, id)
+			}
+		}
+		after = batch[len(batch)-1]
+	}
+	
+	page.next = ""
+	if len(candidates) > 0 {
+		if allowed {
+			after = ids[len(ids)-1]
+		}
+		page.continueAfter(request, after)
+	}
+	return ids, exceeded, nil
+}
+
+// tupleResolver - Check command that reports whether its checks resolve from the tuples alone
+type tupleResolver interface {
+	resolvesFromTuples(ctx context.Context, tenantID string) (bool, error)
+}
+
+// reverseExpand - Finds the entities that hold the permission for the subject by walking the reverse edges of the
+// schema from the tuples of the subject: the entities reached at each step hold a relation or action, and the tuples
+// that point at them lead to the entities that hold the relations and actions reading it. The reads grow with the
+// edges of the subject instead of the entities of the type. ok is false when the permission is not a union of the
+// relations it reads or when the depth runs out before every edge was followed, the entities then still have to be
+// checked.
+func (command *LookupEntityCommand) reverseExpand(ctx context.Context, request *base.PermissionLookupEntityRequest) (ids []string, ok bool, err error) {
+	ctx, span := tracer.Start(ctx, "permissions.lookup-entity.reverse-expand")
+	defer span.End()
+	
+	var sch *base.SchemaDefinition
+	sch, err = command.schemaReader.ReadSchema(ctx, request.GetTenantId(), request.GetMetadata().GetSchemaVersion())
+	if err != nil {
+		return nil, false, err
+	}
+	
+	var edges map[string][]schema.ReverseEdge
+	edges, ok = schema.ReverseEdges(sch, request.GetEntityType(), request.GetPermission())
+	if !ok {
+		return nil, false, nil
+	}
+	
+	subject := request.GetSubject()
+	budget := memoryBudgetFromContext(ctx)
+	
+	// entity ids that hold each relation or action by its reverse key, the frontier has the ones reached last
+	reached := map[string]map[string]bool{}
+	frontier := map[string][]string{}
+	add := func(target *base.RelationReference, id string) error {
+		key := schema.ReverseKey(target.GetType(), target.GetRelation())
+		if reached[key] == nil {
+			reached[key] = map[string]bool{}
+		}
+		if !reached[key][id] {
+			if err := budget.charge(idBytes(id)); err != nil {
+				return err
+			}
+			reached[key][id] = true
+			frontier[key] = append(frontier[key], id)
+		}
+		return nil
+	}
+	
+	// the first step follows the tuples whose subject is the subject itself
+	for _, edge := range edges[schema.ReverseKey(subject.GetType(), subject.GetRelation())] {
+		if !edge.Direct {
+			continue
+		}
+		var it *database.TupleIterator
+		it, err = command.relationshipReader.QueryRelationships(ctx, request.GetTenantId(), &base.TupleFilter{
+			Entity:   &base.EntityFilter{Type: edge.Target.GetType()},
+			Relation: edge.TupleSet,
+			Subject:  &base.SubjectFilter{Type: subject.GetType(), Ids: []string{subject.GetId()}, Relation: subject.GetRelation()},
+		}, request.GetMetadata().GetSnapToken())
+		if err != nil {
+			return nil, false, err
+		}
+		for it.HasNext() {
+			t := it.GetNext()
+			if tuple.AreSubjectsEqual(t.GetSubject(), subject) {
+				if err = add(edge.Target, t.GetEntity().GetId()); err != nil {
+					return nil, false, err
+				}
+			}
+		}
+	}
+	
+	for depth := int32(1); len(frontier) > 0 && depth < request.GetMetadata().GetDepth(); depth++ {
+		current := frontier
+		frontier = map[string][]string{}
+		for key, sources := range current {
+			sourceType, sourceRelation, _ := strings.Cut(key, "#")
+			for _, edge := range edges[key] {
+				if edge.TupleSet == "" {
+					for _, id := range sources {
+						if err = add(edge.Target, id); err != nil {
+							return nil, false, err
+						}
+					}
+					continue
+				}
+				filter := &base.SubjectFilter{Type: sourceType, Ids: sources}
+				if edge.Direct {
+					filter.Relation = sourceRelation
+				}
+				var it *database.TupleIterator
+				it, err = command.relationshipReader.QueryRelationships(ctx, request.GetTenantId(), &base.TupleFilter{
+					Entity:   &base.EntityFilter{Type: edge.Target.GetType()},
+					Relation: edge.TupleSet,
+					Subject:  filter,
+				}, request.GetMetadata().GetSnapToken())
+				if err != nil {
+					return nil, false, err
+				}
+				for it.HasNext() {
+					t := it.GetNext()
+					if err = add(edge.Target, t.GetEntity().GetId()); err != nil {
+						return nil, false, err
+					}
+				}
+			}
+		}
+	}
+	
+	// the entities past the depth are not reached, the checks report them as exceeded
+	if len(frontier) > 0 {
+		return nil, false, nil
+	}
+	
+	key := schema.ReverseKey(request.GetEntityType(), request.GetPermission())
+	ids = make([]string, 0, len(reached[key]))
+	for id := range reached[key] {
+		ids = append(ids, id)
+	}
+	return ids, true, nil
+}
+
+// candidates - Pre-filters the entity ids that need a full check. Starting from the subject, the tuples of the relations
+// that the permission depends on are followed backwards, so only the entities that the subject reaches directly or
+// through its groups are returned. ok is false when the permission excludes a relation or calls a rule, since then an
+// entity can be allowed without any path of tuples to the subject and every entity of the type must be checked.
+func (command *LookupEntityCommand) candidates(ctx context.Context, request *base.PermissionLookupEntityRequest) (ids []string, ok bool, err error) {
+	ctx, span := tracer.Start(ctx, "permissions.lookup-entity.candidates")
+	defer span.End()
+	
+	var sch *base.SchemaDefinition
+	sch, err = command.schemaReader.ReadSchema(ctx, request.GetTenantId(), request.GetMetadata().GetSchemaVersion())
+	if err != nil {
+		return nil, false, err
+	}
+	
+	dependencies, unrestricted := schema.Dependencies(sch, request.GetEntityType(), request.GetPermission())
+	if unrestricted {
+		return nil, false, nil
+	}
+	
+	// the relations that the permission depends on, grouped by the subject types they accept
+	relations := map[string][]*base.RelationReference{}
+	for _, dependency := range dependencies {
+		relation, found := sch.GetEntityDefinitions()[dependency.GetType()].GetRelations()[dependency.GetRelation()]
+		if !found {
+			continue
+		}
+		for _, ref := range relation.GetRelationReferences() {
+			relations[ref.GetType()] = append(relations[ref.GetType()], dependency)
+		}
+	}
+	
+	budget := memoryBudgetFromContext(ctx)
+	visited := map[string]map[string]bool{}
+	frontier := map[string][]string{request.GetSubject().GetType(): {request.GetSubject().GetId()}}
+	for depth := int32(0); len(frontier) > 0 && depth < request.GetMetadata().GetDepth(); depth++ {
+		next := map[string][]string{}
+		for subjectType, subjectIDs := range frontier {
+			for _, relation := range relations[subjectType] {
+				var it *database.TupleIterator
+				it, err = command.relationshipReader.QueryRelationships(ctx, request.GetTenantId(), &base.TupleFilter{
+					Entity:   &base.EntityFilter{Type: relation.GetType()},
+					Relation: relation.GetRelation(),
+					Subject:  &base.SubjectFilter{Type: subjectType, Ids: subjectIDs},
+				}, request.GetMetadata().GetSnapToken())
+				if err != nil {
+					return nil, false, err
+				}
+				for it.HasNext() {
+					entity := it.GetNext().GetEntity()
+					if visited[entity.GetType()] == nil {
+						visited[entity.GetType()] = map[string]bool{}
+					}
+					if visited[entity.GetType()][entity.GetId()] {
+						continue
+					}
+					if err = budget.charge(idBytes(entity.GetId())); err != nil {
+						return nil, false, err
+					}
+					visited[entity.GetType()][entity.GetId()] = true
+					next[entity.GetType()] = append(next[entity.GetType()], entity.GetId())
+				}
+			}
+		}
+		frontier = next
+	}
+	
+	// the entities past the depth are not reached, every entity is checked so the checks report them as exceeded
+	if len(frontier) > 0 {
+		return nil, false, nil
+	}
+	
+	ids = make([]string, 0, len(visited[request.GetEntityType()]))
+	for id := range visited[request.GetEntityType()] {
+		ids = append(ids, id)
+	}
+	return ids, true, nil
+}
+
 // internalCheck -
-func (command *LookupEntityCommand) internalCheck(ctx context.Context, en *base.Entity, request *base.PermissionLookupEntityRequest, resultChan chan<- string) error {
-	result, err := command.checkCommand.Execute(ctx, &base.PermissionCheckRequest{
+func (command *LookupEntityCommand) internalCheck(ctx context.Context, en *base.Entity, request *base.PermissionLookupEntityRequest, resultChan chan<- string, exceeded *depthExceededEntities) error {
+	result, err := command.checkEntity(ctx, en, request)
+	if err != nil {
+		return err
+	}
+	switch result {
+	case base.PermissionCheckResponse_RESULT_ALLOWED:
+		resultChan <- en.GetId()
+	case base.PermissionCheckResponse_RESULT_DEPTH_EXCEEDED:
+		exceeded.add(en.GetId())
+	}
+	return nil
+}
+
+// checkEntity - Result of the check of the permission of the lookup on the entity
+func (command *LookupEntityCommand) checkEntity(ctx context.Context, en *base.Entity, request *base.PermissionLookupEntityRequest) (base.PermissionCheckResponse_Result, error) {
+	response, err := command.checkCommand.Execute(ctx, &base.PermissionCheckRequest{
 		TenantId: request.GetTenantId(),
 		Metadata: &base.PermissionCheckRequestMetadata{
 			SnapToken:     request.GetMetadata().GetSnapToken(),
@@ -167,10 +565,31 @@ func (command *LookupEntityCommand) internalCheck(ctx context.Context, en *base.
 		Subject:    request.GetSubject(),
 	})
 	if err != nil {
-		return err
+		return base.PermissionCheckResponse_RESULT_UNKNOWN, err
 	}
-	if result.Can == base.PermissionCheckResponse_RESULT_ALLOWED {
-		resultChan <- en.GetId()
+	return response.GetCan(), nil
+}
+
+// depthExceededEntities - Ids of the candidates whose checks ran out of depth before they were decided
+type depthExceededEntities struct {
+	mu  sync.Mutex
+	ids []string
+}
+
+// add -
+func (e *depthExceededEntities) add(id string) {
+	if e == nil {
+		return
 	}
-	return nil
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.ids = append(e.ids, id)
+}
+
+// get - Sorted ids
+func (e *depthExceededEntities) get() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	sort.Strings(e.ids)
+	return e.ids
 }
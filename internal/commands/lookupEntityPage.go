@@ -0,0 +1,122 @@
+package commands
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"sort"
+	
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+// lookupEntityPage - Page of the entity ids of a lookup. The candidates are checked in ascending order of their ids
+// from the position of token, until size of them are allowed, and next is set to the token of the following page when
+// candidates remain.
+type lookupEntityPage struct {
+	size  int
+	token string
+	next  string
+}
+
+// newLookupEntityPage - Page requested by the page size and continuous token of the request, nil when the lookup is
+// not paged
+func newLookupEntityPage(request *base.PermissionLookupEntityRequest) *lookupEntityPage {
+	if request.GetPageSize() == 0 && request.GetContinuousToken() == "" {
+		return nil
+	}
+	return &lookupEntityPage{
+		size:  int(request.GetPageSize()),
+		token: request.GetContinuousToken(),
+	}
+}
+
+// lookupEntityContinuation - Position in the entity ids of a lookup. The tenant, subject, entity type and permission
+// bind the token to the lookup it was given for, the snap token and schema version pin the following pages to the
+// result the first page was cut from.
+type lookupEntityContinuation struct {
+	TenantID      string `json:"n"`
+	Subject       string `json:"u"`
+	EntityType    string `json:"t"`
+	Permission    string `json:"p"`
+	SnapToken     string `json:"s"`
+	SchemaVersion string `json:"v"`
+	// After - the last checked entity id
+	After string `json:"a"`
+}
+
+// encode -
+func (c lookupEntityContinuation) encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeLookupEntityContinuation -
+func decodeLookupEntityContinuation(token string) (c lookupEntityContinuation, err error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return c, errors.New(base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN.String())
+	}
+	if err = json.Unmarshal(b, &c); err != nil || c.SnapToken == "" || c.SchemaVersion == "" || c.After == "" {
+		return c, errors.New(base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN.String())
+	}
+	return c, nil
+}
+
+// pin - Sets the snap token and schema version of the token to the request. A token of another lookup, or one whose
+// snap token or schema version differs from the ones the request gives, is invalid.
+func (page *lookupEntityPage) pin(request *base.PermissionLookupEntityRequest) (after string, err error) {
+	if page.token == "" {
+		return "", nil
+	}
+	c, err := decodeLookupEntityContinuation(page.token)
+	if err != nil {
+		return "", err
+	}
+	if c.TenantID != request.GetTenantId() || c.Subject != tuple.SubjectToString(request.GetSubject()) || c.EntityType != request.GetEntityType() || c.Permission != request.GetPermission() {
+		return "", errors.New(base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN.String())
+	}
+	if snap := request.GetMetadata().GetSnapToken(); snap != "" && snap != c.SnapToken {
+		return "", errors.New(base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN.String())
+	}
+	if version := request.GetMetadata().GetSchemaVersion(); version != "" && version != c.SchemaVersion {
+		return "", errors.New(base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN.String())
+	}
+	if request.Metadata == nil {
+		request.Metadata = &base.PermissionLookupEntityRequestMetadata{}
+	}
+	request.Metadata.SnapToken = c.SnapToken
+	request.Metadata.SchemaVersion = c.SchemaVersion
+	return c.After, nil
+}
+
+// seek - Sorts the candidate ids and drops the ones up to the position of the page
+func (page *lookupEntityPage) seek(ids []string, after string) []string {
+	sort.Strings(ids)
+	start := sort.SearchStrings(ids, after)
+	if start < len(ids) && after != "" && ids[start] == after {
+		start++
+	}
+	return ids[start:]
+}
+
+// remaining - Number of allowed ids the page still takes after found of them, -1 when the page is unbounded
+func (page *lookupEntityPage) remaining(found int) int {
+	if page.size <= 0 {
+		return -1
+	}
+	return page.size - found
+}
+
+// continueAfter - Sets next to the token of the page that follows the checked id
+func (page *lookupEntityPage) continueAfter(request *base.PermissionLookupEntityRequest, after string) {
+	page.next = lookupEntityContinuation{
+		TenantID:      request.GetTenantId(),
+		Subject:       tuple.SubjectToString(request.GetSubject()),
+		EntityType:    request.GetEntityType(),
+		Permission:    request.GetPermission(),
+		SnapToken:     request.GetMetadata().GetSnapToken(),
+		SchemaVersion: request.GetMetadata().GetSchemaVersion(),
+		After:         after,
+	}.encode()
+}
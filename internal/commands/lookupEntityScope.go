@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"strings"
+	
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// lookupEntityScope - Candidate entities of a lookup given by the caller, only the ones with an id of ids, when it is
+// not empty, and with the prefix prefix are checked
+type lookupEntityScope struct {
+	ids    []string
+	prefix string
+}
+
+// newLookupEntityScope - Scope given by the entity ids and entity id prefix of the request, nil when every entity of
+// the type is a candidate
+func newLookupEntityScope(request *base.PermissionLookupEntityRequest) *lookupEntityScope {
+	if len(request.GetEntityIds()) == 0 && request.GetEntityIdPrefix() == "" {
+		return nil
+	}
+	return &lookupEntityScope{
+		ids:    request.GetEntityIds(),
+		prefix: request.GetEntityIdPrefix(),
+	}
+}
+
+// contains - Whether the entity id is in the scope
+func (scope *lookupEntityScope) contains(id string) bool {
+	if !strings.HasPrefix(id, scope.prefix) {
+		return false
+	}
+	if len(scope.ids) == 0 {
+		return true
+	}
+	for _, i := range scope.ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
+
+// filter - Keeps the ids in the scope
+func (scope *lookupEntityScope) filter(ids []string) []string {
+	filtered := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if scope.contains(id) {
+			filtered = append(filtered, id)
+		}
+	}
+	return filtered
+}
+
+// candidates - Entity ids of the scope that need a check, ok is false when the scope does not list them and the
+// entities of the type are filtered by the prefix instead
+func (scope *lookupEntityScope) candidates() (ids []string, ok bool) {
+	if len(scope.ids) == 0 {
+		return nil, false
+	}
+	seen := make(map[string]struct{}, len(scope.ids))
+	for _, id := range scope.ids {
+		if _, found := seen[id]; found || !strings.HasPrefix(id, scope.prefix) {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+	return ids, true
+}
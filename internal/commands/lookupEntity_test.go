@@ -2,9 +2,14 @@ package commands
 
 import (
 	"context"
+	"errors"
+	"sync/atomic"
 	
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/types/known/structpb"
 	
 	"github.com/adminium/permify/internal/keys"
 	"github.com/adminium/permify/internal/repositories/mocks"
@@ -16,6 +21,21 @@ import (
 	"github.com/adminium/permify/pkg/tuple"
 )
 
+// allowEntities - Check command allowing only the given entity ids, it counts the checks it runs
+type allowEntities struct {
+	ids    map[string]bool
+	checks atomic.Int32
+}
+
+// Execute -
+func (a *allowEntities) Execute(_ context.Context, request *base.PermissionCheckRequest) (*base.PermissionCheckResponse, error) {
+	a.checks.Add(1)
+	if a.ids[request.GetEntity().GetId()] {
+		return allowed(&base.PermissionCheckResponseMetadata{}), nil
+	}
+	return denied(&base.PermissionCheckResponseMetadata{}), nil
+}
+
 var _ = Describe("lookup-entity-command", func() {
 	var checkCommand *CheckCommand
 	
@@ -237,7 +257,39 @@ entity doc {
 				},
 			}...), nil).Times(1)
 			
-			relationshipReaderForLookupCommand.On("GetUniqueEntityIDsByEntityType", "t1", "doc", token.NewNoopToken().Encode().String()).Return([]string{"1", "2"}, nil).Times(1)
+			// the entities are found by following the tuples of the subject backwards
+			schemaReader.On("ReadSchema", "t1", "noop").Return(sch, nil).Times(1)
+			
+			for _, filter := range []*base.TupleFilter{
+				{Entity: &base.EntityFilter{Type: "doc"}, Relation: "owner", Subject: &base.SubjectFilter{Type: tuple.USER, Ids: []string{"1"}}},
+				{Entity: &base.EntityFilter{Type: "folder"}, Relation: "collaborator", Subject: &base.SubjectFilter{Type: tuple.USER, Ids: []string{"1"}}},
+			} {
+				relationshipReaderForLookupCommand.On("QueryRelationships", "t1", filter, token.NewNoopToken().Encode().String()).Return(database.NewTupleIterator(), nil).Times(1)
+			}
+			
+			relationshipReaderForLookupCommand.On("QueryRelationships", "t1", &base.TupleFilter{
+				Entity:   &base.EntityFilter{Type: "organization"},
+				Relation: "admin",
+				Subject:  &base.SubjectFilter{Type: tuple.USER, Ids: []string{"1"}},
+			}, token.NewNoopToken().Encode().String()).Return(database.NewTupleIterator([]*base.Tuple{
+				{
+					Entity:   &base.Entity{Type: "organization", Id: "1"},
+					Relation: "admin",
+					Subject:  &base.Subject{Type: tuple.USER, Id: "1"},
+				},
+			}...), nil).Times(1)
+			
+			relationshipReaderForLookupCommand.On("QueryRelationships", "t1", &base.TupleFilter{
+				Entity:   &base.EntityFilter{Type: "doc"},
+				Relation: "org",
+				Subject:  &base.SubjectFilter{Type: "organization", Ids: []string{"1"}},
+			}, token.NewNoopToken().Encode().String()).Return(database.NewTupleIterator([]*base.Tuple{
+				{
+					Entity:   &base.Entity{Type: "doc", Id: "1"},
+					Relation: "org",
+					Subject:  &base.Subject{Type: "organization", Id: "1", Relation: tuple.ELLIPSIS},
+				},
+			}...), nil).Times(1)
 			
 			checkCommand, _ = NewCheckCommand(keys.NewNoopCheckCommandKeys(), schemaReader, relationshipReader, telemetry.NewNoopMeter())
 			lookupEntityCommand := NewLookupEntityCommand(checkCommand, schemaReader, relationshipReaderForLookupCommand)
@@ -258,6 +310,548 @@ entity doc {
 			response, err = lookupEntityCommand.Execute(context.Background(), req)
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(response.EntityIds).Should(Equal([]string{"1"}))
+			
+			// doc 2 is not reachable from the subject, so it is never checked
+			relationshipReader.AssertNotCalled(GinkgoT(), "QueryRelationships", "t1", &base.TupleFilter{
+				Entity: &base.EntityFilter{
+					Type: "doc",
+					Ids:  []string{"2"},
+				},
+				Relation: "owner",
+			}, token.NewNoopToken().Encode().String())
+			relationshipReaderForLookupCommand.AssertNotCalled(GinkgoT(), "GetUniqueEntityIDsByEntityType", "t1", "doc", token.NewNoopToken().Encode().String())
+		})
+	})
+	
+	Context("Reverse Expansion: Lookup Entity", func() {
+		It("Reverse Expansion: Case 1", func() {
+			sch, err := schema.NewSchemaFromStringDefinitions(true, `
+entity user {}
+
+entity team {
+	relation member @user
+}
+
+entity folder {
+	relation viewer @user @team#member
+}
+
+entity doc {
+	relation parent @folder
+	relation owner @user
+
+	action view = owner or parent.viewer
+}
+`)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			schemaReader := new(mocks.SchemaReader)
+			schemaReader.On("ReadSchema", "t1", "noop").Return(sch, nil)
+			
+			var tuples []*base.Tuple
+			for _, t := range []string{
+				"team:1#member@user:1",
+				"folder:1#viewer@team:1#member",
+				"folder:2#viewer@user:2",
+				"doc:1#parent@folder:1",
+				"doc:2#owner@user:1",
+				"doc:3#parent@folder:2",
+				"doc:4#owner@user:2",
+			} {
+				tup, err := tuple.Tuple(t)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, tup)
+			}
+			
+			contains := func(values []string, value string) bool {
+				for _, v := range values {
+					if v == value {
+						return true
+					}
+				}
+				return false
+			}
+			
+			relationshipReader := new(mocks.RelationshipReader)
+			relationshipReader.On("QueryRelationships", "t1", mock.Anything, token.NewNoopToken().Encode().String()).Return(func(_ context.Context, _ string, filter *base.TupleFilter, _ string) *database.TupleIterator {
+				var matched []*base.Tuple
+				for _, t := range tuples {
+					if t.GetEntity().GetType() != filter.GetEntity().GetType() || t.GetRelation() != filter.GetRelation() || t.GetSubject().GetType() != filter.GetSubject().GetType() {
+						continue
+					}
+					if !contains(filter.GetSubject().GetIds(), t.GetSubject().GetId()) {
+						continue
+					}
+					if filter.GetSubject().GetRelation() != "" && t.GetSubject().GetRelation() != filter.GetSubject().GetRelation() {
+						continue
+					}
+					matched = append(matched, t)
+				}
+				return database.NewTupleIterator(matched...)
+			}, nil)
+			
+			// the entities are not checked, any read of the check command fails the test
+			checkCommand, _ = NewCheckCommand(keys.NewNoopCheckCommandKeys(), schemaReader, new(mocks.RelationshipReader), telemetry.NewNoopMeter())
+			lookupEntityCommand := NewLookupEntityCommand(checkCommand, schemaReader, relationshipReader)
+			
+			response, err := lookupEntityCommand.Execute(context.Background(), &base.PermissionLookupEntityRequest{
+				TenantId:   "t1",
+				EntityType: "doc",
+				Subject:    &base.Subject{Type: tuple.USER, Id: "1"},
+				Permission: "view",
+				Metadata: &base.PermissionLookupEntityRequestMetadata{
+					SnapToken:     token.NewNoopToken().Encode().String(),
+					SchemaVersion: "noop",
+					Depth:         20,
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(response.EntityIds).Should(ConsistOf("1", "2"))
+			relationshipReader.AssertNotCalled(GinkgoT(), "GetUniqueEntityIDsByEntityType", "t1", "doc", token.NewNoopToken().Encode().String())
+		})
+	})
+	
+	Context("Pagination: Lookup Entity", func() {
+		It("Pagination: Case 1", func() {
+			sch, err := schema.NewSchemaFromStringDefinitions(true, `
+entity user {}
+
+entity doc {
+	relation owner @user
+
+	action view = owner
+}
+`)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			schemaReader := new(mocks.SchemaReader)
+			schemaReader.On("ReadSchema", "t1", "noop").Return(sch, nil)
+			
+			var tuples []*base.Tuple
+			for _, t := range []string{
+				"doc:5#owner@user:1",
+				"doc:3#owner@user:1",
+				"doc:1#owner@user:1",
+				"doc:4#owner@user:1",
+				"doc:2#owner@user:1",
+			} {
+				tup, err := tuple.Tuple(t)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, tup)
+			}
+			
+			relationshipReader := new(mocks.RelationshipReader)
+			relationshipReader.On("QueryRelationships", "t1", mock.Anything, token.NewNoopToken().Encode().String()).Return(func(_ context.Context, _ string, filter *base.TupleFilter, _ string) *database.TupleIterator {
+				if filter.GetRelation() != "owner" {
+					return database.NewTupleIterator()
+				}
+				return database.NewTupleIterator(tuples...)
+			}, nil)
+			
+			checkCommand, _ = NewCheckCommand(keys.NewNoopCheckCommandKeys(), schemaReader, new(mocks.RelationshipReader), telemetry.NewNoopMeter())
+			lookupEntityCommand := NewLookupEntityCommand(checkCommand, schemaReader, relationshipReader)
+			
+			request := func() *base.PermissionLookupEntityRequest {
+				return &base.PermissionLookupEntityRequest{
+					TenantId:   "t1",
+					EntityType: "doc",
+					Subject:    &base.Subject{Type: tuple.USER, Id: "1"},
+					Permission: "view",
+					Metadata: &base.PermissionLookupEntityRequestMetadata{
+						SnapToken:     token.NewNoopToken().Encode().String(),
+						SchemaVersion: "noop",
+						Depth:         20,
+					},
+				}
+			}
+			
+			var pages [][]string
+			var continuousToken string
+			for {
+				req := request()
+				req.PageSize = 2
+				req.ContinuousToken = continuousToken
+				response, err := lookupEntityCommand.Execute(context.Background(), req)
+				Expect(err).ShouldNot(HaveOccurred())
+				pages = append(pages, response.GetEntityIds())
+				if response.GetContinuousToken() == "" {
+					break
+				}
+				continuousToken = response.GetContinuousToken()
+			}
+			Expect(pages).Should(Equal([][]string{{"1", "2"}, {"3", "4"}, {"5"}}))
+			
+			req := request()
+			req.PageSize = 2
+			req.ContinuousToken = "invalid"
+			_, err = lookupEntityCommand.Execute(context.Background(), req)
+			Expect(err).Should(Equal(errors.New(base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN.String())))
+		})
+		
+		It("Pagination: Case 2", func() {
+			sch, err := schema.NewSchemaFromStringDefinitions(true, `
+entity user {}
+
+entity doc {
+	relation owner @user
+
+	action view = owner
+}
+`)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			schemaReader := new(mocks.SchemaReader)
+			schemaReader.On("ReadSchema", "t1", "noop").Return(sch, nil)
+			
+			var tuples []*base.Tuple
+			for _, t := range []string{"doc:1#owner@user:1", "doc:2#owner@user:1", "doc:3#owner@user:1"} {
+				tup, err := tuple.Tuple(t)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, tup)
+			}
+			
+			relationshipReader := new(mocks.RelationshipReader)
+			relationshipReader.On("QueryRelationships", "t1", mock.Anything, token.NewNoopToken().Encode().String()).Return(func(_ context.Context, _ string, filter *base.TupleFilter, _ string) *database.TupleIterator {
+				return database.NewTupleIterator(tuples...)
+			}, nil)
+			
+			checkCommand, _ = NewCheckCommand(keys.NewNoopCheckCommandKeys(), schemaReader, new(mocks.RelationshipReader), telemetry.NewNoopMeter())
+			lookupEntityCommand := NewLookupEntityCommand(checkCommand, schemaReader, relationshipReader)
+			
+			request := func() *base.PermissionLookupEntityRequest {
+				return &base.PermissionLookupEntityRequest{
+					TenantId:   "t1",
+					EntityType: "doc",
+					Subject:    &base.Subject{Type: tuple.USER, Id: "1"},
+					Permission: "view",
+					PageSize:   2,
+					Metadata: &base.PermissionLookupEntityRequestMetadata{
+						SnapToken:     token.NewNoopToken().Encode().String(),
+						SchemaVersion: "noop",
+						Depth:         20,
+					},
+				}
+			}
+			
+			response, err := lookupEntityCommand.Execute(context.Background(), request())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(response.GetEntityIds()).Should(Equal([]string{"1", "2"}))
+			Expect(response.GetContinuousToken()).ShouldNot(BeEmpty())
+			
+			// the token is bound to the tenant, the subject and the snapshot of the lookup it was given for
+			for _, change := range []func(r *base.PermissionLookupEntityRequest){
+				func(r *base.PermissionLookupEntityRequest) { r.TenantId = "t2" },
+				func(r *base.PermissionLookupEntityRequest) { r.Subject.Id = "2" },
+				func(r *base.PermissionLookupEntityRequest) { r.Metadata.SnapToken = "other" },
+			} {
+				req := request()
+				req.ContinuousToken = response.GetContinuousToken()
+				change(req)
+				_, err = lookupEntityCommand.Execute(context.Background(), req)
+				Expect(err).Should(Equal(errors.New(base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN.String())))
+			}
+			
+			req := request()
+			req.ContinuousToken = response.GetContinuousToken()
+			response, err = lookupEntityCommand.Execute(context.Background(), req)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(response.GetEntityIds()).Should(Equal([]string{"3"}))
+			Expect(response.GetContinuousToken()).Should(BeEmpty())
+		})
+		
+		It("Pagination: Case 3", func() {
+			sch, err := schema.NewSchemaFromStringDefinitions(true, `
+entity user {}
+
+entity doc {
+	relation owner @user
+
+	action view = owner
+}
+`)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			schemaReader := new(mocks.SchemaReader)
+			schemaReader.On("ReadSchema", "t1", "noop").Return(sch, nil)
+			
+			var tuples []*base.Tuple
+			for _, t := range []string{"doc:6#owner@user:1", "doc:4#owner@user:1", "doc:2#owner@user:1", "doc:5#owner@user:1", "doc:3#owner@user:1", "doc:1#owner@user:1"} {
+				tup, err := tuple.Tuple(t)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, tup)
+			}
+			
+			relationshipReader := new(mocks.RelationshipReader)
+			relationshipReader.On("QueryRelationships", "t1", mock.Anything, token.NewNoopToken().Encode().String()).Return(func(_ context.Context, _ string, filter *base.TupleFilter, _ string) *database.TupleIterator {
+				return database.NewTupleIterator(tuples...)
+			}, nil)
+			
+			// the candidates are checked in the order of their ids until the page is full
+			checks := &allowEntities{ids: map[string]bool{"1": true, "3": true, "5": true}}
+			lookupEntityCommand := NewLookupEntityCommand(checks, schemaReader, relationshipReader)
+			
+			request := func(continuousToken string) *base.PermissionLookupEntityRequest {
+				return &base.PermissionLookupEntityRequest{
+					TenantId:        "t1",
+					EntityType:      "doc",
+					Subject:         &base.Subject{Type: tuple.USER, Id: "1"},
+					Permission:      "view",
+					PageSize:        2,
+					ContinuousToken: continuousToken,
+					Metadata: &base.PermissionLookupEntityRequestMetadata{
+						SnapToken:     token.NewNoopToken().Encode().String(),
+						SchemaVersion: "noop",
+						Depth:         20,
+					},
+				}
+			}
+			
+			response, err := lookupEntityCommand.Execute(context.Background(), request(""))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(response.GetEntityIds()).Should(Equal([]string{"1", "3"}))
+			Expect(checks.checks.Load()).Should(Equal(int32(3)))
+			
+			response, err = lookupEntityCommand.Execute(context.Background(), request(response.GetContinuousToken()))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(response.GetEntityIds()).Should(Equal([]string{"5"}))
+			Expect(checks.checks.Load()).Should(Equal(int32(6)))
+			Expect(response.GetContinuousToken()).Should(BeEmpty())
+		})
+	})
+	
+	Context("Scope: Lookup Entity", func() {
+		It("Scope: Case 1", func() {
+			sch, err := schema.NewSchemaFromStringDefinitions(true, `
+entity user {}
+
+entity doc {
+	relation owner @user
+	relation blocked @user
+
+	action view = owner and not blocked
+}
+`)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			schemaReader := new(mocks.SchemaReader)
+			schemaReader.On("ReadSchema", "t1", "noop").Return(sch, nil)
+			schemaReader.On("ReadSchemaDefinition", "t1", "doc", "noop").Return(sch.GetEntityDefinitions()["doc"], "noop", nil)
+			
+			var tuples []*base.Tuple
+			for _, t := range []string{
+				"doc:a1#owner@user:1",
+				"doc:a2#owner@user:1",
+				"doc:a3#owner@user:1",
+				"doc:a3#blocked@user:1",
+				"doc:b1#owner@user:1",
+			} {
+				tup, err := tuple.Tuple(t)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, tup)
+			}
+			
+			relationshipReader := new(mocks.RelationshipReader)
+			relationshipReader.On("QueryRelationships", "t1", mock.Anything, token.NewNoopToken().Encode().String()).Return(func(_ context.Context, _ string, filter *base.TupleFilter, _ string) *database.TupleIterator {
+				var matched []*base.Tuple
+				for _, t := range tuples {
+					if t.GetRelation() != filter.GetRelation() {
+						continue
+					}
+					if ids := filter.GetEntity().GetIds(); len(ids) > 0 && !slices.Contains(ids, t.GetEntity().GetId()) {
+						continue
+					}
+					matched = append(matched, t)
+				}
+				return database.NewTupleIterator(matched...)
+			}, nil)
+			relationshipReader.On("GetUniqueEntityIDsByEntityType", "t1", "doc", token.NewNoopToken().Encode().String()).Return([]string{"a1", "a2", "a3", "b1"}, nil)
+			
+			checkCommand, _ = NewCheckCommand(keys.NewNoopCheckCommandKeys(), schemaReader, relationshipReader, telemetry.NewNoopMeter())
+			lookupEntityCommand := NewLookupEntityCommand(checkCommand, schemaReader, relationshipReader)
+			
+			request := func() *base.PermissionLookupEntityRequest {
+				return &base.PermissionLookupEntityRequest{
+					TenantId:   "t1",
+					EntityType: "doc",
+					Subject:    &base.Subject{Type: tuple.USER, Id: "1"},
+					Permission: "view",
+					Metadata: &base.PermissionLookupEntityRequestMetadata{
+						SnapToken:     token.NewNoopToken().Encode().String(),
+						SchemaVersion: "noop",
+						Depth:         20,
+					},
+				}
+			}
+			
+			req := request()
+			req.EntityIds = []string{"a2", "a3", "b1", "c1", "a2"}
+			response, err := lookupEntityCommand.Execute(context.Background(), req)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(response.GetEntityIds()).Should(ConsistOf("a2", "b1"))
+			relationshipReader.AssertNotCalled(GinkgoT(), "GetUniqueEntityIDsByEntityType", mock.Anything, "t1", "doc", mock.Anything)
+			
+			req = request()
+			req.EntityIdPrefix = "a"
+			response, err = lookupEntityCommand.Execute(context.Background(), req)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(response.GetEntityIds()).Should(ConsistOf("a1", "a2"))
+			
+			req = request()
+			req.EntityIds = []string{"a1", "b1"}
+			req.EntityIdPrefix = "b"
+			response, err = lookupEntityCommand.Execute(context.Background(), req)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(response.GetEntityIds()).Should(ConsistOf("b1"))
+		})
+	})
+	
+	Context("Depth: Lookup Entity", func() {
+		It("Depth: Case 1", func() {
+			sch, err := schema.NewSchemaFromStringDefinitions(true, `
+entity user {}
+
+entity team {
+	relation member @user @team#member
+}
+
+entity doc {
+	relation owner @user
+	relation viewer @team#member
+
+	action view = owner or viewer
+}
+`)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			schemaReader := new(mocks.SchemaReader)
+			schemaReader.On("ReadSchema", "t1", "noop").Return(sch, nil)
+			schemaReader.On("ReadSchemaDefinition", "t1", "team", "noop").Return(sch.GetEntityDefinitions()["team"], "noop", nil)
+			schemaReader.On("ReadSchemaDefinition", "t1", "doc", "noop").Return(sch.GetEntityDefinitions()["doc"], "noop", nil)
+			
+			// the viewers of doc 2 reach user 1 through three teams
+			var tuples []*base.Tuple
+			for _, t := range []string{
+				"team:1#member@user:1",
+				"team:2#member@team:1#member",
+				"team:3#member@team:2#member",
+				"doc:1#viewer@team:1#member",
+				"doc:2#viewer@team:3#member",
+				"doc:3#owner@user:1",
+				"doc:4#owner@user:2",
+			} {
+				tup, err := tuple.Tuple(t)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, tup)
+			}
+			
+			relationshipReader := new(mocks.RelationshipReader)
+			relationshipReader.On("QueryRelationships", "t1", mock.Anything, token.NewNoopToken().Encode().String()).Return(func(_ context.Context, _ string, filter *base.TupleFilter, _ string) *database.TupleIterator {
+				var matched []*base.Tuple
+				for _, t := range tuples {
+					if t.GetEntity().GetType() != filter.GetEntity().GetType() || t.GetRelation() != filter.GetRelation() {
+						continue
+					}
+					if ids := filter.GetEntity().GetIds(); len(ids) > 0 && !slices.Contains(ids, t.GetEntity().GetId()) {
+						continue
+					}
+					if subject := filter.GetSubject(); subject != nil {
+						if t.GetSubject().GetType() != subject.GetType() || !slices.Contains(subject.GetIds(), t.GetSubject().GetId()) {
+							continue
+						}
+						if subject.GetRelation() != "" && t.GetSubject().GetRelation() != subject.GetRelation() {
+							continue
+						}
+					}
+					matched = append(matched, t)
+				}
+				return database.NewTupleIterator(matched...)
+			}, nil)
+			relationshipReader.On("GetUniqueEntityIDsByEntityType", "t1", "doc", token.NewNoopToken().Encode().String()).Return([]string{"1", "2", "3", "4"}, nil)
+			
+			checkCommand, _ = NewCheckCommand(keys.NewNoopCheckCommandKeys(), schemaReader, relationshipReader, telemetry.NewNoopMeter())
+			lookupEntityCommand := NewLookupEntityCommand(checkCommand, schemaReader, relationshipReader)
+			
+			request := func(depth int32) *base.PermissionLookupEntityRequest {
+				return &base.PermissionLookupEntityRequest{
+					TenantId:   "t1",
+					EntityType: "doc",
+					Subject:    &base.Subject{Type: tuple.USER, Id: "1"},
+					Permission: "view",
+					Metadata: &base.PermissionLookupEntityRequestMetadata{
+						SnapToken:     token.NewNoopToken().Encode().String(),
+						SchemaVersion: "noop",
+						Depth:         depth,
+					},
+				}
+			}
+			
+			response, err := lookupEntityCommand.Execute(context.Background(), request(20))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(response.GetEntityIds()).Should(ConsistOf("1", "2", "3"))
+			Expect(response.GetDepthExceededEntityIds()).Should(BeEmpty())
+			
+			// doc 2 is past the depth, it is neither allowed nor denied
+			response, err = lookupEntityCommand.Execute(context.Background(), request(4))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(response.GetEntityIds()).Should(ConsistOf("1", "3"))
+			Expect(response.GetDepthExceededEntityIds()).Should(Equal([]string{"2"}))
+		})
+	})
+	
+	Context("Rule: Lookup Entity", func() {
+		It("Rule: Case 1", func() {
+			definitions := []string{
+				"entity user {}",
+				"entity repository {\n\tattribute visibility string\n\trelation owner @user\n\taction read = owner or is_public(visibility)\n}",
+				"rule is_public(visibility string) {\n\tvisibility == \"public\"\n}",
+			}
+			sch, err := schema.NewSchemaFromStringDefinitions(true, definitions...)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			schemaReader := new(mocks.SchemaReader)
+			schemaReader.On("ReadSchema", "t1", "noop").Return(sch, nil)
+			schemaReader.On("ReadSchemaString", "t1", "noop").Return(definitions, nil)
+			schemaReader.On("ReadSchemaDefinition", "t1", "repository", "noop").Return(sch.GetEntityDefinitions()["repository"], "noop", nil)
+			
+			relationshipReader := new(mocks.RelationshipReader)
+			relationshipReader.On("QueryRelationships", "t1", mock.Anything, token.NewNoopToken().Encode().String()).Return(func(_ context.Context, _ string, filter *base.TupleFilter, _ string) *database.TupleIterator {
+				if filter.GetRelation() == "owner" && slices.Contains(filter.GetEntity().GetIds(), "1") {
+					return database.NewTupleIterator(&base.Tuple{
+						Entity:   &base.Entity{Type: "repository", Id: "1"},
+						Relation: "owner",
+						Subject:  &base.Subject{Type: tuple.USER, Id: "1"},
+					})
+				}
+				return database.NewTupleIterator()
+			}, nil)
+			relationshipReader.On("GetUniqueEntityIDsByEntityType", "t1", "repository", token.NewNoopToken().Encode().String()).Return([]string{"1", "2", "3"}, nil)
+			
+			// repository 2 is public and has no owner, repository 3 is private
+			attributeReader := new(mocks.AttributeReader)
+			attributeReader.On("ReadAttributes", "t1", &base.Entity{Type: "repository", Id: "1"}).Return(map[string]*structpb.Value{}, nil)
+			attributeReader.On("ReadAttributes", "t1", &base.Entity{Type: "repository", Id: "2"}).Return(map[string]*structpb.Value{
+				"visibility": structpb.NewStringValue("public"),
+			}, nil)
+			attributeReader.On("ReadAttributes", "t1", &base.Entity{Type: "repository", Id: "3"}).Return(map[string]*structpb.Value{
+				"visibility": structpb.NewStringValue("private"),
+			}, nil)
+			
+			checkCommand, _ = NewCheckCommand(keys.NewNoopCheckCommandKeys(), schemaReader, relationshipReader, telemetry.NewNoopMeter(), Attributes(attributeReader))
+			lookupEntityCommand := NewLookupEntityCommand(checkCommand, schemaReader, relationshipReader)
+			
+			response, err := lookupEntityCommand.Execute(context.Background(), &base.PermissionLookupEntityRequest{
+				TenantId:   "t1",
+				EntityType: "repository",
+				Subject:    &base.Subject{Type: tuple.USER, Id: "1"},
+				Permission: "read",
+				Metadata: &base.PermissionLookupEntityRequestMetadata{
+					SnapToken:     token.NewNoopToken().Encode().String(),
+					SchemaVersion: "noop",
+					Depth:         20,
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(response.GetEntityIds()).Should(ConsistOf("1", "2"))
 		})
 	})
 })
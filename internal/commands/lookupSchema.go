@@ -10,6 +10,7 @@ import (
 	otelCodes "go.opentelemetry.io/otel/codes"
 	
 	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/pkg/dsl/utils"
 	base "github.com/adminium/permify/pkg/pb/base/v1"
 )
 
@@ -52,7 +53,7 @@ func (command *LookupSchemaCommand) Execute(ctx context.Context, request *base.P
 	
 	for _, action := range en.GetActions() {
 		var can bool
-		can, err = command.l(ctx, request, action.Child)
+		can, err = command.l(ctx, request, en, action.Child)
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(otelCodes.Error, err.Error())
@@ -86,13 +87,13 @@ type SchemaLookupFunction func(ctx context.Context, lookupChan chan<- SchemaLook
 type SchemaLookupCombiner func(ctx context.Context, functions []SchemaLookupFunction) SchemaLookupDecision
 
 // c -
-func (command *LookupSchemaCommand) l(ctx context.Context, request *base.PermissionLookupSchemaRequest, child *base.Child) (bool, error) {
+func (command *LookupSchemaCommand) l(ctx context.Context, request *base.PermissionLookupSchemaRequest, en *base.EntityDefinition, child *base.Child) (bool, error) {
 	var fn SchemaLookupFunction
 	switch child.Type.(type) {
 	case *base.Child_Rewrite:
-		fn = command.lookupRewrite(ctx, request, child.GetRewrite())
+		fn = command.lookupRewrite(ctx, request, en, child.GetRewrite())
 	case *base.Child_Leaf:
-		fn = command.lookupLeaf(ctx, request, child.GetLeaf())
+		fn = command.lookupLeaf(ctx, request, en, child.GetLeaf())
 	}
 	
 	if fn == nil {
@@ -104,38 +105,48 @@ func (command *LookupSchemaCommand) l(ctx context.Context, request *base.Permiss
 }
 
 // lookupRewrite -
-func (command *LookupSchemaCommand) lookupRewrite(ctx context.Context, request *base.PermissionLookupSchemaRequest, rewrite *base.Rewrite) SchemaLookupFunction {
+func (command *LookupSchemaCommand) lookupRewrite(ctx context.Context, request *base.PermissionLookupSchemaRequest, en *base.EntityDefinition, rewrite *base.Rewrite) SchemaLookupFunction {
 	switch rewrite.GetRewriteOperation() {
 	case *base.Rewrite_OPERATION_UNION.Enum():
-		return command.setChild(ctx, request, rewrite.GetChildren(), schemaLookupUnion)
+		return command.setChild(ctx, request, en, rewrite.GetChildren(), schemaLookupUnion)
 	case *base.Rewrite_OPERATION_INTERSECTION.Enum():
-		return command.setChild(ctx, request, rewrite.GetChildren(), schemaLookupIntersection)
+		return command.setChild(ctx, request, en, rewrite.GetChildren(), schemaLookupIntersection)
 	default:
 		return schemaLookupFail(errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_CHILD_TYPE.String()))
 	}
 }
 
 // checkLeaf -
-func (command *LookupSchemaCommand) lookupLeaf(ctx context.Context, request *base.PermissionLookupSchemaRequest, leaf *base.Leaf) SchemaLookupFunction {
+func (command *LookupSchemaCommand) lookupLeaf(ctx context.Context, request *base.PermissionLookupSchemaRequest, en *base.EntityDefinition, leaf *base.Leaf) SchemaLookupFunction {
 	switch leaf.GetType().(type) {
 	case *base.Leaf_TupleToUserSet:
 		return command.lookup(ctx, fmt.Sprintf("%s.%s", leaf.GetTupleToUserSet().GetTupleSet().GetRelation(), leaf.GetTupleToUserSet().GetComputed().GetRelation()), request, leaf.GetExclusion())
 	case *base.Leaf_ComputedUserSet:
+		if action, ok := en.GetActions()[leaf.GetComputedUserSet().GetRelation()]; ok {
+			return command.lookupAction(ctx, request, en, action, leaf.GetExclusion())
+		}
+		// the relation of a caveat is looked up as the relation, its tuples may not satisfy the caveat
+		if relation, _, ok := utils.ParseCaveatReference(leaf.GetComputedUserSet().GetRelation()); ok {
+			return command.lookup(ctx, relation, request, leaf.GetExclusion())
+		}
 		return command.lookup(ctx, leaf.GetComputedUserSet().GetRelation(), request, leaf.GetExclusion())
+	case *base.Leaf_Condition:
+		// like the calls of rules, conditions are not granted by relations
+		return command.lookup(ctx, utils.ConditionReference(leaf.GetCondition().GetExpression()), request, leaf.GetExclusion())
 	default:
 		return schemaLookupFail(errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_CHILD_TYPE.String()))
 	}
 }
 
 // set -
-func (command *LookupSchemaCommand) setChild(ctx context.Context, request *base.PermissionLookupSchemaRequest, children []*base.Child, combiner SchemaLookupCombiner) SchemaLookupFunction {
+func (command *LookupSchemaCommand) setChild(ctx context.Context, request *base.PermissionLookupSchemaRequest, en *base.EntityDefinition, children []*base.Child, combiner SchemaLookupCombiner) SchemaLookupFunction {
 	var functions []SchemaLookupFunction
 	for _, child := range children {
 		switch child.GetType().(type) {
 		case *base.Child_Rewrite:
-			functions = append(functions, command.lookupRewrite(ctx, request, child.GetRewrite()))
+			functions = append(functions, command.lookupRewrite(ctx, request, en, child.GetRewrite()))
 		case *base.Child_Leaf:
-			functions = append(functions, command.lookupLeaf(ctx, request, child.GetLeaf()))
+			functions = append(functions, command.lookupLeaf(ctx, request, en, child.GetLeaf()))
 		default:
 			return schemaLookupFail(errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_CHILD_KIND.String()))
 		}
@@ -146,6 +157,19 @@ func (command *LookupSchemaCommand) setChild(ctx context.Context, request *base.
 	}
 }
 
+// lookupAction - An action referenced by another action of the entity is looked up with its own definition, the
+// compiler rejects actions that reference themselves
+func (command *LookupSchemaCommand) lookupAction(ctx context.Context, request *base.PermissionLookupSchemaRequest, en *base.EntityDefinition, action *base.ActionDefinition, exclusion bool) SchemaLookupFunction {
+	return func(ctx context.Context, lookupChan chan<- SchemaLookupDecision) {
+		can, err := command.l(ctx, request, en, action.GetChild())
+		if err != nil {
+			lookupChan <- sendSchemaLookupDecision(false, err)
+			return
+		}
+		lookupChan <- sendSchemaLookupDecision(can != exclusion, nil)
+	}
+}
+
 // check -
 func (command *LookupSchemaCommand) lookup(ctx context.Context, relation string, request *base.PermissionLookupSchemaRequest, exclusion bool) SchemaLookupFunction {
 	return func(ctx context.Context, lookupChan chan<- SchemaLookupDecision) {
@@ -212,4 +212,50 @@ var _ = Describe("lookup-schema-command", func() {
 			}
 		})
 	})
+	
+	Context("Action References: Lookup Schema", func() {
+		It("Action References: Case 1", func() {
+			sch, err := schema.NewSchemaFromStringDefinitions(true, `
+	entity user {}
+	
+	entity doc {
+		relation owner @user
+		relation admin @user
+		relation banned @user
+		
+		action edit = owner
+		action delete = edit or admin
+		action share = delete and not banned
+		action view = not edit
+	}
+	`)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			var en *base.EntityDefinition
+			en, err = schema.GetEntityByName(sch, "doc")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			schemaReader := new(mocks.SchemaReader)
+			schemaReader.On("ReadSchemaDefinition", "t1", "doc", "noop").Return(en, "noop", nil)
+			
+			lookupSchemaCommand = NewLookupSchemaCommand(schemaReader)
+			
+			lookup := func(relations ...string) []string {
+				response, err := lookupSchemaCommand.Execute(context.Background(), &base.PermissionLookupSchemaRequest{
+					TenantId:      "t1",
+					EntityType:    "doc",
+					RelationNames: relations,
+					Metadata: &base.PermissionLookupSchemaRequestMetadata{
+						SchemaVersion: "noop",
+					},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				slices.Sort(response.ActionNames)
+				return response.ActionNames
+			}
+			
+			Expect(lookup("owner")).Should(Equal([]string{"delete", "edit", "share"}))
+			Expect(lookup("admin", "banned")).Should(Equal([]string{"delete", "view"}))
+		})
+	})
 })
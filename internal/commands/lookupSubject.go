@@ -0,0 +1,213 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	
+	"golang.org/x/sync/errgroup"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/internal/schema"
+	"github.com/adminium/permify/pkg/database"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/token"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+// LookupSubjectRequest - Asks which subjects of a type have the permission on the entity. The subject reference
+// is the subject type and, for subject sets, its relation, e.g. user or organization#member.
+type LookupSubjectRequest struct {
+	TenantID         string
+	Entity           *base.Entity
+	Permission       string
+	SubjectReference *base.RelationReference
+	Metadata         *base.PermissionLookupEntityRequestMetadata
+}
+
+// LookupSubjectResponse -
+type LookupSubjectResponse struct {
+	SubjectIDs []string
+}
+
+// LookupSubjectCommand -
+type LookupSubjectCommand struct {
+	// commands
+	checkCommand ICheckCommand
+	// repositories
+	schemaReader       repositories.SchemaReader
+	relationshipReader repositories.RelationshipReader
+	// options
+	memory *MemoryTracker
+}
+
+// NewLookupSubjectCommand -
+func NewLookupSubjectCommand(ck ICheckCommand, sr repositories.SchemaReader, rr repositories.RelationshipReader, opts ...LookupSubjectOption) *LookupSubjectCommand {
+	command := &LookupSubjectCommand{
+		checkCommand:       ck,
+		schemaReader:       sr,
+		relationshipReader: rr,
+	}
+	for _, opt := range opts {
+		opt(command)
+	}
+	return command
+}
+
+// Execute - Subjects are only found through the tuples of the relations the permission depends on, so a subject
+// allowed solely because it is not in an excluded relation is not returned. Every candidate is checked, the ids of
+// the allowed ones are returned in ascending order.
+func (command *LookupSubjectCommand) Execute(ctx context.Context, request *LookupSubjectRequest) (response *LookupSubjectResponse, err error) {
+	ctx, span := tracer.Start(ctx, "permissions.lookup-subject.execute")
+	defer span.End()
+	
+	ctx, release := command.memory.begin(ctx)
+	defer release()
+	
+	if request.Metadata == nil {
+		request.Metadata = &base.PermissionLookupEntityRequestMetadata{}
+	}
+	
+	if request.Metadata.GetSnapToken() == "" {
+		var st token.SnapToken
+		st, err = command.relationshipReader.HeadSnapshot(ctx, request.TenantID)
+		if err != nil {
+			return response, err
+		}
+		request.Metadata.SnapToken = st.Encode().String()
+	}
+	
+	if request.Metadata.GetSchemaVersion() == "" {
+		request.Metadata.SchemaVersion, err = command.schemaReader.HeadVersion(ctx, request.TenantID)
+		if err != nil {
+			return response, err
+		}
+	}
+	
+	if request.Metadata.GetDepth() < 3 {
+		return response, errors.New(base.ErrorCode_ERROR_CODE_DEPTH_NOT_ENOUGH.String())
+	}
+	
+	var ids []string
+	ids, err = command.candidates(ctx, request)
+	if err != nil {
+		return response, err
+	}
+	
+	var mu sync.Mutex
+	allowed := make([]string, 0, len(ids))
+	
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(100)
+	
+	for _, id := range ids {
+		id := id
+		g.Go(func() error {
+			result, err := command.checkCommand.Execute(ctx, &base.PermissionCheckRequest{
+				TenantId: request.TenantID,
+				Metadata: &base.PermissionCheckRequestMetadata{
+					SnapToken:     request.Metadata.GetSnapToken(),
+					SchemaVersion: request.Metadata.GetSchemaVersion(),
+					Depth:         request.Metadata.GetDepth(),
+				},
+				Entity:     request.Entity,
+				Permission: request.Permission,
+				Subject: &base.Subject{
+					Type:     request.SubjectReference.GetType(),
+					Id:       id,
+					Relation: request.SubjectReference.GetRelation(),
+				},
+			})
+			if err != nil {
+				return err
+			}
+			if result.GetCan() == base.PermissionCheckResponse_RESULT_ALLOWED {
+				mu.Lock()
+				allowed = append(allowed, id)
+				mu.Unlock()
+			}
+			return nil
+		})
+	}
+	
+	if err = g.Wait(); err != nil {
+		return response, err
+	}
+	
+	sort.Strings(allowed)
+	return &LookupSubjectResponse{
+		SubjectIDs: allowed,
+	}, nil
+}
+
+// candidates - Ids of the subjects of the requested type that are reached from the entity by following the tuples of
+// the relations that the permission depends on, directly or through the subject sets they contain
+func (command *LookupSubjectCommand) candidates(ctx context.Context, request *LookupSubjectRequest) (ids []string, err error) {
+	ctx, span := tracer.Start(ctx, "permissions.lookup-subject.candidates")
+	defer span.End()
+	
+	var sch *base.SchemaDefinition
+	sch, err = command.schemaReader.ReadSchema(ctx, request.TenantID, request.Metadata.GetSchemaVersion())
+	if err != nil {
+		return nil, err
+	}
+	
+	dependencies, _ := schema.Dependencies(sch, request.Entity.GetType(), request.Permission)
+	
+	// the relations that the permission depends on, grouped by their entity types
+	relations := map[string][]string{}
+	for _, dependency := range dependencies {
+		if _, found := sch.GetEntityDefinitions()[dependency.GetType()].GetRelations()[dependency.GetRelation()]; found {
+			relations[dependency.GetType()] = append(relations[dependency.GetType()], dependency.GetRelation())
+		}
+	}
+	
+	budget := memoryBudgetFromContext(ctx)
+	found := map[string]bool{}
+	visited := map[string]bool{tuple.EntityToString(request.Entity): true}
+	frontier := []*base.Entity{request.Entity}
+	for depth := int32(0); len(frontier) > 0 && depth < request.Metadata.GetDepth(); depth++ {
+		var next []*base.Entity
+		for _, entity := range frontier {
+			for _, relation := range relations[entity.GetType()] {
+				var it *database.TupleIterator
+				it, err = command.relationshipReader.QueryRelationships(ctx, request.TenantID, &base.TupleFilter{
+					Entity:   &base.EntityFilter{Type: entity.GetType(), Ids: []string{entity.GetId()}},
+					Relation: relation,
+				}, request.Metadata.GetSnapToken())
+				if err != nil {
+					return nil, err
+				}
+				for it.HasNext() {
+					subject := it.GetNext().GetSubject()
+					if subject.GetType() == request.SubjectReference.GetType() && !found[subject.GetId()] {
+						if err = budget.charge(idBytes(subject.GetId())); err != nil {
+							return nil, err
+						}
+						found[subject.GetId()] = true
+					}
+					if tuple.IsSubjectUser(subject) {
+						continue
+					}
+					e := &base.Entity{Type: subject.GetType(), Id: subject.GetId()}
+					if visited[tuple.EntityToString(e)] {
+						continue
+					}
+					if err = budget.charge(idBytes(tuple.EntityToString(e))); err != nil {
+						return nil, err
+					}
+					visited[tuple.EntityToString(e)] = true
+					next = append(next, e)
+				}
+			}
+		}
+		frontier = next
+	}
+	
+	ids = make([]string, 0, len(found))
+	for id := range found {
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"context"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	
+	"github.com/adminium/permify/internal/repositories/mocks"
+	"github.com/adminium/permify/internal/schema"
+	"github.com/adminium/permify/pkg/database"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/token"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+// allowSubjects - Check command allowing only the given subject ids
+type allowSubjects map[string]bool
+
+// Execute -
+func (a allowSubjects) Execute(_ context.Context, request *base.PermissionCheckRequest) (*base.PermissionCheckResponse, error) {
+	if a[request.GetSubject().GetId()] {
+		return allowed(&base.PermissionCheckResponseMetadata{}), nil
+	}
+	return denied(&base.PermissionCheckResponseMetadata{}), nil
+}
+
+var _ = Describe("lookup-subject-command", func() {
+	driveSchema := `
+	entity user {}
+	
+	entity organization {
+		relation admin @user
+	}
+	
+	entity doc {
+		relation org @organization
+		relation owner @user
+		
+		action read = owner or org.admin
+	}
+	`
+	
+	Context("Drive Sample: Lookup Subject", func() {
+		It("Drive Sample: Case 1", func() {
+			sch, err := schema.NewSchemaFromStringDefinitions(true, driveSchema)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			schemaReader := new(mocks.SchemaReader)
+			schemaReader.On("ReadSchema", "t1", "noop").Return(sch, nil).Times(1)
+			
+			relationshipReader := new(mocks.RelationshipReader)
+			
+			query := func(entityType, entityID, relation string, subjects ...*base.Subject) {
+				var tuples []*base.Tuple
+				for _, subject := range subjects {
+					tuples = append(tuples, &base.Tuple{
+						Entity:   &base.Entity{Type: entityType, Id: entityID},
+						Relation: relation,
+						Subject:  subject,
+					})
+				}
+				relationshipReader.On("QueryRelationships", "t1", &base.TupleFilter{
+					Entity:   &base.EntityFilter{Type: entityType, Ids: []string{entityID}},
+					Relation: relation,
+				}, token.NewNoopToken().Encode().String()).Return(database.NewTupleIterator(tuples...), nil).Times(1)
+			}
+			
+			query("doc", "1", "owner", &base.Subject{Type: tuple.USER, Id: "2"})
+			query("doc", "1", "org", &base.Subject{Type: "organization", Id: "1", Relation: tuple.ELLIPSIS})
+			query("organization", "1", "admin", &base.Subject{Type: tuple.USER, Id: "1"}, &base.Subject{Type: tuple.USER, Id: "3"})
+			
+			command := NewLookupSubjectCommand(allowSubjects{"1": true, "2": true}, schemaReader, relationshipReader)
+			
+			response, err := command.Execute(context.Background(), &LookupSubjectRequest{
+				TenantID:         "t1",
+				Entity:           &base.Entity{Type: "doc", Id: "1"},
+				Permission:       "read",
+				SubjectReference: &base.RelationReference{Type: tuple.USER},
+				Metadata: &base.PermissionLookupEntityRequestMetadata{
+					SnapToken:     token.NewNoopToken().Encode().String(),
+					SchemaVersion: "noop",
+					Depth:         20,
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(response.SubjectIDs).Should(Equal([]string{"1", "2"}))
+		})
+	})
+})
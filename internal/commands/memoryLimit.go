@@ -0,0 +1,125 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/instrument"
+	
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+const (
+	// _tupleOverhead - Approximate bytes of a tuple besides its strings: the structs of the tuple, its entity and its
+	// subject, and the headers of their strings
+	_tupleOverhead = 256
+	// _expandNodeOverhead - Approximate bytes of a node of an expand tree, _expandChildOverhead of each of its children
+	_expandNodeOverhead  = 128
+	_expandChildOverhead = 8
+	// _idOverhead - Approximate bytes of an id in the sets and lists of a lookup besides the id itself
+	_idOverhead = 48
+)
+
+// MemoryLimitExceededError - A request held more than the memory limit of the expand and lookup operations
+type MemoryLimitExceededError struct {
+	// Limit - bytes a request may hold
+	Limit int64
+}
+
+// Error -
+func (e *MemoryLimitExceededError) Error() string {
+	return base.ErrorCode_ERROR_CODE_MEMORY_LIMIT_EXCEEDED.String()
+}
+
+// isMemoryLimitExceeded - The operation held more than its memory limit, a lookup is not tried again any other way
+func isMemoryLimitExceeded(err error) bool {
+	var exceeded *MemoryLimitExceededError
+	return errors.As(err, &exceeded)
+}
+
+// MemoryTracker - Approximate bytes of the tuples, subjects and ids held by the in-flight expand and lookup
+// operations. Every request is charged for what it reads and is cut with a MemoryLimitExceededError once it holds more
+// than the limit, so that a few pathological requests can not take the node down. The bytes are estimated from the
+// lengths of the strings, they are not measured.
+type MemoryTracker struct {
+	limit    int64
+	inFlight int64
+}
+
+// NewMemoryTracker - Creates new memory tracker with the bytes a request may hold, zero does not limit them. The bytes
+// of all of the in-flight requests are reported as a gauge.
+func NewMemoryTracker(limit int64, m metric.Meter) *MemoryTracker {
+	tracker := &MemoryTracker{
+		limit: limit,
+	}
+	_, _ = m.Int64ObservableGauge("in_flight_memory_bytes",
+		instrument.WithDescription("approximate bytes held by the in-flight expand and lookup operations"),
+		instrument.WithUnit("By"),
+		instrument.WithInt64Callback(func(_ context.Context, o instrument.Int64Observer) error {
+			o.Observe(tracker.InFlight())
+			return nil
+		}),
+	)
+	return tracker
+}
+
+// InFlight - Bytes held by the in-flight requests
+func (t *MemoryTracker) InFlight() int64 {
+	return atomic.LoadInt64(&t.inFlight)
+}
+
+// begin - Charges the operations executed with the returned context to a budget of their own, release takes the bytes
+// of the budget off the in-flight bytes once the request is done. A request that already has a budget keeps it, the
+// lookups of a request are charged along with it.
+func (t *MemoryTracker) begin(ctx context.Context) (context.Context, func()) {
+	if t == nil || memoryBudgetFromContext(ctx) != nil {
+		return ctx, func() {}
+	}
+	budget := &memoryBudget{tracker: t}
+	return context.WithValue(ctx, memoryBudgetKey{}, budget), budget.release
+}
+
+// memoryBudget - Bytes charged to a single request
+type memoryBudget struct {
+	tracker *MemoryTracker
+	used    int64
+}
+
+type memoryBudgetKey struct{}
+
+// memoryBudgetFromContext -
+func memoryBudgetFromContext(ctx context.Context) *memoryBudget {
+	budget, _ := ctx.Value(memoryBudgetKey{}).(*memoryBudget)
+	return budget
+}
+
+// charge - Adds the bytes to the budget, the operations without a budget are not charged
+func (b *memoryBudget) charge(n int64) error {
+	if b == nil {
+		return nil
+	}
+	used := atomic.AddInt64(&b.used, n)
+	atomic.AddInt64(&b.tracker.inFlight, n)
+	if b.tracker.limit > 0 && used > b.tracker.limit {
+		return &MemoryLimitExceededError{Limit: b.tracker.limit}
+	}
+	return nil
+}
+
+// release -
+func (b *memoryBudget) release() {
+	atomic.AddInt64(&b.tracker.inFlight, -atomic.SwapInt64(&b.used, 0))
+}
+
+// tupleBytes - Approximate bytes of the tuple
+func tupleBytes(t *base.Tuple) int64 {
+	return _tupleOverhead + int64(len(t.GetEntity().GetType())+len(t.GetEntity().GetId())+len(t.GetRelation())+
+		len(t.GetSubject().GetType())+len(t.GetSubject().GetId())+len(t.GetSubject().GetRelation()))
+}
+
+// idBytes - Approximate bytes of an id found by a lookup
+func idBytes(id string) int64 {
+	return _idOverhead + int64(len(id))
+}
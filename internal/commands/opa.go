@@ -0,0 +1,193 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+	
+	"go.opentelemetry.io/otel/attribute"
+	otelCodes "go.opentelemetry.io/otel/codes"
+	
+	"github.com/adminium/permify/internal/schema"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+const (
+	_defaultPoliciesCacheSize = 1000
+)
+
+// PolicyEvaluator - Evaluates a policy of an action with the input of the check, e.g. an OPA rule
+type PolicyEvaluator interface {
+	Evaluate(ctx context.Context, path string, input map[string]interface{}) (bool, error)
+}
+
+// PolicyEvaluatorFunc - Adapter to use a go callback as a policy evaluator
+type PolicyEvaluatorFunc func(ctx context.Context, path string, input map[string]interface{}) (bool, error)
+
+// Evaluate -
+func (f PolicyEvaluatorFunc) Evaluate(ctx context.Context, path string, input map[string]interface{}) (bool, error) {
+	return f(ctx, path, input)
+}
+
+// OPAPolicyEvaluator - Evaluates the policies with the data api of an OPA server. The path of a policy is the path
+// of a rule under /v1/data, e.g. authz/doc/edit, and the rule must be a boolean. An undefined rule does not allow.
+type OPAPolicyEvaluator struct {
+	url    string
+	client *http.Client
+}
+
+// NewOPAPolicyEvaluator - Creates new OPA policy evaluator, url is the address of the server, e.g. http://opa:8181
+func NewOPAPolicyEvaluator(url string, timeout time.Duration) *OPAPolicyEvaluator {
+	return &OPAPolicyEvaluator{
+		url:    strings.TrimSuffix(url, "/"),
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Evaluate -
+func (e *OPAPolicyEvaluator) Evaluate(ctx context.Context, path string, input map[string]interface{}) (bool, error) {
+	body, err := json.Marshal(map[string]interface{}{"input": input})
+	if err != nil {
+		return false, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url+"/v1/data/"+strings.TrimPrefix(path, "/"), bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+	
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("opa answered %s for %s", resp.Status, path)
+	}
+	var decision struct {
+		Result interface{} `json:"result"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&decision); err != nil {
+		return false, err
+	}
+	if decision.Result == nil {
+		return false, nil
+	}
+	can, ok := decision.Result.(bool)
+	if !ok {
+		return false, fmt.Errorf("opa rule %s is not a boolean", path)
+	}
+	return can, nil
+}
+
+// Policies - Evaluates the policies that the schemas annotate actions with. Actions without a policy are not
+// affected, so tenants that do not annotate their schemas do not reach the evaluator.
+func Policies(evaluator PolicyEvaluator) CheckOption {
+	return func(command *CheckCommand) {
+		command.policyEvaluator = evaluator
+	}
+}
+
+// readPolicies - Policies of the schema version of the request
+func (command *CheckCommand) readPolicies(ctx context.Context, request *base.PermissionCheckRequest) (schema.Policies, error) {
+	return command.policies.get(request.GetTenantId()+"|"+request.GetMetadata().GetSchemaVersion(), func() (schema.Policies, error) {
+		definitions, err := command.schemaReader.ReadSchemaString(ctx, request.GetTenantId(), request.GetMetadata().GetSchemaVersion())
+		if err != nil {
+			return nil, err
+		}
+		return schema.NewPoliciesFromStringDefinitions(definitions...)
+	})
+}
+
+// checkPolicy - Combines the result of the action with the decision of its policy. The policy is evaluated with the
+// request, the result of the action and the trace of the checks that decided it as input. It is not evaluated
+// when the result of the action alone decides the mode.
+func (command *CheckCommand) checkPolicy(ctx context.Context, request *base.PermissionCheckRequest, fn CheckFunction) CheckFunction {
+	return func(ctx context.Context) (*base.PermissionCheckResponse, error) {
+		p, err := command.readPolicies(ctx, request)
+		if err != nil {
+			return denied(&base.PermissionCheckResponseMetadata{}), err
+		}
+		policy, ok := p.Get(request.GetEntity().GetType(), request.GetPermission())
+		if !ok {
+			return fn(ctx)
+		}
+		
+		ctx, span := tracer.Start(ctx, "permissions.check.policy")
+		defer span.End()
+		span.SetAttributes(attribute.String("path", policy.Path), attribute.String("mode", string(policy.Mode)))
+		
+		outer := checkTraceFromContext(ctx)
+		trace := NewCheckTrace()
+		res, err := fn(ContextWithCheckTrace(ctx, trace))
+		if outer != nil {
+			outer.append(trace.Steps()...)
+		}
+		if err != nil {
+			return denied(&base.PermissionCheckResponseMetadata{}), err
+		}
+		
+		can := res.GetCan() == base.PermissionCheckResponse_RESULT_ALLOWED
+		if (policy.Mode == schema.PolicyModeAnd && !can) || (policy.Mode == schema.PolicyModeOr && can) {
+			return res, nil
+		}
+		
+		var decision bool
+		decision, err = command.policyEvaluator.Evaluate(ctx, policy.Path, policyInput(request, policy, can, trace.Steps()))
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) || errors.Is(ctx.Err(), context.Canceled) {
+				return denied(&base.PermissionCheckResponseMetadata{}), errors.New(base.ErrorCode_ERROR_CODE_CANCELLED.String())
+			}
+			return denied(&base.PermissionCheckResponseMetadata{}), errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+		span.SetAttributes(attribute.Bool("decision", decision))
+		
+		if decision {
+			return allowed(res.GetMetadata()), nil
+		}
+		return denied(res.GetMetadata()), nil
+	}
+}
+
+// policyInput - Input document of a policy
+func policyInput(request *base.PermissionCheckRequest, policy schema.Policy, can bool, steps []CheckTraceStep) map[string]interface{} {
+	trace := make([]interface{}, 0, len(steps))
+	for _, step := range steps {
+		s := map[string]interface{}{
+			"entity":     tuple.EntityToString(step.Entity),
+			"permission": step.Permission,
+			"subject":    tuple.SubjectToString(step.Subject),
+			"depth":      step.Depth,
+			"allowed":    step.Result == base.PermissionCheckResponse_RESULT_ALLOWED,
+		}
+		if step.Error != nil {
+			s["error"] = step.Error.Error()
+		}
+		trace = append(trace, s)
+	}
+	return map[string]interface{}{
+		"tenant_id": request.GetTenantId(),
+		"entity": map[string]interface{}{
+			"type": request.GetEntity().GetType(),
+			"id":   request.GetEntity().GetId(),
+		},
+		"permission": request.GetPermission(),
+		"subject": map[string]interface{}{
+			"type":     request.GetSubject().GetType(),
+			"id":       request.GetSubject().GetId(),
+			"relation": request.GetSubject().GetRelation(),
+		},
+		"mode":    string(policy.Mode),
+		"allowed": can,
+		"trace":   trace,
+	}
+}
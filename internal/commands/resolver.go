@@ -0,0 +1,173 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"sync"
+	
+	otelCodes "go.opentelemetry.io/otel/codes"
+	
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// decision - Result of a combinator after seeing the response of a single child.
+// done reports whether the remaining children can no longer change the result.
+type decision struct {
+	can  base.PermissionCheckResponse_Result
+	done bool
+}
+
+// reducer - Folds the response of the child at the given index into a decision. A child that ran out of depth
+// decides nothing, the combinator ends with RESULT_DEPTH_EXCEEDED instead of its fallback when no other child
+// decided it.
+type reducer func(index int, response *base.PermissionCheckResponse) decision
+
+// checkUnion - Allowed as soon as any child is allowed
+func checkUnion(ctx context.Context, functions []CheckFunction, limit int) (*base.PermissionCheckResponse, error) {
+	return combine(ctx, "permissions.check.union", functions, limit, base.PermissionCheckResponse_RESULT_DENIED, func(_ int, response *base.PermissionCheckResponse) decision {
+		if response.GetCan() == base.PermissionCheckResponse_RESULT_ALLOWED {
+			return decision{can: base.PermissionCheckResponse_RESULT_ALLOWED, done: true}
+		}
+		return decision{}
+	})
+}
+
+// checkIntersection - Denied as soon as any child is denied
+func checkIntersection(ctx context.Context, functions []CheckFunction, limit int) (*base.PermissionCheckResponse, error) {
+	if len(functions) == 0 {
+		return denied(&base.PermissionCheckResponseMetadata{}), nil
+	}
+	return combine(ctx, "permissions.check.intersection", functions, limit, base.PermissionCheckResponse_RESULT_ALLOWED, func(_ int, response *base.PermissionCheckResponse) decision {
+		if response.GetCan() == base.PermissionCheckResponse_RESULT_DENIED {
+			return decision{can: base.PermissionCheckResponse_RESULT_DENIED, done: true}
+		}
+		return decision{}
+	})
+}
+
+// checkExclusion - The first child is the base, the others are excluded from it.
+// Allowed when the base is allowed and none of the excluded children are, denied when the base is denied or any
+// excluded child is allowed.
+func checkExclusion(ctx context.Context, functions []CheckFunction, limit int) (*base.PermissionCheckResponse, error) {
+	if len(functions) == 0 {
+		return denied(&base.PermissionCheckResponseMetadata{}), nil
+	}
+	return combine(ctx, "permissions.check.exclusion", functions, limit, base.PermissionCheckResponse_RESULT_ALLOWED, func(index int, response *base.PermissionCheckResponse) decision {
+		can := response.GetCan()
+		if (index == 0 && can == base.PermissionCheckResponse_RESULT_DENIED) || (index > 0 && can == base.PermissionCheckResponse_RESULT_ALLOWED) {
+			return decision{can: base.PermissionCheckResponse_RESULT_DENIED, done: true}
+		}
+		return decision{}
+	})
+}
+
+// checkAllowed - Always allowed, the base of a standalone exclusion
+func checkAllowed(ctx context.Context) (*base.PermissionCheckResponse, error) {
+	return allowed(&base.PermissionCheckResponseMetadata{}), nil
+}
+
+// combine - Evaluates the children on a bounded pool of workers and reduces their responses in
+// completion order. When the result is decided, the remaining children are cancelled and the
+// workers are waited for before returning, so no goroutine outlives the check.
+func combine(ctx context.Context, name string, functions []CheckFunction, limit int, fallback base.PermissionCheckResponse_Result, reduce reducer) (response *base.PermissionCheckResponse, err error) {
+	responseMetadata := &base.PermissionCheckResponseMetadata{}
+	
+	if len(functions) == 0 {
+		return denied(responseMetadata), nil
+	}
+	
+	ctx, span := tracer.Start(ctx, name)
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+		}
+		span.End()
+	}()
+	
+	cancelCtx, cancel := context.WithCancel(ctx)
+	results, wait := dispatch(cancelCtx, functions, limit)
+	defer func() {
+		cancel()
+		wait()
+	}()
+	
+	exceeded := false
+	for i := 0; i < len(functions); i++ {
+		select {
+		case r := <-results:
+			responseMetadata = joinResponseMetas(responseMetadata, r.resp.GetMetadata())
+			if r.err != nil {
+				return denied(responseMetadata), r.err
+			}
+			if r.resp.GetCan() == base.PermissionCheckResponse_RESULT_DEPTH_EXCEEDED {
+				exceeded = true
+				continue
+			}
+			if d := reduce(r.index, r.resp); d.done {
+				return result(d.can, responseMetadata), nil
+			}
+		case <-ctx.Done():
+			return denied(responseMetadata), errors.New(base.ErrorCode_ERROR_CODE_CANCELLED.String())
+		}
+	}
+	
+	if exceeded {
+		return depthExceeded(responseMetadata), nil
+	}
+	return result(fallback, responseMetadata), nil
+}
+
+// dispatch - Runs the functions on at most limit workers. Every function that is started delivers
+// exactly one response to the returned channel, which is buffered so workers never block on it.
+// Functions that are not started before the context is cancelled are skipped.
+// The returned function blocks until all workers have exited.
+func dispatch(ctx context.Context, functions []CheckFunction, limit int) (<-chan CheckResponse, func()) {
+	results := make(chan CheckResponse, len(functions))
+	jobs := make(chan int)
+	
+	workers := limit
+	if workers <= 0 || workers > len(functions) {
+		workers = len(functions)
+	}
+	
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for index := range jobs {
+				if ctx.Err() != nil {
+					continue
+				}
+				resp, err := functions[index](ctx)
+				results <- CheckResponse{
+					index: index,
+					resp:  resp,
+					err:   err,
+				}
+			}
+		}()
+	}
+	
+	go func() {
+		defer close(jobs)
+		for index := range functions {
+			select {
+			case jobs <- index:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	
+	return results, wg.Wait
+}
+
+// result -
+func result(can base.PermissionCheckResponse_Result, meta *base.PermissionCheckResponseMetadata) *base.PermissionCheckResponse {
+	if can == base.PermissionCheckResponse_RESULT_ALLOWED {
+		return allowed(meta)
+	}
+	return denied(meta)
+}
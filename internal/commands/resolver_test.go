@@ -0,0 +1,117 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+var _ = Describe("resolver", func() {
+	allow := func(ctx context.Context) (*base.PermissionCheckResponse, error) {
+		return allowed(&base.PermissionCheckResponseMetadata{CheckCount: 1}), nil
+	}
+	
+	deny := func(ctx context.Context) (*base.PermissionCheckResponse, error) {
+		return denied(&base.PermissionCheckResponseMetadata{CheckCount: 1}), nil
+	}
+	
+	fail := func(ctx context.Context) (*base.PermissionCheckResponse, error) {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_INTERNAL.String())
+	}
+	
+	Context("Combinators", func() {
+		It("Case 1: Union", func() {
+			res, err := checkUnion(context.Background(), []CheckFunction{deny, deny}, 2)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.GetCan()).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+			Expect(res.GetMetadata().GetCheckCount()).Should(Equal(int32(2)))
+			
+			res, err = checkUnion(context.Background(), []CheckFunction{deny, allow}, 1)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.GetCan()).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+			
+			res, err = checkUnion(context.Background(), nil, 1)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.GetCan()).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+		})
+		
+		It("Case 2: Intersection", func() {
+			res, err := checkIntersection(context.Background(), []CheckFunction{allow, allow}, 2)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.GetCan()).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+			
+			res, err = checkIntersection(context.Background(), []CheckFunction{allow, deny}, 2)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.GetCan()).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+		})
+		
+		It("Case 3: Exclusion", func() {
+			tests := []struct {
+				functions []CheckFunction
+				expected  base.PermissionCheckResponse_Result
+			}{
+				{[]CheckFunction{allow, deny, deny}, base.PermissionCheckResponse_RESULT_ALLOWED},
+				{[]CheckFunction{allow, deny, allow}, base.PermissionCheckResponse_RESULT_DENIED},
+				{[]CheckFunction{deny, deny}, base.PermissionCheckResponse_RESULT_DENIED},
+				{[]CheckFunction{checkAllowed, allow}, base.PermissionCheckResponse_RESULT_DENIED},
+				{[]CheckFunction{checkAllowed, deny}, base.PermissionCheckResponse_RESULT_ALLOWED},
+			}
+			
+			for _, tt := range tests {
+				res, err := checkExclusion(context.Background(), tt.functions, 2)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(res.GetCan()).Should(Equal(tt.expected))
+			}
+		})
+		
+		It("Case 4: Error", func() {
+			res, err := checkUnion(context.Background(), []CheckFunction{fail}, 1)
+			Expect(err).Should(HaveOccurred())
+			Expect(res.GetCan()).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+		})
+	})
+	
+	Context("Dispatch", func() {
+		It("Case 1: Limit", func() {
+			var running, peak int32
+			block := make(chan struct{})
+			fn := func(ctx context.Context) (*base.PermissionCheckResponse, error) {
+				n := atomic.AddInt32(&running, 1)
+				for {
+					p := atomic.LoadInt32(&peak)
+					if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+						break
+					}
+				}
+				<-block
+				atomic.AddInt32(&running, -1)
+				return deny(ctx)
+			}
+			
+			results, wait := dispatch(context.Background(), []CheckFunction{fn, fn, fn, fn, fn}, 2)
+			close(block)
+			wait()
+			Expect(results).Should(HaveLen(5))
+			Expect(atomic.LoadInt32(&peak)).Should(BeNumerically("<=", 2))
+		})
+		
+		It("Case 2: Cancellation", func() {
+			var started int32
+			ctx, cancel := context.WithCancel(context.Background())
+			fn := func(ctx context.Context) (*base.PermissionCheckResponse, error) {
+				atomic.AddInt32(&started, 1)
+				cancel()
+				return deny(ctx)
+			}
+			
+			_, wait := dispatch(ctx, []CheckFunction{fn, fn, fn, fn, fn}, 1)
+			wait()
+			Expect(atomic.LoadInt32(&started)).Should(Equal(int32(1)))
+		})
+	})
+})
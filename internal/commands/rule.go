@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"context"
+	
+	"go.opentelemetry.io/otel/attribute"
+	otelCodes "go.opentelemetry.io/otel/codes"
+	"google.golang.org/protobuf/types/known/structpb"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/internal/schema"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+const (
+	_defaultRulesCacheSize = 1000
+)
+
+// Attributes - Reads the attributes that the rules called by actions are evaluated with. Attributes are not
+// versioned, so rule results are not tied to the snapshot of the check.
+func Attributes(ar repositories.AttributeReader) CheckOption {
+	return func(command *CheckCommand) {
+		command.attributeReader = ar
+	}
+}
+
+// readRules - Rules of the schema version of the request
+func (command *CheckCommand) readRules(ctx context.Context, request *base.PermissionCheckRequest) (*schema.Rules, error) {
+	return command.rules.get(request.GetTenantId()+"|"+request.GetMetadata().GetSchemaVersion(), func() (*schema.Rules, error) {
+		definitions, err := command.schemaReader.ReadSchemaString(ctx, request.GetTenantId(), request.GetMetadata().GetSchemaVersion())
+		if err != nil {
+			return nil, err
+		}
+		return schema.NewRulesFromStringDefinitions(definitions...)
+	})
+}
+
+// checkRule - Evaluates the rule with the attributes of the entity, a rule with an attribute that has no value is
+// not satisfied
+func (command *CheckCommand) checkRule(ctx context.Context, request *base.PermissionCheckRequest, rule string, arguments []string) CheckFunction {
+	return func(ctx context.Context) (*base.PermissionCheckResponse, error) {
+		ctx, span := tracer.Start(ctx, "permissions.check.rule")
+		defer span.End()
+		span.SetAttributes(attribute.String("rule", rule))
+		
+		r, err := command.readRules(ctx, request)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return denied(&base.PermissionCheckResponseMetadata{}), err
+		}
+		
+		if command.attributeReader == nil {
+			return denied(&base.PermissionCheckResponseMetadata{}), nil
+		}
+		
+		var attributes map[string]*structpb.Value
+		attributes, err = command.attributeReader.ReadAttributes(ctx, request.GetTenantId(), request.GetEntity())
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return denied(&base.PermissionCheckResponseMetadata{}), err
+		}
+		
+		values := make([]*structpb.Value, 0, len(arguments))
+		for _, argument := range arguments {
+			value, ok := attributes[argument]
+			if !ok {
+				return denied(&base.PermissionCheckResponseMetadata{}), nil
+			}
+			values = append(values, value)
+		}
+		
+		var can bool
+		can, err = r.Evaluate(rule, values)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return denied(&base.PermissionCheckResponseMetadata{}), err
+		}
+		if can {
+			return allowed(&base.PermissionCheckResponseMetadata{}), nil
+		}
+		return denied(&base.PermissionCheckResponseMetadata{}), nil
+	}
+}
@@ -0,0 +1,74 @@
+package commands
+
+import (
+	"context"
+	
+	"github.com/adminium/permify/internal/schema"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+const (
+	// _defaultSubjectTypesCacheSize - The subject types are kept by permission, so a version has one of them for each
+	// relation and action that is checked
+	_defaultSubjectTypesCacheSize = 10000
+)
+
+// subjectTypeRestriction - Subject types of a relation or action, every type when it is unrestricted
+type subjectTypeRestriction struct {
+	types        []string
+	unrestricted bool
+}
+
+// SubjectTypeRestrictions - Denies the checks of subjects whose type the permission can not hold for, e.g. a team
+// checked for an action that only reads relations of users, before any tuple is read
+func SubjectTypeRestrictions() CheckOption {
+	return func(command *CheckCommand) {
+		command.restrictSubjectTypes = true
+	}
+}
+
+// reachable - Whether the subject type of the request is reachable by the dependency graph of its permission.
+// Externals and policies that can allow on their own may hold for subjects of any type.
+func (command *CheckCommand) reachable(ctx context.Context, request *base.PermissionCheckRequest) (bool, error) {
+	restriction, err := command.readSubjectTypes(ctx, request)
+	if err != nil {
+		return false, err
+	}
+	if restriction.unrestricted {
+		return true, nil
+	}
+	return tuple.ValidateSubjectType(request.GetSubject(), restriction.types) == nil, nil
+}
+
+// readSubjectTypes - Subject types of the permission of the request
+func (command *CheckCommand) readSubjectTypes(ctx context.Context, request *base.PermissionCheckRequest) (subjectTypeRestriction, error) {
+	key := request.GetTenantId() + "|" + request.GetMetadata().GetSchemaVersion() + "|" + request.GetEntity().GetType() + "#" + request.GetPermission()
+	return command.subjectTypes.get(key, func() (r subjectTypeRestriction, err error) {
+		sch, err := command.schemaReader.ReadSchema(ctx, request.GetTenantId(), request.GetMetadata().GetSchemaVersion())
+		if err != nil {
+			return subjectTypeRestriction{}, err
+		}
+		r.types, r.unrestricted = schema.SubjectTypes(sch, request.GetEntity().GetType(), request.GetPermission())
+		
+		if !r.unrestricted && (len(command.externals) > 0 || command.policyEvaluator != nil) {
+			var p schema.Policies
+			if command.policyEvaluator != nil {
+				p, err = command.readPolicies(ctx, request)
+				if err != nil {
+					return subjectTypeRestriction{}, err
+				}
+			}
+			dependencies, _ := schema.Dependencies(sch, request.GetEntity().GetType(), request.GetPermission())
+			for _, dependency := range dependencies {
+				if _, ok := command.external(dependency.GetType(), dependency.GetRelation()); ok {
+					r.unrestricted = true
+				}
+				if policy, ok := p.Get(dependency.GetType(), dependency.GetRelation()); ok && policy.Mode != schema.PolicyModeAnd {
+					r.unrestricted = true
+				}
+			}
+		}
+		return r, nil
+	})
+}
@@ -0,0 +1,104 @@
+package commands
+
+import (
+	"context"
+	"sync"
+	
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+// CheckTraceStep - A single permission or relation resolved while executing a check
+type CheckTraceStep struct {
+	Entity     *base.Entity
+	Permission string
+	Subject    *base.Subject
+	Depth      int32
+	Result     base.PermissionCheckResponse_Result
+	Error      error
+}
+
+// CheckTrace - Collects the steps of a check execution and the tuples it read. Steps are appended in completion order,
+// tuples in the order they were first read.
+type CheckTrace struct {
+	mu     sync.Mutex
+	steps  []CheckTraceStep
+	tuples []string
+	read   map[string]struct{}
+}
+
+// NewCheckTrace - Creates new check trace
+func NewCheckTrace() *CheckTrace {
+	return &CheckTrace{}
+}
+
+// Steps - Get recorded steps
+func (t *CheckTrace) Steps() []CheckTraceStep {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	steps := make([]CheckTraceStep, len(t.steps))
+	copy(steps, t.steps)
+	return steps
+}
+
+// Tuples - Get the tuples read, in the tuple notation
+func (t *CheckTrace) Tuples() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	tuples := make([]string, len(t.tuples))
+	copy(tuples, t.tuples)
+	return tuples
+}
+
+// touch - Records a tuple read by the check, nothing is recorded without a trace
+func (t *CheckTrace) touch(tup *base.Tuple) {
+	if t == nil {
+		return
+	}
+	key := tuple.ToString(tup)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.read[key]; ok {
+		return
+	}
+	if t.read == nil {
+		t.read = map[string]struct{}{}
+	}
+	t.read[key] = struct{}{}
+	t.tuples = append(t.tuples, key)
+}
+
+// record -
+func (t *CheckTrace) record(request *base.PermissionCheckRequest, response *base.PermissionCheckResponse, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.steps = append(t.steps, CheckTraceStep{
+		Entity:     request.GetEntity(),
+		Permission: request.GetPermission(),
+		Subject:    request.GetSubject(),
+		Depth:      request.GetMetadata().GetDepth(),
+		Result:     response.GetCan(),
+		Error:      err,
+	})
+}
+
+// append - Adds the steps of a nested trace
+func (t *CheckTrace) append(steps ...CheckTraceStep) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.steps = append(t.steps, steps...)
+}
+
+type checkTraceKey struct{}
+
+// ContextWithCheckTrace - Checks executed with the returned context record their steps to the trace.
+// Cached results are not read while tracing so that every step is actually re-executed.
+func ContextWithCheckTrace(ctx context.Context, trace *CheckTrace) context.Context {
+	return context.WithValue(ctx, checkTraceKey{}, trace)
+}
+
+// checkTraceFromContext -
+func checkTraceFromContext(ctx context.Context) *CheckTrace {
+	trace, _ := ctx.Value(checkTraceKey{}).(*CheckTrace)
+	return trace
+}
@@ -0,0 +1,74 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	
+	"github.com/adminium/permify/internal/repositories"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+// _defaultTransitiveDepth - Levels a transitive relation is walked at most by the requests without a depth of their own
+const _defaultTransitiveDepth = 100
+
+// walkTransitive - Walks the relation of a transitive tuple set, e.g. parent*, from the entity level by level, and
+// calls visit with the entities of every level that were not reached before. The walk ends when a level reaches no new
+// entities or visit stops it. The entities reached before are skipped, so a cycle of parents ends the walk instead of
+// looping, and a hierarchy deeper than the depth fails with ERROR_CODE_DEPTH_NOT_ENOUGH. Only the subjects that are
+// entities are walked, subject sets like folder#member are not parents.
+func walkTransitive(ctx context.Context, reader repositories.RelationshipReader, tenantID string, entity *base.Entity, relation, snap string, depth int32, visit func(level int32, entities []*base.Entity) (stop bool, err error)) error {
+	visited := map[string]bool{tuple.EntityToString(entity): true}
+	frontier := []*base.Entity{entity}
+	for level := int32(1); ; level++ {
+		if level > depth {
+			return errors.New(base.ErrorCode_ERROR_CODE_DEPTH_NOT_ENOUGH.String())
+		}
+		
+		// the entities of a level are read with a query per entity type
+		var types []string
+		ids := map[string][]string{}
+		for _, e := range frontier {
+			if _, ok := ids[e.GetType()]; !ok {
+				types = append(types, e.GetType())
+			}
+			ids[e.GetType()] = append(ids[e.GetType()], e.GetId())
+		}
+		
+		var next []*base.Entity
+		for _, typ := range types {
+			it, err := reader.QueryRelationships(ctx, tenantID, &base.TupleFilter{
+				Entity: &base.EntityFilter{
+					Type: typ,
+					Ids:  ids[typ],
+				},
+				Relation: relation,
+			}, snap)
+			if err != nil {
+				return err
+			}
+			for it.HasNext() {
+				t := it.GetNext()
+				checkTraceFromContext(ctx).touch(t)
+				subject := t.GetSubject()
+				if subject.GetRelation() != "" && subject.GetRelation() != tuple.ELLIPSIS {
+					continue
+				}
+				ancestor := &base.Entity{Type: subject.GetType(), Id: subject.GetId()}
+				if key := tuple.EntityToString(ancestor); !visited[key] {
+					visited[key] = true
+					next = append(next, ancestor)
+				}
+			}
+		}
+		if len(next) == 0 {
+			return nil
+		}
+		
+		stop, err := visit(level, next)
+		if err != nil || stop {
+			return err
+		}
+		frontier = next
+	}
+}
@@ -1,10 +1,9 @@
 package commands
 
 import (
-	"errors"
-	
 	"go.opentelemetry.io/otel"
 	
+	"github.com/adminium/permify/internal/repositories"
 	base "github.com/adminium/permify/pkg/pb/base/v1"
 )
 
@@ -24,11 +23,55 @@ func ConcurrencyLimit(limit int) CheckOption {
 	}
 }
 
+// TenantSettings - Reads the settings of the tenants that change the behavior of their checks
+func TenantSettings(tr repositories.TenantSettingsReader) CheckOption {
+	return func(c *CheckCommand) {
+		c.tenantSettingsReader = tr
+	}
+}
+
+// ExpandOption - Option type
+type ExpandOption func(*ExpandCommand)
+
+// ExpandSubjectLimit - Defines the maximum number of subjects an expansion returns, zero does not limit them
+func ExpandSubjectLimit(limit int) ExpandOption {
+	return func(c *ExpandCommand) {
+		c.subjectLimit = limit
+	}
+}
+
+// ExpandMemoryLimit - Charges the tuples and the trees of the expansions to the memory tracker
+func ExpandMemoryLimit(tracker *MemoryTracker) ExpandOption {
+	return func(c *ExpandCommand) {
+		c.memory = tracker
+	}
+}
+
+// LookupEntityOption - Option type
+type LookupEntityOption func(*LookupEntityCommand)
+
+// LookupEntityMemoryLimit - Charges the entity ids the lookups find to the memory tracker
+func LookupEntityMemoryLimit(tracker *MemoryTracker) LookupEntityOption {
+	return func(c *LookupEntityCommand) {
+		c.memory = tracker
+	}
+}
+
+// LookupSubjectOption - Option type
+type LookupSubjectOption func(*LookupSubjectCommand)
+
+// LookupSubjectMemoryLimit - Charges the subject ids the lookups find to the memory tracker
+func LookupSubjectMemoryLimit(tracker *MemoryTracker) LookupSubjectOption {
+	return func(c *LookupSubjectCommand) {
+		c.memory = tracker
+	}
+}
+
 // joinResponseMetas -
 func joinResponseMetas(meta ...*base.PermissionCheckResponseMetadata) *base.PermissionCheckResponseMetadata {
 	response := &base.PermissionCheckResponseMetadata{}
 	for _, m := range meta {
-		response.CheckCount += m.CheckCount
+		response.CheckCount += m.GetCheckCount()
 	}
 	return response
 }
@@ -42,14 +85,7 @@ func increaseCheckCount(metadata *base.PermissionCheckResponseMetadata) *base.Pe
 
 // CheckResponse -
 type CheckResponse struct {
-	resp *base.PermissionCheckResponse
-	err  error
-}
-
-// checkDepth -
-func checkDepth(request *base.PermissionCheckRequest) error {
-	if request.GetMetadata().Depth == 0 {
-		return errors.New(base.ErrorCode_ERROR_CODE_DEPTH_NOT_ENOUGH.String())
-	}
-	return nil
+	index int
+	resp  *base.PermissionCheckResponse
+	err   error
 }
@@ -0,0 +1,46 @@
+package commands
+
+import (
+	"sync"
+)
+
+// versioned - Values computed from schema versions, e.g. the parsed rules of a version. Versions never change, so the
+// values are kept until the cache holds size of them, then it starts over. The keys start with tenant_id|version.
+type versioned[T any] struct {
+	size int
+	
+	mu     sync.Mutex
+	values map[string]T
+}
+
+// newVersioned -
+func newVersioned[T any](size int) *versioned[T] {
+	return &versioned[T]{
+		size:   size,
+		values: map[string]T{},
+	}
+}
+
+// get - Value of the key, load computes it when it is not kept. The lock is not held while loading, so the concurrent
+// misses of a key may load it more than once.
+func (v *versioned[T]) get(key string, load func() (T, error)) (T, error) {
+	v.mu.Lock()
+	value, ok := v.values[key]
+	v.mu.Unlock()
+	if ok {
+		return value, nil
+	}
+	
+	value, err := load()
+	if err != nil {
+		return value, err
+	}
+	
+	v.mu.Lock()
+	if len(v.values) >= v.size {
+		v.values = map[string]T{}
+	}
+	v.values[key] = value
+	v.mu.Unlock()
+	return value, nil
+}
@@ -0,0 +1,56 @@
+package commands
+
+import (
+	"errors"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("versioned", func() {
+	Context("Get", func() {
+		It("Case 1: A value is loaded once and the cache starts over when it is full", func() {
+			cache := newVersioned[int](2)
+			loads := 0
+			load := func(value int) func() (int, error) {
+				return func() (int, error) {
+					loads++
+					return value, nil
+				}
+			}
+			
+			for i := 0; i < 2; i++ {
+				value, err := cache.get("t1|v1", load(1))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(value).Should(Equal(1))
+			}
+			Expect(loads).Should(Equal(1))
+			
+			_, err := cache.get("t1|v2", load(2))
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = cache.get("t1|v3", load(3))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(loads).Should(Equal(3))
+			
+			value, err := cache.get("t1|v1", load(1))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(value).Should(Equal(1))
+			Expect(loads).Should(Equal(4))
+		})
+		
+		It("Case 2: The errors of the loads are not kept", func() {
+			cache := newVersioned[int](2)
+			
+			_, err := cache.get("t1|v1", func() (int, error) {
+				return 0, errors.New("schema not found")
+			})
+			Expect(err).Should(HaveOccurred())
+			
+			value, err := cache.get("t1|v1", func() (int, error) {
+				return 1, nil
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(value).Should(Equal(1))
+		})
+	})
+})
@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"context"
+	
+	otelCodes "go.opentelemetry.io/otel/codes"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/pkg/token"
+)
+
+// WatchCommand - Streams the changes of the relation tuples of a tenant
+type WatchCommand struct {
+	// repositories
+	watcher            repositories.Watcher
+	relationshipReader repositories.RelationshipReader
+}
+
+// NewWatchCommand -
+func NewWatchCommand(w repositories.Watcher, rr repositories.RelationshipReader) *WatchCommand {
+	return &WatchCommand{
+		watcher:            w,
+		relationshipReader: rr,
+	}
+}
+
+// Execute - Sends the changes after the snapshot of the token until the context is done or sending fails, a watch
+// without a token starts at the head snapshot. Changes can be sent more than once, a client that reconnects passes
+// the token of the last changes it received.
+func (command *WatchCommand) Execute(ctx context.Context, tenantID, snap string, send func(changes *repositories.RelationshipChanges) error) (err error) {
+	ctx, span := tracer.Start(ctx, "relationships.watch.execute")
+	defer span.End()
+	
+	if snap == "" {
+		var st token.SnapToken
+		st, err = command.relationshipReader.HeadSnapshot(ctx, tenantID)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return err
+		}
+		snap = st.Encode().String()
+	}
+	
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	
+	changes, errs := command.watcher.Watch(ctx, tenantID, snap)
+	for {
+		select {
+		case c, ok := <-changes:
+			if !ok {
+				// the watcher closes both channels, an error is sent before they are closed
+				if err, ok = <-errs; ok {
+					span.RecordError(err)
+					span.SetStatus(otelCodes.Error, err.Error())
+					return err
+				}
+				return ctx.Err()
+			}
+			if err = send(c); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/internal/repositories/mocks"
+	"github.com/adminium/permify/pkg/database"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/storage"
+	"github.com/adminium/permify/pkg/token"
+)
+
+// sliceWatcher - Watcher sending the given changes, then the error if there is one
+type sliceWatcher struct {
+	snap    string
+	changes []*repositories.RelationshipChanges
+	err     error
+}
+
+// Watch -
+func (w *sliceWatcher) Watch(ctx context.Context, _ string, snap string) (<-chan *repositories.RelationshipChanges, <-chan error) {
+	w.snap = snap
+	changes := make(chan *repositories.RelationshipChanges, len(w.changes))
+	errs := make(chan error, 1)
+	for _, c := range w.changes {
+		changes <- c
+	}
+	if w.err != nil {
+		errs <- w.err
+		close(changes)
+		close(errs)
+	}
+	return changes, errs
+}
+
+var _ = Describe("watch-command", func() {
+	created := func(snap string, tuples ...*base.Tuple) *repositories.RelationshipChanges {
+		return &repositories.RelationshipChanges{
+			SnapToken: snap,
+			Created:   database.NewTupleCollection(tuples...),
+			Deleted:   database.NewTupleCollection(),
+		}
+	}
+	
+	Context("Watch", func() {
+		It("Case 1: starts at the head snapshot and sends the changes until the context is done", func() {
+			relationshipReader := new(mocks.RelationshipReader)
+			relationshipReader.On("HeadSnapshot", "t1").Return(token.NewNoopToken(), nil).Times(1)
+			
+			watcher := &sliceWatcher{changes: []*repositories.RelationshipChanges{
+				created("2", &base.Tuple{Entity: &base.Entity{Type: "doc", Id: "1"}, Relation: "owner", Subject: &base.Subject{Type: "user", Id: "1"}}),
+				created("3", &base.Tuple{Entity: &base.Entity{Type: "doc", Id: "2"}, Relation: "owner", Subject: &base.Subject{Type: "user", Id: "2"}}),
+			}}
+			
+			ctx, cancel := context.WithCancel(context.Background())
+			var received []string
+			err := NewWatchCommand(watcher, relationshipReader).Execute(ctx, "t1", "", func(c *repositories.RelationshipChanges) error {
+				received = append(received, c.SnapToken)
+				if len(received) == 2 {
+					cancel()
+				}
+				return nil
+			})
+			
+			Expect(errors.Is(err, context.Canceled)).Should(BeTrue())
+			Expect(watcher.snap).Should(Equal("noop"))
+			Expect(received).Should(Equal([]string{"2", "3"}))
+		})
+		
+		It("Case 2: returns the error of the watcher", func() {
+			watcher := &sliceWatcher{
+				changes: []*repositories.RelationshipChanges{created("2")},
+				err:     storage.ErrWatchFellBehind,
+			}
+			
+			var received int
+			err := NewWatchCommand(watcher, new(mocks.RelationshipReader)).Execute(context.Background(), "t1", "1", func(c *repositories.RelationshipChanges) error {
+				received++
+				return nil
+			})
+			
+			Expect(err).Should(Equal(storage.ErrWatchFellBehind))
+			Expect(watcher.snap).Should(Equal("1"))
+			Expect(received).Should(Equal(1))
+		})
+		
+		It("Case 3: stops when sending fails", func() {
+			watcher := &sliceWatcher{changes: []*repositories.RelationshipChanges{created("2"), created("3")}}
+			
+			sendErr := errors.New("client gone")
+			err := NewWatchCommand(watcher, new(mocks.RelationshipReader)).Execute(context.Background(), "t1", "1", func(c *repositories.RelationshipChanges) error {
+				return sendErr
+			})
+			
+			Expect(err).Should(Equal(sendErr))
+		})
+	})
+})
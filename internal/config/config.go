@@ -12,19 +12,54 @@ import (
 type (
 	// Config -
 	Config struct {
-		Server   `mapstructure:"server"`
-		Log      `mapstructure:"logger"`
-		Profiler `mapstructure:"profiler"`
-		Authn    `mapstructure:"authn"`
-		Tracer   `mapstructure:"tracer"`
-		Meter    `mapstructure:"meter"`
-		Service  `mapstructure:"service"`
-		Database `mapstructure:"database"`
+		Server      `mapstructure:"server"`
+		Log         `mapstructure:"logger"`
+		Profiler    `mapstructure:"profiler"`
+		Authn       `mapstructure:"authn"`
+		Tracer      `mapstructure:"tracer"`
+		Meter       `mapstructure:"meter"`
+		Service     `mapstructure:"service"`
+		Database    `mapstructure:"database"`
+		Distributed `mapstructure:"distributed"`
+		Archive     `mapstructure:"archive"`
+		Events      `mapstructure:"events"`
 	}
 
 	Server struct {
-		HTTP `mapstructure:"http"`
-		GRPC `mapstructure:"grpc"`
+		HTTP      `mapstructure:"http"`
+		GRPC      `mapstructure:"grpc"`
+		Limits    `mapstructure:"limits"`
+		Depth     `mapstructure:"depth"`
+		RateLimit `mapstructure:"rate_limit"`
+	}
+
+	// Limits - Maximum size of a single request, zero disables a limit
+	Limits struct {
+		MaxTuplesPerWrite int `mapstructure:"max_tuples_per_write"`
+		// MaxFilterIDs - entity and subject ids of a read or delete filter
+		MaxFilterIDs     int `mapstructure:"max_filter_ids"`
+		MaxChecksPerBulk int `mapstructure:"max_checks_per_bulk"`
+		// MaxSchemaBytes - length of the source of a written schema
+		MaxSchemaBytes int `mapstructure:"max_schema_bytes"`
+	}
+
+	// RateLimit - Requests per second of every tenant and api key in the classes of endpoints, zero disables the
+	// limit of a class
+	RateLimit struct {
+		Enabled bool    `mapstructure:"enabled"`
+		Read    float64 `mapstructure:"read"`
+		Write   float64 `mapstructure:"write"`
+		Check   float64 `mapstructure:"check"`
+		// Burst - requests a caller may make at once, a second of requests when it is zero
+		Burst int `mapstructure:"burst"`
+	}
+
+	// Depth - Depth of the checks and lookups, the settings of a tenant override both values
+	Depth struct {
+		// Default - depth of the requests that do not give one
+		Default int32 `mapstructure:"default"`
+		// Max - larger depths of the requests are clamped to it, zero does not clamp them
+		Max int32 `mapstructure:"max"`
 	}
 
 	// HTTP -.
@@ -49,7 +84,9 @@ type (
 
 	// Authn -.
 	Authn struct {
-		Enabled   bool      `mapstructure:"enabled"`
+		Enabled bool `mapstructure:"enabled"`
+		// Method - preshared, oidc, or both separated by a comma, the requests are accepted by the first that
+		// authenticates them
 		Method    string    `mapstructure:"method"`
 		Preshared Preshared `mapstructure:"preshared"`
 		Oidc      Oidc      `mapstructure:"oidc"`
@@ -62,6 +99,23 @@ type (
 	Oidc struct {
 		Issuer   string `mapstructure:"issuer"`
 		ClientId string `mapstructure:"client_id"`
+		// JwksURI - keys the tokens are verified with, discovered from the issuer when empty
+		JwksURI string `mapstructure:"jwks_uri"`
+		// TenantsClaim - claim with the tenant or the tenants a token may call, the tokens are not restricted to
+		// tenants when empty
+		TenantsClaim string `mapstructure:"tenants_claim"`
+		// ScopeClaim - claim with the space separated or listed scopes of a token, the tokens are not restricted to a
+		// scope when empty
+		ScopeClaim string     `mapstructure:"scope_claim"`
+		Scopes     OidcScopes `mapstructure:"scopes"`
+	}
+
+	// OidcScopes - Scopes of the tokens that grant reads, writes and the admin endpoints. A token with the admin scope
+	// is not restricted at all.
+	OidcScopes struct {
+		Read  string `mapstructure:"read"`
+		Write string `mapstructure:"write"`
+		Admin string `mapstructure:"admin"`
 	}
 
 	// Profiler -.
@@ -95,6 +149,8 @@ type (
 		Schema         Schema       `mapstructure:"schema"`
 		Permission     Permission   `mapstructure:"permission"`
 		Relationship   Relationship `mapstructure:"relationship"`
+		Identity       Identity     `mapstructure:"identity"`
+		SCIM           SCIM         `mapstructure:"scim"`
 	}
 
 	// Schema -.
@@ -104,13 +160,193 @@ type (
 
 	// Permission -.
 	Permission struct {
-		ConcurrencyLimit int   `mapstructure:"concurrency_limit"`
-		Cache            Cache `mapstructure:"cache"`
+		ConcurrencyLimit int    `mapstructure:"concurrency_limit"`
+		Cache            Cache  `mapstructure:"cache"`
+		Warmup           Warmup `mapstructure:"warmup"`
+		// ExpandSubjectLimit - maximum number of subjects an expand returns, zero does not limit them
+		ExpandSubjectLimit int `mapstructure:"expand_subject_limit"`
+		// MemoryLimit - approximate bytes the tuples and ids read by a single expand or lookup may take, e.g. 64MiB,
+		// zero does not limit them
+		MemoryLimit string `mapstructure:"memory_limit"`
+		// SubjectTypeRestrictions - denies the checks of subjects whose type the permission can not hold for without
+		// reading tuples
+		SubjectTypeRestrictions bool `mapstructure:"subject_type_restrictions"`
+		// MinimizeLatencyStaleness - how long the checks with the minimize_latency consistency reuse a head snapshot
+		MinimizeLatencyStaleness time.Duration `mapstructure:"minimize_latency_staleness"`
+		// Fallback - answers checks from their last known result when the storage is unavailable
+		Fallback Fallback `mapstructure:"fallback"`
+		// Usage - per tenant usage statistics of the checks in a rolling window, reported by the admin service
+		Usage Usage `mapstructure:"usage"`
+		// Externals - relations resolved by external grpc hooks in addition to their tuples
+		Externals []External `mapstructure:"externals"`
+		// OPA - server that evaluates the policies actions are annotated with in the schemas
+		OPA OPA `mapstructure:"opa"`
+		// SharedCache - second level cache of the checks that the replicas share
+		SharedCache SharedCache `mapstructure:"shared_cache"`
+		// Invalidation - invalidates the cached checks that depend on the changes of every tenant in the storage
+		Invalidation Invalidation `mapstructure:"invalidation"`
+		// DecisionLog - sends the decisions of the checks, expands and lookups to http endpoints, kafka, a file or
+		// the database
+		DecisionLog DecisionLog `mapstructure:"decision_log"`
+		// TraceSampling - persists the full traces of a fraction of the checks, listed through the admin service
+		TraceSampling TraceSampling `mapstructure:"trace_sampling"`
+		// Views - allow lists of the most looked up permissions, maintained from the changes of every tenant
+		Views Views `mapstructure:"views"`
+		// ResponseCache - caches the responses of the expands and the schema lookups
+		ResponseCache ResponseCache `mapstructure:"response_cache"`
+		// DeadlineBudget - shares of the deadline of a request its schema reads, tuple queries and dispatched
+		// sub-checks may take
+		DeadlineBudget DeadlineBudget `mapstructure:"deadline_budget"`
+	}
+
+	// DeadlineBudget - A schema read, a tuple query or a dispatched sub-check that runs out of its share of the time
+	// left before the deadline of its request is cut. The reads are tried once more with the rest and the sub-checks
+	// are checked locally.
+	DeadlineBudget struct {
+		Enabled bool          `mapstructure:"enabled"`
+		Schema  float64       `mapstructure:"schema"`
+		Query   float64       `mapstructure:"query"`
+		Check   float64       `mapstructure:"check"`
+		Floor   time.Duration `mapstructure:"floor"`
+	}
+
+	// ResponseCache - Responses of the read-only permission requests other than check, by the request, the snapshot
+	// and the schema version. They are invalidated along with the cached checks.
+	ResponseCache struct {
+		Enabled bool  `mapstructure:"enabled"`
+		Cache   Cache `mapstructure:"cache"`
+	}
+
+	// Views - Materialized allow lists of entity_type#permission pairs, optionally followed by @subject_type. The
+	// lookups are served from them while they are at most max staleness behind the storage.
+	Views struct {
+		Enabled         bool          `mapstructure:"enabled"`
+		Permissions     []string      `mapstructure:"permissions"`
+		MaxStaleness    time.Duration `mapstructure:"max_staleness"`
+		RefreshInterval time.Duration `mapstructure:"refresh_interval"`
+	}
+
+	// DecisionLog - Asynchronous sinks of the decisions of the checks, expands and lookups, the filter and the sample
+	// rate select the decisions that are sent, the decisions that do not fit the queue are dropped. The decisions are
+	// sent to the webhooks, the kafka topic, the file as json lines and the decisions table of a postgres database.
+	DecisionLog struct {
+		Enabled       bool              `mapstructure:"enabled"`
+		SampleRate    float64           `mapstructure:"sample_rate"`
+		Tenants       []string          `mapstructure:"tenants"`
+		Permissions   []string          `mapstructure:"permissions"`
+		OnlyDenied    bool              `mapstructure:"only_denied"`
+		BatchSize     int               `mapstructure:"batch_size"`
+		FlushInterval time.Duration     `mapstructure:"flush_interval"`
+		QueueSize     int               `mapstructure:"queue_size"`
+		Webhooks      []DecisionWebhook `mapstructure:"webhooks"`
+		Kafka         DecisionLogKafka  `mapstructure:"kafka"`
+		Operations    []string          `mapstructure:"operations"`
+		File          string            `mapstructure:"file"`
+		Postgres      bool              `mapstructure:"postgres"`
+	}
+
+	// TraceSampling - Fraction of the checks whose traces, the tuples they read and their durations are written to the
+	// storage, the traces older than the retention are deleted. The traces that do not fit the queue are dropped.
+	TraceSampling struct {
+		Enabled    bool          `mapstructure:"enabled"`
+		SampleRate float64       `mapstructure:"sample_rate"`
+		Retention  time.Duration `mapstructure:"retention"`
+		QueueSize  int           `mapstructure:"queue_size"`
+	}
+
+	// DecisionWebhook - Http endpoint the batches of decisions are posted to
+	DecisionWebhook struct {
+		URL     string            `mapstructure:"url"`
+		Headers map[string]string `mapstructure:"headers"`
+		Timeout time.Duration     `mapstructure:"timeout"`
+	}
+
+	// DecisionLogKafka - Topic the decisions are written to, it is not used without brokers
+	DecisionLogKafka struct {
+		Brokers []string `mapstructure:"brokers"`
+		Topic   string   `mapstructure:"topic"`
+	}
+
+	// Invalidation - Subscription to the changes of the tenants, the tenants are listed every refresh interval
+	Invalidation struct {
+		Enabled         bool          `mapstructure:"enabled"`
+		RefreshInterval time.Duration `mapstructure:"refresh_interval"`
+	}
+
+	// SharedCache - Redis that the replicas share the results of the checks and their invalidations through
+	SharedCache struct {
+		Enabled  bool   `mapstructure:"enabled"`
+		Engine   string `mapstructure:"engine"`
+		Address  string `mapstructure:"address"`
+		Username string `mapstructure:"username"`
+		Password string `mapstructure:"password"`
+		DB       int    `mapstructure:"db"`
+		// Prefix - prefix of the keys, replicas with the same prefix share their results
+		Prefix string `mapstructure:"prefix"`
+		// TTL - lifetime of the results of the tenants whose settings give none
+		TTL     time.Duration `mapstructure:"ttl"`
+		Timeout time.Duration `mapstructure:"timeout"`
+	}
+
+	// OPA - Data api of an OPA server, the timeout limits a single policy evaluation
+	OPA struct {
+		Enabled bool          `mapstructure:"enabled"`
+		URL     string        `mapstructure:"url"`
+		Timeout time.Duration `mapstructure:"timeout"`
+	}
+
+	// External - grpc hook answering checks of a relation from an external data source
+	External struct {
+		// Relation - entity_type#relation the hook resolves
+		Relation string `mapstructure:"relation"`
+		Endpoint string `mapstructure:"endpoint"`
+		// Method - full grpc method name, it takes a check request and returns a check response
+		Method   string        `mapstructure:"method"`
+		Timeout  time.Duration `mapstructure:"timeout"`
+		CacheTTL time.Duration `mapstructure:"cache_ttl"`
+	}
+
+	// Warmup -.
+	Warmup struct {
+		Enabled bool   `mapstructure:"enabled"`
+		File    string `mapstructure:"file"`
+		Size    int    `mapstructure:"size"`
+	}
+
+	// Fallback - Degraded mode of the checks, it prefers availability over freshness during storage incidents
+	Fallback struct {
+		Enabled bool  `mapstructure:"enabled"`
+		Cache   Cache `mapstructure:"cache"`
+	}
+
+	// Usage - Statistics of the checks of the tenants, the window is the age of the oldest check they count
+	Usage struct {
+		Enabled bool          `mapstructure:"enabled"`
+		Window  time.Duration `mapstructure:"window"`
 	}
 
 	// Relationship -.
 	Relationship struct{}
 
+	// Identity -.
+	Identity struct {
+		Enabled bool `mapstructure:"enabled"`
+	}
+
+	// SCIM - Group provisioning of the identity providers, the mapping gives the tuples of the group memberships
+	SCIM struct {
+		Enabled bool        `mapstructure:"enabled"`
+		Mapping SCIMMapping `mapstructure:"mapping"`
+	}
+
+	// SCIMMapping - A member m of the group g is stored as entity_type:g#relation@subject_type:m#subject_relation
+	SCIMMapping struct {
+		EntityType      string `mapstructure:"entity_type"`
+		Relation        string `mapstructure:"relation"`
+		SubjectType     string `mapstructure:"subject_type"`
+		SubjectRelation string `mapstructure:"subject_relation"`
+	}
+
 	// Cache -.
 	Cache struct {
 		NumberOfCounters int64  `mapstructure:"number_of_counters"`
@@ -126,9 +362,184 @@ type (
 		MaxIdleConnections    int           `mapstructure:"max_idle_connections"`
 		MaxConnectionLifetime time.Duration `mapstructure:"max_connection_lifetime"`
 		MaxConnectionIdleTime time.Duration `mapstructure:"max_connection_idle_time"`
+		// FollowerReads - cockroach reads the relationships of checks as of their snapshots, so the closest replicas
+		// can serve them
+		FollowerReads     bool              `mapstructure:"follower_reads"`
+		Raft              Raft              `mapstructure:"raft"`
+		Encryption        Encryption        `mapstructure:"encryption"`
+		GarbageCollection GarbageCollection `mapstructure:"garbage_collection"`
+		Sweeper           Sweeper           `mapstructure:"sweeper"`
+	}
+
+	// GarbageCollection - Deletes the versions of the relation tuples of postgres that were deleted before the window,
+	// the snapshots older than the window can no longer be read
+	GarbageCollection struct {
+		Enabled bool `mapstructure:"enabled"`
+		// Window - how long the deleted tuples are kept, it should be longer than the archive interval
+		Window time.Duration `mapstructure:"window"`
+		// Interval - how often the deleted tuples are collected
+		Interval time.Duration `mapstructure:"interval"`
+		// BatchSize - rows deleted by a single statement
+		BatchSize int `mapstructure:"batch_size"`
+	}
+
+	// Sweeper - Deletes the relation tuples whose validity ended before the window, such as the temporary grants
+	// written with a not after time
+	Sweeper struct {
+		Enabled bool `mapstructure:"enabled"`
+		// Window - how long the tuples are kept after their validity ended, for the snapshots taken before
+		Window time.Duration `mapstructure:"window"`
+		// Interval - how often the expired tuples are swept
+		Interval time.Duration `mapstructure:"interval"`
+		// BatchSize - tuples deleted at once
+		BatchSize int `mapstructure:"batch_size"`
+	}
+
+	// Encryption - Envelope encryption of the tuples of some tenants at rest, every tenant has its own data key that
+	// is wrapped by the key provider
+	Encryption struct {
+		Enabled bool `mapstructure:"enabled"`
+		// Mode - subject encrypts the ids of the subjects, tuple the ids of the entities too
+		Mode     string      `mapstructure:"mode"`
+		Provider KeyProvider `mapstructure:"provider"`
+		// Keys - base64 wrapped data keys of the encrypted tenants by tenant id, the other tenants are not encrypted
+		Keys map[string]string `mapstructure:"keys"`
+	}
+
+	// KeyProvider - Key management service that wraps the data keys
+	KeyProvider struct {
+		// Type - local or vault
+		Type string `mapstructure:"type"`
+		// Key - base64 aes-256 master key of the local provider
+		Key string `mapstructure:"key"`
+		// Address, Token and KeyName - vault server, its token and the name of the transit key
+		Address string `mapstructure:"address"`
+		Token   string `mapstructure:"token"`
+		KeyName string `mapstructure:"key_name"`
+	}
+
+	// Raft - Memory database replicated across the nodes of a cluster, engine raft
+	Raft struct {
+		NodeID string `mapstructure:"node_id"`
+		// Address - address of the raft transport of this node
+		Address string `mapstructure:"address"`
+		// DataDir - directory of the raft log and the snapshots
+		DataDir string `mapstructure:"data_dir"`
+		// Peers - every node of the cluster, this one included
+		Peers        []RaftPeer    `mapstructure:"peers"`
+		ApplyTimeout time.Duration `mapstructure:"apply_timeout"`
+		// PeerKey - key the nodes forward the writes to the leader with, the leader only applies the forwarded writes
+		// of the restricted callers when it is set
+		PeerKey string `mapstructure:"peer_key"`
+	}
+
+	// RaftPeer - A node of the raft cluster
+	RaftPeer struct {
+		ID      string `mapstructure:"id"`
+		Address string `mapstructure:"address"`
+		// GRPCAddress - address the other nodes forward the writes to while this node leads the cluster
+		GRPCAddress string `mapstructure:"grpc_address"`
+	}
+
+	// Distributed - Multi-region deployment against replicated storage
+	Distributed struct {
+		Enabled bool `mapstructure:"enabled"`
+		// Region - name of the region this instance runs in
+		Region string `mapstructure:"region"`
+		// PrimaryRegion - name of the region that accepts writes
+		PrimaryRegion string `mapstructure:"primary_region"`
+		// PrimaryAddress - grpc address writes are forwarded to from the other regions
+		PrimaryAddress string `mapstructure:"primary_address"`
+		// CacheEpoch - bumping the epoch invalidates the permission cache of the region
+		CacheEpoch uint64   `mapstructure:"cache_epoch"`
+		Dispatch   Dispatch `mapstructure:"dispatch"`
+	}
+
+	// Dispatch - Ring of the peers of the region that the subproblems of the checks are spread over
+	Dispatch struct {
+		Enabled bool `mapstructure:"enabled"`
+		// Address - grpc address the peers reach this instance at
+		Address string `mapstructure:"address"`
+		// Timeout - how long a peer has to answer a subproblem before it is checked locally
+		Timeout   time.Duration `mapstructure:"timeout"`
+		Discovery Discovery     `mapstructure:"discovery"`
+		// PeerKey - key the peers dispatch the subproblems with, the subproblems of the checks of the restricted
+		// callers are only dispatched when it is set
+		PeerKey string `mapstructure:"peer_key"`
+	}
+
+	// Discovery - How the peers of the dispatch ring are found
+	Discovery struct {
+		// Type - static, dns or kubernetes, kubernetes falls back to dns when the endpoints cannot be read
+		Type string `mapstructure:"type"`
+		// Members - peers of the static discovery
+		Members []string `mapstructure:"members"`
+		// DNS - name resolved to the addresses of the peers, e.g. the headless service of the pods
+		DNS  string `mapstructure:"dns"`
+		Port string `mapstructure:"port"`
+		// Namespace - namespace of the service, the namespace of the pod when it is empty
+		Namespace string `mapstructure:"namespace"`
+		Service   string `mapstructure:"service"`
+		PortName  string `mapstructure:"port_name"`
+		// RefreshInterval - how often the peers are discovered
+		RefreshInterval time.Duration `mapstructure:"refresh_interval"`
+		// RemovalDelay - how long a peer has to be missing before its keys move to the other peers
+		RemovalDelay time.Duration `mapstructure:"removal_delay"`
+	}
+
+	// Archive - Ships the change log of the tenants and exports of their snapshots to an object storage, only a
+	// single instance should archive to a bucket
+	Archive struct {
+		Enabled bool `mapstructure:"enabled"`
+		// Engine - s3 or gcs, gcs is reached through its s3 compatible api with the hmac keys of a service account
+		Engine string `mapstructure:"engine"`
+		// Endpoint - host of the object storage, the endpoint of the engine when it is empty
+		Endpoint string `mapstructure:"endpoint"`
+		Bucket   string `mapstructure:"bucket"`
+		Region   string `mapstructure:"region"`
+		// Prefix - prefix of the keys of the archived objects
+		Prefix    string `mapstructure:"prefix"`
+		AccessKey string `mapstructure:"access_key"`
+		SecretKey string `mapstructure:"secret_key"`
+		// Insecure - reaches the endpoint over plain http
+		Insecure bool `mapstructure:"insecure"`
+		// Interval - how often the changes are shipped
+		Interval time.Duration `mapstructure:"interval"`
+		// ExportInterval - how often the tenants are exported, zero disables the exports
+		ExportInterval time.Duration `mapstructure:"export_interval"`
+		// Retention - how long the archived objects are kept, zero keeps them forever
+		Retention time.Duration `mapstructure:"retention"`
+	}
+
+	// Events - Publishes the relationship and schema writes of the tenants to kafka or nats, the tenants are listed
+	// every refresh interval. The events are published at least once, from the transactions of the database.
+	Events struct {
+		Enabled bool `mapstructure:"enabled"`
+		// Broker - kafka or nats
+		Broker          string        `mapstructure:"broker"`
+		Kafka           EventsKafka   `mapstructure:"kafka"`
+		NATS            EventsNATS    `mapstructure:"nats"`
+		RefreshInterval time.Duration `mapstructure:"refresh_interval"`
+	}
+
+	// EventsKafka - Topic the events are written to, keyed by tenant
+	EventsKafka struct {
+		Brokers []string `mapstructure:"brokers"`
+		Topic   string   `mapstructure:"topic"`
+	}
+
+	// EventsNATS - Jetstream the events are published to, on the subject followed by the id of their tenant
+	EventsNATS struct {
+		URL     string `mapstructure:"url"`
+		Subject string `mapstructure:"subject"`
 	}
 )
 
+// IsPrimary - Reports whether this instance accepts writes
+func (d Distributed) IsPrimary() bool {
+	return !d.Enabled || d.Region == d.PrimaryRegion
+}
+
 // NewConfig - Creates new config
 func NewConfig() (*Config, error) {
 	cfg := DefaultConfig()
@@ -170,6 +581,22 @@ func DefaultConfig() *Config {
 					Enabled: false,
 				},
 			},
+			Limits: Limits{
+				MaxTuplesPerWrite: 100,
+				MaxFilterIDs:      1_000,
+				MaxChecksPerBulk:  10_000,
+				MaxSchemaBytes:    1 << 20,
+			},
+			Depth: Depth{
+				Default: 20,
+				Max:     100,
+			},
+			RateLimit: RateLimit{
+				Enabled: false,
+				Read:    1_000,
+				Write:   100,
+				Check:   1_000,
+			},
 		},
 		Profiler: Profiler{
 			Enabled: false,
@@ -194,22 +621,162 @@ func DefaultConfig() *Config {
 				},
 			},
 			Permission: Permission{
-				ConcurrencyLimit: 100,
+				ConcurrencyLimit:         100,
+				MinimizeLatencyStaleness: 5 * time.Second,
+				MemoryLimit:              "0",
 				Cache: Cache{
 					NumberOfCounters: 10_000,
 					MaxCost:          "10MiB",
 				},
+				Warmup: Warmup{
+					Enabled: false,
+					File:    "warmup.yaml",
+					Size:    1000,
+				},
+				Fallback: Fallback{
+					Enabled: false,
+					Cache: Cache{
+						NumberOfCounters: 10_000,
+						MaxCost:          "10MiB",
+					},
+				},
+				Usage: Usage{
+					Enabled: false,
+					Window:  time.Hour,
+				},
+				ResponseCache: ResponseCache{
+					Enabled: false,
+					Cache: Cache{
+						NumberOfCounters: 10_000,
+						MaxCost:          "10MiB",
+					},
+				},
+				DeadlineBudget: DeadlineBudget{
+					Enabled: false,
+					Schema:  0.25,
+					Query:   0.5,
+					Check:   0.5,
+					Floor:   10 * time.Millisecond,
+				},
+				SharedCache: SharedCache{
+					Enabled: false,
+					Engine:  "redis",
+					Address: "localhost:6379",
+					Prefix:  "permify:",
+					TTL:     10 * time.Minute,
+					Timeout: 50 * time.Millisecond,
+				},
+				Invalidation: Invalidation{
+					Enabled:         false,
+					RefreshInterval: 30 * time.Second,
+				},
+				DecisionLog: DecisionLog{
+					Enabled:       false,
+					SampleRate:    1,
+					BatchSize:     100,
+					FlushInterval: time.Second,
+					QueueSize:     10_000,
+					Kafka: DecisionLogKafka{
+						Topic: "permify-decisions",
+					},
+				},
+				TraceSampling: TraceSampling{
+					Enabled:    false,
+					SampleRate: 0.001,
+					Retention:  72 * time.Hour,
+					QueueSize:  1000,
+				},
+				Views: Views{
+					Enabled:         false,
+					MaxStaleness:    5 * time.Second,
+					RefreshInterval: 30 * time.Second,
+				},
+				OPA: OPA{
+					Enabled: false,
+					URL:     "http://localhost:8181",
+					Timeout: time.Second,
+				},
 			},
 			Relationship: Relationship{},
+			Identity: Identity{
+				Enabled: false,
+			},
+			SCIM: SCIM{
+				Enabled: false,
+				Mapping: SCIMMapping{
+					EntityType:  "group",
+					Relation:    "member",
+					SubjectType: "user",
+				},
+			},
 		},
 		Authn: Authn{
 			Enabled:   false,
 			Preshared: Preshared{},
-			Oidc:      Oidc{},
+			Oidc: Oidc{
+				Scopes: OidcScopes{
+					Read:  "permify:read",
+					Write: "permify:write",
+					Admin: "permify:admin",
+				},
+			},
 		},
 		Database: Database{
 			Engine:      "memory",
 			AutoMigrate: true,
+			Encryption: Encryption{
+				Enabled: false,
+				Mode:    "subject",
+				Provider: KeyProvider{
+					Type: "local",
+				},
+			},
+			GarbageCollection: GarbageCollection{
+				Enabled:   false,
+				Window:    24 * time.Hour,
+				Interval:  time.Hour,
+				BatchSize: 1000,
+			},
+			Sweeper: Sweeper{
+				Enabled:   false,
+				Window:    time.Hour,
+				Interval:  10 * time.Minute,
+				BatchSize: 1000,
+			},
+		},
+		Distributed: Distributed{
+			Enabled: false,
+			Dispatch: Dispatch{
+				Enabled: false,
+				Timeout: time.Second,
+				Discovery: Discovery{
+					Type:            "static",
+					Port:            "3478",
+					PortName:        "grpc",
+					RefreshInterval: 10 * time.Second,
+					RemovalDelay:    30 * time.Second,
+				},
+			},
+		},
+		Archive: Archive{
+			Enabled:        false,
+			Engine:         "s3",
+			Prefix:         "permify/",
+			Interval:       time.Minute,
+			ExportInterval: 24 * time.Hour,
+			Retention:      0,
+		},
+		Events: Events{
+			Enabled: false,
+			Broker:  "kafka",
+			Kafka: EventsKafka{
+				Topic: "permify.events",
+			},
+			NATS: EventsNATS{
+				URL:     "nats://localhost:4222",
+				Subject: "permify.events",
+			},
+			RefreshInterval: 30 * time.Second,
 		},
 	}
 }
@@ -0,0 +1,59 @@
+package deadline
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Budget - Divides the time left before the deadline of a request between the operations it is made of: the schema
+// reads, the tuple queries and the sub-checks. An operation gets its share of the time that is left when it starts.
+// One that runs out of its share while the request still has time is cut and tried once more with all of the time
+// left, so a single slow storage call does not burn the whole deadline. The operations of the requests without a
+// deadline are not limited.
+type Budget struct {
+	// Schema, Query, Check - shares of the time left that a schema read, a tuple query and a dispatched sub-check
+	// may take, an operation whose share is not between 0 and 1 is not limited
+	Schema float64
+	Query  float64
+	Check  float64
+	// Floor - an operation is not given less time than this, unless less is left
+	Floor time.Duration
+}
+
+// Context - Context of an operation with the share of the time left before the deadline of the request
+func (b Budget) Context(ctx context.Context, share float64) (context.Context, context.CancelFunc) {
+	shared, cancel, _ := b.share(ctx, share)
+	return shared, cancel
+}
+
+// Run - Runs the operation within its share of the time left, and once more with all of the time left when the
+// share ran out before the deadline of the request
+func (b Budget) Run(ctx context.Context, share float64, operation func(ctx context.Context) error) error {
+	shared, cancel, limited := b.share(ctx, share)
+	err := operation(shared)
+	cut := limited && err != nil && errors.Is(shared.Err(), context.DeadlineExceeded) && ctx.Err() == nil
+	cancel()
+	if !cut {
+		return err
+	}
+	return operation(ctx)
+}
+
+// share - limited is false when the operation may take all of the time left
+func (b Budget) share(ctx context.Context, share float64) (context.Context, context.CancelFunc, bool) {
+	deadline, ok := ctx.Deadline()
+	if !ok || share <= 0 || share >= 1 {
+		return ctx, func() {}, false
+	}
+	left := time.Until(deadline)
+	timeout := time.Duration(float64(left) * share)
+	if timeout < b.Floor {
+		timeout = b.Floor
+	}
+	if timeout >= left {
+		return ctx, func() {}, false
+	}
+	shared, cancel := context.WithTimeout(ctx, timeout)
+	return shared, cancel, true
+}
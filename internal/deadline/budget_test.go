@@ -0,0 +1,91 @@
+package deadline
+
+import (
+	"context"
+	"errors"
+	"time"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("budget", func() {
+	Context("Context", func() {
+		budget := Budget{Floor: 100 * time.Millisecond}
+		
+		// share - Time left to an operation given the share of the timeout, zero when it is not limited
+		share := func(timeout time.Duration, share float64) time.Duration {
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+			shared, release := budget.Context(ctx, share)
+			defer release()
+			if shared == ctx {
+				return 0
+			}
+			deadline, _ := shared.Deadline()
+			return time.Until(deadline)
+		}
+		
+		It("Case 1: An operation gets its share of the time left", func() {
+			left := share(4*time.Second, 0.25)
+			Expect(left).Should(BeNumerically(">", 0))
+			Expect(left).Should(BeNumerically("<=", time.Second))
+		})
+		
+		It("Case 2: The floor is given to the operations whose share is shorter, but not more than is left", func() {
+			left := share(200*time.Millisecond, 0.1)
+			Expect(left).Should(BeNumerically(">", 0))
+			Expect(left).Should(BeNumerically("<=", 100*time.Millisecond))
+			
+			Expect(share(50*time.Millisecond, 0.5)).Should(BeZero())
+		})
+		
+		It("Case 3: The shares that are not between 0 and 1 do not limit the operation", func() {
+			Expect(share(time.Second, 0)).Should(BeZero())
+			Expect(share(time.Second, 1)).Should(BeZero())
+		})
+		
+		It("Case 4: An operation of a request without a deadline is not limited", func() {
+			shared, release := budget.Context(context.Background(), 0.5)
+			defer release()
+			Expect(shared).Should(Equal(context.Background()))
+		})
+	})
+	
+	Context("Run", func() {
+		budget := Budget{Query: 0.2}
+		
+		It("Case 1: An operation cut at the end of its share is tried once more with the time left", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			
+			// the first attempt hangs until its share runs out, like a slow storage call
+			attempts := 0
+			err := budget.Run(ctx, budget.Query, func(ctx context.Context) error {
+				attempts++
+				if attempts == 1 {
+					<-ctx.Done()
+					return ctx.Err()
+				}
+				return nil
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(attempts).Should(Equal(2))
+			Expect(ctx.Err()).ShouldNot(HaveOccurred(), "the slow operation burned the deadline of the request")
+		})
+		
+		It("Case 2: Other errors are returned as they are", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			
+			failure := errors.New("failure")
+			attempts := 0
+			err := budget.Run(ctx, budget.Query, func(ctx context.Context) error {
+				attempts++
+				return failure
+			})
+			Expect(err).Should(MatchError(failure))
+			Expect(attempts).Should(Equal(1))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package deadline
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestDeadline(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "deadline-suite")
+}
@@ -0,0 +1,444 @@
+package decisions
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+	
+	"go.opentelemetry.io/otel/trace"
+	
+	"github.com/adminium/permify/internal/commands"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+const (
+	_defaultBatchSize     = 100
+	_defaultFlushInterval = time.Second
+	_defaultQueueSize     = 10_000
+)
+
+// Operations of the decisions
+const (
+	OperationCheck         = "check"
+	OperationExpand        = "expand"
+	OperationLookupEntity  = "lookup_entity"
+	OperationLookupSubject = "lookup_subject"
+)
+
+// Decision - A check, expand or lookup and its result as it is sent to the sinks. The entity of an entity lookup is
+// the looked up entity type and the subject of a subject lookup is the looked up subject type, the lookups report the
+// number of ids they found instead of a result.
+type Decision struct {
+	Time          time.Time `json:"time"`
+	Operation     string    `json:"operation"`
+	TenantID      string    `json:"tenant_id"`
+	Entity        string    `json:"entity"`
+	Permission    string    `json:"permission"`
+	Subject       string    `json:"subject,omitempty"`
+	SchemaVersion string    `json:"schema_version"`
+	SnapToken     string    `json:"snap_token"`
+	Result        string    `json:"result,omitempty"`
+	ResultCount   int       `json:"result_count,omitempty"`
+	LatencyMs     float64   `json:"latency_ms"`
+	TraceID       string    `json:"trace_id,omitempty"`
+	
+	// entityType - matched by the permissions of the filter
+	entityType string
+}
+
+// Sink - Destination of the batches of decisions, e.g. an http endpoint or a kafka topic
+type Sink interface {
+	Send(ctx context.Context, decisions []Decision) error
+	Close() error
+}
+
+// Filter - Decides which decisions are logged, empty lists match every operation, tenant and permission
+type Filter struct {
+	Operations  []string
+	Tenants     []string
+	Permissions []string
+	// OnlyDenied - only the denied checks are logged, the other operations are not
+	OnlyDenied bool
+	// SampleRate - fraction of the matching decisions that are logged, zero logs all of them
+	SampleRate float64
+}
+
+// Logger - Sends the decisions to the sinks in batches without blocking the checks. The decisions are
+// queued, and a full queue drops them instead of slowing the checks down; the dropped decisions are counted. Every
+// batch is sent to every sink, a sink that fails loses the batch and the next one is sent to it again.
+type Logger struct {
+	sinks  []Sink
+	filter Filter
+	// batching
+	queue         chan Decision
+	batchSize     int
+	flushInterval time.Duration
+	dropped       uint64
+	// the sampling is not security sensitive
+	mu     sync.Mutex
+	random *rand.Rand
+	logger logger.Interface
+}
+
+// Option - Option type
+type Option func(*Logger)
+
+// BatchSize - Number of decisions sent to the sinks at once
+func BatchSize(size int) Option {
+	return func(l *Logger) {
+		if size > 0 {
+			l.batchSize = size
+		}
+	}
+}
+
+// FlushInterval - How long a decision waits at most for its batch to fill up
+func FlushInterval(interval time.Duration) Option {
+	return func(l *Logger) {
+		if interval > 0 {
+			l.flushInterval = interval
+		}
+	}
+}
+
+// QueueSize - Number of decisions waiting to be sent, the decisions beyond it are dropped
+func QueueSize(size int) Option {
+	return func(l *Logger) {
+		if size > 0 {
+			l.queue = make(chan Decision, size)
+		}
+	}
+}
+
+// NewLogger - Creates a new decision logger
+func NewLogger(sinks []Sink, filter Filter, l logger.Interface, opts ...Option) *Logger {
+	dl := &Logger{
+		sinks:         sinks,
+		filter:        filter,
+		queue:         make(chan Decision, _defaultQueueSize),
+		batchSize:     _defaultBatchSize,
+		flushInterval: _defaultFlushInterval,
+		random:        rand.New(rand.NewSource(time.Now().UnixNano())),
+		logger:        l,
+	}
+	for _, opt := range opts {
+		opt(dl)
+	}
+	return dl
+}
+
+// Record - Queues the decision of the check when the filter matches it
+func (l *Logger) Record(ctx context.Context, request *base.PermissionCheckRequest, response *base.PermissionCheckResponse, latency time.Duration) {
+	l.record(ctx, Decision{
+		Operation:  OperationCheck,
+		TenantID:   request.GetTenantId(),
+		Entity:     tuple.EntityToString(request.GetEntity()),
+		Permission: request.GetPermission(),
+		Subject:    tuple.SubjectToString(request.GetSubject()),
+		// the check fills the versions of the snapshot and the schema it was executed at
+		SchemaVersion: request.GetMetadata().GetSchemaVersion(),
+		SnapToken:     request.GetMetadata().GetSnapToken(),
+		Result:        response.GetCan().String(),
+		entityType:    request.GetEntity().GetType(),
+	}, latency)
+}
+
+// RecordExpand - Queues the decision of the expand when the filter matches it
+func (l *Logger) RecordExpand(ctx context.Context, request *base.PermissionExpandRequest, latency time.Duration) {
+	l.record(ctx, Decision{
+		Operation:     OperationExpand,
+		TenantID:      request.GetTenantId(),
+		Entity:        tuple.EntityToString(request.GetEntity()),
+		Permission:    request.GetPermission(),
+		SchemaVersion: request.GetMetadata().GetSchemaVersion(),
+		SnapToken:     request.GetMetadata().GetSnapToken(),
+		entityType:    request.GetEntity().GetType(),
+	}, latency)
+}
+
+// RecordLookupEntity - Queues the decision of the entity lookup when the filter matches it
+func (l *Logger) RecordLookupEntity(ctx context.Context, request *base.PermissionLookupEntityRequest, found int, latency time.Duration) {
+	l.record(ctx, Decision{
+		Operation:     OperationLookupEntity,
+		TenantID:      request.GetTenantId(),
+		Entity:        request.GetEntityType(),
+		Permission:    request.GetPermission(),
+		Subject:       tuple.SubjectToString(request.GetSubject()),
+		SchemaVersion: request.GetMetadata().GetSchemaVersion(),
+		SnapToken:     request.GetMetadata().GetSnapToken(),
+		ResultCount:   found,
+		entityType:    request.GetEntityType(),
+	}, latency)
+}
+
+// RecordLookupSubject - Queues the decision of the subject lookup when the filter matches it
+func (l *Logger) RecordLookupSubject(ctx context.Context, request *commands.LookupSubjectRequest, found int, latency time.Duration) {
+	subject := request.SubjectReference.GetType()
+	if request.SubjectReference.GetRelation() != "" {
+		subject += "#" + request.SubjectReference.GetRelation()
+	}
+	l.record(ctx, Decision{
+		Operation:     OperationLookupSubject,
+		TenantID:      request.TenantID,
+		Entity:        tuple.EntityToString(request.Entity),
+		Permission:    request.Permission,
+		Subject:       subject,
+		SchemaVersion: request.Metadata.GetSchemaVersion(),
+		SnapToken:     request.Metadata.GetSnapToken(),
+		ResultCount:   found,
+		entityType:    request.Entity.GetType(),
+	}, latency)
+}
+
+// record - Queues the decision when the filter matches it
+func (l *Logger) record(ctx context.Context, decision Decision, latency time.Duration) {
+	if !l.matches(decision) {
+		return
+	}
+	
+	decision.Time = time.Now().UTC()
+	decision.LatencyMs = float64(latency) / float64(time.Millisecond)
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		decision.TraceID = sc.TraceID().String()
+	}
+	
+	select {
+	case l.queue <- decision:
+	default:
+		atomic.AddUint64(&l.dropped, 1)
+	}
+}
+
+// Dropped - Number of decisions dropped because the queue was full
+func (l *Logger) Dropped() uint64 {
+	return atomic.LoadUint64(&l.dropped)
+}
+
+// Run - Sends the queued decisions until the context is done, the decisions queued by then are sent before it returns
+func (l *Logger) Run(ctx context.Context) error {
+	ticker := time.NewTicker(l.flushInterval)
+	defer ticker.Stop()
+	
+	batch := make([]Decision, 0, l.batchSize)
+	for {
+		select {
+		case decision := <-l.queue:
+			batch = append(batch, decision)
+			if len(batch) >= l.batchSize {
+				l.send(batch)
+				batch = batch[:0]
+			}
+		case <-ticker.C:
+			if len(batch) > 0 {
+				l.send(batch)
+				batch = batch[:0]
+			}
+		case <-ctx.Done():
+			for {
+				select {
+				case decision := <-l.queue:
+					batch = append(batch, decision)
+					if len(batch) >= l.batchSize {
+						l.send(batch)
+						batch = batch[:0]
+					}
+				default:
+					if len(batch) > 0 {
+						l.send(batch)
+					}
+					l.close()
+					return nil
+				}
+			}
+		}
+	}
+}
+
+// send - Sends the batch to every sink, the sinks are given their own deadline so that a shutdown still flushes
+func (l *Logger) send(batch []Decision) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*l.flushInterval)
+	defer cancel()
+	
+	for _, sink := range l.sinks {
+		if err := sink.Send(ctx, batch); err != nil {
+			l.logger.Warn("sending %d decisions failed: %s", len(batch), err.Error())
+		}
+	}
+}
+
+// close - Closes the sinks
+func (l *Logger) close() {
+	for _, sink := range l.sinks {
+		if err := sink.Close(); err != nil {
+			l.logger.Warn(fmt.Sprintf("closing the decision sink failed: %s", err.Error()))
+		}
+	}
+}
+
+// matches - Whether the decision is logged
+func (l *Logger) matches(decision Decision) bool {
+	if l.filter.OnlyDenied && (decision.Operation != OperationCheck || decision.Result == base.PermissionCheckResponse_RESULT_ALLOWED.String()) {
+		return false
+	}
+	if len(l.filter.Operations) > 0 && !contains(l.filter.Operations, decision.Operation) {
+		return false
+	}
+	if len(l.filter.Tenants) > 0 && !contains(l.filter.Tenants, decision.TenantID) {
+		return false
+	}
+	if len(l.filter.Permissions) > 0 && !contains(l.filter.Permissions, decision.entityType+"#"+decision.Permission) && !contains(l.filter.Permissions, decision.Permission) {
+		return false
+	}
+	if l.filter.SampleRate > 0 && l.filter.SampleRate < 1 {
+		l.mu.Lock()
+		sampled := l.random.Float64() < l.filter.SampleRate
+		l.mu.Unlock()
+		return sampled
+	}
+	return true
+}
+
+// contains -
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// LoggingCheckCommand - Logs the decisions of the successful checks
+type LoggingCheckCommand struct {
+	delegate commands.ICheckCommand
+	logger   *Logger
+}
+
+// NewLoggingCheckCommand - Creates new logging check command
+func NewLoggingCheckCommand(delegate commands.ICheckCommand, l *Logger) *LoggingCheckCommand {
+	return &LoggingCheckCommand{
+		delegate: delegate,
+		logger:   l,
+	}
+}
+
+// Execute -
+func (c *LoggingCheckCommand) Execute(ctx context.Context, request *base.PermissionCheckRequest) (*base.PermissionCheckResponse, error) {
+	start := time.Now()
+	response, err := c.delegate.Execute(ctx, request)
+	if err == nil {
+		c.logger.Record(ctx, request, response, time.Since(start))
+	}
+	return response, err
+}
+
+// LoggingExpandCommand - Logs the decisions of the successful expands, the continuations of truncated expands are not
+// logged again
+type LoggingExpandCommand struct {
+	delegate commands.IExpandCommand
+	logger   *Logger
+}
+
+// NewLoggingExpandCommand - Creates new logging expand command
+func NewLoggingExpandCommand(delegate commands.IExpandCommand, l *Logger) *LoggingExpandCommand {
+	return &LoggingExpandCommand{
+		delegate: delegate,
+		logger:   l,
+	}
+}
+
+// Execute -
+func (c *LoggingExpandCommand) Execute(ctx context.Context, request *base.PermissionExpandRequest) (*base.PermissionExpandResponse, error) {
+	start := time.Now()
+	response, err := c.delegate.Execute(ctx, request)
+	if err == nil {
+		c.logger.RecordExpand(ctx, request, time.Since(start))
+	}
+	return response, err
+}
+
+// Continue -
+func (c *LoggingExpandCommand) Continue(ctx context.Context, tenantID, continuation string) (*base.PermissionExpandResponse, error) {
+	return c.delegate.Continue(ctx, tenantID, continuation)
+}
+
+// LoggingLookupEntityCommand - Logs the decisions of the successful entity lookups, with the number of entities they
+// found
+type LoggingLookupEntityCommand struct {
+	delegate commands.ILookupEntityCommand
+	logger   *Logger
+}
+
+// NewLoggingLookupEntityCommand - Creates new logging lookup entity command
+func NewLoggingLookupEntityCommand(delegate commands.ILookupEntityCommand, l *Logger) *LoggingLookupEntityCommand {
+	return &LoggingLookupEntityCommand{
+		delegate: delegate,
+		logger:   l,
+	}
+}
+
+// Execute -
+func (c *LoggingLookupEntityCommand) Execute(ctx context.Context, request *base.PermissionLookupEntityRequest) (*base.PermissionLookupEntityResponse, error) {
+	start := time.Now()
+	response, err := c.delegate.Execute(ctx, request)
+	if err == nil {
+		c.logger.RecordLookupEntity(ctx, request, len(response.GetEntityIds()), time.Since(start))
+	}
+	return response, err
+}
+
+// Stream - The entities sent to the stream are counted
+func (c *LoggingLookupEntityCommand) Stream(ctx context.Context, request *base.PermissionLookupEntityRequest, server base.Permission_LookupEntityStreamServer) error {
+	start := time.Now()
+	counting := &countingLookupEntityStream{Permission_LookupEntityStreamServer: server}
+	err := c.delegate.Stream(ctx, request, counting)
+	if err == nil {
+		c.logger.RecordLookupEntity(ctx, request, counting.sent, time.Since(start))
+	}
+	return err
+}
+
+// countingLookupEntityStream - Counts the entities sent to the stream
+type countingLookupEntityStream struct {
+	base.Permission_LookupEntityStreamServer
+	sent int
+}
+
+// Send -
+func (s *countingLookupEntityStream) Send(response *base.PermissionLookupEntityStreamResponse) error {
+	if err := s.Permission_LookupEntityStreamServer.Send(response); err != nil {
+		return err
+	}
+	s.sent++
+	return nil
+}
+
+// LoggingLookupSubjectCommand - Logs the decisions of the successful subject lookups, with the number of subjects
+// they found
+type LoggingLookupSubjectCommand struct {
+	delegate commands.ILookupSubjectCommand
+	logger   *Logger
+}
+
+// NewLoggingLookupSubjectCommand - Creates new logging lookup subject command
+func NewLoggingLookupSubjectCommand(delegate commands.ILookupSubjectCommand, l *Logger) *LoggingLookupSubjectCommand {
+	return &LoggingLookupSubjectCommand{
+		delegate: delegate,
+		logger:   l,
+	}
+}
+
+// Execute -
+func (c *LoggingLookupSubjectCommand) Execute(ctx context.Context, request *commands.LookupSubjectRequest) (*commands.LookupSubjectResponse, error) {
+	start := time.Now()
+	response, err := c.delegate.Execute(ctx, request)
+	if err == nil {
+		c.logger.RecordLookupSubject(ctx, request, len(response.SubjectIDs), time.Since(start))
+	}
+	return response, err
+}
@@ -0,0 +1,245 @@
+package decisions
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Masterminds/squirrel"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	
+	"github.com/adminium/permify/internal/commands"
+	"github.com/adminium/permify/pkg/database/postgres"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// TestDecisions -
+func TestDecisions(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "decisions-suite")
+}
+
+// check - Check command that allows the owners of the documents
+type check struct{}
+
+func (check) Execute(_ context.Context, request *base.PermissionCheckRequest) (*base.PermissionCheckResponse, error) {
+	if request.GetPermission() == "owner" {
+		return &base.PermissionCheckResponse{Can: base.PermissionCheckResponse_RESULT_ALLOWED}, nil
+	}
+	return &base.PermissionCheckResponse{Can: base.PermissionCheckResponse_RESULT_DENIED}, nil
+}
+
+// request -
+func request(tenantID, permission string) *base.PermissionCheckRequest {
+	return &base.PermissionCheckRequest{
+		TenantId:   tenantID,
+		Entity:     &base.Entity{Type: "doc", Id: "1"},
+		Permission: permission,
+		Subject:    &base.Subject{Type: "user", Id: "1"},
+		Metadata:   &base.PermissionCheckRequestMetadata{SnapToken: "snap", SchemaVersion: "v1"},
+	}
+}
+
+// expand - Expand command that expands nothing
+type expand struct{}
+
+func (expand) Execute(context.Context, *base.PermissionExpandRequest) (*base.PermissionExpandResponse, error) {
+	return &base.PermissionExpandResponse{}, nil
+}
+
+func (expand) Continue(context.Context, string, string) (*base.PermissionExpandResponse, error) {
+	return &base.PermissionExpandResponse{}, nil
+}
+
+// lookupEntity - Lookup entity command that finds two documents
+type lookupEntity struct{}
+
+func (lookupEntity) Execute(context.Context, *base.PermissionLookupEntityRequest) (*base.PermissionLookupEntityResponse, error) {
+	return &base.PermissionLookupEntityResponse{EntityIds: []string{"1", "2"}}, nil
+}
+
+func (lookupEntity) Stream(context.Context, *base.PermissionLookupEntityRequest, base.Permission_LookupEntityStreamServer) error {
+	return nil
+}
+
+// lookupSubject - Lookup subject command that finds one subject
+type lookupSubject struct{}
+
+func (lookupSubject) Execute(context.Context, *commands.LookupSubjectRequest) (*commands.LookupSubjectResponse, error) {
+	return &commands.LookupSubjectResponse{SubjectIDs: []string{"1"}}, nil
+}
+
+var _ = Describe("decisions", func() {
+	Context("Logger", func() {
+		It("Case 1: Matching decisions are posted to the webhooks in batches", func() {
+			var mu sync.Mutex
+			var batches [][]Decision
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var batch []Decision
+				if r.Header.Get("Authorization") != "Bearer token" || json.NewDecoder(r.Body).Decode(&batch) != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				mu.Lock()
+				batches = append(batches, batch)
+				mu.Unlock()
+			}))
+			defer server.Close()
+			
+			l := NewLogger([]Sink{NewWebhook(server.URL, map[string]string{"Authorization": "Bearer token"}, time.Second)}, Filter{
+				Tenants:    []string{"t1"},
+				OnlyDenied: true,
+			}, logger.New("error"), BatchSize(2), FlushInterval(50*time.Millisecond))
+			
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				_ = l.Run(ctx)
+			}()
+			
+			command := NewLoggingCheckCommand(check{}, l)
+			for _, r := range []*base.PermissionCheckRequest{request("t1", "edit"), request("t1", "owner"), request("t2", "edit"), request("t1", "view"), request("t1", "delete")} {
+				_, err := command.Execute(context.Background(), r)
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+			
+			// the full batch is sent at once, the rest when the flush interval passes
+			Eventually(func() int {
+				mu.Lock()
+				defer mu.Unlock()
+				return len(batches)
+			}).Should(Equal(2))
+			
+			cancel()
+			Eventually(done).Should(BeClosed())
+			
+			Expect(batches[0]).Should(HaveLen(2))
+			Expect(batches[1]).Should(HaveLen(1))
+			
+			decision := batches[0][0]
+			Expect(decision.TenantID).Should(Equal("t1"))
+			Expect(decision.Entity).Should(Equal("doc:1"))
+			Expect(decision.Permission).Should(Equal("edit"))
+			Expect(decision.Subject).Should(Equal("user:1"))
+			Expect(decision.SnapToken).Should(Equal("snap"))
+			Expect(decision.Result).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED.String()))
+		})
+		
+		It("Case 2: Decisions beyond the queue are dropped without blocking", func() {
+			l := NewLogger(nil, Filter{Permissions: []string{"doc#edit"}}, logger.New("error"), QueueSize(2))
+			
+			command := NewLoggingCheckCommand(check{}, l)
+			for i := 0; i < 5; i++ {
+				_, err := command.Execute(context.Background(), request("t1", "edit"))
+				Expect(err).ShouldNot(HaveOccurred())
+				_, err = command.Execute(context.Background(), request("t1", "view"))
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+			
+			Expect(l.Dropped()).Should(Equal(uint64(3)))
+		})
+		
+		It("Case 3: Expands and lookups are appended to the file with the number of results of the lookups", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "decisions.jsonl")
+			file, err := NewFile(path)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			l := NewLogger([]Sink{file}, Filter{
+				Operations: []string{OperationExpand, OperationLookupEntity, OperationLookupSubject},
+			}, logger.New("error"))
+			
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				_ = l.Run(ctx)
+			}()
+			
+			_, err = NewLoggingCheckCommand(check{}, l).Execute(context.Background(), request("t1", "edit"))
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = NewLoggingExpandCommand(expand{}, l).Execute(context.Background(), &base.PermissionExpandRequest{
+				TenantId:   "t1",
+				Entity:     &base.Entity{Type: "doc", Id: "1"},
+				Permission: "edit",
+				Metadata:   &base.PermissionExpandRequestMetadata{SnapToken: "snap", SchemaVersion: "v1"},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = NewLoggingLookupEntityCommand(lookupEntity{}, l).Execute(context.Background(), &base.PermissionLookupEntityRequest{
+				TenantId:   "t1",
+				EntityType: "doc",
+				Permission: "edit",
+				Subject:    &base.Subject{Type: "user", Id: "1"},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = NewLoggingLookupSubjectCommand(lookupSubject{}, l).Execute(context.Background(), &commands.LookupSubjectRequest{
+				TenantID:         "t1",
+				Entity:           &base.Entity{Type: "doc", Id: "1"},
+				Permission:       "edit",
+				SubjectReference: &base.RelationReference{Type: "team", Relation: "member"},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			cancel()
+			Eventually(done).Should(BeClosed())
+			
+			content, err := os.ReadFile(path)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			var logged []Decision
+			for _, line := range strings.Split(strings.TrimSpace(string(content)), "\n") {
+				var decision Decision
+				Expect(json.Unmarshal([]byte(line), &decision)).Should(Succeed())
+				logged = append(logged, decision)
+			}
+			Expect(logged).Should(HaveLen(3))
+			
+			Expect(logged[0].Operation).Should(Equal(OperationExpand))
+			Expect(logged[0].Entity).Should(Equal("doc:1"))
+			Expect(logged[0].SnapToken).Should(Equal("snap"))
+			Expect(logged[0].SchemaVersion).Should(Equal("v1"))
+			
+			Expect(logged[1].Operation).Should(Equal(OperationLookupEntity))
+			Expect(logged[1].Entity).Should(Equal("doc"))
+			Expect(logged[1].Subject).Should(Equal("user:1"))
+			Expect(logged[1].ResultCount).Should(Equal(2))
+			
+			Expect(logged[2].Operation).Should(Equal(OperationLookupSubject))
+			Expect(logged[2].Subject).Should(Equal("team#member"))
+			Expect(logged[2].ResultCount).Should(Equal(1))
+		})
+		
+		It("Case 4: A batch is inserted into the decisions table with one statement", func() {
+			database, mock, err := sqlmock.New()
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			sink := NewPostgres(&postgres.Postgres{
+				DB:      database,
+				Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			})
+			
+			now := time.Date(2023, 2, 17, 0, 0, 0, 0, time.UTC)
+			mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO decisions (created_at, operation, tenant_id, entity, permission, subject, schema_version, snap_token, result, result_count, latency_ms, trace_id) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12),($13,$14,$15,$16,$17,$18,$19,$20,$21,$22,$23,$24)`)).
+				WithArgs(now, OperationCheck, "t1", "doc:1", "edit", "user:1", "v1", "snap", "RESULT_DENIED", 0, 1.5, "",
+					now, OperationLookupEntity, "t1", "doc", "edit", "user:1", "v1", "snap", "", 2, 3.0, "").
+				WillReturnResult(sqlmock.NewResult(0, 2))
+			
+			Expect(sink.Send(context.Background(), []Decision{
+				{Time: now, Operation: OperationCheck, TenantID: "t1", Entity: "doc:1", Permission: "edit", Subject: "user:1", SchemaVersion: "v1", SnapToken: "snap", Result: "RESULT_DENIED", LatencyMs: 1.5},
+				{Time: now, Operation: OperationLookupEntity, TenantID: "t1", Entity: "doc", Permission: "edit", Subject: "user:1", SchemaVersion: "v1", SnapToken: "snap", ResultCount: 2, LatencyMs: 3},
+			})).Should(Succeed())
+			Expect(mock.ExpectationsWereMet()).Should(Succeed())
+		})
+	})
+})
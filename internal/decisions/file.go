@@ -0,0 +1,46 @@
+package decisions
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+)
+
+// File - Appends every decision to a file as a line of json, the file can be shipped by a log collector
+type File struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewFile - Creates a new file sink, the file is created when it does not exist
+func NewFile(path string) (*File, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	return &File{file: file}, nil
+}
+
+// Send - Appends the decisions of the batch with a single write, so that the lines of a batch are not interleaved
+// with the lines of another writer of the file
+func (f *File) Send(_ context.Context, decisions []Decision) error {
+	var lines []byte
+	for _, decision := range decisions {
+		line, err := json.Marshal(decision)
+		if err != nil {
+			return err
+		}
+		lines = append(append(lines, line...), '\n')
+	}
+	
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	_, err := f.file.Write(lines)
+	return err
+}
+
+// Close - Closes the file
+func (f *File) Close() error {
+	return f.file.Close()
+}
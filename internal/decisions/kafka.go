@@ -0,0 +1,47 @@
+package decisions
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+	
+	"github.com/segmentio/kafka-go"
+)
+
+// Kafka - Writes every decision as a message of a topic, the messages are keyed by tenant so that the decisions of
+// a tenant stay in order within their partition
+type Kafka struct {
+	writer *kafka.Writer
+}
+
+// NewKafka - Creates a new kafka sink
+func NewKafka(brokers []string, topic string) *Kafka {
+	return &Kafka{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(brokers...),
+			Topic:        topic,
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireOne,
+			// the decisions are batched by the logger already
+			BatchTimeout: 10 * time.Millisecond,
+		},
+	}
+}
+
+// Send - Writes the decisions of the batch at once
+func (k *Kafka) Send(ctx context.Context, decisions []Decision) error {
+	messages := make([]kafka.Message, 0, len(decisions))
+	for _, decision := range decisions {
+		value, err := json.Marshal(decision)
+		if err != nil {
+			return err
+		}
+		messages = append(messages, kafka.Message{Key: []byte(decision.TenantID), Value: value})
+	}
+	return k.writer.WriteMessages(ctx, messages...)
+}
+
+// Close - Flushes the pending messages and closes the connections to the brokers
+func (k *Kafka) Close() error {
+	return k.writer.Close()
+}
@@ -0,0 +1,40 @@
+package decisions
+
+import (
+	"context"
+	
+	db "github.com/adminium/permify/pkg/database/postgres"
+)
+
+// DecisionsTable - Table of the decisions written by the postgres sink, it is created by the migrations
+const DecisionsTable = "decisions"
+
+// Postgres - Inserts the decisions into the decisions table of the database, a batch is inserted with one statement
+type Postgres struct {
+	database *db.Postgres
+}
+
+// NewPostgres - Creates a new postgres sink
+func NewPostgres(database *db.Postgres) *Postgres {
+	return &Postgres{database: database}
+}
+
+// Send - Inserts the decisions of the batch
+func (p *Postgres) Send(ctx context.Context, decisions []Decision) error {
+	if len(decisions) == 0 {
+		return nil
+	}
+	
+	insert := p.database.Builder.Insert(DecisionsTable).
+		Columns("created_at, operation, tenant_id, entity, permission, subject, schema_version, snap_token, result, result_count, latency_ms, trace_id")
+	for _, d := range decisions {
+		insert = insert.Values(d.Time, d.Operation, d.TenantID, d.Entity, d.Permission, d.Subject, d.SchemaVersion, d.SnapToken, d.Result, d.ResultCount, d.LatencyMs, d.TraceID)
+	}
+	_, err := insert.RunWith(p.database.DB).ExecContext(ctx)
+	return err
+}
+
+// Close - The database is closed by its owner
+func (p *Postgres) Close() error {
+	return nil
+}
@@ -0,0 +1,62 @@
+package decisions
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Webhook - Posts the batches of decisions to an http endpoint as a json array
+type Webhook struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+// NewWebhook - Creates a new webhook sink, the headers are added to every request e.g. for its authorization
+func NewWebhook(url string, headers map[string]string, timeout time.Duration) *Webhook {
+	return &Webhook{
+		url:     url,
+		headers: headers,
+		client:  &http.Client{Timeout: timeout},
+	}
+}
+
+// Send - Posts the decisions, any status other than 2xx fails the batch
+func (w *Webhook) Send(ctx context.Context, decisions []Decision) error {
+	body, err := json.Marshal(decisions)
+	if err != nil {
+		return err
+	}
+	
+	var request *http.Request
+	request, err = http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	for key, value := range w.headers {
+		request.Header.Set(key, value)
+	}
+	
+	var response *http.Response
+	response, err = w.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("%s responded %d", w.url, response.StatusCode)
+	}
+	return nil
+}
+
+// Close -
+func (w *Webhook) Close() error {
+	w.client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,217 @@
+package diagnostics
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+	
+	"github.com/rs/xid"
+	
+	"github.com/adminium/permify/internal/commands"
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+const (
+	_defaultQueueSize     = 1000
+	_defaultSweepInterval = 10 * time.Minute
+)
+
+// Sampler - Persists a fraction of the checks with their full traces: the request, the permissions and relations it
+// resolved, the tuples it read and how long it took. The traces are written in the background, a full queue drops them
+// instead of slowing the checks down; the dropped traces are counted. The traces older than the retention are deleted
+// every sweep interval.
+type Sampler struct {
+	writer    repositories.CheckTraceWriter
+	rate      float64
+	retention time.Duration
+	// background
+	queue         chan repositories.CheckTrace
+	sweepInterval time.Duration
+	dropped       uint64
+	// the sampling is not security sensitive
+	mu     sync.Mutex
+	random *rand.Rand
+	now    func() time.Time
+	logger logger.Interface
+}
+
+// Option - Option type
+type Option func(*Sampler)
+
+// QueueSize - Number of traces waiting to be written, the traces beyond it are dropped
+func QueueSize(size int) Option {
+	return func(s *Sampler) {
+		if size > 0 {
+			s.queue = make(chan repositories.CheckTrace, size)
+		}
+	}
+}
+
+// SweepInterval - How often the traces older than the retention are deleted
+func SweepInterval(interval time.Duration) Option {
+	return func(s *Sampler) {
+		if interval > 0 {
+			s.sweepInterval = interval
+		}
+	}
+}
+
+// NewSampler - Creates a new sampler of the given fraction of the checks, a retention that is not positive keeps the
+// traces until they are deleted otherwise
+func NewSampler(writer repositories.CheckTraceWriter, rate float64, retention time.Duration, l logger.Interface, opts ...Option) *Sampler {
+	s := &Sampler{
+		writer:        writer,
+		rate:          rate,
+		retention:     retention,
+		queue:         make(chan repositories.CheckTrace, _defaultQueueSize),
+		sweepInterval: _defaultSweepInterval,
+		random:        rand.New(rand.NewSource(time.Now().UnixNano())),
+		now:           time.Now,
+		logger:        l,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// sample - Whether the next check is traced
+func (s *Sampler) sample() bool {
+	if s.rate <= 0 {
+		return false
+	}
+	if s.rate >= 1 {
+		return true
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.random.Float64() < s.rate
+}
+
+// Record - Queues the trace of the executed check
+func (s *Sampler) Record(request *base.PermissionCheckRequest, response *base.PermissionCheckResponse, err error, trace *commands.CheckTrace, duration time.Duration) {
+	record := repositories.CheckTrace{
+		ID:         xid.New().String(),
+		TenantID:   request.GetTenantId(),
+		Entity:     tuple.EntityToString(request.GetEntity()),
+		Permission: request.GetPermission(),
+		Subject:    tuple.SubjectToString(request.GetSubject()),
+		// the check fills the versions of the snapshot and the schema it was executed at
+		SchemaVersion: request.GetMetadata().GetSchemaVersion(),
+		SnapToken:     request.GetMetadata().GetSnapToken(),
+		Depth:         request.GetMetadata().GetDepth(),
+		Tuples:        trace.Tuples(),
+		Duration:      duration,
+		CreatedAt:     s.now().UTC(),
+	}
+	if err != nil {
+		record.Error = err.Error()
+	} else {
+		record.Result = response.GetCan().String()
+	}
+	for _, step := range trace.Steps() {
+		recorded := repositories.CheckTraceStep{
+			Entity:     tuple.EntityToString(step.Entity),
+			Permission: step.Permission,
+			Subject:    tuple.SubjectToString(step.Subject),
+			Depth:      step.Depth,
+			Result:     step.Result.String(),
+		}
+		if step.Error != nil {
+			recorded.Error = step.Error.Error()
+		}
+		record.Steps = append(record.Steps, recorded)
+	}
+	
+	select {
+	case s.queue <- record:
+	default:
+		atomic.AddUint64(&s.dropped, 1)
+	}
+}
+
+// Dropped - Number of traces dropped because the queue was full
+func (s *Sampler) Dropped() uint64 {
+	return atomic.LoadUint64(&s.dropped)
+}
+
+// Run - Writes the queued traces and deletes the expired ones until the context is done, the traces queued by then
+// are written before it returns
+func (s *Sampler) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.sweepInterval)
+	defer ticker.Stop()
+	
+	for {
+		select {
+		case trace := <-s.queue:
+			s.write(trace)
+		case <-ticker.C:
+			s.sweep(ctx)
+		case <-ctx.Done():
+			for {
+				select {
+				case trace := <-s.queue:
+					s.write(trace)
+				default:
+					return nil
+				}
+			}
+		}
+	}
+}
+
+// write - The trace is given its own deadline so that a shutdown still writes it
+func (s *Sampler) write(trace repositories.CheckTrace) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := s.writer.WriteCheckTrace(ctx, trace); err != nil {
+		s.logger.Warn("writing the trace of a check of tenant %s failed: %s", trace.TenantID, err.Error())
+	}
+}
+
+// sweep - Deletes the traces older than the retention
+func (s *Sampler) sweep(ctx context.Context) {
+	if s.retention <= 0 {
+		return
+	}
+	deleted, err := s.writer.DeleteCheckTraces(ctx, s.now().UTC().Add(-s.retention))
+	if err != nil {
+		s.logger.Warn("deleting the expired check traces failed: %s", err.Error())
+		return
+	}
+	if deleted > 0 {
+		s.logger.Info("deleted %d expired check traces", deleted)
+	}
+}
+
+// SamplingCheckCommand - Traces the sampled checks and records them to the sampler. A traced check reads no cached
+// results and is not dispatched, so the sampled checks take longer than the others.
+type SamplingCheckCommand struct {
+	delegate commands.ICheckCommand
+	sampler  *Sampler
+}
+
+// NewSamplingCheckCommand - Creates new sampling check command
+func NewSamplingCheckCommand(delegate commands.ICheckCommand, s *Sampler) *SamplingCheckCommand {
+	return &SamplingCheckCommand{
+		delegate: delegate,
+		sampler:  s,
+	}
+}
+
+// Execute -
+func (c *SamplingCheckCommand) Execute(ctx context.Context, request *base.PermissionCheckRequest) (*base.PermissionCheckResponse, error) {
+	if !c.sampler.sample() {
+		return c.delegate.Execute(ctx, request)
+	}
+	trace := commands.NewCheckTrace()
+	start := time.Now()
+	response, err := c.delegate.Execute(commands.ContextWithCheckTrace(ctx, trace), request)
+	c.sampler.Record(request, response, err, trace, time.Since(start))
+	return response, err
+}
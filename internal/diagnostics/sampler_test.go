@@ -0,0 +1,132 @@
+package diagnostics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/internal/repositories/memory"
+	"github.com/adminium/permify/internal/repositories/memory/migrations"
+	MMDatabase "github.com/adminium/permify/pkg/database/memory"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// TestDiagnostics -
+func TestDiagnostics(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "diagnostics-suite")
+}
+
+// check - Check command that allows the owners of the documents and fails the deletes
+type check struct{}
+
+func (check) Execute(_ context.Context, request *base.PermissionCheckRequest) (*base.PermissionCheckResponse, error) {
+	switch request.GetPermission() {
+	case "owner":
+		return &base.PermissionCheckResponse{Can: base.PermissionCheckResponse_RESULT_ALLOWED}, nil
+	case "delete":
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_DEPTH_NOT_ENOUGH.String())
+	}
+	return &base.PermissionCheckResponse{Can: base.PermissionCheckResponse_RESULT_DENIED}, nil
+}
+
+// request -
+func request(permission string) *base.PermissionCheckRequest {
+	return &base.PermissionCheckRequest{
+		TenantId:   "t1",
+		Entity:     &base.Entity{Type: "doc", Id: "1"},
+		Permission: permission,
+		Subject:    &base.Subject{Type: "user", Id: "1"},
+		Metadata:   &base.PermissionCheckRequestMetadata{SnapToken: "snap", SchemaVersion: "v1", Depth: 20},
+	}
+}
+
+var _ = Describe("diagnostics", func() {
+	var mem *MMDatabase.Memory
+	var reader *memory.CheckTraceReader
+	var writer *memory.CheckTraceWriter
+	
+	BeforeEach(func() {
+		var err error
+		mem, err = MMDatabase.New(migrations.Schema)
+		Expect(err).ShouldNot(HaveOccurred())
+		reader = memory.NewCheckTraceReader(mem, logger.New("error"))
+		writer = memory.NewCheckTraceWriter(mem, logger.New("error"))
+	})
+	
+	Context("Sampler", func() {
+		It("Case 1: The sampled checks are written with their results and errors", func() {
+			sampler := NewSampler(writer, 1, time.Hour, logger.New("error"))
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				_ = sampler.Run(ctx)
+			}()
+			
+			command := NewSamplingCheckCommand(check{}, sampler)
+			for _, permission := range []string{"owner", "delete"} {
+				_, _ = command.Execute(context.Background(), request(permission))
+			}
+			cancel()
+			<-done
+			
+			traces, err := reader.ListCheckTraces(context.Background(), repositories.CheckTraceFilter{TenantID: "t1"})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(traces).Should(HaveLen(2))
+			
+			byPermission := map[string]repositories.CheckTrace{}
+			for _, trace := range traces {
+				byPermission[trace.Permission] = trace
+			}
+			Expect(byPermission["owner"].Entity).Should(Equal("doc:1"))
+			Expect(byPermission["owner"].Subject).Should(Equal("user:1"))
+			Expect(byPermission["owner"].SchemaVersion).Should(Equal("v1"))
+			Expect(byPermission["owner"].Result).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED.String()))
+			Expect(byPermission["delete"].Result).Should(BeEmpty())
+			Expect(byPermission["delete"].Error).Should(Equal(base.ErrorCode_ERROR_CODE_DEPTH_NOT_ENOUGH.String()))
+		})
+		
+		It("Case 2: Nothing is traced without a sample rate and a full queue drops the traces", func() {
+			sampler := NewSampler(writer, 0, time.Hour, logger.New("error"), QueueSize(1))
+			command := NewSamplingCheckCommand(check{}, sampler)
+			_, err := command.Execute(context.Background(), request("owner"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(sampler.queue).Should(BeEmpty())
+			
+			sampler.rate = 1
+			for i := 0; i < 3; i++ {
+				_, err = command.Execute(context.Background(), request("owner"))
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+			Expect(sampler.queue).Should(HaveLen(1))
+			Expect(sampler.Dropped()).Should(Equal(uint64(2)))
+		})
+		
+		It("Case 3: The traces older than the retention are deleted", func() {
+			now := time.Date(2023, 2, 15, 9, 0, 0, 0, time.UTC)
+			for i, age := range []time.Duration{2 * time.Hour, 30 * time.Minute} {
+				Expect(writer.WriteCheckTrace(context.Background(), repositories.CheckTrace{
+					ID:        string(rune('a' + i)),
+					TenantID:  "t1",
+					CreatedAt: now.Add(-age),
+				})).ShouldNot(HaveOccurred())
+			}
+			
+			sampler := NewSampler(writer, 0, time.Hour, logger.New("error"))
+			sampler.now = func() time.Time { return now }
+			sampler.sweep(context.Background())
+			
+			traces, err := reader.ListCheckTraces(context.Background(), repositories.CheckTraceFilter{TenantID: "t1"})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(traces).Should(HaveLen(1))
+			Expect(traces[0].ID).Should(Equal("b"))
+		})
+	})
+})
@@ -0,0 +1,50 @@
+package events
+
+import (
+	"context"
+	"sync"
+)
+
+// Cursor - The last write of a tenant that was published
+type Cursor struct {
+	// SnapToken - token of the last published change of the relationships
+	SnapToken string
+	// SchemaVersion - last published version of the schema, empty when the tenant had no schema
+	SchemaVersion string
+}
+
+// Cursors - Storage of the cursors of the tenants. The publisher resumes a tenant from its cursor, so that the
+// writes are published at least once across restarts.
+type Cursors interface {
+	// Get reads the cursor of the tenant, ok is false when the tenant was never published.
+	Get(ctx context.Context, tenantID string) (cursor Cursor, ok bool, err error)
+	// Set replaces the cursor of the tenant.
+	Set(ctx context.Context, tenantID string, cursor Cursor) (err error)
+}
+
+// Memory - Cursors of a single process, the tenants are published from their heads again after a restart
+type Memory struct {
+	mu      sync.Mutex
+	cursors map[string]Cursor
+}
+
+// NewMemory - Creates new in-memory cursors
+func NewMemory() *Memory {
+	return &Memory{cursors: map[string]Cursor{}}
+}
+
+// Get -
+func (m *Memory) Get(_ context.Context, tenantID string) (Cursor, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	cursor, ok := m.cursors[tenantID]
+	return cursor, ok, nil
+}
+
+// Set -
+func (m *Memory) Set(_ context.Context, tenantID string, cursor Cursor) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.cursors[tenantID] = cursor
+	return nil
+}
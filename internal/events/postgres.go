@@ -0,0 +1,57 @@
+package events
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+
+	db "github.com/adminium/permify/pkg/database/postgres"
+)
+
+// CursorsTable - Table of the cursors of the tenants, it is created by the migrations
+const CursorsTable = "event_cursors"
+
+// Postgres - Cursors kept next to the transactions they point into, so that a restarted publisher resumes from
+// the last transaction it published
+type Postgres struct {
+	database *db.Postgres
+}
+
+// NewPostgres - Creates new postgres cursors
+func NewPostgres(database *db.Postgres) *Postgres {
+	return &Postgres{database: database}
+}
+
+// Get -
+func (p *Postgres) Get(ctx context.Context, tenantID string) (cursor Cursor, ok bool, err error) {
+	query, args, err := p.database.Builder.Select("snap_token, schema_version").
+		From(CursorsTable).
+		Where(squirrel.Eq{"tenant_id": tenantID}).
+		ToSql()
+	if err != nil {
+		return Cursor{}, false, err
+	}
+
+	err = p.database.DB.QueryRowContext(ctx, query, args...).Scan(&cursor.SnapToken, &cursor.SchemaVersion)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Cursor{}, false, nil
+		}
+		return Cursor{}, false, err
+	}
+	return cursor, true, nil
+}
+
+// Set -
+func (p *Postgres) Set(ctx context.Context, tenantID string, cursor Cursor) error {
+	_, err := p.database.Builder.Insert(CursorsTable).
+		Columns("tenant_id, snap_token, schema_version, updated_at").
+		Values(tenantID, cursor.SnapToken, cursor.SchemaVersion, time.Now().UTC()).
+		Suffix("ON CONFLICT (tenant_id) DO UPDATE SET snap_token = EXCLUDED.snap_token, schema_version = EXCLUDED.schema_version, updated_at = EXCLUDED.updated_at").
+		RunWith(p.database.DB).
+		ExecContext(ctx)
+	return err
+}
@@ -0,0 +1,313 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/pkg/database"
+	"github.com/adminium/permify/pkg/events"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+const (
+	_defaultRefreshInterval = 30 * time.Second
+	_defaultRetryInterval   = time.Second
+	_defaultSchemaInterval  = time.Second
+	_defaultPageSize        = 100
+)
+
+// Publisher - Publishes the writes of every tenant to the broker. The transactions of the storage are the outbox:
+// the changes of the relationships are streamed from them by the watcher and the versions of the schema are polled,
+// and the cursor of a tenant is only moved past a write once the broker acknowledged its event. A failed publish is
+// retried from the cursor, so every write is published at least once and a write may be published again.
+type Publisher struct {
+	watcher            repositories.Watcher
+	tenantReader       repositories.TenantReader
+	relationshipReader repositories.RelationshipReader
+	schemaReader       repositories.SchemaReader
+	broker             events.Broker
+	cursors            Cursors
+	// options
+	refreshInterval time.Duration
+	retryInterval   time.Duration
+	schemaInterval  time.Duration
+	logger          logger.Interface
+	// the tenants being published
+	mu       sync.Mutex
+	watching map[string]context.CancelFunc
+}
+
+// NewPublisher - Creates a new publisher, the tenants are listed every refresh interval to publish the new ones
+func NewPublisher(w repositories.Watcher, tr repositories.TenantReader, rr repositories.RelationshipReader, sr repositories.SchemaReader, b events.Broker, c Cursors, refreshInterval time.Duration, l logger.Interface) *Publisher {
+	if refreshInterval <= 0 {
+		refreshInterval = _defaultRefreshInterval
+	}
+	return &Publisher{
+		watcher:            w,
+		tenantReader:       tr,
+		relationshipReader: rr,
+		schemaReader:       sr,
+		broker:             b,
+		cursors:            c,
+		refreshInterval:    refreshInterval,
+		retryInterval:      _defaultRetryInterval,
+		schemaInterval:     _defaultSchemaInterval,
+		logger:             l,
+		watching:           map[string]context.CancelFunc{},
+	}
+}
+
+// Run - Publishes the tenants until the context is done, the broker is closed once every tenant stopped
+func (p *Publisher) Run(ctx context.Context) error {
+	ticker := time.NewTicker(p.refreshInterval)
+	defer ticker.Stop()
+
+	var wg sync.WaitGroup
+	defer func() {
+		wg.Wait()
+		if err := p.broker.Close(); err != nil {
+			p.logger.Error(fmt.Sprintf("closing the event broker failed: %s", err.Error()))
+		}
+	}()
+
+	for {
+		if err := p.refresh(ctx, &wg); err != nil {
+			p.logger.Error(fmt.Sprintf("listing the tenants to publish failed: %s", err.Error()))
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// refresh - Starts publishing the new tenants and stops publishing the deleted ones
+func (p *Publisher) refresh(ctx context.Context, wg *sync.WaitGroup) error {
+	tenants, err := p.tenants(ctx)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for tenantID, cancel := range p.watching {
+		if _, ok := tenants[tenantID]; !ok {
+			cancel()
+			delete(p.watching, tenantID)
+		}
+	}
+
+	for tenantID := range tenants {
+		if _, ok := p.watching[tenantID]; ok {
+			continue
+		}
+		watchCtx, cancel := context.WithCancel(ctx)
+		p.watching[tenantID] = cancel
+		wg.Add(1)
+		go func(tenantID string) {
+			defer wg.Done()
+			p.watch(watchCtx, tenantID)
+		}(tenantID)
+	}
+	return nil
+}
+
+// watch - Publishes the writes of the tenant until the context is done, a failed watch is resumed from the cursor
+func (p *Publisher) watch(ctx context.Context, tenantID string) {
+	for {
+		cursor, err := p.cursor(ctx, tenantID)
+		if err == nil {
+			err = p.consume(ctx, tenantID, cursor)
+		}
+		if !p.wait(ctx, tenantID, err) {
+			return
+		}
+	}
+}
+
+// cursor - Cursor of the tenant. A tenant that was never published starts at its head, the writes before the
+// publisher was enabled are not published.
+func (p *Publisher) cursor(ctx context.Context, tenantID string) (Cursor, error) {
+	cursor, ok, err := p.cursors.Get(ctx, tenantID)
+	if err != nil || ok {
+		return cursor, err
+	}
+
+	head, err := p.relationshipReader.HeadSnapshot(ctx, tenantID)
+	if err != nil {
+		return Cursor{}, err
+	}
+	cursor.SnapToken = head.Encode().String()
+
+	version, err := p.schemaReader.HeadVersion(ctx, tenantID)
+	switch {
+	case err == nil:
+		cursor.SchemaVersion = version
+	case err.Error() == base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String():
+		// the tenant has no schema yet, its first version is published
+	default:
+		return Cursor{}, err
+	}
+
+	return cursor, p.cursors.Set(ctx, tenantID, cursor)
+}
+
+// consume - Publishes the changes of a single watch and the new versions of the schema until either fails or the
+// context is done
+func (p *Publisher) consume(ctx context.Context, tenantID string, cursor Cursor) (err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ticker := time.NewTicker(p.schemaInterval)
+	defer ticker.Stop()
+
+	if cursor, err = p.schemas(ctx, tenantID, cursor); err != nil {
+		return err
+	}
+
+	changes, errs := p.watcher.Watch(ctx, tenantID, cursor.SnapToken)
+	for {
+		select {
+		case c, ok := <-changes:
+			if !ok {
+				// the watcher closes both channels, an error is sent before they are closed
+				if err, ok := <-errs; ok {
+					return err
+				}
+				return ctx.Err()
+			}
+			if err = p.broker.Publish(ctx, []events.Event{RelationshipsChangedEvent(tenantID, c)}); err != nil {
+				return err
+			}
+			cursor.SnapToken = c.SnapToken
+			if err = p.cursors.Set(ctx, tenantID, cursor); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			if cursor, err = p.schemas(ctx, tenantID, cursor); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// schemas - Publishes the versions of the schema written after the one of the cursor, oldest first, and returns
+// the cursor moved to the newest of them
+func (p *Publisher) schemas(ctx context.Context, tenantID string, cursor Cursor) (Cursor, error) {
+	var versions []string
+	ct := ""
+	for {
+		page, next, err := p.schemaReader.ListSchemaVersions(ctx, tenantID, database.NewPagination(database.Size(_defaultPageSize), database.Token(ct)))
+		if err != nil {
+			return cursor, err
+		}
+		// the versions are listed latest first, the listing stops at the published one
+		done := false
+		for _, version := range page {
+			if version == cursor.SchemaVersion {
+				done = true
+				break
+			}
+			versions = append(versions, version)
+		}
+		if done || next == nil || next.String() == "" {
+			break
+		}
+		ct = next.String()
+	}
+	if len(versions) == 0 {
+		return cursor, nil
+	}
+
+	evs := make([]events.Event, 0, len(versions))
+	for i := len(versions) - 1; i >= 0; i-- {
+		definitions, err := p.schemaReader.ReadSchemaString(ctx, tenantID, versions[i])
+		if err != nil {
+			return cursor, err
+		}
+		evs = append(evs, SchemaWrittenEvent(tenantID, versions[i], definitions))
+	}
+	if err := p.broker.Publish(ctx, evs); err != nil {
+		return cursor, err
+	}
+
+	cursor.SchemaVersion = versions[0]
+	return cursor, p.cursors.Set(ctx, tenantID, cursor)
+}
+
+// wait - Logs the error of a watch and waits before it is retried, it reports false when the context is done
+func (p *Publisher) wait(ctx context.Context, tenantID string, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if err != nil && !errors.Is(err, context.Canceled) {
+		p.logger.Warn("publishing the writes of %s failed: %s", tenantID, err.Error())
+	}
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(p.retryInterval):
+		return true
+	}
+}
+
+// tenants - Ids of every tenant
+func (p *Publisher) tenants(ctx context.Context) (map[string]struct{}, error) {
+	ids := map[string]struct{}{}
+	ct := ""
+	for {
+		tenants, next, err := p.tenantReader.ListTenants(ctx, database.NewPagination(database.Size(_defaultPageSize), database.Token(ct)))
+		if err != nil {
+			return nil, err
+		}
+		for _, tenant := range tenants {
+			ids[tenant.GetId()] = struct{}{}
+		}
+		if next == nil || next.String() == "" {
+			return ids, nil
+		}
+		ct = next.String()
+	}
+}
+
+// RelationshipsChangedEvent - Event of the changes of a transaction
+func RelationshipsChangedEvent(tenantID string, c *repositories.RelationshipChanges) events.Event {
+	event := events.Event{
+		ID:        events.RelationshipsChanged + ":" + c.SnapToken,
+		Version:   events.Version,
+		Type:      events.RelationshipsChanged,
+		TenantID:  tenantID,
+		SnapToken: c.SnapToken,
+		Time:      time.Now().UTC(),
+	}
+	for _, tup := range c.Created.GetTuples() {
+		event.Created = append(event.Created, tuple.ToString(tup))
+	}
+	for _, tup := range c.Deleted.GetTuples() {
+		event.Deleted = append(event.Deleted, tuple.ToString(tup))
+	}
+	return event
+}
+
+// SchemaWrittenEvent - Event of a version of the schema
+func SchemaWrittenEvent(tenantID, version string, definitions []string) events.Event {
+	return events.Event{
+		ID:            events.SchemaWritten + ":" + version,
+		Version:       events.Version,
+		Type:          events.SchemaWritten,
+		TenantID:      tenantID,
+		SchemaVersion: version,
+		Definitions:   definitions,
+		Time:          time.Now().UTC(),
+	}
+}
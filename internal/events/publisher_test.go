@@ -0,0 +1,240 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/internal/repositories/memory"
+	"github.com/adminium/permify/internal/repositories/memory/migrations"
+	"github.com/adminium/permify/pkg/database"
+	db "github.com/adminium/permify/pkg/database/memory"
+	"github.com/adminium/permify/pkg/events"
+	"github.com/adminium/permify/pkg/logger"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+// TestEvents -
+func TestEvents(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "events-suite")
+}
+
+// broker - Broker that records the published events, the publishes fail while failures are left
+type broker struct {
+	mu       sync.Mutex
+	events   []events.Event
+	failures int
+	closed   bool
+}
+
+func (b *broker) Publish(_ context.Context, evs []events.Event) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failures > 0 {
+		b.failures--
+		return errors.New("broker unavailable")
+	}
+	b.events = append(b.events, evs...)
+	return nil
+}
+
+func (b *broker) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	return nil
+}
+
+// get - Types and payloads of the published events, schema.written:<version> or relationships.changed:<tuples>
+func (b *broker) get() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var published []string
+	for _, event := range b.events {
+		switch event.Type {
+		case events.SchemaWritten:
+			published = append(published, event.Type+":"+event.SchemaVersion)
+		case events.RelationshipsChanged:
+			payload := ""
+			for _, t := range event.Created {
+				payload += "+" + t
+			}
+			for _, t := range event.Deleted {
+				payload += "-" + t
+			}
+			published = append(published, event.Type+":"+payload)
+		}
+	}
+	return published
+}
+
+func (b *broker) isClosed() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.closed
+}
+
+var _ = Describe("events", func() {
+	var mem *db.Memory
+	var l logger.Interface
+	var write func(tuples ...string)
+	var writeSchema func(version string)
+	var run func(p *Publisher) (context.CancelFunc, chan struct{})
+
+	BeforeEach(func() {
+		var err error
+		mem, err = db.New(migrations.Schema)
+		Expect(err).ShouldNot(HaveOccurred())
+		l = logger.New("error")
+
+		_, err = memory.NewTenantWriter(mem, l).CreateTenant(context.Background(), "t1", "t1")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		writeSchema = func(version string) {
+			err := memory.NewSchemaWriter(mem, l).WriteSchema(context.Background(), []repositories.SchemaDefinition{
+				{TenantID: "t1", EntityType: "user", SerializedDefinition: []byte("entity user {}"), Version: version},
+				{TenantID: "t1", EntityType: "doc", SerializedDefinition: []byte("entity doc {\n\trelation owner @user\n}"), Version: version},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+
+		writer := memory.NewRelationshipWriter(mem, l)
+		write = func(tuples ...string) {
+			collection := database.NewTupleCollection()
+			for _, t := range tuples {
+				tup, err := tuple.Tuple(t)
+				Expect(err).ShouldNot(HaveOccurred())
+				collection.Add(tup)
+			}
+			_, err := writer.WriteRelationships(context.Background(), "t1", collection)
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+
+		run = func(p *Publisher) (context.CancelFunc, chan struct{}) {
+			p.retryInterval = 10 * time.Millisecond
+			p.schemaInterval = 10 * time.Millisecond
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan struct{})
+			go func() {
+				defer GinkgoRecover()
+				defer close(done)
+				Expect(p.Run(ctx)).Should(Succeed())
+			}()
+			return cancel, done
+		}
+	})
+
+	newPublisher := func(b events.Broker, c Cursors) *Publisher {
+		return NewPublisher(memory.NewWatcher(mem, l), memory.NewTenantReader(mem, l), memory.NewRelationshipReader(mem, l), memory.NewSchemaReader(mem, l), b, c, time.Minute, l)
+	}
+
+	Context("Publisher", func() {
+		It("Case 1: The writes after the publisher started are published in order", func() {
+			writeSchema("v1")
+			write("doc:1#owner@user:1")
+
+			b := &broker{}
+			cursors := NewMemory()
+			cancel, done := run(newPublisher(b, cursors))
+
+			// the tenant starts at its head, the writes before it are not published
+			Eventually(func() bool {
+				_, ok, _ := cursors.Get(context.Background(), "t1")
+				return ok
+			}).Should(BeTrue())
+
+			writeSchema("v2")
+			Eventually(b.get).Should(Equal([]string{"schema.written:v2"}))
+
+			write("doc:2#owner@user:1")
+			Eventually(b.get).Should(Equal([]string{
+				"schema.written:v2",
+				"relationships.changed:+doc:2#owner@user:1",
+			}))
+
+			cursor, _, _ := cursors.Get(context.Background(), "t1")
+			Expect(cursor.SchemaVersion).Should(Equal("v2"))
+			Expect(cursor.SnapToken).ShouldNot(BeEmpty())
+
+			cancel()
+			Eventually(done).Should(BeClosed())
+			Expect(b.isClosed()).Should(BeTrue())
+		})
+
+		It("Case 2: A failed publish is retried from the cursor", func() {
+			b := &broker{failures: 2}
+			cursors := NewMemory()
+			cancel, done := run(newPublisher(b, cursors))
+
+			// the tenant has no schema yet, its first version is published
+			Eventually(func() bool {
+				_, ok, _ := cursors.Get(context.Background(), "t1")
+				return ok
+			}).Should(BeTrue())
+
+			writeSchema("v1")
+			write("doc:1#owner@user:1")
+
+			// the schema is polled while the changes are streamed, the events of the two are not ordered
+			Eventually(b.get).Should(ConsistOf([]string{
+				"schema.written:v1",
+				"relationships.changed:+doc:1#owner@user:1",
+			}))
+
+			cancel()
+			Eventually(done).Should(BeClosed())
+		})
+
+		It("Case 3: A restarted publisher resumes from the stored cursor", func() {
+			b := &broker{}
+			cursors := NewMemory()
+			cancel, done := run(newPublisher(b, cursors))
+			Eventually(func() bool {
+				_, ok, _ := cursors.Get(context.Background(), "t1")
+				return ok
+			}).Should(BeTrue())
+			cancel()
+			Eventually(done).Should(BeClosed())
+
+			// written while no publisher runs
+			writeSchema("v1")
+			write("doc:1#owner@user:1")
+
+			b = &broker{}
+			cancel, done = run(newPublisher(b, cursors))
+			Eventually(b.get).Should(Equal([]string{
+				"schema.written:v1",
+				"relationships.changed:+doc:1#owner@user:1",
+			}))
+
+			cancel()
+			Eventually(done).Should(BeClosed())
+		})
+	})
+
+	Context("Event", func() {
+		It("Case 1: The events carry their version and an id per write", func() {
+			created := database.NewTupleCollection()
+			tup, err := tuple.Tuple("doc:1#owner@user:1")
+			Expect(err).ShouldNot(HaveOccurred())
+			created.Add(tup)
+
+			event := RelationshipsChangedEvent("t1", &repositories.RelationshipChanges{SnapToken: "s1", Created: created, Deleted: database.NewTupleCollection()})
+			Expect(event.Version).Should(Equal(events.Version))
+			Expect(event.ID).Should(Equal("relationships.changed:s1"))
+			Expect(event.Created).Should(Equal([]string{"doc:1#owner@user:1"}))
+			Expect(event.Deleted).Should(BeEmpty())
+
+			event = SchemaWrittenEvent("t1", "v1", []string{"entity user {}"})
+			Expect(event.ID).Should(Equal("schema.written:v1"))
+			Expect(event.Definitions).Should(Equal([]string{"entity user {}"}))
+		})
+	})
+})
@@ -0,0 +1,40 @@
+package factories
+
+import (
+	"fmt"
+	
+	"github.com/adminium/permify/internal/config"
+	"github.com/adminium/permify/pkg/archive"
+	"github.com/adminium/permify/pkg/archive/s3"
+)
+
+// ArchiveStoreFactory - Create the object storage the archives are shipped to according to given configuration
+func ArchiveStoreFactory(conf config.Archive) (archive.Store, error) {
+	if conf.Bucket == "" {
+		return nil, fmt.Errorf("archive needs a bucket")
+	}
+	
+	options := []s3.Option{
+		s3.Prefix(conf.Prefix),
+		s3.Credentials(conf.AccessKey, conf.SecretKey),
+		s3.Region(conf.Region),
+		s3.Insecure(conf.Insecure),
+	}
+	
+	switch conf.Engine {
+	case archive.S3.String():
+		endpoint := conf.Endpoint
+		if endpoint == "" {
+			endpoint = "s3.amazonaws.com"
+		}
+		return s3.New(endpoint, conf.Bucket, options...)
+	case archive.GCS.String():
+		endpoint := conf.Endpoint
+		if endpoint == "" {
+			endpoint = s3.GCSEndpoint
+		}
+		return s3.New(endpoint, conf.Bucket, options...)
+	default:
+		return nil, fmt.Errorf("%s archive engine is unsupported", conf.Engine)
+	}
+}
@@ -3,11 +3,20 @@ package factories
 import (
 	"fmt"
 	
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	
+	"github.com/adminium/permify/internal/authn"
 	"github.com/adminium/permify/internal/config"
 	"github.com/adminium/permify/internal/repositories/memory/migrations"
+	RFRepository "github.com/adminium/permify/internal/repositories/raft"
 	"github.com/adminium/permify/pkg/database"
+	CRDatabase "github.com/adminium/permify/pkg/database/cockroach"
 	IMDatabase "github.com/adminium/permify/pkg/database/memory"
 	PQDatabase "github.com/adminium/permify/pkg/database/postgres"
+	RFDatabase "github.com/adminium/permify/pkg/database/raft"
+	"github.com/adminium/permify/pkg/logger"
+	"github.com/adminium/permify/pkg/storage"
 )
 
 // DatabaseFactory - Create database according to given configuration
@@ -24,13 +33,49 @@ func DatabaseFactory(conf config.Database) (db database.Database, err error) {
 			return nil, err
 		}
 		return
+	case database.COCKROACH.String():
+		db, err = CRDatabase.New(conf.URI, conf.FollowerReads,
+			PQDatabase.MaxOpenConnections(conf.MaxOpenConnections),
+			PQDatabase.MaxIdleConnections(conf.MaxIdleConnections),
+			PQDatabase.MaxConnectionIdleTime(conf.MaxConnectionIdleTime),
+			PQDatabase.MaxConnectionLifeTime(conf.MaxConnectionLifetime),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return
 	case database.MEMORY.String():
 		db, err = IMDatabase.New(migrations.Schema)
 		if err != nil {
 			return nil, err
 		}
 		return
+	case database.RAFT.String():
+		var mem *IMDatabase.Memory
+		mem, err = IMDatabase.New(migrations.Schema)
+		if err != nil {
+			return nil, err
+		}
+		peers := make([]RFDatabase.Peer, 0, len(conf.Raft.Peers))
+		for _, peer := range conf.Raft.Peers {
+			peers = append(peers, RFDatabase.Peer{ID: peer.ID, Address: peer.Address, GRPCAddress: peer.GRPCAddress})
+		}
+		options := []RFDatabase.Option{RFDatabase.ApplyTimeout(conf.Raft.ApplyTimeout)}
+		if conf.Raft.PeerKey != "" {
+			options = append(options, RFDatabase.DialOptions(
+				grpc.WithTransportCredentials(insecure.NewCredentials()),
+				grpc.WithPerRPCCredentials(authn.PeerCredentials(conf.Raft.PeerKey)),
+			))
+		}
+		db, err = RFDatabase.New(mem, RFRepository.NewFSM(mem, logger.New("info")), conf.Raft.NodeID, conf.Raft.Address, conf.Raft.DataDir, peers, options...)
+		if err != nil {
+			return nil, err
+		}
+		return
 	default:
+		if driver, ok := storage.Lookup(conf.Engine); ok {
+			return driver.Open(conf.URI)
+		}
 		return nil, fmt.Errorf("%s connection is unsupported", conf.Engine)
 	}
 }
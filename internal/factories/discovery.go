@@ -0,0 +1,33 @@
+package factories
+
+import (
+	"fmt"
+	
+	"github.com/adminium/permify/internal/cluster"
+	"github.com/adminium/permify/internal/config"
+)
+
+// DiscovererFactory - Create the discovery of the peers of the dispatch ring according to given configuration
+func DiscovererFactory(conf config.Discovery) (cluster.Discoverer, error) {
+	switch conf.Type {
+	case "static":
+		return cluster.Static(conf.Members), nil
+	case "dns":
+		if conf.DNS == "" {
+			return nil, fmt.Errorf("dns discovery needs a name to resolve")
+		}
+		return cluster.DNS{Name: conf.DNS, Port: conf.Port}, nil
+	case "kubernetes":
+		kubernetes, err := cluster.NewInClusterKubernetes(conf.Namespace, conf.Service, conf.PortName)
+		if err != nil {
+			return nil, err
+		}
+		// the endpoints are read with the service account of the pod, dns keeps the ring up when it is not allowed to
+		if conf.DNS != "" {
+			return cluster.Fallback{kubernetes, cluster.DNS{Name: conf.DNS, Port: conf.Port}}, nil
+		}
+		return kubernetes, nil
+	default:
+		return nil, fmt.Errorf("%s discovery is unsupported", conf.Type)
+	}
+}
@@ -0,0 +1,41 @@
+package factories
+
+import (
+	"encoding/base64"
+	"fmt"
+	
+	"github.com/adminium/permify/internal/config"
+	"github.com/adminium/permify/pkg/encryption"
+)
+
+// KeyProviderFactory - Create the key provider the data keys are wrapped by according to given configuration
+func KeyProviderFactory(conf config.KeyProvider) (encryption.KeyProvider, error) {
+	switch conf.Type {
+	case encryption.LOCAL.String():
+		key, err := base64.StdEncoding.DecodeString(conf.Key)
+		if err != nil {
+			return nil, err
+		}
+		return encryption.NewLocal(key)
+	case encryption.VAULT.String():
+		if conf.Address == "" || conf.KeyName == "" {
+			return nil, fmt.Errorf("vault key provider needs an address and a key name")
+		}
+		return encryption.NewVault(conf.Address, conf.Token, conf.KeyName), nil
+	default:
+		return nil, fmt.Errorf("%s key provider is unsupported", conf.Type)
+	}
+}
+
+// KeyringFactory - Create the keyring of the encrypted tenants according to given configuration
+func KeyringFactory(conf config.Encryption) (*encryption.Keyring, error) {
+	mode := encryption.Mode(conf.Mode)
+	if mode != encryption.SUBJECT && mode != encryption.TUPLE {
+		return nil, fmt.Errorf("%s encryption mode is unsupported", conf.Mode)
+	}
+	provider, err := KeyProviderFactory(conf.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return encryption.NewKeyring(provider, mode, conf.Keys), nil
+}
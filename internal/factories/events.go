@@ -0,0 +1,42 @@
+package factories
+
+import (
+	"fmt"
+
+	"github.com/adminium/permify/internal/config"
+	"github.com/adminium/permify/internal/events"
+	"github.com/adminium/permify/pkg/database"
+	PQDatabase "github.com/adminium/permify/pkg/database/postgres"
+	EventBroker "github.com/adminium/permify/pkg/events"
+	"github.com/adminium/permify/pkg/events/kafka"
+	"github.com/adminium/permify/pkg/events/nats"
+)
+
+// EventBrokerFactory - Create the broker the writes are published to according to given configuration
+func EventBrokerFactory(conf config.Events) (EventBroker.Broker, error) {
+	switch conf.Broker {
+	case EventBroker.KAFKA.String():
+		if len(conf.Kafka.Brokers) == 0 || conf.Kafka.Topic == "" {
+			return nil, fmt.Errorf("kafka events need brokers and a topic")
+		}
+		return kafka.New(conf.Kafka.Brokers, conf.Kafka.Topic), nil
+	case EventBroker.NATS.String():
+		if conf.NATS.URL == "" || conf.NATS.Subject == "" {
+			return nil, fmt.Errorf("nats events need a url and a subject")
+		}
+		return nats.New(conf.NATS.URL, conf.NATS.Subject)
+	default:
+		return nil, fmt.Errorf("%s event broker is unsupported", conf.Broker)
+	}
+}
+
+// EventCursorsFactory - Return the cursors of the published writes according to given database interface. The
+// cursors of postgres are kept in its database, the other engines keep them in memory.
+func EventCursorsFactory(db database.Database) events.Cursors {
+	switch db.GetEngineType() {
+	case "postgres":
+		return events.NewPostgres(db.(*PQDatabase.Postgres))
+	default:
+		return events.NewMemory()
+	}
+}
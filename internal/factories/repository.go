@@ -2,12 +2,21 @@ package factories
 
 import (
 	"github.com/adminium/permify/internal/repositories"
+	CRRepository "github.com/adminium/permify/internal/repositories/cockroach"
+	CRSnapshot "github.com/adminium/permify/internal/repositories/cockroach/snapshot"
 	MMRepository "github.com/adminium/permify/internal/repositories/memory"
+	MMSnapshot "github.com/adminium/permify/internal/repositories/memory/snapshot"
 	PQRepository "github.com/adminium/permify/internal/repositories/postgres"
+	PQSnapshot "github.com/adminium/permify/internal/repositories/postgres/snapshot"
+	RFRepository "github.com/adminium/permify/internal/repositories/raft"
 	"github.com/adminium/permify/pkg/database"
+	CRDatabase "github.com/adminium/permify/pkg/database/cockroach"
 	MMDatabase "github.com/adminium/permify/pkg/database/memory"
 	PQDatabase "github.com/adminium/permify/pkg/database/postgres"
+	RFDatabase "github.com/adminium/permify/pkg/database/raft"
 	"github.com/adminium/permify/pkg/logger"
+	"github.com/adminium/permify/pkg/storage"
+	"github.com/adminium/permify/pkg/token"
 )
 
 // RelationshipReaderFactory - Return relationship read operations according to given database interface
@@ -15,9 +24,16 @@ func RelationshipReaderFactory(db database.Database, logger logger.Interface) (r
 	switch db.GetEngineType() {
 	case "postgres":
 		return PQRepository.NewRelationshipReader(db.(*PQDatabase.Postgres), logger)
+	case "cockroach":
+		return CRRepository.NewRelationshipReader(db.(*CRDatabase.Cockroach), logger)
 	case "memory":
 		return MMRepository.NewRelationshipReader(db.(*MMDatabase.Memory), logger)
+	case "raft":
+		return MMRepository.NewRelationshipReader(db.(*RFDatabase.Raft).Memory, logger)
 	default:
+		if driver, ok := storage.Lookup(db.GetEngineType()); ok {
+			return driver.RelationshipReader(db, logger)
+		}
 		return MMRepository.NewRelationshipReader(db.(*MMDatabase.Memory), logger)
 	}
 }
@@ -27,9 +43,16 @@ func RelationshipWriterFactory(db database.Database, logger logger.Interface) (r
 	switch db.GetEngineType() {
 	case "postgres":
 		return PQRepository.NewRelationshipWriter(db.(*PQDatabase.Postgres), logger)
+	case "cockroach":
+		return CRRepository.NewRelationshipWriter(db.(*CRDatabase.Cockroach), logger)
 	case "memory":
 		return MMRepository.NewRelationshipWriter(db.(*MMDatabase.Memory), logger)
+	case "raft":
+		return RFRepository.NewRelationshipWriter(db.(*RFDatabase.Raft), logger)
 	default:
+		if driver, ok := storage.Lookup(db.GetEngineType()); ok {
+			return driver.RelationshipWriter(db, logger)
+		}
 		return MMRepository.NewRelationshipWriter(db.(*MMDatabase.Memory), logger)
 	}
 }
@@ -39,9 +62,16 @@ func SchemaReaderFactory(db database.Database, logger logger.Interface) (repo re
 	switch db.GetEngineType() {
 	case "postgres":
 		return PQRepository.NewSchemaReader(db.(*PQDatabase.Postgres), logger)
+	case "cockroach":
+		return PQRepository.NewSchemaReader(db.(*CRDatabase.Cockroach).Postgres, logger)
 	case "memory":
 		return MMRepository.NewSchemaReader(db.(*MMDatabase.Memory), logger)
+	case "raft":
+		return MMRepository.NewSchemaReader(db.(*RFDatabase.Raft).Memory, logger)
 	default:
+		if driver, ok := storage.Lookup(db.GetEngineType()); ok {
+			return driver.SchemaReader(db, logger)
+		}
 		return MMRepository.NewSchemaReader(db.(*MMDatabase.Memory), logger)
 	}
 }
@@ -51,9 +81,16 @@ func SchemaWriterFactory(db database.Database, logger logger.Interface) (repo re
 	switch db.GetEngineType() {
 	case "postgres":
 		return PQRepository.NewSchemaWriter(db.(*PQDatabase.Postgres), logger)
+	case "cockroach":
+		return PQRepository.NewSchemaWriter(db.(*CRDatabase.Cockroach).Postgres, logger)
 	case "memory":
 		return MMRepository.NewSchemaWriter(db.(*MMDatabase.Memory), logger)
+	case "raft":
+		return RFRepository.NewSchemaWriter(db.(*RFDatabase.Raft), logger)
 	default:
+		if driver, ok := storage.Lookup(db.GetEngineType()); ok {
+			return driver.SchemaWriter(db, logger)
+		}
 		return MMRepository.NewSchemaWriter(db.(*MMDatabase.Memory), logger)
 	}
 }
@@ -63,9 +100,16 @@ func TenantReaderFactory(db database.Database, logger logger.Interface) (repo re
 	switch db.GetEngineType() {
 	case "postgres":
 		return PQRepository.NewTenantReader(db.(*PQDatabase.Postgres), logger)
+	case "cockroach":
+		return PQRepository.NewTenantReader(db.(*CRDatabase.Cockroach).Postgres, logger)
 	case "memory":
 		return MMRepository.NewTenantReader(db.(*MMDatabase.Memory), logger)
+	case "raft":
+		return MMRepository.NewTenantReader(db.(*RFDatabase.Raft).Memory, logger)
 	default:
+		if driver, ok := storage.Lookup(db.GetEngineType()); ok {
+			return driver.TenantReader(db, logger)
+		}
 		return MMRepository.NewTenantReader(db.(*MMDatabase.Memory), logger)
 	}
 }
@@ -75,9 +119,337 @@ func TenantWriterFactory(db database.Database, logger logger.Interface) (repo re
 	switch db.GetEngineType() {
 	case "postgres":
 		return PQRepository.NewTenantWriter(db.(*PQDatabase.Postgres), logger)
+	case "cockroach":
+		return PQRepository.NewTenantWriter(db.(*CRDatabase.Cockroach).Postgres, logger)
 	case "memory":
 		return MMRepository.NewTenantWriter(db.(*MMDatabase.Memory), logger)
+	case "raft":
+		return RFRepository.NewTenantWriter(db.(*RFDatabase.Raft), logger)
 	default:
+		if driver, ok := storage.Lookup(db.GetEngineType()); ok {
+			return driver.TenantWriter(db, logger)
+		}
 		return MMRepository.NewTenantWriter(db.(*MMDatabase.Memory), logger)
 	}
 }
+
+// IdentityReaderFactory - Return identity read operations according to given database interface.
+// Returns nil when the storage driver cannot store identity mappings.
+func IdentityReaderFactory(db database.Database, logger logger.Interface) (repo repositories.IdentityReader) {
+	switch db.GetEngineType() {
+	case "postgres":
+		return PQRepository.NewIdentityReader(db.(*PQDatabase.Postgres), logger)
+	case "cockroach":
+		return PQRepository.NewIdentityReader(db.(*CRDatabase.Cockroach).Postgres, logger)
+	case "memory":
+		return MMRepository.NewIdentityReader(db.(*MMDatabase.Memory), logger)
+	case "raft":
+		return MMRepository.NewIdentityReader(db.(*RFDatabase.Raft).Memory, logger)
+	default:
+		if driver, ok := storage.Lookup(db.GetEngineType()); ok {
+			if identities, ok := driver.(storage.IdentityDriver); ok {
+				return identities.IdentityReader(db, logger)
+			}
+			return nil
+		}
+		return MMRepository.NewIdentityReader(db.(*MMDatabase.Memory), logger)
+	}
+}
+
+// IdentityWriterFactory - Return identity write operations according to given database interface.
+// Returns nil when the storage driver cannot store identity mappings.
+func IdentityWriterFactory(db database.Database, logger logger.Interface) (repo repositories.IdentityWriter) {
+	switch db.GetEngineType() {
+	case "postgres":
+		return PQRepository.NewIdentityWriter(db.(*PQDatabase.Postgres), logger)
+	case "cockroach":
+		return PQRepository.NewIdentityWriter(db.(*CRDatabase.Cockroach).Postgres, logger)
+	case "memory":
+		return MMRepository.NewIdentityWriter(db.(*MMDatabase.Memory), logger)
+	case "raft":
+		return RFRepository.NewIdentityWriter(db.(*RFDatabase.Raft), logger)
+	default:
+		if driver, ok := storage.Lookup(db.GetEngineType()); ok {
+			if identities, ok := driver.(storage.IdentityDriver); ok {
+				return identities.IdentityWriter(db, logger)
+			}
+			return nil
+		}
+		return MMRepository.NewIdentityWriter(db.(*MMDatabase.Memory), logger)
+	}
+}
+
+// AttributeReaderFactory - Return attribute read operations according to given database interface.
+// Returns nil when the storage driver cannot store attributes.
+func AttributeReaderFactory(db database.Database, logger logger.Interface) (repo repositories.AttributeReader) {
+	switch db.GetEngineType() {
+	case "postgres":
+		return PQRepository.NewAttributeReader(db.(*PQDatabase.Postgres), logger)
+	case "cockroach":
+		return PQRepository.NewAttributeReader(db.(*CRDatabase.Cockroach).Postgres, logger)
+	case "memory":
+		return MMRepository.NewAttributeReader(db.(*MMDatabase.Memory), logger)
+	case "raft":
+		return MMRepository.NewAttributeReader(db.(*RFDatabase.Raft).Memory, logger)
+	default:
+		if driver, ok := storage.Lookup(db.GetEngineType()); ok {
+			if attributes, ok := driver.(storage.AttributeDriver); ok {
+				return attributes.AttributeReader(db, logger)
+			}
+			return nil
+		}
+		return MMRepository.NewAttributeReader(db.(*MMDatabase.Memory), logger)
+	}
+}
+
+// AttributeWriterFactory - Return attribute write operations according to given database interface.
+// Returns nil when the storage driver cannot store attributes.
+func AttributeWriterFactory(db database.Database, logger logger.Interface) (repo repositories.AttributeWriter) {
+	switch db.GetEngineType() {
+	case "postgres":
+		return PQRepository.NewAttributeWriter(db.(*PQDatabase.Postgres), logger)
+	case "cockroach":
+		return PQRepository.NewAttributeWriter(db.(*CRDatabase.Cockroach).Postgres, logger)
+	case "memory":
+		return MMRepository.NewAttributeWriter(db.(*MMDatabase.Memory), logger)
+	case "raft":
+		return RFRepository.NewAttributeWriter(db.(*RFDatabase.Raft), logger)
+	default:
+		if driver, ok := storage.Lookup(db.GetEngineType()); ok {
+			if attributes, ok := driver.(storage.AttributeDriver); ok {
+				return attributes.AttributeWriter(db, logger)
+			}
+			return nil
+		}
+		return MMRepository.NewAttributeWriter(db.(*MMDatabase.Memory), logger)
+	}
+}
+
+// TenantSettingsReaderFactory - Return tenant settings read operations according to given database interface.
+// Returns nil when the storage driver cannot store the settings of tenants.
+func TenantSettingsReaderFactory(db database.Database, logger logger.Interface) (repo repositories.TenantSettingsReader) {
+	switch db.GetEngineType() {
+	case "postgres":
+		return PQRepository.NewTenantSettingsReader(db.(*PQDatabase.Postgres), logger)
+	case "cockroach":
+		return PQRepository.NewTenantSettingsReader(db.(*CRDatabase.Cockroach).Postgres, logger)
+	case "memory":
+		return MMRepository.NewTenantSettingsReader(db.(*MMDatabase.Memory), logger)
+	case "raft":
+		return MMRepository.NewTenantSettingsReader(db.(*RFDatabase.Raft).Memory, logger)
+	default:
+		if driver, ok := storage.Lookup(db.GetEngineType()); ok {
+			if settings, ok := driver.(storage.SettingsDriver); ok {
+				return settings.TenantSettingsReader(db, logger)
+			}
+			return nil
+		}
+		return MMRepository.NewTenantSettingsReader(db.(*MMDatabase.Memory), logger)
+	}
+}
+
+// TenantSettingsWriterFactory - Return tenant settings write operations according to given database interface.
+// Returns nil when the storage driver cannot store the settings of tenants.
+func TenantSettingsWriterFactory(db database.Database, logger logger.Interface) (repo repositories.TenantSettingsWriter) {
+	switch db.GetEngineType() {
+	case "postgres":
+		return PQRepository.NewTenantSettingsWriter(db.(*PQDatabase.Postgres), logger)
+	case "cockroach":
+		return PQRepository.NewTenantSettingsWriter(db.(*CRDatabase.Cockroach).Postgres, logger)
+	case "memory":
+		return MMRepository.NewTenantSettingsWriter(db.(*MMDatabase.Memory), logger)
+	case "raft":
+		return RFRepository.NewTenantSettingsWriter(db.(*RFDatabase.Raft), logger)
+	default:
+		if driver, ok := storage.Lookup(db.GetEngineType()); ok {
+			if settings, ok := driver.(storage.SettingsDriver); ok {
+				return settings.TenantSettingsWriter(db, logger)
+			}
+			return nil
+		}
+		return MMRepository.NewTenantSettingsWriter(db.(*MMDatabase.Memory), logger)
+	}
+}
+
+// APIKeyReaderFactory - Return api key read operations according to given database interface.
+// Returns nil when the storage driver cannot store api keys.
+func APIKeyReaderFactory(db database.Database, logger logger.Interface) (repo repositories.APIKeyReader) {
+	switch db.GetEngineType() {
+	case "postgres":
+		return PQRepository.NewAPIKeyReader(db.(*PQDatabase.Postgres), logger)
+	case "cockroach":
+		return PQRepository.NewAPIKeyReader(db.(*CRDatabase.Cockroach).Postgres, logger)
+	case "memory":
+		return MMRepository.NewAPIKeyReader(db.(*MMDatabase.Memory), logger)
+	case "raft":
+		return MMRepository.NewAPIKeyReader(db.(*RFDatabase.Raft).Memory, logger)
+	default:
+		if driver, ok := storage.Lookup(db.GetEngineType()); ok {
+			if keys, ok := driver.(storage.APIKeyDriver); ok {
+				return keys.APIKeyReader(db, logger)
+			}
+			return nil
+		}
+		return MMRepository.NewAPIKeyReader(db.(*MMDatabase.Memory), logger)
+	}
+}
+
+// APIKeyWriterFactory - Return api key write operations according to given database interface.
+// Returns nil when the storage driver cannot store api keys.
+func APIKeyWriterFactory(db database.Database, logger logger.Interface) (repo repositories.APIKeyWriter) {
+	switch db.GetEngineType() {
+	case "postgres":
+		return PQRepository.NewAPIKeyWriter(db.(*PQDatabase.Postgres), logger)
+	case "cockroach":
+		return PQRepository.NewAPIKeyWriter(db.(*CRDatabase.Cockroach).Postgres, logger)
+	case "memory":
+		return MMRepository.NewAPIKeyWriter(db.(*MMDatabase.Memory), logger)
+	case "raft":
+		return RFRepository.NewAPIKeyWriter(db.(*RFDatabase.Raft), logger)
+	default:
+		if driver, ok := storage.Lookup(db.GetEngineType()); ok {
+			if keys, ok := driver.(storage.APIKeyDriver); ok {
+				return keys.APIKeyWriter(db, logger)
+			}
+			return nil
+		}
+		return MMRepository.NewAPIKeyWriter(db.(*MMDatabase.Memory), logger)
+	}
+}
+
+// CheckTraceReaderFactory - Return check trace read operations according to given database interface.
+// Returns nil when the storage driver cannot store check traces.
+func CheckTraceReaderFactory(db database.Database, logger logger.Interface) (repo repositories.CheckTraceReader) {
+	switch db.GetEngineType() {
+	case "postgres":
+		return PQRepository.NewCheckTraceReader(db.(*PQDatabase.Postgres), logger)
+	case "cockroach":
+		return PQRepository.NewCheckTraceReader(db.(*CRDatabase.Cockroach).Postgres, logger)
+	case "memory":
+		return MMRepository.NewCheckTraceReader(db.(*MMDatabase.Memory), logger)
+	case "raft":
+		// the traces are diagnostics of the node that sampled them, they are not worth a round of consensus
+		return nil
+	default:
+		if driver, ok := storage.Lookup(db.GetEngineType()); ok {
+			if traces, ok := driver.(storage.CheckTraceDriver); ok {
+				return traces.CheckTraceReader(db, logger)
+			}
+			return nil
+		}
+		return MMRepository.NewCheckTraceReader(db.(*MMDatabase.Memory), logger)
+	}
+}
+
+// CheckTraceWriterFactory - Return check trace write operations according to given database interface.
+// Returns nil when the storage driver cannot store check traces.
+func CheckTraceWriterFactory(db database.Database, logger logger.Interface) (repo repositories.CheckTraceWriter) {
+	switch db.GetEngineType() {
+	case "postgres":
+		return PQRepository.NewCheckTraceWriter(db.(*PQDatabase.Postgres), logger)
+	case "cockroach":
+		return PQRepository.NewCheckTraceWriter(db.(*CRDatabase.Cockroach).Postgres, logger)
+	case "memory":
+		return MMRepository.NewCheckTraceWriter(db.(*MMDatabase.Memory), logger)
+	case "raft":
+		return nil
+	default:
+		if driver, ok := storage.Lookup(db.GetEngineType()); ok {
+			if traces, ok := driver.(storage.CheckTraceDriver); ok {
+				return traces.CheckTraceWriter(db, logger)
+			}
+			return nil
+		}
+		return MMRepository.NewCheckTraceWriter(db.(*MMDatabase.Memory), logger)
+	}
+}
+
+// WatcherFactory - Return the watcher of relation tuple changes according to given database interface.
+// Returns nil when the storage driver cannot stream changes.
+func WatcherFactory(db database.Database, logger logger.Interface) (repo repositories.Watcher) {
+	switch db.GetEngineType() {
+	case "postgres":
+		return PQRepository.NewWatcher(db.(*PQDatabase.Postgres), logger)
+	case "cockroach":
+		// the changes of cockroach are not read through the snapshots of postgres
+		return nil
+	case "memory":
+		return MMRepository.NewWatcher(db.(*MMDatabase.Memory), logger)
+	case "raft":
+		return MMRepository.NewWatcher(db.(*RFDatabase.Raft).Memory, logger)
+	default:
+		if driver, ok := storage.Lookup(db.GetEngineType()); ok {
+			if watch, ok := driver.(storage.WatchDriver); ok {
+				return watch.Watcher(db, logger)
+			}
+			return nil
+		}
+		return MMRepository.NewWatcher(db.(*MMDatabase.Memory), logger)
+	}
+}
+
+// GarbageCollectorFactory - Return the garbage collector of the deleted relation tuples according to given database
+// interface. Returns nil when the storage does not keep them.
+func GarbageCollectorFactory(db database.Database, logger logger.Interface) (repo repositories.GarbageCollector) {
+	switch db.GetEngineType() {
+	case "postgres":
+		return PQRepository.NewGarbageCollector(db.(*PQDatabase.Postgres), logger)
+	case "cockroach", "memory", "raft":
+		// only the versions of postgres are collected, memory deletes the tuples
+		return nil
+	default:
+		if driver, ok := storage.Lookup(db.GetEngineType()); ok {
+			if gc, ok := driver.(storage.GarbageCollectionDriver); ok {
+				return gc.GarbageCollector(db, logger)
+			}
+		}
+		return nil
+	}
+}
+
+// RelationshipSweeperFactory - Return the sweeper of the expired relation tuples according to given database
+// interface. Returns nil when the storage can not remove them.
+func RelationshipSweeperFactory(db database.Database, logger logger.Interface) (repo repositories.RelationshipSweeper) {
+	switch db.GetEngineType() {
+	case "postgres":
+		return PQRepository.NewRelationshipSweeper(db.(*PQDatabase.Postgres), logger)
+	case "memory":
+		return MMRepository.NewRelationshipSweeper(db.(*MMDatabase.Memory), logger)
+	case "cockroach", "raft":
+		// the nodes of a raft cluster would remove the tuples of their own copy only
+		return nil
+	default:
+		if driver, ok := storage.Lookup(db.GetEngineType()); ok {
+			if sweep, ok := driver.(storage.RelationshipSweepDriver); ok {
+				return sweep.RelationshipSweeper(db, logger)
+			}
+		}
+		return nil
+	}
+}
+
+// SnapTokenDecoderFactory - Return the decoder of the snap tokens according to given database interface. Returns nil
+// when the storage driver can not decode them.
+func SnapTokenDecoderFactory(db database.Database) (decode token.Decoder) {
+	switch db.GetEngineType() {
+	case "postgres":
+		return func(value string) (token.SnapToken, error) {
+			return PQSnapshot.EncodedToken{Value: value}.Decode()
+		}
+	case "cockroach":
+		return func(value string) (token.SnapToken, error) {
+			return CRSnapshot.EncodedToken{Value: value}.Decode()
+		}
+	case "memory", "raft":
+		return func(value string) (token.SnapToken, error) {
+			return MMSnapshot.EncodedToken{Value: value}.Decode()
+		}
+	default:
+		if driver, ok := storage.Lookup(db.GetEngineType()); ok {
+			if tokens, ok := driver.(storage.SnapTokenDriver); ok {
+				return tokens.SnapTokenDecoder()
+			}
+		}
+		return nil
+	}
+}
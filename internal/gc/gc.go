@@ -0,0 +1,148 @@
+package gc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+	
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/instrument"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/pkg/logger"
+)
+
+const (
+	_defaultWindow    = 24 * time.Hour
+	_defaultInterval  = time.Hour
+	_defaultBatchSize = 1000
+)
+
+// Result - Outcome of a garbage collection, the tuples deleted before an error are counted as well
+type Result struct {
+	// Before - the tuples deleted before this time were collected
+	Before   time.Time
+	Deleted  int64
+	Batches  int
+	Duration time.Duration
+}
+
+// Collector - Deletes the versions of the relation tuples that were deleted before the window, so that the table
+// does not grow with every write. The snapshots older than the window, and the changes of the watchers and the
+// archive that lag behind it, can no longer be read. The tuples are deleted in batches, each in its own statement,
+// and the collections of the interval and the ones triggered by the admin service never run at the same time.
+type Collector struct {
+	gc repositories.GarbageCollector
+	// options
+	window    time.Duration
+	interval  time.Duration
+	batchSize int
+	logger    logger.Interface
+	// metrics
+	runCounter        instrument.Int64Counter
+	failureCounter    instrument.Int64Counter
+	deletedCounter    instrument.Int64Counter
+	durationHistogram instrument.Int64Histogram
+	
+	mu sync.Mutex
+}
+
+// NewCollector - Creates a new garbage collector, the zero options are replaced by their defaults
+func NewCollector(gc repositories.GarbageCollector, window, interval time.Duration, batchSize int, m metric.Meter, l logger.Interface) (*Collector, error) {
+	if window <= 0 {
+		window = _defaultWindow
+	}
+	if interval <= 0 {
+		interval = _defaultInterval
+	}
+	if batchSize <= 0 {
+		batchSize = _defaultBatchSize
+	}
+	
+	runCounter, err := m.Int64Counter("garbage_collection_run_count", instrument.WithDescription("garbage collection run count"))
+	if err != nil {
+		return nil, err
+	}
+	failureCounter, err := m.Int64Counter("garbage_collection_failure_count", instrument.WithDescription("garbage collection failure count"))
+	if err != nil {
+		return nil, err
+	}
+	deletedCounter, err := m.Int64Counter("garbage_collection_deleted_tuple_count", instrument.WithDescription("relation tuples deleted by the garbage collection"))
+	if err != nil {
+		return nil, err
+	}
+	durationHistogram, err := m.Int64Histogram("garbage_collection_duration", instrument.WithDescription("garbage collection duration in milliseconds"))
+	if err != nil {
+		return nil, err
+	}
+	
+	return &Collector{
+		gc:                gc,
+		window:            window,
+		interval:          interval,
+		batchSize:         batchSize,
+		logger:            l,
+		runCounter:        runCounter,
+		failureCounter:    failureCounter,
+		deletedCounter:    deletedCounter,
+		durationHistogram: durationHistogram,
+	}, nil
+}
+
+// Run - Collects every interval until the context is done, failed collections are logged and retried on the next one
+func (c *Collector) Run(ctx context.Context) error {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case now := <-ticker.C:
+			if _, err := c.Collect(ctx, now); err != nil {
+				c.logger.Error(fmt.Sprintf("garbage collection failed: %s", err.Error()))
+			}
+		}
+	}
+}
+
+// Collect - Deletes the tuples that were deleted a window before now, batch by batch until a batch is not full
+func (c *Collector) Collect(ctx context.Context, now time.Time) (result Result, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	
+	start := time.Now()
+	result.Before = now.Add(-c.window)
+	c.runCounter.Add(ctx, 1)
+	
+	defer func() {
+		result.Duration = time.Since(start)
+		c.deletedCounter.Add(ctx, result.Deleted)
+		c.durationHistogram.Record(ctx, result.Duration.Milliseconds())
+		if err != nil {
+			c.failureCounter.Add(ctx, 1)
+		}
+	}()
+	
+	for {
+		if err = ctx.Err(); err != nil {
+			return result, err
+		}
+		var deleted int64
+		deleted, err = c.gc.CollectGarbage(ctx, result.Before, c.batchSize)
+		if err != nil {
+			return result, err
+		}
+		result.Deleted += deleted
+		result.Batches++
+		if deleted < int64(c.batchSize) {
+			break
+		}
+	}
+	
+	if result.Deleted > 0 {
+		c.logger.Info("garbage collection deleted %d tuples expired before %s", result.Deleted, result.Before.Format(time.RFC3339))
+	}
+	return result, nil
+}
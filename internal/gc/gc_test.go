@@ -0,0 +1,82 @@
+package gc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	
+	"github.com/adminium/permify/pkg/logger"
+	"github.com/adminium/permify/pkg/telemetry"
+)
+
+// TestGC -
+func TestGC(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "gc-suite")
+}
+
+// storage - Expired tuples of a storage by the time they were deleted
+type storage struct {
+	expired []time.Time
+	limits  []int
+	err     error
+}
+
+func (s *storage) CollectGarbage(_ context.Context, before time.Time, limit int) (int64, error) {
+	s.limits = append(s.limits, limit)
+	if s.err != nil {
+		return 0, s.err
+	}
+	var deleted int64
+	kept := s.expired[:0]
+	for _, at := range s.expired {
+		if at.Before(before) && deleted < int64(limit) {
+			deleted++
+			continue
+		}
+		kept = append(kept, at)
+	}
+	s.expired = kept
+	return deleted, nil
+}
+
+var _ = Describe("gc", func() {
+	now := time.Date(2023, 2, 10, 12, 0, 0, 0, time.UTC)
+	
+	Context("Collect", func() {
+		It("Case 1: Deletes the tuples expired before the window in batches", func() {
+			s := &storage{}
+			for i := 0; i < 5; i++ {
+				s.expired = append(s.expired, now.Add(-48*time.Hour))
+			}
+			s.expired = append(s.expired, now.Add(-time.Hour))
+			
+			collector, err := NewCollector(s, 24*time.Hour, time.Hour, 2, telemetry.NewNoopMeter(), logger.New("error"))
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			result, err := collector.Collect(context.Background(), now)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.Before).Should(Equal(now.Add(-24 * time.Hour)))
+			Expect(result.Deleted).Should(Equal(int64(5)))
+			Expect(result.Batches).Should(Equal(3))
+			Expect(s.limits).Should(Equal([]int{2, 2, 2}))
+			Expect(s.expired).Should(Equal([]time.Time{now.Add(-time.Hour)}))
+		})
+		
+		It("Case 2: Stops at the first failed batch", func() {
+			s := &storage{err: errors.New("connection refused")}
+			
+			collector, err := NewCollector(s, 0, 0, 0, telemetry.NewNoopMeter(), logger.New("error"))
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			result, err := collector.Collect(context.Background(), now)
+			Expect(err).Should(Equal(s.err))
+			Expect(result.Deleted).Should(Equal(int64(0)))
+			Expect(s.limits).Should(Equal([]int{_defaultBatchSize}))
+		})
+	})
+})
@@ -0,0 +1,75 @@
+package gc
+
+import (
+	"context"
+	"time"
+	
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	
+	"github.com/adminium/permify/internal/repositories"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// SnapshotExpiredError - The snap token of a request is older than the window of the garbage collection, the tuples
+// it saw may have been collected
+type SnapshotExpiredError struct {
+	// Earliest - the earliest snap token of the tenant that can still be read
+	Earliest string
+}
+
+// Error -
+func (e *SnapshotExpiredError) Error() string {
+	return base.ErrorCode_ERROR_CODE_SNAPSHOT_EXPIRED.String()
+}
+
+// GRPCStatus - Reports the earliest snap token as an error info detail, so clients can retry from it
+func (e *SnapshotExpiredError) GRPCStatus() *status.Status {
+	st := status.New(codes.FailedPrecondition, e.Error())
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: e.Error(),
+		Domain: "permify",
+		Metadata: map[string]string{
+			"earliest_snap_token": e.Earliest,
+		},
+	})
+	if err != nil {
+		return st
+	}
+	return detailed
+}
+
+// Horizon - Tells apart the snap tokens that are older than the window of the garbage collection. The tuples deleted
+// after them may have been collected, so reading them would return incomplete results.
+type Horizon struct {
+	horizon repositories.SnapshotHorizon
+	window  time.Duration
+}
+
+// NewHorizon - Creates a new horizon of the collections of the window, the zero window is replaced by its default
+func NewHorizon(horizon repositories.SnapshotHorizon, window time.Duration) *Horizon {
+	if window <= 0 {
+		window = _defaultWindow
+	}
+	return &Horizon{
+		horizon: horizon,
+		window:  window,
+	}
+}
+
+// Check - Fails with a SnapshotExpiredError when the snap token of the tenant expired by now, the empty token reads
+// the head snapshot
+func (h *Horizon) Check(ctx context.Context, tenantID, snap string, now time.Time) error {
+	if snap == "" {
+		return nil
+	}
+	earliest, expired, err := h.horizon.ExpiredSnapshot(ctx, tenantID, snap, now.Add(-h.window))
+	if err != nil {
+		return err
+	}
+	if expired {
+		return &SnapshotExpiredError{Earliest: earliest.Encode().String()}
+	}
+	return nil
+}
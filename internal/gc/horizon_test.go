@@ -0,0 +1,65 @@
+package gc
+
+import (
+	"context"
+	"errors"
+	"time"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	
+	"github.com/adminium/permify/pkg/token"
+)
+
+// history - Snapshots of a tenant by the time they were committed, their tokens are their indexes
+type history struct {
+	committed []time.Time
+}
+
+func (h *history) ExpiredSnapshot(_ context.Context, _ string, snap string, before time.Time) (token.SnapToken, bool, error) {
+	earliest := -1
+	for i, at := range h.committed {
+		if at.Before(before) {
+			earliest = i
+		}
+	}
+	if earliest < 0 {
+		return nil, false, nil
+	}
+	for i := range h.committed {
+		if snap == string(rune('a'+i)) {
+			return token.NoopToken{Value: string(rune('a' + earliest))}, i < earliest, nil
+		}
+	}
+	return nil, false, errors.New("unknown snapshot")
+}
+
+var _ = Describe("horizon", func() {
+	now := time.Date(2023, 2, 12, 12, 0, 0, 0, time.UTC)
+	
+	Context("Check", func() {
+		It("Case 1: Rejects the snapshots before the latest one committed before the window", func() {
+			h := &history{committed: []time.Time{now.Add(-48 * time.Hour), now.Add(-30 * time.Hour), now.Add(-time.Hour)}}
+			horizon := NewHorizon(h, 24*time.Hour)
+			
+			err := horizon.Check(context.Background(), "t1", "a", now)
+			var expired *SnapshotExpiredError
+			Expect(errors.As(err, &expired)).Should(BeTrue())
+			
+			st := expired.GRPCStatus()
+			Expect(st.Code()).Should(Equal(codes.FailedPrecondition))
+			Expect(st.Message()).Should(Equal("ERROR_CODE_SNAPSHOT_EXPIRED"))
+			Expect(st.Details()[0].(*errdetails.ErrorInfo).GetMetadata()).Should(HaveKey("earliest_snap_token"))
+			
+			Expect(horizon.Check(context.Background(), "t1", "b", now)).Should(Succeed())
+			Expect(horizon.Check(context.Background(), "t1", "c", now)).Should(Succeed())
+		})
+		
+		It("Case 2: Reads the head snapshot for the empty token", func() {
+			horizon := NewHorizon(&history{}, 0)
+			Expect(horizon.Check(context.Background(), "t1", "", now)).Should(Succeed())
+		})
+	})
+})
@@ -0,0 +1,129 @@
+package gc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+	
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/instrument"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/pkg/logger"
+)
+
+const (
+	_defaultSweepWindow   = time.Hour
+	_defaultSweepInterval = 10 * time.Minute
+)
+
+// Sweeper - Removes the relation tuples whose validity ended a window ago, like the temporary grants written with a
+// not after time. The reads ignore them as soon as they end, the window keeps them for the snapshots taken before.
+type Sweeper struct {
+	sweeper repositories.RelationshipSweeper
+	// options
+	window    time.Duration
+	interval  time.Duration
+	batchSize int
+	logger    logger.Interface
+	// metrics
+	runCounter     instrument.Int64Counter
+	failureCounter instrument.Int64Counter
+	deletedCounter instrument.Int64Counter
+	
+	mu sync.Mutex
+}
+
+// NewSweeper - Creates a new sweeper of the expired relation tuples, the zero options are replaced by their defaults
+func NewSweeper(sweeper repositories.RelationshipSweeper, window, interval time.Duration, batchSize int, m metric.Meter, l logger.Interface) (*Sweeper, error) {
+	if window <= 0 {
+		window = _defaultSweepWindow
+	}
+	if interval <= 0 {
+		interval = _defaultSweepInterval
+	}
+	if batchSize <= 0 {
+		batchSize = _defaultBatchSize
+	}
+	
+	runCounter, err := m.Int64Counter("expired_tuple_sweep_run_count", instrument.WithDescription("expired tuple sweep run count"))
+	if err != nil {
+		return nil, err
+	}
+	failureCounter, err := m.Int64Counter("expired_tuple_sweep_failure_count", instrument.WithDescription("expired tuple sweep failure count"))
+	if err != nil {
+		return nil, err
+	}
+	deletedCounter, err := m.Int64Counter("expired_tuple_sweep_deleted_tuple_count", instrument.WithDescription("expired relation tuples deleted by the sweep"))
+	if err != nil {
+		return nil, err
+	}
+	
+	return &Sweeper{
+		sweeper:        sweeper,
+		window:         window,
+		interval:       interval,
+		batchSize:      batchSize,
+		logger:         l,
+		runCounter:     runCounter,
+		failureCounter: failureCounter,
+		deletedCounter: deletedCounter,
+	}, nil
+}
+
+// Run - Sweeps every interval until the context is done, failed sweeps are logged and retried on the next one
+func (s *Sweeper) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case now := <-ticker.C:
+			if _, err := s.Sweep(ctx, now); err != nil {
+				s.logger.Error(fmt.Sprintf("expired tuple sweep failed: %s", err.Error()))
+			}
+		}
+	}
+}
+
+// Sweep - Deletes the tuples whose validity ended a window before now, batch by batch until a batch is not full
+func (s *Sweeper) Sweep(ctx context.Context, now time.Time) (result Result, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	
+	start := time.Now()
+	result.Before = now.Add(-s.window)
+	s.runCounter.Add(ctx, 1)
+	
+	defer func() {
+		result.Duration = time.Since(start)
+		s.deletedCounter.Add(ctx, result.Deleted)
+		if err != nil {
+			s.failureCounter.Add(ctx, 1)
+		}
+	}()
+	
+	for {
+		if err = ctx.Err(); err != nil {
+			return result, err
+		}
+		var deleted int64
+		deleted, err = s.sweeper.SweepExpiredRelationships(ctx, result.Before, s.batchSize)
+		if err != nil {
+			return result, err
+		}
+		result.Deleted += deleted
+		result.Batches++
+		if deleted < int64(s.batchSize) {
+			break
+		}
+	}
+	
+	if result.Deleted > 0 {
+		s.logger.Info("expired tuple sweep deleted %d tuples not valid after %s", result.Deleted, result.Before.Format(time.RFC3339))
+	}
+	return result, nil
+}
@@ -0,0 +1,56 @@
+package gc
+
+import (
+	"context"
+	"time"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	
+	"github.com/adminium/permify/pkg/logger"
+	"github.com/adminium/permify/pkg/telemetry"
+)
+
+// expiring - Tuples of a storage by the time their validity ends
+type expiring struct {
+	notAfter []time.Time
+}
+
+func (e *expiring) SweepExpiredRelationships(_ context.Context, before time.Time, limit int) (int64, error) {
+	var deleted int64
+	kept := e.notAfter[:0]
+	for _, at := range e.notAfter {
+		if at.Before(before) && deleted < int64(limit) {
+			deleted++
+			continue
+		}
+		kept = append(kept, at)
+	}
+	e.notAfter = kept
+	return deleted, nil
+}
+
+var _ = Describe("sweeper", func() {
+	now := time.Date(2023, 2, 11, 12, 0, 0, 0, time.UTC)
+	
+	Context("Sweep", func() {
+		It("Case 1: Deletes the tuples that ended before the window in batches", func() {
+			e := &expiring{notAfter: []time.Time{
+				now.Add(-3 * time.Hour),
+				now.Add(-2 * time.Hour),
+				now.Add(-30 * time.Minute),
+				now.Add(time.Hour),
+			}}
+			
+			sweeper, err := NewSweeper(e, time.Hour, 0, 1, telemetry.NewNoopMeter(), logger.New("error"))
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			result, err := sweeper.Sweep(context.Background(), now)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.Before).Should(Equal(now.Add(-time.Hour)))
+			Expect(result.Deleted).Should(Equal(int64(2)))
+			Expect(result.Batches).Should(Equal(3))
+			Expect(e.notAfter).Should(Equal([]time.Time{now.Add(-30 * time.Minute), now.Add(time.Hour)}))
+		})
+	})
+})
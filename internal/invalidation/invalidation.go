@@ -0,0 +1,242 @@
+package invalidation
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+	
+	"github.com/adminium/permify/internal/keys"
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/internal/schema"
+	"github.com/adminium/permify/pkg/database"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+const (
+	_defaultRefreshInterval = 30 * time.Second
+	_defaultRetryInterval   = time.Second
+	_defaultPageSize        = 100
+)
+
+// Invalidator - Subscribes to the changes of the relation tuples of every tenant and invalidates the cached checks
+// of the permissions that depend on the changed relations. The writes of this instance are invalidated as they are
+// written, the invalidator catches the writes of the other replicas and of the clients writing to the storage
+// directly, whose results would otherwise be served until they are evicted. The invalidated keys are orphaned by
+// bumping their generations, the cache evicts them as it needs the space.
+type Invalidator struct {
+	watcher            repositories.Watcher
+	tenantReader       repositories.TenantReader
+	relationshipReader repositories.RelationshipReader
+	schemaReader       repositories.SchemaReader
+	keys               keys.CommandKeyManager
+	// options
+	refreshInterval time.Duration
+	retryInterval   time.Duration
+	logger          logger.Interface
+	// the tenants being watched and the tokens of the last changes they invalidated
+	mu       sync.Mutex
+	watching map[string]context.CancelFunc
+	tokens   map[string]string
+}
+
+// NewInvalidator - Creates a new invalidator, the tenants are listed every refresh interval to watch the new ones
+func NewInvalidator(w repositories.Watcher, tr repositories.TenantReader, rr repositories.RelationshipReader, sr repositories.SchemaReader, km keys.CommandKeyManager, refreshInterval time.Duration, l logger.Interface) *Invalidator {
+	if refreshInterval <= 0 {
+		refreshInterval = _defaultRefreshInterval
+	}
+	return &Invalidator{
+		watcher:            w,
+		tenantReader:       tr,
+		relationshipReader: rr,
+		schemaReader:       sr,
+		keys:               km,
+		refreshInterval:    refreshInterval,
+		retryInterval:      _defaultRetryInterval,
+		logger:             l,
+		watching:           map[string]context.CancelFunc{},
+		tokens:             map[string]string{},
+	}
+}
+
+// Run - Watches the tenants until the context is done
+func (i *Invalidator) Run(ctx context.Context) error {
+	ticker := time.NewTicker(i.refreshInterval)
+	defer ticker.Stop()
+	
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	
+	for {
+		if err := i.refresh(ctx, &wg); err != nil {
+			i.logger.Error(fmt.Sprintf("listing the tenants to invalidate failed: %s", err.Error()))
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Token - Token of the last changes of the tenant that were invalidated, it is empty before the first ones
+func (i *Invalidator) Token(tenantID string) string {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.tokens[tenantID]
+}
+
+// refresh - Starts watching the new tenants and stops watching the deleted ones
+func (i *Invalidator) refresh(ctx context.Context, wg *sync.WaitGroup) error {
+	tenants, err := i.tenants(ctx)
+	if err != nil {
+		return err
+	}
+	
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	
+	for tenantID, cancel := range i.watching {
+		if _, ok := tenants[tenantID]; !ok {
+			cancel()
+			delete(i.watching, tenantID)
+			delete(i.tokens, tenantID)
+		}
+	}
+	
+	for tenantID := range tenants {
+		if _, ok := i.watching[tenantID]; ok {
+			continue
+		}
+		watchCtx, cancel := context.WithCancel(ctx)
+		i.watching[tenantID] = cancel
+		wg.Add(1)
+		go func(tenantID string) {
+			defer wg.Done()
+			i.watch(watchCtx, tenantID)
+		}(tenantID)
+	}
+	return nil
+}
+
+// watch - Invalidates the changes of the tenant until the context is done. A failed watch is resumed from the token
+// of the last invalidated changes, so no change is missed; when there is none yet, it starts over at the head
+// snapshot and the checks of the whole tenant are invalidated, since the changes in between are unknown.
+func (i *Invalidator) watch(ctx context.Context, tenantID string) {
+	for {
+		snap := i.Token(tenantID)
+		if snap == "" {
+			head, err := i.relationshipReader.HeadSnapshot(ctx, tenantID)
+			if err != nil {
+				if !i.wait(ctx, tenantID, err) {
+					return
+				}
+				continue
+			}
+			snap = head.Encode().String()
+			i.keys.InvalidateCheckKeys(tenantID)
+		}
+		
+		err := i.consume(ctx, tenantID, snap)
+		if !i.wait(ctx, tenantID, err) {
+			return
+		}
+	}
+}
+
+// consume - Invalidates the changes of a single watch until it fails or the context is done
+func (i *Invalidator) consume(ctx context.Context, tenantID, snap string) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	
+	changes, errs := i.watcher.Watch(ctx, tenantID, snap)
+	for {
+		select {
+		case c, ok := <-changes:
+			if !ok {
+				// the watcher closes both channels, an error is sent before they are closed
+				if err, ok := <-errs; ok {
+					return err
+				}
+				return ctx.Err()
+			}
+			Invalidate(ctx, i.schemaReader, i.keys, tenantID, Changed(c.Created, c.Deleted))
+			i.mu.Lock()
+			if _, ok := i.watching[tenantID]; ok {
+				i.tokens[tenantID] = c.SnapToken
+			}
+			i.mu.Unlock()
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// wait - Logs the error of a watch and waits before it is retried, it reports false when the context is done
+func (i *Invalidator) wait(ctx context.Context, tenantID string, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if err != nil {
+		i.logger.Warn("watching the changes of %s to invalidate failed: %s", tenantID, err.Error())
+	}
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(i.retryInterval):
+		return true
+	}
+}
+
+// tenants - Ids of every tenant
+func (i *Invalidator) tenants(ctx context.Context) (map[string]struct{}, error) {
+	ids := map[string]struct{}{}
+	ct := ""
+	for {
+		tenants, next, err := i.tenantReader.ListTenants(ctx, database.NewPagination(database.Size(_defaultPageSize), database.Token(ct)))
+		if err != nil {
+			return nil, err
+		}
+		for _, tenant := range tenants {
+			ids[tenant.GetId()] = struct{}{}
+		}
+		if next == nil || next.String() == "" {
+			return ids, nil
+		}
+		ct = next.String()
+	}
+}
+
+// Invalidate - Invalidates the dependents of the changed relations in the head schema of the tenant. When the schema
+// cannot be read, every check of the tenant is invalidated instead.
+func Invalidate(ctx context.Context, schemaReader repositories.SchemaReader, km keys.CommandKeyManager, tenantID string, changed map[string]*base.RelationReference) {
+	if len(changed) == 0 {
+		return
+	}
+	version, err := schemaReader.HeadVersion(ctx, tenantID)
+	if err != nil {
+		km.InvalidateCheckKeys(tenantID)
+		return
+	}
+	var sch *base.SchemaDefinition
+	sch, err = schemaReader.ReadSchema(ctx, tenantID, version)
+	if err != nil {
+		km.InvalidateCheckKeys(tenantID)
+		return
+	}
+	for _, reference := range changed {
+		km.InvalidateCheckKeys(tenantID, schema.Dependents(sch, reference.GetType(), reference.GetRelation())...)
+	}
+}
+
+// Changed - Relations of the tuples of the collections
+func Changed(collections ...*database.TupleCollection) map[string]*base.RelationReference {
+	changed := map[string]*base.RelationReference{}
+	for _, collection := range collections {
+		for _, tup := range collection.GetTuples() {
+			changed[tup.GetEntity().GetType()+"#"+tup.GetRelation()] = &base.RelationReference{Type: tup.GetEntity().GetType(), Relation: tup.GetRelation()}
+		}
+	}
+	return changed
+}
@@ -0,0 +1,147 @@
+package invalidation
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/internal/repositories/memory"
+	"github.com/adminium/permify/internal/repositories/memory/migrations"
+	"github.com/adminium/permify/pkg/database"
+	db "github.com/adminium/permify/pkg/database/memory"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+// TestInvalidation -
+func TestInvalidation(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "invalidation-suite")
+}
+
+// recorder - Key manager that records the invalidations, a tenant wide invalidation is recorded as "*"
+type recorder struct {
+	mu          sync.Mutex
+	invalidated map[string][]string
+}
+
+func (r *recorder) SetCheckKey(*base.PermissionCheckRequest, *base.PermissionCheckResponse) bool {
+	return true
+}
+
+func (r *recorder) GetCheckKey(*base.PermissionCheckRequest) (*base.PermissionCheckResponse, bool) {
+	return nil, false
+}
+
+func (r *recorder) InvalidateCheckKeys(tenantID string, references ...*base.RelationReference) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(references) == 0 {
+		r.invalidated[tenantID] = append(r.invalidated[tenantID], "*")
+		return
+	}
+	for _, reference := range references {
+		r.invalidated[tenantID] = append(r.invalidated[tenantID], reference.GetType()+"#"+reference.GetRelation())
+	}
+}
+
+func (r *recorder) get(tenantID string) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string{}, r.invalidated[tenantID]...)
+}
+
+var _ = Describe("invalidation", func() {
+	var mem *db.Memory
+	var l logger.Interface
+	var keys *recorder
+	var write func(tuples ...string)
+	
+	BeforeEach(func() {
+		var err error
+		mem, err = db.New(migrations.Schema)
+		Expect(err).ShouldNot(HaveOccurred())
+		l = logger.New("error")
+		
+		_, err = memory.NewTenantWriter(mem, l).CreateTenant(context.Background(), "t1", "t1")
+		Expect(err).ShouldNot(HaveOccurred())
+		
+		err = memory.NewSchemaWriter(mem, l).WriteSchema(context.Background(), []repositories.SchemaDefinition{
+			{TenantID: "t1", EntityType: "user", SerializedDefinition: []byte("entity user {}"), Version: "v1"},
+			{TenantID: "t1", EntityType: "doc", SerializedDefinition: []byte("entity doc {\n\trelation owner @user\n\trelation viewer @user\n\taction view = viewer or owner\n\taction edit = owner\n}"), Version: "v1"},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		
+		writer := memory.NewRelationshipWriter(mem, l)
+		write = func(tuples ...string) {
+			collection := database.NewTupleCollection()
+			for _, t := range tuples {
+				tup, err := tuple.Tuple(t)
+				Expect(err).ShouldNot(HaveOccurred())
+				collection.Add(tup)
+			}
+			_, err := writer.WriteRelationships(context.Background(), "t1", collection)
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+		
+		keys = &recorder{invalidated: map[string][]string{}}
+	})
+	
+	Context("Invalidator", func() {
+		It("Case 1: Writes to the storage invalidate the checks that depend on them", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			
+			invalidator := NewInvalidator(memory.NewWatcher(mem, l), memory.NewTenantReader(mem, l), memory.NewRelationshipReader(mem, l), memory.NewSchemaReader(mem, l), keys, time.Minute, l)
+			
+			done := make(chan struct{})
+			go func() {
+				defer GinkgoRecover()
+				defer close(done)
+				Expect(invalidator.Run(ctx)).Should(Succeed())
+			}()
+			
+			// the watch starts by invalidating the tenant, the changes before it are unknown
+			Eventually(func() []string { return keys.get("t1") }).Should(Equal([]string{"*"}))
+			
+			write("doc:1#owner@user:1")
+			
+			Eventually(func() []string { return keys.get("t1") }).Should(ConsistOf("*", "doc#owner", "doc#view", "doc#edit"))
+			Eventually(func() string { return invalidator.Token("t1") }).ShouldNot(BeEmpty())
+			
+			cancel()
+			Eventually(done).Should(BeClosed())
+		})
+	})
+	
+	Context("Invalidate", func() {
+		It("Case 1: Only the dependents of the changed relations are invalidated", func() {
+			collection := database.NewTupleCollection()
+			tup, err := tuple.Tuple("doc:1#viewer@user:1")
+			Expect(err).ShouldNot(HaveOccurred())
+			collection.Add(tup)
+			
+			Invalidate(context.Background(), memory.NewSchemaReader(mem, l), keys, "t1", Changed(collection))
+			Expect(keys.get("t1")).Should(ConsistOf("doc#viewer", "doc#view"))
+		})
+		
+		It("Case 2: Every check of a tenant without a schema is invalidated", func() {
+			collection := database.NewTupleCollection()
+			tup, err := tuple.Tuple("doc:1#viewer@user:1")
+			Expect(err).ShouldNot(HaveOccurred())
+			collection.Add(tup)
+			
+			Invalidate(context.Background(), memory.NewSchemaReader(mem, l), keys, "t2", Changed(collection))
+			Expect(keys.get("t2")).Should(Equal([]string{"*"}))
+			
+			Invalidate(context.Background(), memory.NewSchemaReader(mem, l), keys, "t2", Changed())
+			Expect(keys.get("t2")).Should(Equal([]string{"*"}))
+		})
+	})
+})
@@ -1,61 +1,148 @@
 package keys
 
 import (
-	"encoding/hex"
+	"context"
 	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
 	
-	"github.com/cespare/xxhash"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/instrument"
 	
 	"github.com/adminium/permify/pkg/cache"
 	base "github.com/adminium/permify/pkg/pb/base/v1"
-	"github.com/adminium/permify/pkg/tuple"
 )
 
 type CommandKeys struct {
-	cache cache.Cache
+	cache     cache.Cache
+	namespace string
+	// generations of tenants and of their relations and actions, bumping a generation
+	// orphans the keys that were built with the previous one
+	generations sync.Map
+	// ttl of the cached checks of a tenant, zero keeps them until they are evicted
+	ttl func(tenantID string) time.Duration
+	// lookups of cached checks that found and did not find their results
+	hits   uint64
+	misses uint64
+}
+
+// CommandKeysOption - Option type
+type CommandKeysOption func(*CommandKeys)
+
+// Epoch - Namespaces the keys by region and cache epoch, bumping the epoch invalidates every key of the region
+func Epoch(region string, epoch uint64) CommandKeysOption {
+	return func(c *CommandKeys) {
+		c.namespace = fmt.Sprintf("%s.%d", region, epoch)
+	}
+}
+
+// TenantTTL - Expires the cached checks of a tenant after its ttl, when the cache supports expiry
+func TenantTTL(ttl func(tenantID string) time.Duration) CommandKeysOption {
+	return func(c *CommandKeys) {
+		c.ttl = ttl
+	}
+}
+
+// CommandKeysMeter - Reports the lookups of the cached checks that found and did not find their results, and the
+// ratio of the ones that found them
+func CommandKeysMeter(m metric.Meter) CommandKeysOption {
+	return func(c *CommandKeys) {
+		_, _ = m.Int64ObservableCounter("check_cache_hit_count",
+			instrument.WithDescription("check cache hit count"),
+			instrument.WithInt64Callback(func(_ context.Context, o instrument.Int64Observer) error {
+				o.Observe(int64(atomic.LoadUint64(&c.hits)))
+				return nil
+			}),
+		)
+		_, _ = m.Int64ObservableCounter("check_cache_miss_count",
+			instrument.WithDescription("check cache miss count"),
+			instrument.WithInt64Callback(func(_ context.Context, o instrument.Int64Observer) error {
+				o.Observe(int64(atomic.LoadUint64(&c.misses)))
+				return nil
+			}),
+		)
+		_, _ = m.Float64ObservableGauge("check_cache_hit_ratio",
+			instrument.WithDescription("ratio of the check cache lookups that found their results"),
+			instrument.WithFloat64Callback(func(_ context.Context, o instrument.Float64Observer) error {
+				o.Observe(c.HitRatio())
+				return nil
+			}),
+		)
+	}
 }
 
 // NewCheckCommandKeys new instance of CheckCommandKeys
-func NewCheckCommandKeys(cache cache.Cache) CommandKeyManager {
-	return &CommandKeys{
+func NewCheckCommandKeys(cache cache.Cache, opts ...CommandKeysOption) CommandKeyManager {
+	keys := &CommandKeys{
 		cache: cache,
 	}
+	for _, opt := range opts {
+		opt(keys)
+	}
+	return keys
 }
 
 // SetCheckKey - Sets the value for the given key.
 func (c *CommandKeys) SetCheckKey(key *base.PermissionCheckRequest, value *base.PermissionCheckResponse) bool {
-	checkKey := fmt.Sprintf("check_%s_%s:%s:%s@%s", key.GetTenantId(), key.GetMetadata().GetSchemaVersion(), key.GetMetadata().GetSnapToken(), tuple.EntityAndRelationToString(&base.EntityAndRelation{
-		Entity:   key.GetEntity(),
-		Relation: key.GetPermission(),
-	}), tuple.SubjectToString(key.GetSubject()))
-	h := xxhash.New()
-	size, err := h.Write([]byte(checkKey))
-	if err != nil {
-		return false
+	k, size := c.checkKey(key)
+	if c.ttl != nil {
+		if ttl := c.ttl(key.GetTenantId()); ttl > 0 {
+			if expiring, ok := c.cache.(cache.TTLCache); ok {
+				return expiring.SetWithTTL(k, value, int64(size), ttl)
+			}
+		}
 	}
-	k := hex.EncodeToString(h.Sum(nil))
 	return c.cache.Set(k, value, int64(size))
 }
 
 // GetCheckKey - Gets the value for the given key.
 func (c *CommandKeys) GetCheckKey(key *base.PermissionCheckRequest) (*base.PermissionCheckResponse, bool) {
-	checkKey := fmt.Sprintf("check_%s_%s:%s:%s@%s", key.GetTenantId(), key.GetMetadata().GetSchemaVersion(), key.GetMetadata().GetSnapToken(), tuple.EntityAndRelationToString(&base.EntityAndRelation{
-		Entity:   key.GetEntity(),
-		Relation: key.GetPermission(),
-	}), tuple.SubjectToString(key.GetSubject()))
-	h := xxhash.New()
-	_, err := h.Write([]byte(checkKey))
-	if err != nil {
-		return nil, false
-	}
-	k := hex.EncodeToString(h.Sum(nil))
+	k, _ := c.checkKey(key)
 	resp, found := c.cache.Get(k)
 	if found {
+		atomic.AddUint64(&c.hits, 1)
 		return resp.(*base.PermissionCheckResponse), true
 	}
+	atomic.AddUint64(&c.misses, 1)
 	return nil, false
 }
 
+// HitRatio - Ratio of the lookups that found their results, zero before the first lookup
+func (c *CommandKeys) HitRatio() float64 {
+	hits, misses := atomic.LoadUint64(&c.hits), atomic.LoadUint64(&c.misses)
+	if hits+misses == 0 {
+		return 0
+	}
+	return float64(hits) / float64(hits+misses)
+}
+
+// InvalidateCheckKeys - Invalidates the check keys of the given relations and actions of the tenant.
+// Without references every check key of the tenant is invalidated.
+func (c *CommandKeys) InvalidateCheckKeys(tenantID string, references ...*base.RelationReference) {
+	if len(references) == 0 {
+		atomic.AddUint64(c.generation(tenantID), 1)
+		return
+	}
+	for _, reference := range references {
+		atomic.AddUint64(c.generation(generationKey(tenantID, reference.GetType(), reference.GetRelation())), 1)
+	}
+}
+
+// generation - Gets the generation counter of the key, creating it on first use
+func (c *CommandKeys) generation(key string) *uint64 {
+	if g, ok := c.generations.Load(key); ok {
+		return g.(*uint64)
+	}
+	g, _ := c.generations.LoadOrStore(key, new(uint64))
+	return g.(*uint64)
+}
+
+// generationKey -
+func generationKey(tenantID, entityType, relation string) string {
+	return tenantID + "/" + entityType + "#" + relation
+}
+
 // NoopCommandKeys -
 type NoopCommandKeys struct{}
 
@@ -73,3 +160,13 @@ func (c *NoopCommandKeys) SetCheckKey(*base.PermissionCheckRequest, *base.Permis
 func (c *NoopCommandKeys) GetCheckKey(*base.PermissionCheckRequest) (*base.PermissionCheckResponse, bool) {
 	return nil, false
 }
+
+// InvalidateCheckKeys - nothing is cached, so there is nothing to invalidate.
+func (c *NoopCommandKeys) InvalidateCheckKeys(string, ...*base.RelationReference) {}
+
+// checkKey - Hash of the check key with the generations, size is the length of the encoded key
+func (c *CommandKeys) checkKey(key *base.PermissionCheckRequest) (uint64, int) {
+	tenant := atomic.LoadUint64(c.generation(key.GetTenantId()))
+	relation := atomic.LoadUint64(c.generation(generationKey(key.GetTenantId(), key.GetEntity().GetType(), key.GetPermission())))
+	return hashCheckKey(c.namespace, key, tenant, relation)
+}
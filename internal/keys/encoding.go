@@ -0,0 +1,56 @@
+package keys
+
+import (
+	"encoding/binary"
+	"sync"
+	
+	"github.com/cespare/xxhash"
+	
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+// keyBuffers - Buffers the check keys are encoded into before they are hashed
+var keyBuffers = sync.Pool{
+	New: func() any {
+		b := make([]byte, 0, 256)
+		return &b
+	},
+}
+
+// hashCheckKey - Hashes the check key of the request, namespaced and with the generations of the tenant and of the
+// permission. The fields are appended to a pooled buffer with their lengths in front, so encoding the key allocates
+// nothing and no two keys encode alike. size is the length of the encoded key.
+func hashCheckKey(namespace string, key *base.PermissionCheckRequest, tenant, relation uint64) (sum uint64, size int) {
+	bp := keyBuffers.Get().(*[]byte)
+	b := (*bp)[:0]
+	
+	b = appendString(b, namespace)
+	b = appendString(b, key.GetTenantId())
+	b = appendString(b, key.GetMetadata().GetSchemaVersion())
+	b = appendString(b, key.GetMetadata().GetSnapToken())
+	b = binary.LittleEndian.AppendUint64(b, tenant)
+	b = binary.LittleEndian.AppendUint64(b, relation)
+	b = appendString(b, key.GetEntity().GetType())
+	b = appendString(b, key.GetEntity().GetId())
+	b = appendString(b, key.GetPermission())
+	b = appendString(b, key.GetSubject().GetType())
+	b = appendString(b, key.GetSubject().GetId())
+	// the relation of users is not part of the subject, as in the string form of the subjects
+	if tuple.IsSubjectUser(key.GetSubject()) {
+		b = appendString(b, "")
+	} else {
+		b = appendString(b, key.GetSubject().GetRelation())
+	}
+	
+	sum, size = xxhash.Sum64(b), len(b)
+	*bp = b
+	keyBuffers.Put(bp)
+	return sum, size
+}
+
+// appendString - Appends the length of the string and the string
+func appendString(b []byte, s string) []byte {
+	b = binary.AppendUvarint(b, uint64(len(s)))
+	return append(b, s...)
+}
@@ -10,4 +10,6 @@ type CommandKeyManager interface {
 	SetCheckKey(key *base.PermissionCheckRequest, decision *base.PermissionCheckResponse) bool
 	// GetCheckKey gets the value for the given key.
 	GetCheckKey(key *base.PermissionCheckRequest) (*base.PermissionCheckResponse, bool)
+	// InvalidateCheckKeys invalidates the check keys of the given relations and actions, or of the whole tenant.
+	InvalidateCheckKeys(tenantID string, references ...*base.RelationReference)
 }
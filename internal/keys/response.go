@@ -0,0 +1,87 @@
+package keys
+
+import (
+	"encoding/binary"
+	"sync"
+	"sync/atomic"
+	
+	"github.com/cespare/xxhash"
+	"google.golang.org/protobuf/proto"
+	
+	"github.com/adminium/permify/pkg/cache"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// ResponseKeys - Caches the responses of the read-only permission requests other than check. It is the key manager
+// of the checks as well, so the invalidations of the cached checks reach the cached responses through the same
+// hooks. A response is keyed by its whole request, which holds the snapshot and the schema version it was read at,
+// and by the generations of the tenant and of the relation or action it depends on.
+type ResponseKeys struct {
+	CommandKeyManager
+	cache cache.Cache
+	// generations of tenants and of their relations and actions, as the ones of the check keys
+	generations sync.Map
+}
+
+// NewResponseKeys - Caches the responses in the cache, the checks are delegated to the key manager
+func NewResponseKeys(km CommandKeyManager, c cache.Cache) *ResponseKeys {
+	return &ResponseKeys{
+		CommandKeyManager: km,
+		cache:             c,
+	}
+}
+
+// SetResponse - Sets the response of the request of the tenant that depends on the reference, on every relation of
+// the tenant when it is nil
+func (c *ResponseKeys) SetResponse(tenantID string, reference *base.RelationReference, request proto.Message, response interface{}, cost int64) bool {
+	k, size, ok := c.responseKey(tenantID, reference, request)
+	if !ok {
+		return false
+	}
+	return c.cache.Set(k, response, int64(size)+cost)
+}
+
+// GetResponse - Gets the response of the request of the tenant that depends on the reference
+func (c *ResponseKeys) GetResponse(tenantID string, reference *base.RelationReference, request proto.Message) (interface{}, bool) {
+	k, _, ok := c.responseKey(tenantID, reference, request)
+	if !ok {
+		return nil, false
+	}
+	return c.cache.Get(k)
+}
+
+// InvalidateCheckKeys - Invalidates the responses and the check keys of the given relations and actions of the
+// tenant, every one of the tenant without references
+func (c *ResponseKeys) InvalidateCheckKeys(tenantID string, references ...*base.RelationReference) {
+	if len(references) == 0 {
+		atomic.AddUint64(c.generation(tenantID), 1)
+	}
+	for _, reference := range references {
+		atomic.AddUint64(c.generation(generationKey(tenantID, reference.GetType(), reference.GetRelation())), 1)
+	}
+	c.CommandKeyManager.InvalidateCheckKeys(tenantID, references...)
+}
+
+// generation - Gets the generation counter of the key, creating it on first use
+func (c *ResponseKeys) generation(key string) *uint64 {
+	if g, ok := c.generations.Load(key); ok {
+		return g.(*uint64)
+	}
+	g, _ := c.generations.LoadOrStore(key, new(uint64))
+	return g.(*uint64)
+}
+
+// responseKey - Hash of the deterministic encoding of the request with the generations, size is the length of the
+// encoded key
+func (c *ResponseKeys) responseKey(tenantID string, reference *base.RelationReference, request proto.Message) (uint64, int, bool) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(request)
+	if err != nil {
+		return 0, 0, false
+	}
+	b = appendString(b, string(request.ProtoReflect().Descriptor().FullName()))
+	b = binary.LittleEndian.AppendUint64(b, atomic.LoadUint64(c.generation(tenantID)))
+	if reference != nil {
+		b = binary.LittleEndian.AppendUint64(b, atomic.LoadUint64(c.generation(generationKey(tenantID, reference.GetType(), reference.GetRelation()))))
+	}
+	return xxhash.Sum64(b), len(b), true
+}
@@ -0,0 +1,158 @@
+package keys
+
+import (
+	"strconv"
+	"time"
+	
+	"google.golang.org/protobuf/proto"
+	
+	"github.com/adminium/permify/pkg/cache"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// SharedCache - Cache that every replica reaches, e.g. redis. The entries are byte slices and the counters are
+// shared by the replicas too.
+type SharedCache interface {
+	cache.Cache
+	cache.TTLCache
+	Incr(key string) (uint64, error)
+	Counters(keys ...string) ([]uint64, error)
+}
+
+// SharedCommandKeys - Check results in a cache the replicas share. The generations that invalidate the keys are
+// counters of the cache, so a write on one replica invalidates the results that every replica cached.
+type SharedCommandKeys struct {
+	cache     SharedCache
+	namespace string
+	ttl       func(tenantID string) time.Duration
+}
+
+// NewSharedCheckCommandKeys - Creates the check keys of a shared cache, the options are the ones of the in-process keys
+func NewSharedCheckCommandKeys(c SharedCache, opts ...CommandKeysOption) CommandKeyManager {
+	options := &CommandKeys{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return &SharedCommandKeys{
+		cache:     c,
+		namespace: options.namespace,
+		ttl:       options.ttl,
+	}
+}
+
+// SetCheckKey - Sets the value for the given key, the ttl of the tenant or else the one of the cache applies
+func (c *SharedCommandKeys) SetCheckKey(key *base.PermissionCheckRequest, value *base.PermissionCheckResponse) bool {
+	k, ok := c.checkKey(key)
+	if !ok {
+		return false
+	}
+	b, err := proto.Marshal(value)
+	if err != nil {
+		return false
+	}
+	if c.ttl != nil {
+		if ttl := c.ttl(key.GetTenantId()); ttl > 0 {
+			return c.cache.SetWithTTL(k, b, int64(len(b)), ttl)
+		}
+	}
+	return c.cache.Set(k, b, int64(len(b)))
+}
+
+// GetCheckKey - Gets the value for the given key, the shared cache being unavailable is a miss
+func (c *SharedCommandKeys) GetCheckKey(key *base.PermissionCheckRequest) (*base.PermissionCheckResponse, bool) {
+	k, ok := c.checkKey(key)
+	if !ok {
+		return nil, false
+	}
+	raw, found := c.cache.Get(k)
+	if !found {
+		return nil, false
+	}
+	b, ok := raw.([]byte)
+	if !ok {
+		return nil, false
+	}
+	response := &base.PermissionCheckResponse{}
+	if err := proto.Unmarshal(b, response); err != nil {
+		return nil, false
+	}
+	return response, true
+}
+
+// InvalidateCheckKeys - Bumps the shared generations of the given relations and actions, or of the whole tenant
+func (c *SharedCommandKeys) InvalidateCheckKeys(tenantID string, references ...*base.RelationReference) {
+	if len(references) == 0 {
+		_, _ = c.cache.Incr(c.generationKey(tenantID))
+		return
+	}
+	for _, reference := range references {
+		_, _ = c.cache.Incr(c.generationKey(generationKey(tenantID, reference.GetType(), reference.GetRelation())))
+	}
+}
+
+// checkKey - Builds the hashed check key with the shared generations, false when they cannot be read
+func (c *SharedCommandKeys) checkKey(key *base.PermissionCheckRequest) (string, bool) {
+	generations, err := c.cache.Counters(
+		c.generationKey(key.GetTenantId()),
+		c.generationKey(generationKey(key.GetTenantId(), key.GetEntity().GetType(), key.GetPermission())),
+	)
+	if err != nil || len(generations) != 2 {
+		return "", false
+	}
+	sum, _ := hashCheckKey("", key, generations[0], generations[1])
+	return c.prefix() + "check:" + strconv.FormatUint(sum, 16), true
+}
+
+// generationKey - Key of the shared generation counter
+func (c *SharedCommandKeys) generationKey(key string) string {
+	return c.prefix() + "generation:" + key
+}
+
+// prefix - Namespace of the keys, the region and the cache epoch when they are given
+func (c *SharedCommandKeys) prefix() string {
+	if c.namespace != "" {
+		return c.namespace + ":"
+	}
+	return ""
+}
+
+// TieredCommandKeys - In-process check keys in front of shared ones. Results found in the shared cache are copied
+// to the in-process one; invalidations only reach the in-process caches of the replica that wrote, so the ttl of
+// the tenants bounds how long the other replicas serve a result from their own cache.
+type TieredCommandKeys struct {
+	local  CommandKeyManager
+	shared CommandKeyManager
+}
+
+// NewTieredCheckCommandKeys - Creates the check keys of a cache with two levels
+func NewTieredCheckCommandKeys(local, shared CommandKeyManager) CommandKeyManager {
+	return &TieredCommandKeys{
+		local:  local,
+		shared: shared,
+	}
+}
+
+// SetCheckKey - Sets the value in both levels
+func (c *TieredCommandKeys) SetCheckKey(key *base.PermissionCheckRequest, value *base.PermissionCheckResponse) bool {
+	local := c.local.SetCheckKey(key, value)
+	shared := c.shared.SetCheckKey(key, value)
+	return local || shared
+}
+
+// GetCheckKey - Gets the value from the in-process level first
+func (c *TieredCommandKeys) GetCheckKey(key *base.PermissionCheckRequest) (*base.PermissionCheckResponse, bool) {
+	if value, ok := c.local.GetCheckKey(key); ok {
+		return value, true
+	}
+	value, ok := c.shared.GetCheckKey(key)
+	if ok {
+		c.local.SetCheckKey(key, value)
+	}
+	return value, ok
+}
+
+// InvalidateCheckKeys - Invalidates the keys in both levels
+func (c *TieredCommandKeys) InvalidateCheckKeys(tenantID string, references ...*base.RelationReference) {
+	c.local.InvalidateCheckKeys(tenantID, references...)
+	c.shared.InvalidateCheckKeys(tenantID, references...)
+}
@@ -0,0 +1,171 @@
+package keys
+
+import (
+	"testing"
+	"time"
+	
+	"github.com/alicebob/miniredis/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	
+	"github.com/adminium/permify/pkg/cache/redis"
+	"github.com/adminium/permify/pkg/cache/ristretto"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// TestKeys -
+func TestKeys(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "keys-suite")
+}
+
+var _ = Describe("keys", func() {
+	var server *miniredis.Miniredis
+	var shared *redis.Redis
+	
+	request := &base.PermissionCheckRequest{
+		TenantId:   "t1",
+		Metadata:   &base.PermissionCheckRequestMetadata{SchemaVersion: "v1"},
+		Entity:     &base.Entity{Type: "doc", Id: "1"},
+		Permission: "read",
+		Subject:    &base.Subject{Type: "user", Id: "1"},
+	}
+	allowed := &base.PermissionCheckResponse{Can: base.PermissionCheckResponse_RESULT_ALLOWED}
+	
+	BeforeEach(func() {
+		var err error
+		server, err = miniredis.Run()
+		Expect(err).ShouldNot(HaveOccurred())
+		shared, err = redis.New(server.Addr(), redis.Timeout(time.Second))
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+	
+	AfterEach(func() {
+		shared.Close()
+		server.Close()
+	})
+	
+	Context("Shared", func() {
+		It("Case 1: Replicas share the results and their invalidations", func() {
+			a := NewSharedCheckCommandKeys(shared)
+			b := NewSharedCheckCommandKeys(shared)
+			
+			Expect(a.SetCheckKey(request, allowed)).Should(BeTrue())
+			
+			value, ok := b.GetCheckKey(request)
+			Expect(ok).Should(BeTrue())
+			Expect(value.GetCan()).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+			
+			b.InvalidateCheckKeys("t1", &base.RelationReference{Type: "doc", Relation: "read"})
+			_, ok = a.GetCheckKey(request)
+			Expect(ok).Should(BeFalse())
+		})
+		
+		It("Case 2: The ttl of the tenant expires the results", func() {
+			keys := NewSharedCheckCommandKeys(shared, TenantTTL(func(string) time.Duration { return time.Minute }))
+			Expect(keys.SetCheckKey(request, allowed)).Should(BeTrue())
+			
+			server.FastForward(2 * time.Minute)
+			_, ok := keys.GetCheckKey(request)
+			Expect(ok).Should(BeFalse())
+		})
+		
+		It("Case 3: An unavailable redis misses", func() {
+			keys := NewSharedCheckCommandKeys(shared)
+			server.Close()
+			
+			Expect(keys.SetCheckKey(request, allowed)).Should(BeFalse())
+			_, ok := keys.GetCheckKey(request)
+			Expect(ok).Should(BeFalse())
+		})
+	})
+	
+	Context("Tiered", func() {
+		It("Case 1: Results of the shared level are copied to the local one", func() {
+			local, err := ristretto.New()
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			NewSharedCheckCommandKeys(shared).SetCheckKey(request, allowed)
+			
+			keys := NewTieredCheckCommandKeys(NewCheckCommandKeys(local), NewSharedCheckCommandKeys(shared))
+			_, ok := keys.GetCheckKey(request)
+			Expect(ok).Should(BeTrue())
+			
+			local.Wait()
+			server.FlushAll()
+			value, ok := keys.GetCheckKey(request)
+			Expect(ok).Should(BeTrue())
+			Expect(value.GetCan()).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+		})
+	})
+	
+	Context("Metrics", func() {
+		It("Case 1: The hit ratio counts the lookups that found their results", func() {
+			local, err := ristretto.New()
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			keys := NewCheckCommandKeys(local).(*CommandKeys)
+			Expect(keys.HitRatio()).Should(BeZero())
+			
+			_, ok := keys.GetCheckKey(request)
+			Expect(ok).Should(BeFalse())
+			
+			keys.SetCheckKey(request, allowed)
+			local.Wait()
+			
+			_, ok = keys.GetCheckKey(request)
+			Expect(ok).Should(BeTrue())
+			_, ok = keys.GetCheckKey(request)
+			Expect(ok).Should(BeTrue())
+			_, ok = keys.GetCheckKey(request)
+			Expect(ok).Should(BeTrue())
+			
+			Expect(keys.HitRatio()).Should(Equal(0.75))
+		})
+	})
+	
+	Context("Encoding", func() {
+		It("Case 1: Keys are hashed without allocations", func() {
+			sum, _ := hashCheckKey("", request, 0, 0)
+			
+			// users have no relation in their keys, subject sets do
+			user := &base.PermissionCheckRequest{
+				TenantId:   "t1",
+				Metadata:   &base.PermissionCheckRequestMetadata{SchemaVersion: "v1"},
+				Entity:     &base.Entity{Type: "doc", Id: "1"},
+				Permission: "read",
+				Subject:    &base.Subject{Type: "user", Id: "1", Relation: "..."},
+			}
+			other, _ := hashCheckKey("", user, 0, 0)
+			Expect(other).Should(Equal(sum))
+			
+			team := &base.PermissionCheckRequest{
+				TenantId:   "t1",
+				Metadata:   &base.PermissionCheckRequestMetadata{SchemaVersion: "v1"},
+				Entity:     &base.Entity{Type: "doc", Id: "1"},
+				Permission: "read",
+				Subject:    &base.Subject{Type: "team", Id: "1", Relation: "member"},
+			}
+			other, _ = hashCheckKey("", team, 0, 0)
+			Expect(other).ShouldNot(Equal(sum))
+			
+			// the lengths in front of the fields keep their boundaries apart
+			shifted := &base.PermissionCheckRequest{
+				TenantId:   "t",
+				Metadata:   &base.PermissionCheckRequestMetadata{SchemaVersion: "1v1"},
+				Entity:     &base.Entity{Type: "doc", Id: "1"},
+				Permission: "read",
+				Subject:    &base.Subject{Type: "user", Id: "1"},
+			}
+			other, _ = hashCheckKey("", shifted, 0, 0)
+			Expect(other).ShouldNot(Equal(sum))
+			
+			other, _ = hashCheckKey("", request, 1, 0)
+			Expect(other).ShouldNot(Equal(sum))
+			
+			Expect(testing.AllocsPerRun(100, func() {
+				hashCheckKey("eu.1", request, 1, 2)
+			})).Should(BeZero())
+		})
+	})
+})
@@ -0,0 +1,109 @@
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// Class - Class of the endpoints that share a rate
+type Class string
+
+const (
+	// Read - reads of tuples, schemas, attributes and the other endpoints that do not write nor check
+	Read Class = "read"
+	// Write - writes and deletes of tuples, schemas, tenants and their data
+	Write Class = "write"
+	// Check - permission checks, single or in bulk
+	Check Class = "check"
+)
+
+// sweepInterval - how often the buckets that refilled are dropped
+const sweepInterval = time.Minute
+
+// Limiter - Token buckets of the callers, a caller is a tenant and the api key that calls it. Every class of endpoints
+// has a bucket of its own, which refills at the rate of the class and holds up to the burst. The classes without a
+// rate are not limited.
+type Limiter struct {
+	rates map[Class]float64
+	burst int
+	
+	mu      sync.Mutex
+	buckets map[bucketKey]*bucket
+	swept   time.Time
+	now     func() time.Time
+}
+
+// bucketKey -
+type bucketKey struct {
+	class    Class
+	tenantID string
+	apiKey   string
+}
+
+// bucket - tokens is the number of requests left at last
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// New - Creates new limiter with the requests per second of the classes, a burst that is not positive holds a second
+// of requests
+func New(rates map[Class]float64, burst int) *Limiter {
+	return &Limiter{
+		rates:   rates,
+		burst:   burst,
+		buckets: map[bucketKey]*bucket{},
+		now:     time.Now,
+	}
+}
+
+// Allow - Takes a token from the bucket of the caller, false when the bucket is empty
+func (l *Limiter) Allow(class Class, tenantID, apiKey string) bool {
+	rate := l.rates[class]
+	if rate <= 0 {
+		return true
+	}
+	capacity := l.capacity(rate)
+	
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	
+	now := l.now()
+	if now.Sub(l.swept) >= sweepInterval {
+		l.sweep(now)
+	}
+	
+	key := bucketKey{class: class, tenantID: tenantID, apiKey: apiKey}
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: capacity, last: now}
+		l.buckets[key] = b
+	}
+	b.tokens = math.Min(capacity, b.tokens+now.Sub(b.last).Seconds()*rate)
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// capacity - Number of tokens a bucket of the rate holds
+func (l *Limiter) capacity(rate float64) float64 {
+	if l.burst > 0 {
+		return float64(l.burst)
+	}
+	return math.Max(1, math.Ceil(rate))
+}
+
+// sweep - Drops the buckets that refilled since they were last taken from, a new bucket starts full anyway
+func (l *Limiter) sweep(now time.Time) {
+	for key, b := range l.buckets {
+		rate := l.rates[key.class]
+		if b.tokens+now.Sub(b.last).Seconds()*rate >= l.capacity(rate) {
+			delete(l.buckets, key)
+		}
+	}
+	l.swept = now
+}
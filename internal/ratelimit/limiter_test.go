@@ -0,0 +1,65 @@
+package ratelimit
+
+import (
+	"time"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("limiter", func() {
+	var now time.Time
+	
+	BeforeEach(func() {
+		now = time.Date(2023, 2, 12, 0, 0, 0, 0, time.UTC)
+	})
+	
+	Context("Allow", func() {
+		var limiter *Limiter
+		
+		BeforeEach(func() {
+			limiter = New(map[Class]float64{Write: 2, Check: 10}, 2)
+			limiter.now = func() time.Time { return now }
+		})
+		
+		It("Case 1: The bucket of a caller is drained and refilled at the rate of its class", func() {
+			Expect(limiter.Allow(Write, "t1", "key")).Should(BeTrue())
+			Expect(limiter.Allow(Write, "t1", "key")).Should(BeTrue())
+			Expect(limiter.Allow(Write, "t1", "key")).Should(BeFalse(), "write over the burst is allowed")
+			
+			// a token is added every half second
+			now = now.Add(500 * time.Millisecond)
+			Expect(limiter.Allow(Write, "t1", "key")).Should(BeTrue())
+			Expect(limiter.Allow(Write, "t1", "key")).Should(BeFalse(), "write over the refill is allowed")
+		})
+		
+		It("Case 2: The other tenants, keys and classes have buckets of their own", func() {
+			Expect(limiter.Allow(Write, "t1", "key")).Should(BeTrue())
+			Expect(limiter.Allow(Write, "t1", "key")).Should(BeTrue())
+			
+			Expect(limiter.Allow(Write, "t2", "key")).Should(BeTrue())
+			Expect(limiter.Allow(Write, "t1", "other")).Should(BeTrue())
+			Expect(limiter.Allow(Check, "t1", "key")).Should(BeTrue())
+		})
+		
+		It("Case 3: The classes without a rate are not limited", func() {
+			for i := 0; i < 100; i++ {
+				Expect(limiter.Allow(Read, "t1", "key")).Should(BeTrue())
+			}
+		})
+	})
+	
+	Context("Sweep", func() {
+		It("Case 1: The buckets that refilled are dropped, the ones that did not keep their tokens", func() {
+			limiter := New(map[Class]float64{Write: 0.01}, 1)
+			limiter.now = func() time.Time { return now }
+			
+			limiter.Allow(Write, "t1", "key")
+			now = now.Add(sweepInterval)
+			limiter.Allow(Write, "t2", "key")
+			
+			Expect(limiter.buckets).Should(HaveLen(2))
+			Expect(limiter.Allow(Write, "t1", "key")).Should(BeFalse(), "the bucket of t1 was refilled by the sweep")
+		})
+	})
+})
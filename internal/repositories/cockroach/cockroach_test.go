@@ -0,0 +1,13 @@
+package cockroach
+
+import (
+	"testing"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestCockroach(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cockroach-suite")
+}
@@ -0,0 +1,14 @@
+package cockroach
+
+const (
+	RelationTuplesTable = "relation_tuples"
+	TransactionsTable   = "transactions"
+)
+
+const (
+	_defaultMaxTuplesPerWrite      = 100
+	_defaultMaxTuplesPerBatchWrite = 10000
+	// _defaultInsertBatchSize - rows of a single insert statement
+	_defaultInsertBatchSize = 1000
+	_defaultMaxRetries      = 10
+)
@@ -0,0 +1,86 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS tenants (
+    id         VARCHAR NOT NULL,
+    name       VARCHAR NOT NULL,
+    created_at TIMESTAMP DEFAULT (now() AT TIME ZONE 'UTC') NOT NULL,
+    CONSTRAINT pk_tenants PRIMARY KEY (id)
+);
+
+INSERT INTO tenants (id, name) VALUES ('t1', 'example tenant');
+
+-- created_tx_id and expired_tx_id are the commit timestamps of the transactions, cluster_logical_timestamp()
+CREATE TABLE IF NOT EXISTS relation_tuples (
+    id               INT8      DEFAULT unique_rowid() NOT NULL,
+    tenant_id        VARCHAR   NOT NULL,
+    entity_type      VARCHAR   NOT NULL,
+    entity_id        VARCHAR   NOT NULL,
+    relation         VARCHAR   NOT NULL,
+    subject_type     VARCHAR   NOT NULL,
+    subject_id       VARCHAR   NOT NULL,
+    subject_relation VARCHAR   NOT NULL,
+    created_tx_id    DECIMAL   NOT NULL,
+    expired_tx_id    DECIMAL   NOT NULL DEFAULT 0,
+    created_at       TIMESTAMP DEFAULT (now() AT TIME ZONE 'UTC') NOT NULL,
+    created_by       VARCHAR   NOT NULL DEFAULT '',
+    reference        VARCHAR   NOT NULL DEFAULT '',
+    schema_version   VARCHAR   NOT NULL DEFAULT '',
+    not_before       TIMESTAMP NULL,
+    not_after        TIMESTAMP NULL,
+    CONSTRAINT pk_relation_tuple PRIMARY KEY (id),
+    CONSTRAINT uq_relation_tuple_not_expired UNIQUE (tenant_id, entity_type, entity_id, relation, subject_type, subject_id, subject_relation, expired_tx_id),
+    INDEX idx_tuples_subject (tenant_id, subject_type, subject_id, subject_relation, entity_type, relation),
+    INDEX idx_tuples_entity (tenant_id, entity_type, entity_id, relation)
+);
+
+CREATE TABLE IF NOT EXISTS transactions (
+    tenant_id VARCHAR   NOT NULL,
+    id        DECIMAL   NOT NULL,
+    timestamp TIMESTAMP DEFAULT (now() AT TIME ZONE 'UTC') NOT NULL,
+    CONSTRAINT pk_transaction PRIMARY KEY (tenant_id, id)
+);
+
+CREATE TABLE IF NOT EXISTS schema_definitions (
+    tenant_id             VARCHAR  NOT NULL,
+    entity_type           VARCHAR  NOT NULL,
+    serialized_definition BYTEA    NOT NULL,
+    version               CHAR(20) NOT NULL,
+    CONSTRAINT pk_schema_definition PRIMARY KEY (tenant_id, entity_type, version)
+);
+
+CREATE TABLE IF NOT EXISTS identities (
+    tenant_id    VARCHAR NOT NULL,
+    subject_type VARCHAR NOT NULL,
+    alias        VARCHAR NOT NULL,
+    subject_id   VARCHAR NOT NULL,
+    created_at   TIMESTAMP DEFAULT (now() AT TIME ZONE 'UTC') NOT NULL,
+    CONSTRAINT pk_identities PRIMARY KEY (tenant_id, subject_type, alias)
+);
+
+CREATE TABLE IF NOT EXISTS attributes (
+    tenant_id   VARCHAR NOT NULL,
+    entity_type VARCHAR NOT NULL,
+    entity_id   VARCHAR NOT NULL,
+    attribute   VARCHAR NOT NULL,
+    value       JSONB   NOT NULL,
+    created_at  TIMESTAMP DEFAULT (now() AT TIME ZONE 'UTC') NOT NULL,
+    CONSTRAINT pk_attributes PRIMARY KEY (tenant_id, entity_type, entity_id, attribute)
+);
+
+CREATE TABLE IF NOT EXISTS tenant_settings (
+    tenant_id         VARCHAR NOT NULL,
+    strict_validation BOOLEAN NOT NULL DEFAULT TRUE,
+    wildcards         BOOLEAN NOT NULL DEFAULT FALSE,
+    cache_ttl         BIGINT  NOT NULL DEFAULT 0,
+    depth             INTEGER NOT NULL DEFAULT 0,
+    updated_at        TIMESTAMP DEFAULT (now() AT TIME ZONE 'UTC') NOT NULL,
+    CONSTRAINT pk_tenant_settings PRIMARY KEY (tenant_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS tenant_settings;
+DROP TABLE IF EXISTS attributes;
+DROP TABLE IF EXISTS identities;
+DROP TABLE IF EXISTS schema_definitions;
+DROP TABLE IF EXISTS transactions;
+DROP TABLE IF EXISTS relation_tuples;
+DROP TABLE IF EXISTS tenants;
@@ -0,0 +1,24 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS check_traces (
+   id             VARCHAR NOT NULL,
+   tenant_id      VARCHAR NOT NULL,
+   entity         VARCHAR NOT NULL,
+   permission     VARCHAR NOT NULL,
+   subject        VARCHAR NOT NULL,
+   schema_version VARCHAR NOT NULL DEFAULT '',
+   snap_token     VARCHAR NOT NULL DEFAULT '',
+   depth          INTEGER NOT NULL DEFAULT 0,
+   result         VARCHAR NOT NULL DEFAULT '',
+   error          VARCHAR NOT NULL DEFAULT '',
+   steps          JSONB   NOT NULL DEFAULT '[]',
+   tuples         JSONB   NOT NULL DEFAULT '[]',
+   duration       BIGINT  NOT NULL DEFAULT 0,
+   created_at     TIMESTAMP DEFAULT (now() AT TIME ZONE 'UTC') NOT NULL,
+   CONSTRAINT pk_check_traces PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_check_traces_tenant_created ON check_traces (tenant_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_check_traces_created ON check_traces (created_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS check_traces;
@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS decisions (
+   id             BIGSERIAL,
+   created_at     TIMESTAMP NOT NULL,
+   operation      VARCHAR NOT NULL,
+   tenant_id      VARCHAR NOT NULL,
+   entity         VARCHAR NOT NULL,
+   permission     VARCHAR NOT NULL,
+   subject        VARCHAR NOT NULL DEFAULT '',
+   schema_version VARCHAR NOT NULL DEFAULT '',
+   snap_token     VARCHAR NOT NULL DEFAULT '',
+   result         VARCHAR NOT NULL DEFAULT '',
+   result_count   INTEGER NOT NULL DEFAULT 0,
+   latency_ms     DOUBLE PRECISION NOT NULL DEFAULT 0,
+   trace_id       VARCHAR NOT NULL DEFAULT '',
+   CONSTRAINT pk_decisions PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_decisions_tenant_created ON decisions (tenant_id, created_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS decisions;
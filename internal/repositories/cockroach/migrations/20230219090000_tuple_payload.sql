@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE relation_tuples
+    ADD COLUMN IF NOT EXISTS payload JSONB NULL;
+
+-- +goose Down
+ALTER TABLE relation_tuples
+    DROP COLUMN IF EXISTS payload;
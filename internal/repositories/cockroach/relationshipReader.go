@@ -0,0 +1,490 @@
+package cockroach
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	
+	"github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel/codes"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/internal/repositories/cockroach/snapshot"
+	"github.com/adminium/permify/internal/repositories/cockroach/types"
+	"github.com/adminium/permify/internal/repositories/cockroach/utils"
+	pqutils "github.com/adminium/permify/internal/repositories/postgres/utils"
+	"github.com/adminium/permify/pkg/database"
+	db "github.com/adminium/permify/pkg/database/cockroach"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/token"
+)
+
+// RelationshipReader - Reads the relation tuples at the commit timestamps of their transactions. A tuple is visible
+// at every later timestamp until it is deleted, so each read is a single statement and needs no transaction.
+type RelationshipReader struct {
+	database *db.Cockroach
+	// logger
+	logger logger.Interface
+}
+
+// NewRelationshipReader - Creates a new RelationshipReader
+func NewRelationshipReader(database *db.Cockroach, logger logger.Interface) *RelationshipReader {
+	return &RelationshipReader{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// QueryRelationships - Query relationships for a given filter. With follower reads the table is read as of the
+// snapshot, revisions that were garbage collected are read at the present time, which returns the same tuples.
+func (r *RelationshipReader) QueryRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (it *database.TupleIterator, err error) {
+	ctx, span := tracer.Start(ctx, "relationship-reader.query-relationships")
+	defer span.End()
+	
+	var st token.SnapToken
+	st, err = snapshot.EncodedToken{Value: snap}.Decode()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	revision := st.(snapshot.Token).Value
+	
+	var collection *database.TupleCollection
+	followerReads := r.database.FollowerReads && !revision.IsZero()
+	collection, err = r.queryRelationships(ctx, tenantID, filter, revision, followerReads)
+	if err != nil && followerReads && utils.IsBeforeGCThreshold(err) {
+		collection, err = r.queryRelationships(ctx, tenantID, filter, revision, false)
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	
+	return collection.CreateTupleIterator(), nil
+}
+
+// queryRelationships -
+func (r *RelationshipReader) queryRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, revision types.HLC, asOf bool) (*database.TupleCollection, error) {
+	query, args, err := r.queryRelationshipsBuilder(tenantID, filter, revision, asOf).ToSql()
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+	}
+	
+	var rows *sql.Rows
+	rows, err = r.database.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	
+	collection := database.NewTupleCollection()
+	for rows.Next() {
+		rt := repositories.RelationTuple{}
+		var payload sql.NullString
+		err = rows.Scan(&rt.EntityType, &rt.EntityID, &rt.Relation, &rt.SubjectType, &rt.SubjectID, &rt.SubjectRelation, &payload)
+		if err != nil {
+			return nil, err
+		}
+		if rt.Payload, err = pqutils.Payload(payload); err != nil {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		collection.Add(rt.ToTuple())
+	}
+	return collection, rows.Err()
+}
+
+// queryRelationshipsBuilder - Select of the tuples of the filter that are visible at the revision
+func (r *RelationshipReader) queryRelationshipsBuilder(tenantID string, filter *base.TupleFilter, revision types.HLC, asOf bool) squirrel.SelectBuilder {
+	table := RelationTuplesTable
+	if asOf {
+		table = utils.AsOfSystemTime(table, revision)
+	}
+	builder := r.database.Builder.Select("entity_type, entity_id, relation, subject_type, subject_id, subject_relation, payload").From(table).Where(squirrel.Eq{"tenant_id": tenantID})
+	builder = pqutils.FilterQueryForSelectBuilder(builder, filter)
+	
+	builder = utils.SnapshotQuery(builder, revision)
+	return utils.ValidityQuery(builder, revision)
+}
+
+// ExplainQuery - Generated sql of the filter and its execution plan, the query itself is not run
+func (r *RelationshipReader) ExplainQuery(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (plan repositories.QueryPlan, err error) {
+	ctx, span := tracer.Start(ctx, "relationship-reader.explain-query")
+	defer span.End()
+	
+	var st token.SnapToken
+	st, err = snapshot.EncodedToken{Value: snap}.Decode()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return plan, err
+	}
+	
+	var args []interface{}
+	plan.Engine = "cockroach"
+	plan.Query, args, err = r.queryRelationshipsBuilder(tenantID, filter, st.(snapshot.Token).Value, false).ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return plan, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+	}
+	for _, arg := range args {
+		plan.Args = append(plan.Args, fmt.Sprint(arg))
+	}
+	
+	var rows *sql.Rows
+	rows, err = r.database.DB.QueryContext(ctx, "EXPLAIN "+plan.Query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return plan, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	defer rows.Close()
+	
+	for rows.Next() {
+		var line string
+		if err = rows.Scan(&line); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return plan, errors.New(base.ErrorCode_ERROR_CODE_SCAN.String())
+		}
+		plan.Plan = append(plan.Plan, line)
+		if strings.Contains(line, "FULL SCAN") {
+			plan.FullScan = true
+		}
+	}
+	
+	return plan, rows.Err()
+}
+
+// ReadRelationships - Read relationships for a given filter and pagination
+func (r *RelationshipReader) ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, pagination database.Pagination) (collection *database.TupleCollection, ct database.EncodedContinuousToken, err error) {
+	ctx, span := tracer.Start(ctx, "relationship-reader.read-relationships")
+	defer span.End()
+	
+	var st token.SnapToken
+	st, err = snapshot.EncodedToken{Value: snap}.Decode()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+	
+	builder := r.database.Builder.Select("id, entity_type, entity_id, relation, subject_type, subject_id, subject_relation, created_at, created_by, reference, schema_version, not_before, not_after, payload").From(RelationTuplesTable).Where(squirrel.Eq{"tenant_id": tenantID})
+	builder = pqutils.FilterQueryForSelectBuilder(builder, filter)
+	
+	builder = utils.SnapshotQuery(builder, st.(snapshot.Token).Value)
+	builder = utils.ValidityQuery(builder, st.(snapshot.Token).Value)
+	
+	if pagination.Token() != "" {
+		var t database.ContinuousToken
+		t, err = pqutils.EncodedContinuousToken{Value: pagination.Token()}.Decode()
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, nil, err
+		}
+		var v int64
+		v, err = strconv.ParseInt(t.(pqutils.ContinuousToken).Value, 10, 64)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN.String())
+		}
+		builder = builder.Where(squirrel.GtOrEq{"id": v})
+	}
+	
+	builder = builder.OrderBy("id").Limit(uint64(pagination.PageSize() + 1))
+	
+	var query string
+	var args []interface{}
+	
+	query, args, err = builder.ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, pqutils.NewNoopContinuousToken().Encode(), errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+	}
+	
+	var rows *sql.Rows
+	rows, err = r.database.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, pqutils.NewNoopContinuousToken().Encode(), errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	defer rows.Close()
+	
+	// ids of cockroach are unique_rowid() values, which are positive int8s
+	var lastID int64
+	
+	tuples := make([]repositories.RelationTuple, 0, pagination.PageSize()+1)
+	for rows.Next() {
+		rt := repositories.RelationTuple{}
+		var id int64
+		var notBefore, notAfter sql.NullTime
+		var payload sql.NullString
+		err = rows.Scan(&id, &rt.EntityType, &rt.EntityID, &rt.Relation, &rt.SubjectType, &rt.SubjectID, &rt.SubjectRelation, &rt.CreatedAt, &rt.CreatedBy, &rt.Reference, &rt.SchemaVersion, &notBefore, &notAfter, &payload)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, nil, err
+		}
+		rt.ID = uint64(id)
+		rt.NotBefore, rt.NotAfter = notBefore.Time, notAfter.Time
+		if rt.Payload, err = pqutils.Payload(payload); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		lastID = id
+		tuples = append(tuples, rt)
+	}
+	if err = rows.Err(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+	
+	ct = pqutils.NewNoopContinuousToken().Encode()
+	if len(tuples) > int(pagination.PageSize()) {
+		tuples = tuples[:pagination.PageSize()]
+		ct = pqutils.NewContinuousToken(strconv.FormatInt(lastID, 10)).Encode()
+	}
+	
+	collection = database.NewTupleCollection()
+	for _, rt := range tuples {
+		collection.AddWithMetadata(rt.ToTuple(), rt.ToMetadata())
+	}
+	
+	return collection, ct, nil
+}
+
+// GetUniqueEntityIDsByEntityType - Gets all unique entity ids for a given entity type
+func (r *RelationshipReader) GetUniqueEntityIDsByEntityType(ctx context.Context, tenantID string, typ, snap string) (ids []string, err error) {
+	ctx, span := tracer.Start(ctx, "relationship-reader.get-unique-entity-ids-by-entity-type")
+	defer span.End()
+	
+	var st token.SnapToken
+	st, err = snapshot.EncodedToken{Value: snap}.Decode()
+	if err != nil {
+		return nil, err
+	}
+	
+	builder := r.database.Builder.Select("entity_id").Distinct().From(RelationTuplesTable).Where(squirrel.Eq{"entity_type": typ, "tenant_id": tenantID})
+	builder = utils.SnapshotQuery(builder, st.(snapshot.Token).Value)
+	builder = utils.ValidityQuery(builder, st.(snapshot.Token).Value)
+	
+	var query string
+	var args []interface{}
+	query, args, err = builder.ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+	}
+	
+	var rows *sql.Rows
+	rows, err = r.database.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	defer rows.Close()
+	
+	var result []string
+	for rows.Next() {
+		var id string
+		if err = rows.Scan(&id); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+		result = append(result, id)
+	}
+	if err = rows.Err(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	
+	return result, nil
+}
+
+// HeadSnapshot - Gets the latest token
+func (r *RelationshipReader) HeadSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error) {
+	ctx, span := tracer.Start(ctx, "relationship-reader.head-snapshot")
+	defer span.End()
+	
+	builder := r.database.Builder.Select("id").From(TransactionsTable).Where(squirrel.Eq{"tenant_id": tenantID}).OrderBy("id DESC").Limit(1)
+	st, err := r.snapshot(ctx, builder)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	return st, nil
+}
+
+// SnapshotAt - Gets the token of the latest transaction committed at or before the given time, the commit
+// timestamps are compared with the latest timestamp of the time
+func (r *RelationshipReader) SnapshotAt(ctx context.Context, tenantID string, at time.Time) (token.SnapToken, error) {
+	ctx, span := tracer.Start(ctx, "relationship-reader.snapshot-at")
+	defer span.End()
+	
+	latest := types.HLC{Wall: at.UnixNano(), Logical: math.MaxUint32}
+	builder := r.database.Builder.Select("id").From(TransactionsTable).Where(squirrel.Eq{"tenant_id": tenantID}).Where(squirrel.Expr(fmt.Sprintf("id <= %s", latest))).OrderBy("id DESC").Limit(1)
+	st, err := r.snapshot(ctx, builder)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	return st, nil
+}
+
+// snapshot - Token of the transaction the query selects, the zero token when it selects none
+func (r *RelationshipReader) snapshot(ctx context.Context, builder squirrel.SelectBuilder) (token.SnapToken, error) {
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+	}
+	
+	var hlc types.HLC
+	err = r.database.DB.QueryRowContext(ctx, query, args...).Scan(&hlc)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return snapshot.Token{Value: types.HLC{}}, nil
+		}
+		return nil, err
+	}
+	return snapshot.Token{Value: hlc}, nil
+}
+
+// ReadDeletedRelationships - Reads relationships whose deleting transaction was committed within [from, to)
+func (r *RelationshipReader) ReadDeletedRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, from, to time.Time) (collection *database.TupleCollection, err error) {
+	ctx, span := tracer.Start(ctx, "relationship-reader.read-deleted-relationships")
+	defer span.End()
+	
+	builder := r.database.Builder.
+		Select("entity_type, entity_id, relation, subject_type, subject_id, subject_relation, created_at, created_by, reference, schema_version, not_before, not_after, transactions.timestamp").
+		From(RelationTuplesTable).
+		Join(TransactionsTable + " ON relation_tuples.tenant_id = transactions.tenant_id AND relation_tuples.expired_tx_id = transactions.id").
+		Where(squirrel.Eq{"relation_tuples.tenant_id": tenantID}).
+		Where(squirrel.GtOrEq{"transactions.timestamp": from.UTC()}).
+		Where(squirrel.Lt{"transactions.timestamp": to.UTC()})
+	builder = pqutils.FilterQueryForSelectBuilder(builder, filter)
+	builder = builder.OrderBy("transactions.timestamp")
+	
+	collection, err = r.readChanges(ctx, builder, true)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	return collection, nil
+}
+
+// ReadRelationshipChanges - Reads relationships created and deleted between the snapshots of two tokens, from is exclusive and to is inclusive.
+// Tuples both created and deleted in between are in neither collection.
+func (r *RelationshipReader) ReadRelationshipChanges(ctx context.Context, tenantID string, filter *base.TupleFilter, from, to string) (created, deleted *database.TupleCollection, err error) {
+	ctx, span := tracer.Start(ctx, "relationship-reader.read-relationship-changes")
+	defer span.End()
+	
+	var fst, tst token.SnapToken
+	fst, err = snapshot.EncodedToken{Value: from}.Decode()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+	tst, err = snapshot.EncodedToken{Value: to}.Decode()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+	if tst.Lt(fst) {
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+	}
+	
+	fromRevision, toRevision := fst.(snapshot.Token).Value, tst.(snapshot.Token).Value
+	
+	builder := r.database.Builder.
+		Select("entity_type, entity_id, relation, subject_type, subject_id, subject_relation, created_at, created_by, reference, schema_version, not_before, not_after").
+		From(RelationTuplesTable).
+		Where(squirrel.Eq{"tenant_id": tenantID})
+	builder = pqutils.FilterQueryForSelectBuilder(builder, filter)
+	builder = utils.ChangesQuery(builder, "", fromRevision, toRevision).OrderBy("id")
+	
+	created, err = r.readChanges(ctx, builder, false)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+	
+	builder = r.database.Builder.
+		Select("entity_type, entity_id, relation, subject_type, subject_id, subject_relation, created_at, created_by, reference, schema_version, not_before, not_after, transactions.timestamp").
+		From(RelationTuplesTable).
+		Join(TransactionsTable + " ON relation_tuples.tenant_id = transactions.tenant_id AND relation_tuples.expired_tx_id = transactions.id").
+		Where(squirrel.Eq{"relation_tuples.tenant_id": tenantID})
+	builder = pqutils.FilterQueryForSelectBuilder(builder, filter)
+	builder = utils.ChangesQuery(builder, RelationTuplesTable, toRevision, fromRevision).OrderBy("relation_tuples.id")
+	
+	deleted, err = r.readChanges(ctx, builder, true)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+	
+	return created, deleted, nil
+}
+
+// readChanges - Runs a query of created or deleted tuples, deleted queries select the time of deletion as their
+// last column
+func (r *RelationshipReader) readChanges(ctx context.Context, builder squirrel.SelectBuilder, deleted bool) (*database.TupleCollection, error) {
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+	}
+	
+	var rows *sql.Rows
+	rows, err = r.database.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	defer rows.Close()
+	
+	collection := database.NewTupleCollection()
+	for rows.Next() {
+		rt := repositories.RelationTuple{}
+		var notBefore, notAfter sql.NullTime
+		dest := []interface{}{&rt.EntityType, &rt.EntityID, &rt.Relation, &rt.SubjectType, &rt.SubjectID, &rt.SubjectRelation, &rt.CreatedAt, &rt.CreatedBy, &rt.Reference, &rt.SchemaVersion, &notBefore, &notAfter}
+		var deletedAt time.Time
+		if deleted {
+			dest = append(dest, &deletedAt)
+		}
+		if err = rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		rt.NotBefore, rt.NotAfter = notBefore.Time, notAfter.Time
+		metadata := rt.ToMetadata()
+		metadata.DeletedAt = deletedAt
+		collection.AddWithMetadata(rt.ToTuple(), metadata)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	
+	return collection, nil
+}
@@ -0,0 +1,246 @@
+package cockroach
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	
+	"github.com/Masterminds/squirrel"
+	otelCodes "go.opentelemetry.io/otel/codes"
+	
+	"github.com/adminium/permify/internal/repositories/cockroach/snapshot"
+	"github.com/adminium/permify/internal/repositories/cockroach/types"
+	"github.com/adminium/permify/internal/repositories/cockroach/utils"
+	pqutils "github.com/adminium/permify/internal/repositories/postgres/utils"
+	"github.com/adminium/permify/pkg/database"
+	db "github.com/adminium/permify/pkg/database/cockroach"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/token"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+// _commitTimestamp - Commit timestamp of the current transaction, it takes the place of the transaction ids of
+// postgres in the created_tx_id and expired_tx_id columns
+const _commitTimestamp = "cluster_logical_timestamp()"
+
+// RelationshipWriter - Structure for Relationship Writer
+type RelationshipWriter struct {
+	database *db.Cockroach
+	// options
+	txOptions              sql.TxOptions
+	maxTuplesPerWrite      int
+	maxTuplesPerBatchWrite int
+	maxRetries             int
+	// logger
+	logger logger.Interface
+}
+
+// NewRelationshipWriter - Creates a new RelationshipWriter
+func NewRelationshipWriter(database *db.Cockroach, logger logger.Interface) *RelationshipWriter {
+	return &RelationshipWriter{
+		database:               database,
+		txOptions:              sql.TxOptions{Isolation: sql.LevelSerializable, ReadOnly: false},
+		maxTuplesPerWrite:      _defaultMaxTuplesPerWrite,
+		maxTuplesPerBatchWrite: _defaultMaxTuplesPerBatchWrite,
+		maxRetries:             _defaultMaxRetries,
+		logger:                 logger,
+	}
+}
+
+// WriteRelationships - Writes a collection of relationships to the database
+func (w *RelationshipWriter) WriteRelationships(ctx context.Context, tenantID string, collection *database.TupleCollection) (token token.EncodedSnapToken, err error) {
+	ctx, span := tracer.Start(ctx, "relationship-writer.write-relationships")
+	defer span.End()
+	
+	if len(collection.GetTuples()) > w.maxTuplesPerWrite {
+		return nil, errors.New("max tuples per write exceeded")
+	}
+	
+	return w.write(ctx, tenantID, collection)
+}
+
+// BatchWriteRelationships - Writes a large collection of relationships in one transaction, the rows are inserted
+// in several statements but share the transaction and so the snapshot token
+func (w *RelationshipWriter) BatchWriteRelationships(ctx context.Context, tenantID string, collection *database.TupleCollection) (token token.EncodedSnapToken, err error) {
+	ctx, span := tracer.Start(ctx, "relationship-writer.batch-write-relationships")
+	defer span.End()
+	
+	if len(collection.GetTuples()) > w.maxTuplesPerBatchWrite {
+		return nil, errors.New("max tuples per batch write exceeded")
+	}
+	
+	return w.write(ctx, tenantID, collection)
+}
+
+// write - Inserts the tuples and records the transaction, retrying when the transaction has to be restarted
+func (w *RelationshipWriter) write(ctx context.Context, tenantID string, collection *database.TupleCollection) (token token.EncodedSnapToken, err error) {
+	ctx, span := tracer.Start(ctx, "relationship-writer.write")
+	defer span.End()
+	
+	metadata := database.TupleMetadataFromContext(ctx)
+	
+	for i := 0; i <= w.maxRetries; i++ {
+		var tx *sql.Tx
+		tx, err = w.database.DB.BeginTx(ctx, &w.txOptions)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, err
+		}
+		
+		tuples := collection.GetTuples()
+		restart := false
+		for start := 0; start < len(tuples) && !restart; start += _defaultInsertBatchSize {
+			end := start + _defaultInsertBatchSize
+			if end > len(tuples) {
+				end = len(tuples)
+			}
+			
+			insertBuilder := w.database.Builder.Insert(RelationTuplesTable).Columns("entity_type, entity_id, relation, subject_type, subject_id, subject_relation, tenant_id, created_tx_id, created_by, reference, schema_version, not_before, not_after, payload")
+			for _, t := range tuples[start:end] {
+				var payload sql.NullString
+				payload, err = pqutils.NullPayload(t.GetPayload())
+				if err != nil {
+					pqutils.Rollback(tx, w.logger)
+					span.RecordError(err)
+					span.SetStatus(otelCodes.Error, err.Error())
+					return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+				}
+				notBefore, notAfter := tuple.Validity(t)
+				insertBuilder = insertBuilder.Values(t.GetEntity().GetType(), t.GetEntity().GetId(), t.GetRelation(), t.GetSubject().GetType(), t.GetSubject().GetId(), t.GetSubject().GetRelation(), tenantID, squirrel.Expr(_commitTimestamp), metadata.CreatedBy, metadata.Reference, metadata.SchemaVersion, pqutils.NullTime(notBefore), pqutils.NullTime(notAfter), payload)
+			}
+			
+			var query string
+			var args []interface{}
+			
+			query, args, err = insertBuilder.ToSql()
+			if err != nil {
+				pqutils.Rollback(tx, w.logger)
+				span.RecordError(err)
+				span.SetStatus(otelCodes.Error, err.Error())
+				return nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+			}
+			
+			_, err = tx.ExecContext(ctx, query, args...)
+			if err != nil {
+				pqutils.Rollback(tx, w.logger)
+				span.RecordError(err)
+				span.SetStatus(otelCodes.Error, err.Error())
+				if utils.IsRetryable(err) {
+					restart = true
+				} else if strings.Contains(err.Error(), "duplicate key value") {
+					return nil, errors.New(base.ErrorCode_ERROR_CODE_UNIQUE_CONSTRAINT.String())
+				} else {
+					return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+				}
+			}
+		}
+		if restart {
+			continue
+		}
+		
+		var hlc types.HLC
+		hlc, restart, err = w.commit(ctx, tx, tenantID)
+		if restart {
+			continue
+		}
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, err
+		}
+		
+		return snapshot.NewToken(hlc).Encode(), nil
+	}
+	
+	return nil, errors.New(base.ErrorCode_ERROR_CODE_ERROR_MAX_RETRIES.String())
+}
+
+// DeleteRelationships - Deletes a collection of relationships to the database
+func (w *RelationshipWriter) DeleteRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter) (token token.EncodedSnapToken, err error) {
+	ctx, span := tracer.Start(ctx, "relationship-writer.delete-relationships")
+	defer span.End()
+	
+	for i := 0; i <= w.maxRetries; i++ {
+		var tx *sql.Tx
+		tx, err = w.database.DB.BeginTx(ctx, &w.txOptions)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, err
+		}
+		
+		// only the live tuples of the tenant that match every field of the filter are deleted
+		builder := w.database.Builder.Update(RelationTuplesTable).Set("expired_tx_id", squirrel.Expr(_commitTimestamp)).Where(squirrel.Eq{"expired_tx_id": 0, "tenant_id": tenantID})
+		builder = pqutils.FilterQueryForUpdateBuilder(builder, filter)
+		
+		var query string
+		var args []interface{}
+		
+		query, args, err = builder.ToSql()
+		if err != nil {
+			pqutils.Rollback(tx, w.logger)
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+		}
+		
+		_, err = tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			pqutils.Rollback(tx, w.logger)
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			if utils.IsRetryable(err) {
+				continue
+			}
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+		
+		var hlc types.HLC
+		var restart bool
+		hlc, restart, err = w.commit(ctx, tx, tenantID)
+		if restart {
+			continue
+		}
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, err
+		}
+		
+		return snapshot.NewToken(hlc).Encode(), nil
+	}
+	
+	return nil, errors.New(base.ErrorCode_ERROR_CODE_ERROR_MAX_RETRIES.String())
+}
+
+// commit - Records the transaction of the tenant and commits it. Reading the commit timestamp pins the transaction
+// to it, so cockroach restarts the transaction instead of moving it to a later timestamp when it conflicts, and the
+// caller retries it.
+func (w *RelationshipWriter) commit(ctx context.Context, tx *sql.Tx, tenantID string) (hlc types.HLC, restart bool, err error) {
+	transaction := w.database.Builder.Insert(TransactionsTable).
+		Columns("id, tenant_id").
+		Values(squirrel.Expr(_commitTimestamp), tenantID).
+		Suffix("RETURNING id").RunWith(tx)
+	
+	err = transaction.QueryRowContext(ctx).Scan(&hlc)
+	if err != nil {
+		pqutils.Rollback(tx, w.logger)
+		if utils.IsRetryable(err) {
+			return hlc, true, err
+		}
+		return hlc, false, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	
+	if err = tx.Commit(); err != nil {
+		pqutils.Rollback(tx, w.logger)
+		if utils.IsRetryable(err) {
+			return hlc, true, err
+		}
+		return hlc, false, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	
+	return hlc, false, nil
+}
@@ -0,0 +1,130 @@
+package cockroach
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Masterminds/squirrel"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/structpb"
+	
+	"github.com/adminium/permify/internal/commands"
+	"github.com/adminium/permify/internal/keys"
+	"github.com/adminium/permify/internal/repositories/cockroach/snapshot"
+	"github.com/adminium/permify/internal/repositories/cockroach/types"
+	"github.com/adminium/permify/internal/repositories/mocks"
+	"github.com/adminium/permify/internal/schema"
+	"github.com/adminium/permify/pkg/database"
+	db "github.com/adminium/permify/pkg/database/cockroach"
+	"github.com/adminium/permify/pkg/database/postgres"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/telemetry"
+)
+
+var _ = Describe("Relationship", func() {
+	var cr *db.Cockroach
+	var mock sqlmock.Sqlmock
+	
+	BeforeEach(func() {
+		var conn *sql.DB
+		var err error
+		
+		conn, mock, err = sqlmock.New()
+		Expect(err).ShouldNot(HaveOccurred())
+		
+		cr = &db.Cockroach{
+			Postgres: &postgres.Postgres{
+				DB:      conn,
+				Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+			},
+		}
+	})
+	
+	AfterEach(func() {
+		Expect(mock.ExpectationsWereMet()).ShouldNot(HaveOccurred())
+	})
+	
+	payload := func(ip string) *structpb.Struct {
+		p, err := structpb.NewStruct(map[string]interface{}{"ip": ip})
+		Expect(err).ShouldNot(HaveOccurred())
+		return p
+	}
+	
+	columns := []string{"entity_type", "entity_id", "relation", "subject_type", "subject_id", "subject_relation", "payload"}
+	
+	Context("Payload", func() {
+		It("Case 1: The payload of a tuple is written as json", func() {
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO relation_tuples (entity_type, entity_id, relation, subject_type, subject_id, subject_relation, tenant_id, created_tx_id, created_by, reference, schema_version, not_before, not_after, payload) VALUES ($1,$2,$3,$4,$5,$6,$7,cluster_logical_timestamp(),$8,$9,$10,$11,$12,$13)`)).
+				WithArgs("doc", "1", "viewer", "user", "2", "", "t1", "", "", "", nil, nil, `{"ip":"10.0.0.1"}`).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO transactions (id, tenant_id) VALUES (cluster_logical_timestamp(),$1) RETURNING id`)).
+				WithArgs("t1").
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("10.0000000001"))
+			mock.ExpectCommit()
+			
+			collection := database.NewTupleCollection(&base.Tuple{
+				Entity:   &base.Entity{Type: "doc", Id: "1"},
+				Relation: "viewer",
+				Subject:  &base.Subject{Type: "user", Id: "2"},
+				Payload:  payload("10.0.0.1"),
+			})
+			
+			_, err := NewRelationshipWriter(cr, logger.New("error")).WriteRelationships(context.Background(), "t1", collection)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+		
+		It("Case 2: The caveats are evaluated against the payloads read from cockroach", func() {
+			definitions := []string{
+				"entity user {}",
+				"entity doc {\n\trelation viewer @user\n\taction view = viewer with ip_allowed\n}",
+				"caveat ip_allowed \"context.ip == payload.ip\"",
+			}
+			sch, err := schema.NewSchemaFromStringDefinitions(true, definitions...)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			var doc *base.EntityDefinition
+			doc, err = schema.GetEntityByName(sch, "doc")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			schemaReader := new(mocks.SchemaReader)
+			schemaReader.On("ReadSchemaDefinition", "t1", "doc", "noop").Return(doc, "noop", nil)
+			schemaReader.On("ReadSchemaString", "t1", "noop").Return(definitions, nil)
+			
+			query := regexp.QuoteMeta(`SELECT entity_type, entity_id, relation, subject_type, subject_id, subject_relation, payload FROM relation_tuples WHERE tenant_id = $1 AND entity_id IN ($2) AND entity_type = $3 AND relation = $4`)
+			for range []string{"10.0.0.1", "10.0.0.2"} {
+				mock.ExpectQuery(query).
+					WithArgs("t1", "1", "doc", "viewer", sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow("doc", "1", "viewer", "user", "2", "", `{"ip":"10.0.0.1"}`))
+			}
+			
+			var checkCommand *commands.CheckCommand
+			checkCommand, err = commands.NewCheckCommand(keys.NewNoopCheckCommandKeys(), schemaReader, NewRelationshipReader(cr, logger.New("error")), telemetry.NewNoopMeter())
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			check := func(ip string) base.PermissionCheckResponse_Result {
+				response, err := checkCommand.Execute(context.Background(), &base.PermissionCheckRequest{
+					TenantId:   "t1",
+					Entity:     &base.Entity{Type: "doc", Id: "1"},
+					Subject:    &base.Subject{Type: "user", Id: "2"},
+					Permission: "view",
+					Context:    payload(ip),
+					Metadata: &base.PermissionCheckRequestMetadata{
+						SnapToken:     snapshot.NewToken(types.HLC{Wall: 10, Logical: 1}).Encode().String(),
+						SchemaVersion: "noop",
+						Depth:         20,
+					},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				return response.GetCan()
+			}
+			
+			Expect(check("10.0.0.1")).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+			Expect(check("10.0.0.2")).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+		})
+	})
+})
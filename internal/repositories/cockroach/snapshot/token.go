@@ -0,0 +1,79 @@
+package snapshot
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	
+	"github.com/adminium/permify/internal/repositories/cockroach/types"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/token"
+)
+
+type (
+	// Token - Structure for Token, the commit timestamp of the transaction of the snapshot
+	Token struct {
+		Value types.HLC
+	}
+	// EncodedToken - Structure for EncodedToken
+	EncodedToken struct {
+		Value string
+	}
+)
+
+// NewToken - Creates a new snapshot token
+func NewToken(value types.HLC) token.SnapToken {
+	return Token{
+		Value: value,
+	}
+}
+
+// Encode - Encodes the token to a string, the wall time and the logical part in little endian
+func (t Token) Encode() token.EncodedSnapToken {
+	b := make([]byte, 12)
+	binary.LittleEndian.PutUint64(b, uint64(t.Value.Wall))
+	binary.LittleEndian.PutUint32(b[8:], t.Value.Logical)
+	return EncodedToken{
+		Value: base64.StdEncoding.EncodeToString(b),
+	}
+}
+
+// Eg snapshot is equal to given snapshot
+func (t Token) Eg(token token.SnapToken) bool {
+	ct, ok := token.(Token)
+	return ok && t.Value.Compare(ct.Value) == 0
+}
+
+// Gt snapshot is greater than given snapshot
+func (t Token) Gt(token token.SnapToken) bool {
+	ct, ok := token.(Token)
+	return ok && t.Value.Compare(ct.Value) > 0
+}
+
+// Lt snapshot is less than given snapshot
+func (t Token) Lt(token token.SnapToken) bool {
+	ct, ok := token.(Token)
+	return ok && t.Value.Compare(ct.Value) < 0
+}
+
+// Decode decodes the token from a string
+func (t EncodedToken) Decode() (token.SnapToken, error) {
+	b, err := base64.StdEncoding.DecodeString(t.Value)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != 12 {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+	}
+	return Token{
+		Value: types.HLC{
+			Wall:    int64(binary.LittleEndian.Uint64(b)),
+			Logical: binary.LittleEndian.Uint32(b[8:]),
+		},
+	}, nil
+}
+
+// Decode decodes the token from a string
+func (t EncodedToken) String() string {
+	return t.Value
+}
@@ -0,0 +1,68 @@
+package snapshot
+
+import (
+	"testing"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	
+	"github.com/adminium/permify/internal/repositories/cockroach/types"
+)
+
+// TestToken -
+func TestToken(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "token-suite")
+}
+
+var _ = Describe("token", func() {
+	Context("Encode and Decode", func() {
+		It("Case 1: Success", func() {
+			tests := []types.HLC{
+				{Wall: 1676019600000000000, Logical: 0},
+				{Wall: 1676019600000000000, Logical: 3},
+				{Wall: 42, Logical: 4294967295},
+			}
+			
+			for _, tt := range tests {
+				decoded, err := EncodedToken{Value: NewToken(tt).Encode().String()}.Decode()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(decoded).Should(Equal(NewToken(tt)))
+			}
+		})
+		
+		It("Case 2: Fail", func() {
+			_, err := EncodedToken{Value: "BAAAAAAAAAA="}.Decode()
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+	
+	Context("Compare", func() {
+		It("Case 1: The logical part orders timestamps with the same wall time", func() {
+			a := NewToken(types.HLC{Wall: 10, Logical: 1})
+			b := NewToken(types.HLC{Wall: 10, Logical: 2})
+			c := NewToken(types.HLC{Wall: 11, Logical: 0})
+			
+			Expect(a.Lt(b)).Should(BeTrue())
+			Expect(b.Lt(c)).Should(BeTrue())
+			Expect(c.Gt(a)).Should(BeTrue())
+			Expect(a.Eg(NewToken(types.HLC{Wall: 10, Logical: 1}))).Should(BeTrue())
+		})
+	})
+	
+	Context("ParseHLC", func() {
+		It("Case 1: Decimals of cluster_logical_timestamp", func() {
+			hlc, err := types.ParseHLC("1676019600000000000.0000000003")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(hlc).Should(Equal(types.HLC{Wall: 1676019600000000000, Logical: 3}))
+			Expect(hlc.String()).Should(Equal("1676019600000000000.0000000003"))
+			
+			hlc, err = types.ParseHLC("1676019600000000000")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(hlc).Should(Equal(types.HLC{Wall: 1676019600000000000}))
+			
+			_, err = types.ParseHLC("not-a-timestamp")
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
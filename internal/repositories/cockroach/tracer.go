@@ -0,0 +1,7 @@
+package cockroach
+
+import (
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("repositories.cockroach")
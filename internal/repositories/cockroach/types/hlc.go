@@ -0,0 +1,90 @@
+package types
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// _logicalDigits - Digits of the logical part of the decimal form of a timestamp
+const _logicalDigits = 10
+
+// HLC - Hybrid logical clock timestamp of cockroach. Wall is the wall time in nanoseconds and logical orders the
+// transactions committed at the same wall time. In sql it is the decimal wall.logical, with ten digits after the
+// point, as returned by cluster_logical_timestamp().
+type HLC struct {
+	Wall    int64
+	Logical uint32
+}
+
+// ParseHLC - Parses the decimal form of a timestamp
+func ParseHLC(s string) (HLC, error) {
+	wall, logical, _ := strings.Cut(s, ".")
+	w, err := strconv.ParseInt(wall, 10, 64)
+	if err != nil {
+		return HLC{}, err
+	}
+	if len(logical) > _logicalDigits {
+		return HLC{}, fmt.Errorf("logical part of %s has more than %d digits", s, _logicalDigits)
+	}
+	var l uint64
+	if logical != "" {
+		// decimals can drop the trailing zeros of the logical part
+		l, err = strconv.ParseUint(logical+strings.Repeat("0", _logicalDigits-len(logical)), 10, 32)
+		if err != nil {
+			return HLC{}, err
+		}
+	}
+	return HLC{Wall: w, Logical: uint32(l)}, nil
+}
+
+// String - Decimal form of the timestamp
+func (h HLC) String() string {
+	return fmt.Sprintf("%d.%0*d", h.Wall, _logicalDigits, h.Logical)
+}
+
+// Time - Wall time of the timestamp
+func (h HLC) Time() time.Time {
+	return time.Unix(0, h.Wall).UTC()
+}
+
+// IsZero - Timestamp of a tenant without transactions
+func (h HLC) IsZero() bool {
+	return h.Wall == 0 && h.Logical == 0
+}
+
+// Compare - Returns -1, 0 or 1 when the timestamp is before, equal to or after the other one
+func (h HLC) Compare(o HLC) int {
+	switch {
+	case h.Wall < o.Wall || (h.Wall == o.Wall && h.Logical < o.Logical):
+		return -1
+	case h == o:
+		return 0
+	default:
+		return 1
+	}
+}
+
+// Scan - Reads the timestamp from a decimal column
+func (h *HLC) Scan(src interface{}) (err error) {
+	switch v := src.(type) {
+	case string:
+		*h, err = ParseHLC(v)
+	case []byte:
+		*h, err = ParseHLC(string(v))
+	case int64:
+		*h = HLC{Wall: v}
+	case nil:
+		*h = HLC{}
+	default:
+		err = fmt.Errorf("cannot scan %T into a hybrid logical clock timestamp", src)
+	}
+	return err
+}
+
+// Value - Writes the timestamp as a decimal
+func (h HLC) Value() (driver.Value, error) {
+	return h.String(), nil
+}
@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	
+	"github.com/Masterminds/squirrel"
+	
+	"github.com/adminium/permify/internal/repositories/cockroach/types"
+)
+
+// SnapshotQuery - Tuples created at or before the revision that were not deleted at or before it. The tuples carry
+// the commit timestamps of the transactions that created and deleted them, so the visibility is a comparison of
+// timestamps instead of the snapshots of postgres.
+func SnapshotQuery(sl squirrel.SelectBuilder, revision types.HLC) squirrel.SelectBuilder {
+	return sl.Where(squirrel.Expr(visible("", revision)))
+}
+
+// ChangesQuery - Selects tuples that are visible at the to revision but not at the from revision. Swapping the
+// revisions selects the tuples deleted between them. Nothing is visible at the zero revision.
+func ChangesQuery(sl squirrel.SelectBuilder, table string, from, to types.HLC) squirrel.SelectBuilder {
+	if from.IsZero() {
+		return sl.Where(squirrel.Expr(visible(table, to)))
+	}
+	return sl.Where(squirrel.Expr(fmt.Sprintf("%s AND NOT %s", visible(table, to), visible(table, from))))
+}
+
+// visible - Condition of SnapshotQuery as a single expression, the columns are qualified with the table when it is
+// given
+func visible(table string, revision types.HLC) string {
+	if table != "" {
+		table += "."
+	}
+	return fmt.Sprintf("(%[1]screated_tx_id <= %[2]s AND (%[1]sexpired_tx_id = 0 OR %[1]sexpired_tx_id > %[2]s))", table, revision)
+}
+
+// ValidityQuery - Skips tuples whose validity window does not contain the wall time of the revision
+func ValidityQuery(sl squirrel.SelectBuilder, revision types.HLC) squirrel.SelectBuilder {
+	at := revision.Time()
+	return sl.Where(squirrel.And{
+		squirrel.Or{
+			squirrel.Expr("not_before IS NULL"),
+			squirrel.LtOrEq{"not_before": at},
+		},
+		squirrel.Or{
+			squirrel.Expr("not_after IS NULL"),
+			squirrel.Gt{"not_after": at},
+		},
+	})
+}
+
+// AsOfSystemTime - The table read as it was at the revision. Reads in the past can be served by the followers of
+// the ranges once the revision is older than the closed timestamp of the cluster.
+func AsOfSystemTime(table string, revision types.HLC) string {
+	return fmt.Sprintf("%s AS OF SYSTEM TIME %s", table, revision)
+}
+
+// IsRetryable - The transaction conflicted with another one and can be retried
+func IsRetryable(err error) bool {
+	return strings.Contains(err.Error(), "restart transaction") || strings.Contains(err.Error(), "40001")
+}
+
+// IsBeforeGCThreshold - The revision is older than the garbage collection of the table, it can only be read at
+// the present time
+func IsBeforeGCThreshold(err error) bool {
+	return strings.Contains(err.Error(), "GC threshold")
+}
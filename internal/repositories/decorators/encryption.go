@@ -0,0 +1,106 @@
+package decorators
+
+import (
+	"context"
+	
+	"google.golang.org/protobuf/proto"
+	
+	"github.com/adminium/permify/pkg/database"
+	"github.com/adminium/permify/pkg/encryption"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+// tupleCipher - Encrypts the ids of the tuples of a tenant, the wildcard subject keeps its meaning
+type tupleCipher struct {
+	cipher *encryption.Cipher
+	mode   encryption.Mode
+}
+
+// newTupleCipher - Cipher of the tuples of the tenant, nil when the tenant is not encrypted
+func newTupleCipher(ctx context.Context, keyring *encryption.Keyring, tenantID string) (*tupleCipher, error) {
+	c, err := keyring.Cipher(ctx, tenantID)
+	if err != nil || c == nil {
+		return nil, err
+	}
+	return &tupleCipher{cipher: c, mode: keyring.Mode()}, nil
+}
+
+// encryptTuple -
+func (c *tupleCipher) encryptTuple(t *base.Tuple) *base.Tuple {
+	t = proto.Clone(t).(*base.Tuple)
+	if c.mode == encryption.TUPLE {
+		t.Entity.Id = c.cipher.Encrypt(t.GetEntity().GetId())
+	}
+	t.Subject.Id = c.encryptSubjectID(t.GetSubject().GetId())
+	return t
+}
+
+// decryptTuple -
+func (c *tupleCipher) decryptTuple(t *base.Tuple) (*base.Tuple, error) {
+	t = proto.Clone(t).(*base.Tuple)
+	var err error
+	if c.mode == encryption.TUPLE {
+		if t.Entity.Id, err = c.cipher.Decrypt(t.GetEntity().GetId()); err != nil {
+			return nil, err
+		}
+	}
+	if t.Subject.Id, err = c.cipher.Decrypt(t.GetSubject().GetId()); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// encryptFilter - Filter on the encrypted ids, the storage compares them as they are
+func (c *tupleCipher) encryptFilter(filter *base.TupleFilter) *base.TupleFilter {
+	if filter == nil {
+		return nil
+	}
+	filter = proto.Clone(filter).(*base.TupleFilter)
+	if c.mode == encryption.TUPLE && filter.GetEntity() != nil {
+		for i, id := range filter.Entity.Ids {
+			filter.Entity.Ids[i] = c.cipher.Encrypt(id)
+		}
+	}
+	if filter.GetSubject() != nil {
+		for i, id := range filter.Subject.Ids {
+			filter.Subject.Ids[i] = c.encryptSubjectID(id)
+		}
+	}
+	return filter
+}
+
+// encryptCollection -
+func (c *tupleCipher) encryptCollection(collection *database.TupleCollection) *database.TupleCollection {
+	encrypted := database.NewTupleCollection()
+	metadata := collection.GetMetadata()
+	for i, t := range collection.GetTuples() {
+		encrypted.AddWithMetadata(c.encryptTuple(t), metadata[i])
+	}
+	return encrypted
+}
+
+// decryptCollection -
+func (c *tupleCipher) decryptCollection(collection *database.TupleCollection) (*database.TupleCollection, error) {
+	if collection == nil {
+		return nil, nil
+	}
+	decrypted := database.NewTupleCollection()
+	metadata := collection.GetMetadata()
+	for i, t := range collection.GetTuples() {
+		d, err := c.decryptTuple(t)
+		if err != nil {
+			return nil, err
+		}
+		decrypted.AddWithMetadata(d, metadata[i])
+	}
+	return decrypted, nil
+}
+
+// encryptSubjectID -
+func (c *tupleCipher) encryptSubjectID(id string) string {
+	if id == tuple.WILDCARD {
+		return id
+	}
+	return c.cipher.Encrypt(id)
+}
@@ -0,0 +1,35 @@
+package decorators
+
+import (
+	"context"
+	"time"
+	
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/instrument"
+)
+
+// queryDurations - Records the durations of the queries of the repositories by their method, and whether they failed
+type queryDurations struct {
+	histogram instrument.Float64Histogram
+}
+
+// newQueryDurations -
+func newQueryDurations(m metric.Meter) queryDurations {
+	durations := queryDurations{}
+	if histogram, err := m.Float64Histogram("repository_query_duration", instrument.WithDescription("repository query duration in milliseconds"), instrument.WithUnit("ms")); err == nil {
+		durations.histogram = histogram
+	}
+	return durations
+}
+
+// observe - Records the duration of the query since start, it is deferred with the error of the query
+func (d queryDurations) observe(ctx context.Context, method string, start time.Time, err error) {
+	if d.histogram == nil {
+		return
+	}
+	d.histogram.Record(ctx, float64(time.Since(start).Microseconds())/1000,
+		attribute.String("method", method),
+		attribute.Bool("error", err != nil),
+	)
+}
@@ -0,0 +1,168 @@
+package decorators
+
+import (
+	"context"
+	"sync"
+	"time"
+	
+	"google.golang.org/protobuf/proto"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/internal/schema"
+	"github.com/adminium/permify/pkg/database"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/token"
+)
+
+// _defaultAliasesTTL - Time the aliases of the head schema of a tenant are kept before they are read again, so that
+// written schemas are picked up
+const _defaultAliasesTTL = 10 * time.Second
+
+// AliasReader - Reads the aliases of the entities of a schema version, the head version when it is empty
+type AliasReader interface {
+	ReadAliases(ctx context.Context, tenantID, version string) (schema.Aliases, error)
+}
+
+// aliasesEntry - Cached aliases of a tenant
+type aliasesEntry struct {
+	aliases schema.Aliases
+	at      time.Time
+}
+
+// RelationshipReaderWithAliases - Resolves the tuples written with the previous names of the renamed entities of the
+// head schema. The queries of the engine read the tuples of the aliases of their entity and subject types as well and
+// get them under the entity types. The tuples read through the api are returned as they are stored, so that they
+// can be renamed.
+type RelationshipReaderWithAliases struct {
+	delegate repositories.RelationshipReader
+	reader   AliasReader
+	
+	mu      sync.Mutex
+	entries map[string]aliasesEntry
+}
+
+// NewRelationshipReaderWithAliases - Add alias resolution to new relationship reader
+func NewRelationshipReaderWithAliases(delegate repositories.RelationshipReader, reader AliasReader) *RelationshipReaderWithAliases {
+	return &RelationshipReaderWithAliases{
+		delegate: delegate,
+		reader:   reader,
+		entries:  map[string]aliasesEntry{},
+	}
+}
+
+// aliases - Aliases of the head schema of the tenant, none when the schema can not be read
+func (r *RelationshipReaderWithAliases) aliases(ctx context.Context, tenantID string) schema.Aliases {
+	r.mu.Lock()
+	entry, ok := r.entries[tenantID]
+	r.mu.Unlock()
+	if ok && time.Since(entry.at) < _defaultAliasesTTL {
+		return entry.aliases
+	}
+	aliases, err := r.reader.ReadAliases(ctx, tenantID, "")
+	if err != nil {
+		// the reads of the schema report its errors
+		return nil
+	}
+	r.mu.Lock()
+	r.entries[tenantID] = aliasesEntry{aliases: aliases, at: time.Now()}
+	r.mu.Unlock()
+	return aliases
+}
+
+// QueryRelationships - Reads relation tuples from the repository, the tuples of the aliases of the entity and subject
+// types of the filter included
+func (r *RelationshipReaderWithAliases) QueryRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (*database.TupleIterator, error) {
+	aliases := r.aliases(ctx, tenantID)
+	entityTypes := append([]string{filter.GetEntity().GetType()}, aliases.Of(filter.GetEntity().GetType())...)
+	subjectTypes := append([]string{filter.GetSubject().GetType()}, aliases.Of(filter.GetSubject().GetType())...)
+	if len(entityTypes) == 1 && len(subjectTypes) == 1 {
+		return r.delegate.QueryRelationships(ctx, tenantID, filter, snap)
+	}
+	
+	var tuples []*base.Tuple
+	for i, entityType := range entityTypes {
+		for j, subjectType := range subjectTypes {
+			f := proto.Clone(filter).(*base.TupleFilter)
+			if i > 0 {
+				f.Entity.Type = entityType
+			}
+			if j > 0 {
+				f.Subject.Type = subjectType
+			}
+			it, err := r.delegate.QueryRelationships(ctx, tenantID, f, snap)
+			if err != nil {
+				return nil, err
+			}
+			for it.HasNext() {
+				t := it.GetNext()
+				if i > 0 || j > 0 {
+					t = proto.Clone(t).(*base.Tuple)
+					if i > 0 {
+						t.Entity.Type = entityTypes[0]
+					}
+					if j > 0 {
+						t.Subject.Type = subjectTypes[0]
+					}
+				}
+				tuples = append(tuples, t)
+			}
+		}
+	}
+	return database.NewTupleIterator(tuples...), nil
+}
+
+// ReadRelationships - Reads relation tuples from the repository with different options.
+func (r *RelationshipReaderWithAliases) ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, pagination database.Pagination) (*database.TupleCollection, database.EncodedContinuousToken, error) {
+	return r.delegate.ReadRelationships(ctx, tenantID, filter, snap, pagination)
+}
+
+// GetUniqueEntityIDsByEntityType - Reads unique entity IDs from the repository, the ids of the aliases of the type
+// included
+func (r *RelationshipReaderWithAliases) GetUniqueEntityIDsByEntityType(ctx context.Context, tenantID string, typ, snap string) ([]string, error) {
+	ids, err := r.delegate.GetUniqueEntityIDsByEntityType(ctx, tenantID, typ, snap)
+	if err != nil {
+		return nil, err
+	}
+	aliases := r.aliases(ctx, tenantID).Of(typ)
+	if len(aliases) == 0 {
+		return ids, nil
+	}
+	seen := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		seen[id] = struct{}{}
+	}
+	for _, alias := range aliases {
+		var aliased []string
+		aliased, err = r.delegate.GetUniqueEntityIDsByEntityType(ctx, tenantID, alias, snap)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range aliased {
+			if _, ok := seen[id]; !ok {
+				seen[id] = struct{}{}
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids, nil
+}
+
+// HeadSnapshot - Reads the latest version of the snapshot from the repository.
+func (r *RelationshipReaderWithAliases) HeadSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error) {
+	return r.delegate.HeadSnapshot(ctx, tenantID)
+}
+
+// SnapshotAt - Reads the snapshot that was the latest at the given time from the repository.
+func (r *RelationshipReaderWithAliases) SnapshotAt(ctx context.Context, tenantID string, at time.Time) (token.SnapToken, error) {
+	return r.delegate.SnapshotAt(ctx, tenantID, at)
+}
+
+// ReadDeletedRelationships - Reads relation tuples deleted within the given time window from the repository.
+func (r *RelationshipReaderWithAliases) ReadDeletedRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, from, to time.Time) (*database.TupleCollection, error) {
+	return r.delegate.ReadDeletedRelationships(ctx, tenantID, filter, from, to)
+}
+
+// ReadRelationshipChanges - Reads relation tuples created and deleted between the snapshots of two tokens from the repository.
+func (r *RelationshipReaderWithAliases) ReadRelationshipChanges(ctx context.Context, tenantID string, filter *base.TupleFilter, from, to string) (*database.TupleCollection, *database.TupleCollection, error) {
+	return r.delegate.ReadRelationshipChanges(ctx, tenantID, filter, from, to)
+}
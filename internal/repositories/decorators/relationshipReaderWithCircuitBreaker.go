@@ -3,6 +3,7 @@ package decorators
 import (
 	"context"
 	"errors"
+	"time"
 	
 	"github.com/afex/hystrix-go/hystrix"
 	
@@ -122,3 +123,79 @@ func (r *RelationshipReaderWithCircuitBreaker) HeadSnapshot(ctx context.Context,
 		return nil, errors.New(base.ErrorCode_ERROR_CODE_CIRCUIT_BREAKER.String())
 	}
 }
+
+// SnapshotAt - Reads the snapshot that was the latest at the given time from the repository.
+func (r *RelationshipReaderWithCircuitBreaker) SnapshotAt(ctx context.Context, tenantID string, at time.Time) (token.SnapToken, error) {
+	type circuitBreakerResponse struct {
+		Token token.SnapToken
+		Error error
+	}
+	
+	output := make(chan circuitBreakerResponse, 1)
+	hystrix.ConfigureCommand("relationshipReader.snapshotAt", hystrix.CommandConfig{Timeout: 1000})
+	bErrors := hystrix.Go("relationshipReader.snapshotAt", func() error {
+		tok, err := r.delegate.SnapshotAt(ctx, tenantID, at)
+		output <- circuitBreakerResponse{Token: tok, Error: err}
+		return nil
+	}, func(err error) error {
+		return nil
+	})
+	
+	select {
+	case out := <-output:
+		return out.Token, out.Error
+	case <-bErrors:
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_CIRCUIT_BREAKER.String())
+	}
+}
+
+// ReadDeletedRelationships - Reads relation tuples deleted within the given time window from the repository.
+func (r *RelationshipReaderWithCircuitBreaker) ReadDeletedRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, from, to time.Time) (*database.TupleCollection, error) {
+	type circuitBreakerResponse struct {
+		Collection *database.TupleCollection
+		Error      error
+	}
+	
+	output := make(chan circuitBreakerResponse, 1)
+	hystrix.ConfigureCommand("relationshipReader.readDeletedRelationships", hystrix.CommandConfig{Timeout: 1000})
+	bErrors := hystrix.Go("relationshipReader.readDeletedRelationships", func() error {
+		collection, err := r.delegate.ReadDeletedRelationships(ctx, tenantID, filter, from, to)
+		output <- circuitBreakerResponse{Collection: collection, Error: err}
+		return nil
+	}, func(err error) error {
+		return nil
+	})
+	
+	select {
+	case out := <-output:
+		return out.Collection, out.Error
+	case <-bErrors:
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_CIRCUIT_BREAKER.String())
+	}
+}
+
+// ReadRelationshipChanges - Reads relation tuples created and deleted between the snapshots of two tokens from the repository.
+func (r *RelationshipReaderWithCircuitBreaker) ReadRelationshipChanges(ctx context.Context, tenantID string, filter *base.TupleFilter, from, to string) (*database.TupleCollection, *database.TupleCollection, error) {
+	type circuitBreakerResponse struct {
+		Created *database.TupleCollection
+		Deleted *database.TupleCollection
+		Error   error
+	}
+	
+	output := make(chan circuitBreakerResponse, 1)
+	hystrix.ConfigureCommand("relationshipReader.readRelationshipChanges", hystrix.CommandConfig{Timeout: 1000})
+	bErrors := hystrix.Go("relationshipReader.readRelationshipChanges", func() error {
+		created, deleted, err := r.delegate.ReadRelationshipChanges(ctx, tenantID, filter, from, to)
+		output <- circuitBreakerResponse{Created: created, Deleted: deleted, Error: err}
+		return nil
+	}, func(err error) error {
+		return nil
+	})
+	
+	select {
+	case out := <-output:
+		return out.Created, out.Deleted, out.Error
+	case <-bErrors:
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_CIRCUIT_BREAKER.String())
+	}
+}
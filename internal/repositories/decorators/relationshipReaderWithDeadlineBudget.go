@@ -0,0 +1,90 @@
+package decorators
+
+import (
+	"context"
+	"time"
+	
+	"github.com/adminium/permify/internal/deadline"
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/pkg/database"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/token"
+)
+
+// RelationshipReaderWithDeadlineBudget - Gives every read of the relation tuples the query share of the time left
+// before the deadline of its request, a read cut at the end of its share is tried once more with the rest
+type RelationshipReaderWithDeadlineBudget struct {
+	delegate repositories.RelationshipReader
+	budget   deadline.Budget
+}
+
+// NewRelationshipReaderWithDeadlineBudget - Add deadline budget to new relationship reader
+func NewRelationshipReaderWithDeadlineBudget(delegate repositories.RelationshipReader, budget deadline.Budget) *RelationshipReaderWithDeadlineBudget {
+	return &RelationshipReaderWithDeadlineBudget{
+		delegate: delegate,
+		budget:   budget,
+	}
+}
+
+// QueryRelationships - Reads relation tuples from the repository
+func (r *RelationshipReaderWithDeadlineBudget) QueryRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (it *database.TupleIterator, err error) {
+	err = r.budget.Run(ctx, r.budget.Query, func(ctx context.Context) (err error) {
+		it, err = r.delegate.QueryRelationships(ctx, tenantID, filter, snap)
+		return err
+	})
+	return it, err
+}
+
+// ReadRelationships - Reads relation tuples from the repository with different options.
+func (r *RelationshipReaderWithDeadlineBudget) ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, pagination database.Pagination) (collection *database.TupleCollection, ct database.EncodedContinuousToken, err error) {
+	err = r.budget.Run(ctx, r.budget.Query, func(ctx context.Context) (err error) {
+		collection, ct, err = r.delegate.ReadRelationships(ctx, tenantID, filter, snap, pagination)
+		return err
+	})
+	return collection, ct, err
+}
+
+// GetUniqueEntityIDsByEntityType - Reads unique entity IDs from the repository
+func (r *RelationshipReaderWithDeadlineBudget) GetUniqueEntityIDsByEntityType(ctx context.Context, tenantID string, typ, snap string) (ids []string, err error) {
+	err = r.budget.Run(ctx, r.budget.Query, func(ctx context.Context) (err error) {
+		ids, err = r.delegate.GetUniqueEntityIDsByEntityType(ctx, tenantID, typ, snap)
+		return err
+	})
+	return ids, err
+}
+
+// HeadSnapshot - Reads the latest version of the snapshot from the repository.
+func (r *RelationshipReaderWithDeadlineBudget) HeadSnapshot(ctx context.Context, tenantID string) (st token.SnapToken, err error) {
+	err = r.budget.Run(ctx, r.budget.Query, func(ctx context.Context) (err error) {
+		st, err = r.delegate.HeadSnapshot(ctx, tenantID)
+		return err
+	})
+	return st, err
+}
+
+// SnapshotAt - Reads the snapshot that was the latest at the given time from the repository.
+func (r *RelationshipReaderWithDeadlineBudget) SnapshotAt(ctx context.Context, tenantID string, at time.Time) (st token.SnapToken, err error) {
+	err = r.budget.Run(ctx, r.budget.Query, func(ctx context.Context) (err error) {
+		st, err = r.delegate.SnapshotAt(ctx, tenantID, at)
+		return err
+	})
+	return st, err
+}
+
+// ReadDeletedRelationships - Reads relation tuples deleted within the given time window from the repository.
+func (r *RelationshipReaderWithDeadlineBudget) ReadDeletedRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, from, to time.Time) (collection *database.TupleCollection, err error) {
+	err = r.budget.Run(ctx, r.budget.Query, func(ctx context.Context) (err error) {
+		collection, err = r.delegate.ReadDeletedRelationships(ctx, tenantID, filter, from, to)
+		return err
+	})
+	return collection, err
+}
+
+// ReadRelationshipChanges - Reads relation tuples created and deleted between the snapshots of two tokens from the repository.
+func (r *RelationshipReaderWithDeadlineBudget) ReadRelationshipChanges(ctx context.Context, tenantID string, filter *base.TupleFilter, from, to string) (created, deleted *database.TupleCollection, err error) {
+	err = r.budget.Run(ctx, r.budget.Query, func(ctx context.Context) (err error) {
+		created, deleted, err = r.delegate.ReadRelationshipChanges(ctx, tenantID, filter, from, to)
+		return err
+	})
+	return created, deleted, err
+}
@@ -0,0 +1,142 @@
+package decorators
+
+import (
+	"context"
+	"time"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/pkg/database"
+	"github.com/adminium/permify/pkg/encryption"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/token"
+)
+
+// RelationshipReaderWithEncryption - Looks the tuples of the encrypted tenants up by their encrypted ids and decrypts
+// the tuples it reads. The encryption is deterministic, so the filters are encrypted like the tuples were.
+type RelationshipReaderWithEncryption struct {
+	delegate repositories.RelationshipReader
+	keyring  *encryption.Keyring
+}
+
+// NewRelationshipReaderWithEncryption - Add encryption at rest to new relationship reader
+func NewRelationshipReaderWithEncryption(delegate repositories.RelationshipReader, keyring *encryption.Keyring) *RelationshipReaderWithEncryption {
+	return &RelationshipReaderWithEncryption{delegate: delegate, keyring: keyring}
+}
+
+// QueryRelationships - Reads relation tuples from the repository
+func (r *RelationshipReaderWithEncryption) QueryRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (*database.TupleIterator, error) {
+	c, err := newTupleCipher(ctx, r.keyring, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return r.delegate.QueryRelationships(ctx, tenantID, filter, snap)
+	}
+	
+	it, err := r.delegate.QueryRelationships(ctx, tenantID, c.encryptFilter(filter), snap)
+	if err != nil {
+		return nil, err
+	}
+	var tuples []*base.Tuple
+	for it.HasNext() {
+		t, err := c.decryptTuple(it.GetNext())
+		if err != nil {
+			return nil, err
+		}
+		tuples = append(tuples, t)
+	}
+	return database.NewTupleIterator(tuples...), nil
+}
+
+// ReadRelationships - Reads relation tuples from the repository with different options.
+func (r *RelationshipReaderWithEncryption) ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, pagination database.Pagination) (*database.TupleCollection, database.EncodedContinuousToken, error) {
+	c, err := newTupleCipher(ctx, r.keyring, tenantID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if c == nil {
+		return r.delegate.ReadRelationships(ctx, tenantID, filter, snap, pagination)
+	}
+	
+	collection, ct, err := r.delegate.ReadRelationships(ctx, tenantID, c.encryptFilter(filter), snap, pagination)
+	if err != nil {
+		return nil, nil, err
+	}
+	collection, err = c.decryptCollection(collection)
+	if err != nil {
+		return nil, nil, err
+	}
+	return collection, ct, nil
+}
+
+// GetUniqueEntityIDsByEntityType - Reads unique entity IDs from the repository
+func (r *RelationshipReaderWithEncryption) GetUniqueEntityIDsByEntityType(ctx context.Context, tenantID string, typ, snap string) ([]string, error) {
+	ids, err := r.delegate.GetUniqueEntityIDsByEntityType(ctx, tenantID, typ, snap)
+	if err != nil {
+		return nil, err
+	}
+	
+	c, err := newTupleCipher(ctx, r.keyring, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil || c.mode != encryption.TUPLE {
+		return ids, nil
+	}
+	for i, id := range ids {
+		if ids[i], err = c.cipher.Decrypt(id); err != nil {
+			return nil, err
+		}
+	}
+	return ids, nil
+}
+
+// HeadSnapshot - Reads the latest version of the snapshot from the repository.
+func (r *RelationshipReaderWithEncryption) HeadSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error) {
+	return r.delegate.HeadSnapshot(ctx, tenantID)
+}
+
+// SnapshotAt - Reads the snapshot that was the latest at the given time from the repository.
+func (r *RelationshipReaderWithEncryption) SnapshotAt(ctx context.Context, tenantID string, at time.Time) (token.SnapToken, error) {
+	return r.delegate.SnapshotAt(ctx, tenantID, at)
+}
+
+// ReadDeletedRelationships - Reads relation tuples deleted within the given time window from the repository.
+func (r *RelationshipReaderWithEncryption) ReadDeletedRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, from, to time.Time) (*database.TupleCollection, error) {
+	c, err := newTupleCipher(ctx, r.keyring, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return r.delegate.ReadDeletedRelationships(ctx, tenantID, filter, from, to)
+	}
+	
+	collection, err := r.delegate.ReadDeletedRelationships(ctx, tenantID, c.encryptFilter(filter), from, to)
+	if err != nil {
+		return nil, err
+	}
+	return c.decryptCollection(collection)
+}
+
+// ReadRelationshipChanges - Reads relation tuples created and deleted between the snapshots of two tokens from the repository.
+func (r *RelationshipReaderWithEncryption) ReadRelationshipChanges(ctx context.Context, tenantID string, filter *base.TupleFilter, from, to string) (*database.TupleCollection, *database.TupleCollection, error) {
+	c, err := newTupleCipher(ctx, r.keyring, tenantID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if c == nil {
+		return r.delegate.ReadRelationshipChanges(ctx, tenantID, filter, from, to)
+	}
+	
+	created, deleted, err := r.delegate.ReadRelationshipChanges(ctx, tenantID, c.encryptFilter(filter), from, to)
+	if err != nil {
+		return nil, nil, err
+	}
+	if created, err = c.decryptCollection(created); err != nil {
+		return nil, nil, err
+	}
+	if deleted, err = c.decryptCollection(deleted); err != nil {
+		return nil, nil, err
+	}
+	return created, deleted, nil
+}
@@ -0,0 +1,79 @@
+package decorators
+
+import (
+	"context"
+	"time"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/pkg/database"
+	"github.com/adminium/permify/pkg/faults"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/token"
+)
+
+// RelationshipReaderWithFaults - Injects latency and errors into the reads of relation tuples
+type RelationshipReaderWithFaults struct {
+	delegate repositories.RelationshipReader
+	faults   *faults.Injector
+}
+
+// NewRelationshipReaderWithFaults - Add fault injection to new relationship reader
+func NewRelationshipReaderWithFaults(delegate repositories.RelationshipReader, faults *faults.Injector) *RelationshipReaderWithFaults {
+	return &RelationshipReaderWithFaults{delegate: delegate, faults: faults}
+}
+
+// QueryRelationships - Reads relation tuples from the repository
+func (r *RelationshipReaderWithFaults) QueryRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (*database.TupleIterator, error) {
+	if err := r.faults.Before(ctx); err != nil {
+		return nil, err
+	}
+	return r.delegate.QueryRelationships(ctx, tenantID, filter, snap)
+}
+
+// ReadRelationships - Reads relation tuples from the repository with different options.
+func (r *RelationshipReaderWithFaults) ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, pagination database.Pagination) (*database.TupleCollection, database.EncodedContinuousToken, error) {
+	if err := r.faults.Before(ctx); err != nil {
+		return nil, nil, err
+	}
+	return r.delegate.ReadRelationships(ctx, tenantID, filter, snap, pagination)
+}
+
+// GetUniqueEntityIDsByEntityType - Reads unique entity IDs from the repository
+func (r *RelationshipReaderWithFaults) GetUniqueEntityIDsByEntityType(ctx context.Context, tenantID string, typ, snap string) ([]string, error) {
+	if err := r.faults.Before(ctx); err != nil {
+		return nil, err
+	}
+	return r.delegate.GetUniqueEntityIDsByEntityType(ctx, tenantID, typ, snap)
+}
+
+// HeadSnapshot - Reads the latest version of the snapshot from the repository.
+func (r *RelationshipReaderWithFaults) HeadSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error) {
+	if err := r.faults.Before(ctx); err != nil {
+		return nil, err
+	}
+	return r.delegate.HeadSnapshot(ctx, tenantID)
+}
+
+// SnapshotAt - Reads the snapshot that was the latest at the given time from the repository.
+func (r *RelationshipReaderWithFaults) SnapshotAt(ctx context.Context, tenantID string, at time.Time) (token.SnapToken, error) {
+	if err := r.faults.Before(ctx); err != nil {
+		return nil, err
+	}
+	return r.delegate.SnapshotAt(ctx, tenantID, at)
+}
+
+// ReadDeletedRelationships - Reads relation tuples deleted within the given time window from the repository.
+func (r *RelationshipReaderWithFaults) ReadDeletedRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, from, to time.Time) (*database.TupleCollection, error) {
+	if err := r.faults.Before(ctx); err != nil {
+		return nil, err
+	}
+	return r.delegate.ReadDeletedRelationships(ctx, tenantID, filter, from, to)
+}
+
+// ReadRelationshipChanges - Reads relation tuples created and deleted between the snapshots of two tokens from the repository.
+func (r *RelationshipReaderWithFaults) ReadRelationshipChanges(ctx context.Context, tenantID string, filter *base.TupleFilter, from, to string) (*database.TupleCollection, *database.TupleCollection, error) {
+	if err := r.faults.Before(ctx); err != nil {
+		return nil, nil, err
+	}
+	return r.delegate.ReadRelationshipChanges(ctx, tenantID, filter, from, to)
+}
@@ -0,0 +1,70 @@
+package decorators
+
+import (
+	"context"
+	"time"
+	
+	"go.opentelemetry.io/otel/metric"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/pkg/database"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/token"
+)
+
+// RelationshipReaderWithMetrics - Records the duration of every read of the relation tuples, the iterators are
+// measured until they are returned, not until they are drained
+type RelationshipReaderWithMetrics struct {
+	delegate  repositories.RelationshipReader
+	durations queryDurations
+}
+
+// NewRelationshipReaderWithMetrics - Add metrics to new relationship reader
+func NewRelationshipReaderWithMetrics(delegate repositories.RelationshipReader, m metric.Meter) *RelationshipReaderWithMetrics {
+	return &RelationshipReaderWithMetrics{
+		delegate:  delegate,
+		durations: newQueryDurations(m),
+	}
+}
+
+// QueryRelationships - Reads relation tuples from the repository
+func (r *RelationshipReaderWithMetrics) QueryRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (it *database.TupleIterator, err error) {
+	defer func(start time.Time) { r.durations.observe(ctx, "relationshipReader.queryRelationships", start, err) }(time.Now())
+	return r.delegate.QueryRelationships(ctx, tenantID, filter, snap)
+}
+
+// ReadRelationships - Reads relation tuples from the repository with different options.
+func (r *RelationshipReaderWithMetrics) ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, pagination database.Pagination) (collection *database.TupleCollection, ct database.EncodedContinuousToken, err error) {
+	defer func(start time.Time) { r.durations.observe(ctx, "relationshipReader.readRelationships", start, err) }(time.Now())
+	return r.delegate.ReadRelationships(ctx, tenantID, filter, snap, pagination)
+}
+
+// GetUniqueEntityIDsByEntityType - Reads unique entity IDs from the repository
+func (r *RelationshipReaderWithMetrics) GetUniqueEntityIDsByEntityType(ctx context.Context, tenantID string, typ, snap string) (ids []string, err error) {
+	defer func(start time.Time) { r.durations.observe(ctx, "relationshipReader.getUniqueEntityIDsByEntityType", start, err) }(time.Now())
+	return r.delegate.GetUniqueEntityIDsByEntityType(ctx, tenantID, typ, snap)
+}
+
+// HeadSnapshot - Reads the latest version of the snapshot from the repository.
+func (r *RelationshipReaderWithMetrics) HeadSnapshot(ctx context.Context, tenantID string) (st token.SnapToken, err error) {
+	defer func(start time.Time) { r.durations.observe(ctx, "relationshipReader.headSnapshot", start, err) }(time.Now())
+	return r.delegate.HeadSnapshot(ctx, tenantID)
+}
+
+// SnapshotAt - Reads the snapshot that was the latest at the given time from the repository.
+func (r *RelationshipReaderWithMetrics) SnapshotAt(ctx context.Context, tenantID string, at time.Time) (st token.SnapToken, err error) {
+	defer func(start time.Time) { r.durations.observe(ctx, "relationshipReader.snapshotAt", start, err) }(time.Now())
+	return r.delegate.SnapshotAt(ctx, tenantID, at)
+}
+
+// ReadDeletedRelationships - Reads relation tuples deleted within the given time window from the repository.
+func (r *RelationshipReaderWithMetrics) ReadDeletedRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, from, to time.Time) (collection *database.TupleCollection, err error) {
+	defer func(start time.Time) { r.durations.observe(ctx, "relationshipReader.readDeletedRelationships", start, err) }(time.Now())
+	return r.delegate.ReadDeletedRelationships(ctx, tenantID, filter, from, to)
+}
+
+// ReadRelationshipChanges - Reads relation tuples created and deleted between the snapshots of two tokens from the repository.
+func (r *RelationshipReaderWithMetrics) ReadRelationshipChanges(ctx context.Context, tenantID string, filter *base.TupleFilter, from, to string) (created, deleted *database.TupleCollection, err error) {
+	defer func(start time.Time) { r.durations.observe(ctx, "relationshipReader.readRelationshipChanges", start, err) }(time.Now())
+	return r.delegate.ReadRelationshipChanges(ctx, tenantID, filter, from, to)
+}
@@ -0,0 +1,115 @@
+package decorators
+
+import (
+	"context"
+	"errors"
+	"time"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/pkg/database"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/token"
+)
+
+// RelationshipReaderWithRegion - Issues region aware snap tokens in a multi-region deployment. The regions read
+// replicas of the same storage, so the token of another region is read at once the replica of this region has
+// replicated its snapshot.
+type RelationshipReaderWithRegion struct {
+	delegate repositories.RelationshipReader
+	region   string
+	// decode - nil when the storage can not decode its tokens, the tokens of the other regions are read as they are
+	decode token.Decoder
+}
+
+// NewRelationshipReaderWithRegion - Add region aware snap tokens to new relationship reader
+func NewRelationshipReaderWithRegion(delegate repositories.RelationshipReader, region string, decode token.Decoder) *RelationshipReaderWithRegion {
+	return &RelationshipReaderWithRegion{delegate: delegate, region: region, decode: decode}
+}
+
+// QueryRelationships - Reads relation tuples from the repository
+func (r *RelationshipReaderWithRegion) QueryRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (*database.TupleIterator, error) {
+	s, err := r.snapshot(ctx, tenantID, snap)
+	if err != nil {
+		return nil, err
+	}
+	return r.delegate.QueryRelationships(ctx, tenantID, filter, s)
+}
+
+// ReadRelationships - Reads relation tuples from the repository with different options.
+func (r *RelationshipReaderWithRegion) ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, pagination database.Pagination) (*database.TupleCollection, database.EncodedContinuousToken, error) {
+	s, err := r.snapshot(ctx, tenantID, snap)
+	if err != nil {
+		return nil, nil, err
+	}
+	return r.delegate.ReadRelationships(ctx, tenantID, filter, s, pagination)
+}
+
+// GetUniqueEntityIDsByEntityType - Reads unique entity IDs from the repository
+func (r *RelationshipReaderWithRegion) GetUniqueEntityIDsByEntityType(ctx context.Context, tenantID string, typ, snap string) ([]string, error) {
+	s, err := r.snapshot(ctx, tenantID, snap)
+	if err != nil {
+		return nil, err
+	}
+	return r.delegate.GetUniqueEntityIDsByEntityType(ctx, tenantID, typ, s)
+}
+
+// HeadSnapshot - Reads the latest version of the snapshot from the repository.
+func (r *RelationshipReaderWithRegion) HeadSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error) {
+	st, err := r.delegate.HeadSnapshot(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return token.NewRegionalToken(r.region, st), nil
+}
+
+// SnapshotAt - Reads the snapshot that was the latest at the given time from the repository.
+func (r *RelationshipReaderWithRegion) SnapshotAt(ctx context.Context, tenantID string, at time.Time) (token.SnapToken, error) {
+	st, err := r.delegate.SnapshotAt(ctx, tenantID, at)
+	if err != nil {
+		return nil, err
+	}
+	return token.NewRegionalToken(r.region, st), nil
+}
+
+// ReadDeletedRelationships - Reads relation tuples deleted within the given time window from the repository.
+func (r *RelationshipReaderWithRegion) ReadDeletedRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, from, to time.Time) (*database.TupleCollection, error) {
+	return r.delegate.ReadDeletedRelationships(ctx, tenantID, filter, from, to)
+}
+
+// ReadRelationshipChanges - Reads relation tuples created and deleted between the snapshots of two tokens from the repository.
+func (r *RelationshipReaderWithRegion) ReadRelationshipChanges(ctx context.Context, tenantID string, filter *base.TupleFilter, from, to string) (*database.TupleCollection, *database.TupleCollection, error) {
+	f, err := r.snapshot(ctx, tenantID, from)
+	if err != nil {
+		return nil, nil, err
+	}
+	var t string
+	t, err = r.snapshot(ctx, tenantID, to)
+	if err != nil {
+		return nil, nil, err
+	}
+	return r.delegate.ReadRelationshipChanges(ctx, tenantID, filter, f, t)
+}
+
+// snapshot - Storage token of the regional token. The token of another region is compared with the head snapshot
+// of this region and fails when the replica has not replicated it yet, instead of reading a snapshot it does not have.
+func (r *RelationshipReaderWithRegion) snapshot(ctx context.Context, tenantID, snap string) (string, error) {
+	region, s, err := token.SplitRegionalToken(snap)
+	if err != nil {
+		return "", err
+	}
+	if region == "" || region == r.region || s == "" || r.decode == nil {
+		return s, nil
+	}
+	requested, err := r.decode(s)
+	if err != nil {
+		return "", err
+	}
+	head, err := r.delegate.HeadSnapshot(ctx, tenantID)
+	if err != nil {
+		return "", err
+	}
+	if requested.Gt(head) {
+		return "", errors.New(base.ErrorCode_ERROR_CODE_SNAPSHOT_NOT_REPLICATED.String())
+	}
+	return s, nil
+}
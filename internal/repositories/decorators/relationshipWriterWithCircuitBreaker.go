@@ -48,6 +48,33 @@ func (r *RelationshipWriterWithCircuitBreaker) WriteRelationships(ctx context.Co
 	}
 }
 
+// BatchWriteRelationships - Write a large collection of relation tuples to the repository, it has a longer timeout
+// than the other writes
+func (r *RelationshipWriterWithCircuitBreaker) BatchWriteRelationships(ctx context.Context, tenantID string, collection *database.TupleCollection) (token.EncodedSnapToken, error) {
+	type circuitBreakerResponse struct {
+		Token token.EncodedSnapToken
+		Error error
+	}
+	
+	output := make(chan circuitBreakerResponse, 1)
+	
+	hystrix.ConfigureCommand("relationshipWriter.batchWriteRelationships", hystrix.CommandConfig{Timeout: 30000})
+	bErrors := hystrix.Go("relationshipWriter.batchWriteRelationships", func() error {
+		t, err := r.delegate.BatchWriteRelationships(ctx, tenantID, collection)
+		output <- circuitBreakerResponse{Token: t, Error: err}
+		return nil
+	}, func(err error) error {
+		return nil
+	})
+	
+	select {
+	case out := <-output:
+		return out.Token, out.Error
+	case <-bErrors:
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_CIRCUIT_BREAKER.String())
+	}
+}
+
 // DeleteRelationships - Delete relation tuples from the repository
 func (r *RelationshipWriterWithCircuitBreaker) DeleteRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter) (token.EncodedSnapToken, error) {
 	type circuitBreakerResponse struct {
@@ -0,0 +1,58 @@
+package decorators
+
+import (
+	"context"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/pkg/database"
+	"github.com/adminium/permify/pkg/encryption"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/token"
+)
+
+// RelationshipWriterWithEncryption - Encrypts the ids of the tuples of the encrypted tenants before they are stored
+type RelationshipWriterWithEncryption struct {
+	delegate repositories.RelationshipWriter
+	keyring  *encryption.Keyring
+}
+
+// NewRelationshipWriterWithEncryption - Add encryption at rest to new relationship writer
+func NewRelationshipWriterWithEncryption(delegate repositories.RelationshipWriter, keyring *encryption.Keyring) *RelationshipWriterWithEncryption {
+	return &RelationshipWriterWithEncryption{delegate: delegate, keyring: keyring}
+}
+
+// WriteRelationships - Write relation tuples to the repository
+func (r *RelationshipWriterWithEncryption) WriteRelationships(ctx context.Context, tenantID string, collection *database.TupleCollection) (token.EncodedSnapToken, error) {
+	c, err := newTupleCipher(ctx, r.keyring, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if c != nil {
+		collection = c.encryptCollection(collection)
+	}
+	return r.delegate.WriteRelationships(ctx, tenantID, collection)
+}
+
+// BatchWriteRelationships - Write a large collection of relation tuples to the repository
+func (r *RelationshipWriterWithEncryption) BatchWriteRelationships(ctx context.Context, tenantID string, collection *database.TupleCollection) (token.EncodedSnapToken, error) {
+	c, err := newTupleCipher(ctx, r.keyring, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if c != nil {
+		collection = c.encryptCollection(collection)
+	}
+	return r.delegate.BatchWriteRelationships(ctx, tenantID, collection)
+}
+
+// DeleteRelationships - Delete relation tuples from the repository
+func (r *RelationshipWriterWithEncryption) DeleteRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter) (token.EncodedSnapToken, error) {
+	c, err := newTupleCipher(ctx, r.keyring, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if c != nil {
+		filter = c.encryptFilter(filter)
+	}
+	return r.delegate.DeleteRelationships(ctx, tenantID, filter)
+}
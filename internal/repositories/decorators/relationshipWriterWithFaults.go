@@ -0,0 +1,59 @@
+package decorators
+
+import (
+	"context"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/pkg/database"
+	"github.com/adminium/permify/pkg/faults"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/token"
+)
+
+// RelationshipWriterWithFaults - Injects latency and errors into the writes of relation tuples, a partial failure
+// applies the write but loses its response
+type RelationshipWriterWithFaults struct {
+	delegate repositories.RelationshipWriter
+	faults   *faults.Injector
+}
+
+// NewRelationshipWriterWithFaults - Add fault injection to new relationship writer
+func NewRelationshipWriterWithFaults(delegate repositories.RelationshipWriter, faults *faults.Injector) *RelationshipWriterWithFaults {
+	return &RelationshipWriterWithFaults{delegate: delegate, faults: faults}
+}
+
+// WriteRelationships - Write relation tuples to the repository
+func (r *RelationshipWriterWithFaults) WriteRelationships(ctx context.Context, tenantID string, collection *database.TupleCollection) (token.EncodedSnapToken, error) {
+	if err := r.faults.Before(ctx); err != nil {
+		return nil, err
+	}
+	t, err := r.delegate.WriteRelationships(ctx, tenantID, collection)
+	if err = r.faults.After(err); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// BatchWriteRelationships - Write a large collection of relation tuples to the repository
+func (r *RelationshipWriterWithFaults) BatchWriteRelationships(ctx context.Context, tenantID string, collection *database.TupleCollection) (token.EncodedSnapToken, error) {
+	if err := r.faults.Before(ctx); err != nil {
+		return nil, err
+	}
+	t, err := r.delegate.BatchWriteRelationships(ctx, tenantID, collection)
+	if err = r.faults.After(err); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// DeleteRelationships - Delete relation tuples from the repository
+func (r *RelationshipWriterWithFaults) DeleteRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter) (token.EncodedSnapToken, error) {
+	if err := r.faults.Before(ctx); err != nil {
+		return nil, err
+	}
+	t, err := r.delegate.DeleteRelationships(ctx, tenantID, filter)
+	if err = r.faults.After(err); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
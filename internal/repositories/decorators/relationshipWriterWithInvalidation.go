@@ -0,0 +1,64 @@
+package decorators
+
+import (
+	"context"
+	
+	"github.com/adminium/permify/internal/invalidation"
+	"github.com/adminium/permify/internal/keys"
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/pkg/database"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/token"
+)
+
+// RelationshipWriterWithInvalidation - Invalidates the cached checks of the permissions that depend on the written relations
+type RelationshipWriterWithInvalidation struct {
+	delegate     repositories.RelationshipWriter
+	schemaReader repositories.SchemaReader
+	keys         keys.CommandKeyManager
+}
+
+// NewRelationshipWriterWithInvalidation - Add relation aware cache invalidation to new relationship writer
+func NewRelationshipWriterWithInvalidation(delegate repositories.RelationshipWriter, schemaReader repositories.SchemaReader, keys keys.CommandKeyManager) *RelationshipWriterWithInvalidation {
+	return &RelationshipWriterWithInvalidation{
+		delegate:     delegate,
+		schemaReader: schemaReader,
+		keys:         keys,
+	}
+}
+
+// WriteRelationships - Write relation tuples to the repository
+func (r *RelationshipWriterWithInvalidation) WriteRelationships(ctx context.Context, tenantID string, collection *database.TupleCollection) (token.EncodedSnapToken, error) {
+	t, err := r.delegate.WriteRelationships(ctx, tenantID, collection)
+	if err != nil {
+		return nil, err
+	}
+	invalidation.Invalidate(ctx, r.schemaReader, r.keys, tenantID, invalidation.Changed(collection))
+	return t, nil
+}
+
+// BatchWriteRelationships - Write a large collection of relation tuples to the repository
+func (r *RelationshipWriterWithInvalidation) BatchWriteRelationships(ctx context.Context, tenantID string, collection *database.TupleCollection) (token.EncodedSnapToken, error) {
+	t, err := r.delegate.BatchWriteRelationships(ctx, tenantID, collection)
+	if err != nil {
+		return nil, err
+	}
+	invalidation.Invalidate(ctx, r.schemaReader, r.keys, tenantID, invalidation.Changed(collection))
+	return t, nil
+}
+
+// DeleteRelationships - Delete relation tuples from the repository
+func (r *RelationshipWriterWithInvalidation) DeleteRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter) (token.EncodedSnapToken, error) {
+	t, err := r.delegate.DeleteRelationships(ctx, tenantID, filter)
+	if err != nil {
+		return nil, err
+	}
+	if filter.GetEntity().GetType() == "" {
+		r.keys.InvalidateCheckKeys(tenantID)
+		return t, nil
+	}
+	invalidation.Invalidate(ctx, r.schemaReader, r.keys, tenantID, map[string]*base.RelationReference{
+		filter.GetEntity().GetType() + "#" + filter.GetRelation(): {Type: filter.GetEntity().GetType(), Relation: filter.GetRelation()},
+	})
+	return t, nil
+}
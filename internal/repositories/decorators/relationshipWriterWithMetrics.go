@@ -0,0 +1,45 @@
+package decorators
+
+import (
+	"context"
+	"time"
+	
+	"go.opentelemetry.io/otel/metric"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/pkg/database"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/token"
+)
+
+// RelationshipWriterWithMetrics - Records the duration of every write of the relation tuples
+type RelationshipWriterWithMetrics struct {
+	delegate  repositories.RelationshipWriter
+	durations queryDurations
+}
+
+// NewRelationshipWriterWithMetrics - Add metrics to new relationship writer
+func NewRelationshipWriterWithMetrics(delegate repositories.RelationshipWriter, m metric.Meter) *RelationshipWriterWithMetrics {
+	return &RelationshipWriterWithMetrics{
+		delegate:  delegate,
+		durations: newQueryDurations(m),
+	}
+}
+
+// WriteRelationships - Write relation tuples from the repository
+func (r *RelationshipWriterWithMetrics) WriteRelationships(ctx context.Context, tenantID string, collection *database.TupleCollection) (t token.EncodedSnapToken, err error) {
+	defer func(start time.Time) { r.durations.observe(ctx, "relationshipWriter.writeRelationships", start, err) }(time.Now())
+	return r.delegate.WriteRelationships(ctx, tenantID, collection)
+}
+
+// BatchWriteRelationships - Write a large collection of relation tuples to the repository
+func (r *RelationshipWriterWithMetrics) BatchWriteRelationships(ctx context.Context, tenantID string, collection *database.TupleCollection) (t token.EncodedSnapToken, err error) {
+	defer func(start time.Time) { r.durations.observe(ctx, "relationshipWriter.batchWriteRelationships", start, err) }(time.Now())
+	return r.delegate.BatchWriteRelationships(ctx, tenantID, collection)
+}
+
+// DeleteRelationships - Delete relation tuples from the repository
+func (r *RelationshipWriterWithMetrics) DeleteRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter) (t token.EncodedSnapToken, err error) {
+	defer func(start time.Time) { r.durations.observe(ctx, "relationshipWriter.deleteRelationships", start, err) }(time.Now())
+	return r.delegate.DeleteRelationships(ctx, tenantID, filter)
+}
@@ -0,0 +1,48 @@
+package decorators
+
+import (
+	"context"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/pkg/database"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/token"
+)
+
+// RelationshipWriterWithRegion - Issues region aware snap tokens in a multi-region deployment
+type RelationshipWriterWithRegion struct {
+	delegate repositories.RelationshipWriter
+	region   string
+}
+
+// NewRelationshipWriterWithRegion - Add region aware snap tokens to new relationship writer
+func NewRelationshipWriterWithRegion(delegate repositories.RelationshipWriter, region string) *RelationshipWriterWithRegion {
+	return &RelationshipWriterWithRegion{delegate: delegate, region: region}
+}
+
+// WriteRelationships - Write relation tuples to the repository
+func (r *RelationshipWriterWithRegion) WriteRelationships(ctx context.Context, tenantID string, collection *database.TupleCollection) (token.EncodedSnapToken, error) {
+	t, err := r.delegate.WriteRelationships(ctx, tenantID, collection)
+	if err != nil {
+		return nil, err
+	}
+	return token.RegionalEncodedToken{Region: r.region, Token: t}, nil
+}
+
+// BatchWriteRelationships - Write a large collection of relation tuples to the repository
+func (r *RelationshipWriterWithRegion) BatchWriteRelationships(ctx context.Context, tenantID string, collection *database.TupleCollection) (token.EncodedSnapToken, error) {
+	t, err := r.delegate.BatchWriteRelationships(ctx, tenantID, collection)
+	if err != nil {
+		return nil, err
+	}
+	return token.RegionalEncodedToken{Region: r.region, Token: t}, nil
+}
+
+// DeleteRelationships - Delete relation tuples from the repository
+func (r *RelationshipWriterWithRegion) DeleteRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter) (token.EncodedSnapToken, error) {
+	t, err := r.delegate.DeleteRelationships(ctx, tenantID, filter)
+	if err != nil {
+		return nil, err
+	}
+	return token.RegionalEncodedToken{Region: r.region, Token: t}, nil
+}
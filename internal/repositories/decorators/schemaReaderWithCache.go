@@ -8,6 +8,7 @@ import (
 	
 	"github.com/adminium/permify/internal/repositories"
 	"github.com/adminium/permify/pkg/cache"
+	"github.com/adminium/permify/pkg/database"
 	base "github.com/adminium/permify/pkg/pb/base/v1"
 )
 
@@ -53,7 +54,37 @@ func (r *SchemaReaderWithCache) ReadSchemaDefinition(ctx context.Context, tenant
 	return def, "", err
 }
 
+// ReadSchemaString - Read the serialized definitions of the schema version, versions never change so they are cached
+func (r *SchemaReaderWithCache) ReadSchemaString(ctx context.Context, tenantID, version string) (definitions []string, err error) {
+	if version == "" {
+		return r.delegate.ReadSchemaString(ctx, tenantID, version)
+	}
+	key := fmt.Sprintf("%s|%s|serialized", tenantID, version)
+	if s, found := r.cache.Get(key); found {
+		def, ok := s.([]string)
+		if !ok {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_SCAN.String())
+		}
+		return def, nil
+	}
+	definitions, err = r.delegate.ReadSchemaString(ctx, tenantID, version)
+	if err != nil {
+		return nil, err
+	}
+	size := 0
+	for _, definition := range definitions {
+		size += len(definition)
+	}
+	r.cache.Set(key, definitions, int64(size))
+	return definitions, nil
+}
+
 // HeadVersion - Finds the latest version of the schema.
 func (r *SchemaReaderWithCache) HeadVersion(ctx context.Context, tenantID string) (version string, err error) {
 	return r.delegate.HeadVersion(ctx, tenantID)
 }
+
+// ListSchemaVersions - Lists the versions of the schema, new versions are written at any time so they are not cached
+func (r *SchemaReaderWithCache) ListSchemaVersions(ctx context.Context, tenantID string, pagination database.Pagination) (versions []string, ct database.EncodedContinuousToken, err error) {
+	return r.delegate.ListSchemaVersions(ctx, tenantID, pagination)
+}
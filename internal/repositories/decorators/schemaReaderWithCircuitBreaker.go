@@ -7,6 +7,7 @@ import (
 	"github.com/afex/hystrix-go/hystrix"
 	
 	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/pkg/database"
 	base "github.com/adminium/permify/pkg/pb/base/v1"
 )
 
@@ -73,6 +74,32 @@ func (r *SchemaReaderWithCircuitBreaker) ReadSchemaDefinition(ctx context.Contex
 	}
 }
 
+// ReadSchemaString - Read the serialized definitions of the schema version from repository
+func (r *SchemaReaderWithCircuitBreaker) ReadSchemaString(ctx context.Context, tenantID, version string) ([]string, error) {
+	type circuitBreakerResponse struct {
+		Definitions []string
+		Error       error
+	}
+	
+	output := make(chan circuitBreakerResponse, 1)
+	
+	hystrix.ConfigureCommand("schemaReader.readSchemaString", hystrix.CommandConfig{Timeout: 1000})
+	bErrors := hystrix.Go("schemaReader.readSchemaString", func() error {
+		definitions, err := r.delegate.ReadSchemaString(ctx, tenantID, version)
+		output <- circuitBreakerResponse{Definitions: definitions, Error: err}
+		return nil
+	}, func(err error) error {
+		return nil
+	})
+	
+	select {
+	case out := <-output:
+		return out.Definitions, out.Error
+	case <-bErrors:
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_CIRCUIT_BREAKER.String())
+	}
+}
+
 // HeadVersion - Finds the latest version of the schema.
 func (r *SchemaReaderWithCircuitBreaker) HeadVersion(ctx context.Context, tenantID string) (version string, err error) {
 	type circuitBreakerResponse struct {
@@ -98,3 +125,30 @@ func (r *SchemaReaderWithCircuitBreaker) HeadVersion(ctx context.Context, tenant
 		return "", errors.New(base.ErrorCode_ERROR_CODE_CIRCUIT_BREAKER.String())
 	}
 }
+
+// ListSchemaVersions - Lists the versions of the schema.
+func (r *SchemaReaderWithCircuitBreaker) ListSchemaVersions(ctx context.Context, tenantID string, pagination database.Pagination) (versions []string, ct database.EncodedContinuousToken, err error) {
+	type circuitBreakerResponse struct {
+		Versions []string
+		Ct       database.EncodedContinuousToken
+		Error    error
+	}
+	
+	output := make(chan circuitBreakerResponse, 1)
+	
+	hystrix.ConfigureCommand("schemaReader.listSchemaVersions", hystrix.CommandConfig{Timeout: 1000})
+	bErrors := hystrix.Go("schemaReader.listSchemaVersions", func() error {
+		v, c, err := r.delegate.ListSchemaVersions(ctx, tenantID, pagination)
+		output <- circuitBreakerResponse{Versions: v, Ct: c, Error: err}
+		return nil
+	}, func(err error) error {
+		return nil
+	})
+	
+	select {
+	case out := <-output:
+		return out.Versions, out.Ct, out.Error
+	case <-bErrors:
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_CIRCUIT_BREAKER.String())
+	}
+}
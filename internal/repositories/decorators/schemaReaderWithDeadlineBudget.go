@@ -0,0 +1,70 @@
+package decorators
+
+import (
+	"context"
+	
+	"github.com/adminium/permify/internal/deadline"
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/pkg/database"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// SchemaReaderWithDeadlineBudget - Gives every read of the schemas the schema share of the time left before the
+// deadline of its request, a read cut at the end of its share is tried once more with the rest
+type SchemaReaderWithDeadlineBudget struct {
+	delegate repositories.SchemaReader
+	budget   deadline.Budget
+}
+
+// NewSchemaReaderWithDeadlineBudget - Add deadline budget to new schema reader
+func NewSchemaReaderWithDeadlineBudget(delegate repositories.SchemaReader, budget deadline.Budget) *SchemaReaderWithDeadlineBudget {
+	return &SchemaReaderWithDeadlineBudget{
+		delegate: delegate,
+		budget:   budget,
+	}
+}
+
+// ReadSchema - Read schema from repository
+func (r *SchemaReaderWithDeadlineBudget) ReadSchema(ctx context.Context, tenantID string, version string) (sch *base.SchemaDefinition, err error) {
+	err = r.budget.Run(ctx, r.budget.Schema, func(ctx context.Context) (err error) {
+		sch, err = r.delegate.ReadSchema(ctx, tenantID, version)
+		return err
+	})
+	return sch, err
+}
+
+// ReadSchemaDefinition - Read schema definition from repository
+func (r *SchemaReaderWithDeadlineBudget) ReadSchemaDefinition(ctx context.Context, tenantID, entityType, version string) (definition *base.EntityDefinition, v string, err error) {
+	err = r.budget.Run(ctx, r.budget.Schema, func(ctx context.Context) (err error) {
+		definition, v, err = r.delegate.ReadSchemaDefinition(ctx, tenantID, entityType, version)
+		return err
+	})
+	return definition, v, err
+}
+
+// ReadSchemaString - Read the serialized definitions of the schema version from repository
+func (r *SchemaReaderWithDeadlineBudget) ReadSchemaString(ctx context.Context, tenantID, version string) (definitions []string, err error) {
+	err = r.budget.Run(ctx, r.budget.Schema, func(ctx context.Context) (err error) {
+		definitions, err = r.delegate.ReadSchemaString(ctx, tenantID, version)
+		return err
+	})
+	return definitions, err
+}
+
+// HeadVersion - Finds the latest version of the schema.
+func (r *SchemaReaderWithDeadlineBudget) HeadVersion(ctx context.Context, tenantID string) (version string, err error) {
+	err = r.budget.Run(ctx, r.budget.Schema, func(ctx context.Context) (err error) {
+		version, err = r.delegate.HeadVersion(ctx, tenantID)
+		return err
+	})
+	return version, err
+}
+
+// ListSchemaVersions - Lists the versions of the schema.
+func (r *SchemaReaderWithDeadlineBudget) ListSchemaVersions(ctx context.Context, tenantID string, pagination database.Pagination) (versions []string, ct database.EncodedContinuousToken, err error) {
+	err = r.budget.Run(ctx, r.budget.Schema, func(ctx context.Context) (err error) {
+		versions, ct, err = r.delegate.ListSchemaVersions(ctx, tenantID, pagination)
+		return err
+	})
+	return versions, ct, err
+}
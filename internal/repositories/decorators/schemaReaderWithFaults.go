@@ -0,0 +1,61 @@
+package decorators
+
+import (
+	"context"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/pkg/database"
+	"github.com/adminium/permify/pkg/faults"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// SchemaReaderWithFaults - Injects latency and errors into the reads of schemas
+type SchemaReaderWithFaults struct {
+	delegate repositories.SchemaReader
+	faults   *faults.Injector
+}
+
+// NewSchemaReaderWithFaults - Add fault injection to new schema reader
+func NewSchemaReaderWithFaults(delegate repositories.SchemaReader, faults *faults.Injector) *SchemaReaderWithFaults {
+	return &SchemaReaderWithFaults{delegate: delegate, faults: faults}
+}
+
+// ReadSchema - Read schema from repository
+func (r *SchemaReaderWithFaults) ReadSchema(ctx context.Context, tenantID, version string) (*base.SchemaDefinition, error) {
+	if err := r.faults.Before(ctx); err != nil {
+		return nil, err
+	}
+	return r.delegate.ReadSchema(ctx, tenantID, version)
+}
+
+// ReadSchemaDefinition - Read schema definition from repository
+func (r *SchemaReaderWithFaults) ReadSchemaDefinition(ctx context.Context, tenantID, entityType, version string) (*base.EntityDefinition, string, error) {
+	if err := r.faults.Before(ctx); err != nil {
+		return nil, "", err
+	}
+	return r.delegate.ReadSchemaDefinition(ctx, tenantID, entityType, version)
+}
+
+// ReadSchemaString - Read the serialized entity definitions of the schema from repository
+func (r *SchemaReaderWithFaults) ReadSchemaString(ctx context.Context, tenantID, version string) ([]string, error) {
+	if err := r.faults.Before(ctx); err != nil {
+		return nil, err
+	}
+	return r.delegate.ReadSchemaString(ctx, tenantID, version)
+}
+
+// HeadVersion - Finds the latest version of the schema.
+func (r *SchemaReaderWithFaults) HeadVersion(ctx context.Context, tenantID string) (string, error) {
+	if err := r.faults.Before(ctx); err != nil {
+		return "", err
+	}
+	return r.delegate.HeadVersion(ctx, tenantID)
+}
+
+// ListSchemaVersions - Lists the versions of the schema from repository
+func (r *SchemaReaderWithFaults) ListSchemaVersions(ctx context.Context, tenantID string, pagination database.Pagination) ([]string, database.EncodedContinuousToken, error) {
+	if err := r.faults.Before(ctx); err != nil {
+		return nil, nil, err
+	}
+	return r.delegate.ListSchemaVersions(ctx, tenantID, pagination)
+}
@@ -0,0 +1,56 @@
+package decorators
+
+import (
+	"context"
+	"time"
+	
+	"go.opentelemetry.io/otel/metric"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/pkg/database"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// SchemaReaderWithMetrics - Records the duration of every read of the schemas
+type SchemaReaderWithMetrics struct {
+	delegate  repositories.SchemaReader
+	durations queryDurations
+}
+
+// NewSchemaReaderWithMetrics - Add metrics to new schema reader
+func NewSchemaReaderWithMetrics(delegate repositories.SchemaReader, m metric.Meter) *SchemaReaderWithMetrics {
+	return &SchemaReaderWithMetrics{
+		delegate:  delegate,
+		durations: newQueryDurations(m),
+	}
+}
+
+// ReadSchema - Read schema from repository
+func (r *SchemaReaderWithMetrics) ReadSchema(ctx context.Context, tenantID string, version string) (sch *base.SchemaDefinition, err error) {
+	defer func(start time.Time) { r.durations.observe(ctx, "schemaReader.readSchema", start, err) }(time.Now())
+	return r.delegate.ReadSchema(ctx, tenantID, version)
+}
+
+// ReadSchemaDefinition - Read schema definition from repository
+func (r *SchemaReaderWithMetrics) ReadSchemaDefinition(ctx context.Context, tenantID, entityType, version string) (definition *base.EntityDefinition, v string, err error) {
+	defer func(start time.Time) { r.durations.observe(ctx, "schemaReader.readSchemaDefinition", start, err) }(time.Now())
+	return r.delegate.ReadSchemaDefinition(ctx, tenantID, entityType, version)
+}
+
+// ReadSchemaString - Read the serialized definitions of the schema version from repository
+func (r *SchemaReaderWithMetrics) ReadSchemaString(ctx context.Context, tenantID, version string) (definitions []string, err error) {
+	defer func(start time.Time) { r.durations.observe(ctx, "schemaReader.readSchemaString", start, err) }(time.Now())
+	return r.delegate.ReadSchemaString(ctx, tenantID, version)
+}
+
+// HeadVersion - Finds the latest version of the schema.
+func (r *SchemaReaderWithMetrics) HeadVersion(ctx context.Context, tenantID string) (version string, err error) {
+	defer func(start time.Time) { r.durations.observe(ctx, "schemaReader.headVersion", start, err) }(time.Now())
+	return r.delegate.HeadVersion(ctx, tenantID)
+}
+
+// ListSchemaVersions - Lists the versions of the schema.
+func (r *SchemaReaderWithMetrics) ListSchemaVersions(ctx context.Context, tenantID string, pagination database.Pagination) (versions []string, ct database.EncodedContinuousToken, err error) {
+	defer func(start time.Time) { r.durations.observe(ctx, "schemaReader.listSchemaVersions", start, err) }(time.Now())
+	return r.delegate.ListSchemaVersions(ctx, tenantID, pagination)
+}
@@ -0,0 +1,28 @@
+package decorators
+
+import (
+	"context"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/pkg/faults"
+)
+
+// SchemaWriterWithFaults - Injects latency and errors into the writes of schemas, a partial failure applies the write
+// but loses its response
+type SchemaWriterWithFaults struct {
+	delegate repositories.SchemaWriter
+	faults   *faults.Injector
+}
+
+// NewSchemaWriterWithFaults - Add fault injection to new schema writer
+func NewSchemaWriterWithFaults(delegate repositories.SchemaWriter, faults *faults.Injector) *SchemaWriterWithFaults {
+	return &SchemaWriterWithFaults{delegate: delegate, faults: faults}
+}
+
+// WriteSchema - Write schema to repository
+func (r *SchemaWriterWithFaults) WriteSchema(ctx context.Context, definitions []repositories.SchemaDefinition) error {
+	if err := r.faults.Before(ctx); err != nil {
+		return err
+	}
+	return r.faults.After(r.delegate.WriteSchema(ctx, definitions))
+}
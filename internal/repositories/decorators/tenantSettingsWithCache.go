@@ -0,0 +1,62 @@
+package decorators
+
+import (
+	"context"
+	"time"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/pkg/cache"
+)
+
+// _defaultTenantSettingsCacheTTL - Time the settings of a tenant are kept before they are read again, so that the
+// writes of other instances are picked up
+const _defaultTenantSettingsCacheTTL = 10 * time.Second
+
+// tenantSettingsEntry - Cached settings of a tenant
+type tenantSettingsEntry struct {
+	settings repositories.TenantSettings
+	at       time.Time
+}
+
+// TenantSettingsWithCache - Add cache behaviour to the tenant settings, they are read by every check
+type TenantSettingsWithCache struct {
+	reader repositories.TenantSettingsReader
+	writer repositories.TenantSettingsWriter
+	cache  cache.Cache
+}
+
+// NewTenantSettingsWithCache new instance of TenantSettingsWithCache
+func NewTenantSettingsWithCache(reader repositories.TenantSettingsReader, writer repositories.TenantSettingsWriter, cache cache.Cache) *TenantSettingsWithCache {
+	return &TenantSettingsWithCache{
+		reader: reader,
+		writer: writer,
+		cache:  cache,
+	}
+}
+
+// ReadTenantSettings - Read the settings of the tenant from the cache or the repository
+func (r *TenantSettingsWithCache) ReadTenantSettings(ctx context.Context, tenantID string) (settings repositories.TenantSettings, err error) {
+	key := "tenant_settings|" + tenantID
+	if s, found := r.cache.Get(key); found {
+		if entry, ok := s.(tenantSettingsEntry); ok && time.Since(entry.at) < _defaultTenantSettingsCacheTTL {
+			return entry.settings, nil
+		}
+	}
+	settings, err = r.reader.ReadTenantSettings(ctx, tenantID)
+	if err != nil {
+		return settings, err
+	}
+	r.cache.Set(key, tenantSettingsEntry{settings: settings, at: time.Now()}, int64(len(key)))
+	return settings, nil
+}
+
+// WriteTenantSettings - Write the settings of the tenant to the repository, the cached settings are replaced
+func (r *TenantSettingsWithCache) WriteTenantSettings(ctx context.Context, tenantID string, settings repositories.TenantSettings) (err error) {
+	if err = r.writer.WriteTenantSettings(ctx, tenantID, settings); err != nil {
+		return err
+	}
+	key := "tenant_settings|" + tenantID
+	r.cache.Set(key, tenantSettingsEntry{settings: settings, at: time.Now()}, int64(len(key)))
+	r.cache.Wait()
+	return nil
+}
@@ -0,0 +1,62 @@
+package decorators
+
+import (
+	"context"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/pkg/encryption"
+)
+
+// WatcherWithEncryption - Decrypts the streamed changes of the encrypted tenants
+type WatcherWithEncryption struct {
+	delegate repositories.Watcher
+	keyring  *encryption.Keyring
+}
+
+// NewWatcherWithEncryption - Add encryption at rest to new watcher
+func NewWatcherWithEncryption(delegate repositories.Watcher, keyring *encryption.Keyring) *WatcherWithEncryption {
+	return &WatcherWithEncryption{delegate: delegate, keyring: keyring}
+}
+
+// Watch - Streams the changes of the relation tuples after the snapshot of the token
+func (r *WatcherWithEncryption) Watch(ctx context.Context, tenantID string, snap string) (<-chan *repositories.RelationshipChanges, <-chan error) {
+	changes := make(chan *repositories.RelationshipChanges)
+	errs := make(chan error, 1)
+	
+	c, err := newTupleCipher(ctx, r.keyring, tenantID)
+	if err != nil {
+		errs <- err
+		close(changes)
+		close(errs)
+		return changes, errs
+	}
+	if c == nil {
+		return r.delegate.Watch(ctx, tenantID, snap)
+	}
+	
+	delegateChanges, delegateErrs := r.delegate.Watch(ctx, tenantID, snap)
+	go func() {
+		defer close(changes)
+		defer close(errs)
+		for ch := range delegateChanges {
+			var err error
+			if ch.Created, err = c.decryptCollection(ch.Created); err != nil {
+				errs <- err
+				return
+			}
+			if ch.Deleted, err = c.decryptCollection(ch.Deleted); err != nil {
+				errs <- err
+				return
+			}
+			select {
+			case changes <- ch:
+			case <-ctx.Done():
+				return
+			}
+		}
+		if err, ok := <-delegateErrs; ok {
+			errs <- err
+		}
+	}()
+	return changes, errs
+}
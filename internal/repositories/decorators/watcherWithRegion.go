@@ -0,0 +1,51 @@
+package decorators
+
+import (
+	"context"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/pkg/token"
+)
+
+// WatcherWithRegion - Issues region aware snap tokens for the streamed changes in a multi-region deployment
+type WatcherWithRegion struct {
+	delegate repositories.Watcher
+	region   string
+}
+
+// NewWatcherWithRegion - Add region aware snap tokens to new watcher
+func NewWatcherWithRegion(delegate repositories.Watcher, region string) *WatcherWithRegion {
+	return &WatcherWithRegion{delegate: delegate, region: region}
+}
+
+// Watch - Streams the changes of the relation tuples after the snapshot of the token
+func (r *WatcherWithRegion) Watch(ctx context.Context, tenantID string, snap string) (<-chan *repositories.RelationshipChanges, <-chan error) {
+	changes := make(chan *repositories.RelationshipChanges)
+	errs := make(chan error, 1)
+	
+	_, s, err := token.SplitRegionalToken(snap)
+	if err != nil {
+		errs <- err
+		close(changes)
+		close(errs)
+		return changes, errs
+	}
+	
+	delegateChanges, delegateErrs := r.delegate.Watch(ctx, tenantID, s)
+	go func() {
+		defer close(changes)
+		defer close(errs)
+		for c := range delegateChanges {
+			c.SnapToken = r.region + token.RegionSeparator + c.SnapToken
+			select {
+			case changes <- c:
+			case <-ctx.Done():
+				return
+			}
+		}
+		if err, ok := <-delegateErrs; ok {
+			errs <- err
+		}
+	}()
+	return changes, errs
+}
@@ -1,59 +1,76 @@
 package repositories
 
 import (
-	"context"
-	
-	"github.com/adminium/permify/pkg/database"
-	base "github.com/adminium/permify/pkg/pb/base/v1"
-	"github.com/adminium/permify/pkg/token"
+	"github.com/adminium/permify/pkg/storage"
 )
 
+// The repository interfaces are public in pkg/storage so that out-of-tree backends can implement them.
+
 // RelationshipReader -
-type RelationshipReader interface {
-	// QueryRelationships reads relation tuples from the repository.
-	QueryRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (iterator *database.TupleIterator, err error)
-	// ReadRelationships reads relation tuples from the repository with different options.
-	ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, pagination database.Pagination) (collection *database.TupleCollection, ct database.EncodedContinuousToken, err error)
-	// GetUniqueEntityIDsByEntityType reads unique entity IDs from the repository.
-	GetUniqueEntityIDsByEntityType(ctx context.Context, tenantID string, typ, snap string) (ids []string, err error)
-	// HeadSnapshot reads the latest version of the snapshot from the repository.
-	HeadSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error)
-}
+type RelationshipReader = storage.RelationshipReader
 
 // RelationshipWriter -
-type RelationshipWriter interface {
-	// WriteRelationships writes relation tuples to the repository.
-	WriteRelationships(ctx context.Context, tenantID string, collection *database.TupleCollection) (token token.EncodedSnapToken, err error)
-	// DeleteRelationships deletes relation tuples from the repository.
-	DeleteRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter) (token token.EncodedSnapToken, err error)
-}
+type RelationshipWriter = storage.RelationshipWriter
 
 // SchemaReader -
-type SchemaReader interface {
-	// ReadSchema reads entity config from the repository.
-	ReadSchema(ctx context.Context, tenantID string, version string) (schema *base.SchemaDefinition, err error)
-	// ReadSchemaDefinition reads entity config from the repository.
-	ReadSchemaDefinition(ctx context.Context, tenantID string, entityType, version string) (definition *base.EntityDefinition, v string, err error)
-	// HeadVersion reads the latest version of the schema from the repository.
-	HeadVersion(ctx context.Context, tenantID string) (version string, err error)
-}
+type SchemaReader = storage.SchemaReader
 
 // SchemaWriter -
-type SchemaWriter interface {
-	// WriteSchema writes schema to the repository.
-	WriteSchema(ctx context.Context, definitions []SchemaDefinition) (err error)
-}
+type SchemaWriter = storage.SchemaWriter
 
 // TenantReader -
-type TenantReader interface {
-	// ListTenants reads tenants from the repository.
-	ListTenants(ctx context.Context, pagination database.Pagination) (tenants []*base.Tenant, ct database.EncodedContinuousToken, err error)
-}
+type TenantReader = storage.TenantReader
 
 // TenantWriter -
-type TenantWriter interface {
-	// CreateTenant writes tenant to the repository.
-	CreateTenant(ctx context.Context, id, name string) (tenant *base.Tenant, err error)
-	// DeleteTenant deletes tenant from the repository.
-	DeleteTenant(ctx context.Context, tenantID string) (tenant *base.Tenant, err error)
-}
+type TenantWriter = storage.TenantWriter
+
+// TenantGetter -
+type TenantGetter = storage.TenantGetter
+
+// TenantUpdater -
+type TenantUpdater = storage.TenantUpdater
+
+// IdentityReader -
+type IdentityReader = storage.IdentityReader
+
+// IdentityWriter -
+type IdentityWriter = storage.IdentityWriter
+
+// AttributeReader -
+type AttributeReader = storage.AttributeReader
+
+// AttributeWriter -
+type AttributeWriter = storage.AttributeWriter
+
+// TenantSettingsReader -
+type TenantSettingsReader = storage.TenantSettingsReader
+
+// TenantSettingsWriter -
+type TenantSettingsWriter = storage.TenantSettingsWriter
+
+// APIKeyReader -
+type APIKeyReader = storage.APIKeyReader
+
+// APIKeyWriter -
+type APIKeyWriter = storage.APIKeyWriter
+
+// CheckTraceReader -
+type CheckTraceReader = storage.CheckTraceReader
+
+// CheckTraceWriter -
+type CheckTraceWriter = storage.CheckTraceWriter
+
+// Watcher -
+type Watcher = storage.Watcher
+
+// QueryExplainer -
+type QueryExplainer = storage.QueryExplainer
+
+// GarbageCollector -
+type GarbageCollector = storage.GarbageCollector
+
+// SnapshotHorizon -
+type SnapshotHorizon = storage.SnapshotHorizon
+
+// RelationshipSweeper -
+type RelationshipSweeper = storage.RelationshipSweeper
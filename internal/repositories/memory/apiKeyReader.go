@@ -0,0 +1,72 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	
+	"golang.org/x/exp/slices"
+	
+	"github.com/adminium/permify/internal/repositories"
+	db "github.com/adminium/permify/pkg/database/memory"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// APIKeyReader - Structure for API Key Reader
+type APIKeyReader struct {
+	database *db.Memory
+	// logger
+	logger logger.Interface
+}
+
+// NewAPIKeyReader creates a new APIKeyReader
+func NewAPIKeyReader(database *db.Memory, logger logger.Interface) *APIKeyReader {
+	return &APIKeyReader{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// ReadAPIKey - record not found when no key has the hash
+func (r *APIKeyReader) ReadAPIKey(ctx context.Context, hash string) (key repositories.APIKey, err error) {
+	txn := r.database.DB.Txn(false)
+	defer txn.Abort()
+	var raw interface{}
+	raw, err = txn.First(APIKeysTable, "hash", hash)
+	if err != nil {
+		return key, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	if raw == nil {
+		return key, errors.New(base.ErrorCode_ERROR_CODE_RECORD_NOT_FOUND.String())
+	}
+	var ok bool
+	key, ok = raw.(repositories.APIKey)
+	if !ok {
+		return key, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+	}
+	key.Tenants = slices.Clone(key.Tenants)
+	return key, nil
+}
+
+// ListAPIKeys - the oldest first
+func (r *APIKeyReader) ListAPIKeys(ctx context.Context) (keys []repositories.APIKey, err error) {
+	txn := r.database.DB.Txn(false)
+	defer txn.Abort()
+	it, err := txn.Get(APIKeysTable, "id")
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		key, ok := obj.(repositories.APIKey)
+		if !ok {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		key.Tenants = slices.Clone(key.Tenants)
+		keys = append(keys, key)
+	}
+	sort.SliceStable(keys, func(i, j int) bool {
+		return keys[i].CreatedAt.Before(keys[j].CreatedAt)
+	})
+	return keys, nil
+}
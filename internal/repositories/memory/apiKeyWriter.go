@@ -0,0 +1,58 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	
+	"golang.org/x/exp/slices"
+	
+	"github.com/adminium/permify/internal/repositories"
+	db "github.com/adminium/permify/pkg/database/memory"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// APIKeyWriter - Structure for API Key Writer
+type APIKeyWriter struct {
+	database *db.Memory
+	// logger
+	logger logger.Interface
+}
+
+// NewAPIKeyWriter creates a new APIKeyWriter
+func NewAPIKeyWriter(database *db.Memory, logger logger.Interface) *APIKeyWriter {
+	return &APIKeyWriter{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// WriteAPIKey - the tenants of the key are copied, so the caller may reuse them
+func (w *APIKeyWriter) WriteAPIKey(ctx context.Context, key repositories.APIKey) (err error) {
+	txn := w.database.DB.Txn(true)
+	defer txn.Abort()
+	key.Tenants = slices.Clone(key.Tenants)
+	if err = txn.Insert(APIKeysTable, key); err != nil {
+		return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	txn.Commit()
+	return nil
+}
+
+// DeleteAPIKey - record not found when no key has the id
+func (w *APIKeyWriter) DeleteAPIKey(ctx context.Context, id string) (err error) {
+	txn := w.database.DB.Txn(true)
+	defer txn.Abort()
+	raw, err := txn.First(APIKeysTable, "id", id)
+	if err != nil {
+		return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	if raw == nil {
+		return errors.New(base.ErrorCode_ERROR_CODE_RECORD_NOT_FOUND.String())
+	}
+	if err = txn.Delete(APIKeysTable, raw); err != nil {
+		return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	txn.Commit()
+	return nil
+}
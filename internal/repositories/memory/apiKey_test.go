@@ -0,0 +1,75 @@
+package memory_test
+
+import (
+	"context"
+	"time"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/internal/repositories/memory"
+	"github.com/adminium/permify/internal/repositories/memory/migrations"
+	db "github.com/adminium/permify/pkg/database/memory"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/storage"
+)
+
+var _ = Describe("api-key", func() {
+	var writer *memory.APIKeyWriter
+	var reader *memory.APIKeyReader
+	var tenants []string
+	ctx := context.Background()
+	
+	BeforeEach(func() {
+		mem, err := db.New(migrations.Schema)
+		Expect(err).ShouldNot(HaveOccurred())
+		l := logger.New("error")
+		writer = memory.NewAPIKeyWriter(mem, l)
+		reader = memory.NewAPIKeyReader(mem, l)
+		
+		createdAt := time.Date(2023, 2, 14, 0, 0, 0, 0, time.UTC)
+		tenants = []string{"t1", "t2"}
+		for _, key := range []repositories.APIKey{
+			{ID: "k2", Hash: "h2", Scope: storage.APIKeyScopeReadWrite, CreatedAt: createdAt.Add(time.Minute)},
+			{ID: "k1", Hash: "h1", Tenants: tenants, Scope: storage.APIKeyScopeRead, CreatedAt: createdAt},
+		} {
+			Expect(writer.WriteAPIKey(ctx, key)).Should(Succeed())
+		}
+	})
+	
+	Context("ReadAPIKey", func() {
+		It("Case 1: The key is read by the hash of its secret and does not share the tenants of the caller", func() {
+			tenants[0] = "t3"
+			
+			key, err := reader.ReadAPIKey(ctx, "h1")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(key.ID).Should(Equal("k1"))
+			Expect(key.Scope).Should(Equal(storage.APIKeyScopeRead))
+			Expect(key.Tenants).Should(Equal([]string{"t1", "t2"}))
+		})
+	})
+	
+	Context("ListAPIKeys", func() {
+		It("Case 1: The keys are listed the oldest first", func() {
+			listed, err := reader.ListAPIKeys(ctx)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(listed).Should(HaveLen(2))
+			Expect(listed[0].ID).Should(Equal("k1"))
+			Expect(listed[1].ID).Should(Equal("k2"))
+		})
+	})
+	
+	Context("DeleteAPIKey", func() {
+		It("Case 1: The key is deleted by its id and can not be read or deleted again", func() {
+			Expect(writer.DeleteAPIKey(ctx, "k1")).Should(Succeed())
+			
+			_, err := reader.ReadAPIKey(ctx, "h1")
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_RECORD_NOT_FOUND.String()))
+			
+			err = writer.DeleteAPIKey(ctx, "k1")
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_RECORD_NOT_FOUND.String()))
+		})
+	})
+})
@@ -0,0 +1,47 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	
+	"google.golang.org/protobuf/types/known/structpb"
+	
+	"github.com/adminium/permify/internal/repositories"
+	db "github.com/adminium/permify/pkg/database/memory"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// AttributeReader - Structure for Attribute Reader
+type AttributeReader struct {
+	database *db.Memory
+	// logger
+	logger logger.Interface
+}
+
+// NewAttributeReader creates a new AttributeReader
+func NewAttributeReader(database *db.Memory, logger logger.Interface) *AttributeReader {
+	return &AttributeReader{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// ReadAttributes -
+func (r *AttributeReader) ReadAttributes(ctx context.Context, tenantID string, entity *base.Entity) (attributes map[string]*structpb.Value, err error) {
+	txn := r.database.DB.Txn(false)
+	defer txn.Abort()
+	it, err := txn.Get(AttributesTable, "entity", tenantID, entity.GetType(), entity.GetId())
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	attributes = map[string]*structpb.Value{}
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		attribute, ok := obj.(repositories.Attribute)
+		if !ok {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		attributes[attribute.Name] = attribute.Value
+	}
+	return attributes, nil
+}
@@ -0,0 +1,68 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"time"
+	
+	"google.golang.org/protobuf/types/known/structpb"
+	
+	"github.com/adminium/permify/internal/repositories"
+	db "github.com/adminium/permify/pkg/database/memory"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// AttributeWriter - Structure for Attribute Writer
+type AttributeWriter struct {
+	database *db.Memory
+	// logger
+	logger logger.Interface
+}
+
+// NewAttributeWriter creates a new AttributeWriter
+func NewAttributeWriter(database *db.Memory, logger logger.Interface) *AttributeWriter {
+	return &AttributeWriter{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// WriteAttributes - existing values of the attributes are replaced
+func (w *AttributeWriter) WriteAttributes(ctx context.Context, tenantID string, entity *base.Entity, attributes map[string]*structpb.Value) (err error) {
+	txn := w.database.DB.Txn(true)
+	defer txn.Abort()
+	now := time.Now()
+	for name, value := range attributes {
+		if err = txn.Insert(AttributesTable, repositories.Attribute{
+			TenantID:   tenantID,
+			EntityType: entity.GetType(),
+			EntityID:   entity.GetId(),
+			Name:       name,
+			Value:      value,
+			CreatedAt:  now,
+		}); err != nil {
+			return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+	}
+	txn.Commit()
+	return nil
+}
+
+// DeleteAttributes -
+func (w *AttributeWriter) DeleteAttributes(ctx context.Context, tenantID string, entity *base.Entity, names ...string) (err error) {
+	txn := w.database.DB.Txn(true)
+	defer txn.Abort()
+	if len(names) == 0 {
+		if _, err = txn.DeleteAll(AttributesTable, "entity", tenantID, entity.GetType(), entity.GetId()); err != nil {
+			return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+	}
+	for _, name := range names {
+		if _, err = txn.DeleteAll(AttributesTable, "id", tenantID, entity.GetType(), entity.GetId(), name); err != nil {
+			return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+	}
+	txn.Commit()
+	return nil
+}
@@ -0,0 +1,57 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	
+	"github.com/adminium/permify/internal/repositories"
+	db "github.com/adminium/permify/pkg/database/memory"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// CheckTraceReader - Structure for Check Trace Reader
+type CheckTraceReader struct {
+	database *db.Memory
+	// logger
+	logger logger.Interface
+}
+
+// NewCheckTraceReader creates a new CheckTraceReader
+func NewCheckTraceReader(database *db.Memory, logger logger.Interface) *CheckTraceReader {
+	return &CheckTraceReader{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// ListCheckTraces - the newest first
+func (r *CheckTraceReader) ListCheckTraces(ctx context.Context, filter repositories.CheckTraceFilter) (traces []repositories.CheckTrace, err error) {
+	txn := r.database.DB.Txn(false)
+	defer txn.Abort()
+	it, err := txn.Get(CheckTracesTable, "tenant", filter.TenantID)
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		trace, ok := obj.(repositories.CheckTrace)
+		if !ok {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		if !filter.Since.IsZero() && trace.CreatedAt.Before(filter.Since) {
+			continue
+		}
+		if !filter.Until.IsZero() && !trace.CreatedAt.Before(filter.Until) {
+			continue
+		}
+		traces = append(traces, trace)
+	}
+	sort.SliceStable(traces, func(i, j int) bool {
+		return traces[i].CreatedAt.After(traces[j].CreatedAt)
+	})
+	if filter.Limit > 0 && len(traces) > filter.Limit {
+		traces = traces[:filter.Limit]
+	}
+	return traces, nil
+}
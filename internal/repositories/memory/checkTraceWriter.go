@@ -0,0 +1,69 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"time"
+	
+	"golang.org/x/exp/slices"
+	
+	"github.com/adminium/permify/internal/repositories"
+	db "github.com/adminium/permify/pkg/database/memory"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// CheckTraceWriter - Structure for Check Trace Writer
+type CheckTraceWriter struct {
+	database *db.Memory
+	// logger
+	logger logger.Interface
+}
+
+// NewCheckTraceWriter creates a new CheckTraceWriter
+func NewCheckTraceWriter(database *db.Memory, logger logger.Interface) *CheckTraceWriter {
+	return &CheckTraceWriter{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// WriteCheckTrace - the steps and the tuples of the trace are copied, so the caller may reuse them
+func (w *CheckTraceWriter) WriteCheckTrace(ctx context.Context, trace repositories.CheckTrace) (err error) {
+	txn := w.database.DB.Txn(true)
+	defer txn.Abort()
+	trace.Steps = slices.Clone(trace.Steps)
+	trace.Tuples = slices.Clone(trace.Tuples)
+	if err = txn.Insert(CheckTracesTable, trace); err != nil {
+		return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	txn.Commit()
+	return nil
+}
+
+// DeleteCheckTraces - the traces of every tenant created before the time
+func (w *CheckTraceWriter) DeleteCheckTraces(ctx context.Context, before time.Time) (deleted int64, err error) {
+	txn := w.database.DB.Txn(true)
+	defer txn.Abort()
+	it, err := txn.Get(CheckTracesTable, "id")
+	if err != nil {
+		return 0, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	var expired []interface{}
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		trace, ok := obj.(repositories.CheckTrace)
+		if !ok {
+			return 0, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		if trace.CreatedAt.Before(before) {
+			expired = append(expired, obj)
+		}
+	}
+	for _, obj := range expired {
+		if err = txn.Delete(CheckTracesTable, obj); err != nil {
+			return 0, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+	}
+	txn.Commit()
+	return int64(len(expired)), nil
+}
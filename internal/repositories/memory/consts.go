@@ -4,4 +4,9 @@ const (
 	RelationTuplesTable    = "relation_tuples"
 	SchemaDefinitionsTable = "schema_definitions"
 	TenantsTable           = "tenants"
+	IdentitiesTable        = "identities"
+	AttributesTable        = "attributes"
+	TenantSettingsTable    = "tenant_settings"
+	APIKeysTable           = "api_keys"
+	CheckTracesTable       = "check_traces"
 )
@@ -0,0 +1,45 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	
+	"github.com/adminium/permify/internal/repositories"
+	db "github.com/adminium/permify/pkg/database/memory"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// IdentityReader - Structure for Identity Reader
+type IdentityReader struct {
+	database *db.Memory
+	// logger
+	logger logger.Interface
+}
+
+// NewIdentityReader creates a new IdentityReader
+func NewIdentityReader(database *db.Memory, logger logger.Interface) *IdentityReader {
+	return &IdentityReader{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// ResolveIdentity -
+func (r *IdentityReader) ResolveIdentity(ctx context.Context, tenantID, subjectType, alias string) (subjectID string, err error) {
+	txn := r.database.DB.Txn(false)
+	defer txn.Abort()
+	var raw interface{}
+	raw, err = txn.First(IdentitiesTable, "id", tenantID, subjectType, alias)
+	if err != nil {
+		return "", errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	if raw == nil {
+		return "", errors.New(base.ErrorCode_ERROR_CODE_RECORD_NOT_FOUND.String())
+	}
+	identity, ok := raw.(repositories.Identity)
+	if !ok {
+		return "", errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+	}
+	return identity.SubjectID, nil
+}
@@ -0,0 +1,55 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"time"
+	
+	"github.com/adminium/permify/internal/repositories"
+	db "github.com/adminium/permify/pkg/database/memory"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// IdentityWriter - Structure for Identity Writer
+type IdentityWriter struct {
+	database *db.Memory
+	// logger
+	logger logger.Interface
+}
+
+// NewIdentityWriter creates a new IdentityWriter
+func NewIdentityWriter(database *db.Memory, logger logger.Interface) *IdentityWriter {
+	return &IdentityWriter{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// WriteIdentity - an existing mapping of the alias is replaced
+func (w *IdentityWriter) WriteIdentity(ctx context.Context, tenantID, subjectType, alias, subjectID string) (err error) {
+	txn := w.database.DB.Txn(true)
+	defer txn.Abort()
+	if err = txn.Insert(IdentitiesTable, repositories.Identity{
+		TenantID:    tenantID,
+		SubjectType: subjectType,
+		Alias:       alias,
+		SubjectID:   subjectID,
+		CreatedAt:   time.Now(),
+	}); err != nil {
+		return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	txn.Commit()
+	return nil
+}
+
+// DeleteIdentity -
+func (w *IdentityWriter) DeleteIdentity(ctx context.Context, tenantID, subjectType, alias string) (err error) {
+	txn := w.database.DB.Txn(true)
+	defer txn.Abort()
+	if _, err = txn.DeleteAll(IdentitiesTable, "id", tenantID, subjectType, alias); err != nil {
+		return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	txn.Commit()
+	return nil
+}
@@ -1,9 +1,12 @@
 package migrations
 
 import (
+	"fmt"
+	
 	"github.com/hashicorp/go-memdb"
 	
 	"github.com/adminium/permify/internal/repositories/memory"
+	"github.com/adminium/permify/internal/repositories/memory/utils"
 )
 
 // Schema - Database schema for memory db
@@ -53,25 +56,34 @@ var Schema = &memdb.DBSchema{
 					Indexer: &memdb.CompoundIndex{
 						Indexes: []memdb.Indexer{
 							&memdb.StringFieldIndex{Field: "TenantID"},
-							&memdb.StringFieldIndex{Field: "EntityType"},
+							&memdb.UintFieldIndex{Field: "EntityType"},
 							&memdb.StringFieldIndex{Field: "EntityID"},
-							&memdb.StringFieldIndex{Field: "Relation"},
-							&memdb.StringFieldIndex{Field: "SubjectType"},
+							&memdb.UintFieldIndex{Field: "Relation"},
+							&memdb.UintFieldIndex{Field: "SubjectType"},
 							&memdb.StringFieldIndex{Field: "SubjectID"},
-							&memdb.StringFieldIndex{Field: "SubjectRelation"},
+							&memdb.UintFieldIndex{Field: "SubjectRelation"},
 						},
 						AllowMissing: true,
 					},
 				},
+				"tenant-index": {
+					Name:   "tenant-index",
+					Unique: false,
+					Indexer: &memdb.CompoundIndex{
+						Indexes: []memdb.Indexer{
+							&memdb.StringFieldIndex{Field: "TenantID"},
+						},
+					},
+				},
 				"entity-index": {
 					Name:   "entity-index",
 					Unique: false,
 					Indexer: &memdb.CompoundIndex{
 						Indexes: []memdb.Indexer{
 							&memdb.StringFieldIndex{Field: "TenantID"},
-							&memdb.StringFieldIndex{Field: "EntityType"},
+							&memdb.UintFieldIndex{Field: "EntityType"},
 							&memdb.StringFieldIndex{Field: "EntityID"},
-							&memdb.StringFieldIndex{Field: "Relation"},
+							&memdb.UintFieldIndex{Field: "Relation"},
 						},
 					},
 				},
@@ -81,9 +93,9 @@ var Schema = &memdb.DBSchema{
 					Indexer: &memdb.CompoundIndex{
 						Indexes: []memdb.Indexer{
 							&memdb.StringFieldIndex{Field: "TenantID"},
-							&memdb.StringFieldIndex{Field: "EntityType"},
-							&memdb.StringFieldIndex{Field: "Relation"},
-							&memdb.StringFieldIndex{Field: "SubjectType"},
+							&memdb.UintFieldIndex{Field: "EntityType"},
+							&memdb.UintFieldIndex{Field: "Relation"},
+							&memdb.UintFieldIndex{Field: "SubjectType"},
 						},
 					},
 				},
@@ -93,18 +105,175 @@ var Schema = &memdb.DBSchema{
 					Indexer: &memdb.CompoundIndex{
 						Indexes: []memdb.Indexer{
 							&memdb.StringFieldIndex{Field: "TenantID"},
-							&memdb.StringFieldIndex{Field: "EntityType"},
+							&memdb.UintFieldIndex{Field: "EntityType"},
 						},
 					},
 				},
 				"entity-type-and-relation-index": {
 					Name:   "entity-type-and-relation-index",
 					Unique: false,
+					Indexer: &memdb.CompoundIndex{
+						Indexes: []memdb.Indexer{
+							&memdb.StringFieldIndex{Field: "TenantID"},
+							&memdb.UintFieldIndex{Field: "EntityType"},
+							&memdb.UintFieldIndex{Field: "Relation"},
+						},
+					},
+				},
+				"subject-index": {
+					Name:   "subject-index",
+					Unique: false,
+					Indexer: &memdb.CompoundIndex{
+						Indexes: []memdb.Indexer{
+							&memdb.StringFieldIndex{Field: "TenantID"},
+							&memdb.UintFieldIndex{Field: "SubjectType"},
+							&memdb.StringFieldIndex{Field: "SubjectID"},
+						},
+					},
+				},
+				"subject-type-index": {
+					Name:   "subject-type-index",
+					Unique: false,
+					Indexer: &memdb.CompoundIndex{
+						Indexes: []memdb.Indexer{
+							&memdb.StringFieldIndex{Field: "TenantID"},
+							&memdb.UintFieldIndex{Field: "SubjectType"},
+						},
+					},
+				},
+				// expiring-index - the tuples that stop being valid at some time, the sweeper looks them up
+				"expiring-index": {
+					Name:   "expiring-index",
+					Unique: false,
+					Indexer: &memdb.ConditionalIndex{
+						Conditional: func(obj interface{}) (bool, error) {
+							t, ok := obj.(utils.RelationTuple)
+							if !ok {
+								return false, fmt.Errorf("unexpected type %T", obj)
+							}
+							return !t.NotAfter.IsZero(), nil
+						},
+					},
+				},
+			},
+		},
+		memory.IdentitiesTable: {
+			Name: memory.IdentitiesTable,
+			Indexes: map[string]*memdb.IndexSchema{
+				"id": {
+					Name:   "id",
+					Unique: true,
+					Indexer: &memdb.CompoundIndex{
+						Indexes: []memdb.Indexer{
+							&memdb.StringFieldIndex{Field: "TenantID"},
+							&memdb.StringFieldIndex{Field: "SubjectType"},
+							&memdb.StringFieldIndex{Field: "Alias"},
+						},
+					},
+				},
+				"tenant": {
+					Name:   "tenant",
+					Unique: false,
+					Indexer: &memdb.CompoundIndex{
+						Indexes: []memdb.Indexer{
+							&memdb.StringFieldIndex{Field: "TenantID"},
+						},
+					},
+				},
+			},
+		},
+		memory.AttributesTable: {
+			Name: memory.AttributesTable,
+			Indexes: map[string]*memdb.IndexSchema{
+				"id": {
+					Name:   "id",
+					Unique: true,
 					Indexer: &memdb.CompoundIndex{
 						Indexes: []memdb.Indexer{
 							&memdb.StringFieldIndex{Field: "TenantID"},
 							&memdb.StringFieldIndex{Field: "EntityType"},
-							&memdb.StringFieldIndex{Field: "Relation"},
+							&memdb.StringFieldIndex{Field: "EntityID"},
+							&memdb.StringFieldIndex{Field: "Name"},
+						},
+					},
+				},
+				"entity": {
+					Name:   "entity",
+					Unique: false,
+					Indexer: &memdb.CompoundIndex{
+						Indexes: []memdb.Indexer{
+							&memdb.StringFieldIndex{Field: "TenantID"},
+							&memdb.StringFieldIndex{Field: "EntityType"},
+							&memdb.StringFieldIndex{Field: "EntityID"},
+						},
+					},
+				},
+				"tenant": {
+					Name:   "tenant",
+					Unique: false,
+					Indexer: &memdb.CompoundIndex{
+						Indexes: []memdb.Indexer{
+							&memdb.StringFieldIndex{Field: "TenantID"},
+						},
+					},
+				},
+			},
+		},
+		memory.TenantSettingsTable: {
+			Name: memory.TenantSettingsTable,
+			Indexes: map[string]*memdb.IndexSchema{
+				"id": {
+					Name:   "id",
+					Unique: true,
+					Indexer: &memdb.CompoundIndex{
+						Indexes: []memdb.Indexer{
+							&memdb.StringFieldIndex{Field: "TenantID"},
+						},
+					},
+				},
+			},
+		},
+		memory.APIKeysTable: {
+			Name: memory.APIKeysTable,
+			Indexes: map[string]*memdb.IndexSchema{
+				"id": {
+					Name:   "id",
+					Unique: true,
+					Indexer: &memdb.CompoundIndex{
+						Indexes: []memdb.Indexer{
+							&memdb.StringFieldIndex{Field: "ID"},
+						},
+					},
+				},
+				"hash": {
+					Name:   "hash",
+					Unique: true,
+					Indexer: &memdb.CompoundIndex{
+						Indexes: []memdb.Indexer{
+							&memdb.StringFieldIndex{Field: "Hash"},
+						},
+					},
+				},
+			},
+		},
+		memory.CheckTracesTable: {
+			Name: memory.CheckTracesTable,
+			Indexes: map[string]*memdb.IndexSchema{
+				"id": {
+					Name:   "id",
+					Unique: true,
+					Indexer: &memdb.CompoundIndex{
+						Indexes: []memdb.Indexer{
+							&memdb.StringFieldIndex{Field: "ID"},
+						},
+					},
+				},
+				"tenant": {
+					Name:   "tenant",
+					Unique: false,
+					Indexer: &memdb.CompoundIndex{
+						Indexes: []memdb.Indexer{
+							&memdb.StringFieldIndex{Field: "TenantID"},
 						},
 					},
 				},
@@ -3,6 +3,7 @@ package memory
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sort"
 	"strconv"
 	"time"
@@ -35,13 +36,17 @@ func NewRelationshipReader(database *db.Memory, logger logger.Interface) *Relati
 }
 
 // QueryRelationships - Reads relation tuples from the repository.
-func (r *RelationshipReader) QueryRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, _ string) (it *database.TupleIterator, err error) {
+func (r *RelationshipReader) QueryRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (it *database.TupleIterator, err error) {
 	txn := r.database.DB.Txn(false)
 	defer txn.Abort()
 	
 	collection := database.NewTupleCollection()
+	at := snapshotTime(snap)
 	
-	index, args := utils.GetIndexNameAndArgsByFilters(tenantID, filter)
+	index, args, ok := utils.GetIndexNameAndArgsByFilters(r.database.Symbols, tenantID, filter)
+	if !ok {
+		return collection.CreateTupleIterator(), nil
+	}
 	var result memdb.ResultIterator
 	
 	result, err = txn.Get(RelationTuplesTable, index, args...)
@@ -49,20 +54,48 @@ func (r *RelationshipReader) QueryRelationships(ctx context.Context, tenantID st
 		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
 	}
 	
-	fit := memdb.NewFilterIterator(result, utils.FilterQuery(filter))
+	fit := memdb.NewFilterIterator(result, utils.FilterQuery(r.database.Symbols, filter))
 	for obj := fit.Next(); obj != nil; obj = fit.Next() {
-		t, ok := obj.(repositories.RelationTuple)
+		st, ok := obj.(utils.RelationTuple)
 		if !ok {
 			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
 		}
+		t := st.ToRelationTuple(r.database.Symbols)
+		if !t.ValidAt(at) {
+			continue
+		}
 		collection.Add(t.ToTuple())
 	}
 	
 	return collection.CreateTupleIterator(), nil
 }
 
+// ExplainQuery - Index that the tuples of the filter are looked up with, the fields of the filter that the index does
+// not cover are matched against every tuple it returns
+func (r *RelationshipReader) ExplainQuery(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (plan repositories.QueryPlan, err error) {
+	plan.Engine = "memory"
+	
+	index, args, ok := utils.GetIndexNameAndArgsByFilters(r.database.Symbols, tenantID, filter)
+	if !ok {
+		plan.Plan = []string{"the entity type or relation of the filter was never written, no tuple is read"}
+		return plan, nil
+	}
+	
+	plan.Index = index
+	plan.Query = fmt.Sprintf("get %s by %s", RelationTuplesTable, index)
+	for _, arg := range args {
+		plan.Args = append(plan.Args, fmt.Sprint(arg))
+	}
+	plan.FullScan = index == "tenant-index"
+	plan.Plan = []string{
+		fmt.Sprintf("index lookup on %s using %s", RelationTuplesTable, index),
+		"filter the remaining fields of the filter and the tuples not visible at the snapshot",
+	}
+	return plan, nil
+}
+
 // ReadRelationships - Gets all relationships for a given filter
-func (r *RelationshipReader) ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, _ string, pagination database.Pagination) (collection *database.TupleCollection, ct database.EncodedContinuousToken, err error) {
+func (r *RelationshipReader) ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, pagination database.Pagination) (collection *database.TupleCollection, ct database.EncodedContinuousToken, err error) {
 	txn := r.database.DB.Txn(false)
 	defer txn.Abort()
 	
@@ -79,7 +112,10 @@ func (r *RelationshipReader) ReadRelationships(ctx context.Context, tenantID str
 		}
 	}
 	
-	index, args := utils.GetIndexNameAndArgsByFilters(tenantID, filter)
+	index, args, ok := utils.GetIndexNameAndArgsByFilters(r.database.Symbols, tenantID, filter)
+	if !ok {
+		return database.NewTupleCollection(), utils.NewNoopContinuousToken().Encode(), nil
+	}
 	var result memdb.ResultIterator
 	
 	result, err = txn.LowerBound(RelationTuplesTable, index, args...)
@@ -87,13 +123,18 @@ func (r *RelationshipReader) ReadRelationships(ctx context.Context, tenantID str
 		return nil, utils.NewNoopContinuousToken().Encode(), errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
 	}
 	
+	at := snapshotTime(snap)
 	tup := make([]repositories.RelationTuple, 0, 10)
-	fit := memdb.NewFilterIterator(result, utils.FilterQuery(filter))
+	fit := memdb.NewFilterIterator(result, utils.FilterQuery(r.database.Symbols, filter))
 	for obj := fit.Next(); obj != nil; obj = fit.Next() {
-		t, ok := obj.(repositories.RelationTuple)
+		st, ok := obj.(utils.RelationTuple)
 		if !ok {
 			return nil, utils.NewNoopContinuousToken().Encode(), errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
 		}
+		t := st.ToRelationTuple(r.database.Symbols)
+		if !t.ValidAt(at) {
+			continue
+		}
 		tup = append(tup, t)
 	}
 	
@@ -101,37 +142,46 @@ func (r *RelationshipReader) ReadRelationships(ctx context.Context, tenantID str
 		return tup[i].ID < tup[j].ID
 	})
 	
-	tuples := make([]*base.Tuple, 0, pagination.PageSize()+1)
+	collection = database.NewTupleCollection()
 	
 	for _, t := range tup {
 		if t.ID >= lowerBound {
-			tuples = append(tuples, t.ToTuple())
-			if len(tuples) > int(pagination.PageSize()) {
-				return database.NewTupleCollection(tuples[:pagination.PageSize()]...), utils.NewContinuousToken(strconv.FormatUint(t.ID, 10)).Encode(), nil
+			if len(collection.GetTuples()) == int(pagination.PageSize()) {
+				return collection, utils.NewContinuousToken(strconv.FormatUint(t.ID, 10)).Encode(), nil
 			}
+			collection.AddWithMetadata(t.ToTuple(), t.ToMetadata())
 		}
 	}
 	
-	return database.NewTupleCollection(tuples...), utils.NewNoopContinuousToken().Encode(), nil
+	return collection, utils.NewNoopContinuousToken().Encode(), nil
 }
 
 // GetUniqueEntityIDsByEntityType - Gets all entity IDs for a given entity type (unique)
-func (r *RelationshipReader) GetUniqueEntityIDsByEntityType(ctx context.Context, tenantID, typ, _ string) (array []string, err error) {
+func (r *RelationshipReader) GetUniqueEntityIDsByEntityType(ctx context.Context, tenantID, typ, snap string) (array []string, err error) {
 	txn := r.database.DB.Txn(false)
 	defer txn.Abort()
 	
+	entityType, ok := r.database.Symbols.Lookup(typ)
+	if !ok {
+		return []string{}, nil
+	}
+	
 	var it memdb.ResultIterator
-	it, err = txn.Get(RelationTuplesTable, "entity-type-index", tenantID, typ)
+	it, err = txn.Get(RelationTuplesTable, "entity-type-index", tenantID, entityType)
 	if err != nil {
 		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
 	}
 	
+	at := snapshotTime(snap)
 	var result []string
 	for obj := it.Next(); obj != nil; obj = it.Next() {
-		t, ok := obj.(repositories.RelationTuple)
+		t, ok := obj.(utils.RelationTuple)
 		if !ok {
 			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
 		}
+		if !t.ToRelationTuple(r.database.Symbols).ValidAt(at) {
+			continue
+		}
 		result = append(result, t.EntityID)
 	}
 	
@@ -143,6 +193,84 @@ func (r *RelationshipReader) HeadSnapshot(ctx context.Context, _ string) (token.
 	return snapshot.NewToken(time.Now()), nil
 }
 
+// SnapshotAt - Memory engine snapshots are time based, so the token is created directly from the given time.
+func (r *RelationshipReader) SnapshotAt(ctx context.Context, _ string, at time.Time) (token.SnapToken, error) {
+	return snapshot.NewToken(at), nil
+}
+
+// ReadDeletedRelationships - Memory engine removes deleted tuples physically, so there is no history to read.
+func (r *RelationshipReader) ReadDeletedRelationships(ctx context.Context, _ string, _ *base.TupleFilter, _, _ time.Time) (*database.TupleCollection, error) {
+	return nil, errors.New(base.ErrorCode_ERROR_CODE_NOT_IMPLEMENTED.String())
+}
+
+// ReadRelationshipChanges - Memory engine removes deleted tuples physically, so only the tuples written
+// between the snapshots of the tokens are reported.
+func (r *RelationshipReader) ReadRelationshipChanges(ctx context.Context, tenantID string, filter *base.TupleFilter, from, to string) (created, deleted *database.TupleCollection, err error) {
+	var fst, tst token.SnapToken
+	fst, err = snapshot.EncodedToken{Value: from}.Decode()
+	if err != nil {
+		return nil, nil, err
+	}
+	tst, err = snapshot.EncodedToken{Value: to}.Decode()
+	if err != nil {
+		return nil, nil, err
+	}
+	if tst.Lt(fst) {
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+	}
+	
+	txn := r.database.DB.Txn(false)
+	defer txn.Abort()
+	
+	created, deleted = database.NewTupleCollection(), database.NewTupleCollection()
+	
+	index, args, ok := utils.GetIndexNameAndArgsByFilters(r.database.Symbols, tenantID, filter)
+	if !ok {
+		return created, deleted, nil
+	}
+	var result memdb.ResultIterator
+	
+	result, err = txn.Get(RelationTuplesTable, index, args...)
+	if err != nil {
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	
+	// tuples are written before the token of their write is taken
+	fromTime, toTime := time.Unix(0, int64(fst.(snapshot.Token).Value)), time.Unix(0, int64(tst.(snapshot.Token).Value))
+	tup := make([]repositories.RelationTuple, 0, 10)
+	fit := memdb.NewFilterIterator(result, utils.FilterQuery(r.database.Symbols, filter))
+	for obj := fit.Next(); obj != nil; obj = fit.Next() {
+		st, ok := obj.(utils.RelationTuple)
+		if !ok {
+			return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		if st.CreatedAt.Before(fromTime) || !st.CreatedAt.Before(toTime) {
+			continue
+		}
+		tup = append(tup, st.ToRelationTuple(r.database.Symbols))
+	}
+	
+	sort.Slice(tup, func(i, j int) bool {
+		return tup[i].ID < tup[j].ID
+	})
+	
+	for _, t := range tup {
+		created.AddWithMetadata(t.ToTuple(), t.ToMetadata())
+	}
+	
+	return created, deleted, nil
+}
+
+// snapshotTime - Memory snap tokens carry the wall clock they were taken at, tuple validity windows are evaluated against it.
+// Tokens of other engines (noop, ...) are evaluated at the current time.
+func snapshotTime(snap string) time.Time {
+	st, err := snapshot.EncodedToken{Value: snap}.Decode()
+	if err != nil {
+		return time.Now()
+	}
+	return time.Unix(0, int64(st.(snapshot.Token).Value))
+}
+
 // RemoveDuplicate - Remove duplicated keys in given slice
 func removeDuplicate[T string | int](sliceList []T) []T {
 	allKeys := make(map[T]bool)
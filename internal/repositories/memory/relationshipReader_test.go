@@ -0,0 +1,109 @@
+package memory_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	
+	"github.com/adminium/permify/internal/repositories/memory"
+	"github.com/adminium/permify/internal/repositories/memory/migrations"
+	"github.com/adminium/permify/pkg/database"
+	db "github.com/adminium/permify/pkg/database/memory"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+// benchmarkReader - Reader of 100 users that own 100 documents each
+func benchmarkReader(b *testing.B) *memory.RelationshipReader {
+	b.Helper()
+	
+	mem, err := db.New(migrations.Schema)
+	if err != nil {
+		b.Fatal(err)
+	}
+	l := logger.New("error")
+	
+	collection := database.NewTupleCollection()
+	for u := 0; u < 100; u++ {
+		for d := 0; d < 100; d++ {
+			collection.Add(&base.Tuple{
+				Entity:   &base.Entity{Type: "document", Id: fmt.Sprintf("%d-%d", u, d)},
+				Relation: "owner",
+				Subject:  &base.Subject{Type: tuple.USER, Id: fmt.Sprint(u)},
+			})
+		}
+	}
+	if _, err = memory.NewRelationshipWriter(mem, l).WriteRelationships(context.Background(), "t1", collection); err != nil {
+		b.Fatal(err)
+	}
+	return memory.NewRelationshipReader(mem, l)
+}
+
+// count -
+func count(it *database.TupleIterator) (n int) {
+	for it.HasNext() {
+		it.GetNext()
+		n++
+	}
+	return n
+}
+
+// BenchmarkQueryRelationshipsBySubject - The documents of a user, read through the subject index
+func BenchmarkQueryRelationshipsBySubject(b *testing.B) {
+	reader := benchmarkReader(b)
+	filter := &base.TupleFilter{
+		Subject: &base.SubjectFilter{Type: tuple.USER, Ids: []string{"42"}},
+	}
+	
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		it, err := reader.QueryRelationships(context.Background(), "t1", filter, "")
+		if err != nil {
+			b.Fatal(err)
+		}
+		if n := count(it); n != 100 {
+			b.Fatalf("expected 100 tuples, got %d", n)
+		}
+	}
+}
+
+// BenchmarkQueryRelationshipsBySubjectAndRelation - The subject index is preferred over the entity-side one
+func BenchmarkQueryRelationshipsBySubjectAndRelation(b *testing.B) {
+	reader := benchmarkReader(b)
+	filter := &base.TupleFilter{
+		Entity:   &base.EntityFilter{Type: "document"},
+		Relation: "owner",
+		Subject:  &base.SubjectFilter{Type: tuple.USER, Ids: []string{"42"}},
+	}
+	
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		it, err := reader.QueryRelationships(context.Background(), "t1", filter, "")
+		if err != nil {
+			b.Fatal(err)
+		}
+		if n := count(it); n != 100 {
+			b.Fatalf("expected 100 tuples, got %d", n)
+		}
+	}
+}
+
+// BenchmarkQueryRelationshipsBySubjects - Several subjects are only narrowed down to their type
+func BenchmarkQueryRelationshipsBySubjects(b *testing.B) {
+	reader := benchmarkReader(b)
+	filter := &base.TupleFilter{
+		Subject: &base.SubjectFilter{Type: tuple.USER, Ids: []string{"41", "42"}},
+	}
+	
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		it, err := reader.QueryRelationships(context.Background(), "t1", filter, "")
+		if err != nil {
+			b.Fatal(err)
+		}
+		if n := count(it); n != 200 {
+			b.Fatalf("expected 200 tuples, got %d", n)
+		}
+	}
+}
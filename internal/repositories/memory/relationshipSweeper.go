@@ -0,0 +1,58 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"time"
+	
+	"github.com/adminium/permify/internal/repositories/memory/utils"
+	db "github.com/adminium/permify/pkg/database/memory"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// RelationshipSweeper - Deletes the relation tuples whose validity ended before a time, the reads already ignore them
+type RelationshipSweeper struct {
+	database *db.Memory
+	// logger
+	logger logger.Interface
+}
+
+// NewRelationshipSweeper - Creates a new RelationshipSweeper
+func NewRelationshipSweeper(database *db.Memory, logger logger.Interface) *RelationshipSweeper {
+	return &RelationshipSweeper{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// SweepExpiredRelationships - Deletes at most limit relation tuples that are not valid after the time
+func (s *RelationshipSweeper) SweepExpiredRelationships(ctx context.Context, before time.Time, limit int) (deleted int64, err error) {
+	txn := s.database.DB.Txn(true)
+	defer txn.Abort()
+	
+	it, err := txn.Get(RelationTuplesTable, "expiring-index", true)
+	if err != nil {
+		return 0, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	
+	// the tuples are deleted once the iteration is done, since the index is changed by the deletes
+	var expired []utils.RelationTuple
+	for obj := it.Next(); obj != nil && len(expired) < limit; obj = it.Next() {
+		t, ok := obj.(utils.RelationTuple)
+		if !ok {
+			return 0, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		if t.NotAfter.Before(before) {
+			expired = append(expired, t)
+		}
+	}
+	for _, t := range expired {
+		if err = txn.Delete(RelationTuplesTable, t); err != nil {
+			return 0, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+	}
+	
+	txn.Commit()
+	return int64(len(expired)), nil
+}
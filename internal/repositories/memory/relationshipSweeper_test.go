@@ -0,0 +1,79 @@
+package memory_test
+
+import (
+	"context"
+	"time"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	
+	"github.com/adminium/permify/internal/repositories/memory"
+	"github.com/adminium/permify/internal/repositories/memory/migrations"
+	"github.com/adminium/permify/pkg/database"
+	db "github.com/adminium/permify/pkg/database/memory"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+var _ = Describe("relationship-sweeper", func() {
+	var mem *db.Memory
+	var sweeper *memory.RelationshipSweeper
+	now := time.Now()
+	
+	// stored - Tuples of the tenant in the table
+	stored := func() (n int) {
+		it, err := mem.DB.Txn(false).Get(memory.RelationTuplesTable, "tenant-index", "t1")
+		Expect(err).ShouldNot(HaveOccurred())
+		for obj := it.Next(); obj != nil; obj = it.Next() {
+			n++
+		}
+		return n
+	}
+	
+	BeforeEach(func() {
+		var err error
+		mem, err = db.New(migrations.Schema)
+		Expect(err).ShouldNot(HaveOccurred())
+		l := logger.New("error")
+		writer := memory.NewRelationshipWriter(mem, l)
+		sweeper = memory.NewRelationshipSweeper(mem, l)
+		
+		for id, notAfter := range map[string]time.Time{
+			"1": now.Add(-2 * time.Hour),
+			"2": now.Add(-time.Hour),
+			"3": now.Add(time.Hour),
+			"4": {},
+		} {
+			tup := &base.Tuple{Entity: &base.Entity{Type: "doc", Id: id}, Relation: "viewer", Subject: &base.Subject{Type: tuple.USER, Id: "1"}}
+			if !notAfter.IsZero() {
+				tup.NotAfter = timestamppb.New(notAfter)
+			}
+			_, err = writer.WriteRelationships(context.Background(), "t1", database.NewTupleCollection(tup))
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+	})
+	
+	Context("SweepExpiredRelationships", func() {
+		It("Case 1: A batch is cut at the limit", func() {
+			deleted, err := sweeper.SweepExpiredRelationships(context.Background(), now, 1)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(deleted).Should(Equal(int64(1)))
+			Expect(stored()).Should(Equal(3))
+		})
+		
+		It("Case 2: Only the tuples whose validity ended before the time are removed", func() {
+			deleted, err := sweeper.SweepExpiredRelationships(context.Background(), now, 10)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(deleted).Should(Equal(int64(2)))
+			Expect(stored()).Should(Equal(2))
+			
+			// the valid tuples and the ones without an end are kept
+			deleted, err = sweeper.SweepExpiredRelationships(context.Background(), now, 10)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(deleted).Should(Equal(int64(0)))
+			Expect(stored()).Should(Equal(2))
+		})
+	})
+})
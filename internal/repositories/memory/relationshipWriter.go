@@ -15,6 +15,7 @@ import (
 	"github.com/adminium/permify/pkg/logger"
 	base "github.com/adminium/permify/pkg/pb/base/v1"
 	"github.com/adminium/permify/pkg/token"
+	"github.com/adminium/permify/pkg/tuple"
 )
 
 type RelationshipWriter struct {
@@ -40,11 +41,16 @@ func (r *RelationshipWriter) WriteRelationships(ctx context.Context, tenantID st
 		return token.NewNoopToken().Encode(), nil
 	}
 	
+	metadata := database.TupleMetadataFromContext(ctx)
+	createdAt := time.Now()
+	
 	txn := r.database.DB.Txn(true)
 	defer txn.Abort()
 	
+	created := database.NewTupleCollection()
 	for iterator.HasNext() {
 		bt := iterator.GetNext()
+		notBefore, notAfter := tuple.Validity(bt)
 		t := repositories.RelationTuple{
 			ID:              utils.RelationTuplesID.ID(),
 			TenantID:        tenantID,
@@ -54,14 +60,33 @@ func (r *RelationshipWriter) WriteRelationships(ctx context.Context, tenantID st
 			SubjectType:     bt.GetSubject().GetType(),
 			SubjectID:       bt.GetSubject().GetId(),
 			SubjectRelation: bt.GetSubject().GetRelation(),
+			CreatedAt:       createdAt,
+			CreatedBy:       metadata.CreatedBy,
+			Reference:       metadata.Reference,
+			SchemaVersion:   metadata.SchemaVersion,
+			NotBefore:       notBefore,
+			NotAfter:        notAfter,
+			Payload:         bt.GetPayload(),
 		}
-		if err = txn.Insert(RelationTuplesTable, t); err != nil {
+		if err = txn.Insert(RelationTuplesTable, utils.NewRelationTuple(r.database.Symbols, t)); err != nil {
 			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
 		}
+		created.AddWithMetadata(t.ToTuple(), t.ToMetadata())
 	}
 	
 	txn.Commit()
-	return snapshot.NewToken(time.Now()).Encode(), nil
+	st := snapshot.NewToken(time.Now()).Encode()
+	r.database.Changes.Publish(tenantID, &repositories.RelationshipChanges{
+		SnapToken: st.String(),
+		Created:   created,
+		Deleted:   database.NewTupleCollection(),
+	})
+	return st, nil
+}
+
+// BatchWriteRelationships - Write relations to repository, the memory engine writes every collection in one transaction
+func (r *RelationshipWriter) BatchWriteRelationships(ctx context.Context, tenantID string, collection *database.TupleCollection) (token.EncodedSnapToken, error) {
+	return r.WriteRelationships(ctx, tenantID, collection)
 }
 
 // DeleteRelationships - Delete relationship from repository
@@ -70,16 +95,21 @@ func (r *RelationshipWriter) DeleteRelationships(ctx context.Context, tenantID s
 	txn := r.database.DB.Txn(true)
 	defer txn.Abort()
 	
-	index, args := utils.GetIndexNameAndArgsByFilters(tenantID, filter)
+	index, args, ok := utils.GetIndexNameAndArgsByFilters(r.database.Symbols, tenantID, filter)
+	if !ok {
+		return snapshot.NewToken(time.Now()).Encode(), nil
+	}
 	var it memdb.ResultIterator
 	it, err = txn.Get(RelationTuplesTable, index, args...)
 	if err != nil {
 		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
 	}
 	
-	fit := memdb.NewFilterIterator(it, utils.FilterQuery(filter))
+	deletedAt := time.Now()
+	deleted := database.NewTupleCollection()
+	fit := memdb.NewFilterIterator(it, utils.FilterQuery(r.database.Symbols, filter))
 	for obj := fit.Next(); obj != nil; obj = fit.Next() {
-		t, ok := obj.(repositories.RelationTuple)
+		t, ok := obj.(utils.RelationTuple)
 		if !ok {
 			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
 		}
@@ -87,8 +117,20 @@ func (r *RelationshipWriter) DeleteRelationships(ctx context.Context, tenantID s
 		if err != nil {
 			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
 		}
+		rt := t.ToRelationTuple(r.database.Symbols)
+		metadata := rt.ToMetadata()
+		metadata.DeletedAt = deletedAt
+		deleted.AddWithMetadata(rt.ToTuple(), metadata)
 	}
 	
 	txn.Commit()
-	return snapshot.NewToken(time.Now()).Encode(), nil
+	st := snapshot.NewToken(time.Now()).Encode()
+	if len(deleted.GetTuples()) > 0 {
+		r.database.Changes.Publish(tenantID, &repositories.RelationshipChanges{
+			SnapToken: st.String(),
+			Created:   database.NewTupleCollection(),
+			Deleted:   deleted,
+		})
+	}
+	return st, nil
 }
@@ -0,0 +1,79 @@
+package memory_test
+
+import (
+	"context"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	
+	"github.com/adminium/permify/internal/repositories/memory"
+	"github.com/adminium/permify/internal/repositories/memory/migrations"
+	"github.com/adminium/permify/pkg/database"
+	db "github.com/adminium/permify/pkg/database/memory"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+var _ = Describe("relationship-writer", func() {
+	var writer *memory.RelationshipWriter
+	var reader *memory.RelationshipReader
+	
+	// remaining - Number of the tuples of the tenant
+	remaining := func(tenantID string) int {
+		it, err := reader.QueryRelationships(context.Background(), tenantID, &base.TupleFilter{}, "")
+		Expect(err).ShouldNot(HaveOccurred())
+		return count(it)
+	}
+	
+	BeforeEach(func() {
+		mem, err := db.New(migrations.Schema)
+		Expect(err).ShouldNot(HaveOccurred())
+		l := logger.New("error")
+		writer = memory.NewRelationshipWriter(mem, l)
+		reader = memory.NewRelationshipReader(mem, l)
+		
+		tuples := database.NewTupleCollection(
+			&base.Tuple{Entity: &base.Entity{Type: "doc", Id: "1"}, Relation: "owner", Subject: &base.Subject{Type: tuple.USER, Id: "1"}},
+			&base.Tuple{Entity: &base.Entity{Type: "doc", Id: "1"}, Relation: "viewer", Subject: &base.Subject{Type: tuple.USER, Id: "1"}},
+			&base.Tuple{Entity: &base.Entity{Type: "doc", Id: "2"}, Relation: "viewer", Subject: &base.Subject{Type: tuple.USER, Id: "2"}},
+			&base.Tuple{Entity: &base.Entity{Type: "folder", Id: "1"}, Relation: "viewer", Subject: &base.Subject{Type: tuple.USER, Id: "1"}},
+		)
+		for _, tenantID := range []string{"t1", "t2"} {
+			_, err = writer.WriteRelationships(context.Background(), tenantID, tuples)
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+	})
+	
+	Context("DeleteRelationships", func() {
+		It("Case 1: Only the tuples that match every field of the filter are deleted", func() {
+			_, err := writer.DeleteRelationships(context.Background(), "t1", &base.TupleFilter{
+				Entity:   &base.EntityFilter{Type: "doc", Ids: []string{"2"}},
+				Relation: "viewer",
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(remaining("t1")).Should(Equal(3))
+			
+			// everything of user 1 on docs
+			_, err = writer.DeleteRelationships(context.Background(), "t1", &base.TupleFilter{
+				Entity:  &base.EntityFilter{Type: "doc"},
+				Subject: &base.SubjectFilter{Type: tuple.USER, Ids: []string{"1"}},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(remaining("t1")).Should(Equal(1))
+		})
+		
+		It("Case 2: A filter of an unknown relation matches nothing", func() {
+			_, err := writer.DeleteRelationships(context.Background(), "t1", &base.TupleFilter{Relation: "editor"})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(remaining("t1")).Should(Equal(4))
+		})
+		
+		It("Case 3: An empty filter matches every tuple of the tenant and none of the other tenants", func() {
+			_, err := writer.DeleteRelationships(context.Background(), "t1", &base.TupleFilter{})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(remaining("t1")).Should(Equal(0))
+			Expect(remaining("t2")).Should(Equal(4))
+		})
+	})
+})
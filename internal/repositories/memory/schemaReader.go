@@ -3,11 +3,14 @@ package memory
 import (
 	"context"
 	"errors"
+	"sort"
 	
 	"github.com/hashicorp/go-memdb"
 	
 	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/internal/repositories/memory/utils"
 	"github.com/adminium/permify/internal/schema"
+	"github.com/adminium/permify/pkg/database"
 	db "github.com/adminium/permify/pkg/database/memory"
 	"github.com/adminium/permify/pkg/logger"
 	base "github.com/adminium/permify/pkg/pb/base/v1"
@@ -30,25 +33,35 @@ func NewSchemaReader(database *db.Memory, logger logger.Interface) *SchemaReader
 
 // ReadSchema - Reads a new schema from repository
 func (r *SchemaReader) ReadSchema(ctx context.Context, tenantID, version string) (sch *base.SchemaDefinition, err error) {
+	var definitions []string
+	definitions, err = r.ReadSchemaString(ctx, tenantID, version)
+	if err != nil {
+		return sch, err
+	}
+	
+	sch, err = schema.NewSchemaFromStringDefinitions(true, definitions...)
+	if err != nil {
+		return nil, err
+	}
+	
+	return sch, nil
+}
+
+// ReadSchemaString - Reads the serialized definitions of the schema version from repository
+func (r *SchemaReader) ReadSchemaString(ctx context.Context, tenantID, version string) (definitions []string, err error) {
 	txn := r.database.DB.Txn(false)
 	defer txn.Abort()
 	var it memdb.ResultIterator
 	it, err = txn.Get(SchemaDefinitionsTable, "version", tenantID, version)
 	if err != nil {
-		return sch, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
 	}
 	
-	var definitions []string
 	for obj := it.Next(); obj != nil; obj = it.Next() {
 		definitions = append(definitions, obj.(repositories.SchemaDefinition).Serialized())
 	}
 	
-	sch, err = schema.NewSchemaFromStringDefinitions(true, definitions...)
-	if err != nil {
-		return nil, err
-	}
-	
-	return sch, nil
+	return definitions, nil
 }
 
 // ReadSchemaDefinition - Reads a Schema Definition from repository
@@ -93,3 +106,50 @@ func (r *SchemaReader) HeadVersion(ctx context.Context, tenantID string) (string
 	}
 	return "", errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String())
 }
+
+// ListSchemaVersions - Lists the versions of the schema from the repository, the latest first.
+func (r *SchemaReader) ListSchemaVersions(ctx context.Context, tenantID string, pagination database.Pagination) (versions []string, ct database.EncodedContinuousToken, err error) {
+	var upperBound string
+	if pagination.Token() != "" {
+		var t database.ContinuousToken
+		t, err = utils.EncodedContinuousToken{Value: pagination.Token()}.Decode()
+		if err != nil {
+			return nil, nil, err
+		}
+		upperBound = t.(utils.ContinuousToken).Value
+	}
+	
+	txn := r.database.DB.Txn(false)
+	defer txn.Abort()
+	
+	var result memdb.ResultIterator
+	result, err = txn.Get(SchemaDefinitionsTable, "tenant", tenantID)
+	if err != nil {
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	
+	// every entity of a version is a definition of its own
+	unique := map[string]struct{}{}
+	for obj := result.Next(); obj != nil; obj = result.Next() {
+		definition, ok := obj.(repositories.SchemaDefinition)
+		if !ok {
+			return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		if upperBound != "" && definition.Version > upperBound {
+			continue
+		}
+		unique[definition.Version] = struct{}{}
+	}
+	
+	versions = make([]string, 0, len(unique))
+	for version := range unique {
+		versions = append(versions, version)
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(versions)))
+	
+	if len(versions) > int(pagination.PageSize()) {
+		return versions[:pagination.PageSize()], utils.NewContinuousToken(versions[pagination.PageSize()]).Encode(), nil
+	}
+	
+	return versions, utils.NewNoopContinuousToken().Encode(), nil
+}
@@ -3,6 +3,7 @@ package snapshot
 import (
 	"encoding/base64"
 	"encoding/binary"
+	"errors"
 	"time"
 	
 	"github.com/adminium/permify/pkg/token"
@@ -59,6 +60,9 @@ func (t EncodedToken) Decode() (token.SnapToken, error) {
 	if err != nil {
 		return nil, err
 	}
+	if len(b) != 8 {
+		return nil, errors.New("invalid snap token length")
+	}
 	return Token{
 		Value: binary.LittleEndian.Uint64(b),
 	}, nil
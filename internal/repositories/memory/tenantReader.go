@@ -5,6 +5,7 @@ import (
 	
 	"github.com/hashicorp/go-memdb"
 	"github.com/pkg/errors"
+	"golang.org/x/exp/maps"
 	
 	"github.com/adminium/permify/internal/repositories"
 	"github.com/adminium/permify/internal/repositories/memory/utils"
@@ -64,3 +65,23 @@ func (r *TenantReader) ListTenants(ctx context.Context, pagination database.Pagi
 	
 	return tenants, utils.NewNoopContinuousToken().Encode(), err
 }
+
+// GetTenant -
+func (r *TenantReader) GetTenant(ctx context.Context, tenantID string) (tenant *base.Tenant, labels map[string]string, err error) {
+	txn := r.database.DB.Txn(false)
+	defer txn.Abort()
+	
+	var raw interface{}
+	raw, err = txn.First(TenantsTable, "id", tenantID)
+	if err != nil {
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	if raw == nil {
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_TENANT_NOT_FOUND.String())
+	}
+	t, ok := raw.(repositories.Tenant)
+	if !ok {
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+	}
+	return t.ToTenant(), maps.Clone(t.Labels), nil
+}
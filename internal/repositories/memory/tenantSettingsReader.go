@@ -0,0 +1,46 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	
+	"github.com/adminium/permify/internal/repositories"
+	db "github.com/adminium/permify/pkg/database/memory"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/storage"
+)
+
+// TenantSettingsReader - Structure for Tenant Settings Reader
+type TenantSettingsReader struct {
+	database *db.Memory
+	// logger
+	logger logger.Interface
+}
+
+// NewTenantSettingsReader creates a new TenantSettingsReader
+func NewTenantSettingsReader(database *db.Memory, logger logger.Interface) *TenantSettingsReader {
+	return &TenantSettingsReader{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// ReadTenantSettings - the defaults when the tenant has no settings
+func (r *TenantSettingsReader) ReadTenantSettings(ctx context.Context, tenantID string) (settings repositories.TenantSettings, err error) {
+	txn := r.database.DB.Txn(false)
+	defer txn.Abort()
+	var raw interface{}
+	raw, err = txn.First(TenantSettingsTable, "id", tenantID)
+	if err != nil {
+		return settings, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	if raw == nil {
+		return storage.DefaultTenantSettings(), nil
+	}
+	record, ok := raw.(repositories.TenantSettingsRecord)
+	if !ok {
+		return settings, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+	}
+	return record.Settings, nil
+}
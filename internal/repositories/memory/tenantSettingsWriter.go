@@ -0,0 +1,42 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"time"
+	
+	"github.com/adminium/permify/internal/repositories"
+	db "github.com/adminium/permify/pkg/database/memory"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// TenantSettingsWriter - Structure for Tenant Settings Writer
+type TenantSettingsWriter struct {
+	database *db.Memory
+	// logger
+	logger logger.Interface
+}
+
+// NewTenantSettingsWriter creates a new TenantSettingsWriter
+func NewTenantSettingsWriter(database *db.Memory, logger logger.Interface) *TenantSettingsWriter {
+	return &TenantSettingsWriter{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// WriteTenantSettings - the existing settings of the tenant are replaced
+func (w *TenantSettingsWriter) WriteTenantSettings(ctx context.Context, tenantID string, settings repositories.TenantSettings) (err error) {
+	txn := w.database.DB.Txn(true)
+	defer txn.Abort()
+	if err = txn.Insert(TenantSettingsTable, repositories.TenantSettingsRecord{
+		TenantID:  tenantID,
+		Settings:  settings,
+		UpdatedAt: time.Now(),
+	}); err != nil {
+		return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	txn.Commit()
+	return nil
+}
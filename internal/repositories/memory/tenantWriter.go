@@ -5,6 +5,8 @@ import (
 	"errors"
 	"time"
 	
+	"golang.org/x/exp/maps"
+	
 	"github.com/adminium/permify/internal/repositories"
 	db "github.com/adminium/permify/pkg/database/memory"
 	"github.com/adminium/permify/pkg/logger"
@@ -42,7 +44,7 @@ func (w *TenantWriter) CreateTenant(ctx context.Context, id, name string) (resul
 	return tenant.ToTenant(), nil
 }
 
-// DeleteTenant -
+// DeleteTenant - Deletes the tenant along with its tuples, schemas, identities, attributes and settings
 func (w *TenantWriter) DeleteTenant(ctx context.Context, tenantID string) (result *base.Tenant, err error) {
 	txn := w.database.DB.Txn(true)
 	defer txn.Abort()
@@ -51,9 +53,51 @@ func (w *TenantWriter) DeleteTenant(ctx context.Context, tenantID string) (resul
 	if err != nil {
 		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
 	}
+	if raw == nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_TENANT_NOT_FOUND.String())
+	}
 	if _, err = txn.DeleteAll(TenantsTable, "id", tenantID); err != nil {
 		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
 	}
+	for table, index := range map[string]string{
+		RelationTuplesTable:    "tenant-index",
+		SchemaDefinitionsTable: "tenant",
+		IdentitiesTable:        "tenant",
+		AttributesTable:        "tenant",
+		TenantSettingsTable:    "id",
+		CheckTracesTable:       "tenant",
+	} {
+		if _, err = txn.DeleteAll(table, index, tenantID); err != nil {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+	}
 	txn.Commit()
 	return raw.(repositories.Tenant).ToTenant(), nil
 }
+
+// UpdateTenant -
+func (w *TenantWriter) UpdateTenant(ctx context.Context, tenantID, name string, labels map[string]string) (result *base.Tenant, err error) {
+	txn := w.database.DB.Txn(true)
+	defer txn.Abort()
+	var raw interface{}
+	raw, err = txn.First(TenantsTable, "id", tenantID)
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	if raw == nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_TENANT_NOT_FOUND.String())
+	}
+	tenant := raw.(repositories.Tenant)
+	if name != "" {
+		tenant.Name = name
+	}
+	if labels != nil {
+		// the stored tenant must not share the map of the caller
+		tenant.Labels = maps.Clone(labels)
+	}
+	if err = txn.Insert(TenantsTable, tenant); err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	txn.Commit()
+	return tenant.ToTenant(), nil
+}
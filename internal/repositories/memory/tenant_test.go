@@ -0,0 +1,163 @@
+package memory_test
+
+import (
+	"context"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/structpb"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/internal/repositories/memory"
+	"github.com/adminium/permify/internal/repositories/memory/migrations"
+	"github.com/adminium/permify/pkg/database"
+	db "github.com/adminium/permify/pkg/database/memory"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+var _ = Describe("tenant", func() {
+	var mem *db.Memory
+	var l *logger.Logger
+	var writer *memory.TenantWriter
+	var reader *memory.TenantReader
+	ctx := context.Background()
+	
+	BeforeEach(func() {
+		var err error
+		mem, err = db.New(migrations.Schema)
+		Expect(err).ShouldNot(HaveOccurred())
+		l = logger.New("error")
+		writer = memory.NewTenantWriter(mem, l)
+		reader = memory.NewTenantReader(mem, l)
+	})
+	
+	Context("UpdateTenant", func() {
+		BeforeEach(func() {
+			_, err := writer.CreateTenant(ctx, "t1", "first")
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+		
+		It("Case 1: The name and the labels are replaced separately and read back with the tenant", func() {
+			tests := []struct {
+				name   string
+				labels map[string]string
+				// expected
+				tenant string
+				keys   int
+			}{
+				{name: "renamed", labels: nil, tenant: "renamed", keys: 0},
+				{name: "", labels: map[string]string{"plan": "pro", "region": "eu"}, tenant: "renamed", keys: 2},
+				// nil labels keep the labels, empty ones remove them
+				{name: "again", labels: nil, tenant: "again", keys: 2},
+				{name: "", labels: map[string]string{}, tenant: "again", keys: 0},
+			}
+			for _, test := range tests {
+				_, err := writer.UpdateTenant(ctx, "t1", test.name, test.labels)
+				Expect(err).ShouldNot(HaveOccurred())
+				
+				tenant, labels, err := reader.GetTenant(ctx, "t1")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(tenant.GetName()).Should(Equal(test.tenant))
+				Expect(labels).Should(HaveLen(test.keys))
+			}
+		})
+		
+		It("Case 2: A missing tenant is neither read nor updated", func() {
+			_, _, err := reader.GetTenant(ctx, "t2")
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_TENANT_NOT_FOUND.String()))
+			
+			_, err = writer.UpdateTenant(ctx, "t2", "missing", nil)
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_TENANT_NOT_FOUND.String()))
+		})
+	})
+	
+	Context("DeleteTenant", func() {
+		// rows - Rows of the tenant in the table, the tuples are counted with the tenant index
+		rows := func(table, tenantID string) (n int) {
+			it, err := mem.DB.Txn(false).Get(table, "id_prefix", "")
+			Expect(err).ShouldNot(HaveOccurred())
+			for obj := it.Next(); obj != nil; obj = it.Next() {
+				var id string
+				switch row := obj.(type) {
+				case repositories.Tenant:
+					id = row.ID
+				case repositories.SchemaDefinition:
+					id = row.TenantID
+				case repositories.Identity:
+					id = row.TenantID
+				case repositories.Attribute:
+					id = row.TenantID
+				case repositories.TenantSettingsRecord:
+					id = row.TenantID
+				default:
+					continue
+				}
+				if id == tenantID {
+					n++
+				}
+			}
+			return n
+		}
+		
+		// tuples - Tuples of the tenant
+		tuples := func(tenantID string) (n int) {
+			it, err := mem.DB.Txn(false).Get(memory.RelationTuplesTable, "tenant-index", tenantID)
+			Expect(err).ShouldNot(HaveOccurred())
+			for obj := it.Next(); obj != nil; obj = it.Next() {
+				n++
+			}
+			return n
+		}
+		
+		BeforeEach(func() {
+			for _, tenantID := range []string{"t1", "t10"} {
+				_, err := writer.CreateTenant(ctx, tenantID, tenantID)
+				Expect(err).ShouldNot(HaveOccurred())
+				
+				_, err = memory.NewRelationshipWriter(mem, l).WriteRelationships(ctx, tenantID, database.NewTupleCollection(
+					&base.Tuple{Entity: &base.Entity{Type: "doc", Id: "1"}, Relation: "viewer", Subject: &base.Subject{Type: tuple.USER, Id: "1"}},
+				))
+				Expect(err).ShouldNot(HaveOccurred())
+				
+				err = memory.NewSchemaWriter(mem, l).WriteSchema(ctx, []repositories.SchemaDefinition{
+					{TenantID: tenantID, EntityType: "doc", SerializedDefinition: []byte("entity doc {}"), Version: "v1"},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				
+				err = memory.NewIdentityWriter(mem, l).WriteIdentity(ctx, tenantID, tuple.USER, "jane", "1")
+				Expect(err).ShouldNot(HaveOccurred())
+				
+				err = memory.NewAttributeWriter(mem, l).WriteAttributes(ctx, tenantID, &base.Entity{Type: "doc", Id: "1"}, map[string]*structpb.Value{
+					"public": structpb.NewBoolValue(true),
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				
+				err = memory.NewTenantSettingsWriter(mem, l).WriteTenantSettings(ctx, tenantID, repositories.TenantSettings{Wildcards: true})
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+		})
+		
+		It("Case 1: The data of the deleted tenant goes with it, the tenants whose ids it prefixes keep theirs", func() {
+			tenant, err := writer.DeleteTenant(ctx, "t1")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(tenant.GetId()).Should(Equal("t1"))
+			
+			for tenantID, expected := range map[string]int{"t1": 0, "t10": 1} {
+				for _, table := range []string{memory.TenantsTable, memory.SchemaDefinitionsTable, memory.IdentitiesTable, memory.AttributesTable, memory.TenantSettingsTable} {
+					Expect(rows(table, tenantID)).Should(Equal(expected), "rows of %s in %s", tenantID, table)
+				}
+				Expect(tuples(tenantID)).Should(Equal(expected), "tuples of %s", tenantID)
+			}
+		})
+		
+		It("Case 2: A missing tenant can not be deleted", func() {
+			_, err := writer.DeleteTenant(ctx, "t1")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			_, err = writer.DeleteTenant(ctx, "t1")
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_TENANT_NOT_FOUND.String()))
+		})
+	})
+})
@@ -3,6 +3,7 @@ package utils
 import (
 	"sync"
 	
+	db "github.com/adminium/permify/pkg/database/memory"
 	base "github.com/adminium/permify/pkg/pb/base/v1"
 )
 
@@ -25,13 +26,38 @@ func (a *AutoIncForRelationTuples) ID() (id uint64) {
 	return
 }
 
-// GetIndexNameAndArgsByFilters - Get index name and arguments by filters
-func GetIndexNameAndArgsByFilters(tenantID string, filter *base.TupleFilter) (string, []any) {
+// GetIndexNameAndArgsByFilters - Get index name and arguments by filters. A single subject is the most selective
+// filter, so reverse queries of a subject use the subject index before any entity-side index; the fields that the
+// index does not cover are left to FilterQuery.
+// ok is false when the filter names an entity type, relation or subject type that no tuple has.
+func GetIndexNameAndArgsByFilters(symbols *db.Symbols, tenantID string, filter *base.TupleFilter) (index string, args []any, ok bool) {
+	var entityType, relation, subjectType uint32
+	if filter.GetEntity().GetType() != "" {
+		if entityType, ok = symbols.Lookup(filter.GetEntity().GetType()); !ok {
+			return "", nil, false
+		}
+	}
+	if filter.GetRelation() != "" {
+		if relation, ok = symbols.Lookup(filter.GetRelation()); !ok {
+			return "", nil, false
+		}
+	}
+	if filter.GetSubject().GetType() != "" {
+		if subjectType, ok = symbols.Lookup(filter.GetSubject().GetType()); !ok {
+			return "", nil, false
+		}
+	}
+	if filter.GetSubject().GetType() != "" && len(filter.GetSubject().GetIds()) == 1 {
+		return "subject-index", []any{tenantID, subjectType, filter.GetSubject().GetIds()[0]}, true
+	}
 	if filter.GetEntity().GetType() != "" && filter.GetRelation() != "" {
-		return "entity-type-and-relation-index", []any{tenantID, filter.GetEntity().GetType(), filter.GetRelation()}
+		return "entity-type-and-relation-index", []any{tenantID, entityType, relation}, true
 	}
 	if filter.GetEntity().GetType() != "" {
-		return "entity-type-index", []any{tenantID, filter.GetEntity().GetType()}
+		return "entity-type-index", []any{tenantID, entityType}, true
+	}
+	if filter.GetSubject().GetType() != "" {
+		return "subject-type-index", []any{tenantID, subjectType}, true
 	}
-	return "id", nil
+	return "tenant-index", []any{tenantID}, true
 }
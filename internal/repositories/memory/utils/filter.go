@@ -4,31 +4,45 @@ import (
 	"github.com/hashicorp/go-memdb"
 	"golang.org/x/exp/slices"
 	
-	"github.com/adminium/permify/internal/repositories"
+	db "github.com/adminium/permify/pkg/database/memory"
 	base "github.com/adminium/permify/pkg/pb/base/v1"
 )
 
 // FilterQuery - Filter relation tuples according to given filter
-func FilterQuery(filter *base.TupleFilter) memdb.FilterFunc {
+func FilterQuery(symbols *db.Symbols, filter *base.TupleFilter) memdb.FilterFunc {
+	entityType, entityTypeOk := lookup(symbols, filter.GetEntity().GetType())
+	relation, relationOk := lookup(symbols, filter.GetRelation())
+	subjectType, subjectTypeOk := lookup(symbols, filter.GetSubject().GetType())
+	subjectRelation, subjectRelationOk := lookup(symbols, filter.GetSubject().GetRelation())
 	return func(tupleRaw interface{}) bool {
-		tuple, ok := tupleRaw.(repositories.RelationTuple)
+		tuple, ok := tupleRaw.(RelationTuple)
 		if !ok {
 			return true
 		}
 		switch {
-		case filter.GetEntity().GetType() != "" && tuple.EntityType != filter.GetEntity().GetType():
+		case !entityTypeOk || !relationOk || !subjectTypeOk || !subjectRelationOk:
+			return true
+		case filter.GetEntity().GetType() != "" && tuple.EntityType != entityType:
 			return true
 		case len(filter.GetEntity().GetIds()) > 0 && !slices.Contains(filter.GetEntity().GetIds(), tuple.EntityID):
 			return true
-		case filter.GetRelation() != "" && tuple.Relation != filter.GetRelation():
+		case filter.GetRelation() != "" && tuple.Relation != relation:
 			return true
-		case filter.GetSubject().GetType() != "" && tuple.SubjectType != filter.GetSubject().GetType():
+		case filter.GetSubject().GetType() != "" && tuple.SubjectType != subjectType:
 			return true
 		case len(filter.GetSubject().GetIds()) > 0 && !slices.Contains(filter.GetSubject().GetIds(), tuple.SubjectID):
 			return true
-		case filter.GetSubject().GetRelation() != "" && tuple.SubjectRelation != filter.GetSubject().GetRelation():
+		case filter.GetSubject().GetRelation() != "" && tuple.SubjectRelation != subjectRelation:
 			return true
 		}
 		return false
 	}
 }
+
+// lookup - Empty names are not filtered on, other names that were never interned match nothing
+func lookup(symbols *db.Symbols, name string) (uint32, bool) {
+	if name == "" {
+		return 0, true
+	}
+	return symbols.Lookup(name)
+}
@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"time"
+	
+	"google.golang.org/protobuf/types/known/structpb"
+	
+	"github.com/adminium/permify/internal/repositories"
+	db "github.com/adminium/permify/pkg/database/memory"
+)
+
+// RelationTuple - Stored form of a relation tuple. Entity types, relations, subject types and schema versions
+// repeat across tuples, so they are kept as symbols of the database instead of strings.
+type RelationTuple struct {
+	ID              uint64
+	TenantID        string
+	EntityType      uint32
+	EntityID        string
+	Relation        uint32
+	SubjectType     uint32
+	SubjectID       string
+	SubjectRelation uint32
+	// metadata
+	CreatedAt time.Time
+	CreatedBy string
+	Reference string
+	// SchemaVersion - symbol of the version of the schema the tuple was validated against
+	SchemaVersion uint32
+	NotBefore     time.Time
+	NotAfter      time.Time
+	Payload       *structpb.Struct
+}
+
+// NewRelationTuple - Interns the names of the tuple
+func NewRelationTuple(symbols *db.Symbols, t repositories.RelationTuple) RelationTuple {
+	return RelationTuple{
+		ID:              t.ID,
+		TenantID:        t.TenantID,
+		EntityType:      symbols.Intern(t.EntityType),
+		EntityID:        t.EntityID,
+		Relation:        symbols.Intern(t.Relation),
+		SubjectType:     symbols.Intern(t.SubjectType),
+		SubjectID:       t.SubjectID,
+		SubjectRelation: symbols.Intern(t.SubjectRelation),
+		CreatedAt:       t.CreatedAt,
+		CreatedBy:       t.CreatedBy,
+		Reference:       t.Reference,
+		SchemaVersion:   symbols.Intern(t.SchemaVersion),
+		NotBefore:       t.NotBefore,
+		NotAfter:        t.NotAfter,
+		Payload:         t.Payload,
+	}
+}
+
+// ToRelationTuple - Resolves the symbols of the tuple, the names share the memory of the symbol table
+func (t RelationTuple) ToRelationTuple(symbols *db.Symbols) repositories.RelationTuple {
+	return repositories.RelationTuple{
+		ID:              t.ID,
+		TenantID:        t.TenantID,
+		EntityType:      symbols.Name(t.EntityType),
+		EntityID:        t.EntityID,
+		Relation:        symbols.Name(t.Relation),
+		SubjectType:     symbols.Name(t.SubjectType),
+		SubjectID:       t.SubjectID,
+		SubjectRelation: symbols.Name(t.SubjectRelation),
+		CreatedAt:       t.CreatedAt,
+		CreatedBy:       t.CreatedBy,
+		Reference:       t.Reference,
+		SchemaVersion:   symbols.Name(t.SchemaVersion),
+		NotBefore:       t.NotBefore,
+		NotAfter:        t.NotAfter,
+		Payload:         t.Payload,
+	}
+}
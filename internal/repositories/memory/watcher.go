@@ -0,0 +1,103 @@
+package memory
+
+import (
+	"context"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/internal/repositories/memory/snapshot"
+	db "github.com/adminium/permify/pkg/database/memory"
+	"github.com/adminium/permify/pkg/logger"
+	"github.com/adminium/permify/pkg/storage"
+	"github.com/adminium/permify/pkg/token"
+)
+
+const (
+	_defaultWatchBufferSize = 100
+)
+
+// Watcher - Streams the changes that the relationship writer publishes to the feed of the database
+type Watcher struct {
+	database *db.Memory
+	reader   *RelationshipReader
+	// logger
+	logger logger.Interface
+}
+
+// NewWatcher - Creates a new Watcher
+func NewWatcher(database *db.Memory, logger logger.Interface) *Watcher {
+	return &Watcher{
+		database: database,
+		reader:   NewRelationshipReader(database, logger),
+		logger:   logger,
+	}
+}
+
+// Watch - Memory engine removes deleted tuples physically, so the changes before the watch started only contain the
+// written tuples; they are sent at once with the head token. The feed is subscribed to before they are read, changes
+// published at the same time can be sent twice.
+func (w *Watcher) Watch(ctx context.Context, tenantID string, snap string) (<-chan *repositories.RelationshipChanges, <-chan error) {
+	changes := make(chan *repositories.RelationshipChanges, _defaultWatchBufferSize)
+	errs := make(chan error, 1)
+	
+	feed, unsubscribe := w.database.Changes.Subscribe(tenantID, _defaultWatchBufferSize)
+	
+	go func() {
+		defer close(changes)
+		defer close(errs)
+		defer unsubscribe()
+		
+		head, err := w.reader.HeadSnapshot(ctx, tenantID)
+		if err != nil {
+			errs <- err
+			return
+		}
+		
+		var from token.SnapToken
+		from, err = snapshot.EncodedToken{Value: snap}.Decode()
+		if err != nil {
+			errs <- err
+			return
+		}
+		
+		if from.Lt(head) {
+			backlog := &repositories.RelationshipChanges{SnapToken: head.Encode().String()}
+			backlog.Created, backlog.Deleted, err = w.reader.ReadRelationshipChanges(ctx, tenantID, nil, snap, backlog.SnapToken)
+			if err != nil {
+				errs <- err
+				return
+			}
+			if len(backlog.Created.GetTuples()) > 0 {
+				select {
+				case changes <- backlog:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+		
+		for {
+			select {
+			case message, ok := <-feed:
+				if !ok {
+					errs <- storage.ErrWatchFellBehind
+					return
+				}
+				c := message.(*repositories.RelationshipChanges)
+				var st token.SnapToken
+				st, err = snapshot.EncodedToken{Value: c.SnapToken}.Decode()
+				if err != nil || !st.Gt(head) {
+					continue
+				}
+				select {
+				case changes <- c:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	
+	return changes, errs
+}
@@ -4,54 +4,114 @@ import (
 	"database/sql"
 	"embed"
 	"fmt"
+	"math"
 	
 	"github.com/pressly/goose/v3"
 	
 	"github.com/adminium/permify/internal/config"
 	"github.com/adminium/permify/pkg/database"
 	"github.com/adminium/permify/pkg/logger"
+	"github.com/adminium/permify/pkg/storage"
 )
 
 const (
-	postgresMigrationDir = "postgres/migrations"
+	postgresMigrationDir  = "postgres/migrations"
+	cockroachMigrationDir = "cockroach/migrations"
 )
 
 //go:embed postgres/migrations/*.sql
 var postgresMigrations embed.FS
 
+//go:embed cockroach/migrations/*.sql
+var cockroachMigrations embed.FS
+
 // Migrate - migrate the database
 func Migrate(conf config.Database, l logger.Interface) (err error) {
 	switch conf.Engine {
 	case database.POSTGRES.String():
-		
-		var db *sql.DB
-		db, err = sql.Open("pgx", conf.URI)
-		if err != nil {
-			return err
-		}
-		
-		defer func() {
-			if err = db.Close(); err != nil {
-				l.Fatal("failed to close the db", err)
+		return migrate(conf.URI, postgresMigrations, postgresMigrationDir, l)
+	case database.COCKROACH.String():
+		// cockroach speaks the postgres wire protocol, only the tables differ
+		return migrate(conf.URI, cockroachMigrations, cockroachMigrationDir, l)
+	case database.MEMORY.String(), database.RAFT.String():
+		return nil
+	default:
+		if driver, ok := storage.Lookup(conf.Engine); ok {
+			if migrator, ok := driver.(storage.Migrator); ok {
+				return migrator.Migrate(conf.URI)
 			}
-		}()
-		
-		goose.SetTableName("migrations")
-		
-		if err = goose.SetDialect("postgres"); err != nil {
-			l.Fatal("failed to initialize the migrate command", err)
+			return nil
 		}
-		
-		goose.SetBaseFS(postgresMigrations)
-		
-		if err = goose.Up(db, postgresMigrationDir); err != nil {
-			return err
+		return fmt.Errorf("%s connection is unsupported", conf.Engine)
+	}
+}
+
+// migrate - Applies the embedded migrations of the directory to a database of the postgres wire protocol
+func migrate(uri string, migrations embed.FS, dir string, l logger.Interface) (err error) {
+	var db *sql.DB
+	db, err = sql.Open("pgx", uri)
+	if err != nil {
+		return err
+	}
+	
+	defer func() {
+		if cerr := db.Close(); cerr != nil {
+			l.Fatal("failed to close the db", cerr)
 		}
-		
-		return nil
-	case database.MEMORY.String():
-		return nil
+	}()
+	
+	goose.SetTableName("migrations")
+	
+	if err = goose.SetDialect("postgres"); err != nil {
+		l.Fatal("failed to initialize the migrate command", err)
+	}
+	
+	goose.SetBaseFS(migrations)
+	
+	return goose.Up(db, dir)
+}
+
+// PendingMigrations - Versions of the migrations that are not applied to the database yet. The engines that are not
+// migrated by goose have none.
+func PendingMigrations(conf config.Database) (versions []int64, err error) {
+	switch conf.Engine {
+	case database.POSTGRES.String():
+		return pending(conf.URI, postgresMigrations, postgresMigrationDir)
+	case database.COCKROACH.String():
+		return pending(conf.URI, cockroachMigrations, cockroachMigrationDir)
 	default:
-		return fmt.Errorf("%s connection is unsupported", conf.Engine)
+		return nil, nil
+	}
+}
+
+// pending - Embedded migrations of the directory after the version of the database
+func pending(uri string, migrations embed.FS, dir string) (versions []int64, err error) {
+	var db *sql.DB
+	db, err = sql.Open("pgx", uri)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+	
+	goose.SetTableName("migrations")
+	
+	if err = goose.SetDialect("postgres"); err != nil {
+		return nil, err
+	}
+	
+	goose.SetBaseFS(migrations)
+	
+	current, err := goose.GetDBVersion(db)
+	if err != nil {
+		return nil, err
+	}
+	
+	collected, err := goose.CollectMigrations(dir, current, math.MaxInt64)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range collected {
+		versions = append(versions, m.Version)
 	}
+	return versions, nil
 }
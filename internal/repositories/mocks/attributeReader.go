@@ -0,0 +1,38 @@
+package mocks
+
+import (
+	"context"
+	
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/structpb"
+	
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// AttributeReader is an autogenerated mock type for the AttributeReader type
+type AttributeReader struct {
+	mock.Mock
+}
+
+// ReadAttributes - Reads the attributes of an entity from repository
+func (_m *AttributeReader) ReadAttributes(ctx context.Context, tenantID string, entity *base.Entity) (attributes map[string]*structpb.Value, err error) {
+	ret := _m.Called(tenantID, entity)
+	
+	var r0 map[string]*structpb.Value
+	if rf, ok := ret.Get(0).(func(context.Context, string, *base.Entity) map[string]*structpb.Value); ok {
+		r0 = rf(ctx, tenantID, entity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*structpb.Value)
+		}
+	}
+	
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *base.Entity) error); ok {
+		r1 = rf(ctx, tenantID, entity)
+	} else {
+		r1 = ret.Error(1)
+	}
+	
+	return r0, r1
+}
@@ -2,6 +2,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 	
 	"github.com/stretchr/testify/mock"
 	
@@ -121,3 +122,85 @@ func (_m *RelationshipReader) HeadSnapshot(ctx context.Context, tenantID string)
 	
 	return r0, r1
 }
+
+// SnapshotAt - Reads the snapshot that was the latest at the given time from the repository.
+func (_m *RelationshipReader) SnapshotAt(ctx context.Context, tenantID string, at time.Time) (token.SnapToken, error) {
+	ret := _m.Called(tenantID, at)
+	
+	var r0 token.SnapToken
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) token.SnapToken); ok {
+		r0 = rf(ctx, tenantID, at)
+	} else {
+		r0 = ret.Get(0).(token.SnapToken)
+	}
+	
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, tenantID, at)
+	} else {
+		if e, ok := ret.Get(1).(error); ok {
+			r1 = e
+		} else {
+			r1 = nil
+		}
+	}
+	
+	return r0, r1
+}
+
+// ReadDeletedRelationships - Reads relation tuples deleted within the given time window from the repository.
+func (_m *RelationshipReader) ReadDeletedRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, from, to time.Time) (*database.TupleCollection, error) {
+	ret := _m.Called(tenantID, filter, from, to)
+	
+	var r0 *database.TupleCollection
+	if rf, ok := ret.Get(0).(func(context.Context, string, *base.TupleFilter, time.Time, time.Time) *database.TupleCollection); ok {
+		r0 = rf(ctx, tenantID, filter, from, to)
+	} else {
+		r0 = ret.Get(0).(*database.TupleCollection)
+	}
+	
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *base.TupleFilter, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, tenantID, filter, from, to)
+	} else {
+		if e, ok := ret.Get(1).(error); ok {
+			r1 = e
+		} else {
+			r1 = nil
+		}
+	}
+	
+	return r0, r1
+}
+
+// ReadRelationshipChanges - Reads relation tuples created and deleted between the snapshots of two tokens from the repository.
+func (_m *RelationshipReader) ReadRelationshipChanges(ctx context.Context, tenantID string, filter *base.TupleFilter, from, to string) (*database.TupleCollection, *database.TupleCollection, error) {
+	ret := _m.Called(tenantID, filter, from, to)
+	
+	var r0 *database.TupleCollection
+	if rf, ok := ret.Get(0).(func(context.Context, string, *base.TupleFilter, string, string) *database.TupleCollection); ok {
+		r0 = rf(ctx, tenantID, filter, from, to)
+	} else {
+		r0 = ret.Get(0).(*database.TupleCollection)
+	}
+	
+	var r1 *database.TupleCollection
+	if rf, ok := ret.Get(1).(func(context.Context, string, *base.TupleFilter, string, string) *database.TupleCollection); ok {
+		r1 = rf(ctx, tenantID, filter, from, to)
+	} else {
+		r1 = ret.Get(1).(*database.TupleCollection)
+	}
+	
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, *base.TupleFilter, string, string) error); ok {
+		r2 = rf(ctx, tenantID, filter, from, to)
+	} else {
+		if e, ok := ret.Get(2).(error); ok {
+			r2 = e
+		} else {
+			r2 = nil
+		}
+	}
+	
+	return r0, r1, r2
+}
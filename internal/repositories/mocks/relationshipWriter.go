@@ -40,6 +40,31 @@ func (_m *SchemaReader) WriteRelationships(ctx context.Context, tenantID string,
 	return r0, r1
 }
 
+// BatchWriteRelationships - Write Relations to repository in one transaction
+func (_m *SchemaReader) BatchWriteRelationships(ctx context.Context, tenantID string, collection *database.TupleCollection) (token.EncodedSnapToken, error) {
+	ret := _m.Called(tenantID, collection)
+	
+	var r0 token.EncodedSnapToken
+	if rf, ok := ret.Get(0).(func(context.Context, string, *database.TupleCollection) token.EncodedSnapToken); ok {
+		r0 = rf(ctx, tenantID, collection)
+	} else {
+		r0 = ret.Get(0).(token.EncodedSnapToken)
+	}
+	
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *database.TupleCollection) error); ok {
+		r1 = rf(ctx, tenantID, collection)
+	} else {
+		if e, ok := ret.Get(1).(error); ok {
+			r1 = e
+		} else {
+			r1 = nil
+		}
+	}
+	
+	return r0, r1
+}
+
 // DeleteRelationships - Delete relationship from repository
 func (_m *SchemaReader) DeleteRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter) (token.EncodedSnapToken, error) {
 	ret := _m.Called(tenantID, filter)
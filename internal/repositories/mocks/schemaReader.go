@@ -4,6 +4,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"golang.org/x/net/context"
 	
+	"github.com/adminium/permify/pkg/database"
 	base "github.com/adminium/permify/pkg/pb/base/v1"
 )
 
@@ -69,6 +70,33 @@ func (_m *SchemaReader) ReadSchemaDefinition(ctx context.Context, tenantID strin
 	return r0, r1, r2
 }
 
+// ReadSchemaString - Reads the serialized definitions of the schema version from repository
+func (_m *SchemaReader) ReadSchemaString(ctx context.Context, tenantID string, version string) (definitions []string, err error) {
+	ret := _m.Called(tenantID, version)
+	
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []string); ok {
+		r0 = rf(ctx, tenantID, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tenantID, version)
+	} else {
+		if e, ok := ret.Get(1).(error); ok {
+			r1 = e
+		} else {
+			r1 = nil
+		}
+	}
+	
+	return r0, r1
+}
+
 // HeadVersion - Reads the latest version from the repository.
 func (_m *SchemaReader) HeadVersion(ctx context.Context, tenantID string) (version string, err error) {
 	ret := _m.Called(tenantID)
@@ -93,3 +121,35 @@ func (_m *SchemaReader) HeadVersion(ctx context.Context, tenantID string) (versi
 	
 	return r0, r1
 }
+
+// ListSchemaVersions - Reads the versions of the schema from the repository.
+func (_m *SchemaReader) ListSchemaVersions(ctx context.Context, tenantID string, pagination database.Pagination) (versions []string, ct database.EncodedContinuousToken, err error) {
+	ret := _m.Called(tenantID, pagination)
+	
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, string, database.Pagination) []string); ok {
+		r0 = rf(ctx, tenantID, pagination)
+	} else {
+		r0 = ret.Get(0).([]string)
+	}
+	
+	var r1 database.EncodedContinuousToken
+	if rf, ok := ret.Get(1).(func(context.Context, string, database.Pagination) database.EncodedContinuousToken); ok {
+		r1 = rf(ctx, tenantID, pagination)
+	} else {
+		r1 = ret.Get(1).(database.EncodedContinuousToken)
+	}
+	
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, database.Pagination) error); ok {
+		r2 = rf(ctx, tenantID, pagination)
+	} else {
+		if e, ok := ret.Get(2).(error); ok {
+			r2 = e
+		} else {
+			r2 = nil
+		}
+	}
+	
+	return r0, r1, r2
+}
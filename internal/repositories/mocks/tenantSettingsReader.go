@@ -0,0 +1,35 @@
+package mocks
+
+import (
+	"context"
+	
+	"github.com/stretchr/testify/mock"
+	
+	"github.com/adminium/permify/internal/repositories"
+)
+
+// TenantSettingsReader is an autogenerated mock type for the TenantSettingsReader type
+type TenantSettingsReader struct {
+	mock.Mock
+}
+
+// ReadTenantSettings - Reads the settings of a tenant from repository
+func (_m *TenantSettingsReader) ReadTenantSettings(ctx context.Context, tenantID string) (settings repositories.TenantSettings, err error) {
+	ret := _m.Called(tenantID)
+	
+	var r0 repositories.TenantSettings
+	if rf, ok := ret.Get(0).(func(context.Context, string) repositories.TenantSettings); ok {
+		r0 = rf(ctx, tenantID)
+	} else {
+		r0 = ret.Get(0).(repositories.TenantSettings)
+	}
+	
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tenantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	
+	return r0, r1
+}
@@ -3,9 +3,12 @@ package repositories
 import (
 	"time"
 	
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	
+	"github.com/adminium/permify/pkg/database"
 	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/storage"
 )
 
 // RelationTuple - Structure for Relational Tuple
@@ -18,11 +21,21 @@ type RelationTuple struct {
 	SubjectType     string
 	SubjectID       string
 	SubjectRelation string
+	// metadata
+	CreatedAt     time.Time
+	CreatedBy     string
+	Reference     string
+	SchemaVersion string
+	// validity window, zero values are unbounded
+	NotBefore time.Time
+	NotAfter  time.Time
+	// Payload - data the caveats evaluate the tuple with, nil for a tuple without one
+	Payload *structpb.Struct
 }
 
 // ToTuple - Convert database relation tuple to base relation tuple
 func (r RelationTuple) ToTuple() *base.Tuple {
-	return &base.Tuple{
+	t := &base.Tuple{
 		Entity: &base.Entity{
 			Type: r.EntityType,
 			Id:   r.EntityID,
@@ -33,20 +46,69 @@ func (r RelationTuple) ToTuple() *base.Tuple {
 			Id:       r.SubjectID,
 			Relation: r.SubjectRelation,
 		},
+		Payload: r.Payload,
 	}
+	if !r.NotBefore.IsZero() {
+		t.NotBefore = timestamppb.New(r.NotBefore)
+	}
+	if !r.NotAfter.IsZero() {
+		t.NotAfter = timestamppb.New(r.NotAfter)
+	}
+	return t
 }
 
-// SchemaDefinition - Structure for Schema Definition
-type SchemaDefinition struct {
-	TenantID             string
-	EntityType           string
-	SerializedDefinition []byte
-	Version              string
+// ToMetadata - Convert database relation tuple to tuple metadata
+func (r RelationTuple) ToMetadata() *database.TupleMetadata {
+	return &database.TupleMetadata{
+		CreatedAt:     r.CreatedAt,
+		CreatedBy:     r.CreatedBy,
+		Reference:     r.Reference,
+		SchemaVersion: r.SchemaVersion,
+		NotBefore:     r.NotBefore,
+		NotAfter:      r.NotAfter,
+	}
 }
 
-// Serialized - get schema serialized definition
-func (e SchemaDefinition) Serialized() string {
-	return string(e.SerializedDefinition)
+// ValidAt - Checks whether the validity window of the tuple contains the given time
+func (r RelationTuple) ValidAt(at time.Time) bool {
+	if !r.NotBefore.IsZero() && at.Before(r.NotBefore) {
+		return false
+	}
+	if !r.NotAfter.IsZero() && !at.Before(r.NotAfter) {
+		return false
+	}
+	return true
+}
+
+// SchemaDefinition - Structure for Schema Definition
+type SchemaDefinition = storage.SchemaDefinition
+
+// QueryPlan - How the storage reads the relation tuples of a filter
+type QueryPlan = storage.QueryPlan
+
+// RelationshipChanges - Relation tuples created and deleted by the transactions up to a snapshot
+type RelationshipChanges = storage.RelationshipChanges
+
+// TenantSettings - Engine behavior of a tenant
+type TenantSettings = storage.TenantSettings
+
+// APIKey - Key that authenticates the requests of its tenants
+type APIKey = storage.APIKey
+
+// CheckTrace - Sampled check with what it resolved and read
+type CheckTrace = storage.CheckTrace
+
+// CheckTraceStep - Permission or relation resolved by a traced check
+type CheckTraceStep = storage.CheckTraceStep
+
+// CheckTraceFilter - Selects the traces of a tenant
+type CheckTraceFilter = storage.CheckTraceFilter
+
+// TenantSettingsRecord - Structure for the stored settings of a tenant
+type TenantSettingsRecord struct {
+	TenantID  string
+	Settings  TenantSettings
+	UpdatedAt time.Time
 }
 
 // Tenant - Structure for tenant
@@ -54,6 +116,8 @@ type Tenant struct {
 	ID        string
 	Name      string
 	CreatedAt time.Time
+	// Labels - key value pairs of the clients, they are not interpreted
+	Labels map[string]string
 }
 
 // ToTenant - Convert database tenant to base tenant
@@ -64,3 +128,22 @@ func (r Tenant) ToTenant() *base.Tenant {
 		CreatedAt: timestamppb.New(r.CreatedAt),
 	}
 }
+
+// Identity - Structure for the mapping of an external identifier to a canonical subject id
+type Identity struct {
+	TenantID    string
+	SubjectType string
+	Alias       string
+	SubjectID   string
+	CreatedAt   time.Time
+}
+
+// Attribute - Structure for the value of an attribute of an entity
+type Attribute struct {
+	TenantID   string
+	EntityType string
+	EntityID   string
+	Name       string
+	Value      *structpb.Value
+	CreatedAt  time.Time
+}
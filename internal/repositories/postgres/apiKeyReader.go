@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	
+	"github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel/codes"
+	
+	"github.com/adminium/permify/internal/repositories"
+	db "github.com/adminium/permify/pkg/database/postgres"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// APIKeyReader - Structure for API Key Reader
+type APIKeyReader struct {
+	database *db.Postgres
+	// logger
+	logger logger.Interface
+}
+
+// NewAPIKeyReader - Creates a new APIKeyReader
+func NewAPIKeyReader(database *db.Postgres, logger logger.Interface) *APIKeyReader {
+	return &APIKeyReader{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// ReadAPIKey - Reads the key with the hash of its secret, record not found when there is none
+func (r *APIKeyReader) ReadAPIKey(ctx context.Context, hash string) (key repositories.APIKey, err error) {
+	ctx, span := tracer.Start(ctx, "api-key-reader.read-api-key")
+	defer span.End()
+	
+	query := r.database.Builder.Select("id, hash, tenants, scope, created_at").From(APIKeysTable).Where(squirrel.Eq{
+		"hash": hash,
+	}).RunWith(r.database.DB)
+	
+	key, err = scanAPIKey(query.QueryRowContext(ctx))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		if errors.Is(err, sql.ErrNoRows) {
+			return key, errors.New(base.ErrorCode_ERROR_CODE_RECORD_NOT_FOUND.String())
+		}
+		return key, errors.New(base.ErrorCode_ERROR_CODE_SCAN.String())
+	}
+	
+	return key, nil
+}
+
+// ListAPIKeys - Reads the keys, the oldest first
+func (r *APIKeyReader) ListAPIKeys(ctx context.Context) (keys []repositories.APIKey, err error) {
+	ctx, span := tracer.Start(ctx, "api-key-reader.list-api-keys")
+	defer span.End()
+	
+	query := r.database.Builder.Select("id, hash, tenants, scope, created_at").From(APIKeysTable).OrderBy("created_at, id")
+	
+	var rows *sql.Rows
+	rows, err = query.RunWith(r.database.DB).QueryContext(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	defer rows.Close()
+	
+	for rows.Next() {
+		var key repositories.APIKey
+		if key, err = scanAPIKey(rows); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_SCAN.String())
+		}
+		keys = append(keys, key)
+	}
+	if err = rows.Err(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	
+	return keys, nil
+}
+
+// scanAPIKey - The tenants are stored as a json array
+func scanAPIKey(row squirrel.RowScanner) (key repositories.APIKey, err error) {
+	var tenants []byte
+	if err = row.Scan(&key.ID, &key.Hash, &tenants, &key.Scope, &key.CreatedAt); err != nil {
+		return key, err
+	}
+	err = json.Unmarshal(tenants, &key.Tenants)
+	return key, err
+}
@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	
+	"github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel/codes"
+	
+	"github.com/adminium/permify/internal/repositories"
+	db "github.com/adminium/permify/pkg/database/postgres"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// APIKeyWriter - Structure for API Key Writer
+type APIKeyWriter struct {
+	database *db.Postgres
+	// logger
+	logger logger.Interface
+}
+
+// NewAPIKeyWriter - Creates a new APIKeyWriter
+func NewAPIKeyWriter(database *db.Postgres, logger logger.Interface) *APIKeyWriter {
+	return &APIKeyWriter{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// WriteAPIKey - Writes the key with the hash of its secret
+func (w *APIKeyWriter) WriteAPIKey(ctx context.Context, key repositories.APIKey) (err error) {
+	ctx, span := tracer.Start(ctx, "api-key-writer.write-api-key")
+	defer span.End()
+	
+	tenants := key.Tenants
+	if tenants == nil {
+		tenants = []string{}
+	}
+	var encoded []byte
+	encoded, err = json.Marshal(tenants)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+	}
+	
+	_, err = w.database.Builder.Insert(APIKeysTable).
+		Columns("id, hash, tenants, scope, created_at").
+		Values(key.ID, key.Hash, string(encoded), key.Scope, key.CreatedAt).
+		RunWith(w.database.DB).
+		ExecContext(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	
+	return nil
+}
+
+// DeleteAPIKey - Deletes the key with the id, record not found when there is none
+func (w *APIKeyWriter) DeleteAPIKey(ctx context.Context, id string) (err error) {
+	ctx, span := tracer.Start(ctx, "api-key-writer.delete-api-key")
+	defer span.End()
+	
+	result, err := w.database.Builder.Delete(APIKeysTable).
+		Where(squirrel.Eq{"id": id}).
+		RunWith(w.database.DB).
+		ExecContext(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	
+	var affected int64
+	if affected, err = result.RowsAffected(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	if affected == 0 {
+		return errors.New(base.ErrorCode_ERROR_CODE_RECORD_NOT_FOUND.String())
+	}
+	
+	return nil
+}
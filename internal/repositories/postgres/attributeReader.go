@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	
+	"github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	
+	db "github.com/adminium/permify/pkg/database/postgres"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// AttributeReader - Structure for Attribute Reader
+type AttributeReader struct {
+	database *db.Postgres
+	// logger
+	logger logger.Interface
+}
+
+// NewAttributeReader - Creates a new AttributeReader
+func NewAttributeReader(database *db.Postgres, logger logger.Interface) *AttributeReader {
+	return &AttributeReader{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// ReadAttributes - Reads the attribute values of the entity
+func (r *AttributeReader) ReadAttributes(ctx context.Context, tenantID string, entity *base.Entity) (attributes map[string]*structpb.Value, err error) {
+	ctx, span := tracer.Start(ctx, "attribute-reader.read-attributes")
+	defer span.End()
+	
+	var rows *sql.Rows
+	rows, err = r.database.Builder.Select("attribute, value").From(AttributesTable).Where(squirrel.Eq{
+		"tenant_id":   tenantID,
+		"entity_type": entity.GetType(),
+		"entity_id":   entity.GetId(),
+	}).RunWith(r.database.DB).QueryContext(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	defer rows.Close()
+	
+	attributes = map[string]*structpb.Value{}
+	for rows.Next() {
+		var name, raw string
+		if err = rows.Scan(&name, &raw); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_SCAN.String())
+		}
+		value := &structpb.Value{}
+		if err = protojson.Unmarshal([]byte(raw), value); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		attributes[name] = value
+	}
+	if err = rows.Err(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	
+	return attributes, nil
+}
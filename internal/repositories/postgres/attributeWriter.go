@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	
+	"github.com/Masterminds/squirrel"
+	otelCodes "go.opentelemetry.io/otel/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	
+	db "github.com/adminium/permify/pkg/database/postgres"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// AttributeWriter - Structure for Attribute Writer
+type AttributeWriter struct {
+	database *db.Postgres
+	// logger
+	logger logger.Interface
+}
+
+// NewAttributeWriter - Creates a new AttributeWriter
+func NewAttributeWriter(database *db.Postgres, logger logger.Interface) *AttributeWriter {
+	return &AttributeWriter{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// WriteAttributes - Writes the values of the attributes in a single statement, existing values are replaced
+func (w *AttributeWriter) WriteAttributes(ctx context.Context, tenantID string, entity *base.Entity, attributes map[string]*structpb.Value) (err error) {
+	ctx, span := tracer.Start(ctx, "attribute-writer.write-attributes")
+	defer span.End()
+	
+	if len(attributes) == 0 {
+		return nil
+	}
+	
+	query := w.database.Builder.Insert(AttributesTable).
+		Columns("tenant_id, entity_type, entity_id, attribute, value")
+	for name, value := range attributes {
+		var b []byte
+		b, err = protojson.Marshal(value)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		query = query.Values(tenantID, entity.GetType(), entity.GetId(), name, string(b))
+	}
+	
+	_, err = query.Suffix("ON CONFLICT (tenant_id, entity_type, entity_id, attribute) DO UPDATE SET value = EXCLUDED.value").
+		RunWith(w.database.DB).
+		ExecContext(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	
+	return nil
+}
+
+// DeleteAttributes - Deletes the attributes of the entity, all of them when no name is given
+func (w *AttributeWriter) DeleteAttributes(ctx context.Context, tenantID string, entity *base.Entity, names ...string) (err error) {
+	ctx, span := tracer.Start(ctx, "attribute-writer.delete-attributes")
+	defer span.End()
+	
+	builder := w.database.Builder.Delete(AttributesTable).Where(squirrel.Eq{
+		"tenant_id":   tenantID,
+		"entity_type": entity.GetType(),
+		"entity_id":   entity.GetId(),
+	})
+	if len(names) > 0 {
+		builder = builder.Where(squirrel.Eq{"attribute": names})
+	}
+	
+	_, err = builder.RunWith(w.database.DB).ExecContext(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	
+	return nil
+}
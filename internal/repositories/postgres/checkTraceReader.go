@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+	
+	"github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel/codes"
+	
+	"github.com/adminium/permify/internal/repositories"
+	db "github.com/adminium/permify/pkg/database/postgres"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// CheckTraceReader - Structure for Check Trace Reader
+type CheckTraceReader struct {
+	database *db.Postgres
+	// logger
+	logger logger.Interface
+}
+
+// NewCheckTraceReader - Creates a new CheckTraceReader
+func NewCheckTraceReader(database *db.Postgres, logger logger.Interface) *CheckTraceReader {
+	return &CheckTraceReader{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// ListCheckTraces - Reads the traces of the tenant within the times of the filter, the newest first
+func (r *CheckTraceReader) ListCheckTraces(ctx context.Context, filter repositories.CheckTraceFilter) (traces []repositories.CheckTrace, err error) {
+	ctx, span := tracer.Start(ctx, "check-trace-reader.list-check-traces")
+	defer span.End()
+	
+	query := r.database.Builder.
+		Select("id, tenant_id, entity, permission, subject, schema_version, snap_token, depth, result, error, steps, tuples, duration, created_at").
+		From(CheckTracesTable).
+		Where(squirrel.Eq{"tenant_id": filter.TenantID}).
+		OrderBy("created_at DESC, id")
+	if !filter.Since.IsZero() {
+		query = query.Where(squirrel.GtOrEq{"created_at": filter.Since})
+	}
+	if !filter.Until.IsZero() {
+		query = query.Where(squirrel.Lt{"created_at": filter.Until})
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(uint64(filter.Limit))
+	}
+	
+	var rows *sql.Rows
+	rows, err = query.RunWith(r.database.DB).QueryContext(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	defer rows.Close()
+	
+	for rows.Next() {
+		var trace repositories.CheckTrace
+		if trace, err = scanCheckTrace(rows); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_SCAN.String())
+		}
+		traces = append(traces, trace)
+	}
+	if err = rows.Err(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	
+	return traces, nil
+}
+
+// scanCheckTrace - The steps and the tuples are stored as json arrays, the duration in nanoseconds
+func scanCheckTrace(row squirrel.RowScanner) (trace repositories.CheckTrace, err error) {
+	var steps, tuples []byte
+	var duration int64
+	if err = row.Scan(&trace.ID, &trace.TenantID, &trace.Entity, &trace.Permission, &trace.Subject, &trace.SchemaVersion,
+		&trace.SnapToken, &trace.Depth, &trace.Result, &trace.Error, &steps, &tuples, &duration, &trace.CreatedAt); err != nil {
+		return trace, err
+	}
+	trace.Duration = time.Duration(duration)
+	if err = json.Unmarshal(steps, &trace.Steps); err != nil {
+		return trace, err
+	}
+	err = json.Unmarshal(tuples, &trace.Tuples)
+	return trace, err
+}
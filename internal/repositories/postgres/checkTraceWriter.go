@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+	
+	"github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel/codes"
+	
+	"github.com/adminium/permify/internal/repositories"
+	db "github.com/adminium/permify/pkg/database/postgres"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// CheckTraceWriter - Structure for Check Trace Writer
+type CheckTraceWriter struct {
+	database *db.Postgres
+	// logger
+	logger logger.Interface
+}
+
+// NewCheckTraceWriter - Creates a new CheckTraceWriter
+func NewCheckTraceWriter(database *db.Postgres, logger logger.Interface) *CheckTraceWriter {
+	return &CheckTraceWriter{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// WriteCheckTrace - Writes the trace, its steps and tuples as json arrays
+func (w *CheckTraceWriter) WriteCheckTrace(ctx context.Context, trace repositories.CheckTrace) (err error) {
+	ctx, span := tracer.Start(ctx, "check-trace-writer.write-check-trace")
+	defer span.End()
+	
+	steps := trace.Steps
+	if steps == nil {
+		steps = []repositories.CheckTraceStep{}
+	}
+	tuples := trace.Tuples
+	if tuples == nil {
+		tuples = []string{}
+	}
+	var encodedSteps, encodedTuples []byte
+	if encodedSteps, err = json.Marshal(steps); err == nil {
+		encodedTuples, err = json.Marshal(tuples)
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+	}
+	
+	_, err = w.database.Builder.Insert(CheckTracesTable).
+		Columns("id, tenant_id, entity, permission, subject, schema_version, snap_token, depth, result, error, steps, tuples, duration, created_at").
+		Values(trace.ID, trace.TenantID, trace.Entity, trace.Permission, trace.Subject, trace.SchemaVersion, trace.SnapToken,
+			trace.Depth, trace.Result, trace.Error, string(encodedSteps), string(encodedTuples), int64(trace.Duration), trace.CreatedAt).
+		RunWith(w.database.DB).
+		ExecContext(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	
+	return nil
+}
+
+// DeleteCheckTraces - Deletes the traces of every tenant created before the time
+func (w *CheckTraceWriter) DeleteCheckTraces(ctx context.Context, before time.Time) (deleted int64, err error) {
+	ctx, span := tracer.Start(ctx, "check-trace-writer.delete-check-traces")
+	defer span.End()
+	
+	result, err := w.database.Builder.Delete(CheckTracesTable).
+		Where(squirrel.Lt{"created_at": before}).
+		RunWith(w.database.DB).
+		ExecContext(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return 0, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	
+	if deleted, err = result.RowsAffected(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return 0, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	
+	return deleted, nil
+}
@@ -5,9 +5,17 @@ const (
 	SchemaDefinitionTable = "schema_definitions"
 	TransactionsTable     = "transactions"
 	TenantsTable          = "tenants"
+	IdentitiesTable       = "identities"
+	AttributesTable       = "attributes"
+	TenantSettingsTable   = "tenant_settings"
+	APIKeysTable          = "api_keys"
+	CheckTracesTable      = "check_traces"
 )
 
 const (
-	_defaultMaxTuplesPerWrite = 100
-	_defaultMaxRetries        = 10
+	_defaultMaxTuplesPerWrite      = 100
+	_defaultMaxTuplesPerBatchWrite = 10000
+	// _defaultInsertBatchSize - rows of a single insert statement, postgres limits a statement to 65535 parameters
+	_defaultInsertBatchSize = 1000
+	_defaultMaxRetries      = 10
 )
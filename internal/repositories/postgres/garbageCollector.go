@@ -0,0 +1,103 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+	
+	"github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel/codes"
+	
+	"github.com/adminium/permify/internal/repositories/postgres/snapshot"
+	"github.com/adminium/permify/internal/repositories/postgres/types"
+	db "github.com/adminium/permify/pkg/database/postgres"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/token"
+)
+
+// GarbageCollector - Deletes the versions of the relation tuples that expired before a time. The snapshots taken
+// before that time can no longer be read, since the tuples they saw are gone.
+type GarbageCollector struct {
+	database *db.Postgres
+	// logger
+	logger logger.Interface
+}
+
+// NewGarbageCollector - Creates a new GarbageCollector
+func NewGarbageCollector(database *db.Postgres, logger logger.Interface) *GarbageCollector {
+	return &GarbageCollector{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// CollectGarbage - Deletes at most limit relation tuples whose expiring transaction is older than the time
+func (g *GarbageCollector) CollectGarbage(ctx context.Context, before time.Time, limit int) (deleted int64, err error) {
+	ctx, span := tracer.Start(ctx, "garbage-collector.collect-garbage")
+	defer span.End()
+	
+	sub, args, err := g.database.Builder.
+		Select("relation_tuples.id").
+		From(RelationTuplesTable).
+		Join(TransactionsTable + " ON relation_tuples.expired_tx_id = transactions.id").
+		Where(squirrel.Expr("relation_tuples.expired_tx_id <> '0'::xid8")).
+		Where(squirrel.Lt{"transactions.timestamp": before.UTC()}).
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return 0, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+	}
+	
+	result, err := g.database.DB.ExecContext(ctx, "DELETE FROM "+RelationTuplesTable+" WHERE id IN ("+sub+")", args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return 0, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	
+	return result.RowsAffected()
+}
+
+// ExpiredSnapshot - A snapshot is expired when a transaction of the tenant after it was committed before the time,
+// since the tuples that transaction deleted are collected. The latest transaction committed before the time is the
+// earliest snapshot that can still be read. The tokens that are not postgres snapshots are left to the reads.
+func (g *GarbageCollector) ExpiredSnapshot(ctx context.Context, tenantID string, snap string, before time.Time) (earliest token.SnapToken, expired bool, err error) {
+	ctx, span := tracer.Start(ctx, "garbage-collector.expired-snapshot")
+	defer span.End()
+	
+	st, err := snapshot.EncodedToken{Value: snap}.Decode()
+	if err != nil {
+		return nil, false, nil
+	}
+	
+	query, args, err := g.database.Builder.
+		Select("id").
+		From(TransactionsTable).
+		Where(squirrel.Eq{"tenant_id": tenantID}).
+		Where(squirrel.Lt{"timestamp": before.UTC()}).
+		OrderBy("id DESC").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, false, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+	}
+	
+	var xid types.XID8
+	if err = g.database.DB.QueryRowContext(ctx, query, args...).Scan(&xid); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, false, nil
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, false, errors.New(base.ErrorCode_ERROR_CODE_SCAN.String())
+	}
+	
+	earliest = snapshot.Token{Value: xid}
+	return earliest, st.Lt(earliest), nil
+}
@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"time"
+	
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Masterminds/squirrel"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	
+	"github.com/adminium/permify/internal/repositories/postgres/snapshot"
+	"github.com/adminium/permify/internal/repositories/postgres/types"
+	"github.com/adminium/permify/pkg/database/postgres"
+	"github.com/adminium/permify/pkg/logger"
+)
+
+var _ = Describe("GarbageCollector", func() {
+	var garbageCollector *GarbageCollector
+	var mock sqlmock.Sqlmock
+	
+	BeforeEach(func() {
+		var db *sql.DB
+		var err error
+		
+		db, mock, err = sqlmock.New()
+		Expect(err).ShouldNot(HaveOccurred())
+		
+		pg := &postgres.Postgres{
+			DB:      db,
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		}
+		
+		garbageCollector = NewGarbageCollector(pg, logger.New("debug"))
+	})
+	
+	AfterEach(func() {
+		err := mock.ExpectationsWereMet()
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+	
+	Context("Collect Garbage", func() {
+		It("Deletes a batch of the tuples expired before the time", func() {
+			before := time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+			
+			mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM relation_tuples WHERE id IN (SELECT relation_tuples.id FROM relation_tuples JOIN transactions ON relation_tuples.expired_tx_id = transactions.id WHERE relation_tuples.expired_tx_id <> '0'::xid8 AND transactions.timestamp < $1 LIMIT 100)`)).
+				WithArgs(before).
+				WillReturnResult(sqlmock.NewResult(0, 42))
+			
+			deleted, err := garbageCollector.CollectGarbage(context.Background(), before, 100)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(deleted).Should(Equal(int64(42)))
+		})
+	})
+	
+	Context("Expired Snapshot", func() {
+		It("Reports the snapshots before the latest transaction committed before the time", func() {
+			before := time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+			
+			for _, test := range []struct {
+				snap    uint64
+				expired bool
+			}{
+				{snap: 3, expired: true},
+				{snap: 4, expired: false},
+				{snap: 9, expired: false},
+			} {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT id FROM transactions WHERE tenant_id = $1 AND timestamp < $2 ORDER BY id DESC LIMIT 1`)).
+					WithArgs("t1", before).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+				
+				snap := snapshot.NewToken(types.XID8{Uint: test.snap}).Encode().String()
+				earliest, expired, err := garbageCollector.ExpiredSnapshot(context.Background(), "t1", snap, before)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(expired).Should(Equal(test.expired))
+				Expect(earliest.Encode().String()).Should(Equal(snapshot.NewToken(types.XID8{Uint: 4}).Encode().String()))
+			}
+		})
+		
+		It("Reports no snapshot of a tenant without transactions before the time", func() {
+			before := time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+			
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT id FROM transactions WHERE tenant_id = $1 AND timestamp < $2 ORDER BY id DESC LIMIT 1`)).
+				WithArgs("t1", before).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			
+			snap := snapshot.NewToken(types.XID8{Uint: 1}).Encode().String()
+			_, expired, err := garbageCollector.ExpiredSnapshot(context.Background(), "t1", snap, before)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(expired).Should(BeFalse())
+		})
+	})
+})
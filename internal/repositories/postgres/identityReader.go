@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	
+	"github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel/codes"
+	
+	db "github.com/adminium/permify/pkg/database/postgres"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// IdentityReader - Structure for Identity Reader
+type IdentityReader struct {
+	database *db.Postgres
+	// logger
+	logger logger.Interface
+}
+
+// NewIdentityReader - Creates a new IdentityReader
+func NewIdentityReader(database *db.Postgres, logger logger.Interface) *IdentityReader {
+	return &IdentityReader{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// ResolveIdentity - Reads the canonical subject id of the alias
+func (r *IdentityReader) ResolveIdentity(ctx context.Context, tenantID, subjectType, alias string) (subjectID string, err error) {
+	ctx, span := tracer.Start(ctx, "identity-reader.resolve-identity")
+	defer span.End()
+	
+	query := r.database.Builder.Select("subject_id").From(IdentitiesTable).Where(squirrel.Eq{
+		"tenant_id":    tenantID,
+		"subject_type": subjectType,
+		"alias":        alias,
+	}).RunWith(r.database.DB)
+	
+	err = query.QueryRowContext(ctx).Scan(&subjectID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", errors.New(base.ErrorCode_ERROR_CODE_RECORD_NOT_FOUND.String())
+		}
+		return "", errors.New(base.ErrorCode_ERROR_CODE_SCAN.String())
+	}
+	
+	return subjectID, nil
+}
@@ -0,0 +1,70 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	
+	"github.com/Masterminds/squirrel"
+	otelCodes "go.opentelemetry.io/otel/codes"
+	
+	db "github.com/adminium/permify/pkg/database/postgres"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// IdentityWriter - Structure for Identity Writer
+type IdentityWriter struct {
+	database *db.Postgres
+	// logger
+	logger logger.Interface
+}
+
+// NewIdentityWriter - Creates a new IdentityWriter
+func NewIdentityWriter(database *db.Postgres, logger logger.Interface) *IdentityWriter {
+	return &IdentityWriter{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// WriteIdentity - Maps the alias to the subject id, an existing mapping of the alias is replaced
+func (w *IdentityWriter) WriteIdentity(ctx context.Context, tenantID, subjectType, alias, subjectID string) (err error) {
+	ctx, span := tracer.Start(ctx, "identity-writer.write-identity")
+	defer span.End()
+	
+	query := w.database.Builder.Insert(IdentitiesTable).
+		Columns("tenant_id, subject_type, alias, subject_id").
+		Values(tenantID, subjectType, alias, subjectID).
+		Suffix("ON CONFLICT (tenant_id, subject_type, alias) DO UPDATE SET subject_id = EXCLUDED.subject_id").
+		RunWith(w.database.DB)
+	
+	_, err = query.ExecContext(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	
+	return nil
+}
+
+// DeleteIdentity - Deletes the mapping of the alias
+func (w *IdentityWriter) DeleteIdentity(ctx context.Context, tenantID, subjectType, alias string) (err error) {
+	ctx, span := tracer.Start(ctx, "identity-writer.delete-identity")
+	defer span.End()
+	
+	query := w.database.Builder.Delete(IdentitiesTable).Where(squirrel.Eq{
+		"tenant_id":    tenantID,
+		"subject_type": subjectType,
+		"alias":        alias,
+	}).RunWith(w.database.DB)
+	
+	_, err = query.ExecContext(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	
+	return nil
+}
@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE relation_tuples
+    ADD COLUMN IF NOT EXISTS created_at TIMESTAMP DEFAULT (now() AT TIME ZONE 'UTC') NOT NULL,
+    ADD COLUMN IF NOT EXISTS created_by VARCHAR NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS reference  VARCHAR NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE relation_tuples
+    DROP COLUMN IF EXISTS created_at,
+    DROP COLUMN IF EXISTS created_by,
+    DROP COLUMN IF EXISTS reference;
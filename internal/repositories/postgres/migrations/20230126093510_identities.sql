@@ -0,0 +1,12 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS identities (
+   tenant_id    VARCHAR NOT NULL,
+   subject_type VARCHAR NOT NULL,
+   alias        VARCHAR NOT NULL,
+   subject_id   VARCHAR NOT NULL,
+   created_at   TIMESTAMP DEFAULT (now() AT TIME ZONE 'UTC') NOT NULL,
+   CONSTRAINT pk_identities PRIMARY KEY (tenant_id, subject_type, alias)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS identities;
@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE relation_tuples
+    ADD COLUMN IF NOT EXISTS not_before TIMESTAMP NULL,
+    ADD COLUMN IF NOT EXISTS not_after  TIMESTAMP NULL;
+
+-- +goose Down
+ALTER TABLE relation_tuples
+    DROP COLUMN IF EXISTS not_before,
+    DROP COLUMN IF EXISTS not_after;
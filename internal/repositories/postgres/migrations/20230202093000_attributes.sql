@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS attributes (
+   tenant_id   VARCHAR NOT NULL,
+   entity_type VARCHAR NOT NULL,
+   entity_id   VARCHAR NOT NULL,
+   attribute   VARCHAR NOT NULL,
+   value       JSONB   NOT NULL,
+   created_at  TIMESTAMP DEFAULT (now() AT TIME ZONE 'UTC') NOT NULL,
+   CONSTRAINT pk_attributes PRIMARY KEY (tenant_id, entity_type, entity_id, attribute)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS attributes;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE relation_tuples
+    ADD COLUMN IF NOT EXISTS schema_version VARCHAR NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE relation_tuples
+    DROP COLUMN IF EXISTS schema_version;
@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS tenant_settings (
+   tenant_id         VARCHAR NOT NULL,
+   strict_validation BOOLEAN NOT NULL DEFAULT TRUE,
+   wildcards         BOOLEAN NOT NULL DEFAULT FALSE,
+   cache_ttl         BIGINT  NOT NULL DEFAULT 0,
+   depth             INTEGER NOT NULL DEFAULT 0,
+   updated_at        TIMESTAMP DEFAULT (now() AT TIME ZONE 'UTC') NOT NULL,
+   CONSTRAINT pk_tenant_settings PRIMARY KEY (tenant_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS tenant_settings;
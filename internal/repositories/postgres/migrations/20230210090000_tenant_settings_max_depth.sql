@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE tenant_settings
+    ADD COLUMN IF NOT EXISTS max_depth INTEGER NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE tenant_settings
+    DROP COLUMN IF EXISTS max_depth;
@@ -0,0 +1,5 @@
+-- +goose Up
+CREATE INDEX IF NOT EXISTS idx_tuples_not_after ON relation_tuples (not_after) WHERE not_after IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_tuples_not_after;
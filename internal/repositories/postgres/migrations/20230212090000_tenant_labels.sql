@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE tenants
+    ADD COLUMN IF NOT EXISTS labels JSONB NOT NULL DEFAULT '{}';
+
+-- +goose Down
+ALTER TABLE tenants
+    DROP COLUMN IF EXISTS labels;
@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS api_keys (
+   id         VARCHAR NOT NULL,
+   hash       VARCHAR NOT NULL,
+   tenants    JSONB   NOT NULL DEFAULT '[]',
+   scope      VARCHAR NOT NULL,
+   created_at TIMESTAMP DEFAULT (now() AT TIME ZONE 'UTC') NOT NULL,
+   CONSTRAINT pk_api_keys PRIMARY KEY (id),
+   CONSTRAINT uq_api_keys_hash UNIQUE (hash)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS api_keys;
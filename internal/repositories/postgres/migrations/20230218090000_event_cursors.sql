@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS event_cursors (
+   tenant_id      VARCHAR NOT NULL,
+   snap_token     VARCHAR NOT NULL DEFAULT '',
+   schema_version VARCHAR NOT NULL DEFAULT '',
+   updated_at     TIMESTAMP NOT NULL,
+   CONSTRAINT pk_event_cursors PRIMARY KEY (tenant_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS event_cursors;
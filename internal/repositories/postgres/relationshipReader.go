@@ -4,7 +4,10 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"strconv"
+	"strings"
+	"time"
 	
 	"github.com/Masterminds/squirrel"
 	
@@ -63,13 +66,8 @@ func (r *RelationshipReader) QueryRelationships(ctx context.Context, tenantID st
 	
 	var args []interface{}
 	
-	builder := r.database.Builder.Select("entity_type, entity_id, relation, subject_type, subject_id, subject_relation").From(RelationTuplesTable).Where(squirrel.Eq{"tenant_id": tenantID})
-	builder = utils.FilterQueryForSelectBuilder(builder, filter)
-	
-	builder = utils.SnapshotQuery(builder, st.(snapshot.Token).Value.Uint)
-	
 	var query string
-	query, args, err = builder.ToSql()
+	query, args, err = r.queryRelationshipsBuilder(tenantID, filter, st).ToSql()
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
@@ -88,12 +86,18 @@ func (r *RelationshipReader) QueryRelationships(ctx context.Context, tenantID st
 	collection := database.NewTupleCollection()
 	for rows.Next() {
 		rt := repositories.RelationTuple{}
-		err = rows.Scan(&rt.EntityType, &rt.EntityID, &rt.Relation, &rt.SubjectType, &rt.SubjectID, &rt.SubjectRelation)
+		var payload sql.NullString
+		err = rows.Scan(&rt.EntityType, &rt.EntityID, &rt.Relation, &rt.SubjectType, &rt.SubjectID, &rt.SubjectRelation, &payload)
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, err.Error())
 			return nil, err
 		}
+		if rt.Payload, err = utils.Payload(payload); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
 		collection.Add(rt.ToTuple())
 	}
 	if err = rows.Err(); err != nil {
@@ -112,6 +116,80 @@ func (r *RelationshipReader) QueryRelationships(ctx context.Context, tenantID st
 	return collection.CreateTupleIterator(), nil
 }
 
+// queryRelationshipsBuilder - Select of the tuples of the filter that are visible at the snapshot
+func (r *RelationshipReader) queryRelationshipsBuilder(tenantID string, filter *base.TupleFilter, st token.SnapToken) squirrel.SelectBuilder {
+	builder := r.database.Builder.Select("entity_type, entity_id, relation, subject_type, subject_id, subject_relation, payload").From(RelationTuplesTable).Where(squirrel.Eq{"tenant_id": tenantID})
+	builder = utils.FilterQueryForSelectBuilder(builder, filter)
+	
+	builder = utils.SnapshotQuery(builder, st.(snapshot.Token).Value.Uint)
+	return utils.ValidityQuery(builder, st.(snapshot.Token).Value.Uint)
+}
+
+// ExplainQuery - Generated sql of the filter and its execution plan, the query itself is not run
+func (r *RelationshipReader) ExplainQuery(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (plan repositories.QueryPlan, err error) {
+	ctx, span := tracer.Start(ctx, "relationship-reader.explain-query")
+	defer span.End()
+	
+	var st token.SnapToken
+	st, err = snapshot.EncodedToken{Value: snap}.Decode()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return plan, err
+	}
+	
+	var args []interface{}
+	plan.Engine = "postgres"
+	plan.Query, args, err = r.queryRelationshipsBuilder(tenantID, filter, st).ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return plan, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+	}
+	for _, arg := range args {
+		plan.Args = append(plan.Args, fmt.Sprint(arg))
+	}
+	
+	var tx *sql.Tx
+	tx, err = r.database.DB.BeginTx(ctx, &r.txOptions)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return plan, err
+	}
+	
+	defer utils.Rollback(tx, r.logger)
+	
+	var rows *sql.Rows
+	rows, err = tx.QueryContext(ctx, "EXPLAIN "+plan.Query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return plan, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	defer rows.Close()
+	
+	for rows.Next() {
+		var line string
+		if err = rows.Scan(&line); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return plan, errors.New(base.ErrorCode_ERROR_CODE_SCAN.String())
+		}
+		plan.Plan = append(plan.Plan, line)
+		if strings.Contains(line, "Seq Scan on "+RelationTuplesTable) {
+			plan.FullScan = true
+		}
+	}
+	if err = rows.Err(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return plan, err
+	}
+	
+	return plan, tx.Commit()
+}
+
 // ReadRelationships - Read relationships for a given filter and pagination
 func (r *RelationshipReader) ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, pagination database.Pagination) (collection *database.TupleCollection, ct database.EncodedContinuousToken, err error) {
 	ctx, span := tracer.Start(ctx, "relationship-reader.read-relationships")
@@ -135,10 +213,11 @@ func (r *RelationshipReader) ReadRelationships(ctx context.Context, tenantID str
 	
 	defer utils.Rollback(tx, r.logger)
 	
-	builder := r.database.Builder.Select("id, entity_type, entity_id, relation, subject_type, subject_id, subject_relation").From(RelationTuplesTable).Where(squirrel.Eq{"tenant_id": tenantID})
+	builder := r.database.Builder.Select("id, entity_type, entity_id, relation, subject_type, subject_id, subject_relation, created_at, created_by, reference, schema_version, not_before, not_after, payload").From(RelationTuplesTable).Where(squirrel.Eq{"tenant_id": tenantID})
 	builder = utils.FilterQueryForSelectBuilder(builder, filter)
 	
 	builder = utils.SnapshotQuery(builder, st.(snapshot.Token).Value.Uint)
+	builder = utils.ValidityQuery(builder, st.(snapshot.Token).Value.Uint)
 	
 	if pagination.Token() != "" {
 		var t database.ContinuousToken
@@ -181,17 +260,25 @@ func (r *RelationshipReader) ReadRelationships(ctx context.Context, tenantID str
 	
 	var lastID uint64
 	
-	tuples := make([]*base.Tuple, 0, pagination.PageSize()+1)
+	tuples := make([]repositories.RelationTuple, 0, pagination.PageSize()+1)
 	for rows.Next() {
 		rt := repositories.RelationTuple{}
-		err = rows.Scan(&rt.ID, &rt.EntityType, &rt.EntityID, &rt.Relation, &rt.SubjectType, &rt.SubjectID, &rt.SubjectRelation)
+		var notBefore, notAfter sql.NullTime
+		var payload sql.NullString
+		err = rows.Scan(&rt.ID, &rt.EntityType, &rt.EntityID, &rt.Relation, &rt.SubjectType, &rt.SubjectID, &rt.SubjectRelation, &rt.CreatedAt, &rt.CreatedBy, &rt.Reference, &rt.SchemaVersion, &notBefore, &notAfter, &payload)
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, err.Error())
 			return nil, nil, err
 		}
+		rt.NotBefore, rt.NotAfter = notBefore.Time, notAfter.Time
+		if rt.Payload, err = utils.Payload(payload); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
 		lastID = rt.ID
-		tuples = append(tuples, rt.ToTuple())
+		tuples = append(tuples, rt)
 	}
 	if err = rows.Err(); err != nil {
 		span.RecordError(err)
@@ -206,11 +293,18 @@ func (r *RelationshipReader) ReadRelationships(ctx context.Context, tenantID str
 		return nil, nil, err
 	}
 	
+	ct = utils.NewNoopContinuousToken().Encode()
 	if len(tuples) > int(pagination.PageSize()) {
-		return database.NewTupleCollection(tuples[:pagination.PageSize()]...), utils.NewContinuousToken(strconv.FormatUint(lastID, 10)).Encode(), nil
+		tuples = tuples[:pagination.PageSize()]
+		ct = utils.NewContinuousToken(strconv.FormatUint(lastID, 10)).Encode()
+	}
+	
+	collection = database.NewTupleCollection()
+	for _, rt := range tuples {
+		collection.AddWithMetadata(rt.ToTuple(), rt.ToMetadata())
 	}
 	
-	return database.NewTupleCollection(tuples...), utils.NewNoopContinuousToken().Encode(), nil
+	return collection, ct, nil
 }
 
 // GetUniqueEntityIDsByEntityType - Gets all unique entity ids for a given entity type
@@ -238,6 +332,7 @@ func (r *RelationshipReader) GetUniqueEntityIDsByEntityType(ctx context.Context,
 	
 	builder := r.database.Builder.Select("entity_id").Distinct().From(RelationTuplesTable).Where(squirrel.Eq{"entity_type": typ, "tenant_id": tenantID})
 	builder = utils.SnapshotQuery(builder, st.(snapshot.Token).Value.Uint)
+	builder = utils.ValidityQuery(builder, st.(snapshot.Token).Value.Uint)
 	
 	var query string
 	query, args, err = builder.ToSql()
@@ -308,3 +403,217 @@ func (r *RelationshipReader) HeadSnapshot(ctx context.Context, tenantID string)
 	
 	return snapshot.Token{Value: xid}, nil
 }
+
+// SnapshotAt - Gets the token of the latest transaction committed at or before the given time
+func (r *RelationshipReader) SnapshotAt(ctx context.Context, tenantID string, at time.Time) (token.SnapToken, error) {
+	ctx, span := tracer.Start(ctx, "relationship-reader.snapshot-at")
+	defer span.End()
+	
+	var xid types.XID8
+	builder := r.database.Builder.Select("id").From(TransactionsTable).Where(squirrel.Eq{"tenant_id": tenantID}).Where(squirrel.LtOrEq{"timestamp": at.UTC()}).OrderBy("id DESC").Limit(1)
+	query, args, err := builder.ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+	}
+	
+	row := r.database.DB.QueryRowContext(ctx, query, args...)
+	err = row.Scan(&xid)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		if errors.Is(err, sql.ErrNoRows) {
+			return snapshot.Token{Value: types.XID8{Uint: 0}}, nil
+		}
+		return nil, err
+	}
+	
+	return snapshot.Token{Value: xid}, nil
+}
+
+// ReadDeletedRelationships - Reads relationships whose deleting transaction was committed within [from, to)
+func (r *RelationshipReader) ReadDeletedRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, from, to time.Time) (collection *database.TupleCollection, err error) {
+	ctx, span := tracer.Start(ctx, "relationship-reader.read-deleted-relationships")
+	defer span.End()
+	
+	var tx *sql.Tx
+	tx, err = r.database.DB.BeginTx(ctx, &r.txOptions)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	
+	defer utils.Rollback(tx, r.logger)
+	
+	builder := r.database.Builder.
+		Select("entity_type, entity_id, relation, subject_type, subject_id, subject_relation, created_at, created_by, reference, schema_version, transactions.timestamp").
+		From(RelationTuplesTable).
+		Join(TransactionsTable + " ON relation_tuples.expired_tx_id = transactions.id").
+		Where(squirrel.Eq{"relation_tuples.tenant_id": tenantID}).
+		Where(squirrel.GtOrEq{"transactions.timestamp": from.UTC()}).
+		Where(squirrel.Lt{"transactions.timestamp": to.UTC()})
+	builder = utils.FilterQueryForSelectBuilder(builder, filter)
+	builder = builder.OrderBy("transactions.timestamp")
+	
+	var query string
+	var args []interface{}
+	
+	query, args, err = builder.ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+	}
+	
+	var rows *sql.Rows
+	rows, err = tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	defer rows.Close()
+	
+	collection = database.NewTupleCollection()
+	for rows.Next() {
+		rt := repositories.RelationTuple{}
+		var deletedAt time.Time
+		err = rows.Scan(&rt.EntityType, &rt.EntityID, &rt.Relation, &rt.SubjectType, &rt.SubjectID, &rt.SubjectRelation, &rt.CreatedAt, &rt.CreatedBy, &rt.Reference, &rt.SchemaVersion, &deletedAt)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+		metadata := rt.ToMetadata()
+		metadata.DeletedAt = deletedAt
+		collection.AddWithMetadata(rt.ToTuple(), metadata)
+	}
+	if err = rows.Err(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	
+	err = tx.Commit()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	
+	return collection, nil
+}
+
+// ReadRelationshipChanges - Reads relationships created and deleted between the snapshots of two tokens, from is exclusive and to is inclusive.
+// Tuples both created and deleted in between are in neither collection.
+func (r *RelationshipReader) ReadRelationshipChanges(ctx context.Context, tenantID string, filter *base.TupleFilter, from, to string) (created, deleted *database.TupleCollection, err error) {
+	ctx, span := tracer.Start(ctx, "relationship-reader.read-relationship-changes")
+	defer span.End()
+	
+	var fst, tst token.SnapToken
+	fst, err = snapshot.EncodedToken{Value: from}.Decode()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+	tst, err = snapshot.EncodedToken{Value: to}.Decode()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+	if tst.Lt(fst) {
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+	}
+	
+	var tx *sql.Tx
+	tx, err = r.database.DB.BeginTx(ctx, &r.txOptions)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+	
+	defer utils.Rollback(tx, r.logger)
+	
+	fromRevision, toRevision := fst.(snapshot.Token).Value.Uint, tst.(snapshot.Token).Value.Uint
+	
+	builder := r.database.Builder.
+		Select("entity_type, entity_id, relation, subject_type, subject_id, subject_relation, created_at, created_by, reference, schema_version, not_before, not_after").
+		From(RelationTuplesTable).
+		Where(squirrel.Eq{"tenant_id": tenantID})
+	builder = utils.FilterQueryForSelectBuilder(builder, filter)
+	builder = utils.ChangesQuery(builder, fromRevision, toRevision).OrderBy("id")
+	
+	created, err = r.readChanges(ctx, tx, builder, false)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+	
+	builder = r.database.Builder.
+		Select("entity_type, entity_id, relation, subject_type, subject_id, subject_relation, created_at, created_by, reference, schema_version, not_before, not_after, transactions.timestamp").
+		From(RelationTuplesTable).
+		Join(TransactionsTable + " ON relation_tuples.expired_tx_id = transactions.id").
+		Where(squirrel.Eq{"relation_tuples.tenant_id": tenantID})
+	builder = utils.FilterQueryForSelectBuilder(builder, filter)
+	builder = utils.ChangesQuery(builder, toRevision, fromRevision).OrderBy("relation_tuples.id")
+	
+	deleted, err = r.readChanges(ctx, tx, builder, true)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+	
+	err = tx.Commit()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+	
+	return created, deleted, nil
+}
+
+// readChanges - Runs a query of ReadRelationshipChanges, deleted queries select the time of deletion as their last column
+func (r *RelationshipReader) readChanges(ctx context.Context, tx *sql.Tx, builder squirrel.SelectBuilder, deleted bool) (*database.TupleCollection, error) {
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+	}
+	
+	var rows *sql.Rows
+	rows, err = tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	defer rows.Close()
+	
+	collection := database.NewTupleCollection()
+	for rows.Next() {
+		rt := repositories.RelationTuple{}
+		var notBefore, notAfter sql.NullTime
+		dest := []interface{}{&rt.EntityType, &rt.EntityID, &rt.Relation, &rt.SubjectType, &rt.SubjectID, &rt.SubjectRelation, &rt.CreatedAt, &rt.CreatedBy, &rt.Reference, &rt.SchemaVersion, &notBefore, &notAfter}
+		var deletedAt time.Time
+		if deleted {
+			dest = append(dest, &deletedAt)
+		}
+		if err = rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		rt.NotBefore, rt.NotAfter = notBefore.Time, notAfter.Time
+		metadata := rt.ToMetadata()
+		metadata.DeletedAt = deletedAt
+		collection.AddWithMetadata(rt.ToTuple(), metadata)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	
+	return collection, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"regexp"
+	"time"
 	
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/Masterminds/squirrel"
@@ -47,18 +48,19 @@ var _ = Describe("RelationshipReader", func() {
 	})
 	
 	Context("QueryRelationships", func() {
-		columns := []string{"entity_type", "entity_id", "relation", "subject_type", "subject_id", "subject_relation"}
+		columns := []string{"entity_type", "entity_id", "relation", "subject_type", "subject_id", "subject_relation", "payload"}
 		
 		It("should be same queries", func() {
 			rows := sqlmock.NewRows(columns).
-				AddRow("organization", "abc", "admin", "user", "jack", "").
-				AddRow("organization", "abc", "admin", "user", "john", "")
+				AddRow("organization", "abc", "admin", "user", "jack", "", nil).
+				AddRow("organization", "abc", "admin", "user", "john", "", nil)
 			
 			mock.ExpectBegin()
-			mock.ExpectQuery(regexp.QuoteMeta(`SELECT entity_type, entity_id, relation, subject_type, subject_id, subject_relation
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT entity_type, entity_id, relation, subject_type, subject_id, subject_relation, payload
 			 FROM relation_tuples WHERE tenant_id = $1 AND entity_id IN ($2) AND entity_type = $3 AND relation = $4 AND (pg_visible_in_snapshot(created_tx_id, 
 				(select snapshot from transactions where id = '4'::xid8)) = true OR created_tx_id = '4'::xid8) AND ((pg_visible_in_snapshot(expired_tx_id, 
-					(select snapshot from transactions where id = '4'::xid8)) = false OR expired_tx_id = '0'::xid8) AND expired_tx_id <> '4'::xid8)`)).
+					(select snapshot from transactions where id = '4'::xid8)) = false OR expired_tx_id = '0'::xid8) AND expired_tx_id <> '4'::xid8) AND ((not_before IS NULL OR not_before <= (select timestamp from transactions where id = '4'::xid8)) AND 
+					(not_after IS NULL OR not_after > (select timestamp from transactions where id = '4'::xid8)))`)).
 				WithArgs("noop", "abc", "organization", "admin").
 				WillReturnRows(rows)
 			mock.ExpectCommit()
@@ -100,4 +102,75 @@ var _ = Describe("RelationshipReader", func() {
 			}...)))
 		})
 	})
+	
+	Context("ExplainQuery", func() {
+		It("should explain the query of the filter", func() {
+			rows := sqlmock.NewRows([]string{"QUERY PLAN"}).
+				AddRow("Seq Scan on relation_tuples  (cost=0.00..1.01 rows=1 width=160)").
+				AddRow("  Filter: ((tenant_id = 'noop'::text) AND (subject_type = 'user'::text))")
+			
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(`EXPLAIN SELECT entity_type, entity_id, relation, subject_type, subject_id, subject_relation, payload FROM relation_tuples WHERE tenant_id = $1 AND subject_type = $2`)).
+				WithArgs("noop", "user").
+				WillReturnRows(rows)
+			mock.ExpectCommit()
+			
+			plan, err := relationshipReader.ExplainQuery(context.Background(), "noop", &base.TupleFilter{
+				Subject: &base.SubjectFilter{
+					Type: "user",
+				},
+			}, snapshot.NewToken(types.XID8{Uint: 4, Status: pgtype.Present}).Encode().String())
+			
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(plan.Engine).Should(Equal("postgres"))
+			Expect(plan.Args).Should(Equal([]string{"noop", "user"}))
+			Expect(plan.Plan).Should(HaveLen(2))
+			Expect(plan.FullScan).Should(BeTrue())
+		})
+	})
+	
+	Context("ReadRelationshipChanges", func() {
+		columns := []string{"entity_type", "entity_id", "relation", "subject_type", "subject_id", "subject_relation", "created_at", "created_by", "reference", "schema_version", "not_before", "not_after"}
+		
+		visible := func(revision string) string {
+			return `((pg_visible_in_snapshot(created_tx_id, (select snapshot from transactions where id = '` + revision + `'::xid8)) = true OR created_tx_id = '` + revision + `'::xid8) AND 
+				(pg_visible_in_snapshot(expired_tx_id, (select snapshot from transactions where id = '` + revision + `'::xid8)) = false OR expired_tx_id = '0'::xid8) AND expired_tx_id <> '` + revision + `'::xid8)`
+		}
+		
+		It("should be same queries", func() {
+			createdAt := time.Date(2023, 1, 30, 10, 0, 0, 0, time.UTC)
+			deletedAt := time.Date(2023, 1, 31, 10, 0, 0, 0, time.UTC)
+			
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT entity_type, entity_id, relation, subject_type, subject_id, subject_relation, created_at, created_by, reference, schema_version, not_before, not_after
+				FROM relation_tuples WHERE tenant_id = $1 AND entity_type = $2 AND `+visible("5")+` AND NOT `+visible("4")+` ORDER BY id`)).
+				WithArgs("t1", "organization").
+				WillReturnRows(sqlmock.NewRows(columns).AddRow("organization", "abc", "admin", "user", "jack", "", createdAt, "", "", "v1", nil, nil))
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT entity_type, entity_id, relation, subject_type, subject_id, subject_relation, created_at, created_by, reference, schema_version, not_before, not_after, transactions.timestamp
+				FROM relation_tuples JOIN transactions ON relation_tuples.expired_tx_id = transactions.id WHERE relation_tuples.tenant_id = $1 AND entity_type = $2 AND `+visible("4")+` AND NOT `+visible("5")+` ORDER BY relation_tuples.id`)).
+				WithArgs("t1", "organization").
+				WillReturnRows(sqlmock.NewRows(append(columns, "timestamp")).AddRow("organization", "abc", "admin", "user", "john", "", createdAt, "", "", "v1", nil, nil, deletedAt))
+			mock.ExpectCommit()
+			
+			created, deleted, err := relationshipReader.ReadRelationshipChanges(context.Background(), "t1", &base.TupleFilter{
+				Entity: &base.EntityFilter{
+					Type: "organization",
+				},
+			}, snapshot.NewToken(types.XID8{Uint: 4, Status: pgtype.Present}).Encode().String(), snapshot.NewToken(types.XID8{Uint: 5, Status: pgtype.Present}).Encode().String())
+			
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(created.GetTuples()).Should(HaveLen(1))
+			Expect(tuple.SubjectToString(created.GetTuples()[0].GetSubject())).Should(Equal("user:jack"))
+			Expect(deleted.GetTuples()).Should(HaveLen(1))
+			Expect(tuple.SubjectToString(deleted.GetTuples()[0].GetSubject())).Should(Equal("user:john"))
+			Expect(deleted.GetMetadata()[0].DeletedAt).Should(Equal(deletedAt))
+			Expect(created.GetMetadata()[0].SchemaVersion).Should(Equal("v1"))
+		})
+
+		It("should reject tokens out of order", func() {
+			_, _, err := relationshipReader.ReadRelationshipChanges(context.Background(), "t1", &base.TupleFilter{},
+				snapshot.NewToken(types.XID8{Uint: 5, Status: pgtype.Present}).Encode().String(), snapshot.NewToken(types.XID8{Uint: 4, Status: pgtype.Present}).Encode().String())
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_VALIDATION.String()))
+		})
+	})
 })
@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+	
+	"github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel/codes"
+	
+	db "github.com/adminium/permify/pkg/database/postgres"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// RelationshipSweeper - Deletes the relation tuples whose validity ended before a time. The reads already ignore
+// them, the sweeper only keeps the table from growing with temporary tuples. The snapshots taken before their
+// validity ended no longer see them.
+type RelationshipSweeper struct {
+	database *db.Postgres
+	// logger
+	logger logger.Interface
+}
+
+// NewRelationshipSweeper - Creates a new RelationshipSweeper
+func NewRelationshipSweeper(database *db.Postgres, logger logger.Interface) *RelationshipSweeper {
+	return &RelationshipSweeper{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// SweepExpiredRelationships - Deletes at most limit relation tuples that are not valid after the time
+func (s *RelationshipSweeper) SweepExpiredRelationships(ctx context.Context, before time.Time, limit int) (deleted int64, err error) {
+	ctx, span := tracer.Start(ctx, "relationship-sweeper.sweep-expired-relationships")
+	defer span.End()
+	
+	sub, args, err := s.database.Builder.
+		Select("id").
+		From(RelationTuplesTable).
+		Where(squirrel.Lt{"not_after": before.UTC()}).
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return 0, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+	}
+	
+	result, err := s.database.DB.ExecContext(ctx, "DELETE FROM "+RelationTuplesTable+" WHERE id IN ("+sub+")", args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return 0, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	
+	return result.RowsAffected()
+}
@@ -0,0 +1,55 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"time"
+	
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Masterminds/squirrel"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	
+	"github.com/adminium/permify/pkg/database/postgres"
+	"github.com/adminium/permify/pkg/logger"
+)
+
+var _ = Describe("RelationshipSweeper", func() {
+	var relationshipSweeper *RelationshipSweeper
+	var mock sqlmock.Sqlmock
+	
+	BeforeEach(func() {
+		var db *sql.DB
+		var err error
+		
+		db, mock, err = sqlmock.New()
+		Expect(err).ShouldNot(HaveOccurred())
+		
+		pg := &postgres.Postgres{
+			DB:      db,
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		}
+		
+		relationshipSweeper = NewRelationshipSweeper(pg, logger.New("debug"))
+	})
+	
+	AfterEach(func() {
+		err := mock.ExpectationsWereMet()
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+	
+	Context("Sweep Expired Relationships", func() {
+		It("Deletes a batch of the tuples not valid after the time", func() {
+			before := time.Date(2023, 2, 11, 0, 0, 0, 0, time.UTC)
+			
+			mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM relation_tuples WHERE id IN (SELECT id FROM relation_tuples WHERE not_after < $1 LIMIT 100)`)).
+				WithArgs(before).
+				WillReturnResult(sqlmock.NewResult(0, 7))
+			
+			deleted, err := relationshipSweeper.SweepExpiredRelationships(context.Background(), before, 100)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(deleted).Should(Equal(int64(7)))
+		})
+	})
+})
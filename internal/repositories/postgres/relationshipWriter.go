@@ -17,15 +17,17 @@ import (
 	"github.com/adminium/permify/pkg/logger"
 	base "github.com/adminium/permify/pkg/pb/base/v1"
 	"github.com/adminium/permify/pkg/token"
+	"github.com/adminium/permify/pkg/tuple"
 )
 
 // RelationshipWriter - Structure for Relationship Writer
 type RelationshipWriter struct {
 	database *db.Postgres
 	// options
-	txOptions         sql.TxOptions
-	maxTuplesPerWrite int
-	maxRetries        int
+	txOptions              sql.TxOptions
+	maxTuplesPerWrite      int
+	maxTuplesPerBatchWrite int
+	maxRetries             int
 	// logger
 	logger logger.Interface
 }
@@ -33,11 +35,12 @@ type RelationshipWriter struct {
 // NewRelationshipWriter - Creates a new RelationTupleReader
 func NewRelationshipWriter(database *db.Postgres, logger logger.Interface) *RelationshipWriter {
 	return &RelationshipWriter{
-		database:          database,
-		txOptions:         sql.TxOptions{Isolation: sql.LevelSerializable, ReadOnly: false},
-		maxTuplesPerWrite: _defaultMaxTuplesPerWrite,
-		maxRetries:        _defaultMaxRetries,
-		logger:            logger,
+		database:               database,
+		txOptions:              sql.TxOptions{Isolation: sql.LevelSerializable, ReadOnly: false},
+		maxTuplesPerWrite:      _defaultMaxTuplesPerWrite,
+		maxTuplesPerBatchWrite: _defaultMaxTuplesPerBatchWrite,
+		maxRetries:             _defaultMaxRetries,
+		logger:                 logger,
 	}
 }
 
@@ -50,6 +53,29 @@ func (w *RelationshipWriter) WriteRelationships(ctx context.Context, tenantID st
 		return nil, errors.New("max tuples per write exceeded")
 	}
 	
+	return w.write(ctx, tenantID, collection)
+}
+
+// BatchWriteRelationships - Writes a large collection of relationships in one transaction, the rows are inserted
+// in several statements but share the transaction and so the snapshot token
+func (w *RelationshipWriter) BatchWriteRelationships(ctx context.Context, tenantID string, collection *database.TupleCollection) (token token.EncodedSnapToken, err error) {
+	ctx, span := tracer.Start(ctx, "relationship-writer.batch-write-relationships")
+	defer span.End()
+	
+	if len(collection.GetTuples()) > w.maxTuplesPerBatchWrite {
+		return nil, errors.New("max tuples per batch write exceeded")
+	}
+	
+	return w.write(ctx, tenantID, collection)
+}
+
+// write - Inserts the tuples and records the transaction, retrying when the transaction can not be serialized
+func (w *RelationshipWriter) write(ctx context.Context, tenantID string, collection *database.TupleCollection) (token token.EncodedSnapToken, err error) {
+	ctx, span := tracer.Start(ctx, "relationship-writer.write")
+	defer span.End()
+	
+	metadata := database.TupleMetadataFromContext(ctx)
+	
 	for i := 0; i <= w.maxRetries; i++ {
 		var tx *sql.Tx
 		tx, err = w.database.DB.BeginTx(ctx, &w.txOptions)
@@ -59,38 +85,56 @@ func (w *RelationshipWriter) WriteRelationships(ctx context.Context, tenantID st
 			return nil, err
 		}
 		
-		insertBuilder := w.database.Builder.Insert(RelationTuplesTable).Columns("entity_type, entity_id, relation, subject_type, subject_id, subject_relation, tenant_id")
-		
-		iter := collection.CreateTupleIterator()
-		for iter.HasNext() {
-			t := iter.GetNext()
-			insertBuilder = insertBuilder.Values(t.GetEntity().GetType(), t.GetEntity().GetId(), t.GetRelation(), t.GetSubject().GetType(), t.GetSubject().GetId(), t.GetSubject().GetRelation(), tenantID)
-		}
+		tuples := collection.GetTuples()
+		serializationFailure := false
+		for start := 0; start < len(tuples) && !serializationFailure; start += _defaultInsertBatchSize {
+			end := start + _defaultInsertBatchSize
+			if end > len(tuples) {
+				end = len(tuples)
+			}
+			
+			insertBuilder := w.database.Builder.Insert(RelationTuplesTable).Columns("entity_type, entity_id, relation, subject_type, subject_id, subject_relation, tenant_id, created_by, reference, schema_version, not_before, not_after, payload")
+			for _, t := range tuples[start:end] {
+				var payload sql.NullString
+				payload, err = utils.NullPayload(t.GetPayload())
+				if err != nil {
+					utils.Rollback(tx, w.logger)
+					span.RecordError(err)
+					span.SetStatus(otelCodes.Error, err.Error())
+					return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+				}
+				notBefore, notAfter := tuple.Validity(t)
+				insertBuilder = insertBuilder.Values(t.GetEntity().GetType(), t.GetEntity().GetId(), t.GetRelation(), t.GetSubject().GetType(), t.GetSubject().GetId(), t.GetSubject().GetRelation(), tenantID, metadata.CreatedBy, metadata.Reference, metadata.SchemaVersion, utils.NullTime(notBefore), utils.NullTime(notAfter), payload)
+			}
 		
-		var query string
-		var args []interface{}
+			var query string
+			var args []interface{}
 		
-		query, args, err = insertBuilder.ToSql()
-		if err != nil {
-			utils.Rollback(tx, w.logger)
-			span.RecordError(err)
-			span.SetStatus(otelCodes.Error, err.Error())
-			return nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
-		}
+			query, args, err = insertBuilder.ToSql()
+			if err != nil {
+				utils.Rollback(tx, w.logger)
+				span.RecordError(err)
+				span.SetStatus(otelCodes.Error, err.Error())
+				return nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+			}
 		
-		_, err = tx.ExecContext(ctx, query, args...)
-		if err != nil {
-			utils.Rollback(tx, w.logger)
-			span.RecordError(err)
-			span.SetStatus(otelCodes.Error, err.Error())
-			if strings.Contains(err.Error(), "could not serialize") {
-				continue
-			} else if strings.Contains(err.Error(), "duplicate key value") {
-				return nil, errors.New(base.ErrorCode_ERROR_CODE_UNIQUE_CONSTRAINT.String())
-			} else {
-				return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+			_, err = tx.ExecContext(ctx, query, args...)
+			if err != nil {
+				utils.Rollback(tx, w.logger)
+				span.RecordError(err)
+				span.SetStatus(otelCodes.Error, err.Error())
+				if strings.Contains(err.Error(), "could not serialize") {
+					serializationFailure = true
+				} else if strings.Contains(err.Error(), "duplicate key value") {
+					return nil, errors.New(base.ErrorCode_ERROR_CODE_UNIQUE_CONSTRAINT.String())
+				} else {
+					return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+				}
 			}
 		}
+		if serializationFailure {
+			continue
+		}
 		
 		transaction := w.database.Builder.Insert("transactions").
 			Columns("tenant_id").
@@ -139,7 +183,8 @@ func (w *RelationshipWriter) DeleteRelationships(ctx context.Context, tenantID s
 			return nil, err
 		}
 		
-		builder := w.database.Builder.Update(RelationTuplesTable).Set("expired_tx_id", squirrel.Expr("pg_current_xact_id()")).Where(squirrel.Eq{"expired_tx_id": "0"})
+		// only the live tuples of the tenant that match every field of the filter are deleted
+		builder := w.database.Builder.Update(RelationTuplesTable).Set("expired_tx_id", squirrel.Expr("pg_current_xact_id()")).Where(squirrel.Eq{"expired_tx_id": "0", "tenant_id": tenantID})
 		builder = utils.FilterQueryForUpdateBuilder(builder, filter)
 		
 		var query string
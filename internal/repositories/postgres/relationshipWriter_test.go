@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"regexp"
 	
 	"github.com/DATA-DOG/go-sqlmock"
@@ -43,15 +44,15 @@ var _ = Describe("RelationshipWriter", func() {
 	})
 	
 	Context("Writes Relationships", func() {
-		columns := []string{"entity_type", "entity_id", "relation", "subject_type", "subject_id", "subject_relation", "tenant_id"}
+		columns := []string{"entity_type", "entity_id", "relation", "subject_type", "subject_id", "subject_relation", "tenant_id", "created_by", "reference"}
 		
 		It("Insert and throws no error", func() {
 			mock.ExpectBegin()
-			mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO relation_tuples (entity_type, entity_id, relation, subject_type, subject_id, subject_relation, tenant_id)
-			VALUES ($1,$2,$3,$4,$5,$6,$7)`)).
-				WithArgs("organization", "abc", "admin", "subject-1", "sub-id", "admin", "noop").
+			mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO relation_tuples (entity_type, entity_id, relation, subject_type, subject_id, subject_relation, tenant_id, created_by, reference, schema_version, not_before, not_after, payload)
+			VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13)`)).
+				WithArgs("organization", "abc", "admin", "subject-1", "sub-id", "admin", "noop", "", "", "", nil, nil, nil).
 				WillReturnRows(
-					sqlmock.NewRows(columns).AddRow("organization", "abc", "admin", "subject-1", "sub-id", "admin", "noop"),
+					sqlmock.NewRows(columns).AddRow("organization", "abc", "admin", "subject-1", "sub-id", "admin", "noop", "", ""),
 				)
 			mock.ExpectCommit()
 			tp := &database.TupleCollection{}
@@ -67,11 +68,11 @@ var _ = Describe("RelationshipWriter", func() {
 		
 		It("Insert and compares", func() {
 			mock.ExpectBegin()
-			mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO relation_tuples (entity_type, entity_id, relation, subject_type, subject_id, subject_relation, tenant_id)
-			VALUES ($1,$2,$3,$4,$5,$6,$7)`)).
-				WithArgs("organization", "abc", "admin", "subject-1", "sub-id", "admin-sub", "noop").
+			mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO relation_tuples (entity_type, entity_id, relation, subject_type, subject_id, subject_relation, tenant_id, created_by, reference, schema_version, not_before, not_after, payload)
+			VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13)`)).
+				WithArgs("organization", "abc", "admin", "subject-1", "sub-id", "admin-sub", "noop", "", "", "", nil, nil, nil).
 				WillReturnRows(
-					sqlmock.NewRows(columns).AddRow("organization", "abc", "admin", "subject-1", "sub-id", "admin-sub", "noop"),
+					sqlmock.NewRows(columns).AddRow("organization", "abc", "admin", "subject-1", "sub-id", "admin-sub", "noop", "", ""),
 				)
 			mock.ExpectCommit()
 			tp := &database.TupleCollection{}
@@ -94,4 +95,61 @@ var _ = Describe("RelationshipWriter", func() {
 			// TODO: can we write a helper function to fetch the recently inserted record? as we are just creating a mock! any comments? Will think about it!
 		})
 	})
+	
+	Context("Deletes Relationships", func() {
+		It("Deletes the tuples of the tenant that match the filter", func() {
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(`UPDATE relation_tuples SET expired_tx_id = pg_current_xact_id() WHERE expired_tx_id = $1 AND tenant_id = $2 AND entity_id IN ($3,$4) AND entity_type = $5 AND relation = $6 AND subject_type = $7`)).
+				WithArgs("0", "t1", "1", "2", "organization", "admin", "user").
+				WillReturnResult(sqlmock.NewResult(0, 2))
+			mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO transactions (tenant_id) VALUES ($1) RETURNING id`)).
+				WithArgs("t1").
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uint64(5)))
+			mock.ExpectCommit()
+			
+			_, err := relationshipWriter.DeleteRelationships(context.Background(), "t1", &basev1.TupleFilter{
+				Entity:   &basev1.EntityFilter{Type: "organization", Ids: []string{"1", "2"}},
+				Relation: "admin",
+				Subject:  &basev1.SubjectFilter{Type: "user"},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+	})
+	
+	Context("Batch Writes Relationships", func() {
+		tuples := func(n int) *database.TupleCollection {
+			tp := database.NewTupleCollection()
+			for i := 0; i < n; i++ {
+				tp.Add(&basev1.Tuple{
+					Entity:   &basev1.Entity{Type: "organization", Id: fmt.Sprint(i)},
+					Relation: "admin",
+					Subject:  &basev1.Subject{Type: "user", Id: "1"},
+				})
+			}
+			return tp
+		}
+		
+		It("Inserts in several statements of one transaction", func() {
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO relation_tuples`)).WillReturnResult(sqlmock.NewResult(0, 1000))
+			mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO relation_tuples`)).WillReturnResult(sqlmock.NewResult(0, 500))
+			mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO transactions (tenant_id) VALUES ($1) RETURNING id`)).
+				WithArgs("noop").
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uint64(5)))
+			mock.ExpectCommit()
+			
+			_, err := relationshipWriter.BatchWriteRelationships(context.Background(), "noop", tuples(1500))
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("Rejects more tuples than a batch write takes", func() {
+			_, err := relationshipWriter.BatchWriteRelationships(context.Background(), "noop", tuples(_defaultMaxTuplesPerBatchWrite+1))
+			Expect(err).Should(HaveOccurred())
+		})
+		
+		It("Rejects more tuples than a write takes", func() {
+			_, err := relationshipWriter.WriteRelationships(context.Background(), "noop", tuples(_defaultMaxTuplesPerWrite+1))
+			Expect(err).Should(HaveOccurred())
+		})
+	})
 })
@@ -9,7 +9,9 @@ import (
 	"go.opentelemetry.io/otel/codes"
 	
 	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/internal/repositories/postgres/utils"
 	"github.com/adminium/permify/internal/schema"
+	"github.com/adminium/permify/pkg/database"
 	db "github.com/adminium/permify/pkg/database/postgres"
 	"github.com/adminium/permify/pkg/logger"
 	base "github.com/adminium/permify/pkg/pb/base/v1"
@@ -38,6 +40,27 @@ func (r *SchemaReader) ReadSchema(ctx context.Context, tenantID, version string)
 	ctx, span := tracer.Start(ctx, "schema-reader.read-schema")
 	defer span.End()
 	
+	var definitions []string
+	definitions, err = r.ReadSchemaString(ctx, tenantID, version)
+	if err != nil {
+		return nil, err
+	}
+	
+	sch, err = schema.NewSchemaFromStringDefinitions(true, definitions...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	
+	return sch, err
+}
+
+// ReadSchemaString - Reads the serialized definitions of the schema version from the repository.
+func (r *SchemaReader) ReadSchemaString(ctx context.Context, tenantID, version string) (definitions []string, err error) {
+	ctx, span := tracer.Start(ctx, "schema-reader.read-schema-string")
+	defer span.End()
+	
 	builder := r.database.Builder.Select("entity_type, serialized_definition, version").From(SchemaDefinitionTable).Where(squirrel.Eq{"version": version, "tenant_id": tenantID})
 	
 	var query string
@@ -59,7 +82,6 @@ func (r *SchemaReader) ReadSchema(ctx context.Context, tenantID, version string)
 	}
 	defer rows.Close()
 	
-	var definitions []string
 	for rows.Next() {
 		sd := repositories.SchemaDefinition{}
 		err = rows.Scan(&sd.EntityType, &sd.SerializedDefinition, &sd.Version)
@@ -76,14 +98,7 @@ func (r *SchemaReader) ReadSchema(ctx context.Context, tenantID, version string)
 		return nil, err
 	}
 	
-	sch, err = schema.NewSchemaFromStringDefinitions(true, definitions...)
-	if err != nil {
-		span.RecordError(err)
-		span.SetStatus(codes.Error, err.Error())
-		return nil, err
-	}
-	
-	return sch, err
+	return definitions, nil
 }
 
 // ReadSchemaDefinition - Reads entity config from the repository.
@@ -160,3 +175,65 @@ func (r *SchemaReader) HeadVersion(ctx context.Context, tenantID string) (versio
 	
 	return version, nil
 }
+
+// ListSchemaVersions - Lists the versions of the schema, the latest first.
+func (r *SchemaReader) ListSchemaVersions(ctx context.Context, tenantID string, pagination database.Pagination) (versions []string, ct database.EncodedContinuousToken, err error) {
+	ctx, span := tracer.Start(ctx, "schema-reader.list-schema-versions")
+	defer span.End()
+	
+	builder := r.database.Builder.Select("DISTINCT version").From(SchemaDefinitionTable).Where(squirrel.Eq{"tenant_id": tenantID})
+	if pagination.Token() != "" {
+		var t database.ContinuousToken
+		t, err = utils.EncodedContinuousToken{Value: pagination.Token()}.Decode()
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, nil, err
+		}
+		builder = builder.Where(squirrel.LtOrEq{"version": t.(utils.ContinuousToken).Value})
+	}
+	
+	builder = builder.OrderBy("version DESC").Limit(uint64(pagination.PageSize() + 1))
+	
+	var query string
+	var args []interface{}
+	
+	query, args, err = builder.ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+	}
+	
+	var rows *sql.Rows
+	rows, err = r.database.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	defer rows.Close()
+	
+	versions = make([]string, 0, pagination.PageSize()+1)
+	for rows.Next() {
+		var version string
+		err = rows.Scan(&version)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, nil, err
+		}
+		versions = append(versions, version)
+	}
+	if err = rows.Err(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, err
+	}
+	
+	if len(versions) > int(pagination.PageSize()) {
+		return versions[:pagination.PageSize()], utils.NewContinuousToken(versions[pagination.PageSize()]).Encode(), nil
+	}
+	
+	return versions, utils.NewNoopContinuousToken().Encode(), nil
+}
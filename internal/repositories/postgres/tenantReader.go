@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	
 	"github.com/Masterminds/squirrel"
@@ -96,3 +97,31 @@ func (r *TenantReader) ListTenants(ctx context.Context, pagination database.Pagi
 	
 	return tenants, utils.NewNoopContinuousToken().Encode(), nil
 }
+
+// GetTenant - Reads a Tenant with its labels
+func (r *TenantReader) GetTenant(ctx context.Context, tenantID string) (tenant *base.Tenant, labels map[string]string, err error) {
+	ctx, span := tracer.Start(ctx, "tenant-reader.get-tenant")
+	defer span.End()
+	
+	var raw []byte
+	sd := repositories.Tenant{}
+	
+	query := r.database.Builder.Select("id, name, created_at, labels").From(TenantsTable).Where(squirrel.Eq{"id": tenantID}).RunWith(r.database.DB)
+	err = query.QueryRowContext(ctx).Scan(&sd.ID, &sd.Name, &sd.CreatedAt, &raw)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_TENANT_NOT_FOUND.String())
+		}
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	
+	if err = json.Unmarshal(raw, &sd.Labels); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+	}
+	
+	return sd.ToTenant(), sd.Labels, nil
+}
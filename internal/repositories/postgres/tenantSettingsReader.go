@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+	
+	"github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel/codes"
+	
+	"github.com/adminium/permify/internal/repositories"
+	db "github.com/adminium/permify/pkg/database/postgres"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/storage"
+)
+
+// TenantSettingsReader - Structure for Tenant Settings Reader
+type TenantSettingsReader struct {
+	database *db.Postgres
+	// logger
+	logger logger.Interface
+}
+
+// NewTenantSettingsReader - Creates a new TenantSettingsReader
+func NewTenantSettingsReader(database *db.Postgres, logger logger.Interface) *TenantSettingsReader {
+	return &TenantSettingsReader{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// ReadTenantSettings - Reads the settings of the tenant, the defaults when it has none
+func (r *TenantSettingsReader) ReadTenantSettings(ctx context.Context, tenantID string) (settings repositories.TenantSettings, err error) {
+	ctx, span := tracer.Start(ctx, "tenant-settings-reader.read-tenant-settings")
+	defer span.End()
+	
+	query := r.database.Builder.Select("strict_validation, wildcards, cache_ttl, depth, max_depth").From(TenantSettingsTable).Where(squirrel.Eq{
+		"tenant_id": tenantID,
+	}).RunWith(r.database.DB)
+	
+	// the ttl is stored in milliseconds
+	var ttl int64
+	err = query.QueryRowContext(ctx).Scan(&settings.StrictValidation, &settings.Wildcards, &ttl, &settings.Depth, &settings.MaxDepth)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return storage.DefaultTenantSettings(), nil
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return settings, errors.New(base.ErrorCode_ERROR_CODE_SCAN.String())
+	}
+	settings.CacheTTL = time.Duration(ttl) * time.Millisecond
+	
+	return settings, nil
+}
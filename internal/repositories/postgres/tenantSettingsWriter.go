@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	
+	"go.opentelemetry.io/otel/codes"
+	
+	"github.com/adminium/permify/internal/repositories"
+	db "github.com/adminium/permify/pkg/database/postgres"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// TenantSettingsWriter - Structure for Tenant Settings Writer
+type TenantSettingsWriter struct {
+	database *db.Postgres
+	// logger
+	logger logger.Interface
+}
+
+// NewTenantSettingsWriter - Creates a new TenantSettingsWriter
+func NewTenantSettingsWriter(database *db.Postgres, logger logger.Interface) *TenantSettingsWriter {
+	return &TenantSettingsWriter{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// WriteTenantSettings - Writes the settings of the tenant, existing settings are replaced
+func (w *TenantSettingsWriter) WriteTenantSettings(ctx context.Context, tenantID string, settings repositories.TenantSettings) (err error) {
+	ctx, span := tracer.Start(ctx, "tenant-settings-writer.write-tenant-settings")
+	defer span.End()
+	
+	_, err = w.database.Builder.Insert(TenantSettingsTable).
+		Columns("tenant_id, strict_validation, wildcards, cache_ttl, depth, max_depth").
+		Values(tenantID, settings.StrictValidation, settings.Wildcards, settings.CacheTTL.Milliseconds(), settings.Depth, settings.MaxDepth).
+		Suffix("ON CONFLICT (tenant_id) DO UPDATE SET strict_validation = EXCLUDED.strict_validation, wildcards = EXCLUDED.wildcards, cache_ttl = EXCLUDED.cache_ttl, depth = EXCLUDED.depth, max_depth = EXCLUDED.max_depth, updated_at = now() AT TIME ZONE 'UTC'").
+		RunWith(w.database.DB).
+		ExecContext(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	
+	return nil
+}
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 	otelCodes "go.opentelemetry.io/otel/codes"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	
+	"github.com/adminium/permify/internal/repositories/postgres/utils"
 	db "github.com/adminium/permify/pkg/database/postgres"
 	"github.com/adminium/permify/pkg/logger"
 	base "github.com/adminium/permify/pkg/pb/base/v1"
@@ -60,19 +62,92 @@ func (w *TenantWriter) CreateTenant(ctx context.Context, id, name string) (resul
 	}, nil
 }
 
-// DeleteTenant - Deletes a Tenant
+// DeleteTenant - Deletes the tenant along with its tuples, schemas, transactions, identities, attributes and
+// settings
 func (w *TenantWriter) DeleteTenant(ctx context.Context, tenantID string) (result *base.Tenant, err error) {
 	ctx, span := tracer.Start(ctx, "tenant-writer.delete-tenant")
 	defer span.End()
 	
+	var tx *sql.Tx
+	tx, err = w.database.DB.BeginTx(ctx, &w.txOptions)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	
 	var name string
 	var createdAt time.Time
 	
-	query := w.database.Builder.Delete(TenantsTable).Where(squirrel.Eq{"id": tenantID}).Suffix("RETURNING name, created_at").RunWith(w.database.DB)
+	query := w.database.Builder.Delete(TenantsTable).Where(squirrel.Eq{"id": tenantID}).Suffix("RETURNING name, created_at").RunWith(tx)
 	err = query.QueryRowContext(ctx).Scan(&name, &createdAt)
 	if err != nil {
+		utils.Rollback(tx, w.logger)
 		span.RecordError(err)
 		span.SetStatus(otelCodes.Error, err.Error())
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_TENANT_NOT_FOUND.String())
+		}
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	
+	for _, table := range []string{RelationTuplesTable, SchemaDefinitionTable, TransactionsTable, IdentitiesTable, AttributesTable, TenantSettingsTable, CheckTracesTable} {
+		_, err = w.database.Builder.Delete(table).Where(squirrel.Eq{"tenant_id": tenantID}).RunWith(tx).ExecContext(ctx)
+		if err != nil {
+			utils.Rollback(tx, w.logger)
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+		}
+	}
+	
+	if err = tx.Commit(); err != nil {
+		utils.Rollback(tx, w.logger)
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	
+	return &base.Tenant{
+		Id:        tenantID,
+		Name:      name,
+		CreatedAt: timestamppb.New(createdAt),
+	}, nil
+}
+
+// UpdateTenant - Replaces the name and the labels of a Tenant, an empty name keeps the name and nil labels keep the
+// labels
+func (w *TenantWriter) UpdateTenant(ctx context.Context, tenantID, name string, labels map[string]string) (result *base.Tenant, err error) {
+	ctx, span := tracer.Start(ctx, "tenant-writer.update-tenant")
+	defer span.End()
+	
+	var encoded interface{}
+	if labels != nil {
+		var b []byte
+		b, err = json.Marshal(labels)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		encoded = string(b)
+	}
+	
+	var createdAt time.Time
+	
+	query := w.database.Builder.Update(TenantsTable).
+		Set("name", squirrel.Expr("COALESCE(NULLIF(?, ''), name)", name)).
+		Set("labels", squirrel.Expr("COALESCE(?::jsonb, labels)", encoded)).
+		Where(squirrel.Eq{"id": tenantID}).
+		Suffix("RETURNING name, created_at").
+		RunWith(w.database.DB)
+	err = query.QueryRowContext(ctx).Scan(&name, &createdAt)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_TENANT_NOT_FOUND.String())
+		}
 		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
 	}
 	
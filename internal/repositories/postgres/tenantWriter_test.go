@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"time"
+	
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Masterminds/squirrel"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	
+	"github.com/adminium/permify/pkg/database/postgres"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+var _ = Describe("TenantWriter", func() {
+	var tenantWriter *TenantWriter
+	var tenantReader *TenantReader
+	var mock sqlmock.Sqlmock
+	
+	BeforeEach(func() {
+		var db *sql.DB
+		var err error
+		
+		db, mock, err = sqlmock.New()
+		Expect(err).ShouldNot(HaveOccurred())
+		
+		pg := &postgres.Postgres{
+			DB:      db,
+			Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		}
+		
+		tenantWriter = NewTenantWriter(pg, logger.New("debug"))
+		tenantReader = NewTenantReader(pg, logger.New("debug"))
+	})
+	
+	AfterEach(func() {
+		err := mock.ExpectationsWereMet()
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+	
+	Context("Delete Tenant", func() {
+		It("Deletes the data of the tenant along with it", func() {
+			createdAt := time.Date(2023, 2, 12, 0, 0, 0, 0, time.UTC)
+			
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(`DELETE FROM tenants WHERE id = $1 RETURNING name, created_at`)).
+				WithArgs("t1").
+				WillReturnRows(sqlmock.NewRows([]string{"name", "created_at"}).AddRow("first", createdAt))
+			for _, table := range []string{"relation_tuples", "schema_definitions", "transactions", "identities", "attributes", "tenant_settings", "check_traces"} {
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM ` + table + ` WHERE tenant_id = $1`)).
+					WithArgs("t1").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+			mock.ExpectCommit()
+			
+			tenant, err := tenantWriter.DeleteTenant(context.Background(), "t1")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(tenant.GetName()).Should(Equal("first"))
+		})
+		
+		It("Rolls back when the tenant does not exist", func() {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(`DELETE FROM tenants WHERE id = $1 RETURNING name, created_at`)).
+				WithArgs("t2").
+				WillReturnRows(sqlmock.NewRows([]string{"name", "created_at"}))
+			mock.ExpectRollback()
+			
+			_, err := tenantWriter.DeleteTenant(context.Background(), "t2")
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_TENANT_NOT_FOUND.String()))
+		})
+	})
+	
+	Context("Update Tenant", func() {
+		It("Keeps the name when it is empty and replaces the labels", func() {
+			createdAt := time.Date(2023, 2, 12, 0, 0, 0, 0, time.UTC)
+			
+			mock.ExpectQuery(regexp.QuoteMeta(`UPDATE tenants SET name = COALESCE(NULLIF($1, ''), name), labels = COALESCE($2::jsonb, labels) WHERE id = $3 RETURNING name, created_at`)).
+				WithArgs("", `{"plan":"pro"}`, "t1").
+				WillReturnRows(sqlmock.NewRows([]string{"name", "created_at"}).AddRow("first", createdAt))
+			
+			tenant, err := tenantWriter.UpdateTenant(context.Background(), "t1", "", map[string]string{"plan": "pro"})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(tenant.GetName()).Should(Equal("first"))
+		})
+	})
+	
+	Context("Get Tenant", func() {
+		It("Reads the tenant with its labels", func() {
+			createdAt := time.Date(2023, 2, 12, 0, 0, 0, 0, time.UTC)
+			
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, created_at, labels FROM tenants WHERE id = $1`)).
+				WithArgs("t1").
+				WillReturnRows(sqlmock.NewRows([]string{"id", "name", "created_at", "labels"}).AddRow("t1", "first", createdAt, []byte(`{"plan":"pro"}`)))
+			
+			tenant, labels, err := tenantReader.GetTenant(context.Background(), "t1")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(tenant.GetName()).Should(Equal("first"))
+			Expect(labels).Should(Equal(map[string]string{"plan": "pro"}))
+		})
+	})
+})
@@ -3,8 +3,11 @@ package utils
 import (
 	"database/sql"
 	"fmt"
+	"time"
 	
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
 	
 	"github.com/Masterminds/squirrel"
 	
@@ -25,6 +28,70 @@ func SnapshotQuery(sl squirrel.SelectBuilder, revision uint64) squirrel.SelectBu
 	})
 }
 
+// ChangesQuery - Selects tuples that are visible in the snapshot of the to revision but not in the snapshot
+// of the from revision. Swapping the revisions selects the tuples deleted between them.
+// Revision 0 is the snapshot of a tenant without transactions, nothing is visible in it.
+func ChangesQuery(sl squirrel.SelectBuilder, from, to uint64) squirrel.SelectBuilder {
+	if from == 0 {
+		return sl.Where(squirrel.Expr(visible(to)))
+	}
+	return sl.Where(squirrel.Expr(fmt.Sprintf("%s AND NOT %s", visible(to), visible(from))))
+}
+
+// visible - Condition of SnapshotQuery as a single expression
+func visible(revision uint64) string {
+	snapshot := fmt.Sprintf("(select snapshot from transactions where id = '%v'::xid8)", revision)
+	return fmt.Sprintf("((pg_visible_in_snapshot(created_tx_id, %[1]s) = true OR created_tx_id = '%[2]v'::xid8) AND (pg_visible_in_snapshot(expired_tx_id, %[1]s) = false OR expired_tx_id = '0'::xid8) AND expired_tx_id <> '%[2]v'::xid8)", snapshot, revision)
+}
+
+// ValidityQuery - Skips tuples whose validity window does not contain the wall clock of the snapshot,
+// which is the time of the transaction the revision points to
+func ValidityQuery(sl squirrel.SelectBuilder, revision uint64) squirrel.SelectBuilder {
+	at := fmt.Sprintf("(select timestamp from transactions where id = '%v'::xid8)", revision)
+	return sl.Where(squirrel.And{
+		squirrel.Or{
+			squirrel.Expr("not_before IS NULL"),
+			squirrel.Expr(fmt.Sprintf("not_before <= %s", at)),
+		},
+		squirrel.Or{
+			squirrel.Expr("not_after IS NULL"),
+			squirrel.Expr(fmt.Sprintf("not_after > %s", at)),
+		},
+	})
+}
+
+// NullTime - Zero times are stored as NULL
+func NullTime(t time.Time) sql.NullTime {
+	if t.IsZero() {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: t.UTC(), Valid: true}
+}
+
+// NullPayload - JSON of the payload of a tuple, null for a tuple without one
+func NullPayload(payload *structpb.Struct) (sql.NullString, error) {
+	if payload == nil {
+		return sql.NullString{}, nil
+	}
+	b, err := protojson.Marshal(payload)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+	return sql.NullString{String: string(b), Valid: true}, nil
+}
+
+// Payload - Payload of a tuple from its JSON, nil for null
+func Payload(raw sql.NullString) (*structpb.Struct, error) {
+	if !raw.Valid {
+		return nil, nil
+	}
+	payload := &structpb.Struct{}
+	if err := protojson.Unmarshal([]byte(raw.String), payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
 // Rollback - Rollbacks a transaction and logs the error
 func Rollback(tx *sql.Tx, logger logger.Interface) {
 	if err := tx.Rollback(); !errors.Is(err, sql.ErrTxDone) && err != nil {
@@ -0,0 +1,212 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+	
+	"github.com/Masterminds/squirrel"
+	"go.opentelemetry.io/otel/codes"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/internal/repositories/postgres/snapshot"
+	"github.com/adminium/permify/internal/repositories/postgres/types"
+	"github.com/adminium/permify/internal/repositories/postgres/utils"
+	db "github.com/adminium/permify/pkg/database/postgres"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+const (
+	_defaultWatchInterval   = time.Second
+	_defaultWatchBufferSize = 100
+	_defaultWatchBatchSize  = 1000
+)
+
+// Watcher - Changefeed of the relation tuples, the transactions table is polled for the transactions committed
+// after the last one streamed
+type Watcher struct {
+	database *db.Postgres
+	reader   *RelationshipReader
+	// options
+	txOptions sql.TxOptions
+	interval  time.Duration
+	// logger
+	logger logger.Interface
+}
+
+// NewWatcher - Creates a new Watcher
+func NewWatcher(database *db.Postgres, logger logger.Interface) *Watcher {
+	return &Watcher{
+		database:  database,
+		reader:    NewRelationshipReader(database, logger),
+		txOptions: sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true},
+		interval:  _defaultWatchInterval,
+		logger:    logger,
+	}
+}
+
+// Watch - Every transaction of the tenant with tuple changes is streamed with its own token. Transaction ids are
+// assigned before commit, so only the transactions older than every running one are read; a transaction committed
+// late is never skipped, it holds back the newer ones until it is done.
+func (w *Watcher) Watch(ctx context.Context, tenantID string, snap string) (<-chan *repositories.RelationshipChanges, <-chan error) {
+	changes := make(chan *repositories.RelationshipChanges, _defaultWatchBufferSize)
+	errs := make(chan error, 1)
+	
+	go func() {
+		defer close(changes)
+		defer close(errs)
+		
+		st, err := snapshot.EncodedToken{Value: snap}.Decode()
+		if err != nil {
+			errs <- err
+			return
+		}
+		cursor := st.(snapshot.Token).Value.Uint
+		
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+		
+		for {
+			var xids []uint64
+			xids, err = w.transactions(ctx, tenantID, cursor)
+			if err != nil {
+				errs <- err
+				return
+			}
+			for _, xid := range xids {
+				var c *repositories.RelationshipChanges
+				c, err = w.changes(ctx, tenantID, xid)
+				if err != nil {
+					errs <- err
+					return
+				}
+				cursor = xid
+				if len(c.Created.GetTuples()) == 0 && len(c.Deleted.GetTuples()) == 0 {
+					continue
+				}
+				select {
+				case changes <- c:
+				case <-ctx.Done():
+					return
+				}
+			}
+			// a full batch is followed by the next one at once
+			if len(xids) == _defaultWatchBatchSize {
+				continue
+			}
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	
+	return changes, errs
+}
+
+// transactions - Ids of the completed transactions of the tenant after the cursor, in order
+func (w *Watcher) transactions(ctx context.Context, tenantID string, cursor uint64) (xids []uint64, err error) {
+	ctx, span := tracer.Start(ctx, "watcher.transactions")
+	defer span.End()
+	
+	builder := w.database.Builder.Select("id").From(TransactionsTable).
+		Where(squirrel.Eq{"tenant_id": tenantID}).
+		Where(squirrel.Expr(fmt.Sprintf("id > '%v'::xid8", cursor))).
+		Where(squirrel.Expr("id < pg_snapshot_xmin(pg_current_snapshot())")).
+		OrderBy("id").
+		Limit(_defaultWatchBatchSize)
+	
+	var query string
+	var args []interface{}
+	query, args, err = builder.ToSql()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_SQL_BUILDER.String())
+	}
+	
+	var rows *sql.Rows
+	rows, err = w.database.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	defer rows.Close()
+	
+	for rows.Next() {
+		var xid types.XID8
+		if err = rows.Scan(&xid); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+		xids = append(xids, xid.Uint)
+	}
+	if err = rows.Err(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	return xids, nil
+}
+
+// changes - Relation tuples of the tenant created and deleted by the transaction
+func (w *Watcher) changes(ctx context.Context, tenantID string, xid uint64) (c *repositories.RelationshipChanges, err error) {
+	ctx, span := tracer.Start(ctx, "watcher.changes")
+	defer span.End()
+	
+	var tx *sql.Tx
+	tx, err = w.database.DB.BeginTx(ctx, &w.txOptions)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	
+	defer utils.Rollback(tx, w.logger)
+	
+	c = &repositories.RelationshipChanges{SnapToken: snapshot.NewToken(types.XID8{Uint: xid}).Encode().String()}
+	
+	builder := w.database.Builder.
+		Select("entity_type, entity_id, relation, subject_type, subject_id, subject_relation, created_at, created_by, reference, schema_version, not_before, not_after").
+		From(RelationTuplesTable).
+		Where(squirrel.Eq{"tenant_id": tenantID}).
+		Where(squirrel.Expr(fmt.Sprintf("created_tx_id = '%v'::xid8", xid))).
+		OrderBy("id")
+	
+	c.Created, err = w.reader.readChanges(ctx, tx, builder, false)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	
+	builder = w.database.Builder.
+		Select("entity_type, entity_id, relation, subject_type, subject_id, subject_relation, created_at, created_by, reference, schema_version, not_before, not_after, transactions.timestamp").
+		From(RelationTuplesTable).
+		Join(TransactionsTable + " ON relation_tuples.expired_tx_id = transactions.id").
+		Where(squirrel.Eq{"relation_tuples.tenant_id": tenantID}).
+		Where(squirrel.Expr(fmt.Sprintf("relation_tuples.expired_tx_id = '%v'::xid8", xid))).
+		OrderBy("relation_tuples.id")
+	
+	c.Deleted, err = w.reader.readChanges(ctx, tx, builder, true)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	
+	err = tx.Commit()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	
+	return c, nil
+}
@@ -0,0 +1,40 @@
+package raft
+
+import (
+	"context"
+	
+	"github.com/adminium/permify/internal/repositories"
+	db "github.com/adminium/permify/pkg/database/raft"
+	"github.com/adminium/permify/pkg/logger"
+)
+
+// APIKeyWriter - Structure for API Key Writer, the writes are replicated to every node of the cluster
+type APIKeyWriter struct {
+	database *db.Raft
+	// logger
+	logger logger.Interface
+}
+
+// NewAPIKeyWriter - Creates a new APIKeyWriter
+func NewAPIKeyWriter(database *db.Raft, logger logger.Interface) *APIKeyWriter {
+	return &APIKeyWriter{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// WriteAPIKey - Writes the key through the leader
+func (w *APIKeyWriter) WriteAPIKey(ctx context.Context, key repositories.APIKey) error {
+	ctx, span := tracer.Start(ctx, "api-key-writer.write-api-key")
+	defer span.End()
+	
+	return apply(ctx, w.database, opWriteAPIKey, writeAPIKey{Key: key})
+}
+
+// DeleteAPIKey - Deletes the key through the leader
+func (w *APIKeyWriter) DeleteAPIKey(ctx context.Context, id string) error {
+	ctx, span := tracer.Start(ctx, "api-key-writer.delete-api-key")
+	defer span.End()
+	
+	return apply(ctx, w.database, opDeleteAPIKey, deleteAPIKey{ID: id})
+}
@@ -0,0 +1,65 @@
+package raft
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	
+	db "github.com/adminium/permify/pkg/database/raft"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// AttributeWriter - Structure for Attribute Writer, the writes are replicated to every node of the cluster
+type AttributeWriter struct {
+	database *db.Raft
+	// logger
+	logger logger.Interface
+}
+
+// NewAttributeWriter - Creates a new AttributeWriter
+func NewAttributeWriter(database *db.Raft, logger logger.Interface) *AttributeWriter {
+	return &AttributeWriter{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// WriteAttributes - Writes the attributes of the entity through the leader
+func (w *AttributeWriter) WriteAttributes(ctx context.Context, tenantID string, entity *base.Entity, attributes map[string]*structpb.Value) error {
+	ctx, span := tracer.Start(ctx, "attribute-writer.write-attributes")
+	defer span.End()
+	
+	e, err := protojson.Marshal(entity)
+	if err != nil {
+		return errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+	}
+	
+	args := writeAttributes{TenantID: tenantID, Entity: e, Attributes: make(map[string]json.RawMessage, len(attributes))}
+	for name, value := range attributes {
+		var raw []byte
+		raw, err = protojson.Marshal(value)
+		if err != nil {
+			return errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		args.Attributes[name] = raw
+	}
+	
+	return apply(ctx, w.database, opWriteAttributes, args)
+}
+
+// DeleteAttributes - Deletes the attributes of the entity through the leader, all of them when no names are given
+func (w *AttributeWriter) DeleteAttributes(ctx context.Context, tenantID string, entity *base.Entity, names ...string) error {
+	ctx, span := tracer.Start(ctx, "attribute-writer.delete-attributes")
+	defer span.End()
+	
+	e, err := protojson.Marshal(entity)
+	if err != nil {
+		return errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+	}
+	
+	return apply(ctx, w.database, opDeleteAttributes, deleteAttributes{TenantID: tenantID, Entity: e, Names: names})
+}
@@ -0,0 +1,90 @@
+package raft
+
+import (
+	"encoding/json"
+	"errors"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/pkg/database"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// Operations of the commands
+const (
+	opWriteRelationships  = "write_relationships"
+	opDeleteRelationships = "delete_relationships"
+	opWriteSchema         = "write_schema"
+	opCreateTenant        = "create_tenant"
+	opDeleteTenant        = "delete_tenant"
+	opUpdateTenant        = "update_tenant"
+	opWriteIdentity       = "write_identity"
+	opDeleteIdentity      = "delete_identity"
+	opWriteAttributes     = "write_attributes"
+	opDeleteAttributes    = "delete_attributes"
+	opWriteTenantSettings = "write_tenant_settings"
+	opWriteAPIKey         = "write_api_key"
+	opDeleteAPIKey        = "delete_api_key"
+)
+
+// command - A write that is replicated through the log, the arguments depend on the operation. Protobuf messages
+// are kept in their json form.
+type command struct {
+	Op   string          `json:"op"`
+	Args json.RawMessage `json:"args"`
+}
+
+type (
+	writeRelationships struct {
+		TenantID string                 `json:"tenant_id"`
+		Tuples   []json.RawMessage      `json:"tuples"`
+		Metadata database.TupleMetadata `json:"metadata"`
+	}
+	deleteRelationships struct {
+		TenantID string          `json:"tenant_id"`
+		Filter   json.RawMessage `json:"filter"`
+	}
+	writeSchema struct {
+		Definitions []repositories.SchemaDefinition `json:"definitions"`
+	}
+	tenant struct {
+		ID   string `json:"id"`
+		Name string `json:"name,omitempty"`
+		// Labels - null keeps the labels of an updated tenant, an empty object removes them
+		Labels map[string]string `json:"labels"`
+	}
+	identity struct {
+		TenantID    string `json:"tenant_id"`
+		SubjectType string `json:"subject_type"`
+		Alias       string `json:"alias"`
+		SubjectID   string `json:"subject_id,omitempty"`
+	}
+	writeAttributes struct {
+		TenantID   string                     `json:"tenant_id"`
+		Entity     json.RawMessage            `json:"entity"`
+		Attributes map[string]json.RawMessage `json:"attributes"`
+	}
+	deleteAttributes struct {
+		TenantID string          `json:"tenant_id"`
+		Entity   json.RawMessage `json:"entity"`
+		Names    []string        `json:"names"`
+	}
+	writeTenantSettings struct {
+		TenantID string                      `json:"tenant_id"`
+		Settings repositories.TenantSettings `json:"settings"`
+	}
+	writeAPIKey struct {
+		Key repositories.APIKey `json:"key"`
+	}
+	deleteAPIKey struct {
+		ID string `json:"id"`
+	}
+)
+
+// encode - Encodes the command of the operation
+func encode(op string, args interface{}) ([]byte, error) {
+	raw, err := json.Marshal(args)
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+	}
+	return json.Marshal(command{Op: op, Args: raw})
+}
@@ -0,0 +1,27 @@
+package raft
+
+import (
+	"context"
+	
+	otelCodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	
+	db "github.com/adminium/permify/pkg/database/raft"
+)
+
+// apply - Applies a command whose result is only its error
+func apply(ctx context.Context, database *db.Raft, op string, args interface{}) error {
+	span := trace.SpanFromContext(ctx)
+	
+	c, err := encode(op, args)
+	if err != nil {
+		return err
+	}
+	
+	if _, err = database.Apply(ctx, c); err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return err
+	}
+	return nil
+}
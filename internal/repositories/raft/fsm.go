@@ -0,0 +1,283 @@
+package raft
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	
+	hraft "github.com/hashicorp/raft"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	
+	"github.com/adminium/permify/internal/repositories/memory"
+	"github.com/adminium/permify/internal/repositories/memory/migrations"
+	"github.com/adminium/permify/pkg/database"
+	MMDatabase "github.com/adminium/permify/pkg/database/memory"
+	db "github.com/adminium/permify/pkg/database/raft"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// FSM - State machine of the raft log, it applies the committed commands to the memory database of the node with
+// the writers of the memory engine. The snapshots are the commands that changed the database, restoring one
+// replays them, so the rows get the times the node applied them at.
+type FSM struct {
+	database *MMDatabase.Memory
+	
+	relationships  *memory.RelationshipWriter
+	schemas        *memory.SchemaWriter
+	tenants        *memory.TenantWriter
+	identities     *memory.IdentityWriter
+	attributes     *memory.AttributeWriter
+	tenantSettings *memory.TenantSettingsWriter
+	apiKeys        *memory.APIKeyWriter
+	
+	// commands - the applied commands that changed the database
+	commands [][]byte
+}
+
+// NewFSM - Creates the state machine of the memory database
+func NewFSM(database *MMDatabase.Memory, l logger.Interface) *FSM {
+	return &FSM{
+		database:       database,
+		relationships:  memory.NewRelationshipWriter(database, l),
+		schemas:        memory.NewSchemaWriter(database, l),
+		tenants:        memory.NewTenantWriter(database, l),
+		identities:     memory.NewIdentityWriter(database, l),
+		attributes:     memory.NewAttributeWriter(database, l),
+		tenantSettings: memory.NewTenantSettingsWriter(database, l),
+		apiKeys:        memory.NewAPIKeyWriter(database, l),
+	}
+}
+
+// Apply - Applies a committed command, raft applies the commands one at a time in the order of the log
+func (f *FSM) Apply(l *hraft.Log) interface{} {
+	data, err := f.apply(l.Data)
+	if err == nil {
+		f.commands = append(f.commands, l.Data)
+	}
+	return db.Result{Data: data, Err: err}
+}
+
+// Snapshot - The commands applied so far, the slice is only appended to so it is shared with the snapshot
+func (f *FSM) Snapshot() (hraft.FSMSnapshot, error) {
+	return &fsmSnapshot{commands: f.commands[:len(f.commands):len(f.commands)]}, nil
+}
+
+// Restore - Replaces the memory database with the one the commands of the snapshot build
+func (f *FSM) Restore(rc io.ReadCloser) error {
+	defer rc.Close()
+	
+	var commands [][]byte
+	reader := bufio.NewReader(rc)
+	for {
+		size, err := binary.ReadUvarint(reader)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		c := make([]byte, size)
+		if _, err = io.ReadFull(reader, c); err != nil {
+			return err
+		}
+		commands = append(commands, c)
+	}
+	
+	if err := f.clear(); err != nil {
+		return err
+	}
+	
+	f.commands = nil
+	for _, c := range commands {
+		if _, err := f.apply(c); err == nil {
+			f.commands = append(f.commands, c)
+		}
+	}
+	return nil
+}
+
+// clear - Deletes the rows of every table
+func (f *FSM) clear() error {
+	txn := f.database.DB.Txn(true)
+	defer txn.Abort()
+	
+	for table := range migrations.Schema.Tables {
+		it, err := txn.Get(table, "id_prefix", "")
+		if err != nil {
+			return err
+		}
+		var rows []interface{}
+		for obj := it.Next(); obj != nil; obj = it.Next() {
+			rows = append(rows, obj)
+		}
+		for _, row := range rows {
+			if err = txn.Delete(table, row); err != nil {
+				return err
+			}
+		}
+	}
+	
+	txn.Commit()
+	return nil
+}
+
+// apply - Applies the command to the memory database with the writer of its operation
+func (f *FSM) apply(data []byte) ([]byte, error) {
+	var c command
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+	}
+	
+	ctx := context.Background()
+	switch c.Op {
+	case opWriteRelationships:
+		var args writeRelationships
+		if err := json.Unmarshal(c.Args, &args); err != nil {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		collection := database.NewTupleCollection()
+		for _, raw := range args.Tuples {
+			t := &base.Tuple{}
+			if err := protojson.Unmarshal(raw, t); err != nil {
+				return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+			}
+			collection.Add(t)
+		}
+		st, err := f.relationships.WriteRelationships(database.ContextWithTupleMetadata(ctx, args.Metadata), args.TenantID, collection)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(st.String()), nil
+	case opDeleteRelationships:
+		var args deleteRelationships
+		if err := json.Unmarshal(c.Args, &args); err != nil {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		filter := &base.TupleFilter{}
+		if err := protojson.Unmarshal(args.Filter, filter); err != nil {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		st, err := f.relationships.DeleteRelationships(ctx, args.TenantID, filter)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(st.String()), nil
+	case opWriteSchema:
+		var args writeSchema
+		if err := json.Unmarshal(c.Args, &args); err != nil {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		return nil, f.schemas.WriteSchema(ctx, args.Definitions)
+	case opCreateTenant, opDeleteTenant, opUpdateTenant:
+		var args tenant
+		if err := json.Unmarshal(c.Args, &args); err != nil {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		var result *base.Tenant
+		var err error
+		switch c.Op {
+		case opCreateTenant:
+			result, err = f.tenants.CreateTenant(ctx, args.ID, args.Name)
+		case opDeleteTenant:
+			result, err = f.tenants.DeleteTenant(ctx, args.ID)
+		default:
+			result, err = f.tenants.UpdateTenant(ctx, args.ID, args.Name, args.Labels)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return protojson.Marshal(result)
+	case opWriteIdentity, opDeleteIdentity:
+		var args identity
+		if err := json.Unmarshal(c.Args, &args); err != nil {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		if c.Op == opWriteIdentity {
+			return nil, f.identities.WriteIdentity(ctx, args.TenantID, args.SubjectType, args.Alias, args.SubjectID)
+		}
+		return nil, f.identities.DeleteIdentity(ctx, args.TenantID, args.SubjectType, args.Alias)
+	case opWriteAttributes:
+		var args writeAttributes
+		if err := json.Unmarshal(c.Args, &args); err != nil {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		entity := &base.Entity{}
+		if err := protojson.Unmarshal(args.Entity, entity); err != nil {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		attributes := make(map[string]*structpb.Value, len(args.Attributes))
+		for name, raw := range args.Attributes {
+			value := &structpb.Value{}
+			if err := protojson.Unmarshal(raw, value); err != nil {
+				return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+			}
+			attributes[name] = value
+		}
+		return nil, f.attributes.WriteAttributes(ctx, args.TenantID, entity, attributes)
+	case opDeleteAttributes:
+		var args deleteAttributes
+		if err := json.Unmarshal(c.Args, &args); err != nil {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		entity := &base.Entity{}
+		if err := protojson.Unmarshal(args.Entity, entity); err != nil {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		return nil, f.attributes.DeleteAttributes(ctx, args.TenantID, entity, args.Names...)
+	case opWriteTenantSettings:
+		var args writeTenantSettings
+		if err := json.Unmarshal(c.Args, &args); err != nil {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		return nil, f.tenantSettings.WriteTenantSettings(ctx, args.TenantID, args.Settings)
+	case opWriteAPIKey:
+		var args writeAPIKey
+		if err := json.Unmarshal(c.Args, &args); err != nil {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		return nil, f.apiKeys.WriteAPIKey(ctx, args.Key)
+	case opDeleteAPIKey:
+		var args deleteAPIKey
+		if err := json.Unmarshal(c.Args, &args); err != nil {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		return nil, f.apiKeys.DeleteAPIKey(ctx, args.ID)
+	default:
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+	}
+}
+
+// fsmSnapshot - Commands of a snapshot, written with their lengths as uvarints
+type fsmSnapshot struct {
+	commands [][]byte
+}
+
+// Persist - Writes the commands to the sink of the snapshot store
+func (s *fsmSnapshot) Persist(sink hraft.SnapshotSink) error {
+	writer := bufio.NewWriter(sink)
+	size := make([]byte, binary.MaxVarintLen64)
+	for _, c := range s.commands {
+		n := binary.PutUvarint(size, uint64(len(c)))
+		if _, err := writer.Write(size[:n]); err != nil {
+			_ = sink.Cancel()
+			return err
+		}
+		if _, err := writer.Write(c); err != nil {
+			_ = sink.Cancel()
+			return err
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		_ = sink.Cancel()
+		return err
+	}
+	return sink.Close()
+}
+
+// Release -
+func (s *fsmSnapshot) Release() {}
@@ -0,0 +1,248 @@
+package raft_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"sort"
+	"time"
+	
+	hraft "github.com/hashicorp/raft"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	
+	"github.com/adminium/permify/internal/repositories/memory"
+	"github.com/adminium/permify/internal/repositories/memory/migrations"
+	"github.com/adminium/permify/internal/repositories/raft"
+	"github.com/adminium/permify/pkg/database"
+	MMDatabase "github.com/adminium/permify/pkg/database/memory"
+	db "github.com/adminium/permify/pkg/database/raft"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+// sink - Snapshot sink that keeps the snapshot in memory
+type sink struct {
+	bytes.Buffer
+}
+
+func (s *sink) ID() string    { return "test" }
+func (s *sink) Cancel() error { return nil }
+func (s *sink) Close() error  { return nil }
+
+// node - Raft node of a test cluster with the memory database it applies the commands to
+type node struct {
+	*db.Raft
+	id      string
+	address string
+	dir     string
+	mem     *MMDatabase.Memory
+	fsm     *raft.FSM
+	closed  bool
+}
+
+var _ = Describe("fsm", func() {
+	l := logger.New("error")
+	var nodes []*node
+	
+	// freeAddress - Local address that nothing listens on
+	freeAddress := func() string {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ShouldNot(HaveOccurred())
+		defer listener.Close()
+		return listener.Addr().String()
+	}
+	
+	// start - Starts the node with a new memory database, its log and snapshots are read from its directory
+	start := func(n *node, peers []db.Peer) {
+		var err error
+		n.mem, err = MMDatabase.New(migrations.Schema)
+		Expect(err).ShouldNot(HaveOccurred())
+		n.fsm = raft.NewFSM(n.mem, l)
+		n.Raft, err = db.New(n.mem, n.fsm, n.id, n.address, n.dir, peers)
+		Expect(err).ShouldNot(HaveOccurred())
+		n.closed = false
+	}
+	
+	// stop -
+	stop := func(n *node) {
+		Expect(n.Close()).Should(Succeed())
+		n.closed = true
+	}
+	
+	// cluster - Starts a cluster of the nodes with the ids
+	cluster := func(ids ...string) []*node {
+		var peers []db.Peer
+		for _, id := range ids {
+			n := &node{id: id, address: freeAddress(), dir: GinkgoT().TempDir()}
+			nodes = append(nodes, n)
+			peers = append(peers, db.Peer{ID: id, Address: n.address})
+		}
+		for _, n := range nodes[len(nodes)-len(ids):] {
+			start(n, peers)
+		}
+		return nodes[len(nodes)-len(ids):]
+	}
+	
+	// leader - Waits until one of the running nodes is the leader
+	leader := func(among []*node) *node {
+		var found *node
+		Eventually(func() bool {
+			for _, n := range among {
+				if !n.closed && n.Node.State() == hraft.Leader {
+					found = n
+					return true
+				}
+			}
+			return false
+		}, 15*time.Second, 10*time.Millisecond).Should(BeTrue(), "the cluster did not elect a leader")
+		return found
+	}
+	
+	// ids - Sorted entity ids of the tuples of the tenant in the memory database
+	ids := func(mem *MMDatabase.Memory) []string {
+		it, err := memory.NewRelationshipReader(mem, l).QueryRelationships(context.Background(), "t1", &base.TupleFilter{}, "")
+		Expect(err).ShouldNot(HaveOccurred())
+		ids := []string{}
+		for it.HasNext() {
+			ids = append(ids, it.GetNext().GetEntity().GetId())
+		}
+		sort.Strings(ids)
+		return ids
+	}
+	
+	// write - Writes the owner tuples of the docs through the node
+	write := func(n *node, docs ...string) {
+		collection := database.NewTupleCollection()
+		for _, id := range docs {
+			collection.Add(&base.Tuple{Entity: &base.Entity{Type: "doc", Id: id}, Relation: "owner", Subject: &base.Subject{Type: tuple.USER, Id: id}})
+		}
+		_, err := raft.NewRelationshipWriter(n.Raft, l).WriteRelationships(context.Background(), "t1", collection)
+		Expect(err).ShouldNot(HaveOccurred())
+	}
+	
+	BeforeEach(func() {
+		nodes = nil
+	})
+	
+	AfterEach(func() {
+		for _, n := range nodes {
+			if !n.closed {
+				stop(n)
+			}
+		}
+	})
+	
+	Context("Apply", func() {
+		It("Case 1: The writes of a single node cluster are applied to its memory database", func() {
+			n := leader(cluster("n1"))
+			
+			write(n, "1", "2")
+			_, err := raft.NewRelationshipWriter(n.Raft, l).DeleteRelationships(context.Background(), "t1", &base.TupleFilter{Entity: &base.EntityFilter{Type: "doc", Ids: []string{"2"}}})
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			tenant, err := raft.NewTenantWriter(n.Raft, l).CreateTenant(context.Background(), "t2", "edge")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(tenant.GetId()).Should(Equal("t2"))
+			Expect(tenant.GetName()).Should(Equal("edge"))
+			
+			Expect(ids(n.mem)).Should(Equal([]string{"1"}))
+		})
+		
+		It("Case 2: The writes of the leader are applied by the followers", func() {
+			members := cluster("n1", "n2", "n3")
+			write(leader(members), "1", "2")
+			
+			for _, n := range members {
+				Eventually(func() []string { return ids(n.mem) }, 5*time.Second, 10*time.Millisecond).Should(Equal([]string{"1", "2"}))
+			}
+		})
+	})
+	
+	Context("Snapshot", func() {
+		It("Case 1: A snapshot of the state machine restores the same tuples and tenants on another node", func() {
+			n := leader(cluster("n1"))
+			write(n, "1")
+			_, err := raft.NewTenantWriter(n.Raft, l).CreateTenant(context.Background(), "t2", "edge")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			snapshot, err := n.fsm.Snapshot()
+			Expect(err).ShouldNot(HaveOccurred())
+			s := &sink{}
+			Expect(snapshot.Persist(s)).Should(Succeed())
+			
+			// the rows the other node has before the restore are replaced
+			other, err := MMDatabase.New(migrations.Schema)
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = memory.NewRelationshipWriter(other, l).WriteRelationships(context.Background(), "t1", database.NewTupleCollection(
+				&base.Tuple{Entity: &base.Entity{Type: "doc", Id: "3"}, Relation: "owner", Subject: &base.Subject{Type: tuple.USER, Id: "3"}},
+			))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(raft.NewFSM(other, l).Restore(io.NopCloser(&s.Buffer))).Should(Succeed())
+			
+			Expect(ids(other)).Should(Equal([]string{"1"}))
+			tenants, _, err := memory.NewTenantReader(other, l).ListTenants(context.Background(), database.NewPagination(database.Size(10)))
+			Expect(err).ShouldNot(HaveOccurred())
+			var restored []string
+			for _, tenant := range tenants {
+				restored = append(restored, tenant.GetId())
+			}
+			Expect(restored).Should(ContainElement("t2"))
+		})
+	})
+	
+	Context("Failover", func() {
+		It("Case 1: The nodes left when the leader is lost elect a new leader that has its writes and accepts new ones", func() {
+			members := cluster("n1", "n2", "n3")
+			old := leader(members)
+			write(old, "1")
+			for _, n := range members {
+				Eventually(func() []string { return ids(n.mem) }, 5*time.Second, 10*time.Millisecond).Should(Equal([]string{"1"}))
+			}
+			
+			stop(old)
+			
+			current := leader(members)
+			Expect(current.id).ShouldNot(Equal(old.id))
+			write(current, "2")
+			
+			for _, n := range members {
+				if n.closed {
+					continue
+				}
+				Eventually(func() []string { return ids(n.mem) }, 5*time.Second, 10*time.Millisecond).Should(Equal([]string{"1", "2"}))
+			}
+		})
+	})
+	
+	Context("Replay", func() {
+		It("Case 1: A restarted node replays its log into a new memory database", func() {
+			members := cluster("n1")
+			write(leader(members), "1", "2")
+			
+			n := members[0]
+			stop(n)
+			start(n, []db.Peer{{ID: n.id, Address: n.address}})
+			
+			Eventually(func() []string { return ids(n.mem) }, 15*time.Second, 10*time.Millisecond).Should(Equal([]string{"1", "2"}))
+		})
+		
+		It("Case 2: A lost leader that rejoins the cluster catches up with the writes made without it", func() {
+			members := cluster("n1", "n2", "n3")
+			var peers []db.Peer
+			for _, n := range members {
+				peers = append(peers, db.Peer{ID: n.id, Address: n.address})
+			}
+			
+			old := leader(members)
+			write(old, "1")
+			stop(old)
+			write(leader(members), "2")
+			
+			start(old, peers)
+			Eventually(func() []string { return ids(old.mem) }, 15*time.Second, 10*time.Millisecond).Should(Equal([]string{"1", "2"}))
+		})
+	})
+})
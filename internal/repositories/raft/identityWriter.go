@@ -0,0 +1,39 @@
+package raft
+
+import (
+	"context"
+	
+	db "github.com/adminium/permify/pkg/database/raft"
+	"github.com/adminium/permify/pkg/logger"
+)
+
+// IdentityWriter - Structure for Identity Writer, the writes are replicated to every node of the cluster
+type IdentityWriter struct {
+	database *db.Raft
+	// logger
+	logger logger.Interface
+}
+
+// NewIdentityWriter - Creates a new IdentityWriter
+func NewIdentityWriter(database *db.Raft, logger logger.Interface) *IdentityWriter {
+	return &IdentityWriter{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// WriteIdentity - Maps the alias to the subject through the leader
+func (w *IdentityWriter) WriteIdentity(ctx context.Context, tenantID, subjectType, alias, subjectID string) error {
+	ctx, span := tracer.Start(ctx, "identity-writer.write-identity")
+	defer span.End()
+	
+	return apply(ctx, w.database, opWriteIdentity, identity{TenantID: tenantID, SubjectType: subjectType, Alias: alias, SubjectID: subjectID})
+}
+
+// DeleteIdentity - Deletes the alias through the leader
+func (w *IdentityWriter) DeleteIdentity(ctx context.Context, tenantID, subjectType, alias string) error {
+	ctx, span := tracer.Start(ctx, "identity-writer.delete-identity")
+	defer span.End()
+	
+	return apply(ctx, w.database, opDeleteIdentity, identity{TenantID: tenantID, SubjectType: subjectType, Alias: alias})
+}
@@ -0,0 +1,94 @@
+package raft
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	
+	otelCodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/encoding/protojson"
+	
+	"github.com/adminium/permify/internal/repositories/memory/snapshot"
+	"github.com/adminium/permify/pkg/database"
+	db "github.com/adminium/permify/pkg/database/raft"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/token"
+)
+
+// RelationshipWriter - Structure for Relationship Writer, the writes are replicated to every node of the cluster
+type RelationshipWriter struct {
+	database *db.Raft
+	// logger
+	logger logger.Interface
+}
+
+// NewRelationshipWriter - Creates a new RelationshipWriter
+func NewRelationshipWriter(database *db.Raft, logger logger.Interface) *RelationshipWriter {
+	return &RelationshipWriter{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// WriteRelationships - Writes a collection of relationships through the leader
+func (w *RelationshipWriter) WriteRelationships(ctx context.Context, tenantID string, collection *database.TupleCollection) (token.EncodedSnapToken, error) {
+	ctx, span := tracer.Start(ctx, "relationship-writer.write-relationships")
+	defer span.End()
+	
+	if len(collection.GetTuples()) == 0 {
+		return token.NewNoopToken().Encode(), nil
+	}
+	
+	args := writeRelationships{
+		TenantID: tenantID,
+		Tuples:   make([]json.RawMessage, 0, len(collection.GetTuples())),
+		Metadata: database.TupleMetadataFromContext(ctx),
+	}
+	for _, t := range collection.GetTuples() {
+		raw, err := protojson.Marshal(t)
+		if err != nil {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+		}
+		args.Tuples = append(args.Tuples, raw)
+	}
+	
+	return w.apply(ctx, opWriteRelationships, args)
+}
+
+// BatchWriteRelationships - Writes relationships through the leader, the collection is a single command
+func (w *RelationshipWriter) BatchWriteRelationships(ctx context.Context, tenantID string, collection *database.TupleCollection) (token.EncodedSnapToken, error) {
+	return w.WriteRelationships(ctx, tenantID, collection)
+}
+
+// DeleteRelationships - Deletes the relationships of the filter through the leader
+func (w *RelationshipWriter) DeleteRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter) (token.EncodedSnapToken, error) {
+	ctx, span := tracer.Start(ctx, "relationship-writer.delete-relationships")
+	defer span.End()
+	
+	raw, err := protojson.Marshal(filter)
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+	}
+	
+	return w.apply(ctx, opDeleteRelationships, deleteRelationships{TenantID: tenantID, Filter: raw})
+}
+
+// apply - Applies the command and returns the snapshot token the memory writer of the leader returned
+func (w *RelationshipWriter) apply(ctx context.Context, op string, args interface{}) (token.EncodedSnapToken, error) {
+	span := trace.SpanFromContext(ctx)
+	
+	c, err := encode(op, args)
+	if err != nil {
+		return nil, err
+	}
+	
+	data, err := w.database.Apply(ctx, c)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, err
+	}
+	return snapshot.EncodedToken{Value: string(data)}, nil
+}
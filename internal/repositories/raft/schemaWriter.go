@@ -0,0 +1,32 @@
+package raft
+
+import (
+	"context"
+	
+	"github.com/adminium/permify/internal/repositories"
+	db "github.com/adminium/permify/pkg/database/raft"
+	"github.com/adminium/permify/pkg/logger"
+)
+
+// SchemaWriter - Structure for Schema Writer, the writes are replicated to every node of the cluster
+type SchemaWriter struct {
+	database *db.Raft
+	// logger
+	logger logger.Interface
+}
+
+// NewSchemaWriter - Creates a new SchemaWriter
+func NewSchemaWriter(database *db.Raft, logger logger.Interface) *SchemaWriter {
+	return &SchemaWriter{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// WriteSchema - Writes the definitions of a schema version through the leader
+func (w *SchemaWriter) WriteSchema(ctx context.Context, definitions []repositories.SchemaDefinition) error {
+	ctx, span := tracer.Start(ctx, "schema-writer.write-schema")
+	defer span.End()
+	
+	return apply(ctx, w.database, opWriteSchema, writeSchema{Definitions: definitions})
+}
@@ -0,0 +1,32 @@
+package raft
+
+import (
+	"context"
+	
+	"github.com/adminium/permify/internal/repositories"
+	db "github.com/adminium/permify/pkg/database/raft"
+	"github.com/adminium/permify/pkg/logger"
+)
+
+// TenantSettingsWriter - Structure for Tenant Settings Writer, the writes are replicated to every node of the cluster
+type TenantSettingsWriter struct {
+	database *db.Raft
+	// logger
+	logger logger.Interface
+}
+
+// NewTenantSettingsWriter - Creates a new TenantSettingsWriter
+func NewTenantSettingsWriter(database *db.Raft, logger logger.Interface) *TenantSettingsWriter {
+	return &TenantSettingsWriter{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// WriteTenantSettings - Replaces the settings of the tenant through the leader
+func (w *TenantSettingsWriter) WriteTenantSettings(ctx context.Context, tenantID string, settings repositories.TenantSettings) error {
+	ctx, span := tracer.Start(ctx, "tenant-settings-writer.write-tenant-settings")
+	defer span.End()
+	
+	return apply(ctx, w.database, opWriteTenantSettings, writeTenantSettings{TenantID: tenantID, Settings: settings})
+}
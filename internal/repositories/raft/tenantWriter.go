@@ -0,0 +1,76 @@
+package raft
+
+import (
+	"context"
+	"errors"
+	
+	otelCodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/encoding/protojson"
+	
+	db "github.com/adminium/permify/pkg/database/raft"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// TenantWriter - Structure for Tenant Writer, the writes are replicated to every node of the cluster
+type TenantWriter struct {
+	database *db.Raft
+	// logger
+	logger logger.Interface
+}
+
+// NewTenantWriter - Creates a new TenantWriter
+func NewTenantWriter(database *db.Raft, logger logger.Interface) *TenantWriter {
+	return &TenantWriter{
+		database: database,
+		logger:   logger,
+	}
+}
+
+// CreateTenant - Creates the tenant through the leader
+func (w *TenantWriter) CreateTenant(ctx context.Context, id, name string) (*base.Tenant, error) {
+	ctx, span := tracer.Start(ctx, "tenant-writer.create-tenant")
+	defer span.End()
+	
+	return w.apply(ctx, opCreateTenant, tenant{ID: id, Name: name})
+}
+
+// DeleteTenant - Deletes the tenant through the leader
+func (w *TenantWriter) DeleteTenant(ctx context.Context, tenantID string) (*base.Tenant, error) {
+	ctx, span := tracer.Start(ctx, "tenant-writer.delete-tenant")
+	defer span.End()
+	
+	return w.apply(ctx, opDeleteTenant, tenant{ID: tenantID})
+}
+
+// UpdateTenant - Renames and labels the tenant through the leader
+func (w *TenantWriter) UpdateTenant(ctx context.Context, tenantID, name string, labels map[string]string) (*base.Tenant, error) {
+	ctx, span := tracer.Start(ctx, "tenant-writer.update-tenant")
+	defer span.End()
+	
+	return w.apply(ctx, opUpdateTenant, tenant{ID: tenantID, Name: name, Labels: labels})
+}
+
+// apply - Applies the command and returns the tenant the memory writer of the leader returned
+func (w *TenantWriter) apply(ctx context.Context, op string, args tenant) (*base.Tenant, error) {
+	span := trace.SpanFromContext(ctx)
+	
+	c, err := encode(op, args)
+	if err != nil {
+		return nil, err
+	}
+	
+	data, err := w.database.Apply(ctx, c)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, err
+	}
+	
+	result := &base.Tenant{}
+	if err = protojson.Unmarshal(data, result); err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+	}
+	return result, nil
+}
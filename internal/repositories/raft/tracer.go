@@ -0,0 +1,7 @@
+package raft
+
+import (
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("repositories.raft")
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"sort"
+	"strings"
+	
+	"github.com/adminium/permify/pkg/dsl/compiler"
+	"github.com/adminium/permify/pkg/dsl/parser"
+)
+
+// Aliases - Previous names of the entities of a schema, declared as entity document (alias doc) while the tuples and
+// the requests of the clients are renamed. The values are the entity types.
+// sample keys: doc
+type Aliases map[string]string
+
+// NewAliasesFromStringDefinitions - Collects the aliases of the entities of the serialized definitions
+func NewAliasesFromStringDefinitions(definitions ...string) (Aliases, error) {
+	sch, err := parser.NewParser(strings.Join(definitions, "\n")).Parse()
+	if err != nil {
+		return nil, err
+	}
+	c := compiler.NewCompiler(true, sch)
+	if _, err = c.Compile(); err != nil {
+		return nil, err
+	}
+	return c.Aliases(), nil
+}
+
+// Resolve - Entity type of the name, ok is true when the name is an alias
+func (a Aliases) Resolve(name string) (entityType string, ok bool) {
+	entityType, ok = a[name]
+	if !ok {
+		return name, false
+	}
+	return entityType, true
+}
+
+// Of - Aliases of the entity type in alphabetical order
+func (a Aliases) Of(entityType string) (aliases []string) {
+	for alias, typ := range a {
+		if typ == entityType {
+			aliases = append(aliases, alias)
+		}
+	}
+	sort.Strings(aliases)
+	return aliases
+}
+
+// Warnings - One warning for every alias in alphabetical order, an alias is meant to be removed once the tuples and
+// the clients use the entity type
+func (a Aliases) Warnings() []string {
+	warnings := make([]string, 0, len(a))
+	for alias, entityType := range a {
+		warnings = append(warnings, "entity "+entityType+" is still aliased as "+alias+", rename its tuples and requests and remove the alias")
+	}
+	sort.Strings(warnings)
+	return warnings
+}
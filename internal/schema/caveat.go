@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"errors"
+	"strings"
+	
+	"github.com/google/cel-go/cel"
+	"google.golang.org/protobuf/types/known/structpb"
+	
+	"github.com/adminium/permify/pkg/dsl/ast"
+	"github.com/adminium/permify/pkg/dsl/parser"
+	"github.com/adminium/permify/pkg/dsl/utils"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// Caveats - Compiled caveats of a schema. The compiled definitions only carry the relations the caveats are attached
+// to, so the caveats are compiled from their statements.
+type Caveats struct {
+	programs map[string]cel.Program
+}
+
+// NewCaveatsFromStringDefinitions - Compiles the caveats of the serialized definitions
+func NewCaveatsFromStringDefinitions(definitions ...string) (*Caveats, error) {
+	sch, err := parser.NewParser(strings.Join(definitions, "\n")).Parse()
+	if err != nil {
+		return nil, err
+	}
+	env, err := utils.CaveatEnvironment()
+	if err != nil {
+		return nil, err
+	}
+	caveats := &Caveats{
+		programs: map[string]cel.Program{},
+	}
+	for _, st := range sch.Statements {
+		cs, ok := st.(*ast.CaveatStatement)
+		if !ok {
+			continue
+		}
+		checked, issues := env.Compile(cs.Expression.Literal)
+		if issues != nil && issues.Err() != nil {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
+		}
+		var program cel.Program
+		program, err = env.Program(checked)
+		if err != nil {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
+		}
+		caveats.programs[cs.Name.Literal] = program
+	}
+	return caveats, nil
+}
+
+// Evaluate - Evaluates the caveat with the context of the check and the payload of a tuple, nil for an empty one.
+// An expression that can not be evaluated with them, e.g. one that reads a key they do not have, is not satisfied.
+func (c *Caveats) Evaluate(caveat string, context, payload *structpb.Struct) (bool, error) {
+	program, ok := c.programs[caveat]
+	if !ok {
+		return false, errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_RELATION_REFERENCE.String())
+	}
+	out, _, err := program.Eval(map[string]interface{}{
+		"context": context.AsMap(),
+		"payload": payload.AsMap(),
+	})
+	if err != nil {
+		return false, nil
+	}
+	can, ok := out.Value().(bool)
+	return ok && can, nil
+}
+
+// computedRelation - Relation of a computed user set, the relation of the caveat for one with a caveat, e.g. viewer for
+// viewer with ip_allowed
+func computedRelation(relation string) string {
+	if r, _, ok := utils.ParseCaveatReference(relation); ok {
+		return r
+	}
+	return relation
+}
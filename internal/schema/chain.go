@@ -0,0 +1,98 @@
+package schema
+
+import (
+	"strings"
+	
+	"github.com/adminium/permify/pkg/dsl/utils"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// relationChain - Action that resolves a chain of relations on the entity, e.g. parent.admin walks the parent
+// relation and reads admin on the entities it reaches. Chains are not declared in the definitions, the compiler
+// emits them as the computed relations of tuple to user sets that walk more than one relation.
+func relationChain(entityDefinition *base.EntityDefinition, name string) (*base.ActionDefinition, bool) {
+	relation, rest, ok := strings.Cut(name, ".")
+	if !ok || rest == "" {
+		return nil, false
+	}
+	if _, ok = entityDefinition.GetRelations()[relation]; !ok {
+		return nil, false
+	}
+	return &base.ActionDefinition{
+		Name: name,
+		Child: &base.Child{
+			Type: &base.Child_Leaf{
+				Leaf: &base.Leaf{
+					Type: &base.Leaf_TupleToUserSet{
+						TupleToUserSet: &base.TupleToUserSet{
+							TupleSet: &base.TupleSet{Relation: relation},
+							Computed: &base.ComputedUserSet{Relation: rest},
+						},
+					},
+				},
+			},
+		},
+	}, true
+}
+
+// chainReferences - Relations and actions that the computed relation of a tuple to user set reads on an entity type
+// it walks to: the relation itself, or every relation of a chain on the entity types the chain reaches
+func chainReferences(schema *base.SchemaDefinition, entityType, relation string) []reference {
+	first, rest, ok := strings.Cut(relation, ".")
+	if !ok {
+		return []reference{{entityType, relation}}
+	}
+	references := []reference{{entityType, first}}
+	for _, ref := range schema.GetEntityDefinitions()[entityType].GetRelations()[first].GetRelationReferences() {
+		references = append(references, chainReferences(schema, ref.GetType(), rest)...)
+	}
+	return references
+}
+
+// chainTargets - Relations and actions that the computed relation of a tuple to user set checks on the entity types
+// the chain ends at, the relations before them are only walked
+func chainTargets(schema *base.SchemaDefinition, entityType, relation string) []reference {
+	first, rest, ok := strings.Cut(relation, ".")
+	if !ok {
+		return []reference{{entityType, relation}}
+	}
+	var targets []reference
+	for _, ref := range schema.GetEntityDefinitions()[entityType].GetRelations()[first].GetRelationReferences() {
+		targets = append(targets, chainTargets(schema, ref.GetType(), rest)...)
+	}
+	return targets
+}
+
+// tupleSetReferences - Relations that the tuple set of a tuple to user set reads and the entity types it walks to. A
+// transitive tuple set, e.g. parent*, walks its relation again on every entity type it reaches that has it, so it
+// reads the relation on each of them and reaches the entity types of all of them.
+func tupleSetReferences(schema *base.SchemaDefinition, entityType, tupleSet string) (relations []reference, types []string) {
+	relation, transitive := utils.ParseTransitiveReference(tupleSet)
+	if !transitive {
+		relation = tupleSet
+	}
+	
+	relations = []reference{{entityType, relation}}
+	visited := map[string]bool{entityType: true}
+	reached := map[string]bool{}
+	queue := []string{entityType}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, ref := range schema.GetEntityDefinitions()[current].GetRelations()[relation].GetRelationReferences() {
+			if !reached[ref.GetType()] {
+				reached[ref.GetType()] = true
+				types = append(types, ref.GetType())
+			}
+			if !transitive || visited[ref.GetType()] {
+				continue
+			}
+			visited[ref.GetType()] = true
+			if _, ok := schema.GetEntityDefinitions()[ref.GetType()].GetRelations()[relation]; ok {
+				relations = append(relations, reference{ref.GetType(), relation})
+				queue = append(queue, ref.GetType())
+			}
+		}
+	}
+	return relations, types
+}
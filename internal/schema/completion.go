@@ -0,0 +1,105 @@
+package schema
+
+import (
+	"sort"
+	"strings"
+	
+	"github.com/adminium/permify/pkg/dsl/compiler"
+	"github.com/adminium/permify/pkg/dsl/parser"
+	"github.com/adminium/permify/pkg/dsl/utils"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// Docs - Doc comments of the entities, relations and actions of a schema, written as the single line comments right
+// above their statements. The lines of a comment are joined with line breaks.
+// sample keys: entity_type, entity_type#member, entity_type#read
+type Docs map[string]string
+
+// NewDocsFromStringDefinitions - Collects the doc comments of the serialized definitions
+func NewDocsFromStringDefinitions(definitions ...string) (Docs, error) {
+	sch, err := parser.NewParser(strings.Join(definitions, "\n")).Parse()
+	if err != nil {
+		return nil, err
+	}
+	c := compiler.NewCompiler(true, sch)
+	if _, err = c.Compile(); err != nil {
+		return nil, err
+	}
+	return c.Docs(), nil
+}
+
+// Of - Doc of the relation or action of the entity type, or of the entity type itself when name is empty
+func (d Docs) Of(entityType, name string) string {
+	if name == "" {
+		return d[entityType]
+	}
+	return d[utils.Key(entityType, name)]
+}
+
+// Completion - Relations and permissions of an entity type in the shape the autocompletion of a console needs, the
+// rewrites of the permissions are left out
+type Completion struct {
+	EntityType  string
+	Doc         string
+	Relations   []RelationCompletion
+	Permissions []PermissionCompletion
+}
+
+// RelationCompletion - Relation with the subject types it can be written with
+// sample subject types: user, organization#member
+type RelationCompletion struct {
+	Name         string
+	Doc          string
+	Deprecated   bool
+	SubjectTypes []string
+}
+
+// PermissionCompletion - Action that can be checked
+type PermissionCompletion struct {
+	Name       string
+	Doc        string
+	Deprecated bool
+}
+
+// NewCompletion - Builds the completion of the entity definition, relations and permissions are in name order
+func NewCompletion(entity *base.EntityDefinition, deprecations Deprecations, docs Docs) Completion {
+	completion := Completion{
+		EntityType:  entity.GetName(),
+		Doc:         docs.Of(entity.GetName(), ""),
+		Relations:   []RelationCompletion{},
+		Permissions: []PermissionCompletion{},
+	}
+	
+	for name, relation := range entity.GetRelations() {
+		subjectTypes := make([]string, 0, len(relation.GetRelationReferences()))
+		for _, reference := range relation.GetRelationReferences() {
+			if reference.GetRelation() == "" {
+				subjectTypes = append(subjectTypes, reference.GetType())
+				continue
+			}
+			subjectTypes = append(subjectTypes, utils.Key(reference.GetType(), reference.GetRelation()))
+		}
+		completion.Relations = append(completion.Relations, RelationCompletion{
+			Name:         name,
+			Doc:          docs.Of(entity.GetName(), name),
+			Deprecated:   deprecations.IsDeprecated(entity.GetName(), name),
+			SubjectTypes: subjectTypes,
+		})
+	}
+	sort.Slice(completion.Relations, func(i, j int) bool {
+		return completion.Relations[i].Name < completion.Relations[j].Name
+	})
+	
+	for name := range entity.GetActions() {
+		completion.Permissions = append(completion.Permissions, PermissionCompletion{
+			Name:       name,
+			Doc:        docs.Of(entity.GetName(), name),
+			Deprecated: deprecations.IsDeprecated(entity.GetName(), name),
+		})
+	}
+	sort.Slice(completion.Permissions, func(i, j int) bool {
+		return completion.Permissions[i].Name < completion.Permissions[j].Name
+	})
+	
+	return completion
+}
@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"errors"
+	"sync"
+	
+	"github.com/google/cel-go/cel"
+	"google.golang.org/protobuf/types/known/structpb"
+	
+	"github.com/adminium/permify/pkg/dsl/utils"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// _conditionProgramsSize - Compiled programs kept before the cache starts over
+const _conditionProgramsSize = 10000
+
+// Conditions - Programs of the conditions of actions. The conditions only read the context, the entity and the subject
+// of the check, so one environment compiles the conditions of every schema version, the first time they are evaluated.
+type Conditions struct {
+	env *cel.Env
+	
+	mu       sync.Mutex
+	programs map[string]cel.Program
+}
+
+// NewConditions -
+func NewConditions() (*Conditions, error) {
+	env, err := utils.ConditionEnvironment()
+	if err != nil {
+		return nil, err
+	}
+	return &Conditions{
+		env:      env,
+		programs: map[string]cel.Program{},
+	}, nil
+}
+
+// program - Compiled program of the expression
+func (c *Conditions) program(expression string) (cel.Program, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	
+	if program, ok := c.programs[expression]; ok {
+		return program, nil
+	}
+	checked, issues := c.env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
+	}
+	program, err := c.env.Program(checked)
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
+	}
+	if len(c.programs) >= _conditionProgramsSize {
+		c.programs = map[string]cel.Program{}
+	}
+	c.programs[expression] = program
+	return program, nil
+}
+
+// Evaluate - Evaluates the condition with the context of the check, nil for an empty one, and the entity and the
+// subject of the check. An expression that can not be evaluated with them, e.g. one that reads a key the context
+// does not have, is not satisfied.
+func (c *Conditions) Evaluate(expression string, context *structpb.Struct, entity *base.Entity, subject *base.Subject) (bool, error) {
+	program, err := c.program(expression)
+	if err != nil {
+		return false, err
+	}
+	out, _, err := program.Eval(map[string]interface{}{
+		"context": context.AsMap(),
+		"entity": map[string]string{
+			"type": entity.GetType(),
+			"id":   entity.GetId(),
+		},
+		"subject": map[string]string{
+			"type":     subject.GetType(),
+			"id":       subject.GetId(),
+			"relation": subject.GetRelation(),
+		},
+	})
+	if err != nil {
+		return false, nil
+	}
+	can, ok := out.Value().(bool)
+	return ok && can, nil
+}
@@ -0,0 +1,193 @@
+package schema
+
+import (
+	"sort"
+	
+	"github.com/adminium/permify/pkg/dsl/utils"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// Dependents - Returns the relations and actions whose results can change when the tuples of the given entity type
+// and relation change, including the relation itself. An empty relation stands for every relation of the entity type.
+func Dependents(schema *base.SchemaDefinition, entityType, relation string) []*base.RelationReference {
+	graph := dependencyGraph(schema)
+	
+	// invert the graph, so that each relation points to the relations and actions that read it
+	inverted := map[reference][]reference{}
+	for from, dependencies := range graph.edges {
+		for _, to := range dependencies {
+			inverted[to] = append(inverted[to], from)
+		}
+	}
+	
+	var start []reference
+	if relation != "" {
+		start = append(start, reference{entityType, relation})
+	} else {
+		for name := range schema.GetEntityDefinitions()[entityType].GetRelations() {
+			start = append(start, reference{entityType, name})
+		}
+	}
+	
+	return toRelationReferences(walk(inverted, start))
+}
+
+// Dependencies - Returns the relations and actions that the given relation or action reads, including itself.
+// unrestricted reports whether the result can hold for a subject that has no path of tuples to the entity, because
+// one of them excludes another or calls a rule, whose results do not depend on the subject.
+func Dependencies(schema *base.SchemaDefinition, entityType, relation string) (dependencies []*base.RelationReference, unrestricted bool) {
+	graph := dependencyGraph(schema)
+	visited := walk(graph.edges, []reference{{entityType, relation}})
+	for ref := range visited {
+		if graph.exclusions[ref] {
+			unrestricted = true
+		}
+		if _, _, ok := utils.ParseRuleReference(ref.relation); ok {
+			unrestricted = true
+		}
+	}
+	return toRelationReferences(visited), unrestricted
+}
+
+// SubjectTypes - Returns the subject types, e.g. user or team#member, of the tuples whose subjects the relation or
+// action compares with the subject of a check, sorted. A check of a subject of any other type is denied. unrestricted
+// reports whether the result can hold for subjects of any type, because one of the actions it reads excludes another
+// or calls a rule, whose results do not depend on the subject.
+func SubjectTypes(schema *base.SchemaDefinition, entityType, relation string) (types []string, unrestricted bool) {
+	graph := dependencyGraph(schema)
+	start := reference{entityType, relation}
+	
+	// the tuple sets of tuple to user sets are read but their subjects are only walked, not compared
+	compared := map[reference]bool{start: true}
+	for ref := range walk(graph.edges, []reference{start}) {
+		if graph.exclusions[ref] {
+			unrestricted = true
+		}
+		if _, _, ok := utils.ParseRuleReference(ref.relation); ok {
+			unrestricted = true
+		}
+		for _, to := range graph.compared[ref] {
+			compared[to] = true
+		}
+	}
+	
+	keys := map[string]bool{}
+	for ref := range compared {
+		for _, r := range schema.GetEntityDefinitions()[ref.entityType].GetRelations()[ref.relation].GetRelationReferences() {
+			if !keys[referenceKey(r)] {
+				keys[referenceKey(r)] = true
+				types = append(types, referenceKey(r))
+			}
+		}
+	}
+	sort.Strings(types)
+	return types, unrestricted
+}
+
+// graph - Relations and actions, each pointing to the relations and actions it reads
+type graph struct {
+	edges map[reference][]reference
+	// the references of edges whose tuples are compared with the subject, not only walked
+	compared map[reference][]reference
+	// actions that have an excluded leaf
+	exclusions map[reference]bool
+}
+
+// dependencyGraph - Builds the dependency graph of the schema
+func dependencyGraph(schema *base.SchemaDefinition) graph {
+	g := graph{
+		edges:      map[reference][]reference{},
+		compared:   map[reference][]reference{},
+		exclusions: map[reference]bool{},
+	}
+	for _, entity := range schema.GetEntityDefinitions() {
+		for name, relation := range entity.GetRelations() {
+			from := reference{entity.GetName(), name}
+			// tuples with subject sets, e.g. organization#member, are resolved through the subject's relation
+			for _, ref := range relation.GetRelationReferences() {
+				if ref.GetRelation() != "" {
+					g.edges[from] = append(g.edges[from], reference{ref.GetType(), ref.GetRelation()})
+					g.compared[from] = append(g.compared[from], reference{ref.GetType(), ref.GetRelation()})
+				}
+			}
+		}
+		for name, action := range entity.GetActions() {
+			from := reference{entity.GetName(), name}
+			dependencies, compared, exclusion := childDependencies(schema, entity, action.GetChild())
+			g.edges[from] = append(g.edges[from], dependencies...)
+			g.compared[from] = append(g.compared[from], compared...)
+			if exclusion {
+				g.exclusions[from] = true
+			}
+		}
+	}
+	return g
+}
+
+// childDependencies - Collects the relations and actions that the child reads, and those of them that it checks with
+// the subject instead of only walking their tuples
+func childDependencies(schema *base.SchemaDefinition, entity *base.EntityDefinition, child *base.Child) (dependencies, compared []reference, exclusion bool) {
+	switch child.GetType().(type) {
+	case *base.Child_Rewrite:
+		for _, c := range child.GetRewrite().GetChildren() {
+			d, cmp, e := childDependencies(schema, entity, c)
+			dependencies = append(dependencies, d...)
+			compared = append(compared, cmp...)
+			exclusion = exclusion || e
+		}
+	case *base.Child_Leaf:
+		exclusion = child.GetLeaf().GetExclusion()
+		switch leaf := child.GetLeaf().GetType().(type) {
+		case *base.Leaf_ComputedUserSet:
+			// a caveat only leaves out tuples of its relation
+			relation := computedRelation(leaf.ComputedUserSet.GetRelation())
+			dependencies = append(dependencies, reference{entity.GetName(), relation})
+			compared = append(compared, reference{entity.GetName(), relation})
+		case *base.Leaf_TupleToUserSet:
+			relations, types := tupleSetReferences(schema, entity.GetName(), leaf.TupleToUserSet.GetTupleSet().GetRelation())
+			dependencies = append(dependencies, relations...)
+			for _, typ := range types {
+				dependencies = append(dependencies, chainReferences(schema, typ, leaf.TupleToUserSet.GetComputed().GetRelation())...)
+				compared = append(compared, chainTargets(schema, typ, leaf.TupleToUserSet.GetComputed().GetRelation())...)
+			}
+		}
+	}
+	return
+}
+
+// walk - Returns every reference reachable from the start references, including themselves
+func walk(edges map[reference][]reference, start []reference) map[reference]bool {
+	visited := map[reference]bool{}
+	queue := start
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if visited[current] {
+			continue
+		}
+		visited[current] = true
+		queue = append(queue, edges[current]...)
+	}
+	return visited
+}
+
+// toRelationReferences - Converts the references to relation references sorted by type and relation
+func toRelationReferences(references map[reference]bool) []*base.RelationReference {
+	result := make([]*base.RelationReference, 0, len(references))
+	for ref := range references {
+		result = append(result, &base.RelationReference{Type: ref.entityType, Relation: ref.relation})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].GetType() != result[j].GetType() {
+			return result[i].GetType() < result[j].GetType()
+		}
+		return result[i].GetRelation() < result[j].GetRelation()
+	})
+	return result
+}
+
+// reference - Relation or action of an entity type
+type reference struct {
+	entityType string
+	relation   string
+}
@@ -0,0 +1,144 @@
+package schema
+
+import (
+	"sort"
+	"strings"
+	
+	"github.com/adminium/permify/pkg/dsl/compiler"
+	"github.com/adminium/permify/pkg/dsl/parser"
+	"github.com/adminium/permify/pkg/dsl/utils"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// Deprecations - Deprecated relations and actions of a schema
+// sample keys: entity_type#member, entity_type#read
+type Deprecations map[string]struct{}
+
+// Usage - Relation or action that still uses a deprecated relation or action
+type Usage struct {
+	Reference  *base.RelationReference
+	Deprecated *base.RelationReference
+}
+
+// String -
+func (u Usage) String() string {
+	return utils.Key(u.Reference.GetType(), u.Reference.GetRelation()) + " uses deprecated " + utils.Key(u.Deprecated.GetType(), u.Deprecated.GetRelation())
+}
+
+// NewDeprecationsFromStringDefinitions - Collects the deprecated relations and actions of the serialized definitions
+func NewDeprecationsFromStringDefinitions(definitions ...string) (Deprecations, error) {
+	sch, err := parser.NewParser(strings.Join(definitions, "\n")).Parse()
+	if err != nil {
+		return nil, err
+	}
+	c := compiler.NewCompiler(true, sch)
+	if _, err = c.Compile(); err != nil {
+		return nil, err
+	}
+	deprecations := Deprecations{}
+	for _, key := range c.Deprecations() {
+		deprecations[key] = struct{}{}
+	}
+	return deprecations, nil
+}
+
+// IsDeprecated -
+func (d Deprecations) IsDeprecated(entityType, name string) bool {
+	_, ok := d[utils.Key(entityType, name)]
+	return ok
+}
+
+// References - Deprecated relations and actions in key order
+func (d Deprecations) References() []*base.RelationReference {
+	keys := make([]string, 0, len(d))
+	for key := range d {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	references := make([]*base.RelationReference, 0, len(keys))
+	for _, key := range keys {
+		entityType, relation, _ := strings.Cut(key, "#")
+		references = append(references, &base.RelationReference{Type: entityType, Relation: relation})
+	}
+	return references
+}
+
+// DeprecatedUsages - Finds the relations and actions that still use deprecated ones.
+// Deprecated elements using each other are not reported, they are expected to go away together.
+func DeprecatedUsages(sch *base.SchemaDefinition, deprecations Deprecations) []Usage {
+	if len(deprecations) == 0 {
+		return nil
+	}
+	
+	seen := map[string]struct{}{}
+	var usages []Usage
+	use := func(entityType, name, deprecatedType, deprecatedName string) {
+		if !deprecations.IsDeprecated(deprecatedType, deprecatedName) {
+			return
+		}
+		key := utils.Key(entityType, name) + "@" + utils.Key(deprecatedType, deprecatedName)
+		if _, ok := seen[key]; ok {
+			return
+		}
+		seen[key] = struct{}{}
+		usages = append(usages, Usage{
+			Reference:  &base.RelationReference{Type: entityType, Relation: name},
+			Deprecated: &base.RelationReference{Type: deprecatedType, Relation: deprecatedName},
+		})
+	}
+	
+	for entityType, entity := range sch.GetEntityDefinitions() {
+		for name, relation := range entity.GetRelations() {
+			if deprecations.IsDeprecated(entityType, name) {
+				continue
+			}
+			for _, reference := range relation.GetRelationReferences() {
+				if reference.GetRelation() != "" {
+					use(entityType, name, reference.GetType(), reference.GetRelation())
+				}
+			}
+		}
+		for name, action := range entity.GetActions() {
+			if deprecations.IsDeprecated(entityType, name) {
+				continue
+			}
+			for _, leaf := range leaves(action.GetChild()) {
+				switch leaf.GetType().(type) {
+				case *base.Leaf_ComputedUserSet:
+					use(entityType, name, entityType, computedRelation(leaf.GetComputedUserSet().GetRelation()))
+				case *base.Leaf_TupleToUserSet:
+					relations, types := tupleSetReferences(sch, entityType, leaf.GetTupleToUserSet().GetTupleSet().GetRelation())
+					for _, r := range relations {
+						use(entityType, name, r.entityType, r.relation)
+					}
+					for _, typ := range types {
+						for _, r := range chainReferences(sch, typ, leaf.GetTupleToUserSet().GetComputed().GetRelation()) {
+							use(entityType, name, r.entityType, r.relation)
+						}
+					}
+				}
+			}
+		}
+	}
+	
+	sort.Slice(usages, func(i, j int) bool {
+		return usages[i].String() < usages[j].String()
+	})
+	return usages
+}
+
+// leaves -
+func leaves(child *base.Child) []*base.Leaf {
+	switch child.GetType().(type) {
+	case *base.Child_Rewrite:
+		var result []*base.Leaf
+		for _, ch := range child.GetRewrite().GetChildren() {
+			result = append(result, leaves(ch)...)
+		}
+		return result
+	case *base.Child_Leaf:
+		return []*base.Leaf{child.GetLeaf()}
+	default:
+		return nil
+	}
+}
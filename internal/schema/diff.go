@@ -0,0 +1,209 @@
+package schema
+
+import (
+	"sort"
+	
+	"google.golang.org/protobuf/proto"
+	
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// ChangeKind - What changed between two versions of a schema
+type ChangeKind string
+
+const (
+	// EntityAdded - the entity type is new
+	EntityAdded ChangeKind = "entity_added"
+	// EntityRemoved - the entity type is gone together with its relations and actions
+	EntityRemoved ChangeKind = "entity_removed"
+	// RelationAdded - the relation is new
+	RelationAdded ChangeKind = "relation_added"
+	// RelationRemoved - the relation is gone
+	RelationRemoved ChangeKind = "relation_removed"
+	// RelationReferencesChanged - the relation accepts other subject types, e.g. @user became @user @team#member
+	RelationReferencesChanged ChangeKind = "relation_references_changed"
+	// ActionAdded - the action is new
+	ActionAdded ChangeKind = "action_added"
+	// ActionRemoved - the action is gone
+	ActionRemoved ChangeKind = "action_removed"
+	// ActionRenamed - an action is gone and a new one of the entity type has the same expression
+	ActionRenamed ChangeKind = "action_renamed"
+	// ActionChanged - the expression of the action changed
+	ActionChanged ChangeKind = "action_changed"
+)
+
+// Change - Structural change of an entity type, relation or action
+type Change struct {
+	Kind       ChangeKind
+	EntityType string
+	// Name - name of the relation or action, empty for entity types
+	Name string
+	// NewName - name of a renamed action in the new schema
+	NewName string
+	// AddedReferences and RemovedReferences - subject types of a changed relation, e.g. user or team#member
+	AddedReferences   []string
+	RemovedReferences []string
+}
+
+// Breaking - Whether the change breaks existing tuples or the clients checking the removed or renamed action
+func (c Change) Breaking() bool {
+	switch c.Kind {
+	case EntityRemoved, RelationRemoved, ActionRemoved, ActionRenamed:
+		return true
+	case RelationReferencesChanged:
+		return len(c.RemovedReferences) > 0
+	default:
+		return false
+	}
+}
+
+// Orphans - Whether tuples written for the old schema may no longer fit the new one
+func (c Change) Orphans() bool {
+	switch c.Kind {
+	case EntityRemoved, RelationRemoved:
+		return true
+	case RelationReferencesChanged:
+		return len(c.RemovedReferences) > 0
+	default:
+		return false
+	}
+}
+
+// Diff - Compares the entity types, relations and actions of the schema to the one it is replaced with. The changes
+// are sorted by entity type, name and kind. Renames can only be told apart from a removal for actions, whose
+// expressions are compared; a renamed relation is reported as removed and added.
+func Diff(from, to *base.SchemaDefinition) []Change {
+	var changes []Change
+	
+	for name, entity := range from.GetEntityDefinitions() {
+		if _, ok := to.GetEntityDefinitions()[name]; !ok {
+			changes = append(changes, Change{Kind: EntityRemoved, EntityType: name})
+			continue
+		}
+		changes = append(changes, diffEntity(entity, to.GetEntityDefinitions()[name])...)
+	}
+	for name := range to.GetEntityDefinitions() {
+		if _, ok := from.GetEntityDefinitions()[name]; !ok {
+			changes = append(changes, Change{Kind: EntityAdded, EntityType: name})
+		}
+	}
+	
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].EntityType != changes[j].EntityType {
+			return changes[i].EntityType < changes[j].EntityType
+		}
+		if changes[i].Name != changes[j].Name {
+			return changes[i].Name < changes[j].Name
+		}
+		return changes[i].Kind < changes[j].Kind
+	})
+	return changes
+}
+
+// HasBreakingChanges -
+func HasBreakingChanges(changes []Change) bool {
+	for _, change := range changes {
+		if change.Breaking() {
+			return true
+		}
+	}
+	return false
+}
+
+// diffEntity - Changes of the relations and actions of an entity type that is in both schemas
+func diffEntity(from, to *base.EntityDefinition) []Change {
+	var changes []Change
+	entityType := from.GetName()
+	
+	for name, relation := range from.GetRelations() {
+		rel, ok := to.GetRelations()[name]
+		if !ok {
+			changes = append(changes, Change{Kind: RelationRemoved, EntityType: entityType, Name: name})
+			continue
+		}
+		added := referenceDifference(rel.GetRelationReferences(), relation.GetRelationReferences())
+		removed := referenceDifference(relation.GetRelationReferences(), rel.GetRelationReferences())
+		if len(added) > 0 || len(removed) > 0 {
+			changes = append(changes, Change{
+				Kind:              RelationReferencesChanged,
+				EntityType:        entityType,
+				Name:              name,
+				AddedReferences:   added,
+				RemovedReferences: removed,
+			})
+		}
+	}
+	for name := range to.GetRelations() {
+		if _, ok := from.GetRelations()[name]; !ok {
+			changes = append(changes, Change{Kind: RelationAdded, EntityType: entityType, Name: name})
+		}
+	}
+	
+	var removed, added []string
+	for name, action := range from.GetActions() {
+		act, ok := to.GetActions()[name]
+		if !ok {
+			removed = append(removed, name)
+			continue
+		}
+		if !proto.Equal(action.GetChild(), act.GetChild()) {
+			changes = append(changes, Change{Kind: ActionChanged, EntityType: entityType, Name: name})
+		}
+	}
+	for name := range to.GetActions() {
+		if _, ok := from.GetActions()[name]; !ok {
+			added = append(added, name)
+		}
+	}
+	sort.Strings(removed)
+	sort.Strings(added)
+	
+	// a removed action is renamed when an added one has the same expression, each added action is matched once
+	matched := map[string]struct{}{}
+	for _, name := range removed {
+		change := Change{Kind: ActionRemoved, EntityType: entityType, Name: name}
+		for _, candidate := range added {
+			if _, ok := matched[candidate]; ok {
+				continue
+			}
+			if proto.Equal(from.GetActions()[name].GetChild(), to.GetActions()[candidate].GetChild()) {
+				matched[candidate] = struct{}{}
+				change.Kind = ActionRenamed
+				change.NewName = candidate
+				break
+			}
+		}
+		changes = append(changes, change)
+	}
+	for _, name := range added {
+		if _, ok := matched[name]; !ok {
+			changes = append(changes, Change{Kind: ActionAdded, EntityType: entityType, Name: name})
+		}
+	}
+	
+	return changes
+}
+
+// referenceDifference - Subject types of the references a that are not in b, sorted
+func referenceDifference(a, b []*base.RelationReference) []string {
+	keys := map[string]struct{}{}
+	for _, ref := range b {
+		keys[referenceKey(ref)] = struct{}{}
+	}
+	var difference []string
+	for _, ref := range a {
+		if _, ok := keys[referenceKey(ref)]; !ok {
+			difference = append(difference, referenceKey(ref))
+		}
+	}
+	sort.Strings(difference)
+	return difference
+}
+
+// referenceKey - Subject type of the reference in the form the tuples are validated with, e.g. user or team#member
+func referenceKey(ref *base.RelationReference) string {
+	if ref.GetRelation() == "" {
+		return ref.GetType()
+	}
+	return ref.GetType() + "#" + ref.GetRelation()
+}
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"strings"
+	
+	"github.com/adminium/permify/pkg/dsl/ast"
+	"github.com/adminium/permify/pkg/dsl/parser"
+	"github.com/adminium/permify/pkg/dsl/utils"
+)
+
+// PolicyMode - How the decision of a policy is combined with the result of the action
+type PolicyMode string
+
+const (
+	// PolicyModeAnd - Both the action and the policy must allow
+	PolicyModeAnd PolicyMode = "and"
+	// PolicyModeOr - Either the action or the policy allows
+	PolicyModeOr PolicyMode = "or"
+	// PolicyModeOverride - The policy decides, the result of the action is part of its input
+	PolicyModeOverride PolicyMode = "override"
+)
+
+// Policy - OPA policy of an action
+type Policy struct {
+	Path string
+	Mode PolicyMode
+}
+
+// Policies - Policies of the annotated actions of a schema
+// sample keys: entity_type#edit
+type Policies map[string]Policy
+
+// NewPoliciesFromStringDefinitions - Collects the policy annotations of the actions of the serialized definitions,
+// the compiled definitions have no room for them
+func NewPoliciesFromStringDefinitions(definitions ...string) (Policies, error) {
+	sch, err := parser.NewParser(strings.Join(definitions, "\n")).Parse()
+	if err != nil {
+		return nil, err
+	}
+	policies := Policies{}
+	for _, st := range sch.Statements {
+		entity, ok := st.(*ast.EntityStatement)
+		if !ok {
+			continue
+		}
+		for _, as := range entity.ActionStatements {
+			action := as.(*ast.ActionStatement)
+			if action.Policy == nil {
+				continue
+			}
+			mode := PolicyModeAnd
+			if action.Policy.Mode.Literal != "" {
+				mode = PolicyMode(action.Policy.Mode.Literal)
+			}
+			policies[utils.Key(entity.Name.Literal, action.Name.Literal)] = Policy{
+				Path: action.Policy.Path.Literal,
+				Mode: mode,
+			}
+		}
+	}
+	return policies, nil
+}
+
+// Get - Policy of the action of the entity type
+func (p Policies) Get(entityType, action string) (Policy, bool) {
+	policy, ok := p[utils.Key(entityType, action)]
+	return policy, ok
+}
@@ -0,0 +1,141 @@
+package schema
+
+import (
+	"sort"
+	"strings"
+	
+	"github.com/adminium/permify/pkg/dsl/utils"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// ToString - Prints the compiled schema back to the permify DSL.
+// Entities, relations and actions are printed in name order, so equal schemas print to the same text.
+func ToString(sch *base.SchemaDefinition) string {
+	names := make([]string, 0, len(sch.GetEntityDefinitions()))
+	for name := range sch.GetEntityDefinitions() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	
+	definitions := make([]string, 0, len(names))
+	for _, name := range names {
+		definitions = append(definitions, EntityToString(sch.GetEntityDefinitions()[name]))
+	}
+	return strings.Join(definitions, "\n")
+}
+
+// EntityToString - Prints the compiled entity definition back to the permify DSL
+func EntityToString(entity *base.EntityDefinition) string {
+	var sb strings.Builder
+	sb.WriteString("entity ")
+	sb.WriteString(entity.GetName())
+	sb.WriteString(" {\n")
+	
+	relations := make([]string, 0, len(entity.GetRelations()))
+	for name := range entity.GetRelations() {
+		relations = append(relations, name)
+	}
+	sort.Strings(relations)
+	
+	for _, name := range relations {
+		sb.WriteString("\trelation ")
+		sb.WriteString(name)
+		for _, reference := range entity.GetRelations()[name].GetRelationReferences() {
+			sb.WriteString(" @")
+			sb.WriteString(reference.GetType())
+			if reference.GetRelation() != "" {
+				sb.WriteString("#")
+				sb.WriteString(reference.GetRelation())
+			}
+		}
+		sb.WriteString("\n")
+	}
+	
+	actions := make([]string, 0, len(entity.GetActions()))
+	for name := range entity.GetActions() {
+		actions = append(actions, name)
+	}
+	sort.Strings(actions)
+	
+	if len(relations) > 0 && len(actions) > 0 {
+		sb.WriteString("\n")
+	}
+	
+	for _, name := range actions {
+		sb.WriteString("\taction ")
+		sb.WriteString(name)
+		sb.WriteString(" = ")
+		child, condition := splitCondition(entity.GetActions()[name].GetChild())
+		sb.WriteString(childToString(child, false))
+		if condition != nil {
+			sb.WriteString(" when ")
+			sb.WriteString(`"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(condition.GetExpression()) + `"`)
+		}
+		sb.WriteString("\n")
+	}
+	
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// splitCondition - The expression and the condition of an action compiled with one, they are compiled to the
+// intersection of both
+func splitCondition(child *base.Child) (*base.Child, *base.Condition) {
+	rewrite := child.GetRewrite()
+	if rewrite.GetRewriteOperation() != base.Rewrite_OPERATION_INTERSECTION || len(rewrite.GetChildren()) != 2 {
+		return child, nil
+	}
+	condition := rewrite.GetChildren()[1].GetLeaf().GetCondition()
+	if condition == nil {
+		return child, nil
+	}
+	return rewrite.GetChildren()[0], condition
+}
+
+// childToString - nested rewrites are parenthesized, so the printed expression keeps the compiled tree
+func childToString(child *base.Child, nested bool) string {
+	switch child.GetType().(type) {
+	case *base.Child_Rewrite:
+		rewrite := child.GetRewrite()
+		operator := " or "
+		if rewrite.GetRewriteOperation() == base.Rewrite_OPERATION_INTERSECTION {
+			operator = " and "
+		}
+		children := make([]string, 0, len(rewrite.GetChildren()))
+		for _, ch := range rewrite.GetChildren() {
+			children = append(children, childToString(ch, true))
+		}
+		expression := strings.Join(children, operator)
+		if nested {
+			return "(" + expression + ")"
+		}
+		return expression
+	case *base.Child_Leaf:
+		return leafToString(child.GetLeaf())
+	default:
+		return ""
+	}
+}
+
+// leafToString -
+func leafToString(leaf *base.Leaf) string {
+	var sb strings.Builder
+	if leaf.GetExclusion() {
+		sb.WriteString("not ")
+	}
+	switch leaf.GetType().(type) {
+	case *base.Leaf_TupleToUserSet:
+		sb.WriteString(leaf.GetTupleToUserSet().GetTupleSet().GetRelation())
+		sb.WriteString(".")
+		sb.WriteString(leaf.GetTupleToUserSet().GetComputed().GetRelation())
+	case *base.Leaf_ComputedUserSet:
+		if relation, caveat, ok := utils.ParseCaveatReference(leaf.GetComputedUserSet().GetRelation()); ok {
+			sb.WriteString(relation)
+			sb.WriteString(" with ")
+			sb.WriteString(caveat)
+			break
+		}
+		sb.WriteString(leaf.GetComputedUserSet().GetRelation())
+	}
+	return sb.String()
+}
@@ -0,0 +1,96 @@
+package schema
+
+import (
+	"sort"
+	
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// ReferenceKind - How a relation or action refers to the searched one
+type ReferenceKind string
+
+const (
+	// RelationTypeReference - the relation accepts subjects of the entity type, e.g. @organization or @organization#member
+	RelationTypeReference ReferenceKind = "relation_type"
+	// ComputedUserSetReference - the action reads a relation or action of its own entity, e.g. owner
+	ComputedUserSetReference ReferenceKind = "computed_user_set"
+	// TupleSetReference - the action walks the relation to other entities, e.g. parent in parent.admin
+	TupleSetReference ReferenceKind = "tuple_set"
+	// TupleToUserSetReference - the action reads a relation or action of the entities it walks to, e.g. admin in parent.admin
+	TupleToUserSetReference ReferenceKind = "tuple_to_user_set"
+)
+
+// Reference - Relation or action that refers to the searched entity type, relation or action
+type Reference struct {
+	EntityType string
+	// Name - name of the referring relation or action
+	Name string
+	Kind ReferenceKind
+}
+
+// FindReferences - Returns the relations and actions that refer to the relation or action of the entity type directly,
+// i.e. the ones that break when it is removed. An empty relation searches for references to the entity type and
+// to any of its relations and actions from the other entity types, sorted by entity type, name and kind.
+func FindReferences(schema *base.SchemaDefinition, entityType, relation string) []Reference {
+	matches := func(typ, rel string) bool {
+		return typ == entityType && (relation == "" || rel == relation)
+	}
+	
+	found := map[Reference]struct{}{}
+	for _, entity := range schema.GetEntityDefinitions() {
+		// the own relations and actions of a searched entity type are removed with it
+		own := entity.GetName() == entityType
+		
+		for name, rel := range entity.GetRelations() {
+			for _, ref := range rel.GetRelationReferences() {
+				if ref.GetType() != entityType || (relation != "" && ref.GetRelation() != relation) {
+					continue
+				}
+				found[Reference{EntityType: entity.GetName(), Name: name, Kind: RelationTypeReference}] = struct{}{}
+			}
+		}
+		
+		for name, action := range entity.GetActions() {
+			for _, leaf := range leaves(action.GetChild()) {
+				switch l := leaf.GetType().(type) {
+				case *base.Leaf_ComputedUserSet:
+					if relation != "" && matches(entity.GetName(), computedRelation(l.ComputedUserSet.GetRelation())) {
+						found[Reference{EntityType: entity.GetName(), Name: name, Kind: ComputedUserSetReference}] = struct{}{}
+					}
+				case *base.Leaf_TupleToUserSet:
+					relations, types := tupleSetReferences(schema, entity.GetName(), l.TupleToUserSet.GetTupleSet().GetRelation())
+					for _, r := range relations {
+						if relation != "" && matches(r.entityType, r.relation) {
+							found[Reference{EntityType: entity.GetName(), Name: name, Kind: TupleSetReference}] = struct{}{}
+						}
+					}
+					if relation == "" && own {
+						continue
+					}
+					for _, typ := range types {
+						for _, r := range chainReferences(schema, typ, l.TupleToUserSet.GetComputed().GetRelation()) {
+							if matches(r.entityType, r.relation) {
+								found[Reference{EntityType: entity.GetName(), Name: name, Kind: TupleToUserSetReference}] = struct{}{}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	
+	references := make([]Reference, 0, len(found))
+	for ref := range found {
+		references = append(references, ref)
+	}
+	sort.Slice(references, func(i, j int) bool {
+		if references[i].EntityType != references[j].EntityType {
+			return references[i].EntityType < references[j].EntityType
+		}
+		if references[i].Name != references[j].Name {
+			return references[i].Name < references[j].Name
+		}
+		return references[i].Kind < references[j].Kind
+	})
+	return references
+}
@@ -0,0 +1,107 @@
+package schema
+
+import (
+	"strings"
+	
+	"github.com/adminium/permify/pkg/dsl/utils"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// ReverseEdge - Edge of the reverse expansion of a permission. It leads from the entities that hold a relation or
+// action for a subject to the entities that hold the target through them.
+type ReverseEdge struct {
+	// Target - Relation or action that the entities reached by the edge hold
+	Target *base.RelationReference
+	// TupleSet - Relation of the tuples from the target entities to the source entities, empty when the target is an
+	// action of the source entities themselves
+	TupleSet string
+	// Direct - The subjects of the tuples must be the source relation, or the subject itself for the subject's own
+	// key. Tuple to user sets walk the subjects of their tuples whatever their relation.
+	Direct bool
+}
+
+// ReverseKey - Key of the reverse edges that start from the relation or action of the entity type, the entity type
+// alone for subjects without a relation
+func ReverseKey(entityType, relation string) string {
+	if relation == "" {
+		return entityType
+	}
+	return entityType + "#" + relation
+}
+
+// ReverseEdges - Returns the edges of the relations and actions that the given relation or action reads, by the
+// ReverseKey of the relation, action or subject type they start from; e.g. doc#viewer @team#member leads from
+// team#member to doc#viewer. ok is false when one of the actions intersects or excludes its children, calls a rule,
+// or walks a chain or a transitive tuple set, since then the entities that hold it are not the union of the entities
+// the subject reaches.
+func ReverseEdges(schema *base.SchemaDefinition, entityType, relation string) (edges map[string][]ReverseEdge, ok bool) {
+	graph := dependencyGraph(schema)
+	edges = map[string][]ReverseEdge{}
+	for ref := range walk(graph.edges, []reference{{entityType, relation}}) {
+		entity := schema.GetEntityDefinitions()[ref.entityType]
+		target := &base.RelationReference{Type: ref.entityType, Relation: ref.relation}
+		if r, found := entity.GetRelations()[ref.relation]; found {
+			for _, rr := range r.GetRelationReferences() {
+				key := ReverseKey(rr.GetType(), rr.GetRelation())
+				edges[key] = append(edges[key], ReverseEdge{Target: target, TupleSet: ref.relation, Direct: true})
+			}
+			continue
+		}
+		action, found := entity.GetActions()[ref.relation]
+		if !found || !unionEdges(entity, target, action.GetChild(), edges) {
+			return nil, false
+		}
+	}
+	return edges, true
+}
+
+// unionEdges - Adds the edges of the child of the action to the target, false when the child is not a union of
+// computed user sets and tuple to user sets of one relation
+func unionEdges(entity *base.EntityDefinition, target *base.RelationReference, child *base.Child, edges map[string][]ReverseEdge) bool {
+	switch child.GetType().(type) {
+	case *base.Child_Rewrite:
+		if child.GetRewrite().GetRewriteOperation() != base.Rewrite_OPERATION_UNION {
+			return false
+		}
+		for _, c := range child.GetRewrite().GetChildren() {
+			if !unionEdges(entity, target, c, edges) {
+				return false
+			}
+		}
+		return true
+	case *base.Child_Leaf:
+		if child.GetLeaf().GetExclusion() {
+			return false
+		}
+		switch leaf := child.GetLeaf().GetType().(type) {
+		case *base.Leaf_ComputedUserSet:
+			if _, _, ok := utils.ParseRuleReference(leaf.ComputedUserSet.GetRelation()); ok {
+				return false
+			}
+			// the payloads of the tuples are evaluated with the context of a check, which a walk backwards has not
+			if _, _, ok := utils.ParseCaveatReference(leaf.ComputedUserSet.GetRelation()); ok {
+				return false
+			}
+			key := ReverseKey(entity.GetName(), leaf.ComputedUserSet.GetRelation())
+			edges[key] = append(edges[key], ReverseEdge{Target: target})
+			return true
+		case *base.Leaf_TupleToUserSet:
+			tupleSet, computed := leaf.TupleToUserSet.GetTupleSet().GetRelation(), leaf.TupleToUserSet.GetComputed().GetRelation()
+			if _, transitive := utils.ParseTransitiveReference(tupleSet); transitive || strings.Contains(computed, ".") {
+				return false
+			}
+			// subjects of several relations of one type are walked once
+			types := map[string]bool{}
+			for _, ref := range entity.GetRelations()[tupleSet].GetRelationReferences() {
+				if types[ref.GetType()] {
+					continue
+				}
+				types[ref.GetType()] = true
+				key := ReverseKey(ref.GetType(), computed)
+				edges[key] = append(edges[key], ReverseEdge{Target: target, TupleSet: tupleSet})
+			}
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,194 @@
+package schema
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	
+	"google.golang.org/protobuf/types/known/structpb"
+	
+	"github.com/adminium/permify/pkg/dsl/ast"
+	"github.com/adminium/permify/pkg/dsl/parser"
+	"github.com/adminium/permify/pkg/dsl/token"
+	"github.com/adminium/permify/pkg/dsl/utils"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// Rules - Rules and attribute types of a schema. The compiled definitions only carry the calls of the rules,
+// so the rules are evaluated from their statements.
+type Rules struct {
+	rules map[string]*ast.RuleStatement
+	// attribute types
+	// sample keys: entity_type#visibility
+	attributes map[string]string
+}
+
+// NewRulesFromStringDefinitions - Collects the rules and attribute types of the serialized definitions
+func NewRulesFromStringDefinitions(definitions ...string) (*Rules, error) {
+	sch, err := parser.NewParser(strings.Join(definitions, "\n")).Parse()
+	if err != nil {
+		return nil, err
+	}
+	rules := &Rules{
+		rules:      map[string]*ast.RuleStatement{},
+		attributes: map[string]string{},
+	}
+	for _, st := range sch.Statements {
+		switch statement := st.(type) {
+		case *ast.RuleStatement:
+			rules.rules[statement.Name.Literal] = statement
+		case *ast.EntityStatement:
+			for _, as := range statement.AttributeStatements {
+				attribute := as.(*ast.AttributeStatement)
+				rules.attributes[utils.Key(statement.Name.Literal, attribute.Name.Literal)] = attribute.AttributeType.Literal
+			}
+		}
+	}
+	return rules, nil
+}
+
+// AttributeType - Type of the attribute of the entity type
+func (r *Rules) AttributeType(entityType, attribute string) (string, bool) {
+	typ, ok := r.attributes[utils.Key(entityType, attribute)]
+	return typ, ok
+}
+
+// Evaluate - Evaluates the rule with the values of its arguments, in the order of the arguments
+func (r *Rules) Evaluate(rule string, values []*structpb.Value) (bool, error) {
+	rs, ok := r.rules[rule]
+	if !ok || len(rs.Arguments) != len(values) {
+		return false, errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_RELATION_REFERENCE.String())
+	}
+	env := make(map[string]interface{}, len(values))
+	for i, argument := range rs.Arguments {
+		if err := ValidateAttributeValue(argument.Type.Literal, values[i]); err != nil {
+			return false, err
+		}
+		env[argument.Name.Literal] = values[i].AsInterface()
+	}
+	result, err := evaluate(rs.Expression, env)
+	if err != nil {
+		return false, err
+	}
+	can, ok := result.(bool)
+	if !ok {
+		return false, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+	}
+	return can, nil
+}
+
+// ValidateAttributeValue - The value has the type of the attribute, integers are whole numbers
+func ValidateAttributeValue(typ string, value *structpb.Value) error {
+	var ok bool
+	switch typ {
+	case "string":
+		_, ok = value.GetKind().(*structpb.Value_StringValue)
+	case "boolean":
+		_, ok = value.GetKind().(*structpb.Value_BoolValue)
+	case "integer":
+		_, ok = value.GetKind().(*structpb.Value_NumberValue)
+		ok = ok && value.GetNumberValue() == math.Trunc(value.GetNumberValue())
+	case "double":
+		_, ok = value.GetKind().(*structpb.Value_NumberValue)
+	}
+	if !ok {
+		return errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+	}
+	return nil
+}
+
+// evaluate - Value of an expression of a rule: a string, a bool or a float64 for both numeric types
+func evaluate(expression ast.Expression, env map[string]interface{}) (interface{}, error) {
+	switch exp := expression.(type) {
+	case *ast.Literal:
+		switch exp.Token.Type {
+		case token.STRING:
+			return exp.Token.Literal, nil
+		case token.TRUE:
+			return true, nil
+		case token.FALSE:
+			return false, nil
+		default:
+			v, err := strconv.ParseFloat(exp.Token.Literal, 64)
+			if err != nil {
+				return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+			}
+			return v, nil
+		}
+	case *ast.Identifier:
+		v, ok := env[exp.Idents[0].Literal]
+		if !ok {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_RELATION_REFERENCE.String())
+		}
+		if exp.IsPrefix() {
+			b, ok := v.(bool)
+			if !ok {
+				return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+			}
+			return !b, nil
+		}
+		return v, nil
+	case *ast.InfixExpression:
+		left, err := evaluate(exp.Left, env)
+		if err != nil {
+			return nil, err
+		}
+		// and and or do not evaluate their right side when the left one decides
+		switch exp.Operator {
+		case ast.AND, ast.OR:
+			l, ok := left.(bool)
+			if !ok {
+				return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+			}
+			if (exp.Operator == ast.AND && !l) || (exp.Operator == ast.OR && l) {
+				return l, nil
+			}
+			var right interface{}
+			right, err = evaluate(exp.Right, env)
+			if err != nil {
+				return nil, err
+			}
+			r, ok := right.(bool)
+			if !ok {
+				return nil, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+			}
+			return r, nil
+		}
+		var right interface{}
+		right, err = evaluate(exp.Right, env)
+		if err != nil {
+			return nil, err
+		}
+		return compare(exp.Operator, left, right)
+	default:
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_CHILD_KIND.String())
+	}
+}
+
+// compare -
+func compare(operator ast.Operator, left, right interface{}) (bool, error) {
+	switch operator {
+	case ast.EQ:
+		return left == right, nil
+	case ast.NEQ:
+		return left != right, nil
+	}
+	l, lok := left.(float64)
+	r, rok := right.(float64)
+	if !lok || !rok {
+		return false, errors.New(base.ErrorCode_ERROR_CODE_TYPE_CONVERSATION.String())
+	}
+	switch operator {
+	case ast.LT:
+		return l < r, nil
+	case ast.LTE:
+		return l <= r, nil
+	case ast.GT:
+		return l > r, nil
+	case ast.GTE:
+		return l >= r, nil
+	default:
+		return false, errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_CHILD_KIND.String())
+	}
+}
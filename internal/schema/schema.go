@@ -57,19 +57,25 @@ func GetEntityByName(schema *base.SchemaDefinition, name string) (entityDefiniti
 	return nil, errors.New(base.ErrorCode_ERROR_CODE_ENTITY_DEFINITION_NOT_FOUND.String())
 }
 
-// GetTypeOfRelationalReferenceByNameInEntityDefinition -
+// GetTypeOfRelationalReferenceByNameInEntityDefinition - Chains of relations, e.g. parent.admin, are actions
 func GetTypeOfRelationalReferenceByNameInEntityDefinition(entityDefinition *base.EntityDefinition, name string) (relationalDefinitionType base.EntityDefinition_RelationalReference, err error) {
 	if re, ok := entityDefinition.GetReferences()[name]; ok {
 		return re, nil
 	}
+	if _, ok := relationChain(entityDefinition, name); ok {
+		return base.EntityDefinition_RELATIONAL_REFERENCE_ACTION, nil
+	}
 	return base.EntityDefinition_RELATIONAL_REFERENCE_UNSPECIFIED, errors.New(base.ErrorCode_ERROR_CODE_RELATION_DEFINITION_NOT_FOUND.String())
 }
 
-// GetActionByNameInEntityDefinition -
+// GetActionByNameInEntityDefinition - Chains of relations, e.g. parent.admin, are actions that walk their first relation
 func GetActionByNameInEntityDefinition(entityDefinition *base.EntityDefinition, name string) (actionDefinition *base.ActionDefinition, err error) {
 	if re, ok := entityDefinition.GetActions()[name]; ok {
 		return re, nil
 	}
+	if action, ok := relationChain(entityDefinition, name); ok {
+		return action, nil
+	}
 	return nil, errors.New(base.ErrorCode_ERROR_CODE_ACTION_DEFINITION_NOT_FOUND.String())
 }
 
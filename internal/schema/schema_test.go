@@ -5,6 +5,7 @@ import (
 	
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/structpb"
 	
 	base "github.com/adminium/permify/pkg/pb/base/v1"
 )
@@ -325,4 +326,566 @@ var _ = Describe("schema", func() {
 			}))
 		})
 	})
+	
+	Context("Dependencies", func() {
+		It("Case 1", func() {
+			sch, err := NewSchemaFromStringDefinitions(true, `
+entity user {}
+
+entity organization {
+	relation admin @user
+	relation member @user
+}
+
+entity repository {
+	relation parent @organization
+	relation owner @user @organization#member
+	relation viewer @user
+	
+	action edit = owner or parent.admin
+	action delete = edit and not viewer
+	action read = viewer
+}
+`)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			Expect(Dependents(sch, "organization", "admin")).Should(Equal([]*base.RelationReference{
+				{Type: "organization", Relation: "admin"},
+				{Type: "repository", Relation: "delete"},
+				{Type: "repository", Relation: "edit"},
+			}))
+			
+			Expect(Dependents(sch, "organization", "member")).Should(Equal([]*base.RelationReference{
+				{Type: "organization", Relation: "member"},
+				{Type: "repository", Relation: "delete"},
+				{Type: "repository", Relation: "edit"},
+				{Type: "repository", Relation: "owner"},
+			}))
+			
+			Expect(Dependents(sch, "repository", "viewer")).Should(Equal([]*base.RelationReference{
+				{Type: "repository", Relation: "delete"},
+				{Type: "repository", Relation: "read"},
+				{Type: "repository", Relation: "viewer"},
+			}))
+			
+			dependencies, unrestricted := Dependencies(sch, "repository", "edit")
+			Expect(unrestricted).Should(BeFalse())
+			Expect(dependencies).Should(Equal([]*base.RelationReference{
+				{Type: "organization", Relation: "admin"},
+				{Type: "organization", Relation: "member"},
+				{Type: "repository", Relation: "edit"},
+				{Type: "repository", Relation: "owner"},
+				{Type: "repository", Relation: "parent"},
+			}))
+			
+			_, unrestricted = Dependencies(sch, "repository", "delete")
+			Expect(unrestricted).Should(BeTrue())
+			
+			types, unrestricted := SubjectTypes(sch, "repository", "edit")
+			Expect(unrestricted).Should(BeFalse())
+			Expect(types).Should(Equal([]string{"organization#member", "user"}))
+			
+			_, unrestricted = SubjectTypes(sch, "repository", "delete")
+			Expect(unrestricted).Should(BeTrue())
+			
+			// the owners of the members of the organizations and the admins of the parents edit
+			edges, ok := ReverseEdges(sch, "repository", "edit")
+			Expect(ok).Should(BeTrue())
+			Expect(edges["organization#member"]).Should(Equal([]ReverseEdge{
+				{Target: &base.RelationReference{Type: "repository", Relation: "owner"}, TupleSet: "owner", Direct: true},
+			}))
+			Expect(edges["organization#admin"]).Should(Equal([]ReverseEdge{
+				{Target: &base.RelationReference{Type: "repository", Relation: "edit"}, TupleSet: "parent"},
+			}))
+			Expect(edges["repository#owner"]).Should(Equal([]ReverseEdge{
+				{Target: &base.RelationReference{Type: "repository", Relation: "edit"}},
+			}))
+			
+			_, ok = ReverseEdges(sch, "repository", "delete")
+			Expect(ok).Should(BeFalse())
+		})
+		
+		It("Case 2: Relation chains", func() {
+			sch, err := NewSchemaFromStringDefinitions(true, `
+entity user {}
+
+entity holding {
+	relation admin @user
+}
+
+entity organization {
+	relation parent @holding
+}
+
+entity repository {
+	relation parent @organization
+	
+	action update = parent.parent.admin
+}
+`)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			Expect(Dependents(sch, "holding", "admin")).Should(Equal([]*base.RelationReference{
+				{Type: "holding", Relation: "admin"},
+				{Type: "repository", Relation: "update"},
+			}))
+			
+			dependencies, _ := Dependencies(sch, "repository", "update")
+			Expect(dependencies).Should(Equal([]*base.RelationReference{
+				{Type: "holding", Relation: "admin"},
+				{Type: "organization", Relation: "parent"},
+				{Type: "repository", Relation: "parent"},
+				{Type: "repository", Relation: "update"},
+			}))
+			
+			// the parents are only walked, their subjects are never the subject of the check
+			types, _ := SubjectTypes(sch, "repository", "update")
+			Expect(types).Should(Equal([]string{"user"}))
+			
+			Expect(FindReferences(sch, "organization", "parent")).Should(Equal([]Reference{
+				{EntityType: "repository", Name: "update", Kind: TupleToUserSetReference},
+			}))
+		})
+		
+		It("Case 3: Transitive relations", func() {
+			sch, err := NewSchemaFromStringDefinitions(true, `
+entity user {}
+
+entity folder {
+	relation parent @folder
+	relation viewer @user
+	
+	action view = viewer or parent*.viewer
+}
+
+entity doc {
+	relation parent @folder
+	
+	action view = parent*.viewer
+}
+`)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			Expect(Dependents(sch, "folder", "viewer")).Should(Equal([]*base.RelationReference{
+				{Type: "doc", Relation: "view"},
+				{Type: "folder", Relation: "view"},
+				{Type: "folder", Relation: "viewer"},
+			}))
+			
+			// the parents of the folders are walked too
+			dependencies, _ := Dependencies(sch, "doc", "view")
+			Expect(dependencies).Should(Equal([]*base.RelationReference{
+				{Type: "doc", Relation: "parent"},
+				{Type: "doc", Relation: "view"},
+				{Type: "folder", Relation: "parent"},
+				{Type: "folder", Relation: "viewer"},
+			}))
+			
+			_, ok := ReverseEdges(sch, "doc", "view")
+			Expect(ok).Should(BeFalse())
+			
+			Expect(ToString(sch)).Should(ContainSubstring("action view = viewer or parent*.viewer"))
+		})
+		
+		It("Case 4: Rules", func() {
+			sch, err := NewSchemaFromStringDefinitions(true,
+				"entity user {}",
+				"entity repository {\n\tattribute visibility string\n\trelation owner @user\n\taction read = owner or is_public(visibility)\n\taction edit = owner\n}",
+				"rule is_public(visibility string) {\n\tvisibility == \"public\"\n}",
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			// a public repository is read by subjects without any tuple
+			_, unrestricted := Dependencies(sch, "repository", "read")
+			Expect(unrestricted).Should(BeTrue())
+			
+			_, unrestricted = SubjectTypes(sch, "repository", "read")
+			Expect(unrestricted).Should(BeTrue())
+			
+			_, unrestricted = Dependencies(sch, "repository", "edit")
+			Expect(unrestricted).Should(BeFalse())
+		})
+	})
+	
+	Context("ToString", func() {
+		It("Case 1", func() {
+			sch, err := NewSchemaFromStringDefinitions(true, `
+			entity user {}
+			
+			entity organization {
+				relation admin @user
+				relation member @user
+			}
+			
+			entity repository {
+				relation parent @organization
+				relation owner @user @organization#admin
+				relation viewer @user
+				
+				action edit = owner or parent.admin and not viewer
+				action delete = (owner or viewer) and (parent.member or parent.admin)
+			}
+			`)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			Expect(ToString(sch)).Should(Equal("entity organization {\n" +
+				"\trelation admin @user\n" +
+				"\trelation member @user\n" +
+				"}\n" +
+				"\n" +
+				"entity repository {\n" +
+				"\trelation owner @user @organization#admin\n" +
+				"\trelation parent @organization\n" +
+				"\trelation viewer @user\n" +
+				"\n" +
+				"\taction delete = (owner or viewer) and (parent.member or parent.admin)\n" +
+				"\taction edit = (owner or parent.admin) and not viewer\n" +
+				"}\n" +
+				"\n" +
+				"entity user {\n" +
+				"}\n"))
+			
+			printed, err := NewSchemaFromStringDefinitions(true, ToString(sch))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(printed).Should(Equal(sch))
+		})
+		
+		It("Case 2: Conditions", func() {
+			sch, err := NewSchemaFromStringDefinitions(true, `
+			entity user {}
+			
+			entity repository {
+				relation owner @user
+				relation viewer @user
+				
+				action read = owner or viewer when "context.hour >= 9 && context.hour < 18"
+			}
+			`)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ToString(sch)).Should(ContainSubstring("\taction read = owner or viewer when \"context.hour >= 9 && context.hour < 18\"\n"))
+			
+			printed, err := NewSchemaFromStringDefinitions(true, ToString(sch))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(printed).Should(Equal(sch))
+		})
+	})
+	
+	Context("Deprecations", func() {
+		It("Case 1", func() {
+			definitions := []string{`
+			entity user {}
+			
+			entity organization {
+				relation admin @user
+				deprecated relation member @user
+			}
+			
+			entity repository {
+				relation parent @organization
+				relation owner @user @organization#member
+				deprecated relation maintainer @user
+				
+				action push = owner or maintainer
+				action read = push or parent.member
+				deprecated action write = maintainer
+			}
+			`}
+			
+			deprecations, err := NewDeprecationsFromStringDefinitions(definitions...)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			Expect(deprecations.IsDeprecated("repository", "maintainer")).Should(BeTrue())
+			Expect(deprecations.IsDeprecated("repository", "owner")).Should(BeFalse())
+			Expect(deprecations.References()).Should(Equal([]*base.RelationReference{
+				{Type: "organization", Relation: "member"},
+				{Type: "repository", Relation: "maintainer"},
+				{Type: "repository", Relation: "write"},
+			}))
+			
+			sch, err := NewSchemaFromStringDefinitions(true, definitions...)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			var usages []string
+			for _, usage := range DeprecatedUsages(sch, deprecations) {
+				usages = append(usages, usage.String())
+			}
+			Expect(usages).Should(Equal([]string{
+				"repository#owner uses deprecated organization#member",
+				"repository#push uses deprecated repository#maintainer",
+				"repository#read uses deprecated organization#member",
+			}))
+		})
+	})
+	
+	Context("Aliases", func() {
+		It("Case 1", func() {
+			aliases, err := NewAliasesFromStringDefinitions(`
+			entity user {}
+			
+			entity document (alias doc, file) {
+				relation owner @user
+			}
+			`)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			entityType, ok := aliases.Resolve("doc")
+			Expect(ok).Should(BeTrue())
+			Expect(entityType).Should(Equal("document"))
+			entityType, ok = aliases.Resolve("document")
+			Expect(ok).Should(BeFalse())
+			Expect(entityType).Should(Equal("document"))
+			Expect(aliases.Of("document")).Should(Equal([]string{"doc", "file"}))
+			Expect(aliases.Of("user")).Should(BeEmpty())
+			Expect(aliases.Warnings()).Should(HaveLen(2))
+			
+			_, err = NewSchemaFromStringDefinitions(true, `
+			entity user {}
+			
+			entity document (alias doc) {
+				relation owner @user
+			}
+			
+			entity folder {
+				relation child @doc
+			}
+			`)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+	
+	Context("Completion", func() {
+		It("Case 1", func() {
+			definitions := []string{`
+			// a person
+			entity user {}
+			
+			// section comments are not docs
+			
+			// a git repository
+			entity repository {
+				// the organization the repository belongs to
+				relation parent @organization
+				relation owner @user @organization#member // trailing comments are not docs
+				// replaced by owner
+				// removed in the next release
+				deprecated relation maintainer @user
+				
+				// who can push commits
+				action push = owner or maintainer
+				deprecated action write = maintainer
+			}
+			
+			entity organization {
+				relation member @user
+			}
+			`}
+			
+			docs, err := NewDocsFromStringDefinitions(definitions...)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(docs).Should(Equal(Docs{
+				"user":                  "a person",
+				"repository":            "a git repository",
+				"repository#parent":     "the organization the repository belongs to",
+				"repository#maintainer": "replaced by owner\nremoved in the next release",
+				"repository#push":       "who can push commits",
+			}))
+			
+			deprecations, err := NewDeprecationsFromStringDefinitions(definitions...)
+			Expect(err).ShouldNot(HaveOccurred())
+			sch, err := NewSchemaFromStringDefinitions(true, definitions...)
+			Expect(err).ShouldNot(HaveOccurred())
+			entity, err := GetEntityByName(sch, "repository")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			Expect(NewCompletion(entity, deprecations, docs)).Should(Equal(Completion{
+				EntityType: "repository",
+				Doc:        "a git repository",
+				Relations: []RelationCompletion{
+					{Name: "maintainer", Doc: "replaced by owner\nremoved in the next release", Deprecated: true, SubjectTypes: []string{"user"}},
+					{Name: "owner", SubjectTypes: []string{"user", "organization#member"}},
+					{Name: "parent", Doc: "the organization the repository belongs to", SubjectTypes: []string{"organization"}},
+				},
+				Permissions: []PermissionCompletion{
+					{Name: "push", Doc: "who can push commits"},
+					{Name: "write", Deprecated: true},
+				},
+			}))
+		})
+	})
+	
+	Context("FindReferences", func() {
+		sch, err := NewSchemaFromStringDefinitions(true, `
+		entity user {}
+		
+		entity organization {
+			relation admin @user
+			relation member @user
+		}
+		
+		entity repository {
+			relation parent @organization
+			relation owner @user @organization#admin
+			relation viewer @user
+			
+			action edit = owner or parent.admin and not viewer
+			action read = edit or viewer or parent.member
+		}
+		`)
+		
+		It("Case 1: Relation", func() {
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(FindReferences(sch, "organization", "admin")).Should(Equal([]Reference{
+				{EntityType: "repository", Name: "edit", Kind: TupleToUserSetReference},
+				{EntityType: "repository", Name: "owner", Kind: RelationTypeReference},
+			}))
+			Expect(FindReferences(sch, "repository", "parent")).Should(Equal([]Reference{
+				{EntityType: "repository", Name: "edit", Kind: TupleSetReference},
+				{EntityType: "repository", Name: "read", Kind: TupleSetReference},
+			}))
+			Expect(FindReferences(sch, "repository", "edit")).Should(Equal([]Reference{
+				{EntityType: "repository", Name: "read", Kind: ComputedUserSetReference},
+			}))
+			Expect(FindReferences(sch, "organization", "owner")).Should(BeEmpty())
+		})
+
+		It("Case 2: Entity type", func() {
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(FindReferences(sch, "organization", "")).Should(Equal([]Reference{
+				{EntityType: "repository", Name: "edit", Kind: TupleToUserSetReference},
+				{EntityType: "repository", Name: "owner", Kind: RelationTypeReference},
+				{EntityType: "repository", Name: "parent", Kind: RelationTypeReference},
+				{EntityType: "repository", Name: "read", Kind: TupleToUserSetReference},
+			}))
+			Expect(FindReferences(sch, "repository", "")).Should(BeEmpty())
+			Expect(FindReferences(sch, "user", "")).Should(HaveLen(4))
+		})
+	})
+	
+	Context("Rules", func() {
+		rules, err := NewRulesFromStringDefinitions(
+			"entity repository {\n\tattribute visibility string\n\tattribute stars integer\n\tattribute archived boolean\n}",
+			"rule is_public(visibility string) {\n\tvisibility == \"public\"\n}",
+			"rule featured(stars integer, archived boolean) {\n\tstars >= 100 and not archived or stars == -1\n}",
+		)
+		
+		It("Case 1: Attribute types", func() {
+			Expect(err).ShouldNot(HaveOccurred())
+			typ, ok := rules.AttributeType("repository", "stars")
+			Expect(ok).Should(BeTrue())
+			Expect(typ).Should(Equal("integer"))
+			_, ok = rules.AttributeType("repository", "owner")
+			Expect(ok).Should(BeFalse())
+		})
+
+		It("Case 2: Evaluate", func() {
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(rules.Evaluate("is_public", []*structpb.Value{structpb.NewStringValue("public")})).Should(BeTrue())
+			Expect(rules.Evaluate("is_public", []*structpb.Value{structpb.NewStringValue("private")})).Should(BeFalse())
+			Expect(rules.Evaluate("featured", []*structpb.Value{structpb.NewNumberValue(120), structpb.NewBoolValue(false)})).Should(BeTrue())
+			Expect(rules.Evaluate("featured", []*structpb.Value{structpb.NewNumberValue(120), structpb.NewBoolValue(true)})).Should(BeFalse())
+			Expect(rules.Evaluate("featured", []*structpb.Value{structpb.NewNumberValue(-1), structpb.NewBoolValue(true)})).Should(BeTrue())
+		})
+		
+		It("Case 3: Values must have the types of the arguments", func() {
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err := rules.Evaluate("is_public", []*structpb.Value{structpb.NewBoolValue(true)})
+			Expect(err).Should(HaveOccurred())
+			_, err = rules.Evaluate("featured", []*structpb.Value{structpb.NewNumberValue(1.5), structpb.NewBoolValue(true)})
+			Expect(err).Should(HaveOccurred())
+			_, err = rules.Evaluate("is_public", nil)
+			Expect(err).Should(HaveOccurred())
+			_, err = rules.Evaluate("is_private", []*structpb.Value{structpb.NewStringValue("public")})
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+	
+	Context("Conditions", func() {
+		It("Case 1: Evaluate", func() {
+			conditions, err := NewConditions()
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			data, err := structpb.NewStruct(map[string]interface{}{"hour": 10})
+			Expect(err).ShouldNot(HaveOccurred())
+			entity := &base.Entity{Type: "repository", Id: "1"}
+			subject := &base.Subject{Type: "user", Id: "1"}
+			
+			Expect(conditions.Evaluate("context.hour < 18", data, entity, subject)).Should(BeTrue())
+			Expect(conditions.Evaluate("context.hour < 9", data, entity, subject)).Should(BeFalse())
+			Expect(conditions.Evaluate("entity.id == subject.id && subject.type == 'user'", nil, entity, subject)).Should(BeTrue())
+			
+			// a key the context does not have does not satisfy the condition
+			Expect(conditions.Evaluate("context.ip == '10.0.0.1'", data, entity, subject)).Should(BeFalse())
+			
+			_, err = conditions.Evaluate("context.hour <", data, entity, subject)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+	
+	Context("Diff", func() {
+		from, err := NewSchemaFromStringDefinitions(true, `
+		entity user {}
+		
+		entity team {
+			relation member @user
+		}
+		
+		entity organization {
+			relation admin @user
+			relation member @user @team#member
+			relation guest @user
+			
+			action delete = admin
+			action view = member or admin
+		}
+		`)
+		
+		It("Case 1: Changes", func() {
+			Expect(err).ShouldNot(HaveOccurred())
+			to, err := NewSchemaFromStringDefinitions(true, `
+			entity user {}
+			
+			entity organization {
+				relation admin @user
+				relation member @user
+				relation owner @user
+				
+				action remove = admin
+				action view = member or admin or owner
+			}
+			`)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			changes := Diff(from, to)
+			Expect(changes).Should(Equal([]Change{
+				{Kind: ActionRenamed, EntityType: "organization", Name: "delete", NewName: "remove"},
+				{Kind: RelationRemoved, EntityType: "organization", Name: "guest"},
+				{Kind: RelationReferencesChanged, EntityType: "organization", Name: "member", RemovedReferences: []string{"team#member"}},
+				{Kind: RelationAdded, EntityType: "organization", Name: "owner"},
+				{Kind: ActionChanged, EntityType: "organization", Name: "view"},
+				{Kind: EntityRemoved, EntityType: "team"},
+			}))
+			Expect(HasBreakingChanges(changes)).Should(BeTrue())
+			
+			var orphans []string
+			for _, change := range changes {
+				if change.Orphans() {
+					orphans = append(orphans, change.EntityType+"#"+change.Name)
+				}
+			}
+			Expect(orphans).Should(Equal([]string{"organization#guest", "organization#member", "team#"}))
+		})
+		
+		It("Case 2: Additions are not breaking", func() {
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(Diff(from, from)).Should(BeEmpty())
+			
+			changes := Diff(&base.SchemaDefinition{}, from)
+			Expect(changes).Should(Equal([]Change{
+				{Kind: EntityAdded, EntityType: "organization"},
+				{Kind: EntityAdded, EntityType: "team"},
+				{Kind: EntityAdded, EntityType: "user"},
+			}))
+			Expect(HasBreakingChanges(changes)).Should(BeFalse())
+		})
+	})
 })
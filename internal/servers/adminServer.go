@@ -0,0 +1,538 @@
+package servers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+	
+	otelCodes "go.opentelemetry.io/otel/codes"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/internal/services"
+	"github.com/adminium/permify/internal/warmup"
+	"github.com/adminium/permify/pkg/logger"
+	v1 "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+// _defaultUsageLimit - Number of entries of each usage statistic of the requests that do not give a limit
+const _defaultUsageLimit = 10
+
+// AdminServer - Operational endpoints for on-call engineers
+type AdminServer struct {
+	v1.UnimplementedAdminServer
+	
+	adminService      *services.AdminService
+	permissionService services.IPermissionService
+	logger            logger.Interface
+}
+
+// NewAdminServer - Creates new Admin Server
+func NewAdminServer(a *services.AdminService, p services.IPermissionService, l logger.Interface) *AdminServer {
+	return &AdminServer{
+		adminService:      a,
+		permissionService: p,
+		logger:            l,
+	}
+}
+
+// RefreshTenant - Flushes the caches of the tenant, re-reads its head schema and verifies the storage.
+// The diagnostics are returned even when a step fails, ok tells whether all of them succeeded.
+func (r *AdminServer) RefreshTenant(ctx context.Context, request *v1.AdminRefreshTenantRequest) (*v1.AdminRefreshTenantResponse, error) {
+	ctx, span := tracer.Start(ctx, "admin.refresh-tenant")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	diagnostics, ok := r.adminService.RefreshTenant(ctx, request.GetTenantId())
+	
+	steps := make([]*v1.AdminDiagnostic, 0, len(diagnostics))
+	for _, d := range diagnostics {
+		steps = append(steps, &v1.AdminDiagnostic{
+			Step:       d.Step,
+			Ok:         d.Ok,
+			Message:    d.Message,
+			DurationMs: milliseconds(d.Duration),
+		})
+		if !d.Ok {
+			r.logger.Warn("refresh of tenant %s: %s failed: %s", request.GetTenantId(), d.Step, d.Message)
+		}
+	}
+	
+	return &v1.AdminRefreshTenantResponse{
+		TenantId:    request.GetTenantId(),
+		Ok:          ok,
+		Diagnostics: steps,
+	}, nil
+}
+
+// ExplainQuery - Query the storage runs for a tuple filter: the generated sql and its plan on postgres, the index
+// that is looked up on memory. full_scan tells whether every tuple of the table is read to answer the filter.
+func (r *AdminServer) ExplainQuery(ctx context.Context, request *v1.AdminExplainQueryRequest) (*v1.AdminExplainQueryResponse, error) {
+	ctx, span := tracer.Start(ctx, "admin.explain-query")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	tenantID := request.GetTenantId()
+	filter := request.GetFilter()
+	if filter == nil {
+		filter = &v1.TupleFilter{}
+	}
+	
+	plan, err := r.adminService.ExplainQuery(ctx, tenantID, filter, request.GetSnapToken())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		if errors.Is(err, services.ErrQueryExplainUnsupported) {
+			return nil, status.Error(codes.Unimplemented, err.Error())
+		}
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	
+	if plan.FullScan {
+		r.logger.Warn("filter %s of tenant %s reads every tuple of the %s storage", protojson.Format(filter), tenantID, plan.Engine)
+	}
+	
+	return &v1.AdminExplainQueryResponse{
+		TenantId: tenantID,
+		Engine:   plan.Engine,
+		Index:    plan.Index,
+		Query:    plan.Query,
+		Args:     plan.Args,
+		Plan:     plan.Plan,
+		FullScan: plan.FullScan,
+	}, nil
+}
+
+// Usage - Modeling hotspots of the tenant: the most checked permissions and the slowest check shapes by their p99
+// latency in the rolling window, and the entities with the most tuples
+func (r *AdminServer) Usage(ctx context.Context, request *v1.AdminUsageRequest) (*v1.AdminUsageResponse, error) {
+	ctx, span := tracer.Start(ctx, "admin.usage")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	tenantID := request.GetTenantId()
+	limit := int(request.GetLimit())
+	if limit == 0 {
+		limit = _defaultUsageLimit
+	}
+	
+	report, err := r.adminService.Usage(ctx, tenantID, limit)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		if errors.Is(err, services.ErrUsageDisabled) {
+			return nil, status.Error(codes.Unimplemented, err.Error())
+		}
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	
+	permissions := make([]*v1.AdminPermissionUsage, 0, len(report.Permissions))
+	for _, p := range report.Permissions {
+		permissions = append(permissions, &v1.AdminPermissionUsage{
+			Permission: p.Permission,
+			Count:      p.Count,
+		})
+	}
+	shapes := make([]*v1.AdminCheckShapeUsage, 0, len(report.Shapes))
+	for _, s := range report.Shapes {
+		shapes = append(shapes, &v1.AdminCheckShapeUsage{
+			Shape: s.Shape,
+			Count: s.Count,
+			P99Ms: milliseconds(s.P99),
+		})
+	}
+	entities := make([]*v1.AdminEntityUsage, 0, len(report.Entities))
+	for _, e := range report.Entities {
+		entities = append(entities, &v1.AdminEntityUsage{
+			Entity: e.Entity,
+			Tuples: e.Tuples,
+		})
+	}
+	
+	return &v1.AdminUsageResponse{
+		TenantId:          tenantID,
+		WindowSeconds:     report.Window.Seconds(),
+		Checks:            report.Checks,
+		Permissions:       permissions,
+		SlowestChecks:     shapes,
+		Entities:          entities,
+		EntitiesTruncated: report.Truncated,
+	}, nil
+}
+
+// MigrateTenant - Copies the tenant to the backend of the engine at the uri and verifies it by the number of tuples
+// and the checksum of a sample of them on both sides. The report is returned with verified false when they differ.
+func (r *AdminServer) MigrateTenant(ctx context.Context, request *v1.AdminMigrateTenantRequest) (*v1.AdminMigrateTenantResponse, error) {
+	ctx, span := tracer.Start(ctx, "admin.migrate-tenant")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	tenantID, engine := request.GetTenantId(), request.GetEngine()
+	report, err := r.adminService.MigrateTenant(ctx, tenantID, engine, request.GetUri(), int(request.GetSampleRate()))
+	if err != nil && !errors.Is(err, services.ErrMigrationVerification) {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		switch {
+		case errors.Is(err, services.ErrBackendMigrationDisabled):
+			return nil, status.Error(codes.Unimplemented, err.Error())
+		case errors.Is(err, services.ErrMigrationTargetNotEmpty):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	if err != nil {
+		r.logger.Warn("migration of tenant %s to %s: %s", tenantID, engine, err.Error())
+	}
+	
+	return &v1.AdminMigrateTenantResponse{
+		TenantId:       tenantID,
+		Engine:         engine,
+		SchemaVersions: report.Versions,
+		SnapToken:      report.SnapToken,
+		Tuples:         uint64(report.Tuples),
+		TargetTuples:   uint64(report.TargetTuples),
+		Sampled:        uint64(report.Sampled),
+		Checksum:       strconv.FormatUint(report.Checksum, 16),
+		TargetChecksum: strconv.FormatUint(report.TargetChecksum, 16),
+		Verified:       report.Verified(),
+	}, nil
+}
+
+// CollectGarbage - Deletes the tuples of the storage that were deleted before the window of the garbage collection
+// and reports how many were deleted, the ones deleted before a failure included
+func (r *AdminServer) CollectGarbage(ctx context.Context, _ *v1.AdminCollectGarbageRequest) (*v1.AdminCollectGarbageResponse, error) {
+	ctx, span := tracer.Start(ctx, "admin.collect-garbage")
+	defer span.End()
+	
+	result, err := r.adminService.CollectGarbage(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		if errors.Is(err, services.ErrGarbageCollectionDisabled) {
+			return nil, status.Error(codes.Unimplemented, err.Error())
+		}
+		r.logger.Error(fmt.Sprintf("garbage collection failed after deleting %d tuples: %s", result.Deleted, err.Error()))
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	
+	return &v1.AdminCollectGarbageResponse{
+		Before:     timestamppb.New(result.Before),
+		Deleted:    uint64(result.Deleted),
+		Batches:    uint32(result.Batches),
+		DurationMs: milliseconds(result.Duration),
+	}, nil
+}
+
+// CreateAPIKey - Creates an api key restricted to the tenants and the scope of the request. The secret of the key is
+// only returned in the response, it can not be read later.
+func (r *AdminServer) CreateAPIKey(ctx context.Context, request *v1.AdminCreateAPIKeyRequest) (*v1.AdminCreateAPIKeyResponse, error) {
+	ctx, span := tracer.Start(ctx, "admin.create-api-key")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	key, secret, err := r.adminService.CreateAPIKey(ctx, request.GetTenants(), request.GetScope())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, r.apiKeyStatus(err)
+	}
+	
+	return &v1.AdminCreateAPIKeyResponse{
+		Key:    toAPIKey(key),
+		Secret: secret,
+	}, nil
+}
+
+// DeleteAPIKey - Deletes the api key with the id of the request
+func (r *AdminServer) DeleteAPIKey(ctx context.Context, request *v1.AdminDeleteAPIKeyRequest) (*v1.AdminDeleteAPIKeyResponse, error) {
+	ctx, span := tracer.Start(ctx, "admin.delete-api-key")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	if err := r.adminService.DeleteAPIKey(ctx, request.GetId()); err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, r.apiKeyStatus(err)
+	}
+	return &v1.AdminDeleteAPIKeyResponse{
+		Id: request.GetId(),
+	}, nil
+}
+
+// ListAPIKeys - Api keys with their tenants and scopes, without their secrets
+func (r *AdminServer) ListAPIKeys(ctx context.Context, _ *v1.AdminListAPIKeysRequest) (*v1.AdminListAPIKeysResponse, error) {
+	ctx, span := tracer.Start(ctx, "admin.list-api-keys")
+	defer span.End()
+	
+	keys, err := r.adminService.ListAPIKeys(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, r.apiKeyStatus(err)
+	}
+	
+	response := &v1.AdminListAPIKeysResponse{
+		Keys: make([]*v1.AdminAPIKey, 0, len(keys)),
+	}
+	for _, key := range keys {
+		response.Keys = append(response.Keys, toAPIKey(key))
+	}
+	return response, nil
+}
+
+// ListCheckTraces - Sampled traces of the checks of the tenant, the newest first, with the steps they resolved and the
+// tuples they read
+func (r *AdminServer) ListCheckTraces(ctx context.Context, request *v1.AdminListCheckTracesRequest) (*v1.AdminListCheckTracesResponse, error) {
+	ctx, span := tracer.Start(ctx, "admin.list-check-traces")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	// the bounds that are not given are the zero times, which do not bound the traces
+	var since, until time.Time
+	if request.GetSince() != nil {
+		since = request.GetSince().AsTime()
+	}
+	if request.GetUntil() != nil {
+		until = request.GetUntil().AsTime()
+	}
+	
+	tenantID := request.GetTenantId()
+	traces, err := r.adminService.ListCheckTraces(ctx, tenantID, since, until, int(request.GetLimit()))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		if errors.Is(err, services.ErrCheckTracesUnsupported) {
+			return nil, status.Error(codes.Unimplemented, err.Error())
+		}
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	
+	response := &v1.AdminListCheckTracesResponse{
+		TenantId: tenantID,
+		Traces:   make([]*v1.AdminCheckTrace, 0, len(traces)),
+	}
+	for _, trace := range traces {
+		steps := make([]*v1.AdminCheckTraceStep, 0, len(trace.Steps))
+		for _, step := range trace.Steps {
+			steps = append(steps, &v1.AdminCheckTraceStep{
+				Entity:     step.Entity,
+				Permission: step.Permission,
+				Subject:    step.Subject,
+				Depth:      step.Depth,
+				Result:     step.Result,
+				Error:      step.Error,
+			})
+		}
+		response.Traces = append(response.Traces, &v1.AdminCheckTrace{
+			Id:            trace.ID,
+			Entity:        trace.Entity,
+			Permission:    trace.Permission,
+			Subject:       trace.Subject,
+			SchemaVersion: trace.SchemaVersion,
+			SnapToken:     trace.SnapToken,
+			Depth:         trace.Depth,
+			Result:        trace.Result,
+			Error:         trace.Error,
+			Steps:         steps,
+			Tuples:        trace.Tuples,
+			DurationMs:    milliseconds(trace.Duration),
+			CreatedAt:     timestamppb.New(trace.CreatedAt),
+		})
+	}
+	return response, nil
+}
+
+// apiKeyStatus - Status of the error of the api key endpoints
+func (r *AdminServer) apiKeyStatus(err error) error {
+	switch {
+	case errors.Is(err, services.ErrAPIKeysUnsupported):
+		return status.Error(codes.Unimplemented, err.Error())
+	case err.Error() == v1.ErrorCode_ERROR_CODE_RECORD_NOT_FOUND.String():
+		return status.Error(codes.NotFound, err.Error())
+	}
+	r.logger.Error(err.Error())
+	return status.Error(GetStatus(err), err.Error())
+}
+
+// toAPIKey -
+func toAPIKey(key repositories.APIKey) *v1.AdminAPIKey {
+	return &v1.AdminAPIKey{
+		Id:        key.ID,
+		Tenants:   key.Tenants,
+		Scope:     key.Scope,
+		CreatedAt: timestamppb.New(key.CreatedAt),
+	}
+}
+
+// milliseconds - Duration in milliseconds with microsecond precision
+func milliseconds(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
+
+// ReplayCheck - Re-executes the check at the snap token and the schema version of the request, so that a historical
+// decision can be explained by the permissions and relations it resolved
+func (r *AdminServer) ReplayCheck(ctx context.Context, request *v1.AdminReplayCheckRequest) (*v1.AdminReplayCheckResponse, error) {
+	ctx, span := tracer.Start(ctx, "admin.replay-check")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	response, trace, err := r.permissionService.ReplayCheck(ctx, &v1.PermissionCheckRequest{
+		TenantId: request.GetTenantId(),
+		Metadata: &v1.PermissionCheckRequestMetadata{
+			SchemaVersion: request.GetSchemaVersion(),
+			SnapToken:     request.GetSnapToken(),
+			Depth:         request.GetDepth(),
+		},
+		Entity:     request.GetEntity(),
+		Permission: request.GetPermission(),
+		Subject:    request.GetSubject(),
+		Context:    request.GetContext(),
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	
+	steps := make([]*v1.AdminCheckTraceStep, 0, len(trace))
+	for _, step := range trace {
+		recorded := &v1.AdminCheckTraceStep{
+			Entity:     tuple.EntityToString(step.Entity),
+			Permission: step.Permission,
+			Subject:    tuple.SubjectToString(step.Subject),
+			Depth:      step.Depth,
+			Result:     step.Result.String(),
+		}
+		if step.Error != nil {
+			recorded.Error = step.Error.Error()
+		}
+		steps = append(steps, recorded)
+	}
+	
+	return &v1.AdminReplayCheckResponse{
+		Can:   response.GetCan(),
+		Steps: steps,
+	}, nil
+}
+
+// RingStats - Shares of the peers on the dispatch ring and the subproblems this instance routed to them, so the
+// distribution of the keys and the hotspots can be compared across the instances
+func (r *AdminServer) RingStats(ctx context.Context, _ *v1.AdminRingStatsRequest) (*v1.AdminRingStatsResponse, error) {
+	ctx, span := tracer.Start(ctx, "admin.ring-stats")
+	defer span.End()
+	
+	stats, err := r.adminService.RingStats(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		if errors.Is(err, services.ErrDispatchDisabled) {
+			return nil, status.Error(codes.Unimplemented, err.Error())
+		}
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	
+	response := &v1.AdminRingStatsResponse{
+		Self:  stats.Self,
+		Local: stats.Local,
+		Peers: make([]*v1.AdminRingPeer, 0, len(stats.Peers)),
+	}
+	for _, peer := range stats.Peers {
+		response.Peers = append(response.Peers, &v1.AdminRingPeer{
+			Address:    peer.Address,
+			Share:      peer.Share,
+			Dispatched: peer.Dispatched,
+			Failed:     peer.Failed,
+			Misrouted:  peer.Misrouted,
+		})
+	}
+	return response, nil
+}
+
+// WarmUp - Populates the caches of the instance with the checks of the patterns, or of the patterns the instance
+// warms up with when none are given. The invalid patterns and the failing checks are counted.
+func (r *AdminServer) WarmUp(ctx context.Context, request *v1.AdminWarmUpRequest) (*v1.AdminWarmUpResponse, error) {
+	ctx, span := tracer.Start(ctx, "admin.warm-up")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	patterns := make([]warmup.Pattern, 0, len(request.GetPatterns()))
+	for _, pattern := range request.GetPatterns() {
+		patterns = append(patterns, warmup.Pattern{TenantID: pattern.GetTenantId(), Check: pattern.GetCheck()})
+	}
+	
+	var err error
+	if len(patterns) == 0 {
+		patterns, err = r.adminService.WarmupPatterns(ctx)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			if errors.Is(err, services.ErrWarmupDisabled) {
+				return nil, status.Error(codes.Unimplemented, err.Error())
+			}
+			r.logger.Error(err.Error())
+			return nil, status.Error(GetStatus(err), err.Error())
+		}
+	}
+	
+	result, err := r.permissionService.WarmUp(ctx, patterns)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	
+	return &v1.AdminWarmUpResponse{
+		Warmed:  uint32(result.Warmed),
+		Skipped: uint32(result.Skipped),
+		Failed:  uint32(result.Failed),
+	}, nil
+}
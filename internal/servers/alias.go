@@ -0,0 +1,133 @@
+package servers
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	
+	"github.com/adminium/permify/internal/services"
+	"github.com/adminium/permify/pkg/logger"
+	v1 "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+const (
+	// AliasHeader - Response header listing the aliases of renamed entities used by the request, each as alias=entity_type
+	AliasHeader = HeaderPrefix + "aliased"
+)
+
+// AliasUnaryServerInterceptor - Replaces the previous names of renamed entities in the requests with the entity types
+// of the schema and warns, in the response metadata and in the logs, about the aliases used. Tuple reads and deletes
+// are not rewritten, so that the tuples stored under an alias can be renamed.
+func AliasUnaryServerInterceptor(schemas services.ISchemaService, l logger.Interface) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if used := resolveAliases(ctx, schemas, req); len(used) > 0 {
+			l.Warn("%s uses aliases %v", info.FullMethod, used)
+			_ = grpc.SetHeader(ctx, metadata.Pairs(aliasPairs(used)...))
+		}
+		return handler(ctx, req)
+	}
+}
+
+// AliasStreamServerInterceptor - Stream variant of AliasUnaryServerInterceptor
+func AliasStreamServerInterceptor(schemas services.ISchemaService, l logger.Interface) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &aliasServerStream{ServerStream: ss, schemas: schemas, logger: l, method: info.FullMethod})
+	}
+}
+
+// aliasServerStream - Resolves the aliases of every received message, the header is sent with the first response
+type aliasServerStream struct {
+	grpc.ServerStream
+	schemas services.ISchemaService
+	logger  logger.Interface
+	method  string
+}
+
+// RecvMsg -
+func (s *aliasServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if used := resolveAliases(s.Context(), s.schemas, m); len(used) > 0 {
+		s.logger.Warn("%s uses aliases %v", s.method, used)
+		_ = s.ServerStream.SetHeader(metadata.Pairs(aliasPairs(used)...))
+	}
+	return nil
+}
+
+// resolveAliases - Replaces the aliases of the entity and subject types of the request, returns the replaced ones as
+// alias=entity_type
+func resolveAliases(ctx context.Context, schemas services.ISchemaService, req interface{}) (used []string) {
+	var tenantID, version string
+	var types []*string
+	
+	switch r := req.(type) {
+	case *v1.PermissionCheckRequest:
+		tenantID, version = r.GetTenantId(), r.GetMetadata().GetSchemaVersion()
+		types = append(types, entityType(r.GetEntity()), subjectType(r.GetSubject()))
+	case *v1.PermissionExpandRequest:
+		tenantID, version = r.GetTenantId(), r.GetMetadata().GetSchemaVersion()
+		types = append(types, entityType(r.GetEntity()))
+	case *v1.PermissionLookupSchemaRequest:
+		tenantID, version = r.GetTenantId(), r.GetMetadata().GetSchemaVersion()
+		types = append(types, &r.EntityType)
+	case *v1.PermissionLookupEntityRequest:
+		tenantID, version = r.GetTenantId(), r.GetMetadata().GetSchemaVersion()
+		types = append(types, &r.EntityType, subjectType(r.GetSubject()))
+	case *v1.RelationshipWriteRequest:
+		tenantID, version = r.GetTenantId(), r.GetMetadata().GetSchemaVersion()
+		for _, tup := range r.GetTuples() {
+			types = append(types, entityType(tup.GetEntity()), subjectType(tup.GetSubject()))
+		}
+	default:
+		return nil
+	}
+	
+	aliases, err := schemas.ReadAliases(ctx, tenantID, version)
+	if err != nil || len(aliases) == 0 {
+		// the handler reports schema errors itself
+		return nil
+	}
+	
+	seen := map[string]struct{}{}
+	for _, typ := range types {
+		if typ == nil {
+			continue
+		}
+		resolved, ok := aliases.Resolve(*typ)
+		if !ok {
+			continue
+		}
+		if _, found := seen[*typ]; !found {
+			seen[*typ] = struct{}{}
+			used = append(used, *typ+"="+resolved)
+		}
+		*typ = resolved
+	}
+	return used
+}
+
+// entityType -
+func entityType(entity *v1.Entity) *string {
+	if entity == nil {
+		return nil
+	}
+	return &entity.Type
+}
+
+// subjectType -
+func subjectType(subject *v1.Subject) *string {
+	if subject == nil {
+		return nil
+	}
+	return &subject.Type
+}
+
+// aliasPairs -
+func aliasPairs(used []string) []string {
+	kv := make([]string, 0, len(used)*2)
+	for _, u := range used {
+		kv = append(kv, AliasHeader, u)
+	}
+	return kv
+}
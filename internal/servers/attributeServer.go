@@ -0,0 +1,99 @@
+package servers
+
+import (
+	otelCodes "go.opentelemetry.io/otel/codes"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	
+	"github.com/adminium/permify/internal/services"
+	"github.com/adminium/permify/pkg/logger"
+	v1 "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// AttributeServer - Manages the attributes of entities that the rules of the schema are evaluated with
+type AttributeServer struct {
+	v1.UnimplementedAttributeServer
+	
+	attributeService services.IAttributeService
+	logger           logger.Interface
+}
+
+// NewAttributeServer - Creates new Attribute Server
+func NewAttributeServer(a services.IAttributeService, l logger.Interface) *AttributeServer {
+	return &AttributeServer{
+		attributeService: a,
+		logger:           l,
+	}
+}
+
+// Write - Writes the values of the attributes of the entity, the values must have the declared types
+func (r *AttributeServer) Write(ctx context.Context, request *v1.AttributeWriteRequest) (*v1.AttributeWriteResponse, error) {
+	ctx, span := tracer.Start(ctx, "attributes.write")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	attributes := request.GetAttributes().GetFields()
+	if len(attributes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "attributes are required")
+	}
+	
+	if err := r.attributeService.WriteAttributes(ctx, request.GetTenantId(), request.GetEntity(), attributes); err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	return &v1.AttributeWriteResponse{
+		Entity: request.GetEntity(),
+	}, nil
+}
+
+// Read - Values of the attributes of the entity
+func (r *AttributeServer) Read(ctx context.Context, request *v1.AttributeReadRequest) (*v1.AttributeReadResponse, error) {
+	ctx, span := tracer.Start(ctx, "attributes.read")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	attributes, err := r.attributeService.ReadAttributes(ctx, request.GetTenantId(), request.GetEntity())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	return &v1.AttributeReadResponse{
+		Entity:     request.GetEntity(),
+		Attributes: &structpb.Struct{Fields: attributes},
+	}, nil
+}
+
+// Delete - Deletes the named attributes of the entity, or all of them when no names are given
+func (r *AttributeServer) Delete(ctx context.Context, request *v1.AttributeDeleteRequest) (*v1.AttributeDeleteResponse, error) {
+	ctx, span := tracer.Start(ctx, "attributes.delete")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	if err := r.attributeService.DeleteAttributes(ctx, request.GetTenantId(), request.GetEntity(), request.GetNames()...); err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	return &v1.AttributeDeleteResponse{
+		Entity: request.GetEntity(),
+	}, nil
+}
@@ -0,0 +1,122 @@
+package servers
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	
+	"github.com/adminium/permify/internal/services"
+	"github.com/adminium/permify/pkg/dsl/utils"
+	"github.com/adminium/permify/pkg/logger"
+	v1 "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+const (
+	// DeprecationHeader - Response header listing the deprecated relations and actions used by the request
+	DeprecationHeader = HeaderPrefix + "deprecated"
+)
+
+// DeprecationUnaryServerInterceptor - Warns, in the response metadata and in the logs, when a request uses
+// deprecated relations or actions of the schema. It never fails the request.
+func DeprecationUnaryServerInterceptor(schemas services.ISchemaService, l logger.Interface) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if used := deprecatedUsages(ctx, schemas, req); len(used) > 0 {
+			l.Warn("%s uses deprecated %v", info.FullMethod, used)
+			_ = grpc.SetHeader(ctx, metadata.Pairs(pairs(used)...))
+		}
+		return handler(ctx, req)
+	}
+}
+
+// DeprecationStreamServerInterceptor - Stream variant of DeprecationUnaryServerInterceptor
+func DeprecationStreamServerInterceptor(schemas services.ISchemaService, l logger.Interface) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &deprecationServerStream{ServerStream: ss, schemas: schemas, logger: l, method: info.FullMethod})
+	}
+}
+
+// deprecationServerStream - Checks every received message, the header is sent with the first response
+type deprecationServerStream struct {
+	grpc.ServerStream
+	schemas services.ISchemaService
+	logger  logger.Interface
+	method  string
+}
+
+// RecvMsg -
+func (s *deprecationServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if used := deprecatedUsages(s.Context(), s.schemas, m); len(used) > 0 {
+		s.logger.Warn("%s uses deprecated %v", s.method, used)
+		_ = s.ServerStream.SetHeader(metadata.Pairs(pairs(used)...))
+	}
+	return nil
+}
+
+// deprecatedUsages - Deprecated relations and actions referenced by the request, as entity_type#name
+func deprecatedUsages(ctx context.Context, schemas services.ISchemaService, req interface{}) (used []string) {
+	var tenantID, version string
+	var references []*v1.RelationReference
+	
+	switch r := req.(type) {
+	case *v1.PermissionCheckRequest:
+		tenantID, version = r.GetTenantId(), r.GetMetadata().GetSchemaVersion()
+		references = append(references, &v1.RelationReference{Type: r.GetEntity().GetType(), Relation: r.GetPermission()})
+		references = append(references, subjectReference(r.GetSubject())...)
+	case *v1.PermissionExpandRequest:
+		tenantID, version = r.GetTenantId(), r.GetMetadata().GetSchemaVersion()
+		references = append(references, &v1.RelationReference{Type: r.GetEntity().GetType(), Relation: r.GetPermission()})
+	case *v1.PermissionLookupEntityRequest:
+		tenantID, version = r.GetTenantId(), r.GetMetadata().GetSchemaVersion()
+		references = append(references, &v1.RelationReference{Type: r.GetEntityType(), Relation: r.GetPermission()})
+		references = append(references, subjectReference(r.GetSubject())...)
+	case *v1.RelationshipWriteRequest:
+		tenantID, version = r.GetTenantId(), r.GetMetadata().GetSchemaVersion()
+		for _, tup := range r.GetTuples() {
+			references = append(references, &v1.RelationReference{Type: tup.GetEntity().GetType(), Relation: tup.GetRelation()})
+			references = append(references, subjectReference(tup.GetSubject())...)
+		}
+	default:
+		return nil
+	}
+	
+	deprecations, err := schemas.ReadDeprecations(ctx, tenantID, version)
+	if err != nil || len(deprecations) == 0 {
+		// the handler reports schema errors itself
+		return nil
+	}
+	
+	seen := map[string]struct{}{}
+	for _, reference := range references {
+		if !deprecations.IsDeprecated(reference.GetType(), reference.GetRelation()) {
+			continue
+		}
+		key := utils.Key(reference.GetType(), reference.GetRelation())
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		used = append(used, key)
+	}
+	return used
+}
+
+// subjectReference - Subject sets reference the relation of their type
+func subjectReference(subject *v1.Subject) []*v1.RelationReference {
+	if subject.GetRelation() == "" || subject.GetRelation() == tuple.ELLIPSIS {
+		return nil
+	}
+	return []*v1.RelationReference{{Type: subject.GetType(), Relation: subject.GetRelation()}}
+}
+
+// pairs -
+func pairs(used []string) []string {
+	kv := make([]string, 0, len(used)*2)
+	for _, u := range used {
+		kv = append(kv, DeprecationHeader, u)
+	}
+	return kv
+}
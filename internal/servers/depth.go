@@ -0,0 +1,194 @@
+package servers
+
+import (
+	"strconv"
+	
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	
+	"github.com/adminium/permify/internal/config"
+	"github.com/adminium/permify/internal/services"
+	v1 "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+const (
+	// DepthClampedHeader - Response header carrying the depth a request was evaluated with when its depth exceeded the
+	// maximum depth of its tenant
+	DepthClampedHeader = HeaderPrefix + "depth-clamped"
+)
+
+// DepthLimits - Default and maximum depth of the checks and lookups. The settings of a tenant override the values of
+// the server, so that the depth is not left to the clients and a misconfigured one can not make the engine recurse
+// without bounds.
+type DepthLimits struct {
+	depth config.Depth
+	// settings - nil when the storage has no settings, the values of the server apply to every tenant
+	settings services.ISettingsService
+}
+
+// NewDepthLimits - Creates new Depth Limits
+func NewDepthLimits(depth config.Depth, settings services.ISettingsService) *DepthLimits {
+	return &DepthLimits{
+		depth:    depth,
+		settings: settings,
+	}
+}
+
+// Resolve - Depth a request of the tenant is evaluated with, the default when requested is zero. Clamped is true
+// when the requested depth exceeded the maximum.
+func (d *DepthLimits) Resolve(ctx context.Context, tenantID string, requested int32) (depth int32, clamped bool, err error) {
+	def, max, err := d.Tenant(ctx, tenantID)
+	if err != nil {
+		return 0, false, err
+	}
+	depth, clamped = clampDepth(requested, def, max)
+	return depth, clamped, nil
+}
+
+// Tenant - Default and maximum depth of the tenant, zero max does not clamp the depths
+func (d *DepthLimits) Tenant(ctx context.Context, tenantID string) (def, max int32, err error) {
+	def, max = d.depth.Default, d.depth.Max
+	if d.settings == nil {
+		return def, max, nil
+	}
+	s, err := d.settings.ReadSettings(ctx, tenantID)
+	if err != nil {
+		return 0, 0, err
+	}
+	if s.Depth != 0 {
+		def = s.Depth
+	}
+	if s.MaxDepth != 0 {
+		max = s.MaxDepth
+	}
+	return def, max, nil
+}
+
+// clampDepth - The default when requested is zero, the maximum when requested exceeds it
+func clampDepth(requested, def, max int32) (depth int32, clamped bool) {
+	depth = requested
+	if depth == 0 {
+		depth = def
+	}
+	if max > 0 && depth > max {
+		return max, requested != 0
+	}
+	return depth, false
+}
+
+// DepthUnaryServerInterceptor - Gives the checks and lookups that do not set a depth the default depth of their
+// tenant and clamps the larger depths to its maximum, reporting the clamp in the response metadata. It runs before
+// the validation of the requests, which rejects a missing depth.
+func DepthUnaryServerInterceptor(limits *DepthLimits) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		depth, clamped, err := limitDepth(ctx, limits, req)
+		if err != nil {
+			return nil, status.Error(GetStatus(err), err.Error())
+		}
+		if clamped {
+			setDepthClampedHeader(ctx, depth)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// DepthStreamServerInterceptor - Stream variant of DepthUnaryServerInterceptor
+func DepthStreamServerInterceptor(limits *DepthLimits) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &depthServerStream{ServerStream: ss, limits: limits})
+	}
+}
+
+// depthServerStream - Limits the depth of every received message, the header is sent with the first response
+type depthServerStream struct {
+	grpc.ServerStream
+	limits *DepthLimits
+}
+
+// RecvMsg -
+func (s *depthServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	depth, clamped, err := limitDepth(s.Context(), s.limits, m)
+	if err != nil {
+		return status.Error(GetStatus(err), err.Error())
+	}
+	if clamped {
+		_ = s.ServerStream.SetHeader(metadata.Pairs(DepthClampedHeader, strconv.Itoa(int(depth))))
+	}
+	return nil
+}
+
+// limitDepth - Sets the resolved depth on the checks and lookups, the settings are only read for them
+func limitDepth(ctx context.Context, limits *DepthLimits, req interface{}) (depth int32, clamped bool, err error) {
+	switch r := req.(type) {
+	case *v1.PermissionCheckRequest:
+		depth, clamped, err = limits.Resolve(ctx, r.GetTenantId(), r.GetMetadata().GetDepth())
+		if err != nil {
+			return 0, false, err
+		}
+		if r.Metadata == nil {
+			r.Metadata = &v1.PermissionCheckRequestMetadata{}
+		}
+		r.Metadata.Depth = depth
+	case *v1.PermissionLookupEntityRequest:
+		depth, clamped, err = limits.Resolve(ctx, r.GetTenantId(), r.GetMetadata().GetDepth())
+		if err != nil {
+			return 0, false, err
+		}
+		if r.Metadata == nil {
+			r.Metadata = &v1.PermissionLookupEntityRequestMetadata{}
+		}
+		r.Metadata.Depth = depth
+	case *v1.PermissionLookupSubjectRequest:
+		depth, clamped, err = limits.Resolve(ctx, r.GetTenantId(), r.GetMetadata().GetDepth())
+		if err != nil {
+			return 0, false, err
+		}
+		if r.Metadata == nil {
+			r.Metadata = &v1.PermissionLookupSubjectRequestMetadata{}
+		}
+		r.Metadata.Depth = depth
+	case *v1.PermissionBulkCheckRequest:
+		return limitBulkCheckDepth(ctx, limits, r)
+	case *v1.PermissionBulkCheckStreamRequest:
+		if r.GetRequest() == nil {
+			return 0, false, nil
+		}
+		return limitDepth(ctx, limits, r.GetRequest())
+	}
+	return depth, clamped, nil
+}
+
+// limitBulkCheckDepth - Sets the resolved depth on the metadata of the bulk check and of the items that have their
+// own, the settings of the tenant are read once for all of them
+func limitBulkCheckDepth(ctx context.Context, limits *DepthLimits, r *v1.PermissionBulkCheckRequest) (depth int32, clamped bool, err error) {
+	def, max, err := limits.Tenant(ctx, r.GetTenantId())
+	if err != nil {
+		return 0, false, err
+	}
+	if r.Metadata == nil {
+		r.Metadata = &v1.PermissionCheckRequestMetadata{}
+	}
+	depth, clamped = clampDepth(r.Metadata.GetDepth(), def, max)
+	r.Metadata.Depth = depth
+	for _, item := range r.GetItems() {
+		if item.GetMetadata() == nil {
+			continue
+		}
+		d, c := clampDepth(item.Metadata.GetDepth(), def, max)
+		if c {
+			depth, clamped = d, true
+		}
+		item.Metadata.Depth = d
+	}
+	return depth, clamped, nil
+}
+
+// setDepthClampedHeader - Reports the depth a unary request was clamped to
+func setDepthClampedHeader(ctx context.Context, depth int32) {
+	_ = grpc.SetHeader(ctx, metadata.Pairs(DepthClampedHeader, strconv.Itoa(int(depth))))
+}
@@ -0,0 +1,84 @@
+package servers
+
+import (
+	otelCodes "go.opentelemetry.io/otel/codes"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	
+	"github.com/adminium/permify/internal/cluster"
+	"github.com/adminium/permify/internal/services"
+	"github.com/adminium/permify/pkg/logger"
+	v1 "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// DispatchServer - Receives the subproblems of checks that the peers of the dispatch ring route to this instance. It
+// is only registered on the grpc server, the peers dispatch with the credentials of the clients that checked.
+type DispatchServer struct {
+	dispatchService services.IDispatchService
+	logger          logger.Interface
+}
+
+// NewDispatchServer - Creates new Dispatch Server
+func NewDispatchServer(d services.IDispatchService, l logger.Interface) *DispatchServer {
+	return &DispatchServer{
+		dispatchService: d,
+		logger:          l,
+	}
+}
+
+// Check - Checks a dispatched subproblem
+func (r *DispatchServer) Check(ctx context.Context, request *v1.PermissionCheckRequest) (*v1.PermissionCheckResponse, error) {
+	ctx, span := tracer.Start(ctx, "dispatch.check")
+	defer span.End()
+	
+	// the peer that dispatched the subproblem learns whether the rings agree on its owner
+	if owner := r.dispatchService.Owner(request); owner != "" {
+		_ = grpc.SetHeader(ctx, metadata.Pairs(cluster.OwnerHeader, owner))
+	}
+	
+	response, err := r.dispatchService.Check(ctx, request)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	return response, nil
+}
+
+// registerDispatchServer -
+func registerDispatchServer(s *grpc.Server, srv *DispatchServer) {
+	s.RegisterService(&grpc.ServiceDesc{
+		ServiceName: "permify.dispatch.v1.Dispatch",
+		HandlerType: (*interface{})(nil),
+		Methods: []grpc.MethodDesc{
+			{
+				MethodName: "Check",
+				Handler:    dispatchCheckHandler,
+			},
+		},
+		Streams:  []grpc.StreamDesc{},
+		Metadata: "dispatch",
+	}, srv)
+}
+
+// dispatchCheckHandler - Decodes the request and runs it through the interceptor chain like the generated handlers
+func dispatchCheckHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.PermissionCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(*DispatchServer).Check(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: cluster.CheckMethod,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(*DispatchServer).Check(ctx, req.(*v1.PermissionCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
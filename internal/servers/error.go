@@ -13,6 +13,16 @@ func GetStatus(err error) codes.Code {
 		return codes.Internal
 	}
 	switch {
+	case code == int32(base.ErrorCode_ERROR_CODE_SNAPSHOT_EXPIRED):
+		return codes.FailedPrecondition
+	case code == int32(base.ErrorCode_ERROR_CODE_SNAPSHOT_NOT_REPLICATED):
+		return codes.Unavailable
+	case code == int32(base.ErrorCode_ERROR_CODE_TENANT_NOT_FOUND):
+		return codes.NotFound
+	case code == int32(base.ErrorCode_ERROR_CODE_MEMORY_LIMIT_EXCEEDED):
+		return codes.ResourceExhausted
+	case code == int32(base.ErrorCode_ERROR_CODE_NOT_IMPLEMENTED):
+		return codes.Unimplemented
 	case code > 999 && code < 1999:
 		return codes.Unauthenticated
 	case code > 1999 && code < 2999:
@@ -0,0 +1,102 @@
+package servers
+
+import (
+	"fmt"
+	"time"
+	
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	
+	"github.com/adminium/permify/pkg/cache"
+	"github.com/adminium/permify/pkg/logger"
+	v1 "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+const (
+	// StaleHeader - Response header of a check answered from its last known result because the storage failed,
+	// the value is the time the result was computed at in RFC 3339
+	StaleHeader = HeaderPrefix + "stale"
+)
+
+// fallbackEntry - Last known result of a check
+type fallbackEntry struct {
+	response *v1.PermissionCheckResponse
+	at       time.Time
+}
+
+// FallbackUnaryServerInterceptor - Degraded mode of the checks: the result of every successful check is kept by
+// tenant, entity, permission and subject regardless of its snapshot and schema version, and a check that fails
+// because the storage is unavailable is answered with it instead. Such a result can be older than the snap token of
+// the request, so it is marked with the StaleHeader. Checks without a known result still fail.
+func FallbackUnaryServerInterceptor(c cache.Cache, l logger.Interface) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		request, ok := req.(*v1.PermissionCheckRequest)
+		if !ok {
+			return handler(ctx, req)
+		}
+		
+		key := fallbackKey(request)
+		
+		resp, err := handler(ctx, req)
+		if err == nil {
+			if response, ok := resp.(*v1.PermissionCheckResponse); ok {
+				c.Set(key, fallbackEntry{response: response, at: time.Now()}, int64(len(key)))
+			}
+			return resp, nil
+		}
+		
+		if !isStorageError(err) {
+			return resp, err
+		}
+		value, found := c.Get(key)
+		if !found {
+			return resp, err
+		}
+		entry := value.(fallbackEntry)
+		l.Warn("%s answered from the result of %s: %s", info.FullMethod, entry.at.Format(time.RFC3339), err.Error())
+		_ = grpc.SetHeader(ctx, metadata.Pairs(StaleHeader, entry.at.Format(time.RFC3339)))
+		return entry.response, nil
+	}
+}
+
+// fallbackKey -
+func fallbackKey(request *v1.PermissionCheckRequest) string {
+	return fmt.Sprintf("fallback_%s:%t:%s@%s", request.GetTenantId(), request.GetMetadata().GetExclusion(), tuple.EntityAndRelationToString(&v1.EntityAndRelation{
+		Entity:   request.GetEntity(),
+		Relation: request.GetPermission(),
+	}), tuple.SubjectToString(request.GetSubject()))
+}
+
+// isStorageError - The check failed because the storage could not be read, not because the request is invalid
+func isStorageError(err error) bool {
+	s, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+	if s.Code() == codes.Unavailable || s.Code() == codes.DeadlineExceeded {
+		return true
+	}
+	if s.Code() != codes.Internal {
+		return false
+	}
+	code, ok := v1.ErrorCode_value[s.Message()]
+	if !ok {
+		// errors of the database driver are passed through as they are
+		return true
+	}
+	switch v1.ErrorCode(code) {
+	case v1.ErrorCode_ERROR_CODE_INTERNAL,
+		v1.ErrorCode_ERROR_CODE_SQL_BUILDER,
+		v1.ErrorCode_ERROR_CODE_CIRCUIT_BREAKER,
+		v1.ErrorCode_ERROR_CODE_EXECUTION,
+		v1.ErrorCode_ERROR_CODE_SCAN,
+		v1.ErrorCode_ERROR_CODE_ERROR_MAX_RETRIES:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,400 @@
+package servers
+
+import (
+	"errors"
+	"io"
+	
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	
+	v1 "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// ForwardingRelationshipServer - Serves relationship reads locally and forwards writes to the primary region
+type ForwardingRelationshipServer struct {
+	*RelationshipServer
+	primary v1.RelationshipClient
+}
+
+// NewForwardingRelationshipServer - Creates new Forwarding Relationship Server
+func NewForwardingRelationshipServer(server *RelationshipServer, conn grpc.ClientConnInterface) *ForwardingRelationshipServer {
+	return &ForwardingRelationshipServer{
+		RelationshipServer: server,
+		primary:            v1.NewRelationshipClient(conn),
+	}
+}
+
+// Write - Forwards the write to the primary region
+func (r *ForwardingRelationshipServer) Write(ctx context.Context, request *v1.RelationshipWriteRequest) (*v1.RelationshipWriteResponse, error) {
+	ctx, span := tracer.Start(ctx, "relationships.write.forward")
+	defer span.End()
+	
+	return r.primary.Write(forwardedContext(ctx), request)
+}
+
+// Delete - Forwards the delete to the primary region
+func (r *ForwardingRelationshipServer) Delete(ctx context.Context, request *v1.RelationshipDeleteRequest) (*v1.RelationshipDeleteResponse, error) {
+	ctx, span := tracer.Start(ctx, "relationships.delete.forward")
+	defer span.End()
+	
+	return r.primary.Delete(forwardedContext(ctx), request)
+}
+
+// BatchWrite - Forwards the batch write to the primary region
+func (r *ForwardingRelationshipServer) BatchWrite(ctx context.Context, request *v1.RelationshipBatchWriteRequest) (*v1.RelationshipBatchWriteResponse, error) {
+	ctx, span := tracer.Start(ctx, "relationships.batch-write.forward")
+	defer span.End()
+	
+	return r.primary.BatchWrite(forwardedContext(ctx), request)
+}
+
+// Validate - Validates locally and forwards the validations deleting the invalid tuples to the primary region
+func (r *ForwardingRelationshipServer) Validate(ctx context.Context, request *v1.RelationshipValidateRequest) (*v1.RelationshipValidateResponse, error) {
+	if !request.GetDelete() {
+		return r.RelationshipServer.Validate(ctx, request)
+	}
+	
+	ctx, span := tracer.Start(ctx, "relationships.validate.forward")
+	defer span.End()
+	
+	return r.primary.Validate(forwardedContext(ctx), request)
+}
+
+// Import - Relays the pages of the import to the primary region and its response back
+func (r *ForwardingRelationshipServer) Import(server v1.Relationship_ImportServer) error {
+	ctx, span := tracer.Start(server.Context(), "relationships.import.forward")
+	defer span.End()
+	
+	stream, err := r.primary.Import(forwardedContext(ctx))
+	if err != nil {
+		return err
+	}
+	for {
+		request, err := server.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err = stream.Send(request); err != nil {
+			return err
+		}
+	}
+	response, err := stream.CloseAndRecv()
+	if err != nil {
+		return err
+	}
+	return server.SendAndClose(response)
+}
+
+// ApplyMigration - Relays the progress of the migration applied by the primary region
+func (r *ForwardingRelationshipServer) ApplyMigration(request *v1.RelationshipApplyMigrationRequest, server v1.Relationship_ApplyMigrationServer) error {
+	ctx, span := tracer.Start(server.Context(), "relationships.apply-migration.forward")
+	defer span.End()
+	
+	stream, err := r.primary.ApplyMigration(forwardedContext(ctx), request)
+	if err != nil {
+		return err
+	}
+	for {
+		response, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err = server.Send(response); err != nil {
+			return err
+		}
+	}
+}
+
+// ForwardingSchemaServer - Serves schema reads locally and forwards writes to the primary region
+type ForwardingSchemaServer struct {
+	*SchemaServer
+	primary v1.SchemaClient
+}
+
+// NewForwardingSchemaServer - Creates new Forwarding Schema Server
+func NewForwardingSchemaServer(server *SchemaServer, conn grpc.ClientConnInterface) *ForwardingSchemaServer {
+	return &ForwardingSchemaServer{
+		SchemaServer: server,
+		primary:      v1.NewSchemaClient(conn),
+	}
+}
+
+// Write - Forwards the write to the primary region
+func (r *ForwardingSchemaServer) Write(ctx context.Context, request *v1.SchemaWriteRequest) (*v1.SchemaWriteResponse, error) {
+	ctx, span := tracer.Start(ctx, "schemas.write.forward")
+	defer span.End()
+	
+	return r.primary.Write(forwardedContext(ctx), request)
+}
+
+// Rollback - Forwards the rollback to the primary region
+func (r *ForwardingSchemaServer) Rollback(ctx context.Context, request *v1.SchemaRollbackRequest) (*v1.SchemaRollbackResponse, error) {
+	ctx, span := tracer.Start(ctx, "schemas.versions.rollback.forward")
+	defer span.End()
+	
+	return r.primary.Rollback(forwardedContext(ctx), request)
+}
+
+// WriteVersion - Forwards the write to the primary region
+func (r *ForwardingSchemaServer) WriteVersion(ctx context.Context, request *v1.SchemaWriteVersionRequest) (*v1.SchemaWriteVersionResponse, error) {
+	ctx, span := tracer.Start(ctx, "schemas.versions.write.forward")
+	defer span.End()
+	
+	return r.primary.WriteVersion(forwardedContext(ctx), request)
+}
+
+// Diff - Compares dry runs locally and forwards the diffs writing the schema to the primary region
+func (r *ForwardingSchemaServer) Diff(ctx context.Context, request *v1.SchemaDiffRequest) (*v1.SchemaDiffResponse, error) {
+	if request.GetDryRun() {
+		return r.SchemaServer.Diff(ctx, request)
+	}
+	
+	ctx, span := tracer.Start(ctx, "schemas.versions.diff.forward")
+	defer span.End()
+	
+	return r.primary.Diff(forwardedContext(ctx), request)
+}
+
+// ForwardingTenancyServer - Serves tenant reads locally and forwards writes to the primary region
+type ForwardingTenancyServer struct {
+	*TenancyServer
+	primary v1.TenancyClient
+}
+
+// NewForwardingTenancyServer - Creates new Forwarding Tenancy Server
+func NewForwardingTenancyServer(server *TenancyServer, conn grpc.ClientConnInterface) *ForwardingTenancyServer {
+	return &ForwardingTenancyServer{
+		TenancyServer: server,
+		primary:       v1.NewTenancyClient(conn),
+	}
+}
+
+// Create - Forwards the create to the primary region
+func (t *ForwardingTenancyServer) Create(ctx context.Context, request *v1.TenantCreateRequest) (*v1.TenantCreateResponse, error) {
+	ctx, span := tracer.Start(ctx, "tenants.create.forward")
+	defer span.End()
+	
+	return t.primary.Create(forwardedContext(ctx), request)
+}
+
+// Delete - Forwards the delete to the primary region
+func (t *ForwardingTenancyServer) Delete(ctx context.Context, request *v1.TenantDeleteRequest) (*v1.TenantDeleteResponse, error) {
+	ctx, span := tracer.Start(ctx, "tenants.delete.forward")
+	defer span.End()
+	
+	return t.primary.Delete(forwardedContext(ctx), request)
+}
+
+// Update - Forwards the update to the primary region
+func (t *ForwardingTenancyServer) Update(ctx context.Context, request *v1.TenantUpdateRequest) (*v1.TenantUpdateResponse, error) {
+	ctx, span := tracer.Start(ctx, "tenant.update.forward")
+	defer span.End()
+	
+	return t.primary.Update(forwardedContext(ctx), request)
+}
+
+// ForwardingAttributeServer - Serves attribute reads locally and forwards writes to the primary region
+type ForwardingAttributeServer struct {
+	*AttributeServer
+	primary v1.AttributeClient
+}
+
+// NewForwardingAttributeServer - Creates new Forwarding Attribute Server
+func NewForwardingAttributeServer(server *AttributeServer, conn grpc.ClientConnInterface) *ForwardingAttributeServer {
+	return &ForwardingAttributeServer{
+		AttributeServer: server,
+		primary:         v1.NewAttributeClient(conn),
+	}
+}
+
+// Write - Forwards the write to the primary region
+func (r *ForwardingAttributeServer) Write(ctx context.Context, request *v1.AttributeWriteRequest) (*v1.AttributeWriteResponse, error) {
+	ctx, span := tracer.Start(ctx, "attributes.write.forward")
+	defer span.End()
+	
+	return r.primary.Write(forwardedContext(ctx), request)
+}
+
+// Delete - Forwards the delete to the primary region
+func (r *ForwardingAttributeServer) Delete(ctx context.Context, request *v1.AttributeDeleteRequest) (*v1.AttributeDeleteResponse, error) {
+	ctx, span := tracer.Start(ctx, "attributes.delete.forward")
+	defer span.End()
+	
+	return r.primary.Delete(forwardedContext(ctx), request)
+}
+
+// ForwardingSettingsServer - Serves settings reads locally and forwards writes to the primary region
+type ForwardingSettingsServer struct {
+	*SettingsServer
+	primary v1.SettingsClient
+}
+
+// NewForwardingSettingsServer - Creates new Forwarding Settings Server
+func NewForwardingSettingsServer(server *SettingsServer, conn grpc.ClientConnInterface) *ForwardingSettingsServer {
+	return &ForwardingSettingsServer{
+		SettingsServer: server,
+		primary:        v1.NewSettingsClient(conn),
+	}
+}
+
+// Write - Forwards the write to the primary region
+func (r *ForwardingSettingsServer) Write(ctx context.Context, request *v1.SettingsWriteRequest) (*v1.SettingsWriteResponse, error) {
+	ctx, span := tracer.Start(ctx, "settings.write.forward")
+	defer span.End()
+	
+	return r.primary.Write(forwardedContext(ctx), request)
+}
+
+// ForwardingIdentityServer - Forwards the changes of the identity mappings to the primary region
+type ForwardingIdentityServer struct {
+	*IdentityServer
+	primary v1.IdentityClient
+}
+
+// NewForwardingIdentityServer - Creates new Forwarding Identity Server
+func NewForwardingIdentityServer(server *IdentityServer, conn grpc.ClientConnInterface) *ForwardingIdentityServer {
+	return &ForwardingIdentityServer{
+		IdentityServer: server,
+		primary:        v1.NewIdentityClient(conn),
+	}
+}
+
+// Map - Forwards the mapping to the primary region
+func (r *ForwardingIdentityServer) Map(ctx context.Context, request *v1.IdentityMapRequest) (*v1.IdentityMapResponse, error) {
+	ctx, span := tracer.Start(ctx, "identities.map.forward")
+	defer span.End()
+	
+	return r.primary.Map(forwardedContext(ctx), request)
+}
+
+// Unmap - Forwards the removal of the mapping to the primary region
+func (r *ForwardingIdentityServer) Unmap(ctx context.Context, request *v1.IdentityUnmapRequest) (*v1.IdentityUnmapResponse, error) {
+	ctx, span := tracer.Start(ctx, "identities.unmap.forward")
+	defer span.End()
+	
+	return r.primary.Unmap(forwardedContext(ctx), request)
+}
+
+// ForwardingAdminServer - Serves the diagnostics and the cache operations locally and forwards the operations that
+// write to the storage to the primary region
+type ForwardingAdminServer struct {
+	*AdminServer
+	primary v1.AdminClient
+}
+
+// NewForwardingAdminServer - Creates new Forwarding Admin Server
+func NewForwardingAdminServer(server *AdminServer, conn grpc.ClientConnInterface) *ForwardingAdminServer {
+	return &ForwardingAdminServer{
+		AdminServer: server,
+		primary:     v1.NewAdminClient(conn),
+	}
+}
+
+// CollectGarbage - Forwards the garbage collection to the primary region
+func (r *ForwardingAdminServer) CollectGarbage(ctx context.Context, request *v1.AdminCollectGarbageRequest) (*v1.AdminCollectGarbageResponse, error) {
+	ctx, span := tracer.Start(ctx, "admin.collect-garbage.forward")
+	defer span.End()
+	
+	return r.primary.CollectGarbage(forwardedContext(ctx), request)
+}
+
+// CreateAPIKey - Forwards the create to the primary region
+func (r *ForwardingAdminServer) CreateAPIKey(ctx context.Context, request *v1.AdminCreateAPIKeyRequest) (*v1.AdminCreateAPIKeyResponse, error) {
+	ctx, span := tracer.Start(ctx, "admin.create-api-key.forward")
+	defer span.End()
+	
+	return r.primary.CreateAPIKey(forwardedContext(ctx), request)
+}
+
+// DeleteAPIKey - Forwards the delete to the primary region
+func (r *ForwardingAdminServer) DeleteAPIKey(ctx context.Context, request *v1.AdminDeleteAPIKeyRequest) (*v1.AdminDeleteAPIKeyResponse, error) {
+	ctx, span := tracer.Start(ctx, "admin.delete-api-key.forward")
+	defer span.End()
+	
+	return r.primary.DeleteAPIKey(forwardedContext(ctx), request)
+}
+
+// ForwardingOpenFGAServer - Forwards the imports to the primary region
+type ForwardingOpenFGAServer struct {
+	*OpenFGAServer
+	primary v1.OpenFGAClient
+}
+
+// NewForwardingOpenFGAServer - Creates new Forwarding OpenFGA Server
+func NewForwardingOpenFGAServer(server *OpenFGAServer, conn grpc.ClientConnInterface) *ForwardingOpenFGAServer {
+	return &ForwardingOpenFGAServer{
+		OpenFGAServer: server,
+		primary:       v1.NewOpenFGAClient(conn),
+	}
+}
+
+// Import - Forwards the import to the primary region
+func (r *ForwardingOpenFGAServer) Import(ctx context.Context, request *v1.OpenFGAImportRequest) (*v1.OpenFGAImportResponse, error) {
+	ctx, span := tracer.Start(ctx, "openfga.import.forward")
+	defer span.End()
+	
+	return r.primary.Import(forwardedContext(ctx), request)
+}
+
+// ForwardingSCIMServer - Serves the reads of the groups locally and forwards their changes to the primary region, so
+// the tuples of the memberships are only written there
+type ForwardingSCIMServer struct {
+	*SCIMServer
+	primary v1.SCIMClient
+}
+
+// NewForwardingSCIMServer - Creates new Forwarding SCIM Server
+func NewForwardingSCIMServer(server *SCIMServer, conn grpc.ClientConnInterface) *ForwardingSCIMServer {
+	return &ForwardingSCIMServer{
+		SCIMServer: server,
+		primary:    v1.NewSCIMClient(conn),
+	}
+}
+
+// CreateGroup - Forwards the create to the primary region
+func (r *ForwardingSCIMServer) CreateGroup(ctx context.Context, request *v1.SCIMCreateGroupRequest) (*v1.SCIMGroup, error) {
+	ctx, span := tracer.Start(ctx, "scim.create-group.forward")
+	defer span.End()
+	
+	return r.primary.CreateGroup(forwardedContext(ctx), request)
+}
+
+// ReplaceGroup - Forwards the replace to the primary region
+func (r *ForwardingSCIMServer) ReplaceGroup(ctx context.Context, request *v1.SCIMReplaceGroupRequest) (*v1.SCIMGroup, error) {
+	ctx, span := tracer.Start(ctx, "scim.replace-group.forward")
+	defer span.End()
+	
+	return r.primary.ReplaceGroup(forwardedContext(ctx), request)
+}
+
+// PatchGroup - Forwards the patch to the primary region
+func (r *ForwardingSCIMServer) PatchGroup(ctx context.Context, request *v1.SCIMPatchGroupRequest) (*v1.SCIMGroup, error) {
+	ctx, span := tracer.Start(ctx, "scim.patch-group.forward")
+	defer span.End()
+	
+	return r.primary.PatchGroup(forwardedContext(ctx), request)
+}
+
+// DeleteGroup - Forwards the delete to the primary region
+func (r *ForwardingSCIMServer) DeleteGroup(ctx context.Context, request *v1.SCIMDeleteGroupRequest) (*v1.SCIMDeleteGroupResponse, error) {
+	ctx, span := tracer.Start(ctx, "scim.delete-group.forward")
+	defer span.End()
+	
+	return r.primary.DeleteGroup(forwardedContext(ctx), request)
+}
+
+// forwardedContext - Passes the incoming headers (authorization, tuple metadata) on to the primary region
+func forwardedContext(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	return metadata.NewOutgoingContext(ctx, md.Copy())
+}
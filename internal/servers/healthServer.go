@@ -2,29 +2,109 @@ package servers
 
 import (
 	"context"
+	"sort"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	health "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/status"
 )
 
-// HealthServer - Structure for Health Server
+const (
+	// _healthProbeTimeout - A probe that does not answer within it fails
+	_healthProbeTimeout = 2 * time.Second
+	// _healthWatchInterval - How often the watched statuses are probed again
+	_healthWatchInterval = 5 * time.Second
+)
+
+// HealthProbe - Tells whether a dependency of the server can be used, nil when it can
+type HealthProbe func(ctx context.Context) error
+
+// HealthServer - Reports the status of every dependency of the server as a service of its own, e.g. database or
+// cache, and the status of the server as the empty service, which is serving only when all of them are. Kubernetes
+// probes and grpcurl ask for the empty service unless they are told otherwise.
 type HealthServer struct {
 	health.UnimplementedHealthServer
+	probes   map[string]HealthProbe
+	interval time.Duration
 }
 
 // NewHealthServer - Creates new HealthServer Server
-func NewHealthServer() *HealthServer {
-	return &HealthServer{}
+func NewHealthServer(probes map[string]HealthProbe) *HealthServer {
+	return &HealthServer{
+		probes:   probes,
+		interval: _healthWatchInterval,
+	}
 }
 
-// Check - Return health check status response
+// AuthFuncOverride - The probes of kubernetes and of the load balancers carry no credentials, so the health service is
+// open to the callers that are not authenticated. It tells no more than whether the server can serve.
+func (s *HealthServer) AuthFuncOverride(ctx context.Context, _ string) (context.Context, error) {
+	return ctx, nil
+}
+
+// Check - Probes the dependency of the service, or all of them for the empty service
 func (s *HealthServer) Check(ctx context.Context, in *health.HealthCheckRequest) (*health.HealthCheckResponse, error) {
-	return &health.HealthCheckResponse{Status: health.HealthCheckResponse_SERVING}, nil
+	st, ok := s.status(ctx, in.GetService())
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown service %s", in.GetService())
+	}
+	return &health.HealthCheckResponse{Status: st}, nil
+}
+
+// Watch - Sends the status of the service and then every change of it, an unknown service is reported as such
+// instead of failing the stream since it may be registered later
+func (s *HealthServer) Watch(in *health.HealthCheckRequest, stream health.Health_WatchServer) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	last := health.HealthCheckResponse_UNKNOWN
+	for {
+		st, ok := s.status(stream.Context(), in.GetService())
+		if !ok {
+			st = health.HealthCheckResponse_SERVICE_UNKNOWN
+		}
+		if st != last {
+			if err := stream.Send(&health.HealthCheckResponse{Status: st}); err != nil {
+				return err
+			}
+			last = st
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, stream.Context().Err().Error())
+		case <-ticker.C:
+		}
+	}
+}
+
+// status - ok is false when the service is unknown
+func (s *HealthServer) status(ctx context.Context, service string) (health.HealthCheckResponse_ServingStatus, bool) {
+	var names []string
+	if service == "" {
+		for name := range s.probes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	} else {
+		if _, ok := s.probes[service]; !ok {
+			return health.HealthCheckResponse_SERVICE_UNKNOWN, false
+		}
+		names = []string{service}
+	}
+
+	for _, name := range names {
+		if !s.probe(ctx, name) {
+			return health.HealthCheckResponse_NOT_SERVING, true
+		}
+	}
+	return health.HealthCheckResponse_SERVING, true
 }
 
-// Watch - TO:DO
-func (s *HealthServer) Watch(in *health.HealthCheckRequest, _ health.Health_WatchServer) error {
-	// Example of how to register both methods but only implement the Check method.
-	return status.Error(codes.Unimplemented, "unimplemented")
+// probe - The probe is given its own deadline so that a hanging dependency does not hang the probes of kubernetes
+func (s *HealthServer) probe(ctx context.Context, name string) bool {
+	ctx, cancel := context.WithTimeout(ctx, _healthProbeTimeout)
+	defer cancel()
+	return s.probes[name](ctx) == nil
 }
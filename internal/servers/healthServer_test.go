@@ -0,0 +1,88 @@
+package servers
+
+import (
+	"context"
+	"net"
+	
+	grpcAuth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	health "google.golang.org/grpc/health/grpc_health_v1"
+	reflectionV1Alpha "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
+	
+	"github.com/adminium/permify/internal/authn"
+	"github.com/adminium/permify/internal/authn/preshared"
+	"github.com/adminium/permify/internal/config"
+	"github.com/adminium/permify/internal/servers/middleware"
+	v1 "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+var _ = Describe("health-server", func() {
+	var conn *grpc.ClientConn
+	var server *grpc.Server
+	
+	BeforeEach(func() {
+		authenticator, err := preshared.NewKeyAuthn(context.Background(), config.Preshared{Keys: []string{"secret"}})
+		Expect(err).ShouldNot(HaveOccurred())
+		
+		server = grpc.NewServer(
+			grpc.ChainUnaryInterceptor(grpcAuth.UnaryServerInterceptor(middleware.AuthFunc(authenticator))),
+			grpc.ChainStreamInterceptor(grpcAuth.StreamServerInterceptor(middleware.AuthFunc(authenticator))),
+		)
+		health.RegisterHealthServer(server, NewHealthServer(map[string]HealthProbe{
+			"database": func(context.Context) error { return nil },
+		}))
+		v1.RegisterWelcomeServer(server, NewWelcomeServer())
+		reflectionV1Alpha.RegisterServerReflectionServer(server, NewReflectionServer(server))
+		
+		listener := bufconn.Listen(1024 * 1024)
+		go func() {
+			_ = server.Serve(listener)
+		}()
+		
+		conn, err = grpc.DialContext(context.Background(), "bufnet",
+			grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+	
+	AfterEach(func() {
+		Expect(conn.Close()).Should(Succeed())
+		server.Stop()
+	})
+	
+	Context("Authentication", func() {
+		It("Case 1: The health and reflection services answer the callers without credentials", func() {
+			response, err := health.NewHealthClient(conn).Check(context.Background(), &health.HealthCheckRequest{})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(response.GetStatus()).Should(Equal(health.HealthCheckResponse_SERVING))
+			
+			watch, err := health.NewHealthClient(conn).Watch(context.Background(), &health.HealthCheckRequest{Service: "database"})
+			Expect(err).ShouldNot(HaveOccurred())
+			response, err = watch.Recv()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(response.GetStatus()).Should(Equal(health.HealthCheckResponse_SERVING))
+			
+			stream, err := reflectionV1Alpha.NewServerReflectionClient(conn).ServerReflectionInfo(context.Background())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(stream.Send(&reflectionV1Alpha.ServerReflectionRequest{
+				MessageRequest: &reflectionV1Alpha.ServerReflectionRequest_ListServices{},
+			})).Should(Succeed())
+			info, err := stream.Recv()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(info.GetListServicesResponse().GetService()).ShouldNot(BeEmpty())
+		})
+		
+		It("Case 2: The other services still need credentials", func() {
+			_, err := v1.NewWelcomeClient(conn).Hello(context.Background(), &emptypb.Empty{})
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(status.Code(authn.MissingBearerTokenError)))
+		})
+	})
+})
@@ -0,0 +1,94 @@
+package servers
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	
+	"github.com/adminium/permify/internal/services"
+	v1 "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// IdentityUnaryServerInterceptor - Replaces external identifiers in the subjects of incoming requests with
+// canonical subject ids, so the services only ever see canonical ids
+func IdentityUnaryServerInterceptor(identities services.IIdentityService) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := resolveSubjects(ctx, identities, req); err != nil {
+			return nil, status.Error(GetStatus(err), err.Error())
+		}
+		return handler(ctx, req)
+	}
+}
+
+// IdentityStreamServerInterceptor - Stream variant of IdentityUnaryServerInterceptor
+func IdentityStreamServerInterceptor(identities services.IIdentityService) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &identityServerStream{ServerStream: ss, identities: identities})
+	}
+}
+
+// identityServerStream - Resolves the subjects of every received message
+type identityServerStream struct {
+	grpc.ServerStream
+	identities services.IIdentityService
+}
+
+// RecvMsg -
+func (s *identityServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if err := resolveSubjects(s.Context(), s.identities, m); err != nil {
+		return status.Error(GetStatus(err), err.Error())
+	}
+	return nil
+}
+
+// resolveSubjects - Resolves the subjects of the requests that carry one
+func resolveSubjects(ctx context.Context, identities services.IIdentityService, req interface{}) (err error) {
+	switch r := req.(type) {
+	case *v1.PermissionCheckRequest:
+		return resolveSubject(ctx, identities, r.GetTenantId(), r.GetSubject())
+	case *v1.PermissionLookupEntityRequest:
+		return resolveSubject(ctx, identities, r.GetTenantId(), r.GetSubject())
+	case *v1.PermissionBulkCheckRequest:
+		for _, item := range r.GetItems() {
+			if err = resolveSubject(ctx, identities, r.GetTenantId(), item.GetSubject()); err != nil {
+				return err
+			}
+		}
+	case *v1.PermissionBulkCheckStreamRequest:
+		return resolveSubjects(ctx, identities, r.GetRequest())
+	case *v1.RelationshipWriteRequest:
+		for _, tup := range r.GetTuples() {
+			if err = resolveSubject(ctx, identities, r.GetTenantId(), tup.GetSubject()); err != nil {
+				return err
+			}
+		}
+	case *v1.RelationshipReadRequest:
+		return resolveSubjectFilter(ctx, identities, r.GetTenantId(), r.GetFilter().GetSubject())
+	case *v1.RelationshipDeleteRequest:
+		return resolveSubjectFilter(ctx, identities, r.GetTenantId(), r.GetFilter().GetSubject())
+	}
+	return nil
+}
+
+// resolveSubject -
+func resolveSubject(ctx context.Context, identities services.IIdentityService, tenantID string, subject *v1.Subject) (err error) {
+	if subject == nil {
+		return nil
+	}
+	subject.Id, err = identities.ResolveIdentity(ctx, tenantID, subject.GetType(), subject.GetId())
+	return err
+}
+
+// resolveSubjectFilter -
+func resolveSubjectFilter(ctx context.Context, identities services.IIdentityService, tenantID string, filter *v1.SubjectFilter) (err error) {
+	for i, id := range filter.GetIds() {
+		filter.Ids[i], err = identities.ResolveIdentity(ctx, tenantID, filter.GetType(), id)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package servers
+
+import (
+	otelCodes "go.opentelemetry.io/otel/codes"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/status"
+	
+	"github.com/adminium/permify/internal/services"
+	"github.com/adminium/permify/pkg/logger"
+	v1 "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// IdentityServer - Manages the mappings of the external identifiers of the subjects to their canonical ids
+type IdentityServer struct {
+	v1.UnimplementedIdentityServer
+	
+	identityService services.IIdentityService
+	logger          logger.Interface
+}
+
+// NewIdentityServer - Creates new Identity Server
+func NewIdentityServer(i services.IIdentityService, l logger.Interface) *IdentityServer {
+	return &IdentityServer{
+		identityService: i,
+		logger:          l,
+	}
+}
+
+// Map - Maps the alias of the subject type to the subject id
+func (r *IdentityServer) Map(ctx context.Context, request *v1.IdentityMapRequest) (*v1.IdentityMapResponse, error) {
+	ctx, span := tracer.Start(ctx, "identities.map")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	err := r.identityService.MapIdentity(ctx, request.GetTenantId(), request.GetSubjectType(), request.GetAlias(), request.GetSubjectId())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	
+	return &v1.IdentityMapResponse{
+		SubjectType: request.GetSubjectType(),
+		Alias:       request.GetAlias(),
+		SubjectId:   request.GetSubjectId(),
+	}, nil
+}
+
+// Unmap - Removes the mapping of the alias of the subject type
+func (r *IdentityServer) Unmap(ctx context.Context, request *v1.IdentityUnmapRequest) (*v1.IdentityUnmapResponse, error) {
+	ctx, span := tracer.Start(ctx, "identities.unmap")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	err := r.identityService.UnmapIdentity(ctx, request.GetTenantId(), request.GetSubjectType(), request.GetAlias())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	
+	return &v1.IdentityUnmapResponse{
+		SubjectType: request.GetSubjectType(),
+		Alias:       request.GetAlias(),
+	}, nil
+}
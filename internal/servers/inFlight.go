@@ -0,0 +1,52 @@
+package servers
+
+import (
+	"context"
+	
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/instrument"
+	"google.golang.org/grpc"
+)
+
+// InFlightRequests - Counts the requests being served by their method, the streams are counted until they end
+type InFlightRequests struct {
+	counter instrument.Int64UpDownCounter
+}
+
+// NewInFlightRequests - Creates new In Flight Requests
+func NewInFlightRequests(m metric.Meter) *InFlightRequests {
+	requests := &InFlightRequests{}
+	if counter, err := m.Int64UpDownCounter("in_flight_request_count", instrument.WithDescription("in flight request count")); err == nil {
+		requests.counter = counter
+	}
+	return requests
+}
+
+// InFlightUnaryServerInterceptor - Counts the request while it is handled
+func InFlightUnaryServerInterceptor(requests *InFlightRequests) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		defer requests.track(ctx, info.FullMethod)()
+		return handler(ctx, req)
+	}
+}
+
+// InFlightStreamServerInterceptor - Stream variant of InFlightUnaryServerInterceptor
+func InFlightStreamServerInterceptor(requests *InFlightRequests) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		defer requests.track(ss.Context(), info.FullMethod)()
+		return handler(srv, ss)
+	}
+}
+
+// track - Counts the request of the method in, the returned func counts it out
+func (r *InFlightRequests) track(ctx context.Context, method string) func() {
+	if r.counter == nil {
+		return func() {}
+	}
+	attrs := []attribute.KeyValue{attribute.String("method", method)}
+	r.counter.Add(ctx, 1, attrs...)
+	return func() {
+		r.counter.Add(ctx, -1, attrs...)
+	}
+}
@@ -0,0 +1,72 @@
+package servers
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	
+	"github.com/adminium/permify/internal/config"
+	"github.com/adminium/permify/internal/services"
+	v1 "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// LimitsUnaryServerInterceptor - Rejects requests larger than the configured limits before any work is done for them,
+// so a single client can not hold long transactions with oversized writes or filters
+func LimitsUnaryServerInterceptor(limits config.Limits) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := checkLimits(limits, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// LimitsStreamServerInterceptor - Stream variant of LimitsUnaryServerInterceptor, the checks of a bulk check stream
+// are counted across its messages
+func LimitsStreamServerInterceptor(limits config.Limits) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &limitsServerStream{ServerStream: ss, limits: limits})
+	}
+}
+
+// limitsServerStream - Checks the limits of every received message
+type limitsServerStream struct {
+	grpc.ServerStream
+	limits config.Limits
+	// checks - received messages of a bulk check stream
+	checks int
+}
+
+// RecvMsg -
+func (s *limitsServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if _, ok := m.(*v1.PermissionBulkCheckStreamRequest); ok {
+		s.checks++
+		return services.CheckLimit("checks", s.limits.MaxChecksPerBulk, s.checks)
+	}
+	return checkLimits(s.limits, m)
+}
+
+// checkLimits -
+func checkLimits(limits config.Limits, req interface{}) error {
+	switch r := req.(type) {
+	case *v1.RelationshipWriteRequest:
+		return services.CheckLimit("tuples", limits.MaxTuplesPerWrite, len(r.GetTuples()))
+	case *v1.RelationshipReadRequest:
+		return services.CheckLimit("filter", limits.MaxFilterIDs, filterIDs(r.GetFilter()))
+	case *v1.RelationshipDeleteRequest:
+		return services.CheckLimit("filter", limits.MaxFilterIDs, filterIDs(r.GetFilter()))
+	case *v1.SchemaWriteRequest:
+		return services.CheckLimit("schema", limits.MaxSchemaBytes, len(r.GetSchema()))
+	case *v1.PermissionBulkCheckRequest:
+		return services.CheckLimit("checks", limits.MaxChecksPerBulk, len(r.GetItems()))
+	default:
+		return nil
+	}
+}
+
+// filterIDs - Number of entity and subject ids of the filter
+func filterIDs(filter *v1.TupleFilter) int {
+	return len(filter.GetEntity().GetIds()) + len(filter.GetSubject().GetIds())
+}
@@ -5,16 +5,13 @@ import (
 	
 	grpcAuth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	
-	"github.com/adminium/permify/internal/authn/preshared"
+	"github.com/adminium/permify/internal/authn"
 )
 
-// KeyAuthFunc - Middleware that responsible for key authentication
-func KeyAuthFunc(authenticator preshared.KeyAuthenticator) grpcAuth.AuthFunc {
+// AuthFunc - Middleware that responsible for authentication, the context of the authenticated requests carries the
+// principal of the restricted callers
+func AuthFunc(authenticator authn.Authenticator) grpcAuth.AuthFunc {
 	return func(ctx context.Context) (context.Context, error) {
-		err := authenticator.Authenticate(ctx)
-		if err != nil {
-			return nil, err
-		}
-		return ctx, nil
+		return authenticator.Authenticate(ctx)
 	}
 }
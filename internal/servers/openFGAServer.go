@@ -0,0 +1,59 @@
+package servers
+
+import (
+	"errors"
+	
+	otelCodes "go.opentelemetry.io/otel/codes"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	
+	"github.com/adminium/permify/internal/services"
+	"github.com/adminium/permify/pkg/logger"
+	v1 "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// OpenFGAServer - Imports the authorization models and tuples of OpenFGA, so users can migrate from it
+type OpenFGAServer struct {
+	v1.UnimplementedOpenFGAServer
+	
+	openFGAService services.IOpenFGAService
+	logger         logger.Interface
+}
+
+// NewOpenFGAServer - Creates new OpenFGA Server
+func NewOpenFGAServer(o services.IOpenFGAService, l logger.Interface) *OpenFGAServer {
+	return &OpenFGAServer{
+		openFGAService: o,
+		logger:         l,
+	}
+}
+
+// Import - Writes the translation of the authorization model as the schema of the tenant when it is given, then writes
+// the tuples atomically
+func (r *OpenFGAServer) Import(ctx context.Context, request *v1.OpenFGAImportRequest) (*v1.OpenFGAImportResponse, error) {
+	ctx, span := tracer.Start(ctx, "openfga.import")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	result, err := r.openFGAService.Import(ctx, request.GetTenantId(), request.GetSchemaVersion(), request.GetAuthorizationModel(), request.GetTupleKeys(), request.GetTuples())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		if errors.Is(err, services.ErrOpenFGATranslation) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	
+	return &v1.OpenFGAImportResponse{
+		SchemaVersion: result.SchemaVersion,
+		Imported:      uint32(result.Imported),
+		SnapToken:     result.SnapToken,
+	}, nil
+}
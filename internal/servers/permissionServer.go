@@ -1,10 +1,14 @@
 package servers
 
 import (
+	"errors"
+	"strconv"
+	
 	otelCodes "go.opentelemetry.io/otel/codes"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/status"
 	
+	"github.com/adminium/permify/internal/commands"
 	"github.com/adminium/permify/internal/services"
 	"github.com/adminium/permify/pkg/logger"
 	v1 "github.com/adminium/permify/pkg/pb/base/v1"
@@ -61,7 +65,12 @@ func (r *PermissionServer) Expand(ctx context.Context, request *v1.PermissionExp
 	
 	var err error
 	var response *v1.PermissionExpandResponse
-	response, err = r.permissionService.ExpandPermissions(ctx, request)
+	var truncated []commands.ExpandTruncation
+	if request.GetContinuation() != "" {
+		response, truncated, err = r.permissionService.ContinueExpand(ctx, request.GetTenantId(), request.GetContinuation())
+	} else {
+		response, truncated, err = r.permissionService.ExpandPermissionsWithTruncations(ctx, request)
+	}
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(otelCodes.Error, err.Error())
@@ -69,7 +78,34 @@ func (r *PermissionServer) Expand(ctx context.Context, request *v1.PermissionExp
 		return nil, status.Error(GetStatus(err), err.Error())
 	}
 	
-	return response, nil
+	// a truncated tree misses subjects that its intersections and exclusions need, so it is not flattened
+	if request.GetFlatten() {
+		if len(truncated) > 0 {
+			err = errors.New(v1.ErrorCode_ERROR_CODE_VALIDATION.String())
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, status.Error(GetStatus(err), err.Error())
+		}
+		
+		target := &v1.EntityAndRelation{Entity: request.GetEntity(), Relation: request.GetPermission()}
+		if leaf := response.GetTree().GetLeaf(); leaf != nil {
+			target = leaf.GetTarget()
+		}
+		
+		var tree *v1.Expand
+		tree, err = commands.FlattenExpand(target, response.GetTree())
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, status.Error(GetStatus(err), err.Error())
+		}
+		response = &v1.PermissionExpandResponse{Tree: tree}
+	}
+	
+	return &v1.PermissionExpandResponse{
+		Tree:      response.GetTree(),
+		Truncated: toExpandTruncations(truncated),
+	}, nil
 }
 
 // LookupSchema -
@@ -105,9 +141,7 @@ func (r *PermissionServer) LookupEntity(ctx context.Context, request *v1.Permiss
 		return nil, v
 	}
 	
-	var err error
-	var response *v1.PermissionLookupEntityResponse
-	response, err = r.permissionService.LookupEntity(ctx, request)
+	response, err := r.permissionService.LookupEntity(ctx, request)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(otelCodes.Error, err.Error())
@@ -138,3 +172,150 @@ func (r *PermissionServer) LookupEntityStream(request *v1.PermissionLookupEntity
 	
 	return nil
 }
+
+// LookupSubject - Ids of the subjects of the requested type that have the permission on the entity, the reverse of
+// the entity lookup
+func (r *PermissionServer) LookupSubject(ctx context.Context, request *v1.PermissionLookupSubjectRequest) (*v1.PermissionLookupSubjectResponse, error) {
+	ctx, span := tracer.Start(ctx, "permissions.lookup-subject")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	response, err := r.permissionService.LookupSubject(ctx, &commands.LookupSubjectRequest{
+		TenantID:   request.GetTenantId(),
+		Entity:     request.GetEntity(),
+		Permission: request.GetPermission(),
+		SubjectReference: &v1.RelationReference{
+			Type:     request.GetSubjectType(),
+			Relation: request.GetSubjectRelation(),
+		},
+		Metadata: &v1.PermissionLookupEntityRequestMetadata{
+			SnapToken:     request.GetMetadata().GetSnapToken(),
+			SchemaVersion: request.GetMetadata().GetSchemaVersion(),
+			Depth:         request.GetMetadata().GetDepth(),
+		},
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	
+	return &v1.PermissionLookupSubjectResponse{
+		SubjectIds: response.SubjectIDs,
+	}, nil
+}
+
+// BulkCheck - Results of the items in their order, each with the result of its check or the error code it failed with.
+// The metadata of the request applies to the items that have none.
+func (r *PermissionServer) BulkCheck(ctx context.Context, request *v1.PermissionBulkCheckRequest) (*v1.PermissionBulkCheckResponse, error) {
+	ctx, span := tracer.Start(ctx, "permissions.bulk-check")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	items := make([]*services.BulkCheckItem, 0, len(request.GetItems()))
+	for i, item := range request.GetItems() {
+		metadata := item.GetMetadata()
+		if metadata == nil {
+			metadata = &v1.PermissionCheckRequestMetadata{
+				SchemaVersion: request.GetMetadata().GetSchemaVersion(),
+				SnapToken:     request.GetMetadata().GetSnapToken(),
+				Depth:         request.GetMetadata().GetDepth(),
+				Consistency:   request.GetMetadata().GetConsistency(),
+			}
+		}
+		items = append(items, &services.BulkCheckItem{
+			ID: strconv.Itoa(i),
+			Request: &v1.PermissionCheckRequest{
+				TenantId:   request.GetTenantId(),
+				Metadata:   metadata,
+				Entity:     item.GetEntity(),
+				Permission: item.GetPermission(),
+				Subject:    item.GetSubject(),
+				Context:    item.GetContext(),
+			},
+		})
+	}
+	
+	results, err := r.permissionService.BulkCheck(ctx, items, 0, 0)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	
+	response := &v1.PermissionBulkCheckResponse{
+		Results: make([]*v1.PermissionBulkCheckResult, 0, len(results)),
+	}
+	for _, result := range results {
+		if result.Err != nil {
+			response.Results = append(response.Results, &v1.PermissionBulkCheckResult{Error: result.Err.Error()})
+			continue
+		}
+		response.Results = append(response.Results, &v1.PermissionBulkCheckResult{
+			Can:      result.Response.GetCan(),
+			Metadata: result.Response.GetMetadata(),
+		})
+	}
+	return response, nil
+}
+
+// BulkCheckStream - Checks the requests of the stream as they are received and sends each response as soon as its
+// check completes, a failing check is reported in its response and does not end the stream
+func (r *PermissionServer) BulkCheckStream(server v1.Permission_BulkCheckStreamServer) error {
+	ctx, span := tracer.Start(server.Context(), "permissions.bulk-check-stream")
+	defer span.End()
+	
+	recv := func() (*services.BulkCheckItem, error) {
+		request, err := server.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return &services.BulkCheckItem{ID: request.GetId(), Request: request.GetRequest()}, nil
+	}
+	send := func(result *services.BulkCheckResult) error {
+		if result.Err != nil {
+			return server.Send(&v1.PermissionBulkCheckStreamResponse{Id: result.ID, Error: result.Err.Error()})
+		}
+		return server.Send(&v1.PermissionBulkCheckStreamResponse{
+			Id:       result.ID,
+			Can:      result.Response.GetCan(),
+			Metadata: result.Response.GetMetadata(),
+		})
+	}
+	
+	err := r.permissionService.BulkCheckStream(ctx, recv, send, 0, 0)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		// the limit errors of the stream and the errors of the transport keep their status
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return status.Error(GetStatus(err), err.Error())
+	}
+	
+	return nil
+}
+
+// toExpandTruncations -
+func toExpandTruncations(truncated []commands.ExpandTruncation) []*v1.PermissionExpandTruncation {
+	result := make([]*v1.PermissionExpandTruncation, 0, len(truncated))
+	for _, t := range truncated {
+		result = append(result, &v1.PermissionExpandTruncation{
+			Target:       t.Target,
+			Continuation: t.Token,
+		})
+	}
+	return result
+}
@@ -0,0 +1,193 @@
+package servers
+
+import (
+	grpcAuth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/instrument"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	
+	"github.com/adminium/permify/internal/cluster"
+	"github.com/adminium/permify/internal/config"
+	"github.com/adminium/permify/internal/ratelimit"
+	"github.com/adminium/permify/pkg/database/raft"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// RateLimits - Throttles the requests of every tenant and api key to the configured rates of the endpoint classes.
+// The http endpoints are served through the grpc server, so they are throttled alike and answered with 429.
+type RateLimits struct {
+	limiter   *ratelimit.Limiter
+	throttled instrument.Int64Counter
+}
+
+// NewRateLimits - Creates new Rate Limits
+func NewRateLimits(cfg config.RateLimit, m metric.Meter) *RateLimits {
+	limits := &RateLimits{
+		limiter: ratelimit.New(map[ratelimit.Class]float64{
+			ratelimit.Read:  cfg.Read,
+			ratelimit.Write: cfg.Write,
+			ratelimit.Check: cfg.Check,
+		}, cfg.Burst),
+	}
+	if counter, err := m.Int64Counter("rate_limited_request_count", instrument.WithDescription("rate limited request count")); err == nil {
+		limits.throttled = counter
+	}
+	return limits
+}
+
+// RateLimitUnaryServerInterceptor - Rejects the requests of the callers that ran out of their rate
+func RateLimitUnaryServerInterceptor(limits *RateLimits) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := limits.allow(ctx, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// RateLimitStreamServerInterceptor - Stream variant of RateLimitUnaryServerInterceptor
+func RateLimitStreamServerInterceptor(limits *RateLimits) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &rateLimitServerStream{ServerStream: ss, limits: limits, method: info.FullMethod})
+	}
+}
+
+// rateLimitServerStream - Takes a token for every received message
+type rateLimitServerStream struct {
+	grpc.ServerStream
+	limits *RateLimits
+	method string
+}
+
+// RecvMsg -
+func (s *rateLimitServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.limits.allow(s.Context(), s.method, m)
+}
+
+// allow - The peers of the cluster and of the dispatch ring are not throttled, their requests were when they came in
+func (r *RateLimits) allow(ctx context.Context, method string, req interface{}) error {
+	if method == raft.ApplyMethod || method == cluster.CheckMethod {
+		return nil
+	}
+	// the methods that are not known are throttled as reads
+	class, _ := endpointClass(method, req)
+	tenantID := requestTenantID(req)
+	// a request without credentials is throttled with the others that have none
+	apiKey, _ := grpcAuth.AuthFromMD(ctx, "Bearer")
+	if r.limiter.Allow(class, tenantID, apiKey) {
+		return nil
+	}
+	if r.throttled != nil {
+		r.throttled.Add(ctx, 1, attribute.String("class", string(class)), attribute.String("tenant_id", tenantID))
+	}
+	return status.Errorf(codes.ResourceExhausted, "rate limit of the %s requests of tenant %s exceeded", class, tenantID)
+}
+
+// methodClasses - Classes of the full grpc methods, the peer apis are not in it, they are only called by the peers
+var methodClasses = map[string]ratelimit.Class{
+	"/base.v1.Permission/Check":              ratelimit.Check,
+	"/base.v1.Permission/BulkCheck":          ratelimit.Check,
+	"/base.v1.Permission/BulkCheckStream":    ratelimit.Check,
+	"/base.v1.Permission/Expand":             ratelimit.Read,
+	"/base.v1.Permission/LookupSchema":       ratelimit.Read,
+	"/base.v1.Permission/LookupEntity":       ratelimit.Read,
+	"/base.v1.Permission/LookupEntityStream": ratelimit.Read,
+	"/base.v1.Permission/LookupSubject":      ratelimit.Read,
+	
+	"/base.v1.Schema/Read":           ratelimit.Read,
+	"/base.v1.Schema/ListVersions":   ratelimit.Read,
+	"/base.v1.Schema/Diff":           ratelimit.Read,
+	"/base.v1.Schema/Lint":           ratelimit.Read,
+	"/base.v1.Schema/Complete":       ratelimit.Read,
+	"/base.v1.Schema/FindReferences": ratelimit.Read,
+	"/base.v1.Schema/Write":          ratelimit.Write,
+	"/base.v1.Schema/WriteVersion":   ratelimit.Write,
+	"/base.v1.Schema/Rollback":       ratelimit.Write,
+	
+	"/base.v1.Relationship/Read":           ratelimit.Read,
+	"/base.v1.Relationship/ReadDeleted":    ratelimit.Read,
+	"/base.v1.Relationship/ReadChanges":    ratelimit.Read,
+	"/base.v1.Relationship/Export":         ratelimit.Read,
+	"/base.v1.Relationship/Validate":       ratelimit.Read,
+	"/base.v1.Relationship/PlanMigration":  ratelimit.Read,
+	"/base.v1.Relationship/Write":          ratelimit.Write,
+	"/base.v1.Relationship/Delete":         ratelimit.Write,
+	"/base.v1.Relationship/BatchWrite":     ratelimit.Write,
+	"/base.v1.Relationship/Import":         ratelimit.Write,
+	"/base.v1.Relationship/ApplyMigration": ratelimit.Write,
+	
+	"/base.v1.Attribute/Read":   ratelimit.Read,
+	"/base.v1.Attribute/Write":  ratelimit.Write,
+	"/base.v1.Attribute/Delete": ratelimit.Write,
+	
+	"/base.v1.Settings/Read":  ratelimit.Read,
+	"/base.v1.Settings/Write": ratelimit.Write,
+	
+	"/base.v1.Identity/Map":   ratelimit.Write,
+	"/base.v1.Identity/Unmap": ratelimit.Write,
+	
+	"/base.v1.Tenancy/List":   ratelimit.Read,
+	"/base.v1.Tenancy/Read":   ratelimit.Read,
+	"/base.v1.Tenancy/Create": ratelimit.Write,
+	"/base.v1.Tenancy/Update": ratelimit.Write,
+	"/base.v1.Tenancy/Delete": ratelimit.Write,
+	
+	"/base.v1.Admin/ExplainQuery":    ratelimit.Read,
+	"/base.v1.Admin/ListAPIKeys":     ratelimit.Read,
+	"/base.v1.Admin/ListCheckTraces": ratelimit.Read,
+	"/base.v1.Admin/ReplayCheck":     ratelimit.Read,
+	"/base.v1.Admin/RingStats":       ratelimit.Read,
+	"/base.v1.Admin/Usage":           ratelimit.Read,
+	"/base.v1.Admin/WarmUp":          ratelimit.Check,
+	"/base.v1.Admin/CollectGarbage":  ratelimit.Write,
+	"/base.v1.Admin/CreateAPIKey":    ratelimit.Write,
+	"/base.v1.Admin/DeleteAPIKey":    ratelimit.Write,
+	"/base.v1.Admin/MigrateTenant":   ratelimit.Write,
+	"/base.v1.Admin/RefreshTenant":   ratelimit.Write,
+	
+	"/base.v1.Welcome/Hello":  ratelimit.Read,
+	"/base.v1.Watch/Watch":    ratelimit.Read,
+	"/base.v1.OpenFGA/Import": ratelimit.Write,
+	
+	"/base.v1.SCIM/ReadGroup":    ratelimit.Read,
+	"/base.v1.SCIM/CreateGroup":  ratelimit.Write,
+	"/base.v1.SCIM/ReplaceGroup": ratelimit.Write,
+	"/base.v1.SCIM/PatchGroup":   ratelimit.Write,
+	"/base.v1.SCIM/DeleteGroup":  ratelimit.Write,
+	
+	"/grpc.health.v1.Health/Check": ratelimit.Read,
+	"/grpc.health.v1.Health/Watch": ratelimit.Read,
+}
+
+// endpointClass - Class of the request of the full grpc method, a validation that deletes the invalid tuples is a
+// write. The methods that are not known are not reported, e.g. the ones of the peer apis and of the reflection.
+func endpointClass(method string, req interface{}) (ratelimit.Class, bool) {
+	class, ok := methodClasses[method]
+	if !ok {
+		return ratelimit.Read, false
+	}
+	if r, isValidate := req.(*base.RelationshipValidateRequest); isValidate && r.GetDelete() {
+		return ratelimit.Write, true
+	}
+	return class, true
+}
+
+// requestTenantID - Tenant of the generated requests, or the tenant_id field of the ones that take a struct
+func requestTenantID(req interface{}) string {
+	switch r := req.(type) {
+	case interface{ GetTenantId() string }:
+		return r.GetTenantId()
+	case *structpb.Struct:
+		return r.GetFields()["tenant_id"].GetStringValue()
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,26 @@
+package servers
+
+import (
+	"context"
+	
+	"google.golang.org/grpc/reflection"
+	reflectionV1Alpha "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+)
+
+// ReflectionServer - Describes the services of the server to clients like grpcurl. The descriptions are those of the
+// public api definitions, so the service is open to the callers that are not authenticated.
+type ReflectionServer struct {
+	reflectionV1Alpha.ServerReflectionServer
+}
+
+// NewReflectionServer - Creates new Reflection Server that describes the services of the provider
+func NewReflectionServer(services reflection.ServiceInfoProvider) *ReflectionServer {
+	return &ReflectionServer{
+		ServerReflectionServer: reflection.NewServer(reflection.ServerOptions{Services: services}),
+	}
+}
+
+// AuthFuncOverride -
+func (s *ReflectionServer) AuthFuncOverride(ctx context.Context, _ string) (context.Context, error) {
+	return ctx, nil
+}
@@ -2,18 +2,30 @@ package servers
 
 import (
 	"errors"
+	"fmt"
+	"sort"
+	"time"
 	
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	
 	otelCodes "go.opentelemetry.io/otel/codes"
 	"golang.org/x/net/context"
 	
+	"github.com/adminium/permify/internal/authn"
 	"github.com/adminium/permify/internal/services"
+	"github.com/adminium/permify/pkg/database"
 	"github.com/adminium/permify/pkg/logger"
+	"github.com/adminium/permify/pkg/migration"
 	v1 "github.com/adminium/permify/pkg/pb/base/v1"
 	"github.com/adminium/permify/pkg/tuple"
 )
 
+// _migrationBatchSize - New tuples of a migration written at once when the request does not give a batch size, the
+// limit of a write
+const _migrationBatchSize = 100
+
 // RelationshipServer - Structure for Relationship Server
 type RelationshipServer struct {
 	v1.UnimplementedRelationshipServer
@@ -40,7 +52,31 @@ func (r *RelationshipServer) Read(ctx context.Context, request *v1.RelationshipR
 		return nil, v
 	}
 	
-	collection, ct, err := r.relationshipService.ReadRelationships(ctx, request.GetTenantId(), request.GetFilter(), request.GetMetadata().GetSnapToken(), request.GetPageSize(), request.GetContinuousToken())
+	var collection *database.TupleCollection
+	var continuousToken string
+	var err error
+	at := request.GetMetadata().GetAt()
+	switch {
+	case at != nil && (request.GetMetadata().GetSnapToken() != "" || request.GetExpression() != ""):
+		err = errors.New(v1.ErrorCode_ERROR_CODE_VALIDATION.String())
+	case request.GetExpression() != "":
+		// an expression is read in a single page, it replaces the filter
+		if request.GetFilter() != nil || request.GetPageSize() != 0 || request.GetContinuousToken() != "" {
+			err = errors.New(v1.ErrorCode_ERROR_CODE_VALIDATION.String())
+		} else {
+			collection, err = r.relationshipService.FilterRelationships(ctx, request.GetTenantId(), request.GetExpression(), request.GetMetadata().GetSnapToken())
+		}
+	default:
+		var ct database.EncodedContinuousToken
+		if at != nil {
+			collection, ct, err = r.relationshipService.ReadRelationshipsAt(ctx, request.GetTenantId(), request.GetFilter(), at.AsTime(), request.GetPageSize(), request.GetContinuousToken())
+		} else {
+			collection, ct, err = r.relationshipService.ReadRelationships(ctx, request.GetTenantId(), request.GetFilter(), request.GetMetadata().GetSnapToken(), request.GetPageSize(), request.GetContinuousToken())
+		}
+		if err == nil {
+			continuousToken = ct.String()
+		}
+	}
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(otelCodes.Error, err.Error())
@@ -48,9 +84,69 @@ func (r *RelationshipServer) Read(ctx context.Context, request *v1.RelationshipR
 		return nil, status.Error(GetStatus(err), err.Error())
 	}
 	
+	tupleMetadata := make([]*v1.TupleMetadata, 0, len(collection.GetTuples()))
+	for _, m := range collection.GetMetadata() {
+		tupleMetadata = append(tupleMetadata, toTupleMetadata(m))
+	}
+	
 	return &v1.RelationshipReadResponse{
 		Tuples:          collection.GetTuples(),
-		ContinuousToken: ct.String(),
+		ContinuousToken: continuousToken,
+		Metadata:        tupleMetadata,
+	}, nil
+}
+
+// ReadDeleted - Reads the relation tuples deleted within the window, with the time of their deletion
+func (r *RelationshipServer) ReadDeleted(ctx context.Context, request *v1.RelationshipReadDeletedRequest) (*v1.RelationshipReadDeletedResponse, error) {
+	ctx, span := tracer.Start(ctx, "relationships.read-deleted")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	collection, err := r.relationshipService.ReadDeletedRelationships(ctx, request.GetTenantId(), request.GetFilter(), request.GetFrom().AsTime(), request.GetTo().AsTime())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	
+	tupleMetadata := make([]*v1.TupleMetadata, 0, len(collection.GetTuples()))
+	for _, m := range collection.GetMetadata() {
+		tupleMetadata = append(tupleMetadata, toTupleMetadata(m))
+	}
+	
+	return &v1.RelationshipReadDeletedResponse{
+		Tuples:   collection.GetTuples(),
+		Metadata: tupleMetadata,
+	}, nil
+}
+
+// ReadChanges - Read the relation tuples created and deleted between the snapshots of two tokens
+func (r *RelationshipServer) ReadChanges(ctx context.Context, request *v1.RelationshipReadChangesRequest) (*v1.RelationshipReadChangesResponse, error) {
+	ctx, span := tracer.Start(ctx, "relationships.read-changes")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	created, deleted, snap, err := r.relationshipService.ReadRelationshipChanges(ctx, request.GetTenantId(), request.GetFilter(), request.GetFromSnapToken(), request.GetToSnapToken())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	
+	return &v1.RelationshipReadChangesResponse{
+		Created:   created.GetTuples(),
+		Deleted:   deleted.GetTuples(),
+		SnapToken: snap,
 	}, nil
 }
 
@@ -72,6 +168,8 @@ func (r *RelationshipServer) Write(ctx context.Context, request *v1.Relationship
 		}
 	}
 	
+	ctx = database.ContextWithTupleMetadata(ctx, tupleMetadataFromRequest(ctx, request.GetMetadata()))
+	
 	snap, err := r.relationshipService.WriteRelationships(ctx, request.GetTenantId(), request.GetTuples(), request.GetMetadata().GetSchemaVersion())
 	if err != nil {
 		span.RecordError(err)
@@ -107,3 +205,334 @@ func (r *RelationshipServer) Delete(ctx context.Context, request *v1.Relationshi
 		SnapToken: snap.String(),
 	}, nil
 }
+
+// BatchWrite - Writes thousands of tuples at once for imports. A write takes up to 100 tuples, the batch write
+// validates all of its tuples and then commits them in a single transaction with a single snap token. When partial is
+// set, the valid tuples are written and the others are reported with their index and the error they failed with.
+func (r *RelationshipServer) BatchWrite(ctx context.Context, request *v1.RelationshipBatchWriteRequest) (*v1.RelationshipBatchWriteResponse, error) {
+	ctx, span := tracer.Start(ctx, "relationships.batch-write")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	partial := request.GetPartial()
+	
+	// indexes of the valid tuples in the batch, the invalid ones of a partial write are rejected right away
+	tuples := make([]*v1.Tuple, 0, len(request.GetTuples()))
+	indexes := make([]int, 0, len(request.GetTuples()))
+	var rejected []services.RejectedTuple
+	for i, tup := range request.GetTuples() {
+		if err := tup.Validate(); err != nil {
+			if !partial {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			rejected = append(rejected, services.RejectedTuple{Index: i, Tuple: tup, Reason: err.Error()})
+			continue
+		}
+		if tuple.IsSubjectUser(tup.GetSubject()) && tup.GetSubject().GetRelation() != "" {
+			err := errors.New(v1.ErrorCode_ERROR_CODE_SUBJECT_RELATION_MUST_BE_EMPTY.String())
+			if !partial {
+				return nil, err
+			}
+			rejected = append(rejected, services.RejectedTuple{Index: i, Tuple: tup, Reason: err.Error()})
+			continue
+		}
+		tuples = append(tuples, tup)
+		indexes = append(indexes, i)
+	}
+	
+	ctx = database.ContextWithTupleMetadata(ctx, tupleMetadataFromRequest(ctx, request.GetMetadata()))
+	
+	if !partial {
+		snap, err := r.relationshipService.BatchWriteRelationships(ctx, request.GetTenantId(), tuples, request.GetMetadata().GetSchemaVersion())
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			r.logger.Error(err.Error())
+			return nil, status.Error(GetStatus(err), err.Error())
+		}
+		
+		return &v1.RelationshipBatchWriteResponse{
+			SnapToken: snap.String(),
+			Written:   uint32(len(tuples)),
+		}, nil
+	}
+	
+	response := &v1.RelationshipBatchWriteResponse{}
+	if len(tuples) > 0 {
+		snap, invalid, err := r.relationshipService.BatchWriteRelationshipsPartially(ctx, request.GetTenantId(), tuples, request.GetMetadata().GetSchemaVersion())
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			r.logger.Error(err.Error())
+			return nil, status.Error(GetStatus(err), err.Error())
+		}
+		for _, i := range invalid {
+			i.Index = indexes[i.Index]
+			rejected = append(rejected, i)
+		}
+		response.Written = uint32(len(tuples) - len(invalid))
+		if snap != nil {
+			response.SnapToken = snap.String()
+		}
+	}
+	
+	sort.Slice(rejected, func(i, j int) bool {
+		return rejected[i].Index < rejected[j].Index
+	})
+	for _, t := range rejected {
+		response.Rejected = append(response.Rejected, &v1.RelationshipBatchWriteRejection{
+			Index: uint32(t.Index),
+			Error: t.Reason,
+		})
+	}
+	if len(rejected) > 0 {
+		r.logger.Warn("rejected %d of %d tuples of a partial batch write of tenant %s", len(rejected), len(request.GetTuples()), request.GetTenantId())
+	}
+	
+	return response, nil
+}
+
+// Validate - Reports the invalid tuples of a page of the tuples of the tenant and deletes them when delete is set
+func (r *RelationshipServer) Validate(ctx context.Context, request *v1.RelationshipValidateRequest) (*v1.RelationshipValidateResponse, error) {
+	ctx, span := tracer.Start(ctx, "relationships.validate")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	invalid, ct, err := r.relationshipService.ValidateData(ctx, request.GetTenantId(), request.GetMetadata().GetSchemaVersion(), request.GetPageSize(), request.GetContinuousToken(), request.GetDelete())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	
+	violations := make([]*v1.RelationshipViolation, 0, len(invalid))
+	for _, i := range invalid {
+		violations = append(violations, &v1.RelationshipViolation{
+			Tuple:  i.Tuple,
+			Reason: i.Reason,
+		})
+	}
+	if request.GetDelete() && len(invalid) > 0 {
+		r.logger.Warn("deleted %d tuples of tenant %s that do not conform to the schema", len(invalid), request.GetTenantId())
+	}
+	
+	return &v1.RelationshipValidateResponse{
+		Invalid:         violations,
+		Deleted:         request.GetDelete(),
+		ContinuousToken: ct.String(),
+	}, nil
+}
+
+// Export - Streams the tuples of the tenant in pages, the last page has the snap token the tuples were read at
+func (r *RelationshipServer) Export(request *v1.RelationshipExportRequest, server v1.Relationship_ExportServer) error {
+	ctx, span := tracer.Start(server.Context(), "relationships.export")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return v
+	}
+	
+	filter := &v1.TupleFilter{}
+	if request.GetEntityType() != "" {
+		filter.Entity = &v1.EntityFilter{Type: request.GetEntityType()}
+	}
+	
+	page := make([]*v1.Tuple, 0, _transferPageSize)
+	snap, err := r.relationshipService.ExportRelationships(ctx, request.GetTenantId(), filter, request.GetMetadata().GetSnapToken(), func(t *v1.Tuple) error {
+		page = append(page, t)
+		if len(page) < _transferPageSize {
+			return nil
+		}
+		err := server.Send(&v1.RelationshipExportResponse{
+			Tuples: page,
+		})
+		page = make([]*v1.Tuple, 0, _transferPageSize)
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return status.Error(GetStatus(err), err.Error())
+	}
+	
+	return server.Send(&v1.RelationshipExportResponse{
+		Tuples:    page,
+		SnapToken: snap,
+	})
+}
+
+// Import - Writes the streamed tuples in batches that are validated and written atomically each. A failing batch
+// stops the import and the batches before it stay written, the import can be run again as writes of existing tuples
+// are no-ops.
+func (r *RelationshipServer) Import(server v1.Relationship_ImportServer) error {
+	ctx, span := tracer.Start(server.Context(), "relationships.import")
+	defer span.End()
+	
+	first, err := server.Recv()
+	if err != nil {
+		return err
+	}
+	v := first.Validate()
+	if v != nil {
+		return v
+	}
+	if first.GetTenantId() == "" {
+		return status.Error(codes.InvalidArgument, "tenant_id is required")
+	}
+	
+	tuples := first.GetTuples()
+	next := func() (*v1.Tuple, error) {
+		for len(tuples) == 0 {
+			request, err := server.Recv()
+			if err != nil {
+				return nil, err
+			}
+			tuples = request.GetTuples()
+		}
+		t := tuples[0]
+		tuples = tuples[1:]
+		if err := t.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("%s: %s", err.Error(), tuple.ToString(t)))
+		}
+		return t, nil
+	}
+	
+	imported, snap, err := r.relationshipService.ImportRelationships(ctx, first.GetTenantId(), first.GetMetadata().GetSchemaVersion(), next, _transferPageSize)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(fmt.Sprintf("import into tenant %s stopped after %d tuples: %s", first.GetTenantId(), imported, err.Error()))
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return status.Error(GetStatus(err), err.Error())
+	}
+	
+	return server.SendAndClose(&v1.RelationshipImportResponse{
+		Imported:  uint32(imported),
+		SnapToken: snap.String(),
+	})
+}
+
+// PlanMigration - Generates the tuple rewrites of the rules without writing them
+func (r *RelationshipServer) PlanMigration(ctx context.Context, request *v1.RelationshipPlanMigrationRequest) (*v1.RelationshipPlanMigrationResponse, error) {
+	ctx, span := tracer.Start(ctx, "relationships.plan-migration")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	plan, err := r.relationshipService.PlanMigration(ctx, request.GetTenantId(), migration.SpecFromRules(request.GetRules()), request.GetMetadata().GetSnapToken())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	
+	return &v1.RelationshipPlanMigrationResponse{
+		Rewrites: plan.ToRewrites(),
+	}, nil
+}
+
+// ApplyMigration - Plans the tuple rewrites of the rules and applies them in batches, the progress is sent after
+// every batch. A failing batch stops the migration and the batches before it stay applied.
+func (r *RelationshipServer) ApplyMigration(request *v1.RelationshipApplyMigrationRequest, server v1.Relationship_ApplyMigrationServer) error {
+	ctx, span := tracer.Start(server.Context(), "relationships.apply-migration")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return v
+	}
+	
+	batchSize := int(request.GetBatchSize())
+	if batchSize == 0 {
+		batchSize = _migrationBatchSize
+	}
+	
+	plan, err := r.relationshipService.PlanMigration(ctx, request.GetTenantId(), migration.SpecFromRules(request.GetRules()), request.GetMetadata().GetSnapToken())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return status.Error(GetStatus(err), err.Error())
+	}
+	
+	// the progress of the last batch is sent with the snap token once the migration is done
+	var sendErr error
+	var applied int
+	snap, err := r.relationshipService.ApplyMigration(ctx, request.GetTenantId(), plan, batchSize, func(a, total int) {
+		applied = a
+		if a == total || sendErr != nil {
+			return
+		}
+		sendErr = server.Send(&v1.RelationshipApplyMigrationResponse{
+			Applied: uint32(a),
+			Total:   uint32(total),
+		})
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(fmt.Sprintf("migration of tenant %s stopped after %d of %d rewrites: %s", request.GetTenantId(), applied, plan.Len(), err.Error()))
+		return status.Error(GetStatus(err), err.Error())
+	}
+	if sendErr != nil {
+		return sendErr
+	}
+	
+	response := &v1.RelationshipApplyMigrationResponse{
+		Applied: uint32(applied),
+		Total:   uint32(plan.Len()),
+	}
+	// an empty plan writes nothing, so it has no snap token
+	if snap != nil {
+		response.SnapToken = snap.String()
+	}
+	return server.Send(response)
+}
+
+// toTupleMetadata - Metadata of a read tuple, empty for tuples read without metadata
+func toTupleMetadata(m *database.TupleMetadata) *v1.TupleMetadata {
+	if m == nil {
+		return &v1.TupleMetadata{}
+	}
+	timestamp := func(t time.Time) *timestamppb.Timestamp {
+		if t.IsZero() {
+			return nil
+		}
+		return timestamppb.New(t)
+	}
+	return &v1.TupleMetadata{
+		CreatedAt:     timestamp(m.CreatedAt),
+		CreatedBy:     m.CreatedBy,
+		Reference:     m.Reference,
+		SchemaVersion: m.SchemaVersion,
+		NotBefore:     timestamp(m.NotBefore),
+		NotAfter:      timestamp(m.NotAfter),
+		DeletedAt:     timestamp(m.DeletedAt),
+	}
+}
+
+// tupleMetadataFromRequest - The written tuples are attributed to the caller the request was authenticated as and the
+// external reference comes from the request, the validity windows are the ones of the tuples
+func tupleMetadataFromRequest(ctx context.Context, request *v1.RelationshipWriteRequestMetadata) database.TupleMetadata {
+	return database.TupleMetadata{
+		CreatedBy: authn.CallerFromContext(ctx),
+		Reference: request.GetReference(),
+	}
+}
@@ -0,0 +1,78 @@
+package servers
+
+import (
+	otelCodes "go.opentelemetry.io/otel/codes"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	
+	"github.com/adminium/permify/internal/services"
+	db "github.com/adminium/permify/pkg/database/raft"
+	"github.com/adminium/permify/pkg/logger"
+)
+
+// ReplicationServer - Receives the writes the followers of a raft cluster forward to the leader. It is only
+// registered on the grpc server, the nodes reach each other with the credentials of the clients that wrote.
+type ReplicationServer struct {
+	replicationService services.IReplicationService
+	logger             logger.Interface
+}
+
+// NewReplicationServer - Creates new Replication Server
+func NewReplicationServer(r services.IReplicationService, l logger.Interface) *ReplicationServer {
+	return &ReplicationServer{
+		replicationService: r,
+		logger:             l,
+	}
+}
+
+// Apply - Applies a forwarded command, the errors keep the error codes of the writers
+func (r *ReplicationServer) Apply(ctx context.Context, request *wrapperspb.BytesValue) (*wrapperspb.BytesValue, error) {
+	ctx, span := tracer.Start(ctx, "replication.apply")
+	defer span.End()
+	
+	response, err := r.replicationService.Apply(ctx, request.GetValue())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	return wrapperspb.Bytes(response), nil
+}
+
+// registerReplicationServer -
+func registerReplicationServer(s *grpc.Server, srv *ReplicationServer) {
+	s.RegisterService(&grpc.ServiceDesc{
+		ServiceName: "permify.raft.v1.Cluster",
+		HandlerType: (*interface{})(nil),
+		Methods: []grpc.MethodDesc{
+			{
+				MethodName: "Apply",
+				Handler:    replicationApplyHandler,
+			},
+		},
+		Streams:  []grpc.StreamDesc{},
+		Metadata: "replication",
+	}, srv)
+}
+
+// replicationApplyHandler - Decodes the request and runs it through the interceptor chain like the generated handlers
+func replicationApplyHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.BytesValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(*ReplicationServer).Apply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: db.ApplyMethod,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(*ReplicationServer).Apply(ctx, req.(*wrapperspb.BytesValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
@@ -1,11 +1,15 @@
 package servers
 
 import (
+	"errors"
+	
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	
 	otelCodes "go.opentelemetry.io/otel/codes"
 	"golang.org/x/net/context"
 	
+	"github.com/adminium/permify/internal/schema"
 	"github.com/adminium/permify/internal/services"
 	"github.com/adminium/permify/pkg/logger"
 	v1 "github.com/adminium/permify/pkg/pb/base/v1"
@@ -64,3 +68,239 @@ func (r *SchemaServer) Read(ctx context.Context, request *v1.SchemaReadRequest)
 		Schema: response,
 	}, nil
 }
+
+// ListVersions - Lists the versions of the schema, the latest first
+func (r *SchemaServer) ListVersions(ctx context.Context, request *v1.SchemaListVersionsRequest) (*v1.SchemaListVersionsResponse, error) {
+	ctx, span := tracer.Start(ctx, "schemas.versions.list")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	versions, ct, err := r.schemaService.ListSchemaVersions(ctx, request.GetTenantId(), request.GetPageSize(), request.GetContinuousToken())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	
+	return &v1.SchemaListVersionsResponse{
+		Versions:        versions,
+		ContinuousToken: ct.String(),
+	}, nil
+}
+
+// Rollback - Makes the schema of the version the head again and returns the new version it is written with
+func (r *SchemaServer) Rollback(ctx context.Context, request *v1.SchemaRollbackRequest) (*v1.SchemaRollbackResponse, error) {
+	ctx, span := tracer.Start(ctx, "schemas.versions.rollback")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	version, err := r.schemaService.RollbackSchema(ctx, request.GetTenantId(), request.GetSchemaVersion())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	
+	return &v1.SchemaRollbackResponse{
+		SchemaVersion: version,
+	}, nil
+}
+
+// WriteVersion - Writes the schema with the version of the request, which has to be newer than the head version
+func (r *SchemaServer) WriteVersion(ctx context.Context, request *v1.SchemaWriteVersionRequest) (*v1.SchemaWriteVersionResponse, error) {
+	ctx, span := tracer.Start(ctx, "schemas.versions.write")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	err := r.schemaService.WriteSchemaVersion(ctx, request.GetTenantId(), request.GetSchema(), request.GetSchemaVersion())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	
+	return &v1.SchemaWriteVersionResponse{
+		SchemaVersion: request.GetSchemaVersion(),
+	}, nil
+}
+
+// Diff - Compares the schema to the head version and writes it unless dry_run is set. Writes that would orphan
+// existing tuples fail with FailedPrecondition and carry the changes orphaning them as details, unless force is set.
+func (r *SchemaServer) Diff(ctx context.Context, request *v1.SchemaDiffRequest) (*v1.SchemaDiffResponse, error) {
+	ctx, span := tracer.Start(ctx, "schemas.versions.diff")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	var version string
+	var changes []schema.Change
+	var err error
+	if request.GetDryRun() {
+		changes, err = r.schemaService.DiffSchema(ctx, request.GetTenantId(), request.GetSchema())
+	} else {
+		version, changes, err = r.schemaService.WriteSchemaWithDiff(ctx, request.GetTenantId(), request.GetSchema(), request.GetForce())
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		if errors.Is(err, services.ErrOrphanedTuples) {
+			st := status.New(codes.FailedPrecondition, err.Error())
+			if withDetails, e := st.WithDetails(&v1.SchemaDiffResponse{Changes: toSchemaChanges(changes), Breaking: true}); e == nil {
+				st = withDetails
+			}
+			return nil, st.Err()
+		}
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	
+	return &v1.SchemaDiffResponse{
+		SchemaVersion: version,
+		Changes:       toSchemaChanges(changes),
+		Breaking:      schema.HasBreakingChanges(changes),
+	}, nil
+}
+
+// FindReferences - Lists the relations and actions referring to the relation or entity type of the request
+func (r *SchemaServer) FindReferences(ctx context.Context, request *v1.SchemaFindReferencesRequest) (*v1.SchemaFindReferencesResponse, error) {
+	ctx, span := tracer.Start(ctx, "schemas.find-references")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	references, err := r.schemaService.FindReferences(ctx, request.GetTenantId(), request.GetMetadata().GetSchemaVersion(), request.GetEntityType(), request.GetRelation())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	
+	response := &v1.SchemaFindReferencesResponse{
+		References: make([]*v1.SchemaReference, 0, len(references)),
+	}
+	for _, reference := range references {
+		response.References = append(response.References, &v1.SchemaReference{
+			EntityType: reference.EntityType,
+			Name:       reference.Name,
+			Kind:       string(reference.Kind),
+		})
+	}
+	return response, nil
+}
+
+// Complete - Lists the relations, with their subject types, and the permissions of the entity type of the request
+// with their deprecation flags and doc comments
+func (r *SchemaServer) Complete(ctx context.Context, request *v1.SchemaCompleteRequest) (*v1.SchemaCompleteResponse, error) {
+	ctx, span := tracer.Start(ctx, "schemas.complete")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	completion, err := r.schemaService.Complete(ctx, request.GetTenantId(), request.GetMetadata().GetSchemaVersion(), request.GetEntityType())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	
+	response := &v1.SchemaCompleteResponse{
+		EntityType:  completion.EntityType,
+		Doc:         completion.Doc,
+		Relations:   make([]*v1.SchemaRelationCompletion, 0, len(completion.Relations)),
+		Permissions: make([]*v1.SchemaPermissionCompletion, 0, len(completion.Permissions)),
+	}
+	for _, relation := range completion.Relations {
+		response.Relations = append(response.Relations, &v1.SchemaRelationCompletion{
+			Name:         relation.Name,
+			Doc:          relation.Doc,
+			Deprecated:   relation.Deprecated,
+			SubjectTypes: relation.SubjectTypes,
+		})
+	}
+	for _, permission := range completion.Permissions {
+		response.Permissions = append(response.Permissions, &v1.SchemaPermissionCompletion{
+			Name:       permission.Name,
+			Doc:        permission.Doc,
+			Deprecated: permission.Deprecated,
+		})
+	}
+	return response, nil
+}
+
+// Lint - Lists the warnings of the schema of the request, or of the schema version, with the positions of the
+// statements they are about
+func (r *SchemaServer) Lint(ctx context.Context, request *v1.SchemaLintRequest) (*v1.SchemaLintResponse, error) {
+	ctx, span := tracer.Start(ctx, "schemas.lint")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	warnings, err := r.schemaService.Lint(ctx, request.GetTenantId(), request.GetMetadata().GetSchemaVersion(), request.GetSchema())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	
+	response := &v1.SchemaLintResponse{
+		Warnings: make([]*v1.SchemaLintWarning, 0, len(warnings)),
+	}
+	for _, warning := range warnings {
+		response.Warnings = append(response.Warnings, &v1.SchemaLintWarning{
+			Kind:       string(warning.Kind),
+			EntityType: warning.EntityType,
+			Name:       warning.Name,
+			Message:    warning.Message,
+			Line:       uint32(warning.Position.Line),
+			Column:     uint32(warning.Position.Column),
+		})
+	}
+	return response, nil
+}
+
+// toSchemaChanges -
+func toSchemaChanges(changes []schema.Change) []*v1.SchemaChange {
+	result := make([]*v1.SchemaChange, 0, len(changes))
+	for _, change := range changes {
+		result = append(result, &v1.SchemaChange{
+			Kind:              string(change.Kind),
+			EntityType:        change.EntityType,
+			Name:              change.Name,
+			NewName:           change.NewName,
+			AddedReferences:   change.AddedReferences,
+			RemovedReferences: change.RemovedReferences,
+			Breaking:          change.Breaking(),
+		})
+	}
+	return result
+}
@@ -0,0 +1,272 @@
+package servers
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	otelCodes "go.opentelemetry.io/otel/codes"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	
+	"github.com/adminium/permify/internal/services"
+	"github.com/adminium/permify/pkg/logger"
+	v1 "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+const (
+	// _scimGroupSchema - Schema of the scim group resources
+	_scimGroupSchema = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	// _scimErrorSchema - Schema of the scim error responses
+	_scimErrorSchema = "urn:ietf:params:scim:api:messages:2.0:Error"
+	// _scimContentType - Media type of the scim responses
+	_scimContentType = "application/scim+json"
+	// _scimPathSegment - Segment of the http routes of the scim endpoint
+	_scimPathSegment = "/scim/v2/"
+)
+
+// _scimMemberPath - Patch path that selects a single member, e.g. members[value eq "alice"]
+var _scimMemberPath = regexp.MustCompile(`(?i)^members\[value eq "([^"]*)"]$`)
+
+// SCIMServer - Accepts the group and membership events of identity providers as a scim 2.0 groups endpoint, so
+// the changes of the groups propagate to the tuples without glue services
+type SCIMServer struct {
+	v1.UnimplementedSCIMServer
+	
+	scimService services.ISCIMService
+	logger      logger.Interface
+}
+
+// NewSCIMServer - Creates new SCIM Server
+func NewSCIMServer(s services.ISCIMService, l logger.Interface) *SCIMServer {
+	return &SCIMServer{
+		scimService: s,
+		logger:      l,
+	}
+}
+
+// CreateGroup - Provisions the group, its id is the externalId of the resource or its displayName when it has none
+func (r *SCIMServer) CreateGroup(ctx context.Context, request *v1.SCIMCreateGroupRequest) (*v1.SCIMGroup, error) {
+	ctx, span := tracer.Start(ctx, "scim.create-group")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	id := request.GetExternalId()
+	if id == "" {
+		id = request.GetDisplayName()
+	}
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "externalId or displayName is required")
+	}
+	
+	group, err := r.scimService.CreateGroup(ctx, request.GetTenantId(), services.SCIMGroup{ID: id, Members: scimMembers(request.GetMembers())})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	return scimGroupResponse(group, request.GetDisplayName()), nil
+}
+
+// ReplaceGroup - Sets the members of the group to the ones of the resource
+func (r *SCIMServer) ReplaceGroup(ctx context.Context, request *v1.SCIMReplaceGroupRequest) (*v1.SCIMGroup, error) {
+	ctx, span := tracer.Start(ctx, "scim.replace-group")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	group, err := r.scimService.ReplaceGroup(ctx, request.GetTenantId(), services.SCIMGroup{ID: request.GetId(), Members: scimMembers(request.GetMembers())})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	return scimGroupResponse(group, request.GetDisplayName()), nil
+}
+
+// PatchGroup - Applies the operations of the patch request that change the members, the ones on the other attributes
+// are ignored since only the memberships are stored
+func (r *SCIMServer) PatchGroup(ctx context.Context, request *v1.SCIMPatchGroupRequest) (*v1.SCIMGroup, error) {
+	ctx, span := tracer.Start(ctx, "scim.patch-group")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	group, err := r.scimService.PatchGroup(ctx, request.GetTenantId(), request.GetId(), scimOperations(request.GetOperations()))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	return scimGroupResponse(group, ""), nil
+}
+
+// DeleteGroup - Deprovisions the group
+func (r *SCIMServer) DeleteGroup(ctx context.Context, request *v1.SCIMDeleteGroupRequest) (*v1.SCIMDeleteGroupResponse, error) {
+	ctx, span := tracer.Start(ctx, "scim.delete-group")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	if err := r.scimService.DeleteGroup(ctx, request.GetTenantId(), request.GetId()); err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	return &v1.SCIMDeleteGroupResponse{}, nil
+}
+
+// ReadGroup - The group with its members
+func (r *SCIMServer) ReadGroup(ctx context.Context, request *v1.SCIMReadGroupRequest) (*v1.SCIMGroup, error) {
+	ctx, span := tracer.Start(ctx, "scim.read-group")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	group, err := r.scimService.ReadGroup(ctx, request.GetTenantId(), request.GetId())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	return scimGroupResponse(group, ""), nil
+}
+
+// scimMembers - Values of the members of a scim resource
+func scimMembers(members []*v1.SCIMMember) []string {
+	values := make([]string, 0, len(members))
+	for _, member := range members {
+		if member.GetValue() != "" {
+			values = append(values, member.GetValue())
+		}
+	}
+	return values
+}
+
+// scimValueMembers - Values of the members given as the json value of a patch operation, e.g. [{"value": "alice"}]
+func scimValueMembers(value *structpb.Value) []string {
+	var members []string
+	for _, member := range value.GetListValue().GetValues() {
+		if v := member.GetStructValue().GetFields()["value"].GetStringValue(); v != "" {
+			members = append(members, v)
+		}
+	}
+	return members
+}
+
+// scimOperations - Member changes of the operations of a patch request. The members are either the value of the
+// operation, the members of its value when it has no path, or the one selected by a members[value eq "x"] path.
+func scimOperations(operations []*v1.SCIMPatchOperation) []services.SCIMOperation {
+	var changes []services.SCIMOperation
+	for _, operation := range operations {
+		path := operation.GetPath()
+		
+		var members []string
+		switch {
+		case path == "":
+			// the value holds the attributes the operation changes, only the members are stored
+			mv, ok := operation.GetValue().GetStructValue().GetFields()["members"]
+			if !ok {
+				continue
+			}
+			members = scimValueMembers(mv)
+		case strings.EqualFold(path, "members"):
+			members = scimValueMembers(operation.GetValue())
+		case _scimMemberPath.MatchString(path):
+			members = []string{_scimMemberPath.FindStringSubmatch(path)[1]}
+		default:
+			continue
+		}
+		
+		changes = append(changes, services.SCIMOperation{Op: strings.ToLower(operation.GetOp()), Members: members})
+	}
+	return changes
+}
+
+// scimGroupResponse - The scim group resource of the group
+func scimGroupResponse(group services.SCIMGroup, displayName string) *v1.SCIMGroup {
+	if displayName == "" {
+		displayName = group.ID
+	}
+	members := make([]*v1.SCIMMember, 0, len(group.Members))
+	for _, member := range group.Members {
+		members = append(members, &v1.SCIMMember{Value: member})
+	}
+	return &v1.SCIMGroup{
+		Schemas:     []string{_scimGroupSchema},
+		Id:          group.ID,
+		DisplayName: displayName,
+		Members:     members,
+		Meta:        &v1.SCIMMeta{ResourceType: "Group"},
+	}
+}
+
+// scimError - Error response of scim 2.0
+type scimError struct {
+	Schemas []string `json:"schemas"`
+	Status  string   `json:"status"`
+	Detail  string   `json:"detail"`
+}
+
+// scimForwardResponse - Writes the responses of the scim endpoint with the media type and the status codes the
+// identity providers expect
+func scimForwardResponse(ctx context.Context, w http.ResponseWriter, message proto.Message) error {
+	switch message.(type) {
+	case *v1.SCIMGroup:
+		w.Header().Set("Content-Type", _scimContentType)
+		if method, ok := runtime.RPCMethod(ctx); ok && method == "/base.v1.SCIM/CreateGroup" {
+			w.WriteHeader(http.StatusCreated)
+		}
+	case *v1.SCIMDeleteGroupResponse:
+		w.Header().Set("Content-Type", _scimContentType)
+		w.WriteHeader(http.StatusNoContent)
+	}
+	return nil
+}
+
+// scimErrorHandler - Writes the errors of the scim endpoint as scim error responses and the others as usual
+func scimErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, req *http.Request, err error) {
+	if !strings.Contains(req.URL.Path, _scimPathSegment) {
+		runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, req, err)
+		return
+	}
+	s := status.Convert(err)
+	code := runtime.HTTPStatusFromCode(s.Code())
+	buf, merr := json.Marshal(scimError{
+		Schemas: []string{_scimErrorSchema},
+		Status:  strconv.Itoa(code),
+		Detail:  s.Message(),
+	})
+	w.Header().Set("Content-Type", _scimContentType)
+	w.WriteHeader(code)
+	if merr == nil {
+		_, _ = w.Write(buf)
+	}
+}
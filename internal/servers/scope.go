@@ -0,0 +1,83 @@
+package servers
+
+import (
+	"strings"
+	
+	"golang.org/x/exp/slices"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	
+	"github.com/adminium/permify/internal/authn"
+	"github.com/adminium/permify/internal/ratelimit"
+	"github.com/adminium/permify/pkg/storage"
+)
+
+// adminService - Prefix of the full grpc methods of the admin service
+const adminService = "/base.v1.Admin/"
+
+// ScopeUnaryServerInterceptor - Rejects the requests that the restricted caller they were authenticated as, a stored
+// api key or a token with tenant and scope claims, may not make before any command runs for them. The requests of the
+// callers that are not restricted are not checked.
+func ScopeUnaryServerInterceptor(peerKeys map[string]string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := checkScope(ctx, info.FullMethod, req, peerKeys); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// ScopeStreamServerInterceptor - Stream variant of ScopeUnaryServerInterceptor
+func ScopeStreamServerInterceptor(peerKeys map[string]string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &scopeServerStream{ServerStream: ss, method: info.FullMethod, peerKeys: peerKeys})
+	}
+}
+
+// scopeServerStream - Checks the scope of the caller for every received message
+type scopeServerStream struct {
+	grpc.ServerStream
+	method   string
+	peerKeys map[string]string
+}
+
+// RecvMsg -
+func (s *scopeServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return checkScope(s.Context(), s.method, m, s.peerKeys)
+}
+
+// checkScope - The peer apis are only open to the peers, the admin endpoints are only open to the callers that are not
+// restricted, a caller restricted to tenants may only make the requests of one of them, and a read caller may not make
+// the requests of the write endpoints. A restricted caller may not call the methods whose class is not known.
+func checkScope(ctx context.Context, method string, req interface{}, peerKeys map[string]string) error {
+	principal, restricted := authn.PrincipalFromContext(ctx)
+	if key, ok := peerKeys[method]; ok {
+		// the peers forward the requests of the clients, the scope of the client was checked where the request came in
+		if authn.IsPeer(ctx, key) || (key == "" && !restricted) {
+			return nil
+		}
+		return status.Errorf(codes.PermissionDenied, "only the peers may call %s", method)
+	}
+	if !restricted {
+		return nil
+	}
+	if strings.HasPrefix(method, adminService) {
+		return status.Errorf(codes.PermissionDenied, "%s %s may not call the admin endpoints", principal.Kind, principal.ID)
+	}
+	class, known := endpointClass(method, req)
+	if !known {
+		return status.Errorf(codes.PermissionDenied, "%s %s may not call %s", principal.Kind, principal.ID, method)
+	}
+	if tenantID := requestTenantID(req); len(principal.Tenants) > 0 && !slices.Contains(principal.Tenants, tenantID) {
+		return status.Errorf(codes.PermissionDenied, "%s %s may not call tenant %q", principal.Kind, principal.ID, tenantID)
+	}
+	if principal.Scope != storage.APIKeyScopeReadWrite && class == ratelimit.Write {
+		return status.Errorf(codes.PermissionDenied, "%s %s may not write", principal.Kind, principal.ID)
+	}
+	return nil
+}
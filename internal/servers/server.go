@@ -7,6 +7,7 @@ import (
 	"net"
 	"net/http"
 	"net/http/pprof"
+	"strings"
 	"time"
 	
 	grpcAuth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
@@ -20,65 +21,166 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/grpc/reflection"
+	reflectionV1Alpha "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
 	"google.golang.org/protobuf/encoding/protojson"
 	
 	health "google.golang.org/grpc/health/grpc_health_v1"
 	
+	"github.com/adminium/permify/internal/authn"
 	"github.com/adminium/permify/internal/authn/oidc"
 	"github.com/adminium/permify/internal/authn/preshared"
 	"github.com/adminium/permify/internal/config"
+	"github.com/adminium/permify/internal/gc"
+	"github.com/adminium/permify/internal/repositories"
 	"github.com/adminium/permify/internal/servers/middleware"
 	"github.com/adminium/permify/internal/services"
+	"github.com/adminium/permify/pkg/cache"
 	"github.com/adminium/permify/pkg/logger"
 	grpcV1 "github.com/adminium/permify/pkg/pb/base/v1"
 )
 
 var tracer = otel.Tracer("servers")
 
+// HeaderPrefix - Prefix of the request and response headers of permify, the grpc metadata and the http headers of the
+// gateway have the same names
+const HeaderPrefix = "permify-"
+
 // ServiceContainer -
 type ServiceContainer struct {
 	RelationshipService services.IRelationshipService
 	PermissionService   services.IPermissionService
 	SchemaService       services.ISchemaService
 	TenancyService      services.ITenancyService
+	// IdentityService resolves external subject identifiers, nil when identity mapping is disabled
+	IdentityService services.IIdentityService
+	// AdminService serves the operational endpoints, nil when they are not registered
+	AdminService *services.AdminService
+	// AttributeService manages the attributes that rules are evaluated with, nil when the storage has no attributes
+	AttributeService services.IAttributeService
+	// WatchService streams the changes of relation tuples, nil when the storage cannot stream them
+	WatchService services.IWatchService
+	// SettingsService manages the engine behavior of single tenants, nil when the storage has no settings
+	SettingsService services.ISettingsService
+	// SCIMService syncs the groups of identity providers into tuples, nil when the scim endpoint is disabled
+	SCIMService services.ISCIMService
+	// OpenFGAService imports the authorization models and tuples of OpenFGA, nil when the import is not registered
+	OpenFGAService services.IOpenFGAService
+	// ReplicationService applies the writes forwarded by the followers of a raft cluster, nil for the other engines
+	ReplicationService services.IReplicationService
+	// DispatchService checks the subproblems routed by the peers of the dispatch ring, nil when dispatch is disabled
+	DispatchService services.IDispatchService
+	// FallbackCache keeps the last known results of checks, nil when the degraded mode is disabled
+	FallbackCache cache.Cache
+	// SnapshotHorizon rejects the snap tokens older than the window of the garbage collection, nil when it is disabled
+	SnapshotHorizon *gc.Horizon
+	// APIKeyReader reads the api keys accepted besides the preshared ones, nil when the storage has no api keys
+	APIKeyReader repositories.APIKeyReader
+	// PeerKeys are the keys the peers call the methods of the peer apis with, by the full method. Without a key only
+	// the callers that are not restricted may call the method.
+	PeerKeys map[string]string
+	// RateLimits throttles the requests of the tenants and api keys, nil when rate limiting is disabled
+	RateLimits *RateLimits
+	// HealthProbes tell whether the dependencies of the server can be used, by the name of the health service that
+	// reports them
+	HealthProbes map[string]HealthProbe
+	// InFlight counts the requests being served by method, nil when they are not counted
+	InFlight *InFlightRequests
 }
 
 // Run -
-func (s *ServiceContainer) Run(ctx context.Context, cfg *config.Server, authentication *config.Authn, profiler *config.Profiler, l *logger.Logger) error {
+func (s *ServiceContainer) Run(ctx context.Context, cfg *config.Server, authentication *config.Authn, profiler *config.Profiler, distributed *config.Distributed, l *logger.Logger) error {
 	var err error
 	
 	unaryInterceptors := []grpc.UnaryServerInterceptor{
-		grpcValidator.UnaryServerInterceptor(),
 		grpcRecovery.UnaryServerInterceptor(),
 	}
 	
 	streamingInterceptors := []grpc.StreamServerInterceptor{
-		grpcValidator.StreamServerInterceptor(),
 		grpcRecovery.StreamServerInterceptor(),
 	}
 	
+	// the requests are counted before anything can reject them, the rejected ones were in flight too
+	if s.InFlight != nil {
+		unaryInterceptors = append(unaryInterceptors, InFlightUnaryServerInterceptor(s.InFlight))
+		streamingInterceptors = append(streamingInterceptors, InFlightStreamServerInterceptor(s.InFlight))
+	}
+	
 	if authentication != nil && authentication.Enabled {
-		switch authentication.Method {
-		case "preshared":
-			var authenticator *preshared.KeyAuthn
-			authenticator, err = preshared.NewKeyAuthn(ctx, authentication.Preshared)
-			if err != nil {
-				return err
-			}
-			unaryInterceptors = append(unaryInterceptors, grpcAuth.UnaryServerInterceptor(middleware.KeyAuthFunc(authenticator)))
-			streamingInterceptors = append(streamingInterceptors, grpcAuth.StreamServerInterceptor(middleware.KeyAuthFunc(authenticator)))
-		case "oidc":
-			var authenticator *oidc.OidcAuthn
-			authenticator, err = oidc.NewOidcAuthn(ctx, authentication.Oidc)
-			if err != nil {
-				return err
+		var authenticators []authn.Authenticator
+		for _, method := range strings.Split(authentication.Method, ",") {
+			switch strings.TrimSpace(method) {
+			case "preshared":
+				var authenticator *preshared.KeyAuthn
+				var options []preshared.KeyAuthnOption
+				if s.APIKeyReader != nil {
+					options = append(options, preshared.StoredKeys(s.APIKeyReader))
+				}
+				authenticator, err = preshared.NewKeyAuthn(ctx, authentication.Preshared, options...)
+				if err != nil {
+					return err
+				}
+				authenticators = append(authenticators, authenticator)
+			case "oidc":
+				var authenticator *oidc.OidcAuthn
+				authenticator, err = oidc.NewOidcAuthn(ctx, authentication.Oidc)
+				if err != nil {
+					return err
+				}
+				authenticators = append(authenticators, authenticator)
+			default:
+				return fmt.Errorf("unkown authentication method: '%s'", method)
 			}
-			unaryInterceptors = append(unaryInterceptors, oidc.UnaryServerInterceptor(authenticator))
-			streamingInterceptors = append(streamingInterceptors, oidc.StreamServerInterceptor(authenticator))
-		default:
-			return fmt.Errorf("unkown authentication method: '%s'", authentication.Method)
 		}
+		authenticator := authn.Any(authenticators...)
+		unaryInterceptors = append(unaryInterceptors, grpcAuth.UnaryServerInterceptor(middleware.AuthFunc(authenticator)))
+		streamingInterceptors = append(streamingInterceptors, grpcAuth.StreamServerInterceptor(middleware.AuthFunc(authenticator)))
+		// the tenants and the scope of the stored keys and of the restricted tokens are enforced before the requests
+		// are throttled
+		unaryInterceptors = append(unaryInterceptors, ScopeUnaryServerInterceptor(s.PeerKeys))
+		streamingInterceptors = append(streamingInterceptors, ScopeStreamServerInterceptor(s.PeerKeys))
+	}
+	
+	// the callers are throttled once they are authenticated, before any work is done for their requests
+	if s.RateLimits != nil {
+		unaryInterceptors = append(unaryInterceptors, RateLimitUnaryServerInterceptor(s.RateLimits))
+		streamingInterceptors = append(streamingInterceptors, RateLimitStreamServerInterceptor(s.RateLimits))
+	}
+	
+	// oversized requests are rejected before any of them is resolved or validated
+	unaryInterceptors = append(unaryInterceptors, LimitsUnaryServerInterceptor(cfg.Limits))
+	streamingInterceptors = append(streamingInterceptors, LimitsStreamServerInterceptor(cfg.Limits))
+	
+	// external identifiers are resolved after authentication and before validation, since only canonical ids are valid
+	if s.IdentityService != nil {
+		unaryInterceptors = append(unaryInterceptors, IdentityUnaryServerInterceptor(s.IdentityService))
+		streamingInterceptors = append(streamingInterceptors, IdentityStreamServerInterceptor(s.IdentityService))
+	}
+	
+	// the default depth is set and the larger depths are clamped before validation, which rejects a missing depth
+	depthLimits := NewDepthLimits(cfg.Depth, s.SettingsService)
+	unaryInterceptors = append(unaryInterceptors, DepthUnaryServerInterceptor(depthLimits))
+	streamingInterceptors = append(streamingInterceptors, DepthStreamServerInterceptor(depthLimits))
+	
+	unaryInterceptors = append(unaryInterceptors, grpcValidator.UnaryServerInterceptor())
+	streamingInterceptors = append(streamingInterceptors, grpcValidator.StreamServerInterceptor())
+	
+	// the snapshots whose tuples may have been collected are rejected or upgraded to the head before they are read
+	if s.SnapshotHorizon != nil {
+		unaryInterceptors = append(unaryInterceptors, SnapshotUnaryServerInterceptor(s.SnapshotHorizon, l))
+		streamingInterceptors = append(streamingInterceptors, SnapshotStreamServerInterceptor(s.SnapshotHorizon, l))
+	}
+	
+	// the aliases of renamed entities are replaced before the deprecations of the entity types are looked up
+	unaryInterceptors = append(unaryInterceptors, AliasUnaryServerInterceptor(s.SchemaService, l))
+	streamingInterceptors = append(streamingInterceptors, AliasStreamServerInterceptor(s.SchemaService, l))
+	
+	// deprecation warnings are only looked up for valid requests
+	unaryInterceptors = append(unaryInterceptors, DeprecationUnaryServerInterceptor(s.SchemaService, l))
+	streamingInterceptors = append(streamingInterceptors, DeprecationStreamServerInterceptor(s.SchemaService, l))
+	
+	// checks that fail on the storage are answered from their last known result
+	if s.FallbackCache != nil {
+		unaryInterceptors = append(unaryInterceptors, FallbackUnaryServerInterceptor(s.FallbackCache, l))
 	}
 	
 	opts := []grpc.ServerOption{
@@ -97,12 +199,102 @@ func (s *ServiceContainer) Run(ctx context.Context, cfg *config.Server, authenti
 	
 	grpcServer := grpc.NewServer(opts...)
 	grpcV1.RegisterPermissionServer(grpcServer, NewPermissionServer(s.PermissionService, l))
-	grpcV1.RegisterSchemaServer(grpcServer, NewSchemaServer(s.SchemaService, l))
-	grpcV1.RegisterRelationshipServer(grpcServer, NewRelationshipServer(s.RelationshipService, l))
-	grpcV1.RegisterTenancyServer(grpcServer, NewTenancyServer(s.TenancyService, l))
-	health.RegisterHealthServer(grpcServer, NewHealthServer())
+	
+	// connection to the primary region, nil in the primary region
+	var forward grpc.ClientConnInterface
+	
+	if distributed != nil && !distributed.IsPrimary() {
+		options := []grpc.DialOption{
+			grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+		}
+		if cfg.GRPC.TLSConfig.Enabled {
+			options = append(options, grpc.WithTransportCredentials(credentials.NewClientTLSFromCert(nil, "")))
+		} else {
+			options = append(options, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		}
+		
+		var primary *grpc.ClientConn
+		primary, err = grpc.DialContext(ctx, distributed.PrimaryAddress, options...)
+		if err != nil {
+			return err
+		}
+		defer primary.Close()
+		forward = primary
+		
+		grpcV1.RegisterSchemaServer(grpcServer, NewForwardingSchemaServer(NewSchemaServer(s.SchemaService, l), primary))
+		grpcV1.RegisterRelationshipServer(grpcServer, NewForwardingRelationshipServer(NewRelationshipServer(s.RelationshipService, l), primary))
+		grpcV1.RegisterTenancyServer(grpcServer, NewForwardingTenancyServer(NewTenancyServer(s.TenancyService, l), primary))
+		
+		l.Info(fmt.Sprintf("🌍 region %s forwards writes to primary region %s: %s", distributed.Region, distributed.PrimaryRegion, distributed.PrimaryAddress))
+	} else {
+		grpcV1.RegisterSchemaServer(grpcServer, NewSchemaServer(s.SchemaService, l))
+		grpcV1.RegisterRelationshipServer(grpcServer, NewRelationshipServer(s.RelationshipService, l))
+		grpcV1.RegisterTenancyServer(grpcServer, NewTenancyServer(s.TenancyService, l))
+	}
+	
+	if s.OpenFGAService != nil {
+		if forward != nil {
+			grpcV1.RegisterOpenFGAServer(grpcServer, NewForwardingOpenFGAServer(NewOpenFGAServer(s.OpenFGAService, l), forward))
+		} else {
+			grpcV1.RegisterOpenFGAServer(grpcServer, NewOpenFGAServer(s.OpenFGAService, l))
+		}
+	}
+	
+	if s.ReplicationService != nil {
+		registerReplicationServer(grpcServer, NewReplicationServer(s.ReplicationService, l))
+	}
+	
+	if s.DispatchService != nil {
+		registerDispatchServer(grpcServer, NewDispatchServer(s.DispatchService, l))
+	}
+	
+	if s.AdminService != nil {
+		if forward != nil {
+			grpcV1.RegisterAdminServer(grpcServer, NewForwardingAdminServer(NewAdminServer(s.AdminService, s.PermissionService, l), forward))
+		} else {
+			grpcV1.RegisterAdminServer(grpcServer, NewAdminServer(s.AdminService, s.PermissionService, l))
+		}
+	}
+	
+	if s.AttributeService != nil {
+		if forward != nil {
+			grpcV1.RegisterAttributeServer(grpcServer, NewForwardingAttributeServer(NewAttributeServer(s.AttributeService, l), forward))
+		} else {
+			grpcV1.RegisterAttributeServer(grpcServer, NewAttributeServer(s.AttributeService, l))
+		}
+	}
+	
+	if s.WatchService != nil {
+		grpcV1.RegisterWatchServer(grpcServer, NewWatchServer(s.WatchService, l))
+	}
+	
+	if s.SettingsService != nil {
+		if forward != nil {
+			grpcV1.RegisterSettingsServer(grpcServer, NewForwardingSettingsServer(NewSettingsServer(s.SettingsService, l), forward))
+		} else {
+			grpcV1.RegisterSettingsServer(grpcServer, NewSettingsServer(s.SettingsService, l))
+		}
+	}
+	
+	if s.IdentityService != nil {
+		if forward != nil {
+			grpcV1.RegisterIdentityServer(grpcServer, NewForwardingIdentityServer(NewIdentityServer(s.IdentityService, l), forward))
+		} else {
+			grpcV1.RegisterIdentityServer(grpcServer, NewIdentityServer(s.IdentityService, l))
+		}
+	}
+	
+	if s.SCIMService != nil {
+		if forward != nil {
+			grpcV1.RegisterSCIMServer(grpcServer, NewForwardingSCIMServer(NewSCIMServer(s.SCIMService, l), forward))
+		} else {
+			grpcV1.RegisterSCIMServer(grpcServer, NewSCIMServer(s.SCIMService, l))
+		}
+	}
+	
+	health.RegisterHealthServer(grpcServer, NewHealthServer(s.HealthProbes))
 	grpcV1.RegisterWelcomeServer(grpcServer, NewWelcomeServer())
-	reflection.Register(grpcServer)
+	reflectionV1Alpha.RegisterServerReflectionServer(grpcServer, NewReflectionServer(grpcServer))
 	
 	if profiler.Enabled {
 		mux := http.NewServeMux()
@@ -176,6 +368,10 @@ func (s *ServiceContainer) Run(ctx context.Context, cfg *config.Server, authenti
 					},
 				},
 			}),
+			runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+			runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+			runtime.WithForwardResponseOption(scimForwardResponse),
+			runtime.WithErrorHandler(scimErrorHandler),
 		}
 		
 		mux := runtime.NewServeMux(muxOpts...)
@@ -195,6 +391,44 @@ func (s *ServiceContainer) Run(ctx context.Context, cfg *config.Server, authenti
 		if err = grpcV1.RegisterWelcomeHandler(ctx, mux, conn); err != nil {
 			return err
 		}
+		if err = registerTransferHandlers(mux, conn); err != nil {
+			return err
+		}
+		if s.OpenFGAService != nil {
+			if err = grpcV1.RegisterOpenFGAHandler(ctx, mux, conn); err != nil {
+				return err
+			}
+		}
+		if s.AdminService != nil {
+			if err = grpcV1.RegisterAdminHandler(ctx, mux, conn); err != nil {
+				return err
+			}
+		}
+		if s.AttributeService != nil {
+			if err = grpcV1.RegisterAttributeHandler(ctx, mux, conn); err != nil {
+				return err
+			}
+		}
+		if s.WatchService != nil {
+			if err = grpcV1.RegisterWatchHandler(ctx, mux, conn); err != nil {
+				return err
+			}
+		}
+		if s.SettingsService != nil {
+			if err = grpcV1.RegisterSettingsHandler(ctx, mux, conn); err != nil {
+				return err
+			}
+		}
+		if s.IdentityService != nil {
+			if err = grpcV1.RegisterIdentityHandler(ctx, mux, conn); err != nil {
+				return err
+			}
+		}
+		if s.SCIMService != nil {
+			if err = grpcV1.RegisterSCIMHandler(ctx, mux, conn); err != nil {
+				return err
+			}
+		}
 		
 		httpServer = &http.Server{
 			Addr: ":" + cfg.HTTP.Port,
@@ -243,3 +477,22 @@ func (s *ServiceContainer) Run(ctx context.Context, cfg *config.Server, authenti
 	
 	return nil
 }
+
+// incomingHeaderMatcher - Forwards the snapshot fallback header to grpc in addition to the default ones
+func incomingHeaderMatcher(key string) (string, bool) {
+	switch strings.ToLower(key) {
+	case SnapshotFallbackHeader:
+		return strings.ToLower(key), true
+	default:
+		return runtime.DefaultHeaderMatcher(key)
+	}
+}
+
+// outgoingHeaderMatcher - Gives the http responses the permify headers under the names the grpc responses carry them
+// with, the other metadata keeps the grpc metadata prefix of the gateway
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if strings.HasPrefix(key, HeaderPrefix) {
+		return key, true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
@@ -0,0 +1,13 @@
+package servers
+
+import (
+	"testing"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestServers(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "servers-suite")
+}
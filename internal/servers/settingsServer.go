@@ -0,0 +1,113 @@
+package servers
+
+import (
+	otelCodes "go.opentelemetry.io/otel/codes"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/internal/services"
+	"github.com/adminium/permify/pkg/logger"
+	v1 "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// SettingsServer - Manages the settings that change the behavior of the engine for a single tenant
+type SettingsServer struct {
+	v1.UnimplementedSettingsServer
+	
+	settingsService services.ISettingsService
+	logger          logger.Interface
+}
+
+// NewSettingsServer - Creates new Settings Server
+func NewSettingsServer(s services.ISettingsService, l logger.Interface) *SettingsServer {
+	return &SettingsServer{
+		settingsService: s,
+		logger:          l,
+	}
+}
+
+// Read - Settings of the tenant
+func (r *SettingsServer) Read(ctx context.Context, request *v1.SettingsReadRequest) (*v1.SettingsReadResponse, error) {
+	ctx, span := tracer.Start(ctx, "settings.read")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	settings, err := r.settingsService.ReadSettings(ctx, request.GetTenantId())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	
+	return &v1.SettingsReadResponse{
+		Settings: toTenantSettings(settings),
+	}, nil
+}
+
+// Write - Changes the given settings of the tenant, the others keep their values
+func (r *SettingsServer) Write(ctx context.Context, request *v1.SettingsWriteRequest) (*v1.SettingsWriteResponse, error) {
+	ctx, span := tracer.Start(ctx, "settings.write")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	settings, err := r.settingsService.ReadSettings(ctx, request.GetTenantId())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	
+	if request.StrictValidation != nil {
+		settings.StrictValidation = request.GetStrictValidation()
+	}
+	if request.Wildcards != nil {
+		settings.Wildcards = request.GetWildcards()
+	}
+	if request.CacheTtl != nil {
+		if request.GetCacheTtl().AsDuration() < 0 {
+			return nil, status.Error(codes.InvalidArgument, "cache_ttl can not be negative")
+		}
+		settings.CacheTTL = request.GetCacheTtl().AsDuration()
+	}
+	if request.Depth != nil {
+		settings.Depth = request.GetDepth()
+	}
+	if request.MaxDepth != nil {
+		settings.MaxDepth = request.GetMaxDepth()
+	}
+	
+	if err = r.settingsService.WriteSettings(ctx, request.GetTenantId(), settings); err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		r.logger.Error(err.Error())
+		return nil, status.Error(GetStatus(err), err.Error())
+	}
+	
+	return &v1.SettingsWriteResponse{
+		Settings: toTenantSettings(settings),
+	}, nil
+}
+
+// toTenantSettings -
+func toTenantSettings(settings repositories.TenantSettings) *v1.TenantSettings {
+	return &v1.TenantSettings{
+		StrictValidation: settings.StrictValidation,
+		Wildcards:        settings.Wildcards,
+		CacheTtl:         durationpb.New(settings.CacheTTL),
+		Depth:            settings.Depth,
+		MaxDepth:         settings.MaxDepth,
+	}
+}
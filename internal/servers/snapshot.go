@@ -0,0 +1,121 @@
+package servers
+
+import (
+	"errors"
+	"time"
+	
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	
+	"github.com/adminium/permify/internal/gc"
+	"github.com/adminium/permify/pkg/logger"
+	v1 "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+const (
+	// SnapshotFallbackHeader - Header of the tolerant callers, with the value head their requests with an expired
+	// snap token read the head snapshot instead of failing
+	SnapshotFallbackHeader = HeaderPrefix + "snapshot-fallback"
+	// SnapshotUpgradedHeader - Response header carrying the expired snap token a request was upgraded from
+	SnapshotUpgradedHeader = HeaderPrefix + "snapshot-upgraded"
+)
+
+// SnapshotUnaryServerInterceptor - Rejects the requests whose snap token is older than the window of the garbage
+// collection with ERROR_CODE_SNAPSHOT_EXPIRED and the earliest snap token that can be read in the error details, or
+// upgrades them to the head snapshot when the caller tolerates it.
+func SnapshotUnaryServerInterceptor(horizon *gc.Horizon, l logger.Interface) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		expired, err := checkSnapshot(ctx, horizon, req)
+		if err != nil {
+			return nil, snapshotStatus(err)
+		}
+		if expired != "" {
+			l.Warn("%s upgraded from the expired snapshot %s to the head", info.FullMethod, expired)
+			_ = grpc.SetHeader(ctx, metadata.Pairs(SnapshotUpgradedHeader, expired))
+		}
+		return handler(ctx, req)
+	}
+}
+
+// SnapshotStreamServerInterceptor - Stream variant of SnapshotUnaryServerInterceptor
+func SnapshotStreamServerInterceptor(horizon *gc.Horizon, l logger.Interface) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &snapshotServerStream{ServerStream: ss, horizon: horizon, logger: l, method: info.FullMethod})
+	}
+}
+
+// snapshotServerStream - Checks the snap token of every received message, the header is sent with the first response
+type snapshotServerStream struct {
+	grpc.ServerStream
+	horizon *gc.Horizon
+	logger  logger.Interface
+	method  string
+}
+
+// RecvMsg -
+func (s *snapshotServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	expired, err := checkSnapshot(s.Context(), s.horizon, m)
+	if err != nil {
+		return snapshotStatus(err)
+	}
+	if expired != "" {
+		s.logger.Warn("%s upgraded from the expired snapshot %s to the head", s.method, expired)
+		_ = s.ServerStream.SetHeader(metadata.Pairs(SnapshotUpgradedHeader, expired))
+	}
+	return nil
+}
+
+// checkSnapshot - Clears the expired snap token of the request when the caller falls back to the head snapshot and
+// returns it, fails otherwise
+func checkSnapshot(ctx context.Context, horizon *gc.Horizon, req interface{}) (expired string, err error) {
+	var tenantID string
+	var snap *string
+	
+	switch r := req.(type) {
+	case *v1.PermissionCheckRequest:
+		if r.GetMetadata() != nil {
+			tenantID, snap = r.GetTenantId(), &r.Metadata.SnapToken
+		}
+	case *v1.PermissionExpandRequest:
+		if r.GetMetadata() != nil {
+			tenantID, snap = r.GetTenantId(), &r.Metadata.SnapToken
+		}
+	case *v1.PermissionLookupEntityRequest:
+		if r.GetMetadata() != nil {
+			tenantID, snap = r.GetTenantId(), &r.Metadata.SnapToken
+		}
+	case *v1.RelationshipReadRequest:
+		if r.GetMetadata() != nil {
+			tenantID, snap = r.GetTenantId(), &r.Metadata.SnapToken
+		}
+	}
+	if snap == nil {
+		return "", nil
+	}
+	
+	err = horizon.Check(ctx, tenantID, *snap, time.Now())
+	var expiredErr *gc.SnapshotExpiredError
+	if !errors.As(err, &expiredErr) {
+		return "", err
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(SnapshotFallbackHeader); len(values) == 0 || values[0] != "head" {
+		return "", err
+	}
+	expired, *snap = *snap, ""
+	return expired, nil
+}
+
+// snapshotStatus - The expired snapshots keep the details of their status
+func snapshotStatus(err error) error {
+	var expiredErr *gc.SnapshotExpiredError
+	if errors.As(err, &expiredErr) {
+		return expiredErr.GRPCStatus().Err()
+	}
+	return status.Error(GetStatus(err), err.Error())
+}
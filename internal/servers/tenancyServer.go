@@ -2,8 +2,10 @@ package servers
 
 import (
 	"context"
+	"errors"
 	
 	otelCodes "go.opentelemetry.io/otel/codes"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	
 	"github.com/adminium/permify/internal/services"
@@ -81,3 +83,67 @@ func (t *TenancyServer) List(ctx context.Context, request *v1.TenantListRequest)
 		ContinuousToken: ct.String(),
 	}, nil
 }
+
+// Read - Tenant and its labels
+func (t *TenancyServer) Read(ctx context.Context, request *v1.TenantReadRequest) (*v1.TenantReadResponse, error) {
+	ctx, span := tracer.Start(ctx, "tenant.read")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	tenant, labels, err := t.tenancyService.GetTenant(ctx, request.GetTenantId())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, t.status(err)
+	}
+	
+	return &v1.TenantReadResponse{
+		Tenant: tenant,
+		Labels: labels,
+	}, nil
+}
+
+// Update - Changes the name or the labels of the tenant, the one that is not given keeps its value
+func (t *TenancyServer) Update(ctx context.Context, request *v1.TenantUpdateRequest) (*v1.TenantUpdateResponse, error) {
+	ctx, span := tracer.Start(ctx, "tenant.update")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return nil, v
+	}
+	
+	// nil labels keep the labels of the tenant, empty ones remove them
+	labels := request.GetLabels()
+	if request.GetClearLabels() {
+		if len(labels) > 0 {
+			return nil, status.Error(codes.InvalidArgument, "labels can not be given with clear_labels")
+		}
+		labels = map[string]string{}
+	}
+	
+	tenant, current, err := t.tenancyService.UpdateTenant(ctx, request.GetTenantId(), request.GetName(), labels)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, t.status(err)
+	}
+	
+	return &v1.TenantUpdateResponse{
+		Tenant: tenant,
+		Labels: current,
+	}, nil
+}
+
+// status - Status of the error of the tenancy service, the labels of the tenants are not kept by every database
+func (t *TenancyServer) status(err error) error {
+	if errors.Is(err, services.ErrTenantMetadataUnsupported) {
+		return status.Error(codes.Unimplemented, err.Error())
+	}
+	t.logger.Error(err.Error())
+	return status.Error(GetStatus(err), err.Error())
+}
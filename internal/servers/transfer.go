@@ -0,0 +1,174 @@
+package servers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	
+	v1 "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+const (
+	// ExportRelationshipsPath - Http route of the export, the tuples are streamed in the format of the format query
+	// parameter, ndjson by default
+	ExportRelationshipsPath = "/v1/tenants/{tenant_id}/relationships/export"
+	// ImportRelationshipsPath - Http route of the import, the body is a file in the format of the format query
+	// parameter, ndjson by default
+	ImportRelationshipsPath = "/v1/tenants/{tenant_id}/relationships/import"
+	
+	// _transferPageSize - Number of tuples of a message, also the size of the batches the imports are written in
+	_transferPageSize = 1000
+)
+
+// SnapTokenTrailer - Http trailer of the exports with the snap token the tuples were read at
+const SnapTokenTrailer = HeaderPrefix + "snap-token"
+
+// registerTransferHandlers - Exposes the export and the import on the gateway as files, the tenant comes from the
+// path and the other fields from the query parameters
+func registerTransferHandlers(mux *runtime.ServeMux, conn grpc.ClientConnInterface) error {
+	client := v1.NewRelationshipClient(conn)
+	
+	err := mux.HandlePath(http.MethodGet, ExportRelationshipsPath, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		_, outbound := runtime.MarshalerForRequest(mux, req)
+		ctx, err := runtime.AnnotateContext(req.Context(), mux, req, "/base.v1.Relationship/Export", runtime.WithHTTPPathPattern(ExportRelationshipsPath))
+		if err != nil {
+			runtime.HTTPError(req.Context(), mux, outbound, w, req, err)
+			return
+		}
+		format, err := transferFormat(req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, req, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+		
+		var stream v1.Relationship_ExportClient
+		stream, err = client.Export(ctx, &v1.RelationshipExportRequest{
+			TenantId: pathParams["tenant_id"],
+			Metadata: &v1.RelationshipReadRequestMetadata{
+				SnapToken: req.URL.Query().Get("snap_token"),
+			},
+			EntityType: req.URL.Query().Get("entity_type"),
+		})
+		// the first page tells whether the export could start, errors after it can only cut the body short
+		var first *v1.RelationshipExportResponse
+		if err == nil {
+			first, err = stream.Recv()
+		}
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, req, err)
+			return
+		}
+		
+		w.Header().Set("Content-Type", transferContentType(format))
+		w.Header().Set("Trailer", SnapTokenTrailer)
+		encoder, _ := tuple.NewEncoder(w, format)
+		for response := first; ; {
+			for _, t := range response.GetTuples() {
+				if err = encoder.Encode(t); err != nil {
+					return
+				}
+			}
+			if response.GetSnapToken() != "" {
+				if err = encoder.Flush(); err == nil {
+					w.Header().Set(SnapTokenTrailer, response.GetSnapToken())
+				}
+				return
+			}
+			if response, err = stream.Recv(); err != nil {
+				_ = encoder.Flush()
+				return
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+	
+	return mux.HandlePath(http.MethodPost, ImportRelationshipsPath, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		_, outbound := runtime.MarshalerForRequest(mux, req)
+		ctx, err := runtime.AnnotateContext(req.Context(), mux, req, "/base.v1.Relationship/Import", runtime.WithHTTPPathPattern(ImportRelationshipsPath))
+		if err != nil {
+			runtime.HTTPError(req.Context(), mux, outbound, w, req, err)
+			return
+		}
+		format, err := transferFormat(req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, req, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+		decoder, _ := tuple.NewDecoder(req.Body, format)
+		
+		var stream v1.Relationship_ImportClient
+		stream, err = client.Import(ctx)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, req, err)
+			return
+		}
+		if err = SendTuples(stream, decoder, &v1.RelationshipImportRequest{
+			TenantId: pathParams["tenant_id"],
+			Metadata: &v1.RelationshipWriteRequestMetadata{
+				SchemaVersion: req.URL.Query().Get("schema_version"),
+			},
+		}); err != nil && !errors.Is(err, io.EOF) {
+			runtime.HTTPError(ctx, mux, outbound, w, req, err)
+			return
+		}
+		// a failed import ends the stream, its error is received with the response
+		response, err := stream.CloseAndRecv()
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, req, err)
+			return
+		}
+		runtime.ForwardResponseMessage(ctx, mux, outbound, w, req, response)
+	})
+}
+
+// SendTuples - Sends the tuples of the decoder to an import stream in pages of at most _transferPageSize tuples, the
+// first page is sent with the tenant and the metadata of the first request. The stream is left open for sending,
+// CloseAndRecv returns the response of the import.
+func SendTuples(stream v1.Relationship_ImportClient, decoder *tuple.Decoder, first *v1.RelationshipImportRequest) error {
+	request := first
+	send := func() error {
+		err := stream.Send(request)
+		request = &v1.RelationshipImportRequest{}
+		return err
+	}
+	for {
+		t, err := decoder.Decode()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		request.Tuples = append(request.Tuples, t)
+		if len(request.Tuples) == _transferPageSize {
+			if err = send(); err != nil {
+				return err
+			}
+		}
+	}
+	return send()
+}
+
+// transferFormat - Format of the format query parameter, ndjson by default
+func transferFormat(req *http.Request) (tuple.Format, error) {
+	if f := req.URL.Query().Get("format"); f != "" {
+		return tuple.ParseFormat(f)
+	}
+	return tuple.NDJSON, nil
+}
+
+// transferContentType -
+func transferContentType(format tuple.Format) string {
+	if format == tuple.CSV {
+		return "text/csv"
+	}
+	return "application/x-ndjson"
+}
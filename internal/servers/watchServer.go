@@ -0,0 +1,84 @@
+package servers
+
+import (
+	"errors"
+	
+	otelCodes "go.opentelemetry.io/otel/codes"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/internal/services"
+	"github.com/adminium/permify/pkg/database"
+	"github.com/adminium/permify/pkg/logger"
+	v1 "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/storage"
+)
+
+// WatchServer - Streams the changes of the relation tuples of a tenant, so that clients can invalidate their caches
+type WatchServer struct {
+	v1.UnimplementedWatchServer
+	
+	watchService services.IWatchService
+	logger       logger.Interface
+}
+
+// NewWatchServer - Creates new Watch Server
+func NewWatchServer(w services.IWatchService, l logger.Interface) *WatchServer {
+	return &WatchServer{
+		watchService: w,
+		logger:       l,
+	}
+}
+
+// Watch - Every message has the snap token of the changes and the created and deleted tuples as write and delete
+// operations, with the schema version each tuple was written under. A watch that fell behind fails with aborted, it can be resumed with the last snap token.
+func (r *WatchServer) Watch(request *v1.WatchRequest, server v1.Watch_WatchServer) error {
+	ctx, span := tracer.Start(server.Context(), "relationships.watch")
+	defer span.End()
+	
+	v := request.Validate()
+	if v != nil {
+		return v
+	}
+	
+	err := r.watchService.Watch(ctx, request.GetTenantId(), request.GetSnapToken(), func(changes *repositories.RelationshipChanges) error {
+		return server.Send(watchResponse(changes))
+	})
+	if err == nil || errors.Is(err, context.Canceled) {
+		return nil
+	}
+	span.RecordError(err)
+	span.SetStatus(otelCodes.Error, err.Error())
+	r.logger.Error(err.Error())
+	if errors.Is(err, storage.ErrWatchFellBehind) {
+		return status.Error(codes.Aborted, err.Error())
+	}
+	return status.Error(GetStatus(err), err.Error())
+}
+
+// watchResponse -
+func watchResponse(changes *repositories.RelationshipChanges) *v1.WatchResponse {
+	response := &v1.WatchResponse{
+		SnapToken: changes.SnapToken,
+		Changes:   make([]*v1.WatchChange, 0, len(changes.Created.GetTuples())+len(changes.Deleted.GetTuples())),
+	}
+	for _, c := range []struct {
+		operation  v1.WatchChange_Operation
+		collection *database.TupleCollection
+	}{
+		{v1.WatchChange_OPERATION_WRITE, changes.Created},
+		{v1.WatchChange_OPERATION_DELETE, changes.Deleted},
+	} {
+		metadata := c.collection.GetMetadata()
+		for i, t := range c.collection.GetTuples() {
+			response.Changes = append(response.Changes, &v1.WatchChange{
+				Operation:     c.operation,
+				Tuple:         t,
+				SchemaVersion: metadata[i].GetSchemaVersion(),
+			})
+		}
+	}
+	return response
+}
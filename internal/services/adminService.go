@@ -0,0 +1,519 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+	
+	otelCodes "go.opentelemetry.io/otel/codes"
+	
+	"github.com/adminium/permify/internal/authn"
+	"github.com/adminium/permify/internal/cluster"
+	"github.com/adminium/permify/internal/gc"
+	"github.com/adminium/permify/internal/keys"
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/internal/usage"
+	"github.com/adminium/permify/internal/warmup"
+	"github.com/adminium/permify/pkg/database"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/storage"
+	"github.com/adminium/permify/pkg/token"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+// ErrQueryExplainUnsupported - The storage cannot describe how it queries the tuples
+var ErrQueryExplainUnsupported = errors.New("the storage does not explain its queries")
+
+// ErrUsageDisabled - The checks are not recorded, so there are no usage statistics
+var ErrUsageDisabled = errors.New("usage statistics are not enabled")
+
+// ErrGarbageCollectionDisabled - The deleted tuples of the storage are not collected by this server
+var ErrGarbageCollectionDisabled = errors.New("garbage collection is not enabled")
+
+// ErrAPIKeysUnsupported - The storage cannot store api keys
+var ErrAPIKeysUnsupported = errors.New("the storage does not store api keys")
+
+// ErrCheckTracesUnsupported - The storage cannot store the traces of the checks
+var ErrCheckTracesUnsupported = errors.New("the storage does not store check traces")
+
+// ErrDispatchDisabled - The subproblems of the checks are not spread over a ring of peers
+var ErrDispatchDisabled = errors.New("dispatch is not enabled")
+
+// ErrWarmupDisabled - The caches are not warmed up, so there are no patterns to warm them up with
+var ErrWarmupDisabled = errors.New("warmup is not enabled")
+
+// _defaultCheckTraceLimit - Traces listed when no limit is given
+const _defaultCheckTraceLimit = 100
+
+// _usageScanLimit - Tuples of a tenant that are read to find the entities with the most tuples
+const _usageScanLimit = 100_000
+
+// Diagnostic - Outcome of a single step of a tenant refresh
+type Diagnostic struct {
+	Step     string
+	Ok       bool
+	Message  string
+	Duration time.Duration
+}
+
+// EntityUsage - Number of tuples of an entity, e.g. organization:1
+type EntityUsage struct {
+	Entity string
+	Tuples uint64
+}
+
+// UsageReport - Usage statistics of a tenant. The entities are counted from the tuples at the head snapshot, at
+// most the first _usageScanLimit of them, truncated tells whether there were more.
+type UsageReport struct {
+	usage.Statistics
+	Entities  []EntityUsage
+	Truncated bool
+}
+
+// AdminService -
+type AdminService struct {
+	db database.Database
+	// repositories
+	sr repositories.SchemaReader
+	rr repositories.RelationshipReader
+	qe repositories.QueryExplainer
+	// caches
+	km keys.CommandKeyManager
+	ss *SchemaService
+	// usage of the checks, nil when they are not recorded
+	uc *usage.Collector
+	// backend of the server and the opener of the backends its tenants are migrated to, nil when they are not migrated
+	backend Backend
+	open    BackendOpener
+	// collector of the deleted tuples, nil when they are not collected
+	gc *gc.Collector
+	// repositories of the api keys, nil when the storage cannot store them
+	kr repositories.APIKeyReader
+	kw repositories.APIKeyWriter
+	// reader of the sampled check traces, nil when the storage cannot store them
+	tr repositories.CheckTraceReader
+	// dispatcher of the subproblems of the checks, nil when dispatch is disabled
+	dispatcher *cluster.Dispatcher
+	// patterns the caches are warmed up with, nil when warmup is disabled
+	warmupPatterns func() ([]warmup.Pattern, error)
+}
+
+// BackendOpener - Opens the backend of the storage engine at the uri, close releases it
+type BackendOpener func(engine, uri string) (backend Backend, close func(), err error)
+
+// AdminOption - Option of the admin service
+type AdminOption func(*AdminService)
+
+// AdminUsage - Reports the usage statistics aggregated by the collector
+func AdminUsage(uc *usage.Collector) AdminOption {
+	return func(service *AdminService) {
+		service.uc = uc
+	}
+}
+
+// AdminBackends - Migrates the tenants of the backend the server serves to the backends that the opener opens
+func AdminBackends(backend Backend, open BackendOpener) AdminOption {
+	return func(service *AdminService) {
+		service.backend = backend
+		service.open = open
+	}
+}
+
+// AdminGarbageCollector - Collects the deleted tuples of the storage on demand, besides every interval
+func AdminGarbageCollector(c *gc.Collector) AdminOption {
+	return func(service *AdminService) {
+		service.gc = c
+	}
+}
+
+// AdminAPIKeys - Manages the api keys of the repositories
+func AdminAPIKeys(kr repositories.APIKeyReader, kw repositories.APIKeyWriter) AdminOption {
+	return func(service *AdminService) {
+		service.kr = kr
+		service.kw = kw
+	}
+}
+
+// AdminCheckTraces - Lists the sampled traces of the checks
+func AdminCheckTraces(tr repositories.CheckTraceReader) AdminOption {
+	return func(service *AdminService) {
+		service.tr = tr
+	}
+}
+
+// AdminDispatcher - Reports the distribution of the keys and of the subproblems over the ring of the dispatcher
+func AdminDispatcher(dispatcher *cluster.Dispatcher) AdminOption {
+	return func(service *AdminService) {
+		service.dispatcher = dispatcher
+	}
+}
+
+// AdminWarmup - Warms up the caches with the patterns of the function when no patterns are given
+func AdminWarmup(patterns func() ([]warmup.Pattern, error)) AdminOption {
+	return func(service *AdminService) {
+		service.warmupPatterns = patterns
+	}
+}
+
+// NewAdminService - The query explainer is the undecorated relationship reader of the storage, nil when the storage
+// cannot explain its queries
+func NewAdminService(db database.Database, sr repositories.SchemaReader, rr repositories.RelationshipReader, qe repositories.QueryExplainer, km keys.CommandKeyManager, ss *SchemaService, opts ...AdminOption) *AdminService {
+	service := &AdminService{
+		db: db,
+		sr: sr,
+		rr: rr,
+		qe: qe,
+		km: km,
+		ss: ss,
+	}
+	for _, opt := range opts {
+		opt(service)
+	}
+	return service
+}
+
+// RefreshTenant - Flushes the cached state of the tenant, checks that the storage answers and re-reads the head
+// schema. Every step runs even if an earlier one fails, so the diagnostics show everything that is wrong at once.
+// Caches belong to the process, so only the instance serving the request is refreshed.
+func (service *AdminService) RefreshTenant(ctx context.Context, tenantID string) (diagnostics []Diagnostic, ok bool) {
+	ctx, span := tracer.Start(ctx, "admin.refresh-tenant")
+	defer span.End()
+	
+	ok = true
+	step := func(name string, fn func() (string, error)) {
+		start := time.Now()
+		message, err := fn()
+		d := Diagnostic{Step: name, Ok: err == nil, Message: message, Duration: time.Since(start)}
+		if err != nil {
+			d.Message = err.Error()
+			ok = false
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+		}
+		diagnostics = append(diagnostics, d)
+	}
+	
+	step("flush-check-cache", func() (string, error) {
+		service.km.InvalidateCheckKeys(tenantID)
+		return "check keys of the tenant invalidated", nil
+	})
+	
+	step("flush-schema-cache", func() (string, error) {
+		service.ss.FlushTenant(tenantID)
+		return "parsed schema metadata of the tenant dropped", nil
+	})
+	
+	step("storage", func() (string, error) {
+		ready, err := service.db.IsReady(ctx)
+		if err != nil {
+			return "", err
+		}
+		if !ready {
+			return "", fmt.Errorf("%s storage is not ready", service.db.GetEngineType())
+		}
+		return service.db.GetEngineType() + " storage is ready", nil
+	})
+	
+	step("head-snapshot", func() (string, error) {
+		snap, err := service.rr.HeadSnapshot(ctx, tenantID)
+		if err != nil {
+			return "", err
+		}
+		return "head snap token " + snap.Encode().String(), nil
+	})
+	
+	step("head-schema", func() (string, error) {
+		version, err := service.sr.HeadVersion(ctx, tenantID)
+		if err != nil {
+			return "", err
+		}
+		sch, err := service.sr.ReadSchema(ctx, tenantID, version)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("head schema version %s with %d entities", version, len(sch.GetEntityDefinitions())), nil
+	})
+	
+	return diagnostics, ok
+}
+
+// ExplainQuery - Describes how the storage reads the tuples of the filter at the snapshot of the token, the head
+// snapshot when it is empty, so operators can verify that a filter shape is served by an index
+func (service *AdminService) ExplainQuery(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (plan repositories.QueryPlan, err error) {
+	ctx, span := tracer.Start(ctx, "admin.explain-query")
+	defer span.End()
+	
+	if service.qe == nil {
+		return plan, ErrQueryExplainUnsupported
+	}
+	
+	if snap == "" {
+		var st token.SnapToken
+		st, err = service.rr.HeadSnapshot(ctx, tenantID)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return plan, err
+		}
+		snap = st.Encode().String()
+	}
+	
+	plan, err = service.qe.ExplainQuery(ctx, tenantID, filter, snap)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return plan, err
+	}
+	return plan, nil
+}
+
+// Usage - The n most checked permissions and slowest check shapes of the tenant in the rolling window of the
+// collector, and the n entities with the most tuples. Checks are recorded by the instance that serves them, so only
+// the checks of this instance are reported.
+func (service *AdminService) Usage(ctx context.Context, tenantID string, n int) (report UsageReport, err error) {
+	ctx, span := tracer.Start(ctx, "admin.usage")
+	defer span.End()
+	
+	if service.uc == nil {
+		return report, ErrUsageDisabled
+	}
+	
+	report.Statistics = service.uc.Report(tenantID, n)
+	report.Entities, report.Truncated, err = service.entityUsage(ctx, tenantID, n)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return report, err
+	}
+	return report, nil
+}
+
+// entityUsage - Counts the tuples of the entities of the tenant at the head snapshot page by page
+func (service *AdminService) entityUsage(ctx context.Context, tenantID string, n int) (entities []EntityUsage, truncated bool, err error) {
+	var st token.SnapToken
+	st, err = service.rr.HeadSnapshot(ctx, tenantID)
+	if err != nil {
+		return nil, false, err
+	}
+	snap := st.Encode().String()
+	
+	counts := map[string]uint64{}
+	scanned := 0
+	ct := ""
+	for {
+		var collection *database.TupleCollection
+		var next database.EncodedContinuousToken
+		collection, next, err = service.rr.ReadRelationships(ctx, tenantID, &base.TupleFilter{}, snap, database.NewPagination(database.Size(1000), database.Token(ct)))
+		if err != nil {
+			return nil, false, err
+		}
+		for _, t := range collection.GetTuples() {
+			counts[tuple.EntityToString(t.GetEntity())]++
+		}
+		scanned += len(collection.GetTuples())
+		if next == nil || next.String() == "" {
+			break
+		}
+		ct = next.String()
+		if scanned >= _usageScanLimit {
+			truncated = true
+			break
+		}
+	}
+	
+	entities = make([]EntityUsage, 0, len(counts))
+	for entity, count := range counts {
+		entities = append(entities, EntityUsage{Entity: entity, Tuples: count})
+	}
+	sort.Slice(entities, func(i, j int) bool {
+		if entities[i].Tuples != entities[j].Tuples {
+			return entities[i].Tuples > entities[j].Tuples
+		}
+		return entities[i].Entity < entities[j].Entity
+	})
+	if len(entities) > n {
+		entities = entities[:n]
+	}
+	return entities, truncated, nil
+}
+
+// MigrateTenant - Copies the tenant from the backend of the server to the backend of the engine at the uri and
+// verifies the copy, see MigrateTenant. The target is opened for the migration only.
+func (service *AdminService) MigrateTenant(ctx context.Context, tenantID, engine, uri string, sampleRate int) (report BackendMigrationReport, err error) {
+	ctx, span := tracer.Start(ctx, "admin.migrate-tenant")
+	defer span.End()
+	
+	if service.open == nil {
+		return report, ErrBackendMigrationDisabled
+	}
+	
+	target, closeTarget, err := service.open(engine, uri)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return report, err
+	}
+	defer closeTarget()
+	
+	return MigrateTenant(ctx, tenantID, service.backend, target, sampleRate)
+}
+
+// CollectGarbage - Deletes the tuples that were deleted before the window of the garbage collection now, instead of
+// waiting for its interval
+func (service *AdminService) CollectGarbage(ctx context.Context) (result gc.Result, err error) {
+	ctx, span := tracer.Start(ctx, "admin.collect-garbage")
+	defer span.End()
+	
+	if service.gc == nil {
+		return result, ErrGarbageCollectionDisabled
+	}
+	
+	result, err = service.gc.Collect(ctx, time.Now())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+	}
+	return result, err
+}
+
+// CreateAPIKey - Creates a key for the tenants with the scope, the key may call every tenant when none is given and
+// only read when no scope is given. The secret is only returned here, just the hash of it is stored.
+func (service *AdminService) CreateAPIKey(ctx context.Context, tenants []string, scope string) (key repositories.APIKey, secret string, err error) {
+	ctx, span := tracer.Start(ctx, "admin.create-api-key")
+	defer span.End()
+	
+	if service.kw == nil {
+		return key, "", ErrAPIKeysUnsupported
+	}
+	
+	switch scope {
+	case "":
+		scope = storage.APIKeyScopeRead
+	case storage.APIKeyScopeRead, storage.APIKeyScopeReadWrite:
+	default:
+		return key, "", errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+	}
+	for _, tenantID := range tenants {
+		if tenantID == "" {
+			return key, "", errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+		}
+	}
+	
+	id, err := randomHex(8)
+	if err != nil {
+		return key, "", err
+	}
+	secret, err = randomHex(32)
+	if err != nil {
+		return key, "", err
+	}
+	key = repositories.APIKey{
+		ID:        id,
+		Hash:      authn.HashKey(secret),
+		Tenants:   tenants,
+		Scope:     scope,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err = service.kw.WriteAPIKey(ctx, key); err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return key, "", err
+	}
+	return key, secret, nil
+}
+
+// DeleteAPIKey - Deletes the key, the requests authenticated with it are rejected from then on
+func (service *AdminService) DeleteAPIKey(ctx context.Context, id string) (err error) {
+	ctx, span := tracer.Start(ctx, "admin.delete-api-key")
+	defer span.End()
+	
+	if service.kw == nil {
+		return ErrAPIKeysUnsupported
+	}
+	if err = service.kw.DeleteAPIKey(ctx, id); err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+	}
+	return err
+}
+
+// ListAPIKeys - The keys without their secrets, the oldest first
+func (service *AdminService) ListAPIKeys(ctx context.Context) (keys []repositories.APIKey, err error) {
+	ctx, span := tracer.Start(ctx, "admin.list-api-keys")
+	defer span.End()
+	
+	if service.kr == nil {
+		return nil, ErrAPIKeysUnsupported
+	}
+	keys, err = service.kr.ListAPIKeys(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+	}
+	return keys, err
+}
+
+// ListCheckTraces - The sampled traces of the checks of the tenant between since and until, the newest first. The
+// zero times do not bound the traces.
+func (service *AdminService) ListCheckTraces(ctx context.Context, tenantID string, since, until time.Time, limit int) (traces []repositories.CheckTrace, err error) {
+	ctx, span := tracer.Start(ctx, "admin.list-check-traces")
+	defer span.End()
+	
+	if service.tr == nil {
+		return nil, ErrCheckTracesUnsupported
+	}
+	if tenantID == "" || limit < 0 || (!since.IsZero() && !until.IsZero() && until.Before(since)) {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+	}
+	if limit == 0 {
+		limit = _defaultCheckTraceLimit
+	}
+	
+	traces, err = service.tr.ListCheckTraces(ctx, repositories.CheckTraceFilter{
+		TenantID: tenantID,
+		Since:    since,
+		Until:    until,
+		Limit:    limit,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+	}
+	return traces, err
+}
+
+// RingStats - Shares of the peers on the dispatch ring and the subproblems this instance routed to them. Every
+// instance routes its own checks, so the stats of the other instances are read from them.
+func (service *AdminService) RingStats(ctx context.Context) (cluster.RingStats, error) {
+	_, span := tracer.Start(ctx, "admin.ring-stats")
+	defer span.End()
+	
+	if service.dispatcher == nil {
+		return cluster.RingStats{}, ErrDispatchDisabled
+	}
+	return service.dispatcher.Stats(), nil
+}
+
+// WarmupPatterns - Patterns the caches of the instance are warmed up with when no patterns are given
+func (service *AdminService) WarmupPatterns(ctx context.Context) ([]warmup.Pattern, error) {
+	_, span := tracer.Start(ctx, "admin.warmup-patterns")
+	defer span.End()
+	
+	if service.warmupPatterns == nil {
+		return nil, ErrWarmupDisabled
+	}
+	return service.warmupPatterns()
+}
+
+// randomHex - Hex encoding of n random bytes
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.New(base.ErrorCode_ERROR_CODE_INTERNAL.String())
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,248 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	
+	"github.com/adminium/permify/internal/authn"
+	"github.com/adminium/permify/internal/keys"
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/internal/repositories/memory"
+	"github.com/adminium/permify/internal/repositories/memory/migrations"
+	"github.com/adminium/permify/internal/repositories/memory/utils"
+	"github.com/adminium/permify/internal/repositories/mocks"
+	"github.com/adminium/permify/internal/schema"
+	"github.com/adminium/permify/internal/usage"
+	"github.com/adminium/permify/pkg/cache/ristretto"
+	"github.com/adminium/permify/pkg/database"
+	MMDatabase "github.com/adminium/permify/pkg/database/memory"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/storage"
+	"github.com/adminium/permify/pkg/token"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+// fakeExplainer -
+type fakeExplainer struct {
+	plan repositories.QueryPlan
+	snap string
+}
+
+func (e *fakeExplainer) ExplainQuery(_ context.Context, _ string, _ *base.TupleFilter, snap string) (repositories.QueryPlan, error) {
+	e.snap = snap
+	return e.plan, nil
+}
+
+// fakeDatabase -
+type fakeDatabase struct {
+	ready bool
+}
+
+func (d fakeDatabase) GetEngineType() string { return "fake" }
+func (d fakeDatabase) Close() error          { return nil }
+func (d fakeDatabase) IsReady(context.Context) (bool, error) {
+	return d.ready, nil
+}
+
+var _ = Describe("admin-service", func() {
+	Context("RefreshTenant", func() {
+		It("Case 1: Flushes the check cache and reports every step", func() {
+			sch, err := schema.NewSchemaFromStringDefinitions(true, "entity user {}\n\nentity organization {\n\trelation admin @user\n}\n")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			schemaReader := new(mocks.SchemaReader)
+			schemaReader.On("HeadVersion", "t1").Return("v1", nil)
+			schemaReader.On("ReadSchema", "t1", "v1").Return(sch, nil)
+			
+			relationshipReader := new(mocks.RelationshipReader)
+			relationshipReader.On("HeadSnapshot", "t1").Return(token.NewNoopToken(), nil)
+			
+			c, err := ristretto.New()
+			Expect(err).ShouldNot(HaveOccurred())
+			km := keys.NewCheckCommandKeys(c)
+			
+			request := &base.PermissionCheckRequest{
+				TenantId:   "t1",
+				Entity:     &base.Entity{Type: "organization", Id: "1"},
+				Permission: "admin",
+				Subject:    &base.Subject{Type: "user", Id: "1"},
+				Metadata:   &base.PermissionCheckRequestMetadata{SchemaVersion: "v1", SnapToken: "noop"},
+			}
+			km.SetCheckKey(request, &base.PermissionCheckResponse{Can: base.PermissionCheckResponse_RESULT_ALLOWED})
+			c.Wait()
+			_, found := km.GetCheckKey(request)
+			Expect(found).Should(BeTrue())
+			
+			service := NewAdminService(fakeDatabase{ready: true}, schemaReader, relationshipReader, nil, km, NewSchemaService(nil, schemaReader))
+			diagnostics, ok := service.RefreshTenant(context.Background(), "t1")
+			Expect(ok).Should(BeTrue())
+			
+			steps := make([]string, 0, len(diagnostics))
+			for _, d := range diagnostics {
+				Expect(d.Ok).Should(BeTrue())
+				steps = append(steps, d.Step)
+			}
+			Expect(steps).Should(Equal([]string{"flush-check-cache", "flush-schema-cache", "storage", "head-snapshot", "head-schema"}))
+			Expect(diagnostics[4].Message).Should(Equal("head schema version v1 with 2 entities"))
+			
+			_, found = km.GetCheckKey(request)
+			Expect(found).Should(BeFalse())
+		})
+		
+		It("Case 2: Failing steps do not stop the refresh", func() {
+			schemaReader := new(mocks.SchemaReader)
+			schemaReader.On("HeadVersion", "t1").Return("", errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String()))
+			
+			relationshipReader := new(mocks.RelationshipReader)
+			relationshipReader.On("HeadSnapshot", "t1").Return(token.NewNoopToken(), nil)
+			
+			service := NewAdminService(fakeDatabase{ready: false}, schemaReader, relationshipReader, nil, keys.NewNoopCheckCommandKeys(), NewSchemaService(nil, schemaReader))
+			diagnostics, ok := service.RefreshTenant(context.Background(), "t1")
+			Expect(ok).Should(BeFalse())
+			Expect(diagnostics).Should(HaveLen(5))
+			Expect(diagnostics[2].Ok).Should(BeFalse())
+			Expect(diagnostics[2].Message).Should(Equal("fake storage is not ready"))
+			Expect(diagnostics[3].Ok).Should(BeTrue())
+			Expect(diagnostics[4].Ok).Should(BeFalse())
+			Expect(diagnostics[4].Message).Should(Equal(base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String()))
+		})
+	})
+	
+	Context("ExplainQuery", func() {
+		It("Case 1: Explains the filter at the head snapshot", func() {
+			relationshipReader := new(mocks.RelationshipReader)
+			relationshipReader.On("HeadSnapshot", "t1").Return(token.NewNoopToken(), nil)
+			
+			explainer := &fakeExplainer{plan: repositories.QueryPlan{Engine: "fake", Index: "entity-type-index"}}
+			service := NewAdminService(fakeDatabase{ready: true}, nil, relationshipReader, explainer, keys.NewNoopCheckCommandKeys(), nil)
+			
+			plan, err := service.ExplainQuery(context.Background(), "t1", &base.TupleFilter{Entity: &base.EntityFilter{Type: "organization"}}, "")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(plan.Index).Should(Equal("entity-type-index"))
+			Expect(explainer.snap).Should(Equal(token.NewNoopToken().Encode().String()))
+		})
+
+		It("Case 2: Storages without query plans are reported", func() {
+			service := NewAdminService(fakeDatabase{ready: true}, nil, new(mocks.RelationshipReader), nil, keys.NewNoopCheckCommandKeys(), nil)
+			
+			_, err := service.ExplainQuery(context.Background(), "t1", &base.TupleFilter{}, "noop")
+			Expect(errors.Is(err, ErrQueryExplainUnsupported)).Should(BeTrue())
+		})
+	})
+	
+	Context("Usage", func() {
+		It("Case 1: Reports the recorded checks and the entities with the most tuples", func() {
+			collection := database.NewTupleCollection()
+			for _, t := range []string{"doc:1#owner@user:1", "doc:1#viewer@user:2", "doc:2#owner@user:1", "doc:1#viewer@team:1#member"} {
+				tup, err := tuple.Tuple(t)
+				Expect(err).ShouldNot(HaveOccurred())
+				collection.Add(tup)
+			}
+			
+			relationshipReader := new(mocks.RelationshipReader)
+			relationshipReader.On("HeadSnapshot", "t1").Return(token.NewNoopToken(), nil)
+			relationshipReader.On("ReadRelationships", "t1", &base.TupleFilter{}, token.NewNoopToken().Encode().String(), mock.Anything).Return(collection, utils.NewNoopContinuousToken().Encode(), nil)
+			
+			collector := usage.NewCollector(time.Hour)
+			collector.Record(&base.PermissionCheckRequest{
+				TenantId:   "t1",
+				Entity:     &base.Entity{Type: "doc", Id: "1"},
+				Permission: "read",
+				Subject:    &base.Subject{Type: "user", Id: "1"},
+			}, time.Millisecond)
+			
+			service := NewAdminService(fakeDatabase{ready: true}, nil, relationshipReader, nil, keys.NewNoopCheckCommandKeys(), nil, AdminUsage(collector))
+			
+			report, err := service.Usage(context.Background(), "t1", 1)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(report.Checks).Should(Equal(uint64(1)))
+			Expect(report.Permissions).Should(Equal([]usage.PermissionUsage{{Permission: "doc#read", Count: 1}}))
+			Expect(report.Entities).Should(Equal([]EntityUsage{{Entity: "doc:1", Tuples: 3}}))
+			Expect(report.Truncated).Should(BeFalse())
+		})
+
+		It("Case 2: Usage is reported only when the checks are recorded", func() {
+			service := NewAdminService(fakeDatabase{ready: true}, nil, new(mocks.RelationshipReader), nil, keys.NewNoopCheckCommandKeys(), nil)
+			
+			_, err := service.Usage(context.Background(), "t1", 10)
+			Expect(errors.Is(err, ErrUsageDisabled)).Should(BeTrue())
+		})
+	})
+	
+	Context("APIKeys", func() {
+		It("Case 1: Stores the hash of the secret of the created key", func() {
+			mem, err := MMDatabase.New(migrations.Schema)
+			Expect(err).ShouldNot(HaveOccurred())
+			reader := memory.NewAPIKeyReader(mem, logger.New("error"))
+			service := NewAdminService(fakeDatabase{ready: true}, nil, new(mocks.RelationshipReader), nil, keys.NewNoopCheckCommandKeys(), nil,
+				AdminAPIKeys(reader, memory.NewAPIKeyWriter(mem, logger.New("error"))))
+			
+			key, secret, err := service.CreateAPIKey(context.Background(), []string{"t1"}, "")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(key.Scope).Should(Equal(storage.APIKeyScopeRead))
+			
+			stored, err := reader.ReadAPIKey(context.Background(), authn.HashKey(secret))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(stored.ID).Should(Equal(key.ID))
+			Expect(stored.Hash).ShouldNot(Equal(secret))
+			Expect(stored.Tenants).Should(Equal([]string{"t1"}))
+			
+			_, _, err = service.CreateAPIKey(context.Background(), nil, "admin")
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_VALIDATION.String()))
+		})
+		
+		It("Case 2: Api keys are managed only when the storage stores them", func() {
+			service := NewAdminService(fakeDatabase{ready: true}, nil, new(mocks.RelationshipReader), nil, keys.NewNoopCheckCommandKeys(), nil)
+			
+			_, _, err := service.CreateAPIKey(context.Background(), nil, storage.APIKeyScopeRead)
+			Expect(errors.Is(err, ErrAPIKeysUnsupported)).Should(BeTrue())
+		})
+	})
+	
+	Context("CheckTraces", func() {
+		It("Case 1: Lists the traces of the tenant between the bounds, the newest first", func() {
+			mem, err := MMDatabase.New(migrations.Schema)
+			Expect(err).ShouldNot(HaveOccurred())
+			writer := memory.NewCheckTraceWriter(mem, logger.New("error"))
+			service := NewAdminService(fakeDatabase{ready: true}, nil, new(mocks.RelationshipReader), nil, keys.NewNoopCheckCommandKeys(), nil,
+				AdminCheckTraces(memory.NewCheckTraceReader(mem, logger.New("error"))))
+			
+			now := time.Date(2023, 2, 15, 9, 0, 0, 0, time.UTC)
+			for i, tenantID := range []string{"t1", "t1", "t1", "t2"} {
+				Expect(writer.WriteCheckTrace(context.Background(), repositories.CheckTrace{
+					ID:        string(rune('a' + i)),
+					TenantID:  tenantID,
+					Tuples:    []string{"doc:1#owner@user:1"},
+					CreatedAt: now.Add(time.Duration(i) * time.Minute),
+				})).ShouldNot(HaveOccurred())
+			}
+			
+			traces, err := service.ListCheckTraces(context.Background(), "t1", now.Add(time.Minute), time.Time{}, 0)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(traces).Should(HaveLen(2))
+			Expect(traces[0].ID).Should(Equal("c"))
+			Expect(traces[1].ID).Should(Equal("b"))
+			Expect(traces[0].Tuples).Should(Equal([]string{"doc:1#owner@user:1"}))
+			
+			traces, err = service.ListCheckTraces(context.Background(), "t1", time.Time{}, time.Time{}, 1)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(traces).Should(HaveLen(1))
+			
+			_, err = service.ListCheckTraces(context.Background(), "", time.Time{}, time.Time{}, 0)
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_VALIDATION.String()))
+		})
+		
+		It("Case 2: Traces are listed only when the storage stores them", func() {
+			service := NewAdminService(fakeDatabase{ready: true}, nil, new(mocks.RelationshipReader), nil, keys.NewNoopCheckCommandKeys(), nil)
+			
+			_, err := service.ListCheckTraces(context.Background(), "t1", time.Time{}, time.Time{}, 0)
+			Expect(errors.Is(err, ErrCheckTracesUnsupported)).Should(BeTrue())
+		})
+	})
+})
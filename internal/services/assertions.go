@@ -0,0 +1,110 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	
+	"github.com/adminium/permify/internal/commands"
+	"github.com/adminium/permify/internal/keys"
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/internal/repositories/memory"
+	"github.com/adminium/permify/internal/repositories/memory/migrations"
+	"github.com/adminium/permify/pkg/database"
+	db "github.com/adminium/permify/pkg/database/memory"
+	"github.com/adminium/permify/pkg/dsl/ast"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/telemetry"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+const (
+	_assertionTenantID = "t1"
+	_assertionVersion  = "assert"
+	_assertionDepth    = 100
+)
+
+// ErrAssertionFailed - A check of an assert block of the schema did not have the expected result
+var ErrAssertionFailed = errors.New("assertion failed")
+
+// assert - Runs every assert block of the compiled schema. Each block gets a memory engine of its own holding the
+// schema and the tuples of the block, so blocks cannot see each other's tuples.
+func (service *SchemaService) assert(ctx context.Context, c *compilation) error {
+	for _, assertion := range c.assertions {
+		if err := runAssertion(ctx, c, assertion); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runAssertion -
+func runAssertion(ctx context.Context, c *compilation, assertion *ast.AssertStatement) error {
+	mem, err := db.New(migrations.Schema)
+	if err != nil {
+		return err
+	}
+	defer mem.Close()
+	
+	l := logger.New("error")
+	
+	definitions := make([]repositories.SchemaDefinition, 0, len(c.entities))
+	for _, entity := range c.entities {
+		definitions = append(definitions, repositories.SchemaDefinition{
+			TenantID:             _assertionTenantID,
+			Version:              _assertionVersion,
+			EntityType:           entity.name,
+			SerializedDefinition: []byte(entity.definition),
+		})
+	}
+	if err = memory.NewSchemaWriter(mem, l).WriteSchema(ctx, definitions); err != nil {
+		return err
+	}
+	
+	collection := database.NewTupleCollection()
+	for _, t := range assertion.Tuples {
+		var tup *base.Tuple
+		tup, err = tuple.Tuple(t.Literal)
+		if err != nil {
+			return fmt.Errorf("%w: %s", err, t.Literal)
+		}
+		collection.Add(tup)
+	}
+	if _, err = memory.NewRelationshipWriter(mem, l).WriteRelationships(ctx, _assertionTenantID, collection); err != nil {
+		return err
+	}
+	
+	schemaReader := memory.NewSchemaReader(mem, l)
+	relationshipReader := memory.NewRelationshipReader(mem, l)
+	checkCommand, err := commands.NewCheckCommand(keys.NewNoopCheckCommandKeys(), schemaReader, relationshipReader, telemetry.NewNoopMeter())
+	if err != nil {
+		return err
+	}
+	
+	for _, check := range assertion.Checks {
+		var q *tuple.Query
+		q, err = tuple.NewQueryFromString(check.Query.Literal)
+		if err != nil {
+			return fmt.Errorf("%w: %s", err, check.Query.Literal)
+		}
+		var response *base.PermissionCheckResponse
+		response, err = checkCommand.Execute(ctx, &base.PermissionCheckRequest{
+			TenantId:   _assertionTenantID,
+			Entity:     q.Entity,
+			Permission: q.Action,
+			Subject:    q.Subject,
+			Metadata: &base.PermissionCheckRequestMetadata{
+				SchemaVersion: _assertionVersion,
+				Depth:         _assertionDepth,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		if allowed := response.GetCan() == base.PermissionCheckResponse_RESULT_ALLOWED; allowed != check.IsAllowed() {
+			return fmt.Errorf("%w: %s: %s is %t, expected %t", ErrAssertionFailed, assertion.Name.Literal, check.Query.Literal, allowed, check.IsAllowed())
+		}
+	}
+	return nil
+}
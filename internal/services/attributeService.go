@@ -0,0 +1,97 @@
+package services
+
+import (
+	"context"
+	"errors"
+	
+	"google.golang.org/protobuf/types/known/structpb"
+	
+	"github.com/adminium/permify/internal/keys"
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/internal/schema"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// AttributeService -
+type AttributeService struct {
+	// repositories
+	ar repositories.AttributeReader
+	aw repositories.AttributeWriter
+	sr repositories.SchemaReader
+	// caches
+	km keys.CommandKeyManager
+}
+
+// NewAttributeService -
+func NewAttributeService(aw repositories.AttributeWriter, ar repositories.AttributeReader, sr repositories.SchemaReader, km keys.CommandKeyManager) *AttributeService {
+	return &AttributeService{
+		ar: ar,
+		aw: aw,
+		sr: sr,
+		km: km,
+	}
+}
+
+// ReadAttributes - Values of the attributes of the entity by their names
+func (service *AttributeService) ReadAttributes(ctx context.Context, tenantID string, entity *base.Entity) (attributes map[string]*structpb.Value, err error) {
+	ctx, span := tracer.Start(ctx, "attributes.read")
+	defer span.End()
+	
+	return service.ar.ReadAttributes(ctx, tenantID, entity)
+}
+
+// WriteAttributes - Writes the values of the attributes of the entity. Every attribute must be declared on the entity
+// in the head schema with the type of its value. Attributes are not versioned, so the cached checks of the tenant are
+// dropped.
+func (service *AttributeService) WriteAttributes(ctx context.Context, tenantID string, entity *base.Entity, attributes map[string]*structpb.Value) (err error) {
+	ctx, span := tracer.Start(ctx, "attributes.write")
+	defer span.End()
+	
+	var rules *schema.Rules
+	rules, err = service.headRules(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	
+	for name, value := range attributes {
+		typ, ok := rules.AttributeType(entity.GetType(), name)
+		if !ok {
+			return errors.New(base.ErrorCode_ERROR_CODE_RELATION_DEFINITION_NOT_FOUND.String())
+		}
+		if err = schema.ValidateAttributeValue(typ, value); err != nil {
+			return err
+		}
+	}
+	
+	if err = service.aw.WriteAttributes(ctx, tenantID, entity, attributes); err != nil {
+		return err
+	}
+	service.km.InvalidateCheckKeys(tenantID)
+	return nil
+}
+
+// DeleteAttributes - Deletes the named attributes of the entity, or all of them when no name is given
+func (service *AttributeService) DeleteAttributes(ctx context.Context, tenantID string, entity *base.Entity, names ...string) (err error) {
+	ctx, span := tracer.Start(ctx, "attributes.delete")
+	defer span.End()
+	
+	if err = service.aw.DeleteAttributes(ctx, tenantID, entity, names...); err != nil {
+		return err
+	}
+	service.km.InvalidateCheckKeys(tenantID)
+	return nil
+}
+
+// headRules - Rules and attribute types of the head schema of the tenant
+func (service *AttributeService) headRules(ctx context.Context, tenantID string) (*schema.Rules, error) {
+	version, err := service.sr.HeadVersion(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	var definitions []string
+	definitions, err = service.sr.ReadSchemaString(ctx, tenantID, version)
+	if err != nil {
+		return nil, err
+	}
+	return schema.NewRulesFromStringDefinitions(definitions...)
+}
@@ -0,0 +1,246 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	
+	"github.com/cespare/xxhash"
+	otelCodes "go.opentelemetry.io/otel/codes"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/pkg/database"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+// ErrMigrationTargetNotEmpty - The target backend already has schema versions or tuples of the tenant
+var ErrMigrationTargetNotEmpty = errors.New("the target backend already has data of the tenant")
+
+// ErrMigrationVerification - The tuples read back from the target backend do not match the migrated ones
+var ErrMigrationVerification = errors.New("the migrated tenant does not match its source")
+
+// ErrBackendMigrationDisabled - The server does not open other backends to migrate tenants to
+var ErrBackendMigrationDisabled = errors.New("tenants are not migrated by this server")
+
+// _defaultMigrationSampleRate - One in this many tuples is part of the checksum of a migration
+const _defaultMigrationSampleRate = 100
+
+// Backend - Repositories of a storage backend that the tenants are migrated between
+type Backend struct {
+	Engine             string
+	SchemaReader       repositories.SchemaReader
+	SchemaWriter       repositories.SchemaWriter
+	RelationshipReader repositories.RelationshipReader
+	RelationshipWriter repositories.RelationshipWriter
+	TenantReader       repositories.TenantReader
+	TenantWriter       repositories.TenantWriter
+}
+
+// BackendMigrationReport - What a tenant migration copied and how it was verified. The tuples of the target are read
+// back after the copy and counted, and the tuples picked by their hash, one in sample rate, are summed up into a
+// checksum on both sides.
+type BackendMigrationReport struct {
+	// Versions - schema versions copied, oldest first so that the head version stays the head
+	Versions []string
+	// SnapToken - snapshot of the source the tuples were read at
+	SnapToken string
+	// Tuples - tuples read from the source and read back from the target
+	Tuples       int
+	TargetTuples int
+	// Sampled - tuples that are part of the checksums
+	Sampled        int
+	Checksum       uint64
+	TargetChecksum uint64
+}
+
+// Verified - Whether the target has as many tuples as the source and the same sampled checksum
+func (r BackendMigrationReport) Verified() bool {
+	return r.Tuples == r.TargetTuples && r.Checksum == r.TargetChecksum
+}
+
+// MigrateTenant - Copies the tenant, its schema versions with their version ids and its tuples at the head snapshot
+// from the source backend to the target one, which must not have any data of the tenant yet, and verifies the copy.
+// The tuples are written in batches of the page size, their write metadata, attributes and the settings of the tenant
+// are not copied. ErrMigrationVerification is returned with the report when the verification fails.
+func MigrateTenant(ctx context.Context, tenantID string, source, target Backend, sampleRate int) (report BackendMigrationReport, err error) {
+	ctx, span := tracer.Start(ctx, "backends.migrate-tenant")
+	defer span.End()
+	
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+		}
+	}()
+	
+	if sampleRate <= 0 {
+		sampleRate = _defaultMigrationSampleRate
+	}
+	
+	if err = createTenant(ctx, tenantID, source, target); err != nil {
+		return report, err
+	}
+	
+	report.Versions, err = copySchemaVersions(ctx, tenantID, source, target)
+	if err != nil {
+		return report, err
+	}
+	
+	st, err := source.RelationshipReader.HeadSnapshot(ctx, tenantID)
+	if err != nil {
+		return report, err
+	}
+	report.SnapToken = st.Encode().String()
+	
+	report.Tuples, report.Sampled, report.Checksum, err = scanTuples(ctx, source.RelationshipReader, tenantID, report.SnapToken, sampleRate, func(tuples *database.TupleCollection) error {
+		_, err := target.RelationshipWriter.BatchWriteRelationships(ctx, tenantID, tuples)
+		return err
+	})
+	if err != nil {
+		return report, err
+	}
+	
+	st, err = target.RelationshipReader.HeadSnapshot(ctx, tenantID)
+	if err != nil {
+		return report, err
+	}
+	report.TargetTuples, _, report.TargetChecksum, err = scanTuples(ctx, target.RelationshipReader, tenantID, st.Encode().String(), sampleRate, nil)
+	if err != nil {
+		return report, err
+	}
+	
+	if !report.Verified() {
+		return report, ErrMigrationVerification
+	}
+	return report, nil
+}
+
+// createTenant - Creates the tenant on the target with its name on the source, unless the target has it already
+// without any data
+func createTenant(ctx context.Context, tenantID string, source, target Backend) error {
+	tenant, err := findTenant(ctx, source.TenantReader, tenantID)
+	if err != nil {
+		return err
+	}
+	if tenant == nil {
+		return errors.New(base.ErrorCode_ERROR_CODE_TENANT_NOT_FOUND.String())
+	}
+	
+	versions, _, err := target.SchemaReader.ListSchemaVersions(ctx, tenantID, database.NewPagination(database.Size(1)))
+	if err != nil {
+		return err
+	}
+	st, err := target.RelationshipReader.HeadSnapshot(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	collection, _, err := target.RelationshipReader.ReadRelationships(ctx, tenantID, &base.TupleFilter{}, st.Encode().String(), database.NewPagination(database.Size(1)))
+	if err != nil {
+		return err
+	}
+	if len(versions) > 0 || len(collection.GetTuples()) > 0 {
+		return ErrMigrationTargetNotEmpty
+	}
+	
+	existing, err := findTenant(ctx, target.TenantReader, tenantID)
+	if err != nil || existing != nil {
+		return err
+	}
+	_, err = target.TenantWriter.CreateTenant(ctx, tenant.GetId(), tenant.GetName())
+	return err
+}
+
+// findTenant - Tenant of the id, nil when there is none
+func findTenant(ctx context.Context, tr repositories.TenantReader, tenantID string) (*base.Tenant, error) {
+	ct := ""
+	for {
+		tenants, next, err := tr.ListTenants(ctx, database.NewPagination(database.Size(_migrationPageSize), database.Token(ct)))
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range tenants {
+			if t.GetId() == tenantID {
+				return t, nil
+			}
+		}
+		if next == nil || next.String() == "" {
+			return nil, nil
+		}
+		ct = next.String()
+	}
+}
+
+// copySchemaVersions - Writes the schema versions of the source to the target with their version ids, oldest first.
+// The definitions are compiled again to name them by their entity types, as they are stored.
+func copySchemaVersions(ctx context.Context, tenantID string, source, target Backend) (versions []string, err error) {
+	ct := ""
+	for {
+		var page []string
+		var next database.EncodedContinuousToken
+		page, next, err = source.SchemaReader.ListSchemaVersions(ctx, tenantID, database.NewPagination(database.Size(_migrationPageSize), database.Token(ct)))
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, page...)
+		if next == nil || next.String() == "" {
+			break
+		}
+		ct = next.String()
+	}
+	
+	// the versions are listed latest first
+	for i, j := 0, len(versions)-1; i < j; i, j = i+1, j-1 {
+		versions[i], versions[j] = versions[j], versions[i]
+	}
+	
+	schemas := NewSchemaService(target.SchemaWriter, target.SchemaReader)
+	for _, version := range versions {
+		var definitions []string
+		definitions, err = source.SchemaReader.ReadSchemaString(ctx, tenantID, version)
+		if err != nil {
+			return nil, err
+		}
+		var c *compilation
+		c, err = schemas.compile(ctx, strings.Join(definitions, "\n\n"))
+		if err != nil {
+			return nil, fmt.Errorf("schema version %s: %w", version, err)
+		}
+		if err = schemas.write(ctx, tenantID, c, version); err != nil {
+			return nil, err
+		}
+	}
+	return versions, nil
+}
+
+// scanTuples - Reads every tuple of the tenant at the snapshot page by page, counts them and sums up the hashes of
+// the sampled ones. f is called with every page when it is not nil.
+func scanTuples(ctx context.Context, rr repositories.RelationshipReader, tenantID, snap string, sampleRate int, f func(*database.TupleCollection) error) (count, sampled int, checksum uint64, err error) {
+	ct := ""
+	for {
+		var collection *database.TupleCollection
+		var next database.EncodedContinuousToken
+		collection, next, err = rr.ReadRelationships(ctx, tenantID, &base.TupleFilter{}, snap, database.NewPagination(database.Size(_migrationPageSize), database.Token(ct)))
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		for _, t := range collection.GetTuples() {
+			sum := xxhash.Sum64String(tuple.ToString(t))
+			if sum%uint64(sampleRate) == 0 {
+				sampled++
+				checksum += sum
+			}
+		}
+		count += len(collection.GetTuples())
+		if f != nil && len(collection.GetTuples()) > 0 {
+			if err = f(collection); err != nil {
+				return 0, 0, 0, err
+			}
+		}
+		if next == nil || next.String() == "" {
+			return count, sampled, checksum, nil
+		}
+		ct = next.String()
+	}
+}
@@ -0,0 +1,97 @@
+package services
+
+import (
+	"context"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	
+	"github.com/adminium/permify/internal/repositories/memory"
+	"github.com/adminium/permify/internal/repositories/memory/migrations"
+	"github.com/adminium/permify/pkg/database"
+	db "github.com/adminium/permify/pkg/database/memory"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+var _ = Describe("backend-migration", func() {
+	l := logger.New("error")
+	
+	newBackend := func() Backend {
+		mem, err := db.New(migrations.Schema)
+		Expect(err).ShouldNot(HaveOccurred())
+		return Backend{
+			Engine:             mem.GetEngineType(),
+			SchemaReader:       memory.NewSchemaReader(mem, l),
+			SchemaWriter:       memory.NewSchemaWriter(mem, l),
+			RelationshipReader: memory.NewRelationshipReader(mem, l),
+			RelationshipWriter: memory.NewRelationshipWriter(mem, l),
+			TenantReader:       memory.NewTenantReader(mem, l),
+			TenantWriter:       memory.NewTenantWriter(mem, l),
+		}
+	}
+	
+	var source, target Backend
+	var versions []string
+	
+	BeforeEach(func() {
+		source, target = newBackend(), newBackend()
+		
+		_, err := source.TenantWriter.CreateTenant(context.Background(), "t1", "tenant 1")
+		Expect(err).ShouldNot(HaveOccurred())
+		
+		schemas := NewSchemaService(source.SchemaWriter, source.SchemaReader)
+		versions = nil
+		for _, s := range []string{
+			"entity user {}\n\nentity doc {\n\trelation owner @user\n}\n",
+			"entity user {}\n\nentity doc {\n\trelation owner @user\n\trelation viewer @user\n\n\taction view = owner or viewer\n}\n",
+		} {
+			version, err := schemas.WriteSchema(context.Background(), "t1", s)
+			Expect(err).ShouldNot(HaveOccurred())
+			versions = append(versions, version)
+		}
+		
+		collection := database.NewTupleCollection()
+		for i := 0; i < 250; i++ {
+			tup, err := tuple.Tuple("doc:" + string(rune('a'+i%26)) + string(rune('a'+i/26)) + "#viewer@user:1")
+			Expect(err).ShouldNot(HaveOccurred())
+			collection.Add(tup)
+		}
+		_, err = source.RelationshipWriter.WriteRelationships(context.Background(), "t1", collection)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+	
+	It("Case 1: The tenant is copied with its schema versions and verified", func() {
+		report, err := MigrateTenant(context.Background(), "t1", source, target, 10)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(report.Verified()).Should(BeTrue())
+		Expect(report.Versions).Should(Equal(versions))
+		Expect(report.Tuples).Should(Equal(250))
+		Expect(report.TargetTuples).Should(Equal(250))
+		Expect(report.Sampled).Should(BeNumerically(">", 0))
+		
+		head, err := target.SchemaReader.HeadVersion(context.Background(), "t1")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(head).Should(Equal(versions[1]))
+		
+		sch, err := target.SchemaReader.ReadSchema(context.Background(), "t1", versions[0])
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(sch.GetEntityDefinitions()["doc"].GetRelations()).Should(HaveLen(1))
+		
+		tenant, err := findTenant(context.Background(), target.TenantReader, "t1")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(tenant.GetName()).Should(Equal("tenant 1"))
+	})
+	
+	It("Case 2: Targets that have data of the tenant are rejected", func() {
+		_, err := MigrateTenant(context.Background(), "t1", source, target, 0)
+		Expect(err).ShouldNot(HaveOccurred())
+		
+		_, err = MigrateTenant(context.Background(), "t1", source, target, 0)
+		Expect(err).Should(Equal(ErrMigrationTargetNotEmpty))
+		
+		_, err = MigrateTenant(context.Background(), "t2", source, newBackend(), 0)
+		Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_TENANT_NOT_FOUND.String()))
+	})
+})
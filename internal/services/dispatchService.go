@@ -0,0 +1,48 @@
+package services
+
+import (
+	"context"
+	
+	otelCodes "go.opentelemetry.io/otel/codes"
+	
+	"github.com/adminium/permify/internal/cluster"
+	"github.com/adminium/permify/internal/commands"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// DispatchService - Checks the subproblems the peers of the dispatch ring send to the entities this instance owns
+type DispatchService struct {
+	// commands
+	cc commands.ICheckCommand
+	// dispatcher of this instance, its ring tells the owner of the subproblems
+	dispatcher *cluster.Dispatcher
+}
+
+// NewDispatchService -
+func NewDispatchService(cc commands.ICheckCommand, dispatcher *cluster.Dispatcher) *DispatchService {
+	return &DispatchService{
+		cc:         cc,
+		dispatcher: dispatcher,
+	}
+}
+
+// Check - Checks the subproblem, the request already carries the snapshot, schema version and depth of the check it
+// was broken from
+func (s *DispatchService) Check(ctx context.Context, request *base.PermissionCheckRequest) (*base.PermissionCheckResponse, error) {
+	ctx, span := tracer.Start(ctx, "dispatch.check")
+	defer span.End()
+	
+	response, err := s.cc.Execute(ctx, request)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, err
+	}
+	return response, nil
+}
+
+// Owner - Peer that owns the entity of the subproblem on the ring of this instance, the peers that dispatched it
+// compare it with the one they sent it to
+func (s *DispatchService) Owner(request *base.PermissionCheckRequest) string {
+	return s.dispatcher.Owner(request)
+}
@@ -0,0 +1,54 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	
+	"github.com/adminium/permify/internal/repositories"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// AliasSeparator - Separates the kind of an external identifier from its value, e.g. email:alice@example.com.
+// Canonical subject ids cannot contain it, so an id with the separator is always an alias.
+const AliasSeparator = ":"
+
+// IsAlias - Reports whether the subject id is an external identifier
+func IsAlias(id string) bool {
+	return strings.Contains(id, AliasSeparator)
+}
+
+// IdentityService -
+type IdentityService struct {
+	ir repositories.IdentityReader
+	iw repositories.IdentityWriter
+}
+
+// NewIdentityService -
+func NewIdentityService(iw repositories.IdentityWriter, ir repositories.IdentityReader) *IdentityService {
+	return &IdentityService{
+		ir: ir,
+		iw: iw,
+	}
+}
+
+// MapIdentity - Maps the external identifier of a subject type to the canonical subject id
+func (s *IdentityService) MapIdentity(ctx context.Context, tenantID, subjectType, alias, subjectID string) (err error) {
+	if !IsAlias(alias) || IsAlias(subjectID) || subjectID == "" {
+		return errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+	}
+	return s.iw.WriteIdentity(ctx, tenantID, subjectType, alias, subjectID)
+}
+
+// UnmapIdentity -
+func (s *IdentityService) UnmapIdentity(ctx context.Context, tenantID, subjectType, alias string) (err error) {
+	return s.iw.DeleteIdentity(ctx, tenantID, subjectType, alias)
+}
+
+// ResolveIdentity - Returns the canonical subject id of the external identifier, canonical ids are returned as is
+func (s *IdentityService) ResolveIdentity(ctx context.Context, tenantID, subjectType, id string) (subjectID string, err error) {
+	if !IsAlias(id) {
+		return id, nil
+	}
+	return s.ir.ResolveIdentity(ctx, tenantID, subjectType, id)
+}
@@ -2,8 +2,17 @@ package services
 
 import (
 	"context"
+	"time"
 	
+	"google.golang.org/protobuf/types/known/structpb"
+	
+	"github.com/adminium/permify/internal/commands"
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/internal/schema"
+	"github.com/adminium/permify/internal/warmup"
 	"github.com/adminium/permify/pkg/database"
+	"github.com/adminium/permify/pkg/dsl/linter"
+	"github.com/adminium/permify/pkg/migration"
 	base "github.com/adminium/permify/pkg/pb/base/v1"
 	"github.com/adminium/permify/pkg/token"
 )
@@ -11,28 +20,110 @@ import (
 // IPermissionService -
 type IPermissionService interface {
 	CheckPermissions(ctx context.Context, request *base.PermissionCheckRequest) (response *base.PermissionCheckResponse, err error)
+	BulkCheckStream(ctx context.Context, recv func() (*BulkCheckItem, error), send func(*BulkCheckResult) error, window, limit int) error
+	BulkCheck(ctx context.Context, items []*BulkCheckItem, concurrency, limit int) ([]*BulkCheckResult, error)
+	ReplayCheck(ctx context.Context, request *base.PermissionCheckRequest) (response *base.PermissionCheckResponse, trace []commands.CheckTraceStep, err error)
+	WarmUp(ctx context.Context, patterns []warmup.Pattern) (result WarmUpResult, err error)
 	ExpandPermissions(ctx context.Context, request *base.PermissionExpandRequest) (response *base.PermissionExpandResponse, err error)
+	ExpandPermissionsWithTruncations(ctx context.Context, request *base.PermissionExpandRequest) (response *base.PermissionExpandResponse, truncated []commands.ExpandTruncation, err error)
+	ContinueExpand(ctx context.Context, tenantID, continuation string) (response *base.PermissionExpandResponse, truncated []commands.ExpandTruncation, err error)
 	LookupSchema(ctx context.Context, request *base.PermissionLookupSchemaRequest) (response *base.PermissionLookupSchemaResponse, err error)
 	LookupEntity(ctx context.Context, request *base.PermissionLookupEntityRequest) (response *base.PermissionLookupEntityResponse, err error)
 	LookupEntityStream(ctx context.Context, request *base.PermissionLookupEntityRequest, server base.Permission_LookupEntityStreamServer) (err error)
+	LookupSubject(ctx context.Context, request *commands.LookupSubjectRequest) (response *commands.LookupSubjectResponse, err error)
 }
 
 // IRelationshipService -
 type IRelationshipService interface {
 	ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, size uint32, continuousToken string) (*database.TupleCollection, database.EncodedContinuousToken, error)
+	ReadRelationshipsAt(ctx context.Context, tenantID string, filter *base.TupleFilter, at time.Time, size uint32, continuousToken string) (*database.TupleCollection, database.EncodedContinuousToken, error)
+	ReadDeletedRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, from, to time.Time) (*database.TupleCollection, error)
+	ReadRelationshipChanges(ctx context.Context, tenantID string, filter *base.TupleFilter, from, to string) (*database.TupleCollection, *database.TupleCollection, string, error)
+	FilterRelationships(ctx context.Context, tenantID string, expression string, snap string) (*database.TupleCollection, error)
 	WriteRelationships(ctx context.Context, tenantID string, tuples []*base.Tuple, version string) (token.EncodedSnapToken, error)
+	BatchWriteRelationships(ctx context.Context, tenantID string, tuples []*base.Tuple, version string) (token.EncodedSnapToken, error)
+	BatchWriteRelationshipsPartially(ctx context.Context, tenantID string, tuples []*base.Tuple, version string) (token.EncodedSnapToken, []RejectedTuple, error)
 	DeleteRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter) (token.EncodedSnapToken, error)
+	ExportRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, f func(*base.Tuple) error) (string, error)
+	ImportRelationships(ctx context.Context, tenantID string, version string, next func() (*base.Tuple, error), batchSize int) (int, token.EncodedSnapToken, error)
+	ValidateData(ctx context.Context, tenantID string, version string, size uint32, continuousToken string, deleteInvalid bool) ([]InvalidTuple, database.EncodedContinuousToken, error)
+	PlanMigration(ctx context.Context, tenantID string, spec migration.Spec, snap string) (*migration.Plan, error)
+	ApplyMigration(ctx context.Context, tenantID string, plan *migration.Plan, batchSize int, progress migration.ProgressFunc) (token.EncodedSnapToken, error)
 }
 
 // ISchemaService -
 type ISchemaService interface {
 	ReadSchema(ctx context.Context, tenantID string, version string) (response *base.SchemaDefinition, err error)
 	WriteSchema(ctx context.Context, tenantID string, schema string) (version string, err error)
+	ValidateSchema(ctx context.Context, schema string) (response *base.SchemaDefinition, err error)
+	ReadDeprecations(ctx context.Context, tenantID string, version string) (deprecations schema.Deprecations, err error)
+	ReadAliases(ctx context.Context, tenantID string, version string) (aliases schema.Aliases, err error)
+	ReadDocs(ctx context.Context, tenantID string, version string) (docs schema.Docs, err error)
+	Complete(ctx context.Context, tenantID, version, entityType string) (completion schema.Completion, err error)
+	FindReferences(ctx context.Context, tenantID, version, entityType, relation string) (references []schema.Reference, err error)
+	Lint(ctx context.Context, tenantID, version, source string) (warnings []linter.Warning, err error)
+	ListSchemaVersions(ctx context.Context, tenantID string, size uint32, ct string) (versions []string, continuousToken database.EncodedContinuousToken, err error)
+	WriteSchemaVersion(ctx context.Context, tenantID string, schema string, version string) (err error)
+	RollbackSchema(ctx context.Context, tenantID string, version string) (newVersion string, err error)
+	DiffSchema(ctx context.Context, tenantID string, schema string) (changes []schema.Change, err error)
+	WriteSchemaWithDiff(ctx context.Context, tenantID string, schema string, force bool) (version string, changes []schema.Change, err error)
 }
 
 // ITenancyService -
 type ITenancyService interface {
 	CreateTenant(ctx context.Context, id, name string) (tenant *base.Tenant, err error)
 	DeleteTenant(ctx context.Context, tenantID string) (tenant *base.Tenant, err error)
+	GetTenant(ctx context.Context, tenantID string) (tenant *base.Tenant, labels map[string]string, err error)
+	UpdateTenant(ctx context.Context, tenantID, name string, labels map[string]string) (tenant *base.Tenant, current map[string]string, err error)
 	ListTenants(ctx context.Context, size uint32, ct string) (tenants []*base.Tenant, continuousToken database.EncodedContinuousToken, err error)
 }
+
+// IIdentityService -
+type IIdentityService interface {
+	MapIdentity(ctx context.Context, tenantID, subjectType, alias, subjectID string) (err error)
+	UnmapIdentity(ctx context.Context, tenantID, subjectType, alias string) (err error)
+	ResolveIdentity(ctx context.Context, tenantID, subjectType, id string) (subjectID string, err error)
+}
+
+// IAttributeService -
+type IAttributeService interface {
+	ReadAttributes(ctx context.Context, tenantID string, entity *base.Entity) (attributes map[string]*structpb.Value, err error)
+	WriteAttributes(ctx context.Context, tenantID string, entity *base.Entity, attributes map[string]*structpb.Value) (err error)
+	DeleteAttributes(ctx context.Context, tenantID string, entity *base.Entity, names ...string) (err error)
+}
+
+// ISettingsService -
+type ISettingsService interface {
+	ReadSettings(ctx context.Context, tenantID string) (settings repositories.TenantSettings, err error)
+	WriteSettings(ctx context.Context, tenantID string, settings repositories.TenantSettings) (err error)
+}
+
+// ISCIMService -
+type ISCIMService interface {
+	CreateGroup(ctx context.Context, tenantID string, group SCIMGroup) (SCIMGroup, error)
+	ReplaceGroup(ctx context.Context, tenantID string, group SCIMGroup) (SCIMGroup, error)
+	PatchGroup(ctx context.Context, tenantID, id string, operations []SCIMOperation) (SCIMGroup, error)
+	DeleteGroup(ctx context.Context, tenantID, id string) error
+	ReadGroup(ctx context.Context, tenantID, id string) (SCIMGroup, error)
+}
+
+// IOpenFGAService -
+type IOpenFGAService interface {
+	Import(ctx context.Context, tenantID, version string, model *base.OpenFGAAuthorizationModel, keys []*base.OpenFGATupleKey, tuples []string) (result OpenFGAImport, err error)
+}
+
+// IReplicationService -
+type IReplicationService interface {
+	Apply(ctx context.Context, command []byte) ([]byte, error)
+}
+
+// IDispatchService -
+type IDispatchService interface {
+	Check(ctx context.Context, request *base.PermissionCheckRequest) (*base.PermissionCheckResponse, error)
+	Owner(request *base.PermissionCheckRequest) string
+}
+
+// IWatchService -
+type IWatchService interface {
+	Watch(ctx context.Context, tenantID, snap string, send func(changes *repositories.RelationshipChanges) error) (err error)
+}
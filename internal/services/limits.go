@@ -0,0 +1,49 @@
+package services
+
+import (
+	"fmt"
+	
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// LimitError - Request is larger than a configured limit
+type LimitError struct {
+	// Field - request field that is too large, e.g. tuples
+	Field string
+	Limit int
+	Got   int
+}
+
+// Error -
+func (e *LimitError) Error() string {
+	return base.ErrorCode_ERROR_CODE_VALIDATION.String()
+}
+
+// GRPCStatus - Reports the violated limit as a bad request detail, so clients can tell which field to split
+func (e *LimitError) GRPCStatus() *status.Status {
+	st := status.New(codes.InvalidArgument, e.Error())
+	detailed, err := st.WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{
+				Field:       e.Field,
+				Description: fmt.Sprintf("at most %d allowed per request, got %d", e.Limit, e.Got),
+			},
+		},
+	})
+	if err != nil {
+		return st
+	}
+	return detailed
+}
+
+// CheckLimit - Fails when the size of the field is over the limit, zero limits are disabled
+func CheckLimit(field string, limit, got int) error {
+	if limit > 0 && got > limit {
+		return &LimitError{Field: field, Limit: limit, Got: got}
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	
+	internalSchema "github.com/adminium/permify/internal/schema"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/schema"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+// ErrOpenFGATranslation - The authorization model or a tuple of the import cannot be translated
+var ErrOpenFGATranslation = errors.New("openfga import cannot be translated")
+
+// OpenFGAImport - Result of an import, the snap token is empty when there were no tuples to write
+type OpenFGAImport struct {
+	SchemaVersion string
+	Imported      int
+	SnapToken     string
+}
+
+// OpenFGAService - Imports the authorization models and tuples of OpenFGA, so users can migrate from it. The models
+// are written as schemas and the tuples are written through the relationship service, so they are validated against
+// the translated schema.
+type OpenFGAService struct {
+	ss ISchemaService
+	rs IRelationshipService
+}
+
+// NewOpenFGAService -
+func NewOpenFGAService(ss ISchemaService, rs IRelationshipService) *OpenFGAService {
+	return &OpenFGAService{
+		ss: ss,
+		rs: rs,
+	}
+}
+
+// Import - Writes the translation of the model as the schema of the tenant when it is given, then writes the tuples
+// atomically. Without a model the tuples are written against the schema version, the head one when empty. The tuples
+// are the keys and the string forms, e.g. document:1#viewer@user:2, of the tuples.
+func (s *OpenFGAService) Import(ctx context.Context, tenantID, version string, model *base.OpenFGAAuthorizationModel, keys []*base.OpenFGATupleKey, tuples []string) (result OpenFGAImport, err error) {
+	ctx, span := tracer.Start(ctx, "openfga.import")
+	defer span.End()
+	
+	translated := make([]*base.Tuple, 0, len(keys)+len(tuples))
+	for _, key := range keys {
+		var t *base.Tuple
+		t, err = openFGATuple(tuple.OpenFGATupleKey{User: key.GetUser(), Relation: key.GetRelation(), Object: key.GetObject()})
+		if err != nil {
+			return result, err
+		}
+		translated = append(translated, t)
+	}
+	for _, raw := range tuples {
+		var key tuple.OpenFGATupleKey
+		key, err = tuple.ParseOpenFGATupleKey(raw)
+		if err != nil {
+			return result, fmt.Errorf("%w: %s: %s", ErrOpenFGATranslation, err.Error(), raw)
+		}
+		var t *base.Tuple
+		t, err = openFGATuple(key)
+		if err != nil {
+			return result, err
+		}
+		translated = append(translated, t)
+	}
+	
+	var sch *base.SchemaDefinition
+	if model != nil {
+		sch, err = schema.FromOpenFGA(openFGAModel(model))
+		if err != nil {
+			return result, fmt.Errorf("%w: %s", ErrOpenFGATranslation, err.Error())
+		}
+		version, err = s.ss.WriteSchema(ctx, tenantID, internalSchema.ToString(sch))
+	} else {
+		sch, err = s.ss.ReadSchema(ctx, tenantID, version)
+	}
+	if err != nil {
+		return result, err
+	}
+	
+	result.SchemaVersion = version
+	result.Imported = len(translated)
+	if len(translated) == 0 {
+		return result, nil
+	}
+	
+	for _, t := range translated {
+		t.Relation = schema.OpenFGARelation(sch, t.GetEntity().GetType(), t.GetRelation())
+	}
+	snap, err := s.rs.BatchWriteRelationships(ctx, tenantID, translated, version)
+	if err != nil {
+		return result, err
+	}
+	result.SnapToken = snap.String()
+	return result, nil
+}
+
+// openFGATuple - Tuple of the key, its relation is still the one of OpenFGA
+func openFGATuple(key tuple.OpenFGATupleKey) (*base.Tuple, error) {
+	t, err := tuple.FromOpenFGA(key)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %s", ErrOpenFGATranslation, err.Error(), key.Object+"#"+key.Relation+"@"+key.User)
+	}
+	return t, nil
+}
+
+// openFGAModel - Model of the api definitions in the form the schema translates
+func openFGAModel(model *base.OpenFGAAuthorizationModel) *schema.OpenFGAModel {
+	m := &schema.OpenFGAModel{
+		SchemaVersion:   model.GetSchemaVersion(),
+		TypeDefinitions: make([]schema.OpenFGATypeDefinition, 0, len(model.GetTypeDefinitions())),
+	}
+	for _, definition := range model.GetTypeDefinitions() {
+		d := schema.OpenFGATypeDefinition{
+			Type:      definition.GetType(),
+			Relations: make(map[string]*schema.OpenFGAUserset, len(definition.GetRelations())),
+		}
+		for name, userset := range definition.GetRelations() {
+			d.Relations[name] = openFGAUserset(userset)
+		}
+		if metadata := definition.GetMetadata(); metadata != nil {
+			d.Metadata = &schema.OpenFGAMetadata{
+				Relations: make(map[string]schema.OpenFGARelationMetadata, len(metadata.GetRelations())),
+			}
+			for name, relation := range metadata.GetRelations() {
+				references := make([]schema.OpenFGARelationReference, 0, len(relation.GetDirectlyRelatedUserTypes()))
+				for _, reference := range relation.GetDirectlyRelatedUserTypes() {
+					r := schema.OpenFGARelationReference{
+						Type:     reference.GetType(),
+						Relation: reference.GetRelation(),
+					}
+					if reference.GetWildcard() != nil {
+						r.Wildcard = &struct{}{}
+					}
+					references = append(references, r)
+				}
+				d.Metadata.Relations[name] = schema.OpenFGARelationMetadata{DirectlyRelatedUserTypes: references}
+			}
+		}
+		m.TypeDefinitions = append(m.TypeDefinitions, d)
+	}
+	return m
+}
+
+// openFGAUserset - Rewrite of the api definitions in the form the schema translates, nil when none of it is set
+func openFGAUserset(userset *base.OpenFGAUserset) *schema.OpenFGAUserset {
+	if userset == nil {
+		return nil
+	}
+	switch u := userset.GetUserset().(type) {
+	case *base.OpenFGAUserset_This:
+		return &schema.OpenFGAUserset{This: &struct{}{}}
+	case *base.OpenFGAUserset_ComputedUserset:
+		return &schema.OpenFGAUserset{ComputedUserset: &schema.OpenFGAObjectRelation{Relation: u.ComputedUserset.GetRelation()}}
+	case *base.OpenFGAUserset_TupleToUserset:
+		return &schema.OpenFGAUserset{TupleToUserset: &schema.OpenFGATupleToUserset{
+			Tupleset:        schema.OpenFGAObjectRelation{Relation: u.TupleToUserset.GetTupleset().GetRelation()},
+			ComputedUserset: schema.OpenFGAObjectRelation{Relation: u.TupleToUserset.GetComputedUserset().GetRelation()},
+		}}
+	case *base.OpenFGAUserset_Union:
+		return &schema.OpenFGAUserset{Union: openFGAUsersets(u.Union)}
+	case *base.OpenFGAUserset_Intersection:
+		return &schema.OpenFGAUserset{Intersection: openFGAUsersets(u.Intersection)}
+	case *base.OpenFGAUserset_Difference:
+		return &schema.OpenFGAUserset{Difference: &schema.OpenFGADifferenceUserset{
+			Base:     openFGAUserset(u.Difference.GetBase()),
+			Subtract: openFGAUserset(u.Difference.GetSubtract()),
+		}}
+	default:
+		return &schema.OpenFGAUserset{}
+	}
+}
+
+// openFGAUsersets -
+func openFGAUsersets(usersets *base.OpenFGAUsersets) *schema.OpenFGAUsersets {
+	children := make([]*schema.OpenFGAUserset, 0, len(usersets.GetChild()))
+	for _, child := range usersets.GetChild() {
+		children = append(children, openFGAUserset(child))
+	}
+	return &schema.OpenFGAUsersets{Child: children}
+}
@@ -0,0 +1,88 @@
+package services
+
+import (
+	"context"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/encoding/protojson"
+	
+	"github.com/adminium/permify/internal/repositories/memory"
+	"github.com/adminium/permify/internal/repositories/memory/migrations"
+	db "github.com/adminium/permify/pkg/database/memory"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+var _ = Describe("openfga-service", func() {
+	var service *OpenFGAService
+	var relationships *RelationshipService
+	
+	// the json form OpenFGA exports its authorization models in
+	model := `{
+		"schema_version": "1.1",
+		"type_definitions": [
+			{"type": "user"},
+			{
+				"type": "document",
+				"relations": {
+					"editor": {"this": {}},
+					"viewer": {"union": {"child": [{"this": {}}, {"computedUserset": {"relation": "editor"}}]}}
+				},
+				"metadata": {
+					"relations": {
+						"editor": {"directly_related_user_types": [{"type": "user"}]},
+						"viewer": {"directly_related_user_types": [{"type": "user"}]}
+					}
+				}
+			}
+		]
+	}`
+	
+	BeforeEach(func() {
+		mem, err := db.New(migrations.Schema)
+		Expect(err).ShouldNot(HaveOccurred())
+		l := logger.New("error")
+		
+		schemaReader := memory.NewSchemaReader(mem, l)
+		relationships = NewRelationshipService(memory.NewRelationshipReader(mem, l), memory.NewRelationshipWriter(mem, l), schemaReader)
+		service = NewOpenFGAService(NewSchemaService(memory.NewSchemaWriter(mem, l), schemaReader), relationships)
+	})
+	
+	Context("Import", func() {
+		It("Case 1: The model is written as the schema and the tuples are written with the translated relations", func() {
+			m := &base.OpenFGAAuthorizationModel{}
+			Expect(protojson.Unmarshal([]byte(model), m)).Should(Succeed())
+			
+			result, err := service.Import(context.Background(), "t1", "", m, []*base.OpenFGATupleKey{
+				{User: "user:1", Relation: "editor", Object: "document:1"},
+			}, []string{"document:1#viewer@user:2"})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.SchemaVersion).ShouldNot(BeEmpty())
+			Expect(result.Imported).Should(Equal(2))
+			Expect(result.SnapToken).ShouldNot(BeEmpty())
+			
+			collection, _, err := relationships.ReadRelationships(context.Background(), "t1", &base.TupleFilter{
+				Entity: &base.EntityFilter{Type: "document", Ids: []string{"1"}},
+			}, "", 100, "")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			relations := map[string]string{}
+			for _, t := range collection.GetTuples() {
+				relations[t.GetSubject().GetId()] = t.GetRelation()
+			}
+			Expect(relations).Should(Equal(map[string]string{"1": "editor", "2": "viewer_direct"}))
+		})
+		
+		It("Case 2: Tuples that cannot be translated fail the import before the model is written", func() {
+			m := &base.OpenFGAAuthorizationModel{}
+			Expect(protojson.Unmarshal([]byte(model), m)).Should(Succeed())
+			
+			_, err := service.Import(context.Background(), "t1", "", m, nil, []string{"document:1@user:2"})
+			Expect(err).Should(MatchError(ErrOpenFGATranslation))
+			
+			_, err = service.ss.ReadSchema(context.Background(), "t1", "")
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
@@ -2,11 +2,48 @@ package services
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	
+	otelCodes "go.opentelemetry.io/otel/codes"
+	"golang.org/x/sync/errgroup"
 	
 	"github.com/adminium/permify/internal/commands"
+	"github.com/adminium/permify/internal/warmup"
 	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+const (
+	_defaultBulkCheckWindow      = 100
+	_defaultBulkCheckConcurrency = 100
 )
 
+// BulkCheckItem - Check request of a bulk check stream, the response carries the same correlation id
+type BulkCheckItem struct {
+	ID      string
+	Request *base.PermissionCheckRequest
+}
+
+// BulkCheckResult - Response or error of a single check of a bulk check stream
+type BulkCheckResult struct {
+	ID       string
+	Response *base.PermissionCheckResponse
+	Err      error
+}
+
+// WarmUpResult - Outcome of the patterns of a warmup
+type WarmUpResult struct {
+	// Warmed - checks that were executed
+	Warmed int
+	// Skipped - patterns that are not valid checks
+	Skipped int
+	// Failed - checks that failed, e.g. of a tenant that no longer exists
+	Failed int
+}
+
 // PermissionService -
 type PermissionService struct {
 	// commands
@@ -14,16 +51,24 @@ type PermissionService struct {
 	ec commands.IExpandCommand
 	ls commands.ILookupSchemaCommand
 	le commands.ILookupEntityCommand
+	lu commands.ILookupSubjectCommand
+	// cached responses of the expands and the schema lookups, nil when they are not cached
+	responses *responseCache
 }
 
 // NewPermissionService -
-func NewPermissionService(cc commands.ICheckCommand, ec commands.IExpandCommand, ls commands.ILookupSchemaCommand, le commands.ILookupEntityCommand) *PermissionService {
-	return &PermissionService{
+func NewPermissionService(cc commands.ICheckCommand, ec commands.IExpandCommand, ls commands.ILookupSchemaCommand, le commands.ILookupEntityCommand, lu commands.ILookupSubjectCommand, opts ...PermissionOption) *PermissionService {
+	service := &PermissionService{
 		cc: cc,
 		ec: ec,
 		ls: ls,
 		le: le,
+		lu: lu,
+	}
+	for _, opt := range opts {
+		opt(service)
 	}
+	return service
 }
 
 // CheckPermissions -
@@ -31,13 +76,225 @@ func (service *PermissionService) CheckPermissions(ctx context.Context, request
 	return service.cc.Execute(ctx, request)
 }
 
+// BulkCheckStream - Receives check requests until recv returns io.EOF and sends each result as soon as its check
+// completes, so results are not in request order and must be matched by their correlation id. At most window checks
+// are in flight or waiting to be sent; recv is not called while the window is full, so a slow reader holds back the
+// writer through the transport's flow control. A failing check is reported in its result and does not end the stream.
+// recv and send are each called from a single goroutine, so they can be the Recv and Send of a grpc stream.
+// A stream that sends more than limit checks fails with a LimitError, zero does not limit it.
+func (service *PermissionService) BulkCheckStream(ctx context.Context, recv func() (*BulkCheckItem, error), send func(*BulkCheckResult) error, window, limit int) error {
+	ctx, span := tracer.Start(ctx, "permissions.bulk-check-stream")
+	defer span.End()
+	
+	if window <= 0 {
+		window = _defaultBulkCheckWindow
+	}
+	
+	g, ctx := errgroup.WithContext(ctx)
+	
+	inflight := make(chan struct{}, window)
+	results := make(chan *BulkCheckResult, window)
+	
+	// receiver
+	g.Go(func() error {
+		var wg sync.WaitGroup
+		defer func() {
+			wg.Wait()
+			close(results)
+		}()
+		for received := 1; ; received++ {
+			item, err := recv()
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if err = CheckLimit("checks", limit, received); err != nil {
+				return err
+			}
+			select {
+			case inflight <- struct{}{}:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				result := &BulkCheckResult{ID: item.ID}
+				if item.Request == nil || item.Request.Validate() != nil {
+					result.Err = errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+				} else {
+					result.Response, result.Err = service.cc.Execute(ctx, item.Request)
+				}
+				results <- result
+			}()
+		}
+	})
+	
+	// sender
+	g.Go(func() error {
+		for result := range results {
+			if err := send(result); err != nil {
+				return err
+			}
+			<-inflight
+		}
+		return nil
+	})
+	
+	err := g.Wait()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+	}
+	return err
+}
+
+// BulkCheck - Checks the items concurrently, at most concurrency at a time, and returns their results in the order of
+// the items. Items that are the same check are executed once, and the subproblems that the checks share are resolved
+// once when their results are in the check cache. A failing check is reported in its result and does not fail the
+// others. More than limit items fail with a LimitError, zero does not limit them.
+func (service *PermissionService) BulkCheck(ctx context.Context, items []*BulkCheckItem, concurrency, limit int) ([]*BulkCheckResult, error) {
+	ctx, span := tracer.Start(ctx, "permissions.bulk-check")
+	defer span.End()
+	
+	if err := CheckLimit("checks", limit, len(items)); err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, err
+	}
+	if concurrency <= 0 {
+		concurrency = _defaultBulkCheckConcurrency
+	}
+	
+	// the first item of each distinct check is executed, the others copy its result
+	first := make([]int, len(items))
+	seen := map[string]int{}
+	for i, item := range items {
+		first[i] = i
+		if item.Request == nil {
+			continue
+		}
+		key := bulkCheckKey(item.Request)
+		if j, ok := seen[key]; ok {
+			first[i] = j
+			continue
+		}
+		seen[key] = i
+	}
+	
+	results := make([]*BulkCheckResult, len(items))
+	g := new(errgroup.Group)
+	g.SetLimit(concurrency)
+	for i, item := range items {
+		if first[i] != i {
+			continue
+		}
+		i, item := i, item
+		g.Go(func() error {
+			result := &BulkCheckResult{ID: item.ID}
+			if item.Request == nil || item.Request.Validate() != nil {
+				result.Err = errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+			} else {
+				result.Response, result.Err = service.cc.Execute(ctx, item.Request)
+			}
+			results[i] = result
+			return nil
+		})
+	}
+	_ = g.Wait()
+	
+	for i, item := range items {
+		if first[i] != i {
+			results[i] = &BulkCheckResult{ID: item.ID, Response: results[first[i]].Response, Err: results[first[i]].Err}
+		}
+	}
+	return results, nil
+}
+
+// bulkCheckKey - Identity of a check of a bulk check
+func bulkCheckKey(request *base.PermissionCheckRequest) string {
+	return fmt.Sprintf("%s|%s|%s|%d|%t|%s#%s@%s", request.GetTenantId(), request.GetMetadata().GetSchemaVersion(), request.GetMetadata().GetSnapToken(),
+		request.GetMetadata().GetDepth(), request.GetMetadata().GetExclusion(), tuple.EntityToString(request.GetEntity()), request.GetPermission(), tuple.SubjectToString(request.GetSubject()))
+}
+
+// ReplayCheck - Re-executes a check pinned to the given snap token and schema version and returns its trace,
+// so that a historical decision can be explained.
+func (service *PermissionService) ReplayCheck(ctx context.Context, request *base.PermissionCheckRequest) (response *base.PermissionCheckResponse, trace []commands.CheckTraceStep, err error) {
+	ctx, span := tracer.Start(ctx, "permissions.replay-check")
+	defer span.End()
+	
+	if request.GetMetadata().GetSnapToken() == "" || request.GetMetadata().GetSchemaVersion() == "" {
+		return nil, nil, errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+	}
+	
+	t := commands.NewCheckTrace()
+	response, err = service.cc.Execute(commands.ContextWithCheckTrace(ctx, t), request)
+	return response, t.Steps(), err
+}
+
+// WarmUp - Executes the checks of the given patterns so that the schema and check caches are populated
+// before real traffic arrives. Invalid patterns and failing checks are counted, they do not stop the warmup.
+func (service *PermissionService) WarmUp(ctx context.Context, patterns []warmup.Pattern) (result WarmUpResult, err error) {
+	ctx, span := tracer.Start(ctx, "permissions.warm-up")
+	defer span.End()
+	
+	for _, pattern := range patterns {
+		if ctx.Err() != nil {
+			return result, ctx.Err()
+		}
+		var request *base.PermissionCheckRequest
+		request, err = pattern.ToRequest()
+		if err != nil {
+			result.Skipped++
+			continue
+		}
+		// a pattern may refer to a tenant or entity that no longer exists
+		if _, err = service.cc.Execute(ctx, request); err != nil {
+			result.Failed++
+			continue
+		}
+		result.Warmed++
+	}
+	return result, nil
+}
+
 // ExpandPermissions -
 func (service *PermissionService) ExpandPermissions(ctx context.Context, request *base.PermissionExpandRequest) (response *base.PermissionExpandResponse, err error) {
-	return service.ec.Execute(ctx, request)
+	response, _, err = service.ExpandPermissionsWithTruncations(ctx, request)
+	return response, err
+}
+
+// ExpandPermissionsWithTruncations - Expands the permission and returns the leaves that were truncated by the
+// subject limit of the expansion, each with a token for ContinueExpand
+func (service *PermissionService) ExpandPermissionsWithTruncations(ctx context.Context, request *base.PermissionExpandRequest) (response *base.PermissionExpandResponse, truncated []commands.ExpandTruncation, err error) {
+	execute := func() (*base.PermissionExpandResponse, []commands.ExpandTruncation, error) {
+		t := commands.NewExpandTruncations()
+		response, err := service.ec.Execute(commands.ContextWithExpandTruncations(ctx, t), request)
+		return response, t.Leaves(), err
+	}
+	if service.responses != nil {
+		return service.responses.expand(ctx, request, execute)
+	}
+	return execute()
+}
+
+// ContinueExpand - Expands the next subjects of a truncated leaf, the leaf is returned as truncated again when
+// they do not fit in the subject limit either
+func (service *PermissionService) ContinueExpand(ctx context.Context, tenantID, continuation string) (response *base.PermissionExpandResponse, truncated []commands.ExpandTruncation, err error) {
+	t := commands.NewExpandTruncations()
+	response, err = service.ec.Continue(commands.ContextWithExpandTruncations(ctx, t), tenantID, continuation)
+	return response, t.Leaves(), err
 }
 
 // LookupSchema -
 func (service *PermissionService) LookupSchema(ctx context.Context, request *base.PermissionLookupSchemaRequest) (response *base.PermissionLookupSchemaResponse, err error) {
+	if service.responses != nil {
+		return service.responses.lookupSchema(ctx, request, func() (*base.PermissionLookupSchemaResponse, error) {
+			return service.ls.Execute(ctx, request)
+		})
+	}
 	return service.ls.Execute(ctx, request)
 }
 
@@ -46,6 +303,11 @@ func (service *PermissionService) LookupEntity(ctx context.Context, request *bas
 	return service.le.Execute(ctx, request)
 }
 
+// LookupSubject -
+func (service *PermissionService) LookupSubject(ctx context.Context, request *commands.LookupSubjectRequest) (response *commands.LookupSubjectResponse, err error) {
+	return service.lu.Execute(ctx, request)
+}
+
 // LookupEntityStream -
 func (service *PermissionService) LookupEntityStream(ctx context.Context, request *base.PermissionLookupEntityRequest, server base.Permission_LookupEntityStreamServer) (err error) {
 	return service.le.Stream(ctx, request, server)
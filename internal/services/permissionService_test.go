@@ -1 +1,235 @@
 package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync/atomic"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/adminium/permify/internal/keys"
+	"github.com/adminium/permify/internal/warmup"
+	"github.com/adminium/permify/pkg/cache/ristretto"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/telemetry"
+)
+
+// allowAll - check command allowing every request
+type allowAll struct{}
+
+func (allowAll) Execute(context.Context, *base.PermissionCheckRequest) (*base.PermissionCheckResponse, error) {
+	return &base.PermissionCheckResponse{Can: base.PermissionCheckResponse_RESULT_ALLOWED}, nil
+}
+
+// countingCheck - check command allowing the subject with id 1, counting its executions
+type countingCheck struct {
+	executions int32
+}
+
+func (c *countingCheck) Execute(_ context.Context, request *base.PermissionCheckRequest) (*base.PermissionCheckResponse, error) {
+	atomic.AddInt32(&c.executions, 1)
+	if request.GetSubject().GetId() == "1" {
+		return &base.PermissionCheckResponse{Can: base.PermissionCheckResponse_RESULT_ALLOWED}, nil
+	}
+	return &base.PermissionCheckResponse{Can: base.PermissionCheckResponse_RESULT_DENIED}, nil
+}
+
+// countingExpand - expand command returning a leaf of the entity, counting its executions
+type countingExpand struct {
+	executions int32
+}
+
+func (c *countingExpand) Execute(_ context.Context, request *base.PermissionExpandRequest) (*base.PermissionExpandResponse, error) {
+	atomic.AddInt32(&c.executions, 1)
+	return &base.PermissionExpandResponse{Tree: &base.Expand{Node: &base.Expand_Leaf{Leaf: &base.Result{
+		Target: &base.EntityAndRelation{Entity: request.GetEntity(), Relation: request.GetPermission()},
+	}}}}, nil
+}
+
+func (c *countingExpand) Continue(context.Context, string, string) (*base.PermissionExpandResponse, error) {
+	return nil, errors.New(base.ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN.String())
+}
+
+// tenantCheck - check command allowing every request of tenant t1, the other tenants do not exist
+type tenantCheck struct{}
+
+func (tenantCheck) Execute(_ context.Context, request *base.PermissionCheckRequest) (*base.PermissionCheckResponse, error) {
+	if request.GetTenantId() != "t1" {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_NOT_FOUND.String())
+	}
+	return &base.PermissionCheckResponse{Can: base.PermissionCheckResponse_RESULT_ALLOWED}, nil
+}
+
+var _ = Describe("permission-service", func() {
+	Context("BulkCheckStream", func() {
+		stream := func(n int) func() (*BulkCheckItem, error) {
+			sent := 0
+			return func() (*BulkCheckItem, error) {
+				if sent == n {
+					return nil, io.EOF
+				}
+				sent++
+				return &BulkCheckItem{
+					ID: "c",
+					Request: &base.PermissionCheckRequest{
+						TenantId:   "t1",
+						Metadata:   &base.PermissionCheckRequestMetadata{Depth: 20},
+						Entity:     &base.Entity{Type: "repository", Id: "1"},
+						Permission: "push",
+						Subject:    &base.Subject{Type: "user", Id: "1"},
+					},
+				}, nil
+			}
+		}
+
+		It("Case 1: Within the limit", func() {
+			service := NewPermissionService(allowAll{}, nil, nil, nil, nil)
+
+			var results int
+			err := service.BulkCheckStream(context.Background(), stream(3), func(result *BulkCheckResult) error {
+				Expect(result.Err).ShouldNot(HaveOccurred())
+				results++
+				return nil
+			}, 2, 3)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(results).Should(Equal(3))
+		})
+
+		It("Case 2: Over the limit", func() {
+			service := NewPermissionService(allowAll{}, nil, nil, nil, nil)
+
+			err := service.BulkCheckStream(context.Background(), stream(4), func(result *BulkCheckResult) error {
+				return nil
+			}, 2, 3)
+
+			var limitErr *LimitError
+			Expect(errors.As(err, &limitErr)).Should(BeTrue())
+			Expect(limitErr.Field).Should(Equal("checks"))
+			Expect(limitErr.Got).Should(Equal(4))
+
+			st := status.Convert(err)
+			Expect(st.Code()).Should(Equal(codes.InvalidArgument))
+			Expect(st.Message()).Should(Equal(base.ErrorCode_ERROR_CODE_VALIDATION.String()))
+			Expect(st.Details()).Should(HaveLen(1))
+			Expect(st.Details()[0].(*errdetails.BadRequest).GetFieldViolations()[0].GetField()).Should(Equal("checks"))
+		})
+	})
+
+	Context("BulkCheck", func() {
+		item := func(id, subject string) *BulkCheckItem {
+			return &BulkCheckItem{
+				ID: id,
+				Request: &base.PermissionCheckRequest{
+					TenantId:   "t1",
+					Metadata:   &base.PermissionCheckRequestMetadata{Depth: 20},
+					Entity:     &base.Entity{Type: "repository", Id: "1"},
+					Permission: "push",
+					Subject:    &base.Subject{Type: "user", Id: subject},
+				},
+			}
+		}
+
+		It("Case 1: Results in the order of the items, the same checks executed once", func() {
+			command := &countingCheck{}
+			service := NewPermissionService(command, nil, nil, nil, nil)
+
+			results, err := service.BulkCheck(context.Background(), []*BulkCheckItem{
+				item("a", "1"),
+				item("b", "2"),
+				item("c", "1"),
+				{ID: "d"},
+			}, 2, 4)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(results).Should(HaveLen(4))
+
+			Expect(results[0].ID).Should(Equal("a"))
+			Expect(results[0].Response.GetCan()).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+			Expect(results[1].Response.GetCan()).Should(Equal(base.PermissionCheckResponse_RESULT_DENIED))
+			Expect(results[2].ID).Should(Equal("c"))
+			Expect(results[2].Response.GetCan()).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+
+			// an invalid item fails alone
+			Expect(results[3].Err).Should(MatchError(base.ErrorCode_ERROR_CODE_VALIDATION.String()))
+
+			Expect(atomic.LoadInt32(&command.executions)).Should(Equal(int32(2)))
+		})
+
+		It("Case 2: Over the limit", func() {
+			service := NewPermissionService(allowAll{}, nil, nil, nil, nil)
+
+			_, err := service.BulkCheck(context.Background(), []*BulkCheckItem{item("a", "1"), item("b", "2")}, 2, 1)
+
+			var limitErr *LimitError
+			Expect(errors.As(err, &limitErr)).Should(BeTrue())
+			Expect(limitErr.Field).Should(Equal("checks"))
+		})
+	})
+
+	Context("WarmUp", func() {
+		It("Case 1: Invalid patterns and failing checks are counted, they do not stop the warmup", func() {
+			service := NewPermissionService(tenantCheck{}, nil, nil, nil, nil)
+
+			result, err := service.WarmUp(context.Background(), []warmup.Pattern{
+				{TenantID: "t1", Check: "repository:1#push@user:1"},
+				{TenantID: "t1", Check: "not a check"},
+				{TenantID: "t2", Check: "repository:1#push@user:1"},
+				{TenantID: "t1", Check: "repository:2#push@user:1"},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).Should(Equal(WarmUpResult{Warmed: 2, Skipped: 1, Failed: 1}))
+		})
+	})
+
+	Context("Response cache", func() {
+		It("Case 1: Expands are cached until the permission is invalidated", func() {
+			c, err := ristretto.New()
+			Expect(err).ShouldNot(HaveOccurred())
+			rk := keys.NewResponseKeys(keys.NewNoopCheckCommandKeys(), c)
+
+			command := &countingExpand{}
+			service := NewPermissionService(allowAll{}, command, nil, nil, nil, PermissionResponseCache(rk, nil, nil, telemetry.NewNoopMeter()))
+
+			request := func(version string) *base.PermissionExpandRequest {
+				return &base.PermissionExpandRequest{
+					TenantId:   "t1",
+					Metadata:   &base.PermissionExpandRequestMetadata{SnapToken: "s1", SchemaVersion: version},
+					Entity:     &base.Entity{Type: "repository", Id: "1"},
+					Permission: "push",
+				}
+			}
+
+			expand := func(version string) {
+				response, err := service.ExpandPermissions(context.Background(), request(version))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(response.GetTree().GetLeaf().GetTarget().GetRelation()).Should(Equal("push"))
+				c.Wait()
+			}
+
+			expand("v1")
+			expand("v1")
+			Expect(atomic.LoadInt32(&command.executions)).Should(Equal(int32(1)))
+
+			// another schema version is another key
+			expand("v2")
+			Expect(atomic.LoadInt32(&command.executions)).Should(Equal(int32(2)))
+
+			// invalidating another permission keeps the response
+			rk.InvalidateCheckKeys("t1", &base.RelationReference{Type: "repository", Relation: "pull"})
+			expand("v1")
+			Expect(atomic.LoadInt32(&command.executions)).Should(Equal(int32(2)))
+
+			rk.InvalidateCheckKeys("t1", &base.RelationReference{Type: "repository", Relation: "push"})
+			expand("v1")
+			Expect(atomic.LoadInt32(&command.executions)).Should(Equal(int32(3)))
+
+			rk.InvalidateCheckKeys("t1")
+			expand("v1")
+			Expect(atomic.LoadInt32(&command.executions)).Should(Equal(int32(4)))
+		})
+	})
+})
@@ -4,31 +4,54 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"time"
 	
 	otelCodes "go.opentelemetry.io/otel/codes"
 	
 	"github.com/adminium/permify/internal/repositories"
 	"github.com/adminium/permify/internal/schema"
 	"github.com/adminium/permify/pkg/database"
+	"github.com/adminium/permify/pkg/filter"
+	"github.com/adminium/permify/pkg/migration"
 	base "github.com/adminium/permify/pkg/pb/base/v1"
 	"github.com/adminium/permify/pkg/token"
 	"github.com/adminium/permify/pkg/tuple"
 )
 
+// _migrationPageSize - Number of tuples read at once while planning a migration
+const _migrationPageSize = 1000
+
 // RelationshipService -
 type RelationshipService struct {
 	sr repositories.SchemaReader
 	rr repositories.RelationshipReader
 	rw repositories.RelationshipWriter
+	// settings of the tenants, nil when every tenant has the default settings
+	tr repositories.TenantSettingsReader
+}
+
+// RelationshipOption - Option of the relationship service
+type RelationshipOption func(*RelationshipService)
+
+// RelationshipTenantSettings - Reads the settings of the tenants that change how their relationships are validated
+func RelationshipTenantSettings(tr repositories.TenantSettingsReader) RelationshipOption {
+	return func(service *RelationshipService) {
+		service.tr = tr
+	}
 }
 
 // NewRelationshipService -
-func NewRelationshipService(rr repositories.RelationshipReader, rw repositories.RelationshipWriter, sr repositories.SchemaReader) *RelationshipService {
-	return &RelationshipService{
+func NewRelationshipService(rr repositories.RelationshipReader, rw repositories.RelationshipWriter, sr repositories.SchemaReader, opts ...RelationshipOption) *RelationshipService {
+	service := &RelationshipService{
 		sr: sr,
 		rr: rr,
 		rw: rw,
 	}
+	for _, opt := range opts {
+		opt(service)
+	}
+	return service
 }
 
 // ReadRelationships -
@@ -48,50 +71,295 @@ func (service *RelationshipService) ReadRelationships(ctx context.Context, tenan
 	return service.rr.ReadRelationships(ctx, tenantID, filter, snap, database.NewPagination(database.Size(size), database.Token(continuousToken)))
 }
 
-// WriteRelationships -
-func (service *RelationshipService) WriteRelationships(ctx context.Context, tenantID string, tuples []*base.Tuple, version string) (token token.EncodedSnapToken, err error) {
-	ctx, span := tracer.Start(ctx, "relationships.write")
+// ReadRelationshipsAt - Reads relationships as they were at the given point in time
+func (service *RelationshipService) ReadRelationshipsAt(ctx context.Context, tenantID string, filter *base.TupleFilter, at time.Time, size uint32, continuousToken string) (tuples *database.TupleCollection, ct database.EncodedContinuousToken, err error) {
+	ctx, span := tracer.Start(ctx, "relationships.read-at")
 	defer span.End()
 	
-	if version == "" {
-		var v string
-		v, err = service.sr.HeadVersion(ctx, tenantID)
+	var st token.SnapToken
+	st, err = service.rr.SnapshotAt(ctx, tenantID, at)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, nil, err
+	}
+	
+	return service.rr.ReadRelationships(ctx, tenantID, filter, st.Encode().String(), database.NewPagination(database.Size(size), database.Token(continuousToken)))
+}
+
+// ReadDeletedRelationships - Lists relationships deleted within [from, to), with the time of deletion in their metadata
+func (service *RelationshipService) ReadDeletedRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, from, to time.Time) (tuples *database.TupleCollection, err error) {
+	ctx, span := tracer.Start(ctx, "relationships.read-deleted")
+	defer span.End()
+	
+	if !from.Before(to) {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+	}
+	
+	return service.rr.ReadDeletedRelationships(ctx, tenantID, filter, from, to)
+}
+
+// ReadRelationshipChanges - Lists relationships created and deleted after the snapshot of the from token, up to the
+// snapshot of the to token (head when empty). The to token is returned, passing it as from on the next call gives incremental updates.
+func (service *RelationshipService) ReadRelationshipChanges(ctx context.Context, tenantID string, filter *base.TupleFilter, from, to string) (created, deleted *database.TupleCollection, snap string, err error) {
+	ctx, span := tracer.Start(ctx, "relationships.read-changes")
+	defer span.End()
+	
+	if from == "" {
+		return nil, nil, "", errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+	}
+	
+	if to == "" {
+		var st token.SnapToken
+		st, err = service.rr.HeadSnapshot(ctx, tenantID)
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(otelCodes.Error, err.Error())
-			return token, err
+			return nil, nil, "", err
 		}
-		version = v
+		to = st.Encode().String()
 	}
 	
-	relationships := make([]*base.Tuple, 0, len(tuples))
+	created, deleted, err = service.rr.ReadRelationshipChanges(ctx, tenantID, filter, from, to)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, nil, "", err
+	}
+	return created, deleted, to, nil
+}
+
+// FilterRelationships - Reads relation tuples matching a filter expression such as
+// "relation in (owner, editor) and subject_type = user" in a single call
+func (service *RelationshipService) FilterRelationships(ctx context.Context, tenantID, expression, snap string) (tuples *database.TupleCollection, err error) {
+	ctx, span := tracer.Start(ctx, "relationships.filter")
+	defer span.End()
 	
-	for _, tup := range tuples {
-		subject := tup.GetSubject()
-		if !tuple.IsSubjectUser(subject) && subject.GetRelation() == "" {
-			subject.Relation = tuple.ELLIPSIS
+	var exp filter.Expression
+	exp, err = filter.Parse(expression)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+	}
+	
+	if snap == "" {
+		var st token.SnapToken
+		st, err = service.rr.HeadSnapshot(ctx, tenantID)
+		if err != nil {
+			return nil, err
 		}
-		
-		var entity *base.EntityDefinition
-		entity, _, err = service.sr.ReadSchemaDefinition(ctx, tenantID, tup.GetEntity().GetType(), version)
+		snap = st.Encode().String()
+	}
+	
+	tuples = database.NewTupleCollection()
+	seen := map[string]struct{}{}
+	
+	for _, f := range filter.TupleFilters(exp) {
+		var it *database.TupleIterator
+		it, err = service.rr.QueryRelationships(ctx, tenantID, f, snap)
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(otelCodes.Error, err.Error())
-			return token, err
+			return nil, err
 		}
-		
-		if tuple.IsEntityAndSubjectEquals(tup) {
-			return token, errors.New(base.ErrorCode_ERROR_CODE_ENTITY_AND_SUBJECT_CANNOT_BE_EQUAL.String())
+		for it.HasNext() {
+			t := it.GetNext()
+			if !exp.Matches(t) {
+				continue
+			}
+			key := tuple.ToString(t)
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			tuples.Add(t)
 		}
-		
-		var rel *base.RelationDefinition
-		var vt []string
-		rel, err = schema.GetRelationByNameInEntityDefinition(entity, tup.GetRelation())
+	}
+	
+	return tuples, nil
+}
+
+// WriteRelationships -
+func (service *RelationshipService) WriteRelationships(ctx context.Context, tenantID string, tuples []*base.Tuple, version string) (token token.EncodedSnapToken, err error) {
+	ctx, span := tracer.Start(ctx, "relationships.write")
+	defer span.End()
+	
+	var relationships *database.TupleCollection
+	ctx, relationships, err = service.validateRelationships(ctx, tenantID, tuples, version)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return token, err
+	}
+	
+	return service.rw.WriteRelationships(ctx, tenantID, relationships)
+}
+
+// BatchWriteRelationships - Validates every tuple before any is written, then writes them atomically with a single
+// snap token. It is meant for imports of thousands of tuples, which are over the limit of a write.
+func (service *RelationshipService) BatchWriteRelationships(ctx context.Context, tenantID string, tuples []*base.Tuple, version string) (token token.EncodedSnapToken, err error) {
+	ctx, span := tracer.Start(ctx, "relationships.batch-write")
+	defer span.End()
+	
+	var relationships *database.TupleCollection
+	ctx, relationships, err = service.validateRelationships(ctx, tenantID, tuples, version)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return token, err
+	}
+	
+	return service.rw.BatchWriteRelationships(ctx, tenantID, relationships)
+}
+
+// BatchWriteRelationshipsPartially - Writes the tuples that are valid in a single transaction and reports the others
+// by their index in the batch, so that one malformed tuple does not fail a whole sync. The snap token is empty when
+// no tuple is valid, nothing is written then.
+func (service *RelationshipService) BatchWriteRelationshipsPartially(ctx context.Context, tenantID string, tuples []*base.Tuple, version string) (snap token.EncodedSnapToken, rejected []RejectedTuple, err error) {
+	ctx, span := tracer.Start(ctx, "relationships.batch-write-partially")
+	defer span.End()
+	
+	var validation relationshipValidation
+	ctx, validation, err = service.readValidation(ctx, tenantID, tuples, version)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, nil, err
+	}
+	
+	relationships := make([]*base.Tuple, 0, len(tuples))
+	for i, tup := range tuples {
+		relationship, err := validation.validate(tup)
 		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(otelCodes.Error, err.Error())
-			return token, err
+			rejected = append(rejected, RejectedTuple{Index: i, Tuple: tup, Reason: err.Error()})
+			continue
+		}
+		relationships = append(relationships, relationship)
+	}
+	if len(relationships) == 0 {
+		return nil, rejected, nil
+	}
+	
+	snap, err = service.rw.BatchWriteRelationships(ctx, tenantID, database.NewTupleCollection(relationships...))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, nil, err
+	}
+	return snap, rejected, nil
+}
+
+// RejectedTuple - Tuple of a partial batch write that was not written, the index is its position in the batch and the
+// reason is the error its write would fail with
+type RejectedTuple struct {
+	Index  int
+	Tuple  *base.Tuple
+	Reason string
+}
+
+// validateRelationships - Validates the tuples against the schema version, the head one when it is empty. The
+// returned context carries the version as the metadata of the tuples, so the tuples record the version they were
+// validated against.
+func (service *RelationshipService) validateRelationships(ctx context.Context, tenantID string, tuples []*base.Tuple, version string) (context.Context, *database.TupleCollection, error) {
+	ctx, validation, err := service.readValidation(ctx, tenantID, tuples, version)
+	if err != nil {
+		return ctx, nil, err
+	}
+	
+	relationships := make([]*base.Tuple, 0, len(tuples))
+	for _, tup := range tuples {
+		var relationship *base.Tuple
+		relationship, err = validation.validate(tup)
+		if err != nil {
+			return ctx, nil, err
 		}
+		relationships = append(relationships, relationship)
+	}
+	
+	return ctx, database.NewTupleCollection(relationships...), nil
+}
+
+// readValidation - Reads what the tuples are validated with: the entity definitions of their types in the
+// schema version, the head one when it is empty, and whether the tenant validates the subject types. The returned
+// context carries the version as the metadata of the tuples.
+func (service *RelationshipService) readValidation(ctx context.Context, tenantID string, tuples []*base.Tuple, version string) (context.Context, relationshipValidation, error) {
+	var err error
+	if version == "" {
+		version, err = service.sr.HeadVersion(ctx, tenantID)
+		if err != nil {
+			return ctx, relationshipValidation{}, err
+		}
+	}
+	
+	// tenants can turn off the validation of subject types while they migrate their tuples
+	validation := relationshipValidation{strict: true, definitions: map[string]*base.EntityDefinition{}}
+	if service.tr != nil {
+		var settings repositories.TenantSettings
+		settings, err = service.tr.ReadTenantSettings(ctx, tenantID)
+		if err != nil {
+			return ctx, relationshipValidation{}, err
+		}
+		validation.strict = settings.StrictValidation
+	}
+	
+	// entity definitions by type, batches repeat the same few types; the types missing from the version fail
+	// their tuples only
+	for _, tup := range tuples {
+		if _, ok := validation.definitions[tup.GetEntity().GetType()]; ok {
+			continue
+		}
+		var entity *base.EntityDefinition
+		entity, _, err = service.sr.ReadSchemaDefinition(ctx, tenantID, tup.GetEntity().GetType(), version)
+		if err != nil && err.Error() != base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String() {
+			return ctx, relationshipValidation{}, err
+		}
+		validation.definitions[tup.GetEntity().GetType()] = entity
+	}
+	
+	// the tuples record the version they were validated against
+	metadata := database.TupleMetadataFromContext(ctx)
+	metadata.SchemaVersion = version
+	ctx = database.ContextWithTupleMetadata(ctx, metadata)
+	
+	return ctx, validation, nil
+}
+
+// relationshipValidation - Entity definitions of the types of the tuples of a write, nil for the types that are not
+// in the schema version, and whether the subject types are validated
+type relationshipValidation struct {
+	definitions map[string]*base.EntityDefinition
+	strict      bool
+}
+
+// validate - Validates the tuple and returns it as it is written
+func (v relationshipValidation) validate(tup *base.Tuple) (*base.Tuple, error) {
+	subject := tup.GetSubject()
+	if !tuple.IsSubjectUser(subject) && subject.GetRelation() == "" {
+		subject.Relation = tuple.ELLIPSIS
+	}
+	
+	entity := v.definitions[tup.GetEntity().GetType()]
+	if entity == nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String())
+	}
+	
+	if tuple.IsEntityAndSubjectEquals(tup) {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_ENTITY_AND_SUBJECT_CANNOT_BE_EQUAL.String())
+	}
+	
+	err := tuple.ValidateValidity(tup)
+	if err != nil {
+		return nil, err
+	}
+	
+	rel, err := schema.GetRelationByNameInEntityDefinition(entity, tup.GetRelation())
+	if err != nil {
+		return nil, err
+	}
+	
+	if v.strict {
+		var vt []string
 		for _, t := range rel.GetRelationReferences() {
 			if t.GetRelation() != "" {
 				vt = append(vt, fmt.Sprintf("%s#%s", t.GetType(), t.GetRelation()))
@@ -101,20 +369,226 @@ func (service *RelationshipService) WriteRelationships(ctx context.Context, tena
 		}
 		
 		err = tuple.ValidateSubjectType(subject, vt)
+		if err != nil {
+			return nil, err
+		}
+	}
+	
+	return &base.Tuple{
+		Entity:    tup.GetEntity(),
+		Relation:  tup.GetRelation(),
+		Subject:   subject,
+		Payload:   tup.GetPayload(),
+		NotBefore: tup.GetNotBefore(),
+		NotAfter:  tup.GetNotAfter(),
+	}, nil
+}
+
+// InvalidTuple - Tuple that does not conform to the schema version, the reason is the error its write would fail with
+type InvalidTuple struct {
+	Tuple  *base.Tuple
+	Reason string
+}
+
+// ValidateData - Reads a page of the tuples of the tenant and reports the ones whose entity type, relation or subject
+// type is not in the schema version, the head one when empty. The call is a dry run unless deleteInvalid is set, then
+// the reported tuples are deleted. Every page is read at the head snapshot, so the pages stay valid between deletes.
+func (service *RelationshipService) ValidateData(ctx context.Context, tenantID, version string, size uint32, continuousToken string, deleteInvalid bool) (invalid []InvalidTuple, ct database.EncodedContinuousToken, err error) {
+	ctx, span := tracer.Start(ctx, "relationships.validate-data")
+	defer span.End()
+	
+	if version == "" {
+		version, err = service.sr.HeadVersion(ctx, tenantID)
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(otelCodes.Error, err.Error())
-			return token, err
+			return nil, nil, err
 		}
-		
-		relationships = append(relationships, &base.Tuple{
-			Entity:   tup.GetEntity(),
-			Relation: tup.GetRelation(),
-			Subject:  subject,
+	}
+	
+	var sch *base.SchemaDefinition
+	sch, err = service.sr.ReadSchema(ctx, tenantID, version)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, nil, err
+	}
+	
+	var st token.SnapToken
+	st, err = service.rr.HeadSnapshot(ctx, tenantID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, nil, err
+	}
+	
+	var tuples *database.TupleCollection
+	tuples, ct, err = service.rr.ReadRelationships(ctx, tenantID, &base.TupleFilter{}, st.Encode().String(), database.NewPagination(database.Size(size), database.Token(continuousToken)))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, nil, err
+	}
+	
+	for _, t := range tuples.GetTuples() {
+		if reason := conformity(sch, t); reason != "" {
+			invalid = append(invalid, InvalidTuple{Tuple: t, Reason: reason})
+		}
+	}
+	
+	if !deleteInvalid {
+		return invalid, ct, nil
+	}
+	
+	for _, i := range invalid {
+		_, err = service.rw.DeleteRelationships(ctx, tenantID, &base.TupleFilter{
+			Entity: &base.EntityFilter{
+				Type: i.Tuple.GetEntity().GetType(),
+				Ids:  []string{i.Tuple.GetEntity().GetId()},
+			},
+			Relation: i.Tuple.GetRelation(),
+			Subject: &base.SubjectFilter{
+				Type:     i.Tuple.GetSubject().GetType(),
+				Ids:      []string{i.Tuple.GetSubject().GetId()},
+				Relation: i.Tuple.GetSubject().GetRelation(),
+			},
 		})
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, nil, err
+		}
+	}
+	
+	return invalid, ct, nil
+}
+
+// conformity - The error code that the write of the tuple would fail with against the schema, empty when it conforms
+func conformity(sch *base.SchemaDefinition, t *base.Tuple) string {
+	entity, err := schema.GetEntityByName(sch, t.GetEntity().GetType())
+	if err != nil {
+		return err.Error()
+	}
+	
+	var rel *base.RelationDefinition
+	rel, err = schema.GetRelationByNameInEntityDefinition(entity, t.GetRelation())
+	if err != nil {
+		return err.Error()
+	}
+	
+	vt := make([]string, 0, len(rel.GetRelationReferences()))
+	for _, r := range rel.GetRelationReferences() {
+		if r.GetRelation() != "" {
+			vt = append(vt, fmt.Sprintf("%s#%s", r.GetType(), r.GetRelation()))
+		} else {
+			vt = append(vt, r.GetType())
+		}
+	}
+	if err = tuple.ValidateSubjectType(t.GetSubject(), vt); err != nil {
+		return err.Error()
+	}
+	return ""
+}
+
+// ExportRelationships - Calls f with every tuple matching the filter. All pages are read at the same snapshot, the head
+// one when snap is empty, so the export is consistent however long it takes; the snap token it was read at is returned.
+func (service *RelationshipService) ExportRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, f func(*base.Tuple) error) (string, error) {
+	ctx, span := tracer.Start(ctx, "relationships.export")
+	defer span.End()
+	
+	if snap == "" {
+		st, err := service.rr.HeadSnapshot(ctx, tenantID)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return "", err
+		}
+		snap = st.Encode().String()
 	}
 	
-	return service.rw.WriteRelationships(ctx, tenantID, database.NewTupleCollection(relationships...))
+	ct := ""
+	for {
+		tuples, next, err := service.rr.ReadRelationships(ctx, tenantID, filter, snap, database.NewPagination(database.Size(_migrationPageSize), database.Token(ct)))
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return "", err
+		}
+		for _, t := range tuples.GetTuples() {
+			if err = f(t); err != nil {
+				return "", err
+			}
+		}
+		ct = next.String()
+		if ct == "" {
+			return snap, nil
+		}
+	}
+}
+
+// ImportRelationships - Writes the tuples returned by next until it returns io.EOF, in batches that are validated
+// against the schema version, the head one when empty, and written atomically each. A failing batch stops the import
+// and the batches before it stay written, the number of written tuples and the snap token of the last batch are
+// returned either way.
+func (service *RelationshipService) ImportRelationships(ctx context.Context, tenantID, version string, next func() (*base.Tuple, error), batchSize int) (imported int, snap token.EncodedSnapToken, err error) {
+	ctx, span := tracer.Start(ctx, "relationships.import")
+	defer span.End()
+	
+	if batchSize <= 0 {
+		return 0, nil, errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+	}
+	
+	// every batch is validated against the same version even if the head changes during the import
+	if version == "" {
+		version, err = service.sr.HeadVersion(ctx, tenantID)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return 0, nil, err
+		}
+	}
+	
+	batch := make([]*base.Tuple, 0, batchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		s, err := service.BatchWriteRelationships(ctx, tenantID, batch, version)
+		if err != nil {
+			return err
+		}
+		imported += len(batch)
+		snap = s
+		batch = batch[:0]
+		return nil
+	}
+	
+	for {
+		var t *base.Tuple
+		t, err = next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err == nil {
+			batch = append(batch, t)
+			if len(batch) < batchSize {
+				continue
+			}
+			err = flush()
+		}
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return imported, snap, err
+		}
+	}
+	
+	if err = flush(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return imported, snap, err
+	}
+	return imported, snap, nil
 }
 
 // DeleteRelationships -
@@ -124,3 +598,107 @@ func (service *RelationshipService) DeleteRelationships(ctx context.Context, ten
 	
 	return service.rw.DeleteRelationships(ctx, tenantID, filter)
 }
+
+// PlanMigration - Generates the tuple rewrites described by the migration spec
+func (service *RelationshipService) PlanMigration(ctx context.Context, tenantID string, spec migration.Spec, snap string) (plan *migration.Plan, err error) {
+	ctx, span := tracer.Start(ctx, "relationships.plan-migration")
+	defer span.End()
+	
+	if err = spec.Validate(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+	}
+	
+	if snap == "" {
+		var st token.SnapToken
+		st, err = service.rr.HeadSnapshot(ctx, tenantID)
+		if err != nil {
+			return nil, err
+		}
+		snap = st.Encode().String()
+	}
+	
+	plan = &migration.Plan{}
+	for _, rule := range spec.Rules {
+		// the tuples are read in pages with their metadata, rules can target the schema versions they were written under
+		ct := ""
+		for {
+			var tuples *database.TupleCollection
+			var next database.EncodedContinuousToken
+			tuples, next, err = service.rr.ReadRelationships(ctx, tenantID, rule.Filter(), snap, database.NewPagination(database.Size(_migrationPageSize), database.Token(ct)))
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(otelCodes.Error, err.Error())
+				return nil, err
+			}
+			for i, metadata := range tuples.GetMetadata() {
+				if rule.AppliesTo(metadata.GetSchemaVersion()) {
+					plan.Add(rule.Rewrite(tuples.GetTuples()[i]))
+				}
+			}
+			ct = next.String()
+			if ct == "" {
+				break
+			}
+		}
+	}
+	
+	return plan, nil
+}
+
+// ApplyMigration - Applies the plan in batches. New tuples are validated against the head schema,
+// so the schema change must be written before the migration is applied.
+func (service *RelationshipService) ApplyMigration(ctx context.Context, tenantID string, plan *migration.Plan, batchSize int, progress migration.ProgressFunc) (snap token.EncodedSnapToken, err error) {
+	ctx, span := tracer.Start(ctx, "relationships.apply-migration")
+	defer span.End()
+	
+	if batchSize <= 0 {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+	}
+	
+	applied := 0
+	for _, batch := range plan.Batches(batchSize) {
+		var tuples []*base.Tuple
+		for _, rewrite := range batch {
+			tuples = append(tuples, rewrite.New...)
+		}
+		
+		snap, err = service.WriteRelationships(ctx, tenantID, tuples, "")
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, err
+		}
+		
+		for _, rewrite := range batch {
+			if !rewrite.Delete {
+				continue
+			}
+			snap, err = service.rw.DeleteRelationships(ctx, tenantID, &base.TupleFilter{
+				Entity: &base.EntityFilter{
+					Type: rewrite.Old.GetEntity().GetType(),
+					Ids:  []string{rewrite.Old.GetEntity().GetId()},
+				},
+				Relation: rewrite.Old.GetRelation(),
+				Subject: &base.SubjectFilter{
+					Type:     rewrite.Old.GetSubject().GetType(),
+					Ids:      []string{rewrite.Old.GetSubject().GetId()},
+					Relation: rewrite.Old.GetSubject().GetRelation(),
+				},
+			})
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(otelCodes.Error, err.Error())
+				return nil, err
+			}
+		}
+		
+		applied += len(batch)
+		if progress != nil {
+			progress(applied, plan.Len())
+		}
+	}
+	
+	return snap, nil
+}
@@ -1 +1,283 @@
 package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	
+	"github.com/adminium/permify/internal/repositories/memory"
+	"github.com/adminium/permify/internal/repositories/memory/migrations"
+	"github.com/adminium/permify/pkg/database"
+	db "github.com/adminium/permify/pkg/database/memory"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+var _ = Describe("relationship-service", func() {
+	Context("ValidateData", func() {
+		var service *RelationshipService
+		
+		BeforeEach(func() {
+			mem, err := db.New(migrations.Schema)
+			Expect(err).ShouldNot(HaveOccurred())
+			l := logger.New("error")
+			
+			schemas := NewSchemaService(memory.NewSchemaWriter(mem, l), memory.NewSchemaReader(mem, l))
+			_, err = schemas.WriteSchema(context.Background(), "t1", "entity user {}\n\nentity team {\n\trelation member @user\n}\n\nentity doc {\n\trelation owner @user @team#member\n\trelation viewer @user\n}\n")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			writer := memory.NewRelationshipWriter(mem, l)
+			collection := database.NewTupleCollection()
+			for _, t := range []string{"doc:1#owner@user:1", "doc:1#owner@team:1#member", "doc:1#viewer@user:2", "team:1#member@user:3"} {
+				tup, err := tuple.Tuple(t)
+				Expect(err).ShouldNot(HaveOccurred())
+				collection.Add(tup)
+			}
+			_, err = writer.WriteRelationships(context.Background(), "t1", collection)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			_, err = schemas.WriteSchema(context.Background(), "t1", "entity user {}\n\nentity doc {\n\trelation owner @user\n}\n")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			service = NewRelationshipService(memory.NewRelationshipReader(mem, l), writer, memory.NewSchemaReader(mem, l))
+		})
+		
+		It("Case 1: Dry runs report the tuples that do not conform to the head schema", func() {
+			var reasons []string
+			ct := ""
+			for {
+				invalid, next, err := service.ValidateData(context.Background(), "t1", "", 2, ct, false)
+				Expect(err).ShouldNot(HaveOccurred())
+				for _, i := range invalid {
+					reasons = append(reasons, tuple.ToString(i.Tuple)+" "+i.Reason)
+				}
+				ct = next.String()
+				if ct == "" {
+					break
+				}
+			}
+			Expect(reasons).Should(ConsistOf(
+				"doc:1#owner@team:1#member "+base.ErrorCode_ERROR_CODE_SUBJECT_TYPE_NOT_FOUND.String(),
+				"doc:1#viewer@user:2 "+base.ErrorCode_ERROR_CODE_RELATION_DEFINITION_NOT_FOUND.String(),
+				"team:1#member@user:3 "+base.ErrorCode_ERROR_CODE_ENTITY_DEFINITION_NOT_FOUND.String(),
+			))
+			
+			tuples, _, err := service.ReadRelationships(context.Background(), "t1", &base.TupleFilter{}, "", 100, "")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(tuples.GetTuples()).Should(HaveLen(4))
+		})
+		
+		It("Case 2: Invalid tuples are deleted on request", func() {
+			invalid, ct, err := service.ValidateData(context.Background(), "t1", "", 100, "", true)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(invalid).Should(HaveLen(3))
+			Expect(ct.String()).Should(BeEmpty())
+			
+			tuples, _, err := service.ReadRelationships(context.Background(), "t1", &base.TupleFilter{}, "", 100, "")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(tuples.GetTuples()).Should(HaveLen(1))
+			Expect(tuple.ToString(tuples.GetTuples()[0])).Should(Equal("doc:1#owner@user:1"))
+		})
+	})
+	
+	Context("WriteRelationships", func() {
+		var service *RelationshipService
+		
+		BeforeEach(func() {
+			mem, err := db.New(migrations.Schema)
+			Expect(err).ShouldNot(HaveOccurred())
+			l := logger.New("error")
+			
+			schemas := NewSchemaService(memory.NewSchemaWriter(mem, l), memory.NewSchemaReader(mem, l))
+			_, err = schemas.WriteSchema(context.Background(), "t1", "entity user {}\n\nentity doc {\n\trelation owner @user\n}\n")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			service = NewRelationshipService(memory.NewRelationshipReader(mem, l), memory.NewRelationshipWriter(mem, l), memory.NewSchemaReader(mem, l))
+		})
+		
+		It("Case 1: The payloads of the tuples are written with them", func() {
+			tup, err := tuple.Tuple("doc:1#owner@user:1")
+			Expect(err).ShouldNot(HaveOccurred())
+			tup.Payload, err = structpb.NewStruct(map[string]interface{}{"ip": "10.0.0.1"})
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			_, err = service.WriteRelationships(context.Background(), "t1", []*base.Tuple{tup}, "")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			written, _, err := service.ReadRelationships(context.Background(), "t1", &base.TupleFilter{}, "", 100, "")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(written.GetTuples()).Should(HaveLen(1))
+			Expect(written.GetTuples()[0].GetPayload().AsMap()).Should(Equal(map[string]interface{}{"ip": "10.0.0.1"}))
+		})
+		
+		It("Case 2: The validity windows of the tuples are written with them", func() {
+			notBefore, notAfter := time.Now().Add(-time.Hour).Truncate(time.Second), time.Now().Add(time.Hour).Truncate(time.Second)
+			
+			tup, err := tuple.Tuple("doc:1#owner@user:1")
+			Expect(err).ShouldNot(HaveOccurred())
+			tup.NotBefore, tup.NotAfter = timestamppb.New(notBefore), timestamppb.New(notAfter)
+			
+			_, err = service.WriteRelationships(context.Background(), "t1", []*base.Tuple{tup}, "")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			written, _, err := service.ReadRelationships(context.Background(), "t1", &base.TupleFilter{}, "", 100, "")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(written.GetTuples()).Should(HaveLen(1))
+			Expect(written.GetTuples()[0].GetNotBefore().AsTime()).Should(BeTemporally("==", notBefore))
+			Expect(written.GetTuples()[0].GetNotAfter().AsTime()).Should(BeTemporally("==", notAfter))
+		})
+		
+		It("Case 3: Tuples whose validity window is empty are rejected", func() {
+			at := time.Now()
+			
+			tup, err := tuple.Tuple("doc:1#owner@user:1")
+			Expect(err).ShouldNot(HaveOccurred())
+			tup.NotBefore, tup.NotAfter = timestamppb.New(at), timestamppb.New(at)
+			
+			_, err = service.WriteRelationships(context.Background(), "t1", []*base.Tuple{tup}, "")
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_VALIDATION.String()))
+			
+			written, _, err := service.ReadRelationships(context.Background(), "t1", &base.TupleFilter{}, "", 100, "")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(written.GetTuples()).Should(BeEmpty())
+		})
+	})
+	
+	Context("BatchWriteRelationshipsPartially", func() {
+		var service *RelationshipService
+		
+		BeforeEach(func() {
+			mem, err := db.New(migrations.Schema)
+			Expect(err).ShouldNot(HaveOccurred())
+			l := logger.New("error")
+			
+			schemas := NewSchemaService(memory.NewSchemaWriter(mem, l), memory.NewSchemaReader(mem, l))
+			_, err = schemas.WriteSchema(context.Background(), "t1", "entity user {}\n\nentity doc {\n\trelation owner @user\n}\n")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			service = NewRelationshipService(memory.NewRelationshipReader(mem, l), memory.NewRelationshipWriter(mem, l), memory.NewSchemaReader(mem, l))
+		})
+		
+		It("Case 1: Valid tuples are written and the invalid ones are reported by their index", func() {
+			var tuples []*base.Tuple
+			for _, t := range []string{"doc:1#owner@user:1", "doc:1#viewer@user:2", "team:1#member@user:3", "doc:2#owner@user:4"} {
+				tup, err := tuple.Tuple(t)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, tup)
+			}
+			
+			snap, rejected, err := service.BatchWriteRelationshipsPartially(context.Background(), "t1", tuples, "")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(snap).ShouldNot(BeNil())
+			
+			var reasons []string
+			for _, r := range rejected {
+				reasons = append(reasons, fmt.Sprintf("%d %s", r.Index, r.Reason))
+			}
+			Expect(reasons).Should(Equal([]string{
+				"1 " + base.ErrorCode_ERROR_CODE_RELATION_DEFINITION_NOT_FOUND.String(),
+				"2 " + base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String(),
+			}))
+			
+			written, _, err := service.ReadRelationships(context.Background(), "t1", &base.TupleFilter{}, "", 100, "")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(written.GetTuples()).Should(HaveLen(2))
+		})
+		
+		It("Case 2: Nothing is written when no tuple is valid", func() {
+			tup, err := tuple.Tuple("doc:1#viewer@user:2")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			snap, rejected, err := service.BatchWriteRelationshipsPartially(context.Background(), "t1", []*base.Tuple{tup}, "")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(snap).Should(BeNil())
+			Expect(rejected).Should(HaveLen(1))
+			Expect(rejected[0].Index).Should(Equal(0))
+		})
+	})
+	
+	Context("Transfer", func() {
+		var source, target *RelationshipService
+		
+		BeforeEach(func() {
+			l := logger.New("error")
+			var services []*RelationshipService
+			for range []int{0, 1} {
+				mem, err := db.New(migrations.Schema)
+				Expect(err).ShouldNot(HaveOccurred())
+				_, err = NewSchemaService(memory.NewSchemaWriter(mem, l), memory.NewSchemaReader(mem, l)).WriteSchema(context.Background(), "t1", "entity user {}\n\nentity doc {\n\trelation owner @user\n}\n")
+				Expect(err).ShouldNot(HaveOccurred())
+				services = append(services, NewRelationshipService(memory.NewRelationshipReader(mem, l), memory.NewRelationshipWriter(mem, l), memory.NewSchemaReader(mem, l)))
+			}
+			source, target = services[0], services[1]
+			
+			var tuples []*base.Tuple
+			for i := 0; i < 25; i++ {
+				tup, err := tuple.Tuple(fmt.Sprintf("doc:%d#owner@user:1", i))
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, tup)
+			}
+			_, err := source.WriteRelationships(context.Background(), "t1", tuples, "")
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+		
+		It("Case 1: Exports are read at one snapshot and imported in batches", func() {
+			var exported []*base.Tuple
+			snap, err := source.ExportRelationships(context.Background(), "t1", &base.TupleFilter{}, "", func(t *base.Tuple) error {
+				exported = append(exported, t)
+				// writes during the export are not part of it
+				if len(exported) == 1 {
+					tup, err := tuple.Tuple("doc:100#owner@user:1")
+					Expect(err).ShouldNot(HaveOccurred())
+					_, err = source.WriteRelationships(context.Background(), "t1", []*base.Tuple{tup}, "")
+					Expect(err).ShouldNot(HaveOccurred())
+				}
+				return nil
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(snap).ShouldNot(BeEmpty())
+			Expect(exported).Should(HaveLen(25))
+			
+			i := 0
+			imported, _, err := target.ImportRelationships(context.Background(), "t1", "", func() (*base.Tuple, error) {
+				if i == len(exported) {
+					return nil, io.EOF
+				}
+				i++
+				return exported[i-1], nil
+			}, 10)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(imported).Should(Equal(25))
+			
+			tuples, _, err := target.ReadRelationships(context.Background(), "t1", &base.TupleFilter{}, "", 100, "")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(tuples.GetTuples()).Should(HaveLen(25))
+		})
+		
+		It("Case 2: A failing batch stops the import", func() {
+			valid, err := tuple.Tuple("doc:1#owner@user:1")
+			Expect(err).ShouldNot(HaveOccurred())
+			invalid, err := tuple.Tuple("doc:2#viewer@user:1")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			queue := []*base.Tuple{valid, valid, invalid, valid}
+			imported, _, err := target.ImportRelationships(context.Background(), "t1", "", func() (*base.Tuple, error) {
+				if len(queue) == 0 {
+					return nil, io.EOF
+				}
+				t := queue[0]
+				queue = queue[1:]
+				return t, nil
+			}, 2)
+			Expect(err).Should(HaveOccurred())
+			Expect(imported).Should(Equal(2))
+		})
+	})
+})
@@ -0,0 +1,36 @@
+package services
+
+import (
+	"context"
+	
+	otelCodes "go.opentelemetry.io/otel/codes"
+	
+	db "github.com/adminium/permify/pkg/database/raft"
+)
+
+// ReplicationService - Applies the writes the followers of a raft cluster forward to the leader
+type ReplicationService struct {
+	raft *db.Raft
+}
+
+// NewReplicationService -
+func NewReplicationService(raft *db.Raft) *ReplicationService {
+	return &ReplicationService{
+		raft: raft,
+	}
+}
+
+// Apply - Applies the command on the leader, the response has the index of the command in the log so the follower
+// can wait until it applied the command itself
+func (s *ReplicationService) Apply(ctx context.Context, command []byte) ([]byte, error) {
+	ctx, span := tracer.Start(ctx, "replication.apply")
+	defer span.End()
+	
+	index, data, err := s.raft.ApplyOnLeader(ctx, command)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, err
+	}
+	return db.EncodeResponse(index, data), nil
+}
@@ -0,0 +1,119 @@
+package services
+
+import (
+	"context"
+	
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/instrument"
+	"google.golang.org/protobuf/proto"
+	
+	"github.com/adminium/permify/internal/commands"
+	"github.com/adminium/permify/internal/keys"
+	"github.com/adminium/permify/internal/repositories"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// PermissionOption - Option of the permission service
+type PermissionOption func(*PermissionService)
+
+// PermissionResponseCache - Caches the responses of the expands and the schema lookups. The requests without a
+// snapshot or a schema version are cached at the head ones, which are read by the readers as the commands would.
+func PermissionResponseCache(rk *keys.ResponseKeys, sr repositories.SchemaReader, rr repositories.RelationshipReader, m metric.Meter) PermissionOption {
+	return func(service *PermissionService) {
+		service.responses = &responseCache{keys: rk, sr: sr, rr: rr}
+		if counter, err := m.Int64Counter("response_cache_hit_count", instrument.WithDescription("response cache hit count")); err == nil {
+			service.responses.hitCounter = counter
+		}
+		if counter, err := m.Int64Counter("response_cache_miss_count", instrument.WithDescription("response cache miss count")); err == nil {
+			service.responses.missCounter = counter
+		}
+	}
+}
+
+// responseCache -
+type responseCache struct {
+	keys *keys.ResponseKeys
+	// repositories
+	sr repositories.SchemaReader
+	rr repositories.RelationshipReader
+	// metrics
+	hitCounter  instrument.Int64Counter
+	missCounter instrument.Int64Counter
+}
+
+// expandResult - Cached expand, the truncated leaves are part of it
+type expandResult struct {
+	response  *base.PermissionExpandResponse
+	truncated []commands.ExpandTruncation
+}
+
+// expand - Cached response of the expand, the expansion depends on the relations and actions of the permission
+func (c *responseCache) expand(ctx context.Context, request *base.PermissionExpandRequest, execute func() (*base.PermissionExpandResponse, []commands.ExpandTruncation, error)) (*base.PermissionExpandResponse, []commands.ExpandTruncation, error) {
+	if request.Metadata == nil {
+		request.Metadata = &base.PermissionExpandRequestMetadata{}
+	}
+	if request.GetMetadata().GetSnapToken() == "" {
+		st, err := c.rr.HeadSnapshot(ctx, request.GetTenantId())
+		if err != nil {
+			return nil, nil, err
+		}
+		request.Metadata.SnapToken = st.Encode().String()
+	}
+	if request.GetMetadata().GetSchemaVersion() == "" {
+		version, err := c.sr.HeadVersion(ctx, request.GetTenantId())
+		if err != nil {
+			return nil, nil, err
+		}
+		request.Metadata.SchemaVersion = version
+	}
+	
+	reference := &base.RelationReference{Type: request.GetEntity().GetType(), Relation: request.GetPermission()}
+	if cached, ok := c.keys.GetResponse(request.GetTenantId(), reference, request); ok {
+		c.count(ctx, c.hitCounter, "expand")
+		result := cached.(expandResult)
+		return result.response, result.truncated, nil
+	}
+	c.count(ctx, c.missCounter, "expand")
+	
+	response, truncated, err := execute()
+	if err != nil {
+		return response, truncated, err
+	}
+	c.keys.SetResponse(request.GetTenantId(), reference, request, expandResult{response: response, truncated: truncated}, int64(proto.Size(response)))
+	return response, truncated, nil
+}
+
+// lookupSchema - Cached response of the schema lookup, it reads the schema only
+func (c *responseCache) lookupSchema(ctx context.Context, request *base.PermissionLookupSchemaRequest, execute func() (*base.PermissionLookupSchemaResponse, error)) (*base.PermissionLookupSchemaResponse, error) {
+	if request.Metadata == nil {
+		request.Metadata = &base.PermissionLookupSchemaRequestMetadata{}
+	}
+	if request.GetMetadata().GetSchemaVersion() == "" {
+		version, err := c.sr.HeadVersion(ctx, request.GetTenantId())
+		if err != nil {
+			return nil, err
+		}
+		request.Metadata.SchemaVersion = version
+	}
+	
+	if cached, ok := c.keys.GetResponse(request.GetTenantId(), nil, request); ok {
+		c.count(ctx, c.hitCounter, "lookup_schema")
+		return cached.(*base.PermissionLookupSchemaResponse), nil
+	}
+	c.count(ctx, c.missCounter, "lookup_schema")
+	
+	response, err := execute()
+	if err != nil {
+		return response, err
+	}
+	c.keys.SetResponse(request.GetTenantId(), nil, request, response, int64(proto.Size(response)))
+	return response, nil
+}
+
+// count -
+func (c *responseCache) count(ctx context.Context, counter instrument.Int64Counter, method string) {
+	if counter != nil {
+		counter.Add(ctx, 1, attribute.String("method", method))
+	}
+}
@@ -2,31 +2,127 @@ package services
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"sync"
 	
 	"github.com/rs/xid"
 	
 	otelCodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/instrument"
 	
 	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/internal/schema"
+	"github.com/adminium/permify/pkg/database"
 	"github.com/adminium/permify/pkg/dsl/ast"
 	"github.com/adminium/permify/pkg/dsl/compiler"
+	"github.com/adminium/permify/pkg/dsl/linter"
 	"github.com/adminium/permify/pkg/dsl/parser"
 	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
 )
 
+const (
+	_defaultVersionsCacheSize     = 1000
+	_defaultCompileCacheSize      = 100
+)
+
+// ErrOrphanedTuples - The schema removes entity types, relations or subject types that tuples are still written with
+var ErrOrphanedTuples = errors.New("the schema would orphan existing tuples")
+
+// RuleDefinitionPrefix - Prefix of the entity type that the definitions of the rules are written with
+const RuleDefinitionPrefix = "rule:"
+
+// CaveatDefinitionPrefix - Prefix of the entity type that the definitions of the caveats are written with
+const CaveatDefinitionPrefix = "caveat:"
+
 // SchemaService -
 type SchemaService struct {
 	// repositories
 	sw repositories.SchemaWriter
 	sr repositories.SchemaReader
+	// rr - reads the tuples that the removals of a written schema would orphan, nil skips the check
+	rr repositories.RelationshipReader
+	// assertions - whether the assert blocks of the validated schemas are run
+	assertions bool
+	
+	// versions never change, so their parsed deprecations, aliases and docs are kept
+	mu           sync.Mutex
+	deprecations map[string]schema.Deprecations
+	aliases      map[string]schema.Aliases
+	docs         map[string]schema.Docs
+	
+	// compilations by hash of the source, the same schema is often written again and again by deployment pipelines
+	compilations map[string]*compilation
+	// counters
+	compileCacheHitCounter  instrument.Int64Counter
+	compileCacheMissCounter instrument.Int64Counter
+}
+
+// SchemaOption - Option of the schema service
+type SchemaOption func(*SchemaService)
+
+// SchemaMeter - Counts the compilations reused from and added to the compile cache
+func SchemaMeter(m metric.Meter) SchemaOption {
+	return func(service *SchemaService) {
+		if counter, err := m.Int64Counter("schema_compile_cache_hit_count", instrument.WithDescription("schema compile cache hit count")); err == nil {
+			service.compileCacheHitCounter = counter
+		}
+		if counter, err := m.Int64Counter("schema_compile_cache_miss_count", instrument.WithDescription("schema compile cache miss count")); err == nil {
+			service.compileCacheMissCounter = counter
+		}
+	}
+}
+
+// SchemaRelationshipReader - Rejects the schema writes that would orphan the tuples of the reader unless forced
+func SchemaRelationshipReader(rr repositories.RelationshipReader) SchemaOption {
+	return func(service *SchemaService) {
+		service.rr = rr
+	}
+}
+
+// SchemaAssertions - Runs the assert blocks of the validated schemas against a memory engine, a failing check fails the
+// validation with ErrAssertionFailed
+func SchemaAssertions() SchemaOption {
+	return func(service *SchemaService) {
+		service.assertions = true
+	}
 }
 
 // NewSchemaService -
-func NewSchemaService(sw repositories.SchemaWriter, sr repositories.SchemaReader) *SchemaService {
-	return &SchemaService{
-		sw: sw,
-		sr: sr,
+func NewSchemaService(sw repositories.SchemaWriter, sr repositories.SchemaReader, opts ...SchemaOption) *SchemaService {
+	service := &SchemaService{
+		sw:           sw,
+		sr:           sr,
+		deprecations: map[string]schema.Deprecations{},
+		aliases:      map[string]schema.Aliases{},
+		docs:         map[string]schema.Docs{},
+		compilations: map[string]*compilation{},
+	}
+	for _, opt := range opts {
+		opt(service)
 	}
+	return service
+}
+
+// compilation - Result of compiling a schema source, shared between callers so it must not be modified
+type compilation struct {
+	definitions []*base.EntityDefinition
+	// aliases - previous names of the entities, the tuples written with them are not orphaned
+	aliases schema.Aliases
+	// entities - name and serialized definition of every entity statement, in source order
+	entities []compiledEntity
+	// assertions - assert blocks of the source, they are never written
+	assertions []*ast.AssertStatement
+}
+
+// compiledEntity - Entity or rule statement
+type compiledEntity struct {
+	name       string
+	definition string
 }
 
 // ReadSchema -
@@ -46,40 +142,655 @@ func (service *SchemaService) ReadSchema(ctx context.Context, tenantID, version
 	return service.sr.ReadSchema(ctx, tenantID, version)
 }
 
+// ReadDeprecations - Reads the deprecated relations and actions of the schema version
+func (service *SchemaService) ReadDeprecations(ctx context.Context, tenantID, version string) (response schema.Deprecations, err error) {
+	ctx, span := tracer.Start(ctx, "schemas.read-deprecations")
+	defer span.End()
+	
+	if version == "" {
+		var ver string
+		ver, err = service.sr.HeadVersion(ctx, tenantID)
+		if err != nil {
+			return response, err
+		}
+		version = ver
+	}
+	
+	key := tenantID + "|" + version
+	
+	service.mu.Lock()
+	response, ok := service.deprecations[key]
+	service.mu.Unlock()
+	if ok {
+		return response, nil
+	}
+	
+	var definitions []string
+	definitions, err = service.sr.ReadSchemaString(ctx, tenantID, version)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return response, err
+	}
+	
+	response, err = schema.NewDeprecationsFromStringDefinitions(definitions...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, err
+	}
+	
+	service.mu.Lock()
+	if len(service.deprecations) >= _defaultVersionsCacheSize {
+		service.deprecations = map[string]schema.Deprecations{}
+	}
+	service.deprecations[key] = response
+	service.mu.Unlock()
+	
+	return response, nil
+}
+
+// ReadAliases - Reads the aliases of the entities of the schema version
+func (service *SchemaService) ReadAliases(ctx context.Context, tenantID, version string) (response schema.Aliases, err error) {
+	ctx, span := tracer.Start(ctx, "schemas.read-aliases")
+	defer span.End()
+	
+	if version == "" {
+		var ver string
+		ver, err = service.sr.HeadVersion(ctx, tenantID)
+		if err != nil {
+			return response, err
+		}
+		version = ver
+	}
+	
+	key := tenantID + "|" + version
+	
+	service.mu.Lock()
+	response, ok := service.aliases[key]
+	service.mu.Unlock()
+	if ok {
+		return response, nil
+	}
+	
+	var definitions []string
+	definitions, err = service.sr.ReadSchemaString(ctx, tenantID, version)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return response, err
+	}
+	
+	response, err = schema.NewAliasesFromStringDefinitions(definitions...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, err
+	}
+	
+	service.mu.Lock()
+	if len(service.aliases) >= _defaultVersionsCacheSize {
+		service.aliases = map[string]schema.Aliases{}
+	}
+	service.aliases[key] = response
+	service.mu.Unlock()
+	
+	return response, nil
+}
+
+// ReadDocs - Reads the doc comments of the entities, relations and actions of the schema version
+func (service *SchemaService) ReadDocs(ctx context.Context, tenantID, version string) (response schema.Docs, err error) {
+	ctx, span := tracer.Start(ctx, "schemas.read-docs")
+	defer span.End()
+	
+	if version == "" {
+		var ver string
+		ver, err = service.sr.HeadVersion(ctx, tenantID)
+		if err != nil {
+			return response, err
+		}
+		version = ver
+	}
+	
+	key := tenantID + "|" + version
+	
+	service.mu.Lock()
+	response, ok := service.docs[key]
+	service.mu.Unlock()
+	if ok {
+		return response, nil
+	}
+	
+	var definitions []string
+	definitions, err = service.sr.ReadSchemaString(ctx, tenantID, version)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return response, err
+	}
+	
+	response, err = schema.NewDocsFromStringDefinitions(definitions...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, err
+	}
+	
+	service.mu.Lock()
+	if len(service.docs) >= _defaultVersionsCacheSize {
+		service.docs = map[string]schema.Docs{}
+	}
+	service.docs[key] = response
+	service.mu.Unlock()
+	
+	return response, nil
+}
+
+// Complete - Lists the relations, with the subject types they can be written with, and the permissions of the entity
+// type of the schema version for the autocompletion of consoles
+func (service *SchemaService) Complete(ctx context.Context, tenantID, version, entityType string) (completion schema.Completion, err error) {
+	ctx, span := tracer.Start(ctx, "schemas.complete")
+	defer span.End()
+	
+	// the head is resolved once, so the definitions, deprecations and docs are of the same version
+	if version == "" {
+		version, err = service.sr.HeadVersion(ctx, tenantID)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return completion, err
+		}
+	}
+	
+	var sch *base.SchemaDefinition
+	sch, err = service.ReadSchema(ctx, tenantID, version)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return completion, err
+	}
+	
+	var entity *base.EntityDefinition
+	entity, err = schema.GetEntityByName(sch, entityType)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return completion, err
+	}
+	
+	var deprecations schema.Deprecations
+	deprecations, err = service.ReadDeprecations(ctx, tenantID, version)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return completion, err
+	}
+	
+	var docs schema.Docs
+	docs, err = service.ReadDocs(ctx, tenantID, version)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return completion, err
+	}
+	
+	return schema.NewCompletion(entity, deprecations, docs), nil
+}
+
+// FindReferences - Finds the relations and actions of the schema version that refer to the relation or action
+// of the entity type, or to the entity type itself when relation is empty
+func (service *SchemaService) FindReferences(ctx context.Context, tenantID, version, entityType, relation string) (references []schema.Reference, err error) {
+	ctx, span := tracer.Start(ctx, "schemas.find-references")
+	defer span.End()
+	
+	var sch *base.SchemaDefinition
+	sch, err = service.ReadSchema(ctx, tenantID, version)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, err
+	}
+	
+	var entity *base.EntityDefinition
+	entity, err = schema.GetEntityByName(sch, entityType)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, err
+	}
+	
+	if relation != "" {
+		if _, ok := entity.GetReferences()[relation]; !ok {
+			err = errors.New(base.ErrorCode_ERROR_CODE_RELATION_DEFINITION_NOT_FOUND.String())
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, err
+		}
+	}
+	
+	return schema.FindReferences(sch, entityType, relation), nil
+}
+
+// Lint - Returns the warnings of the source, or of the schema version when the source is empty. The warnings of a
+// version are positioned in its definitions joined in their stored order.
+func (service *SchemaService) Lint(ctx context.Context, tenantID, version, source string) (warnings []linter.Warning, err error) {
+	ctx, span := tracer.Start(ctx, "schemas.lint")
+	defer span.End()
+	
+	if source == "" {
+		if version == "" {
+			version, err = service.sr.HeadVersion(ctx, tenantID)
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(otelCodes.Error, err.Error())
+				return nil, err
+			}
+		}
+		
+		var definitions []string
+		definitions, err = service.sr.ReadSchemaString(ctx, tenantID, version)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, err
+		}
+		source = strings.Join(definitions, "\n")
+	}
+	
+	var sch *ast.Schema
+	sch, err = parser.NewParser(source).Parse()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, err
+	}
+	
+	return linter.LintSchema(sch), nil
+}
+
+// FlushTenant - Drops the parsed deprecations, aliases and docs of every schema version of the tenant
+func (service *SchemaService) FlushTenant(tenantID string) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	for key := range service.deprecations {
+		if strings.HasPrefix(key, tenantID+"|") {
+			delete(service.deprecations, key)
+		}
+	}
+	for key := range service.aliases {
+		if strings.HasPrefix(key, tenantID+"|") {
+			delete(service.aliases, key)
+		}
+	}
+	for key := range service.docs {
+		if strings.HasPrefix(key, tenantID+"|") {
+			delete(service.docs, key)
+		}
+	}
+}
+
 // WriteSchema -
 func (service *SchemaService) WriteSchema(ctx context.Context, tenantID, schema string) (response string, err error) {
 	ctx, span := tracer.Start(ctx, "schemas.write")
 	defer span.End()
 	
-	sch, err := parser.NewParser(schema).Parse()
+	c, err := service.compile(ctx, schema)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(otelCodes.Error, err.Error())
 		return "", err
 	}
 	
-	_, err = compiler.NewCompiler(false, sch).Compile()
+	version := xid.New().String()
+	
+	err = service.write(ctx, tenantID, c, version)
+	if err != nil {
+		return "", err
+	}
+	return version, nil
+}
+
+// DiffSchema - Compiles the schema and compares it to the head version of the tenant without writing it. Every
+// entity type of the schema is added when the tenant has no schema yet.
+func (service *SchemaService) DiffSchema(ctx context.Context, tenantID, source string) (changes []schema.Change, err error) {
+	ctx, span := tracer.Start(ctx, "schemas.diff")
+	defer span.End()
+	
+	var c *compilation
+	c, err = service.compile(ctx, source)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, err
+	}
+	
+	changes, err = service.diff(ctx, tenantID, c)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, err
+	}
+	return changes, nil
+}
+
+// WriteSchemaWithDiff - Writes the schema like WriteSchema and returns its changes against the former head version.
+// The writes that remove entity types, relations or subject types which tuples are still written with are rejected
+// with ErrOrphanedTuples unless forced; the changes that orphan tuples are returned with the error.
+func (service *SchemaService) WriteSchemaWithDiff(ctx context.Context, tenantID, source string, force bool) (version string, changes []schema.Change, err error) {
+	ctx, span := tracer.Start(ctx, "schemas.write-with-diff")
+	defer span.End()
+	
+	var c *compilation
+	c, err = service.compile(ctx, source)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return "", nil, err
+	}
+	
+	changes, err = service.diff(ctx, tenantID, c)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return "", nil, err
+	}
+	
+	if !force && service.rr != nil {
+		var orphaning []schema.Change
+		orphaning, err = service.orphaning(ctx, tenantID, changes, c.aliases)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return "", nil, err
+		}
+		if len(orphaning) > 0 {
+			span.RecordError(ErrOrphanedTuples)
+			span.SetStatus(otelCodes.Error, ErrOrphanedTuples.Error())
+			return "", orphaning, ErrOrphanedTuples
+		}
+	}
+	
+	version = xid.New().String()
+	err = service.write(ctx, tenantID, c, version)
+	if err != nil {
+		return "", nil, err
+	}
+	return version, changes, nil
+}
+
+// diff - Compares the compiled schema to the head version of the tenant
+func (service *SchemaService) diff(ctx context.Context, tenantID string, c *compilation) ([]schema.Change, error) {
+	head, err := service.ReadSchema(ctx, tenantID, "")
+	if err != nil {
+		if err.Error() != base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String() {
+			return nil, err
+		}
+		head = &base.SchemaDefinition{}
+	}
+	return schema.Diff(head, schema.NewSchemaFromEntityDefinitions(c.definitions...)), nil
+}
+
+// orphaning - The changes that remove what tuples of the head snapshot are written with. Entity types that are
+// aliases of the schema are not removed, their tuples resolve under the entity type they alias.
+func (service *SchemaService) orphaning(ctx context.Context, tenantID string, changes []schema.Change, aliases schema.Aliases) ([]schema.Change, error) {
+	var orphaning []schema.Change
+	var snap string
+	for _, change := range changes {
+		if !change.Orphans() {
+			continue
+		}
+		if snap == "" {
+			st, err := service.rr.HeadSnapshot(ctx, tenantID)
+			if err != nil {
+				return nil, err
+			}
+			snap = st.Encode().String()
+		}
+		
+		var filters []*base.TupleFilter
+		switch change.Kind {
+		case schema.EntityRemoved:
+			if _, ok := aliases.Resolve(change.EntityType); ok {
+				continue
+			}
+			filters = append(filters, &base.TupleFilter{Entity: &base.EntityFilter{Type: change.EntityType}})
+		case schema.RelationRemoved:
+			filters = append(filters, &base.TupleFilter{Entity: &base.EntityFilter{Type: change.EntityType}, Relation: change.Name})
+		case schema.RelationReferencesChanged:
+			for _, reference := range change.RemovedReferences {
+				subjectType, subjectRelation, _ := strings.Cut(reference, "#")
+				if _, ok := aliases.Resolve(subjectType); ok {
+					continue
+				}
+				filters = append(filters, &base.TupleFilter{
+					Entity:   &base.EntityFilter{Type: change.EntityType},
+					Relation: change.Name,
+					Subject:  &base.SubjectFilter{Type: subjectType, Relation: subjectRelation},
+				})
+			}
+		}
+		
+		for _, filter := range filters {
+			found, err := service.exists(ctx, tenantID, filter, snap)
+			if err != nil {
+				return nil, err
+			}
+			if found {
+				orphaning = append(orphaning, change)
+				break
+			}
+		}
+	}
+	return orphaning, nil
+}
+
+// exists - Whether a tuple of the snapshot matches the filter. The subject relation of the filter has to match
+// exactly, an empty one does not match the tuples of subject sets.
+func (service *SchemaService) exists(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (bool, error) {
+	it, err := service.rr.QueryRelationships(ctx, tenantID, filter, snap)
+	if err != nil {
+		return false, err
+	}
+	for it.HasNext() {
+		t := it.GetNext()
+		if filter.GetSubject() == nil {
+			return true, nil
+		}
+		relation := t.GetSubject().GetRelation()
+		if relation == tuple.ELLIPSIS {
+			relation = ""
+		}
+		if relation == filter.GetSubject().GetRelation() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// WriteSchemaVersion - Writes the schema with the given version instead of a generated one. The versions are ordered
+// by their xids, so the version has to be a valid xid that is newer than the head version of the tenant; otherwise the
+// schema would not become the head.
+func (service *SchemaService) WriteSchemaVersion(ctx context.Context, tenantID, schema, version string) (err error) {
+	ctx, span := tracer.Start(ctx, "schemas.write-version")
+	defer span.End()
+	
+	if _, err = xid.FromString(version); err != nil {
+		err = errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return err
+	}
+	
+	var head string
+	head, err = service.sr.HeadVersion(ctx, tenantID)
+	if err != nil && err.Error() != base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String() {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return err
+	}
+	if version <= head {
+		err = errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return err
+	}
+	
+	var c *compilation
+	c, err = service.compile(ctx, schema)
 	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return err
+	}
+	
+	return service.write(ctx, tenantID, c, version)
+}
+
+// ListSchemaVersions - Lists the versions of the schema of the tenant, the latest first
+func (service *SchemaService) ListSchemaVersions(ctx context.Context, tenantID string, size uint32, ct string) (versions []string, continuousToken database.EncodedContinuousToken, err error) {
+	ctx, span := tracer.Start(ctx, "schemas.list-versions")
+	defer span.End()
+	
+	versions, continuousToken, err = service.sr.ListSchemaVersions(ctx, tenantID, database.NewPagination(database.Size(size), database.Token(ct)))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, nil, err
+	}
+	return versions, continuousToken, nil
+}
+
+// RollbackSchema - Makes the schema of the version the head again. The versions are never rewritten, the definitions
+// of the version are written once more under a new version, so the history keeps the schemas that were rolled back.
+func (service *SchemaService) RollbackSchema(ctx context.Context, tenantID, version string) (newVersion string, err error) {
+	ctx, span := tracer.Start(ctx, "schemas.rollback")
+	defer span.End()
+	
+	if version == "" {
+		err = errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
 		span.RecordError(err)
 		span.SetStatus(otelCodes.Error, err.Error())
 		return "", err
 	}
 	
-	version := xid.New().String()
+	var definitions []string
+	definitions, err = service.sr.ReadSchemaString(ctx, tenantID, version)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return "", err
+	}
+	if len(definitions) == 0 {
+		err = errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String())
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return "", err
+	}
 	
-	cnf := make([]repositories.SchemaDefinition, 0, len(sch.Statements))
-	for _, st := range sch.Statements {
+	return service.WriteSchema(ctx, tenantID, strings.Join(definitions, "\n"))
+}
+
+// write - Writes the compiled entities of the schema with the version
+func (service *SchemaService) write(ctx context.Context, tenantID string, c *compilation, version string) error {
+	cnf := make([]repositories.SchemaDefinition, 0, len(c.entities))
+	for _, entity := range c.entities {
 		cnf = append(cnf, repositories.SchemaDefinition{
 			TenantID:             tenantID,
 			Version:              version,
-			EntityType:           st.(*ast.EntityStatement).Name.Literal,
-			SerializedDefinition: []byte(st.String()),
+			EntityType:           entity.name,
+			SerializedDefinition: []byte(entity.definition),
 		})
 	}
+	return service.sw.WriteSchema(ctx, cnf)
+}
+
+// ValidateSchema - Compiles the schema without writing it, its assert blocks are run when assertions are enabled
+func (service *SchemaService) ValidateSchema(ctx context.Context, source string) (response *base.SchemaDefinition, err error) {
+	ctx, span := tracer.Start(ctx, "schemas.validate")
+	defer span.End()
 	
-	err = service.sw.WriteSchema(ctx, cnf)
+	c, err := service.compile(ctx, source)
 	if err != nil {
-		return "", err
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, err.Error())
+		return nil, err
 	}
-	return version, nil
+	
+	if service.assertions {
+		if err = service.assert(ctx, c); err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelCodes.Error, err.Error())
+			return nil, err
+		}
+	}
+	
+	return schema.NewSchemaFromEntityDefinitions(c.definitions...), nil
+}
+
+// compile - Parses and compiles the schema source, or reuses the compilation of an identical source
+func (service *SchemaService) compile(ctx context.Context, source string) (*compilation, error) {
+	sum := sha256.Sum256([]byte(source))
+	key := hex.EncodeToString(sum[:])
+	
+	service.mu.Lock()
+	c, ok := service.compilations[key]
+	service.mu.Unlock()
+	if ok {
+		if service.compileCacheHitCounter != nil {
+			service.compileCacheHitCounter.Add(ctx, 1)
+		}
+		return c, nil
+	}
+	
+	sch, err := parser.NewParser(source).Parse()
+	if err != nil {
+		return nil, err
+	}
+	
+	cp := compiler.NewCompiler(false, sch)
+	definitions, err := cp.Compile()
+	if err != nil {
+		return nil, err
+	}
+	
+	c = &compilation{
+		definitions: definitions,
+		aliases:     cp.Aliases(),
+		entities:    make([]compiledEntity, 0, len(sch.Statements)),
+	}
+	for _, st := range sch.Statements {
+		switch statement := st.(type) {
+		case *ast.EntityStatement:
+			c.entities = append(c.entities, compiledEntity{
+				name:       statement.Name.Literal,
+				definition: st.String(),
+			})
+		case *ast.RuleStatement:
+			// rules are stored next to the entities, the prefix cannot be part of an entity name
+			c.entities = append(c.entities, compiledEntity{
+				name:       RuleDefinitionPrefix + statement.Name.Literal,
+				definition: st.String(),
+			})
+		case *ast.CaveatStatement:
+			c.entities = append(c.entities, compiledEntity{
+				name:       CaveatDefinitionPrefix + statement.Name.Literal,
+				definition: st.String(),
+			})
+		case *ast.AssertStatement:
+			c.assertions = append(c.assertions, statement)
+		}
+	}
+	
+	if service.compileCacheMissCounter != nil {
+		service.compileCacheMissCounter.Add(ctx, 1)
+	}
+	
+	service.mu.Lock()
+	if len(service.compilations) >= _defaultCompileCacheSize {
+		service.compilations = map[string]*compilation{}
+	}
+	service.compilations[key] = c
+	service.mu.Unlock()
+	
+	return c, nil
 }
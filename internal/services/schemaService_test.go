@@ -1 +1,390 @@
 package services
+
+import (
+	"context"
+	"errors"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	
+	"github.com/rs/xid"
+	
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/internal/repositories/decorators"
+	"github.com/adminium/permify/internal/repositories/memory"
+	"github.com/adminium/permify/internal/repositories/memory/migrations"
+	"github.com/adminium/permify/internal/schema"
+	"github.com/adminium/permify/pkg/database"
+	db "github.com/adminium/permify/pkg/database/memory"
+	"github.com/adminium/permify/pkg/dsl/linter"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+// recordingSchemaWriter - schema writer keeping the written definitions
+type recordingSchemaWriter struct {
+	written [][]repositories.SchemaDefinition
+}
+
+func (w *recordingSchemaWriter) WriteSchema(_ context.Context, definitions []repositories.SchemaDefinition) error {
+	w.written = append(w.written, definitions)
+	return nil
+}
+
+var _ = Describe("schema-service", func() {
+	source := `
+	entity user {}
+	
+	entity organization {
+		relation admin @user
+		action delete = admin
+	}
+	`
+	
+	Context("Compile Cache", func() {
+		It("Case 1: Identical sources are compiled once", func() {
+			service := NewSchemaService(nil, nil)
+			
+			first, err := service.ValidateSchema(context.Background(), source)
+			Expect(err).ShouldNot(HaveOccurred())
+			second, err := service.ValidateSchema(context.Background(), source)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			Expect(service.compilations).Should(HaveLen(1))
+			Expect(second.GetEntityDefinitions()["organization"]).Should(BeIdenticalTo(first.GetEntityDefinitions()["organization"]))
+		})
+		
+		It("Case 2: Writes reuse the compilation of validations", func() {
+			writer := &recordingSchemaWriter{}
+			service := NewSchemaService(writer, nil)
+			
+			_, err := service.ValidateSchema(context.Background(), source)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			v1, err := service.WriteSchema(context.Background(), "t1", source)
+			Expect(err).ShouldNot(HaveOccurred())
+			v2, err := service.WriteSchema(context.Background(), "t1", source)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			Expect(v1).ShouldNot(Equal(v2))
+			Expect(service.compilations).Should(HaveLen(1))
+			Expect(writer.written).Should(HaveLen(2))
+			for i, definitions := range writer.written {
+				Expect(definitions).Should(HaveLen(2))
+				Expect(definitions[0].EntityType).Should(Equal("user"))
+				Expect(definitions[1].EntityType).Should(Equal("organization"))
+				Expect(definitions[1].Version).Should(Equal([]string{v1, v2}[i]))
+			}
+		})
+		
+		It("Case 3: Invalid sources are not cached", func() {
+			service := NewSchemaService(nil, nil)
+			
+			_, err := service.ValidateSchema(context.Background(), "entity organization {\n\trelation admin @user\n}\n")
+			Expect(err).Should(HaveOccurred())
+			Expect(service.compilations).Should(BeEmpty())
+		})
+	})
+	
+	Context("Versions", func() {
+		var service *SchemaService
+		
+		BeforeEach(func() {
+			mem, err := db.New(migrations.Schema)
+			Expect(err).ShouldNot(HaveOccurred())
+			l := logger.New("error")
+			service = NewSchemaService(memory.NewSchemaWriter(mem, l), memory.NewSchemaReader(mem, l))
+		})
+		
+		It("Case 1: Versions are listed the latest first in pages", func() {
+			var written []string
+			for i := 0; i < 3; i++ {
+				version, err := service.WriteSchema(context.Background(), "t1", source)
+				Expect(err).ShouldNot(HaveOccurred())
+				written = append([]string{version}, written...)
+			}
+			
+			versions, ct, err := service.ListSchemaVersions(context.Background(), "t1", 2, "")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(versions).Should(Equal(written[:2]))
+			Expect(ct.String()).ShouldNot(BeEmpty())
+			
+			versions, ct, err = service.ListSchemaVersions(context.Background(), "t1", 2, ct.String())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(versions).Should(Equal(written[2:]))
+			Expect(ct.String()).Should(BeEmpty())
+			
+			versions, _, err = service.ListSchemaVersions(context.Background(), "t2", 2, "")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(versions).Should(BeEmpty())
+		})
+		
+		It("Case 2: Rollbacks write the schema of the version as the new head", func() {
+			first, err := service.WriteSchema(context.Background(), "t1", source)
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = service.WriteSchema(context.Background(), "t1", "entity user {}")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			version, err := service.RollbackSchema(context.Background(), "t1", first)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			sch, err := service.ReadSchema(context.Background(), "t1", "")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(sch.GetEntityDefinitions()).Should(HaveKey("organization"))
+			
+			versions, _, err := service.ListSchemaVersions(context.Background(), "t1", 10, "")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(versions).Should(HaveLen(3))
+			Expect(versions[0]).Should(Equal(version))
+			
+			_, err = service.RollbackSchema(context.Background(), "t1", xid.New().String())
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_SCHEMA_NOT_FOUND.String()))
+		})
+		
+		It("Case 3: Explicit versions have to be newer than the head", func() {
+			older := xid.New().String()
+			head, err := service.WriteSchema(context.Background(), "t1", source)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			err = service.WriteSchemaVersion(context.Background(), "t1", source, older)
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_VALIDATION.String()))
+			err = service.WriteSchemaVersion(context.Background(), "t1", source, "v2")
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_VALIDATION.String()))
+			
+			newer := xid.New().String()
+			Expect(service.WriteSchemaVersion(context.Background(), "t1", source, newer)).Should(Succeed())
+			
+			version, err := service.sr.HeadVersion(context.Background(), "t1")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(version).Should(Equal(newer))
+			Expect(version).ShouldNot(Equal(head))
+		})
+	})
+	
+	Context("Diff", func() {
+		var service *SchemaService
+		var relationships repositories.RelationshipWriter
+		
+		BeforeEach(func() {
+			mem, err := db.New(migrations.Schema)
+			Expect(err).ShouldNot(HaveOccurred())
+			l := logger.New("error")
+			relationships = memory.NewRelationshipWriter(mem, l)
+			service = NewSchemaService(memory.NewSchemaWriter(mem, l), memory.NewSchemaReader(mem, l), SchemaRelationshipReader(memory.NewRelationshipReader(mem, l)))
+		})
+		
+		It("Case 1: Writes orphaning tuples are rejected unless forced", func() {
+			_, changes, err := service.WriteSchemaWithDiff(context.Background(), "t1", source, false)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(changes).Should(HaveLen(2))
+			
+			collection := database.NewTupleCollection()
+			tup, err := tuple.Tuple("organization:1#admin@user:1")
+			Expect(err).ShouldNot(HaveOccurred())
+			collection.Add(tup)
+			_, err = relationships.WriteRelationships(context.Background(), "t1", collection)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			changed := "entity user {}\n\nentity organization {\n\trelation owner @user\n\taction delete = owner\n}\n"
+			changes, err = service.DiffSchema(context.Background(), "t1", changed)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(changes).Should(Equal([]schema.Change{
+				{Kind: schema.RelationRemoved, EntityType: "organization", Name: "admin"},
+				{Kind: schema.ActionChanged, EntityType: "organization", Name: "delete"},
+				{Kind: schema.RelationAdded, EntityType: "organization", Name: "owner"},
+			}))
+			
+			_, changes, err = service.WriteSchemaWithDiff(context.Background(), "t1", changed, false)
+			Expect(errors.Is(err, ErrOrphanedTuples)).Should(BeTrue())
+			Expect(changes).Should(Equal([]schema.Change{
+				{Kind: schema.RelationRemoved, EntityType: "organization", Name: "admin"},
+			}))
+			
+			version, _, err := service.WriteSchemaWithDiff(context.Background(), "t1", changed, true)
+			Expect(err).ShouldNot(HaveOccurred())
+			head, err := service.sr.HeadVersion(context.Background(), "t1")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(head).Should(Equal(version))
+		})
+		
+		It("Case 2: Removed subject types orphan only the tuples written with them", func() {
+			_, _, err := service.WriteSchemaWithDiff(context.Background(), "t1", "entity user {}\n\nentity team {\n\trelation member @user\n}\n\nentity organization {\n\trelation admin @user @team#member\n}\n", false)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			collection := database.NewTupleCollection()
+			tup, err := tuple.Tuple("organization:1#admin@user:1")
+			Expect(err).ShouldNot(HaveOccurred())
+			collection.Add(tup)
+			_, err = relationships.WriteRelationships(context.Background(), "t1", collection)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			_, changes, err := service.WriteSchemaWithDiff(context.Background(), "t1", "entity user {}\n\nentity team {\n\trelation member @user\n}\n\nentity organization {\n\trelation admin @user\n}\n", false)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(changes).Should(Equal([]schema.Change{
+				{Kind: schema.RelationReferencesChanged, EntityType: "organization", Name: "admin", RemovedReferences: []string{"team#member"}},
+			}))
+		})
+		
+		It("Case 3: Renamed entities keep the tuples of their aliases", func() {
+			_, _, err := service.WriteSchemaWithDiff(context.Background(), "t1", source, false)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			collection := database.NewTupleCollection()
+			tup, err := tuple.Tuple("organization:1#admin@user:1")
+			Expect(err).ShouldNot(HaveOccurred())
+			collection.Add(tup)
+			_, err = relationships.WriteRelationships(context.Background(), "t1", collection)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			renamed := "entity user {}\n\nentity company (alias organization) {\n\trelation admin @user\n\taction delete = admin\n}\n"
+			version, _, err := service.WriteSchemaWithDiff(context.Background(), "t1", renamed, false)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			aliases, err := service.ReadAliases(context.Background(), "t1", version)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(aliases).Should(Equal(schema.Aliases{"organization": "company"}))
+			
+			reader := decorators.NewRelationshipReaderWithAliases(service.rr, service)
+			st, err := reader.HeadSnapshot(context.Background(), "t1")
+			Expect(err).ShouldNot(HaveOccurred())
+			it, err := reader.QueryRelationships(context.Background(), "t1", &base.TupleFilter{
+				Entity:   &base.EntityFilter{Type: "company", Ids: []string{"1"}},
+				Relation: "admin",
+			}, st.Encode().String())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(it.HasNext()).Should(BeTrue())
+			Expect(tuple.ToString(it.GetNext())).Should(Equal("company:1#admin@user:1"))
+			Expect(it.HasNext()).Should(BeFalse())
+			
+			ids, err := reader.GetUniqueEntityIDsByEntityType(context.Background(), "t1", "company", st.Encode().String())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ids).Should(Equal([]string{"1"}))
+		})
+	})
+	
+	Context("Completion", func() {
+		It("Case 1: Docs of the written schema are stored with its definitions", func() {
+			mem, err := db.New(migrations.Schema)
+			Expect(err).ShouldNot(HaveOccurred())
+			l := logger.New("error")
+			service := NewSchemaService(memory.NewSchemaWriter(mem, l), memory.NewSchemaReader(mem, l))
+			
+			_, err = service.WriteSchema(context.Background(), "t1", `
+			entity user {}
+			
+			// a company
+			entity organization {
+				// may delete the organization
+				relation admin @user
+				deprecated relation owner @user
+				
+				action delete = admin or owner
+			}
+			`)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			completion, err := service.Complete(context.Background(), "t1", "", "organization")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(completion).Should(Equal(schema.Completion{
+				EntityType: "organization",
+				Doc:        "a company",
+				Relations: []schema.RelationCompletion{
+					{Name: "admin", Doc: "may delete the organization", SubjectTypes: []string{"user"}},
+					{Name: "owner", Deprecated: true, SubjectTypes: []string{"user"}},
+				},
+				Permissions: []schema.PermissionCompletion{
+					{Name: "delete"},
+				},
+			}))
+			
+			_, err = service.Complete(context.Background(), "t1", "", "team")
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+	
+	Context("Lint", func() {
+		It("Case 1: Written schema versions and sources are linted", func() {
+			mem, err := db.New(migrations.Schema)
+			Expect(err).ShouldNot(HaveOccurred())
+			l := logger.New("error")
+			service := NewSchemaService(memory.NewSchemaWriter(mem, l), memory.NewSchemaReader(mem, l))
+			
+			_, err = service.WriteSchema(context.Background(), "t1", `
+			entity user {}
+			
+			entity organization {
+				relation admin @user
+				relation member @user
+				
+				action delete = admin
+			}
+			`)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			warnings, err := service.Lint(context.Background(), "t1", "", "")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(warnings).Should(HaveLen(1))
+			Expect(warnings[0].Kind).Should(Equal(linter.UnusedRelation))
+			Expect(warnings[0].Message).Should(Equal("relation organization#member is not referenced by any action"))
+			
+			warnings, err = service.Lint(context.Background(), "t1", "", `
+			entity organization {
+				action delete = admin
+			}`)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(warnings).Should(HaveLen(1))
+			Expect(warnings[0].Kind).Should(Equal(linter.UnreachableAction))
+			Expect(warnings[0].Position.Line).Should(Equal(3))
+			
+			_, err = service.Lint(context.Background(), "t1", "", "entity organization {")
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+	
+	Context("Assertions", func() {
+		asserted := source + `
+	assert "admins can delete their organization" {
+		tuple "organization:1#admin@user:1"
+		check "can user:1 delete organization:1" true
+		check "can user:2 delete organization:1" false
+	}
+	
+	assert "tuples of other blocks are not visible" {
+		check "can user:1 delete organization:1" false
+	}
+	`
+	
+		It("Case 1: Assert blocks are run only when enabled", func() {
+			_, err := NewSchemaService(nil, nil).ValidateSchema(context.Background(), source+`
+	assert "wrong" {
+		check "can user:1 delete organization:1" true
+	}
+	`)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			_, err = NewSchemaService(nil, nil, SchemaAssertions()).ValidateSchema(context.Background(), asserted)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+		
+		It("Case 2: Failing checks fail the validation", func() {
+			_, err := NewSchemaService(nil, nil, SchemaAssertions()).ValidateSchema(context.Background(), source+`
+	assert "members can delete" {
+		tuple "organization:1#admin@user:1"
+		check "can user:2 delete organization:1" true
+	}
+	`)
+			Expect(errors.Is(err, ErrAssertionFailed)).Should(BeTrue())
+			Expect(err.Error()).Should(Equal("assertion failed: members can delete: can user:2 delete organization:1 is false, expected true"))
+		})
+		
+		It("Case 3: Assert blocks are not written", func() {
+			writer := &recordingSchemaWriter{}
+			_, err := NewSchemaService(writer, nil, SchemaAssertions()).WriteSchema(context.Background(), "t1", asserted)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			Expect(writer.written).Should(HaveLen(1))
+			Expect(writer.written[0]).Should(HaveLen(2))
+		})
+	})
+})
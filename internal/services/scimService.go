@@ -0,0 +1,244 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// _scimPageSize - Tuples read per page when the members of a group are listed
+const _scimPageSize = 1000
+
+// SCIM operations on the members of a group
+const (
+	SCIMOperationAdd     = "add"
+	SCIMOperationRemove  = "remove"
+	SCIMOperationReplace = "replace"
+)
+
+// SCIMMapping - How the groups of the identity provider are stored as tuples, a member m of the group g is the tuple
+// entity_type:g#relation@subject_type:m, or subject_type:m#subject_relation when the subjects are subject sets
+type SCIMMapping struct {
+	EntityType      string
+	Relation        string
+	SubjectType     string
+	SubjectRelation string
+}
+
+// SCIMGroup - A group of the identity provider, the members are the ids of the subjects
+type SCIMGroup struct {
+	ID      string
+	Members []string
+}
+
+// SCIMOperation - A change of the members of a group. Replacing with no members empties the group, removing with no
+// members removes them all.
+type SCIMOperation struct {
+	Op      string
+	Members []string
+}
+
+// SCIMService - Keeps the tuples of the groups in sync with the group and membership events of an identity provider.
+// The tuples are written and deleted through the relationship service, so they are validated against the schema.
+type SCIMService struct {
+	rs      IRelationshipService
+	mapping SCIMMapping
+}
+
+// NewSCIMService -
+func NewSCIMService(rs IRelationshipService, mapping SCIMMapping) *SCIMService {
+	return &SCIMService{
+		rs:      rs,
+		mapping: mapping,
+	}
+}
+
+// CreateGroup - Provisions the group with its members. Groups without members have no tuples, so creating a group
+// that already exists replaces its members instead of failing.
+func (s *SCIMService) CreateGroup(ctx context.Context, tenantID string, group SCIMGroup) (SCIMGroup, error) {
+	ctx, span := tracer.Start(ctx, "scim.create-group")
+	defer span.End()
+	
+	return s.ReplaceGroup(ctx, tenantID, group)
+}
+
+// ReplaceGroup - Writes the members the group does not have yet and deletes the ones it should not have anymore
+func (s *SCIMService) ReplaceGroup(ctx context.Context, tenantID string, group SCIMGroup) (SCIMGroup, error) {
+	ctx, span := tracer.Start(ctx, "scim.replace-group")
+	defer span.End()
+	
+	if group.ID == "" {
+		return SCIMGroup{}, errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+	}
+	
+	current, err := s.members(ctx, tenantID, group.ID)
+	if err != nil {
+		return SCIMGroup{}, err
+	}
+	
+	desired := memberSet(group.Members)
+	var added, removed []string
+	for member := range desired {
+		if !current[member] {
+			added = append(added, member)
+		}
+	}
+	for member := range current {
+		if !desired[member] {
+			removed = append(removed, member)
+		}
+	}
+	
+	if err = s.apply(ctx, tenantID, group.ID, added, removed); err != nil {
+		return SCIMGroup{}, err
+	}
+	return SCIMGroup{ID: group.ID, Members: sortedMembers(desired)}, nil
+}
+
+// PatchGroup - Applies the operations to the members of the group in order
+func (s *SCIMService) PatchGroup(ctx context.Context, tenantID, id string, operations []SCIMOperation) (SCIMGroup, error) {
+	ctx, span := tracer.Start(ctx, "scim.patch-group")
+	defer span.End()
+	
+	if id == "" {
+		return SCIMGroup{}, errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+	}
+	
+	current, err := s.members(ctx, tenantID, id)
+	if err != nil {
+		return SCIMGroup{}, err
+	}
+	
+	// the operations are folded into the members the group ends up with, the tuples are then changed at once
+	desired := memberSet(nil)
+	for member := range current {
+		desired[member] = true
+	}
+	for _, operation := range operations {
+		switch strings.ToLower(operation.Op) {
+		case SCIMOperationAdd:
+			for _, member := range operation.Members {
+				desired[member] = true
+			}
+		case SCIMOperationRemove:
+			if len(operation.Members) == 0 {
+				desired = memberSet(nil)
+			}
+			for _, member := range operation.Members {
+				delete(desired, member)
+			}
+		case SCIMOperationReplace:
+			desired = memberSet(operation.Members)
+		default:
+			return SCIMGroup{}, errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+		}
+	}
+	
+	return s.ReplaceGroup(ctx, tenantID, SCIMGroup{ID: id, Members: sortedMembers(desired)})
+}
+
+// DeleteGroup - Deletes the memberships of the group. Tuples that have the group as their subject are kept, they
+// grant nothing once the group has no members.
+func (s *SCIMService) DeleteGroup(ctx context.Context, tenantID, id string) error {
+	ctx, span := tracer.Start(ctx, "scim.delete-group")
+	defer span.End()
+	
+	if id == "" {
+		return errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+	}
+	
+	_, err := s.rs.DeleteRelationships(ctx, tenantID, s.filter(id, nil))
+	return err
+}
+
+// ReadGroup - The group with its members in ascending order
+func (s *SCIMService) ReadGroup(ctx context.Context, tenantID, id string) (SCIMGroup, error) {
+	ctx, span := tracer.Start(ctx, "scim.read-group")
+	defer span.End()
+	
+	if id == "" {
+		return SCIMGroup{}, errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+	}
+	
+	current, err := s.members(ctx, tenantID, id)
+	if err != nil {
+		return SCIMGroup{}, err
+	}
+	return SCIMGroup{ID: id, Members: sortedMembers(current)}, nil
+}
+
+// members - Ids of the subjects the group has at the head snapshot
+func (s *SCIMService) members(ctx context.Context, tenantID, id string) (map[string]bool, error) {
+	members := map[string]bool{}
+	ct := ""
+	for {
+		collection, next, err := s.rs.ReadRelationships(ctx, tenantID, s.filter(id, nil), "", _scimPageSize, ct)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range collection.GetTuples() {
+			members[t.GetSubject().GetId()] = true
+		}
+		if next == nil || next.String() == "" {
+			return members, nil
+		}
+		ct = next.String()
+	}
+}
+
+// apply - Writes the tuples of the added members and deletes the ones of the removed members
+func (s *SCIMService) apply(ctx context.Context, tenantID, id string, added, removed []string) error {
+	if len(added) > 0 {
+		tuples := make([]*base.Tuple, 0, len(added))
+		for _, member := range added {
+			tuples = append(tuples, &base.Tuple{
+				Entity:   &base.Entity{Type: s.mapping.EntityType, Id: id},
+				Relation: s.mapping.Relation,
+				Subject:  &base.Subject{Type: s.mapping.SubjectType, Id: member, Relation: s.mapping.SubjectRelation},
+			})
+		}
+		// groups of the identity providers can have more members than a single write takes
+		if _, err := s.rs.BatchWriteRelationships(ctx, tenantID, tuples, ""); err != nil {
+			return err
+		}
+	}
+	if len(removed) > 0 {
+		if _, err := s.rs.DeleteRelationships(ctx, tenantID, s.filter(id, removed)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// filter - Tuples of the group, of all its members when members is empty
+func (s *SCIMService) filter(id string, members []string) *base.TupleFilter {
+	return &base.TupleFilter{
+		Entity:   &base.EntityFilter{Type: s.mapping.EntityType, Ids: []string{id}},
+		Relation: s.mapping.Relation,
+		Subject:  &base.SubjectFilter{Type: s.mapping.SubjectType, Ids: members, Relation: s.mapping.SubjectRelation},
+	}
+}
+
+// memberSet -
+func memberSet(members []string) map[string]bool {
+	s := make(map[string]bool, len(members))
+	for _, member := range members {
+		if member != "" {
+			s[member] = true
+		}
+	}
+	return s
+}
+
+// sortedMembers -
+func sortedMembers(members map[string]bool) []string {
+	s := make([]string, 0, len(members))
+	for member := range members {
+		s = append(s, member)
+	}
+	sort.Strings(s)
+	return s
+}
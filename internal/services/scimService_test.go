@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	
+	"github.com/adminium/permify/internal/repositories/memory"
+	"github.com/adminium/permify/internal/repositories/memory/migrations"
+	"github.com/adminium/permify/internal/repositories/mocks"
+	"github.com/adminium/permify/internal/schema"
+	db "github.com/adminium/permify/pkg/database/memory"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+var _ = Describe("scim-service", func() {
+	var service *SCIMService
+	var relationships *RelationshipService
+	
+	BeforeEach(func() {
+		sch, err := schema.NewSchemaFromStringDefinitions(true, "entity user {}\n\nentity group {\n\trelation member @user\n}\n")
+		Expect(err).ShouldNot(HaveOccurred())
+		
+		schemaReader := new(mocks.SchemaReader)
+		schemaReader.On("HeadVersion", "t1").Return("v1", nil)
+		schemaReader.On("ReadSchemaDefinition", "t1", "group", "v1").Return(sch.GetEntityDefinitions()["group"], "v1", nil)
+		
+		mem, err := db.New(migrations.Schema)
+		Expect(err).ShouldNot(HaveOccurred())
+		l := logger.New("error")
+		
+		relationships = NewRelationshipService(memory.NewRelationshipReader(mem, l), memory.NewRelationshipWriter(mem, l), schemaReader)
+		service = NewSCIMService(relationships, SCIMMapping{EntityType: "group", Relation: "member", SubjectType: "user"})
+	})
+	
+	Context("Groups", func() {
+		It("Case 1: Replacing a group writes and deletes the changed memberships", func() {
+			group, err := service.CreateGroup(context.Background(), "t1", SCIMGroup{ID: "engineering", Members: []string{"alice", "bob"}})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(group.Members).Should(Equal([]string{"alice", "bob"}))
+			
+			group, err = service.ReplaceGroup(context.Background(), "t1", SCIMGroup{ID: "engineering", Members: []string{"bob", "carol"}})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(group.Members).Should(Equal([]string{"bob", "carol"}))
+			
+			collection, _, err := relationships.ReadRelationships(context.Background(), "t1", &base.TupleFilter{
+				Entity: &base.EntityFilter{Type: "group", Ids: []string{"engineering"}},
+			}, "", 100, "")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			members := make([]string, 0, len(collection.GetTuples()))
+			for _, t := range collection.GetTuples() {
+				Expect(t.GetRelation()).Should(Equal("member"))
+				Expect(t.GetSubject().GetType()).Should(Equal("user"))
+				members = append(members, t.GetSubject().GetId())
+			}
+			Expect(members).Should(ConsistOf("bob", "carol"))
+		})
+		
+		It("Case 2: Patch operations are applied in order", func() {
+			_, err := service.CreateGroup(context.Background(), "t1", SCIMGroup{ID: "engineering", Members: []string{"alice"}})
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			group, err := service.PatchGroup(context.Background(), "t1", "engineering", []SCIMOperation{
+				{Op: "Add", Members: []string{"bob", "carol"}},
+				{Op: "remove", Members: []string{"alice"}},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(group.Members).Should(Equal([]string{"bob", "carol"}))
+			
+			group, err = service.ReadGroup(context.Background(), "t1", "engineering")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(group.Members).Should(Equal([]string{"bob", "carol"}))
+			
+			_, err = service.PatchGroup(context.Background(), "t1", "engineering", []SCIMOperation{{Op: "move"}})
+			Expect(err).Should(MatchError(base.ErrorCode_ERROR_CODE_VALIDATION.String()))
+		})
+		
+		It("Case 3: Deleting a group deletes its memberships", func() {
+			_, err := service.CreateGroup(context.Background(), "t1", SCIMGroup{ID: "engineering", Members: []string{"alice", "bob"}})
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = service.CreateGroup(context.Background(), "t1", SCIMGroup{ID: "sales", Members: []string{"alice"}})
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			Expect(service.DeleteGroup(context.Background(), "t1", "engineering")).Should(Succeed())
+			
+			group, err := service.ReadGroup(context.Background(), "t1", "engineering")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(group.Members).Should(BeEmpty())
+			
+			group, err = service.ReadGroup(context.Background(), "t1", "sales")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(group.Members).Should(Equal([]string{"alice"}))
+		})
+		
+		It("Case 4: Members that the schema does not allow are rejected", func() {
+			mapped := NewSCIMService(relationships, SCIMMapping{EntityType: "group", Relation: "member", SubjectType: "team", SubjectRelation: "member"})
+			_, err := mapped.CreateGroup(context.Background(), "t1", SCIMGroup{ID: "engineering", Members: []string{"alice"}})
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,56 @@
+package services
+
+import (
+	"context"
+	"errors"
+	
+	"github.com/adminium/permify/internal/keys"
+	"github.com/adminium/permify/internal/repositories"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// SettingsService -
+type SettingsService struct {
+	// repositories
+	tr repositories.TenantSettingsReader
+	tw repositories.TenantSettingsWriter
+	// caches
+	km keys.CommandKeyManager
+}
+
+// NewSettingsService -
+func NewSettingsService(tw repositories.TenantSettingsWriter, tr repositories.TenantSettingsReader, km keys.CommandKeyManager) *SettingsService {
+	return &SettingsService{
+		tr: tr,
+		tw: tw,
+		km: km,
+	}
+}
+
+// ReadSettings - Settings of the tenant, the defaults when none were written
+func (service *SettingsService) ReadSettings(ctx context.Context, tenantID string) (settings repositories.TenantSettings, err error) {
+	ctx, span := tracer.Start(ctx, "settings.read")
+	defer span.End()
+	
+	return service.tr.ReadTenantSettings(ctx, tenantID)
+}
+
+// WriteSettings - Replaces the settings of the tenant. The cached checks of the tenant may have been computed with
+// the previous settings, so they are dropped.
+func (service *SettingsService) WriteSettings(ctx context.Context, tenantID string, settings repositories.TenantSettings) (err error) {
+	ctx, span := tracer.Start(ctx, "settings.write")
+	defer span.End()
+	
+	if settings.Depth != 0 && settings.Depth < 3 {
+		return errors.New(base.ErrorCode_ERROR_CODE_DEPTH_NOT_ENOUGH.String())
+	}
+	if settings.CacheTTL < 0 {
+		return errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+	}
+	
+	if err = service.tw.WriteTenantSettings(ctx, tenantID, settings); err != nil {
+		return err
+	}
+	service.km.InvalidateCheckKeys(tenantID)
+	return nil
+}
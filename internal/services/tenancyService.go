@@ -2,12 +2,16 @@ package services
 
 import (
 	"context"
+	"errors"
 	
 	"github.com/adminium/permify/internal/repositories"
 	"github.com/adminium/permify/pkg/database"
 	base "github.com/adminium/permify/pkg/pb/base/v1"
 )
 
+// ErrTenantMetadataUnsupported - The storage can not read a single tenant, rename it or label it
+var ErrTenantMetadataUnsupported = errors.New("the storage does not support reading and updating a single tenant")
+
 // TenancyService -
 type TenancyService struct {
 	tr repositories.TenantReader
@@ -32,6 +36,28 @@ func (s *TenancyService) DeleteTenant(ctx context.Context, tenantID string) (ten
 	return s.tw.DeleteTenant(ctx, tenantID)
 }
 
+// GetTenant - Tenant and its labels
+func (s *TenancyService) GetTenant(ctx context.Context, tenantID string) (tenant *base.Tenant, labels map[string]string, err error) {
+	getter, ok := s.tr.(repositories.TenantGetter)
+	if !ok {
+		return nil, nil, ErrTenantMetadataUnsupported
+	}
+	return getter.GetTenant(ctx, tenantID)
+}
+
+// UpdateTenant - Renames and labels the tenant, an empty name keeps the name and nil labels keep the labels. The
+// tenant is returned with its labels after the update.
+func (s *TenancyService) UpdateTenant(ctx context.Context, tenantID, name string, labels map[string]string) (tenant *base.Tenant, current map[string]string, err error) {
+	updater, ok := s.tw.(repositories.TenantUpdater)
+	if !ok {
+		return nil, nil, ErrTenantMetadataUnsupported
+	}
+	if _, err = updater.UpdateTenant(ctx, tenantID, name, labels); err != nil {
+		return nil, nil, err
+	}
+	return s.GetTenant(ctx, tenantID)
+}
+
 // ListTenants -
 func (s *TenancyService) ListTenants(ctx context.Context, size uint32, ct string) (tenants []*base.Tenant, continuousToken database.EncodedContinuousToken, err error) {
 	return s.tr.ListTenants(ctx, database.NewPagination(database.Size(size), database.Token(ct)))
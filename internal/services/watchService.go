@@ -0,0 +1,29 @@
+package services
+
+import (
+	"context"
+	
+	"github.com/adminium/permify/internal/commands"
+	"github.com/adminium/permify/internal/repositories"
+)
+
+// WatchService -
+type WatchService struct {
+	// commands
+	wc commands.IWatchCommand
+}
+
+// NewWatchService -
+func NewWatchService(wc commands.IWatchCommand) *WatchService {
+	return &WatchService{
+		wc: wc,
+	}
+}
+
+// Watch - Streams the relation tuples created and deleted in the tenant after the snapshot of the token
+func (service *WatchService) Watch(ctx context.Context, tenantID, snap string, send func(changes *repositories.RelationshipChanges) error) (err error) {
+	ctx, span := tracer.Start(ctx, "relationships.watch")
+	defer span.End()
+	
+	return service.wc.Execute(ctx, tenantID, snap, send)
+}
@@ -0,0 +1,277 @@
+package usage
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+	
+	"github.com/adminium/permify/internal/commands"
+	"github.com/adminium/permify/pkg/dsl/utils"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+const (
+	// _buckets - Number of time slices the window is divided into, the oldest slice is dropped as a whole
+	_buckets = 60
+	// _maxShapes - Distinct permissions and check shapes a tenant keeps per slice, the rest is only counted in total
+	_maxShapes = 1000
+)
+
+// _latencyBounds - Upper bounds of the latency histogram, doubling from 100µs to about 105s. Percentiles are
+// reported as the upper bound of the bucket they fall into.
+var _latencyBounds = func() []time.Duration {
+	bounds := make([]time.Duration, 21)
+	bound := 100 * time.Microsecond
+	for i := range bounds {
+		bounds[i] = bound
+		bound *= 2
+	}
+	return bounds
+}()
+
+// PermissionUsage - How often a permission of an entity type was checked, e.g. doc#read
+type PermissionUsage struct {
+	Permission string
+	Count      uint64
+}
+
+// ShapeUsage - Checks of a permission by a subject type, e.g. doc#read@user or doc#read@team#member
+type ShapeUsage struct {
+	Shape string
+	Count uint64
+	P99   time.Duration
+}
+
+// Statistics - Usage of a tenant in the window
+type Statistics struct {
+	Window      time.Duration
+	Checks      uint64
+	Permissions []PermissionUsage
+	Shapes      []ShapeUsage
+}
+
+// histogram -
+type histogram struct {
+	counts [22]uint64
+	total  uint64
+}
+
+// observe -
+func (h *histogram) observe(d time.Duration) {
+	i := sort.Search(len(_latencyBounds), func(i int) bool { return d <= _latencyBounds[i] })
+	h.counts[i]++
+	h.total++
+}
+
+// merge -
+func (h *histogram) merge(o *histogram) {
+	for i := range h.counts {
+		h.counts[i] += o.counts[i]
+	}
+	h.total += o.total
+}
+
+// percentile - Upper bound of the bucket of the percentile, durations beyond the last bound report the last bound
+func (h *histogram) percentile(p float64) time.Duration {
+	if h.total == 0 {
+		return 0
+	}
+	rank := uint64(float64(h.total)*p + 0.5)
+	if rank == 0 {
+		rank = 1
+	}
+	var seen uint64
+	for i, c := range h.counts {
+		seen += c
+		if seen >= rank {
+			if i >= len(_latencyBounds) {
+				break
+			}
+			return _latencyBounds[i]
+		}
+	}
+	return _latencyBounds[len(_latencyBounds)-1]
+}
+
+// tenantSlice - Usage of a tenant in a single time slice
+type tenantSlice struct {
+	checks      uint64
+	permissions map[string]uint64
+	shapes      map[string]*histogram
+}
+
+// slice -
+type slice struct {
+	start   time.Time
+	tenants map[string]*tenantSlice
+}
+
+// Collector - Aggregates the checks of every tenant in a rolling window, so teams can find the permissions that are
+// checked the most and the check shapes that are the slowest. The window is divided into slices and a slice is
+// dropped as a whole once it is older than the window, so the reports cover between window minus one slice and
+// the window.
+type Collector struct {
+	mu     sync.Mutex
+	window time.Duration
+	width  time.Duration
+	slices []*slice
+	now    func() time.Time
+}
+
+// NewCollector - Creates new collector with the rolling window
+func NewCollector(window time.Duration) *Collector {
+	width := window / _buckets
+	if width <= 0 {
+		width = time.Second
+	}
+	return &Collector{
+		window: window,
+		width:  width,
+		slices: make([]*slice, _buckets),
+		now:    time.Now,
+	}
+}
+
+// Record - Counts a check of the tenant and the time it took
+func (c *Collector) Record(request *base.PermissionCheckRequest, duration time.Duration) {
+	permission := utils.Key(request.GetEntity().GetType(), request.GetPermission())
+	shape := Shape(request)
+	
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	
+	s := c.current()
+	ts, ok := s.tenants[request.GetTenantId()]
+	if !ok {
+		ts = &tenantSlice{
+			permissions: map[string]uint64{},
+			shapes:      map[string]*histogram{},
+		}
+		s.tenants[request.GetTenantId()] = ts
+	}
+	ts.checks++
+	if _, ok = ts.permissions[permission]; ok || len(ts.permissions) < _maxShapes {
+		ts.permissions[permission]++
+	}
+	h, ok := ts.shapes[shape]
+	if !ok {
+		if len(ts.shapes) >= _maxShapes {
+			return
+		}
+		h = &histogram{}
+		ts.shapes[shape] = h
+	}
+	h.observe(duration)
+}
+
+// Report - The n most checked permissions and the n slowest check shapes by their p99 latency of the tenant in the
+// window
+func (c *Collector) Report(tenantID string, n int) Statistics {
+	permissions := map[string]uint64{}
+	shapes := map[string]*histogram{}
+	report := Statistics{Window: c.window}
+	
+	c.mu.Lock()
+	oldest := c.now().Add(-c.window)
+	for _, s := range c.slices {
+		if s == nil || !s.start.After(oldest) {
+			continue
+		}
+		ts, ok := s.tenants[tenantID]
+		if !ok {
+			continue
+		}
+		report.Checks += ts.checks
+		for permission, count := range ts.permissions {
+			permissions[permission] += count
+		}
+		for shape, h := range ts.shapes {
+			merged, ok := shapes[shape]
+			if !ok {
+				merged = &histogram{}
+				shapes[shape] = merged
+			}
+			merged.merge(h)
+		}
+	}
+	c.mu.Unlock()
+	
+	report.Permissions = make([]PermissionUsage, 0, len(permissions))
+	for permission, count := range permissions {
+		report.Permissions = append(report.Permissions, PermissionUsage{Permission: permission, Count: count})
+	}
+	sort.Slice(report.Permissions, func(i, j int) bool {
+		if report.Permissions[i].Count != report.Permissions[j].Count {
+			return report.Permissions[i].Count > report.Permissions[j].Count
+		}
+		return report.Permissions[i].Permission < report.Permissions[j].Permission
+	})
+	
+	report.Shapes = make([]ShapeUsage, 0, len(shapes))
+	for shape, h := range shapes {
+		report.Shapes = append(report.Shapes, ShapeUsage{Shape: shape, Count: h.total, P99: h.percentile(0.99)})
+	}
+	sort.Slice(report.Shapes, func(i, j int) bool {
+		if report.Shapes[i].P99 != report.Shapes[j].P99 {
+			return report.Shapes[i].P99 > report.Shapes[j].P99
+		}
+		if report.Shapes[i].Count != report.Shapes[j].Count {
+			return report.Shapes[i].Count > report.Shapes[j].Count
+		}
+		return report.Shapes[i].Shape < report.Shapes[j].Shape
+	})
+	
+	if len(report.Permissions) > n {
+		report.Permissions = report.Permissions[:n]
+	}
+	if len(report.Shapes) > n {
+		report.Shapes = report.Shapes[:n]
+	}
+	return report
+}
+
+// current - Slice of the current time, the slice it replaces in the ring is dropped. Callers hold the lock.
+func (c *Collector) current() *slice {
+	start := c.now().Truncate(c.width)
+	position := int(start.UnixNano()/int64(c.width)) % len(c.slices)
+	s := c.slices[position]
+	if s == nil || !s.start.Equal(start) {
+		s = &slice{start: start, tenants: map[string]*tenantSlice{}}
+		c.slices[position] = s
+	}
+	return s
+}
+
+// Shape - Shape of a check, the permission and the subject reference without the ids, e.g. doc#read@user
+func Shape(request *base.PermissionCheckRequest) string {
+	shape := utils.Key(request.GetEntity().GetType(), request.GetPermission()) + "@" + request.GetSubject().GetType()
+	if request.GetSubject().GetRelation() != "" {
+		shape = utils.Key(shape, request.GetSubject().GetRelation())
+	}
+	return shape
+}
+
+// RecordingCheckCommand - Records the duration of every successful check
+type RecordingCheckCommand struct {
+	delegate  commands.ICheckCommand
+	collector *Collector
+}
+
+// NewRecordingCheckCommand - Creates new recording check command
+func NewRecordingCheckCommand(delegate commands.ICheckCommand, collector *Collector) *RecordingCheckCommand {
+	return &RecordingCheckCommand{
+		delegate:  delegate,
+		collector: collector,
+	}
+}
+
+// Execute -
+func (c *RecordingCheckCommand) Execute(ctx context.Context, request *base.PermissionCheckRequest) (*base.PermissionCheckResponse, error) {
+	start := time.Now()
+	response, err := c.delegate.Execute(ctx, request)
+	if err == nil {
+		c.collector.Record(request, time.Since(start))
+	}
+	return response, err
+}
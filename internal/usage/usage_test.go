@@ -0,0 +1,112 @@
+package usage
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// TestUsage -
+func TestUsage(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "usage-suite")
+}
+
+// fakeCheckCommand -
+type fakeCheckCommand struct {
+	err error
+}
+
+func (c fakeCheckCommand) Execute(context.Context, *base.PermissionCheckRequest) (*base.PermissionCheckResponse, error) {
+	return &base.PermissionCheckResponse{Can: base.PermissionCheckResponse_RESULT_ALLOWED}, c.err
+}
+
+// request -
+func request(tenantID, entityType, permission string, subject *base.Subject) *base.PermissionCheckRequest {
+	return &base.PermissionCheckRequest{
+		TenantId:   tenantID,
+		Entity:     &base.Entity{Type: entityType, Id: "1"},
+		Permission: permission,
+		Subject:    subject,
+	}
+}
+
+var _ = Describe("usage", func() {
+	Context("Shape", func() {
+		It("Case 1", func() {
+			Expect(Shape(request("t1", "doc", "read", &base.Subject{Type: "user", Id: "1"}))).Should(Equal("doc#read@user"))
+			Expect(Shape(request("t1", "doc", "read", &base.Subject{Type: "team", Id: "1", Relation: "member"}))).Should(Equal("doc#read@team#member"))
+		})
+	})
+	
+	Context("Collector", func() {
+		It("Case 1: Ranks the permissions by their checks and the shapes by their p99", func() {
+			collector := NewCollector(time.Hour)
+			user := &base.Subject{Type: "user", Id: "1"}
+			
+			for i := 0; i < 3; i++ {
+				collector.Record(request("t1", "doc", "read", user), time.Millisecond)
+			}
+			collector.Record(request("t1", "doc", "edit", user), 50*time.Millisecond)
+			collector.Record(request("t1", "folder", "view", &base.Subject{Type: "team", Id: "1", Relation: "member"}), 300*time.Microsecond)
+			collector.Record(request("t2", "doc", "read", user), time.Second)
+			
+			report := collector.Report("t1", 2)
+			Expect(report.Window).Should(Equal(time.Hour))
+			Expect(report.Checks).Should(Equal(uint64(5)))
+			Expect(report.Permissions).Should(Equal([]PermissionUsage{
+				{Permission: "doc#read", Count: 3},
+				{Permission: "doc#edit", Count: 1},
+			}))
+			Expect(report.Shapes).Should(HaveLen(2))
+			Expect(report.Shapes[0].Shape).Should(Equal("doc#edit@user"))
+			Expect(report.Shapes[0].P99).Should(BeNumerically(">=", 50*time.Millisecond))
+			Expect(report.Shapes[1].Shape).Should(Equal("doc#read@user"))
+			Expect(report.Shapes[1].Count).Should(Equal(uint64(3)))
+			Expect(report.Shapes[1].P99).Should(BeNumerically(">=", time.Millisecond))
+			Expect(report.Shapes[1].P99).Should(BeNumerically("<", 50*time.Millisecond))
+			
+			Expect(collector.Report("t3", 10).Checks).Should(Equal(uint64(0)))
+		})
+		
+		It("Case 2: Drops the checks older than the window", func() {
+			now := time.Date(2023, 2, 1, 12, 0, 0, 0, time.UTC)
+			collector := NewCollector(time.Hour)
+			collector.now = func() time.Time { return now }
+			user := &base.Subject{Type: "user", Id: "1"}
+			
+			collector.Record(request("t1", "doc", "read", user), time.Millisecond)
+			now = now.Add(30 * time.Minute)
+			collector.Record(request("t1", "doc", "edit", user), time.Millisecond)
+			Expect(collector.Report("t1", 10).Checks).Should(Equal(uint64(2)))
+			
+			now = now.Add(31 * time.Minute)
+			report := collector.Report("t1", 10)
+			Expect(report.Checks).Should(Equal(uint64(1)))
+			Expect(report.Permissions).Should(Equal([]PermissionUsage{{Permission: "doc#edit", Count: 1}}))
+			
+			now = now.Add(time.Hour)
+			Expect(collector.Report("t1", 10).Checks).Should(Equal(uint64(0)))
+		})
+	})
+	
+	Context("RecordingCheckCommand", func() {
+		It("Case 1: Records only the successful checks", func() {
+			collector := NewCollector(time.Hour)
+			user := &base.Subject{Type: "user", Id: "1"}
+			
+			_, err := NewRecordingCheckCommand(fakeCheckCommand{}, collector).Execute(context.Background(), request("t1", "doc", "read", user))
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = NewRecordingCheckCommand(fakeCheckCommand{err: errors.New("storage")}, collector).Execute(context.Background(), request("t1", "doc", "read", user))
+			Expect(err).Should(HaveOccurred())
+			
+			Expect(collector.Report("t1", 10).Checks).Should(Equal(uint64(1)))
+		})
+	})
+})
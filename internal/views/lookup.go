@@ -0,0 +1,105 @@
+package views
+
+import (
+	"context"
+	
+	"github.com/adminium/permify/internal/commands"
+	"github.com/adminium/permify/internal/repositories"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// LookupEntityCommand - Serves the entity lookups of the materialized views from their allow lists and the others from
+// the delegate. Only the lookups at the head are served, a snap token or schema version asks for a consistency that
+// the views cannot promise.
+type LookupEntityCommand struct {
+	delegate     commands.ILookupEntityCommand
+	schemaReader repositories.SchemaReader
+	materializer *Materializer
+}
+
+// NewLookupEntityCommand - Creates new materialized lookup entity command
+func NewLookupEntityCommand(delegate commands.ILookupEntityCommand, sr repositories.SchemaReader, m *Materializer) *LookupEntityCommand {
+	return &LookupEntityCommand{
+		delegate:     delegate,
+		schemaReader: sr,
+		materializer: m,
+	}
+}
+
+// Execute -
+func (c *LookupEntityCommand) Execute(ctx context.Context, request *base.PermissionLookupEntityRequest) (*base.PermissionLookupEntityResponse, error) {
+	if ids, ok := c.lookup(ctx, request); ok {
+		return &base.PermissionLookupEntityResponse{
+			EntityIds: ids,
+		}, nil
+	}
+	return c.delegate.Execute(ctx, request)
+}
+
+// Stream -
+func (c *LookupEntityCommand) Stream(ctx context.Context, request *base.PermissionLookupEntityRequest, server base.Permission_LookupEntityStreamServer) error {
+	ids, ok := c.lookup(ctx, request)
+	if !ok {
+		return c.delegate.Stream(ctx, request, server)
+	}
+	for _, id := range ids {
+		if err := server.Send(&base.PermissionLookupEntityStreamResponse{
+			EntityId: id,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lookup - Allowed entity ids from the allow list of the view of the request
+func (c *LookupEntityCommand) lookup(ctx context.Context, request *base.PermissionLookupEntityRequest) ([]string, bool) {
+	if request.GetMetadata().GetSnapToken() != "" || request.GetMetadata().GetSchemaVersion() != "" || request.GetSubject().GetRelation() != "" {
+		return nil, false
+	}
+	version, err := c.schemaReader.HeadVersion(ctx, request.GetTenantId())
+	if err != nil {
+		return nil, false
+	}
+	return c.materializer.Entities(request.GetTenantId(), View{
+		EntityType:  request.GetEntityType(),
+		Permission:  request.GetPermission(),
+		SubjectType: request.GetSubject().GetType(),
+	}, request.GetSubject().GetId(), version)
+}
+
+// LookupSubjectCommand - Serves the subject lookups of the materialized views from their allow lists and the others
+// from the delegate, under the same conditions as the entity lookups
+type LookupSubjectCommand struct {
+	delegate     commands.ILookupSubjectCommand
+	schemaReader repositories.SchemaReader
+	materializer *Materializer
+}
+
+// NewLookupSubjectCommand - Creates new materialized lookup subject command
+func NewLookupSubjectCommand(delegate commands.ILookupSubjectCommand, sr repositories.SchemaReader, m *Materializer) *LookupSubjectCommand {
+	return &LookupSubjectCommand{
+		delegate:     delegate,
+		schemaReader: sr,
+		materializer: m,
+	}
+}
+
+// Execute -
+func (c *LookupSubjectCommand) Execute(ctx context.Context, request *commands.LookupSubjectRequest) (*commands.LookupSubjectResponse, error) {
+	if request.Metadata.GetSnapToken() == "" && request.Metadata.GetSchemaVersion() == "" && request.SubjectReference.GetRelation() == "" {
+		if version, err := c.schemaReader.HeadVersion(ctx, request.TenantID); err == nil {
+			ids, ok := c.materializer.Subjects(request.TenantID, View{
+				EntityType:  request.Entity.GetType(),
+				Permission:  request.Permission,
+				SubjectType: request.SubjectReference.GetType(),
+			}, request.Entity.GetId(), version)
+			if ok {
+				return &commands.LookupSubjectResponse{
+					SubjectIDs: ids,
+				}, nil
+			}
+		}
+	}
+	return c.delegate.Execute(ctx, request)
+}
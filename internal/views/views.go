@@ -0,0 +1,510 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	
+	"github.com/adminium/permify/internal/commands"
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/internal/schema"
+	"github.com/adminium/permify/pkg/database"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+const (
+	_defaultMaxStaleness    = 5 * time.Second
+	_defaultRefreshInterval = 30 * time.Second
+	_defaultRetryInterval   = time.Second
+	_defaultPageSize        = 100
+	_defaultDepth           = 20
+	_defaultSubjectType     = "user"
+)
+
+// ErrInvalidView - The view is not in the form entity_type#permission or entity_type#permission@subject_type
+var ErrInvalidView = errors.New("invalid view")
+
+// View - Entity type and permission whose allow list is materialized for the subjects of a type
+type View struct {
+	EntityType  string
+	Permission  string
+	SubjectType string
+}
+
+// ParseView - Parses entity_type#permission, optionally followed by @subject_type; the subjects are users by default
+func ParseView(s string) (View, error) {
+	reference, subjectType, ok := strings.Cut(s, "@")
+	if !ok {
+		subjectType = _defaultSubjectType
+	}
+	entityType, permission, ok := strings.Cut(reference, "#")
+	if !ok || entityType == "" || permission == "" || subjectType == "" || strings.Contains(subjectType, "#") {
+		return View{}, fmt.Errorf("%w: %s", ErrInvalidView, s)
+	}
+	return View{EntityType: entityType, Permission: permission, SubjectType: subjectType}, nil
+}
+
+// String -
+func (v View) String() string {
+	return v.EntityType + "#" + v.Permission + "@" + v.SubjectType
+}
+
+// table - Allow list of a view in a tenant
+type table struct {
+	// entities - allowed subject ids by entity id, subjects - allowed entity ids by subject id, both sorted
+	entities map[string][]string
+	subjects map[string][]string
+	// version - schema version the table was built with
+	version string
+	// changes - number of the changes that affect the view, built - number of them the table contains
+	changes uint64
+	built   uint64
+	// staleSince - time of the first change that the table does not contain, zero when it contains all of them
+	staleSince time.Time
+}
+
+// Materializer - Maintains denormalized allow lists of the configured views for every tenant, so that their lookups
+// are served without a check per candidate. The changes of the relation tuples of every tenant are watched, a change
+// that the permission of a view depends on makes the table of the view stale and it is rebuilt in the background.
+// A stale table is still served until it is more than the max staleness behind the storage, then the lookups are
+// computed again until the rebuild catches up. The tables are also rebuilt every refresh interval when the head
+// schema changed, which is not in the changes of the tuples.
+type Materializer struct {
+	watcher            repositories.Watcher
+	tenantReader       repositories.TenantReader
+	relationshipReader repositories.RelationshipReader
+	schemaReader       repositories.SchemaReader
+	lookupSubject      commands.ILookupSubjectCommand
+	views              []View
+	// options
+	maxStaleness    time.Duration
+	refreshInterval time.Duration
+	retryInterval   time.Duration
+	logger          logger.Interface
+	now             func() time.Time
+	// tables by tenant and view, and the tenants being maintained with the channels their rebuilds are triggered by
+	mu       sync.RWMutex
+	tables   map[string]*table
+	watching map[string]tenant
+}
+
+// tenant - Maintenance of the views of a tenant
+type tenant struct {
+	cancel  context.CancelFunc
+	rebuild chan struct{}
+}
+
+// Option - Option type
+type Option func(*Materializer)
+
+// MaxStaleness - How long the tables are served after a change they do not contain
+func MaxStaleness(d time.Duration) Option {
+	return func(m *Materializer) {
+		if d > 0 {
+			m.maxStaleness = d
+		}
+	}
+}
+
+// RefreshInterval - How often the tenants are listed and the tables are checked against the head schema
+func RefreshInterval(d time.Duration) Option {
+	return func(m *Materializer) {
+		if d > 0 {
+			m.refreshInterval = d
+		}
+	}
+}
+
+// NewMaterializer - Creates a new materializer of the views, the allow lists are built with the lookup subject command
+func NewMaterializer(w repositories.Watcher, tr repositories.TenantReader, rr repositories.RelationshipReader, sr repositories.SchemaReader, ls commands.ILookupSubjectCommand, views []View, l logger.Interface, opts ...Option) *Materializer {
+	m := &Materializer{
+		watcher:            w,
+		tenantReader:       tr,
+		relationshipReader: rr,
+		schemaReader:       sr,
+		lookupSubject:      ls,
+		views:              views,
+		maxStaleness:       _defaultMaxStaleness,
+		refreshInterval:    _defaultRefreshInterval,
+		retryInterval:      _defaultRetryInterval,
+		logger:             l,
+		now:                time.Now,
+		tables:             map[string]*table{},
+		watching:           map[string]tenant{},
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// Run - Maintains the views of the tenants until the context is done
+func (m *Materializer) Run(ctx context.Context) error {
+	ticker := time.NewTicker(m.refreshInterval)
+	defer ticker.Stop()
+	
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	
+	for {
+		if err := m.refresh(ctx, &wg); err != nil {
+			m.logger.Error(fmt.Sprintf("listing the tenants to materialize failed: %s", err.Error()))
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Entities - Ids of the entities of the view that the subject has the permission on, ok is false when the view is
+// not materialized for the tenant or it is too stale to be served
+func (m *Materializer) Entities(tenantID string, view View, subjectID, version string) (ids []string, ok bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	
+	t, ok := m.servable(tenantID, view, version)
+	if !ok {
+		return nil, false
+	}
+	return append([]string{}, t.subjects[subjectID]...), true
+}
+
+// Subjects - Ids of the subjects of the view that have the permission on the entity, ok is false when the view is not
+// materialized for the tenant or it is too stale to be served
+func (m *Materializer) Subjects(tenantID string, view View, entityID, version string) (ids []string, ok bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	
+	t, ok := m.servable(tenantID, view, version)
+	if !ok {
+		return nil, false
+	}
+	return append([]string{}, t.entities[entityID]...), true
+}
+
+// servable - Table of the view when it was built with the schema version and is within the max staleness
+func (m *Materializer) servable(tenantID string, view View, version string) (*table, bool) {
+	t, ok := m.tables[key(tenantID, view)]
+	if !ok || t.version != version {
+		return nil, false
+	}
+	if !t.staleSince.IsZero() && m.now().Sub(t.staleSince) > m.maxStaleness {
+		return nil, false
+	}
+	return t, true
+}
+
+// refresh - Starts maintaining the new tenants, stops maintaining the deleted ones and triggers the rebuilds of the
+// others, which rebuild the tables of an old schema version
+func (m *Materializer) refresh(ctx context.Context, wg *sync.WaitGroup) error {
+	tenants, err := m.tenants(ctx)
+	if err != nil {
+		return err
+	}
+	
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	
+	for tenantID, t := range m.watching {
+		if _, ok := tenants[tenantID]; !ok {
+			t.cancel()
+			delete(m.watching, tenantID)
+			for _, view := range m.views {
+				delete(m.tables, key(tenantID, view))
+			}
+		}
+	}
+	
+	for tenantID := range tenants {
+		if t, ok := m.watching[tenantID]; ok {
+			trigger(t.rebuild)
+			continue
+		}
+		tenantCtx, cancel := context.WithCancel(ctx)
+		t := tenant{cancel: cancel, rebuild: make(chan struct{}, 1)}
+		m.watching[tenantID] = t
+		trigger(t.rebuild)
+		wg.Add(2)
+		go func(tenantID string) {
+			defer wg.Done()
+			m.watch(tenantCtx, tenantID, t.rebuild)
+		}(tenantID)
+		go func(tenantID string) {
+			defer wg.Done()
+			m.build(tenantCtx, tenantID, t.rebuild)
+		}(tenantID)
+	}
+	return nil
+}
+
+// build - Rebuilds the tables of the tenant that need it whenever a rebuild is triggered, until the context is done
+func (m *Materializer) build(ctx context.Context, tenantID string, rebuild <-chan struct{}) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-rebuild:
+		}
+		for _, view := range m.views {
+			if err := m.Rebuild(ctx, tenantID, view); err != nil && ctx.Err() == nil {
+				m.logger.Warn("materializing %s of %s failed: %s", view.String(), tenantID, err.Error())
+			}
+		}
+	}
+}
+
+// Rebuild - Builds the table of the view in the tenant again when it is stale or was built with another schema
+// version. The view is not materialized when the head schema has no such permission.
+func (m *Materializer) Rebuild(ctx context.Context, tenantID string, view View) error {
+	version, err := m.schemaReader.HeadVersion(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	
+	// the changes are counted before the snapshot is read, a change counted later may be in the table already but a
+	// change that is not in the table is never counted as built
+	m.mu.RLock()
+	t, ok := m.tables[key(tenantID, view)]
+	var changes uint64
+	if ok {
+		if t.version == version && t.built == t.changes {
+			m.mu.RUnlock()
+			return nil
+		}
+		changes = t.changes
+	}
+	m.mu.RUnlock()
+	
+	var definition *base.EntityDefinition
+	definition, _, err = m.schemaReader.ReadSchemaDefinition(ctx, tenantID, view.EntityType, version)
+	if err != nil || !hasPermission(definition, view.Permission) {
+		m.mu.Lock()
+		delete(m.tables, key(tenantID, view))
+		m.mu.Unlock()
+		return nil
+	}
+	
+	head, err := m.relationshipReader.HeadSnapshot(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	snap := head.Encode().String()
+	
+	var ids []string
+	ids, err = m.relationshipReader.GetUniqueEntityIDsByEntityType(ctx, tenantID, view.EntityType, snap)
+	if err != nil {
+		return err
+	}
+	
+	entities := make(map[string][]string, len(ids))
+	subjects := map[string][]string{}
+	for _, id := range ids {
+		var response *commands.LookupSubjectResponse
+		response, err = m.lookupSubject.Execute(ctx, &commands.LookupSubjectRequest{
+			TenantID:         tenantID,
+			Entity:           &base.Entity{Type: view.EntityType, Id: id},
+			Permission:       view.Permission,
+			SubjectReference: &base.RelationReference{Type: view.SubjectType},
+			Metadata: &base.PermissionLookupEntityRequestMetadata{
+				SnapToken:     snap,
+				SchemaVersion: version,
+				Depth:         _defaultDepth,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		if len(response.SubjectIDs) == 0 {
+			continue
+		}
+		entities[id] = response.SubjectIDs
+		for _, subjectID := range response.SubjectIDs {
+			subjects[subjectID] = append(subjects[subjectID], id)
+		}
+	}
+	for _, entityIDs := range subjects {
+		sort.Strings(entityIDs)
+	}
+	
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t, ok = m.tables[key(tenantID, view)]
+	if !ok {
+		t = &table{changes: changes}
+		m.tables[key(tenantID, view)] = t
+	}
+	t.entities = entities
+	t.subjects = subjects
+	t.version = version
+	t.built = changes
+	if t.built == t.changes {
+		t.staleSince = time.Time{}
+	}
+	return nil
+}
+
+// watch - Marks the tables that the changes of the tenant affect stale until the context is done. A failed watch is
+// resumed from the token of the last changes it marked; when there is none, it starts over at the head snapshot and
+// every table of the tenant is marked stale, since the changes in between are unknown.
+func (m *Materializer) watch(ctx context.Context, tenantID string, rebuild chan struct{}) {
+	snap := ""
+	for {
+		if snap == "" {
+			head, err := m.relationshipReader.HeadSnapshot(ctx, tenantID)
+			if err != nil {
+				if !m.wait(ctx, tenantID, err) {
+					return
+				}
+				continue
+			}
+			snap = head.Encode().String()
+			m.stale(tenantID, m.views...)
+			trigger(rebuild)
+		}
+		
+		changes, errs := m.watcher.Watch(ctx, tenantID, snap)
+		var err error
+	consume:
+		for {
+			select {
+			case c, ok := <-changes:
+				if !ok {
+					// the watcher closes both channels, an error is sent before they are closed
+					if e, ok := <-errs; ok {
+						err = e
+					}
+					break consume
+				}
+				if affected := m.affected(ctx, tenantID, c.Created, c.Deleted); len(affected) > 0 {
+					m.stale(tenantID, affected...)
+					trigger(rebuild)
+				}
+				snap = c.SnapToken
+			case <-ctx.Done():
+				return
+			}
+		}
+		if !m.wait(ctx, tenantID, err) {
+			return
+		}
+	}
+}
+
+// affected - Views whose permissions depend on the relations of the changed tuples in the head schema of the tenant,
+// every view when the schema cannot be read
+func (m *Materializer) affected(ctx context.Context, tenantID string, collections ...*database.TupleCollection) []View {
+	version, err := m.schemaReader.HeadVersion(ctx, tenantID)
+	if err != nil {
+		return m.views
+	}
+	var sch *base.SchemaDefinition
+	sch, err = m.schemaReader.ReadSchema(ctx, tenantID, version)
+	if err != nil {
+		return m.views
+	}
+	
+	dependents := map[string]struct{}{}
+	seen := map[string]struct{}{}
+	for _, collection := range collections {
+		for _, tup := range collection.GetTuples() {
+			relation := tup.GetEntity().GetType() + "#" + tup.GetRelation()
+			if _, ok := seen[relation]; ok {
+				continue
+			}
+			seen[relation] = struct{}{}
+			for _, dependent := range schema.Dependents(sch, tup.GetEntity().GetType(), tup.GetRelation()) {
+				dependents[dependent.GetType()+"#"+dependent.GetRelation()] = struct{}{}
+			}
+		}
+	}
+	
+	var affected []View
+	for _, view := range m.views {
+		if _, ok := dependents[view.EntityType+"#"+view.Permission]; ok {
+			affected = append(affected, view)
+		}
+	}
+	return affected
+}
+
+// stale - Counts a change in the tables of the views, the ones that contained every change become stale. The tables
+// that are not built yet are counted too, so that a build in progress does not miss the change.
+func (m *Materializer) stale(tenantID string, views ...View) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, view := range views {
+		t, ok := m.tables[key(tenantID, view)]
+		if !ok {
+			t = &table{}
+			m.tables[key(tenantID, view)] = t
+		}
+		if t.built == t.changes {
+			t.staleSince = m.now()
+		}
+		t.changes++
+	}
+}
+
+// wait - Logs the error of a watch and waits before it is retried, it reports false when the context is done
+func (m *Materializer) wait(ctx context.Context, tenantID string, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if err != nil {
+		m.logger.Warn("watching the changes of %s to materialize failed: %s", tenantID, err.Error())
+	}
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(m.retryInterval):
+		return true
+	}
+}
+
+// tenants - Ids of every tenant
+func (m *Materializer) tenants(ctx context.Context) (map[string]struct{}, error) {
+	ids := map[string]struct{}{}
+	ct := ""
+	for {
+		tenants, next, err := m.tenantReader.ListTenants(ctx, database.NewPagination(database.Size(_defaultPageSize), database.Token(ct)))
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range tenants {
+			ids[t.GetId()] = struct{}{}
+		}
+		if next == nil || next.String() == "" {
+			return ids, nil
+		}
+		ct = next.String()
+	}
+}
+
+// hasPermission - Whether the entity has the action or relation
+func hasPermission(definition *base.EntityDefinition, permission string) bool {
+	if _, ok := definition.GetActions()[permission]; ok {
+		return true
+	}
+	_, ok := definition.GetRelations()[permission]
+	return ok
+}
+
+// trigger - Triggers a rebuild unless one is pending already
+func trigger(rebuild chan struct{}) {
+	select {
+	case rebuild <- struct{}{}:
+	default:
+	}
+}
+
+// key -
+func key(tenantID string, view View) string {
+	return tenantID + "|" + view.String()
+}
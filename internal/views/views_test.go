@@ -0,0 +1,190 @@
+package views
+
+import (
+	"context"
+	"testing"
+	"time"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	
+	"github.com/adminium/permify/internal/commands"
+	"github.com/adminium/permify/internal/keys"
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/internal/repositories/memory"
+	"github.com/adminium/permify/internal/repositories/memory/migrations"
+	"github.com/adminium/permify/pkg/database"
+	db "github.com/adminium/permify/pkg/database/memory"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/telemetry"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+// TestViews -
+func TestViews(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "views-suite")
+}
+
+// fixedLookupEntity - Lookup entity command answering every lookup with the same ids
+type fixedLookupEntity struct {
+	ids []string
+}
+
+func (f fixedLookupEntity) Execute(context.Context, *base.PermissionLookupEntityRequest) (*base.PermissionLookupEntityResponse, error) {
+	return &base.PermissionLookupEntityResponse{EntityIds: f.ids}, nil
+}
+
+func (f fixedLookupEntity) Stream(context.Context, *base.PermissionLookupEntityRequest, base.Permission_LookupEntityStreamServer) error {
+	return nil
+}
+
+var _ = Describe("views", func() {
+	var mem *db.Memory
+	var l logger.Interface
+	var write func(tuples ...string)
+	var materializer *Materializer
+	view := View{EntityType: "doc", Permission: "view", SubjectType: "user"}
+	
+	BeforeEach(func() {
+		var err error
+		mem, err = db.New(migrations.Schema)
+		Expect(err).ShouldNot(HaveOccurred())
+		l = logger.New("error")
+		
+		_, err = memory.NewTenantWriter(mem, l).CreateTenant(context.Background(), "t1", "t1")
+		Expect(err).ShouldNot(HaveOccurred())
+		
+		err = memory.NewSchemaWriter(mem, l).WriteSchema(context.Background(), []repositories.SchemaDefinition{
+			{TenantID: "t1", EntityType: "user", SerializedDefinition: []byte("entity user {}"), Version: "v1"},
+			{TenantID: "t1", EntityType: "doc", SerializedDefinition: []byte("entity doc {\n\trelation owner @user\n\trelation viewer @user\n\taction view = viewer or owner\n\taction edit = owner\n}"), Version: "v1"},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		
+		writer := memory.NewRelationshipWriter(mem, l)
+		write = func(tuples ...string) {
+			collection := database.NewTupleCollection()
+			for _, t := range tuples {
+				tup, err := tuple.Tuple(t)
+				Expect(err).ShouldNot(HaveOccurred())
+				collection.Add(tup)
+			}
+			_, err := writer.WriteRelationships(context.Background(), "t1", collection)
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+		write("doc:1#owner@user:1", "doc:2#viewer@user:1", "doc:2#owner@user:2")
+		
+		schemaReader := memory.NewSchemaReader(mem, l)
+		relationshipReader := memory.NewRelationshipReader(mem, l)
+		checkCommand, err := commands.NewCheckCommand(keys.NewNoopCheckCommandKeys(), schemaReader, relationshipReader, telemetry.NewNoopMeter())
+		Expect(err).ShouldNot(HaveOccurred())
+		lookupSubject := commands.NewLookupSubjectCommand(checkCommand, schemaReader, relationshipReader)
+		
+		materializer = NewMaterializer(memory.NewWatcher(mem, l), memory.NewTenantReader(mem, l), relationshipReader, schemaReader, lookupSubject, []View{view}, l, MaxStaleness(time.Minute))
+	})
+	
+	Context("ParseView", func() {
+		It("Case 1: Subjects are users unless a type is given", func() {
+			Expect(ParseView("doc#view")).Should(Equal(view))
+			Expect(ParseView("doc#view@team")).Should(Equal(View{EntityType: "doc", Permission: "view", SubjectType: "team"}))
+			_, err := ParseView("doc")
+			Expect(err).Should(MatchError(ErrInvalidView))
+			_, err = ParseView("doc#view@team#member")
+			Expect(err).Should(MatchError(ErrInvalidView))
+		})
+	})
+	
+	Context("Materializer", func() {
+		It("Case 1: Allow lists are built for both directions", func() {
+			Expect(materializer.Rebuild(context.Background(), "t1", view)).Should(Succeed())
+			
+			ids, ok := materializer.Entities("t1", view, "1", "v1")
+			Expect(ok).Should(BeTrue())
+			Expect(ids).Should(Equal([]string{"1", "2"}))
+			ids, ok = materializer.Subjects("t1", view, "2", "v1")
+			Expect(ok).Should(BeTrue())
+			Expect(ids).Should(Equal([]string{"1", "2"}))
+			
+			_, ok = materializer.Entities("t1", View{EntityType: "doc", Permission: "edit", SubjectType: "user"}, "1", "v1")
+			Expect(ok).Should(BeFalse())
+			_, ok = materializer.Entities("t1", view, "1", "v2")
+			Expect(ok).Should(BeFalse())
+		})
+		
+		It("Case 2: Stale allow lists are served up to the max staleness", func() {
+			now := time.Now()
+			materializer.now = func() time.Time { return now }
+			Expect(materializer.Rebuild(context.Background(), "t1", view)).Should(Succeed())
+			
+			write("doc:3#viewer@user:1")
+			materializer.stale("t1", view)
+			
+			now = now.Add(30 * time.Second)
+			ids, ok := materializer.Entities("t1", view, "1", "v1")
+			Expect(ok).Should(BeTrue())
+			Expect(ids).Should(Equal([]string{"1", "2"}))
+			
+			now = now.Add(time.Minute)
+			_, ok = materializer.Entities("t1", view, "1", "v1")
+			Expect(ok).Should(BeFalse())
+			
+			Expect(materializer.Rebuild(context.Background(), "t1", view)).Should(Succeed())
+			ids, ok = materializer.Entities("t1", view, "1", "v1")
+			Expect(ok).Should(BeTrue())
+			Expect(ids).Should(Equal([]string{"1", "2", "3"}))
+		})
+		
+		It("Case 3: Writes to the storage reach the allow lists", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			
+			done := make(chan struct{})
+			go func() {
+				defer GinkgoRecover()
+				defer close(done)
+				Expect(materializer.Run(ctx)).Should(Succeed())
+			}()
+			
+			Eventually(func() []string {
+				ids, _ := materializer.Entities("t1", view, "1", "v1")
+				return ids
+			}).Should(Equal([]string{"1", "2"}))
+			
+			write("doc:4#owner@user:1")
+			
+			Eventually(func() []string {
+				ids, _ := materializer.Entities("t1", view, "1", "v1")
+				return ids
+			}).Should(Equal([]string{"1", "2", "4"}))
+			
+			cancel()
+			Eventually(done).Should(BeClosed())
+		})
+	})
+	
+	Context("LookupEntityCommand", func() {
+		It("Case 1: Only the lookups at the head are served from the allow lists", func() {
+			Expect(materializer.Rebuild(context.Background(), "t1", view)).Should(Succeed())
+			command := NewLookupEntityCommand(fixedLookupEntity{ids: []string{"computed"}}, memory.NewSchemaReader(mem, l), materializer)
+			
+			request := func(snap string) *base.PermissionLookupEntityRequest {
+				return &base.PermissionLookupEntityRequest{
+					TenantId:   "t1",
+					Metadata:   &base.PermissionLookupEntityRequestMetadata{SnapToken: snap, Depth: 20},
+					EntityType: "doc",
+					Permission: "view",
+					Subject:    &base.Subject{Type: "user", Id: "1"},
+				}
+			}
+			
+			response, err := command.Execute(context.Background(), request(""))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(response.GetEntityIds()).Should(Equal([]string{"1", "2"}))
+			
+			response, err = command.Execute(context.Background(), request("snap"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(response.GetEntityIds()).Should(Equal([]string{"computed"}))
+		})
+	})
+})
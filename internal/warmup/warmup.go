@@ -0,0 +1,146 @@
+package warmup
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sort"
+	"sync"
+	
+	"gopkg.in/yaml.v3"
+	
+	"github.com/adminium/permify/internal/commands"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+const (
+	_defaultDepth = 20
+)
+
+// Pattern - A check that is worth having in the cache, e.g. {tenant_id: t1, check: "doc:1#read@user:1"}
+type Pattern struct {
+	TenantID string `yaml:"tenant_id"`
+	Check    string `yaml:"check"`
+}
+
+// NewPattern - Creates the pattern of a check request
+func NewPattern(request *base.PermissionCheckRequest) Pattern {
+	return Pattern{
+		TenantID: request.GetTenantId(),
+		Check: tuple.ToString(&base.Tuple{
+			Entity:   request.GetEntity(),
+			Relation: request.GetPermission(),
+			Subject:  request.GetSubject(),
+		}),
+	}
+}
+
+// ToRequest - Converts the pattern to a check request against the head snapshot and schema version
+func (p Pattern) ToRequest() (*base.PermissionCheckRequest, error) {
+	tup, err := tuple.Tuple(p.Check)
+	if err != nil {
+		return nil, err
+	}
+	return &base.PermissionCheckRequest{
+		TenantId:   p.TenantID,
+		Entity:     tup.GetEntity(),
+		Permission: tup.GetRelation(),
+		Subject:    tup.GetSubject(),
+		Metadata: &base.PermissionCheckRequestMetadata{
+			Depth: _defaultDepth,
+		},
+	}, nil
+}
+
+// Load - Reads patterns from a yaml file. A missing file is not an error, there is nothing to warm up yet.
+func Load(path string) ([]Pattern, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var patterns []Pattern
+	if err = yaml.Unmarshal(data, &patterns); err != nil {
+		return nil, err
+	}
+	return patterns, nil
+}
+
+// Save - Writes patterns to a yaml file
+func Save(path string, patterns []Pattern) error {
+	data, err := yaml.Marshal(patterns)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+// Recorder - Counts how often each check pattern is requested, so the most frequent ones can be
+// persisted on shutdown and replayed by the next process
+type Recorder struct {
+	mu     sync.Mutex
+	counts map[Pattern]uint64
+	limit  int
+}
+
+// NewRecorder - Creates new recorder that tracks at most limit distinct patterns
+func NewRecorder(limit int) *Recorder {
+	return &Recorder{
+		counts: make(map[Pattern]uint64),
+		limit:  limit,
+	}
+}
+
+// Record - Counts the check request
+func (r *Recorder) Record(request *base.PermissionCheckRequest) {
+	pattern := NewPattern(request)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.counts[pattern]; !ok && len(r.counts) >= r.limit {
+		return
+	}
+	r.counts[pattern]++
+}
+
+// Top - Returns the n most frequent patterns
+func (r *Recorder) Top(n int) []Pattern {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	patterns := make([]Pattern, 0, len(r.counts))
+	for pattern := range r.counts {
+		patterns = append(patterns, pattern)
+	}
+	sort.Slice(patterns, func(i, j int) bool {
+		if r.counts[patterns[i]] != r.counts[patterns[j]] {
+			return r.counts[patterns[i]] > r.counts[patterns[j]]
+		}
+		return patterns[i].Check < patterns[j].Check
+	})
+	if len(patterns) > n {
+		patterns = patterns[:n]
+	}
+	return patterns
+}
+
+// RecordingCheckCommand - Records every executed check request
+type RecordingCheckCommand struct {
+	delegate commands.ICheckCommand
+	recorder *Recorder
+}
+
+// NewRecordingCheckCommand - Creates new recording check command
+func NewRecordingCheckCommand(delegate commands.ICheckCommand, recorder *Recorder) *RecordingCheckCommand {
+	return &RecordingCheckCommand{
+		delegate: delegate,
+		recorder: recorder,
+	}
+}
+
+// Execute -
+func (c *RecordingCheckCommand) Execute(ctx context.Context, request *base.PermissionCheckRequest) (*base.PermissionCheckResponse, error) {
+	c.recorder.Record(request)
+	return c.delegate.Execute(ctx, request)
+}
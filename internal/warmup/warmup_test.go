@@ -0,0 +1,71 @@
+package warmup
+
+import (
+	"path/filepath"
+	"testing"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// TestWarmup -
+func TestWarmup(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "warmup-suite")
+}
+
+var _ = Describe("warmup", func() {
+	Context("Pattern", func() {
+		It("Case 1", func() {
+			request, err := Pattern{TenantID: "t1", Check: "doc:1#read@user:1"}.ToRequest()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(request.GetTenantId()).Should(Equal("t1"))
+			Expect(request.GetEntity()).Should(Equal(&base.Entity{Type: "doc", Id: "1"}))
+			Expect(request.GetPermission()).Should(Equal("read"))
+			Expect(request.GetSubject()).Should(Equal(&base.Subject{Type: "user", Id: "1"}))
+			Expect(request.GetMetadata().GetDepth()).Should(Equal(int32(_defaultDepth)))
+			Expect(NewPattern(request)).Should(Equal(Pattern{TenantID: "t1", Check: "doc:1#read@user:1"}))
+		})
+		
+		It("Case 2", func() {
+			_, err := Pattern{TenantID: "t1", Check: "doc:1"}.ToRequest()
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+	
+	Context("Recorder", func() {
+		It("Case 1", func() {
+			recorder := NewRecorder(3)
+			for _, check := range []string{"doc:1#read@user:1", "doc:2#read@user:1", "doc:2#read@user:1", "doc:3#read@user:1", "doc:3#read@user:1", "doc:3#read@user:1", "doc:4#read@user:1"} {
+				request, err := Pattern{TenantID: "t1", Check: check}.ToRequest()
+				Expect(err).ShouldNot(HaveOccurred())
+				recorder.Record(request)
+			}
+			
+			Expect(recorder.Top(2)).Should(Equal([]Pattern{
+				{TenantID: "t1", Check: "doc:3#read@user:1"},
+				{TenantID: "t1", Check: "doc:2#read@user:1"},
+			}))
+			Expect(recorder.Top(10)).Should(HaveLen(3))
+		})
+	})
+	
+	Context("Save and Load", func() {
+		It("Case 1", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "warmup.yaml")
+			
+			patterns, err := Load(path)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(patterns).Should(BeEmpty())
+			
+			expected := []Pattern{{TenantID: "t1", Check: "doc:1#read@user:1"}}
+			Expect(Save(path, expected)).ShouldNot(HaveOccurred())
+			
+			patterns, err = Load(path)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(patterns).Should(Equal(expected))
+		})
+	})
+})
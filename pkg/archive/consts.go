@@ -0,0 +1,14 @@
+package archive
+
+// Engine - Engine type for archive
+type Engine string
+
+const (
+	S3  Engine = "s3"
+	GCS Engine = "gcs"
+)
+
+// String - String converter
+func (c Engine) String() string {
+	return string(c)
+}
@@ -0,0 +1,28 @@
+package archive
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrNotFound - The store has no object with the key
+var ErrNotFound = errors.New("archived object not found")
+
+// Object - An object of the store
+type Object struct {
+	Key          string
+	LastModified time.Time
+}
+
+// Store - Object storage the archives are shipped to
+type Store interface {
+	// Put writes the object, an existing object with the key is replaced.
+	Put(ctx context.Context, key string, data []byte) (err error)
+	// Get reads the object, ErrNotFound when there is none with the key.
+	Get(ctx context.Context, key string) (data []byte, err error)
+	// List lists the objects whose keys start with the prefix.
+	List(ctx context.Context, prefix string) (objects []Object, err error)
+	// Delete deletes the object.
+	Delete(ctx context.Context, key string) (err error)
+}
@@ -0,0 +1,5 @@
+package s3
+
+// GCSEndpoint - Endpoint of the interoperability api of google cloud storage, it is compatible with s3 and
+// authenticates with the hmac keys of a service account
+const GCSEndpoint = "storage.googleapis.com"
@@ -0,0 +1,39 @@
+package s3
+
+import (
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// Option - Option types for the store
+type Option func(s3 *S3)
+
+// Prefix - Defines the prefix of the keys, stores with different prefixes can share a bucket
+func Prefix(prefix string) Option {
+	return func(s *S3) {
+		s.prefix = prefix
+	}
+}
+
+// Credentials - Defines the access key and the secret of the requests, the credentials of the environment are
+// used when they are empty
+func Credentials(accessKey, secretKey string) Option {
+	return func(s *S3) {
+		if accessKey != "" || secretKey != "" {
+			s.options.Creds = credentials.NewStaticV4(accessKey, secretKey, "")
+		}
+	}
+}
+
+// Region - Defines the region of the bucket
+func Region(region string) Option {
+	return func(s *S3) {
+		s.options.Region = region
+	}
+}
+
+// Insecure - Sends the requests over plain http, for stores inside the network such as minio
+func Insecure(insecure bool) Option {
+	return func(s *S3) {
+		s.options.Secure = !insecure
+	}
+}
@@ -0,0 +1,102 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	
+	"github.com/adminium/permify/pkg/archive"
+)
+
+// S3 - Archive store on an s3 compatible object storage, google cloud storage is reached through its
+// interoperability endpoint
+type S3 struct {
+	bucket  string
+	prefix  string
+	options *minio.Options
+	Client  *minio.Client
+}
+
+// New - Creates new s3 store on the bucket of the endpoint
+func New(endpoint, bucket string, opts ...Option) (*S3, error) {
+	s := &S3{
+		bucket: bucket,
+		options: &minio.Options{
+			Creds: credentials.NewChainCredentials([]credentials.Provider{
+				&credentials.EnvAWS{},
+				&credentials.IAM{},
+			}),
+			Secure: true,
+		},
+	}
+	
+	// options
+	for _, opt := range opts {
+		opt(s)
+	}
+	
+	client, err := minio.New(endpoint, s.options)
+	if err != nil {
+		return nil, err
+	}
+	s.Client = client
+	return s, nil
+}
+
+// Put - Writes the object
+func (s *S3) Put(ctx context.Context, key string, data []byte) error {
+	_, err := s.Client.PutObject(ctx, s.bucket, s.prefix+key, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{
+		ContentType: "application/octet-stream",
+	})
+	return err
+}
+
+// Get - Reads the object
+func (s *S3) Get(ctx context.Context, key string) ([]byte, error) {
+	object, err := s.Client.GetObject(ctx, s.bucket, s.prefix+key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, s.err(err)
+	}
+	defer object.Close()
+	
+	data, err := io.ReadAll(object)
+	if err != nil {
+		return nil, s.err(err)
+	}
+	return data, nil
+}
+
+// List - Lists the objects whose keys start with the prefix, the keys are returned without the prefix of the store
+func (s *S3) List(ctx context.Context, prefix string) ([]archive.Object, error) {
+	var objects []archive.Object
+	for info := range s.Client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{
+		Prefix:    s.prefix + prefix,
+		Recursive: true,
+	}) {
+		if info.Err != nil {
+			return nil, info.Err
+		}
+		objects = append(objects, archive.Object{
+			Key:          strings.TrimPrefix(info.Key, s.prefix),
+			LastModified: info.LastModified,
+		})
+	}
+	return objects, nil
+}
+
+// Delete - Deletes the object
+func (s *S3) Delete(ctx context.Context, key string) error {
+	return s.Client.RemoveObject(ctx, s.bucket, s.prefix+key, minio.RemoveObjectOptions{})
+}
+
+// err - Converts the errors of missing objects
+func (s *S3) err(err error) error {
+	if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+		return archive.ErrNotFound
+	}
+	return err
+}
@@ -0,0 +1,287 @@
+package bundle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	
+	"gopkg.in/yaml.v3"
+	
+	"github.com/adminium/permify/internal/schema"
+	"github.com/adminium/permify/pkg/development"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/token"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+const (
+	// Version - Current version of the bundle format
+	Version = "v1"
+	
+	_defaultPageSize  = 100
+	_defaultBatchSize = 100
+	_defaultDepth     = 100
+	_defaultTenant    = "t1"
+)
+
+var ErrUnsupportedVersion = errors.New("unsupported bundle version")
+
+// Bundle - Packages a schema, its seed tuples and the assertions that must hold for them.
+// The fields are compatible with the file format of the validate command.
+type Bundle struct {
+	Version       string            `yaml:"version"`
+	SchemaVersion string            `yaml:"schema_version,omitempty"`
+	Schema        string            `yaml:"schema"`
+	Tuples        []string          `yaml:"relationships"`
+	Assertions    []map[string]bool `yaml:"assertions"`
+}
+
+// Failure - Assertion whose result did not match the expectation
+type Failure struct {
+	Query    string
+	Expected bool
+}
+
+// String -
+func (f Failure) String() string {
+	return fmt.Sprintf("%s => expected: %v, actual: %v", f.Query, f.Expected, !f.Expected)
+}
+
+// Clients - Permify api clients of the environment the bundle is exported from or imported into
+type Clients struct {
+	Schema       base.SchemaClient
+	Relationship base.RelationshipClient
+	Permission   base.PermissionClient
+}
+
+// Load - Reads the bundle from the file
+func Load(path string) (*Bundle, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	b := &Bundle{}
+	if err = yaml.Unmarshal(data, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// Save - Writes the bundle to the file
+func Save(path string, b *Bundle) error {
+	data, err := yaml.Marshal(b)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// Validate - Checks that the schema compiles and that tuples and assertions are well-formed
+func (b *Bundle) Validate() error {
+	if b.Version != "" && b.Version != Version {
+		return fmt.Errorf("%w: %s", ErrUnsupportedVersion, b.Version)
+	}
+	if _, err := schema.NewSchemaFromStringDefinitions(true, b.Schema); err != nil {
+		return err
+	}
+	if _, err := b.tuples(); err != nil {
+		return err
+	}
+	for _, assertion := range b.Assertions {
+		for query := range assertion {
+			if _, err := tuple.NewQueryFromString(query); err != nil {
+				return fmt.Errorf("%w: %s", err, query)
+			}
+		}
+	}
+	return nil
+}
+
+// Verify - Evaluates the assertions against the bundle alone, on an in-memory environment
+func (b *Bundle) Verify(ctx context.Context) ([]Failure, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	
+	container := development.NewContainer()
+	
+	version, err := container.S.WriteSchema(ctx, _defaultTenant, b.Schema)
+	if err != nil {
+		return nil, err
+	}
+	
+	tuples, err := b.tuples()
+	if err != nil {
+		return nil, err
+	}
+	
+	if len(tuples) > 0 {
+		if _, err = container.R.WriteRelationships(ctx, _defaultTenant, tuples, version); err != nil {
+			return nil, err
+		}
+	}
+	
+	return b.check(func(request *base.PermissionCheckRequest) (*base.PermissionCheckResponse, error) {
+		request.TenantId = _defaultTenant
+		request.Metadata = &base.PermissionCheckRequestMetadata{
+			SchemaVersion: version,
+			SnapToken:     token.NewNoopToken().Encode().String(),
+			Depth:         _defaultDepth,
+		}
+		return container.P.CheckPermissions(ctx, request)
+	})
+}
+
+// Export - Builds a bundle from the schema and the tuples of the tenant.
+// An empty schema version exports the head version. Assertions are carried over as given.
+func Export(ctx context.Context, clients Clients, tenantID, schemaVersion string, assertions []map[string]bool) (*Bundle, error) {
+	sr, err := clients.Schema.Read(ctx, &base.SchemaReadRequest{
+		TenantId: tenantID,
+		Metadata: &base.SchemaReadRequestMetadata{
+			SchemaVersion: schemaVersion,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	
+	b := &Bundle{
+		Version:       Version,
+		SchemaVersion: schemaVersion,
+		Schema:        schema.ToString(sr.GetSchema()),
+		Tuples:        []string{},
+		Assertions:    assertions,
+	}
+	
+	// every tuple belongs to an entity of the schema, so reading each entity type reads them all
+	entityTypes := make([]string, 0, len(sr.GetSchema().GetEntityDefinitions()))
+	for entityType := range sr.GetSchema().GetEntityDefinitions() {
+		entityTypes = append(entityTypes, entityType)
+	}
+	sort.Strings(entityTypes)
+	
+	for _, entityType := range entityTypes {
+		ct := ""
+		for {
+			var rr *base.RelationshipReadResponse
+			rr, err = clients.Relationship.Read(ctx, &base.RelationshipReadRequest{
+				TenantId: tenantID,
+				Metadata: &base.RelationshipReadRequestMetadata{},
+				Filter: &base.TupleFilter{
+					Entity: &base.EntityFilter{Type: entityType},
+				},
+				PageSize:        _defaultPageSize,
+				ContinuousToken: ct,
+			})
+			if err != nil {
+				return nil, err
+			}
+			for _, tup := range rr.GetTuples() {
+				b.Tuples = append(b.Tuples, tuple.ToString(tup))
+			}
+			ct = rr.GetContinuousToken()
+			if ct == "" {
+				break
+			}
+		}
+	}
+	
+	return b, nil
+}
+
+// Import - Writes the schema and the tuples of the bundle to the tenant and evaluates the assertions against it.
+// The bundle is verified on its own first, so a bundle whose assertions do not hold is never imported.
+// Failures returned after the import come from data the tenant already had.
+func Import(ctx context.Context, clients Clients, tenantID string, b *Bundle) (version, snap string, failures []Failure, err error) {
+	failures, err = b.Verify(ctx)
+	if err != nil {
+		return "", "", nil, err
+	}
+	if len(failures) > 0 {
+		return "", "", failures, errors.New("bundle assertions failed")
+	}
+	
+	sw, err := clients.Schema.Write(ctx, &base.SchemaWriteRequest{
+		TenantId: tenantID,
+		Schema:   b.Schema,
+	})
+	if err != nil {
+		return "", "", nil, err
+	}
+	version = sw.GetSchemaVersion()
+	
+	tuples, err := b.tuples()
+	if err != nil {
+		return "", "", nil, err
+	}
+	
+	for i := 0; i < len(tuples); i += _defaultBatchSize {
+		end := i + _defaultBatchSize
+		if end > len(tuples) {
+			end = len(tuples)
+		}
+		var rw *base.RelationshipWriteResponse
+		rw, err = clients.Relationship.Write(ctx, &base.RelationshipWriteRequest{
+			TenantId: tenantID,
+			Metadata: &base.RelationshipWriteRequestMetadata{
+				SchemaVersion: version,
+			},
+			Tuples: tuples[i:end],
+		})
+		if err != nil {
+			return version, snap, nil, err
+		}
+		snap = rw.GetSnapToken()
+	}
+	
+	failures, err = b.check(func(request *base.PermissionCheckRequest) (*base.PermissionCheckResponse, error) {
+		request.TenantId = tenantID
+		request.Metadata = &base.PermissionCheckRequestMetadata{
+			SchemaVersion: version,
+			SnapToken:     snap,
+			Depth:         _defaultDepth,
+		}
+		return clients.Permission.Check(ctx, request)
+	})
+	return version, snap, failures, err
+}
+
+// tuples -
+func (b *Bundle) tuples() ([]*base.Tuple, error) {
+	tuples := make([]*base.Tuple, 0, len(b.Tuples))
+	for _, t := range b.Tuples {
+		tup, err := tuple.Tuple(t)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", err, t)
+		}
+		tuples = append(tuples, tup)
+	}
+	return tuples, nil
+}
+
+// check - Evaluates every assertion with the given check function
+func (b *Bundle) check(fn func(request *base.PermissionCheckRequest) (*base.PermissionCheckResponse, error)) ([]Failure, error) {
+	var failures []Failure
+	for _, assertion := range b.Assertions {
+		for query, expected := range assertion {
+			q, err := tuple.NewQueryFromString(query)
+			if err != nil {
+				return nil, err
+			}
+			res, err := fn(&base.PermissionCheckRequest{
+				Entity:     q.Entity,
+				Permission: q.Action,
+				Subject:    q.Subject,
+			})
+			if err != nil {
+				return nil, err
+			}
+			if (res.GetCan() == base.PermissionCheckResponse_RESULT_ALLOWED) != expected {
+				failures = append(failures, Failure{Query: query, Expected: expected})
+			}
+		}
+	}
+	return failures, nil
+}
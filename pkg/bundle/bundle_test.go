@@ -0,0 +1,103 @@
+package bundle
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// TestBundle -
+func TestBundle(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "bundle-suite")
+}
+
+var _ = Describe("bundle", func() {
+	newBundle := func() *Bundle {
+		return &Bundle{
+			Version: Version,
+			Schema: `
+			entity user {}
+			
+			entity organization {
+				relation admin @user
+				relation member @user
+			}
+			
+			entity repository {
+				relation parent @organization
+				relation owner @user
+				
+				action push = owner
+				action delete = owner or parent.admin
+			}
+			`,
+			Tuples: []string{
+				"organization:1#admin@user:1",
+				"repository:1#parent@organization:1#...",
+				"repository:1#owner@user:2",
+			},
+			Assertions: []map[string]bool{
+				{"can user:1 delete repository:1": true},
+				{"can user:1 push repository:1": false},
+				{"can user:2 push repository:1": true},
+			},
+		}
+	}
+	
+	Context("Validate", func() {
+		It("Case 1: Success", func() {
+			Expect(newBundle().Validate()).ShouldNot(HaveOccurred())
+		})
+		
+		It("Case 2: Fail", func() {
+			b := newBundle()
+			b.Version = "v0"
+			Expect(errors.Is(b.Validate(), ErrUnsupportedVersion)).Should(BeTrue())
+			
+			b = newBundle()
+			b.Tuples = append(b.Tuples, "repository:1#owner")
+			Expect(b.Validate()).Should(HaveOccurred())
+			
+			b = newBundle()
+			b.Assertions = append(b.Assertions, map[string]bool{"user:1 push repository:1": true})
+			Expect(b.Validate()).Should(HaveOccurred())
+		})
+	})
+	
+	Context("Verify", func() {
+		It("Case 1: Success", func() {
+			failures, err := newBundle().Verify(context.Background())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(failures).Should(BeEmpty())
+		})
+		
+		It("Case 2: Failed assertion", func() {
+			b := newBundle()
+			b.Assertions = append(b.Assertions, map[string]bool{"can user:1 push repository:1": true})
+			
+			failures, err := b.Verify(context.Background())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(failures).Should(Equal([]Failure{{Query: "can user:1 push repository:1", Expected: true}}))
+		})
+	})
+	
+	Context("Save and Load", func() {
+		It("Case 1", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "bundle.yaml")
+			
+			b := newBundle()
+			b.Schema = strings.TrimSpace(b.Schema)
+			Expect(Save(path, b)).ShouldNot(HaveOccurred())
+			
+			loaded, err := Load(path)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(loaded).Should(Equal(b))
+		})
+	})
+})
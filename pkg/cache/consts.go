@@ -5,6 +5,7 @@ type Engine string
 
 const (
 	RISTRETTO Engine = "ristretto"
+	REDIS     Engine = "redis"
 )
 
 // String - String converter
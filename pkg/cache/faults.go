@@ -0,0 +1,37 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/adminium/permify/pkg/faults"
+)
+
+// cacheWithFaults - Injects latency and failures into a cache. A failed get misses, a failed or partially failed set
+// does not store the entry.
+type cacheWithFaults struct {
+	delegate Cache
+	faults   *faults.Injector
+}
+
+// NewCacheWithFaults - Add fault injection to the cache
+func NewCacheWithFaults(delegate Cache, faults *faults.Injector) Cache {
+	return &cacheWithFaults{delegate: delegate, faults: faults}
+}
+
+func (c *cacheWithFaults) Get(key any) (any, bool) {
+	if err := c.faults.Before(context.Background()); err != nil {
+		return nil, false
+	}
+	return c.delegate.Get(key)
+}
+
+func (c *cacheWithFaults) Set(key, entry any, cost int64) bool {
+	if err := c.faults.Before(context.Background()); err != nil || c.faults.Drop() {
+		return false
+	}
+	return c.delegate.Set(key, entry, cost)
+}
+
+func (c *cacheWithFaults) Wait() { c.delegate.Wait() }
+
+func (c *cacheWithFaults) Close() { c.delegate.Close() }
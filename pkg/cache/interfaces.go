@@ -1,5 +1,9 @@
 package cache
 
+import (
+	"time"
+)
+
 // Cache - Defines an interface for a generic cache.
 type Cache interface {
 	Get(key any) (any, bool)
@@ -8,6 +12,11 @@ type Cache interface {
 	Close()
 }
 
+// TTLCache - Optionally implemented by caches whose entries can expire
+type TTLCache interface {
+	SetWithTTL(key, entry any, cost int64, ttl time.Duration) bool
+}
+
 // noopCache -
 type noopCache struct{}
 
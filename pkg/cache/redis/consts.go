@@ -0,0 +1,11 @@
+package redis
+
+import (
+	"time"
+)
+
+const (
+	_defaultPrefix  = "permify:"
+	_defaultTTL     = 10 * time.Minute
+	_defaultTimeout = 50 * time.Millisecond
+)
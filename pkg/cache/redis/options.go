@@ -0,0 +1,44 @@
+package redis
+
+import (
+	"time"
+)
+
+// Option - Option types for cache
+type Option func(redis *Redis)
+
+// Prefix - Defines the prefix of the keys, caches with the same prefix share their entries
+func Prefix(prefix string) Option {
+	return func(c *Redis) {
+		c.prefix = prefix
+	}
+}
+
+// TTL - Defines the lifetime of the entries that are set without one
+func TTL(ttl time.Duration) Option {
+	return func(c *Redis) {
+		c.ttl = ttl
+	}
+}
+
+// Timeout - Defines how long a command waits for redis, a command that times out misses
+func Timeout(timeout time.Duration) Option {
+	return func(c *Redis) {
+		c.timeout = timeout
+	}
+}
+
+// Credentials - Defines the user and the password of the connections
+func Credentials(username, password string) Option {
+	return func(c *Redis) {
+		c.options.Username = username
+		c.options.Password = password
+	}
+}
+
+// DB - Defines the database of the connections
+func DB(db int) Option {
+	return func(c *Redis) {
+		c.options.DB = db
+	}
+}
@@ -0,0 +1,135 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+	
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// Redis - Cache that the replicas share. The keys are strings and the entries byte slices, the entries expire after
+// their ttl instead of being evicted by cost.
+type Redis struct {
+	prefix  string
+	ttl     time.Duration
+	timeout time.Duration
+	options *goredis.Options
+	
+	*goredis.Client
+}
+
+// New - Creates new redis cache, the connections are opened by the first commands
+func New(address string, opts ...Option) (*Redis, error) {
+	rs := &Redis{
+		prefix:  _defaultPrefix,
+		ttl:     _defaultTTL,
+		timeout: _defaultTimeout,
+		options: &goredis.Options{Addr: address},
+	}
+	
+	// Custom options
+	for _, opt := range opts {
+		opt(rs)
+	}
+	
+	if address == "" {
+		return nil, errors.New("redis address is required")
+	}
+	
+	rs.options.ReadTimeout = rs.timeout
+	rs.options.WriteTimeout = rs.timeout
+	rs.Client = goredis.NewClient(rs.options)
+	
+	return rs, nil
+}
+
+// Get - Gets the entry of the key, errors of redis are misses
+func (r *Redis) Get(key any) (any, bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+	
+	value, err := r.Client.Get(ctx, r.key(key)).Bytes()
+	if err != nil {
+		return nil, false
+	}
+	return value, true
+}
+
+// Set - Sets the entry of the key with the default ttl, the entry must be a byte slice or a string
+func (r *Redis) Set(key, entry any, cost int64) bool {
+	return r.SetWithTTL(key, entry, cost, r.ttl)
+}
+
+// SetWithTTL - Sets the entry of the key, it expires after the ttl
+func (r *Redis) SetWithTTL(key, entry any, _ int64, ttl time.Duration) bool {
+	var value []byte
+	switch e := entry.(type) {
+	case []byte:
+		value = e
+	case string:
+		value = []byte(e)
+	default:
+		return false
+	}
+	
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+	
+	return r.Client.Set(ctx, r.key(key), value, ttl).Err() == nil
+}
+
+// Incr - Increments the counter of the key and returns its new value, counters do not expire
+func (r *Redis) Incr(key string) (uint64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+	
+	value, err := r.Client.Incr(ctx, r.key(key)).Result()
+	if err != nil {
+		return 0, err
+	}
+	return uint64(value), nil
+}
+
+// Counters - Values of the counters of the keys in one round trip, counters that were never incremented are zero
+func (r *Redis) Counters(keys ...string) ([]uint64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+	
+	prefixed := make([]string, 0, len(keys))
+	for _, key := range keys {
+		prefixed = append(prefixed, r.key(key))
+	}
+	
+	values, err := r.Client.MGet(ctx, prefixed...).Result()
+	if err != nil {
+		return nil, err
+	}
+	
+	counters := make([]uint64, len(values))
+	for i, value := range values {
+		s, ok := value.(string)
+		if !ok {
+			continue
+		}
+		if counters[i], err = strconv.ParseUint(s, 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	return counters, nil
+}
+
+// Wait - Redis commands are synchronous, there are no buffered sets to wait for
+func (r *Redis) Wait() {}
+
+// Close - Closes the connections
+func (r *Redis) Close() {
+	_ = r.Client.Close()
+}
+
+// key - Prefixed key
+func (r *Redis) key(key any) string {
+	return r.prefix + fmt.Sprint(key)
+}
@@ -0,0 +1,180 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+	
+	"github.com/adminium/permify/internal/schema"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+// _defaultCheckDepth - Depth of the checks built without one
+const _defaultCheckDepth = 20
+
+// CheckRequestBuilder - Builds a check request, the entity type, permission and subject are validated against the
+// schema when the request is built
+type CheckRequestBuilder struct {
+	schema  *Schema
+	request *base.PermissionCheckRequest
+	err     error
+}
+
+// Check - Starts a check of the permission on the entity
+func (s *Schema) Check(entityType, entityID, permission string) *CheckRequestBuilder {
+	return &CheckRequestBuilder{
+		schema: s,
+		request: &base.PermissionCheckRequest{
+			TenantId:   s.TenantID,
+			Entity:     &base.Entity{Type: entityType, Id: entityID},
+			Permission: permission,
+			Metadata: &base.PermissionCheckRequestMetadata{
+				SchemaVersion: s.Version,
+				Depth:         _defaultCheckDepth,
+			},
+		},
+	}
+}
+
+// Subject - Subject of the check, the relation is optional
+func (b *CheckRequestBuilder) Subject(subjectType, subjectID string, relation ...string) *CheckRequestBuilder {
+	b.request.Subject = &base.Subject{Type: subjectType, Id: subjectID, Relation: strings.Join(relation, "")}
+	return b
+}
+
+// Group - Checks the members of the group through the group, the subject is group_type:group_id#member
+func (b *CheckRequestBuilder) Group(groupType, groupID string) *CheckRequestBuilder {
+	b.request.Subject, b.err = tuple.GroupSubject(groupType, groupID)
+	return b
+}
+
+// SnapToken - Answers the check from the snapshot of the token
+func (b *CheckRequestBuilder) SnapToken(snap string) *CheckRequestBuilder {
+	b.request.Metadata.SnapToken = snap
+	return b
+}
+
+// Depth - Maximum depth of the check
+func (b *CheckRequestBuilder) Depth(depth int32) *CheckRequestBuilder {
+	b.request.Metadata.Depth = depth
+	return b
+}
+
+// Build - Validates the check against the schema
+func (b *CheckRequestBuilder) Build() (*base.PermissionCheckRequest, error) {
+	if b.err != nil {
+		return nil, fmt.Errorf("%s: %s", base.ErrorCode_ERROR_CODE_VALIDATION, b.err)
+	}
+	if b.request.GetSubject() == nil {
+		return nil, fmt.Errorf("%s: subject is required", base.ErrorCode_ERROR_CODE_VALIDATION)
+	}
+	if err := b.schema.validatePermission(b.request.GetEntity().GetType(), b.request.GetPermission()); err != nil {
+		return nil, err
+	}
+	if err := b.schema.validateSubject(b.request.GetSubject()); err != nil {
+		return nil, err
+	}
+	return b.request, nil
+}
+
+// TupleBuilder - Builds relationship tuples, each tuple is validated against the relation types of the schema
+// like the server validates written tuples
+type TupleBuilder struct {
+	schema *Schema
+	tuples []*base.Tuple
+	err    error
+}
+
+// Tuples - Starts a list of tuples
+func (s *Schema) Tuples() *TupleBuilder {
+	return &TupleBuilder{schema: s}
+}
+
+// Add - Adds the tuple entity_type:entity_id#relation@subject_type:subject_id#subject_relation, the subject
+// relation is optional. The first invalid tuple fails the build.
+func (b *TupleBuilder) Add(entityType, entityID, relation, subjectType, subjectID string, subjectRelation ...string) *TupleBuilder {
+	return b.add(&base.Tuple{
+		Entity:   &base.Entity{Type: entityType, Id: entityID},
+		Relation: relation,
+		Subject:  &base.Subject{Type: subjectType, Id: subjectID, Relation: strings.Join(subjectRelation, "")},
+	}, nil)
+}
+
+// Member - Adds the subject to the group, group_type:group_id#member@member_type:member_id#member_relation. A
+// member of the group type without a relation is a nested group, its members become members of the group.
+func (b *TupleBuilder) Member(groupType, groupID, memberType, memberID string, memberRelation ...string) *TupleBuilder {
+	t, err := tuple.Membership(groupType, groupID, &base.Subject{Type: memberType, Id: memberID, Relation: strings.Join(memberRelation, "")})
+	return b.add(t, err)
+}
+
+// Grant - Relates the members of the group to the entity, entity_type:entity_id#relation@group_type:group_id#member
+func (b *TupleBuilder) Grant(entityType, entityID, relation, groupType, groupID string) *TupleBuilder {
+	t, err := tuple.GroupGrant(&base.Entity{Type: entityType, Id: entityID}, relation, groupType, groupID)
+	return b.add(t, err)
+}
+
+// add - Adds the tuple built by a helper of the tuple package unless it or an earlier tuple is invalid
+func (b *TupleBuilder) add(t *base.Tuple, err error) *TupleBuilder {
+	if b.err != nil {
+		return b
+	}
+	if err != nil {
+		b.err = fmt.Errorf("%s: %s", base.ErrorCode_ERROR_CODE_VALIDATION, err)
+		return b
+	}
+	if b.err = b.schema.validateTuple(t); b.err == nil {
+		b.tuples = append(b.tuples, t)
+	}
+	return b
+}
+
+// Build - Validated tuples
+func (b *TupleBuilder) Build() ([]*base.Tuple, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	return b.tuples, nil
+}
+
+// WriteRequest - Validated tuples in a write request of the tenant of the schema
+func (b *TupleBuilder) WriteRequest() (*base.RelationshipWriteRequest, error) {
+	tuples, err := b.Build()
+	if err != nil {
+		return nil, err
+	}
+	return &base.RelationshipWriteRequest{
+		TenantId: b.schema.TenantID,
+		Metadata: &base.RelationshipWriteRequestMetadata{
+			SchemaVersion: b.schema.Version,
+		},
+		Tuples: tuples,
+	}, nil
+}
+
+// validateTuple - The relation exists and accepts the subject
+func (s *Schema) validateTuple(t *base.Tuple) error {
+	entity, err := s.entity(t.GetEntity().GetType())
+	if err != nil {
+		return err
+	}
+	
+	var rel *base.RelationDefinition
+	rel, err = schema.GetRelationByNameInEntityDefinition(entity, t.GetRelation())
+	if err != nil {
+		return fmt.Errorf("%s: %s#%s", base.ErrorCode_ERROR_CODE_RELATION_DEFINITION_NOT_FOUND, t.GetEntity().GetType(), t.GetRelation())
+	}
+	
+	var vt []string
+	for _, r := range rel.GetRelationReferences() {
+		if r.GetRelation() != "" {
+			vt = append(vt, fmt.Sprintf("%s#%s", r.GetType(), r.GetRelation()))
+		} else {
+			vt = append(vt, r.GetType())
+		}
+	}
+	
+	if err = tuple.ValidateSubjectType(t.GetSubject(), vt); err != nil {
+		return fmt.Errorf("%s: %s", base.ErrorCode_ERROR_CODE_SUBJECT_TYPE_NOT_FOUND, tuple.ToString(t))
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package client
+
+import (
+	"container/list"
+	"context"
+	"strings"
+	"sync"
+	"time"
+	
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+// checkMethod - Full grpc method of the permission check
+const checkMethod = "/base.v1.Permission/Check"
+
+// CheckCache - Least recently used cache of check responses. A check pinned to a snap token and a schema version
+// always has the same result, so it is kept until it is evicted; any other check is kept for the ttl since the
+// relationships or the schema it was answered from can change. Errors are never cached, and neither are checks that
+// exceeded their depth, since a deeper check of the same request can still be allowed or denied.
+type CheckCache struct {
+	mu      sync.Mutex
+	size    int
+	ttl     time.Duration
+	entries map[string]*list.Element
+	order   *list.List
+	
+	// now - clock of the cache, replaced in tests
+	now func() time.Time
+}
+
+// checkCacheEntry -
+type checkCacheEntry struct {
+	key      string
+	response *base.PermissionCheckResponse
+	// expiresAt - zero for pinned checks
+	expiresAt time.Time
+}
+
+// NewCheckCache - Creates new check cache
+func NewCheckCache(size int, ttl time.Duration) *CheckCache {
+	return &CheckCache{
+		size:    size,
+		ttl:     ttl,
+		entries: make(map[string]*list.Element, size),
+		order:   list.New(),
+		now:     time.Now,
+	}
+}
+
+// Get - Cached response of the check, nil when it is not cached or expired
+func (c *CheckCache) Get(request *base.PermissionCheckRequest) *base.PermissionCheckResponse {
+	key := checkCacheKey(request)
+	
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	
+	element, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+	entry := element.Value.(*checkCacheEntry)
+	if !entry.expiresAt.IsZero() && !c.now().Before(entry.expiresAt) {
+		c.order.Remove(element)
+		delete(c.entries, key)
+		return nil
+	}
+	c.order.MoveToFront(element)
+	return proto.Clone(entry.response).(*base.PermissionCheckResponse)
+}
+
+// Set - Caches the response of the check, evicting the least recently used one when the cache is full
+func (c *CheckCache) Set(request *base.PermissionCheckRequest, response *base.PermissionCheckResponse) {
+	if c.size <= 0 || response.GetCan() == base.PermissionCheckResponse_RESULT_DEPTH_EXCEEDED {
+		return
+	}
+	
+	entry := &checkCacheEntry{
+		key:      checkCacheKey(request),
+		response: proto.Clone(response).(*base.PermissionCheckResponse),
+	}
+	if !isPinned(request) {
+		if c.ttl <= 0 {
+			return
+		}
+		entry.expiresAt = c.now().Add(c.ttl)
+	}
+	
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	
+	if element, ok := c.entries[entry.key]; ok {
+		element.Value = entry
+		c.order.MoveToFront(element)
+		return
+	}
+	c.entries[entry.key] = c.order.PushFront(entry)
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*checkCacheEntry).key)
+	}
+}
+
+// Len - Number of cached responses, including the expired ones that were not read since
+func (c *CheckCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// UnaryClientInterceptor - Answers checks from the cache and caches the responses of the server
+func (c *CheckCache) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		request, ok := req.(*base.PermissionCheckRequest)
+		if method != checkMethod || !ok {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		
+		if cached := c.Get(request); cached != nil {
+			proto.Merge(reply.(proto.Message), cached)
+			return nil
+		}
+		
+		if err := invoker(ctx, method, req, reply, cc, opts...); err != nil {
+			return err
+		}
+		if response, ok := reply.(*base.PermissionCheckResponse); ok {
+			c.Set(request, response)
+		}
+		return nil
+	}
+}
+
+// isPinned - The check is answered from a fixed snapshot of both the relationships and the schema
+func isPinned(request *base.PermissionCheckRequest) bool {
+	return request.GetMetadata().GetSnapToken() != "" && request.GetMetadata().GetSchemaVersion() != ""
+}
+
+// checkCacheKey - tenant, check, the context its caveats are evaluated with and the snapshot it is answered from; the
+// depth does not change a successful result. The context is marshalled deterministically, so the same fields in any
+// order give the same key.
+func checkCacheKey(request *base.PermissionCheckRequest) string {
+	var b strings.Builder
+	b.WriteString(request.GetTenantId())
+	b.WriteString("|")
+	b.WriteString(tuple.EntityToString(request.GetEntity()))
+	b.WriteString("#")
+	b.WriteString(request.GetPermission())
+	b.WriteString("@")
+	b.WriteString(tuple.SubjectToString(request.GetSubject()))
+	b.WriteString("|")
+	b.WriteString(request.GetMetadata().GetSnapToken())
+	b.WriteString("|")
+	b.WriteString(request.GetMetadata().GetSchemaVersion())
+	if request.GetContext() != nil {
+		encoded, _ := proto.MarshalOptions{Deterministic: true}.Marshal(request.GetContext())
+		b.WriteString("|")
+		b.Write(encoded)
+	}
+	return b.String()
+}
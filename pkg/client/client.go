@@ -0,0 +1,64 @@
+// Package client is the go sdk of permify, a thin layer over the grpc clients of the api
+package client
+
+import (
+	"context"
+	
+	"google.golang.org/grpc"
+	
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// Client - Permify client
+type Client struct {
+	Permission   base.PermissionClient
+	Schema       base.SchemaClient
+	Relationship base.RelationshipClient
+	Tenancy      base.TenancyClient
+	
+	conn *grpc.ClientConn
+}
+
+// New - Connects to the permify server at the target with DefaultServiceConfig. Transport credentials must be given
+// as a dial option, e.g. WithDialOptions(grpc.WithTransportCredentials(insecure.NewCredentials())) for a local server.
+func New(ctx context.Context, target string, opts ...Option) (*Client, error) {
+	o := &options{
+		serviceConfig: DefaultServiceConfig,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	
+	dialOptions := o.dialOptions
+	if o.serviceConfig != "" {
+		dialOptions = append(dialOptions, grpc.WithDefaultServiceConfig(o.serviceConfig))
+	}
+	// the cached checks are answered before the timeout and the token are given to the call
+	if o.checkCache != nil {
+		dialOptions = append(dialOptions, grpc.WithChainUnaryInterceptor(o.checkCache.UnaryClientInterceptor()))
+	}
+	if o.timeout > 0 {
+		dialOptions = append(dialOptions, grpc.WithChainUnaryInterceptor(TimeoutUnaryClientInterceptor(o.timeout)))
+	}
+	if o.tokenSource != nil {
+		dialOptions = append(dialOptions, grpc.WithChainUnaryInterceptor(TokenUnaryClientInterceptor(o.tokenSource)), grpc.WithChainStreamInterceptor(TokenStreamClientInterceptor(o.tokenSource)))
+	}
+	
+	conn, err := grpc.DialContext(ctx, target, dialOptions...)
+	if err != nil {
+		return nil, err
+	}
+	
+	return &Client{
+		Permission:   base.NewPermissionClient(conn),
+		Schema:       base.NewSchemaClient(conn),
+		Relationship: base.NewRelationshipClient(conn),
+		Tenancy:      base.NewTenancyClient(conn),
+		conn:         conn,
+	}, nil
+}
+
+// Close - Closes the connection of the client
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
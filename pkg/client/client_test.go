@@ -0,0 +1,278 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/structpb"
+	
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+// TestClient -
+func TestClient(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "client-suite")
+}
+
+var _ = Describe("client", func() {
+	request := func(snap, version string) *base.PermissionCheckRequest {
+		return &base.PermissionCheckRequest{
+			TenantId:   "t1",
+			Entity:     &base.Entity{Type: "doc", Id: "1"},
+			Permission: "read",
+			Subject:    &base.Subject{Type: "user", Id: "1"},
+			Metadata: &base.PermissionCheckRequestMetadata{
+				SnapToken:     snap,
+				SchemaVersion: version,
+				Depth:         20,
+			},
+		}
+	}
+	
+	allowed := &base.PermissionCheckResponse{Can: base.PermissionCheckResponse_RESULT_ALLOWED}
+	
+	Context("CheckCache", func() {
+		It("Case 1: Unpinned checks expire after the ttl", func() {
+			now := time.Now()
+			cache := NewCheckCache(10, time.Second)
+			cache.now = func() time.Time { return now }
+			
+			cache.Set(request("", ""), allowed)
+			Expect(cache.Get(request("", "")).GetCan()).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+			Expect(cache.Get(request("snap", ""))).Should(BeNil())
+			
+			now = now.Add(time.Second)
+			Expect(cache.Get(request("", ""))).Should(BeNil())
+			Expect(cache.Len()).Should(Equal(0))
+		})
+		
+		It("Case 2: Pinned checks are kept until they are evicted", func() {
+			now := time.Now()
+			cache := NewCheckCache(2, time.Second)
+			cache.now = func() time.Time { return now }
+			
+			cache.Set(request("s1", "v1"), allowed)
+			cache.Set(request("s2", "v1"), allowed)
+			
+			now = now.Add(time.Hour)
+			Expect(cache.Get(request("s1", "v1"))).ShouldNot(BeNil())
+			
+			// s2 is the least recently used one
+			cache.Set(request("s3", "v1"), allowed)
+			Expect(cache.Get(request("s2", "v1"))).Should(BeNil())
+			Expect(cache.Get(request("s1", "v1"))).ShouldNot(BeNil())
+			Expect(cache.Get(request("s3", "v1"))).ShouldNot(BeNil())
+		})
+		
+		It("Case 3: The interceptor calls the server once for repeated checks", func() {
+			cache := NewCheckCache(10, time.Minute)
+			interceptor := cache.UnaryClientInterceptor()
+			
+			calls := 0
+			invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+				calls++
+				reply.(*base.PermissionCheckResponse).Can = base.PermissionCheckResponse_RESULT_ALLOWED
+				return nil
+			}
+			
+			for i := 0; i < 3; i++ {
+				response := &base.PermissionCheckResponse{}
+				Expect(interceptor(context.Background(), checkMethod, request("", ""), response, nil, invoker)).ShouldNot(HaveOccurred())
+				Expect(response.GetCan()).Should(Equal(base.PermissionCheckResponse_RESULT_ALLOWED))
+			}
+			Expect(calls).Should(Equal(1))
+		})
+		
+		It("Case 4: Checks that exceeded their depth are not cached", func() {
+			cache := NewCheckCache(10, time.Minute)
+			
+			cache.Set(request("s1", "v1"), &base.PermissionCheckResponse{Can: base.PermissionCheckResponse_RESULT_DEPTH_EXCEEDED})
+			Expect(cache.Get(request("s1", "v1"))).Should(BeNil())
+			Expect(cache.Len()).Should(Equal(0))
+		})
+		
+		It("Case 5: Checks with different contexts are cached apart", func() {
+			cache := NewCheckCache(10, time.Minute)
+			
+			withContext := func(fields map[string]interface{}) *base.PermissionCheckRequest {
+				r := request("s1", "v1")
+				c, err := structpb.NewStruct(fields)
+				Expect(err).ShouldNot(HaveOccurred())
+				r.Context = c
+				return r
+			}
+			
+			cache.Set(withContext(map[string]interface{}{"ip": "10.0.0.1", "region": "eu"}), allowed)
+			Expect(cache.Get(withContext(map[string]interface{}{"region": "eu", "ip": "10.0.0.1"}))).ShouldNot(BeNil())
+			Expect(cache.Get(withContext(map[string]interface{}{"ip": "10.0.0.2", "region": "eu"}))).Should(BeNil())
+			Expect(cache.Get(request("s1", "v1"))).Should(BeNil())
+		})
+	})
+	
+	Context("New", func() {
+		It("Case 1: Default service config is valid", func() {
+			c, err := New(context.Background(), "dns:///localhost:3478", WithDialOptions(grpc.WithTransportCredentials(insecure.NewCredentials())))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(c.Close()).ShouldNot(HaveOccurred())
+		})
+	})
+
+	Context("Interceptors", func() {
+		It("Case 1: Token is sent as a bearer token", func() {
+			interceptor := TokenUnaryClientInterceptor(StaticToken("secret"))
+			
+			var authorization []string
+			invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+				md, _ := metadata.FromOutgoingContext(ctx)
+				authorization = md.Get("authorization")
+				return nil
+			}
+			
+			Expect(interceptor(context.Background(), checkMethod, request("", ""), &base.PermissionCheckResponse{}, nil, invoker)).ShouldNot(HaveOccurred())
+			Expect(authorization).Should(Equal([]string{"Bearer secret"}))
+		})
+		
+		It("Case 2: Timeout does not replace the deadline of the caller", func() {
+			interceptor := TimeoutUnaryClientInterceptor(time.Second)
+			
+			var deadline time.Time
+			invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+				deadline, _ = ctx.Deadline()
+				return nil
+			}
+			
+			Expect(interceptor(context.Background(), checkMethod, request("", ""), &base.PermissionCheckResponse{}, nil, invoker)).ShouldNot(HaveOccurred())
+			Expect(time.Until(deadline)).Should(BeNumerically("<=", time.Second))
+			
+			ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+			defer cancel()
+			Expect(interceptor(ctx, checkMethod, request("", ""), &base.PermissionCheckResponse{}, nil, invoker)).ShouldNot(HaveOccurred())
+			Expect(time.Until(deadline)).Should(BeNumerically(">", time.Minute))
+		})
+		
+		It("Case 3: Deadline margin", func() {
+			parent, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+			
+			ctx, cancelChild := WithDeadlineMargin(parent, 10*time.Second)
+			defer cancelChild()
+			
+			parentDeadline, _ := parent.Deadline()
+			deadline, ok := ctx.Deadline()
+			Expect(ok).Should(BeTrue())
+			Expect(parentDeadline.Sub(deadline)).Should(Equal(10 * time.Second))
+			
+			ctx, cancelChild = WithDeadlineMargin(context.Background(), 10*time.Second)
+			defer cancelChild()
+			_, ok = ctx.Deadline()
+			Expect(ok).Should(BeFalse())
+		})
+	})
+	
+	Context("Builders", func() {
+		sch, err := NewSchema("t1", "v1", `
+		entity user {}
+		
+		entity organization {
+			relation member @user
+		}
+		
+		entity doc {
+			relation org @organization
+			relation viewer @user @organization#member
+			
+			action read = viewer or org.member
+		}
+		`)
+		Expect(err).ShouldNot(HaveOccurred())
+		
+		It("Case 1: Check request", func() {
+			request, err := sch.Check("doc", "1", "read").Subject("user", "1").SnapToken("snap").Build()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(request.GetTenantId()).Should(Equal("t1"))
+			Expect(request.GetMetadata().GetSchemaVersion()).Should(Equal("v1"))
+			Expect(request.GetMetadata().GetSnapToken()).Should(Equal("snap"))
+			Expect(request.GetMetadata().GetDepth()).Should(Equal(int32(20)))
+			
+			_, err = sch.Check("doc", "1", "reed").Subject("user", "1").Build()
+			Expect(err).Should(MatchError(ContainSubstring("doc#reed")))
+			
+			_, err = sch.Check("dok", "1", "read").Subject("user", "1").Build()
+			Expect(err).Should(MatchError(ContainSubstring("dok")))
+			
+			_, err = sch.Check("doc", "1", "read").Subject("organization", "1", "membr").Build()
+			Expect(err).Should(MatchError(ContainSubstring("organization#membr")))
+			
+			_, err = sch.Check("doc", "1", "read").Build()
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("Case 2: Tuples", func() {
+			request, err := sch.Tuples().
+				Add("doc", "1", "viewer", "user", "1").
+				Add("doc", "1", "viewer", "organization", "1", "member").
+				Add("doc", "1", "org", "organization", "1").
+				WriteRequest()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(request.GetTuples()).Should(HaveLen(3))
+			Expect(request.GetMetadata().GetSchemaVersion()).Should(Equal("v1"))
+			
+			_, err = sch.Tuples().Add("doc", "1", "viewer", "organization", "1").Add("doc", "1", "viewer", "user", "1").Build()
+			Expect(err).Should(MatchError(ContainSubstring(base.ErrorCode_ERROR_CODE_SUBJECT_TYPE_NOT_FOUND.String())))
+			
+			_, err = sch.Tuples().Add("doc", "1", "owner", "user", "1").Build()
+			Expect(err).Should(MatchError(ContainSubstring("doc#owner")))
+		})
+		
+		It("Case 3: Group memberships, grants and checks", func() {
+			groups, err := NewSchema("t1", "v1", `
+			entity user {}
+			
+			entity group {
+				relation member @user @group#member
+			}
+			
+			entity doc {
+				relation viewer @user @group#member
+				
+				action read = viewer
+			}
+			`)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			tuples, err := groups.Tuples().
+				Member("group", "eng", "user", "1").
+				Member("group", "eng", "group", "backend").
+				Grant("doc", "1", "viewer", "group", "eng").
+				Build()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(tuple.ToString(tuples[0])).Should(Equal("group:eng#member@user:1"))
+			Expect(tuple.ToString(tuples[1])).Should(Equal("group:eng#member@group:backend#member"))
+			Expect(tuple.ToString(tuples[2])).Should(Equal("doc:1#viewer@group:eng#member"))
+			
+			request, err := groups.Check("doc", "1", "read").Group("group", "eng").Build()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(tuple.SubjectToString(request.GetSubject())).Should(Equal("group:eng#member"))
+			
+			_, err = groups.Tuples().Member("group", "group:eng", "user", "1").Build()
+			Expect(err).Should(MatchError(ContainSubstring(tuple.ErrInvalidGroup.Error())))
+			
+			_, err = groups.Tuples().Member("group", "eng", "group", "eng").Build()
+			Expect(err).Should(MatchError(ContainSubstring(tuple.ErrInvalidMember.Error())))
+			
+			_, err = sch.Check("doc", "1", "read").Group("group", "eng").Build()
+			Expect(err).Should(MatchError(ContainSubstring("group")))
+			
+			_, err = groups.Check("doc", "1", "read").Group("user", "1").Build()
+			Expect(err).Should(MatchError(ContainSubstring(tuple.ErrInvalidGroup.Error())))
+		})
+	})
+})
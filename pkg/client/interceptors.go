@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"time"
+	
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// TokenSource - Returns the token sent with every call, it is called for each call so that tokens can be rotated
+type TokenSource func(ctx context.Context) (string, error)
+
+// StaticToken - Token source of a token that never changes, e.g. a preshared key
+func StaticToken(token string) TokenSource {
+	return func(context.Context) (string, error) {
+		return token, nil
+	}
+}
+
+// withToken - Adds the token of the source to the outgoing metadata as a bearer token
+func withToken(ctx context.Context, source TokenSource) (context.Context, error) {
+	token, err := source(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token), nil
+}
+
+// TokenUnaryClientInterceptor - Sends the token of the source with every unary call
+func TokenUnaryClientInterceptor(source TokenSource) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, err := withToken(ctx, source)
+		if err != nil {
+			return err
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// TokenStreamClientInterceptor - Sends the token of the source with every stream
+func TokenStreamClientInterceptor(source TokenSource) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx, err := withToken(ctx, source)
+		if err != nil {
+			return nil, err
+		}
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
+
+// TimeoutUnaryClientInterceptor - Gives unary calls without a deadline the timeout, so that a call never waits
+// forever on an unreachable server. Deadlines set by the caller are kept.
+func TimeoutUnaryClientInterceptor(timeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if _, ok := ctx.Deadline(); !ok {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// WithDeadlineMargin - Propagates the deadline of an incoming request to the permify calls made while handling it,
+// leaving the margin to the handler to use the results. A context without a deadline is returned as it is.
+func WithDeadlineMargin(ctx context.Context, margin time.Duration) (context.Context, context.CancelFunc) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return context.WithCancel(ctx)
+	}
+	return context.WithDeadline(ctx, deadline.Add(-margin))
+}
@@ -0,0 +1,55 @@
+package client
+
+import (
+	"time"
+	
+	"google.golang.org/grpc"
+)
+
+// options -
+type options struct {
+	dialOptions   []grpc.DialOption
+	serviceConfig string
+	checkCache    *CheckCache
+	tokenSource   TokenSource
+	timeout       time.Duration
+}
+
+// Option - Option type
+type Option func(*options)
+
+// WithDialOptions - Adds grpc dial options to the connection of the client
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(o *options) {
+		o.dialOptions = append(o.dialOptions, opts...)
+	}
+}
+
+// WithCheckCache - Answers repeated identical checks from an in-process cache of the given number of entries.
+// Checks pinned to a snap token and a schema version are cached until they are evicted, the others for the ttl.
+func WithCheckCache(size int, ttl time.Duration) Option {
+	return func(o *options) {
+		o.checkCache = NewCheckCache(size, ttl)
+	}
+}
+
+// WithServiceConfig - Replaces DefaultServiceConfig, an empty config falls back to the default of grpc
+func WithServiceConfig(config string) Option {
+	return func(o *options) {
+		o.serviceConfig = config
+	}
+}
+
+// WithToken - Sends the token of the source as a bearer token with every call, e.g. WithToken(StaticToken(key))
+func WithToken(source TokenSource) Option {
+	return func(o *options) {
+		o.tokenSource = source
+	}
+}
+
+// WithTimeout - Gives unary calls without a deadline the timeout
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.timeout = timeout
+	}
+}
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	
+	"github.com/adminium/permify/internal/schema"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+// Schema - Local copy of the schema of a tenant, the requests it builds are validated against it before they are sent
+type Schema struct {
+	TenantID string
+	// Version - version the requests are pinned to, empty when the head version was loaded
+	Version    string
+	Definition *base.SchemaDefinition
+}
+
+// LoadSchema - Reads the schema of the tenant, an empty version reads the head version
+func (c *Client) LoadSchema(ctx context.Context, tenantID, version string) (*Schema, error) {
+	response, err := c.Schema.Read(ctx, &base.SchemaReadRequest{
+		TenantId: tenantID,
+		Metadata: &base.SchemaReadRequestMetadata{
+			SchemaVersion: version,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Schema{
+		TenantID:   tenantID,
+		Version:    version,
+		Definition: response.GetSchema(),
+	}, nil
+}
+
+// NewSchema - Compiles a schema from its definitions, e.g. the schema file of the application, so that requests can
+// be validated without a server
+func NewSchema(tenantID, version string, definitions ...string) (*Schema, error) {
+	definition, err := schema.NewSchemaFromStringDefinitions(true, definitions...)
+	if err != nil {
+		return nil, err
+	}
+	return &Schema{
+		TenantID:   tenantID,
+		Version:    version,
+		Definition: definition,
+	}, nil
+}
+
+// entity - Definition of the entity type
+func (s *Schema) entity(entityType string) (*base.EntityDefinition, error) {
+	entity, err := schema.GetEntityByName(s.Definition, entityType)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", base.ErrorCode_ERROR_CODE_ENTITY_DEFINITION_NOT_FOUND, entityType)
+	}
+	return entity, nil
+}
+
+// validatePermission - The relation or action exists in the entity type
+func (s *Schema) validatePermission(entityType, permission string) error {
+	entity, err := s.entity(entityType)
+	if err != nil {
+		return err
+	}
+	if _, ok := entity.GetReferences()[permission]; !ok {
+		return fmt.Errorf("%s: %s#%s", base.ErrorCode_ERROR_CODE_RELATION_DEFINITION_NOT_FOUND, entityType, permission)
+	}
+	return nil
+}
+
+// validateSubject - The subject type exists and so does its relation, if it has one
+func (s *Schema) validateSubject(subject *base.Subject) error {
+	if tuple.IsSubjectUser(subject) || subject.GetRelation() == "" || subject.GetRelation() == tuple.ELLIPSIS {
+		_, err := s.entity(subject.GetType())
+		return err
+	}
+	return s.validatePermission(subject.GetType(), subject.GetRelation())
+}
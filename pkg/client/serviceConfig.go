@@ -0,0 +1,25 @@
+package client
+
+// DefaultServiceConfig - Balances the calls of the client over all addresses the target resolves to and retries the
+// read-only calls when the server is unavailable. Writes and deletes are not retried, a write that reached the server
+// before the connection failed would be applied twice. Round robin only spreads the calls when the resolver returns
+// several addresses, e.g. "dns:///permify.default.svc.cluster.local:3478" for a headless kubernetes service.
+const DefaultServiceConfig = `{
+	"loadBalancingConfig": [{"round_robin": {}}],
+	"methodConfig": [{
+		"name": [
+			{"service": "base.v1.Permission"},
+			{"service": "base.v1.Schema", "method": "Read"},
+			{"service": "base.v1.Relationship", "method": "Read"},
+			{"service": "base.v1.Tenancy", "method": "List"},
+			{"service": "base.v1.Welcome"}
+		],
+		"retryPolicy": {
+			"maxAttempts": 4,
+			"initialBackoff": "0.1s",
+			"maxBackoff": "2s",
+			"backoffMultiplier": 2,
+			"retryableStatusCodes": ["UNAVAILABLE"]
+		}
+	}]
+}`
@@ -0,0 +1,198 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	
+	"github.com/gookit/color"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	
+	"github.com/adminium/permify/pkg/bundle"
+	"github.com/adminium/permify/pkg/development/validation"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+const (
+	endpoint      = "endpoint"
+	tenant        = "tenant"
+	apiToken      = "token"
+	tlsEnabled    = "tls"
+	schemaVersion = "schema-version"
+	assertions    = "assertions"
+	output        = "output"
+)
+
+// NewBundleCommand - Creates new bundle command
+func NewBundleCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bundle",
+		Short: "export and import policy bundles (schema, relationships and assertions)",
+		Args:  cobra.NoArgs,
+	}
+	
+	cmd.PersistentFlags().String(endpoint, "localhost:3478", "grpc endpoint of the permify server")
+	cmd.PersistentFlags().String(tenant, "t1", "tenant id")
+	cmd.PersistentFlags().String(apiToken, "", "bearer token sent with every request")
+	cmd.PersistentFlags().Bool(tlsEnabled, false, "connect to the server over tls")
+	
+	cmd.AddCommand(NewBundleExportCommand())
+	cmd.AddCommand(NewBundleImportCommand())
+	
+	return cmd
+}
+
+// NewBundleExportCommand - Creates new bundle export command
+func NewBundleExportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "export the schema and relationships of a tenant to a bundle",
+		RunE:  bundleExport(),
+		Args:  cobra.NoArgs,
+	}
+	
+	cmd.Flags().String(schemaVersion, "", "schema version to export, head version if empty")
+	cmd.Flags().String(assertions, "", "validation file whose assertions are packaged into the bundle")
+	cmd.Flags().String(output, "bundle.yaml", "bundle file")
+	
+	return cmd
+}
+
+// NewBundleImportCommand - Creates new bundle import command
+func NewBundleImportCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import <file>",
+		Short: "import a bundle into a tenant after checking its assertions",
+		RunE:  bundleImport(),
+		Args:  cobra.ExactArgs(1),
+	}
+}
+
+// bundleExport - permify bundle export command
+func bundleExport() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		flags, err := getFlags(cmd, []string{tenant, schemaVersion, assertions, output})
+		if err != nil {
+			return err
+		}
+		
+		var as []map[string]bool
+		if flags[assertions] != "" {
+			shape := &validation.Shape{}
+			if err = validation.NewFileDecoder(flags[assertions]).Decode(shape); err != nil {
+				return err
+			}
+			as = shape.Assertions
+		}
+		
+		ctx, clients, closer, err := bundleClients(cmd)
+		if err != nil {
+			return err
+		}
+		defer closer()
+		
+		b, err := bundle.Export(ctx, clients, flags[tenant], flags[schemaVersion], as)
+		if err != nil {
+			color.Danger.Println("export failed: " + err.Error())
+			return err
+		}
+		
+		if err = bundle.Save(flags[output], b); err != nil {
+			return err
+		}
+		
+		color.Success.Printf("bundle successfully exported to %s (%d relationships): ✓ ✅ \n", flags[output], len(b.Tuples))
+		return nil
+	}
+}
+
+// bundleImport - permify bundle import command
+func bundleImport() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		flags, err := getFlags(cmd, []string{tenant})
+		if err != nil {
+			return err
+		}
+		
+		b, err := bundle.Load(args[0])
+		if err != nil {
+			return err
+		}
+		
+		ctx, clients, closer, err := bundleClients(cmd)
+		if err != nil {
+			return err
+		}
+		defer closer()
+		
+		version, snap, failures, err := bundle.Import(ctx, clients, flags[tenant], b)
+		for _, failure := range failures {
+			color.Danger.Println(failure.String())
+		}
+		if err != nil {
+			color.Danger.Println("import failed: " + err.Error())
+			return err
+		}
+		
+		color.Success.Printf("bundle successfully imported, schema version: %s, snap token: %s ✓ ✅ \n", version, snap)
+		
+		if len(failures) > 0 {
+			return fmt.Errorf("%d assertions failed on tenant %s", len(failures), flags[tenant])
+		}
+		return nil
+	}
+}
+
+// bundleClients - Connects to the permify server given by the persistent flags
+func bundleClients(cmd *cobra.Command) (context.Context, bundle.Clients, func(), error) {
+	ctx, conn, err := dial(cmd)
+	if err != nil {
+		return nil, bundle.Clients{}, nil, err
+	}
+	
+	return ctx, bundle.Clients{
+		Schema:       base.NewSchemaClient(conn),
+		Relationship: base.NewRelationshipClient(conn),
+		Permission:   base.NewPermissionClient(conn),
+	}, func() { _ = conn.Close() }, nil
+}
+
+// dial - Connects to the permify server given by the endpoint, token and tls flags, the returned context carries the
+// token
+func dial(cmd *cobra.Command) (context.Context, *grpc.ClientConn, error) {
+	ctx := context.Background()
+	
+	flags, err := getFlags(cmd, []string{endpoint, apiToken})
+	if err != nil {
+		return nil, nil, err
+	}
+	if flags[endpoint] == "" {
+		return nil, nil, errors.New("endpoint is required")
+	}
+	
+	useTLS, err := cmd.Flags().GetBool(tlsEnabled)
+	if err != nil {
+		return nil, nil, err
+	}
+	
+	var options []grpc.DialOption
+	if useTLS {
+		options = append(options, grpc.WithTransportCredentials(credentials.NewClientTLSFromCert(nil, "")))
+	} else {
+		options = append(options, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+	
+	conn, err := grpc.DialContext(ctx, flags[endpoint], options...)
+	if err != nil {
+		return nil, nil, err
+	}
+	
+	if flags[apiToken] != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+flags[apiToken])
+	}
+	return ctx, conn, nil
+}
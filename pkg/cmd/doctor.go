@@ -0,0 +1,415 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+	
+	"github.com/dustin/go-humanize"
+	"github.com/gookit/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	
+	"github.com/adminium/permify/internal/commands"
+	"github.com/adminium/permify/internal/config"
+	"github.com/adminium/permify/internal/decisions"
+	"github.com/adminium/permify/internal/factories"
+	"github.com/adminium/permify/internal/keys"
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/internal/schema"
+	"github.com/adminium/permify/pkg/cache"
+	"github.com/adminium/permify/pkg/cache/redis"
+	"github.com/adminium/permify/pkg/cache/ristretto"
+	"github.com/adminium/permify/pkg/database"
+	"github.com/adminium/permify/pkg/events"
+	"github.com/adminium/permify/pkg/logger"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/storage"
+	"github.com/adminium/permify/pkg/telemetry"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+const (
+	schemaFile = "schema"
+	canary     = "canary"
+	timeout    = "timeout"
+)
+
+// errDoctorSkipped - The check does not apply to the configuration or depends on a check that failed
+var errDoctorSkipped = errors.New("skipped")
+
+// doctorResult - Outcome of a check of the doctor, err is nil when it passed
+type doctorResult struct {
+	name   string
+	detail string
+	err    error
+}
+
+// NewDoctorCommand - Creates new doctor command
+func NewDoctorCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "check the configuration, storage, caches, migrations and schema of the server before it is served",
+		RunE:  doctor(),
+		Args:  cobra.NoArgs,
+	}
+	
+	cmd.Flags().String(tenant, "t1", "tenant id whose head schema is compiled and checked by the canary")
+	cmd.Flags().String(schemaFile, "", "schema file compiled instead of the head schema of the tenant")
+	cmd.Flags().String(canary, "", "check run against the storage, e.g. \"can user:1 view document:1\"")
+	cmd.Flags().Duration(timeout, 5*time.Second, "time limit of every check")
+	
+	return cmd
+}
+
+// doctor - permify doctor command. The configuration is read from the config file and the environment like the
+// serve command, the report lists every check and the command fails when one of them does.
+func doctor() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		flags, err := getFlags(cmd, []string{tenant, schemaFile, canary})
+		if err != nil {
+			return err
+		}
+		limit, err := cmd.Flags().GetDuration(timeout)
+		if err != nil {
+			return err
+		}
+		
+		l := logger.New("error")
+		var report []doctorResult
+		
+		cfg, err := config.NewConfig()
+		if err == nil {
+			err = viper.Unmarshal(cfg)
+		}
+		if err == nil {
+			err = validateConfig(cfg)
+		}
+		report = append(report, doctorResult{name: "config", err: err})
+		if err != nil {
+			printDoctorReport(report)
+			return errors.New("configuration is invalid")
+		}
+		
+		var db database.Database
+		run := func(name string, f func(ctx context.Context) (string, error)) {
+			ctx, cancel := context.WithTimeout(cmd.Context(), limit)
+			defer cancel()
+			detail, err := f(ctx)
+			report = append(report, doctorResult{name: name, detail: detail, err: err})
+		}
+		
+		run("storage", func(ctx context.Context) (string, error) {
+			// a raft node would join its cluster, it is checked by serving it
+			if cfg.Database.Engine == database.RAFT.String() {
+				return "", errDoctorSkipped
+			}
+			d, err := factories.DatabaseFactory(cfg.Database)
+			if err != nil {
+				return "", err
+			}
+			ready, err := d.IsReady(ctx)
+			if err == nil && !ready {
+				err = errors.New("database is not ready")
+			}
+			if err != nil {
+				d.Close()
+				return "", err
+			}
+			db = d
+			return cfg.Database.Engine, nil
+		})
+		if db != nil {
+			defer db.Close()
+		}
+		
+		run("cache", func(ctx context.Context) (string, error) {
+			return checkCaches(ctx, cfg)
+		})
+		
+		run("migrations", func(ctx context.Context) (string, error) {
+			if db == nil {
+				return "", errDoctorSkipped
+			}
+			versions, err := repositories.PendingMigrations(cfg.Database)
+			if err != nil {
+				return "", err
+			}
+			if len(versions) > 0 {
+				return "", fmt.Errorf("%d migrations are not applied, the first is %d", len(versions), versions[0])
+			}
+			return "up to date", nil
+		})
+		
+		var version string
+		run("schema", func(ctx context.Context) (string, error) {
+			if flags[schemaFile] != "" {
+				source, err := os.ReadFile(flags[schemaFile])
+				if err != nil {
+					return "", err
+				}
+				sch, err := schema.NewSchemaFromStringDefinitions(true, string(source))
+				if err != nil {
+					return "", err
+				}
+				return fmt.Sprintf("%s, %d entities", flags[schemaFile], len(sch.GetEntityDefinitions())), nil
+			}
+			if db == nil {
+				return "", errDoctorSkipped
+			}
+			sr := factories.SchemaReaderFactory(db, l)
+			version, err = sr.HeadVersion(ctx, flags[tenant])
+			if err != nil {
+				return "", err
+			}
+			definitions, err := sr.ReadSchemaString(ctx, flags[tenant], version)
+			if err != nil {
+				return "", err
+			}
+			sch, err := schema.NewSchemaFromStringDefinitions(true, strings.Join(definitions, "\n\n"))
+			if err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("head version %s of %s, %d entities", version, flags[tenant], len(sch.GetEntityDefinitions())), nil
+		})
+		
+		run("canary", func(ctx context.Context) (string, error) {
+			if flags[canary] == "" || db == nil {
+				return "", errDoctorSkipped
+			}
+			// the schema of the file is not written, the canary checks the head schema of the tenant
+			if version == "" {
+				version, err = factories.SchemaReaderFactory(db, l).HeadVersion(ctx, flags[tenant])
+				if err != nil {
+					return "", err
+				}
+			}
+			return runCanary(ctx, db, l, flags[tenant], version, flags[canary])
+		})
+		
+		printDoctorReport(report)
+		for _, r := range report {
+			if r.err != nil && !errors.Is(r.err, errDoctorSkipped) {
+				return errors.New("the server is not ready")
+			}
+		}
+		return nil
+	}
+}
+
+// validateConfig - Settings that would make the server fail or misbehave once it is served
+func validateConfig(cfg *config.Config) error {
+	var problems []string
+	
+	switch cfg.Database.Engine {
+	case database.MEMORY.String():
+	case database.RAFT.String():
+		if cfg.Database.Raft.NodeID == "" || cfg.Database.Raft.Address == "" {
+			problems = append(problems, "raft needs a node id and an address")
+		}
+	case database.POSTGRES.String(), database.COCKROACH.String():
+		if cfg.Database.URI == "" {
+			problems = append(problems, "database uri is required")
+		}
+	default:
+		if _, ok := storage.Lookup(cfg.Database.Engine); !ok {
+			problems = append(problems, fmt.Sprintf("%s database engine is unsupported", cfg.Database.Engine))
+		}
+	}
+	
+	if cfg.Database.GarbageCollection.Enabled && cfg.Database.Engine != database.POSTGRES.String() {
+		if _, ok := storage.Lookup(cfg.Database.Engine); !ok {
+			problems = append(problems, fmt.Sprintf("%s database engine does not keep the deleted tuples to collect", cfg.Database.Engine))
+		}
+	}
+	
+	if cfg.Database.Sweeper.Enabled && (cfg.Database.Engine == database.COCKROACH.String() || cfg.Database.Engine == database.RAFT.String()) {
+		problems = append(problems, fmt.Sprintf("%s database engine can not sweep the expired tuples", cfg.Database.Engine))
+	}
+	
+	for i, t := range []config.TLSConfig{cfg.Server.GRPC.TLSConfig, cfg.Server.HTTP.TLSConfig} {
+		if !t.Enabled || (i == 1 && !cfg.Server.HTTP.Enabled) {
+			continue
+		}
+		for _, path := range []string{t.CertPath, t.KeyPath} {
+			if _, err := os.Stat(path); err != nil {
+				problems = append(problems, "tls: "+err.Error())
+			}
+		}
+	}
+	
+	if cfg.Authn.Enabled {
+		for _, method := range strings.Split(cfg.Authn.Method, ",") {
+			switch strings.TrimSpace(method) {
+			case "preshared":
+				if len(cfg.Authn.Preshared.Keys) == 0 {
+					problems = append(problems, "preshared authn needs keys")
+				}
+			case "oidc":
+				if cfg.Authn.Oidc.Issuer == "" || cfg.Authn.Oidc.ClientId == "" {
+					problems = append(problems, "oidc authn needs an issuer and a client id")
+				}
+				if cfg.Authn.Oidc.ScopeClaim != "" && cfg.Authn.Oidc.Scopes.Read == "" && cfg.Authn.Oidc.Scopes.Write == "" {
+					problems = append(problems, "oidc authn needs a read or a write scope to map the scope claim")
+				}
+			default:
+				problems = append(problems, fmt.Sprintf("%s authn method is unsupported", method))
+			}
+		}
+	}
+	
+	if cfg.Server.Depth.Max > 0 && cfg.Server.Depth.Default > cfg.Server.Depth.Max {
+		problems = append(problems, "default depth exceeds the maximum depth")
+	}
+	
+	if r := cfg.Server.RateLimit; r.Enabled && (r.Read < 0 || r.Write < 0 || r.Check < 0 || r.Burst < 0) {
+		problems = append(problems, "rate limits must not be negative")
+	}
+	
+	if b := cfg.Permission.DeadlineBudget; b.Enabled {
+		for _, share := range []float64{b.Schema, b.Query, b.Check} {
+			if share < 0 || share > 1 {
+				problems = append(problems, "deadline budget shares must be between 0 and 1")
+				break
+			}
+		}
+	}
+	
+	if _, err := humanize.ParseBytes(cfg.Permission.MemoryLimit); err != nil {
+		problems = append(problems, fmt.Sprintf("memory limit %q is not a size", cfg.Permission.MemoryLimit))
+	}
+	
+	if d := cfg.Permission.DecisionLog; d.Enabled {
+		if d.SampleRate < 0 || d.SampleRate > 1 {
+			problems = append(problems, fmt.Sprintf("decision sample rate %v is not between 0 and 1", d.SampleRate))
+		}
+		for _, operation := range d.Operations {
+			switch operation {
+			case decisions.OperationCheck, decisions.OperationExpand, decisions.OperationLookupEntity, decisions.OperationLookupSubject:
+			default:
+				problems = append(problems, fmt.Sprintf("%s decision operation is unsupported", operation))
+			}
+		}
+		if d.Postgres && cfg.Database.Engine != database.POSTGRES.String() && cfg.Database.Engine != database.COCKROACH.String() {
+			problems = append(problems, "decisions are written to postgres or cockroach databases only")
+		}
+	}
+	
+	if t := cfg.Permission.TraceSampling; t.Enabled {
+		if t.SampleRate < 0 || t.SampleRate > 1 {
+			problems = append(problems, fmt.Sprintf("trace sample rate %v is not between 0 and 1", t.SampleRate))
+		}
+		if t.QueueSize <= 0 {
+			problems = append(problems, "trace sampling queue size must be positive")
+		}
+	}
+	
+	if cfg.Permission.SharedCache.Enabled && cfg.Permission.SharedCache.Engine != cache.REDIS.String() {
+		problems = append(problems, fmt.Sprintf("%s shared cache is unsupported", cfg.Permission.SharedCache.Engine))
+	}
+	
+	if cfg.Distributed.Enabled && (cfg.Distributed.Region == "" || cfg.Distributed.PrimaryRegion == "") {
+		problems = append(problems, "distributed deployment needs a region and a primary region")
+	}
+	
+	if e := cfg.Events; e.Enabled {
+		switch e.Broker {
+		case events.KAFKA.String():
+			if len(e.Kafka.Brokers) == 0 || e.Kafka.Topic == "" {
+				problems = append(problems, "kafka events need brokers and a topic")
+			}
+		case events.NATS.String():
+			if e.NATS.URL == "" || e.NATS.Subject == "" {
+				problems = append(problems, "nats events need a url and a subject")
+			}
+		default:
+			problems = append(problems, fmt.Sprintf("%s event broker is unsupported", e.Broker))
+		}
+		if cfg.Database.Engine == database.COCKROACH.String() {
+			problems = append(problems, "events are not published from cockroach databases, their changes can not be watched")
+		}
+	}
+	
+	if len(problems) > 0 {
+		return errors.New(strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// checkCaches - Creates the local caches with their sizes and pings the shared cache
+func checkCaches(ctx context.Context, cfg *config.Config) (string, error) {
+	for _, c := range []config.Cache{cfg.Schema.Cache, cfg.Permission.Cache} {
+		local, err := ristretto.New(ristretto.NumberOfCounters(c.NumberOfCounters), ristretto.MaxCost(c.MaxCost))
+		if err != nil {
+			return "", err
+		}
+		local.Close()
+	}
+	if !cfg.Permission.SharedCache.Enabled {
+		return "local", nil
+	}
+	shared, err := redis.New(cfg.Permission.SharedCache.Address,
+		redis.Credentials(cfg.Permission.SharedCache.Username, cfg.Permission.SharedCache.Password),
+		redis.DB(cfg.Permission.SharedCache.DB),
+	)
+	if err != nil {
+		return "", err
+	}
+	defer shared.Close()
+	if err = shared.Ping(ctx).Err(); err != nil {
+		return "", err
+	}
+	return "local and " + cfg.Permission.SharedCache.Address, nil
+}
+
+// runCanary - Checks the query against the storage at the head snapshot, the check must only succeed, whatever
+// its result
+func runCanary(ctx context.Context, db database.Database, l logger.Interface, tenantID, version, query string) (string, error) {
+	q, err := tuple.NewQueryFromString(query)
+	if err != nil {
+		return "", err
+	}
+	rr := factories.RelationshipReaderFactory(db, l)
+	st, err := rr.HeadSnapshot(ctx, tenantID)
+	if err != nil {
+		return "", err
+	}
+	checkCommand, err := commands.NewCheckCommand(keys.NewNoopCheckCommandKeys(), factories.SchemaReaderFactory(db, l), rr, telemetry.NewNoopMeter())
+	if err != nil {
+		return "", err
+	}
+	response, err := checkCommand.Execute(ctx, &base.PermissionCheckRequest{
+		TenantId: tenantID,
+		Metadata: &base.PermissionCheckRequestMetadata{
+			SchemaVersion: version,
+			SnapToken:     st.Encode().String(),
+			Depth:         20,
+		},
+		Entity:     q.Entity,
+		Permission: q.Action,
+		Subject:    q.Subject,
+	})
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s => %s", query, response.GetCan().String()), nil
+}
+
+// printDoctorReport -
+func printDoctorReport(report []doctorResult) {
+	for _, r := range report {
+		switch {
+		case errors.Is(r.err, errDoctorSkipped):
+			color.Warn.Printf("%-12s skipped\n", r.name)
+		case r.err != nil:
+			color.Danger.Printf("%-12s ✗ ❌ %s\n", r.name, r.err.Error())
+		case r.detail != "":
+			color.Success.Printf("%-12s ✓ ✅ %s\n", r.name, r.detail)
+		default:
+			color.Success.Printf("%-12s ✓ ✅\n", r.name)
+		}
+	}
+}
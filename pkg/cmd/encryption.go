@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"fmt"
+	
+	"github.com/spf13/cobra"
+	
+	"github.com/adminium/permify/internal/config"
+	"github.com/adminium/permify/internal/factories"
+	"github.com/adminium/permify/pkg/encryption"
+)
+
+const (
+	keyProvider  = "provider"
+	masterKey    = "master-key"
+	vaultAddress = "vault-address"
+	vaultToken   = "vault-token"
+	vaultKeyName = "vault-key-name"
+)
+
+// NewEncryptionCommand - Creates new encryption command
+func NewEncryptionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "encryption",
+		Short: "manage the data keys of the tenants whose tuples are encrypted at rest",
+		Args:  cobra.NoArgs,
+	}
+	
+	cmd.AddCommand(NewEncryptionGenerateKeyCommand())
+	
+	return cmd
+}
+
+// NewEncryptionGenerateKeyCommand - Creates new encryption generate-key command
+func NewEncryptionGenerateKeyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate-key",
+		Short: "generate a data key wrapped by the key provider, it goes to database.encryption.keys of the tenant",
+		RunE:  encryptionGenerateKey(),
+		Args:  cobra.NoArgs,
+	}
+	
+	cmd.PersistentFlags().String(keyProvider, encryption.LOCAL.String(), "key provider: local or vault")
+	cmd.PersistentFlags().String(masterKey, "", "base64 aes-256 master key of the local key provider")
+	cmd.PersistentFlags().String(vaultAddress, "", "address of the vault server")
+	cmd.PersistentFlags().String(vaultToken, "", "token of the vault server")
+	cmd.PersistentFlags().String(vaultKeyName, "", "name of the transit key of vault")
+	
+	return cmd
+}
+
+// encryptionGenerateKey - permify encryption generate-key command
+func encryptionGenerateKey() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		flags, err := getFlags(cmd, []string{keyProvider, masterKey, vaultAddress, vaultToken, vaultKeyName})
+		if err != nil {
+			return err
+		}
+		
+		provider, err := factories.KeyProviderFactory(config.KeyProvider{
+			Type:    flags[keyProvider],
+			Key:     flags[masterKey],
+			Address: flags[vaultAddress],
+			Token:   flags[vaultToken],
+			KeyName: flags[vaultKeyName],
+		})
+		if err != nil {
+			return err
+		}
+		
+		wrapped, err := encryption.GenerateDataKey(cmd.Context(), provider)
+		if err != nil {
+			return err
+		}
+		fmt.Println(wrapped)
+		return nil
+	}
+}
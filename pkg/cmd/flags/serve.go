@@ -97,6 +97,62 @@ func RegisterServeFlags(cmd *cobra.Command) {
 		panic(err)
 	}
 	
+	flags.Int32("depth-default", conf.Server.Depth.Default, "depth of the checks and lookups that do not give one")
+	if err = viper.BindPFlag("server.depth.default", flags.Lookup("depth-default")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("server.depth.default", "PERMIFY_DEPTH_DEFAULT"); err != nil {
+		panic(err)
+	}
+	
+	flags.Int32("depth-max", conf.Server.Depth.Max, "maximum depth of the checks and lookups, larger depths are clamped to it")
+	if err = viper.BindPFlag("server.depth.max", flags.Lookup("depth-max")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("server.depth.max", "PERMIFY_DEPTH_MAX"); err != nil {
+		panic(err)
+	}
+	
+	flags.Bool("rate-limit-enabled", conf.Server.RateLimit.Enabled, "switch option for the rate limits of the tenants and api keys")
+	if err = viper.BindPFlag("server.rate_limit.enabled", flags.Lookup("rate-limit-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("server.rate_limit.enabled", "PERMIFY_RATE_LIMIT_ENABLED"); err != nil {
+		panic(err)
+	}
+	
+	flags.Float64("rate-limit-read", conf.Server.RateLimit.Read, "requests per second of a tenant and api key to the read endpoints")
+	if err = viper.BindPFlag("server.rate_limit.read", flags.Lookup("rate-limit-read")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("server.rate_limit.read", "PERMIFY_RATE_LIMIT_READ"); err != nil {
+		panic(err)
+	}
+	
+	flags.Float64("rate-limit-write", conf.Server.RateLimit.Write, "requests per second of a tenant and api key to the write endpoints")
+	if err = viper.BindPFlag("server.rate_limit.write", flags.Lookup("rate-limit-write")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("server.rate_limit.write", "PERMIFY_RATE_LIMIT_WRITE"); err != nil {
+		panic(err)
+	}
+	
+	flags.Float64("rate-limit-check", conf.Server.RateLimit.Check, "requests per second of a tenant and api key to the check endpoints")
+	if err = viper.BindPFlag("server.rate_limit.check", flags.Lookup("rate-limit-check")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("server.rate_limit.check", "PERMIFY_RATE_LIMIT_CHECK"); err != nil {
+		panic(err)
+	}
+	
+	flags.Int("rate-limit-burst", conf.Server.RateLimit.Burst, "requests a tenant and api key may make at once")
+	if err = viper.BindPFlag("server.rate_limit.burst", flags.Lookup("rate-limit-burst")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("server.rate_limit.burst", "PERMIFY_RATE_LIMIT_BURST"); err != nil {
+		panic(err)
+	}
+	
 	// PROFILER
 	flags.Bool("profiler-enabled", conf.Profiler.Enabled, "switch option for profiler")
 	if err = viper.BindPFlag("profiler.enabled", flags.Lookup("profiler-enabled")); err != nil {
@@ -132,7 +188,7 @@ func RegisterServeFlags(cmd *cobra.Command) {
 		panic(err)
 	}
 	
-	flags.String("authn-method", conf.Authn.Method, "server authentication method")
+	flags.String("authn-method", conf.Authn.Method, "server authentication method, preshared, oidc or both separated by a comma")
 	if err = viper.BindPFlag("authn.method", flags.Lookup("authn-method")); err != nil {
 		panic(err)
 	}
@@ -164,6 +220,54 @@ func RegisterServeFlags(cmd *cobra.Command) {
 		panic(err)
 	}
 	
+	flags.String("authn-oidc-jwks-uri", conf.Authn.Oidc.JwksURI, "jwks endpoint the tokens are verified with, discovered from the issuer when empty")
+	if err = viper.BindPFlag("authn.oidc.jwks_uri", flags.Lookup("authn-oidc-jwks-uri")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("authn.oidc.jwks_uri", "PERMIFY_AUTHN_OIDC_JWKS_URI"); err != nil {
+		panic(err)
+	}
+	
+	flags.String("authn-oidc-tenants-claim", conf.Authn.Oidc.TenantsClaim, "claim with the tenants a token may call")
+	if err = viper.BindPFlag("authn.oidc.tenants_claim", flags.Lookup("authn-oidc-tenants-claim")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("authn.oidc.tenants_claim", "PERMIFY_AUTHN_OIDC_TENANTS_CLAIM"); err != nil {
+		panic(err)
+	}
+	
+	flags.String("authn-oidc-scope-claim", conf.Authn.Oidc.ScopeClaim, "claim with the scopes of a token")
+	if err = viper.BindPFlag("authn.oidc.scope_claim", flags.Lookup("authn-oidc-scope-claim")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("authn.oidc.scope_claim", "PERMIFY_AUTHN_OIDC_SCOPE_CLAIM"); err != nil {
+		panic(err)
+	}
+	
+	flags.String("authn-oidc-scopes-read", conf.Authn.Oidc.Scopes.Read, "scope of the tokens that may read")
+	if err = viper.BindPFlag("authn.oidc.scopes.read", flags.Lookup("authn-oidc-scopes-read")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("authn.oidc.scopes.read", "PERMIFY_AUTHN_OIDC_SCOPES_READ"); err != nil {
+		panic(err)
+	}
+	
+	flags.String("authn-oidc-scopes-write", conf.Authn.Oidc.Scopes.Write, "scope of the tokens that may read and write")
+	if err = viper.BindPFlag("authn.oidc.scopes.write", flags.Lookup("authn-oidc-scopes-write")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("authn.oidc.scopes.write", "PERMIFY_AUTHN_OIDC_SCOPES_WRITE"); err != nil {
+		panic(err)
+	}
+	
+	flags.String("authn-oidc-scopes-admin", conf.Authn.Oidc.Scopes.Admin, "scope of the tokens that are not restricted")
+	if err = viper.BindPFlag("authn.oidc.scopes.admin", flags.Lookup("authn-oidc-scopes-admin")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("authn.oidc.scopes.admin", "PERMIFY_AUTHN_OIDC_SCOPES_ADMIN"); err != nil {
+		panic(err)
+	}
+	
 	// TRACER
 	flags.Bool("tracer-enabled", conf.Tracer.Enabled, "switch option for tracing")
 	if err = viper.BindPFlag("tracer.enabled", flags.Lookup("tracer-enabled")); err != nil {
@@ -198,7 +302,7 @@ func RegisterServeFlags(cmd *cobra.Command) {
 		panic(err)
 	}
 	
-	flags.String("meter-exporter", conf.Meter.Exporter, "export uri for metric data")
+	flags.String("meter-exporter", conf.Meter.Exporter, "can be; otlp, prometheus. (integrated metric tools)")
 	if err = viper.BindPFlag("meter.exporter", flags.Lookup("meter-exporter")); err != nil {
 		panic(err)
 	}
@@ -206,7 +310,7 @@ func RegisterServeFlags(cmd *cobra.Command) {
 		panic(err)
 	}
 	
-	flags.String("meter-endpoint", conf.Meter.Endpoint, "can be; otlp. (integrated metric tools)")
+	flags.String("meter-endpoint", conf.Meter.Endpoint, "export uri for metric data, the address /metrics is served on for prometheus")
 	if err = viper.BindPFlag("meter.endpoint", flags.Lookup("meter-endpoint")); err != nil {
 		panic(err)
 	}
@@ -247,6 +351,38 @@ func RegisterServeFlags(cmd *cobra.Command) {
 		panic(err)
 	}
 	
+	flags.Int("service-permission-expand-subject-limit", conf.Service.Permission.ExpandSubjectLimit, "maximum number of subjects an expand returns, zero does not limit them")
+	if err = viper.BindPFlag("service.permission.expand_subject_limit", flags.Lookup("service-permission-expand-subject-limit")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.expand_subject_limit", "PERMIFY_SERVICE_PERMISSION_EXPAND_SUBJECT_LIMIT"); err != nil {
+		panic(err)
+	}
+	
+	flags.String("service-permission-memory-limit", conf.Service.Permission.MemoryLimit, "approximate memory of the tuples and ids a single expand or lookup may hold, e.g. 64MiB, zero does not limit it")
+	if err = viper.BindPFlag("service.permission.memory_limit", flags.Lookup("service-permission-memory-limit")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.memory_limit", "PERMIFY_SERVICE_PERMISSION_MEMORY_LIMIT"); err != nil {
+		panic(err)
+	}
+	
+	flags.Bool("service-permission-subject-type-restrictions", conf.Service.Permission.SubjectTypeRestrictions, "denies the checks of subjects whose type the permission can not hold for without reading tuples")
+	if err = viper.BindPFlag("service.permission.subject_type_restrictions", flags.Lookup("service-permission-subject-type-restrictions")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.subject_type_restrictions", "PERMIFY_SERVICE_PERMISSION_SUBJECT_TYPE_RESTRICTIONS"); err != nil {
+		panic(err)
+	}
+	
+	flags.Duration("service-permission-minimize-latency-staleness", conf.Service.Permission.MinimizeLatencyStaleness, "how long the checks with the minimize_latency consistency reuse a head snapshot")
+	if err = viper.BindPFlag("service.permission.minimize_latency_staleness", flags.Lookup("service-permission-minimize-latency-staleness")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.minimize_latency_staleness", "PERMIFY_SERVICE_PERMISSION_MINIMIZE_LATENCY_STALENESS"); err != nil {
+		panic(err)
+	}
+	
 	flags.Int64("service-permission-cache-number-of-counters", conf.Service.Permission.Cache.NumberOfCounters, "permission service cache number of counters")
 	if err = viper.BindPFlag("service.permission.cache.number_of_counters", flags.Lookup("service-permission-cache-number-of-counters")); err != nil {
 		panic(err)
@@ -263,60 +399,765 @@ func RegisterServeFlags(cmd *cobra.Command) {
 		panic(err)
 	}
 	
-	// DATABASE
-	flags.String("database-engine", conf.Database.Engine, "data source. e.g. postgres, memory")
-	if err = viper.BindPFlag("database.engine", flags.Lookup("database-engine")); err != nil {
+	flags.Bool("service-permission-warmup-enabled", conf.Service.Permission.Warmup.Enabled, "warm up the permission cache on startup and record the most frequent checks on shutdown")
+	if err = viper.BindPFlag("service.permission.warmup.enabled", flags.Lookup("service-permission-warmup-enabled")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("database.engine", "PERMIFY_DATABASE_ENGINE"); err != nil {
+	if err = viper.BindEnv("service.permission.warmup.enabled", "PERMIFY_SERVICE_PERMISSION_WARMUP_ENABLED"); err != nil {
 		panic(err)
 	}
 	
-	flags.String("database-uri", conf.Database.URI, "uri of your data source to store relation tuples and schema")
-	if err = viper.BindPFlag("database.uri", flags.Lookup("database-uri")); err != nil {
+	flags.String("service-permission-warmup-file", conf.Service.Permission.Warmup.File, "file that the warmup patterns are read from and recorded to")
+	if err = viper.BindPFlag("service.permission.warmup.file", flags.Lookup("service-permission-warmup-file")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("database.uri", "PERMIFY_DATABASE_URI"); err != nil {
+	if err = viper.BindEnv("service.permission.warmup.file", "PERMIFY_SERVICE_PERMISSION_WARMUP_FILE"); err != nil {
 		panic(err)
 	}
 	
-	flags.Bool("database-auto-migrate", conf.Database.AutoMigrate, "auto migrate database tables")
-	if err = viper.BindPFlag("database.auto_migrate", flags.Lookup("database-auto-migrate")); err != nil {
+	flags.Int("service-permission-warmup-size", conf.Service.Permission.Warmup.Size, "maximum number of check patterns to record for the next warmup")
+	if err = viper.BindPFlag("service.permission.warmup.size", flags.Lookup("service-permission-warmup-size")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("database.auto_migrate", "PERMIFY_DATABASE_AUTO_MIGRATE"); err != nil {
+	if err = viper.BindEnv("service.permission.warmup.size", "PERMIFY_SERVICE_PERMISSION_WARMUP_SIZE"); err != nil {
 		panic(err)
 	}
 	
-	flags.Int("database-max-open-connections", conf.Database.MaxOpenConnections, "maximum number of parallel connections that can be made to the database at any time")
-	if err = viper.BindPFlag("database.max_open_connections", flags.Lookup("database-max-open-connections")); err != nil {
+	flags.Bool("service-permission-shared-cache-enabled", conf.Service.Permission.SharedCache.Enabled, "share the results of the checks with the other replicas through redis")
+	if err = viper.BindPFlag("service.permission.shared_cache.enabled", flags.Lookup("service-permission-shared-cache-enabled")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("database.max_open_connections", "PERMIFY_DATABASE_MAX_OPEN_CONNECTIONS"); err != nil {
+	if err = viper.BindEnv("service.permission.shared_cache.enabled", "PERMIFY_SERVICE_PERMISSION_SHARED_CACHE_ENABLED"); err != nil {
 		panic(err)
 	}
 	
-	flags.Int("database-max-idle-connections", conf.Database.MaxIdleConnections, "maximum number of idle connections that can be made to the database at any time")
-	if err = viper.BindPFlag("database.max_idle_connections", flags.Lookup("database-max-idle-connections")); err != nil {
+	flags.String("service-permission-shared-cache-address", conf.Service.Permission.SharedCache.Address, "address of the redis the replicas share")
+	if err = viper.BindPFlag("service.permission.shared_cache.address", flags.Lookup("service-permission-shared-cache-address")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("database.max_idle_connections", "PERMIFY_DATABASE_MAX_IDLE_CONNECTIONS"); err != nil {
+	if err = viper.BindEnv("service.permission.shared_cache.address", "PERMIFY_SERVICE_PERMISSION_SHARED_CACHE_ADDRESS"); err != nil {
 		panic(err)
 	}
 	
-	flags.Duration("database-max-connection-lifetime", conf.Database.MaxConnectionLifetime, "maximum amount of time a connection may be reused")
-	if err = viper.BindPFlag("database.max_connection_lifetime", flags.Lookup("database-max-connection-lifetime")); err != nil {
+	flags.String("service-permission-shared-cache-password", conf.Service.Permission.SharedCache.Password, "password of the redis the replicas share")
+	if err = viper.BindPFlag("service.permission.shared_cache.password", flags.Lookup("service-permission-shared-cache-password")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("database.max_connection_lifetime", "PERMIFY_DATABASE_MAX_CONNECTION_LIFETIME"); err != nil {
+	if err = viper.BindEnv("service.permission.shared_cache.password", "PERMIFY_SERVICE_PERMISSION_SHARED_CACHE_PASSWORD"); err != nil {
 		panic(err)
 	}
 	
-	flags.Duration("database-max-connection-idle-time", conf.Database.MaxConnectionIdleTime, "maximum amount of time a connection may be idle")
-	if err = viper.BindPFlag("database.max_connection_idle_time", flags.Lookup("database-max-connection-idle-time")); err != nil {
+	flags.String("service-permission-shared-cache-prefix", conf.Service.Permission.SharedCache.Prefix, "prefix of the shared keys, replicas with the same prefix share their results")
+	if err = viper.BindPFlag("service.permission.shared_cache.prefix", flags.Lookup("service-permission-shared-cache-prefix")); err != nil {
 		panic(err)
 	}
-	if err = viper.BindEnv("database.max_connection_idle_time", "PERMIFY_DATABASE_MAX_CONNECTION_IDLE_TIME"); err != nil {
+	if err = viper.BindEnv("service.permission.shared_cache.prefix", "PERMIFY_SERVICE_PERMISSION_SHARED_CACHE_PREFIX"); err != nil {
+		panic(err)
+	}
+	
+	flags.Duration("service-permission-shared-cache-ttl", conf.Service.Permission.SharedCache.TTL, "lifetime of the shared results of the tenants whose settings give none")
+	if err = viper.BindPFlag("service.permission.shared_cache.ttl", flags.Lookup("service-permission-shared-cache-ttl")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.shared_cache.ttl", "PERMIFY_SERVICE_PERMISSION_SHARED_CACHE_TTL"); err != nil {
+		panic(err)
+	}
+	
+	flags.Bool("service-permission-invalidation-enabled", conf.Service.Permission.Invalidation.Enabled, "invalidate the cached checks that depend on the changes of the tenants written by any replica")
+	if err = viper.BindPFlag("service.permission.invalidation.enabled", flags.Lookup("service-permission-invalidation-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.invalidation.enabled", "PERMIFY_SERVICE_PERMISSION_INVALIDATION_ENABLED"); err != nil {
+		panic(err)
+	}
+	
+	flags.Duration("service-permission-invalidation-refresh-interval", conf.Service.Permission.Invalidation.RefreshInterval, "how often the tenants are listed to watch the changes of the new ones")
+	if err = viper.BindPFlag("service.permission.invalidation.refresh_interval", flags.Lookup("service-permission-invalidation-refresh-interval")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.invalidation.refresh_interval", "PERMIFY_SERVICE_PERMISSION_INVALIDATION_REFRESH_INTERVAL"); err != nil {
+		panic(err)
+	}
+	
+	flags.Bool("service-permission-views-enabled", conf.Service.Permission.Views.Enabled, "serve the lookups of the configured permissions from allow lists maintained from the changes of the tenants")
+	if err = viper.BindPFlag("service.permission.views.enabled", flags.Lookup("service-permission-views-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.views.enabled", "PERMIFY_SERVICE_PERMISSION_VIEWS_ENABLED"); err != nil {
+		panic(err)
+	}
+	
+	flags.StringSlice("service-permission-views-permissions", conf.Service.Permission.Views.Permissions, "permissions whose allow lists are materialized as entity_type#permission, optionally followed by @subject_type")
+	if err = viper.BindPFlag("service.permission.views.permissions", flags.Lookup("service-permission-views-permissions")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.views.permissions", "PERMIFY_SERVICE_PERMISSION_VIEWS_PERMISSIONS"); err != nil {
+		panic(err)
+	}
+	
+	flags.Duration("service-permission-views-max-staleness", conf.Service.Permission.Views.MaxStaleness, "how long the allow lists are served after a change they do not contain yet")
+	if err = viper.BindPFlag("service.permission.views.max_staleness", flags.Lookup("service-permission-views-max-staleness")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.views.max_staleness", "PERMIFY_SERVICE_PERMISSION_VIEWS_MAX_STALENESS"); err != nil {
+		panic(err)
+	}
+	
+	flags.Duration("service-permission-views-refresh-interval", conf.Service.Permission.Views.RefreshInterval, "how often the tenants are listed and the allow lists are checked against the head schema")
+	if err = viper.BindPFlag("service.permission.views.refresh_interval", flags.Lookup("service-permission-views-refresh-interval")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.views.refresh_interval", "PERMIFY_SERVICE_PERMISSION_VIEWS_REFRESH_INTERVAL"); err != nil {
+		panic(err)
+	}
+	
+	flags.Bool("service-permission-decision-log-enabled", conf.Service.Permission.DecisionLog.Enabled, "send the decisions of the checks, expands and lookups to the configured sinks")
+	if err = viper.BindPFlag("service.permission.decision_log.enabled", flags.Lookup("service-permission-decision-log-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.decision_log.enabled", "PERMIFY_SERVICE_PERMISSION_DECISION_LOG_ENABLED"); err != nil {
+		panic(err)
+	}
+	
+	flags.Float64("service-permission-decision-log-sample-rate", conf.Service.Permission.DecisionLog.SampleRate, "fraction of the matching decisions that are sent")
+	if err = viper.BindPFlag("service.permission.decision_log.sample_rate", flags.Lookup("service-permission-decision-log-sample-rate")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.decision_log.sample_rate", "PERMIFY_SERVICE_PERMISSION_DECISION_LOG_SAMPLE_RATE"); err != nil {
+		panic(err)
+	}
+	
+	flags.StringSlice("service-permission-decision-log-tenants", conf.Service.Permission.DecisionLog.Tenants, "tenants whose decisions are sent, every tenant when empty")
+	if err = viper.BindPFlag("service.permission.decision_log.tenants", flags.Lookup("service-permission-decision-log-tenants")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.decision_log.tenants", "PERMIFY_SERVICE_PERMISSION_DECISION_LOG_TENANTS"); err != nil {
+		panic(err)
+	}
+	
+	flags.StringSlice("service-permission-decision-log-permissions", conf.Service.Permission.DecisionLog.Permissions, "permissions whose decisions are sent as entity_type#permission, every permission when empty")
+	if err = viper.BindPFlag("service.permission.decision_log.permissions", flags.Lookup("service-permission-decision-log-permissions")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.decision_log.permissions", "PERMIFY_SERVICE_PERMISSION_DECISION_LOG_PERMISSIONS"); err != nil {
+		panic(err)
+	}
+	
+	flags.Bool("service-permission-decision-log-only-denied", conf.Service.Permission.DecisionLog.OnlyDenied, "send only the denied decisions")
+	if err = viper.BindPFlag("service.permission.decision_log.only_denied", flags.Lookup("service-permission-decision-log-only-denied")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.decision_log.only_denied", "PERMIFY_SERVICE_PERMISSION_DECISION_LOG_ONLY_DENIED"); err != nil {
+		panic(err)
+	}
+	
+	flags.Int("service-permission-decision-log-batch-size", conf.Service.Permission.DecisionLog.BatchSize, "number of decisions sent at once")
+	if err = viper.BindPFlag("service.permission.decision_log.batch_size", flags.Lookup("service-permission-decision-log-batch-size")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.decision_log.batch_size", "PERMIFY_SERVICE_PERMISSION_DECISION_LOG_BATCH_SIZE"); err != nil {
+		panic(err)
+	}
+	
+	flags.Duration("service-permission-decision-log-flush-interval", conf.Service.Permission.DecisionLog.FlushInterval, "how long a decision waits at most for its batch to fill up")
+	if err = viper.BindPFlag("service.permission.decision_log.flush_interval", flags.Lookup("service-permission-decision-log-flush-interval")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.decision_log.flush_interval", "PERMIFY_SERVICE_PERMISSION_DECISION_LOG_FLUSH_INTERVAL"); err != nil {
+		panic(err)
+	}
+	
+	flags.Int("service-permission-decision-log-queue-size", conf.Service.Permission.DecisionLog.QueueSize, "number of decisions waiting to be sent, the ones beyond it are dropped")
+	if err = viper.BindPFlag("service.permission.decision_log.queue_size", flags.Lookup("service-permission-decision-log-queue-size")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.decision_log.queue_size", "PERMIFY_SERVICE_PERMISSION_DECISION_LOG_QUEUE_SIZE"); err != nil {
+		panic(err)
+	}
+	
+	flags.StringSlice("service-permission-decision-log-kafka-brokers", conf.Service.Permission.DecisionLog.Kafka.Brokers, "kafka brokers the decisions are written to")
+	if err = viper.BindPFlag("service.permission.decision_log.kafka.brokers", flags.Lookup("service-permission-decision-log-kafka-brokers")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.decision_log.kafka.brokers", "PERMIFY_SERVICE_PERMISSION_DECISION_LOG_KAFKA_BROKERS"); err != nil {
+		panic(err)
+	}
+	
+	flags.String("service-permission-decision-log-kafka-topic", conf.Service.Permission.DecisionLog.Kafka.Topic, "kafka topic the decisions are written to")
+	if err = viper.BindPFlag("service.permission.decision_log.kafka.topic", flags.Lookup("service-permission-decision-log-kafka-topic")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.decision_log.kafka.topic", "PERMIFY_SERVICE_PERMISSION_DECISION_LOG_KAFKA_TOPIC"); err != nil {
+		panic(err)
+	}
+	
+	flags.StringSlice("service-permission-decision-log-operations", conf.Service.Permission.DecisionLog.Operations, "operations whose decisions are sent; check, expand, lookup_entity, lookup_subject, every operation when empty")
+	if err = viper.BindPFlag("service.permission.decision_log.operations", flags.Lookup("service-permission-decision-log-operations")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.decision_log.operations", "PERMIFY_SERVICE_PERMISSION_DECISION_LOG_OPERATIONS"); err != nil {
+		panic(err)
+	}
+	
+	flags.String("service-permission-decision-log-file", conf.Service.Permission.DecisionLog.File, "file the decisions are appended to as json lines")
+	if err = viper.BindPFlag("service.permission.decision_log.file", flags.Lookup("service-permission-decision-log-file")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.decision_log.file", "PERMIFY_SERVICE_PERMISSION_DECISION_LOG_FILE"); err != nil {
+		panic(err)
+	}
+	
+	flags.Bool("service-permission-decision-log-postgres", conf.Service.Permission.DecisionLog.Postgres, "insert the decisions into the decisions table of the postgres or cockroach database")
+	if err = viper.BindPFlag("service.permission.decision_log.postgres", flags.Lookup("service-permission-decision-log-postgres")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.decision_log.postgres", "PERMIFY_SERVICE_PERMISSION_DECISION_LOG_POSTGRES"); err != nil {
+		panic(err)
+	}
+	
+	flags.Bool("service-permission-trace-sampling-enabled", conf.Service.Permission.TraceSampling.Enabled, "persist the traces of a fraction of the checks")
+	if err = viper.BindPFlag("service.permission.trace_sampling.enabled", flags.Lookup("service-permission-trace-sampling-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.trace_sampling.enabled", "PERMIFY_SERVICE_PERMISSION_TRACE_SAMPLING_ENABLED"); err != nil {
+		panic(err)
+	}
+	
+	flags.Float64("service-permission-trace-sampling-sample-rate", conf.Service.Permission.TraceSampling.SampleRate, "fraction of the checks whose traces are persisted")
+	if err = viper.BindPFlag("service.permission.trace_sampling.sample_rate", flags.Lookup("service-permission-trace-sampling-sample-rate")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.trace_sampling.sample_rate", "PERMIFY_SERVICE_PERMISSION_TRACE_SAMPLING_SAMPLE_RATE"); err != nil {
+		panic(err)
+	}
+	
+	flags.Duration("service-permission-trace-sampling-retention", conf.Service.Permission.TraceSampling.Retention, "how long the persisted traces are kept, forever when not positive")
+	if err = viper.BindPFlag("service.permission.trace_sampling.retention", flags.Lookup("service-permission-trace-sampling-retention")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.trace_sampling.retention", "PERMIFY_SERVICE_PERMISSION_TRACE_SAMPLING_RETENTION"); err != nil {
+		panic(err)
+	}
+	
+	flags.Int("service-permission-trace-sampling-queue-size", conf.Service.Permission.TraceSampling.QueueSize, "number of traces waiting to be written, the ones beyond it are dropped")
+	if err = viper.BindPFlag("service.permission.trace_sampling.queue_size", flags.Lookup("service-permission-trace-sampling-queue-size")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.trace_sampling.queue_size", "PERMIFY_SERVICE_PERMISSION_TRACE_SAMPLING_QUEUE_SIZE"); err != nil {
+		panic(err)
+	}
+	
+	flags.Bool("service-permission-fallback-enabled", conf.Service.Permission.Fallback.Enabled, "answer checks from their last known result when the storage is unavailable")
+	if err = viper.BindPFlag("service.permission.fallback.enabled", flags.Lookup("service-permission-fallback-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.fallback.enabled", "PERMIFY_SERVICE_PERMISSION_FALLBACK_ENABLED"); err != nil {
+		panic(err)
+	}
+	
+	flags.Bool("service-permission-response-cache-enabled", conf.Service.Permission.ResponseCache.Enabled, "switch option for caching the responses of the expands and the schema lookups")
+	if err = viper.BindPFlag("service.permission.response_cache.enabled", flags.Lookup("service-permission-response-cache-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.response_cache.enabled", "PERMIFY_SERVICE_PERMISSION_RESPONSE_CACHE_ENABLED"); err != nil {
+		panic(err)
+	}
+	
+	flags.Int64("service-permission-response-cache-number-of-counters", conf.Service.Permission.ResponseCache.Cache.NumberOfCounters, "number of counters of the response cache of the expands and the schema lookups")
+	if err = viper.BindPFlag("service.permission.response_cache.cache.number_of_counters", flags.Lookup("service-permission-response-cache-number-of-counters")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.response_cache.cache.number_of_counters", "PERMIFY_SERVICE_PERMISSION_RESPONSE_CACHE_NUMBER_OF_COUNTERS"); err != nil {
+		panic(err)
+	}
+	
+	flags.String("service-permission-response-cache-max-cost", conf.Service.Permission.ResponseCache.Cache.MaxCost, "max cost of the response cache of the expands and the schema lookups")
+	if err = viper.BindPFlag("service.permission.response_cache.cache.max_cost", flags.Lookup("service-permission-response-cache-max-cost")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.response_cache.cache.max_cost", "PERMIFY_SERVICE_PERMISSION_RESPONSE_CACHE_MAX_COST"); err != nil {
+		panic(err)
+	}
+	
+	flags.Bool("service-permission-deadline-budget-enabled", conf.Service.Permission.DeadlineBudget.Enabled, "switch option for dividing the deadline of the requests between their storage reads and dispatched sub-checks")
+	if err = viper.BindPFlag("service.permission.deadline_budget.enabled", flags.Lookup("service-permission-deadline-budget-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.deadline_budget.enabled", "PERMIFY_SERVICE_PERMISSION_DEADLINE_BUDGET_ENABLED"); err != nil {
+		panic(err)
+	}
+	
+	flags.Float64("service-permission-deadline-budget-schema", conf.Service.Permission.DeadlineBudget.Schema, "share of the time left before the deadline of a request that a schema read may take")
+	if err = viper.BindPFlag("service.permission.deadline_budget.schema", flags.Lookup("service-permission-deadline-budget-schema")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.deadline_budget.schema", "PERMIFY_SERVICE_PERMISSION_DEADLINE_BUDGET_SCHEMA"); err != nil {
+		panic(err)
+	}
+	
+	flags.Float64("service-permission-deadline-budget-query", conf.Service.Permission.DeadlineBudget.Query, "share of the time left before the deadline of a request that a tuple query may take")
+	if err = viper.BindPFlag("service.permission.deadline_budget.query", flags.Lookup("service-permission-deadline-budget-query")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.deadline_budget.query", "PERMIFY_SERVICE_PERMISSION_DEADLINE_BUDGET_QUERY"); err != nil {
+		panic(err)
+	}
+	
+	flags.Float64("service-permission-deadline-budget-check", conf.Service.Permission.DeadlineBudget.Check, "share of the time left before the deadline of a check that a dispatched sub-check may take")
+	if err = viper.BindPFlag("service.permission.deadline_budget.check", flags.Lookup("service-permission-deadline-budget-check")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.deadline_budget.check", "PERMIFY_SERVICE_PERMISSION_DEADLINE_BUDGET_CHECK"); err != nil {
+		panic(err)
+	}
+	
+	flags.Duration("service-permission-deadline-budget-floor", conf.Service.Permission.DeadlineBudget.Floor, "shortest time a storage read or a dispatched sub-check is given, unless less is left")
+	if err = viper.BindPFlag("service.permission.deadline_budget.floor", flags.Lookup("service-permission-deadline-budget-floor")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.permission.deadline_budget.floor", "PERMIFY_SERVICE_PERMISSION_DEADLINE_BUDGET_FLOOR"); err != nil {
+		panic(err)
+	}
+	
+	flags.Bool("service-identity-enabled", conf.Service.Identity.Enabled, "switch option for resolving external subject identifiers to canonical subject ids")
+	if err = viper.BindPFlag("service.identity.enabled", flags.Lookup("service-identity-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("service.identity.enabled", "PERMIFY_SERVICE_IDENTITY_ENABLED"); err != nil {
+		panic(err)
+	}
+	
+	// DATABASE
+	flags.String("database-engine", conf.Database.Engine, "data source. e.g. postgres, memory")
+	if err = viper.BindPFlag("database.engine", flags.Lookup("database-engine")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.engine", "PERMIFY_DATABASE_ENGINE"); err != nil {
+		panic(err)
+	}
+	
+	flags.String("database-uri", conf.Database.URI, "uri of your data source to store relation tuples and schema")
+	if err = viper.BindPFlag("database.uri", flags.Lookup("database-uri")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.uri", "PERMIFY_DATABASE_URI"); err != nil {
+		panic(err)
+	}
+	
+	flags.Bool("database-auto-migrate", conf.Database.AutoMigrate, "auto migrate database tables")
+	if err = viper.BindPFlag("database.auto_migrate", flags.Lookup("database-auto-migrate")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.auto_migrate", "PERMIFY_DATABASE_AUTO_MIGRATE"); err != nil {
+		panic(err)
+	}
+	
+	flags.Int("database-max-open-connections", conf.Database.MaxOpenConnections, "maximum number of parallel connections that can be made to the database at any time")
+	if err = viper.BindPFlag("database.max_open_connections", flags.Lookup("database-max-open-connections")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.max_open_connections", "PERMIFY_DATABASE_MAX_OPEN_CONNECTIONS"); err != nil {
+		panic(err)
+	}
+	
+	flags.Int("database-max-idle-connections", conf.Database.MaxIdleConnections, "maximum number of idle connections that can be made to the database at any time")
+	if err = viper.BindPFlag("database.max_idle_connections", flags.Lookup("database-max-idle-connections")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.max_idle_connections", "PERMIFY_DATABASE_MAX_IDLE_CONNECTIONS"); err != nil {
+		panic(err)
+	}
+	
+	flags.Duration("database-max-connection-lifetime", conf.Database.MaxConnectionLifetime, "maximum amount of time a connection may be reused")
+	if err = viper.BindPFlag("database.max_connection_lifetime", flags.Lookup("database-max-connection-lifetime")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.max_connection_lifetime", "PERMIFY_DATABASE_MAX_CONNECTION_LIFETIME"); err != nil {
+		panic(err)
+	}
+	
+	flags.Duration("database-max-connection-idle-time", conf.Database.MaxConnectionIdleTime, "maximum amount of time a connection may be idle")
+	if err = viper.BindPFlag("database.max_connection_idle_time", flags.Lookup("database-max-connection-idle-time")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.max_connection_idle_time", "PERMIFY_DATABASE_MAX_CONNECTION_IDLE_TIME"); err != nil {
+		panic(err)
+	}
+	
+	flags.Bool("database-garbage-collection-enabled", conf.Database.GarbageCollection.Enabled, "switch option for deleting the relation tuples of postgres that were deleted before the window")
+	if err = viper.BindPFlag("database.garbage_collection.enabled", flags.Lookup("database-garbage-collection-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.garbage_collection.enabled", "PERMIFY_DATABASE_GARBAGE_COLLECTION_ENABLED"); err != nil {
+		panic(err)
+	}
+	
+	flags.Duration("database-garbage-collection-window", conf.Database.GarbageCollection.Window, "how long the deleted relation tuples are kept")
+	if err = viper.BindPFlag("database.garbage_collection.window", flags.Lookup("database-garbage-collection-window")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.garbage_collection.window", "PERMIFY_DATABASE_GARBAGE_COLLECTION_WINDOW"); err != nil {
+		panic(err)
+	}
+	
+	flags.Duration("database-garbage-collection-interval", conf.Database.GarbageCollection.Interval, "how often the deleted relation tuples are collected")
+	if err = viper.BindPFlag("database.garbage_collection.interval", flags.Lookup("database-garbage-collection-interval")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.garbage_collection.interval", "PERMIFY_DATABASE_GARBAGE_COLLECTION_INTERVAL"); err != nil {
+		panic(err)
+	}
+	
+	flags.Int("database-garbage-collection-batch-size", conf.Database.GarbageCollection.BatchSize, "relation tuples deleted by a single statement of the garbage collection")
+	if err = viper.BindPFlag("database.garbage_collection.batch_size", flags.Lookup("database-garbage-collection-batch-size")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.garbage_collection.batch_size", "PERMIFY_DATABASE_GARBAGE_COLLECTION_BATCH_SIZE"); err != nil {
+		panic(err)
+	}
+	
+	flags.Bool("database-sweeper-enabled", conf.Database.Sweeper.Enabled, "switch option for deleting the relation tuples whose validity ended before the window")
+	if err = viper.BindPFlag("database.sweeper.enabled", flags.Lookup("database-sweeper-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.sweeper.enabled", "PERMIFY_DATABASE_SWEEPER_ENABLED"); err != nil {
+		panic(err)
+	}
+	
+	flags.Duration("database-sweeper-window", conf.Database.Sweeper.Window, "how long the relation tuples are kept after their validity ended")
+	if err = viper.BindPFlag("database.sweeper.window", flags.Lookup("database-sweeper-window")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.sweeper.window", "PERMIFY_DATABASE_SWEEPER_WINDOW"); err != nil {
+		panic(err)
+	}
+	
+	flags.Duration("database-sweeper-interval", conf.Database.Sweeper.Interval, "how often the expired relation tuples are swept")
+	if err = viper.BindPFlag("database.sweeper.interval", flags.Lookup("database-sweeper-interval")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.sweeper.interval", "PERMIFY_DATABASE_SWEEPER_INTERVAL"); err != nil {
+		panic(err)
+	}
+	
+	flags.Int("database-sweeper-batch-size", conf.Database.Sweeper.BatchSize, "expired relation tuples deleted at once by the sweeper")
+	if err = viper.BindPFlag("database.sweeper.batch_size", flags.Lookup("database-sweeper-batch-size")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.sweeper.batch_size", "PERMIFY_DATABASE_SWEEPER_BATCH_SIZE"); err != nil {
+		panic(err)
+	}
+	
+	flags.Bool("database-encryption-enabled", conf.Database.Encryption.Enabled, "switch option for encrypting the tuples of the tenants that have a data key")
+	if err = viper.BindPFlag("database.encryption.enabled", flags.Lookup("database-encryption-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.encryption.enabled", "PERMIFY_DATABASE_ENCRYPTION_ENABLED"); err != nil {
+		panic(err)
+	}
+	
+	flags.String("database-encryption-mode", conf.Database.Encryption.Mode, "encrypted parts of the tuples: subject or tuple")
+	if err = viper.BindPFlag("database.encryption.mode", flags.Lookup("database-encryption-mode")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.encryption.mode", "PERMIFY_DATABASE_ENCRYPTION_MODE"); err != nil {
+		panic(err)
+	}
+	
+	flags.String("database-encryption-provider-type", conf.Database.Encryption.Provider.Type, "key provider the data keys are wrapped by: local or vault")
+	if err = viper.BindPFlag("database.encryption.provider.type", flags.Lookup("database-encryption-provider-type")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.encryption.provider.type", "PERMIFY_DATABASE_ENCRYPTION_PROVIDER_TYPE"); err != nil {
+		panic(err)
+	}
+	
+	flags.String("database-encryption-provider-key", conf.Database.Encryption.Provider.Key, "base64 aes-256 master key of the local key provider")
+	if err = viper.BindPFlag("database.encryption.provider.key", flags.Lookup("database-encryption-provider-key")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.encryption.provider.key", "PERMIFY_DATABASE_ENCRYPTION_PROVIDER_KEY"); err != nil {
+		panic(err)
+	}
+	
+	flags.String("database-encryption-provider-address", conf.Database.Encryption.Provider.Address, "address of the vault server")
+	if err = viper.BindPFlag("database.encryption.provider.address", flags.Lookup("database-encryption-provider-address")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.encryption.provider.address", "PERMIFY_DATABASE_ENCRYPTION_PROVIDER_ADDRESS"); err != nil {
+		panic(err)
+	}
+	
+	flags.String("database-encryption-provider-token", conf.Database.Encryption.Provider.Token, "token of the vault server")
+	if err = viper.BindPFlag("database.encryption.provider.token", flags.Lookup("database-encryption-provider-token")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.encryption.provider.token", "PERMIFY_DATABASE_ENCRYPTION_PROVIDER_TOKEN"); err != nil {
+		panic(err)
+	}
+	
+	flags.String("database-encryption-provider-key-name", conf.Database.Encryption.Provider.KeyName, "name of the transit key of vault")
+	if err = viper.BindPFlag("database.encryption.provider.key_name", flags.Lookup("database-encryption-provider-key-name")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("database.encryption.provider.key_name", "PERMIFY_DATABASE_ENCRYPTION_PROVIDER_KEY_NAME"); err != nil {
+		panic(err)
+	}
+	
+	// Distributed
+	flags.Bool("distributed-enabled", conf.Distributed.Enabled, "switch option for multi-region deployment")
+	if err = viper.BindPFlag("distributed.enabled", flags.Lookup("distributed-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.enabled", "PERMIFY_DISTRIBUTED_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	flags.String("distributed-region", conf.Distributed.Region, "name of the region this instance runs in")
+	if err = viper.BindPFlag("distributed.region", flags.Lookup("distributed-region")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.region", "PERMIFY_DISTRIBUTED_REGION"); err != nil {
+		panic(err)
+	}
+	
+	flags.String("distributed-primary-region", conf.Distributed.PrimaryRegion, "name of the region that accepts writes")
+	if err = viper.BindPFlag("distributed.primary_region", flags.Lookup("distributed-primary-region")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.primary_region", "PERMIFY_DISTRIBUTED_PRIMARY_REGION"); err != nil {
+		panic(err)
+	}
+	
+	flags.String("distributed-primary-address", conf.Distributed.PrimaryAddress, "grpc address of the primary region that writes are forwarded to")
+	if err = viper.BindPFlag("distributed.primary_address", flags.Lookup("distributed-primary-address")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.primary_address", "PERMIFY_DISTRIBUTED_PRIMARY_ADDRESS"); err != nil {
+		panic(err)
+	}
+	
+	flags.Uint64("distributed-cache-epoch", conf.Distributed.CacheEpoch, "cache epoch of the region, bumping it invalidates the permission cache")
+	if err = viper.BindPFlag("distributed.cache_epoch", flags.Lookup("distributed-cache-epoch")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.cache_epoch", "PERMIFY_DISTRIBUTED_CACHE_EPOCH"); err != nil {
+		panic(err)
+	}
+	
+	flags.Bool("distributed-dispatch-enabled", conf.Distributed.Dispatch.Enabled, "switch option for spreading the checks over the peers of the region")
+	if err = viper.BindPFlag("distributed.dispatch.enabled", flags.Lookup("distributed-dispatch-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.dispatch.enabled", "PERMIFY_DISTRIBUTED_DISPATCH_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	flags.String("distributed-dispatch-address", conf.Distributed.Dispatch.Address, "grpc address the peers reach this instance at")
+	if err = viper.BindPFlag("distributed.dispatch.address", flags.Lookup("distributed-dispatch-address")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.dispatch.address", "PERMIFY_DISTRIBUTED_DISPATCH_ADDRESS"); err != nil {
+		panic(err)
+	}
+	
+	flags.Duration("distributed-dispatch-timeout", conf.Distributed.Dispatch.Timeout, "how long a peer has to answer a subproblem before it is checked locally")
+	if err = viper.BindPFlag("distributed.dispatch.timeout", flags.Lookup("distributed-dispatch-timeout")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.dispatch.timeout", "PERMIFY_DISTRIBUTED_DISPATCH_TIMEOUT"); err != nil {
+		panic(err)
+	}
+	
+	flags.String("distributed-dispatch-peer-key", conf.Distributed.Dispatch.PeerKey, "key the peers dispatch the subproblems to each other with")
+	if err = viper.BindPFlag("distributed.dispatch.peer_key", flags.Lookup("distributed-dispatch-peer-key")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.dispatch.peer_key", "PERMIFY_DISTRIBUTED_DISPATCH_PEER_KEY"); err != nil {
+		panic(err)
+	}
+	
+	flags.String("distributed-dispatch-discovery-type", conf.Distributed.Dispatch.Discovery.Type, "discovery of the peers: static, dns or kubernetes")
+	if err = viper.BindPFlag("distributed.dispatch.discovery.type", flags.Lookup("distributed-dispatch-discovery-type")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.dispatch.discovery.type", "PERMIFY_DISTRIBUTED_DISPATCH_DISCOVERY_TYPE"); err != nil {
+		panic(err)
+	}
+	
+	flags.StringSlice("distributed-dispatch-discovery-members", conf.Distributed.Dispatch.Discovery.Members, "peers of the static discovery")
+	if err = viper.BindPFlag("distributed.dispatch.discovery.members", flags.Lookup("distributed-dispatch-discovery-members")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.dispatch.discovery.members", "PERMIFY_DISTRIBUTED_DISPATCH_DISCOVERY_MEMBERS"); err != nil {
+		panic(err)
+	}
+	
+	flags.String("distributed-dispatch-discovery-dns", conf.Distributed.Dispatch.Discovery.DNS, "name resolved to the addresses of the peers")
+	if err = viper.BindPFlag("distributed.dispatch.discovery.dns", flags.Lookup("distributed-dispatch-discovery-dns")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.dispatch.discovery.dns", "PERMIFY_DISTRIBUTED_DISPATCH_DISCOVERY_DNS"); err != nil {
+		panic(err)
+	}
+	
+	flags.String("distributed-dispatch-discovery-service", conf.Distributed.Dispatch.Discovery.Service, "kubernetes service whose endpoints are the peers")
+	if err = viper.BindPFlag("distributed.dispatch.discovery.service", flags.Lookup("distributed-dispatch-discovery-service")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("distributed.dispatch.discovery.service", "PERMIFY_DISTRIBUTED_DISPATCH_DISCOVERY_SERVICE"); err != nil {
+		panic(err)
+	}
+	
+	flags.Bool("archive-enabled", conf.Archive.Enabled, "switch option for shipping the change log and exports of the tenants to an object storage")
+	if err = viper.BindPFlag("archive.enabled", flags.Lookup("archive-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("archive.enabled", "PERMIFY_ARCHIVE_ENABLED"); err != nil {
+		panic(err)
+	}
+
+	flags.String("archive-engine", conf.Archive.Engine, "object storage of the archive: s3 or gcs")
+	if err = viper.BindPFlag("archive.engine", flags.Lookup("archive-engine")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("archive.engine", "PERMIFY_ARCHIVE_ENGINE"); err != nil {
+		panic(err)
+	}
+	
+	flags.String("archive-endpoint", conf.Archive.Endpoint, "host of the object storage, the endpoint of the engine when it is empty")
+	if err = viper.BindPFlag("archive.endpoint", flags.Lookup("archive-endpoint")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("archive.endpoint", "PERMIFY_ARCHIVE_ENDPOINT"); err != nil {
+		panic(err)
+	}
+	
+	flags.String("archive-bucket", conf.Archive.Bucket, "bucket the archived objects are written to")
+	if err = viper.BindPFlag("archive.bucket", flags.Lookup("archive-bucket")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("archive.bucket", "PERMIFY_ARCHIVE_BUCKET"); err != nil {
+		panic(err)
+	}
+	
+	flags.String("archive-region", conf.Archive.Region, "region of the bucket")
+	if err = viper.BindPFlag("archive.region", flags.Lookup("archive-region")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("archive.region", "PERMIFY_ARCHIVE_REGION"); err != nil {
+		panic(err)
+	}
+	
+	flags.String("archive-prefix", conf.Archive.Prefix, "prefix of the keys of the archived objects")
+	if err = viper.BindPFlag("archive.prefix", flags.Lookup("archive-prefix")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("archive.prefix", "PERMIFY_ARCHIVE_PREFIX"); err != nil {
+		panic(err)
+	}
+	
+	flags.String("archive-access-key", conf.Archive.AccessKey, "access key of the object storage, the credentials of the environment are used when it is empty")
+	if err = viper.BindPFlag("archive.access_key", flags.Lookup("archive-access-key")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("archive.access_key", "PERMIFY_ARCHIVE_ACCESS_KEY"); err != nil {
+		panic(err)
+	}
+	
+	flags.String("archive-secret-key", conf.Archive.SecretKey, "secret key of the object storage")
+	if err = viper.BindPFlag("archive.secret_key", flags.Lookup("archive-secret-key")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("archive.secret_key", "PERMIFY_ARCHIVE_SECRET_KEY"); err != nil {
+		panic(err)
+	}
+	
+	flags.Bool("archive-insecure", conf.Archive.Insecure, "reach the object storage over plain http")
+	if err = viper.BindPFlag("archive.insecure", flags.Lookup("archive-insecure")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("archive.insecure", "PERMIFY_ARCHIVE_INSECURE"); err != nil {
+		panic(err)
+	}
+	
+	flags.Duration("archive-interval", conf.Archive.Interval, "how often the changes of the tenants are shipped")
+	if err = viper.BindPFlag("archive.interval", flags.Lookup("archive-interval")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("archive.interval", "PERMIFY_ARCHIVE_INTERVAL"); err != nil {
+		panic(err)
+	}
+	
+	flags.Duration("archive-export-interval", conf.Archive.ExportInterval, "how often the tenants are exported, zero disables the exports")
+	if err = viper.BindPFlag("archive.export_interval", flags.Lookup("archive-export-interval")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("archive.export_interval", "PERMIFY_ARCHIVE_EXPORT_INTERVAL"); err != nil {
+		panic(err)
+	}
+	
+	flags.Duration("archive-retention", conf.Archive.Retention, "how long the archived objects are kept, zero keeps them forever")
+	if err = viper.BindPFlag("archive.retention", flags.Lookup("archive-retention")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("archive.retention", "PERMIFY_ARCHIVE_RETENTION"); err != nil {
+		panic(err)
+	}
+	
+	flags.Bool("events-enabled", conf.Events.Enabled, "switch option for publishing the relationship and schema writes of the tenants")
+	if err = viper.BindPFlag("events.enabled", flags.Lookup("events-enabled")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("events.enabled", "PERMIFY_EVENTS_ENABLED"); err != nil {
+		panic(err)
+	}
+	
+	flags.String("events-broker", conf.Events.Broker, "broker the events are published to: kafka or nats")
+	if err = viper.BindPFlag("events.broker", flags.Lookup("events-broker")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("events.broker", "PERMIFY_EVENTS_BROKER"); err != nil {
+		panic(err)
+	}
+	
+	flags.StringSlice("events-kafka-brokers", conf.Events.Kafka.Brokers, "kafka brokers the events are written to")
+	if err = viper.BindPFlag("events.kafka.brokers", flags.Lookup("events-kafka-brokers")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("events.kafka.brokers", "PERMIFY_EVENTS_KAFKA_BROKERS"); err != nil {
+		panic(err)
+	}
+	
+	flags.String("events-kafka-topic", conf.Events.Kafka.Topic, "kafka topic the events are written to")
+	if err = viper.BindPFlag("events.kafka.topic", flags.Lookup("events-kafka-topic")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("events.kafka.topic", "PERMIFY_EVENTS_KAFKA_TOPIC"); err != nil {
+		panic(err)
+	}
+	
+	flags.String("events-nats-url", conf.Events.NATS.URL, "url of the nats server")
+	if err = viper.BindPFlag("events.nats.url", flags.Lookup("events-nats-url")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("events.nats.url", "PERMIFY_EVENTS_NATS_URL"); err != nil {
+		panic(err)
+	}
+	
+	flags.String("events-nats-subject", conf.Events.NATS.Subject, "subject the events are published on, followed by the id of their tenant")
+	if err = viper.BindPFlag("events.nats.subject", flags.Lookup("events-nats-subject")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("events.nats.subject", "PERMIFY_EVENTS_NATS_SUBJECT"); err != nil {
+		panic(err)
+	}
+	
+	flags.Duration("events-refresh-interval", conf.Events.RefreshInterval, "how often the tenants are listed to publish the writes of the new ones")
+	if err = viper.BindPFlag("events.refresh_interval", flags.Lookup("events-refresh-interval")); err != nil {
+		panic(err)
+	}
+	if err = viper.BindEnv("events.refresh_interval", "PERMIFY_EVENTS_REFRESH_INTERVAL"); err != nil {
 		panic(err)
 	}
 }
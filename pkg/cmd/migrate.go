@@ -91,8 +91,10 @@ func migrateUp() func(cmd *cobra.Command, args []string) error {
 			return err
 		}
 
+		dir := migrationDir(flags[databaseEngine])
+
 		switch flags[databaseEngine] {
-		case "postgres":
+		case "postgres", "cockroach":
 			flags[databaseEngine] = "pgx"
 		}
 
@@ -109,7 +111,7 @@ func migrateUp() func(cmd *cobra.Command, args []string) error {
 		}
 
 		if p == 0 {
-			if err := goose.Up(db, "internal/repositories/postgres/migrations"); err != nil {
+			if err := goose.Up(db, dir); err != nil {
 				color.Warn.Println("migration failed: up error " + err.Error())
 				return nil
 			}
@@ -118,7 +120,7 @@ func migrateUp() func(cmd *cobra.Command, args []string) error {
 			return nil
 		}
 
-		if err := goose.UpTo(db, "internal/repositories/postgres/migrations", p); err != nil {
+		if err := goose.UpTo(db, dir, p); err != nil {
 			color.Warn.Println("migration failed: Goose Up Error")
 			return nil
 		}
@@ -137,8 +139,10 @@ func migrateDown() func(cmd *cobra.Command, args []string) error {
 			return nil
 		}
 
+		dir := migrationDir(flags[databaseEngine])
+
 		switch flags[databaseEngine] {
-		case "postgres":
+		case "postgres", "cockroach":
 			flags[databaseEngine] = "pgx"
 		}
 
@@ -155,7 +159,7 @@ func migrateDown() func(cmd *cobra.Command, args []string) error {
 
 		if p == 0 {
 			var count int
-			err = filepath.Walk("internal/repositories/postgres/migrations", func(path string, info os.FileInfo, err error) error {
+			err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
 				if !info.IsDir() && strings.HasSuffix(info.Name(), ".sql") {
 					count++
 				}
@@ -167,7 +171,7 @@ func migrateDown() func(cmd *cobra.Command, args []string) error {
 			}
 
 			for i := 0; i < count; i++ {
-				if err := goose.Down(db, "internal/repositories/postgres/migrations"); err != nil {
+				if err := goose.Down(db, dir); err != nil {
 					color.Warn.Println("migration failed: down error " + err.Error())
 					return nil
 				}
@@ -177,7 +181,7 @@ func migrateDown() func(cmd *cobra.Command, args []string) error {
 			return nil
 		}
 
-		if err := goose.DownTo(db, "internal/repositories/postgres/migrations", p); err != nil {
+		if err := goose.DownTo(db, dir, p); err != nil {
 			color.Warn.Println("migration failed: down error " + err.Error())
 
 			return nil
@@ -197,8 +201,10 @@ func migrateStatus() func(cmd *cobra.Command, args []string) error {
 			return err
 		}
 
+		dir := migrationDir(flags[databaseEngine])
+
 		switch flags[databaseEngine] {
-		case "postgres":
+		case "postgres", "cockroach":
 			flags[databaseEngine] = "pgx"
 		}
 
@@ -208,7 +214,7 @@ func migrateStatus() func(cmd *cobra.Command, args []string) error {
 			return nil
 		}
 
-		if err := goose.Status(db, "internal/repositories/postgres/migrations"); err != nil {
+		if err := goose.Status(db, dir); err != nil {
 			color.Warn.Println("migration failed: check status error " + err.Error())
 			return nil
 		}
@@ -217,6 +223,14 @@ func migrateStatus() func(cmd *cobra.Command, args []string) error {
 	}
 }
 
+// migrationDir - Directory of the migrations of the database engine
+func migrationDir(engine string) string {
+	if engine == "cockroach" {
+		return "internal/repositories/cockroach/migrations"
+	}
+	return "internal/repositories/postgres/migrations"
+}
+
 func getFlags(cmd *cobra.Command, flags []string) (map[string]string, error) {
 	resp := make(map[string]string, len(flags))
 
@@ -0,0 +1,322 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	
+	"github.com/gookit/color"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+	
+	"github.com/adminium/permify/internal/servers"
+	"github.com/adminium/permify/pkg/migration"
+	v1 "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+const (
+	format     = "format"
+	snapToken  = "snap-token"
+	entityType = "entity-type"
+	dryRun     = "dry-run"
+	batchSize  = "batch-size"
+)
+
+// NewRelationshipsCommand - Creates new relationships command
+func NewRelationshipsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relationships",
+		Short: "export and import the relationships of a tenant as ndjson or csv files, and migrate them",
+		Args:  cobra.NoArgs,
+	}
+	
+	cmd.PersistentFlags().String(endpoint, "localhost:3478", "grpc endpoint of the permify server")
+	cmd.PersistentFlags().String(tenant, "t1", "tenant id")
+	cmd.PersistentFlags().String(apiToken, "", "bearer token sent with every request")
+	cmd.PersistentFlags().Bool(tlsEnabled, false, "connect to the server over tls")
+	cmd.PersistentFlags().String(format, "", "file format, ndjson or csv, taken from the file extension if empty")
+	
+	cmd.AddCommand(NewRelationshipsExportCommand())
+	cmd.AddCommand(NewRelationshipsImportCommand())
+	cmd.AddCommand(NewRelationshipsMigrateCommand())
+	
+	return cmd
+}
+
+// NewRelationshipsExportCommand - Creates new relationships export command
+func NewRelationshipsExportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "export the relationships of a tenant, all read at the same snapshot",
+		RunE:  relationshipsExport(),
+		Args:  cobra.NoArgs,
+	}
+	
+	cmd.Flags().String(snapToken, "", "snap token to export the relationships at, head snapshot if empty")
+	cmd.Flags().String(entityType, "", "export only the relationships of the entity type")
+	cmd.Flags().String(output, "-", "file to export to, standard output if -")
+	
+	return cmd
+}
+
+// NewRelationshipsImportCommand - Creates new relationships import command
+func NewRelationshipsImportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "import relationships into a tenant, validated against its schema",
+		RunE:  relationshipsImport(),
+		Args:  cobra.ExactArgs(1),
+	}
+	
+	cmd.Flags().String(schemaVersion, "", "schema version to validate the relationships against, head version if empty")
+	
+	return cmd
+}
+
+// NewRelationshipsMigrateCommand - Creates new relationships migrate command
+func NewRelationshipsMigrateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate <spec>",
+		Short: "rewrite the relationships of renamed and split relations as described by a yaml migration spec",
+		RunE:  relationshipsMigrate(),
+		Args:  cobra.ExactArgs(1),
+	}
+	
+	cmd.Flags().String(snapToken, "", "snap token to read the relationships at, head snapshot if empty")
+	cmd.Flags().Bool(dryRun, false, "print the rewrites without applying them")
+	cmd.Flags().Uint32(batchSize, 100, "number of new relationships written at once")
+	
+	return cmd
+}
+
+// relationshipsExport - permify relationships export command
+func relationshipsExport() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		flags, err := getFlags(cmd, []string{tenant, format, snapToken, entityType, output})
+		if err != nil {
+			return err
+		}
+		
+		f, err := fileFormat(flags[format], flags[output])
+		if err != nil {
+			return err
+		}
+		
+		var w io.Writer = os.Stdout
+		if flags[output] != "-" {
+			var file *os.File
+			file, err = os.Create(flags[output])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			w = file
+		}
+		encoder, err := tuple.NewEncoder(w, f)
+		if err != nil {
+			return err
+		}
+		
+		ctx, conn, err := dial(cmd)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		
+		stream, err := v1.NewRelationshipClient(conn).Export(ctx, &v1.RelationshipExportRequest{
+			TenantId: flags[tenant],
+			Metadata: &v1.RelationshipReadRequestMetadata{
+				SnapToken: flags[snapToken],
+			},
+			EntityType: flags[entityType],
+		})
+		if err != nil {
+			return err
+		}
+		
+		count := 0
+		snap := ""
+		for snap == "" {
+			response, err := stream.Recv()
+			if err != nil {
+				color.Danger.Println("export failed: " + err.Error())
+				return err
+			}
+			for _, t := range response.GetTuples() {
+				if err = encoder.Encode(t); err != nil {
+					return err
+				}
+				count++
+			}
+			snap = response.GetSnapToken()
+		}
+		if err = encoder.Flush(); err != nil {
+			return err
+		}
+		
+		// the relationships may be written to standard output, so the summary goes to standard error
+		fmt.Fprintf(os.Stderr, "%d relationships exported at snap token %s ✓ ✅ \n", count, snap)
+		return nil
+	}
+}
+
+// relationshipsImport - permify relationships import command
+func relationshipsImport() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		flags, err := getFlags(cmd, []string{tenant, format, schemaVersion})
+		if err != nil {
+			return err
+		}
+		
+		f, err := fileFormat(flags[format], args[0])
+		if err != nil {
+			return err
+		}
+		
+		file, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		decoder, err := tuple.NewDecoder(file, f)
+		if err != nil {
+			return err
+		}
+		
+		ctx, conn, err := dial(cmd)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		
+		stream, err := v1.NewRelationshipClient(conn).Import(ctx)
+		if err != nil {
+			return err
+		}
+		if err = servers.SendTuples(stream, decoder, &v1.RelationshipImportRequest{
+			TenantId: flags[tenant],
+			Metadata: &v1.RelationshipWriteRequestMetadata{
+				SchemaVersion: flags[schemaVersion],
+			},
+		}); err != nil && !errors.Is(err, io.EOF) {
+			color.Danger.Println("import failed: " + err.Error())
+			return err
+		}
+		
+		// a failed import ends the stream, its error is received with the response
+		response, err := stream.CloseAndRecv()
+		if err != nil {
+			color.Danger.Println("import failed: " + err.Error())
+			return err
+		}
+		
+		color.Success.Printf("%d relationships imported, snap token: %s ✓ ✅ \n", response.GetImported(), response.GetSnapToken())
+		return nil
+	}
+}
+
+// relationshipsMigrate - permify relationships migrate command
+func relationshipsMigrate() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		flags, err := getFlags(cmd, []string{tenant, snapToken})
+		if err != nil {
+			return err
+		}
+		dry, err := cmd.Flags().GetBool(dryRun)
+		if err != nil {
+			return err
+		}
+		size, err := cmd.Flags().GetUint32(batchSize)
+		if err != nil {
+			return err
+		}
+		
+		b, err := os.ReadFile(args[0])
+		if err != nil {
+			return err
+		}
+		var spec migration.Spec
+		if err = yaml.Unmarshal(b, &spec); err != nil {
+			return err
+		}
+		if err = spec.Validate(); err != nil {
+			return err
+		}
+		
+		ctx, conn, err := dial(cmd)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		client := v1.NewRelationshipClient(conn)
+		
+		if dry {
+			response, err := client.PlanMigration(ctx, &v1.RelationshipPlanMigrationRequest{
+				TenantId: flags[tenant],
+				Metadata: &v1.RelationshipReadRequestMetadata{
+					SnapToken: flags[snapToken],
+				},
+				Rules: spec.ToRules(),
+			})
+			if err != nil {
+				color.Danger.Println("migration plan failed: " + err.Error())
+				return err
+			}
+			for _, rewrite := range response.GetRewrites() {
+				news := make([]string, 0, len(rewrite.GetNew()))
+				for _, t := range rewrite.GetNew() {
+					news = append(news, tuple.ToString(t))
+				}
+				verb := "move"
+				if !rewrite.GetDelete() {
+					verb = "copy"
+				}
+				fmt.Printf("%s %s -> %s\n", verb, tuple.ToString(rewrite.GetOld()), strings.Join(news, ", "))
+			}
+			color.Success.Printf("%d rewrites planned ✓ ✅ \n", len(response.GetRewrites()))
+			return nil
+		}
+		
+		stream, err := client.ApplyMigration(ctx, &v1.RelationshipApplyMigrationRequest{
+			TenantId: flags[tenant],
+			Metadata: &v1.RelationshipReadRequestMetadata{
+				SnapToken: flags[snapToken],
+			},
+			Rules:     spec.ToRules(),
+			BatchSize: size,
+		})
+		if err != nil {
+			return err
+		}
+		for {
+			response, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				color.Danger.Println("migration failed: " + err.Error())
+				return err
+			}
+			if response.GetSnapToken() == "" && response.GetApplied() < response.GetTotal() {
+				fmt.Printf("%d/%d rewrites applied\n", response.GetApplied(), response.GetTotal())
+				continue
+			}
+			color.Success.Printf("%d rewrites applied, snap token: %s ✓ ✅ \n", response.GetApplied(), response.GetSnapToken())
+		}
+	}
+}
+
+// fileFormat - The format of the flag, or of the extension of the file when the flag is empty
+func fileFormat(flag, file string) (tuple.Format, error) {
+	if flag != "" {
+		return tuple.ParseFormat(flag)
+	}
+	if strings.EqualFold(filepath.Ext(file), ".csv") {
+		return tuple.CSV, nil
+	}
+	return tuple.NDJSON, nil
+}
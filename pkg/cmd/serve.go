@@ -2,29 +2,56 @@ package cmd
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"os/signal"
+	"strings"
+	"sync/atomic"
 	"syscall"
+	"time"
 	
 	"github.com/spf13/viper"
 	
+	"github.com/dustin/go-humanize"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 	"go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/trace"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	
 	"github.com/adminium/permify/internal"
+	"github.com/adminium/permify/internal/archive"
+	"github.com/adminium/permify/internal/authn"
+	"github.com/adminium/permify/internal/cluster"
 	"github.com/adminium/permify/internal/commands"
 	"github.com/adminium/permify/internal/config"
+	"github.com/adminium/permify/internal/deadline"
+	"github.com/adminium/permify/internal/decisions"
+	"github.com/adminium/permify/internal/diagnostics"
+	"github.com/adminium/permify/internal/events"
 	"github.com/adminium/permify/internal/factories"
+	"github.com/adminium/permify/internal/gc"
+	"github.com/adminium/permify/internal/invalidation"
 	"github.com/adminium/permify/internal/keys"
 	"github.com/adminium/permify/internal/repositories"
 	"github.com/adminium/permify/internal/repositories/decorators"
 	"github.com/adminium/permify/internal/servers"
 	"github.com/adminium/permify/internal/services"
+	"github.com/adminium/permify/internal/usage"
+	"github.com/adminium/permify/internal/views"
+	"github.com/adminium/permify/internal/warmup"
+	ArchiveStore "github.com/adminium/permify/pkg/archive"
 	"github.com/adminium/permify/pkg/cache"
+	"github.com/adminium/permify/pkg/cache/redis"
 	"github.com/adminium/permify/pkg/cache/ristretto"
 	"github.com/adminium/permify/pkg/database"
+	CRDatabase "github.com/adminium/permify/pkg/database/cockroach"
+	PQDatabase "github.com/adminium/permify/pkg/database/postgres"
+	RFDatabase "github.com/adminium/permify/pkg/database/raft"
+	"github.com/adminium/permify/pkg/encryption"
+	EventBroker "github.com/adminium/permify/pkg/events"
 	"github.com/adminium/permify/pkg/logger"
 	"github.com/adminium/permify/pkg/telemetry"
 	"github.com/adminium/permify/pkg/telemetry/meterexporters"
@@ -97,13 +124,13 @@ func serve() func(cmd *cobra.Command, args []string) error {
 		// Meter
 		meter := telemetry.NewNoopMeter()
 		if cfg.Meter.Enabled {
-			var exporter metric.Exporter
-			exporter, err = meterexporters.ExporterFactory(cfg.Meter.Exporter, cfg.Meter.Endpoint)
+			var reader metric.Reader
+			reader, err = meterexporters.ExporterFactory(cfg.Meter.Exporter, cfg.Meter.Endpoint)
 			if err != nil {
 				l.Fatal(err)
 			}
 			
-			meter, err = telemetry.NewMeter(exporter)
+			meter, err = telemetry.NewMeter(reader)
 			if err != nil {
 				l.Fatal(err)
 			}
@@ -131,6 +158,40 @@ func serve() func(cmd *cobra.Command, args []string) error {
 		tenantReader := factories.TenantReaderFactory(db, l)
 		tenantWriter := factories.TenantWriterFactory(db, l)
 		
+		// the decorators do not forward query plans, so the explainer is taken before they are applied
+		queryExplainer, _ := relationshipReader.(repositories.QueryExplainer)
+		
+		// the durations of the queries are measured right at the storage, before the other decorators add to them
+		if cfg.Meter.Enabled {
+			relationshipReader = decorators.NewRelationshipReaderWithMetrics(relationshipReader, meter)
+			relationshipWriter = decorators.NewRelationshipWriterWithMetrics(relationshipWriter, meter)
+			schemaReader = decorators.NewSchemaReaderWithMetrics(schemaReader, meter)
+		}
+		
+		// the ids of the tuples of the tenants with a data key are encrypted before anything else sees the storage
+		var keyring *encryption.Keyring
+		if cfg.Database.Encryption.Enabled {
+			keyring, err = factories.KeyringFactory(cfg.Database.Encryption)
+			if err != nil {
+				l.Fatal(err)
+			}
+			relationshipReader = decorators.NewRelationshipReaderWithEncryption(relationshipReader, keyring)
+			relationshipWriter = decorators.NewRelationshipWriterWithEncryption(relationshipWriter, keyring)
+		}
+		
+		// the storage reads of a request take shares of its deadline, so that a slow one is cut and tried again in time
+		var budget deadline.Budget
+		if cfg.Permission.DeadlineBudget.Enabled {
+			budget = deadline.Budget{
+				Schema: cfg.Permission.DeadlineBudget.Schema,
+				Query:  cfg.Permission.DeadlineBudget.Query,
+				Check:  cfg.Permission.DeadlineBudget.Check,
+				Floor:  cfg.Permission.DeadlineBudget.Floor,
+			}
+			relationshipReader = decorators.NewRelationshipReaderWithDeadlineBudget(relationshipReader, budget)
+			schemaReader = decorators.NewSchemaReaderWithDeadlineBudget(schemaReader, budget)
+		}
+		
 		// decorators
 		schemaReader = decorators.NewSchemaReaderWithCache(schemaReader, schemaCache)
 		
@@ -143,44 +204,605 @@ func serve() func(cmd *cobra.Command, args []string) error {
 			schemaReader = decorators.NewSchemaReaderWithCircuitBreaker(schemaReader)
 		}
 		
+		// multi-region
+		var keyOptions []keys.CommandKeysOption
+		if cfg.Distributed.Enabled {
+			relationshipReader = decorators.NewRelationshipReaderWithRegion(relationshipReader, cfg.Distributed.Region, factories.SnapTokenDecoderFactory(db))
+			relationshipWriter = decorators.NewRelationshipWriterWithRegion(relationshipWriter, cfg.Distributed.Region)
+			keyOptions = append(keyOptions, keys.Epoch(cfg.Distributed.Region, cfg.Distributed.CacheEpoch))
+		}
+		
+		// settings that change the behavior of the engine tenant by tenant, they are read by every check
+		tenantSettingsReader := factories.TenantSettingsReaderFactory(db, l)
+		tenantSettingsWriter := factories.TenantSettingsWriterFactory(db, l)
+		var tenantSettings *decorators.TenantSettingsWithCache
+		if tenantSettingsReader != nil && tenantSettingsWriter != nil {
+			var settingsCache cache.Cache
+			settingsCache, err = ristretto.New()
+			if err != nil {
+				l.Fatal(err)
+			}
+			tenantSettings = decorators.NewTenantSettingsWithCache(tenantSettingsReader, tenantSettingsWriter, settingsCache)
+			keyOptions = append(keyOptions, keys.TenantTTL(func(tenantID string) time.Duration {
+				settings, err := tenantSettings.ReadTenantSettings(context.Background(), tenantID)
+				if err != nil {
+					return 0
+				}
+				return settings.CacheTTL
+			}))
+		}
+		
 		// key managers
-		checkKeyManager := keys.NewCheckCommandKeys(commandsKeyCache)
+		keyOptions = append(keyOptions, keys.CommandKeysMeter(meter))
+		checkKeyManager := keys.NewCheckCommandKeys(commandsKeyCache, keyOptions...)
+		
+		// the results of the checks and their invalidations are shared by the replicas through the second level
+		if cfg.Permission.SharedCache.Enabled {
+			if cfg.Permission.SharedCache.Engine != cache.REDIS.String() {
+				l.Fatal(fmt.Errorf("%s shared cache is unsupported", cfg.Permission.SharedCache.Engine))
+			}
+			var sharedCache *redis.Redis
+			sharedCache, err = redis.New(cfg.Permission.SharedCache.Address,
+				redis.Credentials(cfg.Permission.SharedCache.Username, cfg.Permission.SharedCache.Password),
+				redis.DB(cfg.Permission.SharedCache.DB),
+				redis.Prefix(cfg.Permission.SharedCache.Prefix),
+				redis.TTL(cfg.Permission.SharedCache.TTL),
+				redis.Timeout(cfg.Permission.SharedCache.Timeout),
+			)
+			if err != nil {
+				l.Fatal(err)
+			}
+			defer sharedCache.Close()
+			checkKeyManager = keys.NewTieredCheckCommandKeys(checkKeyManager, keys.NewSharedCheckCommandKeys(sharedCache, keyOptions...))
+		}
+		
+		// the responses of the expands and the schema lookups are invalidated by the hooks of the cached checks
+		var responseKeys *keys.ResponseKeys
+		if cfg.Permission.ResponseCache.Enabled {
+			var responseCache cache.Cache
+			responseCache, err = ristretto.New(ristretto.NumberOfCounters(cfg.Permission.ResponseCache.Cache.NumberOfCounters), ristretto.MaxCost(cfg.Permission.ResponseCache.Cache.MaxCost))
+			if err != nil {
+				l.Fatal(err)
+			}
+			responseKeys = keys.NewResponseKeys(checkKeyManager, responseCache)
+			checkKeyManager = responseKeys
+		}
+		
+		// written relations invalidate only the cached checks of the permissions that depend on them
+		relationshipWriter = decorators.NewRelationshipWriterWithInvalidation(relationshipWriter, schemaReader, checkKeyManager)
+		
+		checkOptions := []commands.CheckOption{
+			commands.ConcurrencyLimit(cfg.Permission.ConcurrencyLimit),
+			commands.MinimizeLatencyStaleness(cfg.Permission.MinimizeLatencyStaleness),
+		}
+		
+		// relations resolved by external data sources
+		if len(cfg.Permission.Externals) > 0 {
+			var externalCache cache.Cache
+			externalCache, err = ristretto.New()
+			if err != nil {
+				l.Fatal(err)
+			}
+			
+			var externals []commands.External
+			var closeExternals func()
+			externals, closeExternals, err = externalResolvers(cfg.Permission.Externals)
+			if err != nil {
+				l.Fatal(err)
+			}
+			defer closeExternals()
+			
+			checkOptions = append(checkOptions, commands.Externals(externalCache, externals...))
+		}
+		
+		// attributes that the rules of the schemas are evaluated with
+		attributeReader := factories.AttributeReaderFactory(db, l)
+		attributeWriter := factories.AttributeWriterFactory(db, l)
+		if attributeReader != nil {
+			checkOptions = append(checkOptions, commands.Attributes(attributeReader))
+		}
+		
+		if cfg.Permission.OPA.Enabled {
+			checkOptions = append(checkOptions, commands.Policies(commands.NewOPAPolicyEvaluator(cfg.Permission.OPA.URL, cfg.Permission.OPA.Timeout)))
+		}
+		
+		if cfg.Permission.SubjectTypeRestrictions {
+			checkOptions = append(checkOptions, commands.SubjectTypeRestrictions())
+		}
+		
+		var relationshipOptions []services.RelationshipOption
+		if tenantSettings != nil {
+			checkOptions = append(checkOptions, commands.TenantSettings(tenantSettings))
+			relationshipOptions = append(relationshipOptions, services.RelationshipTenantSettings(tenantSettings))
+		}
+		
+		// the ring of the peers follows the scale events of the region, the subproblems of the checks are spread over it
+		var membership *cluster.Membership
+		var dispatcher *cluster.Dispatcher
+		if cfg.Distributed.Dispatch.Enabled {
+			var discoverer cluster.Discoverer
+			discoverer, err = factories.DiscovererFactory(cfg.Distributed.Dispatch.Discovery)
+			if err != nil {
+				l.Fatal(err)
+			}
+			
+			ring := cluster.NewRing(0)
+			membership = cluster.NewMembership(ring, discoverer, cfg.Distributed.Dispatch.Address, cfg.Distributed.Dispatch.Discovery.RefreshInterval, cfg.Distributed.Dispatch.Discovery.RemovalDelay, l)
+			
+			var dialOptions []grpc.DialOption
+			if cfg.Distributed.Dispatch.PeerKey != "" {
+				dialOptions = append(dialOptions,
+					grpc.WithTransportCredentials(insecure.NewCredentials()),
+					grpc.WithPerRPCCredentials(authn.PeerCredentials(cfg.Distributed.Dispatch.PeerKey)),
+				)
+			}
+			dispatcher = cluster.NewDispatcher(ring, cfg.Distributed.Dispatch.Address, cfg.Distributed.Dispatch.Timeout, l, dialOptions...)
+			defer dispatcher.Close()
+			checkOptions = append(checkOptions, commands.Dispatcher(dispatcher), commands.DeadlineBudget(budget))
+		}
+		
+		// the orphaned tuples of the written schemas are looked up under the names they are stored with
+		schemaService := services.NewSchemaService(schemaWriter, schemaReader, services.SchemaMeter(meter), services.SchemaRelationshipReader(relationshipReader))
+		
+		// the tuples of renamed entities are read under the aliases of the head schemas as well
+		relationshipReader = decorators.NewRelationshipReaderWithAliases(relationshipReader, schemaService)
 		
 		// commands
 		var checkCommand *commands.CheckCommand
-		checkCommand, err = commands.NewCheckCommand(checkKeyManager, schemaReader, relationshipReader, meter, commands.ConcurrencyLimit(cfg.Permission.ConcurrencyLimit))
+		checkCommand, err = commands.NewCheckCommand(checkKeyManager, schemaReader, relationshipReader, meter, checkOptions...)
+		if err != nil {
+			l.Fatal(err)
+		}
+		
+		// the tuples and ids held by the expands and lookups in flight are accounted against the limit
+		var memoryLimit uint64
+		memoryLimit, err = humanize.ParseBytes(cfg.Permission.MemoryLimit)
 		if err != nil {
 			l.Fatal(err)
 		}
+		memoryTracker := commands.NewMemoryTracker(int64(memoryLimit), meter)
 		
-		expandCommand := commands.NewExpandCommand(schemaReader, relationshipReader)
+		expandCommand := commands.NewExpandCommand(schemaReader, relationshipReader, commands.ExpandSubjectLimit(cfg.Permission.ExpandSubjectLimit), commands.ExpandMemoryLimit(memoryTracker))
 		schemaLookupCommand := commands.NewLookupSchemaCommand(schemaReader)
-		lookupEntityCommand := commands.NewLookupEntityCommand(checkCommand, schemaReader, relationshipReader)
+		lookupEntityCommand := commands.NewLookupEntityCommand(checkCommand, schemaReader, relationshipReader, commands.LookupEntityMemoryLimit(memoryTracker))
+		lookupSubjectCommand := commands.NewLookupSubjectCommand(checkCommand, schemaReader, relationshipReader, commands.LookupSubjectMemoryLimit(memoryTracker))
+		
+		// the lookups of the most read permissions are served from allow lists kept up to date with the changes
+		var permissionExpandCommand commands.IExpandCommand = expandCommand
+		var permissionLookupEntityCommand commands.ILookupEntityCommand = lookupEntityCommand
+		var permissionLookupSubjectCommand commands.ILookupSubjectCommand = lookupSubjectCommand
+		var materializer *views.Materializer
+		if cfg.Permission.Views.Enabled {
+			watcher := factories.WatcherFactory(db, l)
+			if watcher == nil {
+				l.Fatal(fmt.Sprintf("database engine %s does not support watching the changes to materialize", cfg.Database.Engine))
+			}
+			var materialized []views.View
+			for _, permission := range cfg.Permission.Views.Permissions {
+				var view views.View
+				view, err = views.ParseView(permission)
+				if err != nil {
+					l.Fatal(err)
+				}
+				materialized = append(materialized, view)
+			}
+			materializer = views.NewMaterializer(watcher, tenantReader, relationshipReader, schemaReader, lookupSubjectCommand, materialized, l,
+				views.MaxStaleness(cfg.Permission.Views.MaxStaleness),
+				views.RefreshInterval(cfg.Permission.Views.RefreshInterval),
+			)
+			permissionLookupEntityCommand = views.NewLookupEntityCommand(lookupEntityCommand, schemaReader, materializer)
+			permissionLookupSubjectCommand = views.NewLookupSubjectCommand(lookupSubjectCommand, schemaReader, materializer)
+		}
+		
+		// the most frequent checks are recorded so that the next process can warm up its caches with them
+		var permissionCheckCommand commands.ICheckCommand = checkCommand
+		var recorder *warmup.Recorder
+		if cfg.Permission.Warmup.Enabled {
+			recorder = warmup.NewRecorder(cfg.Permission.Warmup.Size * 10)
+			permissionCheckCommand = warmup.NewRecordingCheckCommand(checkCommand, recorder)
+		}
+		
+		// a fraction of the checks is traced and written to the storage in the background, the admin service lists them
+		var traceSampler *diagnostics.Sampler
+		checkTraceWriter := factories.CheckTraceWriterFactory(db, l)
+		if cfg.Permission.TraceSampling.Enabled {
+			if checkTraceWriter != nil {
+				traceSampler = diagnostics.NewSampler(checkTraceWriter, cfg.Permission.TraceSampling.SampleRate, cfg.Permission.TraceSampling.Retention, l,
+					diagnostics.QueueSize(cfg.Permission.TraceSampling.QueueSize),
+				)
+				permissionCheckCommand = diagnostics.NewSamplingCheckCommand(permissionCheckCommand, traceSampler)
+			} else {
+				l.Warn("trace sampling is not supported by %s, the checks are not traced", db.GetEngineType())
+			}
+		}
+		
+		// the checks are aggregated by tenant so that the admin service can report the modeling hotspots
+		var adminOptions []services.AdminOption
+		if cfg.Permission.Usage.Enabled {
+			collector := usage.NewCollector(cfg.Permission.Usage.Window)
+			permissionCheckCommand = usage.NewRecordingCheckCommand(permissionCheckCommand, collector)
+			adminOptions = append(adminOptions, services.AdminUsage(collector))
+		}
+		if dispatcher != nil {
+			adminOptions = append(adminOptions, services.AdminDispatcher(dispatcher))
+		}
+		// the admin warmup takes the most frequent checks so far, or the ones of the file until any are recorded
+		if recorder != nil {
+			adminOptions = append(adminOptions, services.AdminWarmup(func() ([]warmup.Pattern, error) {
+				if patterns := recorder.Top(cfg.Permission.Warmup.Size); len(patterns) > 0 {
+					return patterns, nil
+				}
+				return warmup.Load(cfg.Permission.Warmup.File)
+			}))
+		}
+		
+		// the decisions are sent in the background, a slow sink drops decisions instead of slowing the checks down
+		var decisionLogger *decisions.Logger
+		if cfg.Permission.DecisionLog.Enabled {
+			var sinks []decisions.Sink
+			for _, webhook := range cfg.Permission.DecisionLog.Webhooks {
+				sinks = append(sinks, decisions.NewWebhook(webhook.URL, webhook.Headers, webhook.Timeout))
+			}
+			if len(cfg.Permission.DecisionLog.Kafka.Brokers) > 0 {
+				sinks = append(sinks, decisions.NewKafka(cfg.Permission.DecisionLog.Kafka.Brokers, cfg.Permission.DecisionLog.Kafka.Topic))
+			}
+			if cfg.Permission.DecisionLog.File != "" {
+				var file *decisions.File
+				file, err = decisions.NewFile(cfg.Permission.DecisionLog.File)
+				if err != nil {
+					l.Fatal(err)
+				}
+				sinks = append(sinks, file)
+			}
+			if cfg.Permission.DecisionLog.Postgres {
+				switch d := db.(type) {
+				case *PQDatabase.Postgres:
+					sinks = append(sinks, decisions.NewPostgres(d))
+				case *CRDatabase.Cockroach:
+					sinks = append(sinks, decisions.NewPostgres(d.Postgres))
+				default:
+					l.Fatal(fmt.Errorf("decisions can not be written to a %s database", cfg.Database.Engine))
+				}
+			}
+			decisionLogger = decisions.NewLogger(sinks, decisions.Filter{
+				Operations:  cfg.Permission.DecisionLog.Operations,
+				Tenants:     cfg.Permission.DecisionLog.Tenants,
+				Permissions: cfg.Permission.DecisionLog.Permissions,
+				OnlyDenied:  cfg.Permission.DecisionLog.OnlyDenied,
+				SampleRate:  cfg.Permission.DecisionLog.SampleRate,
+			}, l,
+				decisions.BatchSize(cfg.Permission.DecisionLog.BatchSize),
+				decisions.FlushInterval(cfg.Permission.DecisionLog.FlushInterval),
+				decisions.QueueSize(cfg.Permission.DecisionLog.QueueSize),
+			)
+			permissionCheckCommand = decisions.NewLoggingCheckCommand(permissionCheckCommand, decisionLogger)
+			permissionExpandCommand = decisions.NewLoggingExpandCommand(permissionExpandCommand, decisionLogger)
+			permissionLookupEntityCommand = decisions.NewLoggingLookupEntityCommand(permissionLookupEntityCommand, decisionLogger)
+			permissionLookupSubjectCommand = decisions.NewLoggingLookupSubjectCommand(permissionLookupSubjectCommand, decisionLogger)
+		}
 		
 		// Services
-		relationshipService := services.NewRelationshipService(relationshipReader, relationshipWriter, schemaReader)
-		permissionService := services.NewPermissionService(checkCommand, expandCommand, schemaLookupCommand, lookupEntityCommand)
-		schemaService := services.NewSchemaService(schemaWriter, schemaReader)
+		relationshipService := services.NewRelationshipService(relationshipReader, relationshipWriter, schemaReader, relationshipOptions...)
+		var permissionOptions []services.PermissionOption
+		if responseKeys != nil {
+			permissionOptions = append(permissionOptions, services.PermissionResponseCache(responseKeys, schemaReader, relationshipReader, meter))
+		}
+		permissionService := services.NewPermissionService(permissionCheckCommand, permissionExpandCommand, schemaLookupCommand, permissionLookupEntityCommand, permissionLookupSubjectCommand, permissionOptions...)
 		tenancyService := services.NewTenancyService(tenantWriter, tenantReader)
 		
+		// the tenants are migrated from the repositories of the server to the backends the admin service opens
+		adminOptions = append(adminOptions, services.AdminBackends(services.Backend{
+			Engine:             db.GetEngineType(),
+			SchemaReader:       schemaReader,
+			SchemaWriter:       schemaWriter,
+			RelationshipReader: relationshipReader,
+			RelationshipWriter: relationshipWriter,
+			TenantReader:       tenantReader,
+			TenantWriter:       tenantWriter,
+		}, backendOpener(l)))
+		
+		// the versions of the deleted tuples are collected every interval and on demand through the admin service, the
+		// snap tokens older than the window can no longer be read
+		var garbageCollector *gc.Collector
+		var snapshotHorizon *gc.Horizon
+		if cfg.Database.GarbageCollection.Enabled {
+			collector := factories.GarbageCollectorFactory(db, l)
+			if collector == nil {
+				l.Fatal(fmt.Sprintf("database engine %s does not keep the deleted tuples to collect", cfg.Database.Engine))
+			}
+			if horizon, ok := collector.(repositories.SnapshotHorizon); ok {
+				snapshotHorizon = gc.NewHorizon(horizon, cfg.Database.GarbageCollection.Window)
+			}
+			garbageCollector, err = gc.NewCollector(collector, cfg.Database.GarbageCollection.Window, cfg.Database.GarbageCollection.Interval, cfg.Database.GarbageCollection.BatchSize, meter, l)
+			if err != nil {
+				l.Fatal(err)
+			}
+			adminOptions = append(adminOptions, services.AdminGarbageCollector(garbageCollector))
+		}
+		
+		// the api keys are managed through the admin service and accepted by the preshared authentication
+		if checkTraceReader := factories.CheckTraceReaderFactory(db, l); checkTraceReader != nil {
+			adminOptions = append(adminOptions, services.AdminCheckTraces(checkTraceReader))
+		}
+		
+		apiKeyReader := factories.APIKeyReaderFactory(db, l)
+		if apiKeyWriter := factories.APIKeyWriterFactory(db, l); apiKeyReader != nil && apiKeyWriter != nil {
+			adminOptions = append(adminOptions, services.AdminAPIKeys(apiKeyReader, apiKeyWriter))
+		}
+		
 		container := servers.ServiceContainer{
 			RelationshipService: relationshipService,
 			PermissionService:   permissionService,
 			SchemaService:       schemaService,
 			TenancyService:      tenancyService,
+			OpenFGAService:      services.NewOpenFGAService(schemaService, relationshipService),
+			AdminService:        services.NewAdminService(db, schemaReader, relationshipReader, queryExplainer, checkKeyManager, schemaService, adminOptions...),
+			SnapshotHorizon:     snapshotHorizon,
+			APIKeyReader:        apiKeyReader,
+			InFlight:            servers.NewInFlightRequests(meter),
+			PeerKeys: map[string]string{
+				RFDatabase.ApplyMethod: cfg.Database.Raft.PeerKey,
+				cluster.CheckMethod:    cfg.Distributed.Dispatch.PeerKey,
+			},
+		}
+		
+		// the server is ready once the database answers and, when there are check patterns to warm up with, the caches
+		// are warm
+		var warmed atomic.Bool
+		warmed.Store(!cfg.Permission.Warmup.Enabled)
+		container.HealthProbes = map[string]servers.HealthProbe{
+			"database": func(ctx context.Context) error {
+				ready, err := db.IsReady(ctx)
+				if err != nil {
+					return err
+				}
+				if !ready {
+					return errors.New("the database is not ready")
+				}
+				return nil
+			},
+			"cache": func(context.Context) error {
+				if !warmed.Load() {
+					return errors.New("the caches are warming up")
+				}
+				return nil
+			},
+		}
+		
+		if cfg.Server.RateLimit.Enabled {
+			container.RateLimits = servers.NewRateLimits(cfg.Server.RateLimit, meter)
+		}
+		
+		if cfg.Permission.Fallback.Enabled {
+			container.FallbackCache, err = ristretto.New(ristretto.NumberOfCounters(cfg.Permission.Fallback.Cache.NumberOfCounters), ristretto.MaxCost(cfg.Permission.Fallback.Cache.MaxCost))
+			if err != nil {
+				l.Fatal(err)
+			}
+		}
+		
+		// the changefeed reads the storage directly, only the region of the tokens is added to it
+		if watcher := factories.WatcherFactory(db, l); watcher != nil {
+			if keyring != nil {
+				watcher = decorators.NewWatcherWithEncryption(watcher, keyring)
+			}
+			if cfg.Distributed.Enabled {
+				watcher = decorators.NewWatcherWithRegion(watcher, cfg.Distributed.Region)
+			}
+			container.WatchService = services.NewWatchService(commands.NewWatchCommand(watcher, relationshipReader))
+		}
+		
+		if attributeReader != nil && attributeWriter != nil {
+			container.AttributeService = services.NewAttributeService(attributeWriter, attributeReader, schemaReader, checkKeyManager)
+		}
+		
+		if tenantSettings != nil {
+			container.SettingsService = services.NewSettingsService(tenantSettings, tenantSettings, checkKeyManager)
+		}
+		
+		if cfg.Service.Identity.Enabled {
+			identityReader := factories.IdentityReaderFactory(db, l)
+			identityWriter := factories.IdentityWriterFactory(db, l)
+			if identityReader == nil || identityWriter == nil {
+				l.Fatal(fmt.Sprintf("database engine %s does not support identity mapping", cfg.Database.Engine))
+			}
+			container.IdentityService = services.NewIdentityService(identityWriter, identityReader)
+		}
+		
+		if cfg.Service.SCIM.Enabled {
+			container.SCIMService = services.NewSCIMService(relationshipService, services.SCIMMapping{
+				EntityType:      cfg.Service.SCIM.Mapping.EntityType,
+				Relation:        cfg.Service.SCIM.Mapping.Relation,
+				SubjectType:     cfg.Service.SCIM.Mapping.SubjectType,
+				SubjectRelation: cfg.Service.SCIM.Mapping.SubjectRelation,
+			})
+		}
+		
+		// the subproblems the peers route here are checked by the command itself, they are not recorded as the checks
+		// of clients
+		if membership != nil {
+			container.DispatchService = services.NewDispatchService(checkCommand, dispatcher)
+		}
+		
+		if replicated, ok := db.(*RFDatabase.Raft); ok {
+			container.ReplicationService = services.NewReplicationService(replicated)
 		}
 		
 		var g *errgroup.Group
 		g, ctx = errgroup.WithContext(ctx)
 		
 		g.Go(func() error {
-			return container.Run(ctx, &cfg.Server, &cfg.Authn, &cfg.Profiler, l)
+			return container.Run(ctx, &cfg.Server, &cfg.Authn, &cfg.Profiler, &cfg.Distributed, l)
 		})
 		
+		// prometheus scrapes the metrics from the endpoint instead of them being pushed
+		if cfg.Meter.Enabled && cfg.Meter.Exporter == "prometheus" {
+			g.Go(func() error {
+				return meterexporters.ServePrometheus(ctx, cfg.Meter.Endpoint)
+			})
+		}
+		
+		if membership != nil {
+			g.Go(func() error {
+				return membership.Run(ctx)
+			})
+		}
+		
+		if decisionLogger != nil {
+			g.Go(func() error {
+				return decisionLogger.Run(ctx)
+			})
+		}
+		
+		if traceSampler != nil {
+			g.Go(func() error {
+				return traceSampler.Run(ctx)
+			})
+		}
+		
+		if materializer != nil {
+			g.Go(func() error {
+				return materializer.Run(ctx)
+			})
+		}
+		
+		// the change log and the exports are kept in the object storage, the database only keeps what the checks need
+		if cfg.Archive.Enabled {
+			var store ArchiveStore.Store
+			store, err = factories.ArchiveStoreFactory(cfg.Archive)
+			if err != nil {
+				l.Fatal(err)
+			}
+			
+			archiver := archive.NewArchiver(store, tenantReader, relationshipReader, schemaReader, cfg.Archive.Interval, cfg.Archive.ExportInterval, cfg.Archive.Retention, l)
+			g.Go(func() error {
+				return archiver.Run(ctx)
+			})
+		}
+		
+		// the transactions of the database are the outbox of the events, every replica publishes them and the
+		// consumers drop the duplicates by their ids
+		if cfg.Events.Enabled {
+			watcher := factories.WatcherFactory(db, l)
+			if watcher == nil {
+				l.Fatal(fmt.Sprintf("database engine %s does not support watching the changes to publish", cfg.Database.Engine))
+			}
+			if keyring != nil {
+				watcher = decorators.NewWatcherWithEncryption(watcher, keyring)
+			}
+			if cfg.Distributed.Enabled {
+				watcher = decorators.NewWatcherWithRegion(watcher, cfg.Distributed.Region)
+			}
+			
+			var broker EventBroker.Broker
+			broker, err = factories.EventBrokerFactory(cfg.Events)
+			if err != nil {
+				l.Fatal(err)
+			}
+			
+			publisher := events.NewPublisher(watcher, tenantReader, relationshipReader, schemaReader, broker, factories.EventCursorsFactory(db), cfg.Events.RefreshInterval, l)
+			g.Go(func() error {
+				return publisher.Run(ctx)
+			})
+		}
+		
+		if garbageCollector != nil {
+			g.Go(func() error {
+				return garbageCollector.Run(ctx)
+			})
+		}
+		
+		// the tuples whose validity ended are ignored by the reads at once and deleted once the window passed
+		if cfg.Database.Sweeper.Enabled {
+			relationshipSweeper := factories.RelationshipSweeperFactory(db, l)
+			if relationshipSweeper == nil {
+				l.Fatal(fmt.Sprintf("database engine %s can not sweep the expired tuples", cfg.Database.Engine))
+			}
+			sweeper, err := gc.NewSweeper(relationshipSweeper, cfg.Database.Sweeper.Window, cfg.Database.Sweeper.Interval, cfg.Database.Sweeper.BatchSize, meter, l)
+			if err != nil {
+				l.Fatal(err)
+			}
+			g.Go(func() error {
+				return sweeper.Run(ctx)
+			})
+		}
+		
+		// the writes of the other replicas reach the cached checks of this one through the changes of the storage
+		if cfg.Permission.Invalidation.Enabled {
+			watcher := factories.WatcherFactory(db, l)
+			if watcher == nil {
+				l.Fatal(fmt.Sprintf("database engine %s does not support watching the changes to invalidate", cfg.Database.Engine))
+			}
+			
+			invalidator := invalidation.NewInvalidator(watcher, tenantReader, relationshipReader, schemaReader, checkKeyManager, cfg.Permission.Invalidation.RefreshInterval, l)
+			g.Go(func() error {
+				return invalidator.Run(ctx)
+			})
+		}
+		
+		if cfg.Permission.Warmup.Enabled {
+			var patterns []warmup.Pattern
+			patterns, err = warmup.Load(cfg.Permission.Warmup.File)
+			if err != nil {
+				l.Error(err)
+			}
+			
+			// warmup runs in the background, a failing warmup must not stop the server
+			go func() {
+				defer warmed.Store(true)
+				result, err := permissionService.WarmUp(ctx, patterns)
+				if err != nil {
+					l.Error(err)
+				}
+				l.Info("🔥 warmed up %d of %d check patterns, %d skipped and %d failed", result.Warmed, len(patterns), result.Skipped, result.Failed)
+			}()
+		}
+		
 		if err = g.Wait(); err != nil {
 			l.Error(err)
 		}
 		
+		if recorder != nil {
+			if err = warmup.Save(cfg.Permission.Warmup.File, recorder.Top(cfg.Permission.Warmup.Size)); err != nil {
+				l.Error(err)
+			}
+		}
+		
 		return nil
 	}
 }
+
+// externalResolvers - Connects to the grpc hooks of the configured external relations
+func externalResolvers(configs []config.External) ([]commands.External, func(), error) {
+	var conns []*grpc.ClientConn
+	closer := func() {
+		for _, conn := range conns {
+			_ = conn.Close()
+		}
+	}
+	
+	externals := make([]commands.External, 0, len(configs))
+	for _, c := range configs {
+		entityType, relation, ok := strings.Cut(c.Relation, "#")
+		if !ok || entityType == "" || relation == "" {
+			closer()
+			return nil, nil, fmt.Errorf("external relation must be in entity_type#relation form: %s", c.Relation)
+		}
+		
+		conn, err := grpc.Dial(c.Endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			closer()
+			return nil, nil, err
+		}
+		conns = append(conns, conn)
+		
+		externals = append(externals, commands.External{
+			EntityType: entityType,
+			Relation:   relation,
+			Resolver:   commands.NewGRPCExternalResolver(conn, c.Method),
+			Timeout:    c.Timeout,
+			TTL:        c.CacheTTL,
+		})
+	}
+	
+	return externals, closer, nil
+}
@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	
+	"github.com/gookit/color"
+	"github.com/spf13/cobra"
+	
+	"github.com/adminium/permify/internal/config"
+	"github.com/adminium/permify/internal/factories"
+	"github.com/adminium/permify/internal/repositories"
+	"github.com/adminium/permify/internal/services"
+	"github.com/adminium/permify/pkg/database"
+	"github.com/adminium/permify/pkg/logger"
+	v1 "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+const (
+	fromEngine = "from-engine"
+	fromURI    = "from-uri"
+	toEngine   = "to-engine"
+	toURI      = "to-uri"
+	sampleRate = "sample-rate"
+)
+
+// NewTenantsCommand - Creates new tenants command
+func NewTenantsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tenants",
+		Short: "manage the tenants of the storage backends",
+		Args:  cobra.NoArgs,
+	}
+	
+	cmd.AddCommand(NewTenantsMigrateCommand())
+	
+	return cmd
+}
+
+// NewTenantsMigrateCommand - Creates new tenants migrate command
+func NewTenantsMigrateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "copy a tenant to another storage backend and verify it, from the backend of a server or another backend",
+		RunE:  tenantsMigrate(),
+		Args:  cobra.NoArgs,
+	}
+	
+	cmd.Flags().String(endpoint, "localhost:3478", "grpc endpoint of the server whose backend the tenant is copied from")
+	cmd.Flags().String(apiToken, "", "bearer token sent with every request")
+	cmd.Flags().Bool(tlsEnabled, false, "connect to the server over tls")
+	cmd.Flags().String(tenant, "t1", "tenant id")
+	cmd.Flags().String(fromEngine, "", "engine of the backend to copy from instead of the backend of the server")
+	cmd.Flags().String(fromURI, "", "uri of the backend to copy from")
+	cmd.Flags().String(toEngine, "", "engine of the backend to copy to")
+	cmd.Flags().String(toURI, "", "uri of the backend to copy to")
+	cmd.Flags().Int(sampleRate, 0, "one in this many tuples is part of the checksums, 100 if zero")
+	
+	return cmd
+}
+
+// tenantsMigrate - permify tenants migrate command
+func tenantsMigrate() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		flags, err := getFlags(cmd, []string{tenant, fromEngine, fromURI, toEngine, toURI})
+		if err != nil {
+			return err
+		}
+		rate, err := cmd.Flags().GetInt(sampleRate)
+		if err != nil {
+			return err
+		}
+		if flags[toEngine] == "" {
+			return fmt.Errorf("--%s is required", toEngine)
+		}
+		
+		var response *v1.AdminMigrateTenantResponse
+		if flags[fromEngine] != "" {
+			response, err = migrateBackends(cmd, flags, rate)
+		} else {
+			response, err = migrateServerBackend(cmd, flags, rate)
+		}
+		if err != nil {
+			color.Danger.Println("migration failed: " + err.Error())
+			return err
+		}
+		
+		summary := fmt.Sprintf("%d schema versions and %d of %d tuples copied to %s, checksum of %d sampled tuples %s, target %s",
+			len(response.GetSchemaVersions()),
+			response.GetTargetTuples(),
+			response.GetTuples(),
+			flags[toEngine],
+			response.GetSampled(),
+			response.GetChecksum(),
+			response.GetTargetChecksum(),
+		)
+		if !response.GetVerified() {
+			color.Danger.Println(summary + ", verification failed")
+			return services.ErrMigrationVerification
+		}
+		color.Success.Println(summary + " ✓ ✅ ")
+		return nil
+	}
+}
+
+// migrateServerBackend - Migrates the tenant from the backend of the server
+func migrateServerBackend(cmd *cobra.Command, flags map[string]string, rate int) (*v1.AdminMigrateTenantResponse, error) {
+	ctx, conn, err := dial(cmd)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	
+	return v1.NewAdminClient(conn).MigrateTenant(ctx, &v1.AdminMigrateTenantRequest{
+		TenantId:   flags[tenant],
+		Engine:     flags[toEngine],
+		Uri:        flags[toURI],
+		SampleRate: uint32(rate),
+	})
+}
+
+// migrateBackends - Migrates the tenant between two backends opened by the command
+func migrateBackends(cmd *cobra.Command, flags map[string]string, rate int) (*v1.AdminMigrateTenantResponse, error) {
+	open := backendOpener(logger.New("error"))
+	source, closeSource, err := open(flags[fromEngine], flags[fromURI])
+	if err != nil {
+		return nil, err
+	}
+	defer closeSource()
+	target, closeTarget, err := open(flags[toEngine], flags[toURI])
+	if err != nil {
+		return nil, err
+	}
+	defer closeTarget()
+	
+	report, err := services.MigrateTenant(cmd.Context(), flags[tenant], source, target, rate)
+	if err != nil && !errors.Is(err, services.ErrMigrationVerification) {
+		return nil, err
+	}
+	
+	return &v1.AdminMigrateTenantResponse{
+		TenantId:       flags[tenant],
+		Engine:         flags[toEngine],
+		SchemaVersions: report.Versions,
+		SnapToken:      report.SnapToken,
+		Tuples:         uint64(report.Tuples),
+		TargetTuples:   uint64(report.TargetTuples),
+		Sampled:        uint64(report.Sampled),
+		Checksum:       strconv.FormatUint(report.Checksum, 16),
+		TargetChecksum: strconv.FormatUint(report.TargetChecksum, 16),
+		Verified:       report.Verified(),
+	}, nil
+}
+
+// backendOpener - Opens the backends of the storage engines with their tables migrated
+func backendOpener(l logger.Interface) services.BackendOpener {
+	return func(engine, uri string) (services.Backend, func(), error) {
+		conf := config.Database{Engine: engine, URI: uri}
+		if err := repositories.Migrate(conf, l); err != nil {
+			return services.Backend{}, nil, err
+		}
+		db, err := factories.DatabaseFactory(conf)
+		if err != nil {
+			return services.Backend{}, nil, err
+		}
+		return newBackend(db, l), func() { db.Close() }, nil
+	}
+}
+
+// newBackend - Repositories of the database that tenants are migrated between
+func newBackend(db database.Database, l logger.Interface) services.Backend {
+	return services.Backend{
+		Engine:             db.GetEngineType(),
+		SchemaReader:       factories.SchemaReaderFactory(db, l),
+		SchemaWriter:       factories.SchemaWriterFactory(db, l),
+		RelationshipReader: factories.RelationshipReaderFactory(db, l),
+		RelationshipWriter: factories.RelationshipWriterFactory(db, l),
+		TenantReader:       factories.TenantReaderFactory(db, l),
+		TenantWriter:       factories.TenantWriterFactory(db, l),
+	}
+}
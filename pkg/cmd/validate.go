@@ -9,6 +9,7 @@ import (
 	"github.com/gookit/color"
 	"github.com/spf13/cobra"
 	
+	"github.com/adminium/permify/internal/schema"
 	"github.com/adminium/permify/pkg/development"
 	"github.com/adminium/permify/pkg/development/validation"
 	base "github.com/adminium/permify/pkg/pb/base/v1"
@@ -48,6 +49,12 @@ func validate() func(cmd *cobra.Command, args []string) error {
 			return err
 		}
 		
+		// Run the assert blocks of the schema -
+		_, err = devContainer.S.ValidateSchema(ctx, s.Schema)
+		if err != nil {
+			return err
+		}
+		
 		// Write schema -
 		var version string
 		version, err = devContainer.S.WriteSchema(ctx, "t1", s.Schema)
@@ -57,6 +64,31 @@ func validate() func(cmd *cobra.Command, args []string) error {
 		
 		color.Success.Println("schema successfully created: ✓ ✅ ")
 		
+		// Report remaining usage of deprecated relations and actions
+		deprecations, err := devContainer.S.ReadDeprecations(ctx, "t1", version)
+		if err != nil {
+			return err
+		}
+		
+		sch, err := devContainer.S.ReadSchema(ctx, "t1", version)
+		if err != nil {
+			return err
+		}
+		
+		for _, usage := range schema.DeprecatedUsages(sch, deprecations) {
+			color.Warn.Println("warning: " + usage.String())
+		}
+		
+		// Report the aliases of renamed entities that are left to clean up
+		aliases, err := devContainer.S.ReadAliases(ctx, "t1", version)
+		if err != nil {
+			return err
+		}
+		
+		for _, warning := range aliases.Warnings() {
+			color.Warn.Println("warning: " + warning)
+		}
+		
 		var tuples []*base.Tuple
 		
 		// Write tuples -
@@ -0,0 +1,31 @@
+package cockroach
+
+import (
+	"github.com/adminium/permify/pkg/database/postgres"
+)
+
+// Cockroach - Structure for CockroachDB instance. Cockroach speaks the postgres wire protocol, so the connection is
+// a postgres one and the repositories that do not depend on snapshots are shared with postgres.
+type Cockroach struct {
+	*postgres.Postgres
+	// FollowerReads - relationship queries read as of the time of their snapshot, so the replicas closest to the
+	// instance can serve them once the snapshot is older than the closed timestamp of the cluster
+	FollowerReads bool
+}
+
+// New - Creates new cockroach db instance
+func New(uri string, followerReads bool, opts ...postgres.Option) (*Cockroach, error) {
+	pg, err := postgres.New(uri, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Cockroach{
+		Postgres:      pg,
+		FollowerReads: followerReads,
+	}, nil
+}
+
+// GetEngineType - Get the engine type which is cockroach in string
+func (c *Cockroach) GetEngineType() string {
+	return "cockroach"
+}
@@ -6,7 +6,8 @@ import (
 
 // TupleCollection -Tuple collection.
 type TupleCollection struct {
-	tuples []*base.Tuple
+	tuples   []*base.Tuple
+	metadata []*TupleMetadata
 }
 
 // NewTupleCollection - Create new tuple collection.
@@ -31,9 +32,29 @@ func (t *TupleCollection) GetTuples() []*base.Tuple {
 	return t.tuples
 }
 
+// GetMetadata - Get tuple metadata, aligned with tuples. Entries are nil for tuples read without metadata.
+func (t *TupleCollection) GetMetadata() []*TupleMetadata {
+	if t.metadata == nil {
+		return make([]*TupleMetadata, len(t.tuples))
+	}
+	return t.metadata
+}
+
 // Add - New subject to collection.
 func (t *TupleCollection) Add(tuple *base.Tuple) {
 	t.tuples = append(t.tuples, tuple)
+	if t.metadata != nil {
+		t.metadata = append(t.metadata, nil)
+	}
+}
+
+// AddWithMetadata - New tuple with its metadata to collection.
+func (t *TupleCollection) AddWithMetadata(tuple *base.Tuple, metadata *TupleMetadata) {
+	if t.metadata == nil {
+		t.metadata = make([]*TupleMetadata, len(t.tuples))
+	}
+	t.tuples = append(t.tuples, tuple)
+	t.metadata = append(t.metadata, metadata)
 }
 
 // ToSubjectCollection - Converts new subject collection from given tuple collection
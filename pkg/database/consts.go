@@ -4,8 +4,10 @@ package database
 type Engine string
 
 const (
-	POSTGRES Engine = "postgres"
-	MEMORY   Engine = "memory"
+	POSTGRES  Engine = "postgres"
+	COCKROACH Engine = "cockroach"
+	MEMORY    Engine = "memory"
+	RAFT      Engine = "raft"
 )
 
 // String - Convert to string
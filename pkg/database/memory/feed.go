@@ -0,0 +1,65 @@
+package memory
+
+import (
+	"sync"
+)
+
+// Feed - In memory pubsub of the changes written to the database. Subscribers of a tenant receive the messages
+// published for it after they subscribed; a subscriber that does not keep up is dropped and its channel closed,
+// so a slow reader never blocks the writers.
+type Feed struct {
+	mu          sync.Mutex
+	subscribers map[string]map[chan interface{}]struct{}
+}
+
+// NewFeed - Creates new feed
+func NewFeed() *Feed {
+	return &Feed{
+		subscribers: map[string]map[chan interface{}]struct{}{},
+	}
+}
+
+// Subscribe - Subscribes to the messages of the tenant with a buffer of the given size, the returned function
+// unsubscribes
+func (f *Feed) Subscribe(tenantID string, size int) (<-chan interface{}, func()) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	ch := make(chan interface{}, size)
+	if f.subscribers[tenantID] == nil {
+		f.subscribers[tenantID] = map[chan interface{}]struct{}{}
+	}
+	f.subscribers[tenantID][ch] = struct{}{}
+
+	return ch, func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.remove(tenantID, ch)
+	}
+}
+
+// Publish - Sends the message to the subscribers of the tenant
+func (f *Feed) Publish(tenantID string, message interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for ch := range f.subscribers[tenantID] {
+		select {
+		case ch <- message:
+		default:
+			f.remove(tenantID, ch)
+		}
+	}
+}
+
+// remove - Closes the channel of a subscriber that was not removed yet
+func (f *Feed) remove(tenantID string, ch chan interface{}) {
+	if _, ok := f.subscribers[tenantID][ch]; !ok {
+		return
+	}
+	delete(f.subscribers[tenantID], ch)
+	if len(f.subscribers[tenantID]) == 0 {
+		delete(f.subscribers, tenantID)
+	}
+	close(ch)
+}
@@ -12,13 +12,19 @@ type Memory struct {
 	sync.RWMutex
 
 	DB *memdb.MemDB
+	// Symbols - names interned by the tables of the database
+	Symbols *Symbols
+	// Changes - changes of the relation tuples, by tenant
+	Changes *Feed
 }
 
 // New - Creates new database schema in memory
 func New(schema *memdb.DBSchema) (*Memory, error) {
 	db, err := memdb.NewMemDB(schema)
 	return &Memory{
-		DB: db,
+		DB:      db,
+		Symbols: NewSymbols(),
+		Changes: NewFeed(),
 	}, err
 }
 
@@ -0,0 +1,63 @@
+package memory
+
+import (
+	"sync"
+)
+
+// Symbols - Interns the small set of names repeated by every tuple (entity types, relations, subject types)
+// as small integers. 0 is always the empty string, symbols are never released.
+type Symbols struct {
+	mu    sync.RWMutex
+	ids   map[string]uint32
+	names []string
+}
+
+// NewSymbols - Creates new symbol table
+func NewSymbols() *Symbols {
+	return &Symbols{
+		ids:   map[string]uint32{"": 0},
+		names: []string{""},
+	}
+}
+
+// Intern - Gets the symbol of the name, creating it if needed
+func (s *Symbols) Intern(name string) uint32 {
+	if id, ok := s.Lookup(name); ok {
+		return id
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if id, ok := s.ids[name]; ok {
+		return id
+	}
+	id := uint32(len(s.names))
+	s.ids[name] = id
+	s.names = append(s.names, name)
+	return id
+}
+
+// Lookup - Gets the symbol of the name without creating it. Names that were never interned
+// can not match any stored tuple.
+func (s *Symbols) Lookup(name string) (uint32, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	id, ok := s.ids[name]
+	return id, ok
+}
+
+// Name - Gets the name of the symbol, every call returns the same string so callers share its memory
+func (s *Symbols) Name(id uint32) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if int(id) >= len(s.names) {
+		return ""
+	}
+	return s.names[id]
+}
+
+// Len - Number of symbols
+func (s *Symbols) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.names)
+}
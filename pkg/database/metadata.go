@@ -0,0 +1,47 @@
+package database
+
+import (
+	"context"
+	"time"
+)
+
+// TupleMetadata - Optional metadata stored alongside a relation tuple
+type TupleMetadata struct {
+	// CreatedAt - time the tuple was written
+	CreatedAt time.Time
+	// CreatedBy - principal that wrote the tuple
+	CreatedBy string
+	// Reference - external reference such as a trace or ticket id
+	Reference string
+	// SchemaVersion - version of the schema the tuple was validated against when it was written
+	SchemaVersion string
+	// DeletedAt - time the tuple was deleted, zero for live tuples
+	DeletedAt time.Time
+	// NotBefore - the tuple is ignored by snapshots taken before this time, zero for no lower bound
+	NotBefore time.Time
+	// NotAfter - the tuple is ignored by snapshots taken at or after this time, zero for no upper bound
+	NotAfter time.Time
+}
+
+// GetSchemaVersion - Schema version of the tuple, empty for tuples read without metadata or written before versions were recorded
+func (m *TupleMetadata) GetSchemaVersion() string {
+	if m == nil {
+		return ""
+	}
+	return m.SchemaVersion
+}
+
+type tupleMetadataKey struct{}
+
+// ContextWithTupleMetadata - Attaches the metadata that will be stored with the tuples written using this context
+func ContextWithTupleMetadata(ctx context.Context, metadata TupleMetadata) context.Context {
+	return context.WithValue(ctx, tupleMetadataKey{}, metadata)
+}
+
+// TupleMetadataFromContext - Gets the write metadata attached to the context, if any
+func TupleMetadataFromContext(ctx context.Context) TupleMetadata {
+	if metadata, ok := ctx.Value(tupleMetadataKey{}).(TupleMetadata); ok {
+		return metadata
+	}
+	return TupleMetadata{}
+}
@@ -0,0 +1,31 @@
+package raft
+
+import (
+	"time"
+	
+	"google.golang.org/grpc"
+)
+
+// Option - Option type
+type Option func(*Raft)
+
+// ApplyTimeout - Defines how long a write waits for the cluster to commit it
+func ApplyTimeout(d time.Duration) Option {
+	return func(r *Raft) {
+		r.applyTimeout = d
+	}
+}
+
+// SnapshotThreshold - Defines how many commands are logged before the log is compacted into a snapshot
+func SnapshotThreshold(n uint64) Option {
+	return func(r *Raft) {
+		r.snapshotThreshold = n
+	}
+}
+
+// DialOptions - Defines the options of the connections the writes are forwarded to the leader through
+func DialOptions(options ...grpc.DialOption) Option {
+	return func(r *Raft) {
+		r.dialOptions = options
+	}
+}
@@ -0,0 +1,313 @@
+package raft
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+	
+	hraft "github.com/hashicorp/raft"
+	raftboltdb "github.com/hashicorp/raft-boltdb/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	
+	"github.com/adminium/permify/pkg/database/memory"
+)
+
+// ApplyMethod - grpc method of the leader that the followers forward the commands to
+const ApplyMethod = "/permify.raft.v1.Cluster/Apply"
+
+const (
+	_defaultApplyTimeout      = 5 * time.Second
+	_defaultSnapshotThreshold = 8192
+	_forwardedHeader          = "permify-raft-forwarded"
+)
+
+// ErrNotLeader - The node cannot apply the command, it was forwarded to a node that lost the leadership
+var ErrNotLeader = errors.New("raft node is not the leader")
+
+// Peer - A node of the cluster
+type Peer struct {
+	ID string
+	// Address - address of the raft transport of the node
+	Address string
+	// GRPCAddress - address the writes are forwarded to when the node is the leader
+	GRPCAddress string
+}
+
+// Result - What the state machine returns for a command, the error codes of the writers are kept
+type Result struct {
+	Data []byte
+	Err  error
+}
+
+// Raft - Memory database replicated across the nodes of a cluster. The writes are commands that the leader appends
+// to the raft log, every node applies the committed commands to its own memory database, so the reads are served
+// locally by every node and a cluster of three keeps working when one of them is lost.
+type Raft struct {
+	*memory.Memory
+	
+	Node *hraft.Raft
+	// peers - grpc addresses of the nodes by id
+	peers map[hraft.ServerID]string
+	
+	applyTimeout      time.Duration
+	snapshotThreshold uint64
+	dialOptions       []grpc.DialOption
+	
+	mu    sync.Mutex
+	conns map[string]*grpc.ClientConn
+	
+	store     *raftboltdb.BoltStore
+	transport *hraft.NetworkTransport
+}
+
+// New - Starts the raft node of the memory database, the cluster is bootstrapped with the peers the first time the
+// node starts with an empty data directory
+func New(mem *memory.Memory, fsm hraft.FSM, id, address, dataDir string, peers []Peer, opts ...Option) (*Raft, error) {
+	r := &Raft{
+		Memory:            mem,
+		peers:             map[hraft.ServerID]string{},
+		applyTimeout:      _defaultApplyTimeout,
+		snapshotThreshold: _defaultSnapshotThreshold,
+		dialOptions:       []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())},
+		conns:             map[string]*grpc.ClientConn{},
+	}
+	
+	for _, opt := range opts {
+		opt(r)
+	}
+	
+	if id == "" {
+		return nil, errors.New("raft node id is required")
+	}
+	
+	if err := os.MkdirAll(dataDir, 0o750); err != nil {
+		return nil, err
+	}
+	
+	conf := hraft.DefaultConfig()
+	conf.LocalID = hraft.ServerID(id)
+	conf.SnapshotThreshold = r.snapshotThreshold
+	conf.LogOutput = os.Stderr
+	
+	// the transport can listen on all interfaces, the other nodes reach it at its address among the peers
+	var advertise net.Addr
+	for _, peer := range peers {
+		if peer.ID == id {
+			addr, err := net.ResolveTCPAddr("tcp", peer.Address)
+			if err != nil {
+				return nil, err
+			}
+			advertise = addr
+		}
+	}
+	
+	var err error
+	r.transport, err = hraft.NewTCPTransport(address, advertise, 3, 10*time.Second, os.Stderr)
+	if err != nil {
+		return nil, err
+	}
+	
+	r.store, err = raftboltdb.NewBoltStore(filepath.Join(dataDir, "raft.db"))
+	if err != nil {
+		_ = r.transport.Close()
+		return nil, err
+	}
+	
+	snapshots, err := hraft.NewFileSnapshotStore(dataDir, 2, os.Stderr)
+	if err != nil {
+		_ = r.close()
+		return nil, err
+	}
+	
+	existing, err := hraft.HasExistingState(r.store, r.store, snapshots)
+	if err != nil {
+		_ = r.close()
+		return nil, err
+	}
+	
+	servers := make([]hraft.Server, 0, len(peers))
+	for _, peer := range peers {
+		r.peers[hraft.ServerID(peer.ID)] = peer.GRPCAddress
+		servers = append(servers, hraft.Server{ID: hraft.ServerID(peer.ID), Address: hraft.ServerAddress(peer.Address)})
+	}
+	
+	// every node is bootstrapped with the same configuration, raft elects the leader among them
+	if !existing {
+		if err = hraft.BootstrapCluster(conf, r.store, r.store, snapshots, r.transport, hraft.Configuration{Servers: servers}); err != nil {
+			_ = r.close()
+			return nil, err
+		}
+	}
+	
+	r.Node, err = hraft.NewRaft(conf, fsm, r.store, r.store, snapshots, r.transport)
+	if err != nil {
+		_ = r.close()
+		return nil, err
+	}
+	
+	return r, nil
+}
+
+// GetEngineType - Gets engine type, returns as string
+func (r *Raft) GetEngineType() string {
+	return "raft"
+}
+
+// IsReady - The node is ready once the cluster has a leader
+func (r *Raft) IsReady(_ context.Context) (bool, error) {
+	address, _ := r.Node.LeaderWithID()
+	return address != "", nil
+}
+
+// Close - Leaves the cluster and closes the log of the node
+func (r *Raft) Close() error {
+	if err := r.Node.Shutdown().Error(); err != nil {
+		return err
+	}
+	
+	r.mu.Lock()
+	for _, conn := range r.conns {
+		_ = conn.Close()
+	}
+	r.mu.Unlock()
+	
+	if err := r.close(); err != nil {
+		return err
+	}
+	return r.Memory.Close()
+}
+
+// close - Closes the transport and the log store
+func (r *Raft) close() error {
+	if r.store != nil {
+		if err := r.store.Close(); err != nil {
+			return err
+		}
+	}
+	return r.transport.Close()
+}
+
+// Apply - Applies the command through the leader. A follower forwards it and waits until it applied the command
+// itself, so the writes of a client are visible to its next reads on any node.
+func (r *Raft) Apply(ctx context.Context, command []byte) ([]byte, error) {
+	if r.Node.State() == hraft.Leader {
+		_, data, err := r.ApplyOnLeader(ctx, command)
+		return data, err
+	}
+	
+	if forwarded(ctx) {
+		return nil, ErrNotLeader
+	}
+	
+	_, id := r.Node.LeaderWithID()
+	address, ok := r.peers[id]
+	if id == "" || !ok {
+		return nil, fmt.Errorf("raft cluster has no leader")
+	}
+	
+	conn, err := r.conn(address)
+	if err != nil {
+		return nil, err
+	}
+	
+	// the credentials of the client authenticate the forwarded command on the leader
+	md, _ := metadata.FromIncomingContext(ctx)
+	md = md.Copy()
+	md.Set(_forwardedHeader, "true")
+	
+	response := &wrapperspb.BytesValue{}
+	if err = conn.Invoke(metadata.NewOutgoingContext(ctx, md), ApplyMethod, wrapperspb.Bytes(command), response); err != nil {
+		// the leader keeps the error codes of the writers as the messages of its errors
+		return nil, errors.New(status.Convert(err).Message())
+	}
+	
+	value := response.GetValue()
+	if len(value) < 8 {
+		return nil, errors.New("invalid response of the raft leader")
+	}
+	if err = r.wait(ctx, binary.BigEndian.Uint64(value[:8])); err != nil {
+		return nil, err
+	}
+	return value[8:], nil
+}
+
+// ApplyOnLeader - Appends the command to the log and returns its index and result once the cluster committed and
+// the node applied it
+func (r *Raft) ApplyOnLeader(ctx context.Context, command []byte) (index uint64, data []byte, err error) {
+	timeout := r.applyTimeout
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < timeout {
+		timeout = time.Until(deadline)
+	}
+	
+	future := r.Node.Apply(command, timeout)
+	if err = future.Error(); err != nil {
+		if errors.Is(err, hraft.ErrNotLeader) || errors.Is(err, hraft.ErrLeadershipLost) {
+			return 0, nil, ErrNotLeader
+		}
+		return 0, nil, err
+	}
+	
+	result, ok := future.Response().(Result)
+	if !ok {
+		return 0, nil, errors.New("invalid result of the raft state machine")
+	}
+	return future.Index(), result.Data, result.Err
+}
+
+// EncodeResponse - Response of the leader to a forwarded command, the index of the command and its result
+func EncodeResponse(index uint64, data []byte) []byte {
+	value := make([]byte, 8, 8+len(data))
+	binary.BigEndian.PutUint64(value, index)
+	return append(value, data...)
+}
+
+// wait - Waits until the node applied the log up to the index
+func (r *Raft) wait(ctx context.Context, index uint64) error {
+	ctx, cancel := context.WithTimeout(ctx, r.applyTimeout)
+	defer cancel()
+	
+	ticker := time.NewTicker(5 * time.Millisecond)
+	defer ticker.Stop()
+	
+	for r.Node.AppliedIndex() < index {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
+
+// conn - Connection to the grpc address of a node, connections are reused across the forwarded commands
+func (r *Raft) conn(address string) (*grpc.ClientConn, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	
+	if conn, ok := r.conns[address]; ok {
+		return conn, nil
+	}
+	
+	conn, err := grpc.Dial(address, r.dialOptions...)
+	if err != nil {
+		return nil, err
+	}
+	r.conns[address] = conn
+	return conn, nil
+}
+
+// forwarded - Reports whether the command was forwarded by another node
+func forwarded(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	return ok && len(md.Get(_forwardedHeader)) > 0
+}
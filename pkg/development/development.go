@@ -7,8 +7,10 @@ import (
 	"github.com/adminium/permify/internal/config"
 	"github.com/adminium/permify/internal/factories"
 	"github.com/adminium/permify/internal/keys"
+	"github.com/adminium/permify/internal/repositories/decorators"
 	"github.com/adminium/permify/internal/services"
 	"github.com/adminium/permify/pkg/database"
+	"github.com/adminium/permify/pkg/faults"
 	"github.com/adminium/permify/pkg/logger"
 	"github.com/adminium/permify/pkg/telemetry"
 )
@@ -18,10 +20,17 @@ type Container struct {
 	P services.IPermissionService
 	R services.IRelationshipService
 	S services.ISchemaService
+	A services.IAttributeService
 }
 
 // NewContainer - Creates new container instance
 func NewContainer() *Container {
+	return NewContainerWithFaults(faults.Faults{})
+}
+
+// NewContainerWithFaults - Creates new container instance whose storage fails as configured, so that tests can see
+// how the services behave when the storage is slow or failing
+func NewContainerWithFaults(f faults.Faults) *Container {
 	var err error
 	
 	var db database.Database
@@ -39,15 +48,28 @@ func NewContainer() *Container {
 	schemaReader := factories.SchemaReaderFactory(db, l)
 	schemaWriter := factories.SchemaWriterFactory(db, l)
 	
+	attributeReader := factories.AttributeReaderFactory(db, l)
+	attributeWriter := factories.AttributeWriterFactory(db, l)
+	
+	if f.Enabled() {
+		injector := faults.NewInjector(f)
+		relationshipReader = decorators.NewRelationshipReaderWithFaults(relationshipReader, injector)
+		relationshipWriter = decorators.NewRelationshipWriterWithFaults(relationshipWriter, injector)
+		schemaReader = decorators.NewSchemaReaderWithFaults(schemaReader, injector)
+		schemaWriter = decorators.NewSchemaWriterWithFaults(schemaWriter, injector)
+	}
+	
 	// commands
-	checkCommand, _ := commands.NewCheckCommand(keys.NewNoopCheckCommandKeys(), schemaReader, relationshipReader, telemetry.NewNoopMeter())
+	checkCommand, _ := commands.NewCheckCommand(keys.NewNoopCheckCommandKeys(), schemaReader, relationshipReader, telemetry.NewNoopMeter(), commands.Attributes(attributeReader))
 	expandCommand := commands.NewExpandCommand(schemaReader, relationshipReader)
 	lookupSchemaCommand := commands.NewLookupSchemaCommand(schemaReader)
 	lookupEntityCommand := commands.NewLookupEntityCommand(checkCommand, schemaReader, relationshipReader)
+	lookupSubjectCommand := commands.NewLookupSubjectCommand(checkCommand, schemaReader, relationshipReader)
 	
 	return &Container{
-		P: services.NewPermissionService(checkCommand, expandCommand, lookupSchemaCommand, lookupEntityCommand),
+		P: services.NewPermissionService(checkCommand, expandCommand, lookupSchemaCommand, lookupEntityCommand, lookupSubjectCommand),
 		R: services.NewRelationshipService(relationshipReader, relationshipWriter, schemaReader),
-		S: services.NewSchemaService(schemaWriter, schemaReader),
+		S: services.NewSchemaService(schemaWriter, schemaReader, services.SchemaAssertions()),
+		A: services.NewAttributeService(attributeWriter, attributeReader, schemaReader, keys.NewNoopCheckCommandKeys()),
 	}
 }
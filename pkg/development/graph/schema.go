@@ -8,6 +8,7 @@ import (
 	"github.com/rs/xid"
 	
 	"github.com/adminium/permify/internal/schema"
+	"github.com/adminium/permify/pkg/dsl/utils"
 	base "github.com/adminium/permify/pkg/pb/base/v1"
 	"github.com/adminium/permify/pkg/tuple"
 )
@@ -85,7 +86,11 @@ func buildActionGraph(entity *base.EntityDefinition, from *Node, children []*bas
 			leaf := child.GetLeaf()
 			switch leaf.GetType().(type) {
 			case *base.Leaf_TupleToUserSet:
-				re, err := schema.GetRelationByNameInEntityDefinition(entity, leaf.GetTupleToUserSet().GetTupleSet().GetRelation())
+				tupleSet := leaf.GetTupleToUserSet().GetTupleSet().GetRelation()
+				if relation, ok := utils.ParseTransitiveReference(tupleSet); ok {
+					tupleSet = relation
+				}
+				re, err := schema.GetRelationByNameInEntityDefinition(entity, tupleSet)
 				if err != nil {
 					return Graph{}, errors.New(base.ErrorCode_ERROR_CODE_RELATION_DEFINITION_NOT_FOUND.String())
 				}
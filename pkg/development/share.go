@@ -0,0 +1,211 @@
+package development
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	
+	"github.com/adminium/permify/internal/schema"
+	"github.com/adminium/permify/pkg/database"
+	v1 "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+const (
+	// SnapshotVersion - Current version of the shared playground format, it prefixes the blobs
+	SnapshotVersion = "p1"
+	
+	_defaultReadPageSize = 100
+	_defaultShareDepth   = 100
+)
+
+// ErrUnsupportedSnapshot - The blob is not a shared playground of a known version
+var ErrUnsupportedSnapshot = errors.New("unsupported playground snapshot")
+
+// Snapshot - The state of a playground, the schema, its tuples and the results of the scenarios checked against them.
+// The scenarios are queries like "can user:1 edit document:1", their results are the ones the playground computed,
+// so a loaded snapshot can be checked again to see whether the results still hold.
+type Snapshot struct {
+	Schema    string     `json:"schema"`
+	Tuples    []string   `json:"tuples"`
+	Scenarios []Scenario `json:"scenarios,omitempty"`
+}
+
+// Scenario - A check query of the playground and its result
+type Scenario struct {
+	Query  string `json:"query"`
+	Result bool   `json:"result"`
+}
+
+// Share - Captures the schema and the tuples of the playground and checks the queries against them
+func (c *Container) Share(ctx context.Context, queries []string) (*Snapshot, error) {
+	sch, err := ReadSchema(ctx, c.S, "")
+	if err != nil {
+		return nil, err
+	}
+	
+	snapshot := &Snapshot{
+		Schema: schema.ToString(sch),
+		Tuples: []string{},
+	}
+	
+	// every tuple belongs to an entity of the schema, so reading each entity type reads them all
+	entityTypes := make([]string, 0, len(sch.GetEntityDefinitions()))
+	for entityType := range sch.GetEntityDefinitions() {
+		entityTypes = append(entityTypes, entityType)
+	}
+	sort.Strings(entityTypes)
+	
+	for _, entityType := range entityTypes {
+		ct := ""
+		for {
+			var collection *database.TupleCollection
+			var next database.EncodedContinuousToken
+			collection, next, err = c.R.ReadRelationships(ctx, "t1", &v1.TupleFilter{Entity: &v1.EntityFilter{Type: entityType}}, "", _defaultReadPageSize, ct)
+			if err != nil {
+				return nil, err
+			}
+			for _, t := range collection.GetTuples() {
+				snapshot.Tuples = append(snapshot.Tuples, tuple.ToString(t))
+			}
+			if next == nil || next.String() == "" {
+				break
+			}
+			ct = next.String()
+		}
+	}
+	
+	for _, query := range queries {
+		var allowed bool
+		allowed, err = c.check(ctx, query, "")
+		if err != nil {
+			return nil, err
+		}
+		snapshot.Scenarios = append(snapshot.Scenarios, Scenario{Query: query, Result: allowed})
+	}
+	
+	return snapshot, nil
+}
+
+// Load - Writes the schema and the tuples of the snapshot to the playground and returns the version of the schema.
+// The playground should be empty, the tuples are added to the ones it has.
+func (c *Container) Load(ctx context.Context, snapshot *Snapshot) (version string, err error) {
+	version, err = WriteSchema(ctx, c.S, snapshot.Schema)
+	if err != nil {
+		return "", err
+	}
+	
+	tuples := make([]*v1.Tuple, 0, len(snapshot.Tuples))
+	for _, t := range snapshot.Tuples {
+		var tup *v1.Tuple
+		tup, err = tuple.Tuple(t)
+		if err != nil {
+			return "", fmt.Errorf("%w: %s", err, t)
+		}
+		tuples = append(tuples, tup)
+	}
+	
+	if len(tuples) > 0 {
+		if _, err = WriteTuple(ctx, c.R, tuples, version); err != nil {
+			return "", err
+		}
+	}
+	return version, nil
+}
+
+// Replay - Checks the scenarios of the snapshot against the playground again and returns the ones whose results
+// changed
+func (c *Container) Replay(ctx context.Context, snapshot *Snapshot, version string) ([]Scenario, error) {
+	var changed []Scenario
+	for _, scenario := range snapshot.Scenarios {
+		allowed, err := c.check(ctx, scenario.Query, version)
+		if err != nil {
+			return nil, err
+		}
+		if allowed != scenario.Result {
+			changed = append(changed, Scenario{Query: scenario.Query, Result: allowed})
+		}
+	}
+	return changed, nil
+}
+
+// check - Result of a check query like "can user:1 edit document:1"
+func (c *Container) check(ctx context.Context, query, version string) (bool, error) {
+	q, err := tuple.NewQueryFromString(query)
+	if err != nil {
+		return false, fmt.Errorf("%w: %s", err, query)
+	}
+	var response *v1.PermissionCheckResponse
+	response, err = c.P.CheckPermissions(ctx, &v1.PermissionCheckRequest{
+		TenantId:   "t1",
+		Entity:     q.Entity,
+		Permission: q.Action,
+		Subject:    q.Subject,
+		Metadata: &v1.PermissionCheckRequestMetadata{
+			SchemaVersion: version,
+			Depth:         _defaultShareDepth,
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+	return response.GetCan() == v1.PermissionCheckResponse_RESULT_ALLOWED, nil
+}
+
+// EncodeSnapshot - Serializes the snapshot into a compact blob that can be pasted into urls and bug reports
+func EncodeSnapshot(snapshot *Snapshot) (string, error) {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return "", err
+	}
+	
+	var buf bytes.Buffer
+	var w *flate.Writer
+	w, err = flate.NewWriter(&buf, flate.BestCompression)
+	if err != nil {
+		return "", err
+	}
+	if _, err = w.Write(data); err != nil {
+		return "", err
+	}
+	if err = w.Close(); err != nil {
+		return "", err
+	}
+	
+	return SnapshotVersion + "." + base64.RawURLEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// DecodeSnapshot - Reads a blob of EncodeSnapshot
+func DecodeSnapshot(blob string) (*Snapshot, error) {
+	version, payload, ok := strings.Cut(strings.TrimSpace(blob), ".")
+	if !ok || version != SnapshotVersion {
+		return nil, ErrUnsupportedSnapshot
+	}
+	
+	compressed, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedSnapshot, err.Error())
+	}
+	
+	r := flate.NewReader(bytes.NewReader(compressed))
+	defer r.Close()
+	
+	var data []byte
+	data, err = io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedSnapshot, err.Error())
+	}
+	
+	snapshot := &Snapshot{}
+	if err = json.Unmarshal(data, snapshot); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedSnapshot, err.Error())
+	}
+	return snapshot, nil
+}
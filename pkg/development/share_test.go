@@ -0,0 +1,71 @@
+package development
+
+import (
+	"context"
+	"testing"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	
+	v1 "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+// TestDevelopment -
+func TestDevelopment(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "development-suite")
+}
+
+var _ = Describe("development", func() {
+	Context("Share", func() {
+		It("Case 1: A shared playground loads with the same results", func() {
+			ctx := context.Background()
+			playground := NewContainer()
+			
+			version, err := WriteSchema(ctx, playground.S, "entity user {}\n\nentity doc {\n\trelation owner @user\n\taction edit = owner\n}")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			var tuples []*v1.Tuple
+			for _, t := range []string{"doc:1#owner@user:1", "doc:2#owner@user:2"} {
+				tup, err := tuple.Tuple(t)
+				Expect(err).ShouldNot(HaveOccurred())
+				tuples = append(tuples, tup)
+			}
+			_, err = WriteTuple(ctx, playground.R, tuples, version)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			snapshot, err := playground.Share(ctx, []string{"can user:1 edit doc:1", "can user:1 edit doc:2"})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(snapshot.Tuples).Should(ConsistOf("doc:1#owner@user:1", "doc:2#owner@user:2"))
+			Expect(snapshot.Scenarios).Should(Equal([]Scenario{
+				{Query: "can user:1 edit doc:1", Result: true},
+				{Query: "can user:1 edit doc:2", Result: false},
+			}))
+			
+			blob, err := EncodeSnapshot(snapshot)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(blob).Should(HavePrefix(SnapshotVersion + "."))
+			
+			decoded, err := DecodeSnapshot(blob)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(decoded).Should(Equal(snapshot))
+			
+			loaded := NewContainer()
+			version, err = loaded.Load(ctx, decoded)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			changed, err := loaded.Replay(ctx, decoded, version)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(changed).Should(BeEmpty())
+		})
+		
+		It("Case 2: Blobs of unknown versions are rejected", func() {
+			_, err := DecodeSnapshot("p0.abc")
+			Expect(err).Should(MatchError(ErrUnsupportedSnapshot))
+			
+			_, err = DecodeSnapshot(SnapshotVersion + ".!!")
+			Expect(err).Should(MatchError(ErrUnsupportedSnapshot))
+		})
+	})
+})
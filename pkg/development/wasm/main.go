@@ -177,6 +177,50 @@ func readSchemaGraph() js.Func {
 	})
 }
 
+// share - Serializes the playground and the results of the given check queries into a shareable blob
+func share() js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		var queries []string
+		err := json.Unmarshal([]byte(string(args[0].String())), &queries)
+		if err != nil {
+			return js.ValueOf([]interface{}{"", err.Error()})
+		}
+		var snapshot *development.Snapshot
+		snapshot, err = dev.Share(context.Background(), queries)
+		if err != nil {
+			return js.ValueOf([]interface{}{"", err.Error()})
+		}
+		var blob string
+		blob, err = development.EncodeSnapshot(snapshot)
+		if err != nil {
+			return js.ValueOf([]interface{}{"", err.Error()})
+		}
+		return js.ValueOf([]interface{}{blob, nil})
+	})
+}
+
+// load - Replaces the playground with a shared one, it returns the version of the schema and the snapshot as json
+func load() js.Func {
+	return js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		snapshot, err := development.DecodeSnapshot(string(args[0].String()))
+		if err != nil {
+			return js.ValueOf([]interface{}{"", nil, err.Error()})
+		}
+		dev = development.NewContainer()
+		var version string
+		version, err = dev.Load(context.Background(), snapshot)
+		if err != nil {
+			return js.ValueOf([]interface{}{"", nil, err.Error()})
+		}
+		var result []byte
+		result, err = json.Marshal(snapshot)
+		if err != nil {
+			return js.ValueOf([]interface{}{"", nil, err.Error()})
+		}
+		return js.ValueOf([]interface{}{version, string(result), nil})
+	})
+}
+
 func main() {
 	ch := make(chan struct{}, 0)
 	dev = development.NewContainer()
@@ -188,5 +232,7 @@ func main() {
 	js.Global().Set("readTuple", readTuple())
 	js.Global().Set("deleteTuple", deleteTuple())
 	js.Global().Set("readSchemaGraph", readSchemaGraph())
+	js.Global().Set("share", share())
+	js.Global().Set("load", load())
 	<-ch
 }
@@ -1,3 +1,5 @@
+// Package ast - Syntax tree of the permify schema language. Trees are created by pkg/dsl/parser,
+// walked with Walk or Inspect and printed back to source with String.
 package ast
 
 import (
@@ -20,10 +22,19 @@ func (o Operator) String() string {
 const (
 	IDENTIFIER ExpressionType = "identifier"
 	INFLIX     ExpressionType = "inflix"
+	CALL       ExpressionType = "call"
+	LITERAL    ExpressionType = "literal"
 	
 	AND Operator = "and"
 	OR  Operator = "or"
 	
+	EQ  Operator = "=="
+	NEQ Operator = "!="
+	LT  Operator = "<"
+	LTE Operator = "<="
+	GT  Operator = ">"
+	GTE Operator = ">="
+	
 	ACTION   RelationalReferenceType = "action"
 	RELATION RelationalReferenceType = "relation"
 )
@@ -49,10 +60,15 @@ type Statement interface {
 
 // EntityStatement -
 type EntityStatement struct {
-	Entity             token.Token // token.ENTITY
-	Name               token.Token // token.IDENT
-	RelationStatements []Statement
-	ActionStatements   []Statement
+	// Doc - lines of the comments right above the statement
+	Doc    []string
+	Entity token.Token // token.ENTITY
+	Name   token.Token // token.IDENT
+	// Aliases - previous names of the entity that tuples and requests may still use, token.IDENT
+	Aliases             []token.Token
+	AttributeStatements []Statement
+	RelationStatements  []Statement
+	ActionStatements    []Statement
 }
 
 // statementNode -
@@ -61,12 +77,28 @@ func (ls *EntityStatement) statementNode() {}
 // String -
 func (ls *EntityStatement) String() string {
 	var sb strings.Builder
+	writeDoc(&sb, "", ls.Doc)
 	sb.WriteString("entity")
 	sb.WriteString(" ")
 	sb.WriteString(ls.Name.Literal)
+	if len(ls.Aliases) > 0 {
+		sb.WriteString(" (alias ")
+		for i, alias := range ls.Aliases {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(alias.Literal)
+		}
+		sb.WriteString(")")
+	}
 	sb.WriteString(" {")
 	sb.WriteString("\n")
 	
+	for _, as := range ls.AttributeStatements {
+		sb.WriteString(as.String())
+		sb.WriteString("\n")
+	}
+	
 	for _, rs := range ls.RelationStatements {
 		sb.WriteString(rs.String())
 		sb.WriteString("\n")
@@ -85,8 +117,56 @@ func (ls *EntityStatement) String() string {
 	return sb.String()
 }
 
+// writeDoc - Writes the doc comments of a statement on the lines above it, so they are kept in the printed source
+func writeDoc(sb *strings.Builder, indent string, doc []string) {
+	for _, line := range doc {
+		sb.WriteString(indent)
+		sb.WriteString("// ")
+		sb.WriteString(line)
+		sb.WriteString("\n")
+	}
+}
+
+// AttributeTypes - Types of the attributes and of the arguments of the rules
+var AttributeTypes = []string{"string", "boolean", "integer", "double"}
+
+// IsAttributeType -
+func IsAttributeType(typ string) bool {
+	for _, t := range AttributeTypes {
+		if t == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// AttributeStatement - Typed value of the entities that rules are evaluated with
+type AttributeStatement struct {
+	Attribute     token.Token // token.ATTRIBUTE
+	Name          token.Token // token.IDENT
+	AttributeType token.Token // token.IDENT
+}
+
+// statementNode -
+func (ls *AttributeStatement) statementNode() {}
+
+// String -
+func (ls *AttributeStatement) String() string {
+	var sb strings.Builder
+	sb.WriteString("\t")
+	sb.WriteString("attribute")
+	sb.WriteString(" ")
+	sb.WriteString(ls.Name.Literal)
+	sb.WriteString(" ")
+	sb.WriteString(ls.AttributeType.Literal)
+	return sb.String()
+}
+
 // RelationStatement -
 type RelationStatement struct {
+	// Doc - lines of the comments right above the statement
+	Doc           []string
+	Deprecated    token.Token // token.DEPRECATED
 	Relation      token.Token // token.RELATION
 	Name          token.Token // token.IDENT
 	RelationTypes []RelationTypeStatement
@@ -98,7 +178,12 @@ func (ls *RelationStatement) statementNode() {}
 // String -
 func (ls *RelationStatement) String() string {
 	var sb strings.Builder
+	writeDoc(&sb, "\t", ls.Doc)
 	sb.WriteString("\t")
+	if ls.IsDeprecated() {
+		sb.WriteString("deprecated")
+		sb.WriteString(" ")
+	}
 	sb.WriteString("relation")
 	sb.WriteString(" ")
 	sb.WriteString(ls.Name.Literal)
@@ -112,6 +197,11 @@ func (ls *RelationStatement) String() string {
 	return sb.String()
 }
 
+// IsDeprecated -
+func (ls *RelationStatement) IsDeprecated() bool {
+	return ls.Deprecated.Literal != ""
+}
+
 // RelationTypeStatement -
 type RelationTypeStatement struct {
 	Sign     token.Token // token.SIGN
@@ -140,6 +230,10 @@ func IsDirectEntityReference(s RelationTypeStatement) bool {
 type Identifier struct {
 	Prefix token.Token
 	Idents []token.Token // token.IDENT
+	// Transitive - the first relation is walked again on every entity it reaches, e.g. parent*.viewer
+	Transitive token.Token // token.STAR
+	// Caveat - caveat the tuples of the relation have to satisfy, e.g. viewer with ip_allowed
+	Caveat token.Token // token.IDENT
 }
 
 // expressionNode -
@@ -152,14 +246,31 @@ func (ls *Identifier) String() string {
 		sb.WriteString("not")
 		sb.WriteString(" ")
 	}
-	for _, ident := range ls.Idents[:len(ls.Idents)-1] {
+	for i, ident := range ls.Idents[:len(ls.Idents)-1] {
 		sb.WriteString(ident.Literal)
+		if i == 0 && ls.IsTransitive() {
+			sb.WriteString("*")
+		}
 		sb.WriteString(".")
 	}
 	sb.WriteString(ls.Idents[len(ls.Idents)-1].Literal)
+	if ls.HasCaveat() {
+		sb.WriteString(" with ")
+		sb.WriteString(ls.Caveat.Literal)
+	}
 	return sb.String()
 }
 
+// IsTransitive -
+func (ls *Identifier) IsTransitive() bool {
+	return ls.Transitive.Literal != ""
+}
+
+// HasCaveat -
+func (ls *Identifier) HasCaveat() bool {
+	return ls.Caveat.Literal != ""
+}
+
 // IsPrefix -
 func (ls *Identifier) IsPrefix() bool {
 	return ls.Prefix.Literal != ""
@@ -175,11 +286,38 @@ func (ls *Identifier) GetType() ExpressionType {
 	return IDENTIFIER
 }
 
+// PolicyAnnotation - OPA policy that decides an action together with its expression, e.g. opa "authz/doc/edit" or.
+// The mode is and, or or override, and when it is not given.
+type PolicyAnnotation struct {
+	Opa  token.Token // token.OPA
+	Path token.Token // token.STRING
+	Mode token.Token // token.AND, token.OR or token.IDENT
+}
+
+// String -
+func (pa *PolicyAnnotation) String() string {
+	var sb strings.Builder
+	sb.WriteString("opa ")
+	sb.WriteString(`"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(pa.Path.Literal) + `"`)
+	if pa.Mode.Literal != "" {
+		sb.WriteString(" ")
+		sb.WriteString(pa.Mode.Literal)
+	}
+	return sb.String()
+}
+
 // ActionStatement -
 type ActionStatement struct {
+	// Doc - lines of the comments right above the statement
+	Doc                 []string
+	Policy              *PolicyAnnotation
+	Deprecated          token.Token // token.DEPRECATED
 	Action              token.Token // token.ACTION
 	Name                token.Token // token.IDENT
 	ExpressionStatement Statement
+	// When - CEL condition the action holds under besides its expression, e.g. when "context.hour < 18"
+	When      token.Token // token.IDENT
+	Condition token.Token // token.STRING
 }
 
 // statementNode -
@@ -188,7 +326,16 @@ func (ls *ActionStatement) statementNode() {}
 // String -
 func (ls *ActionStatement) String() string {
 	var sb strings.Builder
+	writeDoc(&sb, "\t", ls.Doc)
 	sb.WriteString("\t")
+	if ls.Policy != nil {
+		sb.WriteString(ls.Policy.String())
+		sb.WriteString(" ")
+	}
+	if ls.IsDeprecated() {
+		sb.WriteString("deprecated")
+		sb.WriteString(" ")
+	}
 	sb.WriteString("action")
 	sb.WriteString(" ")
 	sb.WriteString(ls.Name.Literal)
@@ -196,9 +343,23 @@ func (ls *ActionStatement) String() string {
 	if ls.ExpressionStatement != nil {
 		sb.WriteString(ls.ExpressionStatement.String())
 	}
+	if ls.HasCondition() {
+		sb.WriteString(" when ")
+		sb.WriteString(quote(ls.Condition.Literal))
+	}
 	return sb.String()
 }
 
+// IsDeprecated -
+func (ls *ActionStatement) IsDeprecated() bool {
+	return ls.Deprecated.Literal != ""
+}
+
+// HasCondition -
+func (ls *ActionStatement) HasCondition() bool {
+	return ls.When.Literal != ""
+}
+
 // ExpressionStatement struct
 type ExpressionStatement struct {
 	Expression Expression
@@ -248,3 +409,208 @@ func (ie *InfixExpression) IsInfix() bool {
 func (ie *InfixExpression) GetType() ExpressionType {
 	return INFLIX
 }
+
+// RuleStatement - Named condition over typed arguments, actions call it with attributes of their entity
+type RuleStatement struct {
+	Rule       token.Token // token.RULE
+	Name       token.Token // token.IDENT
+	Arguments  []RuleArgument
+	Expression Expression
+}
+
+// statementNode -
+func (ls *RuleStatement) statementNode() {}
+
+// String -
+func (ls *RuleStatement) String() string {
+	var sb strings.Builder
+	sb.WriteString("rule")
+	sb.WriteString(" ")
+	sb.WriteString(ls.Name.Literal)
+	sb.WriteString("(")
+	for i, arg := range ls.Arguments {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(arg.String())
+	}
+	sb.WriteString(") {")
+	sb.WriteString("\n")
+	sb.WriteString("\t")
+	if ls.Expression != nil {
+		sb.WriteString(ls.Expression.String())
+	}
+	sb.WriteString("\n")
+	sb.WriteString("}")
+	sb.WriteString(" ")
+	sb.WriteString("\n")
+	return sb.String()
+}
+
+// RuleArgument -
+type RuleArgument struct {
+	Name token.Token // token.IDENT
+	Type token.Token // token.IDENT
+}
+
+// String -
+func (ls RuleArgument) String() string {
+	return ls.Name.Literal + " " + ls.Type.Literal
+}
+
+// Call - Call of a rule with attributes of the entity, e.g. is_public(visibility)
+type Call struct {
+	Prefix    token.Token
+	Name      token.Token   // token.IDENT
+	Arguments []token.Token // token.IDENT
+}
+
+// expressionNode -
+func (ls *Call) expressionNode() {}
+
+// String -
+func (ls *Call) String() string {
+	var sb strings.Builder
+	if ls.Prefix.Literal != "" {
+		sb.WriteString("not")
+		sb.WriteString(" ")
+	}
+	sb.WriteString(ls.Name.Literal)
+	sb.WriteString("(")
+	for i, arg := range ls.Arguments {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(arg.Literal)
+	}
+	sb.WriteString(")")
+	return sb.String()
+}
+
+// IsPrefix -
+func (ls *Call) IsPrefix() bool {
+	return ls.Prefix.Literal != ""
+}
+
+// IsInfix -
+func (ls *Call) IsInfix() bool {
+	return false
+}
+
+// GetType -
+func (ls *Call) GetType() ExpressionType {
+	return CALL
+}
+
+// Literal - String, integer, double or boolean constant of a rule
+type Literal struct {
+	Token token.Token // token.STRING, token.INTEGER, token.DOUBLE, token.TRUE, token.FALSE
+}
+
+// expressionNode -
+func (ls *Literal) expressionNode() {}
+
+// String -
+func (ls *Literal) String() string {
+	if ls.Token.Type == token.STRING {
+		return quote(ls.Token.Literal)
+	}
+	return ls.Token.Literal
+}
+
+// IsInfix -
+func (ls *Literal) IsInfix() bool {
+	return false
+}
+
+// GetType -
+func (ls *Literal) GetType() ExpressionType {
+	return LITERAL
+}
+
+// CaveatStatement - Named CEL condition over the context of the check request and the payload of a tuple,
+// actions attach it to a relation, e.g. viewer with ip_allowed
+type CaveatStatement struct {
+	Caveat     token.Token // token.CAVEAT
+	Name       token.Token // token.IDENT
+	Expression token.Token // token.STRING
+}
+
+// statementNode -
+func (ls *CaveatStatement) statementNode() {}
+
+// String -
+func (ls *CaveatStatement) String() string {
+	var sb strings.Builder
+	sb.WriteString("caveat")
+	sb.WriteString(" ")
+	sb.WriteString(ls.Name.Literal)
+	sb.WriteString(" ")
+	sb.WriteString(quote(ls.Expression.Literal))
+	sb.WriteString(" ")
+	sb.WriteString("\n")
+	return sb.String()
+}
+
+// AssertStatement - Named test of the schema, its checks are expected to have the given results once its tuples are
+// written. Assertions are not compiled into the schema, they are run when a schema is validated.
+type AssertStatement struct {
+	Assert token.Token   // token.ASSERT
+	Name   token.Token   // token.STRING
+	Tuples []token.Token // token.STRING, e.g. "doc:1#owner@user:1"
+	Checks []AssertCheck
+}
+
+// statementNode -
+func (ls *AssertStatement) statementNode() {}
+
+// String -
+func (ls *AssertStatement) String() string {
+	var sb strings.Builder
+	sb.WriteString("assert")
+	sb.WriteString(" ")
+	sb.WriteString(quote(ls.Name.Literal))
+	sb.WriteString(" {")
+	sb.WriteString("\n")
+	
+	for _, t := range ls.Tuples {
+		sb.WriteString("\t")
+		sb.WriteString("tuple")
+		sb.WriteString(" ")
+		sb.WriteString(quote(t.Literal))
+		sb.WriteString("\n")
+	}
+	
+	for _, c := range ls.Checks {
+		sb.WriteString("\t")
+		sb.WriteString(c.String())
+		sb.WriteString("\n")
+	}
+	
+	sb.WriteString("}")
+	sb.WriteString(" ")
+	sb.WriteString("\n")
+	return sb.String()
+}
+
+// AssertCheck - Check query of an assertion and its expected result, e.g. check "can user:1 edit doc:1" true
+type AssertCheck struct {
+	Check    token.Token // token.IDENT
+	Query    token.Token // token.STRING
+	Expected token.Token // token.TRUE or token.FALSE
+}
+
+// String -
+func (ls AssertCheck) String() string {
+	return "check " + quote(ls.Query.Literal) + " " + ls.Expected.Literal
+}
+
+// IsAllowed - Whether the check is expected to be allowed
+func (ls AssertCheck) IsAllowed() bool {
+	return ls.Expected.Type == token.TRUE
+}
+
+// quote - Double quotes the string so that the lexer reads it back as it is
+func quote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
@@ -1,5 +1,9 @@
 package ast
 
+import (
+	"strings"
+)
+
 // Schema - it contains all statements
 type Schema struct {
 	Statements []Statement
@@ -13,6 +17,25 @@ type Schema struct {
 
 	// all relational references
 	relationalReferences map[string]RelationalReferenceType
+
+	// attribute types by entity_type#attribute, argument types by rule name
+	attributeReferences map[string]string
+	ruleReferences      map[string][]string
+
+	// caveat references
+	caveatReferences map[string]struct{}
+}
+
+// String - it prints the statements, the result can be parsed again
+func (sch *Schema) String() string {
+	var sb strings.Builder
+	for i, st := range sch.Statements {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(st.String())
+	}
+	return sb.String()
 }
 
 // SetEntityReferences - it contains entity references
@@ -86,3 +109,36 @@ func (sch *Schema) GetRelationReferenceIfExist(name string) ([]RelationTypeState
 	}
 	return nil, false
 }
+
+// SetAttributeReferences - it contains the types of the attributes
+func (sch *Schema) SetAttributeReferences(r map[string]string) {
+	sch.attributeReferences = r
+}
+
+// SetRuleReferences - it contains the argument types of the rules
+func (sch *Schema) SetRuleReferences(r map[string][]string) {
+	sch.ruleReferences = r
+}
+
+// GetAttributeTypeIfExist - it returns the type of the attribute
+func (sch *Schema) GetAttributeTypeIfExist(name string) (string, bool) {
+	typ, ok := sch.attributeReferences[name]
+	return typ, ok
+}
+
+// GetRuleArgumentTypesIfExist - it returns the argument types of the rule
+func (sch *Schema) GetRuleArgumentTypesIfExist(name string) ([]string, bool) {
+	types, ok := sch.ruleReferences[name]
+	return types, ok
+}
+
+// SetCaveatReferences - it contains the names of the caveats
+func (sch *Schema) SetCaveatReferences(r map[string]struct{}) {
+	sch.caveatReferences = r
+}
+
+// IsCaveatReferenceExist - it checks if the caveat reference exists
+func (sch *Schema) IsCaveatReferenceExist(name string) bool {
+	_, ok := sch.caveatReferences[name]
+	return ok
+}
@@ -0,0 +1,152 @@
+package ast
+
+import (
+	"github.com/adminium/permify/pkg/dsl/token"
+)
+
+// Visitor - Visit is called for every node of the tree. The children of the node are walked with the
+// returned visitor, a nil visitor skips them. Visit(nil) is called after the children of a node.
+type Visitor interface {
+	Visit(node Node) (w Visitor)
+}
+
+// Walk - Traverses the tree in depth-first order: entities, their attributes, relations and relation types,
+// then their actions and the expressions of the actions. Rules are walked with their expressions, caveats and
+// assertions have no children.
+func Walk(v Visitor, node Node) {
+	if v = v.Visit(node); v == nil {
+		return
+	}
+	
+	switch n := node.(type) {
+	case *Schema:
+		for _, st := range n.Statements {
+			Walk(v, st)
+		}
+	case *EntityStatement:
+		for _, st := range n.AttributeStatements {
+			Walk(v, st)
+		}
+		for _, st := range n.RelationStatements {
+			Walk(v, st)
+		}
+		for _, st := range n.ActionStatements {
+			Walk(v, st)
+		}
+	case *RelationStatement:
+		for i := range n.RelationTypes {
+			Walk(v, &n.RelationTypes[i])
+		}
+	case *ActionStatement:
+		if n.ExpressionStatement != nil {
+			Walk(v, n.ExpressionStatement)
+		}
+	case *ExpressionStatement:
+		if n.Expression != nil {
+			Walk(v, n.Expression)
+		}
+	case *RuleStatement:
+		if n.Expression != nil {
+			Walk(v, n.Expression)
+		}
+	case *InfixExpression:
+		Walk(v, n.Left)
+		Walk(v, n.Right)
+	}
+	
+	v.Visit(nil)
+}
+
+// inspector -
+type inspector func(Node) bool
+
+// Visit -
+func (f inspector) Visit(node Node) Visitor {
+	if node != nil && f(node) {
+		return f
+	}
+	return nil
+}
+
+// Inspect - Walks the tree calling f for every node, children are skipped when f returns false
+func Inspect(node Node, f func(Node) bool) {
+	Walk(inspector(f), node)
+}
+
+// Position - Position of the first token of the node in the source, invalid for nodes that were not parsed
+func Position(node Node) token.Position {
+	switch n := node.(type) {
+	case *Schema:
+		if len(n.Statements) > 0 {
+			return Position(n.Statements[0])
+		}
+	case *EntityStatement:
+		return n.Entity.Position
+	case *AttributeStatement:
+		return n.Attribute.Position
+	case *RelationStatement:
+		if n.IsDeprecated() {
+			return n.Deprecated.Position
+		}
+		return n.Relation.Position
+	case *RelationTypeStatement:
+		return n.Sign.Position
+	case *ActionStatement:
+		if n.Policy != nil {
+			return n.Policy.Opa.Position
+		}
+		if n.IsDeprecated() {
+			return n.Deprecated.Position
+		}
+		return n.Action.Position
+	case *ExpressionStatement:
+		if n.Expression != nil {
+			return Position(n.Expression)
+		}
+	case *Identifier:
+		if n.IsPrefix() {
+			return n.Prefix.Position
+		}
+		if len(n.Idents) > 0 {
+			return n.Idents[0].Position
+		}
+	case *RuleStatement:
+		return n.Rule.Position
+	case *CaveatStatement:
+		return n.Caveat.Position
+	case *AssertStatement:
+		return n.Assert.Position
+	case *Call:
+		if n.IsPrefix() {
+			return n.Prefix.Position
+		}
+		return n.Name.Position
+	case *Literal:
+		return n.Token.Position
+	case *InfixExpression:
+		return Position(n.Left)
+	}
+	return token.Position{}
+}
+
+// RewriteExpressions - Replaces every expression of the actions under the node with the result of f.
+// Children are rewritten before their parents, f returns its argument to keep an expression.
+// References of a parsed schema are not updated, print and parse the schema again to validate the result.
+func RewriteExpressions(node Node, f func(Expression) Expression) {
+	Inspect(node, func(n Node) bool {
+		if es, ok := n.(*ExpressionStatement); ok && es.Expression != nil {
+			es.Expression = rewriteExpression(es.Expression, f)
+			return false
+		}
+		return true
+	})
+}
+
+// rewriteExpression -
+func rewriteExpression(exp Expression, f func(Expression) Expression) Expression {
+	if ie, ok := exp.(*InfixExpression); ok {
+		ie.Left = rewriteExpression(ie.Left, f)
+		ie.Right = rewriteExpression(ie.Right, f)
+	}
+	return f(exp)
+}
@@ -2,16 +2,30 @@ package compiler
 
 import (
 	"errors"
+	"strings"
+	
+	"github.com/google/cel-go/cel"
 	
 	"github.com/adminium/permify/pkg/dsl/ast"
+	"github.com/adminium/permify/pkg/dsl/token"
 	"github.com/adminium/permify/pkg/dsl/utils"
 	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
 )
 
 // Compiler -
 type Compiler struct {
 	schema                     *ast.Schema
 	withoutReferenceValidation bool
+	
+	// deprecated relations and actions of the compiled schema
+	// sample keys: entity_type#member, entity_type#read
+	deprecations []string
+	// previous names of the entities of the compiled schema, the values are the entity types
+	aliases map[string]string
+	// doc comments of the entities, relations and actions of the compiled schema
+	// sample keys: entity_type, entity_type#member, entity_type#read
+	docs map[string]string
 }
 
 // NewCompiler -
@@ -31,8 +45,39 @@ func (t *Compiler) Compile() (sch []*base.EntityDefinition, err error) {
 		}
 	}
 	
+	t.deprecations = nil
+	t.aliases = map[string]string{}
+	t.docs = map[string]string{}
+	
 	entities := make([]*base.EntityDefinition, 0, len(t.schema.Statements))
 	for _, sc := range t.schema.Statements {
+		// rules are evaluated from their statements, the compiled definitions have no room for them
+		if rs, ok := sc.(*ast.RuleStatement); ok {
+			if !t.withoutReferenceValidation {
+				if err = t.validateRule(rs); err != nil {
+					return nil, err
+				}
+			}
+			continue
+		}
+		// caveats are evaluated from their statements as well
+		if cs, ok := sc.(*ast.CaveatStatement); ok {
+			if !t.withoutReferenceValidation {
+				if err = t.validateCaveat(cs); err != nil {
+					return nil, err
+				}
+			}
+			continue
+		}
+		// assertions are run against the compiled schema, they are not part of it
+		if as, ok := sc.(*ast.AssertStatement); ok {
+			if !t.withoutReferenceValidation {
+				if err = t.validateAssert(as); err != nil {
+					return nil, err
+				}
+			}
+			continue
+		}
 		var en *base.EntityDefinition
 		es, ok := sc.(*ast.EntityStatement)
 		if !ok {
@@ -48,6 +93,30 @@ func (t *Compiler) Compile() (sch []*base.EntityDefinition, err error) {
 	return entities, err
 }
 
+// Deprecations - Deprecated relations and actions of the last compiled schema, the compiled definitions have no room for the flag
+func (t *Compiler) Deprecations() []string {
+	return t.deprecations
+}
+
+// Aliases - Previous names of the entities of the last compiled schema by alias, the compiled definitions have no
+// room for them
+func (t *Compiler) Aliases() map[string]string {
+	return t.aliases
+}
+
+// Docs - Doc comments of the entities, relations and actions of the last compiled schema, the compiled definitions
+// have no room for them
+func (t *Compiler) Docs() map[string]string {
+	return t.docs
+}
+
+// doc - Keeps the doc comment lines of the key
+func (t *Compiler) doc(key string, lines []string) {
+	if len(lines) > 0 {
+		t.docs[key] = strings.Join(lines, "\n")
+	}
+}
+
 // translateToEntity -
 func (t *Compiler) compile(sc *ast.EntityStatement) (*base.EntityDefinition, error) {
 	entityDefinition := &base.EntityDefinition{
@@ -57,6 +126,11 @@ func (t *Compiler) compile(sc *ast.EntityStatement) (*base.EntityDefinition, err
 		References: map[string]base.EntityDefinition_RelationalReference{},
 	}
 	
+	for _, alias := range sc.Aliases {
+		t.aliases[alias.Literal] = sc.Name.Literal
+	}
+	t.doc(entityDefinition.GetName(), sc.Doc)
+	
 	// relations
 	for _, rs := range sc.RelationStatements {
 		relationSt, okRs := rs.(*ast.RelationStatement)
@@ -75,6 +149,11 @@ func (t *Compiler) compile(sc *ast.EntityStatement) (*base.EntityDefinition, err
 			})
 		}
 		
+		if relationSt.IsDeprecated() {
+			t.deprecations = append(t.deprecations, utils.Key(entityDefinition.GetName(), relationDefinition.GetName()))
+		}
+		t.doc(utils.Key(entityDefinition.GetName(), relationDefinition.GetName()), relationSt.Doc)
+		
 		entityDefinition.Relations[relationDefinition.GetName()] = relationDefinition
 		entityDefinition.References[relationDefinition.GetName()] = base.EntityDefinition_RELATIONAL_REFERENCE_RELATION
 	}
@@ -89,17 +168,80 @@ func (t *Compiler) compile(sc *ast.EntityStatement) (*base.EntityDefinition, err
 		if err != nil {
 			return nil, err
 		}
+		if st.HasCondition() {
+			ch, err = t.compileCondition(ch, st.Condition.Literal)
+			if err != nil {
+				return nil, err
+			}
+		}
 		actionDefinition := &base.ActionDefinition{
 			Name:  st.Name.Literal,
 			Child: ch,
 		}
+		if st.IsDeprecated() {
+			t.deprecations = append(t.deprecations, utils.Key(entityDefinition.GetName(), actionDefinition.GetName()))
+		}
+		t.doc(utils.Key(entityDefinition.GetName(), actionDefinition.GetName()), st.Doc)
+		
 		entityDefinition.Actions[actionDefinition.GetName()] = actionDefinition
 		entityDefinition.References[actionDefinition.GetName()] = base.EntityDefinition_RELATIONAL_REFERENCE_ACTION
 	}
 	
+	if err := validateActionReferences(entityDefinition); err != nil {
+		return nil, err
+	}
+	
 	return entityDefinition, nil
 }
 
+// validateActionReferences - Actions can reference the other actions of their entity like relations, e.g.
+// action delete = edit or admin, as long as no action ends up referencing itself
+func validateActionReferences(entityDefinition *base.EntityDefinition) error {
+	// 0 unvisited, 1 on the current path, 2 done
+	state := map[string]int{}
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case 1:
+			return errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
+		case 2:
+			return nil
+		}
+		state[name] = 1
+		for _, reference := range actionReferences(entityDefinition.GetActions()[name].GetChild()) {
+			if _, ok := entityDefinition.GetActions()[reference]; !ok {
+				continue
+			}
+			if err := visit(reference); err != nil {
+				return err
+			}
+		}
+		state[name] = 2
+		return nil
+	}
+	for name := range entityDefinition.GetActions() {
+		if err := visit(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// actionReferences - Relations and actions of its own entity that the child reads
+func actionReferences(child *base.Child) (references []string) {
+	switch c := child.GetType().(type) {
+	case *base.Child_Rewrite:
+		for _, ch := range c.Rewrite.GetChildren() {
+			references = append(references, actionReferences(ch)...)
+		}
+	case *base.Child_Leaf:
+		if cu := c.Leaf.GetComputedUserSet(); cu != nil {
+			references = append(references, cu.GetRelation())
+		}
+	}
+	return references
+}
+
 // compileExpressionStatement -
 func (t *Compiler) compileExpressionStatement(entityName string, expression *ast.ExpressionStatement) (*base.Child, error) {
 	return t.compileChildren(entityName, expression.Expression)
@@ -155,6 +297,10 @@ func (t *Compiler) compileRewrite(entityName string, exp *ast.InfixExpression) (
 func (t *Compiler) compileLeaf(entityName string, expression ast.Expression) (*base.Child, error) {
 	child := &base.Child{}
 	
+	if expression.GetType() == ast.CALL {
+		return t.compileCall(entityName, expression.(*ast.Call))
+	}
+	
 	var ident *ast.Identifier
 	if expression.GetType() == ast.IDENTIFIER {
 		ident = expression.(*ast.Identifier)
@@ -162,6 +308,10 @@ func (t *Compiler) compileLeaf(entityName string, expression ast.Expression) (*b
 		return nil, errors.New(base.ErrorCode_ERROR_CODE_RELATION_DEFINITION_NOT_FOUND.String())
 	}
 	
+	if ident.HasCaveat() {
+		return t.compileCaveat(entityName, ident)
+	}
+	
 	if len(ident.Idents) == 1 {
 		if !t.withoutReferenceValidation {
 			if !t.schema.IsRelationalReferenceExist(utils.Key(entityName, ident.Idents[0].Literal)) {
@@ -179,28 +329,263 @@ func (t *Compiler) compileLeaf(entityName string, expression ast.Expression) (*b
 		return child, nil
 	}
 	
-	if len(ident.Idents) == 2 {
-		if !t.withoutReferenceValidation {
-			types, exist := t.schema.GetRelationReferenceIfExist(utils.Key(entityName, ident.Idents[0].Literal))
+	// a chain walks a relation to other entities for every relation but its last, e.g. parent.parent.admin, a
+	// transitive first relation walks itself until it reaches no more entities, e.g. parent*.admin
+	if !t.withoutReferenceValidation {
+		typ := entityName
+		for _, relation := range ident.Idents[:len(ident.Idents)-1] {
+			types, exist := t.schema.GetRelationReferenceIfExist(utils.Key(typ, relation.Literal))
 			if !exist {
 				return nil, errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_RELATION_REFERENCE.String())
 			}
-			if !t.schema.IsRelationalReferenceExist(utils.Key(utils.GetBaseEntityRelationTypeStatement(types).Type.Literal, ident.Idents[1].Literal)) {
+			typ = utils.GetBaseEntityRelationTypeStatement(types).Type.Literal
+		}
+		if !t.schema.IsRelationalReferenceExist(utils.Key(typ, ident.Idents[len(ident.Idents)-1].Literal)) {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_RELATION_REFERENCE.String())
+		}
+	}
+		
+	// the rest of the chain is resolved on the entities of the first relation, it is an action of theirs that is
+	// not declared in their definitions
+	computed := make([]string, 0, len(ident.Idents)-1)
+	for _, relation := range ident.Idents[1:] {
+		computed = append(computed, relation.Literal)
+	}
+	
+	tupleSet := ident.Idents[0].Literal
+	if ident.IsTransitive() {
+		tupleSet = utils.TransitiveReference(tupleSet)
+	}
+	
+	leaf, err := t.compileTupleToUserSetIdentifier(tupleSet, strings.Join(computed, "."))
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
+	}
+		
+	leaf.Exclusion = ident.IsPrefix()
+	child.Type = &base.Child_Leaf{Leaf: leaf}
+	return child, nil
+}
+
+// compileCall - The arguments of the call are attributes of the entity with the types of the arguments of the rule
+func (t *Compiler) compileCall(entityName string, call *ast.Call) (*base.Child, error) {
+	arguments := make([]string, 0, len(call.Arguments))
+	for _, argument := range call.Arguments {
+		arguments = append(arguments, argument.Literal)
+	}
+	
+	if !t.withoutReferenceValidation {
+		types, exist := t.schema.GetRuleArgumentTypesIfExist(call.Name.Literal)
+		if !exist {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_RELATION_REFERENCE.String())
+		}
+		if len(types) != len(arguments) {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
+		}
+		for i, argument := range arguments {
+			typ, exist := t.schema.GetAttributeTypeIfExist(utils.Key(entityName, argument))
+			if !exist {
 				return nil, errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_RELATION_REFERENCE.String())
 			}
+			if typ != types[i] {
+				return nil, errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
+			}
 		}
-		
-		leaf, err := t.compileTupleToUserSetIdentifier(ident.Idents[0].Literal, ident.Idents[1].Literal)
+	}
+	
+	leaf, err := t.compileComputedUserSetIdentifier(utils.RuleReference(call.Name.Literal, arguments))
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
+	}
+	
+	leaf.Exclusion = call.IsPrefix()
+	return &base.Child{Type: &base.Child_Leaf{Leaf: leaf}}, nil
+}
+
+// compileCaveat - The caveat is checked against the payloads of the tuples of the relation, so it is attached to a
+// relation of the entity, not to an action or a chain
+func (t *Compiler) compileCaveat(entityName string, ident *ast.Identifier) (*base.Child, error) {
+	if len(ident.Idents) != 1 {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_NOT_SUPPORTED_RELATION_WALK.String())
+	}
+	
+	if !t.withoutReferenceValidation {
+		if !t.schema.IsRelationReferenceExist(utils.Key(entityName, ident.Idents[0].Literal)) {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_RELATION_REFERENCE.String())
+		}
+		if !t.schema.IsCaveatReferenceExist(ident.Caveat.Literal) {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_RELATION_REFERENCE.String())
+		}
+	}
+	
+	leaf, err := t.compileComputedUserSetIdentifier(utils.CaveatReference(ident.Idents[0].Literal, ident.Caveat.Literal))
+	if err != nil {
+		return nil, errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
+	}
+	
+	leaf.Exclusion = ident.IsPrefix()
+	return &base.Child{Type: &base.Child_Leaf{Leaf: leaf}}, nil
+}
+
+// compileCondition - The action holds when both its expression and its condition do, so the condition is a leaf
+// intersected with the expression
+func (t *Compiler) compileCondition(child *base.Child, expression string) (*base.Child, error) {
+	if !t.withoutReferenceValidation {
+		env, err := utils.ConditionEnvironment()
 		if err != nil {
 			return nil, errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
 		}
-		
-		leaf.Exclusion = ident.IsPrefix()
-		child.Type = &base.Child_Leaf{Leaf: leaf}
-		return child, nil
+		checked, issues := env.Compile(expression)
+		if issues != nil && issues.Err() != nil {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
+		}
+		if checked.OutputType() != cel.BoolType && checked.OutputType() != cel.DynType {
+			return nil, errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
+		}
 	}
 	
-	return nil, errors.New(base.ErrorCode_ERROR_CODE_NOT_SUPPORTED_RELATION_WALK.String())
+	condition := &base.Child{
+		Type: &base.Child_Leaf{
+			Leaf: &base.Leaf{
+				Type: &base.Leaf_Condition{Condition: &base.Condition{Expression: expression}},
+			},
+		},
+	}
+	return &base.Child{
+		Type: &base.Child_Rewrite{
+			Rewrite: &base.Rewrite{
+				RewriteOperation: base.Rewrite_OPERATION_INTERSECTION,
+				Children:         []*base.Child{child, condition},
+			},
+		},
+	}, nil
+}
+
+// validateCaveat - The expression of the caveat is a CEL condition over the context and the payload
+func (t *Compiler) validateCaveat(cs *ast.CaveatStatement) error {
+	env, err := utils.CaveatEnvironment()
+	if err != nil {
+		return errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
+	}
+	checked, issues := env.Compile(cs.Expression.Literal)
+	if issues != nil && issues.Err() != nil {
+		return errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
+	}
+	if checked.OutputType() != cel.BoolType && checked.OutputType() != cel.DynType {
+		return errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
+	}
+	return nil
+}
+
+// validateRule - The expression of the rule is a condition over its arguments
+func (t *Compiler) validateRule(rs *ast.RuleStatement) error {
+	arguments := map[string]string{}
+	for _, argument := range rs.Arguments {
+		if _, ok := arguments[argument.Name.Literal]; ok {
+			return errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
+		}
+		arguments[argument.Name.Literal] = argument.Type.Literal
+	}
+	typ, err := ruleExpressionType(rs.Expression, arguments)
+	if err != nil {
+		return err
+	}
+	if typ != "boolean" {
+		return errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
+	}
+	return nil
+}
+
+// validateAssert - The tuples of the assertion are written with relations of the schema and its checks query
+// relations or actions of the schema
+func (t *Compiler) validateAssert(as *ast.AssertStatement) error {
+	for _, tok := range as.Tuples {
+		tup, err := tuple.Tuple(tok.Literal)
+		if err != nil {
+			return errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+		}
+		if !t.schema.IsEntityReferenceExist(tup.GetEntity().GetType()) || !t.schema.IsEntityReferenceExist(tup.GetSubject().GetType()) {
+			return errors.New(base.ErrorCode_ERROR_CODE_RELATION_REFERENCE_NOT_FOUND_IN_ENTITY_REFERENCES.String())
+		}
+		if !t.schema.IsRelationReferenceExist(utils.Key(tup.GetEntity().GetType(), tup.GetRelation())) {
+			return errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_RELATION_REFERENCE.String())
+		}
+	}
+	for _, check := range as.Checks {
+		q, err := tuple.NewQueryFromString(check.Query.Literal)
+		if err != nil {
+			return errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+		}
+		if !t.schema.IsEntityReferenceExist(q.Entity.GetType()) || !t.schema.IsEntityReferenceExist(q.Subject.GetType()) {
+			return errors.New(base.ErrorCode_ERROR_CODE_RELATION_REFERENCE_NOT_FOUND_IN_ENTITY_REFERENCES.String())
+		}
+		if !t.schema.IsRelationalReferenceExist(utils.Key(q.Entity.GetType(), q.Action)) {
+			return errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_RELATION_REFERENCE.String())
+		}
+	}
+	return nil
+}
+
+// ruleExpressionType - Type of the value of an expression of a rule
+func ruleExpressionType(expression ast.Expression, arguments map[string]string) (string, error) {
+	switch exp := expression.(type) {
+	case *ast.Literal:
+		switch exp.Token.Type {
+		case token.STRING:
+			return "string", nil
+		case token.INTEGER:
+			return "integer", nil
+		case token.DOUBLE:
+			return "double", nil
+		default:
+			return "boolean", nil
+		}
+	case *ast.Identifier:
+		if len(exp.Idents) != 1 {
+			return "", errors.New(base.ErrorCode_ERROR_CODE_NOT_SUPPORTED_RELATION_WALK.String())
+		}
+		typ, ok := arguments[exp.Idents[0].Literal]
+		if !ok {
+			return "", errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_RELATION_REFERENCE.String())
+		}
+		if exp.IsPrefix() && typ != "boolean" {
+			return "", errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
+		}
+		return typ, nil
+	case *ast.InfixExpression:
+		left, err := ruleExpressionType(exp.Left, arguments)
+		if err != nil {
+			return "", err
+		}
+		var right string
+		right, err = ruleExpressionType(exp.Right, arguments)
+		if err != nil {
+			return "", err
+		}
+		numeric := isNumeric(left) && isNumeric(right)
+		switch exp.Operator {
+		case ast.AND, ast.OR:
+			if left != "boolean" || right != "boolean" {
+				return "", errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
+			}
+		case ast.EQ, ast.NEQ:
+			if left != right && !numeric {
+				return "", errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
+			}
+		default:
+			if !numeric {
+				return "", errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
+			}
+		}
+		return "boolean", nil
+	default:
+		return "", errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())
+	}
+}
+
+// isNumeric -
+func isNumeric(typ string) bool {
+	return typ == "integer" || typ == "double"
 }
 
 // compileComputedUserSetIdentifier -
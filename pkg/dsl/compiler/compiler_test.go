@@ -377,6 +377,7 @@ var _ = Describe("compiler", func() {
 			entity repository {
 				
 				relation parent @organization
+				relation admin @user
 				action update = parent.parent.admin or admin
 			}
 			`).Parse()
@@ -385,8 +386,40 @@ var _ = Describe("compiler", func() {
 			
 			c := NewCompiler(false, sch)
 			
-			_, err = c.Compile()
-			Expect(err).Should(Equal(errors.New(base.ErrorCode_ERROR_CODE_NOT_SUPPORTED_RELATION_WALK.String())))
+			var is []*base.EntityDefinition
+			is, err = c.Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			// the rest of the chain is read on the organizations of the parent relation
+			Expect(is[3].GetActions()["update"].GetChild().GetRewrite().GetChildren()[0]).Should(Equal(&base.Child{
+				Type: &base.Child_Leaf{
+					Leaf: &base.Leaf{
+						Type: &base.Leaf_TupleToUserSet{
+							TupleToUserSet: &base.TupleToUserSet{
+								TupleSet: &base.TupleSet{Relation: "parent"},
+								Computed: &base.ComputedUserSet{Relation: "parent.admin"},
+							},
+						},
+					},
+				},
+			}))
+			
+			sch, err = parser.NewParser(`
+			entity user {}
+			
+			entity organization {
+				relation admin @user
+			}
+			
+			entity repository {
+				relation parent @organization
+				action update = parent.admin.owner
+			}
+			`).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			_, err = NewCompiler(false, sch).Compile()
+			Expect(err).Should(Equal(errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_RELATION_REFERENCE.String())))
 		})
 		
 		It("Case 7", func() {
@@ -1036,5 +1069,324 @@ var _ = Describe("compiler", func() {
 			
 			Expect(is).Should(Equal(i))
 		})
+		
+		It("Case 12: Rule calls", func() {
+			sch, err := parser.NewParser(`
+			entity user {}
+			
+			entity repository {
+				attribute visibility string
+				attribute stars integer
+				
+				action read = not is_public(visibility)
+			}
+			
+			rule is_public(visibility string) {
+				visibility == "public"
+			}
+			
+			rule popular(stars double) {
+				stars > 10.5
+			}
+			`).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			c := NewCompiler(false, sch)
+			
+			var is []*base.EntityDefinition
+			is, err = c.Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(is).Should(HaveLen(2))
+			Expect(is[1].GetActions()["read"].GetChild()).Should(Equal(&base.Child{
+				Type: &base.Child_Leaf{
+					Leaf: &base.Leaf{
+						Exclusion: true,
+						Type: &base.Leaf_ComputedUserSet{
+							ComputedUserSet: &base.ComputedUserSet{
+								Relation: "is_public(visibility)",
+							},
+						},
+					},
+				},
+			}))
+		})
+		
+		It("Case 13: Rule call errors", func() {
+			for _, source := range []string{
+				// undefined rule
+				"entity repository {\n attribute visibility string\n action read = is_public(visibility)\n}",
+				// undefined attribute
+				"entity repository {\n action read = is_public(visibility)\n}\nrule is_public(visibility string) {\n visibility == \"public\"\n}",
+				// attribute type
+				"entity repository {\n attribute visibility boolean\n action read = is_public(visibility)\n}\nrule is_public(visibility string) {\n visibility == \"public\"\n}",
+				// arity
+				"entity repository {\n attribute visibility string\n action read = is_public(visibility, visibility)\n}\nrule is_public(visibility string) {\n visibility == \"public\"\n}",
+				// ordering of strings
+				"rule newer(visibility string) {\n visibility > \"a\"\n}",
+				// non boolean body
+				"rule stars(stars integer) {\n stars\n}",
+			} {
+				sch, err := parser.NewParser("entity user {}\n" + source).Parse()
+				Expect(err).ShouldNot(HaveOccurred(), source)
+				
+				_, err = NewCompiler(false, sch).Compile()
+				Expect(err).Should(HaveOccurred(), source)
+			}
+		})
+		
+		It("Case 14: Action references", func() {
+			sch, err := parser.NewParser(`
+			entity user {}
+			
+			entity doc {
+				relation owner @user
+				relation admin @user
+				
+				action edit = owner
+				action delete = edit or admin
+			}
+			`).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			var is []*base.EntityDefinition
+			is, err = NewCompiler(false, sch).Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(is[1].GetReferences()["delete"]).Should(Equal(base.EntityDefinition_RELATIONAL_REFERENCE_ACTION))
+			Expect(is[1].GetActions()["delete"].GetChild().GetRewrite().GetChildren()[0]).Should(Equal(&base.Child{
+				Type: &base.Child_Leaf{
+					Leaf: &base.Leaf{
+						Type: &base.Leaf_ComputedUserSet{
+							ComputedUserSet: &base.ComputedUserSet{
+								Relation: "edit",
+							},
+						},
+					},
+				},
+			}))
+			
+			for _, source := range []string{
+				"entity doc {\n relation owner @user\n action edit = edit or owner\n}",
+				"entity doc {\n relation owner @user\n action edit = delete\n action delete = owner and edit\n}",
+			} {
+				sch, err = parser.NewParser("entity user {}\n" + source).Parse()
+				Expect(err).ShouldNot(HaveOccurred(), source)
+				
+				_, err = NewCompiler(false, sch).Compile()
+				Expect(err).Should(Equal(errors.New(base.ErrorCode_ERROR_CODE_SCHEMA_COMPILE.String())), source)
+			}
+		})
+		
+		It("Case 15: Transitive relations", func() {
+			sch, err := parser.NewParser(`
+			entity user {}
+			
+			entity folder {
+				relation parent @folder
+				relation viewer @user
+				
+				action view = viewer or parent*.viewer
+			}
+			`).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			var is []*base.EntityDefinition
+			is, err = NewCompiler(false, sch).Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(is[1].GetActions()["view"].GetChild().GetRewrite().GetChildren()[1]).Should(Equal(&base.Child{
+				Type: &base.Child_Leaf{
+					Leaf: &base.Leaf{
+						Type: &base.Leaf_TupleToUserSet{
+							TupleToUserSet: &base.TupleToUserSet{
+								TupleSet: &base.TupleSet{Relation: "parent*"},
+								Computed: &base.ComputedUserSet{Relation: "viewer"},
+							},
+						},
+					},
+				},
+			}))
+			
+			sch, err = parser.NewParser(`
+			entity user {}
+			
+			entity folder {
+				relation viewer @user
+				
+				action view = viewer or parent*.viewer
+			}
+			`).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			_, err = NewCompiler(false, sch).Compile()
+			Expect(err).Should(Equal(errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_RELATION_REFERENCE.String())))
+		})
+		
+		It("Case 16: Assert blocks reference the relations and actions of the schema", func() {
+			sch, err := parser.NewParser(`
+			entity user {}
+			
+			entity doc {
+				relation owner @user
+				
+				action edit = owner
+			}
+			
+			assert "owners edit" {
+				tuple "doc:1#owner@user:1"
+				check "can user:1 edit doc:1" true
+			}
+			`).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			var is []*base.EntityDefinition
+			is, err = NewCompiler(false, sch).Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(is).Should(HaveLen(2))
+			
+			sch, err = parser.NewParser(`
+			entity user {}
+			
+			entity doc {
+				relation owner @user
+			}
+			
+			assert "editors" {
+				tuple "doc:1#editor@user:1"
+			}
+			`).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			_, err = NewCompiler(false, sch).Compile()
+			Expect(err).Should(Equal(errors.New(base.ErrorCode_ERROR_CODE_UNDEFINED_RELATION_REFERENCE.String())))
+			
+			sch, err = parser.NewParser(`
+			entity user {}
+			
+			entity doc {
+				relation owner @user
+			}
+			
+			assert "folders" {
+				check "can user:1 owner folder:1" true
+			}
+			`).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			_, err = NewCompiler(false, sch).Compile()
+			Expect(err).Should(Equal(errors.New(base.ErrorCode_ERROR_CODE_RELATION_REFERENCE_NOT_FOUND_IN_ENTITY_REFERENCES.String())))
+		})
+		
+		It("Case 17: Caveats", func() {
+			sch, err := parser.NewParser(`
+			entity user {}
+			
+			entity doc {
+				relation viewer @user
+				
+				action view = not viewer with ip_allowed
+			}
+			
+			caveat ip_allowed "context.ip == payload.ip"
+			`).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			var is []*base.EntityDefinition
+			is, err = NewCompiler(false, sch).Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(is).Should(HaveLen(2))
+			Expect(is[1].GetActions()["view"].GetChild()).Should(Equal(&base.Child{
+				Type: &base.Child_Leaf{
+					Leaf: &base.Leaf{
+						Exclusion: true,
+						Type: &base.Leaf_ComputedUserSet{
+							ComputedUserSet: &base.ComputedUserSet{
+								Relation: "viewer[ip_allowed]",
+							},
+						},
+					},
+				},
+			}))
+			
+			for _, source := range []string{
+				// undefined caveat
+				"entity doc {\n relation viewer @user\n action view = viewer with ip_allowed\n}",
+				// caveat of an action
+				"entity doc {\n relation viewer @user\n action read = viewer\n action view = read with ip_allowed\n}\ncaveat ip_allowed \"true\"",
+				// caveat of a chain
+				"entity doc {\n relation parent @doc\n relation viewer @user\n action view = parent.viewer with ip_allowed\n}\ncaveat ip_allowed \"true\"",
+				// invalid expression
+				"caveat ip_allowed \"context.ip ==\"",
+				// non boolean expression
+				"caveat ip_allowed \"1 + 2\"",
+			} {
+				sch, err = parser.NewParser("entity user {}\n" + source).Parse()
+				Expect(err).ShouldNot(HaveOccurred(), source)
+				
+				_, err = NewCompiler(false, sch).Compile()
+				Expect(err).Should(HaveOccurred(), source)
+			}
+		})
+		
+		It("Case 18: Conditions", func() {
+			sch, err := parser.NewParser(`
+			entity user {}
+			
+			entity doc {
+				relation owner @user
+				
+				action view = owner when "context.hour < 18 && subject.type == 'user'"
+			}
+			`).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			var is []*base.EntityDefinition
+			is, err = NewCompiler(false, sch).Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(is[1].GetActions()["view"].GetChild()).Should(Equal(&base.Child{
+				Type: &base.Child_Rewrite{
+					Rewrite: &base.Rewrite{
+						RewriteOperation: base.Rewrite_OPERATION_INTERSECTION,
+						Children: []*base.Child{
+							{
+								Type: &base.Child_Leaf{
+									Leaf: &base.Leaf{
+										Type: &base.Leaf_ComputedUserSet{
+											ComputedUserSet: &base.ComputedUserSet{
+												Relation: "owner",
+											},
+										},
+									},
+								},
+							},
+							{
+								Type: &base.Child_Leaf{
+									Leaf: &base.Leaf{
+										Type: &base.Leaf_Condition{
+											Condition: &base.Condition{
+												Expression: "context.hour < 18 && subject.type == 'user'",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}))
+			
+			for _, source := range []string{
+				// invalid expression
+				"entity doc {\n relation owner @user\n action view = owner when \"context.hour <\"\n}",
+				// undeclared variable
+				"entity doc {\n relation owner @user\n action view = owner when \"payload.hour < 18\"\n}",
+				// non boolean expression
+				"entity doc {\n relation owner @user\n action view = owner when \"entity.id\"\n}",
+			} {
+				sch, err = parser.NewParser("entity user {}\n" + source).Parse()
+				Expect(err).ShouldNot(HaveOccurred(), source)
+				
+				_, err = NewCompiler(false, sch).Compile()
+				Expect(err).Should(HaveOccurred(), source)
+			}
+		})
 	})
 })
@@ -1,26 +1,47 @@
 package lexer
 
 import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
 	"github.com/adminium/permify/pkg/dsl/token"
 )
 
-// Lexer -
+// ErrSizeLimit - The input is longer than the limit of the lexer
+var ErrSizeLimit = errors.New("schema exceeds the size limit")
+
+// Lexer - Reads the input one byte at a time, so only the token being lexed is kept in memory
 type Lexer struct {
-	input          string
-	position       int
-	readPosition   int
+	input          *bufio.Reader
 	linePosition   int
 	columnPosition int
 	ch             byte
+	// read - number of bytes read, limit - maximum number of bytes to read, zero for no limit
+	read  int64
+	limit int64
+	err   error
 }
 
 // NewLexer -
 func NewLexer(input string) (l *Lexer) {
-	l = &Lexer{input: input, linePosition: 1, columnPosition: 1}
+	return NewStreamLexer(strings.NewReader(input), 0)
+}
+
+// NewStreamLexer - Creates a lexer reading at most limit bytes of the reader, zero for no limit
+func NewStreamLexer(r io.Reader, limit int64) (l *Lexer) {
+	l = &Lexer{input: bufio.NewReader(r), limit: limit, linePosition: 1, columnPosition: 1}
 	l.readChar()
 	return
 }
 
+// Err - Error that stopped the lexer before the end of its input, if any
+func (l *Lexer) Err() error {
+	return l.err
+}
+
 // GetLinePosition -
 func (l *Lexer) GetLinePosition() int {
 	return l.linePosition
@@ -31,28 +52,52 @@ func (l *Lexer) GetColumnPosition() int {
 	return l.columnPosition
 }
 
-// readChar -
+// readChar - Reads the next byte, 0 at the end of the input
 func (l *Lexer) readChar() {
-	if l.readPosition >= len(l.input) {
+	l.columnPosition++
+	if l.err != nil {
 		l.ch = 0
-	} else {
-		l.ch = l.input[l.readPosition]
+		return
 	}
-	l.position = l.readPosition
-	l.readPosition++
-	l.columnPosition++
+	ch, err := l.input.ReadByte()
+	if err != nil {
+		if !errors.Is(err, io.EOF) {
+			l.err = err
+		}
+		l.ch = 0
+		return
+	}
+	l.read++
+	if l.limit > 0 && l.read > l.limit {
+		l.err = fmt.Errorf("%w of %d bytes", ErrSizeLimit, l.limit)
+		l.ch = 0
+		return
+	}
+	l.ch = ch
 }
 
 // peekChar -
 func (l *Lexer) peekChar() byte {
-	if l.readPosition >= len(l.input) {
+	if l.err != nil {
 		return 0
 	}
-	return l.input[l.readPosition]
+	b, err := l.input.Peek(1)
+	if err != nil {
+		return 0
+	}
+	return b[0]
 }
 
 // NextToken -
-func (l *Lexer) NextToken() (tok token.Token) {
+func (l *Lexer) NextToken() token.Token {
+	position := token.Position{Line: l.linePosition, Column: l.columnPosition - 1}
+	tok := l.nextToken()
+	tok.Position = position
+	return tok
+}
+
+// nextToken -
+func (l *Lexer) nextToken() (tok token.Token) {
 	switch l.ch {
 	case '\t':
 		tok = token.New(token.TAB, l.ch)
@@ -66,8 +111,48 @@ func (l *Lexer) NextToken() (tok token.Token) {
 		tok = token.New(token.NEWLINE, l.ch)
 	case ';':
 		tok = token.New(token.NEWLINE, l.ch)
+	case '\\':
+		// a backslash at the end of a line joins it with the next one
+		if !isNewline(l.peekChar()) {
+			tok = token.New(token.ILLEGAL, l.ch)
+			break
+		}
+		l.readChar()
+		if l.ch == '\r' && l.peekChar() == '\n' {
+			l.readChar()
+		}
+		l.newLine()
+		tok = token.New(token.LINE_CONTINUATION, '\\')
 	case '=':
+		if l.peekChar() == '=' {
+			l.readChar()
+			tok = token.Token{Type: token.EQ, Literal: "=="}
+			break
+		}
 		tok = token.New(token.ASSIGN, l.ch)
+	case '!':
+		if l.peekChar() != '=' {
+			tok = token.New(token.ILLEGAL, l.ch)
+			break
+		}
+		l.readChar()
+		tok = token.Token{Type: token.NEQ, Literal: "!="}
+	case '<':
+		if l.peekChar() == '=' {
+			l.readChar()
+			tok = token.Token{Type: token.LTE, Literal: "<="}
+			break
+		}
+		tok = token.New(token.LT, l.ch)
+	case '>':
+		if l.peekChar() == '=' {
+			l.readChar()
+			tok = token.Token{Type: token.GTE, Literal: ">="}
+			break
+		}
+		tok = token.New(token.GT, l.ch)
+	case '"':
+		return l.lexString()
 	case '@':
 		tok = token.New(token.SIGN, l.ch)
 	case '(':
@@ -84,6 +169,8 @@ func (l *Lexer) NextToken() (tok token.Token) {
 		tok = token.New(token.HASH, l.ch)
 	case '.':
 		tok = token.New(token.DOT, l.ch)
+	case '*':
+		tok = token.New(token.STAR, l.ch)
 	case 0:
 		tok = token.Token{Type: token.EOF, Literal: ""}
 	default:
@@ -92,6 +179,9 @@ func (l *Lexer) NextToken() (tok token.Token) {
 			tok.Type = token.LookupKeywords(tok.Literal)
 			return
 		}
+		if isDigit(l.ch) || l.ch == '-' && isDigit(l.peekChar()) {
+			return l.lexNumber()
+		}
 		if l.ch == '/' && l.peekChar() == '/' {
 			tok.Literal = l.lexSingleLineComment()
 			tok.Type = token.SINGLE_LINE_COMMENT
@@ -116,41 +206,90 @@ func (l *Lexer) newLine() {
 
 // lexIdent -
 func (l *Lexer) lexIdent() string {
-	position := l.position
+	var b strings.Builder
 	for isLetter(l.ch) {
+		b.WriteByte(l.ch)
 		l.readChar()
 	}
-	return l.input[position:l.position]
+	return b.String()
+}
+
+// lexString - Reads a double quoted string, the literal is the unquoted value. Backslashes escape the next
+// character, a string that is not closed on its line is illegal.
+func (l *Lexer) lexString() token.Token {
+	var b strings.Builder
+	l.readChar()
+	for l.ch != '"' {
+		if l.ch == 0 || isNewline(l.ch) {
+			return token.Token{Type: token.ILLEGAL, Literal: b.String()}
+		}
+		if l.ch == '\\' {
+			l.readChar()
+			if l.ch == 0 || isNewline(l.ch) {
+				return token.Token{Type: token.ILLEGAL, Literal: b.String()}
+			}
+		}
+		b.WriteByte(l.ch)
+		l.readChar()
+	}
+	l.readChar()
+	return token.Token{Type: token.STRING, Literal: b.String()}
+}
+
+// lexNumber - Reads an integer, or a double when it has a fraction
+func (l *Lexer) lexNumber() token.Token {
+	var b strings.Builder
+	typ := token.Type(token.INTEGER)
+	if l.ch == '-' {
+		b.WriteByte(l.ch)
+		l.readChar()
+	}
+	for isDigit(l.ch) {
+		b.WriteByte(l.ch)
+		l.readChar()
+	}
+	if l.ch == '.' && isDigit(l.peekChar()) {
+		typ = token.DOUBLE
+		b.WriteByte(l.ch)
+		l.readChar()
+		for isDigit(l.ch) {
+			b.WriteByte(l.ch)
+			l.readChar()
+		}
+	}
+	return token.Token{Type: typ, Literal: b.String()}
 }
 
 // lexSingleLineComment -
 func (l *Lexer) lexSingleLineComment() string {
 	l.readChar()
 	l.readChar()
-	position := l.position
-	for !isNewline(l.ch) {
-		if l.ch == 0 {
-			return l.input[position:l.position]
-		}
+	var b strings.Builder
+	for !isNewline(l.ch) && l.ch != 0 {
+		b.WriteByte(l.ch)
 		l.readChar()
 	}
-	return l.input[position:l.position]
+	return b.String()
 }
 
 // lexMultiLineComment -
 func (l *Lexer) lexMultiLineComment() string {
 	l.readChar()
 	l.readChar()
-	position := l.position
+	var b strings.Builder
 	for !(l.ch == '*' && l.peekChar() == '/') {
 		if l.ch == 0 {
-			return l.input[position:l.position]
+			return b.String()
 		}
+		if l.ch == '\n' {
+			l.newLine()
+		}
+		b.WriteByte(l.ch)
 		l.readChar()
 	}
 	l.readChar()
 	l.readChar()
-	return l.input[position : l.position-2]
+	return b.String()
 }
 
 // isNewline -
@@ -162,3 +301,8 @@ func isNewline(r byte) bool {
 func isLetter(ch byte) bool {
 	return 'a' <= ch && ch <= 'z' || 'A' <= ch && ch <= 'Z' || ch == '_'
 }
+
+// isDigit -
+func isDigit(ch byte) bool {
+	return '0' <= ch && ch <= '9'
+}
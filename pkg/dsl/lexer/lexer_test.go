@@ -550,5 +550,111 @@ entity organization {
 				Expect(index + lexeme.Literal).Should(Equal(index + tt.expectedLiteral))
 			}
 		})
+		
+		It("Case 7: Line continuation", func() {
+			str := "action read = owner \\\n\tor admin \\"
+			
+			tests := []struct {
+				expectedType    token.Type
+				expectedLiteral string
+			}{
+				{token.ACTION, "action"},
+				{token.SPACE, " "},
+				{token.IDENT, "read"},
+				{token.SPACE, " "},
+				{token.ASSIGN, "="},
+				{token.SPACE, " "},
+				{token.IDENT, "owner"},
+				{token.SPACE, " "},
+				{token.LINE_CONTINUATION, "\\"},
+				{token.TAB, "\t"},
+				{token.OR, "or"},
+				{token.SPACE, " "},
+				{token.IDENT, "admin"},
+				{token.SPACE, " "},
+				{token.ILLEGAL, "\\"},
+				{token.EOF, ""},
+			}
+			
+			l := NewLexer(str)
+			
+			for i, tt := range tests {
+				lexeme := l.NextToken()
+				index := strconv.Itoa(i) + ": "
+				Expect(index + lexeme.Type.String()).Should(Equal(index + tt.expectedType.String()))
+				Expect(index + lexeme.Literal).Should(Equal(index + tt.expectedLiteral))
+			}
+			Expect(l.GetLinePosition()).Should(Equal(2))
+		})
+		
+		It("Case 8: Attributes and rules", func() {
+			str := "attribute level integer\nrule r(a string, b double) { a != \"x\\\"y\" and b >= -1.5 or b < 3 }"
+			
+			tests := []struct {
+				expectedType    token.Type
+				expectedLiteral string
+			}{
+				{token.ATTRIBUTE, "attribute"},
+				{token.SPACE, " "},
+				{token.IDENT, "level"},
+				{token.SPACE, " "},
+				{token.IDENT, "integer"},
+				{token.NEWLINE, "\n"},
+				{token.RULE, "rule"},
+				{token.SPACE, " "},
+				{token.IDENT, "r"},
+				{token.LPAREN, "("},
+				{token.IDENT, "a"},
+				{token.SPACE, " "},
+				{token.IDENT, "string"},
+				{token.COMMA, ","},
+				{token.SPACE, " "},
+				{token.IDENT, "b"},
+				{token.SPACE, " "},
+				{token.IDENT, "double"},
+				{token.RPAREN, ")"},
+				{token.SPACE, " "},
+				{token.LBRACE, "{"},
+				{token.SPACE, " "},
+				{token.IDENT, "a"},
+				{token.SPACE, " "},
+				{token.NEQ, "!="},
+				{token.SPACE, " "},
+				{token.STRING, "x\"y"},
+				{token.SPACE, " "},
+				{token.AND, "and"},
+				{token.SPACE, " "},
+				{token.IDENT, "b"},
+				{token.SPACE, " "},
+				{token.GTE, ">="},
+				{token.SPACE, " "},
+				{token.DOUBLE, "-1.5"},
+				{token.SPACE, " "},
+				{token.OR, "or"},
+				{token.SPACE, " "},
+				{token.IDENT, "b"},
+				{token.SPACE, " "},
+				{token.LT, "<"},
+				{token.SPACE, " "},
+				{token.INTEGER, "3"},
+				{token.SPACE, " "},
+				{token.RBRACE, "}"},
+				{token.EOF, ""},
+			}
+			
+			l := NewLexer(str)
+			
+			for i, tt := range tests {
+				lexeme := l.NextToken()
+				index := strconv.Itoa(i) + ": "
+				Expect(index + lexeme.Type.String()).Should(Equal(index + tt.expectedType.String()))
+				Expect(index + lexeme.Literal).Should(Equal(index + tt.expectedLiteral))
+			}
+		})
+		
+		It("Case 9: Unterminated string", func() {
+			l := NewLexer("\"public\n")
+			Expect(l.NextToken().Type.String()).Should(Equal(token.ILLEGAL))
+		})
 	})
 })
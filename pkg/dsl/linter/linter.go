@@ -0,0 +1,361 @@
+// Package linter - Warnings about schemas that parse but are likely to be wrong: relations no action reads, actions
+// that can never be allowed, actions defined by themselves and names hiding other names.
+package linter
+
+import (
+	"sort"
+	"strings"
+	
+	"github.com/adminium/permify/pkg/dsl/ast"
+	"github.com/adminium/permify/pkg/dsl/token"
+	"github.com/adminium/permify/pkg/dsl/utils"
+)
+
+// Kind - What a warning is about
+type Kind string
+
+const (
+	// UnusedRelation - no action reads the relation, neither directly, through a chain nor through the subject
+	// relations of other relations, e.g. a relation left over from a removed action
+	UnusedRelation Kind = "unused_relation"
+	// UnreachableAction - every relation, action and rule the action refers to is missing or can never be allowed
+	UnreachableAction Kind = "unreachable_action"
+	// CyclicDefinition - the action refers to itself, directly or through other actions of its entity
+	CyclicDefinition Kind = "cyclic_definition"
+	// ShadowedName - the name is also the name of a rule or a caveat, e.g. relation is_public and rule is_public
+	ShadowedName Kind = "shadowed_name"
+)
+
+// Warning -
+type Warning struct {
+	Kind Kind
+	// EntityType - entity of the relation, action or attribute, empty for rules and caveats
+	EntityType string
+	Name       string
+	Message    string
+	// Position - position of the statement in the source, invalid for statements that were not parsed
+	Position token.Position
+}
+
+// String -
+func (w Warning) String() string {
+	if w.Position.IsValid() {
+		return w.Position.String() + ": " + w.Message
+	}
+	return w.Message
+}
+
+// linter -
+type linter struct {
+	schema   *ast.Schema
+	entities []*ast.EntityStatement
+	rules    map[string]*ast.RuleStatement
+	caveats  map[string]*ast.CaveatStatement
+	warnings []Warning
+}
+
+// LintSchema - Returns the warnings of the parsed schema in the order of their positions. The references are resolved
+// like the compiler resolves them, so a schema parsed without reference validation can be linted as well.
+// Chains walking the same relation again, e.g. parent.view in the view of a folder, recurse through the tuples and
+// are not reported as cycles.
+func LintSchema(sch *ast.Schema) []Warning {
+	l := &linter{
+		schema:  sch,
+		rules:   map[string]*ast.RuleStatement{},
+		caveats: map[string]*ast.CaveatStatement{},
+	}
+	for _, st := range sch.Statements {
+		switch s := st.(type) {
+		case *ast.EntityStatement:
+			l.entities = append(l.entities, s)
+		case *ast.RuleStatement:
+			l.rules[s.Name.Literal] = s
+		case *ast.CaveatStatement:
+			l.caveats[s.Name.Literal] = s
+		}
+	}
+	
+	l.lintShadowedNames()
+	l.lintUnusedRelations()
+	l.lintUnreachableActions()
+	l.lintCyclicDefinitions()
+	
+	sort.SliceStable(l.warnings, func(i, j int) bool {
+		pi, pj := l.warnings[i].Position, l.warnings[j].Position
+		if pi.Line != pj.Line {
+			return pi.Line < pj.Line
+		}
+		return pi.Column < pj.Column
+	})
+	return l.warnings
+}
+
+// warn -
+func (l *linter) warn(kind Kind, entityType, name, message string, node ast.Node) {
+	l.warnings = append(l.warnings, Warning{
+		Kind:       kind,
+		EntityType: entityType,
+		Name:       name,
+		Message:    message,
+		Position:   ast.Position(node),
+	})
+}
+
+// lintShadowedNames - Rules and caveats share one namespace with each other and with the relations, actions and
+// attributes of every entity, the parser only rejects the names declared twice in the same namespace
+func (l *linter) lintShadowedNames() {
+	for _, st := range l.schema.Statements {
+		if cs, ok := st.(*ast.CaveatStatement); ok {
+			if _, exist := l.rules[cs.Name.Literal]; exist {
+				l.warn(ShadowedName, "", cs.Name.Literal, "caveat "+cs.Name.Literal+" has the name of rule "+cs.Name.Literal, cs)
+			}
+		}
+	}
+	
+	shadows := func(entityType, what string, name token.Token, node ast.Node) {
+		if _, ok := l.rules[name.Literal]; ok {
+			l.warn(ShadowedName, entityType, name.Literal, what+" "+utils.Key(entityType, name.Literal)+" has the name of rule "+name.Literal, node)
+		}
+		if _, ok := l.caveats[name.Literal]; ok {
+			l.warn(ShadowedName, entityType, name.Literal, what+" "+utils.Key(entityType, name.Literal)+" has the name of caveat "+name.Literal, node)
+		}
+	}
+	for _, es := range l.entities {
+		for _, st := range es.AttributeStatements {
+			as := st.(*ast.AttributeStatement)
+			shadows(es.Name.Literal, "attribute", as.Name, as)
+		}
+		for _, st := range es.RelationStatements {
+			rs := st.(*ast.RelationStatement)
+			shadows(es.Name.Literal, "relation", rs.Name, rs)
+		}
+		for _, st := range es.ActionStatements {
+			as := st.(*ast.ActionStatement)
+			shadows(es.Name.Literal, "action", as.Name, as)
+		}
+	}
+}
+
+// lintUnusedRelations - The relations read by the actions are used, and so are the subject relations of the used
+// relations, e.g. member of @group#member. Deprecated relations are expected to be unused.
+func (l *linter) lintUnusedRelations() {
+	used := map[string]struct{}{}
+	var queue []string
+	use := func(key string) {
+		if _, ok := used[key]; ok {
+			return
+		}
+		used[key] = struct{}{}
+		queue = append(queue, key)
+	}
+	
+	for _, es := range l.entities {
+		for _, st := range es.ActionStatements {
+			for _, leaf := range leaves(st.(*ast.ActionStatement)) {
+				if ident, ok := leaf.(*ast.Identifier); ok {
+					keys, _ := l.resolve(es.Name.Literal, ident)
+					for _, key := range keys {
+						use(key)
+					}
+				}
+			}
+		}
+	}
+	
+	for len(queue) > 0 {
+		key := queue[0]
+		queue = queue[1:]
+		types, ok := l.schema.GetRelationReferenceIfExist(key)
+		if !ok {
+			continue
+		}
+		for _, typ := range types {
+			if !ast.IsDirectEntityReference(typ) {
+				use(utils.Key(typ.Type.Literal, typ.Relation.Literal))
+			}
+		}
+	}
+	
+	for _, es := range l.entities {
+		for _, st := range es.RelationStatements {
+			rs := st.(*ast.RelationStatement)
+			key := utils.Key(es.Name.Literal, rs.Name.Literal)
+			if _, ok := used[key]; ok || rs.IsDeprecated() {
+				continue
+			}
+			l.warn(UnusedRelation, es.Name.Literal, rs.Name.Literal, "relation "+key+" is not referenced by any action", rs)
+		}
+	}
+}
+
+// lintUnreachableActions - An action can be allowed when one of its leaves refers to an existing relation or rule, or
+// to an action that can be allowed. Actions that only refer to each other can never be allowed either.
+func (l *linter) lintUnreachableActions() {
+	reachable := map[string]struct{}{}
+	for changed := true; changed; {
+		changed = false
+		for _, es := range l.entities {
+			for _, st := range es.ActionStatements {
+				as := st.(*ast.ActionStatement)
+				key := utils.Key(es.Name.Literal, as.Name.Literal)
+				if _, ok := reachable[key]; ok {
+					continue
+				}
+				// the opa policy of the action may allow it whatever its expression is
+				if as.Policy != nil {
+					reachable[key] = struct{}{}
+					changed = true
+					continue
+				}
+				for _, leaf := range leaves(as) {
+					if l.isReachable(es.Name.Literal, leaf, reachable) {
+						reachable[key] = struct{}{}
+						changed = true
+						break
+					}
+				}
+			}
+		}
+	}
+	
+	for _, es := range l.entities {
+		for _, st := range es.ActionStatements {
+			as := st.(*ast.ActionStatement)
+			key := utils.Key(es.Name.Literal, as.Name.Literal)
+			if _, ok := reachable[key]; ok {
+				continue
+			}
+			l.warn(UnreachableAction, es.Name.Literal, as.Name.Literal, "action "+key+" can never be allowed, every path it refers to is missing or unreachable", as)
+		}
+	}
+}
+
+// isReachable -
+func (l *linter) isReachable(entityType string, leaf ast.Expression, reachable map[string]struct{}) bool {
+	switch e := leaf.(type) {
+	case *ast.Call:
+		_, ok := l.schema.GetRuleArgumentTypesIfExist(e.Name.Literal)
+		return ok
+	case *ast.Identifier:
+		keys, ok := l.resolve(entityType, e)
+		if !ok {
+			return false
+		}
+		key := keys[len(keys)-1]
+		if typ, _ := l.schema.GetRelationalReferenceTypeIfExist(key); typ == ast.ACTION {
+			_, ok = reachable[key]
+		}
+		return ok
+	default:
+		return true
+	}
+}
+
+// lintCyclicDefinitions - The actions of an entity referring to each other without walking a relation would be
+// evaluated forever, every group of them is reported once on its first action
+func (l *linter) lintCyclicDefinitions() {
+	for _, es := range l.entities {
+		var actions []*ast.ActionStatement
+		refers := map[string]map[string]struct{}{}
+		for _, st := range es.ActionStatements {
+			as := st.(*ast.ActionStatement)
+			actions = append(actions, as)
+			refers[as.Name.Literal] = map[string]struct{}{}
+			for _, leaf := range leaves(as) {
+				ident, ok := leaf.(*ast.Identifier)
+				if !ok || len(ident.Idents) != 1 {
+					continue
+				}
+				name := ident.Idents[0].Literal
+				if typ, _ := l.schema.GetRelationalReferenceTypeIfExist(utils.Key(es.Name.Literal, name)); typ == ast.ACTION {
+					refers[as.Name.Literal][name] = struct{}{}
+				}
+			}
+		}
+		
+		reach := map[string]map[string]struct{}{}
+		for _, as := range actions {
+			reach[as.Name.Literal] = reachableFrom(as.Name.Literal, refers)
+		}
+		
+		reported := map[string]struct{}{}
+		for _, as := range actions {
+			name := as.Name.Literal
+			if _, ok := reach[name][name]; !ok {
+				continue
+			}
+			if _, ok := reported[name]; ok {
+				continue
+			}
+			
+			var cycle []string
+			for _, other := range actions {
+				_, forward := reach[name][other.Name.Literal]
+				_, backward := reach[other.Name.Literal][name]
+				if forward && backward {
+					cycle = append(cycle, utils.Key(es.Name.Literal, other.Name.Literal))
+					reported[other.Name.Literal] = struct{}{}
+				}
+			}
+			
+			message := "action " + cycle[0] + " refers to itself"
+			if len(cycle) > 1 {
+				message = "actions " + strings.Join(cycle, ", ") + " refer to each other"
+			}
+			l.warn(CyclicDefinition, es.Name.Literal, name, message, as)
+		}
+	}
+}
+
+// resolve - Keys of the relations the identifier walks and of the relation or action it ends with, e.g. doc#parent
+// and folder#view for parent.view. The keys are resolved until the first missing one, ok is false when there is one.
+func (l *linter) resolve(entityType string, ident *ast.Identifier) (keys []string, ok bool) {
+	typ := entityType
+	for _, relation := range ident.Idents[:len(ident.Idents)-1] {
+		key := utils.Key(typ, relation.Literal)
+		types, exist := l.schema.GetRelationReferenceIfExist(key)
+		if !exist {
+			return keys, false
+		}
+		keys = append(keys, key)
+		typ = utils.GetBaseEntityRelationTypeStatement(types).Type.Literal
+	}
+	key := utils.Key(typ, ident.Idents[len(ident.Idents)-1].Literal)
+	if !l.schema.IsRelationalReferenceExist(key) {
+		return keys, false
+	}
+	return append(keys, key), true
+}
+
+// leaves - Identifiers and calls of the expression of the action
+func leaves(as *ast.ActionStatement) []ast.Expression {
+	var result []ast.Expression
+	ast.Inspect(as, func(n ast.Node) bool {
+		switch e := n.(type) {
+		case *ast.Identifier:
+			result = append(result, e)
+		case *ast.Call:
+			result = append(result, e)
+		}
+		return true
+	})
+	return result
+}
+
+// reachableFrom - Actions reached from the action by following the references one or more times
+func reachableFrom(name string, refers map[string]map[string]struct{}) map[string]struct{} {
+	reached := map[string]struct{}{}
+	queue := []string{name}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for next := range refers[current] {
+			if _, ok := reached[next]; ok {
+				continue
+			}
+			reached[next] = struct{}{}
+			queue = append(queue, next)
+		}
+	}
+	return reached
+}
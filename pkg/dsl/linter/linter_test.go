@@ -0,0 +1,156 @@
+package linter
+
+import (
+	"testing"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	
+	"github.com/adminium/permify/pkg/dsl/parser"
+)
+
+// TestLinter -
+func TestLinter(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "linter-suite")
+}
+
+// lint -
+func lint(source string) []string {
+	sch, err := parser.NewParser(source).Parse()
+	Expect(err).ShouldNot(HaveOccurred())
+	var warnings []string
+	for _, w := range LintSchema(sch) {
+		warnings = append(warnings, string(w.Kind)+" "+w.String())
+	}
+	return warnings
+}
+
+var _ = Describe("linter", func() {
+	Context("LintSchema", func() {
+		It("Case 1: Clean schema", func() {
+			Expect(lint(`
+			entity user {}
+			
+			entity group {
+				relation member @user @group#member
+			}
+			
+			entity folder {
+				relation parent @folder
+				relation viewer @user @group#member
+				
+				action view = viewer or parent.view
+			}
+			
+			entity doc {
+				attribute visibility string
+				relation folder @folder
+				relation owner @user
+				
+				action edit = owner
+				action view = edit or folder.view or is_public(visibility)
+			}
+			
+			rule is_public(visibility string) {
+				visibility == "public"
+			}`)).Should(BeEmpty())
+		})
+		
+		It("Case 2: Unused relations", func() {
+			Expect(lint(`
+			entity user {}
+			
+			entity group {
+				relation member @user
+				relation manager @user
+			}
+			
+			entity doc {
+				relation team @group#member
+				relation reviewer @group#manager
+				relation owner @user
+				deprecated relation writer @user
+				
+				action view = owner or team
+			}`)).Should(Equal([]string{
+				"unused_relation 6:5: relation group#manager is not referenced by any action",
+				"unused_relation 11:5: relation doc#reviewer is not referenced by any action",
+			}))
+		})
+		
+		It("Case 3: Unreachable actions", func() {
+			Expect(lint(`
+			entity user {}
+			
+			entity doc {
+				relation parent @doc
+				relation owner @user
+				
+				action read = owner
+				action edit = editor or parent.admin
+				action delete = edit or not published(status)
+				action share = edit or read
+				opa "authz/doc/archive" override action archive = archiver
+			}`)).Should(Equal([]string{
+				"unreachable_action 9:5: action doc#edit can never be allowed, every path it refers to is missing or unreachable",
+				"unreachable_action 10:5: action doc#delete can never be allowed, every path it refers to is missing or unreachable",
+			}))
+		})
+		
+		It("Case 4: Cyclic definitions", func() {
+			Expect(lint(`
+			entity user {}
+			
+			entity doc {
+				relation parent @doc
+				relation owner @user
+				
+				action read = owner or read
+				action edit = owner or share
+				action view = read or parent.view
+				action share = owner or edit
+			}`)).Should(Equal([]string{
+				"cyclic_definition 8:5: action doc#read refers to itself",
+				"cyclic_definition 9:5: actions doc#edit, doc#share refer to each other",
+			}))
+		})
+		
+		It("Case 5: Actions referring only to each other", func() {
+			Expect(lint(`
+			entity doc {
+				action edit = share
+				action share = edit
+			}`)).Should(Equal([]string{
+				"unreachable_action 3:5: action doc#edit can never be allowed, every path it refers to is missing or unreachable",
+				"cyclic_definition 3:5: actions doc#edit, doc#share refer to each other",
+				"unreachable_action 4:5: action doc#share can never be allowed, every path it refers to is missing or unreachable",
+			}))
+		})
+		
+		It("Case 6: Shadowed names", func() {
+			Expect(lint(`
+			entity user {}
+			
+			entity doc {
+				attribute ip_allowed string
+				relation is_public @user
+				relation viewer @user
+				
+				action view = is_public or viewer with ip_allowed
+			}
+			
+			rule is_public(visibility string) {
+				visibility == "public"
+			}
+			
+			caveat ip_allowed "context.ip == payload.ip"
+			caveat is_public "true"`)).Should(Equal([]string{
+				"shadowed_name 5:5: attribute doc#ip_allowed has the name of caveat ip_allowed",
+				"shadowed_name 6:5: relation doc#is_public has the name of rule is_public",
+				"shadowed_name 6:5: relation doc#is_public has the name of caveat is_public",
+				"shadowed_name 17:4: caveat is_public has the name of rule is_public",
+			}))
+		})
+	})
+})
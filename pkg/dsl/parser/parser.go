@@ -3,6 +3,8 @@ package parser
 import (
 	"errors"
 	"fmt"
+	"io"
+	"strings"
 	
 	"github.com/adminium/permify/pkg/dsl/ast"
 	"github.com/adminium/permify/pkg/dsl/lexer"
@@ -16,12 +18,19 @@ const (
 	
 	LOWEST
 	LOGIC
+	COMPARE
 	PREFIX // not IDENT
 )
 
 var precedences = map[token.Type]int{
 	token.AND: LOGIC,
 	token.OR:  LOGIC,
+	token.EQ:  COMPARE,
+	token.NEQ: COMPARE,
+	token.LT:  COMPARE,
+	token.LTE: COMPARE,
+	token.GT:  COMPARE,
+	token.GTE: COMPARE,
 }
 
 // Parser -
@@ -33,10 +42,27 @@ type Parser struct {
 	prefixParseFns map[token.Type]prefixParseFn
 	infixParseFunc map[token.Type]infixParseFn
 	
+	// depth - number of open parentheses, line breaks inside parentheses do not end the expression
+	depth int
+	
+	// doc comments - the single line comments on the lines right above a token, a blank line or a token between
+	// them breaks them apart. The statements take the ones of their first token as their doc.
+	comments   []string
+	peekDoc    []string
+	currentDoc []string
+	// lineStart - nothing but comments and blanks were read since the last line break
+	lineStart bool
+	// commented - a comment was read since the last line break
+	commented bool
+	
 	// entity references
 	// sample keys: entity_type
 	entityReferences map[string]struct{}
 	
+	// previous names of the entities, the values are the entity types
+	// sample keys: doc
+	entityAliases map[string]string
+	
 	// relation references
 	// sample keys: entity_type#member
 	relationReferences map[string][]ast.RelationTypeStatement
@@ -48,6 +74,18 @@ type Parser struct {
 	// its contains all references
 	// sample keys: entity_type#member, entity_type#read
 	relationalReferences map[string]ast.RelationalReferenceType
+	
+	// attribute references, the values are the types of the attributes
+	// sample keys: entity_type#visibility
+	attributeReferences map[string]string
+	
+	// rule references, the values are the types of the arguments of the rules
+	// sample keys: is_public
+	ruleReferences map[string][]string
+	
+	// caveat references
+	// sample keys: ip_allowed
+	caveatReferences map[string]struct{}
 }
 
 type (
@@ -57,22 +95,47 @@ type (
 
 // NewParser -
 func NewParser(str string) (p *Parser) {
+	return newParser(lexer.NewLexer(str))
+}
+
+// NewStreamParser - Creates a parser reading at most limit bytes of the reader, zero for no limit.
+// Entity statements can be consumed one by one with Next, so large schemas are never held in memory as a whole.
+func NewStreamParser(r io.Reader, limit int64) (p *Parser) {
+	return newParser(lexer.NewStreamLexer(r, limit))
+}
+
+// newParser -
+func newParser(l *lexer.Lexer) (p *Parser) {
 	p = &Parser{
-		l:                    lexer.NewLexer(str),
+		l:                    l,
+		lineStart:            true,
 		errors:               []string{},
 		entityReferences:     map[string]struct{}{},
+		entityAliases:        map[string]string{},
 		relationReferences:   map[string][]ast.RelationTypeStatement{},
 		actionReferences:     map[string]struct{}{},
 		relationalReferences: map[string]ast.RelationalReferenceType{},
+		attributeReferences:  map[string]string{},
+		ruleReferences:       map[string][]string{},
+		caveatReferences:     map[string]struct{}{},
 	}
 	
 	p.prefixParseFns = make(map[token.Type]prefixParseFn)
 	p.registerPrefix(token.IDENT, p.parseIdentifier)
 	p.registerPrefix(token.NOT, p.parsePrefixExpression)
+	p.registerPrefix(token.LPAREN, p.parseGroupedExpression)
+	p.registerPrefix(token.STRING, p.parseLiteral)
+	p.registerPrefix(token.INTEGER, p.parseLiteral)
+	p.registerPrefix(token.DOUBLE, p.parseLiteral)
+	p.registerPrefix(token.TRUE, p.parseLiteral)
+	p.registerPrefix(token.FALSE, p.parseLiteral)
 	
 	p.infixParseFunc = make(map[token.Type]infixParseFn)
 	p.registerInfix(token.AND, p.parseInfixExpression)
 	p.registerInfix(token.OR, p.parseInfixExpression)
+	for _, t := range []token.Type{token.EQ, token.NEQ, token.LT, token.LTE, token.GT, token.GTE} {
+		p.registerInfix(t, p.parseInfixExpression)
+	}
 	
 	return
 }
@@ -82,7 +145,8 @@ func (p *Parser) setEntityReference(key string) error {
 	if p.entityReferences == nil {
 		p.entityReferences = map[string]struct{}{}
 	}
-	if _, ok := p.entityReferences[key]; ok {
+	_, ok := p.entityReferences[key]
+	if _, aliased := p.entityAliases[key]; ok || aliased {
 		p.errors = append(p.errors, base.ErrorCode_ERROR_CODE_DUPLICATED_ENTITY_REFERENCE.String())
 		return errors.New(base.ErrorCode_ERROR_CODE_DUPLICATED_ENTITY_REFERENCE.String())
 	}
@@ -90,6 +154,20 @@ func (p *Parser) setEntityReference(key string) error {
 	return nil
 }
 
+// setEntityAlias - Aliases are not entity references, the schema must refer to the entity by its name
+func (p *Parser) setEntityAlias(alias, entityType string) error {
+	if p.entityAliases == nil {
+		p.entityAliases = map[string]string{}
+	}
+	_, ok := p.entityReferences[alias]
+	if _, aliased := p.entityAliases[alias]; ok || aliased {
+		p.errors = append(p.errors, base.ErrorCode_ERROR_CODE_DUPLICATED_ENTITY_REFERENCE.String())
+		return errors.New(base.ErrorCode_ERROR_CODE_DUPLICATED_ENTITY_REFERENCE.String())
+	}
+	p.entityAliases[alias] = entityType
+	return nil
+}
+
 // setRelationReference -
 func (p *Parser) setRelationReference(key string, types []ast.RelationTypeStatement) error {
 	if p.relationReferences == nil {
@@ -99,6 +177,10 @@ func (p *Parser) setRelationReference(key string, types []ast.RelationTypeStatem
 		p.errors = append(p.errors, base.ErrorCode_ERROR_CODE_DUPLICATED_RELATION_REFERENCE.String())
 		return errors.New(base.ErrorCode_ERROR_CODE_DUPLICATED_RELATION_REFERENCE.String())
 	}
+	if _, ok := p.attributeReferences[key]; ok {
+		p.errors = append(p.errors, base.ErrorCode_ERROR_CODE_DUPLICATED_RELATION_REFERENCE.String())
+		return errors.New(base.ErrorCode_ERROR_CODE_DUPLICATED_RELATION_REFERENCE.String())
+	}
 	if _, ok := p.relationalReferences[key]; ok {
 		p.errors = append(p.errors, base.ErrorCode_ERROR_CODE_DUPLICATED_RELATION_REFERENCE.String())
 		return errors.New(base.ErrorCode_ERROR_CODE_DUPLICATED_RELATION_REFERENCE.String())
@@ -117,6 +199,10 @@ func (p *Parser) setActionReference(key string) error {
 		p.errors = append(p.errors, base.ErrorCode_ERROR_CODE_DUPLICATED_ACTION_REFERENCE.String())
 		return errors.New(base.ErrorCode_ERROR_CODE_DUPLICATED_ACTION_REFERENCE.String())
 	}
+	if _, ok := p.attributeReferences[key]; ok {
+		p.errors = append(p.errors, base.ErrorCode_ERROR_CODE_DUPLICATED_RELATION_REFERENCE.String())
+		return errors.New(base.ErrorCode_ERROR_CODE_DUPLICATED_RELATION_REFERENCE.String())
+	}
 	if _, ok := p.relationalReferences[key]; ok {
 		p.errors = append(p.errors, base.ErrorCode_ERROR_CODE_DUPLICATED_RELATION_REFERENCE.String())
 		return errors.New(base.ErrorCode_ERROR_CODE_DUPLICATED_RELATION_REFERENCE.String())
@@ -126,18 +212,85 @@ func (p *Parser) setActionReference(key string) error {
 	return nil
 }
 
+// setAttributeReference - attributes share the names of the relations and actions of their entity
+func (p *Parser) setAttributeReference(key, typ string) error {
+	_, attribute := p.attributeReferences[key]
+	_, relational := p.relationalReferences[key]
+	if attribute || relational {
+		p.errors = append(p.errors, base.ErrorCode_ERROR_CODE_DUPLICATED_RELATION_REFERENCE.String())
+		return errors.New(base.ErrorCode_ERROR_CODE_DUPLICATED_RELATION_REFERENCE.String())
+	}
+	p.attributeReferences[key] = typ
+	return nil
+}
+
+// setRuleReference -
+func (p *Parser) setRuleReference(key string, types []string) error {
+	if _, ok := p.ruleReferences[key]; ok {
+		p.errors = append(p.errors, base.ErrorCode_ERROR_CODE_DUPLICATED_RELATION_REFERENCE.String())
+		return errors.New(base.ErrorCode_ERROR_CODE_DUPLICATED_RELATION_REFERENCE.String())
+	}
+	p.ruleReferences[key] = types
+	return nil
+}
+
+// setCaveatReference -
+func (p *Parser) setCaveatReference(key string) error {
+	if _, ok := p.caveatReferences[key]; ok {
+		p.errors = append(p.errors, base.ErrorCode_ERROR_CODE_DUPLICATED_RELATION_REFERENCE.String())
+		return errors.New(base.ErrorCode_ERROR_CODE_DUPLICATED_RELATION_REFERENCE.String())
+	}
+	p.caveatReferences[key] = struct{}{}
+	return nil
+}
+
 // next -
 func (p *Parser) next() {
 	for {
 		peek := p.l.NextToken()
+		p.collectDoc(peek)
+		if p.depth > 0 && peek.Type == token.NEWLINE {
+			continue
+		}
 		if !token.IsIgnores(peek.Type) {
-			p.currentToken = p.peekToken
-			p.peekToken = peek
+			var doc []string
+			if peek.Type != token.NEWLINE {
+				doc, p.comments = p.comments, nil
+			}
+			p.currentToken, p.currentDoc = p.peekToken, p.peekDoc
+			p.peekToken, p.peekDoc = peek, doc
 			break
 		}
 	}
 }
 
+// collectDoc - Keeps the comments that start their lines until the next token, a blank line drops them
+func (p *Parser) collectDoc(tok token.Token) {
+	switch tok.Type {
+	case token.SINGLE_LINE_COMMENT:
+		if p.lineStart {
+			p.comments = append(p.comments, strings.TrimSpace(tok.Literal))
+			p.commented = true
+		}
+	case token.NEWLINE:
+		if p.lineStart && !p.commented {
+			p.comments = nil
+		}
+		p.lineStart, p.commented = true, false
+	default:
+		if !token.IsIgnores(tok.Type) {
+			p.lineStart = false
+		}
+	}
+}
+
+// skipNewlines - Moves past the line breaks at the current token
+func (p *Parser) skipNewlines() {
+	for p.currentTokenIs(token.NEWLINE) {
+		p.next()
+	}
+}
+
 // currentTokenIs -
 func (p *Parser) currentTokenIs(tokens ...token.Type) bool {
 	for _, t := range tokens {
@@ -168,26 +321,59 @@ func (p *Parser) Error() error {
 
 // Parse -
 func (p *Parser) Parse() (*ast.Schema, error) {
-	schema := &ast.Schema{}
-	schema.Statements = []ast.Statement{}
+	statements := []ast.Statement{}
+	
+	for {
+		stmt, err := p.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		statements = append(statements, stmt)
+	}
 	
+	schema := p.Schema()
+	schema.Statements = statements
+	return schema, nil
+}
+
+// Next - Parses the next statement, io.EOF at the end of the input
+func (p *Parser) Next() (ast.Statement, error) {
 	for !p.currentTokenIs(token.EOF) {
 		stmt, err := p.parseStatement()
+		if lerr := p.l.Err(); lerr != nil {
+			return nil, lerr
+		}
 		if err != nil {
 			return nil, p.Error()
 		}
+		p.next()
 		if stmt != nil {
-			schema.Statements = append(schema.Statements, stmt)
+			return stmt, nil
 		}
-		p.next()
 	}
+	if err := p.l.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+// Schema - Schema without statements, carrying the references of the statements parsed so far
+func (p *Parser) Schema() *ast.Schema {
+	schema := &ast.Schema{}
+	schema.Statements = []ast.Statement{}
 	
 	schema.SetEntityReferences(p.entityReferences)
 	schema.SetRelationReferences(p.relationReferences)
 	schema.SetActionReferences(p.actionReferences)
 	
 	schema.SetRelationalReferences(p.relationalReferences)
-	return schema, nil
+	schema.SetAttributeReferences(p.attributeReferences)
+	schema.SetRuleReferences(p.ruleReferences)
+	schema.SetCaveatReferences(p.caveatReferences)
+	return schema
 }
 
 // parseStatement method based on defined token types
@@ -195,14 +381,50 @@ func (p *Parser) parseStatement() (ast.Statement, error) {
 	switch p.currentToken.Type {
 	case token.ENTITY:
 		return p.parseEntityStatement()
+	case token.RULE:
+		return p.parseRuleStatement()
+	case token.CAVEAT:
+		return p.parseCaveatStatement()
+	case token.ASSERT:
+		return p.parseAssertStatement()
 	default:
 		return nil, nil
 	}
 }
 
+// parseEntityAliases - Parses the previous names of the entity, e.g. (alias doc, file). alias is not a keyword, so
+// relations and actions can still be named alias.
+func (p *Parser) parseEntityAliases(entityType string) (aliases []token.Token, err error) {
+	p.next()
+	if !p.expectAndNext(token.IDENT) {
+		return nil, p.Error()
+	}
+	if p.currentToken.Literal != "alias" {
+		p.currentError(token.IDENT)
+		return nil, p.Error()
+	}
+	for {
+		if !p.expectAndNext(token.IDENT) {
+			return nil, p.Error()
+		}
+		if err = p.setEntityAlias(p.currentToken.Literal, entityType); err != nil {
+			return nil, err
+		}
+		aliases = append(aliases, p.currentToken)
+		if !p.peekTokenIs(token.COMMA) {
+			break
+		}
+		p.next()
+	}
+	if !p.expectAndNext(token.RPAREN) {
+		return nil, p.Error()
+	}
+	return aliases, nil
+}
+
 // parseEntityStatement returns a LET Statement AST Node
 func (p *Parser) parseEntityStatement() (*ast.EntityStatement, error) {
-	stmt := &ast.EntityStatement{Entity: p.currentToken}
+	stmt := &ast.EntityStatement{Entity: p.currentToken, Doc: p.currentDoc}
 	if !p.expectAndNext(token.IDENT) {
 		return nil, p.Error()
 	}
@@ -214,6 +436,13 @@ func (p *Parser) parseEntityStatement() (*ast.EntityStatement, error) {
 		return nil, err
 	}
 	
+	if p.peekTokenIs(token.LPAREN) {
+		stmt.Aliases, err = p.parseEntityAliases(stmt.Name.Literal)
+		if err != nil {
+			return nil, err
+		}
+	}
+	
 	if !p.expectAndNext(token.LBRACE) {
 		return nil, p.Error()
 	}
@@ -224,6 +453,59 @@ func (p *Parser) parseEntityStatement() (*ast.EntityStatement, error) {
 			return nil, p.Error()
 		}
 		switch p.currentToken.Type {
+		case token.DEPRECATED:
+			deprecated, doc := p.currentToken, p.currentDoc
+			switch {
+			case p.peekTokenIs(token.RELATION):
+				p.next()
+				relation, err := p.parseRelationStatement(stmt.Name.Literal)
+				if err != nil {
+					return nil, p.Error()
+				}
+				relation.Deprecated = deprecated
+				relation.Doc = doc
+				stmt.RelationStatements = append(stmt.RelationStatements, relation)
+			case p.peekTokenIs(token.ACTION):
+				p.next()
+				action, err := p.parseActionStatement(stmt.Name.Literal)
+				if err != nil {
+					return nil, p.Error()
+				}
+				action.Deprecated = deprecated
+				action.Doc = doc
+				stmt.ActionStatements = append(stmt.ActionStatements, action)
+			default:
+				p.peekError(token.RELATION, token.ACTION)
+				return nil, p.Error()
+			}
+		case token.OPA:
+			doc := p.currentDoc
+			policy, err := p.parsePolicyAnnotation()
+			if err != nil {
+				return nil, p.Error()
+			}
+			var deprecated token.Token
+			if p.peekTokenIs(token.DEPRECATED) {
+				p.next()
+				deprecated = p.currentToken
+			}
+			if !p.expectAndNext(token.ACTION) {
+				return nil, p.Error()
+			}
+			action, err := p.parseActionStatement(stmt.Name.Literal)
+			if err != nil {
+				return nil, p.Error()
+			}
+			action.Policy = policy
+			action.Deprecated = deprecated
+			action.Doc = doc
+			stmt.ActionStatements = append(stmt.ActionStatements, action)
+		case token.ATTRIBUTE:
+			attribute, err := p.parseAttributeStatement(stmt.Name.Literal)
+			if err != nil {
+				return nil, p.Error()
+			}
+			stmt.AttributeStatements = append(stmt.AttributeStatements, attribute)
 		case token.RELATION:
 			relation, err := p.parseRelationStatement(stmt.Name.Literal)
 			if err != nil {
@@ -238,9 +520,190 @@ func (p *Parser) parseEntityStatement() (*ast.EntityStatement, error) {
 			stmt.ActionStatements = append(stmt.ActionStatements, action)
 		default:
 			if !p.currentTokenIs(token.NEWLINE) && !p.currentTokenIs(token.LBRACE) && !p.currentTokenIs(token.RBRACE) {
-				p.currentError(token.RELATION, token.ACTION)
+				p.currentError(token.ATTRIBUTE, token.RELATION, token.ACTION)
+				return nil, p.Error()
+			}
+		}
+		p.next()
+	}
+	
+	return stmt, nil
+}
+
+// parsePolicyAnnotation - Parses opa "path" [and|or|override], the annotated action follows on the same line
+func (p *Parser) parsePolicyAnnotation() (*ast.PolicyAnnotation, error) {
+	policy := &ast.PolicyAnnotation{Opa: p.currentToken}
+	if !p.expectAndNext(token.STRING) {
+		return nil, p.Error()
+	}
+	policy.Path = p.currentToken
+	if policy.Path.Literal == "" {
+		p.errors = append(p.errors, fmt.Sprintf("%v:%v:policy path is empty", p.l.GetLinePosition(), p.l.GetColumnPosition()))
+		return nil, p.Error()
+	}
+	
+	switch {
+	case p.peekTokenIs(token.AND, token.OR):
+		p.next()
+		policy.Mode = p.currentToken
+	case p.peekTokenIs(token.IDENT):
+		if p.peekToken.Literal != "override" {
+			p.errors = append(p.errors, fmt.Sprintf("%v:%v:expected policy mode to be and, or or override, got %s instead", p.l.GetLinePosition(), p.l.GetColumnPosition(), p.peekToken.Literal))
+			return nil, p.Error()
+		}
+		p.next()
+		policy.Mode = p.currentToken
+	}
+	return policy, nil
+}
+
+// parseAttributeStatement -
+func (p *Parser) parseAttributeStatement(entityName string) (*ast.AttributeStatement, error) {
+	stmt := &ast.AttributeStatement{Attribute: p.currentToken}
+	if !p.expectAndNext(token.IDENT) {
+		return nil, p.Error()
+	}
+	stmt.Name = p.currentToken
+	
+	if !p.expectAndNext(token.IDENT) {
+		return nil, p.Error()
+	}
+	stmt.AttributeType = p.currentToken
+	if !ast.IsAttributeType(stmt.AttributeType.Literal) {
+		p.attributeTypeError(stmt.AttributeType)
+		return nil, p.Error()
+	}
+	
+	err := p.setAttributeReference(utils.Key(entityName, stmt.Name.Literal), stmt.AttributeType.Literal)
+	if err != nil {
+		return nil, err
+	}
+	
+	return stmt, nil
+}
+
+// parseRuleStatement - Parses rule name(argument type, ...) { expression }, the expression may span lines
+func (p *Parser) parseRuleStatement() (*ast.RuleStatement, error) {
+	stmt := &ast.RuleStatement{Rule: p.currentToken}
+	if !p.expectAndNext(token.IDENT) {
+		return nil, p.Error()
+	}
+	stmt.Name = p.currentToken
+	
+	if !p.expectAndNext(token.LPAREN) {
+		return nil, p.Error()
+	}
+	
+	var types []string
+	for !p.peekTokenIs(token.RPAREN) {
+		if len(stmt.Arguments) > 0 && !p.expectAndNext(token.COMMA) {
+			return nil, p.Error()
+		}
+		if !p.expectAndNext(token.IDENT) {
+			return nil, p.Error()
+		}
+		argument := ast.RuleArgument{Name: p.currentToken}
+		if !p.expectAndNext(token.IDENT) {
+			return nil, p.Error()
+		}
+		argument.Type = p.currentToken
+		if !ast.IsAttributeType(argument.Type.Literal) {
+			p.attributeTypeError(argument.Type)
+			return nil, p.Error()
+		}
+		stmt.Arguments = append(stmt.Arguments, argument)
+		types = append(types, argument.Type.Literal)
+	}
+	p.next()
+	
+	if !p.expectAndNext(token.LBRACE) {
+		return nil, p.Error()
+	}
+	p.next()
+	p.skipNewlines()
+	
+	exp, err := p.parseExpression(LOWEST)
+	if err != nil {
+		return nil, p.Error()
+	}
+	stmt.Expression = exp
+	
+	p.next()
+	p.skipNewlines()
+	if !p.currentTokenIs(token.RBRACE) {
+		p.currentError(token.RBRACE)
+		return nil, p.Error()
+	}
+	
+	err = p.setRuleReference(stmt.Name.Literal, types)
+	if err != nil {
+		return nil, err
+	}
+	
+	return stmt, nil
+}
+
+// parseCaveatStatement - Parses caveat name "expression", the expression is CEL over context and payload
+func (p *Parser) parseCaveatStatement() (*ast.CaveatStatement, error) {
+	stmt := &ast.CaveatStatement{Caveat: p.currentToken}
+	if !p.expectAndNext(token.IDENT) {
+		return nil, p.Error()
+	}
+	stmt.Name = p.currentToken
+	
+	if !p.expectAndNext(token.STRING) {
+		return nil, p.Error()
+	}
+	stmt.Expression = p.currentToken
+	
+	err := p.setCaveatReference(stmt.Name.Literal)
+	if err != nil {
+		return nil, err
+	}
+	
+	return stmt, nil
+}
+
+// parseAssertStatement - Parses assert "name" { ... }, every line of the block is either tuple "tuple" or
+// check "query" true|false
+func (p *Parser) parseAssertStatement() (*ast.AssertStatement, error) {
+	stmt := &ast.AssertStatement{Assert: p.currentToken}
+	if !p.expectAndNext(token.STRING) {
+		return nil, p.Error()
+	}
+	stmt.Name = p.currentToken
+	
+	if !p.expectAndNext(token.LBRACE) {
+		return nil, p.Error()
+	}
+	
+	for !p.currentTokenIs(token.RBRACE) {
+		if p.currentTokenIs(token.EOF) {
+			p.currentError(token.RBRACE)
+			return nil, p.Error()
+		}
+		switch {
+		case p.currentTokenIs(token.IDENT) && p.currentToken.Literal == "tuple":
+			if !p.expectAndNext(token.STRING) {
+				return nil, p.Error()
+			}
+			stmt.Tuples = append(stmt.Tuples, p.currentToken)
+		case p.currentTokenIs(token.IDENT) && p.currentToken.Literal == "check":
+			check := ast.AssertCheck{Check: p.currentToken}
+			if !p.expectAndNext(token.STRING) {
 				return nil, p.Error()
 			}
+			check.Query = p.currentToken
+			if !p.peekTokenIs(token.TRUE, token.FALSE) {
+				p.peekError(token.TRUE, token.FALSE)
+				return nil, p.Error()
+			}
+			p.next()
+			check.Expected = p.currentToken
+			stmt.Checks = append(stmt.Checks, check)
+		case !p.currentTokenIs(token.NEWLINE, token.LBRACE):
+			p.errors = append(p.errors, fmt.Sprintf("%v:%v:expected tuple or check, got %s instead", p.l.GetLinePosition(), p.l.GetColumnPosition(), p.currentToken.Literal))
+			return nil, p.Error()
 		}
 		p.next()
 	}
@@ -250,7 +713,7 @@ func (p *Parser) parseEntityStatement() (*ast.EntityStatement, error) {
 
 // parseRelationStatement -
 func (p *Parser) parseRelationStatement(entityName string) (*ast.RelationStatement, error) {
-	stmt := &ast.RelationStatement{Relation: p.currentToken}
+	stmt := &ast.RelationStatement{Relation: p.currentToken, Doc: p.currentDoc}
 	if !p.expectAndNext(token.IDENT) {
 		return nil, p.Error()
 	}
@@ -268,6 +731,13 @@ func (p *Parser) parseRelationStatement(entityName string) (*ast.RelationStateme
 			return nil, p.Error()
 		}
 		stmt.RelationTypes = append(stmt.RelationTypes, *relationStatement)
+		// types may be separated by commas, the list continues on the next line after a trailing comma
+		if p.peekTokenIs(token.COMMA) {
+			p.next()
+			for p.peekTokenIs(token.NEWLINE) {
+				p.next()
+			}
+		}
 	}
 	
 	err := p.setRelationReference(utils.Key(entityName, relationName), stmt.RelationTypes)
@@ -302,8 +772,8 @@ func (p *Parser) parseRelationTypeStatement() (*ast.RelationTypeStatement, error
 }
 
 // parseActionStatement -
-func (p *Parser) parseActionStatement(entityName string) (ast.Statement, error) {
-	stmt := &ast.ActionStatement{Action: p.currentToken}
+func (p *Parser) parseActionStatement(entityName string) (*ast.ActionStatement, error) {
+	stmt := &ast.ActionStatement{Action: p.currentToken, Doc: p.currentDoc}
 	
 	if !p.expectAndNext(token.IDENT) {
 		return nil, p.Error()
@@ -320,6 +790,7 @@ func (p *Parser) parseActionStatement(entityName string) (ast.Statement, error)
 	}
 	
 	p.next()
+	p.skipNewlines()
 	
 	ex, err := p.parseExpressionStatement()
 	if err != nil {
@@ -327,6 +798,16 @@ func (p *Parser) parseActionStatement(entityName string) (ast.Statement, error)
 	}
 	stmt.ExpressionStatement = ex
 	
+	// when is not a keyword either, relations and actions can still be named when
+	if p.peekTokenIs(token.IDENT) && p.peekToken.Literal == "when" {
+		p.next()
+		stmt.When = p.currentToken
+		if !p.expectAndNext(token.STRING) {
+			return nil, p.Error()
+		}
+		stmt.Condition = p.currentToken
+	}
+	
 	return stmt, nil
 }
 
@@ -340,10 +821,8 @@ func (p *Parser) parseExpressionStatement() (*ast.ExpressionStatement, error) {
 	}
 	
 	if p.peekTokenIs(token.RPAREN) {
-		p.next()
-		for p.currentTokenIs(token.RPAREN) {
-			p.next()
-		}
+		p.unbalancedParenError()
+		return nil, p.Error()
 	}
 	
 	return stmt, nil
@@ -370,11 +849,6 @@ func (p *Parser) expect(t token.Type) bool {
 
 // parseExpression -
 func (p *Parser) parseExpression(precedence int) (ast.Expression, error) {
-	if p.currentTokenIs(token.LPAREN) {
-		p.next()
-		return p.parseInnerParen()
-	}
-	
 	prefix := p.prefixParseFns[p.currentToken.Type]
 	if prefix == nil {
 		p.noPrefixParseFnError(p.currentToken.Type)
@@ -400,36 +874,23 @@ func (p *Parser) parseExpression(precedence int) (ast.Expression, error) {
 	return exp, nil
 }
 
-// parseInnerParen -
-func (p *Parser) parseInnerParen() (ast.Expression, error) {
-	if p.currentTokenIs(token.LPAREN) {
-		return p.parseExpression(LOWEST)
-	}
+// parseGroupedExpression - Parses an expression in parentheses, it may span lines
+func (p *Parser) parseGroupedExpression() (ast.Expression, error) {
+	p.depth++
+	p.next()
+	p.skipNewlines()
 	
-	prefix := p.prefixParseFns[p.currentToken.Type]
-	if prefix == nil {
-		p.noPrefixParseFnError(p.currentToken.Type)
-		return nil, p.Error()
-	}
-	exp, err := prefix()
+	exp, err := p.parseExpression(LOWEST)
+	p.depth--
 	if err != nil {
 		return nil, p.Error()
 	}
 	
-	for !p.currentTokenIs(token.RPAREN) {
-		if p.peekTokenIs(token.RPAREN) {
-			p.next()
-		}
-		infix := p.infixParseFunc[p.peekToken.Type]
-		if infix == nil {
-			return exp, nil
-		}
-		p.next()
-		exp, err = infix(exp)
-		if err != nil {
-			return nil, p.Error()
-		}
+	if !p.peekTokenIs(token.RPAREN) {
+		p.unbalancedParenError()
+		return nil, p.Error()
 	}
+	p.next()
 	
 	return exp, nil
 }
@@ -440,12 +901,21 @@ func (p *Parser) parsePrefixExpression() (ast.Expression, error) {
 		Prefix: p.currentToken,
 	}
 	p.next()
+	if p.peekTokenIs(token.LPAREN) {
+		return p.parseCall(ident.Prefix)
+	}
 	ident.Idents = append(ident.Idents, p.currentToken)
+	if !p.parseTransitive(ident) {
+		return nil, p.Error()
+	}
 	for p.peekTokenIs(token.DOT) {
 		p.next()
 		p.next()
 		ident.Idents = append(ident.Idents, p.currentToken)
 	}
+	if !p.parseCaveat(ident) {
+		return nil, p.Error()
+	}
 	return ident, nil
 }
 
@@ -458,6 +928,8 @@ func (p *Parser) parseInfixExpression(left ast.Expression) (ast.Expression, erro
 	}
 	precedence := p.currentPrecedence()
 	p.next()
+	// an operator at the end of a line continues the expression on the next one
+	p.skipNewlines()
 	ex, err := p.parseExpression(precedence)
 	if err != nil {
 		return nil, p.Error()
@@ -484,15 +956,71 @@ func (p *Parser) currentPrecedence() int {
 
 // parseIdentifier
 func (p *Parser) parseIdentifier() (ast.Expression, error) {
+	if p.peekTokenIs(token.LPAREN) {
+		return p.parseCall(token.Token{})
+	}
 	ident := &ast.Identifier{Idents: []token.Token{p.currentToken}}
+	if !p.parseTransitive(ident) {
+		return nil, p.Error()
+	}
 	for p.peekTokenIs(token.DOT) {
 		p.next()
 		p.next()
 		ident.Idents = append(ident.Idents, p.currentToken)
 	}
+	if !p.parseCaveat(ident) {
+		return nil, p.Error()
+	}
 	return ident, nil
 }
 
+// parseTransitive - A star after the first relation makes it transitive, e.g. parent*.viewer, it has to be followed
+// by the rest of the chain
+func (p *Parser) parseTransitive(ident *ast.Identifier) bool {
+	if !p.peekTokenIs(token.STAR) {
+		return true
+	}
+	p.next()
+	ident.Transitive = p.currentToken
+	return p.expect(token.DOT)
+}
+
+// parseCaveat - Parses the caveat after a relation, e.g. viewer with ip_allowed. with is not a keyword, so
+// relations and actions can still be named with.
+func (p *Parser) parseCaveat(ident *ast.Identifier) bool {
+	if !p.peekTokenIs(token.IDENT) || p.peekToken.Literal != "with" {
+		return true
+	}
+	p.next()
+	if !p.expectAndNext(token.IDENT) {
+		return false
+	}
+	ident.Caveat = p.currentToken
+	return true
+}
+
+// parseCall - Parses the call of a rule, the arguments are attributes of the entity
+func (p *Parser) parseCall(prefix token.Token) (ast.Expression, error) {
+	call := &ast.Call{Prefix: prefix, Name: p.currentToken}
+	p.next()
+	for !p.peekTokenIs(token.RPAREN) {
+		if len(call.Arguments) > 0 && !p.expectAndNext(token.COMMA) {
+			return nil, p.Error()
+		}
+		if !p.expectAndNext(token.IDENT) {
+			return nil, p.Error()
+		}
+		call.Arguments = append(call.Arguments, p.currentToken)
+	}
+	p.next()
+	return call, nil
+}
+
+// parseLiteral
+func (p *Parser) parseLiteral() (ast.Expression, error) {
+	return &ast.Literal{Token: p.currentToken}, nil
+}
+
 // registerPrefix
 func (p *Parser) registerPrefix(tokenType token.Type, fn prefixParseFn) {
 	p.prefixParseFns[tokenType] = fn
@@ -515,8 +1043,20 @@ func (p *Parser) peekError(t ...token.Type) {
 	p.errors = append(p.errors, msg)
 }
 
+// unbalancedParenError -
+func (p *Parser) unbalancedParenError() {
+	msg := fmt.Sprintf("%v:%v:unbalanced parentheses, got %s", p.l.GetLinePosition(), p.l.GetColumnPosition(), p.peekToken.Type)
+	p.errors = append(p.errors, msg)
+}
+
 // currentError -
 func (p *Parser) currentError(t ...token.Type) {
 	msg := fmt.Sprintf("%v:%v:expected token to be %s, got %s instead", p.l.GetLinePosition(), p.l.GetColumnPosition(), t, p.currentToken.Type)
 	p.errors = append(p.errors, msg)
 }
+
+// attributeTypeError -
+func (p *Parser) attributeTypeError(t token.Token) {
+	msg := fmt.Sprintf("%v:%v:unknown attribute type %s, expected one of %s", p.l.GetLinePosition(), p.l.GetColumnPosition(), t.Literal, ast.AttributeTypes)
+	p.errors = append(p.errors, msg)
+}
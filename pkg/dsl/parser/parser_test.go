@@ -1,12 +1,16 @@
 package parser
 
 import (
+	"errors"
+	"io"
+	"strings"
 	"testing"
 	
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	
 	"github.com/adminium/permify/pkg/dsl/ast"
+	"github.com/adminium/permify/pkg/dsl/lexer"
 )
 
 // TestParser -
@@ -290,5 +294,520 @@ var _ = Describe("parser", func() {
 			Expect(res2.Expression.(*ast.InfixExpression).Left.(*ast.Identifier).String()).Should(Equal("owner"))
 			Expect(res2.Expression.(*ast.InfixExpression).Right.(*ast.Identifier).String()).Should(Equal("parent.create_repository"))
 		})
+		
+		It("Case 7: Deprecated", func() {
+			pr := NewParser(`
+			entity repository {
+			relation owner @user
+			deprecated relation maintainer @user
+			
+			deprecated action push = owner or maintainer
+			action read = owner
+			}`)
+			
+			schema, err := pr.Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			st := schema.Statements[0].(*ast.EntityStatement)
+			
+			Expect(st.RelationStatements[0].(*ast.RelationStatement).IsDeprecated()).Should(BeFalse())
+			Expect(st.RelationStatements[1].(*ast.RelationStatement).IsDeprecated()).Should(BeTrue())
+			Expect(st.RelationStatements[1].(*ast.RelationStatement).Name.Literal).Should(Equal("maintainer"))
+			Expect(st.ActionStatements[0].(*ast.ActionStatement).IsDeprecated()).Should(BeTrue())
+			Expect(st.ActionStatements[1].(*ast.ActionStatement).IsDeprecated()).Should(BeFalse())
+			
+			Expect(st.RelationStatements[1].String()).Should(Equal("\tdeprecated relation maintainer @user "))
+			Expect(st.ActionStatements[0].String()).Should(Equal("\tdeprecated action push = (owner or maintainer)"))
+		})
+		
+		It("Case 8: Deprecated must annotate a relation or an action", func() {
+			_, err := NewParser(`
+			entity repository {
+			deprecated owner @user
+			}`).Parse()
+			Expect(err).Should(HaveOccurred())
+		})
+		
+		It("Case 9: Multiline expressions", func() {
+			pr := NewParser(`
+			entity repository {
+			relation owner @user
+			relation parent @organization
+			relation banned @user
+			
+			action read = (
+				owner or
+				parent.admin
+			) and not banned
+			action push = owner or
+				(parent.admin and
+				not banned)
+			action delete =
+				owner
+			}`)
+			
+			schema, err := pr.Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			st := schema.Statements[0].(*ast.EntityStatement)
+			
+			Expect(st.ActionStatements).Should(HaveLen(3))
+			Expect(st.ActionStatements[0].String()).Should(Equal("\taction read = ((owner or parent.admin) and not banned)"))
+			Expect(st.ActionStatements[1].String()).Should(Equal("\taction push = (owner or (parent.admin and not banned))"))
+			Expect(st.ActionStatements[2].String()).Should(Equal("\taction delete = owner"))
+		})
+		
+		It("Case 10: Line continuations and commas between relation types", func() {
+			pr := NewParser("entity repository {\n" +
+				"\trelation owner @user, @organization#member,\n" +
+				"\t\t@team#member,\n" +
+				"\trelation parent @organization\n" +
+				"\taction read = owner \\\n" +
+				"\t\tor parent.admin\n" +
+				"}")
+			
+			schema, err := pr.Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			st := schema.Statements[0].(*ast.EntityStatement)
+			
+			owner := st.RelationStatements[0].(*ast.RelationStatement)
+			Expect(owner.RelationTypes).Should(HaveLen(3))
+			Expect(owner.RelationTypes[2].String()).Should(Equal("@team#member"))
+			Expect(st.RelationStatements[1].(*ast.RelationStatement).Name.Literal).Should(Equal("parent"))
+			Expect(st.ActionStatements[0].String()).Should(Equal("\taction read = (owner or parent.admin)"))
+		})
+		
+		It("Case 11: Unbalanced parentheses", func() {
+			_, err := NewParser(`
+			entity repository {
+			relation owner @user
+			action read = (owner or owner
+			}`).Parse()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("unbalanced parentheses"))
+			
+			_, err = NewParser(`
+			entity repository {
+			relation owner @user
+			action read = owner)
+			}`).Parse()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("unbalanced parentheses"))
+		})
+		
+		It("Case 12: Attributes and rules", func() {
+			sch, err := NewParser(`
+			entity repository {
+				attribute visibility string
+				attribute stars integer
+				relation owner @user
+				action read = owner or is_public(visibility) and not popular(stars)
+			}
+			
+			rule is_public(visibility string) {
+				visibility == "public"
+			}
+			
+			rule popular(stars integer) {
+				stars >= 100 or
+				stars == -1
+			}`).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			st := sch.Statements[0].(*ast.EntityStatement)
+			Expect(st.AttributeStatements[0].String()).Should(Equal("\tattribute visibility string"))
+			Expect(st.ActionStatements[0].String()).Should(Equal("\taction read = ((owner or is_public(visibility)) and not popular(stars))"))
+			
+			rule := sch.Statements[1].(*ast.RuleStatement)
+			Expect(rule.Name.Literal).Should(Equal("is_public"))
+			Expect(rule.Expression.String()).Should(Equal(`(visibility == "public")`))
+			Expect(sch.Statements[2].(*ast.RuleStatement).Expression.String()).Should(Equal("((stars >= 100) or (stars == -1))"))
+			
+			typ, ok := sch.GetAttributeTypeIfExist("repository#visibility")
+			Expect(ok).Should(BeTrue())
+			Expect(typ).Should(Equal("string"))
+			types, ok := sch.GetRuleArgumentTypesIfExist("popular")
+			Expect(ok).Should(BeTrue())
+			Expect(types).Should(Equal([]string{"integer"}))
+			
+			_, err = NewParser(sch.String()).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+		
+		It("Case 13: Attribute types", func() {
+			_, err := NewParser(`
+			entity repository {
+				attribute visibility text
+			}`).Parse()
+			Expect(err).Should(HaveOccurred())
+			
+			_, err = NewParser(`
+			entity repository {
+				attribute owner string
+				relation owner @user
+			}`).Parse()
+			Expect(err).Should(HaveOccurred())
+		})
+		
+		It("Case 14: Policy annotations", func() {
+			pr := NewParser(`
+			entity repository {
+			relation owner @user
+			
+			opa "authz/repository/push" action push = owner
+			opa "authz/repository/delete" override deprecated action delete = owner
+			action read = owner
+			}`)
+			
+			schema, err := pr.Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			st := schema.Statements[0].(*ast.EntityStatement)
+			
+			push := st.ActionStatements[0].(*ast.ActionStatement)
+			Expect(push.Policy.Path.Literal).Should(Equal("authz/repository/push"))
+			Expect(push.Policy.Mode.Literal).Should(Equal(""))
+			Expect(push.String()).Should(Equal("\topa \"authz/repository/push\" action push = owner"))
+			
+			del := st.ActionStatements[1].(*ast.ActionStatement)
+			Expect(del.Policy.Mode.Literal).Should(Equal("override"))
+			Expect(del.IsDeprecated()).Should(BeTrue())
+			Expect(del.String()).Should(Equal("\topa \"authz/repository/delete\" override deprecated action delete = owner"))
+			
+			Expect(st.ActionStatements[2].(*ast.ActionStatement).Policy).Should(BeNil())
+			
+			_, err = NewParser(`
+			entity repository {
+			relation owner @user
+			opa "authz/repository/push" sometimes action push = owner
+			}`).Parse()
+			Expect(err).Should(HaveOccurred())
+			
+			_, err = NewParser(`
+			entity repository {
+			relation owner @user
+			opa "authz/repository/push" relation maintainer @user
+			}`).Parse()
+			Expect(err).Should(HaveOccurred())
+		})
+		
+		It("Case 15: Transitive relations", func() {
+			pr := NewParser(`
+			entity folder {
+			relation parent @folder
+			relation viewer @user
+			
+			action view = viewer or not parent*.viewer
+			}`)
+			
+			schema, err := pr.Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			st := schema.Statements[0].(*ast.EntityStatement)
+			
+			view := st.ActionStatements[0].(*ast.ActionStatement)
+			es := view.ExpressionStatement.(*ast.ExpressionStatement)
+			ident := es.Expression.(*ast.InfixExpression).Right.(*ast.Identifier)
+			Expect(ident.IsTransitive()).Should(BeTrue())
+			Expect(ident.IsPrefix()).Should(BeTrue())
+			Expect(ident.Idents).Should(HaveLen(2))
+			Expect(ident.String()).Should(Equal("not parent*.viewer"))
+			
+			for _, source := range []string{"action view = parent*", "action view = parent.viewer*.owner", "action view = parent**.viewer"} {
+				_, err = NewParser("entity folder {\nrelation parent @folder\n" + source + "\n}").Parse()
+				Expect(err).Should(HaveOccurred(), source)
+			}
+		})
+		
+		It("Case 16: Entity aliases", func() {
+			schema, err := NewParser(`
+			entity user {}
+			
+			entity document (alias doc, file) {
+			relation alias @user
+			}`).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			st := schema.Statements[1].(*ast.EntityStatement)
+			Expect(st.Name.Literal).Should(Equal("document"))
+			Expect(st.Aliases).Should(HaveLen(2))
+			Expect(st.Aliases[0].Literal).Should(Equal("doc"))
+			Expect(st.Aliases[1].Literal).Should(Equal("file"))
+			Expect(st.RelationStatements[0].(*ast.RelationStatement).Name.Literal).Should(Equal("alias"))
+			Expect(st.String()).Should(HavePrefix("entity document (alias doc, file) {"))
+			
+			for _, source := range []string{
+				"entity user {}\nentity document (alias user) {}",
+				"entity document (alias doc) {}\nentity doc {}",
+				"entity document (alias doc) {}\nentity file (alias doc) {}",
+				"entity document (doc) {}",
+				"entity document (alias) {}",
+			} {
+				_, err = NewParser(source).Parse()
+				Expect(err).Should(HaveOccurred(), source)
+			}
+		})
+		
+		It("Case 17: Caveats", func() {
+			schema, err := NewParser(`
+			entity user {}
+			
+			entity doc {
+				relation viewer @user
+				relation with @user
+				
+				action view = viewer with ip_allowed or not with
+			}
+			
+			caveat ip_allowed "context.ip == payload.ip"`).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			st := schema.Statements[1].(*ast.EntityStatement)
+			Expect(st.ActionStatements[0].String()).Should(Equal("\taction view = (viewer with ip_allowed or not with)"))
+			
+			caveat := schema.Statements[2].(*ast.CaveatStatement)
+			Expect(caveat.Name.Literal).Should(Equal("ip_allowed"))
+			Expect(caveat.Expression.Literal).Should(Equal("context.ip == payload.ip"))
+			Expect(schema.IsCaveatReferenceExist("ip_allowed")).Should(BeTrue())
+			
+			_, err = NewParser(schema.String()).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			for _, source := range []string{
+				"caveat ip_allowed \"true\"\ncaveat ip_allowed \"false\"",
+				"caveat ip_allowed",
+				"entity doc {\n relation viewer @user\n action view = viewer with\n}",
+			} {
+				_, err = NewParser(source).Parse()
+				Expect(err).Should(HaveOccurred(), source)
+			}
+		})
+		
+		It("Case 18: Conditions", func() {
+			schema, err := NewParser(`
+			entity user {}
+			
+			entity doc {
+				relation owner @user
+				relation when @user
+				
+				action view = owner or when when "context.hour < 18"
+				action edit = owner
+			}`).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			st := schema.Statements[1].(*ast.EntityStatement)
+			view := st.ActionStatements[0].(*ast.ActionStatement)
+			Expect(view.HasCondition()).Should(BeTrue())
+			Expect(view.Condition.Literal).Should(Equal("context.hour < 18"))
+			Expect(view.String()).Should(Equal("\taction view = (owner or when) when \"context.hour < 18\""))
+			Expect(st.ActionStatements[1].(*ast.ActionStatement).HasCondition()).Should(BeFalse())
+			
+			_, err = NewParser(schema.String()).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			_, err = NewParser("entity doc {\n relation owner @user\n action view = owner when\n}").Parse()
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+	
+	Context("Stream", func() {
+		source := `
+		entity user {}
+		
+		entity organization {
+			relation admin @user
+		}
+		
+		entity repository {
+			relation parent @organization
+			action delete = parent.admin
+		}`
+		
+		It("Case 1: Statements are parsed one by one", func() {
+			pr := NewStreamParser(strings.NewReader(source), 0)
+			
+			var names []string
+			for {
+				stmt, err := pr.Next()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				Expect(err).ShouldNot(HaveOccurred())
+				names = append(names, stmt.(*ast.EntityStatement).Name.Literal)
+			}
+			Expect(names).Should(Equal([]string{"user", "organization", "repository"}))
+			
+			schema := pr.Schema()
+			Expect(schema.Statements).Should(BeEmpty())
+			typ, ok := schema.GetRelationalReferenceTypeIfExist("repository#delete")
+			Expect(ok).Should(BeTrue())
+			Expect(typ).Should(Equal(ast.ACTION))
+		})
+
+		It("Case 2: Size limit", func() {
+			_, err := NewStreamParser(strings.NewReader(source), int64(len(source))).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			pr := NewStreamParser(strings.NewReader(source), 64)
+			stmt, err := pr.Next()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(stmt.(*ast.EntityStatement).Name.Literal).Should(Equal("user"))
+			
+			_, err = pr.Next()
+			Expect(errors.Is(err, lexer.ErrSizeLimit)).Should(BeTrue())
+			Expect(err.Error()).Should(Equal("schema exceeds the size limit of 64 bytes"))
+		})
+	})
+	
+	Context("Walk", func() {
+		source := "entity user {}\n" +
+			"\n" +
+			"entity repository {\n" +
+			"\trelation owner @user\n" +
+			"\trelation maintainer @user\n" +
+			"\t/* legacy\n" +
+			"\t   roles */\n" +
+			"\taction push = owner or\n" +
+			"\t\tnot maintainer\n" +
+			"}"
+		
+		It("Case 1: Nodes are visited with their positions", func() {
+			sch, err := NewParser(source).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			var visited []string
+			ast.Inspect(sch, func(node ast.Node) bool {
+				switch n := node.(type) {
+				case *ast.EntityStatement:
+					visited = append(visited, ast.Position(n).String()+" entity "+n.Name.Literal)
+				case *ast.RelationTypeStatement:
+					visited = append(visited, ast.Position(n).String()+" type "+n.String())
+				case *ast.ActionStatement:
+					visited = append(visited, ast.Position(n).String()+" action "+n.Name.Literal)
+				case *ast.Identifier:
+					visited = append(visited, ast.Position(n).String()+" identifier "+n.String())
+				}
+				return true
+			})
+
+			Expect(visited).Should(Equal([]string{
+				"1:1 entity user",
+				"3:1 entity repository",
+				"4:17 type @user",
+				"5:22 type @user",
+				"8:2 action push",
+				"8:16 identifier owner",
+				"9:3 identifier not maintainer",
+			}))
+		})
+		
+		It("Case 2: Children are skipped", func() {
+			sch, err := NewParser(source).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			var count int
+			ast.Inspect(sch, func(node ast.Node) bool {
+				count++
+				_, ok := node.(*ast.EntityStatement)
+				return !ok
+			})
+			Expect(count).Should(Equal(3))
+		})
+		
+		It("Case 3: Rewrite expressions", func() {
+			sch, err := NewParser(source).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			// codemod: maintainers are renamed to owners
+			ast.RewriteExpressions(sch, func(exp ast.Expression) ast.Expression {
+				if ident, ok := exp.(*ast.Identifier); ok && ident.Idents[0].Literal == "maintainer" {
+					ident.Idents[0].Literal = "owner"
+				}
+				return exp
+			})
+			
+			st := sch.Statements[1].(*ast.EntityStatement)
+			Expect(st.ActionStatements[0].String()).Should(Equal("\taction push = (owner or not owner)"))
+			
+			_, err = NewParser(sch.String()).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+	})
+	
+	Context("Assert", func() {
+		It("Case 1: Tuples and checks of assert blocks", func() {
+			sch, err := NewParser(`
+			entity user {}
+			
+			assert "owners \"edit\"" {
+				tuple "doc:1#owner@user:1"
+				check "can user:1 edit doc:1" true
+				check "can user:2 edit doc:1" false
+			}
+			`).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			st := sch.Statements[1].(*ast.AssertStatement)
+			Expect(st.Name.Literal).Should(Equal(`owners "edit"`))
+			Expect(st.Tuples).Should(HaveLen(1))
+			Expect(st.Tuples[0].Literal).Should(Equal("doc:1#owner@user:1"))
+			Expect(st.Checks).Should(HaveLen(2))
+			Expect(st.Checks[0].Query.Literal).Should(Equal("can user:1 edit doc:1"))
+			Expect(st.Checks[0].IsAllowed()).Should(BeTrue())
+			Expect(st.Checks[1].IsAllowed()).Should(BeFalse())
+			
+			printed, err := NewParser(sch.String()).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(printed.Statements[1].String()).Should(Equal(st.String()))
+		})
+		
+		It("Case 2: Checks need an expected result", func() {
+			_, err := NewParser(`
+			assert "owners" {
+				check "can user:1 edit doc:1"
+			}
+			`).Parse()
+			Expect(err).Should(HaveOccurred())
+			
+			_, err = NewParser(`
+			assert "owners" {
+				relation owner @user
+			}
+			`).Parse()
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("expected tuple or check, got relation instead"))
+		})
+	})
+	
+	Context("Docs", func() {
+		It("Case 1: Comments right above the statements are kept in the printed source", func() {
+			sch, err := NewParser(`
+			// people
+			entity user {}
+			
+			entity doc {
+				// may edit
+				// and delete
+				relation owner @user // not a doc
+				
+				// dropped by the blank line
+				
+				relation viewer @user
+				// may read
+				deprecated action read = owner or viewer
+			}
+			`).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			user := sch.Statements[0].(*ast.EntityStatement)
+			Expect(user.Doc).Should(Equal([]string{"people"}))
+			st := sch.Statements[1].(*ast.EntityStatement)
+			Expect(st.Doc).Should(BeEmpty())
+			Expect(st.RelationStatements[0].(*ast.RelationStatement).Doc).Should(Equal([]string{"may edit", "and delete"}))
+			Expect(st.RelationStatements[1].(*ast.RelationStatement).Doc).Should(BeEmpty())
+			Expect(st.ActionStatements[0].(*ast.ActionStatement).Doc).Should(Equal([]string{"may read"}))
+			
+			printed, err := NewParser(sch.String()).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(printed.String()).Should(Equal(sch.String()))
+			Expect(printed.Statements[1].(*ast.EntityStatement).RelationStatements[0].(*ast.RelationStatement).Doc).Should(Equal([]string{"may edit", "and delete"}))
+		})
 	})
 })
@@ -1,5 +1,9 @@
 package token
 
+import (
+	"fmt"
+)
+
 // Type -
 type Type string
 
@@ -16,8 +20,25 @@ type WithIgnores struct {
 
 // Token -
 type Token struct {
-	Type    Type
-	Literal string
+	Type     Type
+	Literal  string
+	Position Position
+}
+
+// Position - Line and column of the first character of a token, both start at 1
+type Position struct {
+	Line   int
+	Column int
+}
+
+// String -
+func (p Position) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+// IsValid - Tokens that were not read by the lexer have no position
+func (p Position) IsValid() bool {
+	return p.Line > 0
 }
 
 // New -
@@ -27,12 +48,21 @@ func New(typ Type, ch byte) Token {
 
 // keywords -
 var keywords = map[string]Type{
-	"entity":   ENTITY,
-	"relation": RELATION,
-	"action":   ACTION,
-	"and":      AND,
-	"or":       OR,
-	"not":      NOT,
+	"entity":    ENTITY,
+	"relation":  RELATION,
+	"action":    ACTION,
+	"attribute": ATTRIBUTE,
+	"rule":      RULE,
+	"caveat":    CAVEAT,
+	"assert":    ASSERT,
+	"and":       AND,
+	"or":        OR,
+	"not":       NOT,
+	"true":      TRUE,
+	"false":     FALSE,
+
+	"deprecated": DEPRECATED,
+	"opa":        OPA,
 }
 
 // ignores -
@@ -41,6 +71,7 @@ var ignores = map[Type]struct{}{
 	MULTI_LINE_COMMENT:  {},
 	SPACE:               {},
 	TAB:                 {},
+	LINE_CONTINUATION:   {},
 }
 
 const (
@@ -56,7 +87,12 @@ const (
 	// Identifiers & Literals
 	//
 
-	IDENT = "IDENT"
+	IDENT   = "IDENT"
+	STRING  = "STRING"
+	INTEGER = "INTEGER"
+	DOUBLE  = "DOUBLE"
+	TRUE    = "TRUE"
+	FALSE   = "FALSE"
 
 	//
 	// Delimiters
@@ -74,6 +110,7 @@ const (
 	SIGN   = "SIGN"
 	HASH   = "HASH"
 	DOT    = "DOT"
+	STAR   = "STAR"
 
 	NEWLINE = "NEWLINE"
 
@@ -81,9 +118,13 @@ const (
 	// Keywords
 	//
 
-	ENTITY   = "ENTITY"
-	RELATION = "RELATION"
-	ACTION   = "ACTION"
+	ENTITY    = "ENTITY"
+	RELATION  = "RELATION"
+	ACTION    = "ACTION"
+	ATTRIBUTE = "ATTRIBUTE"
+	RULE      = "RULE"
+	CAVEAT    = "CAVEAT"
+	ASSERT    = "ASSERT"
 
 	//
 	// Prefix
@@ -91,6 +132,13 @@ const (
 
 	NOT = "NOT"
 
+	//
+	// Annotations
+	//
+
+	DEPRECATED = "DEPRECATED"
+	OPA        = "OPA"
+
 	//
 	// Logical
 	//
@@ -98,6 +146,17 @@ const (
 	AND = "AND"
 	OR  = "OR"
 
+	//
+	// Comparison
+	//
+
+	EQ  = "EQ"
+	NEQ = "NEQ"
+	LT  = "LT"
+	LTE = "LTE"
+	GT  = "GT"
+	GTE = "GTE"
+
 	//
 	// Comments
 	//
@@ -106,6 +165,7 @@ const (
 	MULTI_LINE_COMMENT  = "MULTI_LINE_COMMENT"
 	SPACE               = "SPACE"
 	TAB                 = "TAB"
+	LINE_CONTINUATION   = "LINE_CONTINUATION"
 )
 
 // LookupKeywords -
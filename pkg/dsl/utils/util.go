@@ -1,8 +1,11 @@
 package utils
 
 import (
+	"strconv"
 	"strings"
 	
+	"github.com/google/cel-go/cel"
+	
 	"github.com/adminium/permify/pkg/dsl/ast"
 	"github.com/adminium/permify/pkg/dsl/token"
 )
@@ -34,3 +37,98 @@ func Key(v1, v2 string) string {
 	sb.WriteString(v2)
 	return sb.String()
 }
+
+// RuleReference - Name of the compiled call of a rule, e.g. is_public(visibility). The compiled definitions have
+// no leaf for rules, so a call is compiled to a computed user set named after it.
+func RuleReference(rule string, arguments []string) string {
+	var sb strings.Builder
+	sb.WriteString(rule)
+	sb.WriteString("(")
+	sb.WriteString(strings.Join(arguments, ","))
+	sb.WriteString(")")
+	return sb.String()
+}
+
+// ParseRuleReference - Name and arguments of the rule of a compiled call, ok is false for relations and actions
+func ParseRuleReference(reference string) (rule string, arguments []string, ok bool) {
+	rule, rest, found := strings.Cut(reference, "(")
+	if !found || !strings.HasSuffix(rest, ")") {
+		return "", nil, false
+	}
+	rest = strings.TrimSuffix(rest, ")")
+	if rest != "" {
+		arguments = strings.Split(rest, ",")
+	}
+	return rule, arguments, true
+}
+
+// TransitiveReference - Name of the compiled tuple set of a transitive relation, e.g. parent*. The compiled
+// definitions have no flag for it, so the tuple set is named after the relation with a star.
+func TransitiveReference(relation string) string {
+	return relation + "*"
+}
+
+// ParseTransitiveReference - Relation of the tuple set of a transitive relation, ok is false for the other tuple sets
+func ParseTransitiveReference(reference string) (relation string, ok bool) {
+	if !strings.HasSuffix(reference, "*") || len(reference) == 1 {
+		return "", false
+	}
+	return strings.TrimSuffix(reference, "*"), true
+}
+
+// CaveatReference - Name of the compiled relation with a caveat, e.g. viewer with ip_allowed. The compiled
+// definitions have no room for caveats, so the relation is compiled to a computed user set named after both.
+func CaveatReference(relation, caveat string) string {
+	var sb strings.Builder
+	sb.WriteString(relation)
+	sb.WriteString("[")
+	sb.WriteString(caveat)
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// ParseCaveatReference - Relation and caveat of a compiled relation with a caveat, ok is false for the others
+func ParseCaveatReference(reference string) (relation, caveat string, ok bool) {
+	relation, rest, found := strings.Cut(reference, "[")
+	if !found || relation == "" || !strings.HasSuffix(rest, "]") || len(rest) == 1 {
+		return "", "", false
+	}
+	return relation, strings.TrimSuffix(rest, "]"), true
+}
+
+// CaveatEnvironment - CEL environment of the expressions of the caveats, context is the context of the check
+// request and payload the payload of the tuple, both are maps of json values
+func CaveatEnvironment() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable("context", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("payload", cel.MapType(cel.StringType, cel.DynType)),
+	)
+}
+
+// ConditionReference - Name of the condition of an action in the results that name relations, e.g. the targets of
+// expanded leaves, when "context.hour < 18"
+func ConditionReference(expression string) string {
+	return "when " + strconv.Quote(expression)
+}
+
+// ParseConditionReference - Expression of the name of a condition, ok is false for the other names
+func ParseConditionReference(reference string) (expression string, ok bool) {
+	if !strings.HasPrefix(reference, "when ") {
+		return "", false
+	}
+	expression, err := strconv.Unquote(strings.TrimPrefix(reference, "when "))
+	if err != nil {
+		return "", false
+	}
+	return expression, true
+}
+
+// ConditionEnvironment - CEL environment of the conditions of the actions, context is the context of the check
+// request, entity has the type and id of the entity and subject the type, id and relation of the subject
+func ConditionEnvironment() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable("context", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("entity", cel.MapType(cel.StringType, cel.StringType)),
+		cel.Variable("subject", cel.MapType(cel.StringType, cel.StringType)),
+	)
+}
@@ -0,0 +1,68 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Cipher - Encrypts the values of a tenant with its data key. The encryption is deterministic, the nonce is derived
+// from the value, so equal values have equal ciphertexts and the storage can still look the tuples up by them.
+type Cipher struct {
+	aead cipher.AEAD
+	mac  []byte
+}
+
+// NewCipher - Creates new cipher, the first half of the data key encrypts and the second half derives the nonces
+func NewCipher(dataKey []byte) (*Cipher, error) {
+	if len(dataKey) != _dataKeySize {
+		return nil, fmt.Errorf("data key must be %d bytes", _dataKeySize)
+	}
+	block, err := aes.NewCipher(dataKey[:_dataKeySize/2])
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Cipher{
+		aead: aead,
+		mac:  dataKey[_dataKeySize/2:],
+	}, nil
+}
+
+// Encrypt - Encrypts the value, empty values stay empty
+func (c *Cipher) Encrypt(value string) string {
+	if value == "" {
+		return value
+	}
+	h := hmac.New(sha256.New, c.mac)
+	h.Write([]byte(value))
+	nonce := h.Sum(nil)[:c.aead.NonceSize()]
+	return Prefix + base64.RawURLEncoding.EncodeToString(c.aead.Seal(nonce, nonce, []byte(value), nil))
+}
+
+// Decrypt - Decrypts the value, values that are not encrypted are returned as they are
+func (c *Cipher) Decrypt(value string) (string, error) {
+	if !strings.HasPrefix(value, Prefix) {
+		return value, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(value, Prefix))
+	if err != nil {
+		return "", err
+	}
+	if len(data) < c.aead.NonceSize() {
+		return "", errors.New("encrypted value is too short")
+	}
+	plaintext, err := c.aead.Open(nil, data[:c.aead.NonceSize()], data[c.aead.NonceSize():], nil)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
@@ -0,0 +1,37 @@
+package encryption
+
+// Provider - Type of the key provider the data keys are wrapped by
+type Provider string
+
+const (
+	LOCAL Provider = "local"
+	VAULT Provider = "vault"
+)
+
+// String - String converter
+func (p Provider) String() string {
+	return string(p)
+}
+
+// Mode - Parts of the tuples that are encrypted
+type Mode string
+
+const (
+	// SUBJECT - the ids of the subjects are encrypted
+	SUBJECT Mode = "subject"
+	// TUPLE - the ids of the entities and the subjects are encrypted, the types and relations stay readable because
+	// the schema and the indexes of the storage need them
+	TUPLE Mode = "tuple"
+)
+
+// String - String converter
+func (m Mode) String() string {
+	return string(m)
+}
+
+const (
+	// Prefix - Prefix of the encrypted values, values without it were written before their tenant was encrypted
+	Prefix = "enc:"
+	
+	_dataKeySize = 64
+)
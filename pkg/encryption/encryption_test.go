@@ -0,0 +1,143 @@
+package encryption
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// TestEncryption -
+func TestEncryption(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "encryption-suite")
+}
+
+// key - Random key of the size
+func key(size int) []byte {
+	k := make([]byte, size)
+	_, err := rand.Read(k)
+	Expect(err).ShouldNot(HaveOccurred())
+	return k
+}
+
+var _ = Describe("encryption", func() {
+	Context("Cipher", func() {
+		It("Case 1: Equal values have equal ciphertexts", func() {
+			c, err := NewCipher(key(_dataKeySize))
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			encrypted := c.Encrypt("alice")
+			Expect(encrypted).Should(HavePrefix(Prefix))
+			Expect(encrypted).ShouldNot(ContainSubstring("alice"))
+			Expect(c.Encrypt("alice")).Should(Equal(encrypted))
+			Expect(c.Encrypt("bob")).ShouldNot(Equal(encrypted))
+			
+			decrypted, err := c.Decrypt(encrypted)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(decrypted).Should(Equal("alice"))
+		})
+		
+		It("Case 2: Values written before the encryption are read as they are", func() {
+			c, err := NewCipher(key(_dataKeySize))
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			decrypted, err := c.Decrypt("alice")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(decrypted).Should(Equal("alice"))
+			Expect(c.Encrypt("")).Should(Equal(""))
+		})
+		
+		It("Case 3: Values of other tenants cannot be decrypted", func() {
+			c1, err := NewCipher(key(_dataKeySize))
+			Expect(err).ShouldNot(HaveOccurred())
+			c2, err := NewCipher(key(_dataKeySize))
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			_, err = c2.Decrypt(c1.Encrypt("alice"))
+			Expect(err).Should(HaveOccurred())
+			
+			_, err = NewCipher(key(32))
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+	
+	Context("Keyring", func() {
+		It("Case 1: Only the tenants with a data key are encrypted", func() {
+			provider, err := NewLocal(key(32))
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			wrapped, err := GenerateDataKey(context.Background(), provider)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			keyring := NewKeyring(provider, SUBJECT, map[string]string{"t1": wrapped})
+			
+			c, err := keyring.Cipher(context.Background(), "t1")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(c).ShouldNot(BeNil())
+			
+			again, err := keyring.Cipher(context.Background(), "t1")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(again).Should(BeIdenticalTo(c))
+			
+			c, err = keyring.Cipher(context.Background(), "t2")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(c).Should(BeNil())
+		})
+		
+		It("Case 2: Data keys wrapped by another master key are rejected", func() {
+			provider, err := NewLocal(key(32))
+			Expect(err).ShouldNot(HaveOccurred())
+			other, err := NewLocal(key(32))
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			wrapped, err := GenerateDataKey(context.Background(), other)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			_, err = NewKeyring(provider, SUBJECT, map[string]string{"t1": wrapped}).Cipher(context.Background(), "t1")
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+	
+	Context("Vault", func() {
+		It("Case 1: Data keys are wrapped by the transit key", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body := map[string]string{}
+				if r.Header.Get("X-Vault-Token") != "token" || json.NewDecoder(r.Body).Decode(&body) != nil {
+					w.WriteHeader(http.StatusForbidden)
+					return
+				}
+				
+				// the test transit key wraps by prefixing the base64 plaintext
+				switch r.URL.Path {
+				case "/v1/transit/encrypt/permify":
+					_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]string{"ciphertext": "vault:v1:" + body["plaintext"]}})
+				case "/v1/transit/decrypt/permify":
+					_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]string{"plaintext": strings.TrimPrefix(body["ciphertext"], "vault:v1:")}})
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+			
+			vault := NewVault(server.URL, "token", "permify")
+			wrapped, err := vault.Encrypt(context.Background(), []byte("data key"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(wrapped)).Should(Equal("vault:v1:" + base64.StdEncoding.EncodeToString([]byte("data key"))))
+			
+			plaintext, err := vault.Decrypt(context.Background(), wrapped)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(plaintext)).Should(Equal("data key"))
+			
+			_, err = NewVault(server.URL, "token", "missing").Encrypt(context.Background(), []byte("data key"))
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
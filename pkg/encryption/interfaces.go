@@ -0,0 +1,13 @@
+package encryption
+
+import (
+	"context"
+)
+
+// KeyProvider - Key management service that wraps the data keys of the tenants with a key that never leaves it
+type KeyProvider interface {
+	// Encrypt wraps the plaintext.
+	Encrypt(ctx context.Context, plaintext []byte) (ciphertext []byte, err error)
+	// Decrypt unwraps the ciphertext.
+	Decrypt(ctx context.Context, ciphertext []byte) (plaintext []byte, err error)
+}
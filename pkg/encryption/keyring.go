@@ -0,0 +1,77 @@
+package encryption
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"sync"
+)
+
+// Keyring - Data keys of the encrypted tenants, wrapped by the key provider. A data key is unwrapped the first time
+// its tenant is read or written and kept in memory afterwards.
+type Keyring struct {
+	provider KeyProvider
+	mode     Mode
+	wrapped  map[string]string
+	
+	mu      sync.Mutex
+	ciphers map[string]*Cipher
+}
+
+// NewKeyring - Creates new keyring, wrapped holds the base64 wrapped data keys by tenant id
+func NewKeyring(provider KeyProvider, mode Mode, wrapped map[string]string) *Keyring {
+	return &Keyring{
+		provider: provider,
+		mode:     mode,
+		wrapped:  wrapped,
+		ciphers:  map[string]*Cipher{},
+	}
+}
+
+// Mode - Parts of the tuples that are encrypted
+func (k *Keyring) Mode() Mode {
+	return k.mode
+}
+
+// Cipher - Cipher of the tenant, nil when the tenant is not encrypted
+func (k *Keyring) Cipher(ctx context.Context, tenantID string) (*Cipher, error) {
+	wrapped, ok := k.wrapped[tenantID]
+	if !ok {
+		return nil, nil
+	}
+	
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	
+	if c, ok := k.ciphers[tenantID]; ok {
+		return c, nil
+	}
+	
+	data, err := base64.StdEncoding.DecodeString(wrapped)
+	if err != nil {
+		return nil, err
+	}
+	dataKey, err := k.provider.Decrypt(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	c, err := NewCipher(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	k.ciphers[tenantID] = c
+	return c, nil
+}
+
+// GenerateDataKey - Generates a data key and returns it base64 wrapped by the key provider
+func GenerateDataKey(ctx context.Context, provider KeyProvider) (string, error) {
+	dataKey := make([]byte, _dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", err
+	}
+	wrapped, err := provider.Encrypt(ctx, dataKey)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(wrapped), nil
+}
@@ -0,0 +1,48 @@
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+)
+
+// Local - Key provider that wraps the data keys with a master key of the configuration, for deployments without a
+// key management service
+type Local struct {
+	aead cipher.AEAD
+}
+
+// NewLocal - Creates new local key provider, the master key is an aes-256 key
+func NewLocal(masterKey []byte) (*Local, error) {
+	if len(masterKey) != 32 {
+		return nil, errors.New("master key must be 32 bytes")
+	}
+	block, err := aes.NewCipher(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Local{aead: aead}, nil
+}
+
+// Encrypt - Wraps the plaintext, the random nonce is prepended to the ciphertext
+func (l *Local) Encrypt(_ context.Context, plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, l.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return l.aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// Decrypt - Unwraps the ciphertext
+func (l *Local) Decrypt(_ context.Context, ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) < l.aead.NonceSize() {
+		return nil, errors.New("wrapped key is too short")
+	}
+	return l.aead.Open(nil, ciphertext[:l.aead.NonceSize()], ciphertext[l.aead.NonceSize():], nil)
+}
@@ -0,0 +1,81 @@
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Vault - Key provider on the transit secrets engine of hashicorp vault, the key never leaves vault
+type Vault struct {
+	address string
+	token   string
+	key     string
+	Client  *http.Client
+}
+
+// NewVault - Creates new vault key provider, key is the name of the transit key
+func NewVault(address, token, key string) *Vault {
+	return &Vault{
+		address: strings.TrimSuffix(address, "/"),
+		token:   token,
+		key:     key,
+		Client:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Encrypt - Wraps the plaintext, the ciphertext is the vault:v<n>:... string of vault
+func (v *Vault) Encrypt(ctx context.Context, plaintext []byte) ([]byte, error) {
+	response := struct {
+		Data struct {
+			Ciphertext string `json:"ciphertext"`
+		} `json:"data"`
+	}{}
+	if err := v.post(ctx, "encrypt", map[string]string{"plaintext": base64.StdEncoding.EncodeToString(plaintext)}, &response); err != nil {
+		return nil, err
+	}
+	return []byte(response.Data.Ciphertext), nil
+}
+
+// Decrypt - Unwraps the ciphertext
+func (v *Vault) Decrypt(ctx context.Context, ciphertext []byte) ([]byte, error) {
+	response := struct {
+		Data struct {
+			Plaintext string `json:"plaintext"`
+		} `json:"data"`
+	}{}
+	if err := v.post(ctx, "decrypt", map[string]string{"ciphertext": string(ciphertext)}, &response); err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(response.Data.Plaintext)
+}
+
+// post - Calls the operation of the transit key
+func (v *Vault) post(ctx context.Context, operation string, body, response interface{}) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/v1/transit/%s/%s", v.address, operation, v.key), bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Vault-Token", v.token)
+	req.Header.Set("Content-Type", "application/json")
+	
+	resp, err := v.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("vault %s failed with status %d", operation, resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(response)
+}
@@ -0,0 +1,14 @@
+package events
+
+// Engine - Engine type for the broker of the events
+type Engine string
+
+const (
+	KAFKA Engine = "kafka"
+	NATS  Engine = "nats"
+)
+
+// String - String converter
+func (c Engine) String() string {
+	return string(c)
+}
@@ -0,0 +1,45 @@
+package events
+
+import (
+	"context"
+	"time"
+)
+
+// Version - Version of the format of the events, it is bumped when a field changes its meaning or is removed
+const Version = 1
+
+const (
+	// RelationshipsChanged - The relation tuples written and deleted by a transaction of a tenant
+	RelationshipsChanged = "relationships.changed"
+	// SchemaWritten - A new version of the schema of a tenant
+	SchemaWritten = "schema.written"
+)
+
+// Event - A write of a tenant. The events are delivered at least once, the consumers drop the ones whose id they
+// have seen already.
+type Event struct {
+	// ID - unique per tenant, the type followed by the snap token or the schema version
+	ID       string `json:"id"`
+	Version  int    `json:"version"`
+	Type     string `json:"type"`
+	TenantID string `json:"tenant_id"`
+	// SnapToken - snapshot of the transaction of the changed relationships
+	SnapToken string `json:"snap_token,omitempty"`
+	// SchemaVersion - version of the written schema
+	SchemaVersion string `json:"schema_version,omitempty"`
+	// Definitions - serialized entity definitions of the written schema
+	Definitions []string `json:"definitions,omitempty"`
+	// Created and Deleted - the changed tuples in the entity#relation@subject form
+	Created []string `json:"created,omitempty"`
+	Deleted []string `json:"deleted,omitempty"`
+	// Time - when the event was published
+	Time time.Time `json:"time"`
+}
+
+// Broker - Message broker the events are published to
+type Broker interface {
+	// Publish publishes the events in order and returns once the broker acknowledged every one of them.
+	Publish(ctx context.Context, events []Event) (err error)
+	// Close flushes the pending events and closes the connections to the broker.
+	Close() (err error)
+}
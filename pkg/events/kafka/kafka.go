@@ -0,0 +1,56 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+
+	"github.com/adminium/permify/pkg/events"
+)
+
+// Kafka - Writes every event as a message of a topic. The messages are keyed by tenant so that the events of a
+// tenant stay in order within their partition, and every replica of the partition acknowledges them.
+type Kafka struct {
+	writer *kafka.Writer
+}
+
+// New - Creates a new kafka broker
+func New(brokers []string, topic string) *Kafka {
+	return &Kafka{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(brokers...),
+			Topic:        topic,
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireAll,
+			// the events of a change are published at once, they are not batched with the next ones
+			BatchTimeout: 10 * time.Millisecond,
+		},
+	}
+}
+
+// Publish - Writes the events at once, the id of an event is carried in its headers
+func (k *Kafka) Publish(ctx context.Context, evs []events.Event) error {
+	messages := make([]kafka.Message, 0, len(evs))
+	for _, event := range evs {
+		value, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		messages = append(messages, kafka.Message{
+			Key:   []byte(event.TenantID),
+			Value: value,
+			Headers: []kafka.Header{
+				{Key: "id", Value: []byte(event.ID)},
+				{Key: "type", Value: []byte(event.Type)},
+			},
+		})
+	}
+	return k.writer.WriteMessages(ctx, messages...)
+}
+
+// Close - Flushes the pending messages and closes the connections to the brokers
+func (k *Kafka) Close() error {
+	return k.writer.Close()
+}
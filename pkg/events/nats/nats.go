@@ -0,0 +1,58 @@
+package nats
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/nats-io/nats.go"
+
+	"github.com/adminium/permify/pkg/events"
+)
+
+// NATS - Publishes every event to the subject of its tenant through jetstream, <subject>.<tenant id>. A stream must
+// capture the subjects; it acknowledges the events once they are stored and drops the duplicates by their ids.
+type NATS struct {
+	conn    *nats.Conn
+	js      nats.JetStreamContext
+	subject string
+}
+
+// New - Connects to the nats server
+func New(url, subject string) (*NATS, error) {
+	conn, err := nats.Connect(url, nats.Name("permify"))
+	if err != nil {
+		return nil, err
+	}
+	js, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &NATS{
+		conn:    conn,
+		js:      js,
+		subject: subject,
+	}, nil
+}
+
+// Publish - Publishes the events one after the other, every event waits for its acknowledgement
+func (n *NATS) Publish(ctx context.Context, evs []events.Event) error {
+	for _, event := range evs {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		msg := nats.NewMsg(n.subject + "." + event.TenantID)
+		msg.Data = data
+		msg.Header.Set("type", event.Type)
+		if _, err = n.js.PublishMsg(msg, nats.MsgId(event.TenantID+"/"+event.ID), nats.Context(ctx)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close - Drains the connection to the server
+func (n *NATS) Close() error {
+	return n.conn.Drain()
+}
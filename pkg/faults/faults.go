@@ -0,0 +1,114 @@
+package faults
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+	
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// Faults - Failures injected into the calls of the wrapped storage and cache, to test how the callers cope with them.
+// The zero value injects nothing.
+type Faults struct {
+	// Latency - added to every call, a call whose context is done while it waits fails with the error of the context
+	Latency time.Duration
+	// Jitter - upper bound of a random latency added on top of Latency
+	Jitter time.Duration
+	// ErrorRate - share of the calls, between 0 and 1, that fail with Err without reaching the wrapped storage
+	ErrorRate float64
+	// PartialRate - share of the writes, between 0 and 1, that are applied but fail with Err as if the response was
+	// lost, so that the caller does not know whether its write happened. For caches, the share of the entries that
+	// are dropped instead of stored.
+	PartialRate float64
+	// Err - error of the failed calls, a storage execution error when nil
+	Err error
+	// Seed - seed of the random failures, the same seed fails the same calls of a sequential test
+	Seed int64
+}
+
+// Enabled - Some faults are configured
+func (f Faults) Enabled() bool {
+	return f.Latency > 0 || f.Jitter > 0 || f.ErrorRate > 0 || f.PartialRate > 0
+}
+
+// Injector - Decides which calls fail, it is safe for concurrent use
+type Injector struct {
+	faults Faults
+	
+	mu   sync.Mutex
+	rand *rand.Rand
+}
+
+// NewInjector - Creates new fault injector
+func NewInjector(faults Faults) *Injector {
+	if faults.Err == nil {
+		faults.Err = errors.New(base.ErrorCode_ERROR_CODE_EXECUTION.String())
+	}
+	return &Injector{
+		faults: faults,
+		rand:   rand.New(rand.NewSource(faults.Seed)),
+	}
+}
+
+// Before - Waits for the latency of the call and fails it at the error rate, a nil injector injects nothing
+func (i *Injector) Before(ctx context.Context) error {
+	if i == nil {
+		return nil
+	}
+	if latency := i.latency(); latency > 0 {
+		timer := time.NewTimer(latency)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if i.happens(i.faults.ErrorRate) {
+		return i.faults.Err
+	}
+	return nil
+}
+
+// After - Fails an applied write at the partial rate, the error of the write is kept when it failed anyway
+func (i *Injector) After(err error) error {
+	if i == nil || err != nil {
+		return err
+	}
+	if i.happens(i.faults.PartialRate) {
+		return i.faults.Err
+	}
+	return nil
+}
+
+// Drop - An entry is dropped at the partial rate
+func (i *Injector) Drop() bool {
+	if i == nil {
+		return false
+	}
+	return i.happens(i.faults.PartialRate)
+}
+
+// latency -
+func (i *Injector) latency() time.Duration {
+	latency := i.faults.Latency
+	if i.faults.Jitter > 0 {
+		i.mu.Lock()
+		latency += time.Duration(i.rand.Int63n(int64(i.faults.Jitter)))
+		i.mu.Unlock()
+	}
+	return latency
+}
+
+// happens - Draws whether a fault of the rate happens
+func (i *Injector) happens(rate float64) bool {
+	if rate <= 0 {
+		return false
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.rand.Float64() < rate
+}
@@ -0,0 +1,74 @@
+package faults
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// TestFaults -
+func TestFaults(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "faults-suite")
+}
+
+var _ = Describe("faults", func() {
+	Context("Before", func() {
+		It("Case 1: nothing is injected by default", func() {
+			var injector *Injector
+			Expect(injector.Before(context.Background())).ShouldNot(HaveOccurred())
+			Expect(NewInjector(Faults{}).Before(context.Background())).ShouldNot(HaveOccurred())
+			Expect(Faults{}.Enabled()).Should(BeFalse())
+		})
+		
+		It("Case 2: every call fails at error rate 1", func() {
+			injector := NewInjector(Faults{ErrorRate: 1})
+			for i := 0; i < 10; i++ {
+				Expect(injector.Before(context.Background())).Should(MatchError(base.ErrorCode_ERROR_CODE_EXECUTION.String()))
+			}
+		})
+		
+		It("Case 3: the latency ends with the context", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+			
+			start := time.Now()
+			err := NewInjector(Faults{Latency: time.Minute}).Before(ctx)
+			Expect(errors.Is(err, context.DeadlineExceeded)).Should(BeTrue())
+			Expect(time.Since(start)).Should(BeNumerically("<", time.Second))
+		})
+		
+		It("Case 4: the same seed fails the same calls", func() {
+			sequence := func() (failed []bool) {
+				injector := NewInjector(Faults{ErrorRate: 0.5, Seed: 42})
+				for i := 0; i < 20; i++ {
+					failed = append(failed, injector.Before(context.Background()) != nil)
+				}
+				return failed
+			}
+			first := sequence()
+			Expect(first).Should(ContainElement(true))
+			Expect(first).Should(ContainElement(false))
+			Expect(sequence()).Should(Equal(first))
+		})
+	})
+	
+	Context("After", func() {
+		It("Case 1: applied writes fail at partial rate 1", func() {
+			injector := NewInjector(Faults{PartialRate: 1, Err: errors.New("lost")})
+			Expect(injector.After(nil)).Should(MatchError("lost"))
+			Expect(injector.Drop()).Should(BeTrue())
+		})
+		
+		It("Case 2: the error of a failed write is kept", func() {
+			injector := NewInjector(Faults{PartialRate: 1, Err: errors.New("lost")})
+			Expect(injector.After(errors.New("failed"))).Should(MatchError("failed"))
+		})
+	})
+})
@@ -0,0 +1,282 @@
+package filter
+
+import (
+	"strings"
+	
+	"golang.org/x/exp/slices"
+	
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// Field - Type declaration of tuple field
+type Field string
+
+const (
+	ENTITY_TYPE      Field = "entity_type"
+	ENTITY_ID        Field = "entity_id"
+	RELATION         Field = "relation"
+	SUBJECT_TYPE     Field = "subject_type"
+	SUBJECT_ID       Field = "subject_id"
+	SUBJECT_RELATION Field = "subject_relation"
+)
+
+// String - Convert to string
+func (f Field) String() string {
+	return string(f)
+}
+
+// Operator - Type declaration of condition operator
+type Operator string
+
+const (
+	EQ     Operator = "="
+	NEQ    Operator = "!="
+	IN     Operator = "in"
+	NOT_IN Operator = "not in"
+)
+
+// String - Convert to string
+func (o Operator) String() string {
+	return string(o)
+}
+
+// Expression - Filter expression over relation tuples
+type Expression interface {
+	// Matches reports whether the given tuple satisfies the expression.
+	Matches(tup *base.Tuple) bool
+	// String returns the canonical representation of the expression.
+	String() string
+}
+
+// Condition - Compares a tuple field against one or more values
+type Condition struct {
+	Field    Field
+	Operator Operator
+	Values   []string
+}
+
+// Matches -
+func (c *Condition) Matches(tup *base.Tuple) bool {
+	value := fieldValue(tup, c.Field)
+	switch c.Operator {
+	case EQ, IN:
+		return slices.Contains(c.Values, value)
+	case NEQ, NOT_IN:
+		return !slices.Contains(c.Values, value)
+	default:
+		return false
+	}
+}
+
+// String -
+func (c *Condition) String() string {
+	var sb strings.Builder
+	sb.WriteString(c.Field.String())
+	sb.WriteString(" ")
+	sb.WriteString(c.Operator.String())
+	sb.WriteString(" ")
+	if c.Operator == IN || c.Operator == NOT_IN {
+		sb.WriteString("(")
+		sb.WriteString(strings.Join(c.Values, ", "))
+		sb.WriteString(")")
+	} else {
+		sb.WriteString(c.Values[0])
+	}
+	return sb.String()
+}
+
+// Conjunction - Matches when all of its children match
+type Conjunction struct {
+	Children []Expression
+}
+
+// Matches -
+func (a *Conjunction) Matches(tup *base.Tuple) bool {
+	for _, child := range a.Children {
+		if !child.Matches(tup) {
+			return false
+		}
+	}
+	return true
+}
+
+// String -
+func (a *Conjunction) String() string {
+	return join(a.Children, "and")
+}
+
+// Disjunction - Matches when any of its children match
+type Disjunction struct {
+	Children []Expression
+}
+
+// Matches -
+func (o *Disjunction) Matches(tup *base.Tuple) bool {
+	for _, child := range o.Children {
+		if child.Matches(tup) {
+			return true
+		}
+	}
+	return false
+}
+
+// String -
+func (o *Disjunction) String() string {
+	return join(o.Children, "or")
+}
+
+// TupleFilters - Converts the expression into the tuple filters that must be queried to find every matching tuple.
+// Conditions that cannot be expressed by a tuple filter (negations) are left to Matches,
+// so results read with these filters must still be checked against the expression.
+func TupleFilters(expression Expression) []*base.TupleFilter {
+	var filters []*base.TupleFilter
+	for _, conjunction := range disjunctiveNormalForm(expression) {
+		filters = append(filters, conjunctionToTupleFilters(conjunction)...)
+	}
+	return filters
+}
+
+// disjunctiveNormalForm - Flattens the expression into an OR of AND'ed conditions
+func disjunctiveNormalForm(expression Expression) [][]*Condition {
+	switch e := expression.(type) {
+	case *Condition:
+		return [][]*Condition{{e}}
+	case *Disjunction:
+		var result [][]*Condition
+		for _, child := range e.Children {
+			result = append(result, disjunctiveNormalForm(child)...)
+		}
+		return result
+	case *Conjunction:
+		result := [][]*Condition{{}}
+		for _, child := range e.Children {
+			var next [][]*Condition
+			for _, left := range result {
+				for _, right := range disjunctiveNormalForm(child) {
+					conjunction := make([]*Condition, 0, len(left)+len(right))
+					conjunction = append(conjunction, left...)
+					conjunction = append(conjunction, right...)
+					next = append(next, conjunction)
+				}
+			}
+			result = next
+		}
+		return result
+	default:
+		return nil
+	}
+}
+
+// conjunctionToTupleFilters - Converts AND'ed conditions to tuple filters
+func conjunctionToTupleFilters(conditions []*Condition) []*base.TupleFilter {
+	allowed := map[Field][]string{}
+	for _, condition := range conditions {
+		if condition.Operator != EQ && condition.Operator != IN {
+			continue
+		}
+		if values, ok := allowed[condition.Field]; ok {
+			allowed[condition.Field] = intersect(values, condition.Values)
+		} else {
+			allowed[condition.Field] = condition.Values
+		}
+		if len(allowed[condition.Field]) == 0 {
+			return nil
+		}
+	}
+	
+	filters := []*base.TupleFilter{{
+		Entity:  &base.EntityFilter{Ids: allowed[ENTITY_ID]},
+		Subject: &base.SubjectFilter{Ids: allowed[SUBJECT_ID]},
+	}}
+	
+	// single valued fields of the tuple filter are expanded into one filter per value
+	for _, field := range []Field{ENTITY_TYPE, RELATION, SUBJECT_TYPE, SUBJECT_RELATION} {
+		values, ok := allowed[field]
+		if !ok {
+			continue
+		}
+		next := make([]*base.TupleFilter, 0, len(filters)*len(values))
+		for _, f := range filters {
+			for _, value := range values {
+				next = append(next, withField(f, field, value))
+			}
+		}
+		filters = next
+	}
+	
+	return filters
+}
+
+// withField - Copies the tuple filter and sets the given single valued field
+func withField(f *base.TupleFilter, field Field, value string) *base.TupleFilter {
+	filter := &base.TupleFilter{
+		Entity: &base.EntityFilter{
+			Type: f.GetEntity().GetType(),
+			Ids:  f.GetEntity().GetIds(),
+		},
+		Relation: f.GetRelation(),
+		Subject: &base.SubjectFilter{
+			Type:     f.GetSubject().GetType(),
+			Ids:      f.GetSubject().GetIds(),
+			Relation: f.GetSubject().GetRelation(),
+		},
+	}
+	switch field {
+	case ENTITY_TYPE:
+		filter.Entity.Type = value
+	case RELATION:
+		filter.Relation = value
+	case SUBJECT_TYPE:
+		filter.Subject.Type = value
+	case SUBJECT_RELATION:
+		filter.Subject.Relation = value
+	}
+	return filter
+}
+
+// fieldValue - Gets the value of the field from the tuple
+func fieldValue(tup *base.Tuple, field Field) string {
+	switch field {
+	case ENTITY_TYPE:
+		return tup.GetEntity().GetType()
+	case ENTITY_ID:
+		return tup.GetEntity().GetId()
+	case RELATION:
+		return tup.GetRelation()
+	case SUBJECT_TYPE:
+		return tup.GetSubject().GetType()
+	case SUBJECT_ID:
+		return tup.GetSubject().GetId()
+	case SUBJECT_RELATION:
+		return tup.GetSubject().GetRelation()
+	default:
+		return ""
+	}
+}
+
+// intersect -
+func intersect(a, b []string) []string {
+	var result []string
+	for _, v := range a {
+		if slices.Contains(b, v) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// join -
+func join(children []Expression, op string) string {
+	var sb strings.Builder
+	sb.WriteString("(")
+	for i, child := range children {
+		if i > 0 {
+			sb.WriteString(" ")
+			sb.WriteString(op)
+			sb.WriteString(" ")
+		}
+		sb.WriteString(child.String())
+	}
+	sb.WriteString(")")
+	return sb.String()
+}
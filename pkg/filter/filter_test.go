@@ -0,0 +1,125 @@
+package filter
+
+import (
+	"errors"
+	"testing"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+// TestFilter -
+func TestFilter(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "filter-suite")
+}
+
+var _ = Describe("filter", func() {
+	Context("Parse", func() {
+		It("Case 1: Success", func() {
+			tests := []struct {
+				target   string
+				expected string
+			}{
+				{"relation = owner", "relation = owner"},
+				{"relation in (owner, editor) AND subject_type = user", "(relation in (owner, editor) and subject_type = user)"},
+				{"entity_type = doc and (relation = owner or subject_id != '1')", "(entity_type = doc and (relation = owner or subject_id != 1))"},
+				{"subject_type not in (team,organization)", "subject_type not in (team, organization)"},
+			}
+			
+			for _, tt := range tests {
+				expression, err := Parse(tt.target)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(expression.String()).Should(Equal(tt.expected))
+			}
+		})
+		
+		It("Case 2: Fail", func() {
+			tests := []struct {
+				target   string
+				expected error
+			}{
+				{"", ErrEmptyExpression},
+				{"relation", ErrInvalidExpression},
+				{"owner = relation", ErrInvalidExpression},
+				{"relation in (owner", ErrInvalidExpression},
+				{"(relation = owner", ErrInvalidExpression},
+				{"relation = owner subject_type = user", ErrInvalidExpression},
+			}
+			
+			for _, tt := range tests {
+				_, err := Parse(tt.target)
+				Expect(errors.Is(err, tt.expected)).Should(BeTrue())
+			}
+		})
+	})
+	
+	Context("Matches", func() {
+		It("Case 1", func() {
+			expression, err := Parse("relation in (owner, editor) and subject_type = user")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			tests := []struct {
+				target   string
+				expected bool
+			}{
+				{"doc:1#owner@user:1", true},
+				{"doc:1#editor@user:1", true},
+				{"doc:1#viewer@user:1", false},
+				{"doc:1#owner@team:1#member", false},
+			}
+			
+			for _, tt := range tests {
+				tup, err := tuple.Tuple(tt.target)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(expression.Matches(tup)).Should(Equal(tt.expected))
+			}
+		})
+	})
+	
+	Context("TupleFilters", func() {
+		It("Case 1", func() {
+			expression, err := Parse("entity_type = doc and relation in (owner, editor) and subject_id in (1, 2)")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			Expect(TupleFilters(expression)).Should(Equal([]*base.TupleFilter{
+				{
+					Entity:   &base.EntityFilter{Type: "doc"},
+					Relation: "owner",
+					Subject:  &base.SubjectFilter{Ids: []string{"1", "2"}},
+				},
+				{
+					Entity:   &base.EntityFilter{Type: "doc"},
+					Relation: "editor",
+					Subject:  &base.SubjectFilter{Ids: []string{"1", "2"}},
+				},
+			}))
+		})
+		
+		It("Case 2", func() {
+			expression, err := Parse("(entity_type = doc and relation = owner) or (entity_type = folder and relation != owner)")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			Expect(TupleFilters(expression)).Should(Equal([]*base.TupleFilter{
+				{
+					Entity:   &base.EntityFilter{Type: "doc"},
+					Relation: "owner",
+					Subject:  &base.SubjectFilter{},
+				},
+				{
+					Entity:  &base.EntityFilter{Type: "folder"},
+					Subject: &base.SubjectFilter{},
+				},
+			}))
+		})
+		
+		It("Case 3", func() {
+			expression, err := Parse("relation = owner and relation = editor")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(TupleFilters(expression)).Should(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,252 @@
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+var (
+	ErrEmptyExpression   = errors.New("empty filter expression")
+	ErrInvalidExpression = errors.New("invalid filter expression")
+)
+
+// fields - Fields that can be used in filter expressions
+var fields = map[string]Field{
+	ENTITY_TYPE.String():      ENTITY_TYPE,
+	ENTITY_ID.String():        ENTITY_ID,
+	RELATION.String():         RELATION,
+	SUBJECT_TYPE.String():     SUBJECT_TYPE,
+	SUBJECT_ID.String():       SUBJECT_ID,
+	SUBJECT_RELATION.String(): SUBJECT_RELATION,
+}
+
+// Parse - Parses a filter expression
+// sample expression: relation in (owner, editor) and subject_type = user
+func Parse(input string) (Expression, error) {
+	p := &parser{tokens: tokenize(input)}
+	if len(p.tokens) == 0 {
+		return nil, ErrEmptyExpression
+	}
+	expression, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, p.errorf("unexpected %q", p.peek())
+	}
+	return expression, nil
+}
+
+// parser - Recursive descent parser for filter expressions
+type parser struct {
+	tokens   []string
+	position int
+}
+
+// parseOr - or has the lowest precedence
+func (p *parser) parseOr() (Expression, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	children := []Expression{left}
+	for p.acceptKeyword("or") {
+		var right Expression
+		right, err = p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, right)
+	}
+	if len(children) == 1 {
+		return left, nil
+	}
+	return &Disjunction{Children: children}, nil
+}
+
+// parseAnd -
+func (p *parser) parseAnd() (Expression, error) {
+	left, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	children := []Expression{left}
+	for p.acceptKeyword("and") {
+		var right Expression
+		right, err = p.parsePrimary()
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, right)
+	}
+	if len(children) == 1 {
+		return left, nil
+	}
+	return &Conjunction{Children: children}, nil
+}
+
+// parsePrimary - parenthesized expression or condition
+func (p *parser) parsePrimary() (Expression, error) {
+	if p.accept("(") {
+		expression, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(")") {
+			return nil, p.errorf("expected %q", ")")
+		}
+		return expression, nil
+	}
+	return p.parseCondition()
+}
+
+// parseCondition - field operator value(s)
+func (p *parser) parseCondition() (Expression, error) {
+	if p.done() {
+		return nil, p.errorf("expected field")
+	}
+	field, ok := fields[strings.ToLower(p.next())]
+	if !ok {
+		return nil, p.errorf("unknown field %q", p.tokens[p.position-1])
+	}
+	
+	switch {
+	case p.accept("="):
+		return p.single(field, EQ)
+	case p.accept("!="):
+		return p.single(field, NEQ)
+	case p.acceptKeyword("in"):
+		return p.list(field, IN)
+	case p.acceptKeyword("not"):
+		if !p.acceptKeyword("in") {
+			return nil, p.errorf("expected %q", "in")
+		}
+		return p.list(field, NOT_IN)
+	default:
+		return nil, p.errorf("expected operator")
+	}
+}
+
+// single -
+func (p *parser) single(field Field, operator Operator) (Expression, error) {
+	value, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+	return &Condition{Field: field, Operator: operator, Values: []string{value}}, nil
+}
+
+// list -
+func (p *parser) list(field Field, operator Operator) (Expression, error) {
+	if !p.accept("(") {
+		return nil, p.errorf("expected %q", "(")
+	}
+	var values []string
+	for {
+		value, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+		if p.accept(")") {
+			break
+		}
+		if !p.accept(",") {
+			return nil, p.errorf("expected %q or %q", ",", ")")
+		}
+	}
+	return &Condition{Field: field, Operator: operator, Values: values}, nil
+}
+
+// value -
+func (p *parser) value() (string, error) {
+	if p.done() || isPunctuation(p.peek()) {
+		return "", p.errorf("expected value")
+	}
+	return strings.Trim(p.next(), `"'`), nil
+}
+
+// accept -
+func (p *parser) accept(tok string) bool {
+	if !p.done() && p.peek() == tok {
+		p.position++
+		return true
+	}
+	return false
+}
+
+// acceptKeyword - keywords are case-insensitive
+func (p *parser) acceptKeyword(keyword string) bool {
+	if !p.done() && strings.EqualFold(p.peek(), keyword) {
+		p.position++
+		return true
+	}
+	return false
+}
+
+// peek -
+func (p *parser) peek() string {
+	return p.tokens[p.position]
+}
+
+// next -
+func (p *parser) next() string {
+	tok := p.tokens[p.position]
+	p.position++
+	return tok
+}
+
+// done -
+func (p *parser) done() bool {
+	return p.position >= len(p.tokens)
+}
+
+// errorf -
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s at token %d", ErrInvalidExpression, fmt.Sprintf(format, args...), p.position+1)
+}
+
+// tokenize - Splits the input into words, quoted values and punctuation
+func tokenize(input string) (tokens []string) {
+	var current strings.Builder
+	flush := func() {
+		if current.Len() > 0 {
+			tokens = append(tokens, current.String())
+			current.Reset()
+		}
+	}
+	for i := 0; i < len(input); i++ {
+		ch := rune(input[i])
+		switch {
+		case ch == '"' || ch == '\'':
+			flush()
+			end := strings.IndexRune(input[i+1:], ch)
+			if end < 0 {
+				tokens = append(tokens, input[i:])
+				return
+			}
+			tokens = append(tokens, input[i:i+end+2])
+			i += end + 1
+		case unicode.IsSpace(ch):
+			flush()
+		case ch == '!' && i+1 < len(input) && input[i+1] == '=':
+			flush()
+			tokens = append(tokens, "!=")
+			i++
+		case isPunctuation(string(ch)):
+			flush()
+			tokens = append(tokens, string(ch))
+		default:
+			current.WriteRune(ch)
+		}
+	}
+	flush()
+	return
+}
+
+// isPunctuation -
+func isPunctuation(tok string) bool {
+	return tok == "(" || tok == ")" || tok == "," || tok == "=" || tok == "!="
+}
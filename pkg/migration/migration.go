@@ -0,0 +1,181 @@
+package migration
+
+import (
+	"errors"
+	"fmt"
+	
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// Spec - Declarative description of the tuple rewrites that accompany a schema change
+//
+//	rules:
+//	  - entity_type: repository
+//	    relation: maintainer
+//	    to: [admin]
+//	  - entity_type: doc
+//	    relation: editor
+//	    to: [writer, commenter]
+//	    schema_versions: [cf6vvq3s8at6f0hqc2k0]
+type Spec struct {
+	Rules []Rule `yaml:"rules"`
+}
+
+// Rule - Moves the tuples of a relation to one (rename) or more (split) relations
+type Rule struct {
+	EntityType string   `yaml:"entity_type"`
+	Relation   string   `yaml:"relation"`
+	To         []string `yaml:"to"`
+	// Keep - copy the tuples instead of moving them
+	Keep bool `yaml:"keep"`
+	// SchemaVersions - only rewrite the tuples written under these schema versions, all of them when empty
+	SchemaVersions []string `yaml:"schema_versions"`
+}
+
+// SpecFromRules - Spec of the rules of a migration request
+func SpecFromRules(rules []*base.RelationshipMigrationRule) Spec {
+	spec := Spec{Rules: make([]Rule, 0, len(rules))}
+	for _, rule := range rules {
+		spec.Rules = append(spec.Rules, Rule{
+			EntityType:     rule.GetEntityType(),
+			Relation:       rule.GetRelation(),
+			To:             rule.GetTo(),
+			Keep:           rule.GetKeep(),
+			SchemaVersions: rule.GetSchemaVersions(),
+		})
+	}
+	return spec
+}
+
+// ToRules - Rules of a migration request of the spec
+func (s Spec) ToRules() []*base.RelationshipMigrationRule {
+	rules := make([]*base.RelationshipMigrationRule, 0, len(s.Rules))
+	for _, rule := range s.Rules {
+		rules = append(rules, &base.RelationshipMigrationRule{
+			EntityType:     rule.EntityType,
+			Relation:       rule.Relation,
+			To:             rule.To,
+			Keep:           rule.Keep,
+			SchemaVersions: rule.SchemaVersions,
+		})
+	}
+	return rules
+}
+
+// Validate - Validates the migration spec
+func (s Spec) Validate() error {
+	if len(s.Rules) == 0 {
+		return errors.New("migration spec must have at least one rule")
+	}
+	seen := map[string]struct{}{}
+	for i, rule := range s.Rules {
+		if rule.EntityType == "" || rule.Relation == "" {
+			return fmt.Errorf("rule %d: entity_type and relation are required", i)
+		}
+		if len(rule.To) == 0 {
+			return fmt.Errorf("rule %d: at least one target relation is required", i)
+		}
+		for _, to := range rule.To {
+			if to == "" || to == rule.Relation {
+				return fmt.Errorf("rule %d: invalid target relation %q", i, to)
+			}
+		}
+		key := rule.EntityType + "#" + rule.Relation
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("rule %d: duplicated rule for %s", i, key)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
+
+// Filter - Tuple filter that selects the tuples the rule applies to
+func (r Rule) Filter() *base.TupleFilter {
+	return &base.TupleFilter{
+		Entity:   &base.EntityFilter{Type: r.EntityType},
+		Relation: r.Relation,
+	}
+}
+
+// AppliesTo - The rule rewrites the tuples written under the schema version
+func (r Rule) AppliesTo(version string) bool {
+	if len(r.SchemaVersions) == 0 {
+		return true
+	}
+	for _, v := range r.SchemaVersions {
+		if v == version {
+			return true
+		}
+	}
+	return false
+}
+
+// Rewrite - Creates the rewrite of the given tuple
+func (r Rule) Rewrite(tup *base.Tuple) Rewrite {
+	rewrite := Rewrite{Old: tup, Delete: !r.Keep}
+	for _, to := range r.To {
+		rewrite.New = append(rewrite.New, &base.Tuple{
+			Entity:   tup.GetEntity(),
+			Relation: to,
+			Subject:  tup.GetSubject(),
+		})
+	}
+	return rewrite
+}
+
+// Rewrite - Transformation of a single tuple
+type Rewrite struct {
+	Old    *base.Tuple
+	New    []*base.Tuple
+	Delete bool
+}
+
+// Plan - Ordered rewrites generated from a spec
+type Plan struct {
+	Rewrites []Rewrite
+}
+
+// Add - Add rewrite to plan
+func (p *Plan) Add(rewrite Rewrite) {
+	p.Rewrites = append(p.Rewrites, rewrite)
+}
+
+// Len - Number of rewrites in the plan
+func (p *Plan) Len() int {
+	return len(p.Rewrites)
+}
+
+// ToRewrites - Rewrites of a migration response of the plan
+func (p *Plan) ToRewrites() []*base.RelationshipMigrationRewrite {
+	rewrites := make([]*base.RelationshipMigrationRewrite, 0, len(p.Rewrites))
+	for _, rewrite := range p.Rewrites {
+		rewrites = append(rewrites, &base.RelationshipMigrationRewrite{
+			Old:    rewrite.Old,
+			New:    rewrite.New,
+			Delete: rewrite.Delete,
+		})
+	}
+	return rewrites
+}
+
+// Batches - Splits the plan into batches whose new tuples do not exceed the given size.
+// A rewrite is never split across batches.
+func (p *Plan) Batches(size int) (batches [][]Rewrite) {
+	var current []Rewrite
+	count := 0
+	for _, rewrite := range p.Rewrites {
+		if len(current) > 0 && count+len(rewrite.New) > size {
+			batches = append(batches, current)
+			current, count = nil, 0
+		}
+		current = append(current, rewrite)
+		count += len(rewrite.New)
+	}
+	if len(current) > 0 {
+		batches = append(batches, current)
+	}
+	return batches
+}
+
+// ProgressFunc - Called after each applied batch with the number of applied and total rewrites
+type ProgressFunc func(applied, total int)
@@ -0,0 +1,114 @@
+package migration
+
+import (
+	"testing"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/tuple"
+)
+
+// TestMigration -
+func TestMigration(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "migration-suite")
+}
+
+var _ = Describe("migration", func() {
+	Context("Validate", func() {
+		It("Case 1: Success", func() {
+			spec := Spec{Rules: []Rule{
+				{EntityType: "repository", Relation: "maintainer", To: []string{"admin"}},
+				{EntityType: "doc", Relation: "editor", To: []string{"writer", "commenter"}},
+			}}
+			Expect(spec.Validate()).ShouldNot(HaveOccurred())
+		})
+		
+		It("Case 2: Fail", func() {
+			tests := []Spec{
+				{},
+				{Rules: []Rule{{EntityType: "doc", To: []string{"writer"}}}},
+				{Rules: []Rule{{EntityType: "doc", Relation: "editor"}}},
+				{Rules: []Rule{{EntityType: "doc", Relation: "editor", To: []string{"editor"}}}},
+				{Rules: []Rule{
+					{EntityType: "doc", Relation: "editor", To: []string{"writer"}},
+					{EntityType: "doc", Relation: "editor", To: []string{"commenter"}},
+				}},
+			}
+			
+			for _, spec := range tests {
+				Expect(spec.Validate()).Should(HaveOccurred())
+			}
+		})
+	})
+	
+	Context("Rules", func() {
+		It("Case 1", func() {
+			spec := Spec{Rules: []Rule{
+				{EntityType: "repository", Relation: "maintainer", To: []string{"admin"}},
+				{EntityType: "doc", Relation: "editor", To: []string{"writer", "commenter"}, Keep: true, SchemaVersions: []string{"v1"}},
+			}}
+			Expect(SpecFromRules(spec.ToRules())).Should(Equal(spec))
+		})
+	})
+	
+	Context("Rewrite", func() {
+		It("Case 1: Split", func() {
+			tup, err := tuple.Tuple("doc:1#editor@user:1")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			rewrite := Rule{EntityType: "doc", Relation: "editor", To: []string{"writer", "commenter"}}.Rewrite(tup)
+			Expect(rewrite.Delete).Should(BeTrue())
+			
+			var news []string
+			for _, t := range rewrite.New {
+				news = append(news, tuple.ToString(t))
+			}
+			Expect(news).Should(Equal([]string{"doc:1#writer@user:1", "doc:1#commenter@user:1"}))
+		})
+		
+		It("Case 2: Keep", func() {
+			tup, err := tuple.Tuple("doc:1#editor@user:1")
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			rewrite := Rule{EntityType: "doc", Relation: "editor", To: []string{"writer"}, Keep: true}.Rewrite(tup)
+			Expect(rewrite.Delete).Should(BeFalse())
+			Expect(rewrite.New).Should(HaveLen(1))
+		})
+	})
+	
+	Context("AppliesTo", func() {
+		It("Case 1", func() {
+			rule := Rule{EntityType: "doc", Relation: "editor", To: []string{"writer"}}
+			Expect(rule.AppliesTo("v1")).Should(BeTrue())
+			Expect(rule.AppliesTo("")).Should(BeTrue())
+			
+			rule.SchemaVersions = []string{"v1", "v2"}
+			Expect(rule.AppliesTo("v1")).Should(BeTrue())
+			Expect(rule.AppliesTo("v3")).Should(BeFalse())
+			Expect(rule.AppliesTo("")).Should(BeFalse())
+		})
+	})
+	
+	Context("Batches", func() {
+		It("Case 1", func() {
+			plan := &Plan{}
+			rule := Rule{EntityType: "doc", Relation: "editor", To: []string{"writer", "commenter"}}
+			for i := 0; i < 5; i++ {
+				plan.Add(rule.Rewrite(&base.Tuple{
+					Entity:   &base.Entity{Type: "doc", Id: "1"},
+					Relation: "editor",
+					Subject:  &base.Subject{Type: tuple.USER, Id: "1"},
+				}))
+			}
+			
+			batches := plan.Batches(4)
+			Expect(batches).Should(HaveLen(3))
+			Expect(batches[0]).Should(HaveLen(2))
+			Expect(batches[1]).Should(HaveLen(2))
+			Expect(batches[2]).Should(HaveLen(1))
+		})
+	})
+})
@@ -59,17 +59,21 @@ const (
 	ErrorCode_ERROR_CODE_RECORD_NOT_FOUND              ErrorCode = 4008
 	ErrorCode_ERROR_CODE_TENANT_NOT_FOUND              ErrorCode = 4009
 	ErrorCode_ERROR_CODE_INVALID_CONTINUOUS_TOKEN      ErrorCode = 4010
+	ErrorCode_ERROR_CODE_SNAPSHOT_EXPIRED              ErrorCode = 4011
+	ErrorCode_ERROR_CODE_SNAPSHOT_NOT_REPLICATED       ErrorCode = 4012
 	// internal
-	ErrorCode_ERROR_CODE_INTERNAL          ErrorCode = 5000
-	ErrorCode_ERROR_CODE_CANCELLED         ErrorCode = 5001
-	ErrorCode_ERROR_CODE_SQL_BUILDER       ErrorCode = 5002
-	ErrorCode_ERROR_CODE_CIRCUIT_BREAKER   ErrorCode = 5003
-	ErrorCode_ERROR_CODE_EXECUTION         ErrorCode = 5005
-	ErrorCode_ERROR_CODE_SCAN              ErrorCode = 5006
-	ErrorCode_ERROR_CODE_MIGRATION         ErrorCode = 5007
-	ErrorCode_ERROR_CODE_TYPE_CONVERSATION ErrorCode = 5008
-	ErrorCode_ERROR_CODE_ERROR_MAX_RETRIES ErrorCode = 5009
-	ErrorCode_ERROR_CODE_ROLLBACK          ErrorCode = 5010
+	ErrorCode_ERROR_CODE_INTERNAL              ErrorCode = 5000
+	ErrorCode_ERROR_CODE_CANCELLED             ErrorCode = 5001
+	ErrorCode_ERROR_CODE_SQL_BUILDER           ErrorCode = 5002
+	ErrorCode_ERROR_CODE_CIRCUIT_BREAKER       ErrorCode = 5003
+	ErrorCode_ERROR_CODE_EXECUTION             ErrorCode = 5005
+	ErrorCode_ERROR_CODE_SCAN                  ErrorCode = 5006
+	ErrorCode_ERROR_CODE_MIGRATION             ErrorCode = 5007
+	ErrorCode_ERROR_CODE_TYPE_CONVERSATION     ErrorCode = 5008
+	ErrorCode_ERROR_CODE_ERROR_MAX_RETRIES     ErrorCode = 5009
+	ErrorCode_ERROR_CODE_ROLLBACK              ErrorCode = 5010
+	ErrorCode_ERROR_CODE_MEMORY_LIMIT_EXCEEDED ErrorCode = 5011
+	ErrorCode_ERROR_CODE_NOT_IMPLEMENTED       ErrorCode = 5012
 )
 
 // Enum value maps for ErrorCode.
@@ -108,6 +112,8 @@ var (
 		4008: "ERROR_CODE_RECORD_NOT_FOUND",
 		4009: "ERROR_CODE_TENANT_NOT_FOUND",
 		4010: "ERROR_CODE_INVALID_CONTINUOUS_TOKEN",
+		4011: "ERROR_CODE_SNAPSHOT_EXPIRED",
+		4012: "ERROR_CODE_SNAPSHOT_NOT_REPLICATED",
 		5000: "ERROR_CODE_INTERNAL",
 		5001: "ERROR_CODE_CANCELLED",
 		5002: "ERROR_CODE_SQL_BUILDER",
@@ -118,6 +124,8 @@ var (
 		5008: "ERROR_CODE_TYPE_CONVERSATION",
 		5009: "ERROR_CODE_ERROR_MAX_RETRIES",
 		5010: "ERROR_CODE_ROLLBACK",
+		5011: "ERROR_CODE_MEMORY_LIMIT_EXCEEDED",
+		5012: "ERROR_CODE_NOT_IMPLEMENTED",
 	}
 	ErrorCode_value = map[string]int32{
 		"ERROR_CODE_UNSPECIFIED":                                       0,
@@ -153,6 +161,8 @@ var (
 		"ERROR_CODE_RECORD_NOT_FOUND":                                  4008,
 		"ERROR_CODE_TENANT_NOT_FOUND":                                  4009,
 		"ERROR_CODE_INVALID_CONTINUOUS_TOKEN":                          4010,
+		"ERROR_CODE_SNAPSHOT_EXPIRED":                                  4011,
+		"ERROR_CODE_SNAPSHOT_NOT_REPLICATED":                           4012,
 		"ERROR_CODE_INTERNAL":                                          5000,
 		"ERROR_CODE_CANCELLED":                                         5001,
 		"ERROR_CODE_SQL_BUILDER":                                       5002,
@@ -163,6 +173,8 @@ var (
 		"ERROR_CODE_TYPE_CONVERSATION":                                 5008,
 		"ERROR_CODE_ERROR_MAX_RETRIES":                                 5009,
 		"ERROR_CODE_ROLLBACK":                                          5010,
+		"ERROR_CODE_MEMORY_LIMIT_EXCEEDED":                             5011,
+		"ERROR_CODE_NOT_IMPLEMENTED":                                   5012,
 	}
 )
 
@@ -259,7 +271,7 @@ var file_base_v1_errors_proto_rawDesc = []byte{
 	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
 	0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
 	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x2a, 0xf9, 0x0c, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x67, 0x65, 0x2a, 0x8c, 0x0e, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
 	0x12, 0x1a, 0x0a, 0x16, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55,
 	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x1f,
 	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49,
@@ -345,34 +357,43 @@ var file_base_v1_errors_proto_rawDesc = []byte{
 	0x4e, 0x44, 0x10, 0xa9, 0x1f, 0x12, 0x28, 0x0a, 0x23, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43,
 	0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x54,
 	0x49, 0x4e, 0x55, 0x4f, 0x55, 0x53, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0xaa, 0x1f, 0x12,
-	0x18, 0x0a, 0x13, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e,
-	0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10, 0x88, 0x27, 0x12, 0x19, 0x0a, 0x14, 0x45, 0x52, 0x52,
-	0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45,
-	0x44, 0x10, 0x89, 0x27, 0x12, 0x1b, 0x0a, 0x16, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f,
-	0x44, 0x45, 0x5f, 0x53, 0x51, 0x4c, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x45, 0x52, 0x10, 0x8a,
-	0x27, 0x12, 0x1f, 0x0a, 0x1a, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f,
-	0x43, 0x49, 0x52, 0x43, 0x55, 0x49, 0x54, 0x5f, 0x42, 0x52, 0x45, 0x41, 0x4b, 0x45, 0x52, 0x10,
-	0x8b, 0x27, 0x12, 0x19, 0x0a, 0x14, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45,
-	0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x8d, 0x27, 0x12, 0x14, 0x0a,
-	0x0f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x43, 0x41, 0x4e,
-	0x10, 0x8e, 0x27, 0x12, 0x19, 0x0a, 0x14, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44,
-	0x45, 0x5f, 0x4d, 0x49, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x8f, 0x27, 0x12, 0x21,
-	0x0a, 0x1c, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x53, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x90,
-	0x27, 0x12, 0x21, 0x0a, 0x1c, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f,
-	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x4d, 0x41, 0x58, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x49, 0x45,
-	0x53, 0x10, 0x91, 0x27, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f,
-	0x44, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x92, 0x27, 0x42, 0x89,
-	0x01, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0b,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x66,
-	0x79, 0x2f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x66, 0x79, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62,
-	0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x62, 0x61, 0x73, 0x65, 0x76, 0x31, 0xa2,
-	0x02, 0x03, 0x42, 0x58, 0x58, 0xaa, 0x02, 0x07, 0x42, 0x61, 0x73, 0x65, 0x2e, 0x56, 0x31, 0xca,
-	0x02, 0x07, 0x42, 0x61, 0x73, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x13, 0x42, 0x61, 0x73, 0x65,
-	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x08, 0x42, 0x61, 0x73, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x20, 0x0a, 0x1b, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x4e,
+	0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0xab,
+	0x1f, 0x12, 0x27, 0x0a, 0x22, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f,
+	0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x50,
+	0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x44, 0x10, 0xac, 0x1f, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41,
+	0x4c, 0x10, 0x88, 0x27, 0x12, 0x19, 0x0a, 0x14, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f,
+	0x44, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x89, 0x27, 0x12,
+	0x1b, 0x0a, 0x16, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x51,
+	0x4c, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x45, 0x52, 0x10, 0x8a, 0x27, 0x12, 0x1f, 0x0a, 0x1a,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x49, 0x52, 0x43, 0x55,
+	0x49, 0x54, 0x5f, 0x42, 0x52, 0x45, 0x41, 0x4b, 0x45, 0x52, 0x10, 0x8b, 0x27, 0x12, 0x19, 0x0a,
+	0x14, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x45, 0x58, 0x45, 0x43,
+	0x55, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x8d, 0x27, 0x12, 0x14, 0x0a, 0x0f, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x8e, 0x27, 0x12, 0x19,
+	0x0a, 0x14, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x49, 0x47,
+	0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x8f, 0x27, 0x12, 0x21, 0x0a, 0x1c, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4e,
+	0x56, 0x45, 0x52, 0x53, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x90, 0x27, 0x12, 0x21, 0x0a, 0x1c,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x5f, 0x4d, 0x41, 0x58, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x49, 0x45, 0x53, 0x10, 0x91, 0x27, 0x12,
+	0x18, 0x0a, 0x13, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x4f,
+	0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x92, 0x27, 0x12, 0x25, 0x0a, 0x20, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x4c,
+	0x49, 0x4d, 0x49, 0x54, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x93, 0x27,
+	0x12, 0x1f, 0x0a, 0x1a, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x49, 0x4d, 0x50, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x45, 0x44, 0x10, 0x94,
+	0x27, 0x42, 0x89, 0x01, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x42, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x66, 0x79, 0x2f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x66, 0x79, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x70, 0x62, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x62, 0x61, 0x73, 0x65,
+	0x76, 0x31, 0xa2, 0x02, 0x03, 0x42, 0x58, 0x58, 0xaa, 0x02, 0x07, 0x42, 0x61, 0x73, 0x65, 0x2e,
+	0x56, 0x31, 0xca, 0x02, 0x07, 0x42, 0x61, 0x73, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x13, 0x42,
+	0x61, 0x73, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x08, 0x42, 0x61, 0x73, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
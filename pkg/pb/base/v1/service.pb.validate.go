@@ -221,6 +221,35 @@ func (m *PermissionCheckRequest) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetContext()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionCheckRequestValidationError{
+					field:  "Context",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionCheckRequestValidationError{
+					field:  "Context",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContext()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionCheckRequestValidationError{
+				field:  "Context",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return PermissionCheckRequestMultiError(errors)
 	}
@@ -344,6 +373,8 @@ func (m *PermissionCheckRequestMetadata) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Consistency
+
 	if len(errors) > 0 {
 		return PermissionCheckRequestMetadataMultiError(errors)
 	}
@@ -582,6 +613,8 @@ func (m *PermissionCheckResponseMetadata) validate(all bool) error {
 
 	// no validation rules for CheckCount
 
+	// no validation rules for RemainingDepth
+
 	if len(errors) > 0 {
 		return PermissionCheckResponseMetadataMultiError(errors)
 	}
@@ -663,22 +696,23 @@ var _ interface {
 	ErrorName() string
 } = PermissionCheckResponseMetadataValidationError{}
 
-// Validate checks the field values on PermissionExpandRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PermissionExpandRequest) Validate() error {
+// Validate checks the field values on PermissionBulkCheckRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *PermissionBulkCheckRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PermissionExpandRequest with the
+// ValidateAll checks the field values on PermissionBulkCheckRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PermissionExpandRequestMultiError, or nil if none found.
-func (m *PermissionExpandRequest) ValidateAll() error {
+// PermissionBulkCheckRequestMultiError, or nil if none found.
+func (m *PermissionBulkCheckRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PermissionExpandRequest) validate(all bool) error {
+func (m *PermissionBulkCheckRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -686,7 +720,7 @@ func (m *PermissionExpandRequest) validate(all bool) error {
 	var errors []error
 
 	if len(m.GetTenantId()) > 64 {
-		err := PermissionExpandRequestValidationError{
+		err := PermissionBulkCheckRequestValidationError{
 			field:  "TenantId",
 			reason: "value length must be at most 64 bytes",
 		}
@@ -696,8 +730,8 @@ func (m *PermissionExpandRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_PermissionExpandRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := PermissionExpandRequestValidationError{
+	if !_PermissionBulkCheckRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := PermissionBulkCheckRequestValidationError{
 			field:  "TenantId",
 			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
 		}
@@ -707,22 +741,11 @@ func (m *PermissionExpandRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetMetadata() == nil {
-		err := PermissionExpandRequestValidationError{
-			field:  "Metadata",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if all {
 		switch v := interface{}(m.GetMetadata()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PermissionExpandRequestValidationError{
+				errors = append(errors, PermissionBulkCheckRequestValidationError{
 					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -730,7 +753,7 @@ func (m *PermissionExpandRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PermissionExpandRequestValidationError{
+				errors = append(errors, PermissionBulkCheckRequestValidationError{
 					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -739,7 +762,7 @@ func (m *PermissionExpandRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PermissionExpandRequestValidationError{
+			return PermissionBulkCheckRequestValidationError{
 				field:  "Metadata",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -747,86 +770,27 @@ func (m *PermissionExpandRequest) validate(all bool) error {
 		}
 	}
 
-	if m.GetEntity() == nil {
-		err := PermissionExpandRequestValidationError{
-			field:  "Entity",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if all {
-		switch v := interface{}(m.GetEntity()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PermissionExpandRequestValidationError{
-					field:  "Entity",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, PermissionExpandRequestValidationError{
-					field:  "Entity",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetEntity()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PermissionExpandRequestValidationError{
-				field:  "Entity",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if m.GetPermission() != "" {
-
-		if len(m.GetPermission()) > 64 {
-			err := PermissionExpandRequestValidationError{
-				field:  "Permission",
-				reason: "value length must be at most 64 bytes",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
 
-		if !_PermissionExpandRequest_Permission_Pattern.MatchString(m.GetPermission()) {
-			err := PermissionExpandRequestValidationError{
-				field:  "Permission",
-				reason: "value does not match regex pattern \"^([a-z][a-z0-9_]{1,62}[a-z0-9])$\"",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+		// skipping validation for Items[idx]
 
 	}
 
 	if len(errors) > 0 {
-		return PermissionExpandRequestMultiError(errors)
+		return PermissionBulkCheckRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PermissionExpandRequestMultiError is an error wrapping multiple validation
-// errors returned by PermissionExpandRequest.ValidateAll() if the designated
-// constraints aren't met.
-type PermissionExpandRequestMultiError []error
+// PermissionBulkCheckRequestMultiError is an error wrapping multiple
+// validation errors returned by PermissionBulkCheckRequest.ValidateAll() if
+// the designated constraints aren't met.
+type PermissionBulkCheckRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PermissionExpandRequestMultiError) Error() string {
+func (m PermissionBulkCheckRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -835,11 +799,11 @@ func (m PermissionExpandRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PermissionExpandRequestMultiError) AllErrors() []error { return m }
+func (m PermissionBulkCheckRequestMultiError) AllErrors() []error { return m }
 
-// PermissionExpandRequestValidationError is the validation error returned by
-// PermissionExpandRequest.Validate if the designated constraints aren't met.
-type PermissionExpandRequestValidationError struct {
+// PermissionBulkCheckRequestValidationError is the validation error returned
+// by PermissionBulkCheckRequest.Validate if the designated constraints aren't met.
+type PermissionBulkCheckRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -847,24 +811,24 @@ type PermissionExpandRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PermissionExpandRequestValidationError) Field() string { return e.field }
+func (e PermissionBulkCheckRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PermissionExpandRequestValidationError) Reason() string { return e.reason }
+func (e PermissionBulkCheckRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PermissionExpandRequestValidationError) Cause() error { return e.cause }
+func (e PermissionBulkCheckRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PermissionExpandRequestValidationError) Key() bool { return e.key }
+func (e PermissionBulkCheckRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PermissionExpandRequestValidationError) ErrorName() string {
-	return "PermissionExpandRequestValidationError"
+func (e PermissionBulkCheckRequestValidationError) ErrorName() string {
+	return "PermissionBulkCheckRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PermissionExpandRequestValidationError) Error() string {
+func (e PermissionBulkCheckRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -876,14 +840,14 @@ func (e PermissionExpandRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPermissionExpandRequest.%s: %s%s",
+		"invalid %sPermissionBulkCheckRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PermissionExpandRequestValidationError{}
+var _ error = PermissionBulkCheckRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -891,52 +855,165 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PermissionExpandRequestValidationError{}
+} = PermissionBulkCheckRequestValidationError{}
 
-var _PermissionExpandRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
-
-var _PermissionExpandRequest_Permission_Pattern = regexp.MustCompile("^([a-z][a-z0-9_]{1,62}[a-z0-9])$")
+var _PermissionBulkCheckRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
 
-// Validate checks the field values on PermissionExpandRequestMetadata with the
+// Validate checks the field values on PermissionBulkCheckRequestItem with the
 // rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PermissionExpandRequestMetadata) Validate() error {
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *PermissionBulkCheckRequestItem) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PermissionExpandRequestMetadata with
+// ValidateAll checks the field values on PermissionBulkCheckRequestItem with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// PermissionExpandRequestMetadataMultiError, or nil if none found.
-func (m *PermissionExpandRequestMetadata) ValidateAll() error {
+// PermissionBulkCheckRequestItemMultiError, or nil if none found.
+func (m *PermissionBulkCheckRequestItem) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PermissionExpandRequestMetadata) validate(all bool) error {
+func (m *PermissionBulkCheckRequestItem) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SchemaVersion
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionBulkCheckRequestItemValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionBulkCheckRequestItemValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionBulkCheckRequestItemValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for SnapToken
+	if all {
+		switch v := interface{}(m.GetEntity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionBulkCheckRequestItemValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionBulkCheckRequestItemValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEntity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionBulkCheckRequestItemValidationError{
+				field:  "Entity",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Permission
+
+	if all {
+		switch v := interface{}(m.GetSubject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionBulkCheckRequestItemValidationError{
+					field:  "Subject",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionBulkCheckRequestItemValidationError{
+					field:  "Subject",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionBulkCheckRequestItemValidationError{
+				field:  "Subject",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetContext()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionBulkCheckRequestItemValidationError{
+					field:  "Context",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionBulkCheckRequestItemValidationError{
+					field:  "Context",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContext()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionBulkCheckRequestItemValidationError{
+				field:  "Context",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return PermissionExpandRequestMetadataMultiError(errors)
+		return PermissionBulkCheckRequestItemMultiError(errors)
 	}
 
 	return nil
 }
 
-// PermissionExpandRequestMetadataMultiError is an error wrapping multiple
-// validation errors returned by PermissionExpandRequestMetadata.ValidateAll()
+// PermissionBulkCheckRequestItemMultiError is an error wrapping multiple
+// validation errors returned by PermissionBulkCheckRequestItem.ValidateAll()
 // if the designated constraints aren't met.
-type PermissionExpandRequestMetadataMultiError []error
+type PermissionBulkCheckRequestItemMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PermissionExpandRequestMetadataMultiError) Error() string {
+func (m PermissionBulkCheckRequestItemMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -945,12 +1022,12 @@ func (m PermissionExpandRequestMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PermissionExpandRequestMetadataMultiError) AllErrors() []error { return m }
+func (m PermissionBulkCheckRequestItemMultiError) AllErrors() []error { return m }
 
-// PermissionExpandRequestMetadataValidationError is the validation error
-// returned by PermissionExpandRequestMetadata.Validate if the designated
+// PermissionBulkCheckRequestItemValidationError is the validation error
+// returned by PermissionBulkCheckRequestItem.Validate if the designated
 // constraints aren't met.
-type PermissionExpandRequestMetadataValidationError struct {
+type PermissionBulkCheckRequestItemValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -958,24 +1035,24 @@ type PermissionExpandRequestMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e PermissionExpandRequestMetadataValidationError) Field() string { return e.field }
+func (e PermissionBulkCheckRequestItemValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PermissionExpandRequestMetadataValidationError) Reason() string { return e.reason }
+func (e PermissionBulkCheckRequestItemValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PermissionExpandRequestMetadataValidationError) Cause() error { return e.cause }
+func (e PermissionBulkCheckRequestItemValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PermissionExpandRequestMetadataValidationError) Key() bool { return e.key }
+func (e PermissionBulkCheckRequestItemValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PermissionExpandRequestMetadataValidationError) ErrorName() string {
-	return "PermissionExpandRequestMetadataValidationError"
+func (e PermissionBulkCheckRequestItemValidationError) ErrorName() string {
+	return "PermissionBulkCheckRequestItemValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PermissionExpandRequestMetadataValidationError) Error() string {
+func (e PermissionBulkCheckRequestItemValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -987,14 +1064,14 @@ func (e PermissionExpandRequestMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPermissionExpandRequestMetadata.%s: %s%s",
+		"invalid %sPermissionBulkCheckRequestItem.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PermissionExpandRequestMetadataValidationError{}
+var _ error = PermissionBulkCheckRequestItemValidationError{}
 
 var _ interface {
 	Field() string
@@ -1002,73 +1079,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PermissionExpandRequestMetadataValidationError{}
+} = PermissionBulkCheckRequestItemValidationError{}
 
-// Validate checks the field values on PermissionExpandResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PermissionExpandResponse) Validate() error {
+// Validate checks the field values on PermissionBulkCheckResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *PermissionBulkCheckResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PermissionExpandResponse with the
+// ValidateAll checks the field values on PermissionBulkCheckResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PermissionExpandResponseMultiError, or nil if none found.
-func (m *PermissionExpandResponse) ValidateAll() error {
+// PermissionBulkCheckResponseMultiError, or nil if none found.
+func (m *PermissionBulkCheckResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PermissionExpandResponse) validate(all bool) error {
+func (m *PermissionBulkCheckResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetTree()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PermissionExpandResponseValidationError{
-					field:  "Tree",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PermissionBulkCheckResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PermissionBulkCheckResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PermissionExpandResponseValidationError{
-					field:  "Tree",
+				return PermissionBulkCheckResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTree()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PermissionExpandResponseValidationError{
-				field:  "Tree",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return PermissionExpandResponseMultiError(errors)
+		return PermissionBulkCheckResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PermissionExpandResponseMultiError is an error wrapping multiple validation
-// errors returned by PermissionExpandResponse.ValidateAll() if the designated
-// constraints aren't met.
-type PermissionExpandResponseMultiError []error
+// PermissionBulkCheckResponseMultiError is an error wrapping multiple
+// validation errors returned by PermissionBulkCheckResponse.ValidateAll() if
+// the designated constraints aren't met.
+type PermissionBulkCheckResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PermissionExpandResponseMultiError) Error() string {
+func (m PermissionBulkCheckResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1077,11 +1160,12 @@ func (m PermissionExpandResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PermissionExpandResponseMultiError) AllErrors() []error { return m }
+func (m PermissionBulkCheckResponseMultiError) AllErrors() []error { return m }
 
-// PermissionExpandResponseValidationError is the validation error returned by
-// PermissionExpandResponse.Validate if the designated constraints aren't met.
-type PermissionExpandResponseValidationError struct {
+// PermissionBulkCheckResponseValidationError is the validation error returned
+// by PermissionBulkCheckResponse.Validate if the designated constraints
+// aren't met.
+type PermissionBulkCheckResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1089,24 +1173,24 @@ type PermissionExpandResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PermissionExpandResponseValidationError) Field() string { return e.field }
+func (e PermissionBulkCheckResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PermissionExpandResponseValidationError) Reason() string { return e.reason }
+func (e PermissionBulkCheckResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PermissionExpandResponseValidationError) Cause() error { return e.cause }
+func (e PermissionBulkCheckResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PermissionExpandResponseValidationError) Key() bool { return e.key }
+func (e PermissionBulkCheckResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PermissionExpandResponseValidationError) ErrorName() string {
-	return "PermissionExpandResponseValidationError"
+func (e PermissionBulkCheckResponseValidationError) ErrorName() string {
+	return "PermissionBulkCheckResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PermissionExpandResponseValidationError) Error() string {
+func (e PermissionBulkCheckResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1118,14 +1202,14 @@ func (e PermissionExpandResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPermissionExpandResponse.%s: %s%s",
+		"invalid %sPermissionBulkCheckResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PermissionExpandResponseValidationError{}
+var _ error = PermissionBulkCheckResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1133,68 +1217,38 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PermissionExpandResponseValidationError{}
+} = PermissionBulkCheckResponseValidationError{}
 
-// Validate checks the field values on PermissionLookupSchemaRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PermissionLookupSchemaRequest) Validate() error {
+// Validate checks the field values on PermissionBulkCheckResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *PermissionBulkCheckResult) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PermissionLookupSchemaRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// PermissionLookupSchemaRequestMultiError, or nil if none found.
-func (m *PermissionLookupSchemaRequest) ValidateAll() error {
+// ValidateAll checks the field values on PermissionBulkCheckResult with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PermissionBulkCheckResultMultiError, or nil if none found.
+func (m *PermissionBulkCheckResult) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PermissionLookupSchemaRequest) validate(all bool) error {
+func (m *PermissionBulkCheckResult) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetTenantId()) > 64 {
-		err := PermissionLookupSchemaRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at most 64 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_PermissionLookupSchemaRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := PermissionLookupSchemaRequestValidationError{
-			field:  "TenantId",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetMetadata() == nil {
-		err := PermissionLookupSchemaRequestValidationError{
-			field:  "Metadata",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Can
 
 	if all {
 		switch v := interface{}(m.GetMetadata()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PermissionLookupSchemaRequestValidationError{
+				errors = append(errors, PermissionBulkCheckResultValidationError{
 					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1202,7 +1256,7 @@ func (m *PermissionLookupSchemaRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PermissionLookupSchemaRequestValidationError{
+				errors = append(errors, PermissionBulkCheckResultValidationError{
 					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1211,7 +1265,7 @@ func (m *PermissionLookupSchemaRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PermissionLookupSchemaRequestValidationError{
+			return PermissionBulkCheckResultValidationError{
 				field:  "Metadata",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -1219,42 +1273,22 @@ func (m *PermissionLookupSchemaRequest) validate(all bool) error {
 		}
 	}
 
-	if len(m.GetEntityType()) > 64 {
-		err := PermissionLookupSchemaRequestValidationError{
-			field:  "EntityType",
-			reason: "value length must be at most 64 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_PermissionLookupSchemaRequest_EntityType_Pattern.MatchString(m.GetEntityType()) {
-		err := PermissionLookupSchemaRequestValidationError{
-			field:  "EntityType",
-			reason: "value does not match regex pattern \"^([a-z][a-z0-9_]{1,62}[a-z0-9])$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Error
 
 	if len(errors) > 0 {
-		return PermissionLookupSchemaRequestMultiError(errors)
+		return PermissionBulkCheckResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// PermissionLookupSchemaRequestMultiError is an error wrapping multiple
-// validation errors returned by PermissionLookupSchemaRequest.ValidateAll()
-// if the designated constraints aren't met.
-type PermissionLookupSchemaRequestMultiError []error
+// PermissionBulkCheckResultMultiError is an error wrapping multiple validation
+// errors returned by PermissionBulkCheckResult.ValidateAll() if the
+// designated constraints aren't met.
+type PermissionBulkCheckResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PermissionLookupSchemaRequestMultiError) Error() string {
+func (m PermissionBulkCheckResultMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1263,12 +1297,11 @@ func (m PermissionLookupSchemaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PermissionLookupSchemaRequestMultiError) AllErrors() []error { return m }
+func (m PermissionBulkCheckResultMultiError) AllErrors() []error { return m }
 
-// PermissionLookupSchemaRequestValidationError is the validation error
-// returned by PermissionLookupSchemaRequest.Validate if the designated
-// constraints aren't met.
-type PermissionLookupSchemaRequestValidationError struct {
+// PermissionBulkCheckResultValidationError is the validation error returned by
+// PermissionBulkCheckResult.Validate if the designated constraints aren't met.
+type PermissionBulkCheckResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1276,24 +1309,24 @@ type PermissionLookupSchemaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PermissionLookupSchemaRequestValidationError) Field() string { return e.field }
+func (e PermissionBulkCheckResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PermissionLookupSchemaRequestValidationError) Reason() string { return e.reason }
+func (e PermissionBulkCheckResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PermissionLookupSchemaRequestValidationError) Cause() error { return e.cause }
+func (e PermissionBulkCheckResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PermissionLookupSchemaRequestValidationError) Key() bool { return e.key }
+func (e PermissionBulkCheckResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PermissionLookupSchemaRequestValidationError) ErrorName() string {
-	return "PermissionLookupSchemaRequestValidationError"
+func (e PermissionBulkCheckResultValidationError) ErrorName() string {
+	return "PermissionBulkCheckResultValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PermissionLookupSchemaRequestValidationError) Error() string {
+func (e PermissionBulkCheckResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1305,14 +1338,14 @@ func (e PermissionLookupSchemaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPermissionLookupSchemaRequest.%s: %s%s",
+		"invalid %sPermissionBulkCheckResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PermissionLookupSchemaRequestValidationError{}
+var _ error = PermissionBulkCheckResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -1320,52 +1353,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PermissionLookupSchemaRequestValidationError{}
-
-var _PermissionLookupSchemaRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
-
-var _PermissionLookupSchemaRequest_EntityType_Pattern = regexp.MustCompile("^([a-z][a-z0-9_]{1,62}[a-z0-9])$")
+} = PermissionBulkCheckResultValidationError{}
 
-// Validate checks the field values on PermissionLookupSchemaRequestMetadata
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *PermissionLookupSchemaRequestMetadata) Validate() error {
+// Validate checks the field values on PermissionBulkCheckStreamRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PermissionBulkCheckStreamRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PermissionLookupSchemaRequestMetadata
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// PermissionLookupSchemaRequestMetadataMultiError, or nil if none found.
-func (m *PermissionLookupSchemaRequestMetadata) ValidateAll() error {
+// ValidateAll checks the field values on PermissionBulkCheckStreamRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PermissionBulkCheckStreamRequestMultiError, or nil if none found.
+func (m *PermissionBulkCheckStreamRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PermissionLookupSchemaRequestMetadata) validate(all bool) error {
+func (m *PermissionBulkCheckStreamRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SchemaVersion
+	// no validation rules for Id
+
+	// skipping validation for Request
 
 	if len(errors) > 0 {
-		return PermissionLookupSchemaRequestMetadataMultiError(errors)
+		return PermissionBulkCheckStreamRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PermissionLookupSchemaRequestMetadataMultiError is an error wrapping
-// multiple validation errors returned by
-// PermissionLookupSchemaRequestMetadata.ValidateAll() if the designated
+// PermissionBulkCheckStreamRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// PermissionBulkCheckStreamRequest.ValidateAll() if the designated
 // constraints aren't met.
-type PermissionLookupSchemaRequestMetadataMultiError []error
+type PermissionBulkCheckStreamRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PermissionLookupSchemaRequestMetadataMultiError) Error() string {
+func (m PermissionBulkCheckStreamRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1374,12 +1405,12 @@ func (m PermissionLookupSchemaRequestMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PermissionLookupSchemaRequestMetadataMultiError) AllErrors() []error { return m }
+func (m PermissionBulkCheckStreamRequestMultiError) AllErrors() []error { return m }
 
-// PermissionLookupSchemaRequestMetadataValidationError is the validation error
-// returned by PermissionLookupSchemaRequestMetadata.Validate if the
-// designated constraints aren't met.
-type PermissionLookupSchemaRequestMetadataValidationError struct {
+// PermissionBulkCheckStreamRequestValidationError is the validation error
+// returned by PermissionBulkCheckStreamRequest.Validate if the designated
+// constraints aren't met.
+type PermissionBulkCheckStreamRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1387,24 +1418,24 @@ type PermissionLookupSchemaRequestMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e PermissionLookupSchemaRequestMetadataValidationError) Field() string { return e.field }
+func (e PermissionBulkCheckStreamRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PermissionLookupSchemaRequestMetadataValidationError) Reason() string { return e.reason }
+func (e PermissionBulkCheckStreamRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PermissionLookupSchemaRequestMetadataValidationError) Cause() error { return e.cause }
+func (e PermissionBulkCheckStreamRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PermissionLookupSchemaRequestMetadataValidationError) Key() bool { return e.key }
+func (e PermissionBulkCheckStreamRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PermissionLookupSchemaRequestMetadataValidationError) ErrorName() string {
-	return "PermissionLookupSchemaRequestMetadataValidationError"
+func (e PermissionBulkCheckStreamRequestValidationError) ErrorName() string {
+	return "PermissionBulkCheckStreamRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PermissionLookupSchemaRequestMetadataValidationError) Error() string {
+func (e PermissionBulkCheckStreamRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1416,14 +1447,14 @@ func (e PermissionLookupSchemaRequestMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPermissionLookupSchemaRequestMetadata.%s: %s%s",
+		"invalid %sPermissionBulkCheckStreamRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PermissionLookupSchemaRequestMetadataValidationError{}
+var _ error = PermissionBulkCheckStreamRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1431,58 +1462,95 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PermissionLookupSchemaRequestMetadataValidationError{}
+} = PermissionBulkCheckStreamRequestValidationError{}
 
-// Validate checks the field values on PermissionLookupSchemaResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PermissionLookupSchemaResponse) Validate() error {
+// Validate checks the field values on PermissionBulkCheckStreamResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PermissionBulkCheckStreamResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PermissionLookupSchemaResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// PermissionLookupSchemaResponseMultiError, or nil if none found.
-func (m *PermissionLookupSchemaResponse) ValidateAll() error {
+// ValidateAll checks the field values on PermissionBulkCheckStreamResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PermissionBulkCheckStreamResponseMultiError, or nil if none found.
+func (m *PermissionBulkCheckStreamResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PermissionLookupSchemaResponse) validate(all bool) error {
+func (m *PermissionBulkCheckStreamResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(errors) > 0 {
-		return PermissionLookupSchemaResponseMultiError(errors)
-	}
-
-	return nil
-}
-
-// PermissionLookupSchemaResponseMultiError is an error wrapping multiple
-// validation errors returned by PermissionLookupSchemaResponse.ValidateAll()
-// if the designated constraints aren't met.
-type PermissionLookupSchemaResponseMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m PermissionLookupSchemaResponseMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
+	// no validation rules for Id
 
-// AllErrors returns a list of validation violation errors.
-func (m PermissionLookupSchemaResponseMultiError) AllErrors() []error { return m }
+	// no validation rules for Can
 
-// PermissionLookupSchemaResponseValidationError is the validation error
-// returned by PermissionLookupSchemaResponse.Validate if the designated
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionBulkCheckStreamResponseValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionBulkCheckStreamResponseValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionBulkCheckStreamResponseValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return PermissionBulkCheckStreamResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionBulkCheckStreamResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// PermissionBulkCheckStreamResponse.ValidateAll() if the designated
 // constraints aren't met.
-type PermissionLookupSchemaResponseValidationError struct {
+type PermissionBulkCheckStreamResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionBulkCheckStreamResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionBulkCheckStreamResponseMultiError) AllErrors() []error { return m }
+
+// PermissionBulkCheckStreamResponseValidationError is the validation error
+// returned by PermissionBulkCheckStreamResponse.Validate if the designated
+// constraints aren't met.
+type PermissionBulkCheckStreamResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1490,24 +1558,24 @@ type PermissionLookupSchemaResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PermissionLookupSchemaResponseValidationError) Field() string { return e.field }
+func (e PermissionBulkCheckStreamResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PermissionLookupSchemaResponseValidationError) Reason() string { return e.reason }
+func (e PermissionBulkCheckStreamResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PermissionLookupSchemaResponseValidationError) Cause() error { return e.cause }
+func (e PermissionBulkCheckStreamResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PermissionLookupSchemaResponseValidationError) Key() bool { return e.key }
+func (e PermissionBulkCheckStreamResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PermissionLookupSchemaResponseValidationError) ErrorName() string {
-	return "PermissionLookupSchemaResponseValidationError"
+func (e PermissionBulkCheckStreamResponseValidationError) ErrorName() string {
+	return "PermissionBulkCheckStreamResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PermissionLookupSchemaResponseValidationError) Error() string {
+func (e PermissionBulkCheckStreamResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1519,14 +1587,14 @@ func (e PermissionLookupSchemaResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPermissionLookupSchemaResponse.%s: %s%s",
+		"invalid %sPermissionBulkCheckStreamResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PermissionLookupSchemaResponseValidationError{}
+var _ error = PermissionBulkCheckStreamResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1534,24 +1602,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PermissionLookupSchemaResponseValidationError{}
+} = PermissionBulkCheckStreamResponseValidationError{}
 
-// Validate checks the field values on PermissionLookupEntityRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on PermissionExpandRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PermissionLookupEntityRequest) Validate() error {
+func (m *PermissionExpandRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PermissionLookupEntityRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// PermissionLookupEntityRequestMultiError, or nil if none found.
-func (m *PermissionLookupEntityRequest) ValidateAll() error {
+// ValidateAll checks the field values on PermissionExpandRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PermissionExpandRequestMultiError, or nil if none found.
+func (m *PermissionExpandRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PermissionLookupEntityRequest) validate(all bool) error {
+func (m *PermissionExpandRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1559,7 +1627,7 @@ func (m *PermissionLookupEntityRequest) validate(all bool) error {
 	var errors []error
 
 	if len(m.GetTenantId()) > 64 {
-		err := PermissionLookupEntityRequestValidationError{
+		err := PermissionExpandRequestValidationError{
 			field:  "TenantId",
 			reason: "value length must be at most 64 bytes",
 		}
@@ -1569,8 +1637,8 @@ func (m *PermissionLookupEntityRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_PermissionLookupEntityRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := PermissionLookupEntityRequestValidationError{
+	if !_PermissionExpandRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := PermissionExpandRequestValidationError{
 			field:  "TenantId",
 			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
 		}
@@ -1581,7 +1649,7 @@ func (m *PermissionLookupEntityRequest) validate(all bool) error {
 	}
 
 	if m.GetMetadata() == nil {
-		err := PermissionLookupEntityRequestValidationError{
+		err := PermissionExpandRequestValidationError{
 			field:  "Metadata",
 			reason: "value is required",
 		}
@@ -1595,7 +1663,7 @@ func (m *PermissionLookupEntityRequest) validate(all bool) error {
 		switch v := interface{}(m.GetMetadata()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PermissionLookupEntityRequestValidationError{
+				errors = append(errors, PermissionExpandRequestValidationError{
 					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1603,7 +1671,7 @@ func (m *PermissionLookupEntityRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PermissionLookupEntityRequestValidationError{
+				errors = append(errors, PermissionExpandRequestValidationError{
 					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1612,7 +1680,7 @@ func (m *PermissionLookupEntityRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PermissionLookupEntityRequestValidationError{
+			return PermissionExpandRequestValidationError{
 				field:  "Metadata",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -1620,53 +1688,9 @@ func (m *PermissionLookupEntityRequest) validate(all bool) error {
 		}
 	}
 
-	if len(m.GetEntityType()) > 64 {
-		err := PermissionLookupEntityRequestValidationError{
-			field:  "EntityType",
-			reason: "value length must be at most 64 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_PermissionLookupEntityRequest_EntityType_Pattern.MatchString(m.GetEntityType()) {
-		err := PermissionLookupEntityRequestValidationError{
-			field:  "EntityType",
-			reason: "value does not match regex pattern \"^([a-z][a-z0-9_]{1,62}[a-z0-9])$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetPermission()) > 64 {
-		err := PermissionLookupEntityRequestValidationError{
-			field:  "Permission",
-			reason: "value length must be at most 64 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_PermissionLookupEntityRequest_Permission_Pattern.MatchString(m.GetPermission()) {
-		err := PermissionLookupEntityRequestValidationError{
-			field:  "Permission",
-			reason: "value does not match regex pattern \"^([a-z][a-z0-9_]{1,62}[a-z0-9])$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetSubject() == nil {
-		err := PermissionLookupEntityRequestValidationError{
-			field:  "Subject",
+	if m.GetEntity() == nil {
+		err := PermissionExpandRequestValidationError{
+			field:  "Entity",
 			reason: "value is required",
 		}
 		if !all {
@@ -1676,48 +1700,78 @@ func (m *PermissionLookupEntityRequest) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetSubject()).(type) {
+		switch v := interface{}(m.GetEntity()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PermissionLookupEntityRequestValidationError{
-					field:  "Subject",
+				errors = append(errors, PermissionExpandRequestValidationError{
+					field:  "Entity",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PermissionLookupEntityRequestValidationError{
-					field:  "Subject",
+				errors = append(errors, PermissionExpandRequestValidationError{
+					field:  "Entity",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSubject()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetEntity()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PermissionLookupEntityRequestValidationError{
-				field:  "Subject",
+			return PermissionExpandRequestValidationError{
+				field:  "Entity",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	if m.GetPermission() != "" {
+
+		if len(m.GetPermission()) > 64 {
+			err := PermissionExpandRequestValidationError{
+				field:  "Permission",
+				reason: "value length must be at most 64 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_PermissionExpandRequest_Permission_Pattern.MatchString(m.GetPermission()) {
+			err := PermissionExpandRequestValidationError{
+				field:  "Permission",
+				reason: "value does not match regex pattern \"^([a-z][a-z0-9_]{1,62}[a-z0-9])$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Flatten
+
+	// no validation rules for Continuation
+
 	if len(errors) > 0 {
-		return PermissionLookupEntityRequestMultiError(errors)
+		return PermissionExpandRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PermissionLookupEntityRequestMultiError is an error wrapping multiple
-// validation errors returned by PermissionLookupEntityRequest.ValidateAll()
-// if the designated constraints aren't met.
-type PermissionLookupEntityRequestMultiError []error
+// PermissionExpandRequestMultiError is an error wrapping multiple validation
+// errors returned by PermissionExpandRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PermissionExpandRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PermissionLookupEntityRequestMultiError) Error() string {
+func (m PermissionExpandRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1726,12 +1780,11 @@ func (m PermissionLookupEntityRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PermissionLookupEntityRequestMultiError) AllErrors() []error { return m }
+func (m PermissionExpandRequestMultiError) AllErrors() []error { return m }
 
-// PermissionLookupEntityRequestValidationError is the validation error
-// returned by PermissionLookupEntityRequest.Validate if the designated
-// constraints aren't met.
-type PermissionLookupEntityRequestValidationError struct {
+// PermissionExpandRequestValidationError is the validation error returned by
+// PermissionExpandRequest.Validate if the designated constraints aren't met.
+type PermissionExpandRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1739,24 +1792,24 @@ type PermissionLookupEntityRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PermissionLookupEntityRequestValidationError) Field() string { return e.field }
+func (e PermissionExpandRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PermissionLookupEntityRequestValidationError) Reason() string { return e.reason }
+func (e PermissionExpandRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PermissionLookupEntityRequestValidationError) Cause() error { return e.cause }
+func (e PermissionExpandRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PermissionLookupEntityRequestValidationError) Key() bool { return e.key }
+func (e PermissionExpandRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PermissionLookupEntityRequestValidationError) ErrorName() string {
-	return "PermissionLookupEntityRequestValidationError"
+func (e PermissionExpandRequestValidationError) ErrorName() string {
+	return "PermissionExpandRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PermissionLookupEntityRequestValidationError) Error() string {
+func (e PermissionExpandRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1768,14 +1821,14 @@ func (e PermissionLookupEntityRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPermissionLookupEntityRequest.%s: %s%s",
+		"invalid %sPermissionExpandRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PermissionLookupEntityRequestValidationError{}
+var _ error = PermissionExpandRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1783,31 +1836,28 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PermissionLookupEntityRequestValidationError{}
-
-var _PermissionLookupEntityRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+} = PermissionExpandRequestValidationError{}
 
-var _PermissionLookupEntityRequest_EntityType_Pattern = regexp.MustCompile("^([a-z][a-z0-9_]{1,62}[a-z0-9])$")
+var _PermissionExpandRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
 
-var _PermissionLookupEntityRequest_Permission_Pattern = regexp.MustCompile("^([a-z][a-z0-9_]{1,62}[a-z0-9])$")
+var _PermissionExpandRequest_Permission_Pattern = regexp.MustCompile("^([a-z][a-z0-9_]{1,62}[a-z0-9])$")
 
-// Validate checks the field values on PermissionLookupEntityRequestMetadata
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *PermissionLookupEntityRequestMetadata) Validate() error {
+// Validate checks the field values on PermissionExpandRequestMetadata with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PermissionExpandRequestMetadata) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PermissionLookupEntityRequestMetadata
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// PermissionLookupEntityRequestMetadataMultiError, or nil if none found.
-func (m *PermissionLookupEntityRequestMetadata) ValidateAll() error {
+// ValidateAll checks the field values on PermissionExpandRequestMetadata with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PermissionExpandRequestMetadataMultiError, or nil if none found.
+func (m *PermissionExpandRequestMetadata) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PermissionLookupEntityRequestMetadata) validate(all bool) error {
+func (m *PermissionExpandRequestMetadata) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1818,10 +1868,18509 @@ func (m *PermissionLookupEntityRequestMetadata) validate(all bool) error {
 
 	// no validation rules for SnapToken
 
-	if m.GetDepth() < 3 {
-		err := PermissionLookupEntityRequestMetadataValidationError{
-			field:  "Depth",
-			reason: "value must be greater than or equal to 3",
+	if len(errors) > 0 {
+		return PermissionExpandRequestMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionExpandRequestMetadataMultiError is an error wrapping multiple
+// validation errors returned by PermissionExpandRequestMetadata.ValidateAll()
+// if the designated constraints aren't met.
+type PermissionExpandRequestMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionExpandRequestMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionExpandRequestMetadataMultiError) AllErrors() []error { return m }
+
+// PermissionExpandRequestMetadataValidationError is the validation error
+// returned by PermissionExpandRequestMetadata.Validate if the designated
+// constraints aren't met.
+type PermissionExpandRequestMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionExpandRequestMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionExpandRequestMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionExpandRequestMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionExpandRequestMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionExpandRequestMetadataValidationError) ErrorName() string {
+	return "PermissionExpandRequestMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PermissionExpandRequestMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionExpandRequestMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionExpandRequestMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionExpandRequestMetadataValidationError{}
+
+// Validate checks the field values on PermissionExpandResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PermissionExpandResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PermissionExpandResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PermissionExpandResponseMultiError, or nil if none found.
+func (m *PermissionExpandResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionExpandResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTree()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionExpandResponseValidationError{
+					field:  "Tree",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionExpandResponseValidationError{
+					field:  "Tree",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTree()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionExpandResponseValidationError{
+				field:  "Tree",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetTruncated() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PermissionExpandResponseValidationError{
+						field:  fmt.Sprintf("Truncated[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PermissionExpandResponseValidationError{
+						field:  fmt.Sprintf("Truncated[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PermissionExpandResponseValidationError{
+					field:  fmt.Sprintf("Truncated[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PermissionExpandResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionExpandResponseMultiError is an error wrapping multiple validation
+// errors returned by PermissionExpandResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PermissionExpandResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionExpandResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionExpandResponseMultiError) AllErrors() []error { return m }
+
+// PermissionExpandResponseValidationError is the validation error returned by
+// PermissionExpandResponse.Validate if the designated constraints aren't met.
+type PermissionExpandResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionExpandResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionExpandResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionExpandResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionExpandResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionExpandResponseValidationError) ErrorName() string {
+	return "PermissionExpandResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PermissionExpandResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionExpandResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionExpandResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionExpandResponseValidationError{}
+
+// Validate checks the field values on PermissionExpandTruncation with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *PermissionExpandTruncation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PermissionExpandTruncation with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PermissionExpandTruncationMultiError, or nil if none found.
+func (m *PermissionExpandTruncation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionExpandTruncation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTarget()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionExpandTruncationValidationError{
+					field:  "Target",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionExpandTruncationValidationError{
+					field:  "Target",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTarget()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionExpandTruncationValidationError{
+				field:  "Target",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Continuation
+
+	if len(errors) > 0 {
+		return PermissionExpandTruncationMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionExpandTruncationMultiError is an error wrapping multiple
+// validation errors returned by PermissionExpandTruncation.ValidateAll() if
+// the designated constraints aren't met.
+type PermissionExpandTruncationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionExpandTruncationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionExpandTruncationMultiError) AllErrors() []error { return m }
+
+// PermissionExpandTruncationValidationError is the validation error returned
+// by PermissionExpandTruncation.Validate if the designated constraints aren't met.
+type PermissionExpandTruncationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionExpandTruncationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionExpandTruncationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionExpandTruncationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionExpandTruncationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionExpandTruncationValidationError) ErrorName() string {
+	return "PermissionExpandTruncationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PermissionExpandTruncationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionExpandTruncation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionExpandTruncationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionExpandTruncationValidationError{}
+
+// Validate checks the field values on PermissionLookupSchemaRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PermissionLookupSchemaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PermissionLookupSchemaRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PermissionLookupSchemaRequestMultiError, or nil if none found.
+func (m *PermissionLookupSchemaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionLookupSchemaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := PermissionLookupSchemaRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_PermissionLookupSchemaRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := PermissionLookupSchemaRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMetadata() == nil {
+		err := PermissionLookupSchemaRequestValidationError{
+			field:  "Metadata",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionLookupSchemaRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionLookupSchemaRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionLookupSchemaRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetEntityType()) > 64 {
+		err := PermissionLookupSchemaRequestValidationError{
+			field:  "EntityType",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_PermissionLookupSchemaRequest_EntityType_Pattern.MatchString(m.GetEntityType()) {
+		err := PermissionLookupSchemaRequestValidationError{
+			field:  "EntityType",
+			reason: "value does not match regex pattern \"^([a-z][a-z0-9_]{1,62}[a-z0-9])$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PermissionLookupSchemaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionLookupSchemaRequestMultiError is an error wrapping multiple
+// validation errors returned by PermissionLookupSchemaRequest.ValidateAll()
+// if the designated constraints aren't met.
+type PermissionLookupSchemaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionLookupSchemaRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionLookupSchemaRequestMultiError) AllErrors() []error { return m }
+
+// PermissionLookupSchemaRequestValidationError is the validation error
+// returned by PermissionLookupSchemaRequest.Validate if the designated
+// constraints aren't met.
+type PermissionLookupSchemaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionLookupSchemaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionLookupSchemaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionLookupSchemaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionLookupSchemaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionLookupSchemaRequestValidationError) ErrorName() string {
+	return "PermissionLookupSchemaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PermissionLookupSchemaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionLookupSchemaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionLookupSchemaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionLookupSchemaRequestValidationError{}
+
+var _PermissionLookupSchemaRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+var _PermissionLookupSchemaRequest_EntityType_Pattern = regexp.MustCompile("^([a-z][a-z0-9_]{1,62}[a-z0-9])$")
+
+// Validate checks the field values on PermissionLookupSchemaRequestMetadata
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *PermissionLookupSchemaRequestMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PermissionLookupSchemaRequestMetadata
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PermissionLookupSchemaRequestMetadataMultiError, or nil if none found.
+func (m *PermissionLookupSchemaRequestMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionLookupSchemaRequestMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	if len(errors) > 0 {
+		return PermissionLookupSchemaRequestMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionLookupSchemaRequestMetadataMultiError is an error wrapping
+// multiple validation errors returned by
+// PermissionLookupSchemaRequestMetadata.ValidateAll() if the designated
+// constraints aren't met.
+type PermissionLookupSchemaRequestMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionLookupSchemaRequestMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionLookupSchemaRequestMetadataMultiError) AllErrors() []error { return m }
+
+// PermissionLookupSchemaRequestMetadataValidationError is the validation error
+// returned by PermissionLookupSchemaRequestMetadata.Validate if the
+// designated constraints aren't met.
+type PermissionLookupSchemaRequestMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionLookupSchemaRequestMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionLookupSchemaRequestMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionLookupSchemaRequestMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionLookupSchemaRequestMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionLookupSchemaRequestMetadataValidationError) ErrorName() string {
+	return "PermissionLookupSchemaRequestMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PermissionLookupSchemaRequestMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionLookupSchemaRequestMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionLookupSchemaRequestMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionLookupSchemaRequestMetadataValidationError{}
+
+// Validate checks the field values on PermissionLookupSchemaResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PermissionLookupSchemaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PermissionLookupSchemaResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PermissionLookupSchemaResponseMultiError, or nil if none found.
+func (m *PermissionLookupSchemaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionLookupSchemaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return PermissionLookupSchemaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionLookupSchemaResponseMultiError is an error wrapping multiple
+// validation errors returned by PermissionLookupSchemaResponse.ValidateAll()
+// if the designated constraints aren't met.
+type PermissionLookupSchemaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionLookupSchemaResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionLookupSchemaResponseMultiError) AllErrors() []error { return m }
+
+// PermissionLookupSchemaResponseValidationError is the validation error
+// returned by PermissionLookupSchemaResponse.Validate if the designated
+// constraints aren't met.
+type PermissionLookupSchemaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionLookupSchemaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionLookupSchemaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionLookupSchemaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionLookupSchemaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionLookupSchemaResponseValidationError) ErrorName() string {
+	return "PermissionLookupSchemaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PermissionLookupSchemaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionLookupSchemaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionLookupSchemaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionLookupSchemaResponseValidationError{}
+
+// Validate checks the field values on PermissionLookupEntityRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PermissionLookupEntityRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PermissionLookupEntityRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PermissionLookupEntityRequestMultiError, or nil if none found.
+func (m *PermissionLookupEntityRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionLookupEntityRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := PermissionLookupEntityRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_PermissionLookupEntityRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := PermissionLookupEntityRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMetadata() == nil {
+		err := PermissionLookupEntityRequestValidationError{
+			field:  "Metadata",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionLookupEntityRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionLookupEntityRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionLookupEntityRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetEntityType()) > 64 {
+		err := PermissionLookupEntityRequestValidationError{
+			field:  "EntityType",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_PermissionLookupEntityRequest_EntityType_Pattern.MatchString(m.GetEntityType()) {
+		err := PermissionLookupEntityRequestValidationError{
+			field:  "EntityType",
+			reason: "value does not match regex pattern \"^([a-z][a-z0-9_]{1,62}[a-z0-9])$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetPermission()) > 64 {
+		err := PermissionLookupEntityRequestValidationError{
+			field:  "Permission",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_PermissionLookupEntityRequest_Permission_Pattern.MatchString(m.GetPermission()) {
+		err := PermissionLookupEntityRequestValidationError{
+			field:  "Permission",
+			reason: "value does not match regex pattern \"^([a-z][a-z0-9_]{1,62}[a-z0-9])$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSubject() == nil {
+		err := PermissionLookupEntityRequestValidationError{
+			field:  "Subject",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSubject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionLookupEntityRequestValidationError{
+					field:  "Subject",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionLookupEntityRequestValidationError{
+					field:  "Subject",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionLookupEntityRequestValidationError{
+				field:  "Subject",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PageSize
+
+	// no validation rules for ContinuousToken
+
+	for idx, item := range m.GetEntityIds() {
+		_, _ = idx, item
+
+		if len(item) > 128 {
+			err := PermissionLookupEntityRequestValidationError{
+				field:  fmt.Sprintf("EntityIds[%v]", idx),
+				reason: "value length must be at most 128 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_PermissionLookupEntityRequest_EntityIds_Pattern.MatchString(item) {
+			err := PermissionLookupEntityRequestValidationError{
+				field:  fmt.Sprintf("EntityIds[%v]", idx),
+				reason: "value does not match regex pattern \"^([a-zA-Z0-9_][a-zA-Z0-9_|-]{0,127})$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetEntityIdPrefix()) > 128 {
+		err := PermissionLookupEntityRequestValidationError{
+			field:  "EntityIdPrefix",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PermissionLookupEntityRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionLookupEntityRequestMultiError is an error wrapping multiple
+// validation errors returned by PermissionLookupEntityRequest.ValidateAll()
+// if the designated constraints aren't met.
+type PermissionLookupEntityRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionLookupEntityRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionLookupEntityRequestMultiError) AllErrors() []error { return m }
+
+// PermissionLookupEntityRequestValidationError is the validation error
+// returned by PermissionLookupEntityRequest.Validate if the designated
+// constraints aren't met.
+type PermissionLookupEntityRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionLookupEntityRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionLookupEntityRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionLookupEntityRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionLookupEntityRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionLookupEntityRequestValidationError) ErrorName() string {
+	return "PermissionLookupEntityRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PermissionLookupEntityRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionLookupEntityRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionLookupEntityRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionLookupEntityRequestValidationError{}
+
+var _PermissionLookupEntityRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+var _PermissionLookupEntityRequest_EntityType_Pattern = regexp.MustCompile("^([a-z][a-z0-9_]{1,62}[a-z0-9])$")
+
+var _PermissionLookupEntityRequest_Permission_Pattern = regexp.MustCompile("^([a-z][a-z0-9_]{1,62}[a-z0-9])$")
+
+var _PermissionLookupEntityRequest_EntityIds_Pattern = regexp.MustCompile("^([a-zA-Z0-9_][a-zA-Z0-9_|-]{0,127})$")
+
+// Validate checks the field values on PermissionLookupEntityRequestMetadata
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *PermissionLookupEntityRequestMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PermissionLookupEntityRequestMetadata
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PermissionLookupEntityRequestMetadataMultiError, or nil if none found.
+func (m *PermissionLookupEntityRequestMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionLookupEntityRequestMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	// no validation rules for SnapToken
+
+	if m.GetDepth() < 3 {
+		err := PermissionLookupEntityRequestMetadataValidationError{
+			field:  "Depth",
+			reason: "value must be greater than or equal to 3",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PermissionLookupEntityRequestMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionLookupEntityRequestMetadataMultiError is an error wrapping
+// multiple validation errors returned by
+// PermissionLookupEntityRequestMetadata.ValidateAll() if the designated
+// constraints aren't met.
+type PermissionLookupEntityRequestMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionLookupEntityRequestMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionLookupEntityRequestMetadataMultiError) AllErrors() []error { return m }
+
+// PermissionLookupEntityRequestMetadataValidationError is the validation error
+// returned by PermissionLookupEntityRequestMetadata.Validate if the
+// designated constraints aren't met.
+type PermissionLookupEntityRequestMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionLookupEntityRequestMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionLookupEntityRequestMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionLookupEntityRequestMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionLookupEntityRequestMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionLookupEntityRequestMetadataValidationError) ErrorName() string {
+	return "PermissionLookupEntityRequestMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PermissionLookupEntityRequestMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionLookupEntityRequestMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionLookupEntityRequestMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionLookupEntityRequestMetadataValidationError{}
+
+// Validate checks the field values on PermissionLookupEntityResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PermissionLookupEntityResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PermissionLookupEntityResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PermissionLookupEntityResponseMultiError, or nil if none found.
+func (m *PermissionLookupEntityResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionLookupEntityResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ContinuousToken
+
+	if len(errors) > 0 {
+		return PermissionLookupEntityResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionLookupEntityResponseMultiError is an error wrapping multiple
+// validation errors returned by PermissionLookupEntityResponse.ValidateAll()
+// if the designated constraints aren't met.
+type PermissionLookupEntityResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionLookupEntityResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionLookupEntityResponseMultiError) AllErrors() []error { return m }
+
+// PermissionLookupEntityResponseValidationError is the validation error
+// returned by PermissionLookupEntityResponse.Validate if the designated
+// constraints aren't met.
+type PermissionLookupEntityResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionLookupEntityResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionLookupEntityResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionLookupEntityResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionLookupEntityResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionLookupEntityResponseValidationError) ErrorName() string {
+	return "PermissionLookupEntityResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PermissionLookupEntityResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionLookupEntityResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionLookupEntityResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionLookupEntityResponseValidationError{}
+
+// Validate checks the field values on PermissionLookupEntityStreamResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *PermissionLookupEntityStreamResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PermissionLookupEntityStreamResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PermissionLookupEntityStreamResponseMultiError, or nil if none found.
+func (m *PermissionLookupEntityStreamResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionLookupEntityStreamResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EntityId
+
+	// no validation rules for ContinuousToken
+
+	if len(errors) > 0 {
+		return PermissionLookupEntityStreamResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionLookupEntityStreamResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// PermissionLookupEntityStreamResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PermissionLookupEntityStreamResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionLookupEntityStreamResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionLookupEntityStreamResponseMultiError) AllErrors() []error { return m }
+
+// PermissionLookupEntityStreamResponseValidationError is the validation error
+// returned by PermissionLookupEntityStreamResponse.Validate if the designated
+// constraints aren't met.
+type PermissionLookupEntityStreamResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionLookupEntityStreamResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionLookupEntityStreamResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionLookupEntityStreamResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionLookupEntityStreamResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionLookupEntityStreamResponseValidationError) ErrorName() string {
+	return "PermissionLookupEntityStreamResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PermissionLookupEntityStreamResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionLookupEntityStreamResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionLookupEntityStreamResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionLookupEntityStreamResponseValidationError{}
+
+// Validate checks the field values on PermissionLookupSubjectRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *PermissionLookupSubjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PermissionLookupSubjectRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PermissionLookupSubjectRequestMultiError, or nil if none found.
+func (m *PermissionLookupSubjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionLookupSubjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := PermissionLookupSubjectRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_PermissionLookupSubjectRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := PermissionLookupSubjectRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMetadata() == nil {
+		err := PermissionLookupSubjectRequestValidationError{
+			field:  "Metadata",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionLookupSubjectRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionLookupSubjectRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionLookupSubjectRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetEntity() == nil {
+		err := PermissionLookupSubjectRequestValidationError{
+			field:  "Entity",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetEntity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionLookupSubjectRequestValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionLookupSubjectRequestValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEntity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionLookupSubjectRequestValidationError{
+				field:  "Entity",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetPermission()) > 64 {
+		err := PermissionLookupSubjectRequestValidationError{
+			field:  "Permission",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_PermissionLookupSubjectRequest_Permission_Pattern.MatchString(m.GetPermission()) {
+		err := PermissionLookupSubjectRequestValidationError{
+			field:  "Permission",
+			reason: "value does not match regex pattern \"^([a-z][a-z0-9_]{1,62}[a-z0-9])$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetSubjectType()) > 64 {
+		err := PermissionLookupSubjectRequestValidationError{
+			field:  "SubjectType",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_PermissionLookupSubjectRequest_SubjectType_Pattern.MatchString(m.GetSubjectType()) {
+		err := PermissionLookupSubjectRequestValidationError{
+			field:  "SubjectType",
+			reason: "value does not match regex pattern \"^([a-z][a-z0-9_]{1,62}[a-z0-9])$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSubjectRelation() != "" {
+
+		if len(m.GetSubjectRelation()) > 64 {
+			err := PermissionLookupSubjectRequestValidationError{
+				field:  "SubjectRelation",
+				reason: "value length must be at most 64 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_PermissionLookupSubjectRequest_SubjectRelation_Pattern.MatchString(m.GetSubjectRelation()) {
+			err := PermissionLookupSubjectRequestValidationError{
+				field:  "SubjectRelation",
+				reason: "value does not match regex pattern \"^([a-z][a-z0-9_]{1,62}[a-z0-9])$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PermissionLookupSubjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionLookupSubjectRequestMultiError is an error wrapping multiple
+// validation errors returned by PermissionLookupSubjectRequest.ValidateAll()
+// if the designated constraints aren't met.
+type PermissionLookupSubjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionLookupSubjectRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionLookupSubjectRequestMultiError) AllErrors() []error { return m }
+
+// PermissionLookupSubjectRequestValidationError is the validation error
+// returned by PermissionLookupSubjectRequest.Validate if the designated
+// constraints aren't met.
+type PermissionLookupSubjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionLookupSubjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionLookupSubjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionLookupSubjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionLookupSubjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionLookupSubjectRequestValidationError) ErrorName() string {
+	return "PermissionLookupSubjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PermissionLookupSubjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionLookupSubjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionLookupSubjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionLookupSubjectRequestValidationError{}
+
+var _PermissionLookupSubjectRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+var _PermissionLookupSubjectRequest_Permission_Pattern = regexp.MustCompile("^([a-z][a-z0-9_]{1,62}[a-z0-9])$")
+
+var _PermissionLookupSubjectRequest_SubjectType_Pattern = regexp.MustCompile("^([a-z][a-z0-9_]{1,62}[a-z0-9])$")
+
+var _PermissionLookupSubjectRequest_SubjectRelation_Pattern = regexp.MustCompile("^([a-z][a-z0-9_]{1,62}[a-z0-9])$")
+
+// Validate checks the field values on PermissionLookupSubjectRequestMetadata
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *PermissionLookupSubjectRequestMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// PermissionLookupSubjectRequestMetadata with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// PermissionLookupSubjectRequestMetadataMultiError, or nil if none found.
+func (m *PermissionLookupSubjectRequestMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionLookupSubjectRequestMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	// no validation rules for SnapToken
+
+	if m.GetDepth() < 3 {
+		err := PermissionLookupSubjectRequestMetadataValidationError{
+			field:  "Depth",
+			reason: "value must be greater than or equal to 3",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PermissionLookupSubjectRequestMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionLookupSubjectRequestMetadataMultiError is an error wrapping
+// multiple validation errors returned by
+// PermissionLookupSubjectRequestMetadata.ValidateAll() if the designated
+// constraints aren't met.
+type PermissionLookupSubjectRequestMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionLookupSubjectRequestMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionLookupSubjectRequestMetadataMultiError) AllErrors() []error { return m }
+
+// PermissionLookupSubjectRequestMetadataValidationError is the validation
+// error returned by PermissionLookupSubjectRequestMetadata.Validate if the
+// designated constraints aren't met.
+type PermissionLookupSubjectRequestMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionLookupSubjectRequestMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionLookupSubjectRequestMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionLookupSubjectRequestMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionLookupSubjectRequestMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionLookupSubjectRequestMetadataValidationError) ErrorName() string {
+	return "PermissionLookupSubjectRequestMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PermissionLookupSubjectRequestMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionLookupSubjectRequestMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionLookupSubjectRequestMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionLookupSubjectRequestMetadataValidationError{}
+
+// Validate checks the field values on PermissionLookupSubjectResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *PermissionLookupSubjectResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PermissionLookupSubjectResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PermissionLookupSubjectResponseMultiError, or nil if none found.
+func (m *PermissionLookupSubjectResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionLookupSubjectResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return PermissionLookupSubjectResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionLookupSubjectResponseMultiError is an error wrapping multiple
+// validation errors returned by PermissionLookupSubjectResponse.ValidateAll()
+// if the designated constraints aren't met.
+type PermissionLookupSubjectResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionLookupSubjectResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionLookupSubjectResponseMultiError) AllErrors() []error { return m }
+
+// PermissionLookupSubjectResponseValidationError is the validation error
+// returned by PermissionLookupSubjectResponse.Validate if the designated
+// constraints aren't met.
+type PermissionLookupSubjectResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionLookupSubjectResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionLookupSubjectResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionLookupSubjectResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionLookupSubjectResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionLookupSubjectResponseValidationError) ErrorName() string {
+	return "PermissionLookupSubjectResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PermissionLookupSubjectResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionLookupSubjectResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionLookupSubjectResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionLookupSubjectResponseValidationError{}
+
+// Validate checks the field values on SchemaWriteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaWriteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaWriteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaWriteRequestMultiError, or nil if none found.
+func (m *SchemaWriteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaWriteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := SchemaWriteRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaWriteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SchemaWriteRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Schema
+
+	if len(errors) > 0 {
+		return SchemaWriteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaWriteRequestMultiError is an error wrapping multiple validation errors
+// returned by SchemaWriteRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaWriteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaWriteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaWriteRequestMultiError) AllErrors() []error { return m }
+
+// SchemaWriteRequestValidationError is the validation error returned by
+// SchemaWriteRequest.Validate if the designated constraints aren't met.
+type SchemaWriteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaWriteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaWriteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaWriteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaWriteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaWriteRequestValidationError) ErrorName() string {
+	return "SchemaWriteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaWriteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaWriteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaWriteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaWriteRequestValidationError{}
+
+var _SchemaWriteRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on SchemaWriteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaWriteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaWriteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaWriteResponseMultiError, or nil if none found.
+func (m *SchemaWriteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaWriteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	if len(errors) > 0 {
+		return SchemaWriteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaWriteResponseMultiError is an error wrapping multiple validation
+// errors returned by SchemaWriteResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SchemaWriteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaWriteResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaWriteResponseMultiError) AllErrors() []error { return m }
+
+// SchemaWriteResponseValidationError is the validation error returned by
+// SchemaWriteResponse.Validate if the designated constraints aren't met.
+type SchemaWriteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaWriteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaWriteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaWriteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaWriteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaWriteResponseValidationError) ErrorName() string {
+	return "SchemaWriteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaWriteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaWriteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaWriteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaWriteResponseValidationError{}
+
+// Validate checks the field values on SchemaReadRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SchemaReadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaReadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaReadRequestMultiError, or nil if none found.
+func (m *SchemaReadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaReadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := SchemaReadRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaReadRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SchemaReadRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMetadata() == nil {
+		err := SchemaReadRequestValidationError{
+			field:  "Metadata",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaReadRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaReadRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaReadRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SchemaReadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaReadRequestMultiError is an error wrapping multiple validation errors
+// returned by SchemaReadRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaReadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaReadRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaReadRequestMultiError) AllErrors() []error { return m }
+
+// SchemaReadRequestValidationError is the validation error returned by
+// SchemaReadRequest.Validate if the designated constraints aren't met.
+type SchemaReadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaReadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaReadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaReadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaReadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaReadRequestValidationError) ErrorName() string {
+	return "SchemaReadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaReadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaReadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaReadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaReadRequestValidationError{}
+
+var _SchemaReadRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on SchemaReadRequestMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaReadRequestMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaReadRequestMetadata with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaReadRequestMetadataMultiError, or nil if none found.
+func (m *SchemaReadRequestMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaReadRequestMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	if len(errors) > 0 {
+		return SchemaReadRequestMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaReadRequestMetadataMultiError is an error wrapping multiple validation
+// errors returned by SchemaReadRequestMetadata.ValidateAll() if the
+// designated constraints aren't met.
+type SchemaReadRequestMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaReadRequestMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaReadRequestMetadataMultiError) AllErrors() []error { return m }
+
+// SchemaReadRequestMetadataValidationError is the validation error returned by
+// SchemaReadRequestMetadata.Validate if the designated constraints aren't met.
+type SchemaReadRequestMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaReadRequestMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaReadRequestMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaReadRequestMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaReadRequestMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaReadRequestMetadataValidationError) ErrorName() string {
+	return "SchemaReadRequestMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaReadRequestMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaReadRequestMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaReadRequestMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaReadRequestMetadataValidationError{}
+
+// Validate checks the field values on SchemaReadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SchemaReadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaReadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaReadResponseMultiError, or nil if none found.
+func (m *SchemaReadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaReadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSchema()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaReadResponseValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaReadResponseValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchema()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaReadResponseValidationError{
+				field:  "Schema",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SchemaReadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaReadResponseMultiError is an error wrapping multiple validation errors
+// returned by SchemaReadResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaReadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaReadResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaReadResponseMultiError) AllErrors() []error { return m }
+
+// SchemaReadResponseValidationError is the validation error returned by
+// SchemaReadResponse.Validate if the designated constraints aren't met.
+type SchemaReadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaReadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaReadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaReadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaReadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaReadResponseValidationError) ErrorName() string {
+	return "SchemaReadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaReadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaReadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaReadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaReadResponseValidationError{}
+
+// Validate checks the field values on SchemaListVersionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *SchemaListVersionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaListVersionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaListVersionsRequestMultiError, or nil if none found.
+func (m *SchemaListVersionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaListVersionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := SchemaListVersionsRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaListVersionsRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SchemaListVersionsRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if val := m.GetPageSize(); val < 1 || val > 100 {
+			err := SchemaListVersionsRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be inside range [1, 100]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetContinuousToken() != "" {
+
+	}
+
+	if len(errors) > 0 {
+		return SchemaListVersionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaListVersionsRequestMultiError is an error wrapping multiple validation
+// errors returned by SchemaListVersionsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type SchemaListVersionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaListVersionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaListVersionsRequestMultiError) AllErrors() []error { return m }
+
+// SchemaListVersionsRequestValidationError is the validation error returned by
+// SchemaListVersionsRequest.Validate if the designated constraints aren't met.
+type SchemaListVersionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaListVersionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaListVersionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaListVersionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaListVersionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaListVersionsRequestValidationError) ErrorName() string {
+	return "SchemaListVersionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaListVersionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaListVersionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaListVersionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaListVersionsRequestValidationError{}
+
+var _SchemaListVersionsRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on SchemaListVersionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *SchemaListVersionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaListVersionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaListVersionsResponseMultiError, or nil if none found.
+func (m *SchemaListVersionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaListVersionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ContinuousToken
+
+	if len(errors) > 0 {
+		return SchemaListVersionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaListVersionsResponseMultiError is an error wrapping multiple
+// validation errors returned by SchemaListVersionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SchemaListVersionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaListVersionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaListVersionsResponseMultiError) AllErrors() []error { return m }
+
+// SchemaListVersionsResponseValidationError is the validation error returned
+// by SchemaListVersionsResponse.Validate if the designated constraints aren't met.
+type SchemaListVersionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaListVersionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaListVersionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaListVersionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaListVersionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaListVersionsResponseValidationError) ErrorName() string {
+	return "SchemaListVersionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaListVersionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaListVersionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaListVersionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaListVersionsResponseValidationError{}
+
+// Validate checks the field values on SchemaRollbackRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *SchemaRollbackRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaRollbackRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaRollbackRequestMultiError, or nil if none found.
+func (m *SchemaRollbackRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaRollbackRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := SchemaRollbackRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaRollbackRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SchemaRollbackRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSchemaVersion()) < 1 {
+		err := SchemaRollbackRequestValidationError{
+			field:  "SchemaVersion",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SchemaRollbackRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaRollbackRequestMultiError is an error wrapping multiple validation
+// errors returned by SchemaRollbackRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SchemaRollbackRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaRollbackRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaRollbackRequestMultiError) AllErrors() []error { return m }
+
+// SchemaRollbackRequestValidationError is the validation error returned by
+// SchemaRollbackRequest.Validate if the designated constraints aren't met.
+type SchemaRollbackRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaRollbackRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaRollbackRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaRollbackRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaRollbackRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaRollbackRequestValidationError) ErrorName() string {
+	return "SchemaRollbackRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaRollbackRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaRollbackRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaRollbackRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaRollbackRequestValidationError{}
+
+var _SchemaRollbackRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on SchemaRollbackResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *SchemaRollbackResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaRollbackResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaRollbackResponseMultiError, or nil if none found.
+func (m *SchemaRollbackResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaRollbackResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	if len(errors) > 0 {
+		return SchemaRollbackResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaRollbackResponseMultiError is an error wrapping multiple validation
+// errors returned by SchemaRollbackResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SchemaRollbackResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaRollbackResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaRollbackResponseMultiError) AllErrors() []error { return m }
+
+// SchemaRollbackResponseValidationError is the validation error returned by
+// SchemaRollbackResponse.Validate if the designated constraints aren't met.
+type SchemaRollbackResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaRollbackResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaRollbackResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaRollbackResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaRollbackResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaRollbackResponseValidationError) ErrorName() string {
+	return "SchemaRollbackResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaRollbackResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaRollbackResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaRollbackResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaRollbackResponseValidationError{}
+
+// Validate checks the field values on SchemaWriteVersionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *SchemaWriteVersionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaWriteVersionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaWriteVersionRequestMultiError, or nil if none found.
+func (m *SchemaWriteVersionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaWriteVersionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := SchemaWriteVersionRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaWriteVersionRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SchemaWriteVersionRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSchema()) < 1 {
+		err := SchemaWriteVersionRequestValidationError{
+			field:  "Schema",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSchemaVersion()) < 1 {
+		err := SchemaWriteVersionRequestValidationError{
+			field:  "SchemaVersion",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SchemaWriteVersionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaWriteVersionRequestMultiError is an error wrapping multiple validation
+// errors returned by SchemaWriteVersionRequest.ValidateAll() if the
+// designated constraints aren't met.
+type SchemaWriteVersionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaWriteVersionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaWriteVersionRequestMultiError) AllErrors() []error { return m }
+
+// SchemaWriteVersionRequestValidationError is the validation error returned by
+// SchemaWriteVersionRequest.Validate if the designated constraints aren't met.
+type SchemaWriteVersionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaWriteVersionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaWriteVersionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaWriteVersionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaWriteVersionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaWriteVersionRequestValidationError) ErrorName() string {
+	return "SchemaWriteVersionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaWriteVersionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaWriteVersionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaWriteVersionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaWriteVersionRequestValidationError{}
+
+var _SchemaWriteVersionRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on SchemaWriteVersionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *SchemaWriteVersionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaWriteVersionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaWriteVersionResponseMultiError, or nil if none found.
+func (m *SchemaWriteVersionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaWriteVersionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	if len(errors) > 0 {
+		return SchemaWriteVersionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaWriteVersionResponseMultiError is an error wrapping multiple
+// validation errors returned by SchemaWriteVersionResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SchemaWriteVersionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaWriteVersionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaWriteVersionResponseMultiError) AllErrors() []error { return m }
+
+// SchemaWriteVersionResponseValidationError is the validation error returned
+// by SchemaWriteVersionResponse.Validate if the designated constraints aren't met.
+type SchemaWriteVersionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaWriteVersionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaWriteVersionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaWriteVersionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaWriteVersionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaWriteVersionResponseValidationError) ErrorName() string {
+	return "SchemaWriteVersionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaWriteVersionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaWriteVersionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaWriteVersionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaWriteVersionResponseValidationError{}
+
+// Validate checks the field values on SchemaDiffRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SchemaDiffRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaDiffRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaDiffRequestMultiError, or nil if none found.
+func (m *SchemaDiffRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaDiffRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := SchemaDiffRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaDiffRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SchemaDiffRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSchema()) < 1 {
+		err := SchemaDiffRequestValidationError{
+			field:  "Schema",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DryRun
+
+	// no validation rules for Force
+
+	if len(errors) > 0 {
+		return SchemaDiffRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaDiffRequestMultiError is an error wrapping multiple validation errors
+// returned by SchemaDiffRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaDiffRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaDiffRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaDiffRequestMultiError) AllErrors() []error { return m }
+
+// SchemaDiffRequestValidationError is the validation error returned by
+// SchemaDiffRequest.Validate if the designated constraints aren't met.
+type SchemaDiffRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaDiffRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaDiffRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaDiffRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaDiffRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaDiffRequestValidationError) ErrorName() string {
+	return "SchemaDiffRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaDiffRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaDiffRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaDiffRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaDiffRequestValidationError{}
+
+var _SchemaDiffRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on SchemaDiffResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *SchemaDiffResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaDiffResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaDiffResponseMultiError, or nil if none found.
+func (m *SchemaDiffResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaDiffResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SchemaDiffResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SchemaDiffResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SchemaDiffResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Breaking
+
+	if len(errors) > 0 {
+		return SchemaDiffResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaDiffResponseMultiError is an error wrapping multiple validation errors
+// returned by SchemaDiffResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaDiffResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaDiffResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaDiffResponseMultiError) AllErrors() []error { return m }
+
+// SchemaDiffResponseValidationError is the validation error returned by
+// SchemaDiffResponse.Validate if the designated constraints aren't met.
+type SchemaDiffResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaDiffResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaDiffResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaDiffResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaDiffResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaDiffResponseValidationError) ErrorName() string {
+	return "SchemaDiffResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaDiffResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaDiffResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaDiffResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaDiffResponseValidationError{}
+
+// Validate checks the field values on SchemaChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SchemaChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SchemaChangeMultiError, or
+// nil if none found.
+func (m *SchemaChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kind
+
+	// no validation rules for EntityType
+
+	// no validation rules for Name
+
+	// no validation rules for NewName
+
+	// no validation rules for Breaking
+
+	if len(errors) > 0 {
+		return SchemaChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaChangeMultiError is an error wrapping multiple validation errors
+// returned by SchemaChange.ValidateAll() if the designated constraints aren't met.
+type SchemaChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaChangeMultiError) AllErrors() []error { return m }
+
+// SchemaChangeValidationError is the validation error returned by
+// SchemaChange.Validate if the designated constraints aren't met.
+type SchemaChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaChangeValidationError) ErrorName() string { return "SchemaChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SchemaChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaChangeValidationError{}
+
+// Validate checks the field values on SchemaFindReferencesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *SchemaFindReferencesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaFindReferencesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaFindReferencesRequestMultiError, or nil if none found.
+func (m *SchemaFindReferencesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaFindReferencesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := SchemaFindReferencesRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaFindReferencesRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SchemaFindReferencesRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaFindReferencesRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaFindReferencesRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaFindReferencesRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetEntityType()) > 64 {
+		err := SchemaFindReferencesRequestValidationError{
+			field:  "EntityType",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaFindReferencesRequest_EntityType_Pattern.MatchString(m.GetEntityType()) {
+		err := SchemaFindReferencesRequestValidationError{
+			field:  "EntityType",
+			reason: "value does not match regex pattern \"^([a-z][a-z0-9_]{1,62}[a-z0-9])$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRelation() != "" {
+
+		if len(m.GetRelation()) > 64 {
+			err := SchemaFindReferencesRequestValidationError{
+				field:  "Relation",
+				reason: "value length must be at most 64 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_SchemaFindReferencesRequest_Relation_Pattern.MatchString(m.GetRelation()) {
+			err := SchemaFindReferencesRequestValidationError{
+				field:  "Relation",
+				reason: "value does not match regex pattern \"^([a-z][a-z0-9_]{1,62}[a-z0-9])$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SchemaFindReferencesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaFindReferencesRequestMultiError is an error wrapping multiple
+// validation errors returned by SchemaFindReferencesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SchemaFindReferencesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaFindReferencesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaFindReferencesRequestMultiError) AllErrors() []error { return m }
+
+// SchemaFindReferencesRequestValidationError is the validation error returned
+// by SchemaFindReferencesRequest.Validate if the designated constraints
+// aren't met.
+type SchemaFindReferencesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaFindReferencesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaFindReferencesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaFindReferencesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaFindReferencesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaFindReferencesRequestValidationError) ErrorName() string {
+	return "SchemaFindReferencesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaFindReferencesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaFindReferencesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaFindReferencesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaFindReferencesRequestValidationError{}
+
+var _SchemaFindReferencesRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+var _SchemaFindReferencesRequest_EntityType_Pattern = regexp.MustCompile("^([a-z][a-z0-9_]{1,62}[a-z0-9])$")
+
+var _SchemaFindReferencesRequest_Relation_Pattern = regexp.MustCompile("^([a-z][a-z0-9_]{1,62}[a-z0-9])$")
+
+// Validate checks the field values on SchemaFindReferencesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *SchemaFindReferencesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaFindReferencesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaFindReferencesResponseMultiError, or nil if none found.
+func (m *SchemaFindReferencesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaFindReferencesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetReferences() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SchemaFindReferencesResponseValidationError{
+						field:  fmt.Sprintf("References[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SchemaFindReferencesResponseValidationError{
+						field:  fmt.Sprintf("References[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SchemaFindReferencesResponseValidationError{
+					field:  fmt.Sprintf("References[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SchemaFindReferencesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaFindReferencesResponseMultiError is an error wrapping multiple
+// validation errors returned by SchemaFindReferencesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SchemaFindReferencesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaFindReferencesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaFindReferencesResponseMultiError) AllErrors() []error { return m }
+
+// SchemaFindReferencesResponseValidationError is the validation error returned
+// by SchemaFindReferencesResponse.Validate if the designated constraints
+// aren't met.
+type SchemaFindReferencesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaFindReferencesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaFindReferencesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaFindReferencesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaFindReferencesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaFindReferencesResponseValidationError) ErrorName() string {
+	return "SchemaFindReferencesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaFindReferencesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaFindReferencesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaFindReferencesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaFindReferencesResponseValidationError{}
+
+// Validate checks the field values on SchemaReference with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SchemaReference) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaReference with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaReferenceMultiError, or nil if none found.
+func (m *SchemaReference) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaReference) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EntityType
+
+	// no validation rules for Name
+
+	// no validation rules for Kind
+
+	if len(errors) > 0 {
+		return SchemaReferenceMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaReferenceMultiError is an error wrapping multiple validation errors
+// returned by SchemaReference.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaReferenceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaReferenceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaReferenceMultiError) AllErrors() []error { return m }
+
+// SchemaReferenceValidationError is the validation error returned by
+// SchemaReference.Validate if the designated constraints aren't met.
+type SchemaReferenceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaReferenceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaReferenceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaReferenceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaReferenceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaReferenceValidationError) ErrorName() string { return "SchemaReferenceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SchemaReferenceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaReference.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaReferenceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaReferenceValidationError{}
+
+// Validate checks the field values on SchemaCompleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *SchemaCompleteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaCompleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaCompleteRequestMultiError, or nil if none found.
+func (m *SchemaCompleteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaCompleteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := SchemaCompleteRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaCompleteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SchemaCompleteRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaCompleteRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaCompleteRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaCompleteRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetEntityType()) > 64 {
+		err := SchemaCompleteRequestValidationError{
+			field:  "EntityType",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaCompleteRequest_EntityType_Pattern.MatchString(m.GetEntityType()) {
+		err := SchemaCompleteRequestValidationError{
+			field:  "EntityType",
+			reason: "value does not match regex pattern \"^([a-z][a-z0-9_]{1,62}[a-z0-9])$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SchemaCompleteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaCompleteRequestMultiError is an error wrapping multiple validation
+// errors returned by SchemaCompleteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SchemaCompleteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaCompleteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaCompleteRequestMultiError) AllErrors() []error { return m }
+
+// SchemaCompleteRequestValidationError is the validation error returned by
+// SchemaCompleteRequest.Validate if the designated constraints aren't met.
+type SchemaCompleteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaCompleteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaCompleteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaCompleteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaCompleteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaCompleteRequestValidationError) ErrorName() string {
+	return "SchemaCompleteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaCompleteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaCompleteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaCompleteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaCompleteRequestValidationError{}
+
+var _SchemaCompleteRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+var _SchemaCompleteRequest_EntityType_Pattern = regexp.MustCompile("^([a-z][a-z0-9_]{1,62}[a-z0-9])$")
+
+// Validate checks the field values on SchemaCompleteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *SchemaCompleteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaCompleteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaCompleteResponseMultiError, or nil if none found.
+func (m *SchemaCompleteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaCompleteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EntityType
+
+	// no validation rules for Doc
+
+	for idx, item := range m.GetRelations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SchemaCompleteResponseValidationError{
+						field:  fmt.Sprintf("Relations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SchemaCompleteResponseValidationError{
+						field:  fmt.Sprintf("Relations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SchemaCompleteResponseValidationError{
+					field:  fmt.Sprintf("Relations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetPermissions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SchemaCompleteResponseValidationError{
+						field:  fmt.Sprintf("Permissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SchemaCompleteResponseValidationError{
+						field:  fmt.Sprintf("Permissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SchemaCompleteResponseValidationError{
+					field:  fmt.Sprintf("Permissions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SchemaCompleteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaCompleteResponseMultiError is an error wrapping multiple validation
+// errors returned by SchemaCompleteResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SchemaCompleteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaCompleteResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaCompleteResponseMultiError) AllErrors() []error { return m }
+
+// SchemaCompleteResponseValidationError is the validation error returned by
+// SchemaCompleteResponse.Validate if the designated constraints aren't met.
+type SchemaCompleteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaCompleteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaCompleteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaCompleteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaCompleteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaCompleteResponseValidationError) ErrorName() string {
+	return "SchemaCompleteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaCompleteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaCompleteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaCompleteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaCompleteResponseValidationError{}
+
+// Validate checks the field values on SchemaRelationCompletion with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *SchemaRelationCompletion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaRelationCompletion with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaRelationCompletionMultiError, or nil if none found.
+func (m *SchemaRelationCompletion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaRelationCompletion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Doc
+
+	// no validation rules for Deprecated
+
+	if len(errors) > 0 {
+		return SchemaRelationCompletionMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaRelationCompletionMultiError is an error wrapping multiple validation
+// errors returned by SchemaRelationCompletion.ValidateAll() if the designated
+// constraints aren't met.
+type SchemaRelationCompletionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaRelationCompletionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaRelationCompletionMultiError) AllErrors() []error { return m }
+
+// SchemaRelationCompletionValidationError is the validation error returned by
+// SchemaRelationCompletion.Validate if the designated constraints aren't met.
+type SchemaRelationCompletionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaRelationCompletionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaRelationCompletionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaRelationCompletionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaRelationCompletionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaRelationCompletionValidationError) ErrorName() string {
+	return "SchemaRelationCompletionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaRelationCompletionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaRelationCompletion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaRelationCompletionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaRelationCompletionValidationError{}
+
+// Validate checks the field values on SchemaPermissionCompletion with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *SchemaPermissionCompletion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaPermissionCompletion with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaPermissionCompletionMultiError, or nil if none found.
+func (m *SchemaPermissionCompletion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaPermissionCompletion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Doc
+
+	// no validation rules for Deprecated
+
+	if len(errors) > 0 {
+		return SchemaPermissionCompletionMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaPermissionCompletionMultiError is an error wrapping multiple
+// validation errors returned by SchemaPermissionCompletion.ValidateAll() if
+// the designated constraints aren't met.
+type SchemaPermissionCompletionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaPermissionCompletionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaPermissionCompletionMultiError) AllErrors() []error { return m }
+
+// SchemaPermissionCompletionValidationError is the validation error returned
+// by SchemaPermissionCompletion.Validate if the designated constraints aren't met.
+type SchemaPermissionCompletionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaPermissionCompletionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaPermissionCompletionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaPermissionCompletionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaPermissionCompletionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaPermissionCompletionValidationError) ErrorName() string {
+	return "SchemaPermissionCompletionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaPermissionCompletionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaPermissionCompletion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaPermissionCompletionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaPermissionCompletionValidationError{}
+
+// Validate checks the field values on SchemaLintRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SchemaLintRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaLintRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaLintRequestMultiError, or nil if none found.
+func (m *SchemaLintRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaLintRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := SchemaLintRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SchemaLintRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SchemaLintRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchemaLintRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchemaLintRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchemaLintRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Schema
+
+	if len(errors) > 0 {
+		return SchemaLintRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaLintRequestMultiError is an error wrapping multiple validation errors
+// returned by SchemaLintRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaLintRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaLintRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaLintRequestMultiError) AllErrors() []error { return m }
+
+// SchemaLintRequestValidationError is the validation error returned by
+// SchemaLintRequest.Validate if the designated constraints aren't met.
+type SchemaLintRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaLintRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaLintRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaLintRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaLintRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaLintRequestValidationError) ErrorName() string {
+	return "SchemaLintRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaLintRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaLintRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaLintRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaLintRequestValidationError{}
+
+var _SchemaLintRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on SchemaLintResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *SchemaLintResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaLintResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaLintResponseMultiError, or nil if none found.
+func (m *SchemaLintResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaLintResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetWarnings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SchemaLintResponseValidationError{
+						field:  fmt.Sprintf("Warnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SchemaLintResponseValidationError{
+						field:  fmt.Sprintf("Warnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SchemaLintResponseValidationError{
+					field:  fmt.Sprintf("Warnings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SchemaLintResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaLintResponseMultiError is an error wrapping multiple validation errors
+// returned by SchemaLintResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaLintResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaLintResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaLintResponseMultiError) AllErrors() []error { return m }
+
+// SchemaLintResponseValidationError is the validation error returned by
+// SchemaLintResponse.Validate if the designated constraints aren't met.
+type SchemaLintResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaLintResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaLintResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaLintResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaLintResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaLintResponseValidationError) ErrorName() string {
+	return "SchemaLintResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaLintResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaLintResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaLintResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaLintResponseValidationError{}
+
+// Validate checks the field values on SchemaLintWarning with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SchemaLintWarning) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaLintWarning with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchemaLintWarningMultiError, or nil if none found.
+func (m *SchemaLintWarning) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaLintWarning) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kind
+
+	// no validation rules for EntityType
+
+	// no validation rules for Name
+
+	// no validation rules for Message
+
+	// no validation rules for Line
+
+	// no validation rules for Column
+
+	if len(errors) > 0 {
+		return SchemaLintWarningMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaLintWarningMultiError is an error wrapping multiple validation errors
+// returned by SchemaLintWarning.ValidateAll() if the designated constraints
+// aren't met.
+type SchemaLintWarningMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaLintWarningMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaLintWarningMultiError) AllErrors() []error { return m }
+
+// SchemaLintWarningValidationError is the validation error returned by
+// SchemaLintWarning.Validate if the designated constraints aren't met.
+type SchemaLintWarningValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaLintWarningValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaLintWarningValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaLintWarningValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaLintWarningValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaLintWarningValidationError) ErrorName() string {
+	return "SchemaLintWarningValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SchemaLintWarningValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaLintWarning.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaLintWarningValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaLintWarningValidationError{}
+
+// Validate checks the field values on RelationshipWriteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipWriteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipWriteRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipWriteRequestMultiError, or nil if none found.
+func (m *RelationshipWriteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipWriteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := RelationshipWriteRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RelationshipWriteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := RelationshipWriteRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMetadata() == nil {
+		err := RelationshipWriteRequestValidationError{
+			field:  "Metadata",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipWriteRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipWriteRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipWriteRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if l := len(m.GetTuples()); l < 1 || l > 100 {
+		err := RelationshipWriteRequestValidationError{
+			field:  "Tuples",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTuples() {
+		_, _ = idx, item
+
+		if item == nil {
+			err := RelationshipWriteRequestValidationError{
+				field:  fmt.Sprintf("Tuples[%v]", idx),
+				reason: "value is required",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RelationshipWriteRequestValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RelationshipWriteRequestValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RelationshipWriteRequestValidationError{
+					field:  fmt.Sprintf("Tuples[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RelationshipWriteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipWriteRequestMultiError is an error wrapping multiple validation
+// errors returned by RelationshipWriteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RelationshipWriteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipWriteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipWriteRequestMultiError) AllErrors() []error { return m }
+
+// RelationshipWriteRequestValidationError is the validation error returned by
+// RelationshipWriteRequest.Validate if the designated constraints aren't met.
+type RelationshipWriteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipWriteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipWriteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipWriteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipWriteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipWriteRequestValidationError) ErrorName() string {
+	return "RelationshipWriteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipWriteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipWriteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipWriteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipWriteRequestValidationError{}
+
+var _RelationshipWriteRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on RelationshipWriteRequestMetadata with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RelationshipWriteRequestMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipWriteRequestMetadata with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RelationshipWriteRequestMetadataMultiError, or nil if none found.
+func (m *RelationshipWriteRequestMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipWriteRequestMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	if len(m.GetReference()) > 256 {
+		err := RelationshipWriteRequestMetadataValidationError{
+			field:  "Reference",
+			reason: "value length must be at most 256 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RelationshipWriteRequestMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipWriteRequestMetadataMultiError is an error wrapping multiple
+// validation errors returned by
+// RelationshipWriteRequestMetadata.ValidateAll() if the designated
+// constraints aren't met.
+type RelationshipWriteRequestMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipWriteRequestMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipWriteRequestMetadataMultiError) AllErrors() []error { return m }
+
+// RelationshipWriteRequestMetadataValidationError is the validation error
+// returned by RelationshipWriteRequestMetadata.Validate if the designated
+// constraints aren't met.
+type RelationshipWriteRequestMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipWriteRequestMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipWriteRequestMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipWriteRequestMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipWriteRequestMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipWriteRequestMetadataValidationError) ErrorName() string {
+	return "RelationshipWriteRequestMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipWriteRequestMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipWriteRequestMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipWriteRequestMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipWriteRequestMetadataValidationError{}
+
+// Validate checks the field values on RelationshipWriteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipWriteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipWriteResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipWriteResponseMultiError, or nil if none found.
+func (m *RelationshipWriteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipWriteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SnapToken
+
+	if len(errors) > 0 {
+		return RelationshipWriteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipWriteResponseMultiError is an error wrapping multiple validation
+// errors returned by RelationshipWriteResponse.ValidateAll() if the
+// designated constraints aren't met.
+type RelationshipWriteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipWriteResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipWriteResponseMultiError) AllErrors() []error { return m }
+
+// RelationshipWriteResponseValidationError is the validation error returned by
+// RelationshipWriteResponse.Validate if the designated constraints aren't met.
+type RelationshipWriteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipWriteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipWriteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipWriteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipWriteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipWriteResponseValidationError) ErrorName() string {
+	return "RelationshipWriteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipWriteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipWriteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipWriteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipWriteResponseValidationError{}
+
+// Validate checks the field values on RelationshipReadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipReadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipReadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipReadRequestMultiError, or nil if none found.
+func (m *RelationshipReadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipReadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := RelationshipReadRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RelationshipReadRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := RelationshipReadRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMetadata() == nil {
+		err := RelationshipReadRequestValidationError{
+			field:  "Metadata",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipReadRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipReadRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipReadRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipReadRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipReadRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipReadRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if val := m.GetPageSize(); val < 1 || val > 100 {
+			err := RelationshipReadRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be inside range [1, 100]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetContinuousToken() != "" {
+
+	}
+
+	if m.GetExpression() != "" {
+
+		if len(m.GetExpression()) > 1024 {
+			err := RelationshipReadRequestValidationError{
+				field:  "Expression",
+				reason: "value length must be at most 1024 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RelationshipReadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipReadRequestMultiError is an error wrapping multiple validation
+// errors returned by RelationshipReadRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RelationshipReadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipReadRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipReadRequestMultiError) AllErrors() []error { return m }
+
+// RelationshipReadRequestValidationError is the validation error returned by
+// RelationshipReadRequest.Validate if the designated constraints aren't met.
+type RelationshipReadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipReadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipReadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipReadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipReadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipReadRequestValidationError) ErrorName() string {
+	return "RelationshipReadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipReadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipReadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipReadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipReadRequestValidationError{}
+
+var _RelationshipReadRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on RelationshipReadRequestMetadata with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipReadRequestMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipReadRequestMetadata with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RelationshipReadRequestMetadataMultiError, or nil if none found.
+func (m *RelationshipReadRequestMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipReadRequestMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SnapToken
+
+	if all {
+		switch v := interface{}(m.GetAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipReadRequestMetadataValidationError{
+					field:  "At",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipReadRequestMetadataValidationError{
+					field:  "At",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipReadRequestMetadataValidationError{
+				field:  "At",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RelationshipReadRequestMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipReadRequestMetadataMultiError is an error wrapping multiple
+// validation errors returned by RelationshipReadRequestMetadata.ValidateAll()
+// if the designated constraints aren't met.
+type RelationshipReadRequestMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipReadRequestMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipReadRequestMetadataMultiError) AllErrors() []error { return m }
+
+// RelationshipReadRequestMetadataValidationError is the validation error
+// returned by RelationshipReadRequestMetadata.Validate if the designated
+// constraints aren't met.
+type RelationshipReadRequestMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipReadRequestMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipReadRequestMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipReadRequestMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipReadRequestMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipReadRequestMetadataValidationError) ErrorName() string {
+	return "RelationshipReadRequestMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipReadRequestMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipReadRequestMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipReadRequestMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipReadRequestMetadataValidationError{}
+
+// Validate checks the field values on RelationshipReadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipReadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipReadResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipReadResponseMultiError, or nil if none found.
+func (m *RelationshipReadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipReadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTuples() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RelationshipReadResponseValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RelationshipReadResponseValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RelationshipReadResponseValidationError{
+					field:  fmt.Sprintf("Tuples[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ContinuousToken
+
+	for idx, item := range m.GetMetadata() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RelationshipReadResponseValidationError{
+						field:  fmt.Sprintf("Metadata[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RelationshipReadResponseValidationError{
+						field:  fmt.Sprintf("Metadata[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RelationshipReadResponseValidationError{
+					field:  fmt.Sprintf("Metadata[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RelationshipReadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipReadResponseMultiError is an error wrapping multiple validation
+// errors returned by RelationshipReadResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RelationshipReadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipReadResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipReadResponseMultiError) AllErrors() []error { return m }
+
+// RelationshipReadResponseValidationError is the validation error returned by
+// RelationshipReadResponse.Validate if the designated constraints aren't met.
+type RelationshipReadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipReadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipReadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipReadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipReadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipReadResponseValidationError) ErrorName() string {
+	return "RelationshipReadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipReadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipReadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipReadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipReadResponseValidationError{}
+
+// Validate checks the field values on RelationshipReadDeletedRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *RelationshipReadDeletedRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipReadDeletedRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RelationshipReadDeletedRequestMultiError, or nil if none found.
+func (m *RelationshipReadDeletedRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipReadDeletedRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := RelationshipReadDeletedRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RelationshipReadDeletedRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := RelationshipReadDeletedRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipReadDeletedRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipReadDeletedRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipReadDeletedRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetFrom() == nil {
+		err := RelationshipReadDeletedRequestValidationError{
+			field:  "From",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipReadDeletedRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipReadDeletedRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipReadDeletedRequestValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetTo() == nil {
+		err := RelationshipReadDeletedRequestValidationError{
+			field:  "To",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipReadDeletedRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipReadDeletedRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipReadDeletedRequestValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RelationshipReadDeletedRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipReadDeletedRequestMultiError is an error wrapping multiple
+// validation errors returned by RelationshipReadDeletedRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RelationshipReadDeletedRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipReadDeletedRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipReadDeletedRequestMultiError) AllErrors() []error { return m }
+
+// RelationshipReadDeletedRequestValidationError is the validation error
+// returned by RelationshipReadDeletedRequest.Validate if the designated
+// constraints aren't met.
+type RelationshipReadDeletedRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipReadDeletedRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipReadDeletedRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipReadDeletedRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipReadDeletedRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipReadDeletedRequestValidationError) ErrorName() string {
+	return "RelationshipReadDeletedRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipReadDeletedRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipReadDeletedRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipReadDeletedRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipReadDeletedRequestValidationError{}
+
+var _RelationshipReadDeletedRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on RelationshipReadDeletedResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *RelationshipReadDeletedResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipReadDeletedResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RelationshipReadDeletedResponseMultiError, or nil if none found.
+func (m *RelationshipReadDeletedResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipReadDeletedResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTuples() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RelationshipReadDeletedResponseValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RelationshipReadDeletedResponseValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RelationshipReadDeletedResponseValidationError{
+					field:  fmt.Sprintf("Tuples[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetMetadata() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RelationshipReadDeletedResponseValidationError{
+						field:  fmt.Sprintf("Metadata[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RelationshipReadDeletedResponseValidationError{
+						field:  fmt.Sprintf("Metadata[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RelationshipReadDeletedResponseValidationError{
+					field:  fmt.Sprintf("Metadata[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RelationshipReadDeletedResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipReadDeletedResponseMultiError is an error wrapping multiple
+// validation errors returned by RelationshipReadDeletedResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RelationshipReadDeletedResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipReadDeletedResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipReadDeletedResponseMultiError) AllErrors() []error { return m }
+
+// RelationshipReadDeletedResponseValidationError is the validation error
+// returned by RelationshipReadDeletedResponse.Validate if the designated
+// constraints aren't met.
+type RelationshipReadDeletedResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipReadDeletedResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipReadDeletedResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipReadDeletedResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipReadDeletedResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipReadDeletedResponseValidationError) ErrorName() string {
+	return "RelationshipReadDeletedResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipReadDeletedResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipReadDeletedResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipReadDeletedResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipReadDeletedResponseValidationError{}
+
+// Validate checks the field values on RelationshipReadChangesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *RelationshipReadChangesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipReadChangesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RelationshipReadChangesRequestMultiError, or nil if none found.
+func (m *RelationshipReadChangesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipReadChangesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := RelationshipReadChangesRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RelationshipReadChangesRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := RelationshipReadChangesRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipReadChangesRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipReadChangesRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipReadChangesRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetFromSnapToken()) < 1 {
+		err := RelationshipReadChangesRequestValidationError{
+			field:  "FromSnapToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ToSnapToken
+
+	if len(errors) > 0 {
+		return RelationshipReadChangesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipReadChangesRequestMultiError is an error wrapping multiple
+// validation errors returned by RelationshipReadChangesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RelationshipReadChangesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipReadChangesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipReadChangesRequestMultiError) AllErrors() []error { return m }
+
+// RelationshipReadChangesRequestValidationError is the validation error
+// returned by RelationshipReadChangesRequest.Validate if the designated
+// constraints aren't met.
+type RelationshipReadChangesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipReadChangesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipReadChangesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipReadChangesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipReadChangesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipReadChangesRequestValidationError) ErrorName() string {
+	return "RelationshipReadChangesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipReadChangesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipReadChangesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipReadChangesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipReadChangesRequestValidationError{}
+
+var _RelationshipReadChangesRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on RelationshipReadChangesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *RelationshipReadChangesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipReadChangesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RelationshipReadChangesResponseMultiError, or nil if none found.
+func (m *RelationshipReadChangesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipReadChangesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCreated() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RelationshipReadChangesResponseValidationError{
+						field:  fmt.Sprintf("Created[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RelationshipReadChangesResponseValidationError{
+						field:  fmt.Sprintf("Created[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RelationshipReadChangesResponseValidationError{
+					field:  fmt.Sprintf("Created[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetDeleted() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RelationshipReadChangesResponseValidationError{
+						field:  fmt.Sprintf("Deleted[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RelationshipReadChangesResponseValidationError{
+						field:  fmt.Sprintf("Deleted[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RelationshipReadChangesResponseValidationError{
+					field:  fmt.Sprintf("Deleted[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for SnapToken
+
+	if len(errors) > 0 {
+		return RelationshipReadChangesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipReadChangesResponseMultiError is an error wrapping multiple
+// validation errors returned by RelationshipReadChangesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RelationshipReadChangesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipReadChangesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipReadChangesResponseMultiError) AllErrors() []error { return m }
+
+// RelationshipReadChangesResponseValidationError is the validation error
+// returned by RelationshipReadChangesResponse.Validate if the designated
+// constraints aren't met.
+type RelationshipReadChangesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipReadChangesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipReadChangesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipReadChangesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipReadChangesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipReadChangesResponseValidationError) ErrorName() string {
+	return "RelationshipReadChangesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipReadChangesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipReadChangesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipReadChangesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipReadChangesResponseValidationError{}
+
+// Validate checks the field values on RelationshipDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipDeleteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipDeleteRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipDeleteRequestMultiError, or nil if none found.
+func (m *RelationshipDeleteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipDeleteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := RelationshipDeleteRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RelationshipDeleteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := RelationshipDeleteRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipDeleteRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipDeleteRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipDeleteRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RelationshipDeleteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipDeleteRequestMultiError is an error wrapping multiple validation
+// errors returned by RelationshipDeleteRequest.ValidateAll() if the
+// designated constraints aren't met.
+type RelationshipDeleteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipDeleteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipDeleteRequestMultiError) AllErrors() []error { return m }
+
+// RelationshipDeleteRequestValidationError is the validation error returned by
+// RelationshipDeleteRequest.Validate if the designated constraints aren't met.
+type RelationshipDeleteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipDeleteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipDeleteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipDeleteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipDeleteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipDeleteRequestValidationError) ErrorName() string {
+	return "RelationshipDeleteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipDeleteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipDeleteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipDeleteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipDeleteRequestValidationError{}
+
+var _RelationshipDeleteRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on RelationshipDeleteResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelationshipDeleteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipDeleteResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipDeleteResponseMultiError, or nil if none found.
+func (m *RelationshipDeleteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipDeleteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SnapToken
+
+	if len(errors) > 0 {
+		return RelationshipDeleteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipDeleteResponseMultiError is an error wrapping multiple
+// validation errors returned by RelationshipDeleteResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RelationshipDeleteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipDeleteResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipDeleteResponseMultiError) AllErrors() []error { return m }
+
+// RelationshipDeleteResponseValidationError is the validation error returned
+// by RelationshipDeleteResponse.Validate if the designated constraints aren't met.
+type RelationshipDeleteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipDeleteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipDeleteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipDeleteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipDeleteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipDeleteResponseValidationError) ErrorName() string {
+	return "RelationshipDeleteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipDeleteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipDeleteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipDeleteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipDeleteResponseValidationError{}
+
+// Validate checks the field values on RelationshipBatchWriteRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *RelationshipBatchWriteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipBatchWriteRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RelationshipBatchWriteRequestMultiError, or nil if none found.
+func (m *RelationshipBatchWriteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipBatchWriteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := RelationshipBatchWriteRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RelationshipBatchWriteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := RelationshipBatchWriteRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipBatchWriteRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipBatchWriteRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipBatchWriteRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetTuples()) < 1 {
+		err := RelationshipBatchWriteRequestValidationError{
+			field:  "Tuples",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTuples() {
+		_, _ = idx, item
+
+		// skipping validation for Tuples[idx]
+
+	}
+
+	// no validation rules for Partial
+
+	if len(errors) > 0 {
+		return RelationshipBatchWriteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipBatchWriteRequestMultiError is an error wrapping multiple
+// validation errors returned by RelationshipBatchWriteRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RelationshipBatchWriteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipBatchWriteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipBatchWriteRequestMultiError) AllErrors() []error { return m }
+
+// RelationshipBatchWriteRequestValidationError is the validation error
+// returned by RelationshipBatchWriteRequest.Validate if the designated
+// constraints aren't met.
+type RelationshipBatchWriteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipBatchWriteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipBatchWriteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipBatchWriteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipBatchWriteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipBatchWriteRequestValidationError) ErrorName() string {
+	return "RelationshipBatchWriteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipBatchWriteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipBatchWriteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipBatchWriteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipBatchWriteRequestValidationError{}
+
+var _RelationshipBatchWriteRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on RelationshipBatchWriteResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *RelationshipBatchWriteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipBatchWriteResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RelationshipBatchWriteResponseMultiError, or nil if none found.
+func (m *RelationshipBatchWriteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipBatchWriteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SnapToken
+
+	// no validation rules for Written
+
+	for idx, item := range m.GetRejected() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RelationshipBatchWriteResponseValidationError{
+						field:  fmt.Sprintf("Rejected[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RelationshipBatchWriteResponseValidationError{
+						field:  fmt.Sprintf("Rejected[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RelationshipBatchWriteResponseValidationError{
+					field:  fmt.Sprintf("Rejected[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RelationshipBatchWriteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipBatchWriteResponseMultiError is an error wrapping multiple
+// validation errors returned by RelationshipBatchWriteResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RelationshipBatchWriteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipBatchWriteResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipBatchWriteResponseMultiError) AllErrors() []error { return m }
+
+// RelationshipBatchWriteResponseValidationError is the validation error
+// returned by RelationshipBatchWriteResponse.Validate if the designated
+// constraints aren't met.
+type RelationshipBatchWriteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipBatchWriteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipBatchWriteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipBatchWriteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipBatchWriteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipBatchWriteResponseValidationError) ErrorName() string {
+	return "RelationshipBatchWriteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipBatchWriteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipBatchWriteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipBatchWriteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipBatchWriteResponseValidationError{}
+
+// Validate checks the field values on RelationshipBatchWriteRejection with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *RelationshipBatchWriteRejection) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipBatchWriteRejection with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RelationshipBatchWriteRejectionMultiError, or nil if none found.
+func (m *RelationshipBatchWriteRejection) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipBatchWriteRejection) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Index
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return RelationshipBatchWriteRejectionMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipBatchWriteRejectionMultiError is an error wrapping multiple
+// validation errors returned by RelationshipBatchWriteRejection.ValidateAll()
+// if the designated constraints aren't met.
+type RelationshipBatchWriteRejectionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipBatchWriteRejectionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipBatchWriteRejectionMultiError) AllErrors() []error { return m }
+
+// RelationshipBatchWriteRejectionValidationError is the validation error
+// returned by RelationshipBatchWriteRejection.Validate if the designated
+// constraints aren't met.
+type RelationshipBatchWriteRejectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipBatchWriteRejectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipBatchWriteRejectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipBatchWriteRejectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipBatchWriteRejectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipBatchWriteRejectionValidationError) ErrorName() string {
+	return "RelationshipBatchWriteRejectionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipBatchWriteRejectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipBatchWriteRejection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipBatchWriteRejectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipBatchWriteRejectionValidationError{}
+
+// Validate checks the field values on RelationshipValidateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *RelationshipValidateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipValidateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipValidateRequestMultiError, or nil if none found.
+func (m *RelationshipValidateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipValidateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := RelationshipValidateRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RelationshipValidateRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := RelationshipValidateRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipValidateRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipValidateRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipValidateRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if val := m.GetPageSize(); val < 1 || val > 1000 {
+			err := RelationshipValidateRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be inside range [1, 1000]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetContinuousToken() != "" {
+
+	}
+
+	// no validation rules for Delete
+
+	if len(errors) > 0 {
+		return RelationshipValidateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipValidateRequestMultiError is an error wrapping multiple
+// validation errors returned by RelationshipValidateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RelationshipValidateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipValidateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipValidateRequestMultiError) AllErrors() []error { return m }
+
+// RelationshipValidateRequestValidationError is the validation error returned
+// by RelationshipValidateRequest.Validate if the designated constraints
+// aren't met.
+type RelationshipValidateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipValidateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipValidateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipValidateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipValidateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipValidateRequestValidationError) ErrorName() string {
+	return "RelationshipValidateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipValidateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipValidateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipValidateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipValidateRequestValidationError{}
+
+var _RelationshipValidateRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on RelationshipValidateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *RelationshipValidateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipValidateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipValidateResponseMultiError, or nil if none found.
+func (m *RelationshipValidateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipValidateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetInvalid() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RelationshipValidateResponseValidationError{
+						field:  fmt.Sprintf("Invalid[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RelationshipValidateResponseValidationError{
+						field:  fmt.Sprintf("Invalid[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RelationshipValidateResponseValidationError{
+					field:  fmt.Sprintf("Invalid[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Deleted
+
+	// no validation rules for ContinuousToken
+
+	if len(errors) > 0 {
+		return RelationshipValidateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipValidateResponseMultiError is an error wrapping multiple
+// validation errors returned by RelationshipValidateResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RelationshipValidateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipValidateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipValidateResponseMultiError) AllErrors() []error { return m }
+
+// RelationshipValidateResponseValidationError is the validation error returned
+// by RelationshipValidateResponse.Validate if the designated constraints
+// aren't met.
+type RelationshipValidateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipValidateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipValidateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipValidateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipValidateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipValidateResponseValidationError) ErrorName() string {
+	return "RelationshipValidateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipValidateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipValidateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipValidateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipValidateResponseValidationError{}
+
+// Validate checks the field values on RelationshipViolation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *RelationshipViolation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipViolation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipViolationMultiError, or nil if none found.
+func (m *RelationshipViolation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipViolation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTuple()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipViolationValidationError{
+					field:  "Tuple",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipViolationValidationError{
+					field:  "Tuple",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTuple()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipViolationValidationError{
+				field:  "Tuple",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return RelationshipViolationMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipViolationMultiError is an error wrapping multiple validation
+// errors returned by RelationshipViolation.ValidateAll() if the designated
+// constraints aren't met.
+type RelationshipViolationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipViolationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipViolationMultiError) AllErrors() []error { return m }
+
+// RelationshipViolationValidationError is the validation error returned by
+// RelationshipViolation.Validate if the designated constraints aren't met.
+type RelationshipViolationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipViolationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipViolationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipViolationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipViolationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipViolationValidationError) ErrorName() string {
+	return "RelationshipViolationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipViolationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipViolation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipViolationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipViolationValidationError{}
+
+// Validate checks the field values on RelationshipExportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *RelationshipExportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipExportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipExportRequestMultiError, or nil if none found.
+func (m *RelationshipExportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipExportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := RelationshipExportRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RelationshipExportRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := RelationshipExportRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipExportRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipExportRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipExportRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetEntityType() != "" {
+
+		if len(m.GetEntityType()) > 64 {
+			err := RelationshipExportRequestValidationError{
+				field:  "EntityType",
+				reason: "value length must be at most 64 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_RelationshipExportRequest_EntityType_Pattern.MatchString(m.GetEntityType()) {
+			err := RelationshipExportRequestValidationError{
+				field:  "EntityType",
+				reason: "value does not match regex pattern \"^([a-z][a-z0-9_]{1,62}[a-z0-9])$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RelationshipExportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipExportRequestMultiError is an error wrapping multiple validation
+// errors returned by RelationshipExportRequest.ValidateAll() if the
+// designated constraints aren't met.
+type RelationshipExportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipExportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipExportRequestMultiError) AllErrors() []error { return m }
+
+// RelationshipExportRequestValidationError is the validation error returned by
+// RelationshipExportRequest.Validate if the designated constraints aren't met.
+type RelationshipExportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipExportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipExportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipExportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipExportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipExportRequestValidationError) ErrorName() string {
+	return "RelationshipExportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipExportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipExportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipExportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipExportRequestValidationError{}
+
+var _RelationshipExportRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+var _RelationshipExportRequest_EntityType_Pattern = regexp.MustCompile("^([a-z][a-z0-9_]{1,62}[a-z0-9])$")
+
+// Validate checks the field values on RelationshipExportResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *RelationshipExportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipExportResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipExportResponseMultiError, or nil if none found.
+func (m *RelationshipExportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipExportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTuples() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RelationshipExportResponseValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RelationshipExportResponseValidationError{
+						field:  fmt.Sprintf("Tuples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RelationshipExportResponseValidationError{
+					field:  fmt.Sprintf("Tuples[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for SnapToken
+
+	if len(errors) > 0 {
+		return RelationshipExportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipExportResponseMultiError is an error wrapping multiple
+// validation errors returned by RelationshipExportResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RelationshipExportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipExportResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipExportResponseMultiError) AllErrors() []error { return m }
+
+// RelationshipExportResponseValidationError is the validation error returned
+// by RelationshipExportResponse.Validate if the designated constraints aren't met.
+type RelationshipExportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipExportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipExportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipExportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipExportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipExportResponseValidationError) ErrorName() string {
+	return "RelationshipExportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipExportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipExportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipExportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipExportResponseValidationError{}
+
+// Validate checks the field values on RelationshipImportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *RelationshipImportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipImportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipImportRequestMultiError, or nil if none found.
+func (m *RelationshipImportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipImportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetTenantId() != "" {
+
+		if len(m.GetTenantId()) > 64 {
+			err := RelationshipImportRequestValidationError{
+				field:  "TenantId",
+				reason: "value length must be at most 64 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_RelationshipImportRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+			err := RelationshipImportRequestValidationError{
+				field:  "TenantId",
+				reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipImportRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipImportRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipImportRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetTuples() {
+		_, _ = idx, item
+
+		// skipping validation for Tuples[idx]
+
+	}
+
+	if len(errors) > 0 {
+		return RelationshipImportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipImportRequestMultiError is an error wrapping multiple validation
+// errors returned by RelationshipImportRequest.ValidateAll() if the
+// designated constraints aren't met.
+type RelationshipImportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipImportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipImportRequestMultiError) AllErrors() []error { return m }
+
+// RelationshipImportRequestValidationError is the validation error returned by
+// RelationshipImportRequest.Validate if the designated constraints aren't met.
+type RelationshipImportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipImportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipImportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipImportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipImportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipImportRequestValidationError) ErrorName() string {
+	return "RelationshipImportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipImportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipImportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipImportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipImportRequestValidationError{}
+
+var _RelationshipImportRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on RelationshipImportResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *RelationshipImportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipImportResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipImportResponseMultiError, or nil if none found.
+func (m *RelationshipImportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipImportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Imported
+
+	// no validation rules for SnapToken
+
+	if len(errors) > 0 {
+		return RelationshipImportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipImportResponseMultiError is an error wrapping multiple
+// validation errors returned by RelationshipImportResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RelationshipImportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipImportResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipImportResponseMultiError) AllErrors() []error { return m }
+
+// RelationshipImportResponseValidationError is the validation error returned
+// by RelationshipImportResponse.Validate if the designated constraints aren't met.
+type RelationshipImportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipImportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipImportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipImportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipImportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipImportResponseValidationError) ErrorName() string {
+	return "RelationshipImportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipImportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipImportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipImportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipImportResponseValidationError{}
+
+// Validate checks the field values on RelationshipMigrationRule with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *RelationshipMigrationRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipMigrationRule with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipMigrationRuleMultiError, or nil if none found.
+func (m *RelationshipMigrationRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipMigrationRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetEntityType()) > 64 {
+		err := RelationshipMigrationRuleValidationError{
+			field:  "EntityType",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RelationshipMigrationRule_EntityType_Pattern.MatchString(m.GetEntityType()) {
+		err := RelationshipMigrationRuleValidationError{
+			field:  "EntityType",
+			reason: "value does not match regex pattern \"^([a-z][a-z0-9_]{1,62}[a-z0-9])$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetRelation()) > 64 {
+		err := RelationshipMigrationRuleValidationError{
+			field:  "Relation",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RelationshipMigrationRule_Relation_Pattern.MatchString(m.GetRelation()) {
+		err := RelationshipMigrationRuleValidationError{
+			field:  "Relation",
+			reason: "value does not match regex pattern \"^([a-z][a-z0-9_]{1,62}[a-z0-9])$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTo()) < 1 {
+		err := RelationshipMigrationRuleValidationError{
+			field:  "To",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTo() {
+		_, _ = idx, item
+
+		if len(item) > 64 {
+			err := RelationshipMigrationRuleValidationError{
+				field:  fmt.Sprintf("To[%v]", idx),
+				reason: "value length must be at most 64 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_RelationshipMigrationRule_To_Pattern.MatchString(item) {
+			err := RelationshipMigrationRuleValidationError{
+				field:  fmt.Sprintf("To[%v]", idx),
+				reason: "value does not match regex pattern \"^([a-z][a-z0-9_]{1,62}[a-z0-9])$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Keep
+
+	if len(errors) > 0 {
+		return RelationshipMigrationRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipMigrationRuleMultiError is an error wrapping multiple validation
+// errors returned by RelationshipMigrationRule.ValidateAll() if the
+// designated constraints aren't met.
+type RelationshipMigrationRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipMigrationRuleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipMigrationRuleMultiError) AllErrors() []error { return m }
+
+// RelationshipMigrationRuleValidationError is the validation error returned by
+// RelationshipMigrationRule.Validate if the designated constraints aren't met.
+type RelationshipMigrationRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipMigrationRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipMigrationRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipMigrationRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipMigrationRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipMigrationRuleValidationError) ErrorName() string {
+	return "RelationshipMigrationRuleValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipMigrationRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipMigrationRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipMigrationRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipMigrationRuleValidationError{}
+
+var _RelationshipMigrationRule_EntityType_Pattern = regexp.MustCompile("^([a-z][a-z0-9_]{1,62}[a-z0-9])$")
+
+var _RelationshipMigrationRule_Relation_Pattern = regexp.MustCompile("^([a-z][a-z0-9_]{1,62}[a-z0-9])$")
+
+var _RelationshipMigrationRule_To_Pattern = regexp.MustCompile("^([a-z][a-z0-9_]{1,62}[a-z0-9])$")
+
+// Validate checks the field values on RelationshipMigrationRewrite with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *RelationshipMigrationRewrite) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipMigrationRewrite with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelationshipMigrationRewriteMultiError, or nil if none found.
+func (m *RelationshipMigrationRewrite) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipMigrationRewrite) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetOld()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipMigrationRewriteValidationError{
+					field:  "Old",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipMigrationRewriteValidationError{
+					field:  "Old",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOld()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipMigrationRewriteValidationError{
+				field:  "Old",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetNew() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RelationshipMigrationRewriteValidationError{
+						field:  fmt.Sprintf("New[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RelationshipMigrationRewriteValidationError{
+						field:  fmt.Sprintf("New[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RelationshipMigrationRewriteValidationError{
+					field:  fmt.Sprintf("New[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Delete
+
+	if len(errors) > 0 {
+		return RelationshipMigrationRewriteMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipMigrationRewriteMultiError is an error wrapping multiple
+// validation errors returned by RelationshipMigrationRewrite.ValidateAll() if
+// the designated constraints aren't met.
+type RelationshipMigrationRewriteMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipMigrationRewriteMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipMigrationRewriteMultiError) AllErrors() []error { return m }
+
+// RelationshipMigrationRewriteValidationError is the validation error returned
+// by RelationshipMigrationRewrite.Validate if the designated constraints
+// aren't met.
+type RelationshipMigrationRewriteValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipMigrationRewriteValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipMigrationRewriteValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipMigrationRewriteValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipMigrationRewriteValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipMigrationRewriteValidationError) ErrorName() string {
+	return "RelationshipMigrationRewriteValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipMigrationRewriteValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipMigrationRewrite.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipMigrationRewriteValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipMigrationRewriteValidationError{}
+
+// Validate checks the field values on RelationshipPlanMigrationRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RelationshipPlanMigrationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipPlanMigrationRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RelationshipPlanMigrationRequestMultiError, or nil if none found.
+func (m *RelationshipPlanMigrationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipPlanMigrationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := RelationshipPlanMigrationRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RelationshipPlanMigrationRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := RelationshipPlanMigrationRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipPlanMigrationRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipPlanMigrationRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipPlanMigrationRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetRules()) < 1 {
+		err := RelationshipPlanMigrationRequestValidationError{
+			field:  "Rules",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if item == nil {
+			err := RelationshipPlanMigrationRequestValidationError{
+				field:  fmt.Sprintf("Rules[%v]", idx),
+				reason: "value is required",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RelationshipPlanMigrationRequestValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RelationshipPlanMigrationRequestValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RelationshipPlanMigrationRequestValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RelationshipPlanMigrationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipPlanMigrationRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// RelationshipPlanMigrationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RelationshipPlanMigrationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipPlanMigrationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipPlanMigrationRequestMultiError) AllErrors() []error { return m }
+
+// RelationshipPlanMigrationRequestValidationError is the validation error
+// returned by RelationshipPlanMigrationRequest.Validate if the designated
+// constraints aren't met.
+type RelationshipPlanMigrationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipPlanMigrationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipPlanMigrationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipPlanMigrationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipPlanMigrationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipPlanMigrationRequestValidationError) ErrorName() string {
+	return "RelationshipPlanMigrationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipPlanMigrationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipPlanMigrationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipPlanMigrationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipPlanMigrationRequestValidationError{}
+
+var _RelationshipPlanMigrationRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on RelationshipPlanMigrationResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RelationshipPlanMigrationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipPlanMigrationResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// RelationshipPlanMigrationResponseMultiError, or nil if none found.
+func (m *RelationshipPlanMigrationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipPlanMigrationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRewrites() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RelationshipPlanMigrationResponseValidationError{
+						field:  fmt.Sprintf("Rewrites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RelationshipPlanMigrationResponseValidationError{
+						field:  fmt.Sprintf("Rewrites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RelationshipPlanMigrationResponseValidationError{
+					field:  fmt.Sprintf("Rewrites[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RelationshipPlanMigrationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipPlanMigrationResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// RelationshipPlanMigrationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RelationshipPlanMigrationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipPlanMigrationResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipPlanMigrationResponseMultiError) AllErrors() []error { return m }
+
+// RelationshipPlanMigrationResponseValidationError is the validation error
+// returned by RelationshipPlanMigrationResponse.Validate if the designated
+// constraints aren't met.
+type RelationshipPlanMigrationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipPlanMigrationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipPlanMigrationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipPlanMigrationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipPlanMigrationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipPlanMigrationResponseValidationError) ErrorName() string {
+	return "RelationshipPlanMigrationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipPlanMigrationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipPlanMigrationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipPlanMigrationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipPlanMigrationResponseValidationError{}
+
+// Validate checks the field values on RelationshipApplyMigrationRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RelationshipApplyMigrationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipApplyMigrationRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// RelationshipApplyMigrationRequestMultiError, or nil if none found.
+func (m *RelationshipApplyMigrationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipApplyMigrationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := RelationshipApplyMigrationRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RelationshipApplyMigrationRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := RelationshipApplyMigrationRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelationshipApplyMigrationRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelationshipApplyMigrationRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelationshipApplyMigrationRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetRules()) < 1 {
+		err := RelationshipApplyMigrationRequestValidationError{
+			field:  "Rules",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if item == nil {
+			err := RelationshipApplyMigrationRequestValidationError{
+				field:  fmt.Sprintf("Rules[%v]", idx),
+				reason: "value is required",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RelationshipApplyMigrationRequestValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RelationshipApplyMigrationRequestValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RelationshipApplyMigrationRequestValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.GetBatchSize() != 0 {
+
+		if val := m.GetBatchSize(); val < 1 || val > 100 {
+			err := RelationshipApplyMigrationRequestValidationError{
+				field:  "BatchSize",
+				reason: "value must be inside range [1, 100]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RelationshipApplyMigrationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipApplyMigrationRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// RelationshipApplyMigrationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RelationshipApplyMigrationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipApplyMigrationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipApplyMigrationRequestMultiError) AllErrors() []error { return m }
+
+// RelationshipApplyMigrationRequestValidationError is the validation error
+// returned by RelationshipApplyMigrationRequest.Validate if the designated
+// constraints aren't met.
+type RelationshipApplyMigrationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipApplyMigrationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipApplyMigrationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipApplyMigrationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipApplyMigrationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipApplyMigrationRequestValidationError) ErrorName() string {
+	return "RelationshipApplyMigrationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipApplyMigrationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipApplyMigrationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipApplyMigrationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipApplyMigrationRequestValidationError{}
+
+var _RelationshipApplyMigrationRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on RelationshipApplyMigrationResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RelationshipApplyMigrationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationshipApplyMigrationResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// RelationshipApplyMigrationResponseMultiError, or nil if none found.
+func (m *RelationshipApplyMigrationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationshipApplyMigrationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Applied
+
+	// no validation rules for Total
+
+	// no validation rules for SnapToken
+
+	if len(errors) > 0 {
+		return RelationshipApplyMigrationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationshipApplyMigrationResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// RelationshipApplyMigrationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RelationshipApplyMigrationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationshipApplyMigrationResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationshipApplyMigrationResponseMultiError) AllErrors() []error { return m }
+
+// RelationshipApplyMigrationResponseValidationError is the validation error
+// returned by RelationshipApplyMigrationResponse.Validate if the designated
+// constraints aren't met.
+type RelationshipApplyMigrationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationshipApplyMigrationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationshipApplyMigrationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationshipApplyMigrationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationshipApplyMigrationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationshipApplyMigrationResponseValidationError) ErrorName() string {
+	return "RelationshipApplyMigrationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelationshipApplyMigrationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationshipApplyMigrationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationshipApplyMigrationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationshipApplyMigrationResponseValidationError{}
+
+// Validate checks the field values on TenantCreateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TenantCreateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantCreateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TenantCreateRequestMultiError, or nil if none found.
+func (m *TenantCreateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantCreateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetId()) > 64 {
+		err := TenantCreateRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetName()) > 64 {
+		err := TenantCreateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TenantCreateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantCreateRequestMultiError is an error wrapping multiple validation
+// errors returned by TenantCreateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TenantCreateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantCreateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantCreateRequestMultiError) AllErrors() []error { return m }
+
+// TenantCreateRequestValidationError is the validation error returned by
+// TenantCreateRequest.Validate if the designated constraints aren't met.
+type TenantCreateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantCreateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantCreateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantCreateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantCreateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantCreateRequestValidationError) ErrorName() string {
+	return "TenantCreateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TenantCreateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantCreateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantCreateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantCreateRequestValidationError{}
+
+// Validate checks the field values on TenantCreateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TenantCreateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantCreateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TenantCreateResponseMultiError, or nil if none found.
+func (m *TenantCreateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantCreateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTenant()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TenantCreateResponseValidationError{
+					field:  "Tenant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TenantCreateResponseValidationError{
+					field:  "Tenant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTenant()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TenantCreateResponseValidationError{
+				field:  "Tenant",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TenantCreateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantCreateResponseMultiError is an error wrapping multiple validation
+// errors returned by TenantCreateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TenantCreateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantCreateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantCreateResponseMultiError) AllErrors() []error { return m }
+
+// TenantCreateResponseValidationError is the validation error returned by
+// TenantCreateResponse.Validate if the designated constraints aren't met.
+type TenantCreateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantCreateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantCreateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantCreateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantCreateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantCreateResponseValidationError) ErrorName() string {
+	return "TenantCreateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TenantCreateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantCreateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantCreateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantCreateResponseValidationError{}
+
+// Validate checks the field values on TenantDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TenantDeleteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TenantDeleteRequestMultiError, or nil if none found.
+func (m *TenantDeleteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantDeleteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return TenantDeleteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantDeleteRequestMultiError is an error wrapping multiple validation
+// errors returned by TenantDeleteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TenantDeleteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantDeleteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantDeleteRequestMultiError) AllErrors() []error { return m }
+
+// TenantDeleteRequestValidationError is the validation error returned by
+// TenantDeleteRequest.Validate if the designated constraints aren't met.
+type TenantDeleteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantDeleteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantDeleteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantDeleteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantDeleteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantDeleteRequestValidationError) ErrorName() string {
+	return "TenantDeleteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TenantDeleteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantDeleteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantDeleteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantDeleteRequestValidationError{}
+
+// Validate checks the field values on TenantDeleteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TenantDeleteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantDeleteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TenantDeleteResponseMultiError, or nil if none found.
+func (m *TenantDeleteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantDeleteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTenant()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TenantDeleteResponseValidationError{
+					field:  "Tenant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TenantDeleteResponseValidationError{
+					field:  "Tenant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTenant()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TenantDeleteResponseValidationError{
+				field:  "Tenant",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TenantDeleteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantDeleteResponseMultiError is an error wrapping multiple validation
+// errors returned by TenantDeleteResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TenantDeleteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantDeleteResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantDeleteResponseMultiError) AllErrors() []error { return m }
+
+// TenantDeleteResponseValidationError is the validation error returned by
+// TenantDeleteResponse.Validate if the designated constraints aren't met.
+type TenantDeleteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantDeleteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantDeleteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantDeleteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantDeleteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantDeleteResponseValidationError) ErrorName() string {
+	return "TenantDeleteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TenantDeleteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantDeleteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantDeleteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantDeleteResponseValidationError{}
+
+// Validate checks the field values on TenantListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TenantListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TenantListRequestMultiError, or nil if none found.
+func (m *TenantListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPageSize() != 0 {
+
+		if val := m.GetPageSize(); val < 1 || val > 100 {
+			err := TenantListRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be inside range [1, 100]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetContinuousToken() != "" {
+
+	}
+
+	if len(errors) > 0 {
+		return TenantListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantListRequestMultiError is an error wrapping multiple validation errors
+// returned by TenantListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TenantListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantListRequestMultiError) AllErrors() []error { return m }
+
+// TenantListRequestValidationError is the validation error returned by
+// TenantListRequest.Validate if the designated constraints aren't met.
+type TenantListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantListRequestValidationError) ErrorName() string {
+	return "TenantListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TenantListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantListRequestValidationError{}
+
+// Validate checks the field values on TenantListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TenantListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TenantListResponseMultiError, or nil if none found.
+func (m *TenantListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTenants() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TenantListResponseValidationError{
+						field:  fmt.Sprintf("Tenants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TenantListResponseValidationError{
+						field:  fmt.Sprintf("Tenants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TenantListResponseValidationError{
+					field:  fmt.Sprintf("Tenants[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ContinuousToken
+
+	if len(errors) > 0 {
+		return TenantListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantListResponseMultiError is an error wrapping multiple validation errors
+// returned by TenantListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type TenantListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantListResponseMultiError) AllErrors() []error { return m }
+
+// TenantListResponseValidationError is the validation error returned by
+// TenantListResponse.Validate if the designated constraints aren't met.
+type TenantListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantListResponseValidationError) ErrorName() string {
+	return "TenantListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TenantListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantListResponseValidationError{}
+
+// Validate checks the field values on TenantReadRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TenantReadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantReadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TenantReadRequestMultiError, or nil if none found.
+func (m *TenantReadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantReadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := TenantReadRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_TenantReadRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := TenantReadRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TenantReadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantReadRequestMultiError is an error wrapping multiple validation errors
+// returned by TenantReadRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TenantReadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantReadRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantReadRequestMultiError) AllErrors() []error { return m }
+
+// TenantReadRequestValidationError is the validation error returned by
+// TenantReadRequest.Validate if the designated constraints aren't met.
+type TenantReadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantReadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantReadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantReadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantReadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantReadRequestValidationError) ErrorName() string {
+	return "TenantReadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TenantReadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantReadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantReadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantReadRequestValidationError{}
+
+var _TenantReadRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on TenantReadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *TenantReadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantReadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TenantReadResponseMultiError, or nil if none found.
+func (m *TenantReadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantReadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTenant()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TenantReadResponseValidationError{
+					field:  "Tenant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TenantReadResponseValidationError{
+					field:  "Tenant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTenant()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TenantReadResponseValidationError{
+				field:  "Tenant",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Labels
+
+	if len(errors) > 0 {
+		return TenantReadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantReadResponseMultiError is an error wrapping multiple validation errors
+// returned by TenantReadResponse.ValidateAll() if the designated constraints
+// aren't met.
+type TenantReadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantReadResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantReadResponseMultiError) AllErrors() []error { return m }
+
+// TenantReadResponseValidationError is the validation error returned by
+// TenantReadResponse.Validate if the designated constraints aren't met.
+type TenantReadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantReadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantReadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantReadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantReadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantReadResponseValidationError) ErrorName() string {
+	return "TenantReadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TenantReadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantReadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantReadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantReadResponseValidationError{}
+
+// Validate checks the field values on TenantUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *TenantUpdateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TenantUpdateRequestMultiError, or nil if none found.
+func (m *TenantUpdateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantUpdateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := TenantUpdateRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_TenantUpdateRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := TenantUpdateRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Name
+
+	{
+		sorted_keys := make([]string, len(m.GetLabels()))
+		i := 0
+		for key := range m.GetLabels() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetLabels()[key]
+			_ = val
+
+			if utf8.RuneCountInString(key) < 1 {
+				err := TenantUpdateRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value length must be at least 1 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			// no validation rules for Labels[key]
+
+		}
+	}
+
+	// no validation rules for ClearLabels
+
+	if len(errors) > 0 {
+		return TenantUpdateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantUpdateRequestMultiError is an error wrapping multiple validation
+// errors returned by TenantUpdateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TenantUpdateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantUpdateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantUpdateRequestMultiError) AllErrors() []error { return m }
+
+// TenantUpdateRequestValidationError is the validation error returned by
+// TenantUpdateRequest.Validate if the designated constraints aren't met.
+type TenantUpdateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantUpdateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantUpdateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantUpdateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantUpdateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantUpdateRequestValidationError) ErrorName() string {
+	return "TenantUpdateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TenantUpdateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantUpdateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantUpdateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantUpdateRequestValidationError{}
+
+var _TenantUpdateRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on TenantUpdateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *TenantUpdateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantUpdateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TenantUpdateResponseMultiError, or nil if none found.
+func (m *TenantUpdateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantUpdateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTenant()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TenantUpdateResponseValidationError{
+					field:  "Tenant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TenantUpdateResponseValidationError{
+					field:  "Tenant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTenant()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TenantUpdateResponseValidationError{
+				field:  "Tenant",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Labels
+
+	if len(errors) > 0 {
+		return TenantUpdateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantUpdateResponseMultiError is an error wrapping multiple validation
+// errors returned by TenantUpdateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TenantUpdateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantUpdateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantUpdateResponseMultiError) AllErrors() []error { return m }
+
+// TenantUpdateResponseValidationError is the validation error returned by
+// TenantUpdateResponse.Validate if the designated constraints aren't met.
+type TenantUpdateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantUpdateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantUpdateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantUpdateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantUpdateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantUpdateResponseValidationError) ErrorName() string {
+	return "TenantUpdateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TenantUpdateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantUpdateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantUpdateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantUpdateResponseValidationError{}
+
+// Validate checks the field values on AttributeWriteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AttributeWriteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttributeWriteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttributeWriteRequestMultiError, or nil if none found.
+func (m *AttributeWriteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeWriteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := AttributeWriteRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AttributeWriteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := AttributeWriteRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEntity() == nil {
+		err := AttributeWriteRequestValidationError{
+			field:  "Entity",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetEntity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttributeWriteRequestValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttributeWriteRequestValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEntity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttributeWriteRequestValidationError{
+				field:  "Entity",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetAttributes() == nil {
+		err := AttributeWriteRequestValidationError{
+			field:  "Attributes",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAttributes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttributeWriteRequestValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttributeWriteRequestValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttributes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttributeWriteRequestValidationError{
+				field:  "Attributes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AttributeWriteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeWriteRequestMultiError is an error wrapping multiple validation
+// errors returned by AttributeWriteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AttributeWriteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeWriteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeWriteRequestMultiError) AllErrors() []error { return m }
+
+// AttributeWriteRequestValidationError is the validation error returned by
+// AttributeWriteRequest.Validate if the designated constraints aren't met.
+type AttributeWriteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeWriteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttributeWriteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttributeWriteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttributeWriteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttributeWriteRequestValidationError) ErrorName() string {
+	return "AttributeWriteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeWriteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeWriteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeWriteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeWriteRequestValidationError{}
+
+var _AttributeWriteRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on AttributeWriteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AttributeWriteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttributeWriteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttributeWriteResponseMultiError, or nil if none found.
+func (m *AttributeWriteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeWriteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEntity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttributeWriteResponseValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttributeWriteResponseValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEntity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttributeWriteResponseValidationError{
+				field:  "Entity",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AttributeWriteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeWriteResponseMultiError is an error wrapping multiple validation
+// errors returned by AttributeWriteResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AttributeWriteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeWriteResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeWriteResponseMultiError) AllErrors() []error { return m }
+
+// AttributeWriteResponseValidationError is the validation error returned by
+// AttributeWriteResponse.Validate if the designated constraints aren't met.
+type AttributeWriteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeWriteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttributeWriteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttributeWriteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttributeWriteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttributeWriteResponseValidationError) ErrorName() string {
+	return "AttributeWriteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeWriteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeWriteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeWriteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeWriteResponseValidationError{}
+
+// Validate checks the field values on AttributeReadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AttributeReadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttributeReadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttributeReadRequestMultiError, or nil if none found.
+func (m *AttributeReadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeReadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := AttributeReadRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AttributeReadRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := AttributeReadRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEntity() == nil {
+		err := AttributeReadRequestValidationError{
+			field:  "Entity",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetEntity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttributeReadRequestValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttributeReadRequestValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEntity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttributeReadRequestValidationError{
+				field:  "Entity",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AttributeReadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeReadRequestMultiError is an error wrapping multiple validation
+// errors returned by AttributeReadRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AttributeReadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeReadRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeReadRequestMultiError) AllErrors() []error { return m }
+
+// AttributeReadRequestValidationError is the validation error returned by
+// AttributeReadRequest.Validate if the designated constraints aren't met.
+type AttributeReadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeReadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttributeReadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttributeReadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttributeReadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttributeReadRequestValidationError) ErrorName() string {
+	return "AttributeReadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeReadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeReadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeReadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeReadRequestValidationError{}
+
+var _AttributeReadRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on AttributeReadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AttributeReadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttributeReadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttributeReadResponseMultiError, or nil if none found.
+func (m *AttributeReadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeReadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEntity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttributeReadResponseValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttributeReadResponseValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEntity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttributeReadResponseValidationError{
+				field:  "Entity",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAttributes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttributeReadResponseValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttributeReadResponseValidationError{
+					field:  "Attributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttributes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttributeReadResponseValidationError{
+				field:  "Attributes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AttributeReadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeReadResponseMultiError is an error wrapping multiple validation
+// errors returned by AttributeReadResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AttributeReadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeReadResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeReadResponseMultiError) AllErrors() []error { return m }
+
+// AttributeReadResponseValidationError is the validation error returned by
+// AttributeReadResponse.Validate if the designated constraints aren't met.
+type AttributeReadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeReadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttributeReadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttributeReadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttributeReadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttributeReadResponseValidationError) ErrorName() string {
+	return "AttributeReadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeReadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeReadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeReadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeReadResponseValidationError{}
+
+// Validate checks the field values on AttributeDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AttributeDeleteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttributeDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttributeDeleteRequestMultiError, or nil if none found.
+func (m *AttributeDeleteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeDeleteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := AttributeDeleteRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AttributeDeleteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := AttributeDeleteRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEntity() == nil {
+		err := AttributeDeleteRequestValidationError{
+			field:  "Entity",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetEntity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttributeDeleteRequestValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttributeDeleteRequestValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEntity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttributeDeleteRequestValidationError{
+				field:  "Entity",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AttributeDeleteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeDeleteRequestMultiError is an error wrapping multiple validation
+// errors returned by AttributeDeleteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AttributeDeleteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeDeleteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeDeleteRequestMultiError) AllErrors() []error { return m }
+
+// AttributeDeleteRequestValidationError is the validation error returned by
+// AttributeDeleteRequest.Validate if the designated constraints aren't met.
+type AttributeDeleteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeDeleteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttributeDeleteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttributeDeleteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttributeDeleteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttributeDeleteRequestValidationError) ErrorName() string {
+	return "AttributeDeleteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeDeleteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeDeleteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeDeleteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeDeleteRequestValidationError{}
+
+var _AttributeDeleteRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on AttributeDeleteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AttributeDeleteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttributeDeleteResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttributeDeleteResponseMultiError, or nil if none found.
+func (m *AttributeDeleteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeDeleteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEntity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttributeDeleteResponseValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttributeDeleteResponseValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEntity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttributeDeleteResponseValidationError{
+				field:  "Entity",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AttributeDeleteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeDeleteResponseMultiError is an error wrapping multiple validation
+// errors returned by AttributeDeleteResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AttributeDeleteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeDeleteResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeDeleteResponseMultiError) AllErrors() []error { return m }
+
+// AttributeDeleteResponseValidationError is the validation error returned by
+// AttributeDeleteResponse.Validate if the designated constraints aren't met.
+type AttributeDeleteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeDeleteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttributeDeleteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttributeDeleteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttributeDeleteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttributeDeleteResponseValidationError) ErrorName() string {
+	return "AttributeDeleteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeDeleteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeDeleteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeDeleteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeDeleteResponseValidationError{}
+
+// Validate checks the field values on TenantSettings with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TenantSettings) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantSettings with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TenantSettingsMultiError,
+// or nil if none found.
+func (m *TenantSettings) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantSettings) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StrictValidation
+
+	// no validation rules for Wildcards
+
+	if all {
+		switch v := interface{}(m.GetCacheTtl()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TenantSettingsValidationError{
+					field:  "CacheTtl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TenantSettingsValidationError{
+					field:  "CacheTtl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCacheTtl()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TenantSettingsValidationError{
+				field:  "CacheTtl",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Depth
+
+	// no validation rules for MaxDepth
+
+	if len(errors) > 0 {
+		return TenantSettingsMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantSettingsMultiError is an error wrapping multiple validation errors
+// returned by TenantSettings.ValidateAll() if the designated constraints
+// aren't met.
+type TenantSettingsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantSettingsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantSettingsMultiError) AllErrors() []error { return m }
+
+// TenantSettingsValidationError is the validation error returned by
+// TenantSettings.Validate if the designated constraints aren't met.
+type TenantSettingsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantSettingsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantSettingsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantSettingsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantSettingsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantSettingsValidationError) ErrorName() string { return "TenantSettingsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TenantSettingsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantSettings.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantSettingsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantSettingsValidationError{}
+
+// Validate checks the field values on SettingsReadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *SettingsReadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SettingsReadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SettingsReadRequestMultiError, or nil if none found.
+func (m *SettingsReadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SettingsReadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := SettingsReadRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SettingsReadRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SettingsReadRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SettingsReadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SettingsReadRequestMultiError is an error wrapping multiple validation
+// errors returned by SettingsReadRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SettingsReadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SettingsReadRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SettingsReadRequestMultiError) AllErrors() []error { return m }
+
+// SettingsReadRequestValidationError is the validation error returned by
+// SettingsReadRequest.Validate if the designated constraints aren't met.
+type SettingsReadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SettingsReadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SettingsReadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SettingsReadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SettingsReadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SettingsReadRequestValidationError) ErrorName() string {
+	return "SettingsReadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SettingsReadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSettingsReadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SettingsReadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SettingsReadRequestValidationError{}
+
+var _SettingsReadRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on SettingsReadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *SettingsReadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SettingsReadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SettingsReadResponseMultiError, or nil if none found.
+func (m *SettingsReadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SettingsReadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSettings()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SettingsReadResponseValidationError{
+					field:  "Settings",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SettingsReadResponseValidationError{
+					field:  "Settings",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSettings()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SettingsReadResponseValidationError{
+				field:  "Settings",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SettingsReadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SettingsReadResponseMultiError is an error wrapping multiple validation
+// errors returned by SettingsReadResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SettingsReadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SettingsReadResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SettingsReadResponseMultiError) AllErrors() []error { return m }
+
+// SettingsReadResponseValidationError is the validation error returned by
+// SettingsReadResponse.Validate if the designated constraints aren't met.
+type SettingsReadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SettingsReadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SettingsReadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SettingsReadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SettingsReadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SettingsReadResponseValidationError) ErrorName() string {
+	return "SettingsReadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SettingsReadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSettingsReadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SettingsReadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SettingsReadResponseValidationError{}
+
+// Validate checks the field values on SettingsWriteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *SettingsWriteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SettingsWriteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SettingsWriteRequestMultiError, or nil if none found.
+func (m *SettingsWriteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SettingsWriteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := SettingsWriteRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SettingsWriteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SettingsWriteRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.StrictValidation != nil {
+
+		// no validation rules for StrictValidation
+
+	}
+
+	if m.Wildcards != nil {
+
+		// no validation rules for Wildcards
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetCacheTtl()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SettingsWriteRequestValidationError{
+					field:  "CacheTtl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SettingsWriteRequestValidationError{
+					field:  "CacheTtl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCacheTtl()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SettingsWriteRequestValidationError{
+				field:  "CacheTtl",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Depth != nil {
+
+		if m.GetDepth() < 0 {
+			err := SettingsWriteRequestValidationError{
+				field:  "Depth",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.MaxDepth != nil {
+
+		if m.GetMaxDepth() != 0 {
+
+			if m.GetMaxDepth() < 3 {
+				err := SettingsWriteRequestValidationError{
+					field:  "MaxDepth",
+					reason: "value must be greater than or equal to 3",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SettingsWriteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SettingsWriteRequestMultiError is an error wrapping multiple validation
+// errors returned by SettingsWriteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SettingsWriteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SettingsWriteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SettingsWriteRequestMultiError) AllErrors() []error { return m }
+
+// SettingsWriteRequestValidationError is the validation error returned by
+// SettingsWriteRequest.Validate if the designated constraints aren't met.
+type SettingsWriteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SettingsWriteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SettingsWriteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SettingsWriteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SettingsWriteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SettingsWriteRequestValidationError) ErrorName() string {
+	return "SettingsWriteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SettingsWriteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSettingsWriteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SettingsWriteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SettingsWriteRequestValidationError{}
+
+var _SettingsWriteRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on SettingsWriteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *SettingsWriteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SettingsWriteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SettingsWriteResponseMultiError, or nil if none found.
+func (m *SettingsWriteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SettingsWriteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSettings()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SettingsWriteResponseValidationError{
+					field:  "Settings",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SettingsWriteResponseValidationError{
+					field:  "Settings",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSettings()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SettingsWriteResponseValidationError{
+				field:  "Settings",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SettingsWriteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SettingsWriteResponseMultiError is an error wrapping multiple validation
+// errors returned by SettingsWriteResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SettingsWriteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SettingsWriteResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SettingsWriteResponseMultiError) AllErrors() []error { return m }
+
+// SettingsWriteResponseValidationError is the validation error returned by
+// SettingsWriteResponse.Validate if the designated constraints aren't met.
+type SettingsWriteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SettingsWriteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SettingsWriteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SettingsWriteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SettingsWriteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SettingsWriteResponseValidationError) ErrorName() string {
+	return "SettingsWriteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SettingsWriteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSettingsWriteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SettingsWriteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SettingsWriteResponseValidationError{}
+
+// Validate checks the field values on IdentityMapRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *IdentityMapRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IdentityMapRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IdentityMapRequestMultiError, or nil if none found.
+func (m *IdentityMapRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IdentityMapRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := IdentityMapRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_IdentityMapRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := IdentityMapRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetSubjectType()) > 64 {
+		err := IdentityMapRequestValidationError{
+			field:  "SubjectType",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_IdentityMapRequest_SubjectType_Pattern.MatchString(m.GetSubjectType()) {
+		err := IdentityMapRequestValidationError{
+			field:  "SubjectType",
+			reason: "value does not match regex pattern \"^([a-z][a-z0-9_]{1,62}[a-z0-9])$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAlias()) > 256 {
+		err := IdentityMapRequestValidationError{
+			field:  "Alias",
+			reason: "value length must be at most 256 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_IdentityMapRequest_Alias_Pattern.MatchString(m.GetAlias()) {
+		err := IdentityMapRequestValidationError{
+			field:  "Alias",
+			reason: "value does not match regex pattern \"^[^:]+:.+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetSubjectId()) > 128 {
+		err := IdentityMapRequestValidationError{
+			field:  "SubjectId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_IdentityMapRequest_SubjectId_Pattern.MatchString(m.GetSubjectId()) {
+		err := IdentityMapRequestValidationError{
+			field:  "SubjectId",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_][a-zA-Z0-9_|-]{0,127})$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return IdentityMapRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// IdentityMapRequestMultiError is an error wrapping multiple validation errors
+// returned by IdentityMapRequest.ValidateAll() if the designated constraints
+// aren't met.
+type IdentityMapRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IdentityMapRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IdentityMapRequestMultiError) AllErrors() []error { return m }
+
+// IdentityMapRequestValidationError is the validation error returned by
+// IdentityMapRequest.Validate if the designated constraints aren't met.
+type IdentityMapRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IdentityMapRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IdentityMapRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IdentityMapRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IdentityMapRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IdentityMapRequestValidationError) ErrorName() string {
+	return "IdentityMapRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IdentityMapRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIdentityMapRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IdentityMapRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IdentityMapRequestValidationError{}
+
+var _IdentityMapRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+var _IdentityMapRequest_SubjectType_Pattern = regexp.MustCompile("^([a-z][a-z0-9_]{1,62}[a-z0-9])$")
+
+var _IdentityMapRequest_Alias_Pattern = regexp.MustCompile("^[^:]+:.+$")
+
+var _IdentityMapRequest_SubjectId_Pattern = regexp.MustCompile("^([a-zA-Z0-9_][a-zA-Z0-9_|-]{0,127})$")
+
+// Validate checks the field values on IdentityMapResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *IdentityMapResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IdentityMapResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IdentityMapResponseMultiError, or nil if none found.
+func (m *IdentityMapResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IdentityMapResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SubjectType
+
+	// no validation rules for Alias
+
+	// no validation rules for SubjectId
+
+	if len(errors) > 0 {
+		return IdentityMapResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// IdentityMapResponseMultiError is an error wrapping multiple validation
+// errors returned by IdentityMapResponse.ValidateAll() if the designated
+// constraints aren't met.
+type IdentityMapResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IdentityMapResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IdentityMapResponseMultiError) AllErrors() []error { return m }
+
+// IdentityMapResponseValidationError is the validation error returned by
+// IdentityMapResponse.Validate if the designated constraints aren't met.
+type IdentityMapResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IdentityMapResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IdentityMapResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IdentityMapResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IdentityMapResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IdentityMapResponseValidationError) ErrorName() string {
+	return "IdentityMapResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IdentityMapResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIdentityMapResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IdentityMapResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IdentityMapResponseValidationError{}
+
+// Validate checks the field values on IdentityUnmapRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *IdentityUnmapRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IdentityUnmapRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IdentityUnmapRequestMultiError, or nil if none found.
+func (m *IdentityUnmapRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IdentityUnmapRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := IdentityUnmapRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_IdentityUnmapRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := IdentityUnmapRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetSubjectType()) > 64 {
+		err := IdentityUnmapRequestValidationError{
+			field:  "SubjectType",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_IdentityUnmapRequest_SubjectType_Pattern.MatchString(m.GetSubjectType()) {
+		err := IdentityUnmapRequestValidationError{
+			field:  "SubjectType",
+			reason: "value does not match regex pattern \"^([a-z][a-z0-9_]{1,62}[a-z0-9])$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAlias()) > 256 {
+		err := IdentityUnmapRequestValidationError{
+			field:  "Alias",
+			reason: "value length must be at most 256 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_IdentityUnmapRequest_Alias_Pattern.MatchString(m.GetAlias()) {
+		err := IdentityUnmapRequestValidationError{
+			field:  "Alias",
+			reason: "value does not match regex pattern \"^[^:]+:.+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return IdentityUnmapRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// IdentityUnmapRequestMultiError is an error wrapping multiple validation
+// errors returned by IdentityUnmapRequest.ValidateAll() if the designated
+// constraints aren't met.
+type IdentityUnmapRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IdentityUnmapRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IdentityUnmapRequestMultiError) AllErrors() []error { return m }
+
+// IdentityUnmapRequestValidationError is the validation error returned by
+// IdentityUnmapRequest.Validate if the designated constraints aren't met.
+type IdentityUnmapRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IdentityUnmapRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IdentityUnmapRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IdentityUnmapRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IdentityUnmapRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IdentityUnmapRequestValidationError) ErrorName() string {
+	return "IdentityUnmapRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IdentityUnmapRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIdentityUnmapRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IdentityUnmapRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IdentityUnmapRequestValidationError{}
+
+var _IdentityUnmapRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+var _IdentityUnmapRequest_SubjectType_Pattern = regexp.MustCompile("^([a-z][a-z0-9_]{1,62}[a-z0-9])$")
+
+var _IdentityUnmapRequest_Alias_Pattern = regexp.MustCompile("^[^:]+:.+$")
+
+// Validate checks the field values on IdentityUnmapResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *IdentityUnmapResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IdentityUnmapResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IdentityUnmapResponseMultiError, or nil if none found.
+func (m *IdentityUnmapResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IdentityUnmapResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SubjectType
+
+	// no validation rules for Alias
+
+	if len(errors) > 0 {
+		return IdentityUnmapResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// IdentityUnmapResponseMultiError is an error wrapping multiple validation
+// errors returned by IdentityUnmapResponse.ValidateAll() if the designated
+// constraints aren't met.
+type IdentityUnmapResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IdentityUnmapResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IdentityUnmapResponseMultiError) AllErrors() []error { return m }
+
+// IdentityUnmapResponseValidationError is the validation error returned by
+// IdentityUnmapResponse.Validate if the designated constraints aren't met.
+type IdentityUnmapResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IdentityUnmapResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IdentityUnmapResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IdentityUnmapResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IdentityUnmapResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IdentityUnmapResponseValidationError) ErrorName() string {
+	return "IdentityUnmapResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IdentityUnmapResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIdentityUnmapResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IdentityUnmapResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IdentityUnmapResponseValidationError{}
+
+// Validate checks the field values on WatchRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WatchRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WatchRequestMultiError, or
+// nil if none found.
+func (m *WatchRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := WatchRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_WatchRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := WatchRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for SnapToken
+
+	if len(errors) > 0 {
+		return WatchRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchRequest.ValidateAll() if the designated constraints aren't met.
+type WatchRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchRequestMultiError) AllErrors() []error { return m }
+
+// WatchRequestValidationError is the validation error returned by
+// WatchRequest.Validate if the designated constraints aren't met.
+type WatchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchRequestValidationError) ErrorName() string { return "WatchRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WatchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchRequestValidationError{}
+
+var _WatchRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on WatchChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WatchChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WatchChangeMultiError, or
+// nil if none found.
+func (m *WatchChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Operation
+
+	if all {
+		switch v := interface{}(m.GetTuple()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchChangeValidationError{
+					field:  "Tuple",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchChangeValidationError{
+					field:  "Tuple",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTuple()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchChangeValidationError{
+				field:  "Tuple",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SchemaVersion
+
+	if len(errors) > 0 {
+		return WatchChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchChangeMultiError is an error wrapping multiple validation errors
+// returned by WatchChange.ValidateAll() if the designated constraints aren't met.
+type WatchChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchChangeMultiError) AllErrors() []error { return m }
+
+// WatchChangeValidationError is the validation error returned by
+// WatchChange.Validate if the designated constraints aren't met.
+type WatchChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchChangeValidationError) ErrorName() string { return "WatchChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WatchChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchChangeValidationError{}
+
+// Validate checks the field values on WatchResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WatchResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WatchResponseMultiError, or
+// nil if none found.
+func (m *WatchResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SnapToken
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WatchResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WatchResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WatchResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WatchResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchResponseMultiError is an error wrapping multiple validation errors
+// returned by WatchResponse.ValidateAll() if the designated constraints
+// aren't met.
+type WatchResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchResponseMultiError) AllErrors() []error { return m }
+
+// WatchResponseValidationError is the validation error returned by
+// WatchResponse.Validate if the designated constraints aren't met.
+type WatchResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchResponseValidationError) ErrorName() string { return "WatchResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WatchResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchResponseValidationError{}
+
+// Validate checks the field values on OpenFGAImportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *OpenFGAImportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OpenFGAImportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OpenFGAImportRequestMultiError, or nil if none found.
+func (m *OpenFGAImportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OpenFGAImportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := OpenFGAImportRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_OpenFGAImportRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := OpenFGAImportRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAuthorizationModel()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OpenFGAImportRequestValidationError{
+					field:  "AuthorizationModel",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OpenFGAImportRequestValidationError{
+					field:  "AuthorizationModel",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAuthorizationModel()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OpenFGAImportRequestValidationError{
+				field:  "AuthorizationModel",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SchemaVersion
+
+	for idx, item := range m.GetTupleKeys() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OpenFGAImportRequestValidationError{
+						field:  fmt.Sprintf("TupleKeys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OpenFGAImportRequestValidationError{
+						field:  fmt.Sprintf("TupleKeys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OpenFGAImportRequestValidationError{
+					field:  fmt.Sprintf("TupleKeys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return OpenFGAImportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// OpenFGAImportRequestMultiError is an error wrapping multiple validation
+// errors returned by OpenFGAImportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type OpenFGAImportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OpenFGAImportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OpenFGAImportRequestMultiError) AllErrors() []error { return m }
+
+// OpenFGAImportRequestValidationError is the validation error returned by
+// OpenFGAImportRequest.Validate if the designated constraints aren't met.
+type OpenFGAImportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OpenFGAImportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OpenFGAImportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OpenFGAImportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OpenFGAImportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OpenFGAImportRequestValidationError) ErrorName() string {
+	return "OpenFGAImportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OpenFGAImportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOpenFGAImportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OpenFGAImportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OpenFGAImportRequestValidationError{}
+
+var _OpenFGAImportRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on OpenFGAImportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *OpenFGAImportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OpenFGAImportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OpenFGAImportResponseMultiError, or nil if none found.
+func (m *OpenFGAImportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OpenFGAImportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	// no validation rules for Imported
+
+	// no validation rules for SnapToken
+
+	if len(errors) > 0 {
+		return OpenFGAImportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// OpenFGAImportResponseMultiError is an error wrapping multiple validation
+// errors returned by OpenFGAImportResponse.ValidateAll() if the designated
+// constraints aren't met.
+type OpenFGAImportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OpenFGAImportResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OpenFGAImportResponseMultiError) AllErrors() []error { return m }
+
+// OpenFGAImportResponseValidationError is the validation error returned by
+// OpenFGAImportResponse.Validate if the designated constraints aren't met.
+type OpenFGAImportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OpenFGAImportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OpenFGAImportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OpenFGAImportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OpenFGAImportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OpenFGAImportResponseValidationError) ErrorName() string {
+	return "OpenFGAImportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OpenFGAImportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOpenFGAImportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OpenFGAImportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OpenFGAImportResponseValidationError{}
+
+// Validate checks the field values on OpenFGATupleKey with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OpenFGATupleKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OpenFGATupleKey with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OpenFGATupleKeyMultiError, or nil if none found.
+func (m *OpenFGATupleKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OpenFGATupleKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUser()) < 1 {
+		err := OpenFGATupleKeyValidationError{
+			field:  "User",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRelation()) < 1 {
+		err := OpenFGATupleKeyValidationError{
+			field:  "Relation",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetObject()) < 1 {
+		err := OpenFGATupleKeyValidationError{
+			field:  "Object",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return OpenFGATupleKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// OpenFGATupleKeyMultiError is an error wrapping multiple validation errors
+// returned by OpenFGATupleKey.ValidateAll() if the designated constraints
+// aren't met.
+type OpenFGATupleKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OpenFGATupleKeyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OpenFGATupleKeyMultiError) AllErrors() []error { return m }
+
+// OpenFGATupleKeyValidationError is the validation error returned by
+// OpenFGATupleKey.Validate if the designated constraints aren't met.
+type OpenFGATupleKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OpenFGATupleKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OpenFGATupleKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OpenFGATupleKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OpenFGATupleKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OpenFGATupleKeyValidationError) ErrorName() string { return "OpenFGATupleKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OpenFGATupleKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOpenFGATupleKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OpenFGATupleKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OpenFGATupleKeyValidationError{}
+
+// Validate checks the field values on OpenFGAAuthorizationModel with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *OpenFGAAuthorizationModel) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OpenFGAAuthorizationModel with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OpenFGAAuthorizationModelMultiError, or nil if none found.
+func (m *OpenFGAAuthorizationModel) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OpenFGAAuthorizationModel) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	for idx, item := range m.GetTypeDefinitions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OpenFGAAuthorizationModelValidationError{
+						field:  fmt.Sprintf("TypeDefinitions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OpenFGAAuthorizationModelValidationError{
+						field:  fmt.Sprintf("TypeDefinitions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OpenFGAAuthorizationModelValidationError{
+					field:  fmt.Sprintf("TypeDefinitions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return OpenFGAAuthorizationModelMultiError(errors)
+	}
+
+	return nil
+}
+
+// OpenFGAAuthorizationModelMultiError is an error wrapping multiple validation
+// errors returned by OpenFGAAuthorizationModel.ValidateAll() if the
+// designated constraints aren't met.
+type OpenFGAAuthorizationModelMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OpenFGAAuthorizationModelMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OpenFGAAuthorizationModelMultiError) AllErrors() []error { return m }
+
+// OpenFGAAuthorizationModelValidationError is the validation error returned by
+// OpenFGAAuthorizationModel.Validate if the designated constraints aren't met.
+type OpenFGAAuthorizationModelValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OpenFGAAuthorizationModelValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OpenFGAAuthorizationModelValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OpenFGAAuthorizationModelValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OpenFGAAuthorizationModelValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OpenFGAAuthorizationModelValidationError) ErrorName() string {
+	return "OpenFGAAuthorizationModelValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OpenFGAAuthorizationModelValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOpenFGAAuthorizationModel.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OpenFGAAuthorizationModelValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OpenFGAAuthorizationModelValidationError{}
+
+// Validate checks the field values on OpenFGATypeDefinition with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *OpenFGATypeDefinition) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OpenFGATypeDefinition with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OpenFGATypeDefinitionMultiError, or nil if none found.
+func (m *OpenFGATypeDefinition) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OpenFGATypeDefinition) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	{
+		sorted_keys := make([]string, len(m.GetRelations()))
+		i := 0
+		for key := range m.GetRelations() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetRelations()[key]
+			_ = val
+
+			// no validation rules for Relations[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, OpenFGATypeDefinitionValidationError{
+							field:  fmt.Sprintf("Relations[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, OpenFGATypeDefinitionValidationError{
+							field:  fmt.Sprintf("Relations[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return OpenFGATypeDefinitionValidationError{
+						field:  fmt.Sprintf("Relations[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OpenFGATypeDefinitionValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OpenFGATypeDefinitionValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OpenFGATypeDefinitionValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OpenFGATypeDefinitionMultiError(errors)
+	}
+
+	return nil
+}
+
+// OpenFGATypeDefinitionMultiError is an error wrapping multiple validation
+// errors returned by OpenFGATypeDefinition.ValidateAll() if the designated
+// constraints aren't met.
+type OpenFGATypeDefinitionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OpenFGATypeDefinitionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OpenFGATypeDefinitionMultiError) AllErrors() []error { return m }
+
+// OpenFGATypeDefinitionValidationError is the validation error returned by
+// OpenFGATypeDefinition.Validate if the designated constraints aren't met.
+type OpenFGATypeDefinitionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OpenFGATypeDefinitionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OpenFGATypeDefinitionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OpenFGATypeDefinitionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OpenFGATypeDefinitionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OpenFGATypeDefinitionValidationError) ErrorName() string {
+	return "OpenFGATypeDefinitionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OpenFGATypeDefinitionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOpenFGATypeDefinition.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OpenFGATypeDefinitionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OpenFGATypeDefinitionValidationError{}
+
+// Validate checks the field values on OpenFGAMetadata with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OpenFGAMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OpenFGAMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OpenFGAMetadataMultiError, or nil if none found.
+func (m *OpenFGAMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OpenFGAMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	{
+		sorted_keys := make([]string, len(m.GetRelations()))
+		i := 0
+		for key := range m.GetRelations() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetRelations()[key]
+			_ = val
+
+			// no validation rules for Relations[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, OpenFGAMetadataValidationError{
+							field:  fmt.Sprintf("Relations[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, OpenFGAMetadataValidationError{
+							field:  fmt.Sprintf("Relations[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return OpenFGAMetadataValidationError{
+						field:  fmt.Sprintf("Relations[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return OpenFGAMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// OpenFGAMetadataMultiError is an error wrapping multiple validation errors
+// returned by OpenFGAMetadata.ValidateAll() if the designated constraints
+// aren't met.
+type OpenFGAMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OpenFGAMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OpenFGAMetadataMultiError) AllErrors() []error { return m }
+
+// OpenFGAMetadataValidationError is the validation error returned by
+// OpenFGAMetadata.Validate if the designated constraints aren't met.
+type OpenFGAMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OpenFGAMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OpenFGAMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OpenFGAMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OpenFGAMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OpenFGAMetadataValidationError) ErrorName() string { return "OpenFGAMetadataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OpenFGAMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOpenFGAMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OpenFGAMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OpenFGAMetadataValidationError{}
+
+// Validate checks the field values on OpenFGARelationMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *OpenFGARelationMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OpenFGARelationMetadata with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OpenFGARelationMetadataMultiError, or nil if none found.
+func (m *OpenFGARelationMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OpenFGARelationMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDirectlyRelatedUserTypes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OpenFGARelationMetadataValidationError{
+						field:  fmt.Sprintf("DirectlyRelatedUserTypes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OpenFGARelationMetadataValidationError{
+						field:  fmt.Sprintf("DirectlyRelatedUserTypes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OpenFGARelationMetadataValidationError{
+					field:  fmt.Sprintf("DirectlyRelatedUserTypes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return OpenFGARelationMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// OpenFGARelationMetadataMultiError is an error wrapping multiple validation
+// errors returned by OpenFGARelationMetadata.ValidateAll() if the designated
+// constraints aren't met.
+type OpenFGARelationMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OpenFGARelationMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OpenFGARelationMetadataMultiError) AllErrors() []error { return m }
+
+// OpenFGARelationMetadataValidationError is the validation error returned by
+// OpenFGARelationMetadata.Validate if the designated constraints aren't met.
+type OpenFGARelationMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OpenFGARelationMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OpenFGARelationMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OpenFGARelationMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OpenFGARelationMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OpenFGARelationMetadataValidationError) ErrorName() string {
+	return "OpenFGARelationMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OpenFGARelationMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOpenFGARelationMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OpenFGARelationMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OpenFGARelationMetadataValidationError{}
+
+// Validate checks the field values on OpenFGARelationReference with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *OpenFGARelationReference) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OpenFGARelationReference with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OpenFGARelationReferenceMultiError, or nil if none found.
+func (m *OpenFGARelationReference) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OpenFGARelationReference) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Relation
+
+	if all {
+		switch v := interface{}(m.GetWildcard()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OpenFGARelationReferenceValidationError{
+					field:  "Wildcard",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OpenFGARelationReferenceValidationError{
+					field:  "Wildcard",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWildcard()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OpenFGARelationReferenceValidationError{
+				field:  "Wildcard",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OpenFGARelationReferenceMultiError(errors)
+	}
+
+	return nil
+}
+
+// OpenFGARelationReferenceMultiError is an error wrapping multiple validation
+// errors returned by OpenFGARelationReference.ValidateAll() if the designated
+// constraints aren't met.
+type OpenFGARelationReferenceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OpenFGARelationReferenceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OpenFGARelationReferenceMultiError) AllErrors() []error { return m }
+
+// OpenFGARelationReferenceValidationError is the validation error returned by
+// OpenFGARelationReference.Validate if the designated constraints aren't met.
+type OpenFGARelationReferenceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OpenFGARelationReferenceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OpenFGARelationReferenceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OpenFGARelationReferenceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OpenFGARelationReferenceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OpenFGARelationReferenceValidationError) ErrorName() string {
+	return "OpenFGARelationReferenceValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OpenFGARelationReferenceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOpenFGARelationReference.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OpenFGARelationReferenceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OpenFGARelationReferenceValidationError{}
+
+// Validate checks the field values on OpenFGAUserset with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OpenFGAUserset) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OpenFGAUserset with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OpenFGAUsersetMultiError,
+// or nil if none found.
+func (m *OpenFGAUserset) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OpenFGAUserset) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch m.Userset.(type) {
+
+	case *OpenFGAUserset_This:
+
+		if all {
+			switch v := interface{}(m.GetThis()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OpenFGAUsersetValidationError{
+						field:  "This",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OpenFGAUsersetValidationError{
+						field:  "This",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetThis()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OpenFGAUsersetValidationError{
+					field:  "This",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *OpenFGAUserset_ComputedUserset:
+
+		if all {
+			switch v := interface{}(m.GetComputedUserset()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OpenFGAUsersetValidationError{
+						field:  "ComputedUserset",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OpenFGAUsersetValidationError{
+						field:  "ComputedUserset",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetComputedUserset()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OpenFGAUsersetValidationError{
+					field:  "ComputedUserset",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *OpenFGAUserset_TupleToUserset:
+
+		if all {
+			switch v := interface{}(m.GetTupleToUserset()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OpenFGAUsersetValidationError{
+						field:  "TupleToUserset",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OpenFGAUsersetValidationError{
+						field:  "TupleToUserset",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTupleToUserset()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OpenFGAUsersetValidationError{
+					field:  "TupleToUserset",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *OpenFGAUserset_Union:
+
+		if all {
+			switch v := interface{}(m.GetUnion()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OpenFGAUsersetValidationError{
+						field:  "Union",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OpenFGAUsersetValidationError{
+						field:  "Union",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUnion()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OpenFGAUsersetValidationError{
+					field:  "Union",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *OpenFGAUserset_Intersection:
+
+		if all {
+			switch v := interface{}(m.GetIntersection()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OpenFGAUsersetValidationError{
+						field:  "Intersection",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OpenFGAUsersetValidationError{
+						field:  "Intersection",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetIntersection()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OpenFGAUsersetValidationError{
+					field:  "Intersection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *OpenFGAUserset_Difference:
+
+		if all {
+			switch v := interface{}(m.GetDifference()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OpenFGAUsersetValidationError{
+						field:  "Difference",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OpenFGAUsersetValidationError{
+						field:  "Difference",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDifference()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OpenFGAUsersetValidationError{
+					field:  "Difference",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return OpenFGAUsersetMultiError(errors)
+	}
+
+	return nil
+}
+
+// OpenFGAUsersetMultiError is an error wrapping multiple validation errors
+// returned by OpenFGAUserset.ValidateAll() if the designated constraints
+// aren't met.
+type OpenFGAUsersetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OpenFGAUsersetMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OpenFGAUsersetMultiError) AllErrors() []error { return m }
+
+// OpenFGAUsersetValidationError is the validation error returned by
+// OpenFGAUserset.Validate if the designated constraints aren't met.
+type OpenFGAUsersetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OpenFGAUsersetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OpenFGAUsersetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OpenFGAUsersetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OpenFGAUsersetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OpenFGAUsersetValidationError) ErrorName() string { return "OpenFGAUsersetValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OpenFGAUsersetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOpenFGAUserset.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OpenFGAUsersetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OpenFGAUsersetValidationError{}
+
+// Validate checks the field values on OpenFGAObjectRelation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *OpenFGAObjectRelation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OpenFGAObjectRelation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OpenFGAObjectRelationMultiError, or nil if none found.
+func (m *OpenFGAObjectRelation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OpenFGAObjectRelation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Relation
+
+	if len(errors) > 0 {
+		return OpenFGAObjectRelationMultiError(errors)
+	}
+
+	return nil
+}
+
+// OpenFGAObjectRelationMultiError is an error wrapping multiple validation
+// errors returned by OpenFGAObjectRelation.ValidateAll() if the designated
+// constraints aren't met.
+type OpenFGAObjectRelationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OpenFGAObjectRelationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OpenFGAObjectRelationMultiError) AllErrors() []error { return m }
+
+// OpenFGAObjectRelationValidationError is the validation error returned by
+// OpenFGAObjectRelation.Validate if the designated constraints aren't met.
+type OpenFGAObjectRelationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OpenFGAObjectRelationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OpenFGAObjectRelationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OpenFGAObjectRelationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OpenFGAObjectRelationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OpenFGAObjectRelationValidationError) ErrorName() string {
+	return "OpenFGAObjectRelationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OpenFGAObjectRelationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOpenFGAObjectRelation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OpenFGAObjectRelationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OpenFGAObjectRelationValidationError{}
+
+// Validate checks the field values on OpenFGATupleToUserset with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *OpenFGATupleToUserset) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OpenFGATupleToUserset with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OpenFGATupleToUsersetMultiError, or nil if none found.
+func (m *OpenFGATupleToUserset) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OpenFGATupleToUserset) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTupleset()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OpenFGATupleToUsersetValidationError{
+					field:  "Tupleset",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OpenFGATupleToUsersetValidationError{
+					field:  "Tupleset",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTupleset()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OpenFGATupleToUsersetValidationError{
+				field:  "Tupleset",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetComputedUserset()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OpenFGATupleToUsersetValidationError{
+					field:  "ComputedUserset",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OpenFGATupleToUsersetValidationError{
+					field:  "ComputedUserset",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetComputedUserset()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OpenFGATupleToUsersetValidationError{
+				field:  "ComputedUserset",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OpenFGATupleToUsersetMultiError(errors)
+	}
+
+	return nil
+}
+
+// OpenFGATupleToUsersetMultiError is an error wrapping multiple validation
+// errors returned by OpenFGATupleToUserset.ValidateAll() if the designated
+// constraints aren't met.
+type OpenFGATupleToUsersetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OpenFGATupleToUsersetMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OpenFGATupleToUsersetMultiError) AllErrors() []error { return m }
+
+// OpenFGATupleToUsersetValidationError is the validation error returned by
+// OpenFGATupleToUserset.Validate if the designated constraints aren't met.
+type OpenFGATupleToUsersetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OpenFGATupleToUsersetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OpenFGATupleToUsersetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OpenFGATupleToUsersetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OpenFGATupleToUsersetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OpenFGATupleToUsersetValidationError) ErrorName() string {
+	return "OpenFGATupleToUsersetValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OpenFGATupleToUsersetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOpenFGATupleToUserset.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OpenFGATupleToUsersetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OpenFGATupleToUsersetValidationError{}
+
+// Validate checks the field values on OpenFGAUsersets with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OpenFGAUsersets) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OpenFGAUsersets with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OpenFGAUsersetsMultiError, or nil if none found.
+func (m *OpenFGAUsersets) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OpenFGAUsersets) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetChild() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OpenFGAUsersetsValidationError{
+						field:  fmt.Sprintf("Child[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OpenFGAUsersetsValidationError{
+						field:  fmt.Sprintf("Child[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OpenFGAUsersetsValidationError{
+					field:  fmt.Sprintf("Child[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return OpenFGAUsersetsMultiError(errors)
+	}
+
+	return nil
+}
+
+// OpenFGAUsersetsMultiError is an error wrapping multiple validation errors
+// returned by OpenFGAUsersets.ValidateAll() if the designated constraints
+// aren't met.
+type OpenFGAUsersetsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OpenFGAUsersetsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OpenFGAUsersetsMultiError) AllErrors() []error { return m }
+
+// OpenFGAUsersetsValidationError is the validation error returned by
+// OpenFGAUsersets.Validate if the designated constraints aren't met.
+type OpenFGAUsersetsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OpenFGAUsersetsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OpenFGAUsersetsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OpenFGAUsersetsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OpenFGAUsersetsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OpenFGAUsersetsValidationError) ErrorName() string { return "OpenFGAUsersetsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OpenFGAUsersetsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOpenFGAUsersets.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OpenFGAUsersetsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OpenFGAUsersetsValidationError{}
+
+// Validate checks the field values on OpenFGADifferenceUserset with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *OpenFGADifferenceUserset) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OpenFGADifferenceUserset with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OpenFGADifferenceUsersetMultiError, or nil if none found.
+func (m *OpenFGADifferenceUserset) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OpenFGADifferenceUserset) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBase()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OpenFGADifferenceUsersetValidationError{
+					field:  "Base",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OpenFGADifferenceUsersetValidationError{
+					field:  "Base",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBase()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OpenFGADifferenceUsersetValidationError{
+				field:  "Base",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSubtract()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OpenFGADifferenceUsersetValidationError{
+					field:  "Subtract",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OpenFGADifferenceUsersetValidationError{
+					field:  "Subtract",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubtract()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OpenFGADifferenceUsersetValidationError{
+				field:  "Subtract",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OpenFGADifferenceUsersetMultiError(errors)
+	}
+
+	return nil
+}
+
+// OpenFGADifferenceUsersetMultiError is an error wrapping multiple validation
+// errors returned by OpenFGADifferenceUserset.ValidateAll() if the designated
+// constraints aren't met.
+type OpenFGADifferenceUsersetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OpenFGADifferenceUsersetMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OpenFGADifferenceUsersetMultiError) AllErrors() []error { return m }
+
+// OpenFGADifferenceUsersetValidationError is the validation error returned by
+// OpenFGADifferenceUserset.Validate if the designated constraints aren't met.
+type OpenFGADifferenceUsersetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OpenFGADifferenceUsersetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OpenFGADifferenceUsersetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OpenFGADifferenceUsersetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OpenFGADifferenceUsersetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OpenFGADifferenceUsersetValidationError) ErrorName() string {
+	return "OpenFGADifferenceUsersetValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OpenFGADifferenceUsersetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOpenFGADifferenceUserset.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OpenFGADifferenceUsersetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OpenFGADifferenceUsersetValidationError{}
+
+// Validate checks the field values on SCIMMember with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SCIMMember) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SCIMMember with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SCIMMemberMultiError, or
+// nil if none found.
+func (m *SCIMMember) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SCIMMember) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetValue()) > 128 {
+		err := SCIMMemberValidationError{
+			field:  "Value",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SCIMMember_Value_Pattern.MatchString(m.GetValue()) {
+		err := SCIMMemberValidationError{
+			field:  "Value",
+			reason: "value does not match regex pattern \"^([a-zA-Z0-9_][a-zA-Z0-9_|-]{0,127})$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Display
+
+	if len(errors) > 0 {
+		return SCIMMemberMultiError(errors)
+	}
+
+	return nil
+}
+
+// SCIMMemberMultiError is an error wrapping multiple validation errors
+// returned by SCIMMember.ValidateAll() if the designated constraints aren't met.
+type SCIMMemberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SCIMMemberMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SCIMMemberMultiError) AllErrors() []error { return m }
+
+// SCIMMemberValidationError is the validation error returned by
+// SCIMMember.Validate if the designated constraints aren't met.
+type SCIMMemberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SCIMMemberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SCIMMemberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SCIMMemberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SCIMMemberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SCIMMemberValidationError) ErrorName() string { return "SCIMMemberValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SCIMMemberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSCIMMember.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SCIMMemberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SCIMMemberValidationError{}
+
+var _SCIMMember_Value_Pattern = regexp.MustCompile("^([a-zA-Z0-9_][a-zA-Z0-9_|-]{0,127})$")
+
+// Validate checks the field values on SCIMMeta with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SCIMMeta) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SCIMMeta with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SCIMMetaMultiError, or nil
+// if none found.
+func (m *SCIMMeta) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SCIMMeta) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ResourceType
+
+	if len(errors) > 0 {
+		return SCIMMetaMultiError(errors)
+	}
+
+	return nil
+}
+
+// SCIMMetaMultiError is an error wrapping multiple validation errors returned
+// by SCIMMeta.ValidateAll() if the designated constraints aren't met.
+type SCIMMetaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SCIMMetaMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SCIMMetaMultiError) AllErrors() []error { return m }
+
+// SCIMMetaValidationError is the validation error returned by
+// SCIMMeta.Validate if the designated constraints aren't met.
+type SCIMMetaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SCIMMetaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SCIMMetaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SCIMMetaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SCIMMetaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SCIMMetaValidationError) ErrorName() string { return "SCIMMetaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SCIMMetaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSCIMMeta.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SCIMMetaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SCIMMetaValidationError{}
+
+// Validate checks the field values on SCIMGroup with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SCIMGroup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SCIMGroup with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SCIMGroupMultiError, or nil
+// if none found.
+func (m *SCIMGroup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SCIMGroup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for DisplayName
+
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SCIMGroupValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SCIMGroupValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SCIMGroupValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SCIMGroupValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SCIMGroupValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SCIMGroupValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SCIMGroupMultiError(errors)
+	}
+
+	return nil
+}
+
+// SCIMGroupMultiError is an error wrapping multiple validation errors returned
+// by SCIMGroup.ValidateAll() if the designated constraints aren't met.
+type SCIMGroupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SCIMGroupMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SCIMGroupMultiError) AllErrors() []error { return m }
+
+// SCIMGroupValidationError is the validation error returned by
+// SCIMGroup.Validate if the designated constraints aren't met.
+type SCIMGroupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SCIMGroupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SCIMGroupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SCIMGroupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SCIMGroupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SCIMGroupValidationError) ErrorName() string { return "SCIMGroupValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SCIMGroupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSCIMGroup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SCIMGroupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SCIMGroupValidationError{}
+
+// Validate checks the field values on SCIMCreateGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *SCIMCreateGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SCIMCreateGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SCIMCreateGroupRequestMultiError, or nil if none found.
+func (m *SCIMCreateGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SCIMCreateGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := SCIMCreateGroupRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SCIMCreateGroupRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SCIMCreateGroupRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetExternalId()) > 128 {
+		err := SCIMCreateGroupRequestValidationError{
+			field:  "ExternalId",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetDisplayName()) > 128 {
+		err := SCIMCreateGroupRequestValidationError{
+			field:  "DisplayName",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SCIMCreateGroupRequestValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SCIMCreateGroupRequestValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SCIMCreateGroupRequestValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SCIMCreateGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SCIMCreateGroupRequestMultiError is an error wrapping multiple validation
+// errors returned by SCIMCreateGroupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SCIMCreateGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SCIMCreateGroupRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SCIMCreateGroupRequestMultiError) AllErrors() []error { return m }
+
+// SCIMCreateGroupRequestValidationError is the validation error returned by
+// SCIMCreateGroupRequest.Validate if the designated constraints aren't met.
+type SCIMCreateGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SCIMCreateGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SCIMCreateGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SCIMCreateGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SCIMCreateGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SCIMCreateGroupRequestValidationError) ErrorName() string {
+	return "SCIMCreateGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SCIMCreateGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSCIMCreateGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SCIMCreateGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SCIMCreateGroupRequestValidationError{}
+
+var _SCIMCreateGroupRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on SCIMReplaceGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *SCIMReplaceGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SCIMReplaceGroupRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SCIMReplaceGroupRequestMultiError, or nil if none found.
+func (m *SCIMReplaceGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SCIMReplaceGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := SCIMReplaceGroupRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SCIMReplaceGroupRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SCIMReplaceGroupRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := SCIMReplaceGroupRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetId()) > 128 {
+		err := SCIMReplaceGroupRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DisplayName
+
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SCIMReplaceGroupRequestValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SCIMReplaceGroupRequestValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SCIMReplaceGroupRequestValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SCIMReplaceGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SCIMReplaceGroupRequestMultiError is an error wrapping multiple validation
+// errors returned by SCIMReplaceGroupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SCIMReplaceGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SCIMReplaceGroupRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SCIMReplaceGroupRequestMultiError) AllErrors() []error { return m }
+
+// SCIMReplaceGroupRequestValidationError is the validation error returned by
+// SCIMReplaceGroupRequest.Validate if the designated constraints aren't met.
+type SCIMReplaceGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SCIMReplaceGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SCIMReplaceGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SCIMReplaceGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SCIMReplaceGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SCIMReplaceGroupRequestValidationError) ErrorName() string {
+	return "SCIMReplaceGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SCIMReplaceGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSCIMReplaceGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SCIMReplaceGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SCIMReplaceGroupRequestValidationError{}
+
+var _SCIMReplaceGroupRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on SCIMPatchOperation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *SCIMPatchOperation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SCIMPatchOperation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SCIMPatchOperationMultiError, or nil if none found.
+func (m *SCIMPatchOperation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SCIMPatchOperation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_SCIMPatchOperation_Op_Pattern.MatchString(m.GetOp()) {
+		err := SCIMPatchOperationValidationError{
+			field:  "Op",
+			reason: "value does not match regex pattern \"^(?i)(add|remove|replace)$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Path
+
+	if all {
+		switch v := interface{}(m.GetValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SCIMPatchOperationValidationError{
+					field:  "Value",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SCIMPatchOperationValidationError{
+					field:  "Value",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SCIMPatchOperationValidationError{
+				field:  "Value",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SCIMPatchOperationMultiError(errors)
+	}
+
+	return nil
+}
+
+// SCIMPatchOperationMultiError is an error wrapping multiple validation errors
+// returned by SCIMPatchOperation.ValidateAll() if the designated constraints
+// aren't met.
+type SCIMPatchOperationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SCIMPatchOperationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SCIMPatchOperationMultiError) AllErrors() []error { return m }
+
+// SCIMPatchOperationValidationError is the validation error returned by
+// SCIMPatchOperation.Validate if the designated constraints aren't met.
+type SCIMPatchOperationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SCIMPatchOperationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SCIMPatchOperationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SCIMPatchOperationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SCIMPatchOperationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SCIMPatchOperationValidationError) ErrorName() string {
+	return "SCIMPatchOperationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SCIMPatchOperationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSCIMPatchOperation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SCIMPatchOperationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SCIMPatchOperationValidationError{}
+
+var _SCIMPatchOperation_Op_Pattern = regexp.MustCompile("^(?i)(add|remove|replace)$")
+
+// Validate checks the field values on SCIMPatchGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *SCIMPatchGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SCIMPatchGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SCIMPatchGroupRequestMultiError, or nil if none found.
+func (m *SCIMPatchGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SCIMPatchGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := SCIMPatchGroupRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SCIMPatchGroupRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SCIMPatchGroupRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := SCIMPatchGroupRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetId()) > 128 {
+		err := SCIMPatchGroupRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetOperations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SCIMPatchGroupRequestValidationError{
+						field:  fmt.Sprintf("Operations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SCIMPatchGroupRequestValidationError{
+						field:  fmt.Sprintf("Operations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SCIMPatchGroupRequestValidationError{
+					field:  fmt.Sprintf("Operations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SCIMPatchGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SCIMPatchGroupRequestMultiError is an error wrapping multiple validation
+// errors returned by SCIMPatchGroupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SCIMPatchGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SCIMPatchGroupRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SCIMPatchGroupRequestMultiError) AllErrors() []error { return m }
+
+// SCIMPatchGroupRequestValidationError is the validation error returned by
+// SCIMPatchGroupRequest.Validate if the designated constraints aren't met.
+type SCIMPatchGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SCIMPatchGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SCIMPatchGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SCIMPatchGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SCIMPatchGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SCIMPatchGroupRequestValidationError) ErrorName() string {
+	return "SCIMPatchGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SCIMPatchGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSCIMPatchGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SCIMPatchGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SCIMPatchGroupRequestValidationError{}
+
+var _SCIMPatchGroupRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on SCIMDeleteGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *SCIMDeleteGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SCIMDeleteGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SCIMDeleteGroupRequestMultiError, or nil if none found.
+func (m *SCIMDeleteGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SCIMDeleteGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := SCIMDeleteGroupRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SCIMDeleteGroupRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SCIMDeleteGroupRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := SCIMDeleteGroupRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetId()) > 128 {
+		err := SCIMDeleteGroupRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SCIMDeleteGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SCIMDeleteGroupRequestMultiError is an error wrapping multiple validation
+// errors returned by SCIMDeleteGroupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SCIMDeleteGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SCIMDeleteGroupRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SCIMDeleteGroupRequestMultiError) AllErrors() []error { return m }
+
+// SCIMDeleteGroupRequestValidationError is the validation error returned by
+// SCIMDeleteGroupRequest.Validate if the designated constraints aren't met.
+type SCIMDeleteGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SCIMDeleteGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SCIMDeleteGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SCIMDeleteGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SCIMDeleteGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SCIMDeleteGroupRequestValidationError) ErrorName() string {
+	return "SCIMDeleteGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SCIMDeleteGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSCIMDeleteGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SCIMDeleteGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SCIMDeleteGroupRequestValidationError{}
+
+var _SCIMDeleteGroupRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on SCIMDeleteGroupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *SCIMDeleteGroupResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SCIMDeleteGroupResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SCIMDeleteGroupResponseMultiError, or nil if none found.
+func (m *SCIMDeleteGroupResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SCIMDeleteGroupResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SCIMDeleteGroupResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SCIMDeleteGroupResponseMultiError is an error wrapping multiple validation
+// errors returned by SCIMDeleteGroupResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SCIMDeleteGroupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SCIMDeleteGroupResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SCIMDeleteGroupResponseMultiError) AllErrors() []error { return m }
+
+// SCIMDeleteGroupResponseValidationError is the validation error returned by
+// SCIMDeleteGroupResponse.Validate if the designated constraints aren't met.
+type SCIMDeleteGroupResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SCIMDeleteGroupResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SCIMDeleteGroupResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SCIMDeleteGroupResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SCIMDeleteGroupResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SCIMDeleteGroupResponseValidationError) ErrorName() string {
+	return "SCIMDeleteGroupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SCIMDeleteGroupResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSCIMDeleteGroupResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SCIMDeleteGroupResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SCIMDeleteGroupResponseValidationError{}
+
+// Validate checks the field values on SCIMReadGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *SCIMReadGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SCIMReadGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SCIMReadGroupRequestMultiError, or nil if none found.
+func (m *SCIMReadGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SCIMReadGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := SCIMReadGroupRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SCIMReadGroupRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := SCIMReadGroupRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := SCIMReadGroupRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetId()) > 128 {
+		err := SCIMReadGroupRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at most 128 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SCIMReadGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SCIMReadGroupRequestMultiError is an error wrapping multiple validation
+// errors returned by SCIMReadGroupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SCIMReadGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SCIMReadGroupRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SCIMReadGroupRequestMultiError) AllErrors() []error { return m }
+
+// SCIMReadGroupRequestValidationError is the validation error returned by
+// SCIMReadGroupRequest.Validate if the designated constraints aren't met.
+type SCIMReadGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SCIMReadGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SCIMReadGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SCIMReadGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SCIMReadGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SCIMReadGroupRequestValidationError) ErrorName() string {
+	return "SCIMReadGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SCIMReadGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSCIMReadGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SCIMReadGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SCIMReadGroupRequestValidationError{}
+
+var _SCIMReadGroupRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on AdminRefreshTenantRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AdminRefreshTenantRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminRefreshTenantRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminRefreshTenantRequestMultiError, or nil if none found.
+func (m *AdminRefreshTenantRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminRefreshTenantRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := AdminRefreshTenantRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AdminRefreshTenantRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := AdminRefreshTenantRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AdminRefreshTenantRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminRefreshTenantRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminRefreshTenantRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AdminRefreshTenantRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminRefreshTenantRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminRefreshTenantRequestMultiError) AllErrors() []error { return m }
+
+// AdminRefreshTenantRequestValidationError is the validation error returned by
+// AdminRefreshTenantRequest.Validate if the designated constraints aren't met.
+type AdminRefreshTenantRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminRefreshTenantRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminRefreshTenantRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminRefreshTenantRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminRefreshTenantRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminRefreshTenantRequestValidationError) ErrorName() string {
+	return "AdminRefreshTenantRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminRefreshTenantRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminRefreshTenantRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminRefreshTenantRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminRefreshTenantRequestValidationError{}
+
+var _AdminRefreshTenantRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on AdminRefreshTenantResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AdminRefreshTenantResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminRefreshTenantResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminRefreshTenantResponseMultiError, or nil if none found.
+func (m *AdminRefreshTenantResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminRefreshTenantResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for Ok
+
+	for idx, item := range m.GetDiagnostics() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminRefreshTenantResponseValidationError{
+						field:  fmt.Sprintf("Diagnostics[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminRefreshTenantResponseValidationError{
+						field:  fmt.Sprintf("Diagnostics[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminRefreshTenantResponseValidationError{
+					field:  fmt.Sprintf("Diagnostics[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminRefreshTenantResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminRefreshTenantResponseMultiError is an error wrapping multiple
+// validation errors returned by AdminRefreshTenantResponse.ValidateAll() if
+// the designated constraints aren't met.
+type AdminRefreshTenantResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminRefreshTenantResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminRefreshTenantResponseMultiError) AllErrors() []error { return m }
+
+// AdminRefreshTenantResponseValidationError is the validation error returned
+// by AdminRefreshTenantResponse.Validate if the designated constraints aren't met.
+type AdminRefreshTenantResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminRefreshTenantResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminRefreshTenantResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminRefreshTenantResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminRefreshTenantResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminRefreshTenantResponseValidationError) ErrorName() string {
+	return "AdminRefreshTenantResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminRefreshTenantResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminRefreshTenantResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminRefreshTenantResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminRefreshTenantResponseValidationError{}
+
+// Validate checks the field values on AdminDiagnostic with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminDiagnostic) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDiagnostic with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDiagnosticMultiError, or nil if none found.
+func (m *AdminDiagnostic) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDiagnostic) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Step
+
+	// no validation rules for Ok
+
+	// no validation rules for Message
+
+	// no validation rules for DurationMs
+
+	if len(errors) > 0 {
+		return AdminDiagnosticMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDiagnosticMultiError is an error wrapping multiple validation errors
+// returned by AdminDiagnostic.ValidateAll() if the designated constraints
+// aren't met.
+type AdminDiagnosticMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDiagnosticMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDiagnosticMultiError) AllErrors() []error { return m }
+
+// AdminDiagnosticValidationError is the validation error returned by
+// AdminDiagnostic.Validate if the designated constraints aren't met.
+type AdminDiagnosticValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDiagnosticValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDiagnosticValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDiagnosticValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDiagnosticValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDiagnosticValidationError) ErrorName() string { return "AdminDiagnosticValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminDiagnosticValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDiagnostic.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDiagnosticValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDiagnosticValidationError{}
+
+// Validate checks the field values on AdminExplainQueryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AdminExplainQueryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminExplainQueryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminExplainQueryRequestMultiError, or nil if none found.
+func (m *AdminExplainQueryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminExplainQueryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := AdminExplainQueryRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AdminExplainQueryRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := AdminExplainQueryRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for SnapToken
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminExplainQueryRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminExplainQueryRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminExplainQueryRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminExplainQueryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminExplainQueryRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminExplainQueryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminExplainQueryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminExplainQueryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminExplainQueryRequestMultiError) AllErrors() []error { return m }
+
+// AdminExplainQueryRequestValidationError is the validation error returned by
+// AdminExplainQueryRequest.Validate if the designated constraints aren't met.
+type AdminExplainQueryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminExplainQueryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminExplainQueryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminExplainQueryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminExplainQueryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminExplainQueryRequestValidationError) ErrorName() string {
+	return "AdminExplainQueryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminExplainQueryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminExplainQueryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminExplainQueryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminExplainQueryRequestValidationError{}
+
+var _AdminExplainQueryRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on AdminExplainQueryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AdminExplainQueryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminExplainQueryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminExplainQueryResponseMultiError, or nil if none found.
+func (m *AdminExplainQueryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminExplainQueryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for Engine
+
+	// no validation rules for Index
+
+	// no validation rules for Query
+
+	// no validation rules for FullScan
+
+	if len(errors) > 0 {
+		return AdminExplainQueryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminExplainQueryResponseMultiError is an error wrapping multiple validation
+// errors returned by AdminExplainQueryResponse.ValidateAll() if the
+// designated constraints aren't met.
+type AdminExplainQueryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminExplainQueryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminExplainQueryResponseMultiError) AllErrors() []error { return m }
+
+// AdminExplainQueryResponseValidationError is the validation error returned by
+// AdminExplainQueryResponse.Validate if the designated constraints aren't met.
+type AdminExplainQueryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminExplainQueryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminExplainQueryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminExplainQueryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminExplainQueryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminExplainQueryResponseValidationError) ErrorName() string {
+	return "AdminExplainQueryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminExplainQueryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminExplainQueryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminExplainQueryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminExplainQueryResponseValidationError{}
+
+// Validate checks the field values on AdminUsageRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminUsageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUsageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUsageRequestMultiError, or nil if none found.
+func (m *AdminUsageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUsageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := AdminUsageRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AdminUsageRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := AdminUsageRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return AdminUsageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUsageRequestMultiError is an error wrapping multiple validation errors
+// returned by AdminUsageRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AdminUsageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUsageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUsageRequestMultiError) AllErrors() []error { return m }
+
+// AdminUsageRequestValidationError is the validation error returned by
+// AdminUsageRequest.Validate if the designated constraints aren't met.
+type AdminUsageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUsageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUsageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUsageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUsageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUsageRequestValidationError) ErrorName() string {
+	return "AdminUsageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUsageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUsageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUsageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUsageRequestValidationError{}
+
+var _AdminUsageRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on AdminUsageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AdminUsageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUsageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUsageResponseMultiError, or nil if none found.
+func (m *AdminUsageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUsageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for WindowSeconds
+
+	// no validation rules for Checks
+
+	for idx, item := range m.GetPermissions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminUsageResponseValidationError{
+						field:  fmt.Sprintf("Permissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminUsageResponseValidationError{
+						field:  fmt.Sprintf("Permissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminUsageResponseValidationError{
+					field:  fmt.Sprintf("Permissions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetSlowestChecks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminUsageResponseValidationError{
+						field:  fmt.Sprintf("SlowestChecks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminUsageResponseValidationError{
+						field:  fmt.Sprintf("SlowestChecks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminUsageResponseValidationError{
+					field:  fmt.Sprintf("SlowestChecks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetEntities() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminUsageResponseValidationError{
+						field:  fmt.Sprintf("Entities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminUsageResponseValidationError{
+						field:  fmt.Sprintf("Entities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminUsageResponseValidationError{
+					field:  fmt.Sprintf("Entities[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for EntitiesTruncated
+
+	if len(errors) > 0 {
+		return AdminUsageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUsageResponseMultiError is an error wrapping multiple validation errors
+// returned by AdminUsageResponse.ValidateAll() if the designated constraints
+// aren't met.
+type AdminUsageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUsageResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUsageResponseMultiError) AllErrors() []error { return m }
+
+// AdminUsageResponseValidationError is the validation error returned by
+// AdminUsageResponse.Validate if the designated constraints aren't met.
+type AdminUsageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUsageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUsageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUsageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUsageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUsageResponseValidationError) ErrorName() string {
+	return "AdminUsageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUsageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUsageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUsageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUsageResponseValidationError{}
+
+// Validate checks the field values on AdminPermissionUsage with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AdminPermissionUsage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminPermissionUsage with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminPermissionUsageMultiError, or nil if none found.
+func (m *AdminPermissionUsage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminPermissionUsage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Permission
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminPermissionUsageMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminPermissionUsageMultiError is an error wrapping multiple validation
+// errors returned by AdminPermissionUsage.ValidateAll() if the designated
+// constraints aren't met.
+type AdminPermissionUsageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminPermissionUsageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminPermissionUsageMultiError) AllErrors() []error { return m }
+
+// AdminPermissionUsageValidationError is the validation error returned by
+// AdminPermissionUsage.Validate if the designated constraints aren't met.
+type AdminPermissionUsageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminPermissionUsageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminPermissionUsageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminPermissionUsageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminPermissionUsageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminPermissionUsageValidationError) ErrorName() string {
+	return "AdminPermissionUsageValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminPermissionUsageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminPermissionUsage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminPermissionUsageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminPermissionUsageValidationError{}
+
+// Validate checks the field values on AdminCheckShapeUsage with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AdminCheckShapeUsage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminCheckShapeUsage with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminCheckShapeUsageMultiError, or nil if none found.
+func (m *AdminCheckShapeUsage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminCheckShapeUsage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Shape
+
+	// no validation rules for Count
+
+	// no validation rules for P99Ms
+
+	if len(errors) > 0 {
+		return AdminCheckShapeUsageMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminCheckShapeUsageMultiError is an error wrapping multiple validation
+// errors returned by AdminCheckShapeUsage.ValidateAll() if the designated
+// constraints aren't met.
+type AdminCheckShapeUsageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminCheckShapeUsageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminCheckShapeUsageMultiError) AllErrors() []error { return m }
+
+// AdminCheckShapeUsageValidationError is the validation error returned by
+// AdminCheckShapeUsage.Validate if the designated constraints aren't met.
+type AdminCheckShapeUsageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminCheckShapeUsageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminCheckShapeUsageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminCheckShapeUsageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminCheckShapeUsageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminCheckShapeUsageValidationError) ErrorName() string {
+	return "AdminCheckShapeUsageValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminCheckShapeUsageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminCheckShapeUsage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminCheckShapeUsageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminCheckShapeUsageValidationError{}
+
+// Validate checks the field values on AdminEntityUsage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminEntityUsage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminEntityUsage with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminEntityUsageMultiError, or nil if none found.
+func (m *AdminEntityUsage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminEntityUsage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Entity
+
+	// no validation rules for Tuples
+
+	if len(errors) > 0 {
+		return AdminEntityUsageMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminEntityUsageMultiError is an error wrapping multiple validation errors
+// returned by AdminEntityUsage.ValidateAll() if the designated constraints
+// aren't met.
+type AdminEntityUsageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminEntityUsageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminEntityUsageMultiError) AllErrors() []error { return m }
+
+// AdminEntityUsageValidationError is the validation error returned by
+// AdminEntityUsage.Validate if the designated constraints aren't met.
+type AdminEntityUsageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminEntityUsageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminEntityUsageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminEntityUsageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminEntityUsageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminEntityUsageValidationError) ErrorName() string { return "AdminEntityUsageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminEntityUsageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminEntityUsage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminEntityUsageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminEntityUsageValidationError{}
+
+// Validate checks the field values on AdminMigrateTenantRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AdminMigrateTenantRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminMigrateTenantRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminMigrateTenantRequestMultiError, or nil if none found.
+func (m *AdminMigrateTenantRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminMigrateTenantRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := AdminMigrateTenantRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AdminMigrateTenantRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := AdminMigrateTenantRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetEngine()) < 1 {
+		err := AdminMigrateTenantRequestValidationError{
+			field:  "Engine",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -1829,21 +20378,24 @@ func (m *PermissionLookupEntityRequestMetadata) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Uri
+
+	// no validation rules for SampleRate
+
 	if len(errors) > 0 {
-		return PermissionLookupEntityRequestMetadataMultiError(errors)
+		return AdminMigrateTenantRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PermissionLookupEntityRequestMetadataMultiError is an error wrapping
-// multiple validation errors returned by
-// PermissionLookupEntityRequestMetadata.ValidateAll() if the designated
-// constraints aren't met.
-type PermissionLookupEntityRequestMetadataMultiError []error
+// AdminMigrateTenantRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminMigrateTenantRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AdminMigrateTenantRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PermissionLookupEntityRequestMetadataMultiError) Error() string {
+func (m AdminMigrateTenantRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1852,12 +20404,11 @@ func (m PermissionLookupEntityRequestMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PermissionLookupEntityRequestMetadataMultiError) AllErrors() []error { return m }
+func (m AdminMigrateTenantRequestMultiError) AllErrors() []error { return m }
 
-// PermissionLookupEntityRequestMetadataValidationError is the validation error
-// returned by PermissionLookupEntityRequestMetadata.Validate if the
-// designated constraints aren't met.
-type PermissionLookupEntityRequestMetadataValidationError struct {
+// AdminMigrateTenantRequestValidationError is the validation error returned by
+// AdminMigrateTenantRequest.Validate if the designated constraints aren't met.
+type AdminMigrateTenantRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1865,24 +20416,24 @@ type PermissionLookupEntityRequestMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e PermissionLookupEntityRequestMetadataValidationError) Field() string { return e.field }
+func (e AdminMigrateTenantRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PermissionLookupEntityRequestMetadataValidationError) Reason() string { return e.reason }
+func (e AdminMigrateTenantRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PermissionLookupEntityRequestMetadataValidationError) Cause() error { return e.cause }
+func (e AdminMigrateTenantRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PermissionLookupEntityRequestMetadataValidationError) Key() bool { return e.key }
+func (e AdminMigrateTenantRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PermissionLookupEntityRequestMetadataValidationError) ErrorName() string {
-	return "PermissionLookupEntityRequestMetadataValidationError"
+func (e AdminMigrateTenantRequestValidationError) ErrorName() string {
+	return "AdminMigrateTenantRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PermissionLookupEntityRequestMetadataValidationError) Error() string {
+func (e AdminMigrateTenantRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1894,14 +20445,14 @@ func (e PermissionLookupEntityRequestMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPermissionLookupEntityRequestMetadata.%s: %s%s",
+		"invalid %sAdminMigrateTenantRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PermissionLookupEntityRequestMetadataValidationError{}
+var _ error = AdminMigrateTenantRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1909,44 +20460,65 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PermissionLookupEntityRequestMetadataValidationError{}
+} = AdminMigrateTenantRequestValidationError{}
 
-// Validate checks the field values on PermissionLookupEntityResponse with the
+var _AdminMigrateTenantRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on AdminMigrateTenantResponse with the
 // rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PermissionLookupEntityResponse) Validate() error {
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AdminMigrateTenantResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PermissionLookupEntityResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// PermissionLookupEntityResponseMultiError, or nil if none found.
-func (m *PermissionLookupEntityResponse) ValidateAll() error {
+// ValidateAll checks the field values on AdminMigrateTenantResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminMigrateTenantResponseMultiError, or nil if none found.
+func (m *AdminMigrateTenantResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PermissionLookupEntityResponse) validate(all bool) error {
+func (m *AdminMigrateTenantResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for TenantId
+
+	// no validation rules for Engine
+
+	// no validation rules for SnapToken
+
+	// no validation rules for Tuples
+
+	// no validation rules for TargetTuples
+
+	// no validation rules for Sampled
+
+	// no validation rules for Checksum
+
+	// no validation rules for TargetChecksum
+
+	// no validation rules for Verified
+
 	if len(errors) > 0 {
-		return PermissionLookupEntityResponseMultiError(errors)
+		return AdminMigrateTenantResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PermissionLookupEntityResponseMultiError is an error wrapping multiple
-// validation errors returned by PermissionLookupEntityResponse.ValidateAll()
-// if the designated constraints aren't met.
-type PermissionLookupEntityResponseMultiError []error
+// AdminMigrateTenantResponseMultiError is an error wrapping multiple
+// validation errors returned by AdminMigrateTenantResponse.ValidateAll() if
+// the designated constraints aren't met.
+type AdminMigrateTenantResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PermissionLookupEntityResponseMultiError) Error() string {
+func (m AdminMigrateTenantResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1955,12 +20527,11 @@ func (m PermissionLookupEntityResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PermissionLookupEntityResponseMultiError) AllErrors() []error { return m }
+func (m AdminMigrateTenantResponseMultiError) AllErrors() []error { return m }
 
-// PermissionLookupEntityResponseValidationError is the validation error
-// returned by PermissionLookupEntityResponse.Validate if the designated
-// constraints aren't met.
-type PermissionLookupEntityResponseValidationError struct {
+// AdminMigrateTenantResponseValidationError is the validation error returned
+// by AdminMigrateTenantResponse.Validate if the designated constraints aren't met.
+type AdminMigrateTenantResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1968,24 +20539,24 @@ type PermissionLookupEntityResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PermissionLookupEntityResponseValidationError) Field() string { return e.field }
+func (e AdminMigrateTenantResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PermissionLookupEntityResponseValidationError) Reason() string { return e.reason }
+func (e AdminMigrateTenantResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PermissionLookupEntityResponseValidationError) Cause() error { return e.cause }
+func (e AdminMigrateTenantResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PermissionLookupEntityResponseValidationError) Key() bool { return e.key }
+func (e AdminMigrateTenantResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PermissionLookupEntityResponseValidationError) ErrorName() string {
-	return "PermissionLookupEntityResponseValidationError"
+func (e AdminMigrateTenantResponseValidationError) ErrorName() string {
+	return "AdminMigrateTenantResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PermissionLookupEntityResponseValidationError) Error() string {
+func (e AdminMigrateTenantResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1997,14 +20568,14 @@ func (e PermissionLookupEntityResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPermissionLookupEntityResponse.%s: %s%s",
+		"invalid %sAdminMigrateTenantResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PermissionLookupEntityResponseValidationError{}
+var _ error = AdminMigrateTenantResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2012,48 +20583,45 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PermissionLookupEntityResponseValidationError{}
+} = AdminMigrateTenantResponseValidationError{}
 
-// Validate checks the field values on PermissionLookupEntityStreamResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *PermissionLookupEntityStreamResponse) Validate() error {
+// Validate checks the field values on AdminCollectGarbageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AdminCollectGarbageRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PermissionLookupEntityStreamResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// PermissionLookupEntityStreamResponseMultiError, or nil if none found.
-func (m *PermissionLookupEntityStreamResponse) ValidateAll() error {
+// ValidateAll checks the field values on AdminCollectGarbageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminCollectGarbageRequestMultiError, or nil if none found.
+func (m *AdminCollectGarbageRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PermissionLookupEntityStreamResponse) validate(all bool) error {
+func (m *AdminCollectGarbageRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for EntityId
-
 	if len(errors) > 0 {
-		return PermissionLookupEntityStreamResponseMultiError(errors)
+		return AdminCollectGarbageRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PermissionLookupEntityStreamResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// PermissionLookupEntityStreamResponse.ValidateAll() if the designated
-// constraints aren't met.
-type PermissionLookupEntityStreamResponseMultiError []error
+// AdminCollectGarbageRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminCollectGarbageRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminCollectGarbageRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PermissionLookupEntityStreamResponseMultiError) Error() string {
+func (m AdminCollectGarbageRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2062,12 +20630,11 @@ func (m PermissionLookupEntityStreamResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PermissionLookupEntityStreamResponseMultiError) AllErrors() []error { return m }
+func (m AdminCollectGarbageRequestMultiError) AllErrors() []error { return m }
 
-// PermissionLookupEntityStreamResponseValidationError is the validation error
-// returned by PermissionLookupEntityStreamResponse.Validate if the designated
-// constraints aren't met.
-type PermissionLookupEntityStreamResponseValidationError struct {
+// AdminCollectGarbageRequestValidationError is the validation error returned
+// by AdminCollectGarbageRequest.Validate if the designated constraints aren't met.
+type AdminCollectGarbageRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2075,24 +20642,24 @@ type PermissionLookupEntityStreamResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PermissionLookupEntityStreamResponseValidationError) Field() string { return e.field }
+func (e AdminCollectGarbageRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PermissionLookupEntityStreamResponseValidationError) Reason() string { return e.reason }
+func (e AdminCollectGarbageRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PermissionLookupEntityStreamResponseValidationError) Cause() error { return e.cause }
+func (e AdminCollectGarbageRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PermissionLookupEntityStreamResponseValidationError) Key() bool { return e.key }
+func (e AdminCollectGarbageRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PermissionLookupEntityStreamResponseValidationError) ErrorName() string {
-	return "PermissionLookupEntityStreamResponseValidationError"
+func (e AdminCollectGarbageRequestValidationError) ErrorName() string {
+	return "AdminCollectGarbageRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PermissionLookupEntityStreamResponseValidationError) Error() string {
+func (e AdminCollectGarbageRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2104,14 +20671,14 @@ func (e PermissionLookupEntityStreamResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPermissionLookupEntityStreamResponse.%s: %s%s",
+		"invalid %sAdminCollectGarbageRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PermissionLookupEntityStreamResponseValidationError{}
+var _ error = AdminCollectGarbageRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2119,68 +20686,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PermissionLookupEntityStreamResponseValidationError{}
+} = AdminCollectGarbageRequestValidationError{}
 
-// Validate checks the field values on SchemaWriteRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SchemaWriteRequest) Validate() error {
+// Validate checks the field values on AdminCollectGarbageResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AdminCollectGarbageResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SchemaWriteRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminCollectGarbageResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SchemaWriteRequestMultiError, or nil if none found.
-func (m *SchemaWriteRequest) ValidateAll() error {
+// AdminCollectGarbageResponseMultiError, or nil if none found.
+func (m *AdminCollectGarbageResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SchemaWriteRequest) validate(all bool) error {
+func (m *AdminCollectGarbageResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetTenantId()) > 64 {
-		err := SchemaWriteRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at most 64 bytes",
+	if all {
+		switch v := interface{}(m.GetBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminCollectGarbageResponseValidationError{
+					field:  "Before",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminCollectGarbageResponseValidationError{
+					field:  "Before",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminCollectGarbageResponseValidationError{
+				field:  "Before",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	if !_SchemaWriteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := SchemaWriteRequestValidationError{
-			field:  "TenantId",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Deleted
 
-	// no validation rules for Schema
+	// no validation rules for Batches
+
+	// no validation rules for DurationMs
 
 	if len(errors) > 0 {
-		return SchemaWriteRequestMultiError(errors)
+		return AdminCollectGarbageResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SchemaWriteRequestMultiError is an error wrapping multiple validation errors
-// returned by SchemaWriteRequest.ValidateAll() if the designated constraints
-// aren't met.
-type SchemaWriteRequestMultiError []error
+// AdminCollectGarbageResponseMultiError is an error wrapping multiple
+// validation errors returned by AdminCollectGarbageResponse.ValidateAll() if
+// the designated constraints aren't met.
+type AdminCollectGarbageResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SchemaWriteRequestMultiError) Error() string {
+func (m AdminCollectGarbageResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2189,11 +20768,12 @@ func (m SchemaWriteRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SchemaWriteRequestMultiError) AllErrors() []error { return m }
+func (m AdminCollectGarbageResponseMultiError) AllErrors() []error { return m }
 
-// SchemaWriteRequestValidationError is the validation error returned by
-// SchemaWriteRequest.Validate if the designated constraints aren't met.
-type SchemaWriteRequestValidationError struct {
+// AdminCollectGarbageResponseValidationError is the validation error returned
+// by AdminCollectGarbageResponse.Validate if the designated constraints
+// aren't met.
+type AdminCollectGarbageResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2201,24 +20781,24 @@ type SchemaWriteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SchemaWriteRequestValidationError) Field() string { return e.field }
+func (e AdminCollectGarbageResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SchemaWriteRequestValidationError) Reason() string { return e.reason }
+func (e AdminCollectGarbageResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SchemaWriteRequestValidationError) Cause() error { return e.cause }
+func (e AdminCollectGarbageResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SchemaWriteRequestValidationError) Key() bool { return e.key }
+func (e AdminCollectGarbageResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SchemaWriteRequestValidationError) ErrorName() string {
-	return "SchemaWriteRequestValidationError"
+func (e AdminCollectGarbageResponseValidationError) ErrorName() string {
+	return "AdminCollectGarbageResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SchemaWriteRequestValidationError) Error() string {
+func (e AdminCollectGarbageResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2230,14 +20810,14 @@ func (e SchemaWriteRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSchemaWriteRequest.%s: %s%s",
+		"invalid %sAdminCollectGarbageResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SchemaWriteRequestValidationError{}
+var _ error = AdminCollectGarbageResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2245,48 +20825,76 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SchemaWriteRequestValidationError{}
-
-var _SchemaWriteRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+} = AdminCollectGarbageResponseValidationError{}
 
-// Validate checks the field values on SchemaWriteResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SchemaWriteResponse) Validate() error {
+// Validate checks the field values on AdminAPIKey with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminAPIKey) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SchemaWriteResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SchemaWriteResponseMultiError, or nil if none found.
-func (m *SchemaWriteResponse) ValidateAll() error {
+// ValidateAll checks the field values on AdminAPIKey with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AdminAPIKeyMultiError, or
+// nil if none found.
+func (m *AdminAPIKey) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SchemaWriteResponse) validate(all bool) error {
+func (m *AdminAPIKey) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SchemaVersion
+	// no validation rules for Id
+
+	// no validation rules for Scope
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminAPIKeyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminAPIKeyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminAPIKeyValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return SchemaWriteResponseMultiError(errors)
+		return AdminAPIKeyMultiError(errors)
 	}
 
 	return nil
 }
 
-// SchemaWriteResponseMultiError is an error wrapping multiple validation
-// errors returned by SchemaWriteResponse.ValidateAll() if the designated
-// constraints aren't met.
-type SchemaWriteResponseMultiError []error
+// AdminAPIKeyMultiError is an error wrapping multiple validation errors
+// returned by AdminAPIKey.ValidateAll() if the designated constraints aren't met.
+type AdminAPIKeyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SchemaWriteResponseMultiError) Error() string {
+func (m AdminAPIKeyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2295,11 +20903,11 @@ func (m SchemaWriteResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SchemaWriteResponseMultiError) AllErrors() []error { return m }
+func (m AdminAPIKeyMultiError) AllErrors() []error { return m }
 
-// SchemaWriteResponseValidationError is the validation error returned by
-// SchemaWriteResponse.Validate if the designated constraints aren't met.
-type SchemaWriteResponseValidationError struct {
+// AdminAPIKeyValidationError is the validation error returned by
+// AdminAPIKey.Validate if the designated constraints aren't met.
+type AdminAPIKeyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2307,24 +20915,22 @@ type SchemaWriteResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SchemaWriteResponseValidationError) Field() string { return e.field }
+func (e AdminAPIKeyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SchemaWriteResponseValidationError) Reason() string { return e.reason }
+func (e AdminAPIKeyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SchemaWriteResponseValidationError) Cause() error { return e.cause }
+func (e AdminAPIKeyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SchemaWriteResponseValidationError) Key() bool { return e.key }
+func (e AdminAPIKeyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SchemaWriteResponseValidationError) ErrorName() string {
-	return "SchemaWriteResponseValidationError"
-}
+func (e AdminAPIKeyValidationError) ErrorName() string { return "AdminAPIKeyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SchemaWriteResponseValidationError) Error() string {
+func (e AdminAPIKeyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2336,14 +20942,14 @@ func (e SchemaWriteResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSchemaWriteResponse.%s: %s%s",
+		"invalid %sAdminAPIKey.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SchemaWriteResponseValidationError{}
+var _ error = AdminAPIKeyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2351,106 +20957,74 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SchemaWriteResponseValidationError{}
+} = AdminAPIKeyValidationError{}
 
-// Validate checks the field values on SchemaReadRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *SchemaReadRequest) Validate() error {
+// Validate checks the field values on AdminCreateAPIKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AdminCreateAPIKeyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SchemaReadRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminCreateAPIKeyRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SchemaReadRequestMultiError, or nil if none found.
-func (m *SchemaReadRequest) ValidateAll() error {
+// AdminCreateAPIKeyRequestMultiError, or nil if none found.
+func (m *AdminCreateAPIKeyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SchemaReadRequest) validate(all bool) error {
+func (m *AdminCreateAPIKeyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetTenantId()) > 64 {
-		err := SchemaReadRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at most 64 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_SchemaReadRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := SchemaReadRequestValidationError{
-			field:  "TenantId",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetMetadata() == nil {
-		err := SchemaReadRequestValidationError{
-			field:  "Metadata",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	for idx, item := range m.GetTenants() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetMetadata()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SchemaReadRequestValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if len(item) > 64 {
+			err := AdminCreateAPIKeyRequestValidationError{
+				field:  fmt.Sprintf("Tenants[%v]", idx),
+				reason: "value length must be at most 64 bytes",
 			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SchemaReadRequestValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
-	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SchemaReadRequestValidationError{
-				field:  "Metadata",
-				reason: "embedded message failed validation",
-				cause:  err,
+
+		if !_AdminCreateAPIKeyRequest_Tenants_Pattern.MatchString(item) {
+			err := AdminCreateAPIKeyRequestValidationError{
+				field:  fmt.Sprintf("Tenants[%v]", idx),
+				reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+			}
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
+
 	}
 
+	// no validation rules for Scope
+
 	if len(errors) > 0 {
-		return SchemaReadRequestMultiError(errors)
+		return AdminCreateAPIKeyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SchemaReadRequestMultiError is an error wrapping multiple validation errors
-// returned by SchemaReadRequest.ValidateAll() if the designated constraints
-// aren't met.
-type SchemaReadRequestMultiError []error
+// AdminCreateAPIKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminCreateAPIKeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminCreateAPIKeyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SchemaReadRequestMultiError) Error() string {
+func (m AdminCreateAPIKeyRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2459,11 +21033,11 @@ func (m SchemaReadRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SchemaReadRequestMultiError) AllErrors() []error { return m }
+func (m AdminCreateAPIKeyRequestMultiError) AllErrors() []error { return m }
 
-// SchemaReadRequestValidationError is the validation error returned by
-// SchemaReadRequest.Validate if the designated constraints aren't met.
-type SchemaReadRequestValidationError struct {
+// AdminCreateAPIKeyRequestValidationError is the validation error returned by
+// AdminCreateAPIKeyRequest.Validate if the designated constraints aren't met.
+type AdminCreateAPIKeyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2471,24 +21045,24 @@ type SchemaReadRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SchemaReadRequestValidationError) Field() string { return e.field }
+func (e AdminCreateAPIKeyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SchemaReadRequestValidationError) Reason() string { return e.reason }
+func (e AdminCreateAPIKeyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SchemaReadRequestValidationError) Cause() error { return e.cause }
+func (e AdminCreateAPIKeyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SchemaReadRequestValidationError) Key() bool { return e.key }
+func (e AdminCreateAPIKeyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SchemaReadRequestValidationError) ErrorName() string {
-	return "SchemaReadRequestValidationError"
+func (e AdminCreateAPIKeyRequestValidationError) ErrorName() string {
+	return "AdminCreateAPIKeyRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SchemaReadRequestValidationError) Error() string {
+func (e AdminCreateAPIKeyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2500,14 +21074,14 @@ func (e SchemaReadRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSchemaReadRequest.%s: %s%s",
+		"invalid %sAdminCreateAPIKeyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SchemaReadRequestValidationError{}
+var _ error = AdminCreateAPIKeyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2515,48 +21089,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SchemaReadRequestValidationError{}
+} = AdminCreateAPIKeyRequestValidationError{}
 
-var _SchemaReadRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+var _AdminCreateAPIKeyRequest_Tenants_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
 
-// Validate checks the field values on SchemaReadRequestMetadata with the rules
+// Validate checks the field values on AdminCreateAPIKeyResponse with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SchemaReadRequestMetadata) Validate() error {
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AdminCreateAPIKeyResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SchemaReadRequestMetadata with the
+// ValidateAll checks the field values on AdminCreateAPIKeyResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SchemaReadRequestMetadataMultiError, or nil if none found.
-func (m *SchemaReadRequestMetadata) ValidateAll() error {
+// AdminCreateAPIKeyResponseMultiError, or nil if none found.
+func (m *AdminCreateAPIKeyResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SchemaReadRequestMetadata) validate(all bool) error {
+func (m *AdminCreateAPIKeyResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SchemaVersion
+	if all {
+		switch v := interface{}(m.GetKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminCreateAPIKeyResponseValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminCreateAPIKeyResponseValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminCreateAPIKeyResponseValidationError{
+				field:  "Key",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Secret
 
 	if len(errors) > 0 {
-		return SchemaReadRequestMetadataMultiError(errors)
+		return AdminCreateAPIKeyResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SchemaReadRequestMetadataMultiError is an error wrapping multiple validation
-// errors returned by SchemaReadRequestMetadata.ValidateAll() if the
+// AdminCreateAPIKeyResponseMultiError is an error wrapping multiple validation
+// errors returned by AdminCreateAPIKeyResponse.ValidateAll() if the
 // designated constraints aren't met.
-type SchemaReadRequestMetadataMultiError []error
+type AdminCreateAPIKeyResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SchemaReadRequestMetadataMultiError) Error() string {
+func (m AdminCreateAPIKeyResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2565,11 +21169,11 @@ func (m SchemaReadRequestMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SchemaReadRequestMetadataMultiError) AllErrors() []error { return m }
+func (m AdminCreateAPIKeyResponseMultiError) AllErrors() []error { return m }
 
-// SchemaReadRequestMetadataValidationError is the validation error returned by
-// SchemaReadRequestMetadata.Validate if the designated constraints aren't met.
-type SchemaReadRequestMetadataValidationError struct {
+// AdminCreateAPIKeyResponseValidationError is the validation error returned by
+// AdminCreateAPIKeyResponse.Validate if the designated constraints aren't met.
+type AdminCreateAPIKeyResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2577,24 +21181,24 @@ type SchemaReadRequestMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e SchemaReadRequestMetadataValidationError) Field() string { return e.field }
+func (e AdminCreateAPIKeyResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SchemaReadRequestMetadataValidationError) Reason() string { return e.reason }
+func (e AdminCreateAPIKeyResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SchemaReadRequestMetadataValidationError) Cause() error { return e.cause }
+func (e AdminCreateAPIKeyResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SchemaReadRequestMetadataValidationError) Key() bool { return e.key }
+func (e AdminCreateAPIKeyResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SchemaReadRequestMetadataValidationError) ErrorName() string {
-	return "SchemaReadRequestMetadataValidationError"
+func (e AdminCreateAPIKeyResponseValidationError) ErrorName() string {
+	return "AdminCreateAPIKeyResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SchemaReadRequestMetadataValidationError) Error() string {
+func (e AdminCreateAPIKeyResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2606,14 +21210,14 @@ func (e SchemaReadRequestMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSchemaReadRequestMetadata.%s: %s%s",
+		"invalid %sAdminCreateAPIKeyResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SchemaReadRequestMetadataValidationError{}
+var _ error = AdminCreateAPIKeyResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2621,73 +21225,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SchemaReadRequestMetadataValidationError{}
+} = AdminCreateAPIKeyResponseValidationError{}
 
-// Validate checks the field values on SchemaReadResponse with the rules
+// Validate checks the field values on AdminDeleteAPIKeyRequest with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SchemaReadResponse) Validate() error {
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AdminDeleteAPIKeyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SchemaReadResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminDeleteAPIKeyRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SchemaReadResponseMultiError, or nil if none found.
-func (m *SchemaReadResponse) ValidateAll() error {
+// AdminDeleteAPIKeyRequestMultiError, or nil if none found.
+func (m *AdminDeleteAPIKeyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SchemaReadResponse) validate(all bool) error {
+func (m *AdminDeleteAPIKeyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSchema()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SchemaReadResponseValidationError{
-					field:  "Schema",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SchemaReadResponseValidationError{
-					field:  "Schema",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := AdminDeleteAPIKeyRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
 		}
-	} else if v, ok := interface{}(m.GetSchema()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SchemaReadResponseValidationError{
-				field:  "Schema",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return SchemaReadResponseMultiError(errors)
+		return AdminDeleteAPIKeyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SchemaReadResponseMultiError is an error wrapping multiple validation errors
-// returned by SchemaReadResponse.ValidateAll() if the designated constraints
-// aren't met.
-type SchemaReadResponseMultiError []error
+// AdminDeleteAPIKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminDeleteAPIKeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminDeleteAPIKeyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SchemaReadResponseMultiError) Error() string {
+func (m AdminDeleteAPIKeyRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2696,11 +21283,11 @@ func (m SchemaReadResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SchemaReadResponseMultiError) AllErrors() []error { return m }
+func (m AdminDeleteAPIKeyRequestMultiError) AllErrors() []error { return m }
 
-// SchemaReadResponseValidationError is the validation error returned by
-// SchemaReadResponse.Validate if the designated constraints aren't met.
-type SchemaReadResponseValidationError struct {
+// AdminDeleteAPIKeyRequestValidationError is the validation error returned by
+// AdminDeleteAPIKeyRequest.Validate if the designated constraints aren't met.
+type AdminDeleteAPIKeyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2708,24 +21295,24 @@ type SchemaReadResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SchemaReadResponseValidationError) Field() string { return e.field }
+func (e AdminDeleteAPIKeyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SchemaReadResponseValidationError) Reason() string { return e.reason }
+func (e AdminDeleteAPIKeyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SchemaReadResponseValidationError) Cause() error { return e.cause }
+func (e AdminDeleteAPIKeyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SchemaReadResponseValidationError) Key() bool { return e.key }
+func (e AdminDeleteAPIKeyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SchemaReadResponseValidationError) ErrorName() string {
-	return "SchemaReadResponseValidationError"
+func (e AdminDeleteAPIKeyRequestValidationError) ErrorName() string {
+	return "AdminDeleteAPIKeyRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SchemaReadResponseValidationError) Error() string {
+func (e AdminDeleteAPIKeyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2737,14 +21324,14 @@ func (e SchemaReadResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSchemaReadResponse.%s: %s%s",
+		"invalid %sAdminDeleteAPIKeyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SchemaReadResponseValidationError{}
+var _ error = AdminDeleteAPIKeyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2752,162 +21339,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SchemaReadResponseValidationError{}
+} = AdminDeleteAPIKeyRequestValidationError{}
 
-// Validate checks the field values on RelationshipWriteRequest with the rules
+// Validate checks the field values on AdminDeleteAPIKeyResponse with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RelationshipWriteRequest) Validate() error {
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AdminDeleteAPIKeyResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipWriteRequest with the
+// ValidateAll checks the field values on AdminDeleteAPIKeyResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RelationshipWriteRequestMultiError, or nil if none found.
-func (m *RelationshipWriteRequest) ValidateAll() error {
+// AdminDeleteAPIKeyResponseMultiError, or nil if none found.
+func (m *AdminDeleteAPIKeyResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationshipWriteRequest) validate(all bool) error {
+func (m *AdminDeleteAPIKeyResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetTenantId()) > 64 {
-		err := RelationshipWriteRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at most 64 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_RelationshipWriteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := RelationshipWriteRequestValidationError{
-			field:  "TenantId",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetMetadata() == nil {
-		err := RelationshipWriteRequestValidationError{
-			field:  "Metadata",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if all {
-		switch v := interface{}(m.GetMetadata()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RelationshipWriteRequestValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, RelationshipWriteRequestValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return RelationshipWriteRequestValidationError{
-				field:  "Metadata",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if l := len(m.GetTuples()); l < 1 || l > 100 {
-		err := RelationshipWriteRequestValidationError{
-			field:  "Tuples",
-			reason: "value must contain between 1 and 100 items, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	for idx, item := range m.GetTuples() {
-		_, _ = idx, item
-
-		if item == nil {
-			err := RelationshipWriteRequestValidationError{
-				field:  fmt.Sprintf("Tuples[%v]", idx),
-				reason: "value is required",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, RelationshipWriteRequestValidationError{
-						field:  fmt.Sprintf("Tuples[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, RelationshipWriteRequestValidationError{
-						field:  fmt.Sprintf("Tuples[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return RelationshipWriteRequestValidationError{
-					field:  fmt.Sprintf("Tuples[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return RelationshipWriteRequestMultiError(errors)
+		return AdminDeleteAPIKeyResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipWriteRequestMultiError is an error wrapping multiple validation
-// errors returned by RelationshipWriteRequest.ValidateAll() if the designated
-// constraints aren't met.
-type RelationshipWriteRequestMultiError []error
+// AdminDeleteAPIKeyResponseMultiError is an error wrapping multiple validation
+// errors returned by AdminDeleteAPIKeyResponse.ValidateAll() if the
+// designated constraints aren't met.
+type AdminDeleteAPIKeyResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipWriteRequestMultiError) Error() string {
+func (m AdminDeleteAPIKeyResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2916,11 +21388,11 @@ func (m RelationshipWriteRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationshipWriteRequestMultiError) AllErrors() []error { return m }
+func (m AdminDeleteAPIKeyResponseMultiError) AllErrors() []error { return m }
 
-// RelationshipWriteRequestValidationError is the validation error returned by
-// RelationshipWriteRequest.Validate if the designated constraints aren't met.
-type RelationshipWriteRequestValidationError struct {
+// AdminDeleteAPIKeyResponseValidationError is the validation error returned by
+// AdminDeleteAPIKeyResponse.Validate if the designated constraints aren't met.
+type AdminDeleteAPIKeyResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2928,24 +21400,24 @@ type RelationshipWriteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipWriteRequestValidationError) Field() string { return e.field }
+func (e AdminDeleteAPIKeyResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipWriteRequestValidationError) Reason() string { return e.reason }
+func (e AdminDeleteAPIKeyResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipWriteRequestValidationError) Cause() error { return e.cause }
+func (e AdminDeleteAPIKeyResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipWriteRequestValidationError) Key() bool { return e.key }
+func (e AdminDeleteAPIKeyResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipWriteRequestValidationError) ErrorName() string {
-	return "RelationshipWriteRequestValidationError"
+func (e AdminDeleteAPIKeyResponseValidationError) ErrorName() string {
+	return "AdminDeleteAPIKeyResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RelationshipWriteRequestValidationError) Error() string {
+func (e AdminDeleteAPIKeyResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2957,14 +21429,14 @@ func (e RelationshipWriteRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationshipWriteRequest.%s: %s%s",
+		"invalid %sAdminDeleteAPIKeyResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationshipWriteRequestValidationError{}
+var _ error = AdminDeleteAPIKeyResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2972,50 +21444,45 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipWriteRequestValidationError{}
-
-var _RelationshipWriteRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+} = AdminDeleteAPIKeyResponseValidationError{}
 
-// Validate checks the field values on RelationshipWriteRequestMetadata with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *RelationshipWriteRequestMetadata) Validate() error {
+// Validate checks the field values on AdminListAPIKeysRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AdminListAPIKeysRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipWriteRequestMetadata with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RelationshipWriteRequestMetadataMultiError, or nil if none found.
-func (m *RelationshipWriteRequestMetadata) ValidateAll() error {
+// ValidateAll checks the field values on AdminListAPIKeysRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminListAPIKeysRequestMultiError, or nil if none found.
+func (m *AdminListAPIKeysRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationshipWriteRequestMetadata) validate(all bool) error {
+func (m *AdminListAPIKeysRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SchemaVersion
-
 	if len(errors) > 0 {
-		return RelationshipWriteRequestMetadataMultiError(errors)
+		return AdminListAPIKeysRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipWriteRequestMetadataMultiError is an error wrapping multiple
-// validation errors returned by
-// RelationshipWriteRequestMetadata.ValidateAll() if the designated
+// AdminListAPIKeysRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminListAPIKeysRequest.ValidateAll() if the designated
 // constraints aren't met.
-type RelationshipWriteRequestMetadataMultiError []error
+type AdminListAPIKeysRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipWriteRequestMetadataMultiError) Error() string {
+func (m AdminListAPIKeysRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3024,12 +21491,11 @@ func (m RelationshipWriteRequestMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationshipWriteRequestMetadataMultiError) AllErrors() []error { return m }
+func (m AdminListAPIKeysRequestMultiError) AllErrors() []error { return m }
 
-// RelationshipWriteRequestMetadataValidationError is the validation error
-// returned by RelationshipWriteRequestMetadata.Validate if the designated
-// constraints aren't met.
-type RelationshipWriteRequestMetadataValidationError struct {
+// AdminListAPIKeysRequestValidationError is the validation error returned by
+// AdminListAPIKeysRequest.Validate if the designated constraints aren't met.
+type AdminListAPIKeysRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3037,24 +21503,24 @@ type RelationshipWriteRequestMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipWriteRequestMetadataValidationError) Field() string { return e.field }
+func (e AdminListAPIKeysRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipWriteRequestMetadataValidationError) Reason() string { return e.reason }
+func (e AdminListAPIKeysRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipWriteRequestMetadataValidationError) Cause() error { return e.cause }
+func (e AdminListAPIKeysRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipWriteRequestMetadataValidationError) Key() bool { return e.key }
+func (e AdminListAPIKeysRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipWriteRequestMetadataValidationError) ErrorName() string {
-	return "RelationshipWriteRequestMetadataValidationError"
+func (e AdminListAPIKeysRequestValidationError) ErrorName() string {
+	return "AdminListAPIKeysRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RelationshipWriteRequestMetadataValidationError) Error() string {
+func (e AdminListAPIKeysRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3066,14 +21532,14 @@ func (e RelationshipWriteRequestMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationshipWriteRequestMetadata.%s: %s%s",
+		"invalid %sAdminListAPIKeysRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationshipWriteRequestMetadataValidationError{}
+var _ error = AdminListAPIKeysRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3081,46 +21547,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipWriteRequestMetadataValidationError{}
+} = AdminListAPIKeysRequestValidationError{}
 
-// Validate checks the field values on RelationshipWriteResponse with the rules
+// Validate checks the field values on AdminListAPIKeysResponse with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RelationshipWriteResponse) Validate() error {
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AdminListAPIKeysResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipWriteResponse with the
+// ValidateAll checks the field values on AdminListAPIKeysResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RelationshipWriteResponseMultiError, or nil if none found.
-func (m *RelationshipWriteResponse) ValidateAll() error {
+// AdminListAPIKeysResponseMultiError, or nil if none found.
+func (m *AdminListAPIKeysResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationshipWriteResponse) validate(all bool) error {
+func (m *AdminListAPIKeysResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SnapToken
+	for idx, item := range m.GetKeys() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminListAPIKeysResponseValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminListAPIKeysResponseValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminListAPIKeysResponseValidationError{
+					field:  fmt.Sprintf("Keys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return RelationshipWriteResponseMultiError(errors)
+		return AdminListAPIKeysResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipWriteResponseMultiError is an error wrapping multiple validation
-// errors returned by RelationshipWriteResponse.ValidateAll() if the
-// designated constraints aren't met.
-type RelationshipWriteResponseMultiError []error
+// AdminListAPIKeysResponseMultiError is an error wrapping multiple validation
+// errors returned by AdminListAPIKeysResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AdminListAPIKeysResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipWriteResponseMultiError) Error() string {
+func (m AdminListAPIKeysResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3129,11 +21628,11 @@ func (m RelationshipWriteResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationshipWriteResponseMultiError) AllErrors() []error { return m }
+func (m AdminListAPIKeysResponseMultiError) AllErrors() []error { return m }
 
-// RelationshipWriteResponseValidationError is the validation error returned by
-// RelationshipWriteResponse.Validate if the designated constraints aren't met.
-type RelationshipWriteResponseValidationError struct {
+// AdminListAPIKeysResponseValidationError is the validation error returned by
+// AdminListAPIKeysResponse.Validate if the designated constraints aren't met.
+type AdminListAPIKeysResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3141,24 +21640,24 @@ type RelationshipWriteResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipWriteResponseValidationError) Field() string { return e.field }
+func (e AdminListAPIKeysResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipWriteResponseValidationError) Reason() string { return e.reason }
+func (e AdminListAPIKeysResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipWriteResponseValidationError) Cause() error { return e.cause }
+func (e AdminListAPIKeysResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipWriteResponseValidationError) Key() bool { return e.key }
+func (e AdminListAPIKeysResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipWriteResponseValidationError) ErrorName() string {
-	return "RelationshipWriteResponseValidationError"
+func (e AdminListAPIKeysResponseValidationError) ErrorName() string {
+	return "AdminListAPIKeysResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RelationshipWriteResponseValidationError) Error() string {
+func (e AdminListAPIKeysResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3170,14 +21669,14 @@ func (e RelationshipWriteResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationshipWriteResponse.%s: %s%s",
+		"invalid %sAdminListAPIKeysResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationshipWriteResponseValidationError{}
+var _ error = AdminListAPIKeysResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3185,24 +21684,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipWriteResponseValidationError{}
+} = AdminListAPIKeysResponseValidationError{}
 
-// Validate checks the field values on RelationshipReadRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RelationshipReadRequest) Validate() error {
+// Validate checks the field values on AdminListCheckTracesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AdminListCheckTracesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipReadRequest with the
+// ValidateAll checks the field values on AdminListCheckTracesRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RelationshipReadRequestMultiError, or nil if none found.
-func (m *RelationshipReadRequest) ValidateAll() error {
+// AdminListCheckTracesRequestMultiError, or nil if none found.
+func (m *AdminListCheckTracesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationshipReadRequest) validate(all bool) error {
+func (m *AdminListCheckTracesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3210,7 +21710,7 @@ func (m *RelationshipReadRequest) validate(all bool) error {
 	var errors []error
 
 	if len(m.GetTenantId()) > 64 {
-		err := RelationshipReadRequestValidationError{
+		err := AdminListCheckTracesRequestValidationError{
 			field:  "TenantId",
 			reason: "value length must be at most 64 bytes",
 		}
@@ -3220,8 +21720,8 @@ func (m *RelationshipReadRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_RelationshipReadRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := RelationshipReadRequestValidationError{
+	if !_AdminListCheckTracesRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := AdminListCheckTracesRequestValidationError{
 			field:  "TenantId",
 			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
 		}
@@ -3231,40 +21731,29 @@ func (m *RelationshipReadRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetMetadata() == nil {
-		err := RelationshipReadRequestValidationError{
-			field:  "Metadata",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if all {
-		switch v := interface{}(m.GetMetadata()).(type) {
+		switch v := interface{}(m.GetSince()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RelationshipReadRequestValidationError{
-					field:  "Metadata",
+				errors = append(errors, AdminListCheckTracesRequestValidationError{
+					field:  "Since",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, RelationshipReadRequestValidationError{
-					field:  "Metadata",
+				errors = append(errors, AdminListCheckTracesRequestValidationError{
+					field:  "Since",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetSince()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return RelationshipReadRequestValidationError{
-				field:  "Metadata",
+			return AdminListCheckTracesRequestValidationError{
+				field:  "Since",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -3272,67 +21761,192 @@ func (m *RelationshipReadRequest) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetFilter()).(type) {
+		switch v := interface{}(m.GetUntil()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RelationshipReadRequestValidationError{
-					field:  "Filter",
+				errors = append(errors, AdminListCheckTracesRequestValidationError{
+					field:  "Until",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, RelationshipReadRequestValidationError{
-					field:  "Filter",
+				errors = append(errors, AdminListCheckTracesRequestValidationError{
+					field:  "Until",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetUntil()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return RelationshipReadRequestValidationError{
-				field:  "Filter",
+			return AdminListCheckTracesRequestValidationError{
+				field:  "Until",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if m.GetPageSize() != 0 {
+	// no validation rules for Limit
 
-		if val := m.GetPageSize(); val < 1 || val > 100 {
-			err := RelationshipReadRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be inside range [1, 100]",
+	if len(errors) > 0 {
+		return AdminListCheckTracesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminListCheckTracesRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminListCheckTracesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminListCheckTracesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminListCheckTracesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminListCheckTracesRequestMultiError) AllErrors() []error { return m }
+
+// AdminListCheckTracesRequestValidationError is the validation error returned
+// by AdminListCheckTracesRequest.Validate if the designated constraints
+// aren't met.
+type AdminListCheckTracesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminListCheckTracesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminListCheckTracesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminListCheckTracesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminListCheckTracesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminListCheckTracesRequestValidationError) ErrorName() string {
+	return "AdminListCheckTracesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminListCheckTracesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminListCheckTracesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminListCheckTracesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminListCheckTracesRequestValidationError{}
+
+var _AdminListCheckTracesRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// Validate checks the field values on AdminListCheckTracesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AdminListCheckTracesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminListCheckTracesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminListCheckTracesResponseMultiError, or nil if none found.
+func (m *AdminListCheckTracesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminListCheckTracesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	for idx, item := range m.GetTraces() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminListCheckTracesResponseValidationError{
+						field:  fmt.Sprintf("Traces[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminListCheckTracesResponseValidationError{
+						field:  fmt.Sprintf("Traces[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-			if !all {
-				return err
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminListCheckTracesResponseValidationError{
+					field:  fmt.Sprintf("Traces[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
-			errors = append(errors, err)
 		}
 
 	}
 
-	if m.GetContinuousToken() != "" {
-
-	}
-
 	if len(errors) > 0 {
-		return RelationshipReadRequestMultiError(errors)
+		return AdminListCheckTracesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipReadRequestMultiError is an error wrapping multiple validation
-// errors returned by RelationshipReadRequest.ValidateAll() if the designated
-// constraints aren't met.
-type RelationshipReadRequestMultiError []error
+// AdminListCheckTracesResponseMultiError is an error wrapping multiple
+// validation errors returned by AdminListCheckTracesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type AdminListCheckTracesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipReadRequestMultiError) Error() string {
+func (m AdminListCheckTracesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3341,11 +21955,12 @@ func (m RelationshipReadRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationshipReadRequestMultiError) AllErrors() []error { return m }
+func (m AdminListCheckTracesResponseMultiError) AllErrors() []error { return m }
 
-// RelationshipReadRequestValidationError is the validation error returned by
-// RelationshipReadRequest.Validate if the designated constraints aren't met.
-type RelationshipReadRequestValidationError struct {
+// AdminListCheckTracesResponseValidationError is the validation error returned
+// by AdminListCheckTracesResponse.Validate if the designated constraints
+// aren't met.
+type AdminListCheckTracesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3353,24 +21968,24 @@ type RelationshipReadRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipReadRequestValidationError) Field() string { return e.field }
+func (e AdminListCheckTracesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipReadRequestValidationError) Reason() string { return e.reason }
+func (e AdminListCheckTracesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipReadRequestValidationError) Cause() error { return e.cause }
+func (e AdminListCheckTracesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipReadRequestValidationError) Key() bool { return e.key }
+func (e AdminListCheckTracesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipReadRequestValidationError) ErrorName() string {
-	return "RelationshipReadRequestValidationError"
+func (e AdminListCheckTracesResponseValidationError) ErrorName() string {
+	return "AdminListCheckTracesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RelationshipReadRequestValidationError) Error() string {
+func (e AdminListCheckTracesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3382,14 +21997,14 @@ func (e RelationshipReadRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationshipReadRequest.%s: %s%s",
+		"invalid %sAdminListCheckTracesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationshipReadRequestValidationError{}
+var _ error = AdminListCheckTracesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3397,48 +22012,127 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipReadRequestValidationError{}
+} = AdminListCheckTracesResponseValidationError{}
 
-var _RelationshipReadRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
-
-// Validate checks the field values on RelationshipReadRequestMetadata with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RelationshipReadRequestMetadata) Validate() error {
+// Validate checks the field values on AdminCheckTrace with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminCheckTrace) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipReadRequestMetadata with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RelationshipReadRequestMetadataMultiError, or nil if none found.
-func (m *RelationshipReadRequestMetadata) ValidateAll() error {
+// ValidateAll checks the field values on AdminCheckTrace with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminCheckTraceMultiError, or nil if none found.
+func (m *AdminCheckTrace) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationshipReadRequestMetadata) validate(all bool) error {
+func (m *AdminCheckTrace) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
+	// no validation rules for Entity
+
+	// no validation rules for Permission
+
+	// no validation rules for Subject
+
+	// no validation rules for SchemaVersion
+
 	// no validation rules for SnapToken
 
+	// no validation rules for Depth
+
+	// no validation rules for Result
+
+	// no validation rules for Error
+
+	for idx, item := range m.GetSteps() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminCheckTraceValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminCheckTraceValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminCheckTraceValidationError{
+					field:  fmt.Sprintf("Steps[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for DurationMs
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminCheckTraceValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminCheckTraceValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminCheckTraceValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return RelationshipReadRequestMetadataMultiError(errors)
+		return AdminCheckTraceMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipReadRequestMetadataMultiError is an error wrapping multiple
-// validation errors returned by RelationshipReadRequestMetadata.ValidateAll()
-// if the designated constraints aren't met.
-type RelationshipReadRequestMetadataMultiError []error
+// AdminCheckTraceMultiError is an error wrapping multiple validation errors
+// returned by AdminCheckTrace.ValidateAll() if the designated constraints
+// aren't met.
+type AdminCheckTraceMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipReadRequestMetadataMultiError) Error() string {
+func (m AdminCheckTraceMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3447,12 +22141,11 @@ func (m RelationshipReadRequestMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationshipReadRequestMetadataMultiError) AllErrors() []error { return m }
+func (m AdminCheckTraceMultiError) AllErrors() []error { return m }
 
-// RelationshipReadRequestMetadataValidationError is the validation error
-// returned by RelationshipReadRequestMetadata.Validate if the designated
-// constraints aren't met.
-type RelationshipReadRequestMetadataValidationError struct {
+// AdminCheckTraceValidationError is the validation error returned by
+// AdminCheckTrace.Validate if the designated constraints aren't met.
+type AdminCheckTraceValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3460,24 +22153,22 @@ type RelationshipReadRequestMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipReadRequestMetadataValidationError) Field() string { return e.field }
+func (e AdminCheckTraceValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipReadRequestMetadataValidationError) Reason() string { return e.reason }
+func (e AdminCheckTraceValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipReadRequestMetadataValidationError) Cause() error { return e.cause }
+func (e AdminCheckTraceValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipReadRequestMetadataValidationError) Key() bool { return e.key }
+func (e AdminCheckTraceValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipReadRequestMetadataValidationError) ErrorName() string {
-	return "RelationshipReadRequestMetadataValidationError"
-}
+func (e AdminCheckTraceValidationError) ErrorName() string { return "AdminCheckTraceValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RelationshipReadRequestMetadataValidationError) Error() string {
+func (e AdminCheckTraceValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3489,14 +22180,14 @@ func (e RelationshipReadRequestMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationshipReadRequestMetadata.%s: %s%s",
+		"invalid %sAdminCheckTrace.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationshipReadRequestMetadataValidationError{}
+var _ error = AdminCheckTraceValidationError{}
 
 var _ interface {
 	Field() string
@@ -3504,80 +22195,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipReadRequestMetadataValidationError{}
+} = AdminCheckTraceValidationError{}
 
-// Validate checks the field values on RelationshipReadResponse with the rules
+// Validate checks the field values on AdminCheckTraceStep with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RelationshipReadResponse) Validate() error {
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AdminCheckTraceStep) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipReadResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminCheckTraceStep with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RelationshipReadResponseMultiError, or nil if none found.
-func (m *RelationshipReadResponse) ValidateAll() error {
+// AdminCheckTraceStepMultiError, or nil if none found.
+func (m *AdminCheckTraceStep) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationshipReadResponse) validate(all bool) error {
+func (m *AdminCheckTraceStep) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetTuples() {
-		_, _ = idx, item
+	// no validation rules for Entity
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, RelationshipReadResponseValidationError{
-						field:  fmt.Sprintf("Tuples[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, RelationshipReadResponseValidationError{
-						field:  fmt.Sprintf("Tuples[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return RelationshipReadResponseValidationError{
-					field:  fmt.Sprintf("Tuples[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Permission
 
-	}
+	// no validation rules for Subject
 
-	// no validation rules for ContinuousToken
+	// no validation rules for Depth
+
+	// no validation rules for Result
+
+	// no validation rules for Error
 
 	if len(errors) > 0 {
-		return RelationshipReadResponseMultiError(errors)
+		return AdminCheckTraceStepMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipReadResponseMultiError is an error wrapping multiple validation
-// errors returned by RelationshipReadResponse.ValidateAll() if the designated
+// AdminCheckTraceStepMultiError is an error wrapping multiple validation
+// errors returned by AdminCheckTraceStep.ValidateAll() if the designated
 // constraints aren't met.
-type RelationshipReadResponseMultiError []error
+type AdminCheckTraceStepMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipReadResponseMultiError) Error() string {
+func (m AdminCheckTraceStepMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3586,11 +22254,11 @@ func (m RelationshipReadResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationshipReadResponseMultiError) AllErrors() []error { return m }
+func (m AdminCheckTraceStepMultiError) AllErrors() []error { return m }
 
-// RelationshipReadResponseValidationError is the validation error returned by
-// RelationshipReadResponse.Validate if the designated constraints aren't met.
-type RelationshipReadResponseValidationError struct {
+// AdminCheckTraceStepValidationError is the validation error returned by
+// AdminCheckTraceStep.Validate if the designated constraints aren't met.
+type AdminCheckTraceStepValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3598,24 +22266,24 @@ type RelationshipReadResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipReadResponseValidationError) Field() string { return e.field }
+func (e AdminCheckTraceStepValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipReadResponseValidationError) Reason() string { return e.reason }
+func (e AdminCheckTraceStepValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipReadResponseValidationError) Cause() error { return e.cause }
+func (e AdminCheckTraceStepValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipReadResponseValidationError) Key() bool { return e.key }
+func (e AdminCheckTraceStepValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipReadResponseValidationError) ErrorName() string {
-	return "RelationshipReadResponseValidationError"
+func (e AdminCheckTraceStepValidationError) ErrorName() string {
+	return "AdminCheckTraceStepValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RelationshipReadResponseValidationError) Error() string {
+func (e AdminCheckTraceStepValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3627,14 +22295,14 @@ func (e RelationshipReadResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationshipReadResponse.%s: %s%s",
+		"invalid %sAdminCheckTraceStep.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationshipReadResponseValidationError{}
+var _ error = AdminCheckTraceStepValidationError{}
 
 var _ interface {
 	Field() string
@@ -3642,34 +22310,141 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipReadResponseValidationError{}
+} = AdminCheckTraceStepValidationError{}
 
-// Validate checks the field values on RelationshipDeleteRequest with the rules
+// Validate checks the field values on AdminReplayCheckRequest with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RelationshipDeleteRequest) Validate() error {
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AdminReplayCheckRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipDeleteRequest with the
+// ValidateAll checks the field values on AdminReplayCheckRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RelationshipDeleteRequestMultiError, or nil if none found.
-func (m *RelationshipDeleteRequest) ValidateAll() error {
+// AdminReplayCheckRequestMultiError, or nil if none found.
+func (m *AdminReplayCheckRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationshipDeleteRequest) validate(all bool) error {
-	if m == nil {
-		return nil
+func (m *AdminReplayCheckRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTenantId()) > 64 {
+		err := AdminReplayCheckRequestValidationError{
+			field:  "TenantId",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AdminReplayCheckRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
+		err := AdminReplayCheckRequestValidationError{
+			field:  "TenantId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSchemaVersion()) < 1 {
+		err := AdminReplayCheckRequestValidationError{
+			field:  "SchemaVersion",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSnapToken()) < 1 {
+		err := AdminReplayCheckRequestValidationError{
+			field:  "SnapToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEntity() == nil {
+		err := AdminReplayCheckRequestValidationError{
+			field:  "Entity",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetEntity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminReplayCheckRequestValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminReplayCheckRequestValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEntity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminReplayCheckRequestValidationError{
+				field:  "Entity",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetPermission()) > 64 {
+		err := AdminReplayCheckRequestValidationError{
+			field:  "Permission",
+			reason: "value length must be at most 64 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	var errors []error
+	if !_AdminReplayCheckRequest_Permission_Pattern.MatchString(m.GetPermission()) {
+		err := AdminReplayCheckRequestValidationError{
+			field:  "Permission",
+			reason: "value does not match regex pattern \"^([a-z][a-z0-9_]{1,62}[a-z0-9])$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if len(m.GetTenantId()) > 64 {
-		err := RelationshipDeleteRequestValidationError{
-			field:  "TenantId",
-			reason: "value length must be at most 64 bytes",
+	if m.GetSubject() == nil {
+		err := AdminReplayCheckRequestValidationError{
+			field:  "Subject",
+			reason: "value is required",
 		}
 		if !all {
 			return err
@@ -3677,10 +22452,39 @@ func (m *RelationshipDeleteRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_RelationshipDeleteRequest_TenantId_Pattern.MatchString(m.GetTenantId()) {
-		err := RelationshipDeleteRequestValidationError{
-			field:  "TenantId",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9]+$\"",
+	if all {
+		switch v := interface{}(m.GetSubject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminReplayCheckRequestValidationError{
+					field:  "Subject",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminReplayCheckRequestValidationError{
+					field:  "Subject",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminReplayCheckRequestValidationError{
+				field:  "Subject",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetDepth() < 3 {
+		err := AdminReplayCheckRequestValidationError{
+			field:  "Depth",
+			reason: "value must be greater than or equal to 3",
 		}
 		if !all {
 			return err
@@ -3689,28 +22493,28 @@ func (m *RelationshipDeleteRequest) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetFilter()).(type) {
+		switch v := interface{}(m.GetContext()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RelationshipDeleteRequestValidationError{
-					field:  "Filter",
+				errors = append(errors, AdminReplayCheckRequestValidationError{
+					field:  "Context",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, RelationshipDeleteRequestValidationError{
-					field:  "Filter",
+				errors = append(errors, AdminReplayCheckRequestValidationError{
+					field:  "Context",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetContext()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return RelationshipDeleteRequestValidationError{
-				field:  "Filter",
+			return AdminReplayCheckRequestValidationError{
+				field:  "Context",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -3718,19 +22522,19 @@ func (m *RelationshipDeleteRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return RelationshipDeleteRequestMultiError(errors)
+		return AdminReplayCheckRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipDeleteRequestMultiError is an error wrapping multiple validation
-// errors returned by RelationshipDeleteRequest.ValidateAll() if the
-// designated constraints aren't met.
-type RelationshipDeleteRequestMultiError []error
+// AdminReplayCheckRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminReplayCheckRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminReplayCheckRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipDeleteRequestMultiError) Error() string {
+func (m AdminReplayCheckRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3739,11 +22543,11 @@ func (m RelationshipDeleteRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationshipDeleteRequestMultiError) AllErrors() []error { return m }
+func (m AdminReplayCheckRequestMultiError) AllErrors() []error { return m }
 
-// RelationshipDeleteRequestValidationError is the validation error returned by
-// RelationshipDeleteRequest.Validate if the designated constraints aren't met.
-type RelationshipDeleteRequestValidationError struct {
+// AdminReplayCheckRequestValidationError is the validation error returned by
+// AdminReplayCheckRequest.Validate if the designated constraints aren't met.
+type AdminReplayCheckRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3751,24 +22555,24 @@ type RelationshipDeleteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipDeleteRequestValidationError) Field() string { return e.field }
+func (e AdminReplayCheckRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipDeleteRequestValidationError) Reason() string { return e.reason }
+func (e AdminReplayCheckRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipDeleteRequestValidationError) Cause() error { return e.cause }
+func (e AdminReplayCheckRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipDeleteRequestValidationError) Key() bool { return e.key }
+func (e AdminReplayCheckRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipDeleteRequestValidationError) ErrorName() string {
-	return "RelationshipDeleteRequestValidationError"
+func (e AdminReplayCheckRequestValidationError) ErrorName() string {
+	return "AdminReplayCheckRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RelationshipDeleteRequestValidationError) Error() string {
+func (e AdminReplayCheckRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3780,14 +22584,14 @@ func (e RelationshipDeleteRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationshipDeleteRequest.%s: %s%s",
+		"invalid %sAdminReplayCheckRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationshipDeleteRequestValidationError{}
+var _ error = AdminReplayCheckRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3795,48 +22599,85 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipDeleteRequestValidationError{}
+} = AdminReplayCheckRequestValidationError{}
 
-var _RelationshipDeleteRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+var _AdminReplayCheckRequest_TenantId_Pattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
 
-// Validate checks the field values on RelationshipDeleteResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RelationshipDeleteResponse) Validate() error {
+var _AdminReplayCheckRequest_Permission_Pattern = regexp.MustCompile("^([a-z][a-z0-9_]{1,62}[a-z0-9])$")
+
+// Validate checks the field values on AdminReplayCheckResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AdminReplayCheckResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelationshipDeleteResponse with the
+// ValidateAll checks the field values on AdminReplayCheckResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RelationshipDeleteResponseMultiError, or nil if none found.
-func (m *RelationshipDeleteResponse) ValidateAll() error {
+// AdminReplayCheckResponseMultiError, or nil if none found.
+func (m *AdminReplayCheckResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelationshipDeleteResponse) validate(all bool) error {
+func (m *AdminReplayCheckResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SnapToken
+	// no validation rules for Can
+
+	for idx, item := range m.GetSteps() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminReplayCheckResponseValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminReplayCheckResponseValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminReplayCheckResponseValidationError{
+					field:  fmt.Sprintf("Steps[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return RelationshipDeleteResponseMultiError(errors)
+		return AdminReplayCheckResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelationshipDeleteResponseMultiError is an error wrapping multiple
-// validation errors returned by RelationshipDeleteResponse.ValidateAll() if
-// the designated constraints aren't met.
-type RelationshipDeleteResponseMultiError []error
+// AdminReplayCheckResponseMultiError is an error wrapping multiple validation
+// errors returned by AdminReplayCheckResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AdminReplayCheckResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelationshipDeleteResponseMultiError) Error() string {
+func (m AdminReplayCheckResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3845,11 +22686,11 @@ func (m RelationshipDeleteResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelationshipDeleteResponseMultiError) AllErrors() []error { return m }
+func (m AdminReplayCheckResponseMultiError) AllErrors() []error { return m }
 
-// RelationshipDeleteResponseValidationError is the validation error returned
-// by RelationshipDeleteResponse.Validate if the designated constraints aren't met.
-type RelationshipDeleteResponseValidationError struct {
+// AdminReplayCheckResponseValidationError is the validation error returned by
+// AdminReplayCheckResponse.Validate if the designated constraints aren't met.
+type AdminReplayCheckResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3857,24 +22698,24 @@ type RelationshipDeleteResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelationshipDeleteResponseValidationError) Field() string { return e.field }
+func (e AdminReplayCheckResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelationshipDeleteResponseValidationError) Reason() string { return e.reason }
+func (e AdminReplayCheckResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelationshipDeleteResponseValidationError) Cause() error { return e.cause }
+func (e AdminReplayCheckResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelationshipDeleteResponseValidationError) Key() bool { return e.key }
+func (e AdminReplayCheckResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelationshipDeleteResponseValidationError) ErrorName() string {
-	return "RelationshipDeleteResponseValidationError"
+func (e AdminReplayCheckResponseValidationError) ErrorName() string {
+	return "AdminReplayCheckResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RelationshipDeleteResponseValidationError) Error() string {
+func (e AdminReplayCheckResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3886,14 +22727,14 @@ func (e RelationshipDeleteResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelationshipDeleteResponse.%s: %s%s",
+		"invalid %sAdminReplayCheckResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelationshipDeleteResponseValidationError{}
+var _ error = AdminReplayCheckResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3901,66 +22742,45 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelationshipDeleteResponseValidationError{}
+} = AdminReplayCheckResponseValidationError{}
 
-// Validate checks the field values on TenantCreateRequest with the rules
+// Validate checks the field values on AdminRingStatsRequest with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TenantCreateRequest) Validate() error {
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AdminRingStatsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TenantCreateRequest with the rules
+// ValidateAll checks the field values on AdminRingStatsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TenantCreateRequestMultiError, or nil if none found.
-func (m *TenantCreateRequest) ValidateAll() error {
+// AdminRingStatsRequestMultiError, or nil if none found.
+func (m *AdminRingStatsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TenantCreateRequest) validate(all bool) error {
+func (m *AdminRingStatsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetId()) > 64 {
-		err := TenantCreateRequestValidationError{
-			field:  "Id",
-			reason: "value length must be at most 64 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetName()) > 64 {
-		err := TenantCreateRequestValidationError{
-			field:  "Name",
-			reason: "value length must be at most 64 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if len(errors) > 0 {
-		return TenantCreateRequestMultiError(errors)
+		return AdminRingStatsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TenantCreateRequestMultiError is an error wrapping multiple validation
-// errors returned by TenantCreateRequest.ValidateAll() if the designated
+// AdminRingStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminRingStatsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type TenantCreateRequestMultiError []error
+type AdminRingStatsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TenantCreateRequestMultiError) Error() string {
+func (m AdminRingStatsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3969,11 +22789,11 @@ func (m TenantCreateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TenantCreateRequestMultiError) AllErrors() []error { return m }
+func (m AdminRingStatsRequestMultiError) AllErrors() []error { return m }
 
-// TenantCreateRequestValidationError is the validation error returned by
-// TenantCreateRequest.Validate if the designated constraints aren't met.
-type TenantCreateRequestValidationError struct {
+// AdminRingStatsRequestValidationError is the validation error returned by
+// AdminRingStatsRequest.Validate if the designated constraints aren't met.
+type AdminRingStatsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3981,24 +22801,24 @@ type TenantCreateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TenantCreateRequestValidationError) Field() string { return e.field }
+func (e AdminRingStatsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TenantCreateRequestValidationError) Reason() string { return e.reason }
+func (e AdminRingStatsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TenantCreateRequestValidationError) Cause() error { return e.cause }
+func (e AdminRingStatsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TenantCreateRequestValidationError) Key() bool { return e.key }
+func (e AdminRingStatsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TenantCreateRequestValidationError) ErrorName() string {
-	return "TenantCreateRequestValidationError"
+func (e AdminRingStatsRequestValidationError) ErrorName() string {
+	return "AdminRingStatsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TenantCreateRequestValidationError) Error() string {
+func (e AdminRingStatsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4010,14 +22830,14 @@ func (e TenantCreateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTenantCreateRequest.%s: %s%s",
+		"invalid %sAdminRingStatsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TenantCreateRequestValidationError{}
+var _ error = AdminRingStatsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4025,73 +22845,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TenantCreateRequestValidationError{}
+} = AdminRingStatsRequestValidationError{}
 
-// Validate checks the field values on TenantCreateResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TenantCreateResponse) Validate() error {
+// Validate checks the field values on AdminRingPeer with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminRingPeer) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TenantCreateResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TenantCreateResponseMultiError, or nil if none found.
-func (m *TenantCreateResponse) ValidateAll() error {
+// ValidateAll checks the field values on AdminRingPeer with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AdminRingPeerMultiError, or
+// nil if none found.
+func (m *AdminRingPeer) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TenantCreateResponse) validate(all bool) error {
+func (m *AdminRingPeer) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetTenant()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TenantCreateResponseValidationError{
-					field:  "Tenant",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, TenantCreateResponseValidationError{
-					field:  "Tenant",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTenant()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TenantCreateResponseValidationError{
-				field:  "Tenant",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Address
+
+	// no validation rules for Share
+
+	// no validation rules for Dispatched
+
+	// no validation rules for Failed
+
+	// no validation rules for Misrouted
 
 	if len(errors) > 0 {
-		return TenantCreateResponseMultiError(errors)
+		return AdminRingPeerMultiError(errors)
 	}
 
 	return nil
 }
 
-// TenantCreateResponseMultiError is an error wrapping multiple validation
-// errors returned by TenantCreateResponse.ValidateAll() if the designated
-// constraints aren't met.
-type TenantCreateResponseMultiError []error
+// AdminRingPeerMultiError is an error wrapping multiple validation errors
+// returned by AdminRingPeer.ValidateAll() if the designated constraints
+// aren't met.
+type AdminRingPeerMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TenantCreateResponseMultiError) Error() string {
+func (m AdminRingPeerMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4100,11 +22901,11 @@ func (m TenantCreateResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TenantCreateResponseMultiError) AllErrors() []error { return m }
+func (m AdminRingPeerMultiError) AllErrors() []error { return m }
 
-// TenantCreateResponseValidationError is the validation error returned by
-// TenantCreateResponse.Validate if the designated constraints aren't met.
-type TenantCreateResponseValidationError struct {
+// AdminRingPeerValidationError is the validation error returned by
+// AdminRingPeer.Validate if the designated constraints aren't met.
+type AdminRingPeerValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4112,24 +22913,22 @@ type TenantCreateResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TenantCreateResponseValidationError) Field() string { return e.field }
+func (e AdminRingPeerValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TenantCreateResponseValidationError) Reason() string { return e.reason }
+func (e AdminRingPeerValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TenantCreateResponseValidationError) Cause() error { return e.cause }
+func (e AdminRingPeerValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TenantCreateResponseValidationError) Key() bool { return e.key }
+func (e AdminRingPeerValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TenantCreateResponseValidationError) ErrorName() string {
-	return "TenantCreateResponseValidationError"
-}
+func (e AdminRingPeerValidationError) ErrorName() string { return "AdminRingPeerValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TenantCreateResponseValidationError) Error() string {
+func (e AdminRingPeerValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4141,14 +22940,14 @@ func (e TenantCreateResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTenantCreateResponse.%s: %s%s",
+		"invalid %sAdminRingPeer.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TenantCreateResponseValidationError{}
+var _ error = AdminRingPeerValidationError{}
 
 var _ interface {
 	Field() string
@@ -4156,44 +22955,83 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TenantCreateResponseValidationError{}
+} = AdminRingPeerValidationError{}
 
-// Validate checks the field values on TenantDeleteRequest with the rules
+// Validate checks the field values on AdminRingStatsResponse with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TenantDeleteRequest) Validate() error {
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AdminRingStatsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TenantDeleteRequest with the rules
+// ValidateAll checks the field values on AdminRingStatsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TenantDeleteRequestMultiError, or nil if none found.
-func (m *TenantDeleteRequest) ValidateAll() error {
+// AdminRingStatsResponseMultiError, or nil if none found.
+func (m *AdminRingStatsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TenantDeleteRequest) validate(all bool) error {
+func (m *AdminRingStatsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Self
+
+	// no validation rules for Local
+
+	for idx, item := range m.GetPeers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminRingStatsResponseValidationError{
+						field:  fmt.Sprintf("Peers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminRingStatsResponseValidationError{
+						field:  fmt.Sprintf("Peers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminRingStatsResponseValidationError{
+					field:  fmt.Sprintf("Peers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return TenantDeleteRequestMultiError(errors)
+		return AdminRingStatsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TenantDeleteRequestMultiError is an error wrapping multiple validation
-// errors returned by TenantDeleteRequest.ValidateAll() if the designated
+// AdminRingStatsResponseMultiError is an error wrapping multiple validation
+// errors returned by AdminRingStatsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type TenantDeleteRequestMultiError []error
+type AdminRingStatsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TenantDeleteRequestMultiError) Error() string {
+func (m AdminRingStatsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4202,11 +23040,11 @@ func (m TenantDeleteRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TenantDeleteRequestMultiError) AllErrors() []error { return m }
+func (m AdminRingStatsResponseMultiError) AllErrors() []error { return m }
 
-// TenantDeleteRequestValidationError is the validation error returned by
-// TenantDeleteRequest.Validate if the designated constraints aren't met.
-type TenantDeleteRequestValidationError struct {
+// AdminRingStatsResponseValidationError is the validation error returned by
+// AdminRingStatsResponse.Validate if the designated constraints aren't met.
+type AdminRingStatsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4214,24 +23052,24 @@ type TenantDeleteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TenantDeleteRequestValidationError) Field() string { return e.field }
+func (e AdminRingStatsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TenantDeleteRequestValidationError) Reason() string { return e.reason }
+func (e AdminRingStatsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TenantDeleteRequestValidationError) Cause() error { return e.cause }
+func (e AdminRingStatsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TenantDeleteRequestValidationError) Key() bool { return e.key }
+func (e AdminRingStatsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TenantDeleteRequestValidationError) ErrorName() string {
-	return "TenantDeleteRequestValidationError"
+func (e AdminRingStatsResponseValidationError) ErrorName() string {
+	return "AdminRingStatsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TenantDeleteRequestValidationError) Error() string {
+func (e AdminRingStatsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4243,14 +23081,14 @@ func (e TenantDeleteRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTenantDeleteRequest.%s: %s%s",
+		"invalid %sAdminRingStatsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TenantDeleteRequestValidationError{}
+var _ error = AdminRingStatsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4258,73 +23096,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TenantDeleteRequestValidationError{}
+} = AdminRingStatsResponseValidationError{}
 
-// Validate checks the field values on TenantDeleteResponse with the rules
+// Validate checks the field values on AdminWarmUpPattern with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TenantDeleteResponse) Validate() error {
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AdminWarmUpPattern) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TenantDeleteResponse with the rules
+// ValidateAll checks the field values on AdminWarmUpPattern with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TenantDeleteResponseMultiError, or nil if none found.
-func (m *TenantDeleteResponse) ValidateAll() error {
+// AdminWarmUpPatternMultiError, or nil if none found.
+func (m *AdminWarmUpPattern) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TenantDeleteResponse) validate(all bool) error {
+func (m *AdminWarmUpPattern) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetTenant()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TenantDeleteResponseValidationError{
-					field:  "Tenant",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, TenantDeleteResponseValidationError{
-					field:  "Tenant",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTenant()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TenantDeleteResponseValidationError{
-				field:  "Tenant",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for TenantId
+
+	// no validation rules for Check
 
 	if len(errors) > 0 {
-		return TenantDeleteResponseMultiError(errors)
+		return AdminWarmUpPatternMultiError(errors)
 	}
 
 	return nil
 }
 
-// TenantDeleteResponseMultiError is an error wrapping multiple validation
-// errors returned by TenantDeleteResponse.ValidateAll() if the designated
-// constraints aren't met.
-type TenantDeleteResponseMultiError []error
+// AdminWarmUpPatternMultiError is an error wrapping multiple validation errors
+// returned by AdminWarmUpPattern.ValidateAll() if the designated constraints
+// aren't met.
+type AdminWarmUpPatternMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TenantDeleteResponseMultiError) Error() string {
+func (m AdminWarmUpPatternMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4333,11 +23147,11 @@ func (m TenantDeleteResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TenantDeleteResponseMultiError) AllErrors() []error { return m }
+func (m AdminWarmUpPatternMultiError) AllErrors() []error { return m }
 
-// TenantDeleteResponseValidationError is the validation error returned by
-// TenantDeleteResponse.Validate if the designated constraints aren't met.
-type TenantDeleteResponseValidationError struct {
+// AdminWarmUpPatternValidationError is the validation error returned by
+// AdminWarmUpPattern.Validate if the designated constraints aren't met.
+type AdminWarmUpPatternValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4345,24 +23159,24 @@ type TenantDeleteResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TenantDeleteResponseValidationError) Field() string { return e.field }
+func (e AdminWarmUpPatternValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TenantDeleteResponseValidationError) Reason() string { return e.reason }
+func (e AdminWarmUpPatternValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TenantDeleteResponseValidationError) Cause() error { return e.cause }
+func (e AdminWarmUpPatternValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TenantDeleteResponseValidationError) Key() bool { return e.key }
+func (e AdminWarmUpPatternValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TenantDeleteResponseValidationError) ErrorName() string {
-	return "TenantDeleteResponseValidationError"
+func (e AdminWarmUpPatternValidationError) ErrorName() string {
+	return "AdminWarmUpPatternValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TenantDeleteResponseValidationError) Error() string {
+func (e AdminWarmUpPatternValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4374,14 +23188,14 @@ func (e TenantDeleteResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTenantDeleteResponse.%s: %s%s",
+		"invalid %sAdminWarmUpPattern.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TenantDeleteResponseValidationError{}
+var _ error = AdminWarmUpPatternValidationError{}
 
 var _ interface {
 	Field() string
@@ -4389,63 +23203,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TenantDeleteResponseValidationError{}
+} = AdminWarmUpPatternValidationError{}
 
-// Validate checks the field values on TenantListRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TenantListRequest) Validate() error {
+// Validate checks the field values on AdminWarmUpRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AdminWarmUpRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TenantListRequest with the rules
+// ValidateAll checks the field values on AdminWarmUpRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TenantListRequestMultiError, or nil if none found.
-func (m *TenantListRequest) ValidateAll() error {
+// AdminWarmUpRequestMultiError, or nil if none found.
+func (m *AdminWarmUpRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TenantListRequest) validate(all bool) error {
+func (m *AdminWarmUpRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetPageSize() != 0 {
+	for idx, item := range m.GetPatterns() {
+		_, _ = idx, item
 
-		if val := m.GetPageSize(); val < 1 || val > 100 {
-			err := TenantListRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be inside range [1, 100]",
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminWarmUpRequestValidationError{
+						field:  fmt.Sprintf("Patterns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminWarmUpRequestValidationError{
+						field:  fmt.Sprintf("Patterns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-			if !all {
-				return err
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminWarmUpRequestValidationError{
+					field:  fmt.Sprintf("Patterns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
-			errors = append(errors, err)
 		}
 
 	}
 
-	if m.GetContinuousToken() != "" {
-
-	}
-
 	if len(errors) > 0 {
-		return TenantListRequestMultiError(errors)
+		return AdminWarmUpRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TenantListRequestMultiError is an error wrapping multiple validation errors
-// returned by TenantListRequest.ValidateAll() if the designated constraints
+// AdminWarmUpRequestMultiError is an error wrapping multiple validation errors
+// returned by AdminWarmUpRequest.ValidateAll() if the designated constraints
 // aren't met.
-type TenantListRequestMultiError []error
+type AdminWarmUpRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TenantListRequestMultiError) Error() string {
+func (m AdminWarmUpRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4454,11 +23284,11 @@ func (m TenantListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TenantListRequestMultiError) AllErrors() []error { return m }
+func (m AdminWarmUpRequestMultiError) AllErrors() []error { return m }
 
-// TenantListRequestValidationError is the validation error returned by
-// TenantListRequest.Validate if the designated constraints aren't met.
-type TenantListRequestValidationError struct {
+// AdminWarmUpRequestValidationError is the validation error returned by
+// AdminWarmUpRequest.Validate if the designated constraints aren't met.
+type AdminWarmUpRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4466,24 +23296,24 @@ type TenantListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TenantListRequestValidationError) Field() string { return e.field }
+func (e AdminWarmUpRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TenantListRequestValidationError) Reason() string { return e.reason }
+func (e AdminWarmUpRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TenantListRequestValidationError) Cause() error { return e.cause }
+func (e AdminWarmUpRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TenantListRequestValidationError) Key() bool { return e.key }
+func (e AdminWarmUpRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TenantListRequestValidationError) ErrorName() string {
-	return "TenantListRequestValidationError"
+func (e AdminWarmUpRequestValidationError) ErrorName() string {
+	return "AdminWarmUpRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TenantListRequestValidationError) Error() string {
+func (e AdminWarmUpRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4495,14 +23325,14 @@ func (e TenantListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTenantListRequest.%s: %s%s",
+		"invalid %sAdminWarmUpRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TenantListRequestValidationError{}
+var _ error = AdminWarmUpRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4510,80 +23340,51 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TenantListRequestValidationError{}
+} = AdminWarmUpRequestValidationError{}
 
-// Validate checks the field values on TenantListResponse with the rules
+// Validate checks the field values on AdminWarmUpResponse with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TenantListResponse) Validate() error {
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AdminWarmUpResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TenantListResponse with the rules
+// ValidateAll checks the field values on AdminWarmUpResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TenantListResponseMultiError, or nil if none found.
-func (m *TenantListResponse) ValidateAll() error {
+// AdminWarmUpResponseMultiError, or nil if none found.
+func (m *AdminWarmUpResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TenantListResponse) validate(all bool) error {
+func (m *AdminWarmUpResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetTenants() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, TenantListResponseValidationError{
-						field:  fmt.Sprintf("Tenants[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, TenantListResponseValidationError{
-						field:  fmt.Sprintf("Tenants[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return TenantListResponseValidationError{
-					field:  fmt.Sprintf("Tenants[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Warmed
 
-	}
+	// no validation rules for Skipped
 
-	// no validation rules for ContinuousToken
+	// no validation rules for Failed
 
 	if len(errors) > 0 {
-		return TenantListResponseMultiError(errors)
+		return AdminWarmUpResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TenantListResponseMultiError is an error wrapping multiple validation errors
-// returned by TenantListResponse.ValidateAll() if the designated constraints
-// aren't met.
-type TenantListResponseMultiError []error
+// AdminWarmUpResponseMultiError is an error wrapping multiple validation
+// errors returned by AdminWarmUpResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWarmUpResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TenantListResponseMultiError) Error() string {
+func (m AdminWarmUpResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4592,11 +23393,11 @@ func (m TenantListResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TenantListResponseMultiError) AllErrors() []error { return m }
+func (m AdminWarmUpResponseMultiError) AllErrors() []error { return m }
 
-// TenantListResponseValidationError is the validation error returned by
-// TenantListResponse.Validate if the designated constraints aren't met.
-type TenantListResponseValidationError struct {
+// AdminWarmUpResponseValidationError is the validation error returned by
+// AdminWarmUpResponse.Validate if the designated constraints aren't met.
+type AdminWarmUpResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4604,24 +23405,24 @@ type TenantListResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TenantListResponseValidationError) Field() string { return e.field }
+func (e AdminWarmUpResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TenantListResponseValidationError) Reason() string { return e.reason }
+func (e AdminWarmUpResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TenantListResponseValidationError) Cause() error { return e.cause }
+func (e AdminWarmUpResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TenantListResponseValidationError) Key() bool { return e.key }
+func (e AdminWarmUpResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TenantListResponseValidationError) ErrorName() string {
-	return "TenantListResponseValidationError"
+func (e AdminWarmUpResponseValidationError) ErrorName() string {
+	return "AdminWarmUpResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TenantListResponseValidationError) Error() string {
+func (e AdminWarmUpResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4633,14 +23434,14 @@ func (e TenantListResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTenantListResponse.%s: %s%s",
+		"invalid %sAdminWarmUpResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TenantListResponseValidationError{}
+var _ error = AdminWarmUpResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4648,7 +23449,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TenantListResponseValidationError{}
+} = AdminWarmUpResponseValidationError{}
 
 // Validate checks the field values on WelcomeResponse with the rules defined
 // in the proto definition for this message. If any rules are violated, the
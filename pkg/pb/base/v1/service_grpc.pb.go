@@ -28,6 +28,10 @@ type PermissionClient interface {
 	LookupSchema(ctx context.Context, in *PermissionLookupSchemaRequest, opts ...grpc.CallOption) (*PermissionLookupSchemaResponse, error)
 	LookupEntity(ctx context.Context, in *PermissionLookupEntityRequest, opts ...grpc.CallOption) (*PermissionLookupEntityResponse, error)
 	LookupEntityStream(ctx context.Context, in *PermissionLookupEntityRequest, opts ...grpc.CallOption) (Permission_LookupEntityStreamClient, error)
+	LookupSubject(ctx context.Context, in *PermissionLookupSubjectRequest, opts ...grpc.CallOption) (*PermissionLookupSubjectResponse, error)
+	BulkCheck(ctx context.Context, in *PermissionBulkCheckRequest, opts ...grpc.CallOption) (*PermissionBulkCheckResponse, error)
+	// the results are sent as soon as their checks complete and carry the correlation id of their request
+	BulkCheckStream(ctx context.Context, opts ...grpc.CallOption) (Permission_BulkCheckStreamClient, error)
 }
 
 type permissionClient struct {
@@ -106,6 +110,55 @@ func (x *permissionLookupEntityStreamClient) Recv() (*PermissionLookupEntityStre
 	return m, nil
 }
 
+func (c *permissionClient) LookupSubject(ctx context.Context, in *PermissionLookupSubjectRequest, opts ...grpc.CallOption) (*PermissionLookupSubjectResponse, error) {
+	out := new(PermissionLookupSubjectResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Permission/LookupSubject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *permissionClient) BulkCheck(ctx context.Context, in *PermissionBulkCheckRequest, opts ...grpc.CallOption) (*PermissionBulkCheckResponse, error) {
+	out := new(PermissionBulkCheckResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Permission/BulkCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *permissionClient) BulkCheckStream(ctx context.Context, opts ...grpc.CallOption) (Permission_BulkCheckStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Permission_ServiceDesc.Streams[1], "/base.v1.Permission/BulkCheckStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &permissionBulkCheckStreamClient{stream}
+	return x, nil
+}
+
+type Permission_BulkCheckStreamClient interface {
+	Send(*PermissionBulkCheckStreamRequest) error
+	Recv() (*PermissionBulkCheckStreamResponse, error)
+	grpc.ClientStream
+}
+
+type permissionBulkCheckStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *permissionBulkCheckStreamClient) Send(m *PermissionBulkCheckStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *permissionBulkCheckStreamClient) Recv() (*PermissionBulkCheckStreamResponse, error) {
+	m := new(PermissionBulkCheckStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // PermissionServer is the server API for Permission service.
 // All implementations must embed UnimplementedPermissionServer
 // for forward compatibility
@@ -115,6 +168,10 @@ type PermissionServer interface {
 	LookupSchema(context.Context, *PermissionLookupSchemaRequest) (*PermissionLookupSchemaResponse, error)
 	LookupEntity(context.Context, *PermissionLookupEntityRequest) (*PermissionLookupEntityResponse, error)
 	LookupEntityStream(*PermissionLookupEntityRequest, Permission_LookupEntityStreamServer) error
+	LookupSubject(context.Context, *PermissionLookupSubjectRequest) (*PermissionLookupSubjectResponse, error)
+	BulkCheck(context.Context, *PermissionBulkCheckRequest) (*PermissionBulkCheckResponse, error)
+	// the results are sent as soon as their checks complete and carry the correlation id of their request
+	BulkCheckStream(Permission_BulkCheckStreamServer) error
 	mustEmbedUnimplementedPermissionServer()
 }
 
@@ -137,6 +194,15 @@ func (UnimplementedPermissionServer) LookupEntity(context.Context, *PermissionLo
 func (UnimplementedPermissionServer) LookupEntityStream(*PermissionLookupEntityRequest, Permission_LookupEntityStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method LookupEntityStream not implemented")
 }
+func (UnimplementedPermissionServer) LookupSubject(context.Context, *PermissionLookupSubjectRequest) (*PermissionLookupSubjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupSubject not implemented")
+}
+func (UnimplementedPermissionServer) BulkCheck(context.Context, *PermissionBulkCheckRequest) (*PermissionBulkCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkCheck not implemented")
+}
+func (UnimplementedPermissionServer) BulkCheckStream(Permission_BulkCheckStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method BulkCheckStream not implemented")
+}
 func (UnimplementedPermissionServer) mustEmbedUnimplementedPermissionServer() {}
 
 // UnsafePermissionServer may be embedded to opt out of forward compatibility for this service.
@@ -243,6 +309,68 @@ func (x *permissionLookupEntityStreamServer) Send(m *PermissionLookupEntityStrea
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Permission_LookupSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PermissionLookupSubjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionServer).LookupSubject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Permission/LookupSubject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionServer).LookupSubject(ctx, req.(*PermissionLookupSubjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Permission_BulkCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PermissionBulkCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionServer).BulkCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Permission/BulkCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionServer).BulkCheck(ctx, req.(*PermissionBulkCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Permission_BulkCheckStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PermissionServer).BulkCheckStream(&permissionBulkCheckStreamServer{stream})
+}
+
+type Permission_BulkCheckStreamServer interface {
+	Send(*PermissionBulkCheckStreamResponse) error
+	Recv() (*PermissionBulkCheckStreamRequest, error)
+	grpc.ServerStream
+}
+
+type permissionBulkCheckStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *permissionBulkCheckStreamServer) Send(m *PermissionBulkCheckStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *permissionBulkCheckStreamServer) Recv() (*PermissionBulkCheckStreamRequest, error) {
+	m := new(PermissionBulkCheckStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Permission_ServiceDesc is the grpc.ServiceDesc for Permission service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -266,6 +394,14 @@ var Permission_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LookupEntity",
 			Handler:    _Permission_LookupEntity_Handler,
 		},
+		{
+			MethodName: "LookupSubject",
+			Handler:    _Permission_LookupSubject_Handler,
+		},
+		{
+			MethodName: "BulkCheck",
+			Handler:    _Permission_BulkCheck_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -273,6 +409,12 @@ var Permission_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Permission_LookupEntityStream_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "BulkCheckStream",
+			Handler:       _Permission_BulkCheckStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "base/v1/service.proto",
 }
@@ -283,6 +425,13 @@ var Permission_ServiceDesc = grpc.ServiceDesc{
 type SchemaClient interface {
 	Write(ctx context.Context, in *SchemaWriteRequest, opts ...grpc.CallOption) (*SchemaWriteResponse, error)
 	Read(ctx context.Context, in *SchemaReadRequest, opts ...grpc.CallOption) (*SchemaReadResponse, error)
+	ListVersions(ctx context.Context, in *SchemaListVersionsRequest, opts ...grpc.CallOption) (*SchemaListVersionsResponse, error)
+	Rollback(ctx context.Context, in *SchemaRollbackRequest, opts ...grpc.CallOption) (*SchemaRollbackResponse, error)
+	WriteVersion(ctx context.Context, in *SchemaWriteVersionRequest, opts ...grpc.CallOption) (*SchemaWriteVersionResponse, error)
+	Diff(ctx context.Context, in *SchemaDiffRequest, opts ...grpc.CallOption) (*SchemaDiffResponse, error)
+	FindReferences(ctx context.Context, in *SchemaFindReferencesRequest, opts ...grpc.CallOption) (*SchemaFindReferencesResponse, error)
+	Complete(ctx context.Context, in *SchemaCompleteRequest, opts ...grpc.CallOption) (*SchemaCompleteResponse, error)
+	Lint(ctx context.Context, in *SchemaLintRequest, opts ...grpc.CallOption) (*SchemaLintResponse, error)
 }
 
 type schemaClient struct {
@@ -311,12 +460,82 @@ func (c *schemaClient) Read(ctx context.Context, in *SchemaReadRequest, opts ...
 	return out, nil
 }
 
+func (c *schemaClient) ListVersions(ctx context.Context, in *SchemaListVersionsRequest, opts ...grpc.CallOption) (*SchemaListVersionsResponse, error) {
+	out := new(SchemaListVersionsResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Schema/ListVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaClient) Rollback(ctx context.Context, in *SchemaRollbackRequest, opts ...grpc.CallOption) (*SchemaRollbackResponse, error) {
+	out := new(SchemaRollbackResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Schema/Rollback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaClient) WriteVersion(ctx context.Context, in *SchemaWriteVersionRequest, opts ...grpc.CallOption) (*SchemaWriteVersionResponse, error) {
+	out := new(SchemaWriteVersionResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Schema/WriteVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaClient) Diff(ctx context.Context, in *SchemaDiffRequest, opts ...grpc.CallOption) (*SchemaDiffResponse, error) {
+	out := new(SchemaDiffResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Schema/Diff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaClient) FindReferences(ctx context.Context, in *SchemaFindReferencesRequest, opts ...grpc.CallOption) (*SchemaFindReferencesResponse, error) {
+	out := new(SchemaFindReferencesResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Schema/FindReferences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaClient) Complete(ctx context.Context, in *SchemaCompleteRequest, opts ...grpc.CallOption) (*SchemaCompleteResponse, error) {
+	out := new(SchemaCompleteResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Schema/Complete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaClient) Lint(ctx context.Context, in *SchemaLintRequest, opts ...grpc.CallOption) (*SchemaLintResponse, error) {
+	out := new(SchemaLintResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Schema/Lint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SchemaServer is the server API for Schema service.
 // All implementations must embed UnimplementedSchemaServer
 // for forward compatibility
 type SchemaServer interface {
 	Write(context.Context, *SchemaWriteRequest) (*SchemaWriteResponse, error)
 	Read(context.Context, *SchemaReadRequest) (*SchemaReadResponse, error)
+	ListVersions(context.Context, *SchemaListVersionsRequest) (*SchemaListVersionsResponse, error)
+	Rollback(context.Context, *SchemaRollbackRequest) (*SchemaRollbackResponse, error)
+	WriteVersion(context.Context, *SchemaWriteVersionRequest) (*SchemaWriteVersionResponse, error)
+	Diff(context.Context, *SchemaDiffRequest) (*SchemaDiffResponse, error)
+	FindReferences(context.Context, *SchemaFindReferencesRequest) (*SchemaFindReferencesResponse, error)
+	Complete(context.Context, *SchemaCompleteRequest) (*SchemaCompleteResponse, error)
+	Lint(context.Context, *SchemaLintRequest) (*SchemaLintResponse, error)
 	mustEmbedUnimplementedSchemaServer()
 }
 
@@ -330,6 +549,27 @@ func (UnimplementedSchemaServer) Write(context.Context, *SchemaWriteRequest) (*S
 func (UnimplementedSchemaServer) Read(context.Context, *SchemaReadRequest) (*SchemaReadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
 }
+func (UnimplementedSchemaServer) ListVersions(context.Context, *SchemaListVersionsRequest) (*SchemaListVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVersions not implemented")
+}
+func (UnimplementedSchemaServer) Rollback(context.Context, *SchemaRollbackRequest) (*SchemaRollbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rollback not implemented")
+}
+func (UnimplementedSchemaServer) WriteVersion(context.Context, *SchemaWriteVersionRequest) (*SchemaWriteVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteVersion not implemented")
+}
+func (UnimplementedSchemaServer) Diff(context.Context, *SchemaDiffRequest) (*SchemaDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Diff not implemented")
+}
+func (UnimplementedSchemaServer) FindReferences(context.Context, *SchemaFindReferencesRequest) (*SchemaFindReferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindReferences not implemented")
+}
+func (UnimplementedSchemaServer) Complete(context.Context, *SchemaCompleteRequest) (*SchemaCompleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Complete not implemented")
+}
+func (UnimplementedSchemaServer) Lint(context.Context, *SchemaLintRequest) (*SchemaLintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lint not implemented")
+}
 func (UnimplementedSchemaServer) mustEmbedUnimplementedSchemaServer() {}
 
 // UnsafeSchemaServer may be embedded to opt out of forward compatibility for this service.
@@ -379,6 +619,132 @@ func _Schema_Read_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Schema_ListVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchemaListVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServer).ListVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Schema/ListVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServer).ListVersions(ctx, req.(*SchemaListVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Schema_Rollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchemaRollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServer).Rollback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Schema/Rollback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServer).Rollback(ctx, req.(*SchemaRollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Schema_WriteVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchemaWriteVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServer).WriteVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Schema/WriteVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServer).WriteVersion(ctx, req.(*SchemaWriteVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Schema_Diff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchemaDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServer).Diff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Schema/Diff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServer).Diff(ctx, req.(*SchemaDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Schema_FindReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchemaFindReferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServer).FindReferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Schema/FindReferences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServer).FindReferences(ctx, req.(*SchemaFindReferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Schema_Complete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchemaCompleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServer).Complete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Schema/Complete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServer).Complete(ctx, req.(*SchemaCompleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Schema_Lint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchemaLintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServer).Lint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Schema/Lint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServer).Lint(ctx, req.(*SchemaLintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Schema_ServiceDesc is the grpc.ServiceDesc for Schema service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -394,6 +760,34 @@ var Schema_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Read",
 			Handler:    _Schema_Read_Handler,
 		},
+		{
+			MethodName: "ListVersions",
+			Handler:    _Schema_ListVersions_Handler,
+		},
+		{
+			MethodName: "Rollback",
+			Handler:    _Schema_Rollback_Handler,
+		},
+		{
+			MethodName: "WriteVersion",
+			Handler:    _Schema_WriteVersion_Handler,
+		},
+		{
+			MethodName: "Diff",
+			Handler:    _Schema_Diff_Handler,
+		},
+		{
+			MethodName: "FindReferences",
+			Handler:    _Schema_FindReferences_Handler,
+		},
+		{
+			MethodName: "Complete",
+			Handler:    _Schema_Complete_Handler,
+		},
+		{
+			MethodName: "Lint",
+			Handler:    _Schema_Lint_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "base/v1/service.proto",
@@ -405,7 +799,19 @@ var Schema_ServiceDesc = grpc.ServiceDesc{
 type RelationshipClient interface {
 	Write(ctx context.Context, in *RelationshipWriteRequest, opts ...grpc.CallOption) (*RelationshipWriteResponse, error)
 	Read(ctx context.Context, in *RelationshipReadRequest, opts ...grpc.CallOption) (*RelationshipReadResponse, error)
+	ReadDeleted(ctx context.Context, in *RelationshipReadDeletedRequest, opts ...grpc.CallOption) (*RelationshipReadDeletedResponse, error)
+	ReadChanges(ctx context.Context, in *RelationshipReadChangesRequest, opts ...grpc.CallOption) (*RelationshipReadChangesResponse, error)
 	Delete(ctx context.Context, in *RelationshipDeleteRequest, opts ...grpc.CallOption) (*RelationshipDeleteResponse, error)
+	BatchWrite(ctx context.Context, in *RelationshipBatchWriteRequest, opts ...grpc.CallOption) (*RelationshipBatchWriteResponse, error)
+	Validate(ctx context.Context, in *RelationshipValidateRequest, opts ...grpc.CallOption) (*RelationshipValidateResponse, error)
+	// Export - The tuples are streamed in pages, the gateway serves them as ndjson or csv files on
+	// GET /v1/tenants/{tenant_id}/relationships/export
+	Export(ctx context.Context, in *RelationshipExportRequest, opts ...grpc.CallOption) (Relationship_ExportClient, error)
+	// Import - The gateway takes ndjson or csv files on POST /v1/tenants/{tenant_id}/relationships/import
+	Import(ctx context.Context, opts ...grpc.CallOption) (Relationship_ImportClient, error)
+	PlanMigration(ctx context.Context, in *RelationshipPlanMigrationRequest, opts ...grpc.CallOption) (*RelationshipPlanMigrationResponse, error)
+	// ApplyMigration - The progress is streamed after every batch, the last message has the snap token
+	ApplyMigration(ctx context.Context, in *RelationshipApplyMigrationRequest, opts ...grpc.CallOption) (Relationship_ApplyMigrationClient, error)
 }
 
 type relationshipClient struct {
@@ -434,6 +840,24 @@ func (c *relationshipClient) Read(ctx context.Context, in *RelationshipReadReque
 	return out, nil
 }
 
+func (c *relationshipClient) ReadDeleted(ctx context.Context, in *RelationshipReadDeletedRequest, opts ...grpc.CallOption) (*RelationshipReadDeletedResponse, error) {
+	out := new(RelationshipReadDeletedResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Relationship/ReadDeleted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationshipClient) ReadChanges(ctx context.Context, in *RelationshipReadChangesRequest, opts ...grpc.CallOption) (*RelationshipReadChangesResponse, error) {
+	out := new(RelationshipReadChangesResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Relationship/ReadChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *relationshipClient) Delete(ctx context.Context, in *RelationshipDeleteRequest, opts ...grpc.CallOption) (*RelationshipDeleteResponse, error) {
 	out := new(RelationshipDeleteResponse)
 	err := c.cc.Invoke(ctx, "/base.v1.Relationship/Delete", in, out, opts...)
@@ -443,13 +867,150 @@ func (c *relationshipClient) Delete(ctx context.Context, in *RelationshipDeleteR
 	return out, nil
 }
 
-// RelationshipServer is the server API for Relationship service.
-// All implementations must embed UnimplementedRelationshipServer
-// for forward compatibility
-type RelationshipServer interface {
-	Write(context.Context, *RelationshipWriteRequest) (*RelationshipWriteResponse, error)
-	Read(context.Context, *RelationshipReadRequest) (*RelationshipReadResponse, error)
+func (c *relationshipClient) BatchWrite(ctx context.Context, in *RelationshipBatchWriteRequest, opts ...grpc.CallOption) (*RelationshipBatchWriteResponse, error) {
+	out := new(RelationshipBatchWriteResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Relationship/BatchWrite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationshipClient) Validate(ctx context.Context, in *RelationshipValidateRequest, opts ...grpc.CallOption) (*RelationshipValidateResponse, error) {
+	out := new(RelationshipValidateResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Relationship/Validate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationshipClient) Export(ctx context.Context, in *RelationshipExportRequest, opts ...grpc.CallOption) (Relationship_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Relationship_ServiceDesc.Streams[0], "/base.v1.Relationship/Export", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &relationshipExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Relationship_ExportClient interface {
+	Recv() (*RelationshipExportResponse, error)
+	grpc.ClientStream
+}
+
+type relationshipExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *relationshipExportClient) Recv() (*RelationshipExportResponse, error) {
+	m := new(RelationshipExportResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *relationshipClient) Import(ctx context.Context, opts ...grpc.CallOption) (Relationship_ImportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Relationship_ServiceDesc.Streams[1], "/base.v1.Relationship/Import", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &relationshipImportClient{stream}
+	return x, nil
+}
+
+type Relationship_ImportClient interface {
+	Send(*RelationshipImportRequest) error
+	CloseAndRecv() (*RelationshipImportResponse, error)
+	grpc.ClientStream
+}
+
+type relationshipImportClient struct {
+	grpc.ClientStream
+}
+
+func (x *relationshipImportClient) Send(m *RelationshipImportRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *relationshipImportClient) CloseAndRecv() (*RelationshipImportResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(RelationshipImportResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *relationshipClient) PlanMigration(ctx context.Context, in *RelationshipPlanMigrationRequest, opts ...grpc.CallOption) (*RelationshipPlanMigrationResponse, error) {
+	out := new(RelationshipPlanMigrationResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Relationship/PlanMigration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationshipClient) ApplyMigration(ctx context.Context, in *RelationshipApplyMigrationRequest, opts ...grpc.CallOption) (Relationship_ApplyMigrationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Relationship_ServiceDesc.Streams[2], "/base.v1.Relationship/ApplyMigration", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &relationshipApplyMigrationClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Relationship_ApplyMigrationClient interface {
+	Recv() (*RelationshipApplyMigrationResponse, error)
+	grpc.ClientStream
+}
+
+type relationshipApplyMigrationClient struct {
+	grpc.ClientStream
+}
+
+func (x *relationshipApplyMigrationClient) Recv() (*RelationshipApplyMigrationResponse, error) {
+	m := new(RelationshipApplyMigrationResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// RelationshipServer is the server API for Relationship service.
+// All implementations must embed UnimplementedRelationshipServer
+// for forward compatibility
+type RelationshipServer interface {
+	Write(context.Context, *RelationshipWriteRequest) (*RelationshipWriteResponse, error)
+	Read(context.Context, *RelationshipReadRequest) (*RelationshipReadResponse, error)
+	ReadDeleted(context.Context, *RelationshipReadDeletedRequest) (*RelationshipReadDeletedResponse, error)
+	ReadChanges(context.Context, *RelationshipReadChangesRequest) (*RelationshipReadChangesResponse, error)
 	Delete(context.Context, *RelationshipDeleteRequest) (*RelationshipDeleteResponse, error)
+	BatchWrite(context.Context, *RelationshipBatchWriteRequest) (*RelationshipBatchWriteResponse, error)
+	Validate(context.Context, *RelationshipValidateRequest) (*RelationshipValidateResponse, error)
+	// Export - The tuples are streamed in pages, the gateway serves them as ndjson or csv files on
+	// GET /v1/tenants/{tenant_id}/relationships/export
+	Export(*RelationshipExportRequest, Relationship_ExportServer) error
+	// Import - The gateway takes ndjson or csv files on POST /v1/tenants/{tenant_id}/relationships/import
+	Import(Relationship_ImportServer) error
+	PlanMigration(context.Context, *RelationshipPlanMigrationRequest) (*RelationshipPlanMigrationResponse, error)
+	// ApplyMigration - The progress is streamed after every batch, the last message has the snap token
+	ApplyMigration(*RelationshipApplyMigrationRequest, Relationship_ApplyMigrationServer) error
 	mustEmbedUnimplementedRelationshipServer()
 }
 
@@ -463,9 +1024,33 @@ func (UnimplementedRelationshipServer) Write(context.Context, *RelationshipWrite
 func (UnimplementedRelationshipServer) Read(context.Context, *RelationshipReadRequest) (*RelationshipReadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
 }
+func (UnimplementedRelationshipServer) ReadDeleted(context.Context, *RelationshipReadDeletedRequest) (*RelationshipReadDeletedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadDeleted not implemented")
+}
+func (UnimplementedRelationshipServer) ReadChanges(context.Context, *RelationshipReadChangesRequest) (*RelationshipReadChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadChanges not implemented")
+}
 func (UnimplementedRelationshipServer) Delete(context.Context, *RelationshipDeleteRequest) (*RelationshipDeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedRelationshipServer) BatchWrite(context.Context, *RelationshipBatchWriteRequest) (*RelationshipBatchWriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchWrite not implemented")
+}
+func (UnimplementedRelationshipServer) Validate(context.Context, *RelationshipValidateRequest) (*RelationshipValidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validate not implemented")
+}
+func (UnimplementedRelationshipServer) Export(*RelationshipExportRequest, Relationship_ExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedRelationshipServer) Import(Relationship_ImportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
+func (UnimplementedRelationshipServer) PlanMigration(context.Context, *RelationshipPlanMigrationRequest) (*RelationshipPlanMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanMigration not implemented")
+}
+func (UnimplementedRelationshipServer) ApplyMigration(*RelationshipApplyMigrationRequest, Relationship_ApplyMigrationServer) error {
+	return status.Errorf(codes.Unimplemented, "method ApplyMigration not implemented")
+}
 func (UnimplementedRelationshipServer) mustEmbedUnimplementedRelationshipServer() {}
 
 // UnsafeRelationshipServer may be embedded to opt out of forward compatibility for this service.
@@ -515,6 +1100,42 @@ func _Relationship_Read_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Relationship_ReadDeleted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelationshipReadDeletedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationshipServer).ReadDeleted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Relationship/ReadDeleted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationshipServer).ReadDeleted(ctx, req.(*RelationshipReadDeletedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relationship_ReadChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelationshipReadChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationshipServer).ReadChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Relationship/ReadChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationshipServer).ReadChanges(ctx, req.(*RelationshipReadChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Relationship_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RelationshipDeleteRequest)
 	if err := dec(in); err != nil {
@@ -533,6 +1154,128 @@ func _Relationship_Delete_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Relationship_BatchWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelationshipBatchWriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationshipServer).BatchWrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Relationship/BatchWrite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationshipServer).BatchWrite(ctx, req.(*RelationshipBatchWriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relationship_Validate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelationshipValidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationshipServer).Validate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Relationship/Validate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationshipServer).Validate(ctx, req.(*RelationshipValidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relationship_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RelationshipExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RelationshipServer).Export(m, &relationshipExportServer{stream})
+}
+
+type Relationship_ExportServer interface {
+	Send(*RelationshipExportResponse) error
+	grpc.ServerStream
+}
+
+type relationshipExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *relationshipExportServer) Send(m *RelationshipExportResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Relationship_Import_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RelationshipServer).Import(&relationshipImportServer{stream})
+}
+
+type Relationship_ImportServer interface {
+	SendAndClose(*RelationshipImportResponse) error
+	Recv() (*RelationshipImportRequest, error)
+	grpc.ServerStream
+}
+
+type relationshipImportServer struct {
+	grpc.ServerStream
+}
+
+func (x *relationshipImportServer) SendAndClose(m *RelationshipImportResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *relationshipImportServer) Recv() (*RelationshipImportRequest, error) {
+	m := new(RelationshipImportRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Relationship_PlanMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelationshipPlanMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationshipServer).PlanMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Relationship/PlanMigration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationshipServer).PlanMigration(ctx, req.(*RelationshipPlanMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relationship_ApplyMigration_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RelationshipApplyMigrationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RelationshipServer).ApplyMigration(m, &relationshipApplyMigrationServer{stream})
+}
+
+type Relationship_ApplyMigrationServer interface {
+	Send(*RelationshipApplyMigrationResponse) error
+	grpc.ServerStream
+}
+
+type relationshipApplyMigrationServer struct {
+	grpc.ServerStream
+}
+
+func (x *relationshipApplyMigrationServer) Send(m *RelationshipApplyMigrationResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Relationship_ServiceDesc is the grpc.ServiceDesc for Relationship service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -548,12 +1291,48 @@ var Relationship_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Read",
 			Handler:    _Relationship_Read_Handler,
 		},
+		{
+			MethodName: "ReadDeleted",
+			Handler:    _Relationship_ReadDeleted_Handler,
+		},
+		{
+			MethodName: "ReadChanges",
+			Handler:    _Relationship_ReadChanges_Handler,
+		},
 		{
 			MethodName: "Delete",
 			Handler:    _Relationship_Delete_Handler,
 		},
+		{
+			MethodName: "BatchWrite",
+			Handler:    _Relationship_BatchWrite_Handler,
+		},
+		{
+			MethodName: "Validate",
+			Handler:    _Relationship_Validate_Handler,
+		},
+		{
+			MethodName: "PlanMigration",
+			Handler:    _Relationship_PlanMigration_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Export",
+			Handler:       _Relationship_Export_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Import",
+			Handler:       _Relationship_Import_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ApplyMigration",
+			Handler:       _Relationship_ApplyMigration_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "base/v1/service.proto",
 }
 
@@ -564,6 +1343,8 @@ type TenancyClient interface {
 	Create(ctx context.Context, in *TenantCreateRequest, opts ...grpc.CallOption) (*TenantCreateResponse, error)
 	Delete(ctx context.Context, in *TenantDeleteRequest, opts ...grpc.CallOption) (*TenantDeleteResponse, error)
 	List(ctx context.Context, in *TenantListRequest, opts ...grpc.CallOption) (*TenantListResponse, error)
+	Read(ctx context.Context, in *TenantReadRequest, opts ...grpc.CallOption) (*TenantReadResponse, error)
+	Update(ctx context.Context, in *TenantUpdateRequest, opts ...grpc.CallOption) (*TenantUpdateResponse, error)
 }
 
 type tenancyClient struct {
@@ -601,6 +1382,24 @@ func (c *tenancyClient) List(ctx context.Context, in *TenantListRequest, opts ..
 	return out, nil
 }
 
+func (c *tenancyClient) Read(ctx context.Context, in *TenantReadRequest, opts ...grpc.CallOption) (*TenantReadResponse, error) {
+	out := new(TenantReadResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Tenancy/Read", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenancyClient) Update(ctx context.Context, in *TenantUpdateRequest, opts ...grpc.CallOption) (*TenantUpdateResponse, error) {
+	out := new(TenantUpdateResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Tenancy/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TenancyServer is the server API for Tenancy service.
 // All implementations must embed UnimplementedTenancyServer
 // for forward compatibility
@@ -608,6 +1407,8 @@ type TenancyServer interface {
 	Create(context.Context, *TenantCreateRequest) (*TenantCreateResponse, error)
 	Delete(context.Context, *TenantDeleteRequest) (*TenantDeleteResponse, error)
 	List(context.Context, *TenantListRequest) (*TenantListResponse, error)
+	Read(context.Context, *TenantReadRequest) (*TenantReadResponse, error)
+	Update(context.Context, *TenantUpdateRequest) (*TenantUpdateResponse, error)
 	mustEmbedUnimplementedTenancyServer()
 }
 
@@ -624,6 +1425,12 @@ func (UnimplementedTenancyServer) Delete(context.Context, *TenantDeleteRequest)
 func (UnimplementedTenancyServer) List(context.Context, *TenantListRequest) (*TenantListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
+func (UnimplementedTenancyServer) Read(context.Context, *TenantReadRequest) (*TenantReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
+}
+func (UnimplementedTenancyServer) Update(context.Context, *TenantUpdateRequest) (*TenantUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
 func (UnimplementedTenancyServer) mustEmbedUnimplementedTenancyServer() {}
 
 // UnsafeTenancyServer may be embedded to opt out of forward compatibility for this service.
@@ -691,14 +1498,50 @@ func _Tenancy_List_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
-// Tenancy_ServiceDesc is the grpc.ServiceDesc for Tenancy service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Tenancy_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "base.v1.Tenancy",
-	HandlerType: (*TenancyServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
+func _Tenancy_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenancyServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Tenancy/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenancyServer).Read(ctx, req.(*TenantReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tenancy_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenancyServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Tenancy/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenancyServer).Update(ctx, req.(*TenantUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Tenancy_ServiceDesc is the grpc.ServiceDesc for Tenancy service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Tenancy_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "base.v1.Tenancy",
+	HandlerType: (*TenancyServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
 			MethodName: "Create",
 			Handler:    _Tenancy_Create_Handler,
 		},
@@ -710,6 +1553,1327 @@ var Tenancy_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _Tenancy_List_Handler,
 		},
+		{
+			MethodName: "Read",
+			Handler:    _Tenancy_Read_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _Tenancy_Update_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "base/v1/service.proto",
+}
+
+// AttributeClient is the client API for Attribute service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AttributeClient interface {
+	Write(ctx context.Context, in *AttributeWriteRequest, opts ...grpc.CallOption) (*AttributeWriteResponse, error)
+	Read(ctx context.Context, in *AttributeReadRequest, opts ...grpc.CallOption) (*AttributeReadResponse, error)
+	Delete(ctx context.Context, in *AttributeDeleteRequest, opts ...grpc.CallOption) (*AttributeDeleteResponse, error)
+}
+
+type attributeClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAttributeClient(cc grpc.ClientConnInterface) AttributeClient {
+	return &attributeClient{cc}
+}
+
+func (c *attributeClient) Write(ctx context.Context, in *AttributeWriteRequest, opts ...grpc.CallOption) (*AttributeWriteResponse, error) {
+	out := new(AttributeWriteResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Attribute/Write", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attributeClient) Read(ctx context.Context, in *AttributeReadRequest, opts ...grpc.CallOption) (*AttributeReadResponse, error) {
+	out := new(AttributeReadResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Attribute/Read", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attributeClient) Delete(ctx context.Context, in *AttributeDeleteRequest, opts ...grpc.CallOption) (*AttributeDeleteResponse, error) {
+	out := new(AttributeDeleteResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Attribute/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AttributeServer is the server API for Attribute service.
+// All implementations must embed UnimplementedAttributeServer
+// for forward compatibility
+type AttributeServer interface {
+	Write(context.Context, *AttributeWriteRequest) (*AttributeWriteResponse, error)
+	Read(context.Context, *AttributeReadRequest) (*AttributeReadResponse, error)
+	Delete(context.Context, *AttributeDeleteRequest) (*AttributeDeleteResponse, error)
+	mustEmbedUnimplementedAttributeServer()
+}
+
+// UnimplementedAttributeServer must be embedded to have forward compatible implementations.
+type UnimplementedAttributeServer struct {
+}
+
+func (UnimplementedAttributeServer) Write(context.Context, *AttributeWriteRequest) (*AttributeWriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Write not implemented")
+}
+func (UnimplementedAttributeServer) Read(context.Context, *AttributeReadRequest) (*AttributeReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
+}
+func (UnimplementedAttributeServer) Delete(context.Context, *AttributeDeleteRequest) (*AttributeDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedAttributeServer) mustEmbedUnimplementedAttributeServer() {}
+
+// UnsafeAttributeServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AttributeServer will
+// result in compilation errors.
+type UnsafeAttributeServer interface {
+	mustEmbedUnimplementedAttributeServer()
+}
+
+func RegisterAttributeServer(s grpc.ServiceRegistrar, srv AttributeServer) {
+	s.RegisterService(&Attribute_ServiceDesc, srv)
+}
+
+func _Attribute_Write_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttributeWriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttributeServer).Write(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Attribute/Write",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttributeServer).Write(ctx, req.(*AttributeWriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Attribute_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttributeReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttributeServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Attribute/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttributeServer).Read(ctx, req.(*AttributeReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Attribute_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttributeDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttributeServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Attribute/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttributeServer).Delete(ctx, req.(*AttributeDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Attribute_ServiceDesc is the grpc.ServiceDesc for Attribute service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Attribute_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "base.v1.Attribute",
+	HandlerType: (*AttributeServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Write",
+			Handler:    _Attribute_Write_Handler,
+		},
+		{
+			MethodName: "Read",
+			Handler:    _Attribute_Read_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Attribute_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "base/v1/service.proto",
+}
+
+// SettingsClient is the client API for Settings service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SettingsClient interface {
+	Read(ctx context.Context, in *SettingsReadRequest, opts ...grpc.CallOption) (*SettingsReadResponse, error)
+	Write(ctx context.Context, in *SettingsWriteRequest, opts ...grpc.CallOption) (*SettingsWriteResponse, error)
+}
+
+type settingsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSettingsClient(cc grpc.ClientConnInterface) SettingsClient {
+	return &settingsClient{cc}
+}
+
+func (c *settingsClient) Read(ctx context.Context, in *SettingsReadRequest, opts ...grpc.CallOption) (*SettingsReadResponse, error) {
+	out := new(SettingsReadResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Settings/Read", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settingsClient) Write(ctx context.Context, in *SettingsWriteRequest, opts ...grpc.CallOption) (*SettingsWriteResponse, error) {
+	out := new(SettingsWriteResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Settings/Write", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SettingsServer is the server API for Settings service.
+// All implementations must embed UnimplementedSettingsServer
+// for forward compatibility
+type SettingsServer interface {
+	Read(context.Context, *SettingsReadRequest) (*SettingsReadResponse, error)
+	Write(context.Context, *SettingsWriteRequest) (*SettingsWriteResponse, error)
+	mustEmbedUnimplementedSettingsServer()
+}
+
+// UnimplementedSettingsServer must be embedded to have forward compatible implementations.
+type UnimplementedSettingsServer struct {
+}
+
+func (UnimplementedSettingsServer) Read(context.Context, *SettingsReadRequest) (*SettingsReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
+}
+func (UnimplementedSettingsServer) Write(context.Context, *SettingsWriteRequest) (*SettingsWriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Write not implemented")
+}
+func (UnimplementedSettingsServer) mustEmbedUnimplementedSettingsServer() {}
+
+// UnsafeSettingsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SettingsServer will
+// result in compilation errors.
+type UnsafeSettingsServer interface {
+	mustEmbedUnimplementedSettingsServer()
+}
+
+func RegisterSettingsServer(s grpc.ServiceRegistrar, srv SettingsServer) {
+	s.RegisterService(&Settings_ServiceDesc, srv)
+}
+
+func _Settings_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettingsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingsServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Settings/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingsServer).Read(ctx, req.(*SettingsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Settings_Write_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettingsWriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingsServer).Write(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Settings/Write",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingsServer).Write(ctx, req.(*SettingsWriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Settings_ServiceDesc is the grpc.ServiceDesc for Settings service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Settings_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "base.v1.Settings",
+	HandlerType: (*SettingsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Read",
+			Handler:    _Settings_Read_Handler,
+		},
+		{
+			MethodName: "Write",
+			Handler:    _Settings_Write_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "base/v1/service.proto",
+}
+
+// IdentityClient is the client API for Identity service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type IdentityClient interface {
+	Map(ctx context.Context, in *IdentityMapRequest, opts ...grpc.CallOption) (*IdentityMapResponse, error)
+	Unmap(ctx context.Context, in *IdentityUnmapRequest, opts ...grpc.CallOption) (*IdentityUnmapResponse, error)
+}
+
+type identityClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewIdentityClient(cc grpc.ClientConnInterface) IdentityClient {
+	return &identityClient{cc}
+}
+
+func (c *identityClient) Map(ctx context.Context, in *IdentityMapRequest, opts ...grpc.CallOption) (*IdentityMapResponse, error) {
+	out := new(IdentityMapResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Identity/Map", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityClient) Unmap(ctx context.Context, in *IdentityUnmapRequest, opts ...grpc.CallOption) (*IdentityUnmapResponse, error) {
+	out := new(IdentityUnmapResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Identity/Unmap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IdentityServer is the server API for Identity service.
+// All implementations must embed UnimplementedIdentityServer
+// for forward compatibility
+type IdentityServer interface {
+	Map(context.Context, *IdentityMapRequest) (*IdentityMapResponse, error)
+	Unmap(context.Context, *IdentityUnmapRequest) (*IdentityUnmapResponse, error)
+	mustEmbedUnimplementedIdentityServer()
+}
+
+// UnimplementedIdentityServer must be embedded to have forward compatible implementations.
+type UnimplementedIdentityServer struct {
+}
+
+func (UnimplementedIdentityServer) Map(context.Context, *IdentityMapRequest) (*IdentityMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Map not implemented")
+}
+func (UnimplementedIdentityServer) Unmap(context.Context, *IdentityUnmapRequest) (*IdentityUnmapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unmap not implemented")
+}
+func (UnimplementedIdentityServer) mustEmbedUnimplementedIdentityServer() {}
+
+// UnsafeIdentityServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IdentityServer will
+// result in compilation errors.
+type UnsafeIdentityServer interface {
+	mustEmbedUnimplementedIdentityServer()
+}
+
+func RegisterIdentityServer(s grpc.ServiceRegistrar, srv IdentityServer) {
+	s.RegisterService(&Identity_ServiceDesc, srv)
+}
+
+func _Identity_Map_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdentityMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServer).Map(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Identity/Map",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServer).Map(ctx, req.(*IdentityMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Identity_Unmap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdentityUnmapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServer).Unmap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Identity/Unmap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServer).Unmap(ctx, req.(*IdentityUnmapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Identity_ServiceDesc is the grpc.ServiceDesc for Identity service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Identity_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "base.v1.Identity",
+	HandlerType: (*IdentityServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Map",
+			Handler:    _Identity_Map_Handler,
+		},
+		{
+			MethodName: "Unmap",
+			Handler:    _Identity_Unmap_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "base/v1/service.proto",
+}
+
+// WatchClient is the client API for Watch service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WatchClient interface {
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Watch_WatchClient, error)
+}
+
+type watchClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWatchClient(cc grpc.ClientConnInterface) WatchClient {
+	return &watchClient{cc}
+}
+
+func (c *watchClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Watch_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Watch_ServiceDesc.Streams[0], "/base.v1.Watch/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &watchWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Watch_WatchClient interface {
+	Recv() (*WatchResponse, error)
+	grpc.ClientStream
+}
+
+type watchWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *watchWatchClient) Recv() (*WatchResponse, error) {
+	m := new(WatchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// WatchServer is the server API for Watch service.
+// All implementations must embed UnimplementedWatchServer
+// for forward compatibility
+type WatchServer interface {
+	Watch(*WatchRequest, Watch_WatchServer) error
+	mustEmbedUnimplementedWatchServer()
+}
+
+// UnimplementedWatchServer must be embedded to have forward compatible implementations.
+type UnimplementedWatchServer struct {
+}
+
+func (UnimplementedWatchServer) Watch(*WatchRequest, Watch_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (UnimplementedWatchServer) mustEmbedUnimplementedWatchServer() {}
+
+// UnsafeWatchServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WatchServer will
+// result in compilation errors.
+type UnsafeWatchServer interface {
+	mustEmbedUnimplementedWatchServer()
+}
+
+func RegisterWatchServer(s grpc.ServiceRegistrar, srv WatchServer) {
+	s.RegisterService(&Watch_ServiceDesc, srv)
+}
+
+func _Watch_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WatchServer).Watch(m, &watchWatchServer{stream})
+}
+
+type Watch_WatchServer interface {
+	Send(*WatchResponse) error
+	grpc.ServerStream
+}
+
+type watchWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *watchWatchServer) Send(m *WatchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Watch_ServiceDesc is the grpc.ServiceDesc for Watch service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Watch_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "base.v1.Watch",
+	HandlerType: (*WatchServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _Watch_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "base/v1/service.proto",
+}
+
+// OpenFGAClient is the client API for OpenFGA service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OpenFGAClient interface {
+	Import(ctx context.Context, in *OpenFGAImportRequest, opts ...grpc.CallOption) (*OpenFGAImportResponse, error)
+}
+
+type openFGAClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOpenFGAClient(cc grpc.ClientConnInterface) OpenFGAClient {
+	return &openFGAClient{cc}
+}
+
+func (c *openFGAClient) Import(ctx context.Context, in *OpenFGAImportRequest, opts ...grpc.CallOption) (*OpenFGAImportResponse, error) {
+	out := new(OpenFGAImportResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.OpenFGA/Import", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OpenFGAServer is the server API for OpenFGA service.
+// All implementations must embed UnimplementedOpenFGAServer
+// for forward compatibility
+type OpenFGAServer interface {
+	Import(context.Context, *OpenFGAImportRequest) (*OpenFGAImportResponse, error)
+	mustEmbedUnimplementedOpenFGAServer()
+}
+
+// UnimplementedOpenFGAServer must be embedded to have forward compatible implementations.
+type UnimplementedOpenFGAServer struct {
+}
+
+func (UnimplementedOpenFGAServer) Import(context.Context, *OpenFGAImportRequest) (*OpenFGAImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
+func (UnimplementedOpenFGAServer) mustEmbedUnimplementedOpenFGAServer() {}
+
+// UnsafeOpenFGAServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OpenFGAServer will
+// result in compilation errors.
+type UnsafeOpenFGAServer interface {
+	mustEmbedUnimplementedOpenFGAServer()
+}
+
+func RegisterOpenFGAServer(s grpc.ServiceRegistrar, srv OpenFGAServer) {
+	s.RegisterService(&OpenFGA_ServiceDesc, srv)
+}
+
+func _OpenFGA_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenFGAImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenFGAServer).Import(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.OpenFGA/Import",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenFGAServer).Import(ctx, req.(*OpenFGAImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OpenFGA_ServiceDesc is the grpc.ServiceDesc for OpenFGA service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OpenFGA_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "base.v1.OpenFGA",
+	HandlerType: (*OpenFGAServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Import",
+			Handler:    _OpenFGA_Import_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "base/v1/service.proto",
+}
+
+// SCIMClient is the client API for SCIM service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SCIMClient interface {
+	CreateGroup(ctx context.Context, in *SCIMCreateGroupRequest, opts ...grpc.CallOption) (*SCIMGroup, error)
+	ReplaceGroup(ctx context.Context, in *SCIMReplaceGroupRequest, opts ...grpc.CallOption) (*SCIMGroup, error)
+	PatchGroup(ctx context.Context, in *SCIMPatchGroupRequest, opts ...grpc.CallOption) (*SCIMGroup, error)
+	DeleteGroup(ctx context.Context, in *SCIMDeleteGroupRequest, opts ...grpc.CallOption) (*SCIMDeleteGroupResponse, error)
+	ReadGroup(ctx context.Context, in *SCIMReadGroupRequest, opts ...grpc.CallOption) (*SCIMGroup, error)
+}
+
+type sCIMClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSCIMClient(cc grpc.ClientConnInterface) SCIMClient {
+	return &sCIMClient{cc}
+}
+
+func (c *sCIMClient) CreateGroup(ctx context.Context, in *SCIMCreateGroupRequest, opts ...grpc.CallOption) (*SCIMGroup, error) {
+	out := new(SCIMGroup)
+	err := c.cc.Invoke(ctx, "/base.v1.SCIM/CreateGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sCIMClient) ReplaceGroup(ctx context.Context, in *SCIMReplaceGroupRequest, opts ...grpc.CallOption) (*SCIMGroup, error) {
+	out := new(SCIMGroup)
+	err := c.cc.Invoke(ctx, "/base.v1.SCIM/ReplaceGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sCIMClient) PatchGroup(ctx context.Context, in *SCIMPatchGroupRequest, opts ...grpc.CallOption) (*SCIMGroup, error) {
+	out := new(SCIMGroup)
+	err := c.cc.Invoke(ctx, "/base.v1.SCIM/PatchGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sCIMClient) DeleteGroup(ctx context.Context, in *SCIMDeleteGroupRequest, opts ...grpc.CallOption) (*SCIMDeleteGroupResponse, error) {
+	out := new(SCIMDeleteGroupResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.SCIM/DeleteGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sCIMClient) ReadGroup(ctx context.Context, in *SCIMReadGroupRequest, opts ...grpc.CallOption) (*SCIMGroup, error) {
+	out := new(SCIMGroup)
+	err := c.cc.Invoke(ctx, "/base.v1.SCIM/ReadGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SCIMServer is the server API for SCIM service.
+// All implementations must embed UnimplementedSCIMServer
+// for forward compatibility
+type SCIMServer interface {
+	CreateGroup(context.Context, *SCIMCreateGroupRequest) (*SCIMGroup, error)
+	ReplaceGroup(context.Context, *SCIMReplaceGroupRequest) (*SCIMGroup, error)
+	PatchGroup(context.Context, *SCIMPatchGroupRequest) (*SCIMGroup, error)
+	DeleteGroup(context.Context, *SCIMDeleteGroupRequest) (*SCIMDeleteGroupResponse, error)
+	ReadGroup(context.Context, *SCIMReadGroupRequest) (*SCIMGroup, error)
+	mustEmbedUnimplementedSCIMServer()
+}
+
+// UnimplementedSCIMServer must be embedded to have forward compatible implementations.
+type UnimplementedSCIMServer struct {
+}
+
+func (UnimplementedSCIMServer) CreateGroup(context.Context, *SCIMCreateGroupRequest) (*SCIMGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+}
+func (UnimplementedSCIMServer) ReplaceGroup(context.Context, *SCIMReplaceGroupRequest) (*SCIMGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceGroup not implemented")
+}
+func (UnimplementedSCIMServer) PatchGroup(context.Context, *SCIMPatchGroupRequest) (*SCIMGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchGroup not implemented")
+}
+func (UnimplementedSCIMServer) DeleteGroup(context.Context, *SCIMDeleteGroupRequest) (*SCIMDeleteGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
+}
+func (UnimplementedSCIMServer) ReadGroup(context.Context, *SCIMReadGroupRequest) (*SCIMGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadGroup not implemented")
+}
+func (UnimplementedSCIMServer) mustEmbedUnimplementedSCIMServer() {}
+
+// UnsafeSCIMServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SCIMServer will
+// result in compilation errors.
+type UnsafeSCIMServer interface {
+	mustEmbedUnimplementedSCIMServer()
+}
+
+func RegisterSCIMServer(s grpc.ServiceRegistrar, srv SCIMServer) {
+	s.RegisterService(&SCIM_ServiceDesc, srv)
+}
+
+func _SCIM_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SCIMCreateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SCIMServer).CreateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.SCIM/CreateGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SCIMServer).CreateGroup(ctx, req.(*SCIMCreateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SCIM_ReplaceGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SCIMReplaceGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SCIMServer).ReplaceGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.SCIM/ReplaceGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SCIMServer).ReplaceGroup(ctx, req.(*SCIMReplaceGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SCIM_PatchGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SCIMPatchGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SCIMServer).PatchGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.SCIM/PatchGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SCIMServer).PatchGroup(ctx, req.(*SCIMPatchGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SCIM_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SCIMDeleteGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SCIMServer).DeleteGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.SCIM/DeleteGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SCIMServer).DeleteGroup(ctx, req.(*SCIMDeleteGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SCIM_ReadGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SCIMReadGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SCIMServer).ReadGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.SCIM/ReadGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SCIMServer).ReadGroup(ctx, req.(*SCIMReadGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SCIM_ServiceDesc is the grpc.ServiceDesc for SCIM service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SCIM_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "base.v1.SCIM",
+	HandlerType: (*SCIMServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateGroup",
+			Handler:    _SCIM_CreateGroup_Handler,
+		},
+		{
+			MethodName: "ReplaceGroup",
+			Handler:    _SCIM_ReplaceGroup_Handler,
+		},
+		{
+			MethodName: "PatchGroup",
+			Handler:    _SCIM_PatchGroup_Handler,
+		},
+		{
+			MethodName: "DeleteGroup",
+			Handler:    _SCIM_DeleteGroup_Handler,
+		},
+		{
+			MethodName: "ReadGroup",
+			Handler:    _SCIM_ReadGroup_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "base/v1/service.proto",
+}
+
+// AdminClient is the client API for Admin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminClient interface {
+	RefreshTenant(ctx context.Context, in *AdminRefreshTenantRequest, opts ...grpc.CallOption) (*AdminRefreshTenantResponse, error)
+	ExplainQuery(ctx context.Context, in *AdminExplainQueryRequest, opts ...grpc.CallOption) (*AdminExplainQueryResponse, error)
+	Usage(ctx context.Context, in *AdminUsageRequest, opts ...grpc.CallOption) (*AdminUsageResponse, error)
+	MigrateTenant(ctx context.Context, in *AdminMigrateTenantRequest, opts ...grpc.CallOption) (*AdminMigrateTenantResponse, error)
+	CollectGarbage(ctx context.Context, in *AdminCollectGarbageRequest, opts ...grpc.CallOption) (*AdminCollectGarbageResponse, error)
+	CreateAPIKey(ctx context.Context, in *AdminCreateAPIKeyRequest, opts ...grpc.CallOption) (*AdminCreateAPIKeyResponse, error)
+	DeleteAPIKey(ctx context.Context, in *AdminDeleteAPIKeyRequest, opts ...grpc.CallOption) (*AdminDeleteAPIKeyResponse, error)
+	ListAPIKeys(ctx context.Context, in *AdminListAPIKeysRequest, opts ...grpc.CallOption) (*AdminListAPIKeysResponse, error)
+	ListCheckTraces(ctx context.Context, in *AdminListCheckTracesRequest, opts ...grpc.CallOption) (*AdminListCheckTracesResponse, error)
+	ReplayCheck(ctx context.Context, in *AdminReplayCheckRequest, opts ...grpc.CallOption) (*AdminReplayCheckResponse, error)
+	RingStats(ctx context.Context, in *AdminRingStatsRequest, opts ...grpc.CallOption) (*AdminRingStatsResponse, error)
+	WarmUp(ctx context.Context, in *AdminWarmUpRequest, opts ...grpc.CallOption) (*AdminWarmUpResponse, error)
+}
+
+type adminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminClient(cc grpc.ClientConnInterface) AdminClient {
+	return &adminClient{cc}
+}
+
+func (c *adminClient) RefreshTenant(ctx context.Context, in *AdminRefreshTenantRequest, opts ...grpc.CallOption) (*AdminRefreshTenantResponse, error) {
+	out := new(AdminRefreshTenantResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Admin/RefreshTenant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ExplainQuery(ctx context.Context, in *AdminExplainQueryRequest, opts ...grpc.CallOption) (*AdminExplainQueryResponse, error) {
+	out := new(AdminExplainQueryResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Admin/ExplainQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) Usage(ctx context.Context, in *AdminUsageRequest, opts ...grpc.CallOption) (*AdminUsageResponse, error) {
+	out := new(AdminUsageResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Admin/Usage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) MigrateTenant(ctx context.Context, in *AdminMigrateTenantRequest, opts ...grpc.CallOption) (*AdminMigrateTenantResponse, error) {
+	out := new(AdminMigrateTenantResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Admin/MigrateTenant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) CollectGarbage(ctx context.Context, in *AdminCollectGarbageRequest, opts ...grpc.CallOption) (*AdminCollectGarbageResponse, error) {
+	out := new(AdminCollectGarbageResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Admin/CollectGarbage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) CreateAPIKey(ctx context.Context, in *AdminCreateAPIKeyRequest, opts ...grpc.CallOption) (*AdminCreateAPIKeyResponse, error) {
+	out := new(AdminCreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Admin/CreateAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) DeleteAPIKey(ctx context.Context, in *AdminDeleteAPIKeyRequest, opts ...grpc.CallOption) (*AdminDeleteAPIKeyResponse, error) {
+	out := new(AdminDeleteAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Admin/DeleteAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ListAPIKeys(ctx context.Context, in *AdminListAPIKeysRequest, opts ...grpc.CallOption) (*AdminListAPIKeysResponse, error) {
+	out := new(AdminListAPIKeysResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Admin/ListAPIKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ListCheckTraces(ctx context.Context, in *AdminListCheckTracesRequest, opts ...grpc.CallOption) (*AdminListCheckTracesResponse, error) {
+	out := new(AdminListCheckTracesResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Admin/ListCheckTraces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ReplayCheck(ctx context.Context, in *AdminReplayCheckRequest, opts ...grpc.CallOption) (*AdminReplayCheckResponse, error) {
+	out := new(AdminReplayCheckResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Admin/ReplayCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) RingStats(ctx context.Context, in *AdminRingStatsRequest, opts ...grpc.CallOption) (*AdminRingStatsResponse, error) {
+	out := new(AdminRingStatsResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Admin/RingStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) WarmUp(ctx context.Context, in *AdminWarmUpRequest, opts ...grpc.CallOption) (*AdminWarmUpResponse, error) {
+	out := new(AdminWarmUpResponse)
+	err := c.cc.Invoke(ctx, "/base.v1.Admin/WarmUp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServer is the server API for Admin service.
+// All implementations must embed UnimplementedAdminServer
+// for forward compatibility
+type AdminServer interface {
+	RefreshTenant(context.Context, *AdminRefreshTenantRequest) (*AdminRefreshTenantResponse, error)
+	ExplainQuery(context.Context, *AdminExplainQueryRequest) (*AdminExplainQueryResponse, error)
+	Usage(context.Context, *AdminUsageRequest) (*AdminUsageResponse, error)
+	MigrateTenant(context.Context, *AdminMigrateTenantRequest) (*AdminMigrateTenantResponse, error)
+	CollectGarbage(context.Context, *AdminCollectGarbageRequest) (*AdminCollectGarbageResponse, error)
+	CreateAPIKey(context.Context, *AdminCreateAPIKeyRequest) (*AdminCreateAPIKeyResponse, error)
+	DeleteAPIKey(context.Context, *AdminDeleteAPIKeyRequest) (*AdminDeleteAPIKeyResponse, error)
+	ListAPIKeys(context.Context, *AdminListAPIKeysRequest) (*AdminListAPIKeysResponse, error)
+	ListCheckTraces(context.Context, *AdminListCheckTracesRequest) (*AdminListCheckTracesResponse, error)
+	ReplayCheck(context.Context, *AdminReplayCheckRequest) (*AdminReplayCheckResponse, error)
+	RingStats(context.Context, *AdminRingStatsRequest) (*AdminRingStatsResponse, error)
+	WarmUp(context.Context, *AdminWarmUpRequest) (*AdminWarmUpResponse, error)
+	mustEmbedUnimplementedAdminServer()
+}
+
+// UnimplementedAdminServer must be embedded to have forward compatible implementations.
+type UnimplementedAdminServer struct {
+}
+
+func (UnimplementedAdminServer) RefreshTenant(context.Context, *AdminRefreshTenantRequest) (*AdminRefreshTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshTenant not implemented")
+}
+func (UnimplementedAdminServer) ExplainQuery(context.Context, *AdminExplainQueryRequest) (*AdminExplainQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainQuery not implemented")
+}
+func (UnimplementedAdminServer) Usage(context.Context, *AdminUsageRequest) (*AdminUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Usage not implemented")
+}
+func (UnimplementedAdminServer) MigrateTenant(context.Context, *AdminMigrateTenantRequest) (*AdminMigrateTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateTenant not implemented")
+}
+func (UnimplementedAdminServer) CollectGarbage(context.Context, *AdminCollectGarbageRequest) (*AdminCollectGarbageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectGarbage not implemented")
+}
+func (UnimplementedAdminServer) CreateAPIKey(context.Context, *AdminCreateAPIKeyRequest) (*AdminCreateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedAdminServer) DeleteAPIKey(context.Context, *AdminDeleteAPIKeyRequest) (*AdminDeleteAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAPIKey not implemented")
+}
+func (UnimplementedAdminServer) ListAPIKeys(context.Context, *AdminListAPIKeysRequest) (*AdminListAPIKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (UnimplementedAdminServer) ListCheckTraces(context.Context, *AdminListCheckTracesRequest) (*AdminListCheckTracesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCheckTraces not implemented")
+}
+func (UnimplementedAdminServer) ReplayCheck(context.Context, *AdminReplayCheckRequest) (*AdminReplayCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayCheck not implemented")
+}
+func (UnimplementedAdminServer) RingStats(context.Context, *AdminRingStatsRequest) (*AdminRingStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RingStats not implemented")
+}
+func (UnimplementedAdminServer) WarmUp(context.Context, *AdminWarmUpRequest) (*AdminWarmUpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WarmUp not implemented")
+}
+func (UnimplementedAdminServer) mustEmbedUnimplementedAdminServer() {}
+
+// UnsafeAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServer will
+// result in compilation errors.
+type UnsafeAdminServer interface {
+	mustEmbedUnimplementedAdminServer()
+}
+
+func RegisterAdminServer(s grpc.ServiceRegistrar, srv AdminServer) {
+	s.RegisterService(&Admin_ServiceDesc, srv)
+}
+
+func _Admin_RefreshTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminRefreshTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).RefreshTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Admin/RefreshTenant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).RefreshTenant(ctx, req.(*AdminRefreshTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ExplainQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminExplainQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ExplainQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Admin/ExplainQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ExplainQuery(ctx, req.(*AdminExplainQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_Usage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Usage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Admin/Usage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Usage(ctx, req.(*AdminUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_MigrateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminMigrateTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).MigrateTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Admin/MigrateTenant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).MigrateTenant(ctx, req.(*AdminMigrateTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_CollectGarbage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCollectGarbageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).CollectGarbage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Admin/CollectGarbage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).CollectGarbage(ctx, req.(*AdminCollectGarbageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Admin/CreateAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).CreateAPIKey(ctx, req.(*AdminCreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_DeleteAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDeleteAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).DeleteAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Admin/DeleteAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).DeleteAPIKey(ctx, req.(*AdminDeleteAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListAPIKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ListAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Admin/ListAPIKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ListAPIKeys(ctx, req.(*AdminListAPIKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ListCheckTraces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListCheckTracesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ListCheckTraces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Admin/ListCheckTraces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ListCheckTraces(ctx, req.(*AdminListCheckTracesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ReplayCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminReplayCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ReplayCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Admin/ReplayCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ReplayCheck(ctx, req.(*AdminReplayCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_RingStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminRingStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).RingStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Admin/RingStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).RingStats(ctx, req.(*AdminRingStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_WarmUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWarmUpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).WarmUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.v1.Admin/WarmUp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).WarmUp(ctx, req.(*AdminWarmUpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Admin_ServiceDesc is the grpc.ServiceDesc for Admin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Admin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "base.v1.Admin",
+	HandlerType: (*AdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RefreshTenant",
+			Handler:    _Admin_RefreshTenant_Handler,
+		},
+		{
+			MethodName: "ExplainQuery",
+			Handler:    _Admin_ExplainQuery_Handler,
+		},
+		{
+			MethodName: "Usage",
+			Handler:    _Admin_Usage_Handler,
+		},
+		{
+			MethodName: "MigrateTenant",
+			Handler:    _Admin_MigrateTenant_Handler,
+		},
+		{
+			MethodName: "CollectGarbage",
+			Handler:    _Admin_CollectGarbage_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _Admin_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "DeleteAPIKey",
+			Handler:    _Admin_DeleteAPIKey_Handler,
+		},
+		{
+			MethodName: "ListAPIKeys",
+			Handler:    _Admin_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "ListCheckTraces",
+			Handler:    _Admin_ListCheckTraces_Handler,
+		},
+		{
+			MethodName: "ReplayCheck",
+			Handler:    _Admin_ReplayCheck_Handler,
+		},
+		{
+			MethodName: "RingStats",
+			Handler:    _Admin_RingStats_Handler,
+		},
+		{
+			MethodName: "WarmUp",
+			Handler:    _Admin_WarmUp_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "base/v1/service.proto",
@@ -158,6 +158,95 @@ func (m *Tuple) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetPayload()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TupleValidationError{
+					field:  "Payload",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TupleValidationError{
+					field:  "Payload",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPayload()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TupleValidationError{
+				field:  "Payload",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if t := m.GetNotBefore(); t != nil {
+		ts, err := t.AsTime(), t.CheckValid()
+		if err != nil {
+			err = TupleValidationError{
+				field:  "NotBefore",
+				reason: "value is not a valid timestamp",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Unix(0, 0)
+
+			if ts.Sub(gt) <= 0 {
+				err := TupleValidationError{
+					field:  "NotBefore",
+					reason: "value must be greater than 1970-01-01 00:00:00 +0000 UTC",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if t := m.GetNotAfter(); t != nil {
+		ts, err := t.AsTime(), t.CheckValid()
+		if err != nil {
+			err = TupleValidationError{
+				field:  "NotAfter",
+				reason: "value is not a valid timestamp",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Unix(0, 0)
+
+			if ts.Sub(gt) <= 0 {
+				err := TupleValidationError{
+					field:  "NotAfter",
+					reason: "value must be greater than 1970-01-01 00:00:00 +0000 UTC",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
 	if len(errors) > 0 {
 		return TupleMultiError(errors)
 	}
@@ -369,6 +458,228 @@ var _ interface {
 	ErrorName() string
 } = TuplesValidationError{}
 
+// Validate checks the field values on TupleMetadata with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TupleMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TupleMetadata with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TupleMetadataMultiError, or
+// nil if none found.
+func (m *TupleMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TupleMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TupleMetadataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TupleMetadataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TupleMetadataValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CreatedBy
+
+	// no validation rules for Reference
+
+	// no validation rules for SchemaVersion
+
+	if all {
+		switch v := interface{}(m.GetNotBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TupleMetadataValidationError{
+					field:  "NotBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TupleMetadataValidationError{
+					field:  "NotBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNotBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TupleMetadataValidationError{
+				field:  "NotBefore",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetNotAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TupleMetadataValidationError{
+					field:  "NotAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TupleMetadataValidationError{
+					field:  "NotAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNotAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TupleMetadataValidationError{
+				field:  "NotAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDeletedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TupleMetadataValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TupleMetadataValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TupleMetadataValidationError{
+				field:  "DeletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TupleMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// TupleMetadataMultiError is an error wrapping multiple validation errors
+// returned by TupleMetadata.ValidateAll() if the designated constraints
+// aren't met.
+type TupleMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TupleMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TupleMetadataMultiError) AllErrors() []error { return m }
+
+// TupleMetadataValidationError is the validation error returned by
+// TupleMetadata.Validate if the designated constraints aren't met.
+type TupleMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TupleMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TupleMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TupleMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TupleMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TupleMetadataValidationError) ErrorName() string { return "TupleMetadataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TupleMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTupleMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TupleMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TupleMetadataValidationError{}
+
 // Validate checks the field values on Entity with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -114,6 +114,21 @@ func TupleToUserSet(reference, relation string, exclusion bool) *base.Child {
 	}
 }
 
+// Condition - Condition of an action, intersect it with the expression of the action
+func Condition(expression string) *base.Child {
+	return &base.Child{
+		Type: &base.Child_Leaf{
+			Leaf: &base.Leaf{
+				Type: &base.Leaf_Condition{
+					Condition: &base.Condition{
+						Expression: expression,
+					},
+				},
+			},
+		},
+	}
+}
+
 // Union -
 func Union(children ...*base.Child) *base.Child {
 	return &base.Child{
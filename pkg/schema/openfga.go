@@ -0,0 +1,246 @@
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// DirectRelationSuffix - Suffix of the relations that hold the tuples of the OpenFGA relations which also have
+// computed usersets, e.g. viewer: [user] or editor becomes relation viewer_direct @user and action viewer =
+// viewer_direct or editor, so checks of viewer keep their meaning
+const DirectRelationSuffix = "_direct"
+
+// ErrUnsupportedModel - The OpenFGA authorization model cannot be translated
+var ErrUnsupportedModel = errors.New("unsupported openfga authorization model")
+
+// OpenFGAModel - Authorization model of OpenFGA in its json form, schema version 1.1 is needed for the type
+// restrictions of the relations
+type OpenFGAModel struct {
+	SchemaVersion   string                  `json:"schema_version"`
+	TypeDefinitions []OpenFGATypeDefinition `json:"type_definitions"`
+}
+
+// OpenFGATypeDefinition -
+type OpenFGATypeDefinition struct {
+	Type      string                     `json:"type"`
+	Relations map[string]*OpenFGAUserset `json:"relations"`
+	Metadata  *OpenFGAMetadata           `json:"metadata"`
+}
+
+// OpenFGAMetadata -
+type OpenFGAMetadata struct {
+	Relations map[string]OpenFGARelationMetadata `json:"relations"`
+}
+
+// OpenFGARelationMetadata -
+type OpenFGARelationMetadata struct {
+	DirectlyRelatedUserTypes []OpenFGARelationReference `json:"directly_related_user_types"`
+}
+
+// OpenFGARelationReference - Type that can be related directly, a wildcard reference allows type:* tuples
+type OpenFGARelationReference struct {
+	Type     string    `json:"type"`
+	Relation string    `json:"relation,omitempty"`
+	Wildcard *struct{} `json:"wildcard,omitempty"`
+}
+
+// OpenFGAUserset - Rewrite of a relation, exactly one of the fields is set
+type OpenFGAUserset struct {
+	This            *struct{}                 `json:"this,omitempty"`
+	ComputedUserset *OpenFGAObjectRelation    `json:"computedUserset,omitempty"`
+	TupleToUserset  *OpenFGATupleToUserset    `json:"tupleToUserset,omitempty"`
+	Union           *OpenFGAUsersets          `json:"union,omitempty"`
+	Intersection    *OpenFGAUsersets          `json:"intersection,omitempty"`
+	Difference      *OpenFGADifferenceUserset `json:"difference,omitempty"`
+}
+
+// OpenFGAObjectRelation -
+type OpenFGAObjectRelation struct {
+	Relation string `json:"relation"`
+}
+
+// OpenFGATupleToUserset - e.g. viewer from parent
+type OpenFGATupleToUserset struct {
+	Tupleset        OpenFGAObjectRelation `json:"tupleset"`
+	ComputedUserset OpenFGAObjectRelation `json:"computedUserset"`
+}
+
+// OpenFGAUsersets -
+type OpenFGAUsersets struct {
+	Child []*OpenFGAUserset `json:"child"`
+}
+
+// OpenFGADifferenceUserset - e.g. viewer but not blocked
+type OpenFGADifferenceUserset struct {
+	Base     *OpenFGAUserset `json:"base"`
+	Subtract *OpenFGAUserset `json:"subtract"`
+}
+
+// FromOpenFGA - Translates the authorization model into a schema. Relations that only hold tuples become relations,
+// the others become actions; the ones that hold tuples besides get a relation with DirectRelationSuffix for them. The
+// subtracted usersets of differences have to be a computed userset or a tuple to userset, as only those can be negated.
+func FromOpenFGA(model *OpenFGAModel) (*base.SchemaDefinition, error) {
+	if model.SchemaVersion != "1.1" {
+		return nil, fmt.Errorf("%w: schema version %q, 1.1 is needed", ErrUnsupportedModel, model.SchemaVersion)
+	}
+	
+	entities := make([]*base.EntityDefinition, 0, len(model.TypeDefinitions))
+	for _, definition := range model.TypeDefinitions {
+		t := openFGATranslation{definition: definition}
+		
+		names := make([]string, 0, len(definition.Relations))
+		for name := range definition.Relations {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		
+		var relations []*base.RelationDefinition
+		var actions []*base.ActionDefinition
+		for _, name := range names {
+			userset := definition.Relations[name]
+			if userset.This != nil {
+				relation, err := t.relation(name, name)
+				if err != nil {
+					return nil, err
+				}
+				relations = append(relations, relation)
+				continue
+			}
+			if hasThis(userset) {
+				relation, err := t.relation(name, name+DirectRelationSuffix)
+				if err != nil {
+					return nil, err
+				}
+				relations = append(relations, relation)
+			}
+			child, err := t.child(name, userset, false)
+			if err != nil {
+				return nil, err
+			}
+			actions = append(actions, Action(name, child))
+		}
+		entities = append(entities, Entity(definition.Type, relations, actions))
+	}
+	
+	return Schema(entities...), nil
+}
+
+// OpenFGARelation - Relation of the schema translated by FromOpenFGA that the tuples of the OpenFGA relation are
+// written with
+func OpenFGARelation(sch *base.SchemaDefinition, entityType, relation string) string {
+	entity := sch.GetEntityDefinitions()[entityType]
+	if _, ok := entity.GetRelations()[relation]; ok {
+		return relation
+	}
+	if _, ok := entity.GetRelations()[relation+DirectRelationSuffix]; ok {
+		return relation + DirectRelationSuffix
+	}
+	return relation
+}
+
+// openFGATranslation - Translation of a type definition
+type openFGATranslation struct {
+	definition OpenFGATypeDefinition
+}
+
+// relation - Relation named name holding the tuples of the OpenFGA relation, with its directly related types
+func (t openFGATranslation) relation(openFGAName, name string) (*base.RelationDefinition, error) {
+	var types []OpenFGARelationReference
+	if t.definition.Metadata != nil {
+		types = t.definition.Metadata.Relations[openFGAName].DirectlyRelatedUserTypes
+	}
+	if len(types) == 0 {
+		return nil, fmt.Errorf("%w: %s#%s has no directly related user types", ErrUnsupportedModel, t.definition.Type, openFGAName)
+	}
+	
+	// wildcards need no reference of their own, type:* tuples are written with the type
+	seen := map[string]struct{}{}
+	var references []*base.RelationReference
+	for _, typ := range types {
+		key := typ.Type + "#" + typ.Relation
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		references = append(references, &base.RelationReference{Type: typ.Type, Relation: typ.Relation})
+	}
+	return Relation(name, references...), nil
+}
+
+// child - Rewrite of the userset, this refers to the relation of the tuples of the OpenFGA relation
+func (t openFGATranslation) child(name string, userset *OpenFGAUserset, exclusion bool) (*base.Child, error) {
+	switch {
+	case userset.This != nil:
+		return ComputedUserSet(t.tuplesetRelation(name), exclusion), nil
+	case userset.ComputedUserset != nil:
+		return ComputedUserSet(userset.ComputedUserset.Relation, exclusion), nil
+	case userset.TupleToUserset != nil:
+		return TupleToUserSet(t.tuplesetRelation(userset.TupleToUserset.Tupleset.Relation), userset.TupleToUserset.ComputedUserset.Relation, exclusion), nil
+	case exclusion:
+		return nil, fmt.Errorf("%w: %s#%s subtracts a rewrite", ErrUnsupportedModel, t.definition.Type, name)
+	case userset.Union != nil, userset.Intersection != nil:
+		usersets := userset.Union
+		combine := Union
+		if usersets == nil {
+			usersets = userset.Intersection
+			combine = Intersection
+		}
+		children := make([]*base.Child, 0, len(usersets.Child))
+		for _, child := range usersets.Child {
+			ch, err := t.child(name, child, false)
+			if err != nil {
+				return nil, err
+			}
+			children = append(children, ch)
+		}
+		return combine(children...), nil
+	case userset.Difference != nil && userset.Difference.Base != nil && userset.Difference.Subtract != nil:
+		included, err := t.child(name, userset.Difference.Base, false)
+		if err != nil {
+			return nil, err
+		}
+		subtract, err := t.child(name, userset.Difference.Subtract, true)
+		if err != nil {
+			return nil, err
+		}
+		return Intersection(included, subtract), nil
+	default:
+		return nil, fmt.Errorf("%w: %s#%s has an empty rewrite", ErrUnsupportedModel, t.definition.Type, name)
+	}
+}
+
+// tuplesetRelation - Relation of the schema that holds the tuples of the OpenFGA relation of the type
+func (t openFGATranslation) tuplesetRelation(name string) string {
+	if userset, ok := t.definition.Relations[name]; ok && userset.This == nil && hasThis(userset) {
+		return name + DirectRelationSuffix
+	}
+	return name
+}
+
+// hasThis - Whether the rewrite holds tuples of its relation
+func hasThis(userset *OpenFGAUserset) bool {
+	switch {
+	case userset == nil:
+		return false
+	case userset.This != nil:
+		return true
+	case userset.Union != nil:
+		for _, child := range userset.Union.Child {
+			if hasThis(child) {
+				return true
+			}
+		}
+	case userset.Intersection != nil:
+		for _, child := range userset.Intersection.Child {
+			if hasThis(child) {
+				return true
+			}
+		}
+	case userset.Difference != nil:
+		return hasThis(userset.Difference.Base) || hasThis(userset.Difference.Subtract)
+	}
+	return false
+}
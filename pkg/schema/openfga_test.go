@@ -0,0 +1,91 @@
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	
+	internalSchema "github.com/adminium/permify/internal/schema"
+	"github.com/adminium/permify/pkg/dsl/compiler"
+	"github.com/adminium/permify/pkg/dsl/parser"
+)
+
+var _ = Describe("openfga", func() {
+	Context("FromOpenFGA", func() {
+		It("Case 1: Relations with rewrites become actions over direct relations", func() {
+			model := &OpenFGAModel{}
+			err := json.Unmarshal([]byte(`{
+				"schema_version": "1.1",
+				"type_definitions": [
+					{"type": "user"},
+					{
+						"type": "group",
+						"relations": {"member": {"this": {}}},
+						"metadata": {"relations": {"member": {"directly_related_user_types": [{"type": "user"}]}}}
+					},
+					{
+						"type": "folder",
+						"relations": {"viewer": {"this": {}}},
+						"metadata": {"relations": {"viewer": {"directly_related_user_types": [{"type": "user"}, {"type": "user", "wildcard": {}}]}}}
+					},
+					{
+						"type": "document",
+						"relations": {
+							"parent": {"this": {}},
+							"blocked": {"this": {}},
+							"editor": {"this": {}},
+							"viewer": {"union": {"child": [
+								{"this": {}},
+								{"computedUserset": {"relation": "editor"}},
+								{"tupleToUserset": {"tupleset": {"relation": "parent"}, "computedUserset": {"relation": "viewer"}}}
+							]}},
+							"can_view": {"difference": {
+								"base": {"computedUserset": {"relation": "viewer"}},
+								"subtract": {"computedUserset": {"relation": "blocked"}}
+							}}
+						},
+						"metadata": {"relations": {
+							"parent": {"directly_related_user_types": [{"type": "folder"}]},
+							"blocked": {"directly_related_user_types": [{"type": "user"}]},
+							"editor": {"directly_related_user_types": [{"type": "user"}]},
+							"viewer": {"directly_related_user_types": [{"type": "user"}, {"type": "group", "relation": "member"}]}
+						}}
+					}
+				]
+			}`), model)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			sch, err := FromOpenFGA(model)
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			source := internalSchema.ToString(sch)
+			Expect(source).Should(ContainSubstring("\trelation viewer_direct @user @group#member\n"))
+			Expect(source).Should(ContainSubstring("\taction viewer = viewer_direct or editor or parent.viewer\n"))
+			Expect(source).Should(ContainSubstring("\taction can_view = viewer and not blocked\n"))
+			Expect(source).Should(ContainSubstring("entity folder {\n\trelation viewer @user\n}"))
+			
+			parsed, err := parser.NewParser(source).Parse()
+			Expect(err).ShouldNot(HaveOccurred())
+			_, err = compiler.NewCompiler(false, parsed).Compile()
+			Expect(err).ShouldNot(HaveOccurred())
+			
+			Expect(OpenFGARelation(sch, "document", "viewer")).Should(Equal("viewer_direct"))
+			Expect(OpenFGARelation(sch, "document", "editor")).Should(Equal("editor"))
+		})
+		
+		It("Case 2: Models without type restrictions are not supported", func() {
+			_, err := FromOpenFGA(&OpenFGAModel{SchemaVersion: "1.0"})
+			Expect(errors.Is(err, ErrUnsupportedModel)).Should(BeTrue())
+			
+			_, err = FromOpenFGA(&OpenFGAModel{
+				SchemaVersion: "1.1",
+				TypeDefinitions: []OpenFGATypeDefinition{
+					{Type: "document", Relations: map[string]*OpenFGAUserset{"viewer": {This: &struct{}{}}}},
+				},
+			})
+			Expect(errors.Is(err, ErrUnsupportedModel)).Should(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,198 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+	
+	"google.golang.org/protobuf/types/known/structpb"
+	
+	"github.com/adminium/permify/pkg/database"
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+	"github.com/adminium/permify/pkg/token"
+)
+
+// ErrWatchFellBehind - The watch could not keep up with the writes of the tenant, it can be resumed from the snap
+// token of the last changes it streamed
+var ErrWatchFellBehind = errors.New("the watch fell behind the writes")
+
+// RelationshipReader -
+type RelationshipReader interface {
+	// QueryRelationships reads relation tuples from the repository.
+	QueryRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (iterator *database.TupleIterator, err error)
+	// ReadRelationships reads relation tuples from the repository with different options.
+	ReadRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string, pagination database.Pagination) (collection *database.TupleCollection, ct database.EncodedContinuousToken, err error)
+	// GetUniqueEntityIDsByEntityType reads unique entity IDs from the repository.
+	GetUniqueEntityIDsByEntityType(ctx context.Context, tenantID string, typ, snap string) (ids []string, err error)
+	// HeadSnapshot reads the latest version of the snapshot from the repository.
+	HeadSnapshot(ctx context.Context, tenantID string) (token.SnapToken, error)
+	// SnapshotAt reads the snapshot that was the latest at the given time from the repository.
+	SnapshotAt(ctx context.Context, tenantID string, at time.Time) (token.SnapToken, error)
+	// ReadDeletedRelationships reads relation tuples deleted within the given time window from the repository.
+	ReadDeletedRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter, from, to time.Time) (collection *database.TupleCollection, err error)
+	// ReadRelationshipChanges reads relation tuples created and deleted between the snapshots of two tokens from the repository.
+	ReadRelationshipChanges(ctx context.Context, tenantID string, filter *base.TupleFilter, from, to string) (created, deleted *database.TupleCollection, err error)
+}
+
+// RelationshipWriter -
+type RelationshipWriter interface {
+	// WriteRelationships writes relation tuples to the repository.
+	WriteRelationships(ctx context.Context, tenantID string, collection *database.TupleCollection) (token token.EncodedSnapToken, err error)
+	// BatchWriteRelationships writes a large collection of relation tuples atomically, with a single snapshot.
+	BatchWriteRelationships(ctx context.Context, tenantID string, collection *database.TupleCollection) (token token.EncodedSnapToken, err error)
+	// DeleteRelationships deletes relation tuples from the repository.
+	DeleteRelationships(ctx context.Context, tenantID string, filter *base.TupleFilter) (token token.EncodedSnapToken, err error)
+}
+
+// SchemaReader -
+type SchemaReader interface {
+	// ReadSchema reads entity config from the repository.
+	ReadSchema(ctx context.Context, tenantID string, version string) (schema *base.SchemaDefinition, err error)
+	// ReadSchemaDefinition reads entity config from the repository.
+	ReadSchemaDefinition(ctx context.Context, tenantID string, entityType, version string) (definition *base.EntityDefinition, v string, err error)
+	// ReadSchemaString reads the serialized entity definitions of the schema version, as they were written.
+	ReadSchemaString(ctx context.Context, tenantID string, version string) (definitions []string, err error)
+	// HeadVersion reads the latest version of the schema from the repository.
+	HeadVersion(ctx context.Context, tenantID string) (version string, err error)
+	// ListSchemaVersions reads the versions of the schema from the repository, the latest first.
+	ListSchemaVersions(ctx context.Context, tenantID string, pagination database.Pagination) (versions []string, ct database.EncodedContinuousToken, err error)
+}
+
+// SchemaWriter -
+type SchemaWriter interface {
+	// WriteSchema writes schema to the repository.
+	WriteSchema(ctx context.Context, definitions []SchemaDefinition) (err error)
+}
+
+// TenantReader -
+type TenantReader interface {
+	// ListTenants reads tenants from the repository.
+	ListTenants(ctx context.Context, pagination database.Pagination) (tenants []*base.Tenant, ct database.EncodedContinuousToken, err error)
+}
+
+// TenantWriter -
+type TenantWriter interface {
+	// CreateTenant writes tenant to the repository.
+	CreateTenant(ctx context.Context, id, name string) (tenant *base.Tenant, err error)
+	// DeleteTenant deletes tenant from the repository.
+	DeleteTenant(ctx context.Context, tenantID string) (tenant *base.Tenant, err error)
+}
+
+// TenantGetter - Optionally implemented by tenant readers that can read a single tenant with its labels
+type TenantGetter interface {
+	// GetTenant reads the tenant and its labels from the repository.
+	GetTenant(ctx context.Context, tenantID string) (tenant *base.Tenant, labels map[string]string, err error)
+}
+
+// TenantUpdater - Optionally implemented by tenant writers that can rename and label the tenants
+type TenantUpdater interface {
+	// UpdateTenant replaces the name and the labels of the tenant in the repository, an empty name keeps the name
+	// and nil labels keep the labels.
+	UpdateTenant(ctx context.Context, tenantID, name string, labels map[string]string) (tenant *base.Tenant, err error)
+}
+
+// IdentityReader -
+type IdentityReader interface {
+	// ResolveIdentity reads the canonical subject id that the alias is mapped to from the repository.
+	ResolveIdentity(ctx context.Context, tenantID, subjectType, alias string) (subjectID string, err error)
+}
+
+// IdentityWriter -
+type IdentityWriter interface {
+	// WriteIdentity maps the alias to the canonical subject id in the repository.
+	WriteIdentity(ctx context.Context, tenantID, subjectType, alias, subjectID string) (err error)
+	// DeleteIdentity deletes the mapping of the alias from the repository.
+	DeleteIdentity(ctx context.Context, tenantID, subjectType, alias string) (err error)
+}
+
+// AttributeReader -
+type AttributeReader interface {
+	// ReadAttributes reads the attribute values of the entity from the repository.
+	ReadAttributes(ctx context.Context, tenantID string, entity *base.Entity) (attributes map[string]*structpb.Value, err error)
+}
+
+// AttributeWriter -
+type AttributeWriter interface {
+	// WriteAttributes writes the attribute values of the entity to the repository, existing values are replaced.
+	WriteAttributes(ctx context.Context, tenantID string, entity *base.Entity, attributes map[string]*structpb.Value) (err error)
+	// DeleteAttributes deletes the attributes of the entity from the repository, all of them when no name is given.
+	DeleteAttributes(ctx context.Context, tenantID string, entity *base.Entity, names ...string) (err error)
+}
+
+// TenantSettingsReader -
+type TenantSettingsReader interface {
+	// ReadTenantSettings reads the settings of the tenant from the repository, the defaults when none are stored.
+	ReadTenantSettings(ctx context.Context, tenantID string) (settings TenantSettings, err error)
+}
+
+// TenantSettingsWriter -
+type TenantSettingsWriter interface {
+	// WriteTenantSettings writes the settings of the tenant to the repository, existing settings are replaced.
+	WriteTenantSettings(ctx context.Context, tenantID string, settings TenantSettings) (err error)
+}
+
+// APIKeyReader -
+type APIKeyReader interface {
+	// ReadAPIKey reads the key with the hash of its secret from the repository.
+	ReadAPIKey(ctx context.Context, hash string) (key APIKey, err error)
+	// ListAPIKeys reads the keys from the repository, the oldest first.
+	ListAPIKeys(ctx context.Context) (keys []APIKey, err error)
+}
+
+// APIKeyWriter -
+type APIKeyWriter interface {
+	// WriteAPIKey writes the key to the repository.
+	WriteAPIKey(ctx context.Context, key APIKey) (err error)
+	// DeleteAPIKey deletes the key with the id from the repository.
+	DeleteAPIKey(ctx context.Context, id string) (err error)
+}
+
+// CheckTraceReader -
+type CheckTraceReader interface {
+	// ListCheckTraces reads the traces that the filter selects from the repository, the newest first.
+	ListCheckTraces(ctx context.Context, filter CheckTraceFilter) (traces []CheckTrace, err error)
+}
+
+// CheckTraceWriter -
+type CheckTraceWriter interface {
+	// WriteCheckTrace writes the trace to the repository.
+	WriteCheckTrace(ctx context.Context, trace CheckTrace) (err error)
+	// DeleteCheckTraces deletes the traces created before the time from the repository.
+	DeleteCheckTraces(ctx context.Context, before time.Time) (deleted int64, err error)
+}
+
+// Watcher -
+type Watcher interface {
+	// Watch streams the relation tuples created and deleted after the snapshot of the token, in the order of their
+	// transactions. The error channel receives at most one error, after which both channels are closed; they are also
+	// closed when the context is done.
+	Watch(ctx context.Context, tenantID string, snap string) (changes <-chan *RelationshipChanges, errs <-chan error)
+}
+
+// QueryExplainer - Optionally implemented by relationship readers that can describe how a filter is queried
+type QueryExplainer interface {
+	// ExplainQuery describes the query that reads the relation tuples of the filter without running it.
+	ExplainQuery(ctx context.Context, tenantID string, filter *base.TupleFilter, snap string) (plan QueryPlan, err error)
+}
+
+// GarbageCollector - Optionally implemented by storages that keep the versions of the deleted relation tuples
+type GarbageCollector interface {
+	// CollectGarbage deletes at most limit relation tuples that were deleted before the time and returns how many
+	// it deleted.
+	CollectGarbage(ctx context.Context, before time.Time, limit int) (deleted int64, err error)
+}
+
+// SnapshotHorizon - Optionally implemented by garbage collectors, so that the snapshots whose tuples may have been
+// collected are told apart
+type SnapshotHorizon interface {
+	// ExpiredSnapshot reports whether the tuples the snapshot saw may have been collected along with the tuples
+	// deleted before the time, and returns the earliest snapshot of the tenant that can still be read when it was.
+	ExpiredSnapshot(ctx context.Context, tenantID string, snap string, before time.Time) (earliest token.SnapToken, expired bool, err error)
+}
+
+// RelationshipSweeper - Optionally implemented by storages that can remove the relation tuples whose validity ended
+type RelationshipSweeper interface {
+	// SweepExpiredRelationships deletes at most limit relation tuples that stopped being valid before the time and
+	// returns how many it deleted.
+	SweepExpiredRelationships(ctx context.Context, before time.Time, limit int) (deleted int64, err error)
+}
@@ -0,0 +1,123 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/adminium/permify/pkg/database"
+)
+
+// SchemaDefinition - Structure for Schema Definition
+type SchemaDefinition struct {
+	TenantID             string
+	EntityType           string
+	SerializedDefinition []byte
+	Version              string
+}
+
+// Serialized - get schema serialized definition
+func (e SchemaDefinition) Serialized() string {
+	return string(e.SerializedDefinition)
+}
+
+// QueryPlan - How the storage reads the relation tuples of a filter
+type QueryPlan struct {
+	Engine string
+	// Index - index the tuples are looked up with, empty when the storage plans the query itself
+	Index string
+	// Query - query sent to the storage and its arguments
+	Query string
+	Args  []string
+	// Plan - lines of the execution plan of the query
+	Plan []string
+	// FullScan - every tuple of the table is read to answer the filter
+	FullScan bool
+}
+
+// RelationshipChanges - Relation tuples created and deleted by the transactions up to the snapshot of the token
+type RelationshipChanges struct {
+	SnapToken string
+	Created   *database.TupleCollection
+	Deleted   *database.TupleCollection
+}
+
+// TenantSettings - Engine behavior of a tenant, so that changes can be rolled out tenant by tenant
+type TenantSettings struct {
+	// StrictValidation - written subjects must have one of the types the relation references
+	StrictValidation bool
+	// Wildcards - a tuple with the subject id * relates every subject of its type
+	Wildcards bool
+	// CacheTTL - lifetime of the cached checks of the tenant, zero keeps them until they are evicted
+	CacheTTL time.Duration
+	// Depth - depth of the checks and lookups that do not give one, zero leaves it to the server default
+	Depth int32
+	// MaxDepth - larger depths of the checks and lookups are clamped to it, zero leaves it to the server maximum
+	MaxDepth int32
+}
+
+// DefaultTenantSettings - Settings of the tenants that have none stored, the behavior before the settings existed
+func DefaultTenantSettings() TenantSettings {
+	return TenantSettings{
+		StrictValidation: true,
+	}
+}
+
+// Scopes of the api keys
+const (
+	// APIKeyScopeRead - the key may read, check and look up, but not write
+	APIKeyScopeRead = "read"
+	// APIKeyScopeReadWrite - the key may also write and delete
+	APIKeyScopeReadWrite = "read_write"
+)
+
+// APIKey - Key that authenticates the requests of the tenants it is restricted to, only the hash of its secret is
+// stored
+type APIKey struct {
+	ID string
+	// Hash - hex encoded sha256 of the secret
+	Hash string
+	// Tenants - tenants the key may call, every tenant when there are none
+	Tenants   []string
+	Scope     string
+	CreatedAt time.Time
+}
+
+// CheckTrace - Sampled check with what it resolved and read, kept to explain its result after the fact
+type CheckTrace struct {
+	ID       string
+	TenantID string
+	// the check as it was executed, the entity and the subject in the tuple notation
+	Entity        string
+	Permission    string
+	Subject       string
+	SchemaVersion string
+	SnapToken     string
+	Depth         int32
+	// Result - result of the check, empty when it failed
+	Result string
+	Error  string
+	// Steps - permissions and relations resolved, in completion order
+	Steps []CheckTraceStep
+	// Tuples - tuples read, in the tuple notation
+	Tuples    []string
+	Duration  time.Duration
+	CreatedAt time.Time
+}
+
+// CheckTraceStep - Permission or relation resolved by a traced check
+type CheckTraceStep struct {
+	Entity     string `json:"entity"`
+	Permission string `json:"permission"`
+	Subject    string `json:"subject"`
+	Depth      int32  `json:"depth"`
+	Result     string `json:"result"`
+	Error      string `json:"error,omitempty"`
+}
+
+// CheckTraceFilter - Selects the traces of a tenant, the zero times do not bound them
+type CheckTraceFilter struct {
+	TenantID string
+	Since    time.Time
+	Until    time.Time
+	// Limit - number of traces read at most, zero reads all of them
+	Limit int
+}
@@ -0,0 +1,144 @@
+package storage
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	
+	"github.com/adminium/permify/pkg/database"
+	"github.com/adminium/permify/pkg/logger"
+	"github.com/adminium/permify/pkg/token"
+)
+
+// Driver - Storage backend maintained out of tree. Drivers register themselves, usually from an init function,
+// and are selected with the database engine configuration.
+type Driver interface {
+	// Open opens the database for the given uri.
+	Open(uri string) (database.Database, error)
+	// RelationshipReader creates the relationship reader of the database.
+	RelationshipReader(db database.Database, logger logger.Interface) RelationshipReader
+	// RelationshipWriter creates the relationship writer of the database.
+	RelationshipWriter(db database.Database, logger logger.Interface) RelationshipWriter
+	// SchemaReader creates the schema reader of the database.
+	SchemaReader(db database.Database, logger logger.Interface) SchemaReader
+	// SchemaWriter creates the schema writer of the database.
+	SchemaWriter(db database.Database, logger logger.Interface) SchemaWriter
+	// TenantReader creates the tenant reader of the database.
+	TenantReader(db database.Database, logger logger.Interface) TenantReader
+	// TenantWriter creates the tenant writer of the database.
+	TenantWriter(db database.Database, logger logger.Interface) TenantWriter
+}
+
+// IdentityDriver - Optionally implemented by drivers that can store identity mappings
+type IdentityDriver interface {
+	// IdentityReader creates the identity reader of the database.
+	IdentityReader(db database.Database, logger logger.Interface) IdentityReader
+	// IdentityWriter creates the identity writer of the database.
+	IdentityWriter(db database.Database, logger logger.Interface) IdentityWriter
+}
+
+// AttributeDriver - Optionally implemented by drivers that can store the attributes of entities
+type AttributeDriver interface {
+	// AttributeReader creates the attribute reader of the database.
+	AttributeReader(db database.Database, logger logger.Interface) AttributeReader
+	// AttributeWriter creates the attribute writer of the database.
+	AttributeWriter(db database.Database, logger logger.Interface) AttributeWriter
+}
+
+// SettingsDriver - Optionally implemented by drivers that can store the settings of tenants
+type SettingsDriver interface {
+	// TenantSettingsReader creates the tenant settings reader of the database.
+	TenantSettingsReader(db database.Database, logger logger.Interface) TenantSettingsReader
+	// TenantSettingsWriter creates the tenant settings writer of the database.
+	TenantSettingsWriter(db database.Database, logger logger.Interface) TenantSettingsWriter
+}
+
+// APIKeyDriver - Optionally implemented by drivers that can store api keys
+type APIKeyDriver interface {
+	// APIKeyReader creates the api key reader of the database.
+	APIKeyReader(db database.Database, logger logger.Interface) APIKeyReader
+	// APIKeyWriter creates the api key writer of the database.
+	APIKeyWriter(db database.Database, logger logger.Interface) APIKeyWriter
+}
+
+// CheckTraceDriver - Optionally implemented by drivers that can store the sampled traces of checks
+type CheckTraceDriver interface {
+	// CheckTraceReader creates the check trace reader of the database.
+	CheckTraceReader(db database.Database, logger logger.Interface) CheckTraceReader
+	// CheckTraceWriter creates the check trace writer of the database.
+	CheckTraceWriter(db database.Database, logger logger.Interface) CheckTraceWriter
+}
+
+// WatchDriver - Optionally implemented by drivers that can stream the changes of relation tuples
+type WatchDriver interface {
+	// Watcher creates the watcher of the database.
+	Watcher(db database.Database, logger logger.Interface) Watcher
+}
+
+// GarbageCollectionDriver - Optionally implemented by drivers whose storage keeps the versions of the deleted
+// relation tuples
+type GarbageCollectionDriver interface {
+	// GarbageCollector creates the garbage collector of the database.
+	GarbageCollector(db database.Database, logger logger.Interface) GarbageCollector
+}
+
+// RelationshipSweepDriver - Optionally implemented by drivers whose storage can remove the expired relation tuples
+type RelationshipSweepDriver interface {
+	// RelationshipSweeper creates the sweeper of the expired relation tuples of the database.
+	RelationshipSweeper(db database.Database, logger logger.Interface) RelationshipSweeper
+}
+
+// SnapTokenDriver - Optionally implemented by drivers whose snap tokens can be compared across the regions of a
+// multi-region deployment
+type SnapTokenDriver interface {
+	// SnapTokenDecoder creates the decoder of the snap tokens of the database.
+	SnapTokenDecoder() token.Decoder
+}
+
+// Migrator - Optionally implemented by drivers that need to migrate the database before use
+type Migrator interface {
+	// Migrate migrates the database for the given uri.
+	Migrate(uri string) error
+}
+
+var (
+	driversMu sync.RWMutex
+	drivers   = make(map[string]Driver)
+)
+
+// Register - Makes a storage driver available by the provided engine name.
+// It panics if Register is called twice with the same name, if the driver is nil, or if the name is a built-in engine.
+func Register(engine string, driver Driver) {
+	driversMu.Lock()
+	defer driversMu.Unlock()
+	if driver == nil {
+		panic("storage: register driver is nil")
+	}
+	if engine == database.POSTGRES.String() || engine == database.MEMORY.String() {
+		panic(fmt.Sprintf("storage: %s is a built-in engine", engine))
+	}
+	if _, dup := drivers[engine]; dup {
+		panic(fmt.Sprintf("storage: register called twice for driver %s", engine))
+	}
+	drivers[engine] = driver
+}
+
+// Lookup - Gets the driver registered with the engine name
+func Lookup(engine string) (Driver, bool) {
+	driversMu.RLock()
+	defer driversMu.RUnlock()
+	driver, ok := drivers[engine]
+	return driver, ok
+}
+
+// Drivers - Returns a sorted list of the names of the registered drivers
+func Drivers() []string {
+	driversMu.RLock()
+	defer driversMu.RUnlock()
+	list := make([]string, 0, len(drivers))
+	for name := range drivers {
+		list = append(list, name)
+	}
+	sort.Strings(list)
+	return list
+}
@@ -0,0 +1,54 @@
+package storage
+
+import (
+	"testing"
+	
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	
+	"github.com/adminium/permify/pkg/database"
+	"github.com/adminium/permify/pkg/logger"
+)
+
+// TestStorage -
+func TestStorage(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "storage-suite")
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(string) (database.Database, error) { return nil, nil }
+func (fakeDriver) RelationshipReader(database.Database, logger.Interface) RelationshipReader {
+	return nil
+}
+func (fakeDriver) RelationshipWriter(database.Database, logger.Interface) RelationshipWriter {
+	return nil
+}
+func (fakeDriver) SchemaReader(database.Database, logger.Interface) SchemaReader { return nil }
+func (fakeDriver) SchemaWriter(database.Database, logger.Interface) SchemaWriter { return nil }
+func (fakeDriver) TenantReader(database.Database, logger.Interface) TenantReader { return nil }
+func (fakeDriver) TenantWriter(database.Database, logger.Interface) TenantWriter { return nil }
+
+var _ = Describe("storage", func() {
+	Context("Register", func() {
+		It("Case 1: Success", func() {
+			Register("fake", fakeDriver{})
+			
+			driver, ok := Lookup("fake")
+			Expect(ok).Should(BeTrue())
+			Expect(driver).Should(Equal(fakeDriver{}))
+			Expect(Drivers()).Should(ContainElement("fake"))
+			
+			_, ok = Lookup("unknown")
+			Expect(ok).Should(BeFalse())
+		})
+		
+		It("Case 2: Fail", func() {
+			Expect(func() { Register("fake-2", nil) }).Should(Panic())
+			Expect(func() { Register(database.POSTGRES.String(), fakeDriver{}) }).Should(Panic())
+			Register("fake-3", fakeDriver{})
+			Expect(func() { Register("fake-3", fakeDriver{}) }).Should(Panic())
+		})
+	})
+})
@@ -23,15 +23,15 @@ func NewNoopMeter() omt.Meter {
 	return omt.NewNoopMeter()
 }
 
-// NewMeter - Creates new meter
-func NewMeter(exporter metric.Exporter) (omt.Meter, error) {
+// NewMeter - Creates new meter read by the reader of its exporter
+func NewMeter(reader metric.Reader) (omt.Meter, error) {
 	hostName, err := os.Hostname()
 	if err != nil {
 		return nil, err
 	}
 	
 	mp := metric.NewMeterProvider(
-		metric.WithReader(metric.NewPeriodicReader(exporter)),
+		metric.WithReader(reader),
 		metric.WithResource(resource.NewWithAttributes(
 			semconv.SchemaURL,
 			semconv.ServiceNameKey.String(xid.New().String()),
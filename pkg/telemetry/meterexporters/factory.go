@@ -6,11 +6,14 @@ import (
 	"go.opentelemetry.io/otel/sdk/metric"
 )
 
-// ExporterFactory - Create meter exporter according to given params
-func ExporterFactory(name, endpoint string) (metric.Exporter, error) {
+// ExporterFactory - Create meter reader of the exporter according to given params. The otlp exporter pushes the
+// metrics to the endpoint, the prometheus exporter is scraped from the /metrics path of the endpoint.
+func ExporterFactory(name, endpoint string) (metric.Reader, error) {
 	switch name {
 	case "otlp":
 		return NewOTLP(endpoint)
+	case "prometheus":
+		return NewPrometheus()
 	default:
 		return nil, fmt.Errorf("%s meter exporter is unsupported", name)
 	}
@@ -7,8 +7,8 @@ import (
 	"go.opentelemetry.io/otel/sdk/metric"
 )
 
-// NewOTLP - Creates new OTLP exporter
-func NewOTLP(endpoint string) (metric.Exporter, error) {
+// NewOTLP - Creates new OTLP exporter, it is read periodically
+func NewOTLP(endpoint string) (metric.Reader, error) {
 	exporter, err := otlpmetrichttp.New(context.Background(),
 		otlpmetrichttp.WithCompression(otlpmetrichttp.GzipCompression),
 		otlpmetrichttp.WithEndpoint(endpoint),
@@ -16,5 +16,5 @@ func NewOTLP(endpoint string) (metric.Exporter, error) {
 	if err != nil {
 		return nil, err
 	}
-	return exporter, nil
+	return metric.NewPeriodicReader(exporter), nil
 }
@@ -0,0 +1,45 @@
+package meterexporters
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel/exporters/prometheus"
+	"go.opentelemetry.io/otel/sdk/metric"
+)
+
+// PrometheusPath - Path the metrics are scraped from
+const PrometheusPath = "/metrics"
+
+// NewPrometheus - Creates new Prometheus exporter, the metrics are collected into the default registry when it is
+// scraped
+func NewPrometheus() (metric.Reader, error) {
+	return prometheus.New()
+}
+
+// ServePrometheus - Serves the default registry on the path of the address until the context is done
+func ServePrometheus(ctx context.Context, address string) error {
+	mux := http.NewServeMux()
+	mux.Handle(PrometheusPath, promhttp.Handler())
+
+	srv := &http.Server{
+		Addr:              address,
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = srv.Shutdown(shutdownCtx)
+	}()
+
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package token
+
+import (
+	"errors"
+	"strings"
+)
+
+// RegionSeparator - Separates the region from the storage token in an encoded regional token
+const RegionSeparator = ":"
+
+type (
+	// RegionalToken - Snap token issued by a region of a multi-region deployment
+	RegionalToken struct {
+		Region string
+		Token  SnapToken
+	}
+	// RegionalEncodedToken - Encoded regional token
+	RegionalEncodedToken struct {
+		Region string
+		Token  EncodedSnapToken
+	}
+	// Decoder - Decodes a storage token from its string
+	Decoder func(value string) (SnapToken, error)
+)
+
+// NewRegionalToken - Creates a new regional snapshot token
+func NewRegionalToken(region string, token SnapToken) SnapToken {
+	return RegionalToken{
+		Region: region,
+		Token:  token,
+	}
+}
+
+// Encode - Encodes the token to a string
+func (t RegionalToken) Encode() EncodedSnapToken {
+	return RegionalEncodedToken{
+		Region: t.Region,
+		Token:  t.Token.Encode(),
+	}
+}
+
+// Eg - Snapshot is equal to given snapshot. Tokens issued by different regions are never equal.
+func (t RegionalToken) Eg(token SnapToken) bool {
+	ct, ok := token.(RegionalToken)
+	return ok && ct.Region == t.Region && t.Token.Eg(ct.Token)
+}
+
+// Gt - Snapshot is greater than given snapshot. Tokens issued by different regions are not comparable.
+func (t RegionalToken) Gt(token SnapToken) bool {
+	ct, ok := token.(RegionalToken)
+	return ok && ct.Region == t.Region && t.Token.Gt(ct.Token)
+}
+
+// Lt - Snapshot is less than given snapshot. Tokens issued by different regions are not comparable.
+func (t RegionalToken) Lt(token SnapToken) bool {
+	ct, ok := token.(RegionalToken)
+	return ok && ct.Region == t.Region && t.Token.Lt(ct.Token)
+}
+
+// Decode - Decodes the token from a string
+func (t RegionalEncodedToken) Decode() (SnapToken, error) {
+	st, err := t.Token.Decode()
+	if err != nil {
+		return nil, err
+	}
+	return RegionalToken{
+		Region: t.Region,
+		Token:  st,
+	}, nil
+}
+
+// String - Returns the string representation of the token
+func (t RegionalEncodedToken) String() string {
+	return t.Region + RegionSeparator + t.Token.String()
+}
+
+// SplitRegionalToken - Splits an encoded regional token into its region and storage token.
+// Tokens without a region are returned as they are.
+func SplitRegionalToken(value string) (region, snap string, err error) {
+	if !strings.Contains(value, RegionSeparator) {
+		return "", value, nil
+	}
+	parts := strings.SplitN(value, RegionSeparator, 2)
+	if parts[0] == "" {
+		return "", "", errors.New("invalid regional token")
+	}
+	return parts[0], parts[1], nil
+}
@@ -45,4 +45,39 @@ var _ = Describe("token", func() {
 			}
 		})
 	})
+
+	Context("Regional", func() {
+		It("Case 1: Encode", func() {
+			Expect(NewRegionalToken("eu", NewNoopToken()).Encode().String()).Should(Equal("eu:noop"))
+		})
+
+		It("Case 2: Compare", func() {
+			eu := NewRegionalToken("eu", NewNoopToken())
+			Expect(eu.Eg(NewRegionalToken("eu", NewNoopToken()))).Should(BeTrue())
+			Expect(eu.Eg(NewRegionalToken("us", NewNoopToken()))).Should(BeFalse())
+			Expect(eu.Eg(NewNoopToken())).Should(BeFalse())
+		})
+
+		It("Case 3: Split", func() {
+			tests := []struct {
+				target string
+				region string
+				snap   string
+			}{
+				{"eu:noop", "eu", "noop"},
+				{"noop", "", "noop"},
+				{"us-east-1:AQAAAAAAAAA=", "us-east-1", "AQAAAAAAAAA="},
+			}
+
+			for _, tt := range tests {
+				region, snap, err := SplitRegionalToken(tt.target)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(region).Should(Equal(tt.region))
+				Expect(snap).Should(Equal(tt.snap))
+			}
+
+			_, _, err := SplitRegionalToken(":noop")
+			Expect(err).Should(HaveOccurred())
+		})
+	})
 })
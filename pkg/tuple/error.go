@@ -9,4 +9,6 @@ var (
 	ErrInvalidTuple             = errors.New("invalid tuple")
 	ErrInvalidEntityAndRelation = errors.New("invalid entity and relation")
 	ErrInvalidQuery             = errors.New("invalid query")
+	ErrInvalidGroup             = errors.New("invalid group")
+	ErrInvalidMember            = errors.New("invalid group member")
 )
@@ -0,0 +1,196 @@
+package tuple
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// Format - File format of exported tuples
+type Format string
+
+const (
+	// NDJSON - One json object per line with the fields of Record
+	NDJSON Format = "ndjson"
+	// CSV - A header line with the columns of Record, then one line per tuple
+	CSV Format = "csv"
+)
+
+// ErrUnsupportedFormat - The format is neither ndjson nor csv
+var ErrUnsupportedFormat = errors.New("unsupported tuple format")
+
+// ParseFormat -
+func ParseFormat(f string) (Format, error) {
+	switch Format(f) {
+	case NDJSON, CSV:
+		return Format(f), nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedFormat, f)
+	}
+}
+
+// csvHeader - Columns of the csv format, in the order of the fields of Record
+var csvHeader = []string{"entity_type", "entity_id", "relation", "subject_type", "subject_id", "subject_relation"}
+
+// Record - Flat form of a tuple in the files, the subject relation is empty for users
+type Record struct {
+	EntityType      string `json:"entity_type"`
+	EntityID        string `json:"entity_id"`
+	Relation        string `json:"relation"`
+	SubjectType     string `json:"subject_type"`
+	SubjectID       string `json:"subject_id"`
+	SubjectRelation string `json:"subject_relation,omitempty"`
+}
+
+// NewRecord -
+func NewRecord(t *base.Tuple) Record {
+	return Record{
+		EntityType:      t.GetEntity().GetType(),
+		EntityID:        t.GetEntity().GetId(),
+		Relation:        t.GetRelation(),
+		SubjectType:     t.GetSubject().GetType(),
+		SubjectID:       t.GetSubject().GetId(),
+		SubjectRelation: t.GetSubject().GetRelation(),
+	}
+}
+
+// Tuple -
+func (r Record) Tuple() *base.Tuple {
+	return &base.Tuple{
+		Entity:   &base.Entity{Type: r.EntityType, Id: r.EntityID},
+		Relation: r.Relation,
+		Subject:  &base.Subject{Type: r.SubjectType, Id: r.SubjectID, Relation: r.SubjectRelation},
+	}
+}
+
+// Encoder - Writes tuples in a format, Flush has to be called after the last tuple
+type Encoder struct {
+	format Format
+	w      *bufio.Writer
+	csv    *csv.Writer
+	header bool
+}
+
+// NewEncoder -
+func NewEncoder(w io.Writer, format Format) (*Encoder, error) {
+	if _, err := ParseFormat(string(format)); err != nil {
+		return nil, err
+	}
+	e := &Encoder{format: format, w: bufio.NewWriter(w)}
+	if format == CSV {
+		e.csv = csv.NewWriter(e.w)
+	}
+	return e, nil
+}
+
+// Encode -
+func (e *Encoder) Encode(t *base.Tuple) error {
+	r := NewRecord(t)
+	if e.format == CSV {
+		if !e.header {
+			e.header = true
+			if err := e.csv.Write(csvHeader); err != nil {
+				return err
+			}
+		}
+		return e.csv.Write([]string{r.EntityType, r.EntityID, r.Relation, r.SubjectType, r.SubjectID, r.SubjectRelation})
+	}
+	b, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	if _, err = e.w.Write(b); err != nil {
+		return err
+	}
+	return e.w.WriteByte('\n')
+}
+
+// Flush -
+func (e *Encoder) Flush() error {
+	if e.csv != nil {
+		e.csv.Flush()
+		if err := e.csv.Error(); err != nil {
+			return err
+		}
+	}
+	return e.w.Flush()
+}
+
+// Decoder - Reads the tuples of a file one by one
+type Decoder struct {
+	format  Format
+	scanner *bufio.Scanner
+	csv     *csv.Reader
+	header  bool
+	// line - number of the last read line of ndjson files, csv errors carry their own
+	line int
+}
+
+// NewDecoder -
+func NewDecoder(r io.Reader, format Format) (*Decoder, error) {
+	if _, err := ParseFormat(string(format)); err != nil {
+		return nil, err
+	}
+	d := &Decoder{format: format}
+	if format == CSV {
+		d.csv = csv.NewReader(r)
+		d.csv.FieldsPerRecord = len(csvHeader)
+		d.csv.ReuseRecord = true
+	} else {
+		d.scanner = bufio.NewScanner(r)
+	}
+	return d, nil
+}
+
+// Decode - Next tuple of the file, io.EOF after the last one. Empty lines of ndjson files are skipped.
+func (d *Decoder) Decode() (*base.Tuple, error) {
+	if d.format == CSV {
+		return d.decodeCSV()
+	}
+	for d.scanner.Scan() {
+		d.line++
+		line := bytes.TrimSpace(d.scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		r := Record{}
+		if err := json.Unmarshal(line, &r); err != nil {
+			return nil, fmt.Errorf("line %d: %w", d.line, err)
+		}
+		return r.Tuple(), nil
+	}
+	if err := d.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+// decodeCSV - The header line is skipped when it names the columns
+func (d *Decoder) decodeCSV() (*base.Tuple, error) {
+	for {
+		fields, err := d.csv.Read()
+		if err != nil {
+			return nil, err
+		}
+		if !d.header {
+			d.header = true
+			if fields[0] == csvHeader[0] {
+				continue
+			}
+		}
+		return Record{
+			EntityType:      fields[0],
+			EntityID:        fields[1],
+			Relation:        fields[2],
+			SubjectType:     fields[3],
+			SubjectID:       fields[4],
+			SubjectRelation: fields[5],
+		}.Tuple(), nil
+	}
+}
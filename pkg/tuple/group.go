@@ -0,0 +1,83 @@
+package tuple
+
+import (
+	"strings"
+	
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+const (
+	// GROUP - Entity type of the groups of the schemas that follow the group membership pattern
+	GROUP = "group"
+	// MEMBER - Relation of the groups to their members
+	MEMBER = "member"
+)
+
+// GroupSubject - Members of the group as a subject, e.g. group:eng#member. A check of this subject tells whether the
+// members of the group have the permission through the group, a tuple of it relates them all at once.
+func GroupSubject(groupType, groupID string) (*base.Subject, error) {
+	if !isGroupPart(groupType) || groupType == USER || !isGroupPart(groupID) || groupID == WILDCARD {
+		return nil, ErrInvalidGroup
+	}
+	return &base.Subject{
+		Type:     groupType,
+		Id:       groupID,
+		Relation: MEMBER,
+	}, nil
+}
+
+// Membership - Tuple that makes the subject a member of the group, e.g. group:eng#member@user:1. A member that is
+// a group of the same type without a relation is nested with its members, group:eng#member@group:backend#member.
+func Membership(groupType, groupID string, member *base.Subject) (*base.Tuple, error) {
+	group, err := GroupSubject(groupType, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if !isGroupPart(member.GetType()) || !isGroupPart(member.GetId()) {
+		return nil, ErrInvalidMember
+	}
+	
+	subject := &base.Subject{
+		Type:     member.GetType(),
+		Id:       member.GetId(),
+		Relation: member.GetRelation(),
+	}
+	if subject.GetType() == groupType && subject.GetRelation() == "" {
+		subject.Relation = MEMBER
+	}
+	if !IsSubjectUser(subject) && subject.GetRelation() == "" {
+		return nil, ErrInvalidMember
+	}
+	// a group that is a member of itself makes every check of it loop until the depth runs out
+	if AreSubjectsEqual(group, subject) {
+		return nil, ErrInvalidMember
+	}
+	
+	return &base.Tuple{
+		Entity:   &base.Entity{Type: group.GetType(), Id: group.GetId()},
+		Relation: MEMBER,
+		Subject:  subject,
+	}, nil
+}
+
+// GroupGrant - Tuple that relates the members of the group to the entity, e.g. doc:1#viewer@group:eng#member
+func GroupGrant(entity *base.Entity, relation, groupType, groupID string) (*base.Tuple, error) {
+	group, err := GroupSubject(groupType, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if !isGroupPart(entity.GetType()) || !isGroupPart(entity.GetId()) || !isGroupPart(relation) {
+		return nil, ErrInvalidEntityAndRelation
+	}
+	return &base.Tuple{
+		Entity:   &base.Entity{Type: entity.GetType(), Id: entity.GetId()},
+		Relation: relation,
+		Subject:  group,
+	}, nil
+}
+
+// isGroupPart - The part is not empty and has none of the separators of the tuple notation, which are the usual
+// mistakes of assembling group:eng#member by hand
+func isGroupPart(part string) bool {
+	return part != "" && !strings.ContainsAny(part, ":#@ ")
+}
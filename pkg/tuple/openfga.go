@@ -0,0 +1,71 @@
+package tuple
+
+import (
+	"strings"
+	
+	base "github.com/adminium/permify/pkg/pb/base/v1"
+)
+
+// OpenFGATupleKey - Tuple key of OpenFGA, e.g. {"user": "group:eng#member", "relation": "viewer", "object": "document:1"}.
+// Keys of the same tuples in Zanzibar papers are written as document:1#viewer@group:eng#member, which is the string
+// form of the tuples here too.
+type OpenFGATupleKey struct {
+	User     string `json:"user"`
+	Relation string `json:"relation"`
+	Object   string `json:"object"`
+}
+
+// ParseOpenFGATupleKey - Key of the string form of a tuple, e.g. document:1#viewer@user:2
+func ParseOpenFGATupleKey(s string) (OpenFGATupleKey, error) {
+	parts := strings.SplitN(strings.TrimSpace(s), "@", 2)
+	if len(parts) != 2 {
+		return OpenFGATupleKey{}, ErrInvalidTuple
+	}
+	object := strings.SplitN(parts[0], "#", 2)
+	if len(object) != 2 {
+		return OpenFGATupleKey{}, ErrInvalidTuple
+	}
+	return OpenFGATupleKey{User: parts[1], Relation: object[1], Object: object[0]}, nil
+}
+
+// FromOpenFGA - Tuple of the key. Users other than the type user that have no relation, e.g. the folder:1 of a parent
+// relation, get the ellipsis relation.
+func FromOpenFGA(key OpenFGATupleKey) (*base.Tuple, error) {
+	if key.Relation == "" || strings.ContainsAny(key.Relation, "#@:") {
+		return nil, ErrInvalidTuple
+	}
+	entity, err := E(key.Object)
+	if err != nil {
+		return nil, err
+	}
+	user, err := EAR(key.User)
+	if err != nil {
+		return nil, err
+	}
+	subject := &base.Subject{
+		Type:     user.GetEntity().GetType(),
+		Id:       user.GetEntity().GetId(),
+		Relation: user.GetRelation(),
+	}
+	if subject.GetRelation() == "" && !IsSubjectUser(subject) {
+		subject.Relation = ELLIPSIS
+	}
+	return &base.Tuple{
+		Entity:   entity,
+		Relation: key.Relation,
+		Subject:  subject,
+	}, nil
+}
+
+// ToOpenFGA - Tuple key of the tuple, the ellipsis relation is left out
+func ToOpenFGA(t *base.Tuple) OpenFGATupleKey {
+	user := EntityToString(&base.Entity{Type: t.GetSubject().GetType(), Id: t.GetSubject().GetId()})
+	if relation := t.GetSubject().GetRelation(); relation != "" && relation != ELLIPSIS {
+		user += "#" + relation
+	}
+	return OpenFGATupleKey{
+		User:     user,
+		Relation: t.GetRelation(),
+		Object:   EntityToString(t.GetEntity()),
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 	
 	"golang.org/x/exp/slices"
 	
@@ -23,6 +24,11 @@ const (
 	USER = "user"
 )
 
+const (
+	// WILDCARD - Subject id of the tuples that relate every subject of their type
+	WILDCARD = "*"
+)
+
 const (
 	SEPARATOR = "."
 )
@@ -55,6 +61,14 @@ func SubjectToString(subject *base.Subject) string {
 	return fmt.Sprintf("%s"+RELATION, fmt.Sprintf(ENTITY, subject.GetType(), subject.GetId()), subject.GetRelation())
 }
 
+// ToString -
+func ToString(tup *base.Tuple) string {
+	return EntityAndRelationToString(&base.EntityAndRelation{
+		Entity:   tup.GetEntity(),
+		Relation: tup.GetRelation(),
+	}) + "@" + SubjectToString(tup.GetSubject())
+}
+
 // IsEntityAndSubjectEquals -
 func IsEntityAndSubjectEquals(t *base.Tuple) bool {
 	return t.GetEntity().GetType() == t.GetSubject().GetType() && t.GetEntity().GetId() == t.GetSubject().GetId() && t.GetRelation() == t.GetSubject().GetRelation()
@@ -81,6 +95,26 @@ func ValidateSubjectType(subject *base.Subject, relationTypes []string) (err err
 	return nil
 }
 
+// Validity - Validity window of the tuple, the zero times are unbounded
+func Validity(t *base.Tuple) (notBefore, notAfter time.Time) {
+	if t.GetNotBefore() != nil {
+		notBefore = t.GetNotBefore().AsTime()
+	}
+	if t.GetNotAfter() != nil {
+		notAfter = t.GetNotAfter().AsTime()
+	}
+	return notBefore, notAfter
+}
+
+// ValidateValidity - The validity window of the tuple has to be non empty when it is bounded on both sides
+func ValidateValidity(t *base.Tuple) error {
+	notBefore, notAfter := Validity(t)
+	if !notBefore.IsZero() && !notAfter.IsZero() && !notBefore.Before(notAfter) {
+		return errors.New(base.ErrorCode_ERROR_CODE_VALIDATION.String())
+	}
+	return nil
+}
+
 // SplitRelation -
 func SplitRelation(relation string) (a []string) {
 	s := strings.Split(relation, SEPARATOR)
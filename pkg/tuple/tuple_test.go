@@ -2,6 +2,8 @@ package tuple
 
 import (
 	`errors`
+	"io"
+	"strings"
 	"testing"
 	
 	. "github.com/onsi/ginkgo/v2"
@@ -43,6 +45,22 @@ var _ = Describe("tuple", func() {
 				Expect(EntityAndRelationToString(tt.target)).Should(Equal(tt.expected))
 			}
 		})
+		
+		It("ToString", func() {
+			tests := []struct {
+				target   string
+				expected string
+			}{
+				{"repository:1#admin@user:1", "repository:1#admin@user:1"},
+				{"repository:1#parent@organization:1#...", "repository:1#parent@organization:1#..."},
+			}
+			
+			for _, tt := range tests {
+				tup, err := Tuple(tt.target)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(ToString(tup)).Should(Equal(tt.expected))
+			}
+		})
 	})
 	
 	Context("StringToTuple", func() {
@@ -412,4 +430,114 @@ var _ = Describe("tuple", func() {
 			}
 		})
 	})
+	
+	Context("Format", func() {
+		tuples := []string{"doc:1#owner@user:1", "doc:1#owner@team:1#member", "doc:2#viewer@user:\"2,3\""}
+		
+		It("Case 1: Tuples survive a round trip through both formats", func() {
+			for _, format := range []Format{NDJSON, CSV} {
+				var b strings.Builder
+				encoder, err := NewEncoder(&b, format)
+				Expect(err).ShouldNot(HaveOccurred())
+				for _, t := range tuples {
+					tup, err := Tuple(t)
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(encoder.Encode(tup)).Should(Succeed())
+				}
+				Expect(encoder.Flush()).Should(Succeed())
+				
+				decoder, err := NewDecoder(strings.NewReader(b.String()), format)
+				Expect(err).ShouldNot(HaveOccurred())
+				var decoded []string
+				for {
+					tup, err := decoder.Decode()
+					if errors.Is(err, io.EOF) {
+						break
+					}
+					Expect(err).ShouldNot(HaveOccurred())
+					decoded = append(decoded, ToString(tup))
+				}
+				Expect(decoded).Should(Equal(tuples))
+			}
+		})
+		
+		It("Case 2: Files without csv header and with blank ndjson lines", func() {
+			decoder, err := NewDecoder(strings.NewReader("doc,1,owner,user,1,\n"), CSV)
+			Expect(err).ShouldNot(HaveOccurred())
+			tup, err := decoder.Decode()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ToString(tup)).Should(Equal("doc:1#owner@user:1"))
+			
+			decoder, err = NewDecoder(strings.NewReader("\n{\"entity_type\":\"doc\",\"entity_id\":\"1\",\"relation\":\"owner\",\"subject_type\":\"user\",\"subject_id\":\"1\"}\n\n{\n"), NDJSON)
+			Expect(err).ShouldNot(HaveOccurred())
+			tup, err = decoder.Decode()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ToString(tup)).Should(Equal("doc:1#owner@user:1"))
+			_, err = decoder.Decode()
+			Expect(err).Should(MatchError(ContainSubstring("line 4")))
+			
+			_, err = ParseFormat("xml")
+			Expect(errors.Is(err, ErrUnsupportedFormat)).Should(BeTrue())
+		})
+	})
+	
+	Context("OpenFGA", func() {
+		It("Case 1: Tuple keys of users, usersets and objects", func() {
+			for key, expected := range map[string]string{
+				"document:1#viewer@user:2":           "document:1#viewer@user:2",
+				"document:1#viewer@group:eng#member": "document:1#viewer@group:eng#member",
+				"document:1#parent@folder:1":         "document:1#parent@folder:1#...",
+				"document:1#viewer@user:*":           "document:1#viewer@user:*",
+			} {
+				k, err := ParseOpenFGATupleKey(key)
+				Expect(err).ShouldNot(HaveOccurred())
+				tup, err := FromOpenFGA(k)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(ToString(tup)).Should(Equal(expected))
+				Expect(ToOpenFGA(tup)).Should(Equal(k))
+			}
+			
+			_, err := ParseOpenFGATupleKey("document:1@user:2")
+			Expect(err).Should(Equal(ErrInvalidTuple))
+			_, err = FromOpenFGA(OpenFGATupleKey{User: "user:2", Relation: "viewer", Object: "document"})
+			Expect(err).Should(Equal(ErrInvalidEntity))
+		})
+	})
+	
+	Context("Group", func() {
+		It("Case 1: Members, nested groups and grants", func() {
+			subject, err := GroupSubject(GROUP, "eng")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(SubjectToString(subject)).Should(Equal("group:eng#member"))
+			
+			for member, expected := range map[*base.Subject]string{
+				{Type: USER, Id: "1"}:                         "group:eng#member@user:1",
+				{Type: GROUP, Id: "backend"}:                  "group:eng#member@group:backend#member",
+				{Type: "team", Id: "infra", Relation: MEMBER}: "group:eng#member@team:infra#member",
+			} {
+				tup, err := Membership(GROUP, "eng", member)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(ToString(tup)).Should(Equal(expected))
+			}
+			
+			tup, err := GroupGrant(&base.Entity{Type: "doc", Id: "1"}, "viewer", GROUP, "eng")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ToString(tup)).Should(Equal("doc:1#viewer@group:eng#member"))
+		})
+		
+		It("Case 2: Hand assembled and cyclic groups are rejected", func() {
+			for _, group := range [][2]string{{GROUP, "group:eng"}, {GROUP, "eng#member"}, {"", "eng"}, {USER, "1"}, {GROUP, WILDCARD}} {
+				_, err := GroupSubject(group[0], group[1])
+				Expect(err).Should(Equal(ErrInvalidGroup))
+			}
+			
+			for _, member := range []*base.Subject{{Type: USER}, {Type: "team", Id: "infra"}, {Type: GROUP, Id: "eng"}, {Type: "user:1", Id: "1"}} {
+				_, err := Membership(GROUP, "eng", member)
+				Expect(err).Should(Equal(ErrInvalidMember))
+			}
+			
+			_, err := GroupGrant(&base.Entity{Type: "doc", Id: "1"}, "", GROUP, "eng")
+			Expect(err).Should(Equal(ErrInvalidEntityAndRelation))
+		})
+	})
 })